@@ -0,0 +1,80 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/peertls/conformance"
+)
+
+var (
+	conformanceCmd = &cobra.Command{
+		Use:   "conformance",
+		Short: "Check node IDs, certificate chains, extensions and revocations against test vectors",
+	}
+
+	conformanceRunCmd = &cobra.Command{
+		Use:   "run",
+		Short: "Run the conformance suites against this implementation",
+		Long:  "Run the conformance suites against this implementation.\n\nThe built-in vectors are used unless --vectors is given, e.g. vectors created by another implementation.",
+		Args:  cobra.NoArgs,
+		RunE:  cmdConformanceRun,
+	}
+
+	conformanceVectorsCmd = &cobra.Command{
+		Use:   "vectors",
+		Short: "Print the built-in test vectors as json, for checking other implementations",
+		Args:  cobra.NoArgs,
+		RunE:  cmdConformanceVectors,
+	}
+
+	conformanceVectorsPath string
+)
+
+func init() {
+	rootCmd.AddCommand(conformanceCmd)
+	conformanceCmd.AddCommand(conformanceRunCmd)
+	conformanceCmd.AddCommand(conformanceVectorsCmd)
+
+	conformanceRunCmd.Flags().StringVar(&conformanceVectorsPath, "vectors", "", "path to a json file with the vectors to check, empty uses the built-in vectors")
+}
+
+func cmdConformanceRun(cmd *cobra.Command, args []string) (err error) {
+	vectors := conformance.Default
+	if conformanceVectorsPath != "" {
+		file, err := os.Open(conformanceVectorsPath)
+		if err != nil {
+			return err
+		}
+		vectors, err = conformance.ReadVectors(file)
+		if err := errs.Combine(err, file.Close()); err != nil {
+			return err
+		}
+	}
+
+	failed := 0
+	for _, result := range conformance.Run(vectors) {
+		if result.Err != nil {
+			failed++
+			fmt.Printf("%s %s: %s: %v\n", color.RedString("FAIL"), result.Suite, result.Name, result.Err)
+			continue
+		}
+		fmt.Printf("%s %s: %s\n", color.GreenString("PASS"), result.Suite, result.Name)
+	}
+
+	if failed > 0 {
+		return errs.New("%d vectors failed", failed)
+	}
+	return nil
+}
+
+func cmdConformanceVectors(cmd *cobra.Command, args []string) (err error) {
+	return conformance.WriteVectors(os.Stdout, conformance.Default)
+}
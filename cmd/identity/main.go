@@ -54,6 +54,7 @@ var (
 		Concurrency    uint   `default:"4" help:"number of concurrent workers for certificate authority generation"`
 		ParentCertPath string `help:"path to the parent authority's certificate chain"`
 		ParentKeyPath  string `help:"path to the parent authority's private key"`
+		CrossSign      bool   `default:"false" help:"keep the existing signed chain and add the newly signed chain in parallel, for migrating between signer authorities"`
 		Signer         certificates.CertClientConfig
 	}
 
@@ -177,8 +178,15 @@ func cmdAuthorize(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	ca.Cert = signedChain[0]
-	ca.RestChain = signedChain[1:]
+	if config.CrossSign {
+		// peers trusting either signer accept the identity
+		if err := ca.AddAltChain(signedChain); err != nil {
+			return err
+		}
+	} else {
+		ca.Cert = signedChain[0]
+		ca.RestChain = signedChain[1:]
+	}
 	err = identity.FullCAConfig{
 		CertPath: caConfig.CertPath,
 	}.Save(ca)
@@ -191,8 +199,14 @@ func cmdAuthorize(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	ident.RestChain = signedChain[1:]
-	ident.CA = ca.Cert
+	if config.CrossSign {
+		if err := ident.AddAltChain(signedChain); err != nil {
+			return err
+		}
+	} else {
+		ident.RestChain = signedChain[1:]
+		ident.CA = ca.Cert
+	}
 	err = identity.Config{
 		CertPath: identConfig.CertPath,
 	}.Save(ident)
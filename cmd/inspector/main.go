@@ -12,9 +12,12 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 
@@ -57,6 +60,34 @@ var (
 		Use:   "statdb",
 		Short: "commands for statdb",
 	}
+	irreparableCmd = &cobra.Command{
+		Use:   "irreparable",
+		Short: "commands for irreparable segments",
+	}
+	repairCmd = &cobra.Command{
+		Use:   "repair",
+		Short: "commands for repairs",
+	}
+	objectsCmd = &cobra.Command{
+		Use:   "objects",
+		Short: "commands for objects",
+	}
+	broadcastCmd = &cobra.Command{
+		Use:   "broadcast",
+		Short: "commands for broadcasting notices to nodes",
+	}
+	auditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "commands for audits",
+	}
+	transportCmd = &cobra.Command{
+		Use:   "transport",
+		Short: "commands for dials to nodes",
+	}
+	maintenanceCmd = &cobra.Command{
+		Use:   "maintenance",
+		Short: "commands for satellite maintenance, during which no new uploads are accepted",
+	}
 	countNodeCmd = &cobra.Command{
 		Use:   "count",
 		Short: "count nodes in kademlia and overlay",
@@ -79,6 +110,11 @@ var (
 		Short: "dump all nodes in the routing table",
 		RunE:  DumpNodes,
 	}
+	topologyCmd = &cobra.Command{
+		Use:   "topology [lookup_limit]",
+		Short: "dump the routing table, neighborhood and recent lookups as json",
+		RunE:  Topology,
+	}
 	getStatsCmd = &cobra.Command{
 		Use:   "getstats <node_id>",
 		Short: "Get node stats",
@@ -105,14 +141,79 @@ var (
 		Args:  cobra.MinimumNArgs(1),
 		RunE:  CreateCSVStats,
 	}
+	listIrreparableCmd = &cobra.Command{
+		Use:   "list <project_id>",
+		Short: "list the irreparable segments of a project",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  ListIrreparableSegments,
+	}
+	repairStatsCmd = &cobra.Command{
+		Use:   "stats [node_limit]",
+		Short: "show the outcomes of repairs and the nodes they failed on most",
+		RunE:  RepairStats,
+	}
+	objectHealthCmd = &cobra.Command{
+		Use:   "health <project_id> <bucket> <encrypted_path>",
+		Short: "show the piece placement and health of the segments of an object",
+		Args:  cobra.MinimumNArgs(3),
+		RunE:  ObjectHealth,
+	}
+	sendBroadcastCmd = &cobra.Command{
+		Use:   "send <message>",
+		Short: "sign a notice and deliver it to the nodes the satellite contacted recently",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  SendBroadcast,
+	}
+	broadcastStatusCmd = &cobra.Command{
+		Use:   "status <broadcast_id>",
+		Short: "show how many nodes a notice was delivered to and which deliveries failed",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  BroadcastStatus,
+	}
+	auditHistoryCmd = &cobra.Command{
+		Use:   "history <node_id> [since]",
+		Short: "show the outcomes of the audits of a node since a duration ago, e.g. 72h",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  AuditHistory,
+	}
+	dialStatsCmd = &cobra.Command{
+		Use:   "stats",
+		Short: "show the success rates and latencies of dials by region and subnet of the dialed nodes",
+		RunE:  DialStats,
+	}
+	scheduleMaintenanceCmd = &cobra.Command{
+		Use:   "schedule <until> [start]",
+		Short: "schedule a maintenance, times in RFC3339 format, without start it starts immediately",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  ScheduleMaintenance,
+	}
+	cancelMaintenanceCmd = &cobra.Command{
+		Use:   "cancel",
+		Short: "cancel the scheduled maintenance, or end it when it's active",
+		RunE:  CancelMaintenance,
+	}
+	maintenanceStatusCmd = &cobra.Command{
+		Use:   "status",
+		Short: "show the scheduled maintenance",
+		RunE:  MaintenanceStatus,
+	}
 )
 
+// irreparableLimit is the number of irreparable segments requested at once
+const irreparableLimit = 100
+
 // Inspector gives access to kademlia and overlay cache
 type Inspector struct {
 	identity      *identity.FullIdentity
 	kadclient     pb.KadInspectorClient
 	overlayclient pb.OverlayInspectorClient
 	statdbclient  pb.StatDBInspectorClient
+	irrdbclient   pb.IrreparableInspectorClient
+	pdbclient     pb.PointerDBInspectorClient
+	repairclient  pb.RepairInspectorClient
+	bcastclient   pb.BroadcastInspectorClient
+	auditclient   pb.AuditInspectorClient
+	dialclient    pb.TransportInspectorClient
 }
 
 // NewInspector creates a new gRPC inspector server for access to kad
@@ -140,6 +241,12 @@ func NewInspector(address, path string) (*Inspector, error) {
 		kadclient:     pb.NewKadInspectorClient(conn),
 		overlayclient: pb.NewOverlayInspectorClient(conn),
 		statdbclient:  pb.NewStatDBInspectorClient(conn),
+		irrdbclient:   pb.NewIrreparableInspectorClient(conn),
+		pdbclient:     pb.NewPointerDBInspectorClient(conn),
+		repairclient:  pb.NewRepairInspectorClient(conn),
+		bcastclient:   pb.NewBroadcastInspectorClient(conn),
+		auditclient:   pb.NewAuditInspectorClient(conn),
+		dialclient:    pb.NewTransportInspectorClient(conn),
 	}, nil
 }
 
@@ -199,6 +306,33 @@ func DumpNodes(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// Topology outputs the routing table, the neighborhood and the recent lookups
+// of the node as json
+func Topology(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	lookupLimit := 20
+	if len(args) > 0 {
+		lookupLimit, err = strconv.Atoi(args[0])
+		if err != nil {
+			return ErrArgs.Wrap(err)
+		}
+	}
+
+	topology, err := i.kadclient.Topology(context.Background(), &pb.TopologyRequest{
+		LookupLimit: int32(lookupLimit),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Println(prettyPrint(topology))
+	return nil
+}
+
 func prettyPrint(unformatted proto.Message) string {
 	m := jsonpb.Marshaler{Indent: "  ", EmitDefaults: true}
 	formatted, err := m.MarshalToString(unformatted)
@@ -402,20 +536,347 @@ func CreateCSVStats(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// ListIrreparableSegments lists the irreparable segments of a project
+func ListIrreparableSegments(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	projectID, err := uuid.Parse(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	var offset int64
+	for {
+		res, err := i.irrdbclient.ListIrreparableSegments(context.Background(), &pb.ListIrreparableSegmentsRequest{
+			ProjectId: projectID[:],
+			Limit:     irreparableLimit,
+			Offset:    offset,
+		})
+		if err != nil {
+			return ErrRequest.Wrap(err)
+		}
+
+		for _, segment := range res.Segments {
+			// segment paths are of the form "projectID/segment/bucket/path"
+			components := storj.SplitPath(string(segment.Path))
+			if len(components) < 4 {
+				fmt.Printf("Path: %q\n", segment.Path)
+			} else {
+				fmt.Printf("Bucket: %s, Segment: %s, Path: %s\n",
+					components[2], components[1], storj.JoinPaths(components[3:]...))
+			}
+			fmt.Printf("  LostPieces: %d, RepairAttempts: %d, LastChecked: %s, LastError: %s\n",
+				segment.LostPieces, segment.RepairAttemptCount,
+				time.Unix(segment.LastRepairSeconds, 0).UTC().Format(time.RFC3339), segment.LastError)
+		}
+
+		if len(res.Segments) < irreparableLimit {
+			return nil
+		}
+		offset += int64(len(res.Segments))
+	}
+}
+
+// RepairStats shows the outcomes of the repairs of the satellite's repair worker
+func RepairStats(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	nodeLimit := 10
+	if len(args) > 0 {
+		nodeLimit, err = strconv.Atoi(args[0])
+		if err != nil {
+			return ErrArgs.Wrap(err)
+		}
+	}
+
+	res, err := i.repairclient.RepairStats(context.Background(), &pb.RepairStatsRequest{
+		NodeLimit: int32(nodeLimit),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Size buckets: %v\n", res.SizeBuckets)
+	for _, outcome := range res.Outcomes {
+		fmt.Printf("Outcome: %s, Count: %d, TotalBytes: %d, SizeCounts: %v\n",
+			outcome.Outcome, outcome.Count, outcome.TotalBytes, outcome.SizeCounts)
+	}
+	for _, node := range res.Nodes {
+		fmt.Printf("Node: %s, DownloadFailures: %d, UploadFailures: %d\n",
+			node.NodeId, node.DownloadFailures, node.UploadFailures)
+	}
+	return nil
+}
+
+// ObjectHealth shows where the segments of an object are stored and when
+// they were last audited and repaired
+func ObjectHealth(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	projectID, err := uuid.Parse(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	res, err := i.pdbclient.ObjectHealth(context.Background(), &pb.ObjectHealthRequest{
+		ProjectId:     projectID[:],
+		Bucket:        []byte(args[1]),
+		EncryptedPath: []byte(args[2]),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	formatTime := func(seconds int64) string {
+		if seconds == 0 {
+			return "never"
+		}
+		return time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+	}
+
+	for _, segment := range res.Segments {
+		if segment.SegmentIndex < 0 {
+			fmt.Printf("Segment: last, Path: %s\n", segment.Path)
+		} else {
+			fmt.Printf("Segment: %d, Path: %s\n", segment.SegmentIndex, segment.Path)
+		}
+		if segment.Inline {
+			fmt.Printf("  Inline\n")
+			continue
+		}
+
+		online := 0
+		for _, piece := range segment.Pieces {
+			if piece.Online {
+				online++
+			}
+		}
+		fmt.Printf("  Pieces: %d (%d online), MinReq: %d, Repair: %d, Success: %d, Total: %d\n",
+			len(segment.Pieces), online, segment.MinReq, segment.RepairThreshold, segment.SuccessThreshold, segment.Total)
+		fmt.Printf("  LastAudited: %s, LastRepaired: %s\n",
+			formatTime(segment.LastAuditSeconds), formatTime(segment.LastRepairSeconds))
+		for _, piece := range segment.Pieces {
+			fmt.Printf("    Piece: %d, Node: %s, Online: %t\n", piece.PieceNum, piece.NodeId, piece.Online)
+		}
+	}
+	return nil
+}
+
+// SendBroadcast signs a notice with the satellite identity and delivers it to
+// the nodes the satellite contacted recently
+func SendBroadcast(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.bcastclient.Broadcast(context.Background(), &pb.BroadcastRequest{
+		Message: strings.Join(args, " "),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	var id uuid.UUID
+	copy(id[:], res.Id)
+	fmt.Printf("Broadcast: %s, Nodes: %d\n", id.String(), res.NodeCount)
+	return nil
+}
+
+// BroadcastStatus shows the delivery status of a broadcast notice
+func BroadcastStatus(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	id, err := uuid.Parse(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	res, err := i.bcastclient.BroadcastStatus(context.Background(), &pb.BroadcastStatusRequest{
+		Id: id[:],
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Message: %s\n", res.Message)
+	fmt.Printf("Created: %s\n", time.Unix(res.CreatedSeconds, 0).UTC().Format(time.RFC3339))
+	fmt.Printf("Pending: %d, Delivered: %d, Failed: %d\n", res.Pending, res.Delivered, res.Failed)
+	for _, failure := range res.Failures {
+		fmt.Printf("  Node: %s, Attempts: %d, Error: %s\n", failure.NodeId, failure.Attempts, failure.Error)
+	}
+	return nil
+}
+
+// AuditHistory shows the outcomes of the recent audits of a node, as evidence
+// when the node operator disputes a disqualification
+func AuditHistory(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	since := 24 * time.Hour
+	if len(args) > 1 {
+		since, err = time.ParseDuration(args[1])
+		if err != nil {
+			return ErrArgs.Wrap(err)
+		}
+	}
+
+	res, err := i.auditclient.AuditHistory(context.Background(), &pb.AuditHistoryRequest{
+		NodeId:       nodeID,
+		SinceSeconds: time.Now().Add(-since).Unix(),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	for _, record := range res.Records {
+		fmt.Printf("Audited: %s, Outcome: %s, Segment: %x, Stripe: %d, Piece: %d, Duration: %dms, Error: %s\n",
+			time.Unix(record.AuditedSeconds, 0).Format(time.RFC3339), record.Outcome, record.SegmentHash,
+			record.StripeIndex, record.PieceNum, record.DurationMs, record.Error)
+	}
+	return nil
+}
+
+// DialStats shows the success rates and latencies of the dials of the
+// satellite by region and subnet, to spot degraded connectivity
+func DialStats(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.dialclient.DialStats(context.Background(), &pb.DialStatsRequest{})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	printGroup := func(kind string, group *pb.DialGroupStats) {
+		var averageMs int64
+		if group.Successes > 0 {
+			averageMs = group.TotalLatencyMs / group.Successes
+		}
+		fmt.Printf("%s: %s, Successes: %d, Failures: %d, AverageLatency: %dms, LatencyCounts: %v\n",
+			kind, group.Group, group.Successes, group.Failures, averageMs, group.LatencyCounts)
+	}
+
+	fmt.Printf("Latency buckets: %vms\n", res.LatencyBucketsMs)
+	for _, region := range res.Regions {
+		printGroup("Region", region)
+	}
+	for _, subnet := range res.Subnets {
+		printGroup("Subnet", subnet)
+	}
+	return nil
+}
+
+// ScheduleMaintenance schedules a maintenance of the satellite
+func ScheduleMaintenance(cmd *cobra.Command, args []string) (err error) {
+	until, err := time.Parse(time.RFC3339, args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+	req := &pb.ScheduleMaintenanceRequest{UntilSeconds: until.Unix()}
+	if len(args) > 1 {
+		start, err := time.Parse(time.RFC3339, args[1])
+		if err != nil {
+			return ErrArgs.Wrap(err)
+		}
+		req.StartSeconds = start.Unix()
+	}
+	return maintenance(req)
+}
+
+// CancelMaintenance cancels the scheduled maintenance of the satellite
+func CancelMaintenance(cmd *cobra.Command, args []string) (err error) {
+	return maintenance(&pb.ScheduleMaintenanceRequest{Cancel: true})
+}
+
+// MaintenanceStatus shows the scheduled maintenance of the satellite
+func MaintenanceStatus(cmd *cobra.Command, args []string) (err error) {
+	return maintenance(&pb.ScheduleMaintenanceRequest{StatusOnly: true})
+}
+
+// maintenance sends req and shows the scheduled maintenance
+func maintenance(req *pb.ScheduleMaintenanceRequest) error {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	res, err := i.pdbclient.ScheduleMaintenance(context.Background(), req)
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	if res.UntilSeconds == 0 {
+		fmt.Println("No maintenance scheduled")
+		return nil
+	}
+	fmt.Printf("Start: %s, Until: %s, Active: %t\n",
+		time.Unix(res.StartSeconds, 0).UTC().Format(time.RFC3339),
+		time.Unix(res.UntilSeconds, 0).UTC().Format(time.RFC3339), res.Active)
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(kadCmd)
 	rootCmd.AddCommand(statsCmd)
+	rootCmd.AddCommand(irreparableCmd)
+	rootCmd.AddCommand(repairCmd)
+	rootCmd.AddCommand(objectsCmd)
+	rootCmd.AddCommand(broadcastCmd)
+	rootCmd.AddCommand(auditCmd)
+	rootCmd.AddCommand(transportCmd)
+	rootCmd.AddCommand(maintenanceCmd)
 
 	kadCmd.AddCommand(countNodeCmd)
 	kadCmd.AddCommand(pingNodeCmd)
 	kadCmd.AddCommand(lookupNodeCmd)
 	kadCmd.AddCommand(dumpNodesCmd)
+	kadCmd.AddCommand(topologyCmd)
 
 	statsCmd.AddCommand(getStatsCmd)
 	statsCmd.AddCommand(getCSVStatsCmd)
 	statsCmd.AddCommand(createStatsCmd)
 	statsCmd.AddCommand(createCSVStatsCmd)
 
+	irreparableCmd.AddCommand(listIrreparableCmd)
+
+	repairCmd.AddCommand(repairStatsCmd)
+
+	objectsCmd.AddCommand(objectHealthCmd)
+
+	broadcastCmd.AddCommand(sendBroadcastCmd)
+	broadcastCmd.AddCommand(broadcastStatusCmd)
+
+	auditCmd.AddCommand(auditHistoryCmd)
+
+	transportCmd.AddCommand(dialStatsCmd)
+
+	maintenanceCmd.AddCommand(scheduleMaintenanceCmd)
+	maintenanceCmd.AddCommand(cancelMaintenanceCmd)
+	maintenanceCmd.AddCommand(maintenanceStatusCmd)
+
 	flag.Parse()
 }
 
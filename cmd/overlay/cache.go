@@ -10,12 +10,17 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/satellite/satellitedb"
 )
 
 type cacheConfig struct {
 	NodesPath string `help:"the path to a JSON file containing an object with IP keys and nodeID values"`
 	Database  string `help:"overlay database connection string" default:"sqlite3://$CONFDIR/master.db"`
+
+	Ping       overlay.PingConfig
+	Reputation reputation.Config
+	Subnets    overlay.SubnetConfig
 }
 
 func (c cacheConfig) open(ctx context.Context) (cache *overlay.Cache, dbClose func(), err error) {
@@ -30,5 +35,5 @@ func (c cacheConfig) open(ctx context.Context) (cache *overlay.Cache, dbClose fu
 		}
 	}
 
-	return overlay.NewCache(database.OverlayCache(), database.StatDB()), dbClose, nil
+	return overlay.NewCache(database.OverlayCache(), database.StatDB(), database.NodePings(), c.Ping, c.Reputation, c.Subnets), dbClose, nil
 }
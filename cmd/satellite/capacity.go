@@ -0,0 +1,36 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/accounting/capacity"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// generateCapacityReport writes a capacity planning report as JSON
+func generateCapacityReport(ctx context.Context, window time.Duration, output io.Writer) (err error) {
+	db, err := satellitedb.New(capacityCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	report, err := capacity.Generate(ctx, db.OverlayCache(), db.Accounting(), time.Now(), window)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
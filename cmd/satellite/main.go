@@ -16,8 +16,13 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/datarepair/repairer"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/satellitedb"
 )
@@ -55,6 +60,11 @@ var (
 		Short: "Repair Queue Diagnostic Tool support",
 		RunE:  cmdQDiag,
 	}
+	repairWorkerCmd = &cobra.Command{
+		Use:   "repair-worker",
+		Short: "Run a repair worker against the repair queue of the satellite database",
+		RunE:  cmdRepairWorker,
+	}
 	reportsCmd = &cobra.Command{
 		Use:   "reports",
 		Short: "Generate a report",
@@ -66,6 +76,30 @@ var (
 		Args:  cobra.MinimumNArgs(2),
 		RunE:  cmdPayments,
 	}
+	partnerAttributionCmd = &cobra.Command{
+		Use:   "partner-attribution [start] [end]",
+		Short: "Generate a partner attribution report for a given period",
+		Long:  "Generate a report of the at-rest data and the egress of the buckets attributed to each partner for a given period. Format dates using YYYY-MM-DD",
+		Args:  cobra.MinimumNArgs(2),
+		RunE:  cmdPartnerAttribution,
+	}
+	capacityCmd = &cobra.Command{
+		Use:   "capacity",
+		Short: "Generate a capacity planning report as JSON",
+		Long:  "Generate a capacity planning report as JSON, forecasting when the free space of the storage nodes runs out at the growth of the data at rest during the window",
+		RunE:  cmdCapacity,
+	}
+
+	simulateCmd = &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate satellite algorithms against the current database",
+	}
+	simulateSelectionCmd = &cobra.Command{
+		Use:   "selection",
+		Short: "Simulate node selections and output the distribution of the pieces as JSON",
+		Long:  "Run the node selection repeatedly against the current overlay without storing anything and output the shares of the selected pieces per node, subnet and operator declared region as JSON, to validate the fairness of selection parameters before changing them",
+		RunE:  cmdSimulateSelection,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
@@ -77,10 +111,37 @@ var (
 		Database   string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
 		QListLimit int    `help:"maximum segments that can be requested" default:"1000"`
 	}
+	repairWorkerCfg struct {
+		Database string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+		Identity identity.Config
+		Repairer repairer.Config
+	}
 	paymentsCfg struct {
 		Database string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
 		Output   string `help:"destination of report output" default:""`
 	}
+	partnerAttributionCfg struct {
+		Database string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+		Output   string `help:"destination of report output" default:""`
+	}
+	capacityCfg struct {
+		Database string        `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+		Window   time.Duration `help:"period the ingest rates are measured over" default:"168h0m0s"`
+		Output   string        `help:"destination of report output" default:""`
+	}
+
+	simulateSelectionCfg struct {
+		Database   string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+		Overlay    overlay.Config
+		Reputation reputation.Config
+		Placement  overlay.PlacementConfig
+
+		Runs      int         `help:"number of simulated selections" default:"1000"`
+		Nodes     int         `help:"number of nodes selected for each segment" default:"80"`
+		PieceSize memory.Size `help:"free disk and bandwidth the selected nodes need" default:"2.3MiB"`
+		Top       int         `help:"maximum number of nodes, subnets and regions in the output, 0 for all" default:"100"`
+		Output    string      `help:"destination of report output" default:""`
+	}
 
 	defaultConfDir = fpath.ApplicationDir("storj", "satellite")
 	// TODO: this path should be defined somewhere else
@@ -114,13 +175,22 @@ func init() {
 	rootCmd.AddCommand(setupCmd)
 	rootCmd.AddCommand(diagCmd)
 	rootCmd.AddCommand(qdiagCmd)
+	rootCmd.AddCommand(repairWorkerCmd)
 	rootCmd.AddCommand(reportsCmd)
 	reportsCmd.AddCommand(paymentsCmd)
+	reportsCmd.AddCommand(partnerAttributionCmd)
+	reportsCmd.AddCommand(capacityCmd)
+	rootCmd.AddCommand(simulateCmd)
+	simulateCmd.AddCommand(simulateSelectionCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.Bind(diagCmd.Flags(), &diagCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.Bind(qdiagCmd.Flags(), &qdiagCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(repairWorkerCmd.Flags(), &repairWorkerCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.Bind(paymentsCmd.Flags(), &paymentsCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(partnerAttributionCmd.Flags(), &partnerAttributionCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(capacityCmd.Flags(), &capacityCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(simulateSelectionCmd.Flags(), &simulateSelectionCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -202,11 +272,12 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 	// initialize the table header (fields)
 	const padding = 3
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, padding, ' ', tabwriter.AlignRight|tabwriter.Debug)
-	fmt.Fprintln(w, "UplinkID\tTotal\t# Of Transactions\tPUT Action\tGET Action\t")
+	fmt.Fprintln(w, "UplinkID\tTotal\t# Of Transactions\tPUT Action\tGET Action\tGET (Audit) Action\tGET (Repair) Action\tPUT (Repair) Action\t")
 
 	// populate the row fields
 	for _, s := range stats {
-		fmt.Fprint(w, s.NodeID, "\t", s.TotalBytes, "\t", s.TotalTransactions, "\t", s.PutActionCount, "\t", s.GetActionCount, "\t\n")
+		fmt.Fprint(w, s.NodeID, "\t", s.TotalBytes, "\t", s.TotalTransactions, "\t", s.PutActionCount, "\t", s.GetActionCount, "\t",
+			s.GetAuditActionCount, "\t", s.GetRepairActionCount, "\t", s.PutRepairActionCount, "\t\n")
 	}
 
 	// display the data
@@ -282,6 +353,84 @@ func cmdPayments(cmd *cobra.Command, args []string) (err error) {
 	return generateCSV(ctx, start, end, file)
 }
 
+func cmdPartnerAttribution(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	layout := "2006-01-02"
+	start, err := time.Parse(layout, args[0])
+	if err != nil {
+		return errs.New("Invalid date format. Please use YYYY-MM-DD")
+	}
+	end, err := time.Parse(layout, args[1])
+	if err != nil {
+		return errs.New("Invalid date format. Please use YYYY-MM-DD")
+	}
+
+	// Ensure that start date is not after end date
+	if start.After(end) {
+		return errs.New("Invalid time period (%v) - (%v)", start, end)
+	}
+
+	// send output to stdout
+	if partnerAttributionCfg.Output == "" {
+		return generatePartnerAttributionCSV(ctx, start, end, os.Stdout)
+	}
+
+	// send output to file
+	file, err := os.Create(partnerAttributionCfg.Output)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errs.Combine(err, file.Close())
+	}()
+
+	return generatePartnerAttributionCSV(ctx, start, end, file)
+}
+
+func cmdCapacity(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	// send output to stdout
+	if capacityCfg.Output == "" {
+		return generateCapacityReport(ctx, capacityCfg.Window, os.Stdout)
+	}
+
+	// send output to file
+	file, err := os.Create(capacityCfg.Output)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errs.Combine(err, file.Close())
+	}()
+
+	return generateCapacityReport(ctx, capacityCfg.Window, file)
+}
+
+func cmdSimulateSelection(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	// send output to stdout
+	if simulateSelectionCfg.Output == "" {
+		return simulateSelection(ctx, os.Stdout)
+	}
+
+	// send output to file
+	file, err := os.Create(simulateSelectionCfg.Output)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errs.Combine(err, file.Close())
+	}()
+
+	return simulateSelection(ctx, file)
+}
+
 func main() {
 	process.Exec(rootCmd)
 }
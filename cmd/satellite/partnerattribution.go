@@ -0,0 +1,102 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// generatePartnerAttributionCSV generates a report of the at-rest data and the
+// egress of the buckets attributed to each partner for a given period
+func generatePartnerAttributionCSV(ctx context.Context, start time.Time, end time.Time, output io.Writer) (err error) {
+	db, err := satellitedb.New(partnerAttributionCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	attributions, err := db.BucketAttributions().GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	buckets := make(map[string]int)
+	for _, attribution := range attributions {
+		buckets[attribution.PartnerID]++
+	}
+
+	atRest, err := db.Accounting().QueryPartnerStorage(ctx, start, end)
+	if err != nil {
+		return err
+	}
+
+	egress, err := db.Accounting().QueryPartnerEgress(ctx, start, end)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool)
+	for partnerID := range buckets {
+		known[partnerID] = true
+	}
+	for partnerID := range atRest {
+		known[partnerID] = true
+	}
+	for partnerID := range egress {
+		known[partnerID] = true
+	}
+
+	var partners []string
+	for partnerID := range known {
+		partners = append(partners, partnerID)
+	}
+	sort.Strings(partners)
+
+	w := csv.NewWriter(output)
+	headers := []string{
+		"partnerID",
+		"buckets",
+		"byte-hours:AtRest",
+		"bytes:Egress",
+		"start",
+		"end",
+	}
+	if err := w.Write(headers); err != nil {
+		return err
+	}
+
+	for _, partnerID := range partners {
+		record := []string{
+			partnerID,
+			strconv.Itoa(buckets[partnerID]),
+			strconv.FormatFloat(atRest[partnerID], 'f', 5, 64),
+			strconv.FormatInt(egress[partnerID], 10),
+			start.Format("2006-01-02"),
+			end.Format("2006-01-02"),
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	if output != os.Stdout {
+		fmt.Println("Generated partner attribution report")
+	}
+	return nil
+}
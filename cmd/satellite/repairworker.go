@@ -0,0 +1,50 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/datarepair/repairer"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// cmdRepairWorker runs a repair worker in a separate process, any number of
+// workers can share the repair queue of a satellite database
+func cmdRepairWorker(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	identity, err := repairWorkerCfg.Identity.Load()
+	if err != nil {
+		zap.S().Fatal(err)
+	}
+
+	if err := process.InitMetricsWithCertPath(ctx, nil, repairWorkerCfg.Identity.CertPath); err != nil {
+		zap.S().Error("Failed to initialize telemetry batcher: ", err)
+	}
+
+	db, err := satellitedb.New(repairWorkerCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	worker := repairer.NewWorker(zap.L().Named("repair worker"), db.RepairQueue(), db.SegmentHealth(), &repairWorkerCfg.Repairer, identity)
+	defer func() {
+		err = errs.Combine(err, worker.Close())
+	}()
+
+	err = worker.Run(ctx)
+	if err == context.Canceled {
+		return nil
+	}
+	return err
+}
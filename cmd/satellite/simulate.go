@@ -0,0 +1,68 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// simulateSelection writes the distribution of the pieces of simulated node
+// selections as JSON
+func simulateSelection(ctx context.Context, output io.Writer) (err error) {
+	if simulateSelectionCfg.Runs <= 0 || simulateSelectionCfg.Nodes <= 0 {
+		return errs.New("runs and nodes must be positive")
+	}
+	if err := simulateSelectionCfg.Overlay.Node.Verify(); err != nil {
+		return err
+	}
+
+	db, err := satellitedb.New(simulateSelectionCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	config := simulateSelectionCfg.Overlay
+	cache := overlay.NewCache(db.OverlayCache(), db.StatDB(), db.NodePings(), config.Ping, simulateSelectionCfg.Reputation, config.Subnets)
+
+	pieceSize := simulateSelectionCfg.PieceSize.Int64()
+	stats, err := cache.SimulateSelection(ctx, &pb.FindStorageNodesRequest{
+		Opts: &pb.OverlayOptions{
+			Amount:       int64(simulateSelectionCfg.Nodes),
+			Restrictions: &pb.NodeRestrictions{FreeDisk: pieceSize, FreeBandwidth: pieceSize},
+			Placement:    simulateSelectionCfg.Placement.Constraints(),
+		},
+	}, &config.Node, simulateSelectionCfg.Runs)
+	if err != nil {
+		return err
+	}
+
+	if top := simulateSelectionCfg.Top; top > 0 {
+		stats.Nodes = limitShares(stats.Nodes, top)
+		stats.Subnets = limitShares(stats.Subnets, top)
+		stats.Regions = limitShares(stats.Regions, top)
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(stats)
+}
+
+// limitShares returns the first limit shares
+func limitShares(shares []overlay.SelectionShare, limit int) []overlay.SelectionShare {
+	if len(shares) > limit {
+		return shares[:limit]
+	}
+	return shares
+}
@@ -12,6 +12,7 @@ import (
 	"runtime"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/golang/protobuf/ptypes"
@@ -110,10 +111,44 @@ func dashCmd(cmd *cobra.Command, args []string) (err error) {
 				return err
 			}
 
+			reconciled := color.YellowString("never")
+			if unix := stats.GetUsedSpaceReconciledUnixSec(); unix > 0 {
+				reconciled = color.WhiteString(time.Unix(unix, 0).Format(time.RFC3339))
+			}
+
+			w = tabwriter.NewWriter(color.Output, 0, 0, 1, ' ', 0)
+			fmt.Fprintf(w, "\nPieces\t%s\n", whiteInt(stats.GetUsedPieces()))
+			fmt.Fprintf(w, "Last Scrub\t%s\n", reconciled)
+			if err = w.Flush(); err != nil {
+				return err
+			}
+
 		} else {
 			color.Yellow("Loading...\n")
 		}
 
+		if health := data.GetDiskHealth(); health != nil {
+			status := color.GreenString("OK")
+			if health.GetIngressStopped() {
+				status = color.RedString("FAILING, NOT ACCEPTING UPLOADS")
+			} else if len(health.GetWarnings()) > 0 {
+				status = color.YellowString("WARNING")
+			}
+
+			w = tabwriter.NewWriter(color.Output, 0, 0, 1, ' ', 0)
+			fmt.Fprintf(w, "\nDisk Health\t%s\n", status)
+			for _, warning := range health.GetWarnings() {
+				fmt.Fprintf(w, "\t%s\n", color.YellowString(warning))
+			}
+			if temperature := health.GetTemperature(); temperature > 0 {
+				fmt.Fprintf(w, "Temperature\t%s\n", color.WhiteString("%d°C", temperature))
+			}
+			fmt.Fprintf(w, "Last Check\t%s\n", color.WhiteString(time.Unix(health.GetCheckedUnixSec(), 0).Format(time.RFC3339)))
+			if err = w.Flush(); err != nil {
+				return err
+			}
+		}
+
 		w = tabwriter.NewWriter(color.Output, 0, 0, 1, ' ', 0)
 		// TODO: Get addresses from server data
 		fmt.Fprintf(w, "\nBootstrap\t%s\n", color.WhiteString(data.GetBootstrapAddress()))
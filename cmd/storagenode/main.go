@@ -5,9 +5,11 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -15,12 +17,17 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/cfgstruct"
 	"storj.io/storj/pkg/pb"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/lazyfilewalker"
+	"storj.io/storj/pkg/piecestore/psserver"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/storagenodedb"
+	"storj.io/storj/storagenode/trust"
 )
 
 // StorageNodeFlags defines storage node configuration
@@ -63,14 +70,94 @@ var (
 		Short: "Diagnostic Tool support",
 		RunE:  cmdDiag,
 	}
+	forgetSatelliteCmd = &cobra.Command{
+		Use:   "forget-satellite <satellite-id>",
+		Short: "Delete the pieces and bandwidth agreements of a satellite which is no longer trusted",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdForgetSatellite,
+	}
+	trustCmd = &cobra.Command{
+		Use:   "trust",
+		Short: "Manage the satellites the node stores data for, a running node picks up changes at the next refresh",
+	}
+	trustAddCmd = &cobra.Command{
+		Use:   "add <satellite-id>",
+		Short: "Trust a satellite in addition to the configured ones",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdTrustAdd,
+	}
+	trustRemoveCmd = &cobra.Command{
+		Use:   "remove <satellite-id>",
+		Short: "Stop trusting a satellite, its pieces are deleted after the grace period",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdTrustRemove,
+	}
+	trustListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the trusted satellites",
+		RunE:  cmdTrustList,
+	}
+	pairCmd = &cobra.Command{
+		Use:   "pair",
+		Short: "Manage the agents allowed to use the dashboard api, the dashboard and the inspector of the node",
+	}
+	pairAddCmd = &cobra.Command{
+		Use:   "add <name>",
+		Short: "Pair an agent and print its token, pairing an existing agent again replaces its token",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdPairAdd,
+	}
+	pairRemoveCmd = &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Unpair an agent, its token and identity are refused afterwards",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdPairRemove,
+	}
+	pairListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the paired agents",
+		RunE:  cmdPairList,
+	}
+	migrateStorageCmd = &cobra.Command{
+		Use:   "migrate-storage",
+		Short: "Copy the pieces and databases to a new storage directory and switch the config to it",
+		RunE:  cmdMigrateStorage,
+	}
+	scrubCmd = &cobra.Command{
+		Use:   "scrub",
+		Short: "Verify the stored pieces against their recorded sizes and hashes to detect bit rot",
+		RunE:  cmdScrub,
+	}
 	dashboardCmd = &cobra.Command{
 		Use:   "dashboard",
 		Short: "Display a dashbaord",
 		RunE:  dashCmd,
 	}
+	usedSpaceFilewalkerCmd = &cobra.Command{
+		Use:    lazyfilewalker.UsedSpaceCommand,
+		Short:  "Walk the stored pieces and print the used space, run by the storagenode itself",
+		RunE:   cmdUsedSpaceFilewalker,
+		Hidden: true,
+		// only load the config file when it exists
+		Annotations: map[string]string{"type": "setup"},
+	}
 	runCfg   StorageNodeFlags
 	setupCfg StorageNodeFlags
 
+	migrateStorageCfg struct {
+		To         string `default:"" help:"directory to move the stored pieces and databases to"`
+		SampleSize int    `default:"1000" help:"number of copied pieces which are compared by hash"`
+	}
+
+	pairCfg struct {
+		NodeID string `default:"" help:"identity the agent connects with to the dashboard and the inspector, empty when it only uses the token"`
+	}
+
+	scrubCfg struct {
+		Rate       memory.Size `default:"10MiB" help:"bytes of pieces read per second, 0 for unlimited"`
+		Quarantine bool        `default:"false" help:"if true, corrupted pieces are moved to the quarantine folder of the storage directory"`
+	}
+
 	dashboardCfg struct {
 		Address         string `default:":28967" help:"address for dashboard service"`
 		ExternalAddress string `default:":28967" help:"address that your node is listening on if using a tunneling service"`
@@ -83,6 +170,7 @@ var (
 	defaultDiagDir     string
 	confDir            string
 	identityDir        string
+	storageDir         string
 )
 
 const (
@@ -115,12 +203,37 @@ func init() {
 	rootCmd.AddCommand(setupCmd)
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(diagCmd)
+	rootCmd.AddCommand(forgetSatelliteCmd)
+	rootCmd.AddCommand(trustCmd)
+	trustCmd.AddCommand(trustAddCmd)
+	trustCmd.AddCommand(trustRemoveCmd)
+	trustCmd.AddCommand(trustListCmd)
+	rootCmd.AddCommand(pairCmd)
+	pairCmd.AddCommand(pairAddCmd)
+	pairCmd.AddCommand(pairRemoveCmd)
+	pairCmd.AddCommand(pairListCmd)
+	rootCmd.AddCommand(migrateStorageCmd)
+	rootCmd.AddCommand(scrubCmd)
 	rootCmd.AddCommand(dashboardCmd)
+	rootCmd.AddCommand(usedSpaceFilewalkerCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.BindSetup(configCmd.Flags(), &setupCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.Bind(diagCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(forgetSatelliteCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(trustAddCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(trustRemoveCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(trustListCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(pairAddCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(pairAddCmd.Flags(), &pairCfg)
+	cfgstruct.Bind(pairRemoveCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(pairListCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(migrateStorageCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(migrateStorageCmd.Flags(), &migrateStorageCfg)
+	cfgstruct.Bind(scrubCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(scrubCmd.Flags(), &scrubCfg)
 	cfgstruct.Bind(dashboardCmd.Flags(), &dashboardCfg, cfgstruct.ConfDir(defaultDiagDir))
+	usedSpaceFilewalkerCmd.Flags().StringVar(&storageDir, "storage-dir", "", "directory the pieces are stored in")
 }
 
 func databaseConfig(config storagenode.Config) storagenodedb.Config {
@@ -128,6 +241,7 @@ func databaseConfig(config storagenode.Config) storagenodedb.Config {
 		Storage:  config.Storage.Path,
 		Info:     filepath.Join(config.Storage.Path, "piecestore.db"),
 		Kademlia: config.Kademlia.DBPath,
+		Write:    config.Storage.WriteOptions(),
 	}
 }
 
@@ -169,6 +283,8 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	go reloadOnHangup(ctx, log.Named("reload"), peer.Operator.Service)
+
 	runError := peer.Run(ctx)
 	closeError := peer.Close()
 
@@ -323,6 +439,215 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 	return err
 }
 
+func cmdForgetSatellite(cmd *cobra.Command, args []string) (err error) {
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid satellite id %q: %v", args[0], err)
+	}
+
+	db, err := storagenodedb.New(databaseConfig(runCfg.Config))
+	if err != nil {
+		return errs.New("Error starting master database on storagenode: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	ctx := process.Ctx(cmd)
+	pool, err := trust.NewPool(zap.L(), db.PSDB(), db.Storage(), runCfg.Storage, runCfg.Trust)
+	if err != nil {
+		return err
+	}
+	// a satellite listed by a source which can't be fetched may be trusted
+	if err := pool.Refresh(ctx); err != nil {
+		return err
+	}
+
+	const reportEvery = 1000
+	result, err := psserver.ForgetSatellite(ctx, zap.L(), pool, db.PSDB(), db.Storage(), satelliteID,
+		func(progress psserver.ForgetProgress) {
+			if progress.Deleted%reportEvery == 0 {
+				fmt.Printf("deleted %d of %d pieces, freed %s\n", progress.Deleted, progress.Pieces, memory.Size(progress.Freed))
+			}
+		})
+
+	fmt.Printf("deleted %d of %d pieces of satellite %s, freed %s\n", result.Deleted, result.Pieces, satelliteID, memory.Size(result.Freed))
+	return err
+}
+
+func cmdTrustAdd(cmd *cobra.Command, args []string) (err error) {
+	return setSatelliteTrust(args[0], true)
+}
+
+func cmdTrustRemove(cmd *cobra.Command, args []string) (err error) {
+	return setSatelliteTrust(args[0], false)
+}
+
+// setSatelliteTrust stores that the operator explicitly trusted or untrusted the satellite
+func setSatelliteTrust(arg string, trusted bool) (err error) {
+	satelliteID, err := storj.NodeIDFromString(arg)
+	if err != nil {
+		return errs.New("invalid satellite id %q: %v", arg, err)
+	}
+
+	db, err := storagenodedb.New(databaseConfig(runCfg.Config))
+	if err != nil {
+		return errs.New("Error starting master database on storagenode: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	if err := db.PSDB().SetSatelliteTrust(satelliteID, trusted); err != nil {
+		return err
+	}
+
+	if trusted {
+		fmt.Printf("satellite %s is trusted\n", satelliteID)
+	} else {
+		fmt.Printf("satellite %s is no longer trusted, its pieces are deleted after %s\n", satelliteID, runCfg.Trust.GracePeriod)
+	}
+	return nil
+}
+
+func cmdTrustList(cmd *cobra.Command, args []string) (err error) {
+	db, err := storagenodedb.New(databaseConfig(runCfg.Config))
+	if err != nil {
+		return errs.New("Error starting master database on storagenode: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	pool, err := trust.NewPool(zap.L(), db.PSDB(), db.Storage(), runCfg.Storage, runCfg.Trust)
+	if err != nil {
+		return err
+	}
+	if err := pool.Refresh(process.Ctx(cmd)); err != nil {
+		zap.S().Warn(err)
+	}
+
+	if pool.Restricted() {
+		fmt.Println("trusted satellites:")
+		printNodeIDs(pool.Trusted())
+	} else {
+		fmt.Println("every satellite is trusted except the removed ones")
+	}
+
+	if untrusted := pool.Untrusted(); len(untrusted) > 0 {
+		fmt.Println("removed satellites:")
+		printNodeIDs(untrusted)
+	}
+	return nil
+}
+
+// printNodeIDs prints the ids in order, one per line
+func printNodeIDs(ids storj.NodeIDList) {
+	sort.Slice(ids, func(i, k int) bool { return ids[i].Less(ids[k]) })
+	for _, id := range ids {
+		fmt.Println("  " + id.String())
+	}
+}
+
+func cmdMigrateStorage(cmd *cobra.Command, args []string) (err error) {
+	if migrateStorageCfg.To == "" {
+		return errs.New("to is required")
+	}
+	from, err := filepath.Abs(runCfg.Storage.Path)
+	if err != nil {
+		return err
+	}
+	to, err := filepath.Abs(migrateStorageCfg.To)
+	if err != nil {
+		return err
+	}
+
+	const reportEvery = 10000
+	result, err := pstore.Migrate(process.Ctx(cmd), from, to,
+		pstore.MigrateOptions{SampleSize: migrateStorageCfg.SampleSize},
+		func(progress pstore.MigrateProgress) {
+			if progress.Files%reportEvery == 0 {
+				fmt.Printf("copied %d files, %s\n", progress.Files, memory.Size(progress.Bytes))
+			}
+		})
+	if err != nil {
+		fmt.Println("migration stopped, run the command again to resume it")
+		return err
+	}
+	fmt.Printf("copied and verified %d files, %s, %d already copied before\n", result.Files, memory.Size(result.Bytes), result.Skipped)
+
+	configFile := filepath.Join(confDir, "config.yaml")
+	if err := setConfigValue(configFile, "storage.path", to); err != nil {
+		return err
+	}
+	fmt.Printf("storage path in %s changed to %s, %s can be removed\n", configFile, to, from)
+	return nil
+}
+
+func cmdScrub(cmd *cobra.Command, args []string) (err error) {
+	db, err := storagenodedb.New(databaseConfig(runCfg.Config))
+	if err != nil {
+		return errs.New("Error starting master database on storagenode: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	const reportEvery = 1000
+	options := psserver.ScrubOptions{Rate: scrubCfg.Rate, Quarantine: scrubCfg.Quarantine}
+	result, err := psserver.Scrub(process.Ctx(cmd), zap.L(), db.PSDB(), db.Storage(), options,
+		func(progress psserver.ScrubProgress) {
+			if progress.Pieces%reportEvery == 0 {
+				fmt.Printf("checked %d pieces, %s, %d corrupted\n", progress.Pieces, memory.Size(progress.Bytes), len(progress.Corrupted))
+			}
+		})
+
+	for _, piece := range result.Corrupted {
+		fmt.Printf("corrupted piece %s: %s\n", piece.ID, piece.Reason)
+	}
+	fmt.Printf("checked %d pieces, %s, %d corrupted, %d checked only by size, %d unknown\n",
+		result.Pieces, memory.Size(result.Bytes), len(result.Corrupted), result.Unhashed, result.Unknown)
+	if len(result.Corrupted) > 0 && scrubCfg.Quarantine {
+		fmt.Printf("corrupted pieces were moved to %s\n", db.Storage().QuarantineDir())
+	}
+	return err
+}
+
+// setConfigValue sets key to the string value in the config file, the
+// file is replaced at once so an interruption doesn't leave it half written
+func setConfigValue(configFile, key, value string) error {
+	data, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+
+	line := fmt.Sprintf("%s: %q", key, value)
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	found := false
+	for i, l := range lines {
+		if strings.HasPrefix(l, key+":") {
+			lines[i] = line
+			found = true
+		}
+	}
+	if !found {
+		lines = append(lines, line)
+	}
+
+	tmp := configFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, configFile)
+}
+
+func cmdUsedSpaceFilewalker(cmd *cobra.Command, args []string) (err error) {
+	if storageDir == "" {
+		return errs.New("storage-dir is required")
+	}
+	return lazyfilewalker.RunUsedSpace(process.Ctx(cmd), zap.L(), storageDir, os.Stdout)
+}
+
 func main() {
 	process.Exec(rootCmd)
 }
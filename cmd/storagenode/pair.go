@@ -0,0 +1,91 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/pairing"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+// withAuthorizer calls fn with an authorizer using the database of the node
+func withAuthorizer(fn func(authorizer *pairing.Authorizer) error) (err error) {
+	db, err := storagenodedb.New(databaseConfig(runCfg.Config))
+	if err != nil {
+		return errs.New("Error starting master database on storagenode: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	// the cli only manages the agents, it doesn't authorize the node itself
+	return fn(pairing.NewAuthorizer(zap.L(), storj.NodeID{}, db.PSDB(), runCfg.Pairing))
+}
+
+func cmdPairAdd(cmd *cobra.Command, args []string) (err error) {
+	var nodeID storj.NodeID
+	if pairCfg.NodeID != "" {
+		nodeID, err = storj.NodeIDFromString(pairCfg.NodeID)
+		if err != nil {
+			return errs.New("invalid node id %q: %v", pairCfg.NodeID, err)
+		}
+	}
+
+	return withAuthorizer(func(authorizer *pairing.Authorizer) error {
+		token, err := authorizer.Pair(args[0], nodeID)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("agent %q is paired, its token is shown only once:\n\n  %s\n\n", args[0], token)
+		fmt.Printf("send it with the requests to the dashboard api, for example:\n\n")
+		fmt.Printf("  curl -H \"Authorization: Bearer %s\" http://%s/api/dashboard\n", token, runCfg.DashboardAPI.Address)
+		if !nodeID.IsZero() {
+			fmt.Printf("\nthe agent can also connect to the dashboard and the inspector as %s\n", nodeID)
+		}
+		return nil
+	})
+}
+
+func cmdPairRemove(cmd *cobra.Command, args []string) (err error) {
+	return withAuthorizer(func(authorizer *pairing.Authorizer) error {
+		if err := authorizer.Unpair(args[0]); err != nil {
+			return err
+		}
+		fmt.Printf("agent %q is no longer paired\n", args[0])
+		return nil
+	})
+}
+
+func cmdPairList(cmd *cobra.Command, args []string) (err error) {
+	return withAuthorizer(func(authorizer *pairing.Authorizer) error {
+		agents, err := authorizer.Agents()
+		if err != nil {
+			return err
+		}
+		if len(agents) == 0 {
+			fmt.Println("no agents are paired")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tNODE ID\tPAIRED")
+		for _, agent := range agents {
+			nodeID := "-"
+			if !agent.NodeID.IsZero() {
+				nodeID = agent.NodeID.String()
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", agent.Name, nodeID, agent.Created.Format("2006-01-02 15:04"))
+		}
+		return w.Flush()
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/operator"
+)
+
+// reloadOnHangup updates the operator settings from the config file every
+// time the process receives SIGHUP, until ctx is done
+func reloadOnHangup(ctx context.Context, log *zap.Logger, service *operator.Service) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+		}
+
+		configFile := filepath.Join(confDir, "config.yaml")
+		update, err := readOperatorSettings(configFile)
+		if err != nil {
+			log.Error("Failed to read operator settings", zap.String("file", configFile), zap.Error(err))
+			continue
+		}
+
+		settings, err := service.Update(ctx, update)
+		if err != nil {
+			log.Error("Failed to update operator settings", zap.Error(err))
+			continue
+		}
+		log.Info("Operator settings reloaded",
+			zap.String("email", settings.Email),
+			zap.String("wallet", settings.Wallet),
+			zap.Stringer("disk space", settings.AllocatedDiskSpace),
+			zap.Stringer("bandwidth", settings.AllocatedBandwidth))
+	}
+}
+
+// readOperatorSettings reads the settings which can be changed without a
+// restart from the config file, missing keys keep their current value
+func readOperatorSettings(configFile string) (settings operator.Settings, err error) {
+	vip := viper.New()
+	vip.SetConfigFile(configFile)
+	if err := vip.ReadInConfig(); err != nil {
+		return settings, err
+	}
+
+	settings.Email = vip.GetString("kademlia.operator.email")
+	settings.Wallet = vip.GetString("kademlia.operator.wallet")
+	if value := vip.GetString("storage.allocated-disk-space"); value != "" {
+		if err := settings.AllocatedDiskSpace.Set(value); err != nil {
+			return settings, err
+		}
+	}
+	if value := vip.GetString("storage.allocated-bandwidth"); value != "" {
+		if err := settings.AllocatedBandwidth.Set(value); err != nil {
+			return settings, err
+		}
+	}
+	return settings, nil
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/sync2"
+)
+
+// batchFlags are the flags of the commands operating on whole trees of
+// files or objects with --recursive
+type batchFlags struct {
+	recursive   *bool
+	concurrency *int
+	include     *[]string
+	exclude     *[]string
+	dryRun      *bool
+}
+
+// addBatchFlags adds the flags of recursive operations to cmd
+func addBatchFlags(cmd *cobra.Command, operation string) batchFlags {
+	return batchFlags{
+		recursive:   cmd.Flags().BoolP("recursive", "r", false, fmt.Sprintf("if true, %s all the files or objects below the source prefix", operation)),
+		concurrency: cmd.Flags().Int("concurrency", 4, "how many files or objects are processed at once with --recursive"),
+		include:     cmd.Flags().StringSlice("include", nil, "with --recursive, only process the paths matching one of these glob patterns"),
+		exclude:     cmd.Flags().StringSlice("exclude", nil, "with --recursive, skip the paths matching one of these glob patterns"),
+		dryRun:      cmd.Flags().Bool("dry-run", false, "with --recursive, only show what would be processed, without doing it"),
+	}
+}
+
+// check returns an error if the flags can't be used together
+func (flags batchFlags) check() error {
+	if !*flags.recursive && (len(*flags.include) > 0 || len(*flags.exclude) > 0 || *flags.dryRun) {
+		return fmt.Errorf("--include, --exclude and --dry-run can only be used with --recursive")
+	}
+	if *flags.concurrency < 1 {
+		return fmt.Errorf("Invalid concurrency %d, it must be at least 1", *flags.concurrency)
+	}
+	for _, pattern := range append(append([]string{}, *flags.include...), *flags.exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("Invalid pattern %q: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// matches returns whether the slash separated path relative to the source
+// prefix passes the filters. A pattern matches either the whole relative
+// path or its last element.
+func (flags batchFlags) matches(rel string) bool {
+	matchAny := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, rel); ok {
+				return true
+			}
+			if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(*flags.include) > 0 && !matchAny(*flags.include) {
+		return false
+	}
+	return !matchAny(*flags.exclude)
+}
+
+// filter returns the sorted paths of entries which pass the filters
+func (flags batchFlags) filter(entries map[string]syncEntry) []string {
+	var paths []string
+	for rel := range entries {
+		if flags.matches(rel) {
+			paths = append(paths, rel)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// batchItem is a single file or object processed by a batch
+type batchItem struct {
+	// description describes the item in dry runs and failures
+	description string
+	size        int64
+	// result is printed for dry runs with json output
+	result interface{}
+	// run processes the item and writes its result to out
+	run func(ctx context.Context, out io.Writer) error
+}
+
+// batch runs the items of a recursive operation with a worker pool and
+// counts their results
+type batch struct {
+	operation   string
+	source      fpath.FPath
+	destination string
+	dryRun      bool
+
+	mu        sync.Mutex // protects the output and the counters
+	succeeded int
+	failed    int
+	bytes     int64
+}
+
+// run runs the items, concurrency at once, and prints the summary
+func (b *batch) run(ctx context.Context, concurrency int, items []batchItem) error {
+	start := time.Now()
+
+	limiter := sync2.NewLimiter(concurrency)
+	for _, item := range items {
+		item := item
+		limiter.Go(ctx, func() {
+			// the output is buffered, so that the outputs of concurrent
+			// items aren't interleaved
+			var out bytes.Buffer
+			var err error
+			if !b.dryRun {
+				err = item.run(ctx, &out)
+			}
+			b.report(err, item, out.Bytes())
+		})
+	}
+	limiter.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	result := batchResult{
+		Operation:   b.operation,
+		Source:      b.source.String(),
+		Destination: b.destination,
+		Succeeded:   b.succeeded,
+		Failed:      b.failed,
+		Bytes:       b.bytes,
+		Duration:    time.Since(start).Seconds(),
+		DryRun:      b.dryRun,
+	}
+	if jsonOutput() {
+		if err := printJSON(os.Stdout, result); err != nil {
+			return err
+		}
+	} else {
+		dryRun := ""
+		if b.dryRun {
+			dryRun = " (dry run)"
+		}
+		fmt.Printf("%d succeeded, %d failed, %d bytes in %s%s\n",
+			result.Succeeded, result.Failed, result.Bytes, time.Since(start).Round(time.Millisecond), dryRun)
+	}
+
+	if b.failed > 0 {
+		return fmt.Errorf("%d of %d failed", b.failed, len(items))
+	}
+	return nil
+}
+
+// report counts the result of item and prints its output. Dry runs print
+// the descriptions of the items instead.
+func (b *batch) report(err error, item batchItem, output []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, _ = os.Stdout.Write(output)
+
+	if err != nil {
+		b.failed++
+		fmt.Fprintf(os.Stderr, "%s failed: %v\n", item.description, err)
+		return
+	}
+
+	b.succeeded++
+	b.bytes += item.size
+
+	if !b.dryRun {
+		return
+	}
+	if jsonOutput() {
+		_ = printJSON(os.Stdout, item.result)
+		return
+	}
+	fmt.Printf("(dry run) %s\n", item.description)
+}
+
+// joinRelative returns the path of the slash separated path rel relative to
+// prefix
+func joinRelative(prefix fpath.FPath, rel string) fpath.FPath {
+	if prefix.IsLocal() {
+		return prefix.Join(filepath.FromSlash(rel))
+	}
+	return prefix.Join(rel)
+}
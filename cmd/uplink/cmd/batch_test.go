@@ -0,0 +1,88 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newBatchFlags returns recursive batch flags with the filters
+func newBatchFlags(include, exclude []string) batchFlags {
+	recursive, concurrency, dryRun := true, 1, false
+	return batchFlags{
+		recursive:   &recursive,
+		concurrency: &concurrency,
+		include:     &include,
+		exclude:     &exclude,
+		dryRun:      &dryRun,
+	}
+}
+
+func TestBatchFlagsMatches(t *testing.T) {
+	for i, tt := range []struct {
+		include []string
+		exclude []string
+		path    string
+		matches bool
+	}{
+		{nil, nil, "a.txt", true},
+		{nil, nil, "dir/a.txt", true},
+
+		// patterns match the whole path or its last element
+		{[]string{"*.txt"}, nil, "a.txt", true},
+		{[]string{"*.txt"}, nil, "dir/a.txt", true},
+		{[]string{"*.txt"}, nil, "a.jpg", false},
+		{[]string{"dir/*"}, nil, "dir/a.txt", true},
+		{[]string{"dir/*"}, nil, "dir/sub/a.txt", false},
+		{[]string{"dir"}, nil, "dir/a.txt", false},
+
+		// any of the included patterns is enough
+		{[]string{"*.jpg", "*.txt"}, nil, "a.txt", true},
+		{[]string{"*.jpg", "*.png"}, nil, "a.txt", false},
+
+		{nil, []string{"*.tmp"}, "a.txt", true},
+		{nil, []string{"*.tmp"}, "dir/a.tmp", false},
+		{nil, []string{"*.tmp", "cache/*"}, "cache/a.txt", false},
+
+		// the excluded patterns take precedence
+		{[]string{"*.txt"}, []string{"secret*"}, "secret.txt", false},
+		{[]string{"*.txt"}, []string{"secret*"}, "dir/public.txt", true},
+
+		// invalid patterns match nothing, they're rejected by check
+		{[]string{"["}, nil, "a.txt", false},
+		{nil, []string{"["}, "a.txt", true},
+	} {
+		flags := newBatchFlags(tt.include, tt.exclude)
+		assert.Equal(t, tt.matches, flags.matches(tt.path), "#%d: %s", i, tt.path)
+	}
+}
+
+func TestBatchFlagsFilter(t *testing.T) {
+	entries := map[string]syncEntry{
+		"b.txt":         {size: 1},
+		"a.txt":         {size: 2},
+		"c.tmp":         {size: 3},
+		"dir/d.txt":     {size: 4},
+		"dir/sub/e.jpg": {size: 5},
+	}
+
+	for i, tt := range []struct {
+		include []string
+		exclude []string
+		paths   []string
+	}{
+		{nil, nil, []string{"a.txt", "b.txt", "c.tmp", "dir/d.txt", "dir/sub/e.jpg"}},
+		{[]string{"*.txt"}, nil, []string{"a.txt", "b.txt", "dir/d.txt"}},
+		{nil, []string{"*.tmp"}, []string{"a.txt", "b.txt", "dir/d.txt", "dir/sub/e.jpg"}},
+		{[]string{"*.txt"}, []string{"dir/*"}, []string{"a.txt", "b.txt"}},
+		{[]string{"*.png"}, nil, nil},
+	} {
+		flags := newBatchFlags(tt.include, tt.exclude)
+		assert.Equal(t, tt.paths, flags.filter(entries), "#%d", i)
+	}
+
+	assert.Nil(t, newBatchFlags(nil, nil).filter(nil))
+}
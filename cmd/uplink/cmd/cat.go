@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -42,5 +43,5 @@ func catMain(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	return download(ctx, src, dst, false)
+	return download(ctx, src, dst, false, os.Stdout)
 }
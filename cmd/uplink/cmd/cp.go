@@ -9,9 +9,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
-	progressbar "github.com/cheggaaa/pb"
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 
@@ -24,20 +24,30 @@ import (
 )
 
 var (
-	progress *bool
+	progress      *bool
+	cpContentType *string
+	cpMetadata    *map[string]string
+	cpResumeState *string
+	cpBatch       batchFlags
 )
 
 func init() {
 	cpCmd := addCmd(&cobra.Command{
 		Use:   "cp",
-		Short: "Copies a local file or Storj object to another location locally or in Storj",
+		Short: "Copies a local file or Storj object to another location locally or in Storj, or whole trees of them with --recursive",
 		RunE:  copyMain,
 	}, RootCmd)
 	progress = cpCmd.Flags().Bool("progress", true, "if true, show progress")
+	cpContentType = cpCmd.Flags().String("content-type", "", "content type of the uploaded object, detected from the file name or data when empty")
+	cpMetadata = cpCmd.Flags().StringToString("metadata", nil, "metadata of the uploaded object as comma separated key=value pairs, a copy keeps the metadata of the source when empty")
+	cpResumeState = cpCmd.Flags().String("resume-state", "", "file keeping the state of a resumable upload, an interrupted upload is resumed by running the same command again")
+	cpBatch = addBatchFlags(cpCmd, "copy")
 }
 
-// upload transfers src from local machine to s3 compatible object dst
-func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress bool) (err error) {
+// upload transfers src from local machine to s3 compatible object dst with
+// metadata, contentType is detected when empty and the result is written to
+// out
+func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, contentType string, metadata map[string]string, showProgress bool, out io.Writer) (err error) {
 	if !src.IsLocal() {
 		return fmt.Errorf("source must be local path: %s", src)
 	}
@@ -76,7 +86,17 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 		return err
 	}
 
+	reader := io.Reader(file)
+	if contentType == "" {
+		contentType, reader, err = stream.DetectContentType(dst.Path(), reader)
+		if err != nil {
+			return err
+		}
+	}
+
 	createInfo := storj.CreateObject{
+		ContentType:      contentType,
+		Metadata:         metadata,
 		RedundancyScheme: cfg.GetRedundancyScheme(),
 		EncryptionScheme: cfg.GetEncryptionScheme(),
 	}
@@ -85,43 +105,106 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 		return convertError(err, dst)
 	}
 
-	reader := io.Reader(file)
-	var bar *progressbar.ProgressBar
+	size := fileInfo.Size()
+	var resumable *stream.ResumableUpload
+	if *cpResumeState != "" {
+		if file == os.Stdin {
+			return fmt.Errorf("uploads from stdin can't be resumed")
+		}
+		resumable, err = beginResumable(ctx, streams, obj, dst, *cpResumeState)
+		if err != nil {
+			return err
+		}
+		if offset := resumable.Offset(); offset > 0 {
+			if _, err := file.Seek(offset, io.SeekStart); err != nil {
+				return err
+			}
+			reader, size = file, size-offset
+		}
+	}
+
+	finish := func() {}
 	if showProgress {
-		bar = progressbar.New(int(fileInfo.Size())).SetUnits(progressbar.U_BYTES)
-		bar.Start()
-		reader = bar.NewProxyReader(reader)
+		reader, finish = trackProgress(reader, "upload", src.String(), size)
 	}
 
-	err = uploadStream(ctx, streams, obj, reader)
+	var n int64
+	if resumable != nil {
+		n, err = uploadResumable(ctx, resumable, reader)
+	} else {
+		n, err = uploadStream(ctx, streams, obj, reader)
+	}
 	if err != nil {
 		return err
 	}
 
-	if bar != nil {
-		bar.Finish()
-	}
+	finish()
 
-	fmt.Printf("Created %s\n", dst.String())
+	if jsonOutput() {
+		return printJSON(out, transferResult{
+			Operation:   "upload",
+			Source:      src.String(),
+			Destination: dst.String(),
+			Bytes:       n,
+			ContentType: contentType,
+		})
+	}
 
-	return nil
+	_, err = fmt.Fprintf(out, "Created %s\n", dst.String())
+	return err
 }
 
-func uploadStream(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader) error {
+func uploadStream(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader) (int64, error) {
 	mutableStream, err := mutableObject.CreateStream(ctx)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	upload := stream.NewUpload(ctx, mutableStream, streams)
 
-	_, err = io.Copy(upload, reader)
+	n, err := io.Copy(upload, reader)
+
+	return n, utils.CombineErrors(err, upload.Close())
+}
+
+// beginResumable resumes the upload whose state is kept at statePath, or
+// begins it when there's no state yet
+func beginResumable(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, dst fpath.FPath, statePath string) (*stream.ResumableUpload, error) {
+	if _, err := os.Stat(statePath); os.IsNotExist(err) {
+		mutableStream, err := mutableObject.CreateStream(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return stream.BeginUpload(ctx, mutableStream, streams, statePath)
+	}
 
-	return utils.CombineErrors(err, upload.Close())
+	upload, err := stream.ResumeUpload(ctx, streams, statePath)
+	if err != nil {
+		return nil, err
+	}
+	if upload.Bucket() != dst.Bucket() || upload.Path() != dst.Path() {
+		return nil, fmt.Errorf("%s is the state of an upload to sj://%s/%s", statePath, upload.Bucket(), upload.Path())
+	}
+	return upload, nil
 }
 
-// download transfers s3 compatible object src to dst on local machine
-func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress bool) (err error) {
+// uploadResumable uploads the rest of the stream and commits it, an
+// interrupted upload keeps its state for resuming it
+func uploadResumable(ctx context.Context, upload *stream.ResumableUpload, reader io.Reader) (int64, error) {
+	if err := upload.PutPart(ctx, reader); err != nil {
+		return 0, fmt.Errorf("upload interrupted after %d bytes, run the same command again to resume it: %v", upload.Offset(), err)
+	}
+
+	meta, err := upload.Commit(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("upload not committed, run the same command again to resume it: %v", err)
+	}
+	return meta.Size, nil
+}
+
+// download transfers s3 compatible object src to dst on local machine, the
+// result is written to out
+func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress bool, out io.Writer) (err error) {
 	if src.IsLocal() {
 		return fmt.Errorf("source must be Storj URL: %s", src)
 	}
@@ -130,27 +213,22 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 		return fmt.Errorf("destination must be local path: %s", dst)
 	}
 
+	ctx = streams.WithDownloadParallelism(ctx, cfg.Client.DownloadParallelism)
 	metainfo, streams, err := cfg.Metainfo(ctx)
 	if err != nil {
 		return err
 	}
 
-	readOnlyStream, err := metainfo.GetObjectStream(ctx, src.Bucket(), src.Path())
+	download, err := stream.NewReader(ctx, metainfo, streams, src.Bucket(), src.Path())
 	if err != nil {
 		return convertError(err, src)
 	}
-
-	download := stream.NewDownload(ctx, readOnlyStream, streams)
 	defer func() { err = errs.Combine(err, download.Close()) }()
 
-	var bar *progressbar.ProgressBar
-	var reader io.Reader
+	reader := io.Reader(download)
+	finish := func() {}
 	if showProgress {
-		bar = progressbar.New(int(readOnlyStream.Info().Size)).SetUnits(progressbar.U_BYTES)
-		bar.Start()
-		reader = bar.NewProxyReader(download)
-	} else {
-		reader = download
+		reader, finish = trackProgress(reader, "download", src.String(), download.Size())
 	}
 
 	if fileInfo, err := os.Stat(dst.Path()); err == nil && fileInfo.IsDir() {
@@ -168,24 +246,34 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 		defer func() { err = errs.Combine(err, file.Close()) }()
 	}
 
-	_, err = io.Copy(file, reader)
+	n, err := io.Copy(file, reader)
 	if err != nil {
 		return err
 	}
 
-	if bar != nil {
-		bar.Finish()
-	}
+	finish()
 
 	if dst.Base() != "-" {
-		fmt.Printf("Downloaded %s to %s\n", src.String(), dst.String())
+		if jsonOutput() {
+			return printJSON(out, transferResult{
+				Operation:   "download",
+				Source:      src.String(),
+				Destination: dst.String(),
+				Bytes:       n,
+				ContentType: download.Info().ContentType,
+				Metadata:    download.Info().Metadata,
+			})
+		}
+		_, err = fmt.Fprintf(out, "Downloaded %s to %s\n", src.String(), dst.String())
+		return err
 	}
 
 	return nil
 }
 
-// copy copies s3 compatible object src to s3 compatible object dst
-func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err error) {
+// copyObject copies s3 compatible object src to s3 compatible object dst,
+// the result is written to out
+func copyObject(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress bool, out io.Writer) (err error) {
 	if src.IsLocal() {
 		return fmt.Errorf("source must be Storj URL: %s", src)
 	}
@@ -194,6 +282,7 @@ func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err error) {
 		return fmt.Errorf("destination must be Storj URL: %s", dst)
 	}
 
+	ctx = streams.WithDownloadParallelism(ctx, cfg.Client.DownloadParallelism)
 	metainfo, streams, err := cfg.Metainfo(ctx)
 	if err != nil {
 		return err
@@ -207,14 +296,10 @@ func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err error) {
 	download := stream.NewDownload(ctx, readOnlyStream, streams)
 	defer func() { err = errs.Combine(err, download.Close()) }()
 
-	var bar *progressbar.ProgressBar
-	var reader io.Reader
-	if *progress {
-		bar = progressbar.New(int(readOnlyStream.Info().Size)).SetUnits(progressbar.U_BYTES)
-		bar.Start()
-		reader = bar.NewProxyReader(download)
-	} else {
-		reader = download
+	reader := io.Reader(download)
+	finish := func() {}
+	if showProgress {
+		reader, finish = trackProgress(reader, "copy", src.String(), readOnlyStream.Info().Size)
 	}
 
 	// if destination object name not specified, default to source object name
@@ -222,7 +307,20 @@ func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err error) {
 		dst = dst.Join(src.Base())
 	}
 
+	// the copy keeps the content type and the metadata of the source unless
+	// they're overridden
+	contentType := readOnlyStream.Info().ContentType
+	if *cpContentType != "" {
+		contentType = *cpContentType
+	}
+	metadata := readOnlyStream.Info().Metadata
+	if len(*cpMetadata) > 0 {
+		metadata = *cpMetadata
+	}
+
 	createInfo := storj.CreateObject{
+		ContentType:      contentType,
+		Metadata:         metadata,
 		RedundancyScheme: cfg.GetRedundancyScheme(),
 		EncryptionScheme: cfg.GetEncryptionScheme(),
 	}
@@ -231,18 +329,25 @@ func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err error) {
 		return convertError(err, dst)
 	}
 
-	err = uploadStream(ctx, streams, obj, reader)
+	n, err := uploadStream(ctx, streams, obj, reader)
 	if err != nil {
 		return err
 	}
 
-	if bar != nil {
-		bar.Finish()
-	}
+	finish()
 
-	fmt.Printf("%s copied to %s\n", src.String(), dst.String())
+	if jsonOutput() {
+		return printJSON(out, transferResult{
+			Operation:   "copy",
+			Source:      src.String(),
+			Destination: dst.String(),
+			Bytes:       n,
+			ContentType: contentType,
+		})
+	}
 
-	return nil
+	_, err = fmt.Fprintf(out, "%s copied to %s\n", src.String(), dst.String())
+	return err
 }
 
 // copyMain is the function executed when cpCmd is called
@@ -254,6 +359,13 @@ func copyMain(cmd *cobra.Command, args []string) (err error) {
 		return fmt.Errorf("No destination specified")
 	}
 
+	if err := cpBatch.check(); err != nil {
+		return err
+	}
+	if *cpBatch.recursive && *cpResumeState != "" {
+		return fmt.Errorf("Recursive uploads can't be resumed")
+	}
+
 	ctx := process.Ctx(cmd)
 
 	src, err := fpath.New(args[0])
@@ -271,16 +383,86 @@ func copyMain(cmd *cobra.Command, args []string) (err error) {
 		return errors.New("At least one of the source or the desination must be a Storj URL")
 	}
 
+	if *cpBatch.recursive {
+		return copyRecursive(ctx, src, dst)
+	}
+
 	// if uploading
 	if src.IsLocal() {
-		return upload(ctx, src, dst, *progress)
+		return upload(ctx, src, dst, *cpContentType, *cpMetadata, *progress, os.Stdout)
 	}
 
 	// if downloading
 	if dst.IsLocal() {
-		return download(ctx, src, dst, *progress)
+		return download(ctx, src, dst, *progress, os.Stdout)
 	}
 
 	// if copying from one remote location to another
-	return copy(ctx, src, dst)
+	return copyObject(ctx, src, dst, *progress, os.Stdout)
+}
+
+// copyRecursive copies the files or objects below src to the same relative
+// paths below dst, each in the way cp copies a single one
+func copyRecursive(ctx context.Context, src fpath.FPath, dst fpath.FPath) error {
+	var entries map[string]syncEntry
+	if src.IsLocal() {
+		fileInfo, err := os.Stat(src.Path())
+		if err != nil {
+			return err
+		}
+		if !fileInfo.IsDir() {
+			return fmt.Errorf("source must be a directory: %s", src)
+		}
+		entries, err = listSyncFiles(src.Path(), false)
+		if err != nil {
+			return err
+		}
+	} else {
+		metainfo, _, err := cfg.Metainfo(ctx)
+		if err != nil {
+			return err
+		}
+		entries, err = listSyncObjects(ctx, metainfo, src)
+		if err != nil {
+			return convertError(err, src)
+		}
+	}
+
+	operation := "copy"
+	if src.IsLocal() {
+		operation = "upload"
+	} else if dst.IsLocal() {
+		operation = "download"
+	}
+
+	var items []batchItem
+	for _, rel := range cpBatch.filter(entries) {
+		itemSrc, itemDst, size := joinRelative(src, rel), joinRelative(dst, rel), entries[rel].size
+		items = append(items, batchItem{
+			description: fmt.Sprintf("%s %s to %s", operation, itemSrc, itemDst),
+			size:        size,
+			result: transferResult{
+				Operation:   operation,
+				Source:      itemSrc.String(),
+				Destination: itemDst.String(),
+				Bytes:       size,
+			},
+			run: func(ctx context.Context, out io.Writer) error {
+				switch {
+				case itemSrc.IsLocal():
+					return upload(ctx, itemSrc, itemDst, *cpContentType, *cpMetadata, false, out)
+				case itemDst.IsLocal():
+					if err := os.MkdirAll(filepath.Dir(itemDst.Path()), 0755); err != nil {
+						return err
+					}
+					return download(ctx, itemSrc, itemDst, false, out)
+				default:
+					return copyObject(ctx, itemSrc, itemDst, false, out)
+				}
+			},
+		})
+	}
+
+	b := &batch{operation: "copy", source: src, destination: dst.String(), dryRun: *cpBatch.dryRun}
+	return b.run(ctx, *cpBatch.concurrency, items)
 }
@@ -0,0 +1,93 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	lifecycleExpireAfterDays         *int
+	lifecycleVersionsExpireAfterDays *int
+)
+
+func init() {
+	lifecycleCmd := addCmd(&cobra.Command{
+		Use:   "lifecycle",
+		Short: "Show or set when the objects of a bucket expire",
+		RunE:  bucketLifecycle,
+	}, RootCmd)
+	lifecycleExpireAfterDays = lifecycleCmd.Flags().Int("expire-after-days", 0, "delete the objects uploaded more than this many days ago, 0 keeps them")
+	lifecycleVersionsExpireAfterDays = lifecycleCmd.Flags().Int("versions-expire-after-days", 0, "delete the versions kept more than this many days ago, 0 keeps them")
+}
+
+func bucketLifecycle(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	if len(args) == 0 {
+		return fmt.Errorf("No bucket specified")
+	}
+
+	dst, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	if dst.IsLocal() || dst.Path() != "" {
+		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	// without flags the current lifecycle is shown
+	if cmd.Flags().Changed("expire-after-days") || cmd.Flags().Changed("versions-expire-after-days") {
+		err = metainfo.SetBucketLifecycle(ctx, dst.Bucket(), storj.BucketLifecycle{
+			ExpireAfterDays:         *lifecycleExpireAfterDays,
+			VersionsExpireAfterDays: *lifecycleVersionsExpireAfterDays,
+		})
+		if err != nil {
+			return convertError(err, dst)
+		}
+	}
+
+	lifecycle, err := metainfo.GetBucketLifecycle(ctx, dst.Bucket())
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	if jsonOutput() {
+		return printJSON(os.Stdout, lifecycleResult{
+			Bucket:                  dst.Bucket(),
+			ExpireAfterDays:         lifecycle.ExpireAfterDays,
+			VersionsExpireAfterDays: lifecycle.VersionsExpireAfterDays,
+		})
+	}
+
+	fmt.Printf("%-18s %s\n", "Bucket:", dst.Bucket())
+	fmt.Printf("%-18s %s\n", "Objects expire:", formatExpiration(lifecycle.ExpireAfterDays))
+	fmt.Printf("%-18s %s\n", "Versions expire:", formatExpiration(lifecycle.VersionsExpireAfterDays))
+
+	return nil
+}
+
+// formatExpiration returns a description of an expiration of days
+func formatExpiration(days int) string {
+	if days <= 0 {
+		return "never"
+	}
+	if days == 1 {
+		return "after 1 day"
+	}
+	return fmt.Sprintf("after %d days", days)
+}
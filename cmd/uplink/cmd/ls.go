@@ -6,6 +6,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -62,7 +64,10 @@ func list(cmd *cobra.Command, args []string) error {
 		if len(list.Items) > 0 {
 			noBuckets = false
 			for _, bucket := range list.Items {
-				fmt.Println("BKT", formatTime(bucket.Created), bucket.Name)
+				err = printBucket(bucket)
+				if err != nil {
+					return err
+				}
 				if *recursiveFlag {
 					prefix, err := fpath.New(fmt.Sprintf("sj://%s/", bucket.Name))
 					if err != nil {
@@ -81,7 +86,7 @@ func list(cmd *cobra.Command, args []string) error {
 		startAfter = list.Items[len(list.Items)-1].Name
 	}
 
-	if noBuckets {
+	if noBuckets && !jsonOutput() {
 		fmt.Println("No buckets")
 	}
 
@@ -103,6 +108,14 @@ func listFiles(ctx context.Context, metainfo storj.Metainfo, prefix fpath.FPath,
 		}
 
 		for _, object := range list.Items {
+			if jsonOutput() {
+				err = printObjectJSON(list.Bucket, list.Prefix, object)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
 			path := object.Path
 			if prependBucket {
 				path = fmt.Sprintf("%s/%s", prefix.Bucket(), path)
@@ -124,6 +137,46 @@ func listFiles(ctx context.Context, metainfo storj.Metainfo, prefix fpath.FPath,
 	return nil
 }
 
+func printBucket(bucket storj.Bucket) error {
+	if !jsonOutput() {
+		fmt.Println("BKT", formatTime(bucket.Created), bucket.Name)
+		return nil
+	}
+
+	created := bucket.Created.UTC()
+	return printJSON(os.Stdout, listEntry{
+		Type:    "bucket",
+		Bucket:  bucket.Name,
+		Created: &created,
+	})
+}
+
+// printObjectJSON prints object with its full path, unlike the text output
+// which is relative to the listed prefix
+func printObjectJSON(bucket string, prefix storj.Path, object storj.Object) error {
+	path := object.Path
+	if prefix = strings.TrimSuffix(prefix, "/"); prefix != "" {
+		path = storj.JoinPaths(prefix, path)
+	}
+	if object.IsPrefix {
+		return printJSON(os.Stdout, listEntry{
+			Type:   "prefix",
+			Bucket: bucket,
+			Path:   path,
+		})
+	}
+
+	modified := object.Modified.UTC()
+	return printJSON(os.Stdout, listEntry{
+		Type:        "object",
+		Bucket:      bucket,
+		Path:        path,
+		Size:        object.Size,
+		Modified:    &modified,
+		ContentType: object.ContentType,
+	})
+}
+
 func formatTime(t time.Time) string {
 	return t.Local().Format("2006-01-02 15:04:05")
 }
@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -13,12 +14,17 @@ import (
 	"storj.io/storj/pkg/storj"
 )
 
+var (
+	mbVersioning *bool
+)
+
 func init() {
-	addCmd(&cobra.Command{
+	mbCmd := addCmd(&cobra.Command{
 		Use:   "mb",
 		Short: "Create a new bucket",
 		RunE:  makeBucket,
 	}, RootCmd)
+	mbVersioning = mbCmd.Flags().Bool("versioning", false, "if true, replaced and deleted objects are kept as versions")
 }
 
 func makeBucket(cmd *cobra.Command, args []string) error {
@@ -53,11 +59,19 @@ func makeBucket(cmd *cobra.Command, args []string) error {
 	if !storj.ErrBucketNotFound.Has(err) {
 		return err
 	}
-	_, err = metainfo.CreateBucket(ctx, dst.Bucket(), &storj.Bucket{PathCipher: storj.Cipher(cfg.Enc.PathType)})
+	_, err = metainfo.CreateBucket(ctx, dst.Bucket(), &storj.Bucket{
+		PathCipher: storj.Cipher(cfg.Enc.PathType),
+		Versioning: *mbVersioning,
+		PartnerID:  cfg.Client.PartnerID,
+	})
 	if err != nil {
 		return err
 	}
 
+	if jsonOutput() {
+		return printJSON(os.Stdout, bucketResult{Operation: "create", Bucket: dst.Bucket()})
+	}
+
 	fmt.Printf("Bucket %s created\n", dst.Bucket())
 
 	return nil
@@ -12,6 +12,8 @@ import (
 	"os"
 	"os/signal"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/hanwen/go-fuse/fuse"
@@ -21,18 +23,26 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/stream"
 )
 
+var (
+	mountCacheTimeout *time.Duration
+	mountReadAhead    = memory.MiB
+)
+
 func init() {
-	addCmd(&cobra.Command{
+	mountCmd := addCmd(&cobra.Command{
 		Use:   "mount",
 		Short: "Mount a bucket",
 		RunE:  mountBucket,
 	}, RootCmd)
+	mountCacheTimeout = mountCmd.Flags().Duration("cache-timeout", time.Second, "how long the attributes of files and directories are cached, 0 disables caching")
+	mountCmd.Flags().Var(&mountReadAhead, "read-ahead", "how much is downloaded ahead of reads, 0 downloads only the read ranges")
 }
 
 func mountBucket(cmd *cobra.Command, args []string) (err error) {
@@ -43,7 +53,7 @@ func mountBucket(cmd *cobra.Command, args []string) (err error) {
 		return fmt.Errorf("No destination specified")
 	}
 
-	ctx := process.Ctx(cmd)
+	ctx := streams.WithDownloadParallelism(process.Ctx(cmd), cfg.Client.DownloadParallelism)
 
 	metainfo, streams, err := cfg.Metainfo(ctx)
 	if err != nil {
@@ -67,8 +77,13 @@ func mountBucket(cmd *cobra.Command, args []string) (err error) {
 		return convertError(err, src)
 	}
 
-	nfs := pathfs.NewPathNodeFs(newStorjFS(ctx, metainfo, streams, bucket), nil)
-	conn := nodefs.NewFileSystemConnector(nfs.Root(), nil)
+	nfs := pathfs.NewPathNodeFs(newStorjFS(ctx, metainfo, streams, bucket, *mountCacheTimeout, mountReadAhead.Int64()), nil)
+	conn := nodefs.NewFileSystemConnector(nfs.Root(), &nodefs.Options{
+		EntryTimeout:    *mountCacheTimeout,
+		AttrTimeout:     *mountCacheTimeout,
+		NegativeTimeout: *mountCacheTimeout,
+		Owner:           fuse.CurrentOwner(),
+	})
 
 	// workaround to avoid async (unordered) reading
 	mountOpts := fuse.MountOptions{MaxBackground: 1}
@@ -100,9 +115,24 @@ type storjFS struct {
 	createdFiles map[string]*storjFile
 	nodeFS       *pathfs.PathNodeFs
 	pathfs.FileSystem
+
+	// cacheTimeout is how long attrs are kept, readAhead is how much a read
+	// downloads ahead of the requested range
+	cacheTimeout time.Duration
+	readAhead    int64
+
+	mu    sync.Mutex
+	attrs map[string]cachedAttr
 }
 
-func newStorjFS(ctx context.Context, metainfo storj.Metainfo, streams streams.Store, bucket storj.Bucket) *storjFS {
+// cachedAttr is the attributes of a file or a directory, which are valid
+// until expires
+type cachedAttr struct {
+	attr    fuse.Attr
+	expires time.Time
+}
+
+func newStorjFS(ctx context.Context, metainfo storj.Metainfo, streams streams.Store, bucket storj.Bucket, cacheTimeout time.Duration, readAhead int64) *storjFS {
 	return &storjFS{
 		ctx:          ctx,
 		metainfo:     metainfo,
@@ -110,6 +140,49 @@ func newStorjFS(ctx context.Context, metainfo storj.Metainfo, streams streams.St
 		bucket:       bucket,
 		createdFiles: make(map[string]*storjFile),
 		FileSystem:   pathfs.NewDefaultFileSystem(),
+		cacheTimeout: cacheTimeout,
+		readAhead:    readAhead,
+		attrs:        make(map[string]cachedAttr),
+	}
+}
+
+// lookupAttr returns the cached attributes of name, if they haven't expired
+func (sf *storjFS) lookupAttr(name string) (*fuse.Attr, bool) {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+
+	cached, ok := sf.attrs[name]
+	if !ok || time.Now().After(cached.expires) {
+		delete(sf.attrs, name)
+		return nil, false
+	}
+	attr := cached.attr
+	return &attr, true
+}
+
+// cacheAttr caches the attributes of name
+func (sf *storjFS) cacheAttr(name string, attr *fuse.Attr) {
+	if sf.cacheTimeout <= 0 {
+		return
+	}
+
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+	sf.attrs[name] = cachedAttr{attr: *attr, expires: time.Now().Add(sf.cacheTimeout)}
+}
+
+// invalidateAttrs removes the cached attributes of the names, or of all
+// files and directories when no names are given
+func (sf *storjFS) invalidateAttrs(names ...string) {
+	sf.mu.Lock()
+	defer sf.mu.Unlock()
+
+	if len(names) == 0 {
+		sf.attrs = make(map[string]cachedAttr)
+		return
+	}
+	for _, name := range names {
+		delete(sf.attrs, name)
 	}
 }
 
@@ -137,6 +210,10 @@ func (sf *storjFS) GetAttr(name string, context *fuse.Context) (*fuse.Attr, fuse
 		return &fuse.Attr{Mode: fuse.S_IFDIR | 0755}, fuse.OK
 	}
 
+	if attr, ok := sf.lookupAttr(name); ok {
+		return attr, fuse.OK
+	}
+
 	object, err := sf.metainfo.GetObject(sf.ctx, sf.bucket.Name, name)
 	if err != nil && !storj.ErrObjectNotFound.Has(err) {
 		return nil, fuse.EIO
@@ -151,18 +228,22 @@ func (sf *storjFS) GetAttr(name string, context *fuse.Context) (*fuse.Attr, fuse
 
 		// if exactly one element has this prefix then it's directory
 		if len(list.Items) == 1 {
-			return &fuse.Attr{Mode: fuse.S_IFDIR | 0755}, fuse.OK
+			attr := &fuse.Attr{Mode: fuse.S_IFDIR | 0755}
+			sf.cacheAttr(name, attr)
+			return attr, fuse.OK
 		}
 
 		return nil, fuse.ENOENT
 	}
 
-	return &fuse.Attr{
+	attr := &fuse.Attr{
 		Owner: *fuse.CurrentOwner(),
 		Mode:  fuse.S_IFREG | 0644,
 		Size:  uint64(object.Size),
 		Mtime: uint64(object.Modified.Unix()),
-	}, fuse.OK
+	}
+	sf.cacheAttr(name, attr)
+	return attr, fuse.OK
 }
 
 func (sf *storjFS) OpenDir(name string, context *fuse.Context) (c []fuse.DirEntry, code fuse.Status) {
@@ -192,6 +273,7 @@ func (sf *storjFS) OpenDir(name string, context *fuse.Context) (c []fuse.DirEntr
 
 func (sf *storjFS) Mkdir(name string, mode uint32, context *fuse.Context) fuse.Status {
 	zap.S().Debug("Mkdir: ", name)
+	defer sf.invalidateAttrs(name)
 
 	createInfo := storj.CreateObject{
 		ContentType:      "application/directory",
@@ -227,6 +309,7 @@ func (sf *storjFS) Mkdir(name string, mode uint32, context *fuse.Context) fuse.S
 
 func (sf *storjFS) Rmdir(name string, context *fuse.Context) (code fuse.Status) {
 	zap.S().Debug("Rmdir: ", name)
+	defer sf.invalidateAttrs()
 
 	err := sf.listObjects(sf.ctx, name, true, func(items []storj.Object) error {
 		for _, item := range items {
@@ -296,6 +379,7 @@ func (sf *storjFS) listObjects(ctx context.Context, name string, recursive bool,
 
 func (sf *storjFS) Unlink(name string, context *fuse.Context) (code fuse.Status) {
 	zap.S().Debug("Unlink: ", name)
+	defer sf.invalidateAttrs(name)
 
 	err := sf.metainfo.DeleteObject(sf.ctx, sf.bucket.Name, name)
 	if err != nil {
@@ -309,19 +393,24 @@ func (sf *storjFS) Unlink(name string, context *fuse.Context) (code fuse.Status)
 }
 
 type storjFile struct {
-	ctx             context.Context
-	metainfo        storj.Metainfo
-	streams         streams.Store
-	bucket          storj.Bucket
-	created         bool
-	name            string
-	size            uint64
-	mtime           uint64
-	reader          io.ReadCloser
-	writer          io.WriteCloser
-	mutableObject   storj.MutableObject
-	predictedOffset int64
-	FS              *storjFS
+	ctx           context.Context
+	metainfo      storj.Metainfo
+	streams       streams.Store
+	bucket        storj.Bucket
+	created       bool
+	name          string
+	size          uint64
+	mtime         uint64
+	writer        io.WriteCloser
+	mutableObject storj.MutableObject
+	FS            *storjFS
+
+	// download is opened by the first read, buffer holds the data read
+	// ahead of the last read starting at bufferOffset
+	mu           sync.Mutex
+	download     *stream.Download
+	buffer       []byte
+	bufferOffset int64
 
 	nodefs.File
 }
@@ -354,13 +443,14 @@ func (f *storjFile) GetAttr(attr *fuse.Attr) fuse.Status {
 	return fuse.ENOSYS
 }
 
+// Read reads the range at off from the read ahead buffer, or downloads the
+// range and the data following it when the buffer doesn't hold it. Reads
+// at any offset download only the segments overlapping their ranges.
 func (f *storjFile) Read(buf []byte, off int64) (res fuse.ReadResult, code fuse.Status) {
-	// Detect if offset was moved manually (e.g. stream rev/fwd)
-	if off != f.predictedOffset {
-		f.closeReader()
-	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
 
-	reader, err := f.getReader(off)
+	download, err := f.getDownload()
 	if err != nil {
 		if storj.ErrObjectNotFound.Has(err) {
 			return nil, fuse.ENOENT
@@ -368,17 +458,47 @@ func (f *storjFile) Read(buf []byte, off int64) (res fuse.ReadResult, code fuse.
 		return nil, fuse.EIO
 	}
 
-	n, err := io.ReadFull(reader, buf)
-	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
-		return nil, fuse.EIO
+	size := download.Size()
+	if off >= size {
+		return fuse.ReadResultData(nil), fuse.OK
 	}
 
-	f.predictedOffset = off + int64(n)
+	end := off + int64(len(buf))
+	if end > size {
+		end = size
+	}
+
+	if off < f.bufferOffset || end > f.bufferOffset+int64(len(f.buffer)) {
+		length := end - off
+		if length < f.FS.readAhead {
+			length = f.FS.readAhead
+		}
+		if length > size-off {
+			length = size - off
+		}
+
+		f.buffer = make([]byte, length)
+		n, err := download.ReadAt(f.buffer, off)
+		if err != nil && err != io.EOF {
+			f.buffer = nil
+			return nil, fuse.EIO
+		}
+		f.buffer, f.bufferOffset = f.buffer[:n], off
+		if end > off+int64(n) {
+			end = off + int64(n)
+		}
+	}
 
+	n := copy(buf, f.buffer[off-f.bufferOffset:end-f.bufferOffset])
 	return fuse.ReadResultData(buf[:n]), fuse.OK
 }
 
 func (f *storjFile) Write(data []byte, off int64) (uint32, fuse.Status) {
+	// objects are uploaded as streams, which can only be appended to
+	if off != 0 && (f.writer == nil || off != int64(f.size)) {
+		return 0, fuse.Status(syscall.ENOTSUP)
+	}
+
 	writer, err := f.getWriter(off)
 	if err != nil {
 		return 0, fuse.EIO
@@ -398,22 +518,16 @@ func (f *storjFile) Write(data []byte, off int64) (uint32, fuse.Status) {
 	return uint32(written), fuse.OK
 }
 
-func (f *storjFile) getReader(off int64) (io.ReadCloser, error) {
-	if f.reader == nil {
+func (f *storjFile) getDownload() (*stream.Download, error) {
+	if f.download == nil {
 		readOnlyStream, err := f.metainfo.GetObjectStream(f.ctx, f.bucket.Name, f.name)
 		if err != nil {
 			return nil, err
 		}
 
-		download := stream.NewDownload(f.ctx, readOnlyStream, f.streams)
-		_, err = download.Seek(off, io.SeekStart)
-		if err != nil {
-			return nil, err
-		}
-
-		f.reader = download
+		f.download = stream.NewDownload(f.ctx, readOnlyStream, f.streams)
 	}
-	return f.reader, nil
+	return f.download, nil
 }
 
 func (f *storjFile) getWriter(off int64) (io.Writer, error) {
@@ -444,19 +558,23 @@ func (f *storjFile) getWriter(off int64) (io.Writer, error) {
 func (f *storjFile) Flush() fuse.Status {
 	zap.S().Debug("Flush: ", f.name)
 
-	f.closeReader()
+	f.closeDownload()
 	f.closeWriter()
 	return fuse.OK
 }
 
-func (f *storjFile) closeReader() {
-	if f.reader != nil {
-		closeErr := f.reader.Close()
+func (f *storjFile) closeDownload() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.download != nil {
+		closeErr := f.download.Close()
 		if closeErr != nil {
-			zap.S().Errorf("error closing reader: %v", closeErr)
+			zap.S().Errorf("error closing download: %v", closeErr)
 		}
-		f.reader = nil
+		f.download = nil
 	}
+	f.buffer = nil
 }
 
 func (f *storjFile) closeWriter() {
@@ -466,11 +584,15 @@ func (f *storjFile) closeWriter() {
 			zap.S().Errorf("error closing writer: %v", closeErr)
 		}
 
-		f.FS.removeCreatedFile(f.name)
 		err := f.mutableObject.Commit(f.ctx)
 		if err != nil {
 			zap.S().Errorf("error during commiting data: %v", err)
 		}
+
+		// the attributes are invalidated only after the commit, otherwise
+		// a GetAttr during the commit could cache the replaced object
+		f.FS.removeCreatedFile(f.name)
+		f.FS.invalidateAttrs(f.name)
 		f.writer = nil
 	}
 }
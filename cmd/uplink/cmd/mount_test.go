@@ -0,0 +1,183 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build linux darwin
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hanwen/go-fuse/fuse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+func TestMountAttrCache(t *testing.T) {
+	sf := newStorjFS(context.Background(), nil, nil, storj.Bucket{}, time.Hour, 0)
+
+	_, ok := sf.lookupAttr("a")
+	assert.False(t, ok)
+
+	sf.cacheAttr("a", &fuse.Attr{Size: 1})
+	sf.cacheAttr("b", &fuse.Attr{Size: 2})
+	sf.cacheAttr("c", &fuse.Attr{Size: 3})
+
+	attr, ok := sf.lookupAttr("a")
+	require.True(t, ok)
+	assert.EqualValues(t, 1, attr.Size)
+
+	// the returned attributes are a copy of the cached ones
+	attr.Size = 10
+	attr, ok = sf.lookupAttr("a")
+	require.True(t, ok)
+	assert.EqualValues(t, 1, attr.Size)
+
+	sf.invalidateAttrs("a")
+	_, ok = sf.lookupAttr("a")
+	assert.False(t, ok)
+	_, ok = sf.lookupAttr("b")
+	assert.True(t, ok)
+
+	sf.invalidateAttrs()
+	_, ok = sf.lookupAttr("b")
+	assert.False(t, ok)
+	_, ok = sf.lookupAttr("c")
+	assert.False(t, ok)
+
+	// expired attributes aren't returned
+	sf.cacheTimeout = time.Nanosecond
+	sf.cacheAttr("a", &fuse.Attr{Size: 1})
+	time.Sleep(time.Millisecond)
+	_, ok = sf.lookupAttr("a")
+	assert.False(t, ok)
+
+	// nothing is cached without a timeout
+	sf.cacheTimeout = 0
+	sf.cacheAttr("a", &fuse.Attr{Size: 1})
+	_, ok = sf.lookupAttr("a")
+	assert.False(t, ok)
+}
+
+// commitObject is a mutable object which caches stale attributes while it's
+// committed, like a GetAttr concurrent with the commit would
+type commitObject struct {
+	storj.MutableObject
+	fs   *storjFS
+	name string
+}
+
+func (object *commitObject) Commit(ctx context.Context) error {
+	object.fs.cacheAttr(object.name, &fuse.Attr{Size: 1})
+	return nil
+}
+
+// nopWriteCloser discards the written data
+type nopWriteCloser struct{}
+
+func (nopWriteCloser) Write(data []byte) (int, error) { return len(data), nil }
+func (nopWriteCloser) Close() error                   { return nil }
+
+func TestMountCloseWriterInvalidatesAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	sf := newStorjFS(ctx, nil, nil, storj.Bucket{}, time.Hour, 0)
+
+	file := newStorjFile(ctx, "a", nil, nil, storj.Bucket{}, true, sf)
+	file.writer = nopWriteCloser{}
+	file.mutableObject = &commitObject{fs: sf, name: "a"}
+	sf.addCreatedFile("a", file)
+
+	file.closeWriter()
+
+	_, ok := sf.lookupAttr("a")
+	assert.False(t, ok)
+	assert.NotContains(t, sf.createdFiles, "a")
+	assert.Nil(t, file.writer)
+}
+
+// rangeCounter records the ranges read from a ranger
+type rangeCounter struct {
+	ranger.Ranger
+	ranges [][2]int64
+}
+
+func (rr *rangeCounter) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	rr.ranges = append(rr.ranges, [2]int64{offset, length})
+	return rr.Ranger.Range(ctx, offset, length)
+}
+
+// rangeStreams stores a single stream in memory
+type rangeStreams struct {
+	streams.Store
+	ranger *rangeCounter
+}
+
+func (s *rangeStreams) Get(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (ranger.Ranger, streams.Meta, error) {
+	return s.ranger, streams.Meta{Size: s.ranger.Size()}, nil
+}
+
+// rangeStream is the read-only stream of rangeStreams
+type rangeStream struct {
+	storj.ReadOnlyStream
+	size int64
+}
+
+func (s *rangeStream) Info() storj.Object {
+	return storj.Object{Bucket: storj.Bucket{Name: "bucket"}, Path: "object", Size: s.size}
+}
+
+func TestMountReadAhead(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("0123456789abcdefghij")
+	rr := &rangeCounter{Ranger: ranger.ByteRanger(data)}
+
+	sf := newStorjFS(ctx, nil, nil, storj.Bucket{}, 0, 8)
+	file := newStorjFile(ctx, "object", nil, nil, storj.Bucket{}, false, sf)
+	file.download = stream.NewDownload(ctx, &rangeStream{size: int64(len(data))}, &rangeStreams{ranger: rr})
+
+	read := func(n int, off int64) string {
+		buf := make([]byte, n)
+		res, status := file.Read(buf, off)
+		require.Equal(t, fuse.OK, status)
+		result, status := res.Bytes(buf)
+		require.Equal(t, fuse.OK, status)
+		return string(result)
+	}
+
+	for i, tt := range []struct {
+		n      int
+		off    int64
+		data   string
+		ranges [][2]int64
+	}{
+		// the first read downloads readAhead bytes
+		{2, 0, "01", [][2]int64{{0, 8}}},
+		// reads inside the buffer don't download anything
+		{4, 2, "2345", [][2]int64{{0, 8}}},
+		{2, 6, "67", [][2]int64{{0, 8}}},
+		// a read past the buffer downloads readAhead bytes at its offset
+		{4, 6, "6789", [][2]int64{{0, 8}, {6, 8}}},
+		// a read larger than readAhead downloads the whole read
+		{10, 0, "0123456789", [][2]int64{{0, 8}, {6, 8}, {0, 10}}},
+		// the read ahead stops at the end of the object
+		{2, 16, "gh", [][2]int64{{0, 8}, {6, 8}, {0, 10}, {16, 4}}},
+		{4, 18, "ij", [][2]int64{{0, 8}, {6, 8}, {0, 10}, {16, 4}}},
+		// reads past the end return nothing
+		{4, 20, "", [][2]int64{{0, 8}, {6, 8}, {0, 10}, {16, 4}}},
+	} {
+		assert.Equal(t, tt.data, read(tt.n, tt.off), "#%d", i)
+		assert.Equal(t, tt.ranges, rr.ranges, "#%d", i)
+	}
+
+	file.closeDownload()
+	assert.Nil(t, file.download)
+	assert.Nil(t, file.buffer)
+}
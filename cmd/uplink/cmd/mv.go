@@ -0,0 +1,71 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/process"
+)
+
+func init() {
+	addCmd(&cobra.Command{
+		Use:   "mv",
+		Short: "Moves or renames an object without transferring its data",
+		RunE:  moveObject,
+	}, RootCmd)
+}
+
+func moveObject(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	if len(args) == 0 {
+		return fmt.Errorf("No object specified for move")
+	}
+	if len(args) == 1 {
+		return fmt.Errorf("No destination specified")
+	}
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	dst, err := fpath.New(args[1])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() || dst.IsLocal() {
+		return fmt.Errorf("Both the source and the destination must be Storj URLs")
+	}
+
+	// if destination object name not specified, default to source object name
+	if dst.Path() == "" || strings.HasSuffix(dst.Path(), "/") {
+		dst = dst.Join(src.Base())
+	}
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = metainfo.MoveObject(ctx, src.Bucket(), src.Path(), dst.Bucket(), dst.Path())
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	if jsonOutput() {
+		return printJSON(os.Stdout, transferResult{Operation: "move", Source: src.String(), Destination: dst.String()})
+	}
+
+	fmt.Printf("Moved %s to %s\n", src, dst)
+
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	progressbar "github.com/cheggaaa/pb"
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+
+	// progressInterval is the minimum time between two json progress events
+	progressInterval = 500 * time.Millisecond
+)
+
+var (
+	outputFormat *string
+)
+
+func init() {
+	outputFormat = RootCmd.PersistentFlags().String("output", outputText, "output format, either text or json")
+	RootCmd.PersistentPreRunE = checkOutputFormat
+}
+
+func checkOutputFormat(cmd *cobra.Command, args []string) error {
+	switch *outputFormat {
+	case outputText, outputJSON:
+		return nil
+	default:
+		return fmt.Errorf("Invalid output format %q, use text or json", *outputFormat)
+	}
+}
+
+// jsonOutput returns true when results should be printed as json lines
+func jsonOutput() bool {
+	return *outputFormat == outputJSON
+}
+
+// printJSON writes v to w as a single line of json
+func printJSON(w io.Writer, v interface{}) error {
+	return json.NewEncoder(w).Encode(v)
+}
+
+// listEntry is the json representation of a single item printed by ls
+type listEntry struct {
+	// Type is one of "bucket", "prefix" or "object"
+	Type     string     `json:"type"`
+	Bucket   string     `json:"bucket"`
+	Path     string     `json:"path,omitempty"`
+	Size     int64      `json:"size"`
+	Created  *time.Time `json:"created,omitempty"`
+	Modified *time.Time `json:"modified,omitempty"`
+	// ContentType is only set for objects
+	ContentType string `json:"contentType,omitempty"`
+}
+
+// bucketResult is the json representation of the result of mb and rb
+type bucketResult struct {
+	// Operation is either "create" or "delete"
+	Operation string `json:"operation"`
+	Bucket    string `json:"bucket"`
+}
+
+// objectResult is the json representation of the result of rm
+type objectResult struct {
+	// Operation is always "delete"
+	Operation string `json:"operation"`
+	Bucket    string `json:"bucket"`
+	Path      string `json:"path"`
+}
+
+// transferResult is the json representation of the result of cp, put, cat and mv
+type transferResult struct {
+	// Operation is one of "upload", "download", "copy" or "move"
+	Operation   string `json:"operation"`
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Bytes       int64  `json:"bytes"`
+	ContentType string `json:"contentType,omitempty"`
+	// Metadata is only set for downloads
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// statResult is the json representation of the result of stat
+type statResult struct {
+	Bucket      string            `json:"bucket"`
+	Path        string            `json:"path"`
+	Size        int64             `json:"size"`
+	Created     time.Time         `json:"created"`
+	Modified    time.Time         `json:"modified"`
+	Expires     *time.Time        `json:"expires,omitempty"`
+	ContentType string            `json:"contentType,omitempty"`
+	Checksum    string            `json:"checksum,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// syncResult is the json representation of the summary of sync, the
+// transfers and deletes are printed as transferResult and objectResult
+type syncResult struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Transferred int    `json:"transferred"`
+	Deleted     int    `json:"deleted"`
+	UpToDate    int    `json:"upToDate"`
+	Failed      int    `json:"failed"`
+	DryRun      bool   `json:"dryRun,omitempty"`
+}
+
+// batchResult is the json representation of the summary of cp and rm with
+// --recursive, the single items are printed as transferResult and
+// objectResult
+type batchResult struct {
+	// Operation is either "copy" or "delete"
+	Operation   string `json:"operation"`
+	Source      string `json:"source"`
+	Destination string `json:"destination,omitempty"`
+	Succeeded   int    `json:"succeeded"`
+	Failed      int    `json:"failed"`
+	Bytes       int64  `json:"bytes"`
+	// Duration is in seconds
+	Duration float64 `json:"duration"`
+	DryRun   bool    `json:"dryRun,omitempty"`
+}
+
+// lifecycleResult is the json representation of the result of lifecycle
+type lifecycleResult struct {
+	Bucket                  string `json:"bucket"`
+	ExpireAfterDays         int    `json:"expireAfterDays"`
+	VersionsExpireAfterDays int    `json:"versionsExpireAfterDays"`
+}
+
+// progressEvent is printed as a json line to stderr while transferring data
+type progressEvent struct {
+	Operation string `json:"operation"`
+	Source    string `json:"source"`
+	Bytes     int64  `json:"bytes"`
+	Total     int64  `json:"total"`
+	Done      bool   `json:"done"`
+}
+
+// jsonProgress reports the progress of reading from a reader as json lines
+type jsonProgress struct {
+	reader io.Reader
+	event  progressEvent
+	last   time.Time
+}
+
+// Read implements io.Reader
+func (progress *jsonProgress) Read(p []byte) (n int, err error) {
+	n, err = progress.reader.Read(p)
+	progress.event.Bytes += int64(n)
+	if now := time.Now(); now.Sub(progress.last) >= progressInterval {
+		progress.last = now
+		progress.emit()
+	}
+	return n, err
+}
+
+// Finish prints the final progress event
+func (progress *jsonProgress) Finish() {
+	progress.event.Done = true
+	progress.emit()
+}
+
+func (progress *jsonProgress) emit() {
+	_ = printJSON(os.Stderr, progress.event)
+}
+
+// trackProgress wraps reader so that the progress of the transfer is shown
+// either as a progress bar or as json lines on stderr, depending on the
+// output format. The returned func must be called once the transfer has
+// completed.
+func trackProgress(reader io.Reader, operation, source string, total int64) (io.Reader, func()) {
+	if jsonOutput() {
+		progress := &jsonProgress{
+			reader: reader,
+			event: progressEvent{
+				Operation: operation,
+				Source:    source,
+				Total:     total,
+			},
+		}
+		return progress, progress.Finish
+	}
+
+	bar := progressbar.New(int(total)).SetUnits(progressbar.U_BYTES)
+	bar.Start()
+	return bar.NewProxyReader(reader), bar.Finish
+}
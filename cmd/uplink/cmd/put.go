@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -12,12 +13,19 @@ import (
 	"storj.io/storj/pkg/process"
 )
 
+var (
+	putContentType *string
+	putMetadata    *map[string]string
+)
+
 func init() {
-	addCmd(&cobra.Command{
+	putCmd := addCmd(&cobra.Command{
 		Use:   "put",
 		Short: "Copies data from standard in to a Storj object",
 		RunE:  putMain,
 	}, RootCmd)
+	putContentType = putCmd.Flags().String("content-type", "", "content type of the uploaded object, detected from the object name or data when empty")
+	putMetadata = putCmd.Flags().StringToString("metadata", nil, "metadata of the uploaded object as comma separated key=value pairs")
 }
 
 // putMain is the function executed when putCmd is called
@@ -42,5 +50,5 @@ func putMain(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	return upload(ctx, src, dst, false)
+	return upload(ctx, src, dst, *putContentType, *putMetadata, false, os.Stdout)
 }
@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -60,6 +61,10 @@ func deleteBucket(cmd *cobra.Command, args []string) error {
 		return convertError(err, dst)
 	}
 
+	if jsonOutput() {
+		return printJSON(os.Stdout, bucketResult{Operation: "delete", Bucket: dst.Bucket()})
+	}
+
 	fmt.Printf("Bucket %s deleted\n", dst.Bucket())
 
 	return nil
@@ -4,20 +4,29 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/spf13/cobra"
 
 	"storj.io/storj/internal/fpath"
 	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	rmBatch batchFlags
 )
 
 func init() {
-	addCmd(&cobra.Command{
+	rmCmd := addCmd(&cobra.Command{
 		Use:   "rm",
-		Short: "Delete an object",
+		Short: "Delete an object, or all the objects below a prefix with --recursive",
 		RunE:  deleteObject,
 	}, RootCmd)
+	rmBatch = addBatchFlags(rmCmd, "delete")
 }
 
 func deleteObject(cmd *cobra.Command, args []string) error {
@@ -27,6 +36,10 @@ func deleteObject(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("No object specified for deletion")
 	}
 
+	if err := rmBatch.check(); err != nil {
+		return err
+	}
+
 	dst, err := fpath.New(args[0])
 	if err != nil {
 		return err
@@ -41,12 +54,48 @@ func deleteObject(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	err = metainfo.DeleteObject(ctx, dst.Bucket(), dst.Path())
+	if *rmBatch.recursive {
+		return deleteRecursive(ctx, metainfo, dst)
+	}
+
+	return deletePath(ctx, metainfo, dst, os.Stdout)
+}
+
+// deletePath deletes the object dst and writes the result to out
+func deletePath(ctx context.Context, metainfo storj.Metainfo, dst fpath.FPath, out io.Writer) error {
+	err := metainfo.DeleteObject(ctx, dst.Bucket(), dst.Path())
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	if jsonOutput() {
+		return printJSON(out, objectResult{Operation: "delete", Bucket: dst.Bucket(), Path: dst.Path()})
+	}
+
+	_, err = fmt.Fprintf(out, "Deleted %s\n", dst)
+	return err
+}
+
+// deleteRecursive deletes the objects below the prefix dst
+func deleteRecursive(ctx context.Context, metainfo storj.Metainfo, dst fpath.FPath) error {
+	objects, err := listSyncObjects(ctx, metainfo, dst)
 	if err != nil {
 		return convertError(err, dst)
 	}
 
-	fmt.Printf("Deleted %s\n", dst)
+	var items []batchItem
+	for _, rel := range rmBatch.filter(objects) {
+		object := joinRelative(dst, rel)
+		items = append(items, batchItem{
+			description: fmt.Sprintf("delete %s", object),
+			size:        objects[rel].size,
+			result:      objectResult{Operation: "delete", Bucket: object.Bucket(), Path: object.Path()},
+			run: func(ctx context.Context, out io.Writer) error {
+				return deletePath(ctx, metainfo, object, out)
+			},
+		})
+	}
 
-	return nil
+	b := &batch{operation: "delete", source: dst, dryRun: *rmBatch.dryRun}
+	return b.run(ctx, *rmBatch.concurrency, items)
 }
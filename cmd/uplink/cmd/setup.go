@@ -19,8 +19,9 @@ import (
 
 // UplinkFlags configuration flags
 type UplinkFlags struct {
-	APIKey        string `default:"" help:"the api key to use for the satellite" setup:"true"`
-	SatelliteAddr string `default:"localhost:7778" help:"the address to use for the satellite" setup:"true"`
+	APIKey         string `default:"" help:"the api key to use for the satellite" setup:"true"`
+	SatelliteAddr  string `default:"localhost:7778" help:"the address to use for the satellite, as <node id>@<host>:<port> to verify its identity" setup:"true"`
+	NonInteractive bool   `default:"false" help:"use the flags instead of asking for the setup values and skip the checks" setup:"true"`
 
 	miniogw.Config // TODO remove when GetMetainfo will be moved from minio
 }
@@ -79,6 +80,19 @@ func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 		return fmt.Errorf("uplink configuration already exists (%v)", setupDir)
 	}
 
+	ctx := process.Ctx(cmd)
+	if setupCfg.NonInteractive {
+		_, setupCfg.SatelliteAddr, err = splitNodeURL(setupCfg.SatelliteAddr)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = newWizard().run(ctx, &setupCfg, identityDir)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = os.MkdirAll(setupDir, 0700)
 	if err != nil {
 		return err
@@ -89,6 +103,33 @@ func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 		"client.pointer-db-addr": setupCfg.SatelliteAddr,
 		"client.overlay-addr":    setupCfg.SatelliteAddr,
 	}
+	if setupCfg.Enc.Key != "" {
+		overrides["enc.key"] = setupCfg.Enc.Key
+	}
+
+	configFile := filepath.Join(setupDir, "config.yaml")
+	err = process.SaveConfigWithAllDefaults(cmd.Flags(), configFile, overrides)
+	if err != nil {
+		return err
+	}
+	if setupCfg.NonInteractive {
+		return nil
+	}
+
+	fmt.Printf("Configuration written to %s, testing an upload...\n", configFile)
+
+	config := setupCfg.Config
+	config.Identity.CertPath = filepath.Join(identityDir, "identity.cert")
+	config.Identity.KeyPath = filepath.Join(identityDir, "identity.key")
+	config.Client.APIKey = setupCfg.APIKey
+	config.Client.OverlayAddr = setupCfg.SatelliteAddr
+	config.Client.PointerDBAddr = setupCfg.SatelliteAddr
+
+	err = testUpload(ctx, config)
+	if err != nil {
+		return fmt.Errorf("the test upload failed, check the configuration in %s: %v", configFile, err)
+	}
 
-	return process.SaveConfigWithAllDefaults(cmd.Flags(), filepath.Join(setupDir, "config.yaml"), overrides)
+	fmt.Println("The test upload succeeded, the uplink is ready to use.")
+	return nil
 }
@@ -0,0 +1,213 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/miniogw"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+)
+
+var shareCfg struct {
+	readonly        *bool
+	disallowReads   *bool
+	disallowWrites  *bool
+	disallowLists   *bool
+	disallowDeletes *bool
+	notBefore       *string
+	notAfter        *string
+	deriveKeys      *bool
+}
+
+func init() {
+	shareCmd := addCmd(&cobra.Command{
+		Use:   "share [sj://bucket/prefix]...",
+		Short: "Creates a scope with a restricted api key, which only allows access to the given paths",
+		RunE:  shareMain,
+	}, RootCmd)
+	shareCfg.readonly = shareCmd.Flags().Bool("readonly", false, "implies --disallow-writes and --disallow-deletes")
+	shareCfg.disallowReads = shareCmd.Flags().Bool("disallow-reads", false, "if true, disallow reads")
+	shareCfg.disallowWrites = shareCmd.Flags().Bool("disallow-writes", false, "if true, disallow writes")
+	shareCfg.disallowLists = shareCmd.Flags().Bool("disallow-lists", false, "if true, disallow lists")
+	shareCfg.disallowDeletes = shareCmd.Flags().Bool("disallow-deletes", false, "if true, disallow deletes")
+	shareCfg.notBefore = shareCmd.Flags().String("not-before", "", "disallow access before this time, either RFC3339 or relative to now like +2h")
+	shareCfg.notAfter = shareCmd.Flags().String("not-after", "", "disallow access after this time, either RFC3339 or relative to now like +720h")
+	shareCfg.deriveKeys = shareCmd.Flags().Bool("derive-keys", false, "if true, the scope contains encryption keys derived for the given paths instead of the root key")
+}
+
+// shareResult is the json representation of the result of share
+type shareResult struct {
+	APIKey string `json:"apiKey"`
+	Scope  string `json:"scope"`
+}
+
+// shareMain restricts the configured api key and prints it together with
+// the scope, which a collaborator passes with --client.scope to access the
+// allowed paths. The key is restricted without contacting the satellite,
+// only the path ciphers of the buckets of the allowed paths are looked up.
+// The scope contains the root encryption key and the keys of the buckets
+// encrypted with their own passphrases, the satellite enforces the
+// restrictions of the key. With --derive-keys it contains only keys derived
+// for the allowed paths, which can't decrypt any other paths.
+func shareMain(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	config, err := cfg.Config.WithScope()
+	if err != nil {
+		return err
+	}
+
+	key, err := macaroon.ParseAPIKey(config.Client.APIKey)
+	if err != nil {
+		return fmt.Errorf("the api key can't be restricted, use the macaroon of the key shown when it was created: %v", err)
+	}
+
+	caveat := pb.Caveat{
+		DisallowReads:   *shareCfg.disallowReads,
+		DisallowWrites:  *shareCfg.disallowWrites || *shareCfg.readonly,
+		DisallowLists:   *shareCfg.disallowLists,
+		DisallowDeletes: *shareCfg.disallowDeletes || *shareCfg.readonly,
+	}
+
+	now := time.Now()
+	if caveat.NotBefore, err = parseShareTime(*shareCfg.notBefore, now); err != nil {
+		return err
+	}
+	if caveat.NotAfter, err = parseShareTime(*shareCfg.notAfter, now); err != nil {
+		return err
+	}
+
+	access, err := config.Enc.Access()
+	if err != nil {
+		return err
+	}
+
+	buckets := make(map[string]bool)
+	var derivedKeys []encryption.PrefixKey
+	for _, arg := range args {
+		path, derivedKey, err := sharePath(ctx, config, access, arg)
+		if err != nil {
+			return err
+		}
+		caveat.AllowedPaths = append(caveat.AllowedPaths, path)
+		buckets[string(path.Bucket)] = true
+		derivedKeys = append(derivedKeys, derivedKey)
+	}
+
+	restricted, err := key.Restrict(caveat)
+	if err != nil {
+		return err
+	}
+
+	scope := miniogw.Scope{
+		SatelliteAddr: config.Client.PointerDBAddr,
+		APIKey:        restricted.Serialize(),
+	}
+	if *shareCfg.deriveKeys {
+		if len(args) == 0 {
+			return fmt.Errorf("keys can only be derived for the given paths")
+		}
+		scope.PrefixKeys = derivedKeys
+	} else {
+		scope.EncryptionKey = []byte(config.Enc.Key)
+		for _, prefixKey := range access.Prefixes() {
+			if len(args) == 0 || buckets[storj.SplitPath(prefixKey.Prefix)[0]] {
+				scope.PrefixKeys = append(scope.PrefixKeys, prefixKey)
+			}
+		}
+	}
+	serializedScope, err := scope.Serialize()
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput() {
+		return printJSON(os.Stdout, shareResult{
+			APIKey: scope.APIKey,
+			Scope:  serializedScope,
+		})
+	}
+
+	fmt.Printf("%-9s %s\n", "API Key:", scope.APIKey)
+	fmt.Printf("%-9s %s\n", "Scope:", serializedScope)
+
+	return nil
+}
+
+// sharePath returns the bucket and the encrypted path prefix of arg, and
+// the encryption key derived for it from access
+func sharePath(ctx context.Context, config miniogw.Config, access *encryption.Access, arg string) (*pb.CaveatPath, encryption.PrefixKey, error) {
+	path, err := fpath.New(arg)
+	if err != nil {
+		return nil, encryption.PrefixKey{}, err
+	}
+	if path.IsLocal() || path.Bucket() == "" {
+		return nil, encryption.PrefixKey{}, fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	prefix := strings.TrimSuffix(path.Path(), "/")
+	if prefix == "" {
+		// the bucket name isn't encrypted, so the cipher doesn't matter
+		derivedKey, err := access.Derive(path.Bucket(), storj.Unencrypted)
+		if err != nil {
+			return nil, encryption.PrefixKey{}, err
+		}
+		return &pb.CaveatPath{Bucket: []byte(path.Bucket())}, derivedKey, nil
+	}
+
+	identity, err := config.Identity.Load()
+	if err != nil {
+		return nil, encryption.PrefixKey{}, err
+	}
+	metainfo, _, err := config.GetMetainfo(ctx, identity)
+	if err != nil {
+		return nil, encryption.PrefixKey{}, err
+	}
+	bucket, err := metainfo.GetBucket(ctx, path.Bucket())
+	if err != nil {
+		return nil, encryption.PrefixKey{}, convertError(err, path)
+	}
+
+	derivedKey, err := access.Derive(storj.JoinPaths(path.Bucket(), prefix), bucket.PathCipher)
+	if err != nil {
+		return nil, encryption.PrefixKey{}, err
+	}
+
+	return &pb.CaveatPath{
+		Bucket:              []byte(path.Bucket()),
+		EncryptedPathPrefix: []byte(strings.TrimPrefix(derivedKey.EncryptedPrefix, path.Bucket()+"/")),
+	}, derivedKey, nil
+}
+
+// parseShareTime parses either an RFC3339 time or a duration relative to now
+func parseShareTime(value string, now time.Time) (*timestamp.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		d, durationErr := time.ParseDuration(value)
+		if durationErr != nil {
+			return nil, fmt.Errorf("invalid time %q, use RFC3339 or a duration like +2h", value)
+		}
+		t = now.Add(d)
+	}
+
+	return ptypes.TimestampProto(t)
+}
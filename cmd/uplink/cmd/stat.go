@@ -0,0 +1,92 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/process"
+)
+
+func init() {
+	addCmd(&cobra.Command{
+		Use:   "stat",
+		Short: "Shows the information and the metadata of a Storj object",
+		RunE:  statObject,
+	}, RootCmd)
+}
+
+func statObject(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	if len(args) == 0 {
+		return fmt.Errorf("No object specified")
+	}
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() {
+		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	object, err := metainfo.GetObject(ctx, src.Bucket(), src.Path())
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	if jsonOutput() {
+		result := statResult{
+			Bucket:      object.Bucket.Name,
+			Path:        object.Path,
+			Size:        object.Size,
+			Created:     object.Created.UTC(),
+			Modified:    object.Modified.UTC(),
+			ContentType: object.ContentType,
+			Checksum:    hex.EncodeToString(object.Checksum),
+			Metadata:    object.Metadata,
+		}
+		if !object.Expires.IsZero() {
+			expires := object.Expires.UTC()
+			result.Expires = &expires
+		}
+		return printJSON(os.Stdout, result)
+	}
+
+	fmt.Printf("%-14s %s\n", "Object:", src)
+	fmt.Printf("%-14s %d\n", "Size:", object.Size)
+	fmt.Printf("%-14s %s\n", "Created:", formatTime(object.Created))
+	fmt.Printf("%-14s %s\n", "Modified:", formatTime(object.Modified))
+	if !object.Expires.IsZero() {
+		fmt.Printf("%-14s %s\n", "Expires:", formatTime(object.Expires))
+	}
+	fmt.Printf("%-14s %s\n", "Content-Type:", object.ContentType)
+	if len(object.Checksum) > 0 {
+		fmt.Printf("%-14s %x\n", "Checksum:", object.Checksum)
+	}
+
+	keys := make([]string, 0, len(object.Metadata))
+	for key := range object.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Printf("%-14s %s=%s\n", "Metadata:", key, object.Metadata[key])
+	}
+
+	return nil
+}
@@ -0,0 +1,464 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+const (
+	// syncModifiedKey is the metadata key of the modification time of the
+	// uploaded file
+	syncModifiedKey = "sync-mtime"
+	// syncChecksumKey is the metadata key of the sha256 checksum of the
+	// uploaded file
+	syncChecksumKey = "sync-sha256"
+)
+
+var (
+	syncConcurrency *int
+	syncDelete      *bool
+	syncChecksum    *bool
+	syncDryRun      *bool
+)
+
+func init() {
+	syncCmd := addCmd(&cobra.Command{
+		Use:   "sync",
+		Short: "Synchronizes a local directory with a Storj prefix, transferring only the changed files",
+		RunE:  syncMain,
+	}, RootCmd)
+	syncConcurrency = syncCmd.Flags().Int("concurrency", 4, "how many files are transferred at once")
+	syncDelete = syncCmd.Flags().Bool("delete", false, "if true, delete the files of the destination which aren't in the source")
+	syncChecksum = syncCmd.Flags().Bool("checksum", false, "if true, compare the sha256 checksums of the files instead of their modification times")
+	syncDryRun = syncCmd.Flags().Bool("dry-run", false, "if true, only show what would be transferred and deleted")
+}
+
+// syncEntry is a local file or a Storj object compared by sync
+type syncEntry struct {
+	size     int64
+	modified time.Time
+	// checksum is the hex sha256 of the content, empty when unknown
+	checksum string
+}
+
+// upToDate returns whether entry doesn't need to be replaced by source
+func (entry syncEntry) upToDate(source syncEntry, checksum bool) bool {
+	if entry.size != source.size {
+		return false
+	}
+	if checksum {
+		return source.checksum != "" && entry.checksum == source.checksum
+	}
+	return entry.modified.Equal(source.modified)
+}
+
+// synchronizer transfers and deletes the files of a synchronization
+type synchronizer struct {
+	metainfo storj.Metainfo
+	streams  streams.Store
+	local    fpath.FPath
+	remote   fpath.FPath
+	upload   bool
+
+	mu          sync.Mutex // protects the output and the counters
+	transferred int
+	deleted     int
+	failed      int
+}
+
+// syncMain is the function executed when syncCmd is called
+func syncMain(cmd *cobra.Command, args []string) (err error) {
+	if len(args) == 0 {
+		return fmt.Errorf("No source specified for sync")
+	}
+	if len(args) == 1 {
+		return fmt.Errorf("No destination specified")
+	}
+	if *syncConcurrency < 1 {
+		return fmt.Errorf("Invalid concurrency %d, it must be at least 1", *syncConcurrency)
+	}
+
+	ctx := process.Ctx(cmd)
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	dst, err := fpath.New(args[1])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() == dst.IsLocal() {
+		return errors.New("One of the source or the destination must be a local directory and the other a Storj URL")
+	}
+
+	syncer := &synchronizer{local: src, remote: dst, upload: src.IsLocal()}
+	if !syncer.upload {
+		syncer.local, syncer.remote = dst, src
+	}
+
+	if syncer.upload {
+		fileInfo, err := os.Stat(syncer.local.Path())
+		if err != nil {
+			return err
+		}
+		if !fileInfo.IsDir() {
+			return fmt.Errorf("source must be a directory: %s", src)
+		}
+	} else if !*syncDryRun {
+		if err := os.MkdirAll(syncer.local.Path(), 0755); err != nil {
+			return err
+		}
+	}
+
+	ctx = streams.WithDownloadParallelism(ctx, cfg.Client.DownloadParallelism)
+	syncer.metainfo, syncer.streams, err = cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	files, err := listSyncFiles(syncer.local.Path(), *syncChecksum)
+	if err != nil {
+		return err
+	}
+	objects, err := listSyncObjects(ctx, syncer.metainfo, syncer.remote)
+	if err != nil {
+		return convertError(err, syncer.remote)
+	}
+
+	source, destination := files, objects
+	if !syncer.upload {
+		source, destination = objects, files
+	}
+
+	var transfers, deletes []string
+	for path, entry := range source {
+		if existing, ok := destination[path]; !ok || !existing.upToDate(entry, *syncChecksum) {
+			transfers = append(transfers, path)
+		}
+	}
+	if *syncDelete {
+		for path := range destination {
+			if _, ok := source[path]; !ok {
+				deletes = append(deletes, path)
+			}
+		}
+	}
+	sort.Strings(transfers)
+	sort.Strings(deletes)
+
+	limiter := sync2.NewLimiter(*syncConcurrency)
+	for _, path := range transfers {
+		path, entry := path, source[path]
+		limiter.Go(ctx, func() {
+			syncer.transfer(ctx, path, entry)
+		})
+	}
+	for _, path := range deletes {
+		path := path
+		limiter.Go(ctx, func() {
+			syncer.delete(ctx, path)
+		})
+	}
+	limiter.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	result := syncResult{
+		Source:      src.String(),
+		Destination: dst.String(),
+		Transferred: syncer.transferred,
+		Deleted:     syncer.deleted,
+		UpToDate:    len(source) - len(transfers),
+		Failed:      syncer.failed,
+		DryRun:      *syncDryRun,
+	}
+	if jsonOutput() {
+		if err := printJSON(os.Stdout, result); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("%d transferred, %d deleted, %d up to date\n", result.Transferred, result.Deleted, result.UpToDate)
+	}
+
+	if syncer.failed > 0 {
+		return fmt.Errorf("%d of %d transfers and deletes failed", syncer.failed, len(transfers)+len(deletes))
+	}
+	return nil
+}
+
+// transfer uploads or downloads the file at path relative to the
+// synchronized directory
+func (syncer *synchronizer) transfer(ctx context.Context, path string, entry syncEntry) {
+	operation := "upload"
+	if !syncer.upload {
+		operation = "download"
+	}
+
+	src, dst := syncer.local.Join(filepath.FromSlash(path)), syncer.remotePath(path)
+	if !syncer.upload {
+		src, dst = dst, src
+	}
+
+	var err error
+	if !*syncDryRun {
+		if syncer.upload {
+			err = syncer.uploadFile(ctx, src, dst, entry)
+		} else {
+			err = syncer.downloadFile(ctx, src, dst, entry)
+		}
+	}
+
+	syncer.report(err, fmt.Sprintf("%s %s to %s", operation, src, dst), transferResult{
+		Operation:   operation,
+		Source:      src.String(),
+		Destination: dst.String(),
+		Bytes:       entry.size,
+	})
+}
+
+// delete deletes the file at path relative to the synchronized directory
+// from the destination
+func (syncer *synchronizer) delete(ctx context.Context, path string) {
+	var deleted fpath.FPath
+	var err error
+	if syncer.upload {
+		deleted = syncer.remotePath(path)
+		if !*syncDryRun {
+			err = syncer.metainfo.DeleteObject(ctx, deleted.Bucket(), deleted.Path())
+		}
+	} else {
+		deleted = syncer.local.Join(filepath.FromSlash(path))
+		if !*syncDryRun {
+			err = os.Remove(deleted.Path())
+		}
+	}
+
+	syncer.report(err, fmt.Sprintf("delete %s", deleted), objectResult{
+		Operation: "delete",
+		Bucket:    deleted.Bucket(),
+		Path:      deleted.Path(),
+	})
+}
+
+// report counts and prints the result of a transfer or a delete, which is
+// described by description in the text output
+func (syncer *synchronizer) report(err error, description string, result interface{}) {
+	syncer.mu.Lock()
+	defer syncer.mu.Unlock()
+
+	if err != nil {
+		syncer.failed++
+		fmt.Fprintf(os.Stderr, "%s failed: %v\n", description, err)
+		return
+	}
+
+	if _, ok := result.(objectResult); ok {
+		syncer.deleted++
+	} else {
+		syncer.transferred++
+	}
+
+	if jsonOutput() {
+		_ = printJSON(os.Stdout, result)
+		return
+	}
+
+	if *syncDryRun {
+		description = "(dry run) " + description
+	}
+	fmt.Println(description)
+}
+
+// remotePath returns the Storj URL of the file at path relative to the
+// synchronized prefix
+func (syncer *synchronizer) remotePath(path string) fpath.FPath {
+	return syncer.remote.Join(path)
+}
+
+// uploadFile uploads the local file src to the object dst, keeping its
+// modification time and checksum in the metadata of the object
+func (syncer *synchronizer) uploadFile(ctx context.Context, src, dst fpath.FPath, entry syncEntry) (err error) {
+	file, err := os.Open(src.Path())
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	if entry.checksum == "" {
+		entry.checksum, err = syncFileChecksum(file)
+		if err != nil {
+			return err
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	contentType, reader, err := stream.DetectContentType(dst.Path(), file)
+	if err != nil {
+		return err
+	}
+
+	obj, err := syncer.metainfo.CreateObject(ctx, dst.Bucket(), dst.Path(), &storj.CreateObject{
+		ContentType: contentType,
+		Metadata: map[string]string{
+			syncModifiedKey: entry.modified.UTC().Format(time.RFC3339Nano),
+			syncChecksumKey: entry.checksum,
+		},
+		RedundancyScheme: cfg.GetRedundancyScheme(),
+		EncryptionScheme: cfg.GetEncryptionScheme(),
+	})
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	_, err = uploadStream(ctx, syncer.streams, obj, reader)
+	return err
+}
+
+// downloadFile downloads the object src to the local file dst and sets the
+// modification time of the file to the one of the object
+func (syncer *synchronizer) downloadFile(ctx context.Context, src, dst fpath.FPath, entry syncEntry) (err error) {
+	if err := os.MkdirAll(filepath.Dir(dst.Path()), 0755); err != nil {
+		return err
+	}
+
+	download, err := stream.NewReader(ctx, syncer.metainfo, syncer.streams, src.Bucket(), src.Path())
+	if err != nil {
+		return convertError(err, src)
+	}
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	file, err := os.Create(dst.Path())
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, download)
+	if err = errs.Combine(err, file.Close()); err != nil {
+		return err
+	}
+
+	return os.Chtimes(dst.Path(), entry.modified, entry.modified)
+}
+
+// listSyncFiles returns the regular files below dir by their slash separated
+// path relative to dir, their checksums are only calculated when checksum
+// is set
+func listSyncFiles(dir string, checksum bool) (map[string]syncEntry, error) {
+	files := make(map[string]syncEntry)
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return files, nil
+	}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		entry := syncEntry{size: info.Size(), modified: info.ModTime()}
+		if checksum {
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			entry.checksum, err = syncFileChecksum(file)
+			if err = errs.Combine(err, file.Close()); err != nil {
+				return err
+			}
+		}
+
+		files[filepath.ToSlash(rel)] = entry
+		return nil
+	})
+	return files, err
+}
+
+// listSyncObjects returns the objects below prefix by their path relative to
+// prefix, the modification times and checksums of the uploaded files are
+// taken from the metadata of the objects
+func listSyncObjects(ctx context.Context, metainfo storj.Metainfo, prefix fpath.FPath) (map[string]syncEntry, error) {
+	objects := make(map[string]syncEntry)
+
+	startAfter := ""
+	for {
+		list, err := metainfo.ListObjects(ctx, prefix.Bucket(), storj.ListOptions{
+			Direction: storj.After,
+			Cursor:    startAfter,
+			Prefix:    prefix.Path(),
+			Recursive: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range list.Items {
+			if object.IsPrefix {
+				continue
+			}
+
+			entry := syncEntry{
+				size:     object.Size,
+				modified: object.Modified,
+				checksum: object.Metadata[syncChecksumKey],
+			}
+			if modified, err := time.Parse(time.RFC3339Nano, object.Metadata[syncModifiedKey]); err == nil {
+				entry.modified = modified
+			}
+			objects[path.Clean(object.Path)] = entry
+		}
+
+		if !list.More || len(list.Items) == 0 {
+			break
+		}
+		startAfter = list.Items[len(list.Items)-1].Path
+	}
+
+	return objects, nil
+}
+
+// syncFileChecksum returns the hex sha256 checksum of the rest of file
+func syncFileChecksum(file *os.File) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+)
+
+func TestSyncEntryUpToDate(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Second)
+
+	for i, tt := range []struct {
+		entry    syncEntry
+		source   syncEntry
+		checksum bool
+		upToDate bool
+	}{
+		{ // same size and modification time
+			entry:    syncEntry{size: 10, modified: now},
+			source:   syncEntry{size: 10, modified: now},
+			upToDate: true,
+		},
+		{ // different size
+			entry:  syncEntry{size: 10, modified: now},
+			source: syncEntry{size: 11, modified: now},
+		},
+		{ // different modification time
+			entry:  syncEntry{size: 10, modified: now},
+			source: syncEntry{size: 10, modified: later},
+		},
+		{ // same modification time in another location
+			entry:    syncEntry{size: 10, modified: now},
+			source:   syncEntry{size: 10, modified: now.UTC()},
+			upToDate: true,
+		},
+		{ // same checksum, modification time is ignored
+			entry:    syncEntry{size: 10, modified: now, checksum: "abc"},
+			source:   syncEntry{size: 10, modified: later, checksum: "abc"},
+			checksum: true,
+			upToDate: true,
+		},
+		{ // different checksum
+			entry:    syncEntry{size: 10, modified: now, checksum: "abc"},
+			source:   syncEntry{size: 10, modified: now, checksum: "def"},
+			checksum: true,
+		},
+		{ // unknown checksum of the source
+			entry:    syncEntry{size: 10, modified: now},
+			source:   syncEntry{size: 10, modified: now},
+			checksum: true,
+		},
+		{ // same checksum, different size
+			entry:    syncEntry{size: 10, modified: now, checksum: "abc"},
+			source:   syncEntry{size: 11, modified: now, checksum: "abc"},
+			checksum: true,
+		},
+	} {
+		assert.Equal(t, tt.upToDate, tt.entry.upToDate(tt.source, tt.checksum), "#%d", i)
+	}
+}
+
+func TestListSyncFiles(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	modified := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	contents := map[string]string{
+		"a.txt":       "hello",
+		"sub/b.txt":   "storj",
+		"sub/c/d.txt": "",
+	}
+	for name, content := range contents {
+		path := ctx.File(append([]string{"root"}, strings.Split(name, "/")...)...)
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+		require.NoError(t, os.Chtimes(path, modified, modified))
+	}
+	ctx.Dir("root", "empty")
+
+	files, err := listSyncFiles(ctx.Dir("root"), false)
+	require.NoError(t, err)
+	require.Len(t, files, len(contents))
+	for name, content := range contents {
+		entry, ok := files[name]
+		require.True(t, ok, name)
+		assert.Equal(t, int64(len(content)), entry.size, name)
+		assert.True(t, entry.modified.Equal(modified), name)
+		assert.Empty(t, entry.checksum, name)
+	}
+
+	files, err = listSyncFiles(ctx.Dir("root"), true)
+	require.NoError(t, err)
+	require.Len(t, files, len(contents))
+	for name, content := range contents {
+		sum := sha256.Sum256([]byte(content))
+		assert.Equal(t, hex.EncodeToString(sum[:]), files[name].checksum, name)
+	}
+
+	files, err = listSyncFiles(ctx.File("missing"), true)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
@@ -0,0 +1,324 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/crypto/ssh/terminal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/miniogw"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb/pdbclient"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/pkg/utils"
+)
+
+const (
+	// dialTimeout is how long the wizard waits for the satellite to answer
+	dialTimeout = 20 * time.Second
+	// generatedKeySize is the number of random bytes of generated passphrases,
+	// which encode to the 32 bytes of an encryption key
+	generatedKeySize = 20
+	// setupTestBucket is the bucket the end-to-end test uploads to
+	setupTestBucket = "uplink-setup-test"
+)
+
+// wizard asks for the setup values interactively
+type wizard struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// newWizard creates a wizard asking on the standard input and output
+func newWizard() *wizard {
+	return &wizard{
+		in:  bufio.NewReader(os.Stdin),
+		out: os.Stdout,
+	}
+}
+
+// ask returns the answer to question, or defaultValue when the answer is empty
+func (w *wizard) ask(question, defaultValue string) (string, error) {
+	if defaultValue != "" {
+		fmt.Fprintf(w.out, "%s [%s]: ", question, defaultValue)
+	} else {
+		fmt.Fprintf(w.out, "%s: ", question)
+	}
+
+	answer, err := w.in.ReadString('\n')
+	if err != nil && !(err == io.EOF && answer != "") {
+		return "", err
+	}
+
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return defaultValue, nil
+	}
+	return answer, nil
+}
+
+// askSecret returns the answer to question without echoing it when the
+// standard input is a terminal
+func (w *wizard) askSecret(question string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return w.ask(question, "")
+	}
+
+	fmt.Fprintf(w.out, "%s: ", question)
+	answer, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(w.out)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(answer)), nil
+}
+
+// confirm returns whether question was answered with yes
+func (w *wizard) confirm(question string) (bool, error) {
+	answer, err := w.ask(question+" (y/n)", "y")
+	if err != nil {
+		return false, err
+	}
+	return strings.HasPrefix(strings.ToLower(answer), "y"), nil
+}
+
+// run asks for the satellite, api key and encryption passphrase and checks
+// them, the answers are stored in config
+func (w *wizard) run(ctx context.Context, config *UplinkFlags, identityDir string) error {
+	ident, err := identity.Config{
+		CertPath: filepath.Join(identityDir, "identity.cert"),
+		KeyPath:  filepath.Join(identityDir, "identity.key"),
+	}.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load the uplink identity from %s, create one first: %v", identityDir, err)
+	}
+
+	for {
+		nodeURL, err := w.ask("Satellite address, as <node id>@<host>:<port> to verify its identity", config.SatelliteAddr)
+		if err != nil {
+			return err
+		}
+		address, err := checkSatellite(ctx, ident, nodeURL)
+		if err == nil {
+			config.SatelliteAddr = address
+			break
+		}
+		fmt.Fprintf(w.out, "Failed to reach the satellite: %v\n", err)
+	}
+
+	for {
+		apiKey, err := w.ask("API key", config.APIKey)
+		if err != nil {
+			return err
+		}
+		err = checkAPIKey(ctx, ident, config.SatelliteAddr, apiKey)
+		if err == nil {
+			config.APIKey = apiKey
+			break
+		}
+		fmt.Fprintf(w.out, "The API key can't be used: %v\n", err)
+	}
+
+	for config.Enc.Key == "" {
+		passphrase, err := w.askSecret("Encryption passphrase, empty to generate one")
+		if err != nil {
+			return err
+		}
+
+		if passphrase == "" {
+			passphrase, err = generatePassphrase()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w.out, "Your encryption passphrase is\n\n    %s\n\n", passphrase)
+			fmt.Fprintln(w.out, "Store it safely, your data can't be decrypted without it.")
+			saved, err := w.confirm("Did you store the passphrase?")
+			if err != nil {
+				return err
+			}
+			if saved {
+				config.Enc.Key = passphrase
+			}
+			continue
+		}
+
+		confirmation, err := w.askSecret("Enter the encryption passphrase again")
+		if err != nil {
+			return err
+		}
+		if confirmation != passphrase {
+			fmt.Fprintln(w.out, "The passphrases don't match.")
+			continue
+		}
+		if len(passphrase) > storj.KeySize {
+			fmt.Fprintf(w.out, "Only the first %d bytes of the passphrase are used.\n", storj.KeySize)
+		}
+		config.Enc.Key = passphrase
+	}
+
+	return nil
+}
+
+// splitNodeURL splits a node URL of the form <node id>@<address> into its
+// parts, the node id is zero when the URL is only an address
+func splitNodeURL(nodeURL string) (id storj.NodeID, address string, err error) {
+	i := strings.LastIndex(nodeURL, "@")
+	if i < 0 {
+		return storj.NodeID{}, nodeURL, nil
+	}
+	id, err = storj.NodeIDFromString(nodeURL[:i])
+	if err != nil {
+		return storj.NodeID{}, "", fmt.Errorf("invalid node id: %v", err)
+	}
+	return id, nodeURL[i+1:], nil
+}
+
+// checkSatellite dials the satellite at nodeURL and verifies its identity
+// when the URL contains its node id, the address of the satellite is returned
+func checkSatellite(ctx context.Context, ident *identity.FullIdentity, nodeURL string) (address string, err error) {
+	id, address, err := splitNodeURL(nodeURL)
+	if err != nil {
+		return "", err
+	}
+	if address == "" {
+		return "", fmt.Errorf("missing address")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	client := transport.NewClient(ident)
+	if id.IsZero() {
+		fmt.Println("The identity of the satellite isn't verified without its node id.")
+		conn, err := client.DialAddress(ctx, address)
+		if err != nil {
+			return "", err
+		}
+		return address, conn.Close()
+	}
+
+	conn, err := client.DialNode(ctx, &pb.Node{
+		Id:   id,
+		Type: pb.NodeType_SATELLITE,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   address,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return address, conn.Close()
+}
+
+// checkAPIKey verifies that the satellite at address accepts apiKey
+func checkAPIKey(ctx context.Context, ident *identity.FullIdentity, address, apiKey string) error {
+	if apiKey == "" {
+		return fmt.Errorf("missing API key")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	pdb, err := pdbclient.NewClientContext(ctx, ident, address, apiKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = pdb.BucketTemplate(ctx, "")
+	switch status.Code(errs.Unwrap(err)) {
+	case codes.Unauthenticated:
+		return fmt.Errorf("the satellite rejected the API key")
+	case codes.PermissionDenied:
+		// restricted keys may not be allowed to create buckets
+		return nil
+	}
+	return err
+}
+
+// generatePassphrase returns a random passphrase as long as an encryption key
+func generatePassphrase() (string, error) {
+	var random [generatedKeySize]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.EncodeToString(random[:]), nil
+}
+
+// testUpload uploads, downloads and deletes a small object with config to
+// verify that it works end-to-end
+func testUpload(ctx context.Context, config miniogw.Config) (err error) {
+	ident, err := config.Identity.Load()
+	if err != nil {
+		return err
+	}
+
+	metainfo, streams, err := config.GetMetainfo(ctx, ident)
+	if err != nil {
+		return err
+	}
+
+	_, err = metainfo.GetBucket(ctx, setupTestBucket)
+	if storj.ErrBucketNotFound.Has(err) {
+		_, err = metainfo.CreateBucket(ctx, setupTestBucket, &storj.Bucket{PathCipher: storj.Cipher(config.Enc.PathType)})
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, metainfo.DeleteBucket(ctx, setupTestBucket)) }()
+	} else if err != nil {
+		return err
+	}
+
+	data := make([]byte, config.Client.MaxInlineSize.Int()+1)
+	if _, err := rand.Read(data); err != nil {
+		return err
+	}
+	path := fmt.Sprintf("test-%d", time.Now().UnixNano())
+
+	obj, err := metainfo.CreateObject(ctx, setupTestBucket, path, &storj.CreateObject{
+		ContentType:      "application/octet-stream",
+		RedundancyScheme: config.GetRedundancyScheme(),
+		EncryptionScheme: config.GetEncryptionScheme(),
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := uploadStream(ctx, streams, obj, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("upload failed: %v", err)
+	}
+	defer func() { err = errs.Combine(err, metainfo.DeleteObject(ctx, setupTestBucket, path)) }()
+
+	download, err := stream.NewReader(ctx, metainfo, streams, setupTestBucket, path)
+	if err != nil {
+		return err
+	}
+	downloaded, err := ioutil.ReadAll(download)
+	err = utils.CombineErrors(err, download.Close())
+	if err != nil {
+		return fmt.Errorf("download failed: %v", err)
+	}
+	if !bytes.Equal(data, downloaded) {
+		return fmt.Errorf("downloaded data differs from the uploaded data")
+	}
+	return nil
+}
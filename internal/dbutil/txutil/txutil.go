@@ -0,0 +1,115 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package txutil
+
+import (
+	"context"
+	"database/sql"
+	"math/rand"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+)
+
+var mon = monkit.Package()
+
+const (
+	// errSerializationFailure is returned by postgres and cockroach when a
+	// transaction conflicted with a concurrent one
+	errSerializationFailure = "40001"
+	// errDeadlockDetected is returned by postgres when a transaction was
+	// aborted to resolve a deadlock
+	errDeadlockDetected = "40P01"
+
+	// maxUnwrap limits how many causes are followed looking for the database error
+	maxUnwrap = 100
+)
+
+// Config defines how often and how fast failed transactions are retried
+type Config struct {
+	// MaxRetries is how many times a transaction is retried before giving up
+	MaxRetries int
+	// Backoff is the delay before the first retry, it doubles with every further retry
+	Backoff time.Duration
+	// MaxBackoff limits the delay between two retries
+	MaxBackoff time.Duration
+}
+
+// DefaultConfig is the retry config used for the satellite database
+var DefaultConfig = Config{
+	MaxRetries: 5,
+	Backoff:    10 * time.Millisecond,
+	MaxBackoff: time.Second,
+}
+
+// Retry calls fn until it succeeds or fails with an error which can't be
+// retried. It gives up after config.MaxRetries retries, when ctx is canceled,
+// or when the deadline of ctx would pass before the next retry.
+// fn must not have any side effects which are kept when it fails.
+func Retry(ctx context.Context, config Config, fn func(ctx context.Context) error) (err error) {
+	backoff := config.Backoff
+	for retry := 0; ; retry++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err = fn(ctx)
+		if err == nil || retry >= config.MaxRetries || !IsRetryable(err) {
+			return err
+		}
+
+		// wait a random time in [backoff/2, backoff), so the conflicting
+		// transactions don't conflict again
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return err
+		}
+
+		mon.Event("tx_retry")
+		if !sync2.Sleep(ctx, wait) {
+			return errs.Combine(err, ctx.Err())
+		}
+
+		backoff *= 2
+		if config.MaxBackoff > 0 && backoff > config.MaxBackoff {
+			backoff = config.MaxBackoff
+		}
+	}
+}
+
+// WithTx runs fn in a transaction of db and commits it, the transaction is
+// retried with config when it fails with an error which can be retried
+func WithTx(ctx context.Context, db *sql.DB, config Config, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	return Retry(ctx, config, func(ctx context.Context) error {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if err := fn(ctx, tx); err != nil {
+			return errs.Combine(err, tx.Rollback())
+		}
+		return tx.Commit()
+	})
+}
+
+// IsRetryable returns whether err was caused by a transaction which
+// conflicted with a concurrent one or was aborted to resolve a deadlock,
+// running it again from the start may succeed
+func IsRetryable(err error) bool {
+	for i := 0; err != nil && i < maxUnwrap; i++ {
+		switch e := err.(type) {
+		case *pq.Error:
+			return e.Code == errSerializationFailure || e.Code == errDeadlockDetected
+		case interface{ Cause() error }:
+			err = e.Cause()
+		default:
+			return false
+		}
+	}
+	return false
+}
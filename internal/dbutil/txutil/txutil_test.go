@@ -0,0 +1,90 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package txutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/dbutil/txutil"
+)
+
+var testConfig = txutil.Config{
+	MaxRetries: 3,
+	Backoff:    time.Millisecond,
+	MaxBackoff: 2 * time.Millisecond,
+}
+
+func TestIsRetryable(t *testing.T) {
+	conflict := &pq.Error{Code: "40001"}
+	deadlock := &pq.Error{Code: "40P01"}
+	violation := &pq.Error{Code: "23505"}
+	class := errs.Class("test")
+
+	assert.True(t, txutil.IsRetryable(conflict))
+	assert.True(t, txutil.IsRetryable(deadlock))
+	assert.True(t, txutil.IsRetryable(class.Wrap(deadlock)))
+
+	assert.False(t, txutil.IsRetryable(nil))
+	assert.False(t, txutil.IsRetryable(violation))
+	assert.False(t, txutil.IsRetryable(errors.New("40001")))
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+	conflict := &pq.Error{Code: "40001"}
+
+	t.Run("succeeds after conflicts", func(t *testing.T) {
+		calls := 0
+		err := txutil.Retry(ctx, testConfig, func(ctx context.Context) error {
+			calls++
+			if calls < 3 {
+				return conflict
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		calls := 0
+		err := txutil.Retry(ctx, testConfig, func(ctx context.Context) error {
+			calls++
+			return conflict
+		})
+		assert.Equal(t, conflict, err)
+		assert.Equal(t, testConfig.MaxRetries+1, calls)
+	})
+
+	t.Run("doesn't retry other errors", func(t *testing.T) {
+		calls := 0
+		failure := errors.New("failure")
+		err := txutil.Retry(ctx, testConfig, func(ctx context.Context) error {
+			calls++
+			return failure
+		})
+		assert.Equal(t, failure, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("doesn't wait past the deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Millisecond)
+		defer cancel()
+
+		calls := 0
+		err := txutil.Retry(ctx, txutil.Config{MaxRetries: 3, Backoff: time.Hour}, func(ctx context.Context) error {
+			calls++
+			return conflict
+		})
+		assert.Equal(t, conflict, err)
+		assert.Equal(t, 1, calls)
+	})
+}
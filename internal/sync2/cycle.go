@@ -0,0 +1,177 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package sync2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Cycle implements a controllable recurring event.
+//
+// Cycle control methods don't have any effect after the cycle has completed.
+type Cycle struct {
+	interval time.Duration
+
+	init      sync.Once
+	closeOnce sync.Once
+	control   chan interface{}
+	closing   chan struct{}
+	stopped   chan struct{}
+}
+
+type (
+	// cycle control messages
+	cyclePause          struct{}
+	cycleContinue       struct{}
+	cycleChangeInterval struct{ interval time.Duration }
+	cycleTrigger        struct{ done chan struct{} }
+)
+
+// NewCycle creates a new cycle with the specified interval.
+func NewCycle(interval time.Duration) *Cycle {
+	cycle := &Cycle{}
+	cycle.SetInterval(interval)
+	return cycle
+}
+
+// SetInterval allows to change the interval before starting.
+func (cycle *Cycle) SetInterval(interval time.Duration) {
+	cycle.interval = interval
+}
+
+// initialize sets up the channels on first use, this allows
+// using the zero value of Cycle
+func (cycle *Cycle) initialize() {
+	cycle.init.Do(func() {
+		cycle.control = make(chan interface{})
+		cycle.closing = make(chan struct{})
+		cycle.stopped = make(chan struct{})
+	})
+}
+
+// Start runs the specified function with an errgroup.
+func (cycle *Cycle) Start(ctx context.Context, group *errgroup.Group, fn func(ctx context.Context) error) {
+	group.Go(func() error {
+		return cycle.Run(ctx, fn)
+	})
+}
+
+// Run runs fn immediately and then every interval until the context is
+// canceled, the cycle is closed or fn returns an error.
+//
+// When fn is slower than the interval, some of the executions are skipped.
+func (cycle *Cycle) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	cycle.initialize()
+	defer close(cycle.stopped)
+
+	currentInterval := cycle.interval
+	ticker := time.NewTicker(currentInterval)
+	defer func() { ticker.Stop() }()
+
+	select {
+	case <-cycle.closing:
+		return nil
+	default:
+	}
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case message := <-cycle.control:
+			switch message := message.(type) {
+			case cyclePause:
+				ticker.Stop()
+
+			case cycleContinue:
+				ticker.Stop()
+				ticker = time.NewTicker(currentInterval)
+
+			case cycleChangeInterval:
+				currentInterval = message.interval
+				ticker.Stop()
+				ticker = time.NewTicker(currentInterval)
+
+			case cycleTrigger:
+				err := fn(ctx)
+				if message.done != nil {
+					close(message.done)
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				return err
+			}
+
+		case <-cycle.closing:
+			return nil
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Close stops the cycle permanently, it's safe to call even when the cycle
+// has never been started.
+func (cycle *Cycle) Close() {
+	cycle.initialize()
+	cycle.closeOnce.Do(func() {
+		close(cycle.closing)
+	})
+}
+
+// sendControl sends a control message to the running cycle
+func (cycle *Cycle) sendControl(message interface{}) {
+	cycle.initialize()
+	select {
+	case cycle.control <- message:
+	case <-cycle.closing:
+	case <-cycle.stopped:
+	}
+}
+
+// ChangeInterval allows to change the ticker interval after it has started.
+func (cycle *Cycle) ChangeInterval(interval time.Duration) {
+	cycle.sendControl(cycleChangeInterval{interval})
+}
+
+// Pause pauses the cycle, it can still be triggered manually.
+func (cycle *Cycle) Pause() {
+	cycle.sendControl(cyclePause{})
+}
+
+// Restart restarts the ticker from 0.
+func (cycle *Cycle) Restart() {
+	cycle.sendControl(cycleContinue{})
+}
+
+// Trigger ensures that fn is run at least once more.
+// When fn is currently running it waits for it to complete before triggering.
+func (cycle *Cycle) Trigger() {
+	cycle.sendControl(cycleTrigger{})
+}
+
+// TriggerWait ensures that fn is run at least once more and waits for it to complete.
+// When fn is currently running it waits for it to complete before triggering.
+func (cycle *Cycle) TriggerWait() {
+	done := make(chan struct{})
+	cycle.sendControl(cycleTrigger{done})
+
+	select {
+	case <-done:
+	case <-cycle.closing:
+	case <-cycle.stopped:
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package sync2_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/sync2"
+)
+
+func TestCycle_Basic(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var count int64
+	cycle := sync2.NewCycle(time.Millisecond)
+
+	var group errgroup.Group
+	cycle.Start(ctx, &group, func(ctx context.Context) error {
+		atomic.AddInt64(&count, 1)
+		return nil
+	})
+
+	// wait for the ticker to run a few times
+	for atomic.LoadInt64(&count) < 5 {
+		time.Sleep(time.Millisecond)
+	}
+
+	cycle.Close()
+	if err := group.Wait(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCycle_PauseTrigger(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var count int64
+	cycle := sync2.NewCycle(time.Hour)
+
+	var group errgroup.Group
+	cycle.Start(ctx, &group, func(ctx context.Context) error {
+		atomic.AddInt64(&count, 1)
+		return nil
+	})
+
+	cycle.Pause()
+	// the first run happens on start
+	if n := atomic.LoadInt64(&count); n != 1 {
+		t.Fatalf("expected 1 run, got %d", n)
+	}
+
+	cycle.TriggerWait()
+	cycle.TriggerWait()
+	if n := atomic.LoadInt64(&count); n != 3 {
+		t.Fatalf("expected 3 runs, got %d", n)
+	}
+
+	cycle.ChangeInterval(time.Millisecond)
+	for atomic.LoadInt64(&count) < 5 {
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	if err := group.Wait(); err != context.Canceled {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+
+	// control methods don't block after the cycle has completed
+	cycle.TriggerWait()
+	cycle.Pause()
+	cycle.Close()
+}
+
+func TestCycle_Error(t *testing.T) {
+	failure := errors.New("failure")
+
+	var count int64
+	cycle := sync2.NewCycle(time.Millisecond)
+	err := cycle.Run(context.Background(), func(ctx context.Context) error {
+		if atomic.AddInt64(&count, 1) >= 3 {
+			return failure
+		}
+		return nil
+	})
+	if err != failure {
+		t.Fatalf("expected failure, got %v", err)
+	}
+}
+
+func TestCycle_CloseBeforeRun(t *testing.T) {
+	cycle := sync2.NewCycle(time.Millisecond)
+	cycle.Close()
+
+	err := cycle.Run(context.Background(), func(ctx context.Context) error {
+		t.Fatal("closed cycle should not run")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package sync2
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitBurst is how far ahead of the rate transfers may get, so
+	// short pauses don't reduce the average rate
+	rateLimitBurst = 100 * time.Millisecond
+	// rateLimitChunk is the most a limited reader reads at once
+	rateLimitChunk = 32 * 1024
+)
+
+// RateLimiter limits the rate of bytes transferred by all the readers
+// sharing it. A nil RateLimiter doesn't limit the rate.
+type RateLimiter struct {
+	rate int64 // bytes per second
+
+	mu   sync.Mutex
+	next time.Time // when the transferred bytes are within the rate
+}
+
+// NewRateLimiter creates a limiter of bytesPerSecond, it returns nil when
+// bytesPerSecond isn't positive
+func NewRateLimiter(bytesPerSecond int64) *RateLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return &RateLimiter{rate: bytesPerSecond}
+}
+
+// Wait accounts n transferred bytes and waits until the transfers are
+// within the rate again or ctx is done
+func (limiter *RateLimiter) Wait(ctx context.Context, n int) error {
+	if limiter == nil || n <= 0 {
+		return nil
+	}
+
+	limiter.mu.Lock()
+	now := time.Now()
+	if limiter.next.Before(now) {
+		limiter.next = now
+	}
+	limiter.next = limiter.next.Add(time.Duration(int64(n) * int64(time.Second) / limiter.rate))
+	wait := limiter.next.Sub(now) - rateLimitBurst
+	limiter.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	if !Sleep(ctx, wait) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// LimitReader returns a reader of r, which reads at the rate of limiter
+func LimitReader(ctx context.Context, limiter *RateLimiter, r io.Reader) io.Reader {
+	if limiter == nil {
+		return r
+	}
+	return &limitedReader{ctx: ctx, limiter: limiter, reader: r}
+}
+
+// LimitReadCloser returns a reader of r, which reads at the rate of limiter
+func LimitReadCloser(ctx context.Context, limiter *RateLimiter, r io.ReadCloser) io.ReadCloser {
+	if limiter == nil {
+		return r
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{LimitReader(ctx, limiter, r), r}
+}
+
+// limitedReader reads at the rate of a limiter
+type limitedReader struct {
+	ctx     context.Context
+	limiter *RateLimiter
+	reader  io.Reader
+}
+
+// Read implements io.Reader
+func (r *limitedReader) Read(p []byte) (n int, err error) {
+	if len(p) > rateLimitChunk {
+		p = p[:rateLimitChunk]
+	}
+	n, err = r.reader.Read(p)
+	if waitErr := r.limiter.Wait(r.ctx, n); waitErr != nil && err == nil {
+		err = waitErr
+	}
+	return n, err
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package sync2_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"storj.io/storj/internal/sync2"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	limiter := sync2.NewRateLimiter(200 * 1024)
+
+	start := time.Now()
+
+	// the readers share the rate
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reader := sync2.LimitReader(ctx, limiter, bytes.NewReader(make([]byte, 150*1024)))
+			n, err := io.Copy(ioutil.Discard, reader)
+			if err != nil || n != 150*1024 {
+				t.Errorf("read %d bytes: %v", n, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 300KiB at 200KiB/s take 1.5s, less the burst
+	elapsed := time.Since(start)
+	if elapsed < time.Second || elapsed > 5*time.Second {
+		t.Errorf("limited reads took %v", elapsed)
+	}
+}
+
+func TestRateLimiter_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	limiter := sync2.NewRateLimiter(1024)
+	reader := sync2.LimitReader(ctx, limiter, bytes.NewReader(make([]byte, 64*1024)))
+
+	start := time.Now()
+	_, err := io.Copy(ioutil.Discard, reader)
+	if err != context.Canceled {
+		t.Errorf("expected canceled read, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("canceled read took too long")
+	}
+}
+
+func TestRateLimiter_Unlimited(t *testing.T) {
+	if sync2.NewRateLimiter(0) != nil {
+		t.Error("expected no limiter")
+	}
+
+	reader := bytes.NewReader(nil)
+	if sync2.LimitReader(context.Background(), nil, reader) != reader {
+		t.Error("expected the reader to be returned unchanged")
+	}
+}
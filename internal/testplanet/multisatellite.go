@@ -0,0 +1,103 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package testplanet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+)
+
+// SatelliteByID returns the satellite with the id, nil when the planet
+// doesn't have it
+func (planet *Planet) SatelliteByID(id storj.NodeID) *satellite.Peer {
+	for _, satellite := range planet.Satellites {
+		if satellite.ID() == id {
+			return satellite
+		}
+	}
+	return nil
+}
+
+// RegisterStorageNodes adds every storage node to the overlay of every
+// satellite, so the nodes can be selected without waiting for discovery
+func (planet *Planet) RegisterStorageNodes(ctx context.Context) error {
+	var group errs.Group
+	for _, satellite := range planet.Satellites {
+		for _, storageNode := range planet.StorageNodes {
+			group.Add(satellite.Overlay.Service.Put(ctx, storageNode.ID(), storageNode.Local()))
+		}
+	}
+	return group.Err()
+}
+
+// SettleAgreements sends the unsent bandwidth agreements of every storage
+// node to the satellites they were signed by, regardless of the batch window
+func (planet *Planet) SettleAgreements(ctx context.Context) error {
+	for _, storageNode := range planet.StorageNodes {
+		agreementGroups, err := storageNode.DB.PSDB().GetBandwidthAllocations()
+		if err != nil {
+			return err
+		}
+		for satelliteID, agreements := range agreementGroups {
+			storageNode.Agreements.Sender.SendAgreementsToSatellite(ctx, satelliteID, agreements)
+		}
+	}
+	return nil
+}
+
+// SettledBandwidth returns the bandwidth totals of each storage node which
+// satellite has settled since
+func (planet *Planet) SettledBandwidth(ctx context.Context, satellite *satellite.Peer, since time.Time) (map[storj.NodeID][]int64, error) {
+	return satellite.DB.BandwidthAgreement().GetTotals(ctx, since, time.Now())
+}
+
+// Reputations returns the statistics every satellite keeps about the node,
+// satellites which don't know the node are missing
+func (planet *Planet) Reputations(ctx context.Context, nodeID storj.NodeID) map[storj.NodeID]*statdb.NodeStats {
+	reputations := make(map[storj.NodeID]*statdb.NodeStats)
+	for _, satellite := range planet.Satellites {
+		stats, err := satellite.DB.StatDB().Get(ctx, nodeID)
+		if err != nil {
+			continue
+		}
+		reputations[satellite.ID()] = stats
+	}
+	return reputations
+}
+
+// RequireIsolated checks that the traffic and audits of the active
+// satellites didn't leak to the other satellites: none of them has settled
+// bandwidth since since, nor audited any storage node
+func (planet *Planet) RequireIsolated(ctx context.Context, t testing.TB, active []*satellite.Peer, since time.Time) {
+	isActive := make(map[storj.NodeID]bool)
+	for _, satellite := range active {
+		isActive[satellite.ID()] = true
+	}
+
+	for _, satellite := range planet.Satellites {
+		if isActive[satellite.ID()] {
+			continue
+		}
+
+		totals, err := planet.SettledBandwidth(ctx, satellite, since)
+		require.NoError(t, err)
+		require.Empty(t, totals, "satellite %s settled bandwidth", satellite.ID())
+
+		for _, storageNode := range planet.StorageNodes {
+			stats, err := satellite.DB.StatDB().Get(ctx, storageNode.ID())
+			if err != nil {
+				continue
+			}
+			require.Zero(t, stats.AuditCount, "satellite %s audited storage node %s", satellite.ID(), storageNode.ID())
+		}
+	}
+}
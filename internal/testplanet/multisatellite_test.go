@@ -0,0 +1,71 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package testplanet_test
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/reputation"
+	"storj.io/storj/satellite"
+)
+
+func TestMultipleSatellites(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 2, 6, 1)
+	require.NoError(t, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+	start := time.Now().Add(-time.Minute)
+
+	require.NoError(t, planet.RegisterStorageNodes(ctx))
+	active, idle := planet.Satellites[0], planet.Satellites[1]
+	assert.Equal(t, idle, planet.SatelliteByID(idle.ID()))
+
+	// every satellite knows every storage node
+	for _, storageNode := range planet.StorageNodes {
+		assert.Len(t, planet.Reputations(ctx, storageNode.ID()), len(planet.Satellites))
+	}
+
+	data := make([]byte, 100*memory.KiB)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+
+	err = planet.Uplinks[0].Upload(ctx, active, "test/bucket", "test/path", data)
+	require.NoError(t, err)
+
+	{ // the uploaded data is only settled with the satellite it was uploaded through
+		require.NoError(t, planet.SettleAgreements(ctx))
+
+		totals, err := planet.SettledBandwidth(ctx, active, start)
+		require.NoError(t, err)
+		assert.NotEmpty(t, totals)
+
+		planet.RequireIsolated(ctx, t, []*satellite.Peer{active}, start)
+	}
+
+	{ // audits only change the reputation on the auditing satellite
+		audited := planet.StorageNodes[0].ID()
+		_, err := active.DB.StatDB().UpdateAuditSuccess(ctx, audited, false, reputation.Model{
+			Alpha0: 1, Lambda: 1, Weight: 1,
+		})
+		require.NoError(t, err)
+
+		reputations := planet.Reputations(ctx, audited)
+		assert.Equal(t, int64(1), reputations[active.ID()].AuditCount)
+		assert.Equal(t, int64(0), reputations[idle.ID()].AuditCount)
+
+		planet.RequireIsolated(ctx, t, []*satellite.Peer{active}, start)
+	}
+}
@@ -25,28 +25,40 @@ import (
 	"storj.io/storj/bootstrap"
 	"storj.io/storj/bootstrap/bootstrapdb"
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/accounting/rollup"
 	"storj.io/storj/pkg/accounting/tally"
 	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/broadcast"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/datarepair/checker"
 	"storj.io/storj/pkg/datarepair/repairer"
 	"storj.io/storj/pkg/discovery"
+	"storj.io/storj/pkg/gc"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/lifecycle"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/peertls"
 	"storj.io/storj/pkg/piecestore/psserver"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consolepurge"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/mockpayments"
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/pairing"
+	"storj.io/storj/storagenode/preflight"
 	"storj.io/storj/storagenode/storagenodedb"
+	"storj.io/storj/storagenode/trust"
 )
 
 // Peer represents one of StorageNode or Satellite
@@ -357,12 +369,12 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 			Overlay: overlay.Config{
 				RefreshInterval: 30 * time.Second,
 				Node: overlay.NodeSelectionConfig{
-					UptimeRatio:           0,
-					UptimeCount:           0,
-					AuditSuccessRatio:     0,
-					AuditCount:            0,
 					NewNodeAuditThreshold: 0,
-					NewNodePercentage:     0,
+					NewNodeFraction:       0,
+				},
+				Ping: overlay.PingConfig{
+					Decay:            0.5,
+					OfflineThreshold: 0.5,
 				},
 			},
 			Discovery: discovery.Config{
@@ -370,6 +382,33 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				DiscoveryInterval: 1 * time.Second,
 				RefreshInterval:   1 * time.Second,
 				RefreshLimit:      100,
+				BootstrapInterval: 1 * time.Minute,
+			},
+			Broadcast: broadcast.Config{
+				ContactWindow: 24 * time.Hour,
+				Concurrency:   4,
+				MaxAttempts:   2,
+				RetryInterval: time.Second,
+				Timeout:       10 * time.Second,
+			},
+			Reputation: reputation.Config{
+				AuditAlpha0:  1,
+				AuditBeta0:   0,
+				AuditLambda:  0.95,
+				AuditWeight:  1,
+				AuditDQ:      0.6,
+				UptimeAlpha0: 1,
+				UptimeBeta0:  0,
+				UptimeLambda: 0.99,
+				UptimeWeight: 1,
+				UptimeDQ:     0.6,
+				Selection: reputation.SelectionConfig{
+					MinAuditScore:      0.6,
+					MinUptimeScore:     0.6,
+					VettingAuditCount:  0,
+					PreferenceExponent: 1,
+					Oversampling:       2,
+				},
 			},
 			PointerDB: pointerdb.Config{
 				DatabaseURL:          "bolt://" + filepath.Join(storageDir, "pointers.db"),
@@ -377,10 +416,15 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				MaxInlineSegmentSize: 8000,
 				Overlay:              true,
 				BwExpiration:         45,
+				Loop: pointerdb.LoopConfig{
+					CoalesceDuration: time.Second,
+				},
 			},
 			BwAgreement: bwagreement.Config{},
 			Checker: checker.Config{
-				Interval: 30 * time.Second,
+				Interval:            30 * time.Second,
+				IrreparableInterval: time.Hour,
+				CriticalMargin:      2,
 			},
 			Repairer: repairer.Config{
 				MaxRepair:     10,
@@ -389,10 +433,30 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				PointerDBAddr: "", // overridden in satellite.New
 				MaxBufferMem:  4 * memory.MB,
 				APIKey:        "",
+				LeaseDuration: time.Hour,
+				LocalWorker:   true,
+				DryRunLimit:   1000,
 			},
 			Audit: audit.Config{
-				MaxRetriesStatDB: 0,
-				Interval:         30 * time.Second,
+				MaxRetriesStatDB:  0,
+				Interval:          30 * time.Second,
+				VerifyPieceHashes: true,
+				ReservoirSize:     64,
+				LogRetention:      24 * time.Hour,
+			},
+			GarbageCollection: gc.Config{
+				Enabled:           false, // tests send the filters explicitly
+				Interval:          time.Hour,
+				GracePeriod:       time.Hour,
+				InitialPieces:     1000,
+				FalsePositiveRate: 0.1,
+				Concurrency:       2,
+				RetainTimeout:     time.Minute,
+			},
+			Lifecycle: lifecycle.Config{
+				Enabled:    false, // tests expire the objects explicitly
+				Interval:   time.Hour,
+				MaxDeletes: 1000,
 			},
 			Tally: tally.Config{
 				Interval: 30 * time.Second,
@@ -400,9 +464,21 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 			Rollup: rollup.Config{
 				Interval: 120 * time.Second,
 			},
+			Invariants: accounting.InvariantConfig{
+				Tolerance: 0.001,
+			},
 			Console: consoleweb.Config{
-				Address:      "127.0.0.1:0",
-				PasswordCost: console.TestPasswordCost,
+				Address:             "127.0.0.1:0",
+				PasswordCost:        console.TestPasswordCost,
+				DeletionGracePeriod: console.DefaultDeletionGracePeriod,
+			},
+			ConsolePurge: consolepurge.Config{
+				Interval:  30 * time.Second,
+				BatchSize: 100,
+			},
+			Payments: payments.Config{
+				Interval:            24 * time.Hour,
+				StorageTBMonthPrice: 1000,
 			},
 		}
 		if planet.config.Reconfigure.Satellite != nil {
@@ -416,6 +492,8 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 		if err != nil {
 			return xs, err
 		}
+		// the satellites bill through the mock provider, which never charges anyone
+		peer.SetupPayments(config.Payments, mockpayments.New())
 
 		log.Debug("id=" + peer.ID().String() + " addr=" + peer.Addr())
 		xs = append(xs, peer)
@@ -432,6 +510,12 @@ func (planet *Planet) newStorageNodes(count int) ([]*storagenode.Peer, error) {
 		}
 	}()
 
+	// storage nodes only accept data from the satellites of the planet
+	var satelliteIDs []string
+	for _, satellite := range planet.Satellites {
+		satelliteIDs = append(satelliteIDs, satellite.ID().String())
+	}
+
 	for i := 0; i < count; i++ {
 		prefix := "storage" + strconv.Itoa(i)
 		log := planet.log.Named(prefix)
@@ -488,7 +572,32 @@ func (planet *Planet) newStorageNodes(count int) ([]*storagenode.Peer, error) {
 				KBucketRefreshInterval: time.Hour,
 
 				AgreementSenderCheckInterval: time.Hour,
+				AgreementSettlementWindow:    3 * time.Hour,
 				CollectorInterval:            time.Hour,
+				CollectorBatchSize:           1000,
+				TrashRetention:               7 * 24 * time.Hour,
+
+				UsedSpaceInterval: time.Hour,
+
+				WhitelistedSatelliteIDs: strings.Join(satelliteIDs, ","),
+				SatelliteIDRestriction:  len(satelliteIDs) > 0,
+			},
+			Trust: trust.Config{
+				RefreshInterval: time.Hour,
+				GracePeriod:     7 * 24 * time.Hour,
+			},
+			Notifications: notifications.Config{
+				Interval:      time.Hour,
+				DiskFullRatio: 0.9,
+				MinAuditRatio: 0.95,
+				MaxClockSkew:  5 * time.Minute,
+			},
+			Pairing: pairing.Config{
+				Enabled: true,
+			},
+			Preflight: preflight.Config{
+				// the satellites start concurrently with the nodes
+				Enabled: false,
 			},
 		}
 		if planet.config.Reconfigure.StorageNode != nil {
@@ -17,6 +17,7 @@ import (
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/overlay"
@@ -172,33 +173,15 @@ func (uplink *Uplink) Upload(ctx context.Context, satellite *satellite.Peer, buc
 		}
 	}
 
-	createInfo := storj.CreateObject{
+	upload, err := stream.NewWriter(ctx, metainfo, streams, bucket, path, &storj.CreateObject{
 		RedundancyScheme: redScheme,
 		EncryptionScheme: encScheme,
-	}
-	obj, err := metainfo.CreateObject(ctx, bucket, path, &createInfo)
+	})
 	if err != nil {
 		return err
 	}
 
-	reader := bytes.NewReader(data)
-	err = uploadStream(ctx, streams, obj, reader)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func uploadStream(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader) error {
-	mutableStream, err := mutableObject.CreateStream(ctx)
-	if err != nil {
-		return err
-	}
-
-	upload := stream.NewUpload(ctx, mutableStream, streams)
-
-	_, err = io.Copy(upload, reader)
+	_, err = io.Copy(upload, bytes.NewReader(data))
 
 	return errs.Combine(err, upload.Close())
 }
@@ -210,12 +193,10 @@ func (uplink *Uplink) Download(ctx context.Context, satellite *satellite.Peer, b
 		return []byte{}, err
 	}
 
-	readOnlyStream, err := metainfo.GetObjectStream(ctx, bucket, path)
+	download, err := stream.NewReader(ctx, metainfo, streams, bucket, path)
 	if err != nil {
 		return []byte{}, err
 	}
-
-	download := stream.NewDownload(ctx, readOnlyStream, streams)
 	defer func() { err = errs.Combine(err, download.Close()) }()
 
 	data, err := ioutil.ReadAll(download)
@@ -268,17 +249,16 @@ func (uplink *Uplink) getMetainfo(satellite *satellite.Peer) (db storj.Metainfo,
 		return nil, nil, fmt.Errorf("EncryptionBlockSize must be a multiple of ErasureShareSize * RS MinThreshold")
 	}
 
-	key := new(storj.Key)
-	copy(key[:], "enc.key")
+	access := encryption.NewAccess(encryption.KeyFromPassphrase("enc.key"))
 
-	streams, err := streams.NewStreamStore(segments, segmentSize.Int64(), key, int(encScheme.BlockSize), encScheme.Cipher)
+	streams, err := streams.NewStreamStore(segments, segmentSize.Int64(), access, int(encScheme.BlockSize), encScheme.Cipher)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	buckets := buckets.NewStore(streams)
 
-	return kvmetainfo.New(buckets, streams, segments, pdb, key), streams, nil
+	return kvmetainfo.New(buckets, streams, segments, pdb, access), streams, nil
 }
 
 func (uplink *Uplink) getRedundancyScheme() storj.RedundancyScheme {
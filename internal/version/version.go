@@ -0,0 +1,62 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package version contains the version of the build.
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// Version is the version of the build, release builds set it with
+//
+//	go build -ldflags "-X storj.io/storj/internal/version.Version=v0.1.0"
+var Version = "v0.0.0-dev"
+
+// SemVer is a semantic version, pre-release and build suffixes are dropped
+type SemVer struct {
+	Major, Minor, Patch int64
+}
+
+// Parse parses a version like v1.2.3 or 1.2.3-rc
+func Parse(value string) (SemVer, error) {
+	trimmed := strings.TrimPrefix(value, "v")
+	if i := strings.IndexAny(trimmed, "-+"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+
+	parts := strings.Split(trimmed, ".")
+	if len(parts) != 3 {
+		return SemVer{}, errs.New("invalid version %q", value)
+	}
+
+	var numbers [3]int64
+	for i, part := range parts {
+		number, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || number < 0 {
+			return SemVer{}, errs.New("invalid version %q", value)
+		}
+		numbers[i] = number
+	}
+	return SemVer{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// Less returns whether v is older than other
+func (v SemVer) Less(other SemVer) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Patch < other.Patch
+}
+
+// String returns the version as vMajor.Minor.Patch
+func (v SemVer) String() string {
+	return fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
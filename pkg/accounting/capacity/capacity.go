@@ -0,0 +1,157 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package capacity
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/overlay"
+)
+
+var (
+	// Error is the default capacity errs class
+	Error = errs.Class("capacity error")
+	mon   = monkit.Package()
+)
+
+const day = 24 * time.Hour
+
+// Report is a forecast of when the storage nodes run out of free space at
+// the current growth of the data stored on them
+type Report struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	// WindowStart and WindowEnd are the period the rates are measured over
+	WindowStart time.Time `json:"windowStart"`
+	WindowEnd   time.Time `json:"windowEnd"`
+
+	StorageNodes int64 `json:"storageNodes"`
+	// FreeBytes is the free space reported by the storage nodes
+	FreeBytes int64 `json:"freeBytes"`
+	// AtRestBytes is the data stored on the storage nodes at the last tally
+	AtRestBytes int64     `json:"atRestBytes"`
+	LastTally   time.Time `json:"lastTally"`
+	// UsedRatio is the share of the total capacity which is in use
+	UsedRatio float64 `json:"usedRatio"`
+
+	// IngestBytesPerDay is the growth of the data at rest during the window
+	IngestBytesPerDay float64 `json:"ingestBytesPerDay"`
+	// UploadBytesPerDay is the uploaded data settled during the window,
+	// including the data which was deleted again
+	UploadBytesPerDay float64 `json:"uploadBytesPerDay"`
+
+	// DaysUntilFull is when the free space is exhausted at the ingest rate,
+	// missing when the data at rest isn't growing
+	DaysUntilFull *float64   `json:"daysUntilFull,omitempty"`
+	FullAt        *time.Time `json:"fullAt,omitempty"`
+}
+
+// Generate creates a report from the capacity in the overlay and the
+// accounting tallies of the window before now
+func Generate(ctx context.Context, nodes overlay.DB, tallies accounting.DB, now time.Time, window time.Duration) (_ *Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if window <= 0 {
+		return nil, Error.New("window must be positive, got %v", window)
+	}
+
+	capacity, err := nodes.Capacity(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	totals, err := tallies.QueryRawTotals(ctx, now.Add(-window))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	report := &Report{
+		GeneratedAt:  now.UTC(),
+		WindowStart:  now.Add(-window).UTC(),
+		WindowEnd:    now.UTC(),
+		StorageNodes: capacity.StorageNodes,
+		FreeBytes:    capacity.FreeDisk,
+	}
+	report.forecast(totals, window)
+	return report, nil
+}
+
+// sample is the data at rest at the end of a tally
+type sample struct {
+	at    time.Time
+	bytes float64
+}
+
+// forecast fills in the at-rest totals, the rates and the forecast from the
+// raw tallies of the window
+func (report *Report) forecast(totals []*accounting.RawTotal, window time.Duration) {
+	var uploaded float64
+	var byteHours []sample
+	for _, total := range totals {
+		switch total.DataType {
+		case accounting.BandwidthPut:
+			uploaded += total.DataTotal
+		case accounting.AtRest:
+			byteHours = append(byteHours, sample{at: total.IntervalEndTime, bytes: total.DataTotal})
+		}
+	}
+	report.UploadBytesPerDay = uploaded / (float64(window) / float64(day))
+
+	// tallies store byte hours since the previous tally, so the first tally
+	// of the window only marks where the next one starts
+	var atRest []sample
+	for i := 1; i < len(byteHours); i++ {
+		hours := byteHours[i].at.Sub(byteHours[i-1].at).Hours()
+		if hours <= 0 {
+			continue
+		}
+		atRest = append(atRest, sample{at: byteHours[i].at, bytes: byteHours[i].bytes / hours})
+	}
+	if len(atRest) > 0 {
+		last := atRest[len(atRest)-1]
+		report.AtRestBytes = int64(last.bytes)
+		report.LastTally = last.at.UTC()
+	}
+	if total := report.AtRestBytes + report.FreeBytes; total > 0 {
+		report.UsedRatio = float64(report.AtRestBytes) / float64(total)
+	}
+
+	report.IngestBytesPerDay = growth(atRest)
+	if report.IngestBytesPerDay > 0 {
+		days := float64(report.FreeBytes) / report.IngestBytesPerDay
+		fullAt := report.GeneratedAt.Add(time.Duration(days * float64(day)))
+		report.DaysUntilFull = &days
+		report.FullAt = &fullAt
+	}
+}
+
+// growth returns the least squares slope of the samples in bytes per day
+func growth(samples []sample) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+
+	origin := samples[0].at
+	var sumX, sumY float64
+	for _, s := range samples {
+		sumX += float64(s.at.Sub(origin)) / float64(day)
+		sumY += s.bytes
+	}
+	n := float64(len(samples))
+	meanX, meanY := sumX/n, sumY/n
+
+	var covariance, variance float64
+	for _, s := range samples {
+		dx := float64(s.at.Sub(origin))/float64(day) - meanX
+		covariance += dx * (s.bytes - meanY)
+		variance += dx * dx
+	}
+	if variance == 0 {
+		return 0
+	}
+	return covariance / variance
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package capacity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/accounting/capacity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestGenerate(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		now := time.Now().UTC().Truncate(time.Hour)
+		window := 7 * 24 * time.Hour
+
+		{ // without any data nothing is growing
+			report, err := capacity.Generate(ctx, db.OverlayCache(), db.Accounting(), now, window)
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), report.StorageNodes)
+			assert.Equal(t, int64(0), report.AtRestBytes)
+			assert.Nil(t, report.DaysUntilFull)
+		}
+
+		nodeA, nodeB := teststorj.NodeIDFromString("a"), teststorj.NodeIDFromString("b")
+		for _, id := range []storj.NodeID{nodeA, nodeB} {
+			err := db.OverlayCache().Update(ctx, &pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Address:      &pb.NodeAddress{Address: "127.0.0.1:7777"},
+				Restrictions: &pb.NodeRestrictions{FreeDisk: 5000},
+			})
+			require.NoError(t, err)
+		}
+
+		// daily tallies of 1000, 2000 and 3000 bytes at rest, stored as byte hours
+		for i, stored := range []float64{500, 1000, 2000, 3000} {
+			tally := now.Add(time.Duration(i-4) * 24 * time.Hour)
+			err := db.Accounting().SaveAtRestRaw(ctx, tally, map[storj.NodeID]float64{
+				nodeA: stored * 24 / 2,
+				nodeB: stored * 24 / 2,
+			})
+			require.NoError(t, err)
+		}
+		// 7000 bytes uploaded during the window and some before
+		err := db.Accounting().SaveBWRaw(ctx, now.Add(-time.Hour), map[storj.NodeID][]int64{
+			nodeA: {4000, 100},
+			nodeB: {3000, 100},
+		})
+		require.NoError(t, err)
+		err = db.Accounting().SaveBWRaw(ctx, now.Add(-window-time.Hour), map[storj.NodeID][]int64{
+			nodeA: {50000},
+		})
+		require.NoError(t, err)
+
+		report, err := capacity.Generate(ctx, db.OverlayCache(), db.Accounting(), now, window)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), report.StorageNodes)
+		assert.Equal(t, int64(10000), report.FreeBytes)
+		assert.Equal(t, int64(3000), report.AtRestBytes)
+		assert.True(t, now.Add(-24*time.Hour).Equal(report.LastTally))
+		assert.InDelta(t, 3000.0/13000.0, report.UsedRatio, 1e-9)
+		assert.InDelta(t, 1000, report.IngestBytesPerDay, 1e-6)
+		assert.InDelta(t, 1000, report.UploadBytesPerDay, 1e-6)
+
+		require.NotNil(t, report.DaysUntilFull)
+		assert.InDelta(t, 10, *report.DaysUntilFull, 1e-6)
+		require.NotNil(t, report.FullAt)
+		assert.WithinDuration(t, now.Add(10*24*time.Hour), *report.FullAt, time.Second)
+
+		_, err = capacity.Generate(ctx, db.OverlayCache(), db.Accounting(), now, 0)
+		assert.Error(t, err)
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
+
 	"storj.io/storj/pkg/storj"
 )
 
@@ -36,6 +38,25 @@ type Rollup struct {
 	AtRestTotal    float64
 }
 
+// RawTotal is the sum of the raw tallies of a data type ending at the same time
+type RawTotal struct {
+	IntervalEndTime time.Time
+	DataType        int
+	DataTotal       float64
+}
+
+// Violation is a broken accounting invariant found by a self-check
+type Violation struct {
+	ID            int64
+	Name          string
+	IntervalStart time.Time
+	IntervalEnd   time.Time
+	Expected      float64
+	Actual        float64
+	Details       string
+	CreatedAt     time.Time
+}
+
 // DB stores information about bandwidth usage
 type DB interface {
 	// LastTimestamp records the latest last tallied time.
@@ -44,6 +65,18 @@ type DB interface {
 	SaveBWRaw(ctx context.Context, tallyEnd time.Time, bwTotals map[storj.NodeID][]int64) error
 	// SaveAtRestRaw records raw tallies of at-rest-data.
 	SaveAtRestRaw(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64) error
+	// SaveAtRestTallies records the raw tallies of each node and the tallies of each project and partner in a single transaction.
+	SaveAtRestTallies(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64, projectData map[uuid.UUID]float64, partnerData map[string]float64) error
+	// SaveProjectStorageTally records the at-rest byte hours of each project.
+	SaveProjectStorageTally(ctx context.Context, intervalEnd time.Time, projectData map[uuid.UUID]float64) error
+	// SavePartnerStorageTally records the at-rest byte hours of the buckets attributed to each partner.
+	SavePartnerStorageTally(ctx context.Context, intervalEnd time.Time, partnerData map[string]float64) error
+	// QueryPartnerStorage returns the at-rest byte hours of each partner tallied between start (inclusive) and end (exclusive)
+	QueryPartnerStorage(ctx context.Context, start time.Time, end time.Time) (map[string]float64, error)
+	// QueryPartnerEgress returns the downloaded bytes of each partner agreed between start (inclusive) and end (exclusive)
+	QueryPartnerEgress(ctx context.Context, start time.Time, end time.Time) (map[string]int64, error)
+	// QueryProjectStorage returns the at-rest byte hours of a project tallied between start (inclusive) and end (exclusive)
+	QueryProjectStorage(ctx context.Context, projectID uuid.UUID, start time.Time, end time.Time) (float64, error)
 	// GetRaw retrieves all raw tallies
 	GetRaw(ctx context.Context) ([]*Raw, error)
 	// GetRawSince r retrieves all raw tallies sinces
@@ -52,4 +85,16 @@ type DB interface {
 	SaveRollup(ctx context.Context, latestTally time.Time, stats RollupStats) error
 	// QueryPaymentInfo queries StatDB, Accounting Rollup on nodeID
 	QueryPaymentInfo(ctx context.Context, start time.Time, end time.Time) ([]*CSVRow, error)
+	// QueryNodeRollups returns the rollups of a node starting between start (inclusive) and end (exclusive)
+	QueryNodeRollups(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) ([]*Rollup, error)
+	// QueryRawTotals returns the raw tallies since (inclusive) summed by interval end time and data type, ordered by interval end time
+	QueryRawTotals(ctx context.Context, since time.Time) ([]*RawTotal, error)
+	// QueryProjectStorageTotal returns the at-rest byte hours of all projects tallied since (inclusive)
+	QueryProjectStorageTotal(ctx context.Context, since time.Time) (float64, error)
+	// QueryRollupTotals returns the sums of the rollups starting between start (inclusive) and end (exclusive)
+	QueryRollupTotals(ctx context.Context, start time.Time, end time.Time) (*Rollup, error)
+	// SaveViolation records a broken invariant
+	SaveViolation(ctx context.Context, violation *Violation) error
+	// QueryViolations returns the broken invariants recorded since (inclusive), ordered by creation time
+	QueryViolations(ctx context.Context, since time.Time) ([]*Violation, error)
 }
@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+var (
+	// Error is the default accounting errs class
+	Error = errs.Class("accounting error")
+	mon   = monkit.Package()
+)
+
+// MaxAtRestUsagePeriod is the longest period a node can request its at-rest usage for
+const MaxAtRestUsagePeriod = 366 * 24 * time.Hour
+
+// Endpoint implements pb.AccountingServer, it lets storage nodes query
+// the accounting data the satellite keeps about them
+type Endpoint struct {
+	log *zap.Logger
+	db  DB
+}
+
+// NewEndpoint creates a new accounting endpoint
+func NewEndpoint(log *zap.Logger, db DB) *Endpoint {
+	return &Endpoint{log: log, db: db}
+}
+
+// Close closes resources
+func (endpoint *Endpoint) Close() error { return nil }
+
+// AtRestUsage returns the daily at-rest data of the calling storage node,
+// the byte-hours are the same values the payments are calculated from
+func (endpoint *Endpoint) AtRestUsage(ctx context.Context, req *pb.AtRestUsageRequest) (resp *pb.AtRestUsageResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	start, err := ptypes.Timestamp(req.GetStart())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	end, err := ptypes.Timestamp(req.GetEnd())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if !start.Before(end) {
+		return nil, Error.New("start %v is not before end %v", start, end)
+	}
+	if end.Sub(start) > MaxAtRestUsagePeriod {
+		return nil, Error.New("period %v is longer than %v", end.Sub(start), MaxAtRestUsagePeriod)
+	}
+
+	rollups, err := endpoint.db.QueryNodeRollups(ctx, peer.ID, start, end)
+	if err != nil {
+		endpoint.log.Error("querying node rollups", zap.Stringer("node", peer.ID), zap.Error(err))
+		return nil, Error.Wrap(err)
+	}
+
+	resp = &pb.AtRestUsageResponse{}
+	for _, rollup := range rollups {
+		startTime, err := ptypes.TimestampProto(rollup.StartTime)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		resp.Days = append(resp.Days, &pb.AtRestDay{
+			StartTime: startTime,
+			ByteHours: rollup.AtRestTotal,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestAtRestUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node, other := planet.StorageNodes[0], planet.StorageNodes[1]
+
+		day := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		stats := accounting.RollupStats{}
+		for i := 0; i < 3; i++ {
+			start := day.Add(time.Duration(i) * 24 * time.Hour)
+			stats[start] = map[storj.NodeID]*accounting.Rollup{
+				node.ID():  {NodeID: node.ID(), StartTime: start, AtRestTotal: float64(i + 1)},
+				other.ID(): {NodeID: other.ID(), StartTime: start, AtRestTotal: 100},
+			}
+		}
+		require.NoError(t, satellite.DB.Accounting().SaveRollup(ctx, day, stats))
+
+		local := satellite.Local()
+		conn, err := node.Transport.DialNode(ctx, &local)
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := pb.NewAccountingClient(conn)
+
+		start, err := ptypes.TimestampProto(day.Add(24 * time.Hour))
+		require.NoError(t, err)
+		end, err := ptypes.TimestampProto(day.Add(72 * time.Hour))
+		require.NoError(t, err)
+
+		resp, err := client.AtRestUsage(ctx, &pb.AtRestUsageRequest{Start: start, End: end})
+		require.NoError(t, err)
+		require.Len(t, resp.Days, 2)
+		for i, usage := range resp.Days {
+			startTime, err := ptypes.Timestamp(usage.StartTime)
+			require.NoError(t, err)
+			assert.True(t, day.Add(time.Duration(i+1)*24*time.Hour).Equal(startTime))
+			assert.Equal(t, float64(i+2), usage.ByteHours)
+		}
+
+		// the period must not be empty
+		_, err = client.AtRestUsage(ctx, &pb.AtRestUsageRequest{Start: end, End: start})
+		assert.Error(t, err)
+	})
+}
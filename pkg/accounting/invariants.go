@@ -0,0 +1,78 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Names of the invariants checked after the tally and rollup cycles
+const (
+	// InvariantNodeAtRest is broken when the at-rest data saved for the nodes
+	// doesn't add up to the pieces found in the metainfo
+	InvariantNodeAtRest = "node_at_rest"
+	// InvariantProjectAtRest is broken when the at-rest data saved for the
+	// projects doesn't add up to the segments found in the metainfo
+	InvariantProjectAtRest = "project_at_rest"
+	// InvariantRollupTotals is broken when the rollups of a day don't add up
+	// to the raw tallies they were rolled up from
+	InvariantRollupTotals = "rollup_totals"
+)
+
+// InvariantConfig contains the configurable values of the accounting self-checks
+type InvariantConfig struct {
+	Tolerance float64 `help:"relative difference up to which accounting totals are considered equal" default:"0.001"`
+}
+
+// InvariantChecker compares accounting totals which should be equal and
+// reports the ones which aren't
+type InvariantChecker struct {
+	log       *zap.Logger
+	db        DB
+	tolerance float64
+}
+
+// NewInvariantChecker creates a new invariant checker
+func NewInvariantChecker(log *zap.Logger, db DB, config InvariantConfig) *InvariantChecker {
+	return &InvariantChecker{log: log, db: db, tolerance: config.Tolerance}
+}
+
+// Equal returns whether expected and actual are equal within the tolerance
+func (checker *InvariantChecker) Equal(expected, actual float64) bool {
+	diff := math.Abs(expected - actual)
+	return diff <= checker.tolerance*math.Max(math.Abs(expected), math.Abs(actual))
+}
+
+// Check records a violation of the invariant name when expected and actual
+// differ by more than the tolerance
+func (checker *InvariantChecker) Check(ctx context.Context, name string, start, end time.Time, expected, actual float64, details string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if checker.Equal(expected, actual) {
+		return nil
+	}
+
+	mon.Event("accounting_invariant_violation")
+	checker.log.Error("accounting invariant violated",
+		zap.String("invariant", name),
+		zap.Time("start", start),
+		zap.Time("end", end),
+		zap.Float64("expected", expected),
+		zap.Float64("actual", actual),
+		zap.String("details", details),
+	)
+
+	return Error.Wrap(checker.db.SaveViolation(ctx, &Violation{
+		Name:          name,
+		IntervalStart: start,
+		IntervalEnd:   end,
+		Expected:      expected,
+		Actual:        actual,
+		Details:       details,
+	}))
+}
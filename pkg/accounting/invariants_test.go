@@ -0,0 +1,51 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestInvariantChecker(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, sdb satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		db := sdb.Accounting()
+		checker := accounting.NewInvariantChecker(zap.NewNop(), db, accounting.InvariantConfig{Tolerance: 0.01})
+
+		start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := start.Add(24 * time.Hour)
+
+		assert.True(t, checker.Equal(0, 0))
+		assert.True(t, checker.Equal(1000, 1005))
+		assert.False(t, checker.Equal(1000, 1020))
+		assert.False(t, checker.Equal(0, 1))
+
+		require.NoError(t, checker.Check(ctx, accounting.InvariantNodeAtRest, start, end, 1000, 1005, "within tolerance"))
+		require.NoError(t, checker.Check(ctx, accounting.InvariantRollupTotals, start, end, 1000, 500, "data type 5"))
+
+		violations, err := db.QueryViolations(ctx, time.Time{})
+		require.NoError(t, err)
+		if assert.Len(t, violations, 1) {
+			violation := violations[0]
+			assert.Equal(t, accounting.InvariantRollupTotals, violation.Name)
+			assert.True(t, start.Equal(violation.IntervalStart))
+			assert.True(t, end.Equal(violation.IntervalEnd))
+			assert.Equal(t, 1000.0, violation.Expected)
+			assert.Equal(t, 500.0, violation.Actual)
+			assert.Equal(t, "data type 5", violation.Details)
+		}
+	})
+}
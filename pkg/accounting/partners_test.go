@@ -0,0 +1,91 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestQueryPartnerEgress(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		uplink, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		node1, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		node2, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		const (
+			attributed   = "00000000-0000-0000-0000-000000000001"
+			unattributed = "00000000-0000-0000-0000-000000000002"
+			upload       = "00000000-0000-0000-0000-000000000003"
+		)
+		require.NoError(t, db.BucketAttributions().CreateSerial(ctx, attributed, "partner"))
+		require.NoError(t, db.BucketAttributions().CreateSerial(ctx, upload, "partner"))
+
+		agreements := []struct {
+			serial string
+			action pb.BandwidthAction
+			node   storj.NodeID
+		}{
+			{attributed, pb.BandwidthAction_GET, node1.ID},
+			{attributed, pb.BandwidthAction_GET, node2.ID},
+			{unattributed, pb.BandwidthAction_GET, node1.ID},
+			{upload, pb.BandwidthAction_PUT, node1.ID},
+		}
+		for _, agreement := range agreements {
+			err := db.BandwidthAgreement().CreateAgreement(ctx, &pb.RenterBandwidthAllocation{
+				PayerAllocation: pb.PayerBandwidthAllocation{
+					Action:       agreement.action,
+					SerialNumber: agreement.serial,
+					UplinkId:     uplink.ID,
+				},
+				Total:         1000,
+				StorageNodeId: agreement.node,
+			})
+			require.NoError(t, err)
+		}
+
+		egress, err := db.Accounting().QueryPartnerEgress(ctx, time.Now().UTC().Add(-time.Hour), time.Now().UTC().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"partner": 2000}, egress)
+
+		egress, err = db.Accounting().QueryPartnerEgress(ctx, time.Now().UTC().Add(time.Hour), time.Now().UTC().Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, egress)
+	})
+}
+
+func TestQueryPartnerStorage(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		now := time.Now().UTC()
+		require.NoError(t, db.Accounting().SavePartnerStorageTally(ctx, now.Add(-2*time.Hour), map[string]float64{"a": 100, "b": 10}))
+		require.NoError(t, db.Accounting().SavePartnerStorageTally(ctx, now, map[string]float64{"a": 200}))
+
+		stored, err := db.Accounting().QueryPartnerStorage(ctx, now.Add(-3*time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"a": 300, "b": 10}, stored)
+
+		stored, err = db.Accounting().QueryPartnerStorage(ctx, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"a": 200}, stored)
+	})
+}
@@ -6,10 +6,13 @@ package rollup
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/storj"
 )
@@ -21,35 +24,41 @@ type Config struct {
 
 // Rollup is the service for totalling data on storage nodes on daily intervals
 type Rollup struct { // TODO: rename to service
-	logger *zap.Logger
-	ticker *time.Ticker
-	db     accounting.DB
+	logger     *zap.Logger
+	db         accounting.DB
+	invariants *accounting.InvariantChecker
+
+	Loop sync2.Cycle
 }
 
-// New creates a new rollup service
-func New(logger *zap.Logger, db accounting.DB, interval time.Duration) *Rollup {
-	return &Rollup{
-		logger: logger,
-		ticker: time.NewTicker(interval),
-		db:     db,
+// New creates a new rollup service, the saved rollups aren't checked when invariants is nil
+func New(logger *zap.Logger, db accounting.DB, interval time.Duration, invariants *accounting.InvariantChecker) *Rollup {
+	rollup := &Rollup{
+		logger:     logger,
+		db:         db,
+		invariants: invariants,
 	}
+	rollup.Loop.SetInterval(interval)
+	return rollup
 }
 
 // Run the Rollup loop
 func (r *Rollup) Run(ctx context.Context) (err error) {
 	r.logger.Info("Rollup service starting up")
 	defer mon.Task()(&ctx)(&err)
-	for {
-		err = r.Query(ctx)
-		if err != nil {
+
+	return r.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := r.Query(ctx); err != nil {
 			r.logger.Error("Query failed", zap.Error(err))
 		}
-		select {
-		case <-r.ticker.C: // wait for the next interval to happen
-		case <-ctx.Done(): // or the Rollup is canceled via context
-			return ctx.Err()
-		}
-	}
+		return nil
+	})
+}
+
+// Close stops the Rollup loop
+func (r *Rollup) Close() error {
+	r.Loop.Close()
+	return nil
 }
 
 // Query rolls up raw tally
@@ -109,5 +118,71 @@ func (r *Rollup) Query(ctx context.Context) error {
 		r.logger.Info("Rollup only found tallies for today")
 		return nil
 	}
-	return Error.Wrap(r.db.SaveRollup(ctx, latestTally, rollupStats))
+	if err := r.db.SaveRollup(ctx, latestTally, rollupStats); err != nil {
+		return Error.Wrap(err)
+	}
+
+	days := make([]time.Time, 0, len(rollupStats))
+	for day := range rollupStats {
+		days = append(days, day)
+	}
+	return r.checkTotals(ctx, days)
+}
+
+// rollupDataTypes are the data types which are rolled up
+var rollupDataTypes = []int{
+	accounting.BandwidthPut,
+	accounting.BandwidthGet,
+	accounting.BandwidthGetAudit,
+	accounting.BandwidthGetRepair,
+	accounting.BandwidthPutRepair,
+	accounting.AtRest,
+}
+
+// checkTotals verifies that the saved rollups of each day add up to the raw
+// tallies of the day
+func (r *Rollup) checkTotals(ctx context.Context, days []time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if r.invariants == nil || len(days) == 0 {
+		return nil
+	}
+	sort.Slice(days, func(i, k int) bool { return days[i].Before(days[k]) })
+
+	rawTotals, err := r.db.QueryRawTotals(ctx, days[0])
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	raw := make(map[time.Time]map[int]float64)
+	for _, total := range rawTotals {
+		end := total.IntervalEndTime
+		day := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
+		if raw[day] == nil {
+			raw[day] = make(map[int]float64)
+		}
+		raw[day][total.DataType] += total.DataTotal
+	}
+
+	var group errs.Group
+	for _, day := range days {
+		next := day.AddDate(0, 0, 1)
+		totals, err := r.db.QueryRollupTotals(ctx, day, next)
+		if err != nil {
+			group.Add(Error.Wrap(err))
+			continue
+		}
+		rolled := map[int]float64{
+			accounting.BandwidthPut:       float64(totals.PutTotal),
+			accounting.BandwidthGet:       float64(totals.GetTotal),
+			accounting.BandwidthGetAudit:  float64(totals.GetAuditTotal),
+			accounting.BandwidthGetRepair: float64(totals.GetRepairTotal),
+			accounting.BandwidthPutRepair: float64(totals.PutRepairTotal),
+			accounting.AtRest:             totals.AtRestTotal,
+		}
+		for _, dataType := range rollupDataTypes {
+			group.Add(r.invariants.Check(ctx, accounting.InvariantRollupTotals, day, next,
+				raw[day][dataType], rolled[dataType], fmt.Sprintf("raw tallies vs. rollups of data type %d", dataType)))
+		}
+	}
+	return group.Err()
 }
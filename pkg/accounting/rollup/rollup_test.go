@@ -12,6 +12,7 @@ import (
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/accounting/rollup"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
@@ -82,5 +83,6 @@ func createRollup(t *testing.T) (*testcontext.Context, *rollup.Rollup, satellite
 		assert.NoError(t, err)
 	}
 
-	return ctx, rollup.New(zap.NewNop(), db.Accounting(), time.Second), db, nodeData, cleanup
+	invariants := accounting.NewInvariantChecker(zap.NewNop(), db.Accounting(), accounting.InvariantConfig{Tolerance: 0.001})
+	return ctx, rollup.New(zap.NewNop(), db.Accounting(), time.Second, invariants), db, nodeData, cleanup
 }
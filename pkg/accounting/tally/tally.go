@@ -5,18 +5,20 @@ package tally
 
 import (
 	"context"
+	"strings"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storj"
-	"storj.io/storj/storage"
+	"storj.io/storj/satellite/console"
 )
 
 // Config contains configurable values for tally
@@ -24,57 +26,90 @@ type Config struct {
 	Interval time.Duration `help:"how frequently tally should run" default:"30s"`
 }
 
+// BucketStats is the bucket counters store methods used by tally
+type BucketStats interface {
+	GetAll(ctx context.Context) ([]console.BucketStat, error)
+	Reconcile(ctx context.Context, stat console.BucketStat, unchangedSince time.Time) (reconciled bool, err error)
+}
+
+// BucketAttributions is the bucket attributions store methods used by tally
+type BucketAttributions interface {
+	GetAll(ctx context.Context) ([]pointerdb.BucketAttribution, error)
+}
+
 // Tally is the service for accounting for data stored on each storage node
 type Tally struct { // TODO: rename Tally to Service
-	pointerdb     *pointerdb.Service
+	loop          *pointerdb.Loop
 	overlay       pb.OverlayServer // TODO: this should be *overlay.Service
 	limit         int
 	logger        *zap.Logger
-	ticker        *time.Ticker
 	accountingDB  accounting.DB
 	bwAgreementDB bwagreement.DB // bwagreements database
+	bucketStats   BucketStats
+	attributions  BucketAttributions
+	invariants    *accounting.InvariantChecker
+
+	Loop sync2.Cycle
 }
 
-// New creates a new Tally
-func New(logger *zap.Logger, accountingDB accounting.DB, bwAgreementDB bwagreement.DB, pointerdb *pointerdb.Service, overlay pb.OverlayServer, limit int, interval time.Duration) *Tally {
-	return &Tally{
-		pointerdb:     pointerdb,
+// New creates a new Tally, the saved tallies aren't checked when invariants is nil,
+// the bucket counters aren't reconciled when bucketStats is nil and no partner
+// tallies are saved when attributions is nil
+func New(logger *zap.Logger, accountingDB accounting.DB, bwAgreementDB bwagreement.DB, bucketStats BucketStats, attributions BucketAttributions, loop *pointerdb.Loop, overlay pb.OverlayServer, limit int, interval time.Duration, invariants *accounting.InvariantChecker) *Tally {
+	tally := &Tally{
+		loop:          loop,
 		overlay:       overlay,
 		limit:         limit,
 		logger:        logger,
-		ticker:        time.NewTicker(interval),
 		accountingDB:  accountingDB,
 		bwAgreementDB: bwAgreementDB,
+		bucketStats:   bucketStats,
+		attributions:  attributions,
+		invariants:    invariants,
 	}
+	tally.Loop.SetInterval(interval)
+	return tally
 }
 
 // Run the Tally loop
 func (t *Tally) Run(ctx context.Context) (err error) {
 	t.logger.Info("Tally service starting up")
 	defer mon.Task()(&ctx)(&err)
-	for {
-		if err = t.Tally(ctx); err != nil {
+
+	return t.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := t.Tally(ctx); err != nil {
 			t.logger.Error("Tally failed", zap.Error(err))
 		}
-		select {
-		case <-t.ticker.C: // wait for the next interval to happen
-		case <-ctx.Done(): // or the Tally is canceled via context
-			return ctx.Err()
-		}
-	}
+		return nil
+	})
+}
+
+// Close stops the Tally loop
+func (t *Tally) Close() error {
+	t.Loop.Close()
+	return nil
 }
 
 //Tally calculates data-at-rest and bandwidth usage once
 func (t *Tally) Tally(ctx context.Context) error {
 	//data at rest
-	var errAtRest, errBWA error
-	latestTally, nodeData, err := t.calculateAtRestData(ctx)
+	var errAtRest, errBuckets, errBWA error
+	latestTally, nodeData, projectData, partnerData, totals, err := t.calculateAtRestData(ctx)
+	if totals.Buckets != nil {
+		if err := t.reconcileBucketStats(ctx, totals); err != nil {
+			errBuckets = errs.New("Reconciling bucket stats failed : %v", err)
+		}
+	}
 	if err != nil {
 		errAtRest = errs.New("Query for data-at-rest failed : %v", err)
 	} else if len(nodeData) > 0 {
-		err = t.SaveAtRestRaw(ctx, latestTally, nodeData)
+		// the tallies are saved together with the last tally timestamp, so that
+		// a failed save doesn't skip or repeat the project and partner tallies
+		err = t.accountingDB.SaveAtRestTallies(ctx, latestTally, nodeData, projectData, partnerData)
 		if err != nil {
 			errAtRest = errs.New("Saving data-at-rest failed : %v", err)
+		} else if err = t.checkAtRest(ctx, latestTally, totals); err != nil {
+			errAtRest = errs.New("Checking data-at-rest failed : %v", err)
 		}
 	}
 	//bandwdith
@@ -87,63 +122,58 @@ func (t *Tally) Tally(ctx context.Context) error {
 			errBWA = errs.New("Saving for bandwidth failed : %v", err)
 		}
 	}
-	return errs.Combine(errAtRest, errBWA)
+	return errs.Combine(errAtRest, errBuckets, errBWA)
 }
 
-// calculateAtRestData iterates through the pieces on pointerdb and calculates
-// the amount of at-rest data stored on each respective node
-func (t *Tally) calculateAtRestData(ctx context.Context) (latestTally time.Time, nodeData map[storj.NodeID]float64, err error) {
+// atRestTotals is the at-rest data found on a metainfo loop iteration,
+// independently of which node or project it is attributed to
+type atRestTotals struct {
+	Start    time.Time
+	Pieces   float64
+	Segments float64
+
+	// Buckets are the objects and bytes of each bucket, nil when the loop
+	// didn't finish. They include the changes made before Joined.
+	Buckets map[bucketKey]*console.BucketStat
+	Joined  time.Time
+}
+
+// bucketKey identifies a bucket of a project
+type bucketKey struct {
+	projectID uuid.UUID
+	bucket    string
+}
+
+// calculateAtRestData iterates through the pieces on the metainfo loop and calculates
+// the amount of at-rest data stored on each respective node, by each project and
+// in the buckets attributed to each partner
+func (t *Tally) calculateAtRestData(ctx context.Context) (latestTally time.Time, nodeData map[storj.NodeID]float64, projectData map[uuid.UUID]float64, partnerData map[string]float64, totals atRestTotals, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	latestTally, err = t.accountingDB.LastTimestamp(ctx, accounting.LastAtRestTally)
 	if err != nil {
-		return latestTally, nodeData, Error.Wrap(err)
+		return latestTally, nodeData, projectData, partnerData, totals, Error.Wrap(err)
+	}
+	partners, err := t.attributedBuckets(ctx)
+	if err != nil {
+		return latestTally, nodeData, projectData, partnerData, totals, Error.Wrap(err)
 	}
+	totals.Start = latestTally
 	nodeData = make(map[storj.NodeID]float64)
+	projectData = make(map[uuid.UUID]float64)
+	partnerData = make(map[string]float64)
+	buckets := make(map[bucketKey]*console.BucketStat)
 
-	err = t.pointerdb.Iterate("", "", true, false,
-		func(it storage.Iterator) error {
-			var item storage.ListItem
-			for it.Next(&item) {
-				pointer := &pb.Pointer{}
-				err = proto.Unmarshal(item.Value, pointer)
-				if err != nil {
-					return Error.Wrap(err)
-				}
-				remote := pointer.GetRemote()
-				if remote == nil {
-					continue
-				}
-				pieces := remote.GetRemotePieces()
-				if pieces == nil {
-					t.logger.Debug("no pieces on remote segment")
-					continue
-				}
-				segmentSize := pointer.GetSegmentSize()
-				redundancy := remote.GetRedundancy()
-				if redundancy == nil {
-					t.logger.Debug("no redundancy scheme present")
-					continue
-				}
-				minReq := redundancy.GetMinReq()
-				if minReq <= 0 {
-					t.logger.Debug("pointer minReq must be an int greater than 0")
-					continue
-				}
-				pieceSize := segmentSize / int64(minReq)
-				for _, piece := range pieces {
-					t.logger.Info("found piece on Node ID" + piece.NodeId.String())
-					nodeData[piece.NodeId] += float64(pieceSize)
-				}
-			}
-			return nil
-		},
-	)
+	totals.Joined = time.Now()
+	err = t.loop.Join(ctx, &atRestObserver{logger: t.logger, nodeData: nodeData, projectData: projectData, partners: partners, partnerData: partnerData, buckets: buckets, totals: &totals})
+	if err == nil {
+		totals.Buckets = buckets
+	}
 	if len(nodeData) == 0 {
-		return latestTally, nodeData, nil
+		return latestTally, nodeData, projectData, partnerData, totals, nil
 	}
 	if err != nil {
-		return latestTally, nodeData, Error.Wrap(err)
+		return latestTally, nodeData, projectData, partnerData, totals, Error.Wrap(err)
 	}
 	//store byte hours, not just bytes
 	numHours := time.Now().Sub(latestTally).Hours()
@@ -154,7 +184,230 @@ func (t *Tally) calculateAtRestData(ctx context.Context) (latestTally time.Time,
 	for k := range nodeData {
 		nodeData[k] *= numHours //calculate byte hours
 	}
-	return latestTally, nodeData, err
+	for k := range projectData {
+		projectData[k] *= numHours
+	}
+	for k := range partnerData {
+		partnerData[k] *= numHours
+	}
+	totals.Pieces *= numHours
+	totals.Segments *= numHours
+	return latestTally, nodeData, projectData, partnerData, totals, err
+}
+
+// attributedBucket is a bucket of a project
+type attributedBucket struct {
+	projectID uuid.UUID
+	bucket    string
+}
+
+// attributedBuckets returns the partners of the attributed buckets
+func (t *Tally) attributedBuckets(ctx context.Context) (partners map[attributedBucket]string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if t.attributions == nil {
+		return nil, nil
+	}
+
+	attributions, err := t.attributions.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	partners = make(map[attributedBucket]string, len(attributions))
+	for _, attribution := range attributions {
+		partners[attributedBucket{projectID: attribution.ProjectID, bucket: attribution.BucketName}] = attribution.PartnerID
+	}
+	return partners, nil
+}
+
+// checkAtRest verifies that the saved node and project tallies add up to the
+// at-rest data found in the metainfo
+func (t *Tally) checkAtRest(ctx context.Context, latestTally time.Time, totals atRestTotals) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if t.invariants == nil {
+		return nil
+	}
+
+	rawTotals, err := t.accountingDB.QueryRawTotals(ctx, latestTally)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	var nodeTotal float64
+	for _, total := range rawTotals {
+		if total.DataType == accounting.AtRest {
+			nodeTotal += total.DataTotal
+		}
+	}
+
+	projectTotal, err := t.accountingDB.QueryProjectStorageTotal(ctx, latestTally)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return errs.Combine(
+		t.invariants.Check(ctx, accounting.InvariantNodeAtRest, totals.Start, latestTally,
+			totals.Pieces, nodeTotal, "byte hours of the pieces in the metainfo vs. the saved node tallies"),
+		t.invariants.Check(ctx, accounting.InvariantProjectAtRest, totals.Start, latestTally,
+			totals.Segments, projectTotal, "byte hours of the segments in the metainfo vs. the saved project tallies"),
+	)
+}
+
+// atRestObserver sums up the data stored on each node, by each project and in
+// the buckets attributed to each partner during a metainfo loop iteration
+type atRestObserver struct {
+	logger      *zap.Logger
+	nodeData    map[storj.NodeID]float64
+	projectData map[uuid.UUID]float64
+	partners    map[attributedBucket]string
+	partnerData map[string]float64
+	buckets     map[bucketKey]*console.BucketStat
+	totals      *atRestTotals
+}
+
+// addProjectData adds the segment size to the project the path belongs to
+// and to the partner its bucket is attributed to
+func (observer *atRestObserver) addProjectData(path storj.Path, size int64) {
+	components := strings.SplitN(path, "/", 4)
+	projectID, err := uuid.Parse(components[0])
+	if err != nil {
+		observer.logger.Debug("segment path doesn't start with a project id", zap.String("Path", path))
+		return
+	}
+	observer.projectData[*projectID] += float64(size)
+
+	if len(components) < 3 {
+		return
+	}
+	if partnerID, ok := observer.partners[attributedBucket{projectID: *projectID, bucket: components[2]}]; ok {
+		observer.partnerData[partnerID] += float64(size)
+	}
+}
+
+// addBucketData adds the segment to the counters of the bucket the path
+// belongs to, the last segment of an object also counts the object
+func (observer *atRestObserver) addBucketData(path storj.Path, pointer *pb.Pointer) {
+	components := strings.SplitN(path, "/", 4)
+	if len(components) < 4 {
+		return
+	}
+	projectID, err := uuid.Parse(components[0])
+	if err != nil {
+		return
+	}
+
+	key := bucketKey{projectID: *projectID, bucket: components[2]}
+	stat, ok := observer.buckets[key]
+	if !ok {
+		stat = &console.BucketStat{ProjectID: key.projectID, BucketName: key.bucket}
+		observer.buckets[key] = stat
+	}
+	if components[1] == "l" {
+		stat.ObjectCount++
+	}
+	stat.TotalBytes += pointerdb.SegmentSize(pointer)
+}
+
+// RemoteSegment adds the size of the pieces to their nodes
+func (observer *atRestObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	observer.addBucketData(path, pointer)
+	remote := pointer.GetRemote()
+	if remote == nil {
+		return nil
+	}
+	pieces := remote.GetRemotePieces()
+	if pieces == nil {
+		observer.logger.Debug("no pieces on remote segment")
+		return nil
+	}
+	segmentSize := pointer.GetSegmentSize()
+	observer.totals.Segments += float64(segmentSize)
+	observer.addProjectData(path, segmentSize)
+	redundancy := remote.GetRedundancy()
+	if redundancy == nil {
+		observer.logger.Debug("no redundancy scheme present")
+		return nil
+	}
+	minReq := redundancy.GetMinReq()
+	if minReq <= 0 {
+		observer.logger.Debug("pointer minReq must be an int greater than 0")
+		return nil
+	}
+	pieceSize := segmentSize / int64(minReq)
+	observer.totals.Pieces += float64(pieceSize) * float64(len(pieces))
+	for _, piece := range pieces {
+		observer.logger.Info("found piece on Node ID" + piece.NodeId.String())
+		observer.nodeData[piece.NodeId] += float64(pieceSize)
+	}
+	return nil
+}
+
+// InlineSegment only counts inline segments towards project usage, they're stored on the satellite
+func (observer *atRestObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	observer.totals.Segments += float64(len(pointer.GetInlineSegment()))
+	observer.addProjectData(path, int64(len(pointer.GetInlineSegment())))
+	observer.addBucketData(path, pointer)
+	return nil
+}
+
+// reconcileBucketStats corrects the bucket counters which drifted from the
+// objects and bytes found by the metainfo loop. Counters changed after the
+// observer joined the loop are left alone, the loop may have missed the change.
+func (t *Tally) reconcileBucketStats(ctx context.Context, totals atRestTotals) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if t.bucketStats == nil {
+		return nil
+	}
+
+	stats, err := t.bucketStats.GetAll(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var corrected int64
+	var group errs.Group
+	reconcile := func(stat console.BucketStat, actual console.BucketStat) {
+		if stat.ObjectCount == actual.ObjectCount && stat.TotalBytes == actual.TotalBytes {
+			return
+		}
+		reconciled, err := t.bucketStats.Reconcile(ctx, actual, totals.Joined)
+		if err != nil {
+			group.Add(err)
+			return
+		}
+		if reconciled {
+			corrected++
+			t.logger.Warn("bucket stats drifted",
+				zap.String("Project ID", actual.ProjectID.String()),
+				zap.String("Bucket", actual.BucketName),
+				zap.Int64("Object Count Drift", stat.ObjectCount-actual.ObjectCount),
+				zap.Int64("Total Bytes Drift", stat.TotalBytes-actual.TotalBytes))
+		}
+	}
+
+	for _, stat := range stats {
+		key := bucketKey{projectID: stat.ProjectID, bucket: stat.BucketName}
+		actual, ok := totals.Buckets[key]
+		delete(totals.Buckets, key)
+		if stat.UpdatedAt.After(totals.Joined) {
+			continue
+		}
+		if !ok {
+			// every object of the bucket was deleted
+			actual = &console.BucketStat{ProjectID: stat.ProjectID, BucketName: stat.BucketName}
+		}
+		reconcile(stat, *actual)
+	}
+
+	// buckets whose objects were committed before they were counted
+	for key, actual := range totals.Buckets {
+		reconcile(console.BucketStat{ProjectID: key.projectID, BucketName: key.bucket}, *actual)
+	}
+
+	mon.IntVal("bucket_stats_corrected").Observe(corrected)
+	return Error.Wrap(group.Err())
 }
 
 // SaveAtRestRaw records raw tallies of at-rest-data and updates the LastTimestamp
@@ -12,6 +12,7 @@ import (
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/bwagreement/testbwagreement"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/piecestore/psserver/psdb"
@@ -50,6 +51,32 @@ func TestQueryWithBw(t *testing.T) {
 	})
 }
 
+func TestTallyLoopTrigger(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		tally := planet.Satellites[0].Accounting.Tally
+		tally.Loop.Pause()
+
+		sendGeneratedAgreements(ctx, t, planet)
+		tally.Loop.TriggerWait()
+
+		raws, err := planet.Satellites[0].DB.Accounting().GetRawSince(ctx, time.Time{})
+		require.NoError(t, err)
+
+		bandwidth := make(map[int]float64)
+		for _, raw := range raws {
+			if raw.DataType != accounting.AtRest {
+				bandwidth[raw.DataType] += raw.DataTotal
+			}
+		}
+		require.Len(t, bandwidth, 5)
+		for _, total := range bandwidth {
+			require.Equal(t, float64(1000), total)
+		}
+	})
+}
+
 func sendGeneratedAgreements(ctx context.Context, t *testing.T, planet *testplanet.Planet) {
 	satID := planet.Satellites[0].Identity
 	upID := planet.Uplinks[0].Identity
@@ -65,9 +92,14 @@ func sendGeneratedAgreements(ctx context.Context, t *testing.T, planet *testplan
 
 	agreements := make([]*psdb.Agreement, len(actions))
 	for i, action := range actions {
-		pba, err := testbwagreement.GeneratePayerBandwidthAllocation(action, satID, upID, time.Hour)
+		// audit and repair traffic is done by the satellite itself
+		renterID := upID
+		if !action.IsCustomer() {
+			renterID = satID
+		}
+		pba, err := testbwagreement.GeneratePayerBandwidthAllocation(action, satID, renterID, time.Hour)
 		require.NoError(t, err)
-		rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, snID.ID, upID, 1000)
+		rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, snID.ID, renterID, 1000)
 		require.NoError(t, err)
 		agreements[i] = &psdb.Agreement{Agreement: *rba}
 	}
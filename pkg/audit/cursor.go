@@ -16,30 +16,32 @@ import (
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
-	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
 )
 
 // Stripe keeps track of a stripe's index and its parent segment
 type Stripe struct {
+	Path          storj.Path
 	Index         int
 	Segment       *pb.Pointer
 	PBA           *pb.PayerBandwidthAllocation
 	Authorization *pb.SignedMessage
 }
 
-// Cursor keeps track of audit location in pointer db
+// Cursor picks segments to audit from a reservoir filled by the metainfo loop
 type Cursor struct {
 	pointers   *pointerdb.Service
+	loop       *pointerdb.Loop
 	allocation *pointerdb.AllocationSigner
 	identity   *identity.FullIdentity
-	lastPath   storj.Path
+	reservoir  *Reservoir
 	mutex      sync.Mutex
 }
 
-// NewCursor creates a Cursor which iterates over pointer db
-func NewCursor(pointers *pointerdb.Service, allocation *pointerdb.AllocationSigner, identity *identity.FullIdentity) *Cursor {
-	return &Cursor{pointers: pointers, allocation: allocation, identity: identity}
+// NewCursor creates a Cursor which samples reservoirSize segments on each metainfo loop iteration
+func NewCursor(pointers *pointerdb.Service, loop *pointerdb.Loop, allocation *pointerdb.AllocationSigner, identity *identity.FullIdentity, reservoirSize int) *Cursor {
+	return &Cursor{pointers: pointers, loop: loop, allocation: allocation, identity: identity, reservoir: NewReservoir(reservoirSize)}
 }
 
 // NextStripe returns a random stripe to be audited
@@ -47,36 +49,26 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 	cursor.mutex.Lock()
 	defer cursor.mutex.Unlock()
 
-	var pointerItems []*pb.ListResponse_Item
-	var path storj.Path
-	var more bool
-
-	pointerItems, more, err = cursor.pointers.List("", cursor.lastPath, "", true, 0, meta.None)
-	if err != nil {
-		return nil, err
+	if cursor.reservoir.Len() == 0 {
+		cursor.reservoir.Reset()
+		err = cursor.loop.Join(ctx, cursor.reservoir)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if len(pointerItems) == 0 {
+	path, ok := cursor.reservoir.Pop()
+	if !ok {
 		return nil, nil
 	}
 
-	pointerItem, err := getRandomPointer(pointerItems)
-	if err != nil {
-		return nil, err
-	}
-
-	path = pointerItem.Path
-
-	// keep track of last path listed
-	if !more {
-		cursor.lastPath = ""
-	} else {
-		cursor.lastPath = pointerItems[len(pointerItems)-1].Path
-	}
-
 	// get pointer info
 	pointer, err := cursor.pointers.Get(path)
 	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			// the segment was deleted after it was sampled
+			return nil, nil
+		}
 		return nil, err
 	}
 	peerIdentity := &identity.PeerIdentity{ID: cursor.identity.ID, Leaf: cursor.identity.Leaf}
@@ -115,6 +107,7 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 	}
 
 	return &Stripe{
+		Path:          path,
 		Index:         index,
 		Segment:       pointer,
 		PBA:           pba,
@@ -148,13 +141,3 @@ func getRandomStripe(es eestream.ErasureScheme, pointer *pb.Pointer) (index int,
 	}
 	return int(randomStripeIndex.Int64()), nil
 }
-
-func getRandomPointer(pointerItems []*pb.ListResponse_Item) (pointer *pb.ListResponse_Item, err error) {
-	randomNum, err := rand.Int(rand.Reader, big.NewInt(int64(len(pointerItems))))
-	if err != nil {
-		return &pb.ListResponse_Item{}, err
-	}
-	randomNumInt64 := randomNum.Int64()
-	pointerItem := pointerItems[randomNumInt64]
-	return pointerItem, nil
-}
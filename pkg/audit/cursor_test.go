@@ -87,7 +87,7 @@ func TestAuditSegment(t *testing.T) {
 		pointers := planet.Satellites[0].Metainfo.Service
 		allocation := planet.Satellites[0].Metainfo.Allocation
 		// create a pdb client and instance of audit
-		cursor := audit.NewCursor(pointers, allocation, planet.Satellites[0].Identity)
+		cursor := audit.NewCursor(pointers, planet.Satellites[0].Metainfo.Loop, allocation, planet.Satellites[0].Identity, len(tests))
 
 		// put 10 paths in db
 		t.Run("putToDB", func(t *testing.T) {
@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+)
+
+const (
+	// defaultHistoryLimit is the number of records returned when the request has no limit
+	defaultHistoryLimit = 100
+	// maxHistoryLimit is the maximum number of records returned at once
+	maxHistoryLimit = 1000
+)
+
+// Inspector is a gRPC service for inspecting the outcomes of audits
+type Inspector struct {
+	auditLog LogDB
+}
+
+// NewInspector creates an Inspector
+func NewInspector(auditLog LogDB) *Inspector {
+	return &Inspector{auditLog: auditLog}
+}
+
+// AuditHistory returns the outcomes of the recent audits of a node
+func (srv *Inspector) AuditHistory(ctx context.Context, req *pb.AuditHistoryRequest) (_ *pb.AuditHistoryResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	records, err := srv.auditLog.ListByNode(ctx, req.NodeId, time.Unix(req.SinceSeconds, 0), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	resp := &pb.AuditHistoryResponse{}
+	for _, record := range records {
+		resp.Records = append(resp.Records, &pb.AuditRecord{
+			SegmentHash:    record.SegmentHash,
+			StripeIndex:    record.StripeIndex,
+			PieceNum:       record.PieceNum,
+			Outcome:        string(record.Outcome),
+			Error:          record.Error,
+			DurationMs:     int64(record.Duration / time.Millisecond),
+			AuditedSeconds: record.AuditedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
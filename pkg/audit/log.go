@@ -0,0 +1,108 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// maxRecordedError limits the length of the errors kept in the audit log
+const maxRecordedError = 256
+
+// Outcome is the result of an audit for a node
+type Outcome string
+
+const (
+	// OutcomeSuccess is recorded when the node returned a correct share
+	OutcomeSuccess = Outcome("success")
+	// OutcomeFailed is recorded when the share of the node didn't match the others
+	OutcomeFailed = Outcome("failed")
+	// OutcomeCorrupted is recorded when the piece of the node didn't match its signed hash
+	OutcomeCorrupted = Outcome("corrupted")
+	// OutcomeOffline is recorded when the share couldn't be downloaded from the node
+	OutcomeOffline = Outcome("offline")
+)
+
+// Record is the evidence of the outcome of an audit for a node
+type Record struct {
+	NodeID storj.NodeID
+	// SegmentHash is the sha256 hash of the audited pointer, it identifies
+	// the segment and its pieces without revealing the path
+	SegmentHash []byte
+	StripeIndex int64
+	PieceNum    int32
+
+	Outcome Outcome
+	Error   string
+	// Duration is how long downloading the share took
+	Duration  time.Duration
+	AuditedAt time.Time
+}
+
+// LogDB stores the records of audits for a retention window, so operators
+// disputing a disqualification can be shown the evidence
+type LogDB interface {
+	// Record stores the records of an audit
+	Record(ctx context.Context, records []Record) error
+	// ListByNode returns the most recent records of a node since the given time
+	ListByNode(ctx context.Context, nodeID storj.NodeID, since time.Time, limit int) ([]Record, error)
+	// DeleteBefore deletes the records of audits before the given time
+	DeleteBefore(ctx context.Context, before time.Time) (deleted int64, err error)
+}
+
+// segmentHash returns the hash identifying the audited pointer
+func segmentHash(pointer *pb.Pointer) ([]byte, error) {
+	data, err := proto.Marshal(pointer)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(data)
+	return hash[:], nil
+}
+
+// auditRecords returns the evidence of the outcomes of an audit of stripe
+func auditRecords(stripe *Stripe, shares map[int]Share, nodes map[int]storj.NodeID, failed map[int]bool, audited time.Time) ([]Record, error) {
+	hash, err := segmentHash(stripe.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]Record, 0, len(shares))
+	for pieceNum, share := range shares {
+		record := Record{
+			NodeID:      nodes[pieceNum],
+			SegmentHash: hash,
+			StripeIndex: int64(stripe.Index),
+			PieceNum:    int32(pieceNum),
+			Outcome:     OutcomeSuccess,
+			Duration:    share.Duration,
+			AuditedAt:   audited,
+		}
+
+		switch {
+		case ErrPieceHash.Has(share.Error):
+			record.Outcome = OutcomeCorrupted
+		case share.Error != nil:
+			record.Outcome = OutcomeOffline
+		case failed[pieceNum]:
+			record.Outcome = OutcomeFailed
+		}
+		if share.Error != nil {
+			record.Error = share.Error.Error()
+			if len(record.Error) > maxRecordedError {
+				record.Error = record.Error[:maxRecordedError]
+			}
+		}
+
+		records = append(records, record)
+	}
+	return records, nil
+}
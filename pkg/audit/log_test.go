@@ -0,0 +1,82 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestLogDB(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		auditLog := db.AuditLog()
+		node := teststorj.NodeIDFromString("node")
+		other := teststorj.NodeIDFromString("other")
+
+		now := time.Now().Truncate(time.Second)
+		old := now.Add(-48 * time.Hour)
+
+		require.NoError(t, auditLog.Record(ctx, []audit.Record{
+			{NodeID: node, SegmentHash: []byte("old"), StripeIndex: 1, PieceNum: 2, Outcome: audit.OutcomeSuccess, AuditedAt: old},
+		}))
+		require.NoError(t, auditLog.Record(ctx, []audit.Record{
+			{NodeID: node, SegmentHash: []byte("new"), StripeIndex: 3, PieceNum: 4, Outcome: audit.OutcomeOffline, Error: "dial timeout", Duration: 1500 * time.Millisecond, AuditedAt: now},
+			{NodeID: other, SegmentHash: []byte("new"), StripeIndex: 3, PieceNum: 5, Outcome: audit.OutcomeSuccess, AuditedAt: now},
+		}))
+
+		{ // the records of a node are returned most recent first
+			records, err := auditLog.ListByNode(ctx, node, old, 10)
+			require.NoError(t, err)
+			require.Len(t, records, 2)
+
+			assert.Equal(t, node, records[0].NodeID)
+			assert.Equal(t, []byte("new"), records[0].SegmentHash)
+			assert.Equal(t, int64(3), records[0].StripeIndex)
+			assert.Equal(t, int32(4), records[0].PieceNum)
+			assert.Equal(t, audit.OutcomeOffline, records[0].Outcome)
+			assert.Equal(t, "dial timeout", records[0].Error)
+			assert.Equal(t, 1500*time.Millisecond, records[0].Duration)
+			assert.True(t, now.Equal(records[0].AuditedAt))
+
+			assert.Equal(t, []byte("old"), records[1].SegmentHash)
+		}
+
+		{ // only records since the given time are returned
+			records, err := auditLog.ListByNode(ctx, node, now.Add(-time.Hour), 10)
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+		}
+
+		{ // records before the retention are deleted
+			deleted, err := auditLog.DeleteBefore(ctx, now.Add(-24*time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), deleted)
+
+			records, err := auditLog.ListByNode(ctx, node, old, 10)
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+		}
+
+		{ // the inspector returns the records of a node
+			resp, err := audit.NewInspector(auditLog).AuditHistory(ctx, &pb.AuditHistoryRequest{NodeId: other})
+			require.NoError(t, err)
+			require.Len(t, resp.Records, 1)
+			assert.Equal(t, "success", resp.Records[0].Outcome)
+			assert.Equal(t, int32(5), resp.Records[0].PieceNum)
+		}
+	})
+}
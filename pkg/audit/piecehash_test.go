@@ -0,0 +1,56 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth"
+)
+
+func TestVerifyPieceHash(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	uplink, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	piece := []byte("some piece data which is stored on a node")
+	sum := sha256.Sum256(piece)
+
+	satellite, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	signed, err := auth.NewSignedData(sum[:], uplink)
+	require.NoError(t, err)
+
+	uplinkKey, err := auth.EncodePublicKey(uplink.Leaf.PublicKey)
+	require.NoError(t, err)
+	satelliteKey, err := auth.EncodePublicKey(satellite.Leaf.PublicKey)
+	require.NoError(t, err)
+	signers := [][]byte{uplinkKey, satelliteKey}
+
+	assert.NoError(t, verifyPieceHash(piece, signed, signers))
+
+	// a valid signature of anyone else is rejected
+	err = verifyPieceHash(piece, signed, [][]byte{satelliteKey})
+	assert.True(t, ErrPieceHash.Has(err))
+
+	rotted := append([]byte{}, piece...)
+	rotted[3] ^= 0x10
+	err = verifyPieceHash(rotted, signed, signers)
+	assert.True(t, ErrPieceHash.Has(err))
+
+	forged := *signed
+	forged.Signature = append([]byte{}, signed.Signature...)
+	forged.Signature[0] ^= 0x01
+	err = verifyPieceHash(piece, &forged, signers)
+	assert.True(t, ErrPieceHash.Has(err))
+}
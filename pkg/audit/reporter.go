@@ -6,6 +6,7 @@ package audit
 import (
 	"context"
 
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 )
@@ -18,6 +19,7 @@ type reporter interface {
 type Reporter struct {
 	statdb     statdb.DB
 	maxRetries int
+	reputation reputation.Config
 }
 
 // RecordAuditsInfo is a struct containing arguments/return values for RecordAudits()
@@ -28,8 +30,8 @@ type RecordAuditsInfo struct {
 }
 
 // NewReporter instantiates a reporter
-func NewReporter(sdb statdb.DB, maxRetries int) *Reporter {
-	return &Reporter{statdb: sdb, maxRetries: maxRetries}
+func NewReporter(sdb statdb.DB, maxRetries int, reputation reputation.Config) *Reporter {
+	return &Reporter{statdb: sdb, maxRetries: maxRetries, reputation: reputation}
 }
 
 // RecordAudits saves failed audit details to statdb
@@ -88,6 +90,8 @@ func (reporter *Reporter) recordAuditFailStatus(ctx context.Context, failedAudit
 			NodeID:       nodeID,
 			IsUp:         true,
 			AuditSuccess: false,
+			AuditModel:   reporter.reputation.Audit(),
+			UptimeModel:  reporter.reputation.Uptime(),
 		})
 		if err != nil {
 			failedIDs = append(failedIDs, nodeID)
@@ -105,7 +109,7 @@ func (reporter *Reporter) recordOfflineStatus(ctx context.Context, offlineNodeID
 	failedIDs := storj.NodeIDList{}
 
 	for _, nodeID := range offlineNodeIDs {
-		_, err := reporter.statdb.UpdateUptime(ctx, nodeID, false)
+		_, err := reporter.statdb.UpdateUptime(ctx, nodeID, false, reporter.reputation.Uptime())
 		if err != nil {
 			failedIDs = append(failedIDs, nodeID)
 		}
@@ -125,6 +129,8 @@ func (reporter *Reporter) recordAuditSuccessStatus(ctx context.Context, successN
 			NodeID:       nodeID,
 			IsUp:         true,
 			AuditSuccess: true,
+			AuditModel:   reporter.reputation.Audit(),
+			UptimeModel:  reporter.reputation.Uptime(),
 		})
 		if err != nil {
 			failedIDs = append(failedIDs, nodeID)
@@ -0,0 +1,73 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"math/rand"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// Reservoir samples a fixed number of remote segments uniformly at random
+// during a metainfo loop iteration
+type Reservoir struct {
+	size  int
+	seen  int64
+	paths []storj.Path
+}
+
+// NewReservoir creates a Reservoir which keeps at most size segments
+func NewReservoir(size int) *Reservoir {
+	if size <= 0 {
+		size = 1
+	}
+	return &Reservoir{size: size}
+}
+
+// RemoteSegment samples the segment into the reservoir
+func (reservoir *Reservoir) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	// segments without data don't have any stripes to audit
+	if pointer.GetSegmentSize() == 0 {
+		return nil
+	}
+
+	reservoir.seen++
+	if len(reservoir.paths) < reservoir.size {
+		reservoir.paths = append(reservoir.paths, path)
+		return nil
+	}
+	if index := rand.Int63n(reservoir.seen); index < int64(reservoir.size) {
+		reservoir.paths[index] = path
+	}
+	return nil
+}
+
+// InlineSegment ignores inline segments, they're stored on the satellite
+func (reservoir *Reservoir) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
+
+// Len returns the number of sampled segments which haven't been popped yet
+func (reservoir *Reservoir) Len() int {
+	return len(reservoir.paths)
+}
+
+// Pop removes and returns a sampled segment
+func (reservoir *Reservoir) Pop() (path storj.Path, ok bool) {
+	if len(reservoir.paths) == 0 {
+		return "", false
+	}
+	last := len(reservoir.paths) - 1
+	path = reservoir.paths[last]
+	reservoir.paths = reservoir.paths[:last]
+	return path, true
+}
+
+// Reset clears the reservoir before sampling a new iteration
+func (reservoir *Reservoir) Reset() {
+	reservoir.seen = 0
+	reservoir.paths = reservoir.paths[:0]
+}
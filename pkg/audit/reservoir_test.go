@@ -0,0 +1,47 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestReservoir(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	reservoir := audit.NewReservoir(3)
+
+	// empty segments can't be audited
+	require.NoError(t, reservoir.RemoteSegment(ctx, "empty", &pb.Pointer{}))
+	assert.Equal(t, 0, reservoir.Len())
+
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		path := fmt.Sprintf("segment%d", i)
+		seen[path] = true
+		require.NoError(t, reservoir.RemoteSegment(ctx, path, &pb.Pointer{SegmentSize: 10}))
+	}
+	assert.Equal(t, 3, reservoir.Len())
+
+	sampled := map[string]bool{}
+	for reservoir.Len() > 0 {
+		path, ok := reservoir.Pop()
+		require.True(t, ok)
+		assert.True(t, seen[path])
+		assert.False(t, sampled[path], "segment sampled twice")
+		sampled[path] = true
+	}
+
+	_, ok := reservoir.Pop()
+	assert.False(t, ok)
+}
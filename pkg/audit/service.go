@@ -9,19 +9,27 @@ import (
 
 	"go.uber.org/zap"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/transport"
 )
 
 // Config contains configurable values for audit service
 type Config struct {
-	MaxRetriesStatDB int           `help:"max number of times to attempt updating a statdb batch" default:"3"`
-	Interval         time.Duration `help:"how frequently segments are audited" default:"30s"`
+	MaxRetriesStatDB  int           `help:"max number of times to attempt updating a statdb batch" default:"3"`
+	Interval          time.Duration `help:"how frequently segments are audited" default:"30s"`
+	VerifyPieceHashes bool          `help:"download whole pieces instead of single shares to verify them against the hash signed by the uploader, multiplies the audit bandwidth" default:"false"`
+	ReservoirSize     int           `help:"number of segments sampled for auditing on each metainfo loop iteration" default:"64"`
+	LogRetention      time.Duration `help:"how long the outcomes of audits are kept as evidence for disputes, 0 doesn't keep them" default:"2160h0m0s"`
 }
 
+// logCleanupInterval is how often records older than the retention are deleted
+const logCleanupInterval = time.Hour
+
 // Service helps coordinate Cursor and Verifier to run the audit process continuously
 type Service struct {
 	log *zap.Logger
@@ -29,39 +37,51 @@ type Service struct {
 	Cursor   *Cursor
 	Verifier *Verifier
 	Reporter reporter
+	health   pointerdb.HealthDB
+
+	auditLog     LogDB
+	logRetention time.Duration
+	lastCleanup  time.Time
 
-	ticker *time.Ticker
+	Loop sync2.Cycle
 }
 
-// NewService instantiates a Service with access to a Cursor and Verifier
-func NewService(log *zap.Logger, sdb statdb.DB, interval time.Duration, maxRetries int, pointers *pointerdb.Service, allocation *pointerdb.AllocationSigner, transport transport.Client, overlay *overlay.Cache, identity *identity.FullIdentity) (service *Service, err error) {
-	return &Service{
+// NewService instantiates a Service with access to a Cursor and Verifier,
+// the times segments are audited are recorded in health and the outcomes
+// for the nodes are kept in auditLog for logRetention
+func NewService(log *zap.Logger, sdb statdb.DB, health pointerdb.HealthDB, auditLog LogDB, logRetention time.Duration, interval time.Duration, maxRetries int, verifyHashes bool, reservoirSize int, reputation reputation.Config, pointers *pointerdb.Service, loop *pointerdb.Loop, allocation *pointerdb.AllocationSigner, transport transport.Client, overlay *overlay.Cache, identity *identity.FullIdentity) (service *Service, err error) {
+	service = &Service{
 		log: log,
 		// TODO: instead of overlay.Client use overlay.Service
-		Cursor:   NewCursor(pointers, allocation, identity),
-		Verifier: NewVerifier(transport, overlay, identity),
-		Reporter: NewReporter(sdb, maxRetries),
-
-		ticker: time.NewTicker(interval),
-	}, nil
+		Cursor:       NewCursor(pointers, loop, allocation, identity, reservoirSize),
+		Verifier:     NewVerifier(transport, overlay, identity, verifyHashes),
+		Reporter:     NewReporter(sdb, maxRetries, reputation),
+		health:       health,
+		auditLog:     auditLog,
+		logRetention: logRetention,
+	}
+	service.Loop.SetInterval(interval)
+	return service, nil
 }
 
 // Run runs auditing service
 func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	service.log.Info("Audit cron is starting up")
-	for {
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
 		err := service.process(ctx)
 		if err != nil {
 			service.log.Error("process", zap.Error(err))
 		}
+		return nil
+	})
+}
 
-		select {
-		case <-service.ticker.C:
-		case <-ctx.Done():
-			return ctx.Err()
-		}
-	}
+// Close stops the auditing service
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
 }
 
 // process picks a random stripe and verifies correctness
@@ -74,11 +94,17 @@ func (service *Service) process(ctx context.Context) error {
 		return nil
 	}
 
-	verifiedNodes, err := service.Verifier.verify(ctx, stripe)
+	verifiedNodes, records, err := service.Verifier.verify(ctx, stripe)
 	if err != nil {
 		return err
 	}
 
+	if err := service.health.RecordAudit(ctx, stripe.Path, time.Now()); err != nil {
+		service.log.Error("recording audit", zap.String("path", stripe.Path), zap.Error(err))
+	}
+
+	service.logAudit(ctx, records)
+
 	// TODO(moby) we need to decide if we want to do something with nodes that the reporter failed to update
 	_, err = service.Reporter.RecordAudits(ctx, verifiedNodes)
 	if err != nil {
@@ -87,3 +113,29 @@ func (service *Service) process(ctx context.Context) error {
 
 	return nil
 }
+
+// logAudit keeps the records of an audit and deletes the ones older than the retention
+func (service *Service) logAudit(ctx context.Context, records []Record) {
+	if service.logRetention <= 0 {
+		return
+	}
+
+	if err := service.auditLog.Record(ctx, records); err != nil {
+		service.log.Error("logging audit", zap.Error(err))
+	}
+
+	now := time.Now()
+	if now.Sub(service.lastCleanup) < logCleanupInterval {
+		return
+	}
+	service.lastCleanup = now
+
+	deleted, err := service.auditLog.DeleteBefore(ctx, now.Add(-service.logRetention))
+	if err != nil {
+		service.log.Error("deleting expired audit records", zap.Error(err))
+		return
+	}
+	if deleted > 0 {
+		service.log.Debug("deleted expired audit records", zap.Int64("count", deleted))
+	}
+}
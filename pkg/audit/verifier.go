@@ -6,27 +6,36 @@ package audit
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"io"
+	"time"
 
 	"github.com/vivint/infectious"
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/ranger"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 )
 
 var mon = monkit.Package()
 
+// ErrPieceHash is returned when a downloaded piece does not match its signed hash
+var ErrPieceHash = errs.Class("piece hash verification failed")
+
 // Share represents required information about an audited share
 type Share struct {
 	Error       error
 	PieceNumber int
 	Data        []byte
+	// Duration is how long downloading the share took
+	Duration time.Duration
 }
 
 // Verifier helps verify the correctness of a given stripe
@@ -40,25 +49,27 @@ type downloader interface {
 
 // defaultDownloader downloads shares from networked storage nodes
 type defaultDownloader struct {
-	transport transport.Client
-	overlay   *overlay.Cache
-	identity  *identity.FullIdentity
+	transport    transport.Client
+	overlay      *overlay.Cache
+	identity     *identity.FullIdentity
+	verifyHashes bool
 	reporter
 }
 
 // newDefaultDownloader creates a defaultDownloader
-func newDefaultDownloader(transport transport.Client, overlay *overlay.Cache, id *identity.FullIdentity) *defaultDownloader {
-	return &defaultDownloader{transport: transport, overlay: overlay, identity: id}
+func newDefaultDownloader(transport transport.Client, overlay *overlay.Cache, id *identity.FullIdentity, verifyHashes bool) *defaultDownloader {
+	return &defaultDownloader{transport: transport, overlay: overlay, identity: id, verifyHashes: verifyHashes}
 }
 
-// NewVerifier creates a Verifier
-func NewVerifier(transport transport.Client, overlay *overlay.Cache, id *identity.FullIdentity) *Verifier {
-	return &Verifier{downloader: newDefaultDownloader(transport, overlay, id)}
+// NewVerifier creates a Verifier, when verifyHashes is set whole pieces are
+// downloaded and checked against the hash signed by the uploader
+func NewVerifier(transport transport.Client, overlay *overlay.Cache, id *identity.FullIdentity, verifyHashes bool) *Verifier {
+	return &Verifier{downloader: newDefaultDownloader(transport, overlay, id, verifyHashes)}
 }
 
 // getShare use piece store clients to download shares from a given node
 func (d *defaultDownloader) getShare(ctx context.Context, stripeIndex, shareSize, pieceNumber int,
-	id psclient.PieceID, pieceSize int64, fromNode *pb.Node, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (s Share, err error) {
+	id psclient.PieceID, pieceSize int64, pieceHash *pb.SignedMessage, signers [][]byte, fromNode *pb.Node, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (s Share, err error) {
 	// TODO: too many arguments use a struct
 	defer mon.Task()(&ctx)(&err)
 
@@ -84,6 +95,10 @@ func (d *defaultDownloader) getShare(ctx context.Context, stripeIndex, shareSize
 
 	offset := shareSize * stripeIndex
 
+	if d.verifyHashes && pieceHash != nil && len(signers) > 0 {
+		return d.getVerifiedShare(ctx, rr, offset, shareSize, pieceNumber, pieceHash, signers)
+	}
+
 	rc, err := rr.Range(ctx, int64(offset), int64(shareSize))
 	if err != nil {
 		return s, err
@@ -104,6 +119,73 @@ func (d *defaultDownloader) getShare(ctx context.Context, stripeIndex, shareSize
 	return s, nil
 }
 
+// getVerifiedShare downloads the whole piece, checks it against the piece hash
+// signed by one of the signers and extracts the share at the given offset
+func (d *defaultDownloader) getVerifiedShare(ctx context.Context, rr ranger.Ranger, offset, shareSize, pieceNumber int, pieceHash *pb.SignedMessage, signers [][]byte) (s Share, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rc, err := rr.Range(ctx, 0, rr.Size())
+	if err != nil {
+		return s, err
+	}
+	defer func() { err = errs.Combine(err, rc.Close()) }()
+
+	piece := make([]byte, rr.Size())
+	_, err = io.ReadFull(rc, piece)
+	if err != nil {
+		return s, err
+	}
+
+	if err := verifyPieceHash(piece, pieceHash, signers); err != nil {
+		return s, err
+	}
+
+	if offset+shareSize > len(piece) {
+		return s, Error.New("share at offset %d out of piece bounds %d", offset, len(piece))
+	}
+
+	return Share{
+		Error:       nil,
+		PieceNumber: pieceNumber,
+		Data:        append([]byte{}, piece[offset:offset+shareSize]...),
+	}, nil
+}
+
+// verifyPieceHash checks that the hash is signed by one of the expected signers
+// and that the piece data matches it
+func verifyPieceHash(piece []byte, pieceHash *pb.SignedMessage, signers [][]byte) error {
+	expected := false
+	for _, signer := range signers {
+		expected = expected || bytes.Equal(pieceHash.GetPublicKey(), signer)
+	}
+	if !expected {
+		return ErrPieceHash.New("hash is not signed by the uploader or the satellite")
+	}
+	if err := auth.NewSignedMessageVerifier()(pieceHash); err != nil {
+		return ErrPieceHash.Wrap(err)
+	}
+	sum := sha256.Sum256(piece)
+	if !bytes.Equal(sum[:], pieceHash.GetData()) {
+		return ErrPieceHash.New("piece data does not match signed hash")
+	}
+	return nil
+}
+
+// hashSigners returns the keys the piece hashes of the pointer may be signed
+// with, the uplink which uploaded the segment and the satellite which repaired
+// it. Segments put before the uploader was recorded have none.
+func (d *defaultDownloader) hashSigners(pointer *pb.Pointer) ([][]byte, error) {
+	uploader := pointer.GetRemote().GetUploaderKey()
+	if len(uploader) == 0 {
+		return nil, nil
+	}
+	satellite, err := auth.EncodePublicKey(d.identity.Leaf.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{uploader, satellite}, nil
+}
+
 // Download Shares downloads shares from the nodes where remote pieces are located
 func (d *defaultDownloader) DownloadShares(ctx context.Context, pointer *pb.Pointer,
 	stripeIndex int, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (shares map[int]Share, nodes map[int]storj.NodeID, err error) {
@@ -127,13 +209,18 @@ func (d *defaultDownloader) DownloadShares(ctx context.Context, pointer *pb.Poin
 
 	shareSize := int(pointer.Remote.Redundancy.GetErasureShareSize())
 	pieceID := psclient.PieceID(pointer.Remote.GetPieceId())
+	signers, err := d.hashSigners(pointer)
+	if err != nil {
+		return nil, nodes, err
+	}
 
 	// this downloads shares from nodes at the given stripe index
 	for i, node := range nodeSlice {
 		paddedSize := calcPadded(pointer.GetSegmentSize(), shareSize)
 		pieceSize := paddedSize / int64(pointer.Remote.Redundancy.GetMinReq())
 
-		s, err := d.getShare(ctx, stripeIndex, shareSize, int(pieces[i].PieceNum), pieceID, pieceSize, node, pba, authorization)
+		start := time.Now()
+		s, err := d.getShare(ctx, stripeIndex, shareSize, int(pieces[i].PieceNum), pieceID, pieceSize, pieces[i].GetHash(), signers, node, pba, authorization)
 		if err != nil {
 			s = Share{
 				Error:       err,
@@ -141,6 +228,7 @@ func (d *defaultDownloader) DownloadShares(ctx context.Context, pointer *pb.Poin
 				Data:        nil,
 			}
 		}
+		s.Duration = time.Since(start)
 
 		shares[s.PieceNumber] = s
 		nodes[s.PieceNumber] = nodeIds[i]
@@ -197,18 +285,23 @@ func calcPadded(size int64, blockSize int) int64 {
 	return size + int64(blockSize) - mod
 }
 
-// verify downloads shares then verifies the data correctness at the given stripe
-func (verifier *Verifier) verify(ctx context.Context, stripe *Stripe) (verifiedNodes *RecordAuditsInfo, err error) {
+// verify downloads shares then verifies the data correctness at the given stripe,
+// records are the evidence of the outcome for every node
+func (verifier *Verifier) verify(ctx context.Context, stripe *Stripe) (verifiedNodes *RecordAuditsInfo, records []Record, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	audited := time.Now()
 	shares, nodes, err := verifier.downloader.DownloadShares(ctx, stripe.Segment, stripe.Index, stripe.PBA, stripe.Authorization)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	var offlineNodes storj.NodeIDList
+	var offlineNodes, corruptedNodes storj.NodeIDList
 	for pieceNum := range shares {
-		if shares[pieceNum].Error != nil {
+		switch err := shares[pieceNum].Error; {
+		case ErrPieceHash.Has(err):
+			corruptedNodes = append(corruptedNodes, nodes[pieceNum])
+		case err != nil:
 			offlineNodes = append(offlineNodes, nodes[pieceNum])
 		}
 	}
@@ -218,21 +311,30 @@ func (verifier *Verifier) verify(ctx context.Context, stripe *Stripe) (verifiedN
 	total := int(pointer.Remote.Redundancy.GetTotal())
 	pieceNums, err := auditShares(ctx, required, total, shares)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	var failedNodes storj.NodeIDList
+	// nodes returning data which does not match the signed piece hash fail even
+	// when the remaining shares are enough to reconstruct the stripe
+	failedNodes := corruptedNodes
+	failedPieces := make(map[int]bool, len(pieceNums))
 	for _, pieceNum := range pieceNums {
 		failedNodes = append(failedNodes, nodes[pieceNum])
+		failedPieces[pieceNum] = true
 	}
 
 	successNodes := getSuccessNodes(ctx, nodes, failedNodes, offlineNodes)
 
+	records, err = auditRecords(stripe, shares, nodes, failedPieces, audited)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return &RecordAuditsInfo{
 		SuccessNodeIDs: successNodes,
 		FailNodeIDs:    failedNodes,
 		OfflineNodeIDs: offlineNodes,
-	}, nil
+	}, records, nil
 }
 
 // getSuccessNodes uses the failed nodes and offline nodes arrays to determine which nodes passed the audit
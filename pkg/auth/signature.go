@@ -4,6 +4,7 @@
 package auth
 
 import (
+	"crypto"
 	"crypto/ecdsa"
 
 	"github.com/gtank/cryptopasta"
@@ -30,14 +31,18 @@ func GenerateSignature(data []byte, identity *identity.FullIdentity) ([]byte, er
 	return signature, nil
 }
 
-// NewSignedMessage creates instance of signed message
-func NewSignedMessage(signature []byte, identity *identity.FullIdentity) (*pb.SignedMessage, error) {
-	k, ok := identity.Leaf.PublicKey.(*ecdsa.PublicKey)
+// EncodePublicKey encodes a leaf public key the way signed messages carry it
+func EncodePublicKey(key crypto.PublicKey) ([]byte, error) {
+	k, ok := key.(*ecdsa.PublicKey)
 	if !ok {
-		return nil, peertls.ErrUnsupportedKey.New("%T", identity.Leaf.PublicKey)
+		return nil, peertls.ErrUnsupportedKey.New("%T", key)
 	}
+	return cryptopasta.EncodePublicKey(k)
+}
 
-	encodedKey, err := cryptopasta.EncodePublicKey(k)
+// NewSignedMessage creates instance of signed message
+func NewSignedMessage(signature []byte, identity *identity.FullIdentity) (*pb.SignedMessage, error) {
+	encodedKey, err := EncodePublicKey(identity.Leaf.PublicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -48,6 +53,21 @@ func NewSignedMessage(signature []byte, identity *identity.FullIdentity) (*pb.Si
 	}, nil
 }
 
+// NewSignedData signs data with identity and wraps it into a signed message
+func NewSignedData(data []byte, identity *identity.FullIdentity) (*pb.SignedMessage, error) {
+	signature, err := GenerateSignature(data, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := NewSignedMessage(signature, identity)
+	if err != nil {
+		return nil, err
+	}
+	signed.Data = data
+	return signed, nil
+}
+
 // SignedMessageVerifier checks if provided signed message can be verified
 type SignedMessageVerifier func(signature *pb.SignedMessage) error
 
@@ -0,0 +1,131 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package bloomfilter implements the bloom filters satellites send to the
+// storage nodes to tell them which pieces they should keep
+package bloomfilter
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"math/rand"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default bloomfilter errs class
+var Error = errs.Class("bloom filter error")
+
+const (
+	version = 1
+	// headerSize is the size of the version, seed and hash count of an encoded filter
+	headerSize = 3
+	// maxHashCount is the maximum number of hash functions of a filter
+	maxHashCount = 32
+)
+
+// Filter is a bloom filter, it never reports that it doesn't contain an
+// added element, but it reports a small fraction of the other elements too
+type Filter struct {
+	seed      byte
+	hashCount byte
+	table     []byte
+}
+
+// NewOptimal returns a filter which reports falsePositiveRate of the
+// elements it doesn't contain when expectedElements are added to it
+func NewOptimal(expectedElements int, falsePositiveRate float64) *Filter {
+	if expectedElements < 1 {
+		expectedElements = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.1
+	}
+
+	bits := math.Ceil(-float64(expectedElements) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	hashCount := int(math.Round(bits / float64(expectedElements) * math.Ln2))
+	if hashCount < 1 {
+		hashCount = 1
+	}
+	if hashCount > maxHashCount {
+		hashCount = maxHashCount
+	}
+
+	// a random seed ensures that different elements are false positives each time
+	return newExplicit(byte(rand.Intn(256)), byte(hashCount), int(math.Ceil(bits/8)))
+}
+
+// newExplicit returns an empty filter with the given parameters
+func newExplicit(seed, hashCount byte, sizeInBytes int) *Filter {
+	if sizeInBytes < 1 {
+		sizeInBytes = 1
+	}
+	return &Filter{
+		seed:      seed,
+		hashCount: hashCount,
+		table:     make([]byte, sizeInBytes),
+	}
+}
+
+// NewFromBytes decodes a filter encoded with Bytes
+func NewFromBytes(data []byte) (*Filter, error) {
+	if len(data) <= headerSize {
+		return nil, Error.New("not enough data")
+	}
+	if data[0] != version {
+		return nil, Error.New("unsupported version %d", data[0])
+	}
+	if data[2] < 1 || data[2] > maxHashCount {
+		return nil, Error.New("invalid hash count %d", data[2])
+	}
+
+	filter := newExplicit(data[1], data[2], len(data)-headerSize)
+	copy(filter.table, data[headerSize:])
+	return filter, nil
+}
+
+// Add adds an element to the filter
+func (filter *Filter) Add(element []byte) {
+	h1, h2 := filter.hash(element)
+	bits := uint64(len(filter.table)) * 8
+	for i := uint64(0); i < uint64(filter.hashCount); i++ {
+		bit := (h1 + i*h2) % bits
+		filter.table[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Contains returns whether the element may have been added to the filter,
+// it returns false only when the element definitely wasn't added
+func (filter *Filter) Contains(element []byte) bool {
+	h1, h2 := filter.hash(element)
+	bits := uint64(len(filter.table)) * 8
+	for i := uint64(0); i < uint64(filter.hashCount); i++ {
+		bit := (h1 + i*h2) % bits
+		if filter.table[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// hash returns the two hashes of element the hash functions are derived from
+func (filter *Filter) hash(element []byte) (h1, h2 uint64) {
+	hasher := sha256.New()
+	_, _ = hasher.Write([]byte{filter.seed})
+	_, _ = hasher.Write(element)
+	sum := hasher.Sum(nil)
+	return binary.BigEndian.Uint64(sum[0:8]), binary.BigEndian.Uint64(sum[8:16])
+}
+
+// Size returns the size of the encoded filter in bytes
+func (filter *Filter) Size() int {
+	return headerSize + len(filter.table)
+}
+
+// Bytes encodes the filter, so it can be decoded with NewFromBytes
+func (filter *Filter) Bytes() []byte {
+	data := make([]byte, 0, filter.Size())
+	data = append(data, version, filter.seed, filter.hashCount)
+	return append(data, filter.table...)
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/bloomfilter"
+)
+
+func TestFilter(t *testing.T) {
+	const count = 10000
+	const falsePositiveRate = 0.1
+
+	filter := bloomfilter.NewOptimal(count, falsePositiveRate)
+	for i := 0; i < count; i++ {
+		filter.Add([]byte(fmt.Sprintf("added-%d", i)))
+	}
+
+	decoded, err := bloomfilter.NewFromBytes(filter.Bytes())
+	require.NoError(t, err)
+
+	for _, filter := range []*bloomfilter.Filter{filter, decoded} {
+		for i := 0; i < count; i++ {
+			assert.True(t, filter.Contains([]byte(fmt.Sprintf("added-%d", i))))
+		}
+
+		falsePositives := 0
+		for i := 0; i < count; i++ {
+			if filter.Contains([]byte(fmt.Sprintf("missing-%d", i))) {
+				falsePositives++
+			}
+		}
+		assert.InDelta(t, falsePositiveRate, float64(falsePositives)/count, falsePositiveRate/2)
+	}
+}
+
+func TestNewFromBytesInvalid(t *testing.T) {
+	for _, data := range [][]byte{
+		nil,
+		{1, 0, 1},
+		{2, 0, 1, 0},
+		{1, 0, 0, 0},
+		{1, 0, 33, 0},
+	} {
+		_, err := bloomfilter.NewFromBytes(data)
+		assert.Error(t, err, fmt.Sprint(data))
+	}
+}
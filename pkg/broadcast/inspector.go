@@ -0,0 +1,65 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package broadcast
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// Inspector is a gRPC service for broadcasting notices and inspecting their delivery
+type Inspector struct {
+	service *Service
+}
+
+// NewInspector creates an Inspector
+func NewInspector(service *Service) *Inspector {
+	return &Inspector{service: service}
+}
+
+// Broadcast signs a notice and queues it for delivery to the nodes the satellite contacted recently
+func (srv *Inspector) Broadcast(ctx context.Context, req *pb.BroadcastRequest) (_ *pb.BroadcastResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	status, err := srv.service.Broadcast(ctx, req.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BroadcastResponse{
+		Id:        status.Notice.Id,
+		NodeCount: int64(len(status.recipients)),
+	}, nil
+}
+
+// BroadcastStatus returns how many nodes a broadcast notice was delivered to
+func (srv *Inspector) BroadcastStatus(ctx context.Context, req *pb.BroadcastStatusRequest) (_ *pb.BroadcastStatusResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	status := srv.service.Status(req.Id)
+	if status == nil {
+		return nil, Error.New("broadcast %x not found", req.Id)
+	}
+
+	summary := status.Summary()
+	resp := &pb.BroadcastStatusResponse{
+		Message:        status.Notice.Message,
+		CreatedSeconds: status.Created.Unix(),
+		Pending:        int64(summary.Pending),
+		Delivered:      int64(summary.Delivered),
+		Failed:         int64(summary.Failed),
+	}
+	for _, failure := range summary.Failures {
+		delivery := &pb.NoticeDelivery{
+			NodeId:   failure.NodeID,
+			Attempts: int32(failure.Attempts),
+		}
+		if failure.Err != nil {
+			delivery.Error = failure.Err.Error()
+		}
+		resp.Failures = append(resp.Failures, delivery)
+	}
+	return resp, nil
+}
@@ -0,0 +1,252 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package broadcast
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default broadcast errs class
+	Error = errs.Class("broadcast error")
+)
+
+const (
+	// maxStatuses is how many of the most recent broadcasts are tracked
+	maxStatuses = 32
+	// listLimit is how many nodes are listed at once from the overlay cache
+	listLimit = 100
+)
+
+// Config contains the configurable values of broadcasting notices to nodes
+type Config struct {
+	ContactWindow time.Duration `help:"how recently a node must have been contacted successfully to receive broadcast notices" default:"24h0m0s"`
+	Concurrency   int           `help:"how many nodes a notice is delivered to at once" default:"16"`
+	MaxAttempts   int           `help:"how many times the delivery of a notice to a node is attempted" default:"3"`
+	RetryInterval time.Duration `help:"how long to wait before retrying the failed deliveries of a notice" default:"1m0s"`
+	Timeout       time.Duration `help:"timeout of delivering a notice to a single node" default:"10s"`
+}
+
+// Service signs notices with the satellite identity and delivers them to
+// the nodes the satellite contacted recently
+type Service struct {
+	log      *zap.Logger
+	identity *identity.FullIdentity
+	cache    *overlay.Cache
+	pings    overlay.PingDB
+	kad      *kademlia.Kademlia
+	config   Config
+
+	queue chan *Status
+
+	mu       sync.Mutex
+	statuses map[string]*Status
+	order    []string // ids of the tracked broadcasts, oldest first
+}
+
+// NewService returns a new broadcast service
+func NewService(log *zap.Logger, identity *identity.FullIdentity, cache *overlay.Cache, pings overlay.PingDB, kad *kademlia.Kademlia, config Config) *Service {
+	return &Service{
+		log:      log,
+		identity: identity,
+		cache:    cache,
+		pings:    pings,
+		kad:      kad,
+		config:   config,
+		queue:    make(chan *Status, maxStatuses),
+		statuses: make(map[string]*Status),
+	}
+}
+
+// Broadcast signs a notice with message and queues it for delivery to the
+// nodes contacted within the contact window
+func (service *Service) Broadcast(ctx context.Context, message string) (_ *Status, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if message == "" {
+		return nil, Error.New("missing message")
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	notice := &pb.Notice{
+		Id:             id[:],
+		SatelliteId:    service.identity.ID,
+		Message:        message,
+		CreatedUnixSec: time.Now().Unix(),
+	}
+	if err := auth.SignMessage(notice, *service.identity); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	recipients, err := service.recipients(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := newStatus(notice, recipients, service.config.MaxAttempts)
+	service.track(status)
+
+	select {
+	case service.queue <- status:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	service.log.Info("broadcasting notice",
+		zap.String("id", hex.EncodeToString(notice.Id)),
+		zap.Int("recipients", len(recipients)),
+		zap.String("message", message),
+	)
+	return status, nil
+}
+
+// Status returns the delivery status of a broadcast notice, nil when the
+// notice is not tracked
+func (service *Service) Status(id []byte) *Status {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.statuses[hex.EncodeToString(id)]
+}
+
+// Run delivers the queued notices
+func (service *Service) Run(ctx context.Context) error {
+	for {
+		select {
+		case status := <-service.queue:
+			if err := service.deliver(ctx, status); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// track starts tracking status and stops tracking the oldest broadcast
+// when there are too many
+func (service *Service) track(status *Status) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if len(service.order) >= maxStatuses {
+		delete(service.statuses, service.order[0])
+		service.order = service.order[1:]
+	}
+
+	id := hex.EncodeToString(status.Notice.Id)
+	service.statuses[id] = status
+	service.order = append(service.order, id)
+}
+
+// recipients returns the nodes which were contacted successfully within the
+// contact window
+func (service *Service) recipients(ctx context.Context) (recipients []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	since := time.Now().Add(-service.config.ContactWindow)
+
+	var cursor storj.NodeID
+	for {
+		nodes, err := service.cache.List(ctx, cursor, listLimit)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, node := range nodes {
+			if node == nil || node.Type != pb.NodeType_STORAGE {
+				continue
+			}
+
+			pings, err := service.pings.Get(ctx, node.Id)
+			if err == overlay.ErrNodeNotFound {
+				continue
+			}
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			if pings.LastSuccessAt.After(since) {
+				recipients = append(recipients, node)
+			}
+		}
+
+		if len(nodes) < listLimit {
+			return recipients, nil
+		}
+		cursor = nodes[len(nodes)-1].Id
+	}
+}
+
+// deliver delivers the notice of status to its recipients, retrying the
+// failed deliveries until they have been attempted the maximum number of times
+func (service *Service) deliver(ctx context.Context, status *Status) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pending := status.recipients
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt > 0 && !sync2.Sleep(ctx, service.config.RetryInterval) {
+			return ctx.Err()
+		}
+
+		var mu sync.Mutex
+		var retry []*pb.Node
+
+		limiter := sync2.NewLimiter(service.config.Concurrency)
+		for _, node := range pending {
+			node := node
+			limiter.Go(ctx, func() {
+				err := service.notify(ctx, node, status.Notice)
+				if err != nil {
+					service.log.Debug("delivering notice failed", zap.String("node", node.Id.String()), zap.Error(err))
+				}
+				if status.Record(node.Id, err) {
+					mu.Lock()
+					retry = append(retry, node)
+					mu.Unlock()
+				}
+			})
+		}
+		limiter.Wait()
+
+		pending = retry
+	}
+
+	summary := status.Summary()
+	mon.IntVal("broadcast_delivered").Observe(int64(summary.Delivered))
+	mon.IntVal("broadcast_failed").Observe(int64(summary.Failed))
+	service.log.Info("broadcast finished",
+		zap.String("id", hex.EncodeToString(status.Notice.Id)),
+		zap.Int("delivered", summary.Delivered),
+		zap.Int("failed", summary.Failed),
+	)
+	return nil
+}
+
+// notify delivers notice to a single node
+func (service *Service) notify(ctx context.Context, node *pb.Node, notice *pb.Notice) error {
+	ctx, cancel := context.WithTimeout(ctx, service.config.Timeout)
+	defer cancel()
+	return service.kad.Notify(ctx, *node, notice)
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package broadcast
+
+import (
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// Delivery is the outcome of delivering a notice to a single node
+type Delivery struct {
+	NodeID    storj.NodeID
+	Attempts  int
+	Delivered bool
+	Err       error // of the last attempt
+}
+
+// Summary counts the deliveries of a notice
+type Summary struct {
+	Pending   int
+	Delivered int
+	Failed    int
+	Failures  []Delivery
+}
+
+// Status tracks the delivery of a broadcast notice to each recipient
+type Status struct {
+	Notice  *pb.Notice
+	Created time.Time
+
+	recipients  []*pb.Node
+	maxAttempts int
+
+	mu         sync.Mutex
+	deliveries map[storj.NodeID]*Delivery
+}
+
+// newStatus returns the status of a notice which is not yet delivered to any of recipients
+func newStatus(notice *pb.Notice, recipients []*pb.Node, maxAttempts int) *Status {
+	status := &Status{
+		Notice:      notice,
+		Created:     time.Unix(notice.CreatedUnixSec, 0),
+		recipients:  recipients,
+		maxAttempts: maxAttempts,
+		deliveries:  make(map[storj.NodeID]*Delivery, len(recipients)),
+	}
+	for _, node := range recipients {
+		status.deliveries[node.Id] = &Delivery{NodeID: node.Id}
+	}
+	return status
+}
+
+// Record updates the delivery to a node with the outcome of an attempt,
+// it returns whether the delivery should be retried
+func (status *Status) Record(nodeID storj.NodeID, err error) (retry bool) {
+	status.mu.Lock()
+	defer status.mu.Unlock()
+
+	delivery, ok := status.deliveries[nodeID]
+	if !ok {
+		return false
+	}
+
+	delivery.Attempts++
+	delivery.Delivered = err == nil
+	delivery.Err = err
+	return err != nil && delivery.Attempts < status.maxAttempts
+}
+
+// Summary returns the counts of pending, delivered and failed deliveries,
+// a delivery fails once it has been attempted the maximum number of times
+func (status *Status) Summary() Summary {
+	status.mu.Lock()
+	defer status.mu.Unlock()
+
+	var summary Summary
+	for _, delivery := range status.deliveries {
+		switch {
+		case delivery.Delivered:
+			summary.Delivered++
+		case delivery.Attempts >= status.maxAttempts:
+			summary.Failed++
+			summary.Failures = append(summary.Failures, *delivery)
+		default:
+			summary.Pending++
+		}
+	}
+	return summary
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package broadcast
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestStatus(t *testing.T) {
+	a, b, c := teststorj.MockNode("a"), teststorj.MockNode("b"), teststorj.MockNode("c")
+	status := newStatus(&pb.Notice{Message: "upgrade"}, []*pb.Node{a, b, c}, 2)
+
+	summary := status.Summary()
+	assert.Equal(t, 3, summary.Pending)
+	assert.Equal(t, 0, summary.Delivered)
+	assert.Equal(t, 0, summary.Failed)
+
+	dialFailed := errors.New("dial failed")
+	assert.False(t, status.Record(a.Id, nil))
+	assert.True(t, status.Record(b.Id, dialFailed))
+	assert.True(t, status.Record(c.Id, dialFailed))
+
+	summary = status.Summary()
+	assert.Equal(t, 2, summary.Pending)
+	assert.Equal(t, 1, summary.Delivered)
+
+	assert.False(t, status.Record(b.Id, nil))
+	assert.False(t, status.Record(c.Id, dialFailed))
+
+	summary = status.Summary()
+	assert.Equal(t, 0, summary.Pending)
+	assert.Equal(t, 2, summary.Delivered)
+	assert.Equal(t, 1, summary.Failed)
+	if assert.Len(t, summary.Failures, 1) {
+		assert.Equal(t, c.Id, summary.Failures[0].NodeID)
+		assert.Equal(t, 2, summary.Failures[0].Attempts)
+		assert.Equal(t, dialFailed, summary.Failures[0].Err)
+	}
+
+	// deliveries to nodes which aren't recipients are ignored
+	assert.False(t, status.Record(teststorj.NodeIDFromString("d"), dialFailed))
+}
@@ -27,15 +27,19 @@ var (
 // Config is a configuration struct that is everything you need to start an
 // agreement receiver responsibility
 type Config struct {
+	ExpirationTolerance time.Duration `help:"how long expired agreements are still accepted, storage nodes delay sending them by up to their check interval, batch window and jitter" default:"3h0m0s"`
 }
 
 //UplinkStat contains information about an uplink's returned bandwidth agreement
 type UplinkStat struct {
-	NodeID            storj.NodeID
-	TotalBytes        int64
-	PutActionCount    int
-	GetActionCount    int
-	TotalTransactions int
+	NodeID               storj.NodeID
+	TotalBytes           int64
+	PutActionCount       int
+	GetActionCount       int
+	GetAuditActionCount  int
+	GetRepairActionCount int
+	PutRepairActionCount int
+	TotalTransactions    int
 }
 
 // DB stores bandwidth agreements.
@@ -51,14 +55,15 @@ type DB interface {
 // Server is an implementation of the pb.BandwidthServer interface
 type Server struct {
 	db     DB
+	config Config
 	NodeID storj.NodeID
 	logger *zap.Logger
 }
 
 // NewServer creates instance of Server
-func NewServer(db DB, logger *zap.Logger, nodeID storj.NodeID) *Server {
+func NewServer(db DB, config Config, logger *zap.Logger, nodeID storj.NodeID) *Server {
 	// TODO: reorder arguments, rename logger -> log
-	return &Server{db: db, logger: logger, NodeID: nodeID}
+	return &Server{db: db, config: config, logger: logger, NodeID: nodeID}
 }
 
 // Close closes resources
@@ -81,8 +86,15 @@ func (s *Server) BandwidthAgreements(ctx context.Context, rba *pb.RenterBandwidt
 	if pba.SatelliteId != s.NodeID {
 		return reply, pb.ErrPayer.New("Satellite ID: %v vs %v", pba.SatelliteId, s.NodeID)
 	}
+	if !pba.Action.IsValid() {
+		return reply, pb.ErrPayer.New("invalid action %d", pba.Action)
+	}
+	//audit and repair agreements are only valid for the satellite's own traffic
+	if !pba.Action.IsCustomer() && pba.UplinkId != s.NodeID {
+		return reply, pb.ErrPayer.New("%v agreement for uplink %v", pba.Action, pba.UplinkId)
+	}
 	exp := time.Unix(pba.GetExpirationUnixSec(), 0).UTC()
-	if exp.Before(time.Now().UTC()) {
+	if exp.Add(s.config.ExpirationTolerance).Before(time.Now().UTC()) {
 		return reply, pb.ErrPayer.Wrap(auth.ErrExpired.New("%v vs %v", exp, time.Now().UTC()))
 	}
 	//verify message crypto
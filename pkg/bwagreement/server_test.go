@@ -64,7 +64,7 @@ func testDatabase(ctx context.Context, t *testing.T, bwdb bwagreement.DB) {
 	assert.NoError(t, err)
 	satID, err := testidentity.NewTestIdentity(ctx)
 	assert.NoError(t, err)
-	satellite := bwagreement.NewServer(bwdb, zap.NewNop(), satID.ID)
+	satellite := bwagreement.NewServer(bwdb, bwagreement.Config{}, zap.NewNop(), satID.ID)
 
 	{ // TestSameSerialNumberBandwidthAgreements
 		pbaFile1, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, time.Hour)
@@ -167,6 +167,70 @@ func testDatabase(ctx context.Context, t *testing.T, bwdb bwagreement.DB) {
 		}
 	}
 
+	{ // TestExpirationTolerance
+		tolerant := bwagreement.NewServer(bwdb, bwagreement.Config{ExpirationTolerance: time.Hour}, zap.NewNop(), satID.ID)
+
+		{ // storage nodes can submit a bwagreement that expired within the tolerance
+			pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, -30*time.Minute)
+			assert.NoError(t, err)
+
+			ctxSN1, storageNode1 := getPeerContext(ctx, t)
+			rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode1, upID, 666)
+			assert.NoError(t, err)
+
+			reply, err := tolerant.BandwidthAgreements(ctxSN1, rba)
+			assert.NoError(t, err)
+			assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+		}
+
+		{ // storage nodes can't submit a bwagreement that expired before the tolerance
+			pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, -2*time.Hour)
+			assert.NoError(t, err)
+
+			ctxSN1, storageNode1 := getPeerContext(ctx, t)
+			rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode1, upID, 666)
+			assert.NoError(t, err)
+
+			reply, err := tolerant.BandwidthAgreements(ctxSN1, rba)
+			assert.True(t, auth.ErrExpired.Has(err))
+			assert.Equal(t, pb.AgreementsSummary_REJECTED, reply.Status)
+		}
+	}
+
+	{ // TestAuditRepairBandwidthAgreements
+		for _, action := range []pb.BandwidthAction{
+			pb.BandwidthAction_GET_AUDIT,
+			pb.BandwidthAction_GET_REPAIR,
+			pb.BandwidthAction_PUT_REPAIR,
+		} {
+			{ // the satellite can submit audit and repair bwagreements for its own traffic
+				pba, err := testbwagreement.GeneratePayerBandwidthAllocation(action, satID, satID, time.Hour)
+				assert.NoError(t, err)
+
+				ctxSN1, storageNode1 := getPeerContext(ctx, t)
+				rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode1, satID, 666)
+				assert.NoError(t, err)
+
+				reply, err := satellite.BandwidthAgreements(ctxSN1, rba)
+				assert.NoError(t, err)
+				assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+			}
+
+			{ // uplinks can't get customer traffic paid as audit or repair traffic
+				pba, err := testbwagreement.GeneratePayerBandwidthAllocation(action, satID, upID, time.Hour)
+				assert.NoError(t, err)
+
+				ctxSN1, storageNode1 := getPeerContext(ctx, t)
+				rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode1, upID, 666)
+				assert.NoError(t, err)
+
+				reply, err := satellite.BandwidthAgreements(ctxSN1, rba)
+				assert.True(t, pb.ErrPayer.Has(err))
+				assert.Equal(t, pb.AgreementsSummary_REJECTED, reply.Status)
+			}
+		}
+	}
+
 	{ // TestManipulatedBandwidthAgreements
 		pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, time.Hour)
 		if !assert.NoError(t, err) {
@@ -5,6 +5,7 @@ package checker
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -16,6 +17,7 @@ import (
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
@@ -29,7 +31,13 @@ var (
 
 // Config contains configurable values for checker
 type Config struct {
-	Interval time.Duration `help:"how frequently checker should audit segments" default:"30s"`
+	Interval            time.Duration `help:"how frequently checker should audit segments" default:"30s"`
+	IrreparableInterval time.Duration `help:"how frequently checker should retry irreparable segments" default:"1h"`
+	CriticalMargin      int32         `help:"segments with at most this many healthy pieces above the minimum required are critical and repaired first" default:"2"`
+
+	DryRun     bool   `help:"if true, the checker only reports how many segments would be repaired under the current thresholds and the what-if scenarios, without queueing repairs" default:"false"`
+	WhatIf     string `help:"semicolon separated threshold scenarios evaluated in dry run mode, e.g. \"lenient:repair-threshold=30,critical-margin=1;strict:audit-dq=0.7\"" default:""`
+	ReportPath string `help:"path the dry run report is written to as json, when empty it's only logged" default:""`
 }
 
 // Checker is the interface for data repair checker
@@ -37,7 +45,9 @@ type Checker interface {
 	// TODO: remove interface
 	Run(ctx context.Context) error
 	IdentifyInjuredSegments(ctx context.Context) (err error)
+	RetryIrreparableSegments(ctx context.Context) (err error)
 	OfflineNodes(ctx context.Context, nodeIDs storj.NodeIDList) (offline []int32, err error)
+	WhatIf(ctx context.Context) (*Report, error)
 	Close() error
 }
 
@@ -45,33 +55,72 @@ type Checker interface {
 type checker struct {
 	statdb      statdb.DB
 	pointerdb   *pointerdb.Service
+	loop        *pointerdb.Loop
 	repairQueue queue.RepairQueue
 	overlay     pb.OverlayServer
 	irrdb       irreparable.DB
+	reputation  reputation.Config
 	limit       int
+	config      Config
+	scenarios   []Scenario
 	logger      *zap.Logger
 	ticker      *time.Ticker
+
+	irreparableTicker *time.Ticker
 }
 
 // NewChecker creates a new instance of checker
-func NewChecker(pointerdb *pointerdb.Service, sdb statdb.DB, repairQueue queue.RepairQueue, overlay pb.OverlayServer, irrdb irreparable.DB, limit int, logger *zap.Logger, interval time.Duration) Checker {
+func NewChecker(pointerdb *pointerdb.Service, loop *pointerdb.Loop, sdb statdb.DB, repairQueue queue.RepairQueue, overlay pb.OverlayServer, irrdb irreparable.DB, limit int, logger *zap.Logger, config Config, reputation reputation.Config) (Checker, error) {
 	// TODO: reorder arguments
+	current := Scenario{
+		Name:           "current",
+		CriticalMargin: config.CriticalMargin,
+		AuditDQ:        reputation.AuditDQ,
+		UptimeDQ:       reputation.UptimeDQ,
+	}
+	whatIf, err := ParseScenarios(config.WhatIf, current)
+	if err != nil {
+		return nil, err
+	}
+
 	return &checker{
 		statdb:      sdb,
 		pointerdb:   pointerdb,
+		loop:        loop,
 		repairQueue: repairQueue,
 		overlay:     overlay,
 		irrdb:       irrdb,
+		reputation:  reputation,
 		limit:       limit,
+		config:      config,
+		scenarios:   append([]Scenario{current}, whatIf...),
 		logger:      logger,
-		ticker:      time.NewTicker(interval),
-	}
+		ticker:      time.NewTicker(config.Interval),
+
+		irreparableTicker: time.NewTicker(config.IrreparableInterval),
+	}, nil
 }
 
 // Run the checker loop
 func (c *checker) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if c.config.DryRun {
+		c.logger.Info("Checker is running in dry run mode, no repairs are queued")
+		for {
+			err = c.dryRun(ctx)
+			if err != nil {
+				c.logger.Error("Checker dry run failed", zap.Error(err))
+			}
+
+			select {
+			case <-c.ticker.C:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
 	for {
 		err = c.IdentifyInjuredSegments(ctx)
 		if err != nil {
@@ -80,6 +129,11 @@ func (c *checker) Run(ctx context.Context) (err error) {
 
 		select {
 		case <-c.ticker.C: // wait for the next interval to happen
+		case <-c.irreparableTicker.C: // or for the irreparable segments to be retried
+			err = c.RetryIrreparableSegments(ctx)
+			if err != nil {
+				c.logger.Error("Checker failed retrying irreparable segments", zap.Error(err))
+			}
 		case <-ctx.Done(): // or the checker is canceled via context
 			return ctx.Err()
 		}
@@ -87,86 +141,194 @@ func (c *checker) Run(ctx context.Context) (err error) {
 }
 
 // Close closes resources
-func (c *checker) Close() error { return nil }
+func (c *checker) Close() error {
+	c.ticker.Stop()
+	c.irreparableTicker.Stop()
+	return nil
+}
 
-// IdentifyInjuredSegments checks for missing pieces off of the pointerdb and overlay cache
+// IdentifyInjuredSegments checks for missing pieces off of the metainfo loop and overlay cache
 func (c *checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	err = c.pointerdb.Iterate("", "", true, false,
-		func(it storage.Iterator) error {
-			var item storage.ListItem
-			lim := c.limit
-			if lim <= 0 || lim > storage.LookupLimit {
-				lim = storage.LookupLimit
-			}
-			for ; lim > 0 && it.Next(&item); lim-- {
-				pointer := &pb.Pointer{}
-
-				err = proto.Unmarshal(item.Value, pointer)
-				if err != nil {
-					return Error.New("error unmarshalling pointer %s", err)
-				}
-
-				remote := pointer.GetRemote()
-				if remote == nil {
-					continue
-				}
-
-				pieces := remote.GetRemotePieces()
-				if pieces == nil {
-					c.logger.Debug("no pieces on remote segment")
-					continue
-				}
-
-				var nodeIDs storj.NodeIDList
-				for _, p := range pieces {
-					nodeIDs = append(nodeIDs, p.NodeId)
-				}
-
-				// Find all offline nodes
-				offlineNodes, err := c.OfflineNodes(ctx, nodeIDs)
-				if err != nil {
-					return Error.New("error getting offline nodes %s", err)
-				}
-
-				invalidNodes, err := c.invalidNodes(ctx, nodeIDs)
-				if err != nil {
-					return Error.New("error getting invalid nodes %s", err)
-				}
-
-				missingPieces := combineOfflineWithInvalid(offlineNodes, invalidNodes)
-
-				numHealthy := len(nodeIDs) - len(missingPieces)
-				if (int32(numHealthy) >= pointer.Remote.Redundancy.MinReq) && (int32(numHealthy) < pointer.Remote.Redundancy.RepairThreshold) {
-					err = c.repairQueue.Enqueue(ctx, &pb.InjuredSegment{
-						Path:       string(item.Key),
-						LostPieces: missingPieces,
-					})
-					if err != nil {
-						return Error.New("error adding injured segment to queue %s", err)
-					}
-				} else if int32(numHealthy) < pointer.Remote.Redundancy.MinReq {
-					// make an entry in to the irreparable table
-					segmentInfo := &irreparable.RemoteSegmentInfo{
-						EncryptedSegmentPath:   item.Key,
-						EncryptedSegmentDetail: item.Value,
-						LostPiecesCount:        int64(len(missingPieces)),
-						RepairUnixSec:          time.Now().Unix(),
-						RepairAttemptCount:     int64(1),
-					}
-
-					//add the entry if new or update attempt count if already exists
-					err := c.irrdb.IncrementRepairAttempts(ctx, segmentInfo)
-					if err != nil {
-						return Error.New("error handling irreparable segment to queue %s", err)
-					}
-				}
+	return c.loop.Join(ctx, &checkerObserver{checker: c})
+}
+
+// checkerObserver checks the segments of a metainfo loop iteration
+type checkerObserver struct {
+	checker *checker
+}
+
+// RemoteSegment queues the segment for repair or marks it irreparable when it's missing pieces
+func (observer *checkerObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	c := observer.checker
+
+	remote := pointer.GetRemote()
+	if remote == nil {
+		return nil
+	}
+
+	if len(remote.GetRemotePieces()) == 0 {
+		c.logger.Debug("no pieces on remote segment")
+		return nil
+	}
+
+	missingPieces, numHealthy, err := c.missingPieces(ctx, remote)
+	if err != nil {
+		return err
+	}
+
+	redundancy := remote.Redundancy
+	if (numHealthy >= redundancy.MinReq) && (numHealthy < redundancy.RepairThreshold) {
+		return c.enqueue(ctx, []byte(path), redundancy, missingPieces, numHealthy)
+	} else if numHealthy < redundancy.MinReq {
+		pointerBytes, err := proto.Marshal(pointer)
+		if err != nil {
+			return Error.New("error marshalling pointer %s", err)
+		}
+
+		// make an entry in to the irreparable table
+		segmentInfo := &irreparable.RemoteSegmentInfo{
+			EncryptedSegmentPath:   []byte(path),
+			ProjectID:              irreparable.ProjectID([]byte(path)),
+			EncryptedSegmentDetail: pointerBytes,
+			LostPiecesCount:        int64(len(missingPieces)),
+			RepairUnixSec:          time.Now().Unix(),
+			RepairAttemptCount:     int64(1),
+			LastError:              notEnoughPieces(numHealthy, redundancy.MinReq),
+		}
+
+		//add the entry if new or update attempt count if already exists
+		err = c.irrdb.IncrementRepairAttempts(ctx, segmentInfo)
+		if err != nil {
+			return Error.New("error handling irreparable segment to queue %s", err)
+		}
+	}
+	return nil
+}
+
+// InlineSegment ignores inline segments, they can't lose pieces
+func (observer *checkerObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
+
+// RetryIrreparableSegments checks the segments in the irreparable table again,
+// segments which became repairable are queued and segments which no longer
+// exist or are healthy are removed from the table
+func (c *checker) RetryIrreparableSegments(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lim := c.limit
+	if lim <= 0 || lim > storage.LookupLimit {
+		lim = storage.LookupLimit
+	}
+
+	// segments checked during this run are updated to a later time,
+	// so listing from the start always returns the remaining ones
+	checkedBefore := time.Now()
+	for {
+		segments, err := c.irrdb.ListCheckedBefore(ctx, checkedBefore, lim)
+		if err != nil {
+			return Error.New("error listing irreparable segments %s", err)
+		}
+
+		for _, segment := range segments {
+			err = c.retryIrreparable(ctx, segment)
+			if err != nil {
+				return err
 			}
+		}
+
+		if len(segments) < lim {
 			return nil
-		},
-	)
-	return err
+		}
+	}
+}
+
+// retryIrreparable checks a single irreparable segment again
+func (c *checker) retryIrreparable(ctx context.Context, segment *irreparable.RemoteSegmentInfo) (err error) {
+	path := string(segment.EncryptedSegmentPath)
+
+	pointer, err := c.pointerdb.Get(path)
+	if err != nil {
+		if !storage.ErrKeyNotFound.Has(err) {
+			return Error.New("error getting pointer %s", err)
+		}
+		// the segment has been deleted
+		return Error.Wrap(c.irrdb.Delete(ctx, segment.EncryptedSegmentPath))
+	}
+
+	remote := pointer.GetRemote()
+	if remote == nil || len(remote.GetRemotePieces()) == 0 {
+		return Error.Wrap(c.irrdb.Delete(ctx, segment.EncryptedSegmentPath))
+	}
+
+	missingPieces, numHealthy, err := c.missingPieces(ctx, remote)
+	if err != nil {
+		return err
+	}
+
+	redundancy := remote.Redundancy
+	if numHealthy >= redundancy.MinReq {
+		if numHealthy < redundancy.RepairThreshold {
+			err = c.enqueue(ctx, segment.EncryptedSegmentPath, redundancy, missingPieces, numHealthy)
+			if err != nil {
+				return err
+			}
+		}
+		c.logger.Info("irreparable segment recovered", zap.String("path", path), zap.Int32("healthy", numHealthy))
+		return Error.Wrap(c.irrdb.Delete(ctx, segment.EncryptedSegmentPath))
+	}
+
+	segment.LostPiecesCount = int64(len(missingPieces))
+	segment.RepairUnixSec = time.Now().Unix()
+	segment.LastError = notEnoughPieces(numHealthy, redundancy.MinReq)
+	err = c.irrdb.IncrementRepairAttempts(ctx, segment)
+	if err != nil {
+		return Error.New("error handling irreparable segment to queue %s", err)
+	}
+	return nil
+}
+
+// missingPieces returns the indices of the pieces which are offline or on invalid nodes and the number of healthy pieces
+func (c *checker) missingPieces(ctx context.Context, remote *pb.RemoteSegment) (missingPieces []int32, numHealthy int32, err error) {
+	var nodeIDs storj.NodeIDList
+	for _, p := range remote.GetRemotePieces() {
+		nodeIDs = append(nodeIDs, p.NodeId)
+	}
+
+	// Find all offline nodes
+	offlineNodes, err := c.OfflineNodes(ctx, nodeIDs)
+	if err != nil {
+		return nil, 0, Error.New("error getting offline nodes %s", err)
+	}
+
+	invalidNodes, err := c.invalidNodes(ctx, nodeIDs, c.reputation.AuditDQ, c.reputation.UptimeDQ)
+	if err != nil {
+		return nil, 0, Error.New("error getting invalid nodes %s", err)
+	}
+
+	missingPieces = combineOfflineWithInvalid(offlineNodes, invalidNodes)
+	return missingPieces, int32(len(nodeIDs) - len(missingPieces)), nil
+}
+
+// enqueue adds a segment to the repair queue
+func (c *checker) enqueue(ctx context.Context, path []byte, redundancy *pb.RedundancyScheme, missingPieces []int32, numHealthy int32) error {
+	err := c.repairQueue.Enqueue(ctx, &pb.InjuredSegment{
+		Path:          string(path),
+		LostPieces:    missingPieces,
+		HealthyPieces: numHealthy,
+		Critical:      numHealthy <= redundancy.MinReq+c.config.CriticalMargin,
+	})
+	if err != nil {
+		return Error.New("error adding injured segment to queue %s", err)
+	}
+	return nil
+}
+
+// notEnoughPieces describes why a segment cannot be repaired
+func notEnoughPieces(numHealthy, minReq int32) string {
+	return fmt.Sprintf("only %d healthy pieces, %d required", numHealthy, minReq)
 }
 
 // OfflineNodes returns the indices of offline nodes
@@ -184,8 +346,9 @@ func (c *checker) OfflineNodes(ctx context.Context, nodeIDs storj.NodeIDList) (o
 	return offline, nil
 }
 
-// Find invalidNodes by checking the audit results that are place in statdb
-func (c *checker) invalidNodes(ctx context.Context, nodeIDs storj.NodeIDList) (invalidNodes []int32, err error) {
+// Find invalidNodes by checking the audit results that are place in statdb,
+// nodes below auditDQ or uptimeDQ are disqualified
+func (c *checker) invalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, auditDQ, uptimeDQ float64) (invalidNodes []int32, err error) {
 	// filter if nodeIDs have invalid pieces from auditing results
 	maxStats := &statdb.NodeStats{
 		AuditSuccessRatio: 0, // TODO: update when we have stats added to statdb
@@ -197,10 +360,18 @@ func (c *checker) invalidNodes(ctx context.Context, nodeIDs storj.NodeIDList) (i
 		return nil, Error.New("error getting valid nodes from statdb %s", err)
 	}
 
+	disqualifiedIDs, err := c.statdb.FindDisqualifiedNodes(ctx, nodeIDs, auditDQ, uptimeDQ)
+	if err != nil {
+		return nil, Error.New("error getting disqualified nodes from statdb %s", err)
+	}
+
 	invalidNodesMap := make(map[storj.NodeID]bool)
 	for _, invalidID := range invalidIDs {
 		invalidNodesMap[invalidID] = true
 	}
+	for _, disqualifiedID := range disqualifiedIDs {
+		invalidNodesMap[disqualifiedID] = true
+	}
 
 	for i, nID := range nodeIDs {
 		if invalidNodesMap[nID] {
@@ -8,11 +8,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/datarepair/checker"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
 	"storj.io/storj/storage"
 )
 
@@ -69,6 +72,8 @@ func TestIdentifyInjuredSegments(t *testing.T) {
 
 		assert.Equal(t, "fake-piece-id", injuredSegment.Path)
 		assert.Equal(t, len(expectedLostPieces), len(injuredSegment.LostPieces))
+		assert.Equal(t, int32(len(planet.StorageNodes)), injuredSegment.HealthyPieces)
+		assert.True(t, injuredSegment.Critical)
 		for _, lostPiece := range injuredSegment.LostPieces {
 			if !expectedLostPieces[lostPiece] {
 				t.Error("should be lost: ", lostPiece)
@@ -178,5 +183,114 @@ func TestIdentifyIrreparableSegments(t *testing.T) {
 		// check if repair attempt count was incremented
 		assert.Equal(t, 2, int(remoteSegmentInfo.RepairAttemptCount))
 		assert.True(t, firstRepair < remoteSegmentInfo.RepairUnixSec)
+		assert.Equal(t, "only 3 healthy pieces, 4 required", remoteSegmentInfo.LastError)
+
+		// retrying the irreparable segment increments the count again
+		time.Sleep(1 * time.Second)
+		err = checker.RetryIrreparableSegments(ctx)
+		assert.NoError(t, err)
+
+		remoteSegmentInfo, err = irreparable.Get(ctx, []byte("fake-piece-id"))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, int(remoteSegmentInfo.RepairAttemptCount))
+
+		// once the segment is repairable again it is queued and removed
+		pointer.Remote.Redundancy.MinReq = 2
+		err = pointerdb.Put(pointer.Remote.PieceId, pointer)
+		assert.NoError(t, err)
+
+		time.Sleep(1 * time.Second)
+		err = checker.RetryIrreparableSegments(ctx)
+		assert.NoError(t, err)
+
+		injuredSegment, err := repairQueue.Dequeue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "fake-piece-id", injuredSegment.Path)
+
+		_, err = irreparable.Get(ctx, []byte("fake-piece-id"))
+		assert.Error(t, err)
+	})
+}
+
+func TestWhatIf(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(index int, config *satellite.Config) {
+				config.Checker.WhatIf = "lenient:repair-threshold=4;relaxed:critical-margin=-1"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		time.Sleep(2 * time.Second)
+
+		// 4 online and 6 offline nodes
+		var pieces []*pb.RemotePiece
+		for i := 0; i < 10; i++ {
+			nodeID := storj.NodeID{byte(i)}
+			if i < len(planet.StorageNodes) {
+				nodeID = planet.StorageNodes[i].ID()
+			}
+			pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+		}
+		pointer := &pb.Pointer{
+			Remote: &pb.RemoteSegment{
+				Redundancy: &pb.RedundancyScheme{
+					MinReq:          int32(4),
+					RepairThreshold: int32(8),
+				},
+				PieceId:      "fake-piece-id",
+				RemotePieces: pieces,
+			},
+		}
+		err := planet.Satellites[0].Metainfo.Service.Put(pointer.Remote.PieceId, pointer)
+		require.NoError(t, err)
+
+		report, err := planet.Satellites[0].Repair.Checker.WhatIf(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), report.Segments)
+		require.Len(t, report.Scenarios, 3)
+
+		current, lenient, relaxed := report.Scenarios[0], report.Scenarios[1], report.Scenarios[2]
+		assert.Equal(t, "current", current.Name)
+		assert.Equal(t, int64(1), current.Injured)
+		assert.Equal(t, int64(1), current.Critical)
+
+		assert.Equal(t, "lenient", lenient.Name)
+		assert.Equal(t, int64(1), lenient.Healthy)
+		assert.Equal(t, int64(0), lenient.Injured)
+
+		assert.Equal(t, "relaxed", relaxed.Name)
+		assert.Equal(t, int64(1), relaxed.Injured)
+		assert.Equal(t, int64(0), relaxed.Critical)
+
+		// nothing is queued nor marked irreparable
+		_, err = planet.Satellites[0].DB.RepairQueue().Dequeue(ctx)
+		assert.True(t, storage.ErrEmptyQueue.Has(err))
 	})
 }
+
+func TestParseScenarios(t *testing.T) {
+	base := checker.Scenario{Name: "current", CriticalMargin: 2, AuditDQ: 0.6, UptimeDQ: 0.6}
+
+	scenarios, err := checker.ParseScenarios("", base)
+	require.NoError(t, err)
+	assert.Empty(t, scenarios)
+
+	scenarios, err = checker.ParseScenarios("a:repair-threshold=35, critical-margin=1; b:audit-dq=0.5,uptime-dq=0.4;c", base)
+	require.NoError(t, err)
+	assert.Equal(t, []checker.Scenario{
+		{Name: "a", RepairThreshold: 35, CriticalMargin: 1, AuditDQ: 0.6, UptimeDQ: 0.6},
+		{Name: "b", CriticalMargin: 2, AuditDQ: 0.5, UptimeDQ: 0.4},
+		{Name: "c", CriticalMargin: 2, AuditDQ: 0.6, UptimeDQ: 0.6},
+	}, scenarios)
+
+	for _, spec := range []string{
+		":repair-threshold=1",
+		"a:repair-threshold",
+		"a:repair-threshold=x",
+		"a:unknown=1",
+	} {
+		_, err := checker.ParseScenarios(spec, base)
+		assert.Error(t, err, spec)
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// Scenario is a configuration of the repair thresholds the checker can
+// evaluate without queueing any repairs
+type Scenario struct {
+	Name string `json:"name"`
+	// RepairThreshold replaces the repair threshold of every segment when
+	// positive, otherwise the threshold the segment was uploaded with is used
+	RepairThreshold int32   `json:"repairThreshold,omitempty"`
+	CriticalMargin  int32   `json:"criticalMargin"`
+	AuditDQ         float64 `json:"auditDQ"`
+	UptimeDQ        float64 `json:"uptimeDQ"`
+}
+
+// ParseScenarios parses semicolon separated scenarios in the form
+// "name:repair-threshold=35,critical-margin=1,audit-dq=0.5,uptime-dq=0.5",
+// the values which aren't specified are taken from base
+func ParseScenarios(spec string, base Scenario) ([]Scenario, error) {
+	var scenarios []Scenario
+	for _, item := range strings.Split(spec, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		scenario := base
+		parts := strings.SplitN(item, ":", 2)
+		scenario.Name = strings.TrimSpace(parts[0])
+		if scenario.Name == "" {
+			return nil, Error.New("scenario %q has no name", item)
+		}
+		if len(parts) < 2 {
+			scenarios = append(scenarios, scenario)
+			continue
+		}
+
+		for _, setting := range strings.Split(parts[1], ",") {
+			setting = strings.TrimSpace(setting)
+			if setting == "" {
+				continue
+			}
+			keyValue := strings.SplitN(setting, "=", 2)
+			if len(keyValue) != 2 {
+				return nil, Error.New("scenario %q: invalid setting %q", scenario.Name, setting)
+			}
+			key, value := strings.TrimSpace(keyValue[0]), strings.TrimSpace(keyValue[1])
+
+			var err error
+			switch key {
+			case "repair-threshold":
+				scenario.RepairThreshold, err = parseInt32(value)
+			case "critical-margin":
+				scenario.CriticalMargin, err = parseInt32(value)
+			case "audit-dq":
+				scenario.AuditDQ, err = strconv.ParseFloat(value, 64)
+			case "uptime-dq":
+				scenario.UptimeDQ, err = strconv.ParseFloat(value, 64)
+			default:
+				return nil, Error.New("scenario %q: unknown setting %q", scenario.Name, key)
+			}
+			if err != nil {
+				return nil, Error.New("scenario %q: invalid %s: %v", scenario.Name, key, err)
+			}
+		}
+		scenarios = append(scenarios, scenario)
+	}
+	return scenarios, nil
+}
+
+func parseInt32(value string) (int32, error) {
+	v, err := strconv.ParseInt(value, 10, 32)
+	return int32(v), err
+}
+
+// ScenarioReport counts the segments of each state under a scenario
+type ScenarioReport struct {
+	Scenario
+	Healthy int64 `json:"healthy"`
+	// Injured segments would be queued for repair, Critical ones first
+	Injured     int64 `json:"injured"`
+	Critical    int64 `json:"critical"`
+	Irreparable int64 `json:"irreparable"`
+}
+
+// Report is the result of a dry run of the checker
+type Report struct {
+	Start     time.Time         `json:"start"`
+	Finish    time.Time         `json:"finish"`
+	Segments  int64             `json:"segments"`
+	Scenarios []*ScenarioReport `json:"scenarios"`
+}
+
+// count adds a segment with numHealthy healthy pieces to the report
+func (report *ScenarioReport) count(redundancy *pb.RedundancyScheme, numHealthy int32) {
+	repairThreshold := redundancy.RepairThreshold
+	if report.RepairThreshold > 0 {
+		repairThreshold = report.RepairThreshold
+	}
+
+	switch {
+	case numHealthy < redundancy.MinReq:
+		report.Irreparable++
+	case numHealthy < repairThreshold:
+		report.Injured++
+		if numHealthy <= redundancy.MinReq+report.CriticalMargin {
+			report.Critical++
+		}
+	default:
+		report.Healthy++
+	}
+}
+
+// WhatIf checks all segments under the current thresholds and the what-if
+// scenarios, without queueing repairs or touching the irreparable segments
+func (c *checker) WhatIf(ctx context.Context) (_ *Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	observer := &whatIfObserver{
+		checker: c,
+		report:  &Report{Start: time.Now().UTC()},
+	}
+	for _, scenario := range c.scenarios {
+		observer.report.Scenarios = append(observer.report.Scenarios, &ScenarioReport{Scenario: scenario})
+	}
+
+	err = c.loop.Join(ctx, observer)
+	if err != nil {
+		return nil, err
+	}
+	observer.report.Finish = time.Now().UTC()
+	return observer.report, nil
+}
+
+// dryRun runs the what-if analysis and reports the result
+func (c *checker) dryRun(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report, err := c.WhatIf(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, scenario := range report.Scenarios {
+		c.logger.Info("what-if scenario",
+			zap.String("name", scenario.Name),
+			zap.Int64("segments", report.Segments),
+			zap.Int64("healthy", scenario.Healthy),
+			zap.Int64("injured", scenario.Injured),
+			zap.Int64("critical", scenario.Critical),
+			zap.Int64("irreparable", scenario.Irreparable))
+	}
+
+	if c.config.ReportPath == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(ioutil.WriteFile(c.config.ReportPath, data, 0644))
+}
+
+// whatIfObserver counts the segments of a metainfo loop iteration under
+// every scenario
+type whatIfObserver struct {
+	checker *checker
+	report  *Report
+}
+
+// RemoteSegment counts the segment in every scenario
+func (observer *whatIfObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	c := observer.checker
+
+	remote := pointer.GetRemote()
+	if remote == nil || len(remote.GetRemotePieces()) == 0 {
+		return nil
+	}
+	observer.report.Segments++
+
+	var nodeIDs storj.NodeIDList
+	for _, p := range remote.GetRemotePieces() {
+		nodeIDs = append(nodeIDs, p.NodeId)
+	}
+
+	offlineNodes, err := c.OfflineNodes(ctx, nodeIDs)
+	if err != nil {
+		return Error.New("error getting offline nodes %s", err)
+	}
+
+	for _, scenario := range observer.report.Scenarios {
+		invalidNodes, err := c.invalidNodes(ctx, nodeIDs, scenario.AuditDQ, scenario.UptimeDQ)
+		if err != nil {
+			return Error.New("error getting invalid nodes %s", err)
+		}
+
+		missingPieces := combineOfflineWithInvalid(offlineNodes, invalidNodes)
+		scenario.count(remote.Redundancy, int32(len(nodeIDs)-len(missingPieces)))
+	}
+	return nil
+}
+
+// InlineSegment ignores inline segments, they can't lose pieces
+func (observer *whatIfObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package irreparable
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// Inspector is a gRPC service for inspecting irreparable segments
+type Inspector struct {
+	irrdb DB
+}
+
+// NewInspector creates an Inspector
+func NewInspector(irrdb DB) *Inspector {
+	return &Inspector{irrdb: irrdb}
+}
+
+// ListIrreparableSegments returns the irreparable segments of a project
+func (srv *Inspector) ListIrreparableSegments(ctx context.Context, req *pb.ListIrreparableSegmentsRequest) (*pb.ListIrreparableSegmentsResponse, error) {
+	if len(req.ProjectId) != len(uuid.UUID{}) {
+		return nil, errs.New("invalid project id length %d", len(req.ProjectId))
+	}
+	var projectID uuid.UUID
+	copy(projectID[:], req.ProjectId)
+
+	segments, err := srv.irrdb.ListByProject(ctx, projectID, int(req.Limit), req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListIrreparableSegmentsResponse{}
+	for _, segment := range segments {
+		resp.Segments = append(resp.Segments, &pb.IrreparableSegment{
+			Path:               segment.EncryptedSegmentPath,
+			LostPieces:         segment.LostPiecesCount,
+			LastRepairSeconds:  segment.RepairUnixSec,
+			RepairAttemptCount: segment.RepairAttemptCount,
+			LastError:          segment.LastError,
+		})
+	}
+	return resp, nil
+}
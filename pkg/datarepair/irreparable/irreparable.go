@@ -5,6 +5,11 @@ package irreparable
 
 import (
 	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/storj"
 )
 
 // DB stores information about repairs that have failed.
@@ -15,13 +20,29 @@ type DB interface {
 	Get(ctx context.Context, segmentPath []byte) (*RemoteSegmentInfo, error)
 	// Delete removes irreparable segment info based on segmentPath.
 	Delete(ctx context.Context, segmentPath []byte) error
+	// ListCheckedBefore returns irreparable segments which were last checked before the given time.
+	ListCheckedBefore(ctx context.Context, before time.Time, limit int) ([]*RemoteSegmentInfo, error)
+	// ListByProject returns irreparable segments belonging to a project.
+	ListByProject(ctx context.Context, projectID uuid.UUID, limit int, offset int64) ([]*RemoteSegmentInfo, error)
 }
 
 // RemoteSegmentInfo is information about failed repairs.
 type RemoteSegmentInfo struct {
 	EncryptedSegmentPath   []byte
+	ProjectID              []byte // empty when the segment path does not start with a project id
 	EncryptedSegmentDetail []byte //contains marshaled info of pb.Pointer
 	LostPiecesCount        int64
 	RepairUnixSec          int64
 	RepairAttemptCount     int64
+	LastError              string
+}
+
+// ProjectID returns the project id a segment path belongs to.
+// Segment paths are of the form "projectID/segment/bucket/path".
+func ProjectID(segmentPath []byte) []byte {
+	id, err := uuid.Parse(storj.SplitPath(string(segmentPath))[0])
+	if err != nil {
+		return []byte{}
+	}
+	return id[:]
 }
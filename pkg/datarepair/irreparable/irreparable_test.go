@@ -7,7 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/datarepair/irreparable"
@@ -22,14 +24,23 @@ func TestIrreparable(t *testing.T) {
 
 		irrdb := db.Irreparable()
 
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		otherProjectID, err := uuid.New()
+		require.NoError(t, err)
+
 		//testing variables
+		segmentPath := []byte(projectID.String() + "/l/bucket/IamSegmentkeyinfo")
 		segmentInfo := &irreparable.RemoteSegmentInfo{
-			EncryptedSegmentPath:   []byte("IamSegmentkeyinfo"),
+			EncryptedSegmentPath:   segmentPath,
+			ProjectID:              irreparable.ProjectID(segmentPath),
 			EncryptedSegmentDetail: []byte("IamSegmentdetailinfo"),
 			LostPiecesCount:        int64(10),
 			RepairUnixSec:          time.Now().Unix(),
 			RepairAttemptCount:     int64(10),
+			LastError:              "not enough pieces",
 		}
+		assert.Equal(t, projectID[:], segmentInfo.ProjectID)
 
 		{ // New entry
 			err := irrdb.IncrementRepairAttempts(ctx, segmentInfo)
@@ -46,6 +57,28 @@ func TestIrreparable(t *testing.T) {
 			assert.Equal(t, segmentInfo, dbxInfo)
 		}
 
+		{ //List by project
+			segments, err := irrdb.ListByProject(ctx, *projectID, 10, 0)
+			assert.NoError(t, err)
+			assert.Equal(t, []*irreparable.RemoteSegmentInfo{segmentInfo}, segments)
+
+			segments, err = irrdb.ListByProject(ctx, *otherProjectID, 10, 0)
+			assert.NoError(t, err)
+			assert.Empty(t, segments)
+		}
+
+		{ //List by last check time
+			checked := time.Unix(segmentInfo.RepairUnixSec, 0)
+
+			segments, err := irrdb.ListCheckedBefore(ctx, checked.Add(time.Second), 10)
+			assert.NoError(t, err)
+			assert.Equal(t, []*irreparable.RemoteSegmentInfo{segmentInfo}, segments)
+
+			segments, err = irrdb.ListCheckedBefore(ctx, checked, 10)
+			assert.NoError(t, err)
+			assert.Empty(t, segments)
+		}
+
 		{ //Delete existing entry
 			err := irrdb.Delete(ctx, segmentInfo.EncryptedSegmentPath)
 			assert.NoError(t, err)
@@ -5,25 +5,68 @@ package queue
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
 )
 
 // RepairQueue implements queueing for segments that need repairing.
+// Segments are ordered by Priority, so the most endangered segments are repaired first.
 type RepairQueue interface {
-	// Enqueue adds an injured segment.
+	// Enqueue adds an injured segment, or requeues it with a new priority when it's already queued.
 	Enqueue(ctx context.Context, qi *pb.InjuredSegment) error
-	// Dequeue removes an injured segment.
+	// Dequeue removes the injured segment with the lowest priority.
 	Dequeue(ctx context.Context) (pb.InjuredSegment, error)
-	// Peekqueue lists limit amount of injured segments.
+	// Peekqueue lists limit amount of injured segments in the order they are dequeued.
 	Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSegment, error)
+
+	// Claim leases the injured segment with the lowest priority which isn't leased yet.
+	// The segment stays queued but is hidden from other workers until the lease expires,
+	// so segments of crashed or failed workers are retried after the lease.
+	Claim(ctx context.Context, lease time.Duration) (*Job, error)
+	// Complete removes a claimed segment from the queue.
+	Complete(ctx context.Context, path storj.Path) error
+	// Stats returns how many segments are queued and how many of those are leased.
+	Stats(ctx context.Context) (Stats, error)
+}
+
+// Job is an injured segment claimed by a repair worker
+type Job struct {
+	Segment pb.InjuredSegment
+	// Attempts is how many times the segment has been claimed, including this claim
+	Attempts int64
+	// LeasedUntil is when the segment becomes visible to other workers again
+	LeasedUntil time.Time
+}
+
+// Stats contains the size of the repair queue
+type Stats struct {
+	Queued int64
+	Leased int64
 }
 
-// Queue implements the RepairQueue interface
+// nonCritical is added to the priority of segments that aren't critical,
+// so that they are always repaired after the critical ones
+const nonCritical = 1 << 32
+
+// Priority returns the priority of an injured segment, segments with a lower
+// priority are repaired first. Critical segments precede all others and
+// within both groups segments with fewer healthy pieces come first.
+func Priority(seg *pb.InjuredSegment) int64 {
+	priority := int64(seg.GetHealthyPieces())
+	if !seg.GetCritical() {
+		priority += nonCritical
+	}
+	return priority
+}
+
+// Queue implements the RepairQueue interface on top of storage.Queue.
+// It doesn't support prioritization, segments are dequeued in FIFO order.
 type Queue struct {
 	db storage.Queue
 }
@@ -84,3 +127,31 @@ func (q *Queue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSegment,
 	}
 	return segs, nil
 }
+
+// Claim dequeues the next repair segment, storage.Queue doesn't support leases
+// so the segment isn't retried when the repair fails
+func (q *Queue) Claim(ctx context.Context, lease time.Duration) (*Job, error) {
+	seg, err := q.Dequeue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Job{
+		Segment:     seg,
+		Attempts:    1,
+		LeasedUntil: time.Now().Add(lease),
+	}, nil
+}
+
+// Complete does nothing, the segment was already removed when it was claimed
+func (q *Queue) Complete(ctx context.Context, path storj.Path) error {
+	return nil
+}
+
+// Stats returns the number of queued segments, counting at most storage.LookupLimit segments
+func (q *Queue) Stats(ctx context.Context) (Stats, error) {
+	result, err := q.db.Peekqueue(storage.LookupLimit)
+	if err != nil {
+		return Stats{}, Error.New("error peeking into repair queue %s", err)
+	}
+	return Stats{Queued: int64(len(result))}, nil
+}
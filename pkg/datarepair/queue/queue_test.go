@@ -8,14 +8,17 @@ import (
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+	"storj.io/storj/storage"
 	"storj.io/storj/storage/redis"
 	"storj.io/storj/storage/redis/redisserver"
 	"storj.io/storj/storage/testqueue"
@@ -54,6 +57,111 @@ func TestDequeueEmptyQueue(t *testing.T) {
 	})
 }
 
+func TestPriority(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		for _, seg := range []*pb.InjuredSegment{
+			{Path: "healthy", HealthyPieces: 9},
+			{Path: "endangered", HealthyPieces: 6},
+			{Path: "critical", HealthyPieces: 5, Critical: true},
+			{Path: "requeued", HealthyPieces: 8},
+		} {
+			err := q.Enqueue(ctx, seg)
+			assert.NoError(t, err)
+		}
+
+		// the checker observed that the segment lost more pieces
+		err := q.Enqueue(ctx, &pb.InjuredSegment{Path: "requeued", HealthyPieces: 4, Critical: true})
+		assert.NoError(t, err)
+
+		list, err := q.Peekqueue(ctx, 10)
+		assert.NoError(t, err)
+
+		var paths []string
+		for _, seg := range list {
+			paths = append(paths, seg.Path)
+		}
+		assert.Equal(t, []string{"requeued", "critical", "endangered", "healthy"}, paths)
+
+		for _, expected := range paths {
+			seg, err := q.Dequeue(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, seg.Path)
+		}
+	})
+}
+
+func TestClaimComplete(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		for _, seg := range []*pb.InjuredSegment{
+			{Path: "healthy", HealthyPieces: 9},
+			{Path: "critical", HealthyPieces: 5, Critical: true},
+		} {
+			require.NoError(t, q.Enqueue(ctx, seg))
+		}
+
+		// leased segments are hidden from other workers
+		first, err := q.Claim(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, "critical", first.Segment.Path)
+		assert.Equal(t, int64(1), first.Attempts)
+
+		second, err := q.Claim(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, "healthy", second.Segment.Path)
+
+		_, err = q.Claim(ctx, time.Hour)
+		assert.True(t, storage.ErrEmptyQueue.Has(err))
+
+		stats, err := q.Stats(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, queue.Stats{Queued: 2, Leased: 2}, stats)
+
+		// completed segments are removed
+		require.NoError(t, q.Complete(ctx, first.Segment.Path))
+
+		stats, err = q.Stats(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, queue.Stats{Queued: 1, Leased: 1}, stats)
+	})
+}
+
+func TestClaimExpiredLease(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		require.NoError(t, q.Enqueue(ctx, &pb.InjuredSegment{Path: "abc"}))
+
+		// a lease in the past simulates a worker which crashed or failed
+		job, err := q.Claim(ctx, -time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), job.Attempts)
+
+		job, err = q.Claim(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, "abc", job.Segment.Path)
+		assert.Equal(t, int64(2), job.Attempts)
+
+		// requeueing by the checker doesn't reset the lease
+		require.NoError(t, q.Enqueue(ctx, &pb.InjuredSegment{Path: "abc", HealthyPieces: 3}))
+
+		_, err = q.Claim(ctx, time.Hour)
+		assert.True(t, storage.ErrEmptyQueue.Has(err))
+	})
+}
+
 func TestSequential(t *testing.T) {
 	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
 		ctx := testcontext.New(t)
@@ -0,0 +1,108 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"sync"
+	"time"
+
+	"storj.io/storj/internal/memory"
+)
+
+// Budget limits the amount of data the repairer downloads from the storage
+// nodes per hour and per day. A zero limit means the corresponding window is
+// unlimited. Repairs reserve the bytes they are going to download before they
+// start, so concurrent repairs can't overrun the budget together.
+type Budget struct {
+	hourly int64
+	daily  int64
+
+	mu        sync.Mutex
+	hour      time.Time
+	day       time.Time
+	hourSpent int64
+	daySpent  int64
+	reserved  int64
+}
+
+// NewBudget creates a repair egress budget
+func NewBudget(hourly, daily memory.Size) *Budget {
+	return &Budget{
+		hourly: hourly.Int64(),
+		daily:  daily.Int64(),
+	}
+}
+
+// Add records n downloaded bytes at time now
+func (budget *Budget) Add(now time.Time, n int64) {
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+
+	budget.advance(now)
+	budget.hourSpent += n
+	budget.daySpent += n
+}
+
+// Reserve reserves n bytes for a repair which is about to start, unless they
+// don't fit in the bytes left in the current hour or day. The reserved bytes
+// count as spent until the repair releases them.
+func (budget *Budget) Reserve(now time.Time, n int64) bool {
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+
+	budget.advance(now)
+	if !fits(remaining(budget.hourly, budget.hourSpent+budget.reserved), n) ||
+		!fits(remaining(budget.daily, budget.daySpent+budget.reserved), n) {
+		return false
+	}
+	budget.reserved += n
+	return true
+}
+
+// Release releases n bytes reserved by a repair which ended, the bytes it
+// downloaded were recorded with Add
+func (budget *Budget) Release(n int64) {
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+
+	budget.reserved -= n
+}
+
+// Remaining returns the number of bytes left in the current hour and day
+// besides the reserved bytes, an unlimited window always returns -1
+func (budget *Budget) Remaining(now time.Time) (hourly, daily int64) {
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+
+	budget.advance(now)
+	return remaining(budget.hourly, budget.hourSpent+budget.reserved), remaining(budget.daily, budget.daySpent+budget.reserved)
+}
+
+// advance resets the spent bytes when now is in a new window
+func (budget *Budget) advance(now time.Time) {
+	now = now.UTC()
+	if hour := now.Truncate(time.Hour); !hour.Equal(budget.hour) {
+		budget.hour = hour
+		budget.hourSpent = 0
+	}
+	if day := now.Truncate(24 * time.Hour); !day.Equal(budget.day) {
+		budget.day = day
+		budget.daySpent = 0
+	}
+}
+
+// fits returns whether n bytes fit in the remaining bytes of a window
+func fits(remaining, n int64) bool {
+	return remaining < 0 || n <= remaining
+}
+
+func remaining(limit, spent int64) int64 {
+	if limit <= 0 {
+		return -1
+	}
+	if spent >= limit {
+		return 0
+	}
+	return limit - spent
+}
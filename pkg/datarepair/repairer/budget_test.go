@@ -0,0 +1,73 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/datarepair/repairer"
+)
+
+func TestBudget(t *testing.T) {
+	start := time.Date(2019, 1, 1, 10, 30, 0, 0, time.UTC)
+	budget := repairer.NewBudget(10*memory.KiB, 15*memory.KiB)
+
+	hourly, daily := budget.Remaining(start)
+	assert.Equal(t, (10 * memory.KiB).Int64(), hourly)
+	assert.Equal(t, (15 * memory.KiB).Int64(), daily)
+
+	budget.Add(start, (4 * memory.KiB).Int64())
+	budget.Add(start.Add(time.Minute), (8 * memory.KiB).Int64())
+	hourly, daily = budget.Remaining(start.Add(time.Minute))
+	assert.Equal(t, int64(0), hourly)
+	assert.Equal(t, (3 * memory.KiB).Int64(), daily)
+
+	// the hourly window starts over, the daily one doesn't
+	budget.Add(start.Add(time.Hour), (5 * memory.KiB).Int64())
+	hourly, daily = budget.Remaining(start.Add(time.Hour))
+	assert.Equal(t, (5 * memory.KiB).Int64(), hourly)
+	assert.Equal(t, int64(0), daily)
+
+	// both windows start over on the next day
+	hourly, daily = budget.Remaining(start.Add(24 * time.Hour))
+	assert.Equal(t, (10 * memory.KiB).Int64(), hourly)
+	assert.Equal(t, (15 * memory.KiB).Int64(), daily)
+}
+
+func TestBudgetUnlimited(t *testing.T) {
+	now := time.Now()
+	budget := repairer.NewBudget(0, 0)
+	budget.Add(now, (1 * memory.GiB).Int64())
+
+	hourly, daily := budget.Remaining(now)
+	assert.Equal(t, int64(-1), hourly)
+	assert.Equal(t, int64(-1), daily)
+}
+
+func TestBudgetReserve(t *testing.T) {
+	now := time.Date(2019, 1, 1, 10, 30, 0, 0, time.UTC)
+	budget := repairer.NewBudget(10*memory.KiB, 15*memory.KiB)
+
+	assert.True(t, budget.Reserve(now, (6*memory.KiB).Int64()))
+	// the second repair doesn't fit besides the first one
+	assert.False(t, budget.Reserve(now, (6*memory.KiB).Int64()))
+
+	hourly, daily := budget.Remaining(now)
+	assert.Equal(t, (4 * memory.KiB).Int64(), hourly)
+	assert.Equal(t, (9 * memory.KiB).Int64(), daily)
+
+	// the downloaded bytes stay spent after the reservation is released
+	budget.Add(now, (5 * memory.KiB).Int64())
+	budget.Release((6 * memory.KiB).Int64())
+	hourly, daily = budget.Remaining(now)
+	assert.Equal(t, (5 * memory.KiB).Int64(), hourly)
+	assert.Equal(t, (10 * memory.KiB).Int64(), daily)
+
+	assert.True(t, budget.Reserve(now, (5*memory.KiB).Int64()))
+	assert.True(t, repairer.NewBudget(0, 0).Reserve(now, (1*memory.GiB).Int64()))
+}
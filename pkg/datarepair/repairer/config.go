@@ -23,10 +23,26 @@ type Config struct {
 	PointerDBAddr string        `help:"Address to contact pointerdb server through"`
 	MaxBufferMem  memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4M"`
 	APIKey        string        `help:"repairer-specific pointerdb access credential"`
+
+	HourlyEgressBudget memory.Size `help:"maximum bytes downloaded for repairs per hour by each repair worker, 0 for unlimited" default:"0"`
+	DailyEgressBudget  memory.Size `help:"maximum bytes downloaded for repairs per day by each repair worker, 0 for unlimited" default:"0"`
+
+	LeaseDuration time.Duration `help:"how long a claimed segment is hidden from other repair workers, failed repairs are retried after it expires" default:"1h"`
+	LocalWorker   bool          `help:"run a repair worker in the satellite process, disable when only separate repair workers should repair" default:"true"`
+
+	MaxDownloadsPerNode int `help:"maximum concurrent repair downloads from a single storage node by each repair worker, 0 for unlimited" default:"4"`
+
+	DryRun           bool        `help:"only report what repairing the queued segments would transfer, without downloading, uploading or changing anything" default:"false"`
+	DryRunLimit      int         `help:"maximum number of queued segments evaluated by each dry run" default:"1000"`
+	DryRunThroughput memory.Size `help:"expected transfer rate per second of a single repair, used to estimate the duration of dry runs" default:"10MB"`
+
+	Placement overlay.PlacementConfig
 }
 
-// GetSegmentRepairer creates a new segment repairer from storeConfig values
-func (c Config) GetSegmentRepairer(ctx context.Context, identity *identity.FullIdentity) (ss SegmentRepairer, err error) {
+// GetSegmentRepairer creates a new segment repairer from storeConfig values,
+// the bytes downloaded by the repairer are recorded in budget and the nodes
+// they are downloaded from are selected by scheduler
+func (c Config) GetSegmentRepairer(ctx context.Context, identity *identity.FullIdentity, budget *Budget, scheduler *NodeScheduler) (ss SegmentRepairer, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	var oc overlay.Client
@@ -40,6 +56,11 @@ func (c Config) GetSegmentRepairer(ctx context.Context, identity *identity.FullI
 		return nil, err
 	}
 
-	ec := ecclient.NewClient(identity, c.MaxBufferMem.Int())
-	return segments.NewSegmentRepairer(oc, ec, pdb), nil
+	// the pieces are metered as they are downloaded from the storage nodes,
+	// the decoded segment is smaller than the downloaded pieces
+	ec := ecclient.NewMeteredClient(identity, c.MaxBufferMem.Int(), func(n int64) {
+		budget.Add(time.Now(), n)
+		mon.Meter("repair_downloaded_bytes").Mark64(n)
+	})
+	return segments.NewSegmentRepairer(oc, ec, pdb, c.Placement.Constraints(), scheduler), nil
 }
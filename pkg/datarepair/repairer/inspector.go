@@ -0,0 +1,48 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// Inspector is a gRPC service for inspecting the outcomes of repairs
+type Inspector struct {
+	service *Service
+}
+
+// NewInspector creates an Inspector
+func NewInspector(service *Service) *Inspector {
+	return &Inspector{service: service}
+}
+
+// RepairStats returns the outcomes of the repairs done by the local repair worker
+func (srv *Inspector) RepairStats(ctx context.Context, req *pb.RepairStatsRequest) (_ *pb.RepairStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats := srv.service.Stats()
+	if stats == nil {
+		return nil, Error.New("repair stats are only available for the local repair worker")
+	}
+
+	resp := &pb.RepairStatsResponse{SizeBuckets: SizeBuckets}
+	for _, outcome := range stats.Outcomes() {
+		resp.Outcomes = append(resp.Outcomes, &pb.RepairOutcomeStats{
+			Outcome:    string(outcome.Outcome),
+			Count:      outcome.Count,
+			TotalBytes: outcome.TotalBytes,
+			SizeCounts: outcome.SizeCounts,
+		})
+	}
+	for _, node := range stats.FailingNodes(int(req.NodeLimit)) {
+		resp.Nodes = append(resp.Nodes, &pb.NodeRepairFailures{
+			NodeId:           node.NodeID,
+			DownloadFailures: node.DownloadFailures,
+			UploadFailures:   node.UploadFailures,
+		})
+	}
+	return resp, nil
+}
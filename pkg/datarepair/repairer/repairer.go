@@ -8,86 +8,99 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
-	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storage/segments"
 	"storj.io/storj/pkg/storj"
-	"storj.io/storj/storage"
 )
 
 // SegmentRepairer is a repairer for segments
 type SegmentRepairer interface {
-	Repair(ctx context.Context, path storj.Path, lostPieces []int32) (err error)
+	// Repair repairs a segment, the report describes how the repair ended
+	Repair(ctx context.Context, path storj.Path, lostPieces []int32) (report *segments.RepairReport, err error)
+	// Plan evaluates a repair without transferring or modifying anything
+	Plan(ctx context.Context, path storj.Path, lostPieces []int32) (*segments.RepairPlan, error)
 }
 
-// Service contains the information needed to run the repair service
+// Service coordinates the repairs of a satellite. The repairs themselves are
+// done by workers claiming segments from the shared repair queue, the
+// service runs one of them in the satellite process unless disabled.
 type Service struct {
-	queue    queue.RepairQueue
-	config   *Config
-	identity *identity.FullIdentity
-	repairer SegmentRepairer
-	limiter  *sync2.Limiter
-	ticker   *time.Ticker
+	queue  queue.RepairQueue
+	config *Config
+	worker *Worker
+	ticker *time.Ticker
 }
 
 // NewService creates repairing service
-func NewService(queue queue.RepairQueue, config *Config, identity *identity.FullIdentity, interval time.Duration, concurrency int) *Service {
-	return &Service{
-		queue:    queue,
-		config:   config,
-		identity: identity,
-		limiter:  sync2.NewLimiter(concurrency),
-		ticker:   time.NewTicker(interval),
+func NewService(queue queue.RepairQueue, health pointerdb.HealthDB, config *Config, identity *identity.FullIdentity, interval time.Duration, concurrency int) *Service {
+	service := &Service{
+		queue:  queue,
+		config: config,
+		ticker: time.NewTicker(interval),
 	}
+	if config.LocalWorker {
+		workerConfig := *config
+		workerConfig.Interval = interval
+		workerConfig.MaxRepair = concurrency
+		service.worker = NewWorker(zap.L().Named("repair worker"), queue, health, &workerConfig, identity)
+	}
+	return service
+}
+
+// Stats returns the repair outcomes of the local worker, nil when the
+// satellite doesn't run one
+func (service *Service) Stats() *Stats {
+	if service.worker == nil {
+		return nil
+	}
+	return service.worker.Stats()
 }
 
 // Close closes resources
-func (service *Service) Close() error { return nil }
+func (service *Service) Close() error {
+	service.ticker.Stop()
+	if service.worker != nil {
+		return service.worker.Close()
+	}
+	return nil
+}
 
 // Run runs the repairer service
 func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	// TODO: close segment repairer, currently this leaks connections
-	service.repairer, err = service.config.GetSegmentRepairer(ctx, service.identity)
-	if err != nil {
-		return err
+	group, ctx := errgroup.WithContext(ctx)
+	if service.worker != nil {
+		group.Go(func() error {
+			return service.worker.Run(ctx)
+		})
 	}
-
-	// wait for all repairs to complete
-	defer service.limiter.Wait()
-
-	for {
-		err := service.process(ctx)
-		if err != nil {
-			zap.L().Error("process", zap.Error(err))
+	group.Go(func() error {
+		for {
+			service.reportStats(ctx)
+
+			select {
+			case <-service.ticker.C: // wait for the next interval to happen
+			case <-ctx.Done(): // or the repairer service is canceled via context
+				return ctx.Err()
+			}
 		}
-
-		select {
-		case <-service.ticker.C: // wait for the next interval to happen
-		case <-ctx.Done(): // or the repairer service is canceled via context
-			return ctx.Err()
-		}
-	}
+	})
+	return group.Wait()
 }
 
-// process picks an item from repair queue and spawns a repair worker
-func (service *Service) process(ctx context.Context) error {
-	seg, err := service.queue.Dequeue(ctx)
+// reportStats reports the length of the repair queue, so operators know
+// whether the repair workers keep up with the checker
+func (service *Service) reportStats(ctx context.Context) {
+	stats, err := service.queue.Stats(ctx)
 	if err != nil {
-		if storage.ErrEmptyQueue.Has(err) {
-			return nil
-		}
-		return err
+		zap.L().Error("repair queue stats", zap.Error(err))
+		return
 	}
-
-	service.limiter.Go(ctx, func() {
-		err := service.repairer.Repair(ctx, seg.GetPath(), seg.GetLostPieces())
-		if err != nil {
-			zap.L().Error("Repair failed", zap.Error(err))
-		}
-	})
-
-	return nil
+	mon.IntVal("repair_queue_length").Observe(stats.Queued)
+	mon.IntVal("repair_queue_leased").Observe(stats.Leased)
 }
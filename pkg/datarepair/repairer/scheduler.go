@@ -0,0 +1,131 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/pkg/storj"
+)
+
+// NodeScheduler spreads the repair downloads of a worker across the healthy
+// holders of each segment, so a single storage node isn't asked to serve
+// more than a limited number of concurrent repair downloads.
+type NodeScheduler struct {
+	limit int
+
+	mu      sync.Mutex
+	active  map[storj.NodeID]int
+	changed chan struct{}
+}
+
+// compile time check that NodeScheduler implements segments.DownloadScheduler
+var _ segments.DownloadScheduler = (*NodeScheduler)(nil)
+
+// NewNodeScheduler creates a scheduler allowing limit concurrent downloads
+// per node, a limit of 0 only spreads the downloads without limiting them
+func NewNodeScheduler(limit int) *NodeScheduler {
+	return &NodeScheduler{
+		limit:   limit,
+		active:  make(map[storj.NodeID]int),
+		changed: make(chan struct{}),
+	}
+}
+
+// Schedule selects count of the nodes with the fewest active downloads and
+// waits until enough of them are below the limit. The selected nodes keep
+// the positions they have in nodes, release must be called once the downloads
+// from them are done.
+func (scheduler *NodeScheduler) Schedule(ctx context.Context, nodes []*pb.Node, count int) (selected []*pb.Node, release func(), err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	available := 0
+	for _, node := range nodes {
+		if node != nil {
+			available++
+		}
+	}
+	if available < count {
+		return nil, nil, Error.New("not enough nodes to schedule (%d) downloads, %d required", available, count)
+	}
+
+	for {
+		scheduler.mu.Lock()
+		acquired, ok := scheduler.tryAcquire(nodes, count)
+		changed := scheduler.changed
+		scheduler.mu.Unlock()
+
+		if ok {
+			return acquired, func() { scheduler.release(acquired) }, nil
+		}
+
+		mon.Meter("repair_download_schedule_waits").Mark(1)
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+// Active returns the number of scheduled downloads from a node
+func (scheduler *NodeScheduler) Active(id storj.NodeID) int {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+	return scheduler.active[id]
+}
+
+// tryAcquire selects and occupies count of the least busy nodes below the
+// limit, it must be called with mu held
+func (scheduler *NodeScheduler) tryAcquire(nodes []*pb.Node, count int) ([]*pb.Node, bool) {
+	var candidates []int
+	for _, i := range rand.Perm(len(nodes)) {
+		node := nodes[i]
+		if node == nil {
+			continue
+		}
+		if scheduler.limit > 0 && scheduler.active[node.Id] >= scheduler.limit {
+			continue
+		}
+		candidates = append(candidates, i)
+	}
+	if len(candidates) < count {
+		return nil, false
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return scheduler.active[nodes[candidates[a]].Id] < scheduler.active[nodes[candidates[b]].Id]
+	})
+
+	selected := make([]*pb.Node, len(nodes))
+	for _, i := range candidates[:count] {
+		selected[i] = nodes[i]
+		scheduler.active[nodes[i].Id]++
+	}
+	return selected, true
+}
+
+// release frees the downloads of the selected nodes and wakes up waiting repairs
+func (scheduler *NodeScheduler) release(selected []*pb.Node) {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	for _, node := range selected {
+		if node == nil {
+			continue
+		}
+		scheduler.active[node.Id]--
+		if scheduler.active[node.Id] <= 0 {
+			delete(scheduler.active, node.Id)
+		}
+	}
+
+	close(scheduler.changed)
+	scheduler.changed = make(chan struct{})
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/datarepair/repairer"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestNodeScheduler(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	a := &pb.Node{Id: teststorj.NodeIDFromString("a")}
+	b := &pb.Node{Id: teststorj.NodeIDFromString("b")}
+	c := &pb.Node{Id: teststorj.NodeIDFromString("c")}
+	nodes := []*pb.Node{a, nil, b, c}
+
+	scheduler := repairer.NewNodeScheduler(1)
+
+	first, releaseFirst, err := scheduler.Schedule(ctx, nodes, 2)
+	require.NoError(t, err)
+	require.Len(t, first, len(nodes))
+	assert.Nil(t, first[1])
+	assert.Equal(t, 2, countNodes(first))
+
+	// the node which isn't busy is selected for the next download
+	second, releaseSecond, err := scheduler.Schedule(ctx, nodes, 1)
+	require.NoError(t, err)
+	for i, node := range second {
+		if node != nil {
+			assert.Nil(t, first[i])
+			assert.Equal(t, nodes[i], node)
+		}
+	}
+	for _, node := range []*pb.Node{a, b, c} {
+		assert.Equal(t, 1, scheduler.Active(node.Id))
+	}
+
+	// all nodes are at the limit, so scheduling waits for a release
+	timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	_, _, err = scheduler.Schedule(timeout, nodes, 1)
+	cancel()
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	scheduled := make(chan []*pb.Node)
+	ctx.Go(func() error {
+		third, releaseThird, err := scheduler.Schedule(ctx, nodes, 1)
+		if err != nil {
+			return err
+		}
+		releaseThird()
+		scheduled <- third
+		return nil
+	})
+
+	releaseSecond()
+	third := <-scheduled
+	assert.Equal(t, second, third)
+
+	releaseFirst()
+	for _, node := range []*pb.Node{a, b, c} {
+		assert.Equal(t, 0, scheduler.Active(node.Id))
+	}
+
+	_, _, err = scheduler.Schedule(ctx, nodes, 4)
+	assert.Error(t, err)
+}
+
+func TestNodeScheduler_Unlimited(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	a := &pb.Node{Id: teststorj.NodeIDFromString("a")}
+	b := &pb.Node{Id: teststorj.NodeIDFromString("b")}
+
+	scheduler := repairer.NewNodeScheduler(0)
+	for i := 0; i < 10; i++ {
+		_, _, err := scheduler.Schedule(ctx, []*pb.Node{a, b}, 1)
+		require.NoError(t, err)
+	}
+
+	// the downloads are spread evenly
+	assert.Equal(t, 5, scheduler.Active(a.Id))
+	assert.Equal(t, 5, scheduler.Active(b.Id))
+}
+
+func countNodes(nodes []*pb.Node) (count int) {
+	for _, node := range nodes {
+		if node != nil {
+			count++
+		}
+	}
+	return count
+}
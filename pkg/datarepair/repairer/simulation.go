@@ -0,0 +1,109 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/storj"
+)
+
+// Simulation summarizes what repairing the queued segments would transfer
+type Simulation struct {
+	// Segments is the number of queued segments which were evaluated
+	Segments int
+	// Repairable segments need new pieces, Healthy ones don't and Failed ones
+	// can't be repaired, e.g. because too few pieces are left
+	Repairable int
+	Healthy    int
+	Failed     int
+
+	DownloadBytes int64
+	UploadBytes   int64
+	// DownloadNodes and UploadNodes are the number of distinct nodes involved
+	DownloadNodes int
+	UploadNodes   int
+
+	// EstimatedDuration is how long the transfers would take with the
+	// configured concurrency, throughput and egress budgets
+	EstimatedDuration time.Duration
+}
+
+// Simulate evaluates up to config.DryRunLimit queued segments and selects the
+// nodes to repair them with, without claiming the segments or transferring
+// any data
+func Simulate(ctx context.Context, queue queue.RepairQueue, repairer SegmentRepairer, config *Config) (simulation *Simulation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segments, err := queue.Peekqueue(ctx, config.DryRunLimit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	simulation = &Simulation{}
+	downloadNodes := make(map[storj.NodeID]struct{})
+	uploadNodes := make(map[storj.NodeID]struct{})
+
+	for _, segment := range segments {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		simulation.Segments++
+
+		plan, err := repairer.Plan(ctx, segment.GetPath(), segment.GetLostPieces())
+		if err != nil {
+			simulation.Failed++
+			continue
+		}
+		if len(plan.UploadNodes) == 0 {
+			simulation.Healthy++
+			continue
+		}
+
+		simulation.Repairable++
+		simulation.DownloadBytes += plan.DownloadBytes()
+		simulation.UploadBytes += plan.UploadBytes()
+		for _, id := range plan.DownloadNodes {
+			downloadNodes[id] = struct{}{}
+		}
+		for _, id := range plan.UploadNodes {
+			uploadNodes[id] = struct{}{}
+		}
+	}
+
+	simulation.DownloadNodes = len(downloadNodes)
+	simulation.UploadNodes = len(uploadNodes)
+	simulation.EstimatedDuration = estimateDuration(simulation.DownloadBytes, simulation.UploadBytes, config)
+	return simulation, nil
+}
+
+// estimateDuration estimates how long repairs transferring the given amount
+// of bytes take, limited either by the throughput or by the egress budgets
+func estimateDuration(download, upload int64, config *Config) time.Duration {
+	var estimate time.Duration
+
+	throughput := config.DryRunThroughput.Int64() * int64(config.MaxRepair)
+	if throughput > 0 {
+		estimate = durationFor(download+upload, throughput, time.Second)
+	}
+
+	if hourly := config.HourlyEgressBudget.Int64(); hourly > 0 {
+		if byBudget := durationFor(download, hourly, time.Hour); byBudget > estimate {
+			estimate = byBudget
+		}
+	}
+	if daily := config.DailyEgressBudget.Int64(); daily > 0 {
+		if byBudget := durationFor(download, daily, 24*time.Hour); byBudget > estimate {
+			estimate = byBudget
+		}
+	}
+	return estimate
+}
+
+// durationFor returns how long transferring bytes takes at rate bytes per period
+func durationFor(bytes, rate int64, period time.Duration) time.Duration {
+	return time.Duration(float64(bytes) / float64(rate) * float64(period))
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/datarepair/repairer"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/pkg/storj"
+)
+
+// peekQueue is a repair queue which only supports peeking
+type peekQueue struct {
+	queue.RepairQueue
+	segments []pb.InjuredSegment
+}
+
+func (q *peekQueue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSegment, error) {
+	if limit < len(q.segments) {
+		return q.segments[:limit], nil
+	}
+	return q.segments, nil
+}
+
+// planRepairer returns fixed plans and fails when asked to repair
+type planRepairer struct {
+	plans map[storj.Path]*segments.RepairPlan
+}
+
+func (r *planRepairer) Repair(ctx context.Context, path storj.Path, lostPieces []int32) (*segments.RepairReport, error) {
+	return nil, errors.New("dry runs must not repair")
+}
+
+func (r *planRepairer) Plan(ctx context.Context, path storj.Path, lostPieces []int32) (*segments.RepairPlan, error) {
+	plan, ok := r.plans[path]
+	if !ok {
+		return nil, errors.New("not enough healthy pieces")
+	}
+	return plan, nil
+}
+
+func TestSimulate(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	a, b, c := teststorj.NodeIDFromString("a"), teststorj.NodeIDFromString("b"), teststorj.NodeIDFromString("c")
+	x, y := teststorj.NodeIDFromString("x"), teststorj.NodeIDFromString("y")
+
+	q := &peekQueue{segments: []pb.InjuredSegment{
+		{Path: "first"}, {Path: "second"}, {Path: "healthy"}, {Path: "irreparable"}, {Path: "over limit"},
+	}}
+	r := &planRepairer{plans: map[storj.Path]*segments.RepairPlan{
+		"first": {
+			DownloadNodes: storj.NodeIDList{a, b},
+			UploadNodes:   storj.NodeIDList{x},
+			PieceSize:     memory.MiB.Int64(),
+		},
+		"second": {
+			DownloadNodes: storj.NodeIDList{b, c},
+			UploadNodes:   storj.NodeIDList{x, y},
+			PieceSize:     memory.MiB.Int64(),
+		},
+		"healthy":    {},
+		"over limit": {UploadNodes: storj.NodeIDList{x}, PieceSize: memory.MiB.Int64()},
+	}}
+
+	config := &repairer.Config{
+		MaxRepair:        2,
+		DryRunLimit:      4,
+		DryRunThroughput: memory.MiB,
+	}
+
+	simulation, err := repairer.Simulate(ctx, q, r, config)
+	require.NoError(t, err)
+
+	assert.Equal(t, &repairer.Simulation{
+		Segments:      4,
+		Repairable:    2,
+		Healthy:       1,
+		Failed:        1,
+		DownloadBytes: 4 * memory.MiB.Int64(),
+		UploadBytes:   3 * memory.MiB.Int64(),
+		DownloadNodes: 3,
+		UploadNodes:   2,
+		// 7MiB transferred by two concurrent repairs at 1MiB/s
+		EstimatedDuration: 3500 * time.Millisecond,
+	}, simulation)
+
+	// a small egress budget makes repairs take longer than the transfers
+	config.HourlyEgressBudget = 2 * memory.MiB
+	simulation, err = repairer.Simulate(ctx, q, r, config)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, simulation.EstimatedDuration)
+}
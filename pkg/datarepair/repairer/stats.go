@@ -0,0 +1,150 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"sort"
+	"sync"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/pkg/storj"
+)
+
+// SizeBuckets are the upper bounds of the segment size buckets of repair
+// outcomes, larger segments are counted in an additional last bucket
+var SizeBuckets = []int64{
+	memory.MiB.Int64(),
+	4 * memory.MiB.Int64(),
+	16 * memory.MiB.Int64(),
+	64 * memory.MiB.Int64(),
+}
+
+// OutcomeStats contains how many repairs ended with an outcome
+type OutcomeStats struct {
+	Outcome    segments.RepairOutcome
+	Count      int64
+	TotalBytes int64
+	// SizeCounts are the number of segments in each size bucket
+	SizeCounts []int64
+}
+
+// NodeFailures contains how often repairs failed because of a node
+type NodeFailures struct {
+	NodeID           storj.NodeID
+	DownloadFailures int64
+	UploadFailures   int64
+}
+
+// Total returns the number of failures of the node
+func (failures *NodeFailures) Total() int64 {
+	return failures.DownloadFailures + failures.UploadFailures
+}
+
+// Stats collects the outcomes of repairs since the worker started
+type Stats struct {
+	mu       sync.Mutex
+	outcomes map[segments.RepairOutcome]*OutcomeStats
+	nodes    map[storj.NodeID]*NodeFailures
+}
+
+// NewStats creates empty repair stats
+func NewStats() *Stats {
+	return &Stats{
+		outcomes: make(map[segments.RepairOutcome]*OutcomeStats),
+		nodes:    make(map[storj.NodeID]*NodeFailures),
+	}
+}
+
+// Record adds the outcome of a repair to the stats
+func (stats *Stats) Record(report *segments.RepairReport) {
+	mon.Meter("repair_outcome_" + string(report.Outcome)).Mark(1)
+	mon.IntVal("repair_segment_size_" + string(report.Outcome)).Observe(report.SegmentSize)
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	outcome, ok := stats.outcomes[report.Outcome]
+	if !ok {
+		outcome = &OutcomeStats{
+			Outcome:    report.Outcome,
+			SizeCounts: make([]int64, len(SizeBuckets)+1),
+		}
+		stats.outcomes[report.Outcome] = outcome
+	}
+	outcome.Count++
+	outcome.TotalBytes += report.SegmentSize
+	outcome.SizeCounts[sizeBucket(report.SegmentSize)]++
+
+	for _, id := range report.FailedDownloads {
+		stats.node(id).DownloadFailures++
+	}
+	for _, id := range report.FailedUploads {
+		stats.node(id).UploadFailures++
+	}
+}
+
+// node returns the failures of a node, it must be called with mu held
+func (stats *Stats) node(id storj.NodeID) *NodeFailures {
+	failures, ok := stats.nodes[id]
+	if !ok {
+		failures = &NodeFailures{NodeID: id}
+		stats.nodes[id] = failures
+	}
+	return failures
+}
+
+// Outcomes returns the stats of every outcome, including those which
+// haven't happened yet
+func (stats *Stats) Outcomes() []OutcomeStats {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	var outcomes []OutcomeStats
+	for _, outcome := range segments.RepairOutcomes {
+		if recorded, ok := stats.outcomes[outcome]; ok {
+			copied := *recorded
+			copied.SizeCounts = append([]int64(nil), recorded.SizeCounts...)
+			outcomes = append(outcomes, copied)
+			continue
+		}
+		outcomes = append(outcomes, OutcomeStats{
+			Outcome:    outcome,
+			SizeCounts: make([]int64, len(SizeBuckets)+1),
+		})
+	}
+	return outcomes
+}
+
+// FailingNodes returns up to limit nodes with the most failures, ordered
+// by their number of failures
+func (stats *Stats) FailingNodes(limit int) []NodeFailures {
+	stats.mu.Lock()
+	nodes := make([]NodeFailures, 0, len(stats.nodes))
+	for _, failures := range stats.nodes {
+		nodes = append(nodes, *failures)
+	}
+	stats.mu.Unlock()
+
+	sort.Slice(nodes, func(i, k int) bool {
+		if nodes[i].Total() != nodes[k].Total() {
+			return nodes[i].Total() > nodes[k].Total()
+		}
+		return nodes[i].NodeID.Less(nodes[k].NodeID)
+	})
+	if limit >= 0 && limit < len(nodes) {
+		nodes = nodes[:limit]
+	}
+	return nodes
+}
+
+// sizeBucket returns the index of the size bucket of a segment
+func sizeBucket(size int64) int {
+	for i, limit := range SizeBuckets {
+		if size <= limit {
+			return i
+		}
+	}
+	return len(SizeBuckets)
+}
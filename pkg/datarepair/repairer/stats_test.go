@@ -0,0 +1,67 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/datarepair/repairer"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestStats(t *testing.T) {
+	a, b, c := teststorj.NodeIDFromString("a"), teststorj.NodeIDFromString("b"), teststorj.NodeIDFromString("c")
+
+	stats := repairer.NewStats()
+	stats.Record(&segments.RepairReport{
+		Outcome:       segments.RepairSucceeded,
+		SegmentSize:   memory.KiB.Int64(),
+		FailedUploads: storj.NodeIDList{c},
+	})
+	stats.Record(&segments.RepairReport{
+		Outcome:     segments.RepairSucceeded,
+		SegmentSize: 2 * memory.MiB.Int64(),
+	})
+	stats.Record(&segments.RepairReport{
+		Outcome:         segments.RepairDownloadFailed,
+		SegmentSize:     100 * memory.MiB.Int64(),
+		FailedDownloads: storj.NodeIDList{a, b},
+	})
+	stats.Record(&segments.RepairReport{
+		Outcome:       segments.RepairUploadFailed,
+		SegmentSize:   memory.MiB.Int64(),
+		FailedUploads: storj.NodeIDList{b},
+	})
+
+	outcomes := stats.Outcomes()
+	require.Len(t, outcomes, len(segments.RepairOutcomes))
+	byOutcome := make(map[segments.RepairOutcome]repairer.OutcomeStats)
+	for _, outcome := range outcomes {
+		require.Len(t, outcome.SizeCounts, len(repairer.SizeBuckets)+1)
+		byOutcome[outcome.Outcome] = outcome
+	}
+
+	succeeded := byOutcome[segments.RepairSucceeded]
+	assert.Equal(t, int64(2), succeeded.Count)
+	assert.Equal(t, memory.KiB.Int64()+2*memory.MiB.Int64(), succeeded.TotalBytes)
+	assert.Equal(t, []int64{1, 1, 0, 0, 0}, succeeded.SizeCounts)
+	assert.Equal(t, []int64{0, 0, 0, 0, 1}, byOutcome[segments.RepairDownloadFailed].SizeCounts)
+	assert.Equal(t, []int64{1, 0, 0, 0, 0}, byOutcome[segments.RepairUploadFailed].SizeCounts)
+	assert.Equal(t, int64(0), byOutcome[segments.RepairPointerChanged].Count)
+
+	nodes := stats.FailingNodes(2)
+	require.Len(t, nodes, 2)
+	assert.Equal(t, b, nodes[0].NodeID)
+	assert.Equal(t, int64(1), nodes[0].DownloadFailures)
+	assert.Equal(t, int64(1), nodes[0].UploadFailures)
+	assert.Equal(t, int64(1), nodes[1].Total())
+
+	assert.Len(t, stats.FailingNodes(-1), 3)
+}
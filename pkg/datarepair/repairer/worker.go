@@ -0,0 +1,204 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/storage"
+)
+
+// Worker repairs segments claimed from the shared repair queue.
+// Workers don't keep any state besides their egress budget and the downloads
+// they are running, so any number of them can run against the same queue,
+// inside the satellite or as separate processes.
+type Worker struct {
+	log       *zap.Logger
+	queue     queue.RepairQueue
+	health    pointerdb.HealthDB
+	config    *Config
+	identity  *identity.FullIdentity
+	repairer  SegmentRepairer
+	limiter   *sync2.Limiter
+	budget    *Budget
+	scheduler *NodeScheduler
+	stats     *Stats
+	ticker    *time.Ticker
+}
+
+// NewWorker creates a repair worker, the times segments are repaired are
+// recorded in health
+func NewWorker(log *zap.Logger, queue queue.RepairQueue, health pointerdb.HealthDB, config *Config, identity *identity.FullIdentity) *Worker {
+	return &Worker{
+		log:       log,
+		queue:     queue,
+		health:    health,
+		config:    config,
+		identity:  identity,
+		limiter:   sync2.NewLimiter(config.MaxRepair),
+		budget:    NewBudget(config.HourlyEgressBudget, config.DailyEgressBudget),
+		scheduler: NewNodeScheduler(config.MaxDownloadsPerNode),
+		stats:     NewStats(),
+		ticker:    time.NewTicker(config.Interval),
+	}
+}
+
+// Stats returns the outcomes of the repairs done by the worker
+func (worker *Worker) Stats() *Stats {
+	return worker.stats
+}
+
+// Close closes resources
+func (worker *Worker) Close() error {
+	worker.ticker.Stop()
+	return nil
+}
+
+// Run claims and repairs segments until the context is canceled
+func (worker *Worker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// TODO: close segment repairer, currently this leaks connections
+	worker.repairer, err = worker.config.GetSegmentRepairer(ctx, worker.identity, worker.budget, worker.scheduler)
+	if err != nil {
+		return err
+	}
+
+	// wait for all repairs to complete
+	defer worker.limiter.Wait()
+
+	for {
+		if worker.config.DryRun {
+			err = worker.dryRun(ctx)
+		} else {
+			err = worker.process(ctx)
+		}
+		if err != nil {
+			worker.log.Error("process", zap.Error(err))
+		}
+
+		select {
+		case <-worker.ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the worker is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// process claims segments and repairs them until the queue is empty or
+// the repair egress budget has been exhausted
+func (worker *Worker) process(ctx context.Context) error {
+	for {
+		hourly, daily := worker.budget.Remaining(time.Now())
+		mon.IntVal("repair_budget_remaining_hourly").Observe(hourly)
+		mon.IntVal("repair_budget_remaining_daily").Observe(daily)
+		if hourly == 0 || daily == 0 {
+			worker.log.Debug("repair egress budget exhausted, pausing repairs",
+				zap.Int64("remaining hourly", hourly), zap.Int64("remaining daily", daily))
+			return nil
+		}
+
+		job, err := worker.queue.Claim(ctx, worker.config.LeaseDuration)
+		if err != nil {
+			if storage.ErrEmptyQueue.Has(err) {
+				return nil
+			}
+			return err
+		}
+		mon.IntVal("repair_attempts").Observe(job.Attempts)
+
+		// the bytes the repair downloads are reserved before it starts, when
+		// they don't fit the segment is retried after its lease expires
+		reserved := worker.downloadBytes(ctx, job)
+		if !worker.budget.Reserve(time.Now(), reserved) {
+			worker.log.Debug("repair egress budget exhausted, pausing repairs",
+				zap.String("path", job.Segment.GetPath()), zap.Int64("download bytes", reserved))
+			return nil
+		}
+
+		// blocks until there is room for another repair
+		started := worker.limiter.Go(ctx, func() {
+			defer worker.budget.Release(reserved)
+			worker.repair(ctx, job)
+		})
+		if !started {
+			worker.budget.Release(reserved)
+			return ctx.Err()
+		}
+	}
+}
+
+// dryRun reports what repairing the queued segments would transfer
+func (worker *Worker) dryRun(ctx context.Context) error {
+	simulation, err := Simulate(ctx, worker.queue, worker.repairer, worker.config)
+	if err != nil {
+		return err
+	}
+
+	mon.IntVal("repair_dry_run_download_bytes").Observe(simulation.DownloadBytes)
+	mon.IntVal("repair_dry_run_upload_bytes").Observe(simulation.UploadBytes)
+	worker.log.Info("repair dry run",
+		zap.Int("segments", simulation.Segments),
+		zap.Int("repairable", simulation.Repairable),
+		zap.Int("healthy", simulation.Healthy),
+		zap.Int("failed", simulation.Failed),
+		zap.Int64("download bytes", simulation.DownloadBytes),
+		zap.Int64("upload bytes", simulation.UploadBytes),
+		zap.Int("download nodes", simulation.DownloadNodes),
+		zap.Int("upload nodes", simulation.UploadNodes),
+		zap.Duration("estimated duration", simulation.EstimatedDuration))
+	return nil
+}
+
+// downloadBytes returns the number of bytes repairing the claimed segment
+// downloads, segments which can't be planned fail on repair and reserve none
+func (worker *Worker) downloadBytes(ctx context.Context, job *queue.Job) int64 {
+	plan, err := worker.repairer.Plan(ctx, job.Segment.GetPath(), job.Segment.GetLostPieces())
+	if err != nil {
+		worker.log.Debug("planning repair", zap.String("path", job.Segment.GetPath()), zap.Error(err))
+		return 0
+	}
+	return plan.DownloadBytes()
+}
+
+// repair repairs a claimed segment and removes it from the queue, when the
+// repair fails the segment is retried by any worker after the lease expires
+func (worker *Worker) repair(ctx context.Context, job *queue.Job) {
+	path := job.Segment.GetPath()
+
+	report, err := worker.repairer.Repair(ctx, path, job.Segment.GetLostPieces())
+	if report == nil {
+		report = &segments.RepairReport{Path: path, Outcome: segments.RepairSucceeded}
+		if err != nil {
+			report.Outcome = segments.RepairFailed
+		}
+	}
+	worker.stats.Record(report)
+
+	if err != nil {
+		worker.log.Error("repair failed",
+			zap.String("path", path),
+			zap.String("outcome", string(report.Outcome)),
+			zap.Int64("attempts", job.Attempts),
+			zap.Time("retry after", job.LeasedUntil),
+			zap.Error(err))
+		return
+	}
+
+	if err := worker.health.RecordRepair(ctx, path, time.Now()); err != nil {
+		worker.log.Error("recording repair", zap.String("path", path), zap.Error(err))
+	}
+
+	if err := worker.queue.Complete(ctx, path); err != nil {
+		worker.log.Error("completing repair", zap.String("path", path), zap.Error(err))
+	}
+}
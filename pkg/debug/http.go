@@ -0,0 +1,50 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package debug
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const defaultDiffLimit = 20
+
+// Handler returns an http.Handler serving snapshot capture and diffing:
+//
+//	GET <prefix>/capture?label=before
+//	GET <prefix>/diff?from=before&to=after&limit=20
+func (s *Snapshots) Handler() http.Handler {
+	var mux http.ServeMux
+	mux.HandleFunc("/capture", func(w http.ResponseWriter, r *http.Request) {
+		label := r.URL.Query().Get("label")
+		if label == "" {
+			http.Error(w, "missing label", http.StatusBadRequest)
+			return
+		}
+		snapshot := s.Capture(label)
+		_, _ = fmt.Fprintf(w, "captured %q: %d goroutine stacks, %d allocation sites\n",
+			label, len(snapshot.Goroutines), len(snapshot.Heap))
+	})
+	mux.HandleFunc("/diff", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		limit := defaultDiffLimit
+		if v := query.Get("limit"); v != "" {
+			var err error
+			limit, err = strconv.Atoi(v)
+			if err != nil || limit < 0 {
+				http.Error(w, "invalid limit", http.StatusBadRequest)
+				return
+			}
+		}
+
+		diff, err := s.Diff(query.Get("from"), query.Get("to"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		_ = diff.WriteSummary(w, limit)
+	})
+	return &mux
+}
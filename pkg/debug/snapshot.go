@@ -0,0 +1,236 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package debug
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default debug errs class
+var Error = errs.Class("debug error")
+
+// maxFrames is the number of innermost frames used to identify a stack
+const maxFrames = 8
+
+// Snapshot is a point in time capture of goroutine stacks and heap allocation sites
+type Snapshot struct {
+	Label      string
+	Time       time.Time
+	Goroutines map[string]int64
+	Heap       map[string]HeapSite
+}
+
+// HeapSite is the in-use memory attributed to a single allocation stack
+type HeapSite struct {
+	Bytes   int64
+	Objects int64
+}
+
+// Capture takes a new snapshot of goroutines and the heap profile
+func Capture(label string) *Snapshot {
+	snapshot := &Snapshot{
+		Label:      label,
+		Time:       time.Now(),
+		Goroutines: map[string]int64{},
+		Heap:       map[string]HeapSite{},
+	}
+
+	var goroutines []runtime.StackRecord
+	n, ok := runtime.GoroutineProfile(nil)
+	for !ok {
+		goroutines = make([]runtime.StackRecord, n+10)
+		n, ok = runtime.GoroutineProfile(goroutines)
+	}
+	for _, record := range goroutines[:n] {
+		snapshot.Goroutines[stackKey(record.Stack())]++
+	}
+
+	// the heap profile lags up to two collection cycles behind
+	runtime.GC()
+	runtime.GC()
+
+	var allocs []runtime.MemProfileRecord
+	n, ok = runtime.MemProfile(nil, false)
+	for !ok {
+		allocs = make([]runtime.MemProfileRecord, n+50)
+		n, ok = runtime.MemProfile(allocs, false)
+	}
+	for _, record := range allocs[:n] {
+		key := stackKey(record.Stack())
+		site := snapshot.Heap[key]
+		site.Bytes += record.InUseBytes()
+		site.Objects += record.InUseObjects()
+		snapshot.Heap[key] = site
+	}
+
+	return snapshot
+}
+
+// stackKey converts program counters into a human readable stack identifier
+func stackKey(pcs []uintptr) string {
+	var lines []string
+	frames := runtime.CallersFrames(pcs)
+	for len(lines) < maxFrames {
+		frame, more := frames.Next()
+		if frame.Function != "" {
+			lines = append(lines, fmt.Sprintf("%s (%s:%d)", frame.Function, frame.File, frame.Line))
+		}
+		if !more {
+			break
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// StackDelta describes how the goroutine count for a stack changed between snapshots
+type StackDelta struct {
+	Stack  string
+	Before int64
+	After  int64
+}
+
+// HeapDelta describes how in-use memory for an allocation site changed between snapshots
+type HeapDelta struct {
+	Stack  string
+	Before HeapSite
+	After  HeapSite
+}
+
+// Diff is a summary of the differences between two snapshots
+type Diff struct {
+	From, To *Snapshot
+
+	// Goroutines contains stacks which have more goroutines in To than in From
+	Goroutines []StackDelta
+	// Heap contains allocation sites which have more in-use bytes in To than in From
+	Heap []HeapDelta
+}
+
+// Compare computes the growth from the snapshot from to the snapshot to
+func Compare(from, to *Snapshot) *Diff {
+	diff := &Diff{From: from, To: to}
+
+	for stack, after := range to.Goroutines {
+		before := from.Goroutines[stack]
+		if after > before {
+			diff.Goroutines = append(diff.Goroutines, StackDelta{Stack: stack, Before: before, After: after})
+		}
+	}
+	sort.Slice(diff.Goroutines, func(i, k int) bool {
+		a, b := diff.Goroutines[i], diff.Goroutines[k]
+		if a.After-a.Before != b.After-b.Before {
+			return a.After-a.Before > b.After-b.Before
+		}
+		return a.Stack < b.Stack
+	})
+
+	for stack, after := range to.Heap {
+		before := from.Heap[stack]
+		if after.Bytes > before.Bytes {
+			diff.Heap = append(diff.Heap, HeapDelta{Stack: stack, Before: before, After: after})
+		}
+	}
+	sort.Slice(diff.Heap, func(i, k int) bool {
+		a, b := diff.Heap[i], diff.Heap[k]
+		if a.After.Bytes-a.Before.Bytes != b.After.Bytes-b.Before.Bytes {
+			return a.After.Bytes-a.Before.Bytes > b.After.Bytes-b.Before.Bytes
+		}
+		return a.Stack < b.Stack
+	})
+
+	return diff
+}
+
+// WriteSummary writes a human readable summary of at most limit entries per section to w
+func (diff *Diff) WriteSummary(w io.Writer, limit int) (err error) {
+	p := func(format string, args ...interface{}) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, args...)
+		}
+	}
+
+	p("snapshot diff %q (%s) -> %q (%s), elapsed %s\n\n",
+		diff.From.Label, diff.From.Time.Format(time.RFC3339),
+		diff.To.Label, diff.To.Time.Format(time.RFC3339),
+		diff.To.Time.Sub(diff.From.Time))
+
+	p("goroutine stacks with growth: %d\n", len(diff.Goroutines))
+	for i, delta := range diff.Goroutines {
+		if i >= limit {
+			p("... %d more\n", len(diff.Goroutines)-limit)
+			break
+		}
+		p("\n+%d goroutines (%d -> %d)\n%s\n", delta.After-delta.Before, delta.Before, delta.After, indent(delta.Stack))
+	}
+
+	p("\nallocation sites with growth: %d\n", len(diff.Heap))
+	for i, delta := range diff.Heap {
+		if i >= limit {
+			p("... %d more\n", len(diff.Heap)-limit)
+			break
+		}
+		p("\n+%d bytes in %+d objects (%d -> %d bytes)\n%s\n",
+			delta.After.Bytes-delta.Before.Bytes, delta.After.Objects-delta.Before.Objects,
+			delta.Before.Bytes, delta.After.Bytes, indent(delta.Stack))
+	}
+
+	return err
+}
+
+func indent(s string) string {
+	return "\t" + strings.Replace(s, "\n", "\n\t", -1)
+}
+
+// Snapshots keeps labeled snapshots for later comparison
+type Snapshots struct {
+	mu        sync.Mutex
+	snapshots map[string]*Snapshot
+}
+
+// NewSnapshots creates an empty snapshot registry
+func NewSnapshots() *Snapshots {
+	return &Snapshots{snapshots: map[string]*Snapshot{}}
+}
+
+// Capture takes a new snapshot and stores it under label, replacing any previous one
+func (s *Snapshots) Capture(label string) *Snapshot {
+	snapshot := Capture(label)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[label] = snapshot
+	return snapshot
+}
+
+// Get returns the snapshot stored under label
+func (s *Snapshots) Get(label string) (*Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot, ok := s.snapshots[label]
+	if !ok {
+		return nil, Error.New("snapshot %q not found", label)
+	}
+	return snapshot, nil
+}
+
+// Diff compares two stored snapshots
+func (s *Snapshots) Diff(from, to string) (*Diff, error) {
+	a, err := s.Get(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.Get(to)
+	if err != nil {
+		return nil, err
+	}
+	return Compare(a, b), nil
+}
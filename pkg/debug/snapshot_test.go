@@ -0,0 +1,73 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package debug_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/debug"
+)
+
+func TestCompare(t *testing.T) {
+	from := &debug.Snapshot{
+		Label:      "before",
+		Goroutines: map[string]int64{"idle": 2, "leaky": 1, "done": 3},
+		Heap: map[string]debug.HeapSite{
+			"cache":  {Bytes: 100, Objects: 1},
+			"buffer": {Bytes: 500, Objects: 5},
+		},
+	}
+	to := &debug.Snapshot{
+		Label:      "after",
+		Goroutines: map[string]int64{"idle": 2, "leaky": 10, "new": 1},
+		Heap: map[string]debug.HeapSite{
+			"cache":  {Bytes: 1000, Objects: 10},
+			"buffer": {Bytes: 100, Objects: 1},
+			"fresh":  {Bytes: 50, Objects: 1},
+		},
+	}
+
+	diff := debug.Compare(from, to)
+
+	assert.Equal(t, []debug.StackDelta{
+		{Stack: "leaky", Before: 1, After: 10},
+		{Stack: "new", Before: 0, After: 1},
+	}, diff.Goroutines)
+
+	assert.Equal(t, []debug.HeapDelta{
+		{Stack: "cache", Before: debug.HeapSite{Bytes: 100, Objects: 1}, After: debug.HeapSite{Bytes: 1000, Objects: 10}},
+		{Stack: "fresh", After: debug.HeapSite{Bytes: 50, Objects: 1}},
+	}, diff.Heap)
+
+	var buf bytes.Buffer
+	require.NoError(t, diff.WriteSummary(&buf, 1))
+	assert.Contains(t, buf.String(), "+9 goroutines (1 -> 10)")
+	assert.Contains(t, buf.String(), "+900 bytes in +9 objects (100 -> 1000 bytes)")
+	assert.NotContains(t, buf.String(), "fresh")
+}
+
+func TestSnapshotsLeak(t *testing.T) {
+	snapshots := debug.NewSnapshots()
+	snapshots.Capture("before")
+
+	stop := make(chan struct{})
+	defer close(stop)
+	for i := 0; i < 5; i++ {
+		go func() { <-stop }()
+	}
+
+	snapshots.Capture("after")
+
+	diff, err := snapshots.Diff("before", "after")
+	require.NoError(t, err)
+	require.NotEmpty(t, diff.Goroutines)
+	assert.True(t, diff.Goroutines[0].After-diff.Goroutines[0].Before >= 5)
+
+	_, err = snapshots.Diff("before", "missing")
+	assert.Error(t, err)
+}
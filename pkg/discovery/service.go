@@ -10,9 +10,12 @@ import (
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 )
@@ -30,6 +33,7 @@ type Config struct {
 	GraveyardInterval time.Duration `help:"the interval at which the the graveyard tries to resurrect nodes" default:"30s"`
 	DiscoveryInterval time.Duration `help:"the interval at which the satellite attempts to find new nodes via random node ID lookups" default:"1s"`
 	RefreshLimit      int           `help:"the amount of nodes refreshed at each interval" default:"100"`
+	BootstrapInterval time.Duration `help:"the interval at which the bootstrap nodes are health checked" default:"5m0s"`
 }
 
 // Discovery struct loads on cache, kad, and statdb
@@ -40,72 +44,107 @@ type Discovery struct {
 	statdb statdb.DB
 	config Config
 
+	standings overlay.StandingDB
+
 	// refreshOffset tracks the offset of the current refresh cycle
 	refreshOffset int64
+
+	Refresh   sync2.Cycle
+	Graveyard sync2.Cycle
+	Discovery sync2.Cycle
+	Bootstrap sync2.Cycle
 }
 
-// New returns a new discovery service.
-func New(logger *zap.Logger, ol *overlay.Cache, kad *kademlia.Kademlia, stat statdb.DB, config Config) *Discovery {
-	return &Discovery{
+// New returns a new discovery service, which tells the nodes it refreshes
+// their standing. The standings aren't sent when standings is nil.
+func New(logger *zap.Logger, ol *overlay.Cache, kad *kademlia.Kademlia, stat statdb.DB, standings overlay.StandingDB, config Config) *Discovery {
+	discovery := &Discovery{
 		log:    logger,
 		cache:  ol,
 		kad:    kad,
 		statdb: stat,
 		config: config,
 
+		standings: standings,
+
 		refreshOffset: 0,
 	}
+
+	discovery.Refresh.SetInterval(config.RefreshInterval)
+	discovery.Graveyard.SetInterval(config.GraveyardInterval)
+	discovery.Discovery.SetInterval(config.DiscoveryInterval)
+	discovery.Bootstrap.SetInterval(config.BootstrapInterval)
+
+	return discovery
 }
 
 // NewDiscovery Returns a new Discovery instance with cache, kad, and statdb loaded on
 func NewDiscovery(logger *zap.Logger, ol *overlay.Cache, kad *kademlia.Kademlia, stat statdb.DB, config Config) *Discovery {
-	return &Discovery{
-		log:    logger,
-		cache:  ol,
-		kad:    kad,
-		statdb: stat,
-		config: config,
-	}
+	return New(logger, ol, kad, stat, nil, config)
 }
 
 // Close closes resources
-func (discovery *Discovery) Close() error { return nil }
+func (discovery *Discovery) Close() error {
+	discovery.Refresh.Close()
+	discovery.Graveyard.Close()
+	discovery.Discovery.Close()
+	discovery.Bootstrap.Close()
+	return nil
+}
 
 // Run runs the discovery service
 func (discovery *Discovery) Run(ctx context.Context) error {
-	refresh := time.NewTicker(discovery.config.RefreshInterval)
-	graveyard := time.NewTicker(discovery.config.GraveyardInterval)
-	discover := time.NewTicker(discovery.config.DiscoveryInterval)
-	defer refresh.Stop()
-	defer graveyard.Stop()
-	defer discover.Stop()
-
-	for {
-		select {
-		case <-refresh.C:
-			err := discovery.refresh(ctx)
-			if err != nil {
-				discovery.log.Error("error with cache refresh: ", zap.Error(err))
-			}
-		case <-discover.C:
-			err := discovery.discover(ctx)
-			if err != nil {
-				discovery.log.Error("error with cache discovery: ", zap.Error(err))
-			}
-		case <-graveyard.C:
-			err := discovery.searchGraveyard(ctx)
-			if err != nil {
-				discovery.log.Error("graveyard resurrection failed: ", zap.Error(err))
-			}
-		case <-ctx.Done():
-			return ctx.Err()
+	var group errgroup.Group
+
+	discovery.Refresh.Start(ctx, &group, func(ctx context.Context) error {
+		err := discovery.refresh(ctx)
+		if err != nil {
+			discovery.log.Error("error with cache refresh: ", zap.Error(err))
+		}
+		return nil
+	})
+	discovery.Discovery.Start(ctx, &group, func(ctx context.Context) error {
+		err := discovery.discover(ctx)
+		if err != nil {
+			discovery.log.Error("error with cache discovery: ", zap.Error(err))
+		}
+		return nil
+	})
+	discovery.Graveyard.Start(ctx, &group, func(ctx context.Context) error {
+		err := discovery.searchGraveyard(ctx)
+		if err != nil {
+			discovery.log.Error("graveyard resurrection failed: ", zap.Error(err))
 		}
+		return nil
+	})
+	discovery.Bootstrap.Start(ctx, &group, func(ctx context.Context) error {
+		discovery.checkBootstrap(ctx)
+		return nil
+	})
+
+	return group.Wait()
+}
+
+// checkBootstrap health checks the bootstrap nodes, so bootstrapping again
+// starts with the live ones
+func (discovery *Discovery) checkBootstrap(ctx context.Context) {
+	nodes := discovery.kad.GetBootstrapNodes()
+	if len(nodes) == 0 {
+		return
+	}
+	live, err := discovery.kad.CheckBootstrapNodes(ctx)
+	if err != nil {
+		discovery.log.Debug("bootstrap health check canceled", zap.Error(err))
+		return
+	}
+	if live == 0 {
+		discovery.log.Warn("no bootstrap node is reachable", zap.Int("bootstrap nodes", len(nodes)))
 	}
 }
 
 // refresh updates the cache db with the current DHT.
-// We currently do not penalize nodes that are unresponsive,
-// but should in the future.
+// Unresponsive nodes are removed from the cache once their
+// ping success rate drops below the offline threshold.
 func (discovery *Discovery) refresh(ctx context.Context) error {
 	nodes := discovery.kad.Seen()
 	for _, v := range nodes {
@@ -131,12 +170,21 @@ func (discovery *Discovery) refresh(ctx context.Context) error {
 			return ctx.Err()
 		}
 
-		ping, err := discovery.kad.Ping(ctx, *node)
+		standing, err := discovery.standing(ctx, node)
+		if err != nil {
+			discovery.log.Error("could not get node standing", zap.String("ID", node.Id.String()), zap.Error(err))
+		}
+
+		ping, err := discovery.kad.CheckIn(ctx, *node, standing)
 		if err != nil {
 			discovery.log.Info("could not ping node", zap.String("ID", node.Id.String()), zap.Error(err))
-			_, err := discovery.statdb.UpdateUptime(ctx, node.Id, false)
+			offline, err := discovery.cache.RecordPing(ctx, node.Id, false)
 			if err != nil {
-				discovery.log.Error("could not update node uptime in statdb", zap.String("ID", node.Id.String()), zap.Error(err))
+				discovery.log.Error("could not record node ping", zap.String("ID", node.Id.String()), zap.Error(err))
+				continue
+			}
+			if !offline {
+				continue
 			}
 			err = discovery.cache.Delete(ctx, node.Id)
 			if err != nil {
@@ -149,9 +197,11 @@ func (discovery *Discovery) refresh(ctx context.Context) error {
 			return ctx.Err()
 		}
 
-		_, err = discovery.statdb.UpdateUptime(ctx, ping.Id, true)
+		// the node isn't marked as online when its ping couldn't be recorded
+		_, err = discovery.cache.RecordPing(ctx, ping.Id, true)
 		if err != nil {
-			discovery.log.Error("could not update node uptime in statdb", zap.String("ID", ping.Id.String()), zap.Error(err))
+			discovery.log.Error("could not record node ping", zap.String("ID", ping.Id.String()), zap.Error(err))
+			continue
 		}
 		err = discovery.cache.Put(ctx, ping.Id, ping)
 		if err != nil {
@@ -162,6 +212,46 @@ func (discovery *Discovery) refresh(ctx context.Context) error {
 	return nil
 }
 
+// standing returns the standing node is checked in with. A new status is
+// recorded when it changes, so the node is told since when it applies.
+func (discovery *Discovery) standing(ctx context.Context, node *pb.Node) (*pb.NodeStanding, error) {
+	if discovery.standings == nil {
+		return nil, nil
+	}
+
+	now := time.Now()
+	status, reason := discovery.cache.Standing(node)
+
+	last, err := discovery.standings.Get(ctx, node.Id)
+	if err != nil && err != overlay.ErrNodeNotFound {
+		return nil, Error.Wrap(err)
+	}
+
+	var since time.Time
+	switch {
+	case last != nil && last.Status == status:
+		since = last.Since
+	case last != nil || status != pb.NodeStanding_GOOD:
+		since = now
+		err = discovery.standings.Set(ctx, overlay.NodeStanding{NodeID: node.Id, Status: status, Reason: reason, Since: since})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		discovery.log.Info("node standing changed",
+			zap.String("ID", node.Id.String()), zap.Stringer("Status", status), zap.String("Reason", reason))
+	}
+
+	standing := &pb.NodeStanding{
+		Status:      status,
+		Reason:      reason,
+		SentUnixSec: now.Unix(),
+	}
+	if !since.IsZero() {
+		standing.SinceUnixSec = since.Unix()
+	}
+	return standing, nil
+}
+
 // graveyard attempts to ping all nodes in the Seen() map from Kademlia and adds them to the cache
 // if they respond. This is an attempt to resurrect nodes that may have gone offline in the last hour
 // and were removed from the cache due to an unsuccessful response.
@@ -191,9 +281,9 @@ func (discovery *Discovery) searchGraveyard(ctx context.Context) error {
 			errors.Add(err)
 		}
 
-		_, err = discovery.statdb.UpdateUptime(ctx, ping.Id, true)
+		_, err = discovery.cache.RecordPing(ctx, ping.Id, true)
 		if err != nil {
-			discovery.log.Warn("could not update node uptime")
+			discovery.log.Warn("could not record node ping")
 			errors.Add(err)
 		}
 	}
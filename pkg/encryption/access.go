@@ -0,0 +1,278 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package encryption
+
+import (
+	"sort"
+	"strings"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Access is the set of keys paths and contents are encrypted with. The
+// paths under a prefix with its own key are encrypted with keys derived
+// from that key, all the other paths with keys derived from the root key.
+//
+// Buckets encrypted with their own passphrases, and prefixes shared without
+// sharing the root key, are prefixes with their own keys, so an uplink can
+// access them without knowing the root key of their owner.
+type Access struct {
+	root     *storj.Key
+	prefixes map[storj.Path]PrefixKey
+}
+
+// PrefixKey is the key of the paths under Prefix, which is encrypted as
+// EncryptedPrefix. The first element of both is the bucket, which isn't
+// encrypted. The keys of the paths under Prefix are derived from Key like
+// they are from the key of the depth of Prefix.
+//
+// BucketMetaKey is the key the metadata of the bucket is encrypted with,
+// it's set by Derive, so the bucket can be read when only the keys of
+// prefixes in it are known.
+type PrefixKey struct {
+	Prefix          storj.Path
+	EncryptedPrefix storj.Path
+	Key             storj.Key
+	BucketMetaKey   storj.Key
+}
+
+// NewAccess creates an access with the root key
+func NewAccess(root *storj.Key) *Access {
+	return &Access{
+		root:     root,
+		prefixes: make(map[storj.Path]PrefixKey),
+	}
+}
+
+// KeyFromPassphrase returns the key encrypting with passphrase
+func KeyFromPassphrase(passphrase string) *storj.Key {
+	key := new(storj.Key)
+	copy(key[:], passphrase)
+	return key
+}
+
+// Root returns the root key
+func (access *Access) Root() *storj.Key {
+	return access.root
+}
+
+// Add adds the key of the paths under a prefix. Adding another key for a
+// prefix which already has one fails.
+func (access *Access) Add(prefixKey PrefixKey) error {
+	comps := storj.SplitPath(prefixKey.Prefix)
+	encComps := storj.SplitPath(prefixKey.EncryptedPrefix)
+	if prefixKey.Prefix == "" || len(comps) != len(encComps) || comps[0] != encComps[0] {
+		return Error.New("invalid prefix %q encrypted as %q", prefixKey.Prefix, prefixKey.EncryptedPrefix)
+	}
+
+	if existing, ok := access.prefixes[prefixKey.Prefix]; ok && existing != prefixKey {
+		return Error.New("conflicting keys for prefix %q", prefixKey.Prefix)
+	}
+	access.prefixes[prefixKey.Prefix] = prefixKey
+	return nil
+}
+
+// AddBucket adds the key of a bucket encrypted with its own passphrase
+func (access *Access) AddBucket(bucket string, key *storj.Key) error {
+	return access.Add(PrefixKey{
+		Prefix:          bucket,
+		EncryptedPrefix: bucket,
+		Key:             *key,
+	})
+}
+
+// Prefixes returns the keys of the prefixes, sorted by prefix
+func (access *Access) Prefixes() []PrefixKey {
+	prefixes := make([]PrefixKey, 0, len(access.prefixes))
+	for _, prefixKey := range access.prefixes {
+		prefixes = append(prefixes, prefixKey)
+	}
+	sort.Slice(prefixes, func(i, k int) bool {
+		return prefixes[i].Prefix < prefixes[k].Prefix
+	})
+	return prefixes
+}
+
+// Merge adds the prefix keys of other to access, which keeps its own root
+// key. It fails if both have different keys for the same prefix.
+func (access *Access) Merge(other *Access) error {
+	for _, prefixKey := range other.Prefixes() {
+		if err := access.Add(prefixKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Derive returns the prefix key of path itself, with which path can be
+// shared without sharing the key of its bucket. The first element of path
+// is the bucket.
+func (access *Access) Derive(path storj.Path, cipher storj.Cipher) (PrefixKey, error) {
+	encrypted, err := access.EncryptPath(path, cipher)
+	if err != nil {
+		return PrefixKey{}, err
+	}
+	key, err := access.PathKey(path)
+	if err != nil {
+		return PrefixKey{}, err
+	}
+	bucketMetaKey, err := access.ContentKey(storj.SplitPath(path)[0])
+	if err != nil {
+		return PrefixKey{}, err
+	}
+	return PrefixKey{
+		Prefix:          path,
+		EncryptedPrefix: encrypted,
+		Key:             *key,
+		BucketMetaKey:   *bucketMetaKey,
+	}, nil
+}
+
+// EncryptPath encrypts path, except for its first element which is the
+// bucket
+func (access *Access) EncryptPath(path storj.Path, cipher storj.Cipher) (storj.Path, error) {
+	if len(storj.SplitPath(path)) <= 1 {
+		return path, nil
+	}
+
+	prefixKey, err := access.lookup(path)
+	if err != nil {
+		return "", err
+	}
+
+	rest := trimPathPrefix(path, prefixKey.Prefix)
+	if rest == "" {
+		return prefixKey.EncryptedPrefix, nil
+	}
+	encrypted, err := EncryptPath(rest, cipher, &prefixKey.Key)
+	if err != nil {
+		return "", err
+	}
+	return storj.JoinPaths(prefixKey.EncryptedPrefix, encrypted), nil
+}
+
+// DecryptPath decrypts a path encrypted by EncryptPath
+func (access *Access) DecryptPath(encrypted storj.Path, cipher storj.Cipher) (storj.Path, error) {
+	if len(storj.SplitPath(encrypted)) <= 1 {
+		return encrypted, nil
+	}
+
+	prefixKey, err := access.lookupEncrypted(encrypted)
+	if err != nil {
+		return "", err
+	}
+
+	rest := trimPathPrefix(encrypted, prefixKey.EncryptedPrefix)
+	if rest == "" {
+		return prefixKey.Prefix, nil
+	}
+	decrypted, err := DecryptPath(rest, cipher, &prefixKey.Key)
+	if err != nil {
+		return "", err
+	}
+	return storj.JoinPaths(prefixKey.Prefix, decrypted), nil
+}
+
+// PathKey returns the key of the depth of path, from which the keys of the
+// paths under it are derived
+func (access *Access) PathKey(path storj.Path) (*storj.Key, error) {
+	prefixKey, err := access.lookup(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rest := trimPathPrefix(path, prefixKey.Prefix)
+	if rest == "" {
+		return &prefixKey.Key, nil
+	}
+	return DerivePathKey(rest, &prefixKey.Key, len(storj.SplitPath(rest)))
+}
+
+// ContentKey returns the key the content and the metadata of the object at
+// path are encrypted with
+func (access *Access) ContentKey(path storj.Path) (*storj.Key, error) {
+	if bucketMetaKey, ok := access.bucketMetaKey(path); ok {
+		return bucketMetaKey, nil
+	}
+
+	key, err := access.PathKey(path)
+	if err != nil {
+		return nil, err
+	}
+	return DeriveKey(key, "content")
+}
+
+// lookup returns the key of the longest prefix of path which has its own
+// key, or the key of the bucket of path derived from the root key
+func (access *Access) lookup(path storj.Path) (PrefixKey, error) {
+	comps := storj.SplitPath(path)
+	for i := len(comps); i > 0; i-- {
+		if prefixKey, ok := access.prefixes[storj.JoinPaths(comps[:i]...)]; ok {
+			return prefixKey, nil
+		}
+	}
+	return access.bucketKey(comps[0])
+}
+
+// lookupEncrypted returns the key of the longest encrypted prefix of
+// encrypted which has its own key, or the key of the bucket of encrypted
+// derived from the root key
+func (access *Access) lookupEncrypted(encrypted storj.Path) (PrefixKey, error) {
+	var found *PrefixKey
+	for _, prefixKey := range access.prefixes {
+		prefixKey := prefixKey
+		if trimPathPrefix(encrypted, prefixKey.EncryptedPrefix) == encrypted {
+			continue
+		}
+		if found == nil || len(prefixKey.EncryptedPrefix) > len(found.EncryptedPrefix) {
+			found = &prefixKey
+		}
+	}
+	if found != nil {
+		return *found, nil
+	}
+	return access.bucketKey(storj.SplitPath(encrypted)[0])
+}
+
+// bucketMetaKey returns the key of the metadata of the bucket at path, when
+// it's only known from the keys of prefixes in the bucket
+func (access *Access) bucketMetaKey(path storj.Path) (*storj.Key, bool) {
+	if _, ok := access.prefixes[path]; ok || access.root != nil {
+		return nil, false
+	}
+	for _, prefixKey := range access.prefixes {
+		if storj.SplitPath(prefixKey.Prefix)[0] == path && prefixKey.BucketMetaKey != (storj.Key{}) {
+			return &prefixKey.BucketMetaKey, true
+		}
+	}
+	return nil, false
+}
+
+// bucketKey returns the key of bucket derived from the root key
+func (access *Access) bucketKey(bucket string) (PrefixKey, error) {
+	if access.root == nil {
+		return PrefixKey{}, Error.New("no key for bucket %q", bucket)
+	}
+	key, err := DeriveKey(access.root, "path:"+bucket)
+	if err != nil {
+		return PrefixKey{}, err
+	}
+	return PrefixKey{
+		Prefix:          bucket,
+		EncryptedPrefix: bucket,
+		Key:             *key,
+	}, nil
+}
+
+// trimPathPrefix returns the rest of path after prefix, or path itself if
+// prefix isn't a prefix of it
+func trimPathPrefix(path, prefix storj.Path) storj.Path {
+	if path == prefix {
+		return ""
+	}
+	if strings.HasPrefix(path, prefix+"/") {
+		return path[len(prefix)+1:]
+	}
+	return path
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/storj"
+)
+
+func TestAccessRootKey(t *testing.T) {
+	root := new(storj.Key)
+	copy(root[:], randData(storj.KeySize))
+	access := NewAccess(root)
+
+	for _, cipher := range []storj.Cipher{storj.AESGCM, storj.SecretBox} {
+		path := "bucket/fold1/file.txt"
+
+		// the keys are derived from the root key like without an access
+		encrypted, err := access.EncryptPath(path, cipher)
+		require.NoError(t, err)
+		bucketKey, err := DerivePathKey(path, root, 1)
+		require.NoError(t, err)
+		encryptedRest, err := EncryptPath("fold1/file.txt", cipher, bucketKey)
+		require.NoError(t, err)
+		assert.Equal(t, "bucket/"+encryptedRest, encrypted)
+
+		decrypted, err := access.DecryptPath(encrypted, cipher)
+		require.NoError(t, err)
+		assert.Equal(t, path, decrypted)
+
+		contentKey, err := access.ContentKey(path)
+		require.NoError(t, err)
+		expected, err := DeriveContentKey(path, root)
+		require.NoError(t, err)
+		assert.Equal(t, expected, contentKey)
+	}
+}
+
+func TestAccessPrefixKeys(t *testing.T) {
+	root := new(storj.Key)
+	copy(root[:], randData(storj.KeySize))
+	owner := NewAccess(root)
+
+	// a bucket encrypted with its own passphrase
+	require.NoError(t, owner.AddBucket("team", KeyFromPassphrase("team passphrase")))
+	teamKey, err := owner.ContentKey("team/file.txt")
+	require.NoError(t, err)
+	rootKey, err := DeriveContentKey("team/file.txt", root)
+	require.NoError(t, err)
+	assert.NotEqual(t, rootKey, teamKey)
+
+	member := NewAccess(KeyFromPassphrase("another root"))
+	require.NoError(t, member.AddBucket("team", KeyFromPassphrase("team passphrase")))
+	memberKey, err := member.ContentKey("team/file.txt")
+	require.NoError(t, err)
+	assert.Equal(t, teamKey, memberKey)
+
+	// a prefix shared without the root key
+	shared, err := owner.Derive("bucket/fold1", storj.AESGCM)
+	require.NoError(t, err)
+	access := NewAccess(nil)
+	require.NoError(t, access.Add(shared))
+
+	for _, path := range []storj.Path{"bucket/fold1", "bucket/fold1/file.txt", "bucket/fold1/fold2/file.txt"} {
+		encrypted, err := owner.EncryptPath(path, storj.AESGCM)
+		require.NoError(t, err)
+		sharedEncrypted, err := access.EncryptPath(path, storj.AESGCM)
+		require.NoError(t, err)
+		assert.Equal(t, encrypted, sharedEncrypted, path)
+
+		decrypted, err := access.DecryptPath(encrypted, storj.AESGCM)
+		require.NoError(t, err)
+		assert.Equal(t, path, decrypted)
+
+		contentKey, err := owner.ContentKey(path)
+		require.NoError(t, err)
+		sharedContentKey, err := access.ContentKey(path)
+		require.NoError(t, err)
+		assert.Equal(t, contentKey, sharedContentKey, path)
+	}
+
+	_, err = access.EncryptPath("bucket/fold2/file.txt", storj.AESGCM)
+	assert.Error(t, err)
+
+	// the metadata of the bucket can be read without the key of the bucket
+	bucketMetaKey, err := owner.ContentKey("bucket")
+	require.NoError(t, err)
+	sharedBucketMetaKey, err := access.ContentKey("bucket")
+	require.NoError(t, err)
+	assert.Equal(t, bucketMetaKey, sharedBucketMetaKey)
+	_, err = access.PathKey("bucket")
+	assert.Error(t, err)
+
+	// accesses are combined into one
+	require.NoError(t, member.Merge(access))
+	assert.Len(t, member.Prefixes(), 2)
+	assert.Equal(t, root, owner.Root())
+
+	conflicting := NewAccess(nil)
+	require.NoError(t, conflicting.AddBucket("team", KeyFromPassphrase("other passphrase")))
+	assert.Error(t, member.Merge(conflicting))
+}
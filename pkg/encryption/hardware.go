@@ -0,0 +1,44 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package encryption
+
+import (
+	"runtime"
+
+	"golang.org/x/sys/cpu"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// aesAccelerated is whether the CPU has instructions for AES and the
+// multiplication used by GCM, it's detected once at startup
+var aesAccelerated = hasAESAcceleration()
+
+// hasAESAcceleration detects whether AES-GCM is accelerated by the CPU
+func hasAESAcceleration() bool {
+	switch runtime.GOARCH {
+	case "amd64", "386":
+		return cpu.X86.HasAES && cpu.X86.HasPCLMULQDQ
+	case "arm64":
+		return cpu.ARM64.HasAES && cpu.ARM64.HasPMULL
+	default:
+		return false
+	}
+}
+
+// HasAESAcceleration returns whether the CPU accelerates AES-GCM. Without the
+// acceleration, such as on Raspberry Pi-class hardware, AES-GCM is many times
+// slower than SecretBox.
+func HasAESAcceleration() bool {
+	return aesAccelerated
+}
+
+// RecommendedCipher returns the cipher which should be used instead of cipher
+// on this hardware: SecretBox replaces AES-GCM when it isn't accelerated
+func RecommendedCipher(cipher storj.Cipher) storj.Cipher {
+	if cipher == storj.AESGCM && !HasAESAcceleration() {
+		return storj.SecretBox
+	}
+	return cipher
+}
@@ -0,0 +1,25 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/pkg/storj"
+)
+
+func TestRecommendedCipher(t *testing.T) {
+	defer func(accelerated bool) { aesAccelerated = accelerated }(aesAccelerated)
+
+	aesAccelerated = true
+	assert.Equal(t, storj.AESGCM, RecommendedCipher(storj.AESGCM))
+	assert.Equal(t, storj.SecretBox, RecommendedCipher(storj.SecretBox))
+
+	aesAccelerated = false
+	assert.Equal(t, storj.SecretBox, RecommendedCipher(storj.AESGCM))
+	assert.Equal(t, storj.SecretBox, RecommendedCipher(storj.SecretBox))
+	assert.Equal(t, storj.Unencrypted, RecommendedCipher(storj.Unencrypted))
+}
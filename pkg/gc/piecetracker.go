@@ -0,0 +1,67 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/storj"
+)
+
+// pieceTracker adds the pieces of every segment to the bloom filters of the
+// nodes storing them during a metainfo loop iteration
+type pieceTracker struct {
+	satelliteID       storj.NodeID
+	initialPieces     int
+	falsePositiveRate float64
+
+	filters map[storj.NodeID]*bloomfilter.Filter
+}
+
+// newPieceTracker returns a piece tracker for the pieces stored for satelliteID
+func newPieceTracker(satelliteID storj.NodeID, initialPieces int, falsePositiveRate float64) *pieceTracker {
+	return &pieceTracker{
+		satelliteID:       satelliteID,
+		initialPieces:     initialPieces,
+		falsePositiveRate: falsePositiveRate,
+		filters:           make(map[storj.NodeID]*bloomfilter.Filter),
+	}
+}
+
+// RemoteSegment adds the pieces of the segment to the filters of their nodes
+func (tracker *pieceTracker) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	remote := pointer.GetRemote()
+	if remote == nil {
+		return nil
+	}
+
+	pieceID := psclient.PieceID(remote.GetPieceId())
+	for _, piece := range remote.GetRemotePieces() {
+		// the node stores the piece under the derived id in the namespace of the satellite
+		derivedPieceID, err := pieceID.Derive(piece.NodeId.Bytes())
+		if err != nil {
+			return err
+		}
+		id, err := derivedPieceID.Namespaced(tracker.satelliteID.Bytes())
+		if err != nil {
+			return err
+		}
+
+		filter, ok := tracker.filters[piece.NodeId]
+		if !ok {
+			filter = bloomfilter.NewOptimal(tracker.initialPieces, tracker.falsePositiveRate)
+			tracker.filters[piece.NodeId] = filter
+		}
+		filter.Add([]byte(id))
+	}
+	return nil
+}
+
+// InlineSegment ignores inline segments, they're stored on the satellite
+func (tracker *pieceTracker) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return nil
+}
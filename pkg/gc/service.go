@@ -0,0 +1,178 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package gc sends bloom filters of the pieces the storage nodes should keep,
+// so the nodes can garbage collect the pieces whose deletes never reached them
+package gc
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default gc errs class
+	Error = errs.Class("garbage collection error")
+)
+
+// Config contains the configurable values of garbage collection
+type Config struct {
+	Enabled           bool          `help:"set if garbage collection bloom filters are sent to the storage nodes" default:"false"`
+	Interval          time.Duration `help:"how frequently garbage collection bloom filters are sent to the storage nodes" default:"120h0m0s"`
+	GracePeriod       time.Duration `help:"how long before the metainfo loop starts pieces must have been stored to be garbage collected" default:"1h0m0s"`
+	InitialPieces     int           `help:"the number of pieces a storage node is expected to hold, used to size its bloom filter" default:"400000"`
+	FalsePositiveRate float64       `help:"the fraction of the garbage pieces the bloom filters fail to identify" default:"0.1"`
+	Concurrency       int           `help:"how many storage nodes bloom filters are sent to at once" default:"4"`
+	RetainTimeout     time.Duration `help:"timeout of sending a bloom filter to a storage node and waiting for it to trash its garbage" default:"10m0s"`
+}
+
+// Service periodically builds a bloom filter of the pieces each storage node
+// should hold from the metainfo loop and sends it to the node, the node moves
+// the pieces which are not in the filter to its trash
+type Service struct {
+	log         *zap.Logger
+	config      Config
+	loop        *pointerdb.Loop
+	overlay     *overlay.Cache
+	transport   transport.Client
+	satelliteID storj.NodeID
+
+	Loop sync2.Cycle
+}
+
+// NewService creates a new garbage collection service
+func NewService(log *zap.Logger, config Config, loop *pointerdb.Loop, overlay *overlay.Cache, transport transport.Client, satelliteID storj.NodeID) *Service {
+	service := &Service{
+		log:         log,
+		config:      config,
+		loop:        loop,
+		overlay:     overlay,
+		transport:   transport,
+		satelliteID: satelliteID,
+	}
+	service.Loop.SetInterval(config.Interval)
+	return service
+}
+
+// Run sends the bloom filters to the storage nodes every interval
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.SendFilters(ctx, time.Now().Add(-service.config.GracePeriod))
+		if err != nil {
+			service.log.Error("garbage collection failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the garbage collection loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// SendFilters builds the bloom filters of the storage nodes from the metainfo
+// loop and sends them to the nodes. The nodes keep the pieces stored after
+// createdBefore, they may belong to segments which weren't committed when the
+// loop went past them.
+func (service *Service) SendFilters(ctx context.Context, createdBefore time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tracker := newPieceTracker(service.satelliteID, service.config.InitialPieces, service.config.FalsePositiveRate)
+	if err := service.loop.Join(ctx, tracker); err != nil {
+		return Error.Wrap(err)
+	}
+
+	var trashed, failed int64
+
+	limiter := sync2.NewLimiter(service.config.Concurrency)
+	for nodeID, filter := range tracker.filters {
+		nodeID, filter := nodeID, filter
+		limiter.Go(ctx, func() {
+			count, err := service.sendFilter(ctx, nodeID, filter, createdBefore)
+			if err != nil {
+				atomic.AddInt64(&failed, 1)
+				service.log.Warn("sending bloom filter failed", zap.Stringer("node", nodeID), zap.Error(err))
+				return
+			}
+			atomic.AddInt64(&trashed, count)
+		})
+	}
+	limiter.Wait()
+
+	mon.IntVal("gc_nodes").Observe(int64(len(tracker.filters)))
+	mon.IntVal("gc_nodes_failed").Observe(failed)
+	mon.IntVal("gc_pieces_trashed").Observe(trashed)
+	service.log.Info("garbage collection finished",
+		zap.Int("nodes", len(tracker.filters)),
+		zap.Int64("failed", failed),
+		zap.Int64("trashed", trashed),
+	)
+	return nil
+}
+
+// sendFilter sends the bloom filter to a single node and returns the number
+// of pieces it trashed
+func (service *Service) sendFilter(ctx context.Context, nodeID storj.NodeID, filter *bloomfilter.Filter, createdBefore time.Time) (trashed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithTimeout(ctx, service.config.RetainTimeout)
+	defer cancel()
+
+	node, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return 0, err
+	}
+	node.Type.DPanicOnInvalid("gc send filter")
+
+	ps, err := psclient.NewPSClient(ctx, service.transport, node, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, ps.Close()) }()
+
+	return ps.Retain(ctx, filter, createdBefore)
+}
+
+// RestoreTrash asks a node to move the pieces of the satellite which were
+// trashed at or after trashedAfter back from its trash, it undoes a garbage
+// collection with a faulty bloom filter as long as the node still keeps them
+func (service *Service) RestoreTrash(ctx context.Context, nodeID storj.NodeID, trashedAfter time.Time) (restored int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	node.Type.DPanicOnInvalid("gc restore trash")
+
+	ps, err := psclient.NewPSClient(ctx, service.transport, node, 0)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ps.Close()) }()
+
+	restored, err = ps.RestoreTrash(ctx, trashedAfter)
+	return restored, Error.Wrap(err)
+}
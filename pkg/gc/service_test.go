@@ -0,0 +1,90 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc_test
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/storage/meta"
+)
+
+func TestGarbageCollection(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		pointers := satellite.Metainfo.Service
+
+		listPaths := func() map[string]bool {
+			items, _, err := pointers.List("", "", "", true, 0, meta.None)
+			require.NoError(t, err)
+			paths := make(map[string]bool)
+			for _, item := range items {
+				paths[item.Path] = true
+			}
+			return paths
+		}
+
+		countPieces := func() (total int) {
+			for _, node := range planet.StorageNodes {
+				ids, err := node.DB.PSDB().GetPiecesBySatellite(satellite.ID(), time.Now().Add(time.Hour))
+				require.NoError(t, err)
+				total += len(ids)
+			}
+			return total
+		}
+
+		data := make([]byte, 10*memory.KiB)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+
+		require.NoError(t, uplink.Upload(ctx, satellite, "testbucket", "keep", data))
+		kept := countPieces()
+		keptPaths := listPaths()
+		require.NotZero(t, kept)
+
+		require.NoError(t, uplink.Upload(ctx, satellite, "testbucket", "garbage", data))
+		stored := countPieces()
+		require.True(t, stored > kept)
+
+		// delete the segment without deleting its pieces, like a delete which never reached the nodes
+		for path := range listPaths() {
+			if !keptPaths[path] {
+				require.NoError(t, pointers.Delete(path))
+			}
+		}
+
+		// pieces stored after the filters were created are kept
+		err = satellite.GarbageCollection.Service.SendFilters(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.True(t, countPieces() > kept)
+
+		// the filters are sized for far more pieces, so there are practically no false positives
+		err = satellite.GarbageCollection.Service.SendFilters(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, kept, countPieces())
+
+		downloaded, err := uplink.Download(ctx, satellite, "testbucket", "keep")
+		require.NoError(t, err)
+		require.Equal(t, data, downloaded)
+
+		// the trashed pieces can be restored until the trash is emptied
+		var restored int64
+		for _, node := range planet.StorageNodes {
+			count, err := satellite.GarbageCollection.Service.RestoreTrash(ctx, node.ID(), time.Time{})
+			require.NoError(t, err)
+			restored += count
+		}
+		require.Equal(t, int64(stored-kept), restored)
+		require.Equal(t, stored, countPieces())
+	})
+}
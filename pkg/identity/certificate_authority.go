@@ -34,6 +34,9 @@ type PeerCertificateAuthority struct {
 	Cert *x509.Certificate
 	// The ID is calculated from the CA public key.
 	ID storj.NodeID
+	// AltChains are chains of the CA key cross-signed by other authorities,
+	// each starts with its certificate of the CA key
+	AltChains [][]*x509.Certificate
 }
 
 // FullCertificateAuthority represents the CA which is used to author and validate full identities
@@ -45,6 +48,9 @@ type FullCertificateAuthority struct {
 	ID storj.NodeID
 	// Key is the private key of the CA
 	Key crypto.PrivateKey
+	// AltChains are chains of the CA key cross-signed by other authorities,
+	// each starts with its certificate of the CA key
+	AltChains [][]*x509.Certificate
 }
 
 // CASetupConfig is for creating a CA
@@ -249,6 +255,7 @@ func (fc FullCAConfig) Load() (*FullCertificateAuthority, error) {
 		Cert:      p.Cert,
 		Key:       k,
 		ID:        p.ID,
+		AltChains: p.AltChains,
 	}, nil
 }
 
@@ -268,6 +275,9 @@ func (fc FullCAConfig) Save(ca *FullCertificateAuthority) error {
 
 	chain := []*x509.Certificate{ca.Cert}
 	chain = append(chain, ca.RestChain...)
+	for _, altChain := range ca.AltChains {
+		chain = append(chain, altChain...)
+	}
 
 	if fc.CertPath != "" {
 		if err := peertls.WriteChain(&certData, chain...); err != nil {
@@ -308,11 +318,19 @@ func (pc PeerCAConfig) Load() (*PeerCertificateAuthority, error) {
 		return nil, peertls.ErrNotExist.Wrap(err)
 	}
 
-	chain, err := DecodeAndParseChainPEM(chainPEM)
+	certs, err := DecodeAndParseChainPEM(chainPEM)
 	if err != nil {
 		return nil, errs.New("failed to load identity %#v: %v",
 			pc.CertPath, err)
 	}
+	chains := peertls.SplitChains(certs)
+	if len(chains) == 0 {
+		return nil, ErrChainLength.New("CA chain is empty")
+	}
+	chain, altChains := chains[0], chains[1:]
+	if err := verifyAltChains(chain[peertls.CAIndex-1], altChains); err != nil {
+		return nil, err
+	}
 
 	nodeID, err := NodeIDFromKey(chain[peertls.LeafIndex].PublicKey)
 	if err != nil {
@@ -325,6 +343,7 @@ func (pc PeerCAConfig) Load() (*PeerCertificateAuthority, error) {
 		RestChain: chain[peertls.CAIndex:],
 		Cert:      chain[peertls.CAIndex-1],
 		ID:        nodeID,
+		AltChains: altChains,
 	}, nil
 }
 
@@ -358,6 +377,7 @@ func (ca *FullCertificateAuthority) NewIdentity() (*FullIdentity, error) {
 		Leaf:      leafCert,
 		Key:       leafKey,
 		ID:        ca.ID,
+		AltChains: ca.AltChains,
 	}, nil
 
 }
@@ -371,6 +391,16 @@ func (ca *FullCertificateAuthority) RestChainRaw() [][]byte {
 	return chain
 }
 
+// AddAltChain adds a chain of the CA key cross-signed by another authority,
+// chain starts with the cross-signed certificate of the CA key
+func (ca *FullCertificateAuthority) AddAltChain(chain []*x509.Certificate) error {
+	if err := verifyAltChains(ca.Cert, [][]*x509.Certificate{chain}); err != nil {
+		return err
+	}
+	ca.AltChains = append(ca.AltChains, chain)
+	return nil
+}
+
 // Sign signs the passed certificate with ca certificate
 func (ca *FullCertificateAuthority) Sign(cert *x509.Certificate) (*x509.Certificate, error) {
 	signedCertBytes, err := x509.CreateCertificate(rand.Reader, cert, ca.Cert, cert.PublicKey, ca.Key)
@@ -38,6 +38,9 @@ type PeerIdentity struct {
 	Leaf *x509.Certificate
 	// The ID taken from the CA public key
 	ID storj.NodeID
+	// AltChains are chains of the CA key cross-signed by other authorities,
+	// each starts with its certificate of the CA key
+	AltChains [][]*x509.Certificate
 }
 
 // FullIdentity represents you on the network. In addition to a PeerIdentity,
@@ -53,6 +56,9 @@ type FullIdentity struct {
 	ID storj.NodeID
 	// Key is the key this identity uses with the leaf for communication.
 	Key crypto.PrivateKey
+	// AltChains are chains of the CA key cross-signed by other authorities,
+	// each starts with its certificate of the CA key
+	AltChains [][]*x509.Certificate
 }
 
 // SetupConfig allows you to run a set of Responsibilities with the given
@@ -74,13 +80,18 @@ type Config struct {
 // FullIdentityFromPEM loads a FullIdentity from a certificate chain and
 // private key PEM-encoded bytes
 func FullIdentityFromPEM(chainPEM, keyPEM []byte) (*FullIdentity, error) {
-	chain, err := DecodeAndParseChainPEM(chainPEM)
+	certs, err := DecodeAndParseChainPEM(chainPEM)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	if len(chain) < peertls.CAIndex+1 {
+	if len(certs) < peertls.CAIndex+1 {
 		return nil, ErrChainLength.New("identity chain does not contain a CA certificate")
 	}
+	chains := peertls.SplitChains(certs[peertls.CAIndex:])
+	caChain, altChains := chains[0], chains[1:]
+	if err := verifyAltChains(caChain[0], altChains); err != nil {
+		return nil, err
+	}
 	keysBytes, err := decodePEM(keyPEM)
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -91,17 +102,18 @@ func FullIdentityFromPEM(chainPEM, keyPEM []byte) (*FullIdentity, error) {
 	if err != nil {
 		return nil, errs.New("unable to parse EC private key: %v", err)
 	}
-	nodeID, err := NodeIDFromKey(chain[peertls.CAIndex].PublicKey)
+	nodeID, err := NodeIDFromKey(caChain[0].PublicKey)
 	if err != nil {
 		return nil, err
 	}
 
 	return &FullIdentity{
-		RestChain: chain[peertls.CAIndex+1:],
-		CA:        chain[peertls.CAIndex],
-		Leaf:      chain[peertls.LeafIndex],
+		RestChain: caChain[1:],
+		CA:        caChain[0],
+		Leaf:      certs[peertls.LeafIndex],
 		Key:       key,
 		ID:        nodeID,
+		AltChains: altChains,
 	}, nil
 }
 
@@ -133,21 +145,31 @@ func PeerIdentityFromCerts(leaf, ca *x509.Certificate, rest []*x509.Certificate)
 	}, nil
 }
 
-// PeerIdentityFromPeer loads a PeerIdentity from a peer connection
-func PeerIdentityFromPeer(peer *peer.Peer) (*PeerIdentity, error) {
-	tlsInfo := peer.AuthInfo.(credentials.TLSInfo)
-	c := tlsInfo.State.PeerCertificates
-	if len(c) < 2 {
+// PeerIdentityFromChain loads a PeerIdentity from the certificates a peer
+// presented, which may contain cross-signed chains after the primary chain
+func PeerIdentityFromChain(certs []*x509.Certificate) (*PeerIdentity, error) {
+	if len(certs) < peertls.CAIndex+1 {
 		return nil, Error.New("invalid certificate chain")
 	}
-	pi, err := PeerIdentityFromCerts(c[peertls.LeafIndex], c[peertls.CAIndex], c[2:])
+	chains := peertls.SplitChains(certs[peertls.CAIndex:])
+	pi, err := PeerIdentityFromCerts(certs[peertls.LeafIndex], chains[0][0], chains[0][1:])
 	if err != nil {
 		return nil, err
 	}
+	pi.AltChains = chains[1:]
 
 	return pi, nil
 }
 
+// PeerIdentityFromPeer loads a PeerIdentity from a peer connection
+func PeerIdentityFromPeer(peer *peer.Peer) (*PeerIdentity, error) {
+	tlsInfo, ok := peer.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, Error.New("peer didn't authenticate with tls")
+	}
+	return PeerIdentityFromChain(tlsInfo.State.PeerCertificates)
+}
+
 // PeerIdentityFromContext loads a PeerIdentity from a ctx TLS credentials
 func PeerIdentityFromContext(ctx context.Context) (*PeerIdentity, error) {
 	p, ok := peer.FromContext(ctx)
@@ -269,6 +291,9 @@ func (ic Config) Save(fi *FullIdentity) error {
 
 	chain := []*x509.Certificate{fi.Leaf, fi.CA}
 	chain = append(chain, fi.RestChain...)
+	for _, altChain := range fi.AltChains {
+		chain = append(chain, altChain...)
+	}
 
 	if ic.CertPath != "" {
 		writeChainErr = peertls.WriteChain(&certData, chain...)
@@ -298,15 +323,31 @@ func (ic Config) SaveBackup(fi *FullIdentity) error {
 	}.Save(fi)
 }
 
-// ChainRaw returns all of the certificate chain as a 2d byte slice
+// ChainRaw returns all of the certificate chain as a 2d byte slice,
+// including the cross-signed chains
 func (fi *FullIdentity) ChainRaw() [][]byte {
 	chain := [][]byte{fi.Leaf.Raw, fi.CA.Raw}
 	for _, cert := range fi.RestChain {
 		chain = append(chain, cert.Raw)
 	}
+	for _, altChain := range fi.AltChains {
+		for _, cert := range altChain {
+			chain = append(chain, cert.Raw)
+		}
+	}
 	return chain
 }
 
+// AddAltChain adds a chain of the CA key cross-signed by another authority,
+// chain starts with the cross-signed certificate of the CA key
+func (fi *FullIdentity) AddAltChain(chain []*x509.Certificate) error {
+	if err := verifyAltChains(fi.CA, [][]*x509.Certificate{chain}); err != nil {
+		return err
+	}
+	fi.AltChains = append(fi.AltChains, chain)
+	return nil
+}
+
 // RestChainRaw returns the rest (excluding leaf and CA) of the certificate chain as a 2d byte slice
 func (fi *FullIdentity) RestChainRaw() [][]byte {
 	var chain [][]byte
@@ -316,9 +357,9 @@ func (fi *FullIdentity) RestChainRaw() [][]byte {
 	return chain
 }
 
-// ServerOption returns a grpc `ServerOption` for incoming connections
-// to the node with this full identity
-func (fi *FullIdentity) ServerOption(pcvFuncs ...peertls.PeerCertVerificationFunc) (grpc.ServerOption, error) {
+// ServerTLSConfig returns a tls config for incoming connections to the
+// node with this full identity, which requires the peer's identity
+func (fi *FullIdentity) ServerTLSConfig(pcvFuncs ...peertls.PeerCertVerificationFunc) (*tls.Config, error) {
 	c, err := peertls.TLSCert(fi.ChainRaw(), fi.Leaf, fi.Key)
 	if err != nil {
 		return nil, err
@@ -328,39 +369,68 @@ func (fi *FullIdentity) ServerOption(pcvFuncs ...peertls.PeerCertVerificationFun
 		[]peertls.PeerCertVerificationFunc{peertls.VerifyPeerCertChains},
 		pcvFuncs...,
 	)
-	tlsConfig := &tls.Config{
+	return &tls.Config{
 		Certificates:       []tls.Certificate{*c},
 		InsecureSkipVerify: true,
 		ClientAuth:         tls.RequireAnyClientCert,
 		VerifyPeerCertificate: peertls.VerifyPeerFunc(
 			pcvFuncs...,
 		),
-	}
-
-	return grpc.Creds(credentials.NewTLS(tlsConfig)), nil
+	}, nil
 }
 
-// DialOption returns a grpc `DialOption` for making outgoing connections
-// to the node with this peer identity
-// id is an optional id of the node we are dialing
-func (fi *FullIdentity) DialOption(id storj.NodeID) (grpc.DialOption, error) {
+// ClientTLSConfig returns a tls config for outgoing connections from the
+// node with this full identity, id is an optional id of the node we are dialing
+func (fi *FullIdentity) ClientTLSConfig(id storj.NodeID) (*tls.Config, error) {
 	c, err := peertls.TLSCert(fi.ChainRaw(), fi.Leaf, fi.Key)
 	if err != nil {
 		return nil, err
 	}
 
-	tlsConfig := &tls.Config{
+	return &tls.Config{
 		Certificates:       []tls.Certificate{*c},
 		InsecureSkipVerify: true,
 		VerifyPeerCertificate: peertls.VerifyPeerFunc(
 			peertls.VerifyPeerCertChains,
 			verifyIdentity(id),
 		),
+	}, nil
+}
+
+// ServerOption returns a grpc `ServerOption` for incoming connections
+// to the node with this full identity
+func (fi *FullIdentity) ServerOption(pcvFuncs ...peertls.PeerCertVerificationFunc) (grpc.ServerOption, error) {
+	tlsConfig, err := fi.ServerTLSConfig(pcvFuncs...)
+	if err != nil {
+		return nil, err
 	}
+	return grpc.Creds(credentials.NewTLS(tlsConfig)), nil
+}
 
+// DialOption returns a grpc `DialOption` for making outgoing connections
+// to the node with this peer identity
+// id is an optional id of the node we are dialing
+func (fi *FullIdentity) DialOption(id storj.NodeID) (grpc.DialOption, error) {
+	tlsConfig, err := fi.ClientTLSConfig(id)
+	if err != nil {
+		return nil, err
+	}
 	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
 }
 
+// verifyAltChains checks that the cross-signed chains are for the key of ca
+func verifyAltChains(ca *x509.Certificate, altChains [][]*x509.Certificate) error {
+	for _, chain := range altChains {
+		if len(chain) == 0 {
+			return Error.New("empty cross-signed chain")
+		}
+		if !bytes.Equal(chain[0].RawSubjectPublicKeyInfo, ca.RawSubjectPublicKeyInfo) {
+			return Error.New("cross-signed chain is for a different CA key")
+		}
+	}
+	return nil
+}
+
 func verifyIdentity(id storj.NodeID) peertls.PeerCertVerificationFunc {
 	return func(_ [][]byte, parsedChains [][]*x509.Certificate) (err error) {
 		defer mon.TaskNamed("verifyIdentity")(nil)(&err)
@@ -14,6 +14,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/identity"
@@ -152,6 +153,88 @@ func TestVerifyPeer(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCrossSignedIdentity(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	newCA := func() *identity.FullCertificateAuthority {
+		ca, err := identity.NewCA(ctx, identity.NewCAOptions{
+			Difficulty:  8,
+			Concurrency: 4,
+		})
+		require.NoError(t, err)
+		return ca
+	}
+	oldSigner, newSigner, unrelated, ca := newCA(), newCA(), newCA(), newCA()
+
+	signed, err := oldSigner.Sign(ca.Cert)
+	require.NoError(t, err)
+	ca.Cert, ca.RestChain = signed, []*x509.Certificate{oldSigner.Cert}
+
+	crossSigned, err := newSigner.Sign(ca.Cert)
+	require.NoError(t, err)
+	require.NoError(t, ca.AddAltChain([]*x509.Certificate{crossSigned, newSigner.Cert}))
+
+	// chains of another CA key can't be added
+	assert.Error(t, ca.AddAltChain([]*x509.Certificate{unrelated.Cert}))
+
+	fi, err := ca.NewIdentity()
+	require.NoError(t, err)
+	require.Len(t, fi.AltChains, 1)
+
+	{ // both chains are stored
+		ic := identity.Config{
+			CertPath: ctx.File("identity.cert"),
+			KeyPath:  ctx.File("identity.key"),
+		}
+		require.NoError(t, ic.Save(fi))
+
+		loaded, err := ic.Load()
+		require.NoError(t, err)
+		assert.Equal(t, fi.ID, loaded.ID)
+		assert.Equal(t, fi.CA.Raw, loaded.CA.Raw)
+		require.Len(t, loaded.RestChain, 1)
+		assert.Equal(t, oldSigner.Cert.Raw, loaded.RestChain[0].Raw)
+		require.Len(t, loaded.AltChains, 1)
+		assert.Equal(t, crossSigned.Raw, loaded.AltChains[0][0].Raw)
+		assert.Equal(t, newSigner.Cert.Raw, loaded.AltChains[0][1].Raw)
+	}
+
+	{ // peers accept any chain reaching a whitelisted signer
+		for _, whitelisted := range []*identity.FullCertificateAuthority{oldSigner, newSigner} {
+			verify := peertls.VerifyPeerFunc(
+				peertls.VerifyPeerCertChains,
+				peertls.VerifyCAWhitelist([]*x509.Certificate{whitelisted.Cert}),
+			)
+			assert.NoError(t, verify(fi.ChainRaw(), nil))
+		}
+
+		verify := peertls.VerifyPeerFunc(
+			peertls.VerifyPeerCertChains,
+			peertls.VerifyCAWhitelist([]*x509.Certificate{unrelated.Cert}),
+		)
+		assert.Error(t, verify(fi.ChainRaw(), nil))
+	}
+
+	{ // the presented chains are parsed into the peer identity
+		certs, err := identity.ParseCertChain(fi.ChainRaw())
+		require.NoError(t, err)
+
+		peer, err := identity.PeerIdentityFromChain(certs)
+		require.NoError(t, err)
+		assert.Equal(t, fi.ID, peer.ID)
+		require.Len(t, peer.RestChain, 1)
+		require.Len(t, peer.AltChains, 1)
+		assert.Equal(t, crossSigned.Raw, peer.AltChains[0][0].Raw)
+	}
+
+	{ // chains of another CA key are rejected
+		chain := append(fi.ChainRaw(), unrelated.Cert.Raw)
+		err := peertls.VerifyPeerFunc(peertls.VerifyPeerCertChains)(chain, nil)
+		assert.True(t, peertls.ErrVerifyCertificateChain.Has(err))
+	}
+}
+
 func pregeneratedIdentity(t *testing.T) *identity.FullIdentity {
 	const chain = `-----BEGIN CERTIFICATE-----
 MIIBQDCB56ADAgECAhB+u3d03qyW/ROgwy/ZsPccMAoGCCqGSM49BAMCMAAwIhgP
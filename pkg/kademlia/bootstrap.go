@@ -0,0 +1,175 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pb"
+)
+
+var (
+	// bootstrapRetryInterval is how long bootstrapping waits before trying
+	// the bootstrap nodes again when none of them is reachable
+	bootstrapRetryInterval = 30 * time.Second
+	// bootstrapPingTimeout bounds how long a bootstrap node may take to answer
+	bootstrapPingTimeout = 10 * time.Second
+)
+
+// bootstrapHealth tracks which bootstrap nodes answered the last time they
+// were contacted and rotates among them
+type bootstrapHealth struct {
+	mu     sync.Mutex
+	next   int
+	failed map[string]time.Time // by address, when the node last failed
+}
+
+// order returns the nodes starting at the next one in rotation, the nodes
+// which answered the last time first
+func (health *bootstrapHealth) order(nodes []pb.Node) []pb.Node {
+	health.mu.Lock()
+	defer health.mu.Unlock()
+
+	if len(nodes) == 0 {
+		return nil
+	}
+	start := health.next % len(nodes)
+	health.next++
+
+	var live, failed []pb.Node
+	for i := range nodes {
+		node := nodes[(start+i)%len(nodes)]
+		if _, ok := health.failed[node.GetAddress().GetAddress()]; ok {
+			failed = append(failed, node)
+		} else {
+			live = append(live, node)
+		}
+	}
+	return append(live, failed...)
+}
+
+// live returns the nodes in rotation which answered the last time, all of
+// them when none did
+func (health *bootstrapHealth) live(nodes []pb.Node) []pb.Node {
+	ordered := health.order(nodes)
+
+	health.mu.Lock()
+	defer health.mu.Unlock()
+	for i, node := range ordered {
+		if _, ok := health.failed[node.GetAddress().GetAddress()]; ok {
+			if i == 0 {
+				return ordered
+			}
+			return ordered[:i]
+		}
+	}
+	return ordered
+}
+
+// update records whether the node at address answered
+func (health *bootstrapHealth) update(address string, err error) {
+	health.mu.Lock()
+	defer health.mu.Unlock()
+
+	if err == nil {
+		delete(health.failed, address)
+		return
+	}
+	if health.failed == nil {
+		health.failed = make(map[string]time.Time)
+	}
+	health.failed[address] = time.Now()
+}
+
+// LiveBootstrapNodes returns the bootstrap nodes which answered the last
+// time they were contacted
+func (k *Kademlia) LiveBootstrapNodes() []pb.Node {
+	k.bootstrapHealth.mu.Lock()
+	defer k.bootstrapHealth.mu.Unlock()
+
+	var live []pb.Node
+	for _, node := range k.bootstrapNodes {
+		if _, ok := k.bootstrapHealth.failed[node.GetAddress().GetAddress()]; !ok {
+			live = append(live, node)
+		}
+	}
+	return live
+}
+
+// CheckBootstrapNodes pings all bootstrap nodes and records which of them
+// answer, it returns how many did
+func (k *Kademlia) CheckBootstrapNodes(ctx context.Context) (live int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, node := range k.bootstrapNodes {
+		if ctx.Err() != nil {
+			return live, ctx.Err()
+		}
+		err := k.pingBootstrapNode(ctx, node)
+		if err != nil {
+			k.log.Debug("bootstrap node unreachable", zap.String("address", node.GetAddress().GetAddress()), zap.Error(err))
+			continue
+		}
+		live++
+	}
+	mon.IntVal("bootstrap_nodes_live").Observe(int64(live))
+	return live, nil
+}
+
+// pingBootstrapNode pings a bootstrap node and records whether it answered
+func (k *Kademlia) pingBootstrapNode(ctx context.Context, node pb.Node) error {
+	ctx, cancel := context.WithTimeout(ctx, bootstrapPingTimeout)
+	defer cancel()
+
+	_, err := k.dialer.Ping(ctx, node)
+	k.bootstrapHealth.update(node.GetAddress().GetAddress(), err)
+	return err
+}
+
+// pingBootstrap pings the bootstrap nodes in rotation until one answers
+func (k *Kademlia) pingBootstrap(ctx context.Context) error {
+	var group []error
+	for _, node := range k.bootstrapHealth.order(k.bootstrapNodes) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err := k.pingBootstrapNode(ctx, node)
+		if err == nil {
+			return nil
+		}
+		group = append(group, err)
+	}
+	return Error.New("no bootstrap node reachable: %v", group)
+}
+
+// waitForBootstrapNodes retries the bootstrap nodes until one answers. When
+// the routing table already knows nodes, those are used instead of waiting.
+func (k *Kademlia) waitForBootstrapNodes(ctx context.Context) (reachable bool, err error) {
+	for {
+		err := k.pingBootstrap(ctx)
+		if err == nil {
+			return true, nil
+		}
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+
+		// the routing table always contains the node itself
+		known, listErr := k.routingTable.nodeBucketDB.List(nil, 0)
+		if listErr == nil && len(known) > 1 {
+			k.log.Warn("bootstrap nodes unreachable, continuing with known nodes", zap.Int("known", len(known)-1), zap.Error(err))
+			return false, nil
+		}
+
+		k.log.Warn("bootstrap nodes unreachable, retrying", zap.Duration("interval", bootstrapRetryInterval), zap.Error(err))
+		if !sync2.Sleep(ctx, bootstrapRetryInterval) {
+			return false, ctx.Err()
+		}
+	}
+}
@@ -6,9 +6,11 @@ package kademlia
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/pkg/pb"
 )
@@ -16,13 +18,13 @@ import (
 var (
 	// Error defines a Kademlia error
 	Error = errs.Class("kademlia error")
-	// mon   = monkit.Package() // TODO: figure out whether this is needed
+	mon   = monkit.Package()
 )
 
 // Config defines all of the things that are needed to start up Kademlia
 // server endpoints (and not necessarily client code).
 type Config struct {
-	BootstrapAddr   string `help:"the Kademlia node to bootstrap against" default:""`
+	BootstrapAddr   string `help:"the Kademlia nodes to bootstrap against, separated by commas, the live ones are used in rotation" default:""`
 	DBPath          string `help:"the path for storage node db services to be created on" default:"$CONFDIR/kademlia"`
 	ExternalAddress string `user:"true" help:"the public address of the Kademlia node, useful for nodes behind NAT" default:""`
 	Operator        OperatorConfig
@@ -30,16 +32,22 @@ type Config struct {
 	// TODO: reduce the number of flags here
 	Alpha int `help:"alpha is a system wide concurrency parameter" default:"5"`
 	RoutingTableConfig
+
+	RateLimit RateLimitConfig
 }
 
 // BootstrapNodes returns bootstrap nodes defined in the config
 func (c Config) BootstrapNodes() []pb.Node {
 	var nodes []pb.Node
-	if c.BootstrapAddr != "" {
+	for _, address := range strings.Split(c.BootstrapAddr, ",") {
+		address = strings.TrimSpace(address)
+		if address == "" {
+			continue
+		}
 		nodes = append(nodes, pb.Node{
 			Address: &pb.NodeAddress{
 				Transport: pb.NodeTransport_TCP_TLS_GRPC,
-				Address:   c.BootstrapAddr,
+				Address:   address,
 			},
 			Type: pb.NodeType_BOOTSTRAP,
 		})
@@ -56,6 +64,7 @@ func (c Config) Verify(log *zap.Logger) error {
 type OperatorConfig struct {
 	Email  string `user:"true" help:"operator email address" default:""`
 	Wallet string `user:"true" help:"operator wallet adress" default:""`
+	Region string `user:"true" help:"region the node is located in, satellites can avoid storing several pieces of a segment in the same region" default:""`
 }
 
 // Verify verifies whether operator config is valid.
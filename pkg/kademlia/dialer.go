@@ -73,19 +73,60 @@ func (dialer *Dialer) Lookup(ctx context.Context, self pb.Node, ask pb.Node, fin
 
 // Ping pings target.
 func (dialer *Dialer) Ping(ctx context.Context, target pb.Node) (bool, error) {
+	_, err := dialer.CheckIn(ctx, target, nil)
+	return err == nil, err
+}
+
+// CheckIn pings target with the standing it has with this satellite, if any,
+// and returns the capacity and metadata it reports
+func (dialer *Dialer) CheckIn(ctx context.Context, target pb.Node, standing *pb.NodeStanding) (*pb.PingResponse, error) {
+	if !dialer.limit.Lock() {
+		return nil, context.Canceled
+	}
+	defer dialer.limit.Unlock()
+
+	conn, err := dialer.dial(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := conn.client.Ping(ctx, &pb.PingRequest{Standing: standing})
+
+	return resp, errs.Combine(err, conn.disconnect())
+}
+
+// PingBack pings target and asks it to ping self back
+func (dialer *Dialer) PingBack(ctx context.Context, target pb.Node, self pb.Node) (*pb.PingResponse, error) {
+	if !dialer.limit.Lock() {
+		return nil, context.Canceled
+	}
+	defer dialer.limit.Unlock()
+
+	conn, err := dialer.dial(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := conn.client.Ping(ctx, &pb.PingRequest{Pingback: &self})
+
+	return resp, errs.Combine(err, conn.disconnect())
+}
+
+// Notify delivers a signed notice to target
+func (dialer *Dialer) Notify(ctx context.Context, target pb.Node, notice *pb.Notice) error {
 	if !dialer.limit.Lock() {
-		return false, context.Canceled
+		return context.Canceled
 	}
 	defer dialer.limit.Unlock()
 
 	conn, err := dialer.dial(ctx, target)
 	if err != nil {
-		return false, err
+		return err
 	}
 
-	_, err = conn.client.Ping(ctx, &pb.PingRequest{})
+	_, err = conn.client.Notify(ctx, notice)
 
-	return err == nil, errs.Combine(err, conn.disconnect())
+	return errs.Combine(err, conn.disconnect())
 }
 
 // FetchPeerIdentity connects to a node and returns its peer identity
@@ -4,37 +4,70 @@
 package kademlia
 
 import (
+	"bytes"
 	"context"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 )
 
 // EndpointError defines errors class for Endpoint
 var EndpointError = errs.Class("kademlia endpoint error")
 
+// maxNotices is how many of the most recent notices an endpoint keeps
+const maxNotices = 32
+
+// StandingReceiver stores the standings satellites report when they check in
+type StandingReceiver interface {
+	ReceiveStanding(ctx context.Context, satelliteID storj.NodeID, standing *pb.NodeStanding) error
+}
+
 // Endpoint implements the kademlia Endpoints
 type Endpoint struct {
 	log          *zap.Logger
 	service      *Kademlia
 	routingTable *RoutingTable
+	limiter      *rateLimiter
 	connected    int32
+
+	mu        sync.Mutex
+	notices   []*pb.Notice // most recent last
+	standings StandingReceiver
 }
 
-// NewEndpoint returns a new kademlia endpoint
-func NewEndpoint(log *zap.Logger, service *Kademlia, routingTable *RoutingTable) *Endpoint {
+// NewEndpoint returns a new kademlia endpoint, which drops the requests of
+// peers exceeding the rate limit
+func NewEndpoint(log *zap.Logger, service *Kademlia, routingTable *RoutingTable, rateLimit RateLimitConfig) *Endpoint {
 	return &Endpoint{
 		service:      service,
 		routingTable: routingTable,
+		limiter:      newRateLimiter(rateLimit),
 		log:          log,
 	}
 }
 
+// SetStandingReceiver sets where the standings reported on check-ins are
+// stored, they are ignored until it's set
+func (endpoint *Endpoint) SetStandingReceiver(receiver StandingReceiver) {
+	endpoint.mu.Lock()
+	defer endpoint.mu.Unlock()
+	endpoint.standings = receiver
+}
+
 // Query is a node to node communication query
 func (endpoint *Endpoint) Query(ctx context.Context, req *pb.QueryRequest) (*pb.QueryResponse, error) {
+	if err := endpoint.limiter.Verify(ctx); err != nil {
+		return &pb.QueryResponse{}, err
+	}
+
 	if req.GetPingback() {
 		endpoint.pingback(ctx, req.Sender)
 	}
@@ -71,8 +104,116 @@ func (endpoint *Endpoint) pingback(ctx context.Context, target *pb.Node) {
 	}
 }
 
-// Ping provides an easy way to verify a node is online and accepting requests
+// Ping provides an easy way to verify a node is online and accepting requests,
+// the response reports the node's current capacity and metadata
 func (endpoint *Endpoint) Ping(ctx context.Context, req *pb.PingRequest) (*pb.PingResponse, error) {
-	//TODO
-	return &pb.PingResponse{}, nil
+	if err := endpoint.limiter.Verify(ctx); err != nil {
+		return &pb.PingResponse{}, err
+	}
+
+	if standing := req.GetStanding(); standing != nil {
+		// a standing which can't be stored mustn't look like the node is offline
+		if err := endpoint.receiveStanding(ctx, standing); err != nil {
+			endpoint.log.Debug("could not receive standing", zap.Error(err))
+		}
+	}
+
+	self := endpoint.routingTable.Local()
+	resp := &pb.PingResponse{
+		Restrictions: self.Restrictions,
+		Metadata:     self.Metadata,
+		SentUnixSec:  time.Now().Unix(),
+	}
+
+	if target := req.GetPingback(); target != nil {
+		err := endpoint.pingbackSender(ctx, target)
+		resp.PingbackOk = err == nil
+		if err != nil {
+			resp.PingbackError = err.Error()
+		}
+	}
+	return resp, nil
+}
+
+// pingbackSender pings the peer at the address it asked to be pinged at, so
+// it knows whether it's reachable
+func (endpoint *Endpoint) pingbackSender(ctx context.Context, target *pb.Node) error {
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return EndpointError.Wrap(err)
+	}
+	if peer.ID != target.Id {
+		return EndpointError.New("pingback of %s requested by %s", target.Id, peer.ID)
+	}
+
+	_, err = endpoint.service.Ping(ctx, *target)
+	return err
+}
+
+// receiveStanding hands the standing reported by the peer to the receiver
+func (endpoint *Endpoint) receiveStanding(ctx context.Context, standing *pb.NodeStanding) error {
+	endpoint.mu.Lock()
+	receiver := endpoint.standings
+	endpoint.mu.Unlock()
+	if receiver == nil {
+		return nil
+	}
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return EndpointError.Wrap(err)
+	}
+	return receiver.ReceiveStanding(ctx, peer.ID, standing)
+}
+
+// Notify accepts a notice broadcast by a satellite, the notice must be signed
+// by the peer sending it
+func (endpoint *Endpoint) Notify(ctx context.Context, notice *pb.Notice) (*pb.NoticeResponse, error) {
+	if err := endpoint.limiter.Verify(ctx); err != nil {
+		return &pb.NoticeResponse{}, err
+	}
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return &pb.NoticeResponse{}, EndpointError.Wrap(err)
+	}
+	if peer.ID != notice.SatelliteId {
+		return &pb.NoticeResponse{}, EndpointError.New("notice of %s sent by %s", notice.SatelliteId, peer.ID)
+	}
+	if err := auth.VerifyMsg(notice, notice.SatelliteId); err != nil {
+		return &pb.NoticeResponse{}, EndpointError.Wrap(err)
+	}
+
+	endpoint.mu.Lock()
+	defer endpoint.mu.Unlock()
+
+	for _, received := range endpoint.notices {
+		if bytes.Equal(received.Id, notice.Id) {
+			// the satellite is retrying a delivery
+			return &pb.NoticeResponse{}, nil
+		}
+	}
+	if len(endpoint.notices) >= maxNotices {
+		endpoint.notices = endpoint.notices[1:]
+	}
+	endpoint.notices = append(endpoint.notices, notice)
+
+	endpoint.log.Warn("notice from satellite",
+		zap.String("satellite", notice.SatelliteId.String()),
+		zap.Time("created", time.Unix(notice.CreatedUnixSec, 0)),
+		zap.String("message", notice.Message),
+	)
+	return &pb.NoticeResponse{}, nil
+}
+
+// Notices returns the notices received from satellites, most recent first
+func (endpoint *Endpoint) Notices() []*pb.Notice {
+	endpoint.mu.Lock()
+	defer endpoint.mu.Unlock()
+
+	notices := make([]*pb.Notice, 0, len(endpoint.notices))
+	for i := len(endpoint.notices) - 1; i >= 0; i-- {
+		notices = append(notices, endpoint.notices[i])
+	}
+	return notices
 }
@@ -5,6 +5,7 @@ package kademlia
 
 import (
 	"context"
+	"time"
 
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
@@ -106,3 +107,58 @@ func (srv *Inspector) LookupNode(ctx context.Context, req *pb.LookupNodeRequest)
 		Node: &node,
 	}, nil
 }
+
+// Topology returns the routing table, the neighborhood and the recent lookups
+// of the node, so the network can be visualized
+func (srv *Inspector) Topology(ctx context.Context, req *pb.TopologyRequest) (_ *pb.TopologyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rt := srv.dht.routingTable
+	self := rt.Local()
+
+	buckets, err := rt.KBuckets()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	// the local node is in its own neighborhood
+	neighborhood, err := rt.FindNear(self.Id, rt.K()+1)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	stats := srv.dht.LookupStats()
+	totals := stats.Totals()
+	resp := &pb.TopologyResponse{
+		Self:         &self,
+		BucketSize:   int32(rt.K()),
+		Neighborhood: neighborhood,
+		LookupStats: &pb.LookupStats{
+			Count:           totals.Count,
+			Found:           totals.Found,
+			NotFound:        totals.NotFound,
+			Failed:          totals.Failed,
+			QueriedNodes:    totals.QueriedNodes,
+			TotalDurationMs: int64(totals.TotalDuration / time.Millisecond),
+		},
+	}
+	for _, bucket := range buckets {
+		resp.Buckets = append(resp.Buckets, &pb.KBucket{
+			Id:                   bucket.ID,
+			Depth:                int32(bucket.Depth),
+			LastRefreshedSeconds: bucket.LastRefreshed.Unix(),
+			Nodes:                bucket.Nodes,
+			ReplacementCache:     bucket.ReplacementCache,
+		})
+	}
+	for _, lookup := range stats.Recent(int(req.LookupLimit)) {
+		resp.RecentLookups = append(resp.RecentLookups, &pb.Lookup{
+			Target:         lookup.Target,
+			StartedSeconds: lookup.Started.Unix(),
+			DurationMs:     int64(lookup.Duration / time.Millisecond),
+			QueriedNodes:   int32(lookup.QueriedNodes),
+			Found:          lookup.Found,
+			Error:          lookup.Error,
+		})
+	}
+	return resp, nil
+}
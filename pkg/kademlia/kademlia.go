@@ -50,6 +50,9 @@ type Kademlia struct {
 	identity       *identity.FullIdentity
 	lookups        sync2.WorkGroup
 
+	bootstrapHealth bootstrapHealth
+	lookupStats     LookupStats
+
 	bootstrapFinished sync2.Fence
 }
 
@@ -144,23 +147,7 @@ func (k *Kademlia) Bootstrap(ctx context.Context) error {
 		return nil
 	}
 
-	var errs errs.Group
-	for _, node := range k.bootstrapNodes {
-		if ctx.Err() != nil {
-			errs.Add(ctx.Err())
-			return errs.Err()
-		}
-
-		_, err := k.dialer.Ping(ctx, node)
-		if err == nil {
-			// We have pinged successfully one bootstrap node.
-			// Clear any errors and break the cycle.
-			errs = nil
-			break
-		}
-		errs.Add(err)
-	}
-	err := errs.Err()
+	reachable, err := k.waitForBootstrapNodes(ctx)
 	if err != nil {
 		return err
 	}
@@ -169,7 +156,11 @@ func (k *Kademlia) Bootstrap(ctx context.Context) error {
 	k.routingTable.mutex.Lock()
 	id := k.routingTable.self.Id
 	k.routingTable.mutex.Unlock()
-	_, err = k.lookup(ctx, id, true)
+	_, err = k.lookup(ctx, id, reachable)
+	if !reachable && NodeNotFound.Has(err) {
+		// the known nodes don't need to know about this node
+		err = nil
+	}
 
 	// TODO(dylan): We do not currently handle this last bit of behavior.
 	// ```
@@ -199,23 +190,56 @@ func (k *Kademlia) FetchPeerIdentity(ctx context.Context, nodeID storj.NodeID) (
 	return k.dialer.FetchPeerIdentity(ctx, node)
 }
 
-// Ping checks that the provided node is still accessible on the network
+// Ping checks that the provided node is still accessible on the network,
+// the returned node has the capacity and metadata the node reported
 func (k *Kademlia) Ping(ctx context.Context, node pb.Node) (pb.Node, error) {
+	return k.CheckIn(ctx, node, nil)
+}
+
+// CheckIn pings the provided node like Ping and tells it the standing it has
+// with this satellite, when standing isn't nil
+func (k *Kademlia) CheckIn(ctx context.Context, node pb.Node, standing *pb.NodeStanding) (pb.Node, error) {
 	if !k.lookups.Start() {
 		return pb.Node{}, context.Canceled
 	}
 	defer k.lookups.Done()
 
-	ok, err := k.dialer.Ping(ctx, node)
+	resp, err := k.dialer.CheckIn(ctx, node, standing)
 	if err != nil {
 		return pb.Node{}, NodeErr.Wrap(err)
 	}
-	if !ok {
-		return pb.Node{}, NodeErr.New("Failed pinging node")
+	if resp.Restrictions != nil {
+		node.Restrictions = resp.Restrictions
+	}
+	if resp.Metadata != nil {
+		node.Metadata = resp.Metadata
 	}
 	return node, nil
 }
 
+// PingBack pings the provided node and asks it to ping this node back at its
+// external address, the response has the clock of the node and whether it
+// could reach this node
+func (k *Kademlia) PingBack(ctx context.Context, node pb.Node) (*pb.PingResponse, error) {
+	if !k.lookups.Start() {
+		return nil, context.Canceled
+	}
+	defer k.lookups.Done()
+
+	resp, err := k.dialer.PingBack(ctx, node, k.routingTable.Local())
+	return resp, NodeErr.Wrap(err)
+}
+
+// Notify delivers a notice signed by this node to the provided node
+func (k *Kademlia) Notify(ctx context.Context, node pb.Node, notice *pb.Notice) error {
+	if !k.lookups.Start() {
+		return context.Canceled
+	}
+	defer k.lookups.Done()
+
+	return NodeErr.Wrap(k.dialer.Notify(ctx, node, notice))
+}
+
 // FindNode looks up the provided NodeID first in the local Node, and if it is not found
 // begins searching the network for the NodeID. Returns and error if node was not found
 func (k *Kademlia) FindNode(ctx context.Context, ID storj.NodeID) (pb.Node, error) {
@@ -228,20 +252,31 @@ func (k *Kademlia) FindNode(ctx context.Context, ID storj.NodeID) (pb.Node, erro
 }
 
 //lookup initiates a kadmelia node lookup
-func (k *Kademlia) lookup(ctx context.Context, ID storj.NodeID, isBootstrap bool) (pb.Node, error) {
+func (k *Kademlia) lookup(ctx context.Context, ID storj.NodeID, isBootstrap bool) (_ pb.Node, err error) {
 	if !k.lookups.Start() {
 		return pb.Node{}, context.Canceled
 	}
 	defer k.lookups.Done()
 
+	var target *pb.Node
+	info := LookupInfo{Target: ID, Started: time.Now()}
+	defer func() {
+		info.Duration = time.Since(info.Started)
+		info.Found = target != nil
+		if err != nil && !NodeNotFound.Has(err) {
+			info.Error = err.Error()
+		}
+		k.lookupStats.Record(info)
+	}()
+
 	kb := k.routingTable.K()
 	var nodes []*pb.Node
 	if isBootstrap {
-		for _, v := range k.bootstrapNodes {
+		for _, v := range k.bootstrapHealth.live(k.bootstrapNodes) {
+			v := v
 			nodes = append(nodes, &v)
 		}
 	} else {
-		var err error
 		nodes, err = k.routingTable.FindNear(ID, kb)
 		if err != nil {
 			return pb.Node{}, err
@@ -250,7 +285,8 @@ func (k *Kademlia) lookup(ctx context.Context, ID storj.NodeID, isBootstrap bool
 	lookup := newPeerDiscovery(k.log, k.routingTable.Local(), nodes, k.dialer, ID, discoveryOptions{
 		concurrency: k.alpha, retries: defaultRetries, bootstrap: isBootstrap, bootstrapNodes: k.bootstrapNodes,
 	})
-	target, err := lookup.Run(ctx)
+	target, err = lookup.Run(ctx)
+	info.QueriedNodes = lookup.Queried()
 	if err != nil {
 		return pb.Node{}, err
 	}
@@ -272,6 +308,9 @@ func (k *Kademlia) lookup(ctx context.Context, ID storj.NodeID, isBootstrap bool
 	return *target, nil
 }
 
+// LookupStats returns the statistics of the lookups done by this kademlia instance
+func (k *Kademlia) LookupStats() *LookupStats { return &k.lookupStats }
+
 // Seen returns all nodes that this kademlia instance has successfully communicated with
 func (k *Kademlia) Seen() []*pb.Node {
 	nodes := []*pb.Node{}
@@ -159,6 +159,53 @@ func TestBootstrap(t *testing.T) {
 	assert.Len(t, nodeIDs, 3)
 }
 
+func TestBootstrap_Unreachable(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	bn, s, clean := testNode(t, []pb.Node{})
+	defer clean()
+	defer s.Stop()
+
+	defer func(timeout time.Duration) { bootstrapPingTimeout = timeout }(bootstrapPingTimeout)
+	bootstrapPingTimeout = time.Second
+
+	// a listener which is closed right away refuses connections
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, lis.Close())
+	unreachable := pb.Node{
+		Address: &pb.NodeAddress{Transport: pb.NodeTransport_TCP_TLS_GRPC, Address: lis.Addr().String()},
+		Type:    pb.NodeType_BOOTSTRAP,
+	}
+
+	// the live bootstrap node is used when the other one is down
+	n1, s1, clean1 := testNode(t, []pb.Node{unreachable, bn.routingTable.self})
+	defer clean1()
+	defer s1.Stop()
+
+	require.NoError(t, n1.Bootstrap(ctx))
+	live, err := n1.CheckBootstrapNodes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, live)
+	assert.Equal(t, []pb.Node{bn.routingTable.self}, n1.LiveBootstrapNodes())
+
+	// once all bootstrap nodes are down, the known nodes are used
+	n1.SetBootstrapNodes([]pb.Node{unreachable})
+	require.NoError(t, n1.Bootstrap(ctx))
+	assert.Empty(t, n1.LiveBootstrapNodes())
+}
+
+func TestBootstrapNodes(t *testing.T) {
+	config := Config{BootstrapAddr: "first.example.com:7777, second.example.com:7777,"}
+	nodes := config.BootstrapNodes()
+	require.Len(t, nodes, 2)
+	assert.Equal(t, "first.example.com:7777", nodes[0].Address.Address)
+	assert.Equal(t, "second.example.com:7777", nodes[1].Address.Address)
+
+	assert.Empty(t, Config{}.BootstrapNodes())
+}
+
 func testNode(t *testing.T, bn []pb.Node) (*Kademlia, *grpc.Server, func()) {
 	ctx := testcontext.New(t)
 	// new address
@@ -174,7 +221,7 @@ func testNode(t *testing.T, bn []pb.Node) (*Kademlia, *grpc.Server, func()) {
 	logger := zaptest.NewLogger(t)
 	k, err := newKademlia(logger, pb.NodeType_STORAGE, bn, lis.Addr().String(), nil, fid, dir, defaultAlpha)
 	assert.NoError(t, err)
-	s := NewEndpoint(logger, k, k.GetRoutingTable().(*RoutingTable))
+	s := NewEndpoint(logger, k, k.GetRoutingTable().(*RoutingTable), RateLimitConfig{})
 	// new ident opts
 	identOpt, err := fid.ServerOption()
 	assert.NoError(t, err)
@@ -529,6 +576,10 @@ func (mn *mockNodesServer) Ping(ctx context.Context, req *pb.PingRequest) (*pb.P
 	return &pb.PingResponse{}, nil
 }
 
+func (mn *mockNodesServer) Notify(ctx context.Context, req *pb.Notice) (*pb.NoticeResponse, error) {
+	return &pb.NoticeResponse{}, nil
+}
+
 // newKademlia returns a newly configured Kademlia instance
 func newKademlia(log *zap.Logger, nodeType pb.NodeType, bootstrapNodes []pb.Node, address string, metadata *pb.NodeMetadata, identity *identity.FullIdentity, path string, alpha int) (*Kademlia, error) {
 	self := pb.Node{
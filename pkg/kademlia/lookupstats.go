@@ -0,0 +1,102 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// recentLookupsSize is how many of the most recent lookups are kept
+const recentLookupsSize = 100
+
+// LookupInfo describes a single node lookup
+type LookupInfo struct {
+	Target       storj.NodeID
+	Started      time.Time
+	Duration     time.Duration
+	QueriedNodes int
+	Found        bool
+	Error        string
+}
+
+// LookupTotals contains the totals of all lookups since the node started
+type LookupTotals struct {
+	Count         int64
+	Found         int64
+	NotFound      int64
+	Failed        int64
+	QueriedNodes  int64
+	TotalDuration time.Duration
+}
+
+// LookupStats counts the lookups of a Kademlia instance and keeps the most
+// recent ones
+type LookupStats struct {
+	mu     sync.Mutex
+	totals LookupTotals
+	recent []LookupInfo // ring buffer of at most recentLookupsSize lookups
+	next   int
+}
+
+// Record adds a finished lookup to the stats
+func (stats *LookupStats) Record(info LookupInfo) {
+	switch {
+	case info.Error != "":
+		mon.Meter("lookup_failed").Mark(1)
+	case info.Found:
+		mon.Meter("lookup_found").Mark(1)
+	default:
+		mon.Meter("lookup_not_found").Mark(1)
+	}
+	mon.IntVal("lookup_queried_nodes").Observe(int64(info.QueriedNodes))
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	stats.totals.Count++
+	switch {
+	case info.Error != "":
+		stats.totals.Failed++
+	case info.Found:
+		stats.totals.Found++
+	default:
+		stats.totals.NotFound++
+	}
+	stats.totals.QueriedNodes += int64(info.QueriedNodes)
+	stats.totals.TotalDuration += info.Duration
+
+	if len(stats.recent) < recentLookupsSize {
+		stats.recent = append(stats.recent, info)
+		return
+	}
+	stats.recent[stats.next] = info
+	stats.next = (stats.next + 1) % recentLookupsSize
+}
+
+// Totals returns the totals of all recorded lookups
+func (stats *LookupStats) Totals() LookupTotals {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	return stats.totals
+}
+
+// Recent returns at most limit of the most recent lookups, most recent first
+func (stats *LookupStats) Recent(limit int) []LookupInfo {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	if limit <= 0 || limit > len(stats.recent) {
+		limit = len(stats.recent)
+	}
+	lookups := make([]LookupInfo, 0, limit)
+	for i := 1; i <= limit; i++ {
+		// the most recent lookup is right before next
+		index := (stats.next - i + len(stats.recent)) % len(stats.recent)
+		lookups = append(lookups, stats.recent[index])
+	}
+	return lookups
+}
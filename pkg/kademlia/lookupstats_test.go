@@ -0,0 +1,46 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupStats(t *testing.T) {
+	var stats LookupStats
+
+	for i := 0; i < recentLookupsSize+10; i++ {
+		info := LookupInfo{QueriedNodes: i, Duration: time.Millisecond}
+		switch i % 3 {
+		case 0:
+			info.Found = true
+		case 1:
+			info.Error = "dial failed"
+		}
+		stats.Record(info)
+	}
+
+	totals := stats.Totals()
+	assert.EqualValues(t, recentLookupsSize+10, totals.Count)
+	assert.EqualValues(t, 37, totals.Found)
+	assert.EqualValues(t, 37, totals.Failed)
+	assert.EqualValues(t, 36, totals.NotFound)
+	assert.Equal(t, (recentLookupsSize+10)*time.Millisecond, totals.TotalDuration)
+
+	// the most recent lookups come first
+	recent := stats.Recent(3)
+	if assert.Len(t, recent, 3) {
+		assert.Equal(t, recentLookupsSize+9, recent[0].QueriedNodes)
+		assert.Equal(t, recentLookupsSize+8, recent[1].QueriedNodes)
+		assert.Equal(t, recentLookupsSize+7, recent[2].QueriedNodes)
+	}
+
+	all := stats.Recent(0)
+	if assert.Len(t, all, recentLookupsSize) {
+		assert.Equal(t, 10, all[len(all)-1].QueriedNodes)
+	}
+}
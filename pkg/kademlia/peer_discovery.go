@@ -23,8 +23,9 @@ type peerDiscovery struct {
 	target storj.NodeID
 	opts   discoveryOptions
 
-	cond  sync.Cond
-	queue discoveryQueue
+	cond    sync.Cond
+	queue   discoveryQueue
+	queried int // protected by cond.L
 }
 
 // ErrMaxRetries is used when a lookup has been retried the max number of times
@@ -82,6 +83,7 @@ func (lookup *peerDiscovery) Run(ctx context.Context) (target *pb.Node, err erro
 
 					if next != nil {
 						working++
+						lookup.queried++
 						break
 					}
 					// no work, wait until some other routine inserts into the queue
@@ -128,6 +130,13 @@ func (lookup *peerDiscovery) Run(ctx context.Context) (target *pb.Node, err erro
 	return target, err
 }
 
+// Queried returns how many nodes the lookup queried
+func (lookup *peerDiscovery) Queried() int {
+	lookup.cond.L.Lock()
+	defer lookup.cond.L.Unlock()
+	return lookup.queried
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
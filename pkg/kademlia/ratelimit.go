@@ -0,0 +1,165 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/identity"
+)
+
+var (
+	// ErrRateLimited is the error class of requests exceeding the rate limit
+	ErrRateLimited = errs.Class("rate limited")
+	// ErrBanned is the error class of requests from temporarily banned peers
+	ErrBanned = errs.Class("banned")
+	// ErrDifficulty is the error class of requests from identities with a too low difficulty
+	ErrDifficulty = errs.Class("insufficient difficulty")
+)
+
+// maxLimitedSources is how many sources are tracked before the idle ones are pruned
+const maxLimitedSources = 4096
+
+// RateLimitConfig limits the requests peers can make to the kademlia endpoint
+type RateLimitConfig struct {
+	Rate          float64       `help:"requests per second each peer address may make to the kademlia endpoint, 0 disables the limit" default:"10"`
+	Burst         int           `help:"requests each peer address may make at once to the kademlia endpoint" default:"50"`
+	BanThreshold  int           `help:"requests over the limit after which a peer address is banned, 0 disables banning" default:"100"`
+	BanDuration   time.Duration `help:"how long peer addresses flooding the kademlia endpoint are banned" default:"10m0s"`
+	MinDifficulty uint16        `help:"minimum identity difficulty of peers making requests to the kademlia endpoint, 0 disables the check" default:"0"`
+}
+
+// rateLimiter limits the requests of each source with a token bucket and
+// temporarily bans the sources which keep exceeding it
+type rateLimiter struct {
+	config RateLimitConfig
+	now    func() time.Time
+
+	mu      sync.Mutex
+	sources map[string]*tokenBucket
+	banned  map[string]time.Time
+}
+
+// tokenBucket are the requests a source may still make
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+	dropped int
+}
+
+func newRateLimiter(config RateLimitConfig) *rateLimiter {
+	return &rateLimiter{
+		config:  config,
+		now:     time.Now,
+		sources: make(map[string]*tokenBucket),
+		banned:  make(map[string]time.Time),
+	}
+}
+
+// Verify checks the address and identity of the peer making a request
+func (limiter *rateLimiter) Verify(ctx context.Context) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if limiter.config.MinDifficulty > 0 {
+		peerIdentity, err := identity.PeerIdentityFromPeer(p)
+		if err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		difficulty, err := peerIdentity.ID.Difficulty()
+		if err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		if difficulty < limiter.config.MinDifficulty {
+			mon.Meter("kademlia_requests_dropped_difficulty").Mark(1)
+			return status.Error(codes.PermissionDenied, ErrDifficulty.New("%d is less than %d", difficulty, limiter.config.MinDifficulty).Error())
+		}
+	}
+
+	if p.Addr == nil {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if err := limiter.allow(host); err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return nil
+}
+
+// allow takes a token of source, it fails when there are none left or the
+// source is banned
+func (limiter *rateLimiter) allow(source string) error {
+	if limiter.config.Rate <= 0 {
+		return nil
+	}
+	now := limiter.now()
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if until, ok := limiter.banned[source]; ok {
+		if now.Before(until) {
+			mon.Meter("kademlia_requests_dropped_banned").Mark(1)
+			return ErrBanned.New("%s until %s", source, until.Format(time.RFC3339))
+		}
+		delete(limiter.banned, source)
+	}
+
+	bucket, ok := limiter.sources[source]
+	if !ok {
+		if len(limiter.sources) >= maxLimitedSources {
+			limiter.prune(now)
+		}
+		bucket = &tokenBucket{tokens: float64(limiter.config.Burst), updated: now}
+		limiter.sources[source] = bucket
+	}
+
+	bucket.tokens += now.Sub(bucket.updated).Seconds() * limiter.config.Rate
+	if bucket.tokens > float64(limiter.config.Burst) {
+		bucket.tokens = float64(limiter.config.Burst)
+	}
+	bucket.updated = now
+
+	if bucket.tokens < 1 {
+		mon.Meter("kademlia_requests_dropped_rate").Mark(1)
+		bucket.dropped++
+		if limiter.config.BanThreshold > 0 && bucket.dropped >= limiter.config.BanThreshold {
+			limiter.banned[source] = now.Add(limiter.config.BanDuration)
+			delete(limiter.sources, source)
+			mon.Meter("kademlia_sources_banned").Mark(1)
+		}
+		return ErrRateLimited.New("%s", source)
+	}
+	bucket.tokens--
+	return nil
+}
+
+// prune forgets the sources which have been idle long enough to have a full
+// bucket again and the expired bans
+func (limiter *rateLimiter) prune(now time.Time) {
+	refill := time.Duration(float64(limiter.config.Burst) / limiter.config.Rate * float64(time.Second))
+	for source, bucket := range limiter.sources {
+		if now.Sub(bucket.updated) >= refill {
+			delete(limiter.sources, source)
+		}
+	}
+	for source, until := range limiter.banned {
+		if !now.Before(until) {
+			delete(limiter.banned, source)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(RateLimitConfig{
+		Rate:         2,
+		Burst:        4,
+		BanThreshold: 3,
+		BanDuration:  time.Minute,
+	})
+	now := time.Unix(1e9, 0)
+	limiter.now = func() time.Time { return now }
+
+	// a burst is allowed, afterwards only the rate
+	for i := 0; i < 4; i++ {
+		require.NoError(t, limiter.allow("10.0.0.1"))
+	}
+	assert.True(t, ErrRateLimited.Has(limiter.allow("10.0.0.1")))
+	assert.NoError(t, limiter.allow("10.0.0.2"), "sources are limited separately")
+
+	now = now.Add(500 * time.Millisecond)
+	assert.NoError(t, limiter.allow("10.0.0.1"))
+	assert.True(t, ErrRateLimited.Has(limiter.allow("10.0.0.1")))
+
+	// sources flooding the endpoint are banned
+	assert.True(t, ErrRateLimited.Has(limiter.allow("10.0.0.1")))
+	now = now.Add(10 * time.Second)
+	assert.True(t, ErrBanned.Has(limiter.allow("10.0.0.1")))
+
+	now = now.Add(time.Minute)
+	assert.NoError(t, limiter.allow("10.0.0.1"))
+
+	// idle sources are forgotten
+	limiter.prune(now.Add(time.Hour))
+	assert.Empty(t, limiter.sources)
+	assert.Empty(t, limiter.banned)
+}
+
+func TestRateLimiter_Verify(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 7777},
+	})
+
+	limiter := newRateLimiter(RateLimitConfig{Rate: 1, Burst: 1})
+	require.NoError(t, limiter.Verify(ctx))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(limiter.Verify(ctx)))
+
+	// in process requests aren't limited
+	assert.NoError(t, limiter.Verify(context.Background()))
+
+	// peers without an identity can't prove their difficulty
+	limiter = newRateLimiter(RateLimitConfig{MinDifficulty: 1})
+	assert.Equal(t, codes.Unauthenticated, status.Code(limiter.Verify(ctx)))
+}
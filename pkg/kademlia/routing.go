@@ -146,6 +146,57 @@ func (rt *RoutingTable) GetBucketIds() (storage.Keys, error) {
 	return kbuckets, nil
 }
 
+// KBucket describes a k-bucket of the routing table
+type KBucket struct {
+	ID               storj.NodeID
+	Depth            int
+	LastRefreshed    time.Time
+	Nodes            []*pb.Node
+	ReplacementCache []*pb.Node
+}
+
+// KBuckets returns the k-buckets of the routing table with their nodes and
+// replacement caches
+func (rt *RoutingTable) KBuckets() ([]KBucket, error) {
+	bIDs, err := rt.GetBucketIds()
+	if err != nil {
+		return nil, RoutingErr.Wrap(err)
+	}
+
+	var buckets []KBucket
+	for _, key := range bIDs {
+		bID := keyToBucketID(key)
+		depth, err := rt.determineLeafDepth(bID)
+		if err != nil {
+			return nil, err
+		}
+		refreshed, err := rt.GetBucketTimestamp(bID[:])
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := rt.getUnmarshaledNodesFromBucket(bID)
+		if err != nil {
+			return nil, err
+		}
+
+		rt.mutex.Lock()
+		var cache []*pb.Node
+		for _, node := range rt.replacementCache[bID] {
+			cache = append(cache, pb.CopyNode(node))
+		}
+		rt.mutex.Unlock()
+
+		buckets = append(buckets, KBucket{
+			ID:               storj.NodeID(bID),
+			Depth:            depth,
+			LastRefreshed:    refreshed,
+			Nodes:            nodes,
+			ReplacementCache: cache,
+		})
+	}
+	return buckets, nil
+}
+
 // FindNear returns the node corresponding to the provided nodeID
 // returns all Nodes closest via XOR to the provided nodeID up to the provided limit
 // always returns limit + self
@@ -191,6 +242,31 @@ func (rt *RoutingTable) UpdateSelf(node *pb.Node) error {
 	return nil
 }
 
+// UpdateRestrictions updates the capacity the local node reports
+func (rt *RoutingTable) UpdateRestrictions(restrictions *pb.NodeRestrictions) error {
+	return rt.updateSelf(func(self *pb.Node) { self.Restrictions = restrictions })
+}
+
+// UpdateMetadata updates the operator metadata the local node reports
+func (rt *RoutingTable) UpdateMetadata(metadata *pb.NodeMetadata) error {
+	return rt.updateSelf(func(self *pb.Node) { self.Metadata = metadata })
+}
+
+// updateSelf changes the local node with update, concurrent updates of
+// different fields don't overwrite each other
+func (rt *RoutingTable) updateSelf(update func(self *pb.Node)) error {
+	rt.mutex.Lock()
+	update(&rt.self)
+	self := rt.self
+	rt.seen[self.Id] = &self
+	rt.mutex.Unlock()
+
+	if err := rt.updateNode(&self); err != nil {
+		return RoutingErr.New("could not update node %s", err)
+	}
+	return nil
+}
+
 // ConnectionSuccess updates or adds a node to the routing table when
 // a successful connection is made to the node on the network
 func (rt *RoutingTable) ConnectionSuccess(node *pb.Node) error {
@@ -0,0 +1,120 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+)
+
+// expiredObject is an object which expired by the lifecycle of its bucket
+type expiredObject struct {
+	projectID uuid.UUID
+	bucket    string
+	path      storj.Path
+	// createdBefore is when the object expired
+	createdBefore time.Time
+}
+
+// segmentPath returns the pointerdb path of a segment of the object
+func (object expiredObject) segmentPath(segment string) storj.Path {
+	return storj.JoinPaths(object.projectID.String(), segment, object.bucket, object.path)
+}
+
+// lastSegmentPath returns the pointerdb path of the last segment of the
+// object, which holds the metadata of the object
+func (object expiredObject) lastSegmentPath() storj.Path {
+	return object.segmentPath("l")
+}
+
+// expiredCollector collects the objects which expired by the lifecycles of
+// their buckets during a metainfo loop iteration
+type expiredCollector struct {
+	lifecycles map[string]pointerdb.BucketLifecycle
+	now        time.Time
+	limit      int
+
+	expired []expiredObject
+}
+
+// newExpiredCollector returns a collector of at most limit objects which
+// expired by now
+func newExpiredCollector(lifecycles []pointerdb.BucketLifecycle, now time.Time, limit int) *expiredCollector {
+	collector := &expiredCollector{
+		lifecycles: make(map[string]pointerdb.BucketLifecycle, len(lifecycles)),
+		now:        now,
+		limit:      limit,
+	}
+	for _, lifecycle := range lifecycles {
+		collector.lifecycles[storj.JoinPaths(lifecycle.ProjectID.String(), lifecycle.BucketName)] = lifecycle
+	}
+	return collector
+}
+
+// RemoteSegment collects the object of the segment when it has expired
+func (collector *expiredCollector) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return collector.collect(path, pointer)
+}
+
+// InlineSegment collects the object of the segment when it has expired
+func (collector *expiredCollector) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	return collector.collect(path, pointer)
+}
+
+// collect collects the object of the path of the form
+// "projectID/segment/bucket/path" when the segment is the last one of an
+// expired object
+func (collector *expiredCollector) collect(path storj.Path, pointer *pb.Pointer) error {
+	if collector.limit > 0 && len(collector.expired) >= collector.limit {
+		return nil
+	}
+
+	components := storj.SplitPath(path)
+	if len(components) < 4 || components[1] != "l" {
+		return nil
+	}
+
+	lifecycle, ok := collector.lifecycles[storj.JoinPaths(components[0], components[2])]
+	if !ok {
+		return nil
+	}
+
+	objectPath := storj.JoinPaths(components[3:]...)
+
+	days := lifecycle.ExpireAfterDays
+	if lifecycle.VersionsPrefix != "" && strings.HasPrefix(objectPath, lifecycle.VersionsPrefix+"/") {
+		// versions are kept since they were replaced or deleted
+		days = lifecycle.VersionsExpireAfterDays
+	}
+	if days <= 0 {
+		return nil
+	}
+
+	created, err := ptypes.Timestamp(pointer.GetCreationDate())
+	if err != nil {
+		// segments without a creation date aren't expired
+		return nil
+	}
+
+	createdBefore := collector.now.AddDate(0, 0, -days)
+	if !created.Before(createdBefore) {
+		return nil
+	}
+
+	collector.expired = append(collector.expired, expiredObject{
+		projectID:     lifecycle.ProjectID,
+		bucket:        lifecycle.BucketName,
+		path:          objectPath,
+		createdBefore: createdBefore,
+	})
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestExpiredCollector(t *testing.T) {
+	ctx := context.Background()
+
+	projectID, err := uuid.New()
+	require.NoError(t, err)
+
+	now := time.Now()
+	collector := newExpiredCollector([]pointerdb.BucketLifecycle{
+		{ProjectID: *projectID, BucketName: "objects", ExpireAfterDays: 30, VersionsPrefix: "versions", VersionsExpireAfterDays: 0},
+		{ProjectID: *projectID, BucketName: "versions", ExpireAfterDays: 0, VersionsPrefix: "versions", VersionsExpireAfterDays: 7},
+	}, now, 0)
+
+	pointer := func(age time.Duration) *pb.Pointer {
+		created, err := ptypes.TimestampProto(now.Add(-age))
+		require.NoError(t, err)
+		return &pb.Pointer{CreationDate: created}
+	}
+	day := 24 * time.Hour
+
+	for _, segment := range []struct {
+		path storj.Path
+		age  time.Duration
+	}{
+		{"objects/old", 31 * day},
+		{"objects/new", 29 * day},
+		{"versions/objects/old/id", 31 * day},
+		{"versions/old", 31 * day},
+		{"versions/versions/old/id", 8 * day},
+		{"versions/versions/new/id", 6 * day},
+		{"other/old", 31 * day},
+	} {
+		path := storj.JoinPaths(projectID.String(), "l", segment.path)
+		require.NoError(t, collector.InlineSegment(ctx, path, pointer(segment.age)))
+
+		// only the last segment of an object is collected
+		path = storj.JoinPaths(projectID.String(), "s0", segment.path)
+		require.NoError(t, collector.RemoteSegment(ctx, path, pointer(segment.age)))
+	}
+
+	var expired []storj.Path
+	for _, object := range collector.expired {
+		expired = append(expired, storj.JoinPaths(object.bucket, object.path))
+	}
+	assert.Equal(t, []storj.Path{"objects/old", "versions/versions/old/id"}, expired)
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package lifecycle deletes the objects and the versions of objects which
+// expired by the lifecycle rules of their buckets
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/storage"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default lifecycle errs class
+	Error = errs.Class("lifecycle error")
+)
+
+// Config contains the configurable values of bucket lifecycles
+type Config struct {
+	Enabled    bool          `help:"set if the objects expired by bucket lifecycles are deleted" default:"false"`
+	Interval   time.Duration `help:"how frequently the objects expired by bucket lifecycles are deleted" default:"24h0m0s"`
+	MaxDeletes int           `help:"the most objects deleted in one run, the rest are deleted by the next runs" default:"10000"`
+}
+
+// Service periodically finds the objects expired by the lifecycles of their
+// buckets with the metainfo loop and deletes them. The pieces of the deleted
+// segments are left to garbage collection and the bucket counters to tally.
+type Service struct {
+	log        *zap.Logger
+	config     Config
+	lifecycles pointerdb.BucketLifecycles
+	objectTags pointerdb.ObjectTags
+	loop       *pointerdb.Loop
+	pointers   *pointerdb.Service
+
+	Loop sync2.Cycle
+}
+
+// NewService creates a new bucket lifecycle service
+func NewService(log *zap.Logger, config Config, lifecycles pointerdb.BucketLifecycles, objectTags pointerdb.ObjectTags, loop *pointerdb.Loop, pointers *pointerdb.Service) *Service {
+	service := &Service{
+		log:        log,
+		config:     config,
+		lifecycles: lifecycles,
+		objectTags: objectTags,
+		loop:       loop,
+		pointers:   pointers,
+	}
+	service.Loop.SetInterval(config.Interval)
+	return service
+}
+
+// Run deletes the expired objects every interval
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		_, err := service.ExpireObjects(ctx, time.Now())
+		if err != nil {
+			service.log.Error("expiring objects failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the bucket lifecycle loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// ExpireObjects deletes the objects and the versions which expired by now and
+// returns the number of deleted ones
+func (service *Service) ExpireObjects(ctx context.Context, now time.Time) (deleted int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lifecycles, err := service.lifecycles.GetAll(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if len(lifecycles) == 0 {
+		return 0, nil
+	}
+
+	collector := newExpiredCollector(lifecycles, now, service.config.MaxDeletes)
+	if err := service.loop.Join(ctx, collector); err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	var failed int
+	for _, object := range collector.expired {
+		err := service.deleteObject(ctx, object)
+		if err != nil {
+			if ctx.Err() != nil {
+				return deleted, ctx.Err()
+			}
+			failed++
+			service.log.Warn("deleting expired object failed", zap.String("path", object.lastSegmentPath()), zap.Error(err))
+			continue
+		}
+		deleted++
+	}
+
+	mon.IntVal("lifecycle_objects_deleted").Observe(int64(deleted))
+	mon.IntVal("lifecycle_objects_failed").Observe(int64(failed))
+	service.log.Info("expiring objects finished",
+		zap.Int("buckets", len(lifecycles)),
+		zap.Int("deleted", deleted),
+		zap.Int("failed", failed),
+	)
+	return deleted, nil
+}
+
+// deleteObject deletes the segments of an expired object, unless it was
+// replaced after the metainfo loop went past it
+func (service *Service) deleteObject(ctx context.Context, object expiredObject) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lastSegment, err := service.pointers.Get(object.lastSegmentPath())
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+	created, err := ptypes.Timestamp(lastSegment.GetCreationDate())
+	if err != nil {
+		return err
+	}
+	if !created.Before(object.createdBefore) {
+		return nil
+	}
+
+	// the last segment is deleted last, so an interrupted delete is retried
+	for index := 0; ; index++ {
+		path := object.segmentPath(fmt.Sprintf("s%d", index))
+		_, err := service.pointers.Get(path)
+		if storage.ErrKeyNotFound.Has(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := service.pointers.Delete(path); err != nil {
+			return err
+		}
+	}
+
+	if err := service.pointers.Delete(object.lastSegmentPath()); err != nil {
+		return err
+	}
+
+	return service.objectTags.Delete(ctx, object.projectID, object.bucket, object.path)
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestExpireObjects(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		service := satellite.Lifecycle.Service
+
+		remote := make([]byte, 10*memory.KiB)
+		inline := []byte("inline")
+
+		require.NoError(t, uplink.Upload(ctx, satellite, "expiring", "remote", remote))
+		require.NoError(t, uplink.Upload(ctx, satellite, "expiring", "inline", inline))
+		require.NoError(t, uplink.Upload(ctx, satellite, "kept", "remote", remote))
+
+		pdb, err := uplink.DialPointerDB(satellite, uplink.APIKey[satellite.ID()])
+		require.NoError(t, err)
+		err = pdb.SetBucketLifecycle(ctx, "expiring", &pb.BucketLifecycle{ExpireAfterDays: 1})
+		require.NoError(t, err)
+
+		// the objects haven't expired yet
+		deleted, err := service.ExpireObjects(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 0, deleted)
+
+		deleted, err = service.ExpireObjects(ctx, time.Now().Add(48*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+
+		for _, path := range []storj.Path{"remote", "inline"} {
+			_, err = uplink.Download(ctx, satellite, "expiring", path)
+			assert.True(t, storj.ErrObjectNotFound.Has(err))
+		}
+
+		downloaded, err := uplink.Download(ctx, satellite, "kept", "remote")
+		require.NoError(t, err)
+		assert.Equal(t, remote, downloaded)
+
+		// removing the lifecycle keeps the new objects
+		require.NoError(t, uplink.Upload(ctx, satellite, "expiring", "remote", remote))
+		err = pdb.SetBucketLifecycle(ctx, "expiring", &pb.BucketLifecycle{})
+		require.NoError(t, err)
+
+		deleted, err = service.ExpireObjects(ctx, time.Now().Add(48*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, deleted)
+	})
+}
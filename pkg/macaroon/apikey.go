@@ -0,0 +1,201 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/mr-tron/base58/base58"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// ActionType is the kind of operation an api key is checked for
+type ActionType int
+
+const (
+	// ActionRead reads a segment or the information about a bucket
+	ActionRead ActionType = iota + 1
+	// ActionWrite stores or replaces a segment
+	ActionWrite
+	// ActionList lists the paths below a prefix
+	ActionList
+	// ActionDelete deletes a segment
+	ActionDelete
+)
+
+// Action is an operation on a bucket and an encrypted path within it. An
+// empty path is the bucket itself, an empty bucket are all buckets.
+type Action struct {
+	Op            ActionType
+	Bucket        []byte
+	EncryptedPath []byte
+}
+
+// APIKey is an api key, which is a macaroon whose caveats are pb.Caveats
+type APIKey struct {
+	mac *Macaroon
+}
+
+// NewAPIKey creates an unrestricted api key, head identifies the key on the
+// satellite, which checks the key with secret
+func NewAPIKey(head, secret []byte) *APIKey {
+	return &APIKey{mac: NewUnrestricted(head, secret)}
+}
+
+// ParseAPIKey parses an api key serialized by Serialize
+func ParseAPIKey(key string) (*APIKey, error) {
+	data, err := base58.Decode(key)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	mac, err := ParseMacaroon(data)
+	if err != nil {
+		return nil, err
+	}
+	return &APIKey{mac: mac}, nil
+}
+
+// Restrict returns a copy of the key, which is further restricted by
+// caveat. Keys are restricted without the secret, so anyone holding a key
+// can create keys allowing less than it.
+func (key *APIKey) Restrict(caveat pb.Caveat) (*APIKey, error) {
+	if len(caveat.Nonce) == 0 {
+		caveat.Nonce = make([]byte, 4)
+		if _, err := rand.Read(caveat.Nonce); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+	data, err := proto.Marshal(&caveat)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &APIKey{mac: key.mac.AddFirstPartyCaveat(data)}, nil
+}
+
+// Check returns an error unless the key was issued with secret and all of
+// its caveats allow actions at now
+func (key *APIKey) Check(secret []byte, now time.Time, actions ...Action) error {
+	if !key.mac.Validate(secret) {
+		return ErrUnauthorized.New("invalid signature")
+	}
+
+	for _, data := range key.mac.Caveats() {
+		var caveat pb.Caveat
+		if err := proto.Unmarshal(data, &caveat); err != nil {
+			return ErrUnauthorized.New("invalid caveat: %v", err)
+		}
+		if err := checkTime(&caveat, now); err != nil {
+			return err
+		}
+		for _, action := range actions {
+			if !allows(&caveat, action) {
+				return ErrUnauthorized.New("action %d on %q not allowed", action.Op, action.Bucket)
+			}
+		}
+	}
+	return nil
+}
+
+// Head returns the identifier of the key on the satellite
+func (key *APIKey) Head() []byte { return key.mac.Head() }
+
+// Serialize encodes the key as a string
+func (key *APIKey) Serialize() string {
+	return base58.Encode(key.mac.Serialize())
+}
+
+func checkTime(caveat *pb.Caveat, now time.Time) error {
+	if caveat.NotBefore != nil {
+		notBefore, err := ptypes.Timestamp(caveat.NotBefore)
+		if err != nil {
+			return ErrUnauthorized.Wrap(err)
+		}
+		if now.Before(notBefore) {
+			return ErrUnauthorized.New("key is valid from %v", notBefore)
+		}
+	}
+	if caveat.NotAfter != nil {
+		notAfter, err := ptypes.Timestamp(caveat.NotAfter)
+		if err != nil {
+			return ErrUnauthorized.Wrap(err)
+		}
+		if now.After(notAfter) {
+			return ErrUnauthorized.New("key expired at %v", notAfter)
+		}
+	}
+	return nil
+}
+
+// allows returns whether caveat allows action
+func allows(caveat *pb.Caveat, action Action) bool {
+	switch action.Op {
+	case ActionRead:
+		if caveat.DisallowReads {
+			return false
+		}
+	case ActionWrite:
+		if caveat.DisallowWrites {
+			return false
+		}
+	case ActionList:
+		if caveat.DisallowLists {
+			return false
+		}
+	case ActionDelete:
+		if caveat.DisallowDeletes {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if len(caveat.AllowedPaths) == 0 {
+		return true
+	}
+
+	for _, path := range caveat.AllowedPaths {
+		if allowsPath(path, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsPath returns whether action is within path. The paths are encrypted
+// per component, so the prefixes are compared per component. Listing a
+// parent of path is allowed, only the encrypted names in it are revealed,
+// and the information about the bucket may be read.
+func allowsPath(path *pb.CaveatPath, action Action) bool {
+	if len(action.Bucket) == 0 {
+		return action.Op == ActionList
+	}
+	if !bytes.Equal(path.Bucket, action.Bucket) {
+		return false
+	}
+
+	prefix := strings.TrimSuffix(string(path.EncryptedPathPrefix), "/")
+	actionPath := strings.TrimSuffix(string(action.EncryptedPath), "/")
+
+	if isPathPrefix(prefix, actionPath) {
+		return true
+	}
+	switch action.Op {
+	case ActionList:
+		return isPathPrefix(actionPath, prefix)
+	case ActionRead:
+		return actionPath == ""
+	}
+	return false
+}
+
+// isPathPrefix returns whether prefix is path or one of its parents
+func isPathPrefix(prefix, path string) bool {
+	return prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestAPIKey(t *testing.T) {
+	secret := []byte("secret")
+	key := macaroon.NewAPIKey([]byte("id"), secret)
+
+	parsed, err := macaroon.ParseAPIKey(key.Serialize())
+	require.NoError(t, err)
+	assert.Equal(t, []byte("id"), parsed.Head())
+	assert.NoError(t, parsed.Check(secret, time.Now(), macaroon.Action{Op: macaroon.ActionWrite}))
+	assert.True(t, macaroon.ErrUnauthorized.Has(parsed.Check([]byte("other secret"), time.Now())))
+
+	_, err = macaroon.ParseAPIKey("not a key")
+	assert.Error(t, err)
+}
+
+func TestAPIKeyCaveats(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+
+	timestamp := func(t time.Time) *timestamp.Timestamp {
+		ts, _ := ptypes.TimestampProto(t)
+		return ts
+	}
+	action := func(op macaroon.ActionType, bucket, path string) macaroon.Action {
+		return macaroon.Action{Op: op, Bucket: []byte(bucket), EncryptedPath: []byte(path)}
+	}
+	paths := []*pb.CaveatPath{
+		{Bucket: []byte("a"), EncryptedPathPrefix: []byte("x/y")},
+		{Bucket: []byte("b")},
+	}
+
+	for i, tt := range []struct {
+		caveats []pb.Caveat
+		action  macaroon.Action
+		allowed bool
+	}{
+		{nil, action(macaroon.ActionDelete, "a", "z"), true},
+		{[]pb.Caveat{{DisallowReads: true}}, action(macaroon.ActionRead, "a", "z"), false},
+		{[]pb.Caveat{{DisallowReads: true}}, action(macaroon.ActionList, "a", "z"), true},
+		{[]pb.Caveat{{DisallowWrites: true}}, action(macaroon.ActionWrite, "a", "z"), false},
+		{[]pb.Caveat{{DisallowLists: true}}, action(macaroon.ActionList, "a", "z"), false},
+		{[]pb.Caveat{{DisallowDeletes: true}}, action(macaroon.ActionDelete, "a", "z"), false},
+		{[]pb.Caveat{{DisallowDeletes: true}, {DisallowWrites: true}}, action(macaroon.ActionWrite, "a", "z"), false},
+
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionWrite, "a", "x/y"), true},
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionWrite, "a", "x/y/z"), true},
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionWrite, "a", "x/yz"), false},
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionWrite, "a", "x"), false},
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionWrite, "b", "any/path"), true},
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionWrite, "c", "x/y"), false},
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionList, "a", "x/"), true},
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionList, "a", "w"), false},
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionList, "", ""), true},
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionRead, "a", ""), true},
+		{[]pb.Caveat{{AllowedPaths: paths}}, action(macaroon.ActionDelete, "a", ""), false},
+		{[]pb.Caveat{{AllowedPaths: paths}, {AllowedPaths: paths[1:]}}, action(macaroon.ActionWrite, "a", "x/y"), false},
+
+		{[]pb.Caveat{{NotAfter: timestamp(now.Add(time.Hour))}}, action(macaroon.ActionRead, "a", "z"), true},
+		{[]pb.Caveat{{NotAfter: timestamp(now.Add(-time.Hour))}}, action(macaroon.ActionRead, "a", "z"), false},
+		{[]pb.Caveat{{NotBefore: timestamp(now.Add(-time.Hour))}}, action(macaroon.ActionRead, "a", "z"), true},
+		{[]pb.Caveat{{NotBefore: timestamp(now.Add(time.Hour))}}, action(macaroon.ActionRead, "a", "z"), false},
+	} {
+		errTag := fmt.Sprintf("%d. %+v", i, tt)
+
+		key := macaroon.NewAPIKey([]byte("id"), secret)
+		for _, caveat := range tt.caveats {
+			var err error
+			key, err = key.Restrict(caveat)
+			require.NoError(t, err, errTag)
+		}
+
+		// the key is checked by the satellite after it's serialized
+		parsed, err := macaroon.ParseAPIKey(key.Serialize())
+		require.NoError(t, err, errTag)
+
+		err = parsed.Check(secret, now, tt.action)
+		if tt.allowed {
+			assert.NoError(t, err, errTag)
+		} else {
+			assert.True(t, macaroon.ErrUnauthorized.Has(err), errTag)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon
+
+import (
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the errs class of macaroon errors
+	Error = errs.Class("macaroon error")
+	// ErrUnauthorized is the errs class of api keys, which don't allow an action
+	ErrUnauthorized = errs.Class("api key unauthorized")
+)
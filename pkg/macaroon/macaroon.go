@@ -0,0 +1,139 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+)
+
+// macaroonVersion is the version of the serialized macaroon format
+const macaroonVersion = 1
+
+// Macaroon is a bearer token, which its holder can restrict further by
+// adding caveats without knowing the secret it was issued with. The tail
+// is a chain of HMACs: the head is signed with the secret and every caveat
+// is signed with the previous tail, so caveats can be added but not removed.
+type Macaroon struct {
+	head    []byte
+	caveats [][]byte
+	tail    []byte
+}
+
+// NewUnrestricted creates a macaroon without caveats, head identifies the
+// secret the macaroon is signed with
+func NewUnrestricted(head, secret []byte) *Macaroon {
+	return &Macaroon{
+		head: append([]byte(nil), head...),
+		tail: sign(secret, head),
+	}
+}
+
+// AddFirstPartyCaveat returns a copy of the macaroon restricted by caveat
+func (m *Macaroon) AddFirstPartyCaveat(caveat []byte) *Macaroon {
+	restricted := m.copy()
+	restricted.caveats = append(restricted.caveats, append([]byte(nil), caveat...))
+	restricted.tail = sign(m.tail, caveat)
+	return restricted
+}
+
+// Validate checks that the macaroon was issued with secret and that its
+// caveats weren't tampered with
+func (m *Macaroon) Validate(secret []byte) bool {
+	tail := sign(secret, m.head)
+	for _, caveat := range m.caveats {
+		tail = sign(tail, caveat)
+	}
+	return subtle.ConstantTimeCompare(tail, m.tail) == 1
+}
+
+// Head returns the identifier of the secret of the macaroon
+func (m *Macaroon) Head() []byte { return append([]byte(nil), m.head...) }
+
+// Caveats returns the caveats of the macaroon in the order they were added
+func (m *Macaroon) Caveats() [][]byte { return m.copy().caveats }
+
+// Tail returns the signature of the macaroon
+func (m *Macaroon) Tail() []byte { return append([]byte(nil), m.tail...) }
+
+// Serialize encodes the macaroon as bytes
+func (m *Macaroon) Serialize() []byte {
+	data := []byte{macaroonVersion}
+	data = appendBytes(data, m.head)
+	data = appendUvarint(data, uint64(len(m.caveats)))
+	for _, caveat := range m.caveats {
+		data = appendBytes(data, caveat)
+	}
+	return appendBytes(data, m.tail)
+}
+
+// ParseMacaroon decodes a macaroon encoded by Serialize
+func ParseMacaroon(data []byte) (_ *Macaroon, err error) {
+	if len(data) == 0 || data[0] != macaroonVersion {
+		return nil, Error.New("invalid macaroon version")
+	}
+	data = data[1:]
+
+	m := &Macaroon{}
+	if m.head, data, err = readBytes(data); err != nil {
+		return nil, err
+	}
+	count, n := binary.Uvarint(data)
+	if n <= 0 || count > uint64(len(data)) {
+		return nil, Error.New("invalid caveat count")
+	}
+	data = data[n:]
+	for i := uint64(0); i < count; i++ {
+		var caveat []byte
+		if caveat, data, err = readBytes(data); err != nil {
+			return nil, err
+		}
+		m.caveats = append(m.caveats, caveat)
+	}
+	if m.tail, data, err = readBytes(data); err != nil {
+		return nil, err
+	}
+	if len(data) != 0 {
+		return nil, Error.New("trailing data")
+	}
+	return m, nil
+}
+
+func (m *Macaroon) copy() *Macaroon {
+	caveats := make([][]byte, 0, len(m.caveats)+1)
+	for _, caveat := range m.caveats {
+		caveats = append(caveats, append([]byte(nil), caveat...))
+	}
+	return &Macaroon{
+		head:    append([]byte(nil), m.head...),
+		caveats: caveats,
+		tail:    append([]byte(nil), m.tail...),
+	}
+}
+
+func sign(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func appendUvarint(data []byte, value uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(data, buf[:binary.PutUvarint(buf[:], value)]...)
+}
+
+func appendBytes(data, value []byte) []byte {
+	return append(appendUvarint(data, uint64(len(value))), value...)
+}
+
+func readBytes(data []byte) (value, rest []byte, err error) {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || length > uint64(len(data)-n) {
+		return nil, nil, Error.New("invalid length")
+	}
+	data = data[n:]
+	return data[:length], data[length:], nil
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/macaroon"
+)
+
+func TestMacaroon(t *testing.T) {
+	secret := []byte("secret")
+	unrestricted := macaroon.NewUnrestricted([]byte("head"), secret)
+	assert.True(t, unrestricted.Validate(secret))
+	assert.False(t, unrestricted.Validate([]byte("other secret")))
+
+	restricted := unrestricted.AddFirstPartyCaveat([]byte("first")).AddFirstPartyCaveat([]byte("second"))
+	assert.True(t, restricted.Validate(secret))
+	assert.Equal(t, [][]byte{[]byte("first"), []byte("second")}, restricted.Caveats())
+	assert.Equal(t, []byte("head"), restricted.Head())
+
+	// restricting returns a copy
+	assert.Empty(t, unrestricted.Caveats())
+
+	parsed, err := macaroon.ParseMacaroon(restricted.Serialize())
+	require.NoError(t, err)
+	assert.Equal(t, restricted, parsed)
+	assert.True(t, parsed.Validate(secret))
+
+	// caveats can't be removed with the tail of the restricted macaroon
+	tampered := macaroon.NewUnrestricted([]byte("head"), secret).AddFirstPartyCaveat([]byte("first"))
+	data := tampered.Serialize()
+	copy(data[len(data)-len(restricted.Tail()):], restricted.Tail())
+	parsed, err = macaroon.ParseMacaroon(data)
+	require.NoError(t, err)
+	assert.False(t, parsed.Validate(secret))
+
+	for _, invalid := range [][]byte{nil, {0}, {1, 4, 'h'}, append(restricted.Serialize(), 0)} {
+		_, err = macaroon.ParseMacaroon(invalid)
+		assert.Error(t, err)
+	}
+}
@@ -18,12 +18,63 @@ func (db *DB) CreateBucket(ctx context.Context, bucket string, info *storj.Bucke
 		return storj.Bucket{}, storj.ErrNoBucket.New("")
 	}
 
-	meta, err := db.buckets.Put(ctx, bucket, getPathCipher(info))
+	pathCipher, err := db.bucketPathCipher(ctx, bucket, info)
 	if err != nil {
 		return storj.Bucket{}, err
 	}
 
-	return bucketFromMeta(bucket, meta), nil
+	meta, err := db.buckets.Put(ctx, bucket, pathCipher)
+	if err != nil {
+		return storj.Bucket{}, err
+	}
+
+	if info != nil && info.Versioning {
+		meta, err = db.buckets.SetVersioning(ctx, bucket, true)
+		if err != nil {
+			return storj.Bucket{}, err
+		}
+	}
+
+	bucketInfo = bucketFromMeta(bucket, meta)
+	if info != nil && info.PartnerID != "" && db.pointers != nil {
+		err = db.pointers.SetBucketAttribution(ctx, bucket, info.PartnerID)
+		if err != nil {
+			return storj.Bucket{}, err
+		}
+		bucketInfo.PartnerID = info.PartnerID
+	}
+
+	return bucketInfo, nil
+}
+
+// SetBucketVersioning enables or disables keeping versions of the objects in
+// bucket. Disabling it keeps the existing versions.
+func (db *DB) SetBucketVersioning(ctx context.Context, bucket string, enabled bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket == "" {
+		return storj.ErrNoBucket.New("")
+	}
+
+	_, err = db.buckets.SetVersioning(ctx, bucket, enabled)
+	return err
+}
+
+// bucketPathCipher returns the path cipher of a new bucket, the bucket
+// template of the project takes precedence over the requested one
+func (db *DB) bucketPathCipher(ctx context.Context, bucket string, info *storj.Bucket) (storj.Cipher, error) {
+	if db.pointers == nil {
+		return getPathCipher(info), nil
+	}
+
+	template, err := db.pointers.BucketTemplate(ctx, bucket)
+	if err != nil {
+		return 0, err
+	}
+	if !template.GetFound() {
+		return getPathCipher(info), nil
+	}
+	return storj.Cipher(template.GetPathCipher()), nil
 }
 
 // DeleteBucket deletes bucket
@@ -109,5 +160,6 @@ func bucketFromMeta(bucket string, meta buckets.Meta) storj.Bucket {
 		Name:       bucket,
 		Created:    meta.Created,
 		PathCipher: meta.PathEncryptionType,
+		Versioning: meta.Versioning,
 	}
 }
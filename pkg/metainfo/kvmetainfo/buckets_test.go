@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/satellite/console"
 
 	"github.com/stretchr/testify/assert"
@@ -182,6 +183,26 @@ func TestBucketCreateCipher(t *testing.T) {
 	})
 }
 
+func TestBucketAttribution(t *testing.T) {
+	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
+		bucket, err := db.CreateBucket(ctx, "attributed", &storj.Bucket{PathCipher: storj.AESGCM, PartnerID: "partner"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "partner", bucket.PartnerID)
+
+		attributions, err := planet.Satellites[0].DB.BucketAttributions().GetAll(ctx)
+		if assert.NoError(t, err) && assert.Len(t, attributions, 1) {
+			assert.Equal(t, "attributed", attributions[0].BucketName)
+			assert.Equal(t, "partner", attributions[0].PartnerID)
+		}
+
+		// the attribution can't be changed once it's set
+		_, err = db.CreateBucket(ctx, "attributed", &storj.Bucket{PathCipher: storj.AESGCM, PartnerID: "another partner"})
+		assert.Error(t, err)
+	})
+}
+
 func TestListBucketsEmpty(t *testing.T) {
 	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
 		_, err := db.ListBuckets(ctx, storj.BucketListOptions{})
@@ -385,17 +406,16 @@ func newMetainfoParts(planet *testplanet.Planet) (*kvmetainfo.DB, buckets.Store,
 
 	segments := segments.NewSegmentStore(oc, ec, pdb, rs, 8*memory.KB.Int())
 
-	key := new(storj.Key)
-	copy(key[:], TestEncKey)
+	access := encryption.NewAccess(encryption.KeyFromPassphrase(TestEncKey))
 
-	streams, err := streams.NewStreamStore(segments, 64*memory.MB.Int64(), key, 1*memory.KB.Int(), storj.AESGCM)
+	streams, err := streams.NewStreamStore(segments, 64*memory.MB.Int64(), access, 1*memory.KB.Int(), storj.AESGCM)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
 	buckets := buckets.NewStore(streams)
 
-	return kvmetainfo.New(buckets, streams, segments, pdb, key), buckets, streams, nil
+	return kvmetainfo.New(buckets, streams, segments, pdb, access), buckets, streams, nil
 }
 
 func forAllCiphers(test func(cipher storj.Cipher)) {
@@ -0,0 +1,55 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+// GetBucketLifecycle returns when the satellite deletes the objects of bucket
+func (db *DB) GetBucketLifecycle(ctx context.Context, bucket string) (lifecycle storj.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err = db.GetBucket(ctx, bucket); err != nil {
+		return storj.BucketLifecycle{}, err
+	}
+
+	resp, err := db.pointers.BucketLifecycle(ctx, bucket)
+	if err != nil {
+		return storj.BucketLifecycle{}, err
+	}
+
+	return storj.BucketLifecycle{
+		ExpireAfterDays:         int(resp.GetLifecycle().GetExpireAfterDays()),
+		VersionsExpireAfterDays: int(resp.GetLifecycle().GetVersionsExpireAfterDays()),
+	}, nil
+}
+
+// SetBucketLifecycle replaces when the satellite deletes the objects of
+// bucket, a lifecycle of zero days keeps them
+func (db *DB) SetBucketLifecycle(ctx context.Context, bucket string, lifecycle storj.BucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	// the satellite can't decrypt the paths, it tells the versions from the
+	// objects by the encrypted prefix they are kept under
+	encrypted, err := db.access.EncryptPath(storj.JoinPaths(bucket, streams.VersionsPrefix), bucketInfo.PathCipher)
+	if err != nil {
+		return err
+	}
+
+	return db.pointers.SetBucketLifecycle(ctx, bucket, &pb.BucketLifecycle{
+		ExpireAfterDays:         int32(lifecycle.ExpireAfterDays),
+		VersionsPrefix:          storj.JoinPaths(storj.SplitPath(encrypted)[1:]...),
+		VersionsExpireAfterDays: int32(lifecycle.VersionsExpireAfterDays),
+	})
+}
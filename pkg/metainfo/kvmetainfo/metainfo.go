@@ -8,6 +8,7 @@ import (
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/pointerdb/pdbclient"
 	"storj.io/storj/pkg/storage/buckets"
 	"storj.io/storj/pkg/storage/segments"
@@ -31,17 +32,17 @@ type DB struct {
 	segments segments.Store
 	pointers pdbclient.Client
 
-	rootKey *storj.Key
+	access *encryption.Access
 }
 
 // New creates a new metainfo database
-func New(buckets buckets.Store, streams streams.Store, segments segments.Store, pointers pdbclient.Client, rootKey *storj.Key) *DB {
+func New(buckets buckets.Store, streams streams.Store, segments segments.Store, pointers pdbclient.Client, access *encryption.Access) *DB {
 	return &DB{
 		buckets:  buckets,
 		streams:  streams,
 		segments: segments,
 		pointers: pointers,
-		rootKey:  rootKey,
+		access:   access,
 	}
 }
 
@@ -6,6 +6,9 @@ package kvmetainfo
 import (
 	"context"
 	"errors"
+	"mime"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -14,7 +17,6 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/memory"
-	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storage/objects"
@@ -63,7 +65,7 @@ func (db *DB) GetObjectStream(ctx context.Context, bucket string, path storj.Pat
 		return nil, err
 	}
 
-	streamKey, err := encryption.DeriveContentKey(meta.fullpath, db.rootKey)
+	streamKey, err := db.access.ContentKey(meta.fullpath)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +91,10 @@ func (db *DB) CreateObject(ctx context.Context, bucket string, path storj.Path,
 		return nil, storj.ErrNoPath.New("")
 	}
 
+	if streams.IsVersionPath(path) {
+		return nil, errClass.New("path %q is reserved for versions", path)
+	}
+
 	info := storj.Object{
 		Bucket: bucketInfo,
 		Path:   path,
@@ -102,8 +108,9 @@ func (db *DB) CreateObject(ctx context.Context, bucket string, path storj.Path,
 		info.EncryptionScheme = createInfo.EncryptionScheme
 	}
 
-	// TODO: autodetect content type from the path extension
-	// if info.ContentType == "" {}
+	if info.ContentType == "" {
+		info.ContentType = mime.TypeByExtension(filepath.Ext(path))
+	}
 
 	if info.RedundancyScheme.IsZero() {
 		info.RedundancyScheme = DefaultRS
@@ -132,14 +139,57 @@ func (db *DB) ModifyObject(ctx context.Context, bucket string, path storj.Path)
 func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
 	store, err := db.buckets.GetObjectStore(ctx, bucket)
 	if err != nil {
 		return err
 	}
 
+	if bucketInfo.Versioning {
+		// the deleted object is kept as a version
+		ctx = streams.WithKeptVersions(ctx)
+	}
+
 	return store.Delete(ctx, path)
 }
 
+// MoveObject moves an object to a new path, replacing the object at the new path
+func (db *DB) MoveObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	newBucketInfo := bucketInfo
+	if newBucket != bucket {
+		newBucketInfo, err = db.GetBucket(ctx, newBucket)
+		if err != nil {
+			return err
+		}
+	}
+
+	if path == "" || newPath == "" {
+		return storj.ErrNoPath.New("")
+	}
+
+	if streams.IsVersionPath(path) || streams.IsVersionPath(newPath) {
+		return errClass.New("paths under %q are reserved for versions", streams.VersionsPrefix)
+	}
+
+	err = db.streams.Move(ctx,
+		storj.JoinPaths(bucket, path), bucketInfo.PathCipher,
+		storj.JoinPaths(newBucket, newPath), newBucketInfo.PathCipher)
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrObjectNotFound.Wrap(err)
+	}
+	return err
+}
+
 // ModifyPendingObject creates an interface for updating a partially uploaded object
 func (db *DB) ModifyPendingObject(ctx context.Context, bucket string, path storj.Path) (object storj.MutableObject, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -161,11 +211,19 @@ func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.List
 		return storj.ObjectList{}, err
 	}
 
+	if options.Delimiter != 0 && options.Delimiter != '/' {
+		return storj.ObjectList{}, errClass.New("unsupported delimiter %q", options.Delimiter)
+	}
+
 	objects, err := db.buckets.GetObjectStore(ctx, bucket)
 	if err != nil {
 		return storj.ObjectList{}, err
 	}
 
+	if options.NamePrefix != "" {
+		return listObjectsWithName(ctx, bucketInfo, objects, options)
+	}
+
 	var startAfter, endBefore string
 	switch options.Direction {
 	case storj.Before:
@@ -202,12 +260,79 @@ func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.List
 	}
 
 	for _, item := range items {
+		if options.Prefix == "" && streams.IsVersionPath(item.Path) {
+			continue
+		}
 		list.Items = append(list.Items, objectFromMeta(bucketInfo, item.Path, item.IsPrefix, item.Meta))
 	}
 
 	return list, nil
 }
 
+// listObjectsWithName lists the items of options.Prefix, which start with
+// options.NamePrefix. The paths are encrypted per component, so the items
+// are filtered while listing and the pages are listed until there are
+// enough items to tell whether there are more.
+func listObjectsWithName(ctx context.Context, bucketInfo storj.Bucket, store objects.Store, options storj.ListOptions) (list storj.ObjectList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var startAfter string
+	switch options.Direction {
+	case storj.Forward:
+		startAfter = keyBefore(options.Cursor)
+	case storj.After:
+		startAfter = options.Cursor
+	default:
+		return storj.ObjectList{}, errClass.New("invalid direction %d for listing with name prefix", options.Direction)
+	}
+
+	// unencrypted paths are listed in order, so the listing can start at
+	// the name prefix and stop after the last path starting with it
+	ordered := bucketInfo.PathCipher == storj.Unencrypted
+	if ordered && startAfter < options.NamePrefix {
+		startAfter = keyBefore(options.NamePrefix)
+	}
+
+	limit := options.Limit
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+
+	list = storj.ObjectList{
+		Bucket: bucketInfo.Name,
+		Prefix: options.Prefix,
+	}
+
+	for {
+		items, more, err := store.List(ctx, options.Prefix, startAfter, "", options.Recursive, limit+1, meta.All)
+		if err != nil {
+			return storj.ObjectList{}, err
+		}
+
+		for _, item := range items {
+			if options.Prefix == "" && streams.IsVersionPath(item.Path) {
+				continue
+			}
+			if !strings.HasPrefix(item.Path, options.NamePrefix) {
+				if ordered && item.Path > options.NamePrefix {
+					return list, nil
+				}
+				continue
+			}
+			if len(list.Items) == limit {
+				list.More = true
+				return list, nil
+			}
+			list.Items = append(list.Items, objectFromMeta(bucketInfo, item.Path, item.IsPrefix, item.Meta))
+		}
+
+		if !more || len(items) == 0 {
+			return list, nil
+		}
+		startAfter = items[len(items)-1].Path
+	}
+}
+
 type object struct {
 	fullpath        string
 	encryptedPath   string
@@ -230,7 +355,7 @@ func (db *DB) getInfo(ctx context.Context, prefix string, bucket string, path st
 
 	fullpath := bucket + "/" + path
 
-	encryptedPath, err := streams.EncryptAfterBucket(fullpath, bucketInfo.PathCipher, db.rootKey)
+	encryptedPath, err := db.access.EncryptPath(fullpath, bucketInfo.PathCipher)
 	if err != nil {
 		return object{}, storj.Object{}, err
 	}
@@ -265,7 +390,7 @@ func (db *DB) getInfo(ctx context.Context, prefix string, bucket string, path st
 		Data:       pointer.GetMetadata(),
 	}
 
-	streamInfoData, err := streams.DecryptStreamInfo(ctx, lastSegmentMeta, fullpath, db.rootKey)
+	streamInfoData, err := streams.DecryptStreamInfo(ctx, lastSegmentMeta, fullpath, db.access)
 	if err != nil {
 		return object{}, storj.Object{}, err
 	}
@@ -303,6 +428,8 @@ func objectFromMeta(bucket storj.Bucket, path storj.Path, isPrefix bool, meta ob
 		Path:     path,
 		IsPrefix: isPrefix,
 
+		VersionID: meta.VersionID,
+
 		Metadata: meta.UserDefined,
 
 		ContentType: meta.ContentType,
@@ -333,6 +460,8 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		Path:     path,
 		IsPrefix: false,
 
+		VersionID: stream.VersionId,
+
 		Metadata: serMetaInfo.UserDefined,
 
 		ContentType: serMetaInfo.ContentType,
@@ -341,8 +470,8 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		Expires:     lastSegment.Expiration, // TODO: use correct field
 
 		Stream: storj.Stream{
-			Size: stream.SegmentsSize*(stream.NumberOfSegments-1) + stream.LastSegmentSize,
-			// Checksum: []byte(object.Checksum),
+			Size:     stream.SegmentsSize*(stream.NumberOfSegments-1) + stream.LastSegmentSize,
+			Checksum: stream.Etag,
 
 			SegmentCount:     stream.NumberOfSegments,
 			FixedSegmentSize: stream.SegmentsSize,
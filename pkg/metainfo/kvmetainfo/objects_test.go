@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	mathrand "math/rand"
+	"sort"
 	"testing"
 	"time"
 
@@ -118,6 +119,63 @@ func TestGetObject(t *testing.T) {
 	})
 }
 
+func TestObjectMetadata(t *testing.T) {
+	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		metadata := map[string]string{"key1": "value1", "key2": "value2"}
+		obj, err := db.CreateObject(ctx, bucket.Name, TestFile, &storj.CreateObject{
+			ContentType: "application/x-test",
+			Metadata:    metadata,
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		str, err := obj.CreateStream(ctx)
+		if !assert.NoError(t, err) {
+			return
+		}
+		writer := stream.NewUpload(ctx, str, streams)
+		_, err = writer.Write([]byte("test"))
+		assert.NoError(t, err)
+		assert.NoError(t, writer.Close())
+		if !assert.NoError(t, obj.Commit(ctx)) {
+			return
+		}
+
+		// the metadata is returned by stat, download and list
+		object, err := db.GetObject(ctx, bucket.Name, TestFile)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "application/x-test", object.ContentType)
+			assert.Equal(t, metadata, object.Metadata)
+		}
+
+		readOnly, err := db.GetObjectStream(ctx, bucket.Name, TestFile)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "application/x-test", readOnly.Info().ContentType)
+			assert.Equal(t, metadata, readOnly.Info().Metadata)
+		}
+
+		list, err := db.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+			assert.Equal(t, "application/x-test", list.Items[0].ContentType)
+			assert.Equal(t, metadata, list.Items[0].Metadata)
+		}
+
+		// without a content type, it's detected from the extension
+		upload(ctx, t, db, streams, bucket, "file.html", nil)
+		object, err = db.GetObject(ctx, bucket.Name, "file.html")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "text/html; charset=utf-8", object.ContentType)
+			assert.Empty(t, object.Metadata)
+		}
+	})
+}
+
 func TestGetObjectStream(t *testing.T) {
 	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
 		// we wait a second for all the nodes to complete bootstrapping off the satellite
@@ -293,6 +351,64 @@ func TestDeleteObject(t *testing.T) {
 	})
 }
 
+func TestMoveObject(t *testing.T) {
+	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
+		data := make([]byte, 32*memory.KB)
+		_, err := rand.Read(data)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		otherBucket, err := db.CreateBucket(ctx, "other-bucket", &storj.Bucket{PathCipher: storj.SecretBox})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		upload(ctx, t, db, streams, bucket, "small-file", []byte("test"))
+		upload(ctx, t, db, streams, bucket, "large-file", data)
+		upload(ctx, t, db, streams, bucket, "replaced-file", []byte("replaced"))
+
+		err = db.MoveObject(ctx, "", "", bucket.Name, "moved-file")
+		assert.True(t, storj.ErrNoBucket.Has(err))
+
+		err = db.MoveObject(ctx, bucket.Name, "small-file", bucket.Name, "")
+		assert.True(t, storj.ErrNoPath.Has(err))
+
+		err = db.MoveObject(ctx, bucket.Name, "small-file", "non-existing-bucket", "moved-file")
+		assert.True(t, storj.ErrBucketNotFound.Has(err))
+
+		err = db.MoveObject(ctx, bucket.Name, "non-existing-file", bucket.Name, "moved-file")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		// the moved object replaces the object at the new path
+		err = db.MoveObject(ctx, bucket.Name, "large-file", bucket.Name, "replaced-file")
+		if !assert.NoError(t, err) {
+			return
+		}
+		_, err = db.GetObject(ctx, bucket.Name, "large-file")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+		assertStream(ctx, t, db, streams, bucket, "replaced-file", 32*memory.KB.Int64(), data)
+
+		// objects are moved between buckets with different path ciphers
+		err = db.MoveObject(ctx, bucket.Name, "small-file", otherBucket.Name, "moved/small-file")
+		if !assert.NoError(t, err) {
+			return
+		}
+		_, err = db.GetObject(ctx, bucket.Name, "small-file")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		object, err := db.GetObject(ctx, otherBucket.Name, "moved/small-file")
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(4), object.Size)
+			assert.Equal(t, storj.SecretBox, object.Bucket.PathCipher)
+		}
+	})
+}
+
 func TestListObjectsEmpty(t *testing.T) {
 	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
 		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
@@ -652,6 +768,26 @@ func TestListObjects(t *testing.T) {
 				options: options("a/", "xbb", storj.Before, 2),
 				more:    true,
 				result:  []string{"xaa", "xb"},
+			}, {
+				options: storj.ListOptions{NamePrefix: "a", Direction: storj.After},
+				result:  []string{"a", "a/", "aa"},
+			}, {
+				options: storj.ListOptions{NamePrefix: "a", Direction: storj.Forward, Limit: 2},
+				more:    true,
+				result:  []string{"a", "a/"},
+			}, {
+				options: storj.ListOptions{NamePrefix: "a", Cursor: "a/", Direction: storj.After, Limit: 1},
+				result:  []string{"aa"},
+			}, {
+				options: storj.ListOptions{Prefix: "a/", NamePrefix: "xb", Direction: storj.Forward},
+				result:  []string{"xb", "xbb"},
+			}, {
+				options: storj.ListOptions{NamePrefix: "b/y", Recursive: true, Direction: storj.After, Limit: 3},
+				more:    true,
+				result:  []string{"b/ya", "b/yaa", "b/yb"},
+			}, {
+				options: storj.ListOptions{NamePrefix: "d", Direction: storj.After},
+				result:  []string{},
 			},
 		} {
 			errTag := fmt.Sprintf("%d. %+v", i, tt)
@@ -660,6 +796,7 @@ func TestListObjects(t *testing.T) {
 
 			if assert.NoError(t, err, errTag) {
 				assert.Equal(t, tt.more, list.More, errTag)
+				assert.Equal(t, len(tt.result), len(list.Items), errTag)
 				for i, item := range list.Items {
 					assert.Equal(t, tt.result[i], item.Path, errTag)
 					assert.Equal(t, TestBucket, item.Bucket.Name, errTag)
@@ -667,6 +804,39 @@ func TestListObjects(t *testing.T) {
 				}
 			}
 		}
+
+		_, err = db.ListObjects(ctx, bucket.Name, storj.ListOptions{Delimiter: '#', Direction: storj.After})
+		assert.Error(t, err)
+
+		_, err = db.ListObjects(ctx, bucket.Name, storj.ListOptions{NamePrefix: "a", Direction: storj.Before})
+		assert.Error(t, err)
+
+		// the names in encrypted buckets are filtered while listing
+		encrypted, err := db.CreateBucket(ctx, "encrypted", &storj.Bucket{PathCipher: storj.AESGCM})
+		if !assert.NoError(t, err) {
+			return
+		}
+		for _, path := range filePaths {
+			upload(ctx, t, db, streams, encrypted, path, nil)
+		}
+
+		var names []string
+		opts := storj.ListOptions{NamePrefix: "a/x", Recursive: true, Direction: storj.After, Limit: 2}
+		for {
+			list, err := db.ListObjects(ctx, encrypted.Name, opts)
+			if !assert.NoError(t, err) {
+				return
+			}
+			for _, item := range list.Items {
+				names = append(names, item.Path)
+			}
+			if !list.More {
+				break
+			}
+			opts = opts.NextPage(list)
+		}
+		sort.Strings(names)
+		assert.Equal(t, []string{"a/xa", "a/xaa", "a/xb", "a/xbb", "a/xc"}, names)
 	})
 }
 
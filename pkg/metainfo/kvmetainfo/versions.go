@@ -0,0 +1,152 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// ListObjectVersions lists the versions of the object at path, which were
+// kept when it was replaced or deleted in a versioned bucket. The newest
+// version is listed first, the current object isn't listed.
+func (db *DB) ListObjectVersions(ctx context.Context, bucket string, path storj.Path) (versions []storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if path == "" {
+		return nil, storj.ErrNoPath.New("")
+	}
+
+	store, err := db.buckets.GetObjectStore(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := storj.JoinPaths(streams.VersionsPrefix, path)
+
+	startAfter := ""
+	for {
+		items, more, err := store.List(ctx, prefix, startAfter, "", false, storage.LookupLimit, meta.All)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			// prefixes are the versions of the objects below path
+			if item.IsPrefix {
+				continue
+			}
+			version := objectFromMeta(bucketInfo, path, false, item.Meta)
+			version.VersionID = item.Path
+			versions = append(versions, version)
+		}
+
+		if !more || len(items) == 0 {
+			break
+		}
+		startAfter = items[len(items)-1].Path
+	}
+
+	sort.SliceStable(versions, func(i, k int) bool {
+		return versions[i].Modified.After(versions[k].Modified)
+	})
+
+	return versions, nil
+}
+
+// GetObjectVersion returns information about the version versionID of the
+// object at path
+func (db *DB) GetObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versionPath, err := objectVersionPath(path, versionID)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	_, info, err = db.getInfo(ctx, committedPrefix, bucket, versionPath)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	info.Path = path
+	info.VersionID = versionID
+	return info, nil
+}
+
+// GetObjectVersionStream returns interface for reading the stream of the
+// version versionID of the object at path
+func (db *DB) GetObjectVersionStream(ctx context.Context, bucket string, path storj.Path, versionID string) (stream storj.ReadOnlyStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versionPath, err := objectVersionPath(path, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, info, err := db.getInfo(ctx, committedPrefix, bucket, versionPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// the segment keys of a version are encrypted for the path it's kept at
+	streamKey, err := db.access.ContentKey(meta.fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	info.Path = path
+	info.VersionID = versionID
+	return &readonlyStream{
+		db:            db,
+		info:          info,
+		encryptedPath: meta.encryptedPath,
+		streamKey:     streamKey,
+	}, nil
+}
+
+// PurgeObjectVersion permanently deletes the version versionID of the object
+// at path
+func (db *DB) PurgeObjectVersion(ctx context.Context, bucket string, path storj.Path, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	versionPath, err := objectVersionPath(path, versionID)
+	if err != nil {
+		return err
+	}
+
+	err = db.streams.Delete(ctx, storj.JoinPaths(bucket, versionPath), bucketInfo.PathCipher)
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrObjectNotFound.Wrap(err)
+	}
+	return err
+}
+
+// objectVersionPath returns the path in the bucket the version versionID of
+// the object at path is kept at
+func objectVersionPath(path storj.Path, versionID string) (storj.Path, error) {
+	if path == "" {
+		return "", storj.ErrNoPath.New("")
+	}
+	if versionID == "" || strings.Contains(versionID, "/") {
+		return "", errClass.New("invalid version id %q", versionID)
+	}
+	return storj.JoinPaths(streams.VersionsPrefix, path, versionID), nil
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo_test
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/storage/buckets"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+func TestObjectVersions(t *testing.T) {
+	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, store streams.Store) {
+		bucket, err := db.CreateBucket(ctx, TestBucket, &storj.Bucket{PathCipher: storj.AESGCM, Versioning: true})
+		require.NoError(t, err)
+		assert.True(t, bucket.Versioning)
+
+		upload(ctx, t, db, store, bucket, TestFile, []byte("first"))
+		first, err := db.GetObject(ctx, bucket.Name, TestFile)
+		require.NoError(t, err)
+		assert.NotEmpty(t, first.VersionID)
+
+		// replacing the object keeps the first upload as a version
+		upload(ctx, t, db, store, bucket, TestFile, []byte("second"))
+		second, err := db.GetObject(ctx, bucket.Name, TestFile)
+		require.NoError(t, err)
+		assert.NotEqual(t, first.VersionID, second.VersionID)
+
+		// deleting the object keeps it as a version too
+		require.NoError(t, db.DeleteObject(ctx, bucket.Name, TestFile))
+		_, err = db.GetObject(ctx, bucket.Name, TestFile)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		versions, err := db.ListObjectVersions(ctx, bucket.Name, TestFile)
+		require.NoError(t, err)
+		ids := []string{}
+		for _, version := range versions {
+			assert.Equal(t, TestFile, version.Path)
+			ids = append(ids, version.VersionID)
+		}
+		assert.ElementsMatch(t, []string{first.VersionID, second.VersionID}, ids)
+
+		for id, content := range map[string]string{first.VersionID: "first", second.VersionID: "second"} {
+			version, err := db.GetObjectVersion(ctx, bucket.Name, TestFile, id)
+			require.NoError(t, err)
+			assert.Equal(t, id, version.VersionID)
+			assert.EqualValues(t, len(content), version.Size)
+
+			readOnly, err := db.GetObjectVersionStream(ctx, bucket.Name, TestFile, id)
+			require.NoError(t, err)
+			download := stream.NewDownload(ctx, readOnly, store)
+			data, err := ioutil.ReadAll(download)
+			assert.NoError(t, err)
+			assert.NoError(t, download.Close())
+			assert.Equal(t, content, string(data))
+		}
+
+		// the versions aren't listed with the objects of the bucket
+		list, err := db.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After})
+		require.NoError(t, err)
+		assert.Empty(t, list.Items)
+
+		_, err = db.CreateObject(ctx, bucket.Name, streams.VersionsPrefix+"/"+TestFile, nil)
+		assert.Error(t, err)
+
+		require.NoError(t, db.PurgeObjectVersion(ctx, bucket.Name, TestFile, first.VersionID))
+		_, err = db.GetObjectVersion(ctx, bucket.Name, TestFile, first.VersionID)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+		err = db.PurgeObjectVersion(ctx, bucket.Name, TestFile, first.VersionID)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		versions, err = db.ListObjectVersions(ctx, bucket.Name, TestFile)
+		require.NoError(t, err)
+		if assert.Len(t, versions, 1) {
+			assert.Equal(t, second.VersionID, versions[0].VersionID)
+		}
+	})
+}
+
+func TestSetBucketVersioning(t *testing.T) {
+	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, store streams.Store) {
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+		assert.False(t, bucket.Versioning)
+
+		upload(ctx, t, db, store, bucket, TestFile, []byte("unversioned"))
+
+		require.NoError(t, db.SetBucketVersioning(ctx, bucket.Name, true))
+		versioned, err := db.GetBucket(ctx, bucket.Name)
+		require.NoError(t, err)
+		assert.True(t, versioned.Versioning)
+		assert.Equal(t, bucket.Created.Unix(), versioned.Created.Unix())
+
+		// objects uploaded without versioning are kept as the null version
+		require.NoError(t, db.DeleteObject(ctx, bucket.Name, TestFile))
+		version, err := db.GetObjectVersion(ctx, bucket.Name, TestFile, streams.NullVersionID)
+		require.NoError(t, err)
+		assert.EqualValues(t, len("unversioned"), version.Size)
+
+		require.NoError(t, db.SetBucketVersioning(ctx, bucket.Name, false))
+		upload(ctx, t, db, store, bucket, "other", nil)
+		require.NoError(t, db.DeleteObject(ctx, bucket.Name, "other"))
+		versions, err := db.ListObjectVersions(ctx, bucket.Name, "other")
+		require.NoError(t, err)
+		assert.Empty(t, versions)
+	})
+}
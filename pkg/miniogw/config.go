@@ -6,16 +6,23 @@ package miniogw
 import (
 	"context"
 	"errors"
+	"math"
+	"net"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/minio/cli"
 	minio "github.com/minio/minio/cmd"
 	"github.com/vivint/infectious"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/overlay"
@@ -25,6 +32,7 @@ import (
 	"storj.io/storj/pkg/storage/segments"
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
 )
 
 // RSConfig is a configuration struct that keeps details about default
@@ -36,15 +44,77 @@ type RSConfig struct {
 	RepairThreshold  int         `help:"the minimum safe pieces before a repair is triggered. m." default:"35"`
 	SuccessThreshold int         `help:"the desired total pieces for a segment. o." default:"80"`
 	MaxThreshold     int         `help:"the largest amount of pieces to encode to. n." default:"95"`
+
+	OverProvisioning float64 `help:"how many times the success threshold of nodes new uploads are dialed to, replacing max-threshold. 0 uses max-threshold" default:"0"`
+	LongTailMargin   float64 `help:"how many times the time it took to reach the repair threshold uploads wait for the success threshold, before canceling the slowest piece uploads" default:"1.5"`
+}
+
+// TotalCount returns the number of pieces new uploads are encoded to, and
+// the number of nodes they are dialed to
+func (c RSConfig) TotalCount() int {
+	if c.OverProvisioning <= 0 {
+		return c.MaxThreshold
+	}
+	return int(math.Ceil(float64(c.SuccessThreshold) * c.OverProvisioning))
 }
 
 // EncryptionConfig is a configuration struct that keeps details about
 // encrypting segments
 type EncryptionConfig struct {
-	Key       string      `help:"root key for encrypting the data"`
-	BlockSize memory.Size `help:"size (in bytes) of encrypted blocks" default:"1KiB"`
-	DataType  int         `help:"Type of encryption to use for content and metadata (1=AES-GCM, 2=SecretBox)" default:"1"`
-	PathType  int         `help:"Type of encryption to use for paths (0=Unencrypted, 1=AES-GCM, 2=SecretBox)" default:"1"`
+	Key        string      `help:"root key for encrypting the data"`
+	BucketKeys string      `help:"comma separated bucket:passphrase pairs of the buckets encrypted with their own passphrases instead of the root key" default:""`
+	BlockSize  memory.Size `help:"size (in bytes) of encrypted blocks" default:"1KiB"`
+	DataType   int         `help:"Type of encryption to use for content and metadata (1=AES-GCM, 2=SecretBox)" default:"1"`
+	PathType   int         `help:"Type of encryption to use for paths (0=Unencrypted, 1=AES-GCM, 2=SecretBox)" default:"1"`
+
+	AutoDataType bool `help:"use SecretBox instead of AES-GCM for content and metadata on hardware without AES acceleration" default:"true"`
+
+	// PrefixKeys are the keys of the paths of the configured scope, which
+	// are encrypted with their own keys
+	PrefixKeys []encryption.PrefixKey `internal:"true"`
+}
+
+// Access returns the encryption access of the root key, the bucket keys
+// and the prefix keys. Without a root key, only the paths of the bucket and
+// prefix keys can be accessed.
+func (c EncryptionConfig) Access() (*encryption.Access, error) {
+	var root *storj.Key
+	if c.Key != "" || (c.BucketKeys == "" && len(c.PrefixKeys) == 0) {
+		root = encryption.KeyFromPassphrase(c.Key)
+	}
+	access := encryption.NewAccess(root)
+
+	for _, bucketKey := range strings.Split(c.BucketKeys, ",") {
+		if bucketKey == "" {
+			continue
+		}
+		split := strings.Index(bucketKey, ":")
+		if split <= 0 || split == len(bucketKey)-1 {
+			return nil, encryption.ErrInvalidConfig.New("bucket keys must be bucket:passphrase pairs")
+		}
+		err := access.AddBucket(bucketKey[:split], encryption.KeyFromPassphrase(bucketKey[split+1:]))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, prefixKey := range c.PrefixKeys {
+		if err := access.Add(prefixKey); err != nil {
+			return nil, err
+		}
+	}
+	return access, nil
+}
+
+// DataCipher returns the cipher for the content and metadata of new uploads,
+// AES-GCM is replaced by SecretBox on hardware without AES acceleration
+// unless AutoDataType is disabled
+func (c EncryptionConfig) DataCipher() storj.Cipher {
+	cipher := storj.Cipher(c.DataType)
+	if !c.AutoDataType {
+		return cipher
+	}
+	return encryption.RecommendedCipher(cipher)
 }
 
 // MinioConfig is a configuration struct that keeps details about starting
@@ -53,6 +123,8 @@ type MinioConfig struct {
 	AccessKey string `help:"Minio Access Key to use" default:"insecure-dev-access-key"`
 	SecretKey string `help:"Minio Secret Key to use" default:"insecure-dev-secret-key"`
 	Dir       string `help:"Minio generic server config path" default:"$CONFDIR/minio"`
+
+	Versioning bool `help:"generate a version ID for every uploaded object and keep replaced and deleted objects as versions in the buckets created" default:"false"`
 }
 
 // ClientConfig is a configuration struct for the miniogw that controls how
@@ -62,9 +134,40 @@ type ClientConfig struct {
 	OverlayAddr   string `help:"Address to contact overlay server through"`
 	PointerDBAddr string `help:"Address to contact pointerdb server through"`
 
-	APIKey        string      `help:"API Key (TODO: this needs to change to macaroons somehow)"`
+	APIKey        string      `help:"API Key, either a key of the satellite or a key restricted with uplink share"`
+	Scope         string      `help:"scope created with uplink share, replaces the satellite addresses, the api key and the encryption key" default:""`
 	MaxInlineSize memory.Size `help:"max inline segment size in bytes" default:"4KiB"`
 	SegmentSize   memory.Size `help:"the size of a segment in bytes" default:"64MiB"`
+
+	DownloadParallelism int `help:"number of segments downloaded concurrently, each is buffered in memory" default:"1"`
+
+	MaxUploadRate   memory.Size `help:"bytes per second all uploads together may send to the storage nodes, 0 doesn't limit the rate" default:"0"`
+	MaxDownloadRate memory.Size `help:"bytes per second all downloads together may receive from the storage nodes, 0 doesn't limit the rate" default:"0"`
+
+	DNSCacheTTL time.Duration `help:"how long resolved addresses are cached, 0 disables caching" default:"1m0s"`
+	SatelliteIP string        `help:"IP address to dial the satellite at instead of resolving the overlay and pointerdb addresses" default:""`
+
+	PartnerID string `help:"the partner the buckets created are attributed to, like the application or user agent they are created with" default:""`
+
+	Retry transport.RetryConfig
+}
+
+// Resolver returns the resolver the uplink dials through
+func (c ClientConfig) Resolver() (*transport.Resolver, error) {
+	pins := make(map[string]string)
+	if c.SatelliteIP != "" {
+		if net.ParseIP(c.SatelliteIP) == nil {
+			return nil, Error.New("invalid satellite IP address %q", c.SatelliteIP)
+		}
+		for _, address := range []string{c.OverlayAddr, c.PointerDBAddr} {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			pins[host] = c.SatelliteIP
+		}
+	}
+	return transport.NewResolver(c.DNSCacheTTL, pins), nil
 }
 
 // ServerConfig determines how minio listens for requests
@@ -135,6 +238,11 @@ func (c Config) action(ctx context.Context, cliCtx *cli.Context, identity *ident
 func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity) (db storj.Metainfo, ss streams.Store, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	c, err = c.WithScope()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if c.Client.OverlayAddr == "" || c.Client.PointerDBAddr == "" {
 		var errlist errs.Group
 		if c.Client.OverlayAddr == "" {
@@ -146,18 +254,29 @@ func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity
 		return nil, nil, errlist.Err()
 	}
 
-	oc, err := overlay.NewClient(identity, c.Client.OverlayAddr)
+	resolver, err := c.Client.Resolver()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	oc, err := overlay.NewClientContext(ctx, identity, c.Client.OverlayAddr, resolver.DialOption())
 	if err != nil {
 		return nil, nil, Error.New("failed to connect to overlay: %v", err)
 	}
 
-	pdb, err := pdbclient.NewClient(identity, c.Client.PointerDBAddr, c.Client.APIKey)
+	pdb, err := pdbclient.NewClientContext(ctx, identity, c.Client.PointerDBAddr, c.Client.APIKey, resolver.DialOption())
 	if err != nil {
 		return nil, nil, Error.New("failed to connect to pointer DB: %v", err)
 	}
 
-	ec := ecclient.NewClient(identity, c.RS.MaxBufferMem.Int())
-	fc, err := infectious.NewFEC(c.RS.MinThreshold, c.RS.MaxThreshold)
+	tc := transport.NewClientWithRetries(identity, []grpc.DialOption{resolver.DialOption()}, transport.NewRetryPolicy(c.Client.Retry))
+	ec := ecclient.NewClientFromTransport(tc, c.RS.MaxBufferMem.Int(),
+		sync2.NewRateLimiter(c.Client.MaxUploadRate.Int64()), sync2.NewRateLimiter(c.Client.MaxDownloadRate.Int64()),
+		c.RS.LongTailMargin)
+	if c.RS.TotalCount() < c.RS.SuccessThreshold {
+		return nil, nil, Error.New("uploads have to be dialed to at least the success threshold of nodes")
+	}
+	fc, err := infectious.NewFEC(c.RS.MinThreshold, c.RS.TotalCount())
 	if err != nil {
 		return nil, nil, Error.New("failed to create erasure coding client: %v", err)
 	}
@@ -173,17 +292,28 @@ func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity
 		return nil, nil, err
 	}
 
-	key := new(storj.Key)
-	copy(key[:], c.Enc.Key)
+	access, err := c.Enc.Access()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataCipher := c.Enc.DataCipher()
+	if storj.Cipher(c.Enc.DataType) == storj.AESGCM && !encryption.HasAESAcceleration() {
+		if dataCipher == storj.AESGCM {
+			zap.S().Warn("AES-GCM is not accelerated on this hardware, uploads are much faster with SecretBox (--enc.data-type 2)")
+		} else {
+			zap.S().Info("Using SecretBox for uploads since AES-GCM is not accelerated on this hardware")
+		}
+	}
 
-	streams, err := streams.NewStreamStore(segments, c.Client.SegmentSize.Int64(), key, c.Enc.BlockSize.Int(), storj.Cipher(c.Enc.DataType))
+	streams, err := streams.NewStreamStore(segments, c.Client.SegmentSize.Int64(), access, c.Enc.BlockSize.Int(), dataCipher)
 	if err != nil {
 		return nil, nil, Error.New("failed to create stream store: %v", err)
 	}
 
 	buckets := buckets.NewStore(streams)
 
-	return kvmetainfo.New(buckets, streams, segments, pdb, key), streams, nil
+	return kvmetainfo.New(buckets, streams, segments, pdb, access), streams, nil
 }
 
 // GetRedundancyScheme returns the configured redundancy scheme for new uploads
@@ -193,14 +323,14 @@ func (c Config) GetRedundancyScheme() storj.RedundancyScheme {
 		RequiredShares: int16(c.RS.MinThreshold),
 		RepairShares:   int16(c.RS.RepairThreshold),
 		OptimalShares:  int16(c.RS.SuccessThreshold),
-		TotalShares:    int16(c.RS.MaxThreshold),
+		TotalShares:    int16(c.RS.TotalCount()),
 	}
 }
 
 // GetEncryptionScheme returns the configured encryption scheme for new uploads
 func (c Config) GetEncryptionScheme() storj.EncryptionScheme {
 	return storj.EncryptionScheme{
-		Cipher:    storj.Cipher(c.Enc.DataType),
+		Cipher:    c.Enc.DataCipher(),
 		BlockSize: int32(c.Enc.BlockSize),
 	}
 }
@@ -214,5 +344,9 @@ func (c Config) NewGateway(ctx context.Context, identity *identity.FullIdentity)
 		return nil, err
 	}
 
-	return NewStorjGateway(metainfo, streams, storj.Cipher(c.Enc.PathType), c.GetEncryptionScheme(), c.GetRedundancyScheme()), nil
+	gateway := NewStorjGateway(metainfo, streams, storj.Cipher(c.Enc.PathType), c.GetEncryptionScheme(), c.GetRedundancyScheme())
+	gateway.Versioning = c.Minio.Versioning
+	gateway.PartnerID = c.Client.PartnerID
+	gateway.DownloadParallelism = c.Client.DownloadParallelism
+	return gateway, nil
 }
@@ -0,0 +1,41 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRSConfigOverProvisioning(t *testing.T) {
+	for i, tt := range []struct {
+		overProvisioning float64
+		total            int
+	}{
+		{0, 95},     // max-threshold is used
+		{-1, 95},    // so is it for negative values
+		{1, 80},     // just the success threshold
+		{1.25, 100}, // more than max-threshold
+		{1.01, 81},  // partial nodes are rounded up
+		{1.125, 90}, // exact products aren't rounded
+		{0.5, 40},   // rejected by GetMetainfo
+	} {
+		config := Config{RS: RSConfig{
+			MinThreshold:     29,
+			RepairThreshold:  35,
+			SuccessThreshold: 80,
+			MaxThreshold:     95,
+			OverProvisioning: tt.overProvisioning,
+		}}
+
+		assert.Equal(t, tt.total, config.RS.TotalCount(), "#%d", i)
+
+		scheme := config.GetRedundancyScheme()
+		assert.EqualValues(t, 29, scheme.RequiredShares, "#%d", i)
+		assert.EqualValues(t, 35, scheme.RepairShares, "#%d", i)
+		assert.EqualValues(t, 80, scheme.OptimalShares, "#%d", i)
+		assert.EqualValues(t, tt.total, scheme.TotalShares, "#%d", i)
+	}
+}
@@ -28,6 +28,9 @@ var (
 	Error = errs.Class("Storj Gateway error")
 )
 
+// versionIDKey is the metadata key under which the version ID of an object is returned
+const versionIDKey = "x-amz-version-id"
+
 // NewStorjGateway creates a *Storj object from an existing ObjectStore
 func NewStorjGateway(metainfo storj.Metainfo, streams streams.Store, pathCipher storj.Cipher, encryption storj.EncryptionScheme, redundancy storj.RedundancyScheme) *Gateway {
 	return &Gateway{
@@ -48,6 +51,15 @@ type Gateway struct {
 	encryption storj.EncryptionScheme
 	redundancy storj.RedundancyScheme
 	multipart  *MultipartUploads
+
+	// Versioning makes every upload generate a new version ID, and the
+	// buckets created keep replaced and deleted objects as versions, like
+	// S3 buckets with versioning enabled
+	Versioning bool
+	// PartnerID is the partner the buckets created are attributed to
+	PartnerID string
+	// DownloadParallelism is the number of segments downloaded concurrently
+	DownloadParallelism int
 }
 
 // Name implements cmd.Gateway
@@ -115,6 +127,11 @@ func (layer *gatewayLayer) GetObject(ctx context.Context, bucket, object string,
 		return convertError(err, bucket, object)
 	}
 
+	// the object changed since minio got its info, which the etag comes from
+	if checksum := readOnlyStream.Info().Checksum; etag != "" && len(checksum) > 0 && etag != hex.EncodeToString(checksum) {
+		return minio.InvalidETag{}
+	}
+
 	if startOffset < 0 || length < -1 || startOffset+length > readOnlyStream.Info().Size {
 		return minio.InvalidRange{
 			OffsetBegin:  startOffset,
@@ -123,20 +140,19 @@ func (layer *gatewayLayer) GetObject(ctx context.Context, bucket, object string,
 		}
 	}
 
-	download := stream.NewDownload(ctx, readOnlyStream, layer.gateway.streams)
-	defer func() { err = errs.Combine(err, download.Close()) }()
+	if length == -1 {
+		length = readOnlyStream.Info().Size - startOffset
+	}
 
-	_, err = download.Seek(startOffset, io.SeekStart)
+	// only the segments which overlap the range are downloaded
+	ctx = streams.WithDownloadParallelism(ctx, layer.gateway.DownloadParallelism)
+	download, err := stream.NewDownloadRange(ctx, readOnlyStream, layer.gateway.streams, startOffset, length)
 	if err != nil {
 		return err
 	}
+	defer func() { err = errs.Combine(err, download.Close()) }()
 
-	if length == -1 {
-		_, err = io.Copy(writer, download)
-	} else {
-		_, err = io.CopyN(writer, download, length)
-	}
-
+	_, err = io.Copy(writer, download)
 	return err
 }
 
@@ -155,7 +171,7 @@ func (layer *gatewayLayer) GetObjectInfo(ctx context.Context, bucket, object str
 		Size:        obj.Size,
 		ETag:        hex.EncodeToString(obj.Checksum),
 		ContentType: obj.ContentType,
-		UserDefined: obj.Metadata,
+		UserDefined: userDefined(obj),
 	}, err
 }
 
@@ -191,60 +207,23 @@ func (layer *gatewayLayer) ListObjects(ctx context.Context, bucket, prefix, mark
 		return minio.ListObjectsInfo{}, minio.UnsupportedDelimiter{Delimiter: delimiter}
 	}
 
-	startAfter := marker
-	recursive := delimiter == ""
-
-	var objects []minio.ObjectInfo
-	var prefixes []string
-
-	list, err := layer.gateway.metainfo.ListObjects(ctx, bucket, storj.ListOptions{
-		Direction: storj.After,
-		Cursor:    startAfter,
-		Prefix:    prefix,
-		Recursive: recursive,
-		Limit:     maxKeys,
-	})
+	objects, prefixes, more, next, err := layer.listObjects(ctx, bucket, prefix, marker, delimiter, maxKeys)
 	if err != nil {
 		return result, convertError(err, bucket, "")
 	}
 
-	if len(list.Items) > 0 {
-		for _, item := range list.Items {
-			path := item.Path
-			if recursive && prefix != "" {
-				path = storj.JoinPaths(strings.TrimSuffix(prefix, "/"), path)
-			}
-			if item.IsPrefix {
-				prefixes = append(prefixes, path)
-				continue
-			}
-			objects = append(objects, minio.ObjectInfo{
-				Bucket:      bucket,
-				IsDir:       false,
-				Name:        path,
-				ModTime:     item.Modified,
-				Size:        item.Size,
-				ETag:        hex.EncodeToString(item.Checksum),
-				ContentType: item.ContentType,
-				UserDefined: item.Metadata,
-			})
-		}
-		startAfter = list.Items[len(list.Items)-1].Path
-	}
-
 	result = minio.ListObjectsInfo{
-		IsTruncated: list.More,
+		IsTruncated: more,
 		Objects:     objects,
 		Prefixes:    prefixes,
 	}
-	if list.More {
-		result.NextMarker = startAfter
+	if more {
+		result.NextMarker = next
 	}
 
 	return result, err
 }
 
-// ListObjectsV2 - Not implemented stub
 func (layer *gatewayLayer) ListObjectsV2(ctx context.Context, bucket, prefix, continuationToken, delimiter string, maxKeys int, fetchOwner bool, startAfter string) (result minio.ListObjectsV2Info, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -252,69 +231,93 @@ func (layer *gatewayLayer) ListObjectsV2(ctx context.Context, bucket, prefix, co
 		return minio.ListObjectsV2Info{ContinuationToken: continuationToken}, minio.UnsupportedDelimiter{Delimiter: delimiter}
 	}
 
-	recursive := delimiter == ""
-	var nextContinuationToken string
-
-	var startAfterPath storj.Path
+	// the continuation token is the full path of the last listed item
+	startAfterPath := startAfter
 	if continuationToken != "" {
 		startAfterPath = continuationToken
 	}
-	if startAfterPath == "" && startAfter != "" {
-		startAfterPath = startAfter
-	}
-
-	var objects []minio.ObjectInfo
-	var prefixes []string
 
-	list, err := layer.gateway.metainfo.ListObjects(ctx, bucket, storj.ListOptions{
-		Direction: storj.After,
-		Cursor:    startAfterPath,
-		Prefix:    prefix,
-		Recursive: recursive,
-		Limit:     maxKeys,
-	})
+	objects, prefixes, more, next, err := layer.listObjects(ctx, bucket, prefix, startAfterPath, delimiter, maxKeys)
 	if err != nil {
 		return minio.ListObjectsV2Info{ContinuationToken: continuationToken}, convertError(err, bucket, "")
 	}
 
-	if len(list.Items) > 0 {
-		for _, item := range list.Items {
-			path := item.Path
-			if recursive && prefix != "" {
-				path = storj.JoinPaths(strings.TrimSuffix(prefix, "/"), path)
-			}
-			if item.IsPrefix {
-				prefixes = append(prefixes, path)
-				continue
-			}
-			objects = append(objects, minio.ObjectInfo{
-				Bucket:      bucket,
-				IsDir:       false,
-				Name:        path,
-				ModTime:     item.Modified,
-				Size:        item.Size,
-				ETag:        hex.EncodeToString(item.Checksum),
-				ContentType: item.ContentType,
-				UserDefined: item.Metadata,
-			})
-		}
-
-		nextContinuationToken = list.Items[len(list.Items)-1].Path + "\x00"
-	}
-
 	result = minio.ListObjectsV2Info{
-		IsTruncated:       list.More,
+		IsTruncated:       more,
 		ContinuationToken: continuationToken,
 		Objects:           objects,
 		Prefixes:          prefixes,
 	}
-	if list.More {
-		result.NextContinuationToken = nextContinuationToken
+	if more {
+		result.NextContinuationToken = next
 	}
 
 	return result, err
 }
 
+// listObjects lists the objects and, with a delimiter, the common prefixes
+// of bucket, which start with prefix and come after startAfter. The paths
+// are encrypted per component, so the directory part of prefix is listed
+// and the items in it are filtered by the rest of prefix. The returned
+// names are full paths and next is the full path of the last item.
+func (layer *gatewayLayer) listObjects(ctx context.Context, bucket, prefix, startAfter, delimiter string, maxKeys int) (objects []minio.ObjectInfo, prefixes []string, more bool, next string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dir, name := "", prefix
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir, name = prefix[:i+1], prefix[i+1:]
+	}
+
+	// the cursor is relative to the listed directory
+	var cursor string
+	switch {
+	case strings.HasPrefix(startAfter, dir):
+		cursor = startAfter[len(dir):]
+	case startAfter > dir:
+		// all the paths starting with prefix come before startAfter
+		if _, err = layer.gateway.metainfo.GetBucket(ctx, bucket); err != nil {
+			return nil, nil, false, "", err
+		}
+		return nil, nil, false, "", nil
+	}
+
+	list, err := layer.gateway.metainfo.ListObjects(ctx, bucket, storj.ListOptions{
+		Direction:  storj.After,
+		Cursor:     cursor,
+		Prefix:     dir,
+		NamePrefix: name,
+		Recursive:  delimiter == "",
+		Limit:      maxKeys,
+	})
+	if err != nil {
+		return nil, nil, false, "", err
+	}
+
+	for _, item := range list.Items {
+		path := dir + item.Path
+		if item.IsPrefix {
+			prefixes = append(prefixes, path)
+			continue
+		}
+		objects = append(objects, minio.ObjectInfo{
+			Bucket:      bucket,
+			IsDir:       false,
+			Name:        path,
+			ModTime:     item.Modified,
+			Size:        item.Size,
+			ETag:        hex.EncodeToString(item.Checksum),
+			ContentType: item.ContentType,
+			UserDefined: userDefined(item),
+		})
+	}
+
+	if len(list.Items) > 0 {
+		next = dir + list.Items[len(list.Items)-1].Path
+	}
+
+	return objects, prefixes, list.More, next, nil
+}
+
 func (layer *gatewayLayer) MakeBucketWithLocation(ctx context.Context, bucket string, location string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	// TODO: This current strategy of calling bs.Get
@@ -333,7 +336,11 @@ func (layer *gatewayLayer) MakeBucketWithLocation(ctx context.Context, bucket st
 		return convertError(err, bucket, "")
 	}
 
-	_, err = layer.gateway.metainfo.CreateBucket(ctx, bucket, &storj.Bucket{PathCipher: layer.gateway.pathCipher})
+	_, err = layer.gateway.metainfo.CreateBucket(ctx, bucket, &storj.Bucket{
+		PathCipher: layer.gateway.pathCipher,
+		Versioning: layer.gateway.Versioning,
+		PartnerID:  layer.gateway.PartnerID,
+	})
 
 	return err
 }
@@ -346,6 +353,7 @@ func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject,
 		return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
 	}
 
+	ctx = streams.WithDownloadParallelism(ctx, layer.gateway.DownloadParallelism)
 	download := stream.NewDownload(ctx, readOnlyStream, layer.gateway.streams)
 	defer func() { err = errs.Combine(err, download.Close()) }()
 
@@ -364,6 +372,10 @@ func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject,
 func (layer *gatewayLayer) putObject(ctx context.Context, bucket, object string, reader io.Reader, createInfo *storj.CreateObject) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if layer.gateway.Versioning {
+		ctx = streams.WithVersioning(ctx)
+	}
+
 	mutableObject, err := layer.gateway.metainfo.CreateObject(ctx, bucket, object, createInfo)
 	if err != nil {
 		return minio.ObjectInfo{}, convertError(err, bucket, object)
@@ -388,10 +400,25 @@ func (layer *gatewayLayer) putObject(ctx context.Context, bucket, object string,
 		Size:        info.Size,
 		ETag:        hex.EncodeToString(info.Checksum),
 		ContentType: info.ContentType,
-		UserDefined: info.Metadata,
+		UserDefined: userDefined(info),
 	}, nil
 }
 
+// userDefined returns the user defined metadata of object along with its
+// version ID, if it has one
+func userDefined(object storj.Object) map[string]string {
+	if object.VersionID == "" {
+		return object.Metadata
+	}
+
+	metadata := make(map[string]string, len(object.Metadata)+1)
+	for key, value := range object.Metadata {
+		metadata[key] = value
+	}
+	metadata[versionIDKey] = object.VersionID
+	return metadata
+}
+
 func upload(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader) error {
 	mutableStream, err := mutableObject.CreateStream(ctx)
 	if err != nil {
@@ -411,6 +438,14 @@ func (layer *gatewayLayer) PutObject(ctx context.Context, bucket, object string,
 	contentType := metadata["content-type"]
 	delete(metadata, "content-type")
 
+	reader := io.Reader(data)
+	if contentType == "" && data != nil {
+		contentType, reader, err = stream.DetectContentType(object, reader)
+		if err != nil {
+			return minio.ObjectInfo{}, err
+		}
+	}
+
 	createInfo := storj.CreateObject{
 		ContentType:      contentType,
 		Metadata:         metadata,
@@ -418,7 +453,7 @@ func (layer *gatewayLayer) PutObject(ctx context.Context, bucket, object string,
 		EncryptionScheme: layer.gateway.encryption,
 	}
 
-	return layer.putObject(ctx, bucket, object, data, &createInfo)
+	return layer.putObject(ctx, bucket, object, reader, &createInfo)
 }
 
 func (layer *gatewayLayer) Shutdown(ctx context.Context) (err error) {
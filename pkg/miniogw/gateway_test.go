@@ -9,7 +9,7 @@ import (
 	"encoding/hex"
 	"flag"
 	"fmt"
-	"strings"
+	"io/ioutil"
 	"testing"
 	"time"
 
@@ -23,6 +23,7 @@ import (
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/buckets"
@@ -200,7 +201,7 @@ func TestPutObject(t *testing.T) {
 			assert.False(t, info.IsDir)
 			assert.True(t, time.Since(info.ModTime) < 1*time.Second)
 			assert.Equal(t, data.Size(), info.Size)
-			// assert.Equal(t, data.SHA256HexString(), info.ETag) TODO: when we start calculating checksums
+			assert.Equal(t, data.MD5HexString(), info.ETag)
 			assert.Equal(t, serMetaInfo.ContentType, info.ContentType)
 			assert.Equal(t, serMetaInfo.UserDefined, info.UserDefined)
 		}
@@ -292,9 +293,20 @@ func TestGetObject(t *testing.T) {
 			ContentType: "text/plain",
 			Metadata:    map[string]string{"key1": "value1", "key2": "value2"},
 		}
-		_, err = createFile(ctx, metainfo, streams, TestBucket, TestFile, &createInfo, []byte("abcdef"))
+		obj, err := createFile(ctx, metainfo, streams, TestBucket, TestFile, &createInfo, []byte("abcdef"))
 		assert.NoError(t, err)
 
+		// Check the error when the object changed since its etag was read
+		err = layer.GetObject(ctx, TestBucket, TestFile, 0, -1, ioutil.Discard, "00000000000000000000000000000000")
+		assert.Equal(t, minio.InvalidETag{}, err)
+
+		// Check that the object is returned for its current etag
+		var content bytes.Buffer
+		err = layer.GetObject(ctx, TestBucket, TestFile, 0, -1, &content, hex.EncodeToString(obj.Checksum))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "abcdef", content.String())
+		}
+
 		for i, tt := range []struct {
 			offset, length int64
 			substr         string
@@ -582,16 +594,38 @@ func testListObjects(t *testing.T, listObjects func(context.Context, minio.Objec
 			}, {
 				prefix:    "a",
 				delimiter: "/",
-				objects:   []string{"xa", "xaa", "xb", "xbb", "xc"},
+				prefixes:  []string{"a/"},
+				objects:   []string{"a", "aa"},
+			}, {
+				prefix:  "a",
+				objects: []string{"a", "a/xa", "a/xaa", "a/xb", "a/xbb", "a/xc", "aa"},
 			}, {
 				prefix:    "a/",
 				delimiter: "/",
-				objects:   []string{"xa", "xaa", "xb", "xbb", "xc"},
+				objects:   []string{"a/xa", "a/xaa", "a/xb", "a/xbb", "a/xc"},
 			}, {
 				prefix:    "a/",
-				marker:    "xb",
+				marker:    "a/xb",
 				delimiter: "/",
-				objects:   []string{"xbb", "xc"},
+				objects:   []string{"a/xbb", "a/xc"},
+			}, {
+				prefix:    "a/xb",
+				delimiter: "/",
+				objects:   []string{"a/xb", "a/xbb"},
+			}, {
+				prefix:  "b/y",
+				maxKeys: 2,
+				more:    true,
+				objects: []string{"b/ya", "b/yaa"},
+			}, {
+				prefix:  "b/y",
+				marker:  "b/yaa",
+				maxKeys: 2,
+				more:    true,
+				objects: []string{"b/yb", "b/ybb"},
+			}, {
+				prefix: "b/y",
+				marker: "c",
 			}, {
 				marker:  "a/xbb",
 				maxKeys: 5,
@@ -599,11 +633,11 @@ func testListObjects(t *testing.T, listObjects func(context.Context, minio.Objec
 				objects: []string{"a/xc", "aa", "b", "b/ya", "b/yaa"},
 			}, {
 				prefix:    "a/",
-				marker:    "xaa",
+				marker:    "a/xaa",
 				delimiter: "/",
 				maxKeys:   2,
 				more:      true,
-				objects:   []string{"xb", "xbb"},
+				objects:   []string{"a/xb", "a/xbb"},
 			},
 		} {
 			errTag := fmt.Sprintf("%d. %+v", i, tt)
@@ -615,11 +649,7 @@ func testListObjects(t *testing.T, listObjects func(context.Context, minio.Objec
 				assert.Equal(t, tt.prefixes, prefixes, errTag)
 				assert.Equal(t, len(tt.objects), len(objects), errTag)
 				for i, objectInfo := range objects {
-					path := objectInfo.Name
-					if tt.prefix != "" {
-						path = storj.JoinPaths(strings.TrimSuffix(tt.prefix, "/"), path)
-					}
-					obj := files[path]
+					obj := files[objectInfo.Name]
 
 					assert.Equal(t, tt.objects[i], objectInfo.Name, errTag)
 					assert.Equal(t, TestBucket, objectInfo.Bucket, errTag)
@@ -709,17 +739,16 @@ func initEnv(planet *testplanet.Planet) (minio.ObjectLayer, storj.Metainfo, stre
 
 	segments := segments.NewSegmentStore(oc, ec, pdb, rs, 8*memory.KB.Int())
 
-	key := new(storj.Key)
-	copy(key[:], TestEncKey)
+	access := encryption.NewAccess(encryption.KeyFromPassphrase(TestEncKey))
 
-	streams, err := streams.NewStreamStore(segments, 64*memory.MB.Int64(), key, 1*memory.KB.Int(), storj.AESGCM)
+	streams, err := streams.NewStreamStore(segments, 64*memory.MB.Int64(), access, 1*memory.KB.Int(), storj.AESGCM)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
 	buckets := buckets.NewStore(streams)
 
-	metainfo := kvmetainfo.New(buckets, streams, segments, pdb, key)
+	metainfo := kvmetainfo.New(buckets, streams, segments, pdb, access)
 
 	gateway := NewStorjGateway(
 		metainfo,
@@ -0,0 +1,146 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/mr-tron/base58/base58"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// Scope is the satellite, the api key and the encryption keys an uplink
+// needs to access the paths allowed by the api key. It's serialized to a
+// single string, which can be handed to others.
+//
+// The paths under the prefixes of PrefixKeys are encrypted with their own
+// keys, a scope with only these keys and no EncryptionKey gives access to
+// them without sharing the root key.
+type Scope struct {
+	SatelliteAddr string
+	APIKey        string
+	EncryptionKey []byte
+	PrefixKeys    []encryption.PrefixKey
+}
+
+// ParseScope parses a scope serialized by Serialize
+func ParseScope(encoded string) (*Scope, error) {
+	data, err := base58.Decode(encoded)
+	if err != nil {
+		return nil, Error.New("invalid scope: %v", err)
+	}
+
+	var msg pb.Scope
+	if err := proto.Unmarshal(data, &msg); err != nil {
+		return nil, Error.New("invalid scope: %v", err)
+	}
+	if msg.SatelliteAddr == "" || msg.ApiKey == "" {
+		return nil, Error.New("invalid scope: satellite address or api key missing")
+	}
+
+	scope := &Scope{
+		SatelliteAddr: msg.SatelliteAddr,
+		APIKey:        msg.ApiKey,
+		EncryptionKey: msg.EncryptionKey,
+	}
+	for _, prefixKey := range msg.PrefixKeys {
+		if len(prefixKey.Key) != storj.KeySize || (len(prefixKey.BucketMetaKey) != 0 && len(prefixKey.BucketMetaKey) != storj.KeySize) {
+			return nil, Error.New("invalid scope: invalid key of prefix %q", prefixKey.Prefix)
+		}
+
+		key := encryption.PrefixKey{
+			Prefix:          storj.Path(prefixKey.Prefix),
+			EncryptedPrefix: storj.Path(prefixKey.EncryptedPrefix),
+		}
+		copy(key.Key[:], prefixKey.Key)
+		copy(key.BucketMetaKey[:], prefixKey.BucketMetaKey)
+		scope.PrefixKeys = append(scope.PrefixKeys, key)
+	}
+	return scope, nil
+}
+
+// Serialize encodes the scope as a string
+func (s *Scope) Serialize() (string, error) {
+	msg := &pb.Scope{
+		SatelliteAddr: s.SatelliteAddr,
+		ApiKey:        s.APIKey,
+		EncryptionKey: s.EncryptionKey,
+	}
+	for _, prefixKey := range s.PrefixKeys {
+		prefixKey := prefixKey
+		msg.PrefixKeys = append(msg.PrefixKeys, &pb.PrefixKey{
+			Prefix:          []byte(prefixKey.Prefix),
+			EncryptedPrefix: []byte(prefixKey.EncryptedPrefix),
+			Key:             prefixKey.Key[:],
+			BucketMetaKey:   prefixKey.BucketMetaKey[:],
+		})
+	}
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base58.Encode(data), nil
+}
+
+// Combine adds the encryption keys of other to the scope, so a single
+// uplink session can access the paths of both. The scopes have to be of the
+// same satellite and can't have different encryption keys. The api key of
+// the scope is kept, it should allow accessing the paths of other too.
+func (s *Scope) Combine(other *Scope) error {
+	if s.SatelliteAddr != other.SatelliteAddr {
+		return Error.New("scopes of different satellites can't be combined")
+	}
+
+	if len(s.EncryptionKey) == 0 {
+		s.EncryptionKey = other.EncryptionKey
+	} else if len(other.EncryptionKey) != 0 && !bytes.Equal(s.EncryptionKey, other.EncryptionKey) {
+		return Error.New("scopes with different encryption keys can't be combined")
+	}
+
+	access := encryption.NewAccess(nil)
+	for _, prefixKey := range append(s.PrefixKeys, other.PrefixKeys...) {
+		if err := access.Add(prefixKey); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	s.PrefixKeys = access.Prefixes()
+	return nil
+}
+
+// WithScope returns the config with the satellite addresses, the api key
+// and the encryption keys replaced by the ones of the configured scope.
+// Several comma separated scopes are combined into one.
+func (c Config) WithScope() (Config, error) {
+	if c.Client.Scope == "" {
+		return c, nil
+	}
+
+	var scope *Scope
+	for _, serialized := range strings.Split(c.Client.Scope, ",") {
+		parsed, err := ParseScope(serialized)
+		if err != nil {
+			return c, err
+		}
+		if scope == nil {
+			scope = parsed
+			continue
+		}
+		if err := scope.Combine(parsed); err != nil {
+			return c, err
+		}
+	}
+
+	c.Client.OverlayAddr = scope.SatelliteAddr
+	c.Client.PointerDBAddr = scope.SatelliteAddr
+	c.Client.APIKey = scope.APIKey
+	c.Enc.Key = string(scope.EncryptionKey)
+	c.Enc.PrefixKeys = scope.PrefixKeys
+	return c, nil
+}
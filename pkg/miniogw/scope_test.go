@@ -0,0 +1,105 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestScope(t *testing.T) {
+	scope := Scope{
+		SatelliteAddr: "127.0.0.1:7777",
+		APIKey:        "restricted-key",
+		EncryptionKey: []byte("passphrase"),
+	}
+
+	serialized, err := scope.Serialize()
+	require.NoError(t, err)
+
+	parsed, err := ParseScope(serialized)
+	require.NoError(t, err)
+	assert.Equal(t, scope, *parsed)
+
+	var config Config
+	config.Client.Scope = serialized
+	config.Client.APIKey = "configured-key"
+
+	config, err = config.WithScope()
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:7777", config.Client.OverlayAddr)
+	assert.Equal(t, "127.0.0.1:7777", config.Client.PointerDBAddr)
+	assert.Equal(t, "restricted-key", config.Client.APIKey)
+	assert.Equal(t, "passphrase", config.Enc.Key)
+
+	withoutKey, err := (&Scope{SatelliteAddr: "127.0.0.1:7777"}).Serialize()
+	require.NoError(t, err)
+
+	for _, invalid := range []string{"", "0OIl", withoutKey} {
+		_, err = ParseScope(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestScopeCombine(t *testing.T) {
+	owner := encryption.NewAccess(encryption.KeyFromPassphrase("passphrase"))
+	photos, err := owner.Derive("bucket/photos", storj.AESGCM)
+	require.NoError(t, err)
+	videos, err := owner.Derive("bucket/videos", storj.AESGCM)
+	require.NoError(t, err)
+
+	scope := Scope{
+		SatelliteAddr: "127.0.0.1:7777",
+		APIKey:        "restricted-key",
+		PrefixKeys:    []encryption.PrefixKey{photos},
+	}
+	serialized, err := scope.Serialize()
+	require.NoError(t, err)
+
+	parsed, err := ParseScope(serialized)
+	require.NoError(t, err)
+	assert.Equal(t, scope, *parsed)
+
+	other, err := (&Scope{
+		SatelliteAddr: "127.0.0.1:7777",
+		APIKey:        "other-key",
+		PrefixKeys:    []encryption.PrefixKey{videos},
+	}).Serialize()
+	require.NoError(t, err)
+
+	var config Config
+	config.Client.Scope = serialized + "," + other
+	config.Enc.BucketKeys = "team:team passphrase"
+
+	config, err = config.WithScope()
+	require.NoError(t, err)
+	assert.Equal(t, "restricted-key", config.Client.APIKey)
+	assert.Equal(t, "", config.Enc.Key)
+	assert.Equal(t, []encryption.PrefixKey{photos, videos}, config.Enc.PrefixKeys)
+
+	// without a root key only the paths with their own keys can be accessed
+	access, err := config.Enc.Access()
+	require.NoError(t, err)
+	assert.Nil(t, access.Root())
+	assert.Len(t, access.Prefixes(), 3)
+	_, err = access.ContentKey("bucket/photos/photo.jpg")
+	assert.NoError(t, err)
+	_, err = access.ContentKey("bucket/music/song.mp3")
+	assert.Error(t, err)
+
+	otherSatellite, err := (&Scope{SatelliteAddr: "127.0.0.1:8888", APIKey: "key"}).Serialize()
+	require.NoError(t, err)
+	config.Client.Scope = serialized + "," + otherSatellite
+	_, err = config.WithScope()
+	assert.Error(t, err)
+
+	config.Enc.BucketKeys = "team"
+	_, err = config.Enc.Access()
+	assert.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
@@ -57,17 +58,30 @@ type DB interface {
 	Delete(ctx context.Context, id storj.NodeID) error
 	// GetWalletAddress gets the node's wallet address
 	GetWalletAddress(ctx context.Context, id storj.NodeID) (string, error)
+	// Capacity returns the number of storage nodes and their reported free space
+	Capacity(ctx context.Context) (*Capacity, error)
+}
+
+// Capacity is the free space reported by the storage nodes in the overlay
+type Capacity struct {
+	StorageNodes int64
+	FreeDisk     int64
 }
 
 // Cache is used to store overlay data in Redis
 type Cache struct {
-	db     DB
-	statDB statdb.DB
+	db         DB
+	statDB     statdb.DB
+	pings      PingDB
+	ping       PingConfig
+	reputation *reputation.Service
+	subnets    SubnetConfig
 }
 
-// NewCache returns a new Cache
-func NewCache(db DB, sdb statdb.DB) *Cache {
-	return &Cache{db: db, statDB: sdb}
+// NewCache returns a new Cache, the subnets of nodes are maintained with the
+// prefix lengths of subnets
+func NewCache(db DB, sdb statdb.DB, pings PingDB, ping PingConfig, reputationConfig reputation.Config, subnets SubnetConfig) *Cache {
+	return &Cache{db: db, statDB: sdb, pings: pings, ping: ping, reputation: reputation.NewService(reputationConfig), subnets: subnets}
 }
 
 // Close closes resources
@@ -105,6 +119,13 @@ func (cache *Cache) FindStorageNodes(ctx context.Context, req *pb.FindStorageNod
 	minimumRequiredNodes := int(req.GetMinNodes())
 	freeBandwidth := req.GetOpts().GetRestrictions().FreeBandwidth
 	freeDisk := req.GetOpts().GetRestrictions().FreeDisk
+	// nodes reporting less than the configured minimums are full
+	if minimum := preferences.MinimumBandwidth.Int64(); freeBandwidth < minimum {
+		freeBandwidth = minimum
+	}
+	if minimum := preferences.MinimumDisk.Int64(); freeDisk < minimum {
+		freeDisk = minimum
+	}
 	excludedNodes := req.GetOpts().ExcludedNodes
 	requestedCount := int(req.GetOpts().GetAmount())
 
@@ -113,44 +134,110 @@ func (cache *Cache) FindStorageNodes(ctx context.Context, req *pb.FindStorageNod
 	// TODO: add sanity limits to requested node count
 	// TODO: add sanity limits to excluded nodes
 
-	reputableNodeCount := minimumRequiredNodes
-	if reputableNodeCount <= 0 {
-		reputableNodeCount = requestedCount
+	nodeCount := minimumRequiredNodes
+	if nodeCount <= 0 {
+		nodeCount = requestedCount
 	}
 
-	auditCount := preferences.AuditCount
-	if auditCount < preferences.NewNodeAuditThreshold {
-		auditCount = preferences.NewNodeAuditThreshold
+	// only vetted nodes are selected as reputable nodes, the others are new nodes
+	vettingAuditCount := cache.reputation.VettingAuditCount(preferences.NewNodeAuditThreshold)
+
+	// the excluded nodes hold the other pieces of the segment, so with
+	// placement constraints the new nodes must not share their subnets or
+	// regions. Selecting distinct subnets constrains every selection.
+	constraints := req.GetOpts().GetPlacement()
+	if preferences.DistinctSubnets && !constraints.GetDistinctSubnets() {
+		constraints = &pb.PlacementConstraints{
+			DistinctSubnets: true,
+			DistinctRegions: constraints.GetDistinctRegions(),
+		}
 	}
 
-	reputableNodes, err := cache.db.SelectNodes(ctx, reputableNodeCount, &NodeCriteria{
-		Type: pb.NodeType_STORAGE,
+	var placed *placement
+	if constraints != nil {
+		var existing []*pb.Node
+		if len(excludedNodes) > 0 {
+			var err error
+			existing, err = cache.db.GetAll(ctx, excludedNodes)
+			if err != nil {
+				return nil, err
+			}
+		}
+		placed = newPlacement(constraints, cache.subnets, existing)
+	}
 
-		FreeBandwidth: freeBandwidth,
-		FreeDisk:      freeDisk,
+	// the database only returns a single node of each subnet which isn't occupied yet
+	excludedSubnets := func() []string {
+		if placed == nil {
+			return nil
+		}
+		return placed.occupiedSubnets()
+	}
 
-		AuditCount:         auditCount,
-		AuditSuccessRatio:  preferences.AuditSuccessRatio,
-		UptimeCount:        preferences.UptimeCount,
-		UptimeSuccessRatio: preferences.UptimeRatio,
+	// more eligible nodes than requested are selected at random, of which the
+	// reputation service prefers the nodes with higher scores
+	var selectReputable nodeSelection = func(count int, excluded storj.NodeIDList) ([]*pb.Node, error) {
+		candidates, err := cache.db.SelectNodes(ctx, cache.reputation.Candidates(count), &NodeCriteria{
+			Type: pb.NodeType_STORAGE,
 
-		Excluded: excludedNodes,
-	})
-	if err != nil {
-		return nil, err
+			FreeBandwidth: freeBandwidth,
+			FreeDisk:      freeDisk,
+			RequireWallet: preferences.RequireWallet,
+
+			AuditCount: vettingAuditCount,
+
+			AuditReputationScore:  cache.reputation.MinAuditScore(),
+			UptimeReputationScore: cache.reputation.MinUptimeScore(),
+
+			Excluded: excluded,
+
+			DistinctSubnets: constraints.GetDistinctSubnets(),
+			ExcludedSubnets: excludedSubnets(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return cache.reputation.Prefer(candidates, count), nil
 	}
 
-	newNodeCount := int64(float64(reputableNodeCount) * preferences.NewNodePercentage)
-	newNodes, err := cache.db.SelectNewNodes(ctx, int(newNodeCount), &NewNodeCriteria{
-		Type: pb.NodeType_STORAGE,
+	var selectNew nodeSelection = func(count int, excluded storj.NodeIDList) ([]*pb.Node, error) {
+		candidates, err := cache.db.SelectNewNodes(ctx, cache.reputation.Candidates(count), &NewNodeCriteria{
+			Type: pb.NodeType_STORAGE,
+
+			FreeBandwidth: freeBandwidth,
+			FreeDisk:      freeDisk,
+			RequireWallet: preferences.RequireWallet,
+
+			AuditThreshold: vettingAuditCount,
+
+			AuditReputationScore:  cache.reputation.MinAuditScore(),
+			UptimeReputationScore: cache.reputation.MinUptimeScore(),
+
+			Excluded: excluded,
+
+			DistinctSubnets: constraints.GetDistinctSubnets(),
+			ExcludedSubnets: excludedSubnets(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return cache.reputation.Prefer(candidates, count), nil
+	}
 
-		FreeBandwidth: freeBandwidth,
-		FreeDisk:      freeDisk,
+	if placed != nil {
+		selectReputable = placedSelection(placed, selectReputable)
+		selectNew = placedSelection(placed, selectNew)
+	}
 
-		AuditThreshold: preferences.NewNodeAuditThreshold,
+	// a fraction of the nodes are unvetted, so they can gradually accumulate
+	// data and audits. Vetted nodes replace the missing unvetted nodes.
+	newNodes, err := selectNew(preferences.NewNodeCount(nodeCount), excludedNodes)
+	if err != nil {
+		return nil, err
+	}
 
-		Excluded: excludedNodes,
-	})
+	reputableNodeCount := nodeCount - len(newNodes)
+	reputableNodes, err := selectReputable(reputableNodeCount, excludedNodes)
 	if err != nil {
 		return nil, err
 	}
@@ -159,8 +246,8 @@ func (cache *Cache) FindStorageNodes(ctx context.Context, req *pb.FindStorageNod
 	nodes = append(nodes, newNodes...)
 	nodes = append(nodes, reputableNodes...)
 
-	if len(reputableNodes) < reputableNodeCount {
-		return nodes, ErrNotEnoughNodes.New("requested %d found %d", reputableNodeCount, len(reputableNodes))
+	if len(nodes) < nodeCount {
+		return nodes, ErrNotEnoughNodes.New("requested %d found %d", nodeCount, len(nodes))
 	}
 
 	return nodes, nil
@@ -186,19 +273,40 @@ func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node)
 		return errors.New("invalid request")
 	}
 
+	value.LastNet = ""
+	if value.GetAddress().GetTransport() == pb.NodeTransport_RELAY_TCP_TLS_GRPC {
+		// relayed nodes share the address of the relay, their own network is unknown
+		value.LastNet = "relay/" + nodeID.String()
+	} else if address := value.GetAddress().GetAddress(); address != "" {
+		value.LastNet = cache.subnets.Subnet(address)
+	}
+
 	// get existing node rep, or create a new statdb node with 0 rep
 	stats, err := cache.statDB.CreateEntryIfNotExists(ctx, nodeID)
 	if err != nil {
 		return err
 	}
 
+	// prefer the decayed ping success rate over the all-time uptime ratio
+	uptimeRatio := stats.UptimeRatio
+	pings, err := cache.pings.Get(ctx, nodeID)
+	if err != nil && err != ErrNodeNotFound {
+		return err
+	}
+	if pings != nil {
+		uptimeRatio = pings.SuccessRate
+	}
+
 	value.Reputation = &pb.NodeStats{
 		AuditSuccessRatio:  stats.AuditSuccessRatio,
 		AuditSuccessCount:  stats.AuditSuccessCount,
 		AuditCount:         stats.AuditCount,
-		UptimeRatio:        stats.UptimeRatio,
+		UptimeRatio:        uptimeRatio,
 		UptimeSuccessCount: stats.UptimeSuccessCount,
 		UptimeCount:        stats.UptimeCount,
+
+		AuditReputationScore:  cache.reputation.Audit().Score(stats.AuditReputation()),
+		UptimeReputationScore: cache.reputation.Uptime().Score(stats.UptimeReputation()),
 	}
 
 	return cache.db.Update(ctx, &value)
@@ -213,12 +321,26 @@ func (cache *Cache) Delete(ctx context.Context, id storj.NodeID) error {
 	return cache.db.Delete(ctx, id)
 }
 
+// RecordPing records the outcome of a periodic ping to the node and feeds it
+// into the uptime reputation. It returns whether the decayed ping success
+// rate of the node dropped below the offline threshold.
+func (cache *Cache) RecordPing(ctx context.Context, nodeID storj.NodeID, success bool) (offline bool, err error) {
+	pings, err := cache.pings.Record(ctx, nodeID, success, cache.ping.Decay)
+	if err != nil {
+		return false, err
+	}
+	offline = cache.ping.Offline(pings)
+
+	_, err = cache.statDB.UpdateUptime(ctx, nodeID, success, cache.reputation.Uptime())
+	return offline, err
+}
+
 // ConnFailure implements the Transport Observer `ConnFailure` function
 func (cache *Cache) ConnFailure(ctx context.Context, node *pb.Node, failureError error) {
 	// TODO: Kademlia paper specifies 5 unsuccessful PINGs before removing the node
 	// from our routing table, but this is the cache so maybe we want to treat
 	// it differently.
-	_, err := cache.statDB.UpdateUptime(ctx, node.Id, false)
+	_, err := cache.statDB.UpdateUptime(ctx, node.Id, false, cache.reputation.Uptime())
 	if err != nil {
 		zap.L().Debug("error updating uptime for node in statDB", zap.Error(err))
 	}
@@ -230,7 +352,7 @@ func (cache *Cache) ConnSuccess(ctx context.Context, node *pb.Node) {
 	if err != nil {
 		zap.L().Debug("error updating uptime for node in statDB", zap.Error(err))
 	}
-	_, err = cache.statDB.UpdateUptime(ctx, node.Id, true)
+	_, err = cache.statDB.UpdateUptime(ctx, node.Id, true, cache.reputation.Uptime())
 	if err != nil {
 		zap.L().Debug("error updating statdDB with node connection info", zap.Error(err))
 	}
@@ -9,10 +9,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
@@ -26,11 +29,11 @@ func TestCache_Database(t *testing.T) {
 		ctx := testcontext.New(t)
 		defer ctx.Cleanup()
 
-		testCache(ctx, t, db.OverlayCache(), db.StatDB())
+		testCache(ctx, t, db.OverlayCache(), db.StatDB(), db.NodePings())
 	})
 }
 
-func testCache(ctx context.Context, t *testing.T, store overlay.DB, sdb statdb.DB) {
+func testCache(ctx context.Context, t *testing.T, store overlay.DB, sdb statdb.DB, pings overlay.PingDB) {
 	valid1ID := storj.NodeID{}
 	valid2ID := storj.NodeID{}
 	missingID := storj.NodeID{}
@@ -39,7 +42,10 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB, sdb statdb.D
 	_, _ = rand.Read(valid2ID[:])
 	_, _ = rand.Read(missingID[:])
 
-	cache := overlay.NewCache(store, sdb)
+	cache := overlay.NewCache(store, sdb, pings, overlay.PingConfig{
+		Decay:            0.5,
+		OfflineThreshold: 0.5,
+	}, reputation.Config{}, overlay.DefaultSubnets)
 
 	{ // Put
 		err := cache.Put(ctx, valid1ID, pb.Node{Id: valid1ID})
@@ -120,6 +126,39 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB, sdb statdb.D
 		assert.NotEqual(t, len(zero), 0)
 	}
 
+	{ // RecordPing
+		offline, err := cache.RecordPing(ctx, valid2ID, true)
+		assert.NoError(t, err)
+		assert.False(t, offline)
+
+		offline, err = cache.RecordPing(ctx, valid2ID, false)
+		assert.NoError(t, err)
+		assert.False(t, offline)
+
+		offline, err = cache.RecordPing(ctx, valid2ID, false)
+		assert.NoError(t, err)
+		assert.True(t, offline)
+
+		// the decayed success rate replaces the uptime ratio
+		err = cache.Put(ctx, valid2ID, pb.Node{Id: valid2ID})
+		assert.NoError(t, err)
+
+		valid2, err := cache.Get(ctx, valid2ID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, 0.25, valid2.GetReputation().GetUptimeRatio())
+		}
+	}
+
+	{ // RecordPing of a new node failing its first ping
+		offline, err := cache.RecordPing(ctx, valid1ID, false)
+		assert.NoError(t, err)
+		assert.False(t, offline)
+
+		offline, err = cache.RecordPing(ctx, valid1ID, false)
+		assert.NoError(t, err)
+		assert.True(t, offline)
+	}
+
 	{ // Delete
 		// Test standard delete
 		err := cache.Delete(ctx, valid1ID)
@@ -141,3 +180,378 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB, sdb statdb.D
 		assert.True(t, err == overlay.ErrEmptyNode)
 	}
 }
+
+func TestFindStorageNodes_Placement(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB(), db.NodePings(), overlay.PingConfig{}, reputation.Config{}, overlay.DefaultSubnets)
+
+		addresses := []string{"10.0.1.1:7777", "10.0.1.2:7777", "10.0.1.3:7777", "10.0.2.1:7777", "10.0.2.2:7777", "10.0.3.1:7777"}
+		regions := []string{"eu", "eu", "us", "us", "asia", "eu"}
+
+		var ids storj.NodeIDList
+		for i, address := range addresses {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+			ids = append(ids, id)
+
+			err := cache.Put(ctx, id, pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Address:      &pb.NodeAddress{Address: address},
+				Metadata:     &pb.NodeMetadata{Region: regions[i]},
+				Restrictions: &pb.NodeRestrictions{FreeBandwidth: 1, FreeDisk: 1},
+			})
+			require.NoError(t, err)
+		}
+
+		find := func(amount int, excluded storj.NodeIDList, placement *pb.PlacementConstraints) ([]*pb.Node, error) {
+			return cache.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+				Opts: &pb.OverlayOptions{
+					Amount:        int64(amount),
+					Restrictions:  &pb.NodeRestrictions{},
+					ExcludedNodes: excluded,
+					Placement:     placement,
+				},
+			}, &overlay.NodeSelectionConfig{})
+		}
+
+		subnets := overlay.PlacementConfig{DistinctSubnets: true}.Constraints()
+		for i := 0; i < 10; i++ {
+			nodes, err := find(3, nil, subnets)
+			require.NoError(t, err)
+			require.Len(t, nodes, 3)
+
+			occupied := map[string]bool{}
+			for _, node := range nodes {
+				subnet := node.LastNet
+				assert.Equal(t, overlay.DefaultSubnets.Subnet(node.Address.Address), subnet)
+				assert.False(t, occupied[subnet], "subnet %s selected twice", subnet)
+				occupied[subnet] = true
+			}
+		}
+
+		// the excluded nodes occupy their subnets
+		nodes, err := find(1, storj.NodeIDList{ids[0], ids[3]}, subnets)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, ids[5], nodes[0].Id)
+
+		_, err = find(4, nil, subnets)
+		assert.True(t, overlay.ErrNotEnoughNodes.Has(err))
+
+		regionsOnly := overlay.PlacementConfig{DistinctRegions: true}.Constraints()
+		nodes, err = find(1, storj.NodeIDList{ids[0], ids[2]}, regionsOnly)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, ids[4], nodes[0].Id)
+
+		// without constraints nodes in the same subnet are selected
+		nodes, err = find(6, nil, nil)
+		require.NoError(t, err)
+		assert.Len(t, nodes, 6)
+	})
+}
+
+func TestFindStorageNodes_DistinctSubnets(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		// nodes in the same /16 are a single failure domain
+		subnets := overlay.SubnetConfig{IPv4Bits: 16, IPv6Bits: 64}
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB(), db.NodePings(), overlay.PingConfig{}, reputation.Config{}, subnets)
+
+		addresses := []string{"10.1.1.1:7777", "10.1.2.1:7777", "10.1.3.1:7777", "10.2.1.1:7777", "10.2.2.1:7777", "10.3.1.1:7777"}
+
+		var ids storj.NodeIDList
+		for _, address := range addresses {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+			ids = append(ids, id)
+
+			err := cache.Put(ctx, id, pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Address:      &pb.NodeAddress{Address: address},
+				Restrictions: &pb.NodeRestrictions{FreeBandwidth: 1, FreeDisk: 1},
+			})
+			require.NoError(t, err)
+		}
+
+		node, err := cache.Get(ctx, ids[1])
+		require.NoError(t, err)
+		assert.Equal(t, "10.1.0.0/16", node.LastNet)
+
+		find := func(amount int, excluded storj.NodeIDList, distinct bool) ([]*pb.Node, error) {
+			return cache.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+				Opts: &pb.OverlayOptions{
+					Amount:        int64(amount),
+					Restrictions:  &pb.NodeRestrictions{},
+					ExcludedNodes: excluded,
+				},
+			}, &overlay.NodeSelectionConfig{DistinctSubnets: distinct})
+		}
+
+		for i := 0; i < 10; i++ {
+			nodes, err := find(3, nil, true)
+			require.NoError(t, err)
+			require.Len(t, nodes, 3)
+
+			occupied := map[string]bool{}
+			for _, node := range nodes {
+				assert.False(t, occupied[node.LastNet], "subnet %s selected twice", node.LastNet)
+				occupied[node.LastNet] = true
+			}
+		}
+
+		// the excluded nodes occupy their subnets
+		nodes, err := find(1, storj.NodeIDList{ids[0], ids[3]}, true)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, ids[5], nodes[0].Id)
+
+		_, err = find(4, nil, true)
+		assert.True(t, overlay.ErrNotEnoughNodes.Has(err))
+
+		nodes, err = find(6, nil, false)
+		require.NoError(t, err)
+		assert.Len(t, nodes, 6)
+	})
+}
+
+func TestFindStorageNodes_Reputation(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		config := reputation.Config{
+			AuditAlpha0: 1, AuditLambda: 0.95, AuditWeight: 1, AuditDQ: 0.6,
+			UptimeAlpha0: 1, UptimeLambda: 0.99, UptimeWeight: 1, UptimeDQ: 0.6,
+			Selection: reputation.SelectionConfig{
+				MinAuditScore:      0.8,
+				MinUptimeScore:     0.6,
+				VettingAuditCount:  2,
+				PreferenceExponent: 1,
+				Oversampling:       2,
+			},
+		}
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB(), db.NodePings(), overlay.PingConfig{}, config, overlay.DefaultSubnets)
+
+		put := func(id storj.NodeID) {
+			err := cache.Put(ctx, id, pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Address:      &pb.NodeAddress{Address: "127.0.0.1:7777"},
+				Restrictions: &pb.NodeRestrictions{FreeBandwidth: 1, FreeDisk: 1},
+			})
+			require.NoError(t, err)
+		}
+
+		// vetted, failing, new and vetted nodes
+		audits := [][]bool{{true, true, true}, {false, false, false}, {}, {true, true}}
+		var ids storj.NodeIDList
+		for _, outcomes := range audits {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+			ids = append(ids, id)
+
+			put(id)
+			for _, success := range outcomes {
+				_, err := db.StatDB().UpdateAuditSuccess(ctx, id, success, config.Audit())
+				require.NoError(t, err)
+			}
+			// the overlay picks up the new scores with the next update
+			put(id)
+		}
+
+		find := func(amount int, newNodes float64) ([]*pb.Node, error) {
+			return cache.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+				Opts: &pb.OverlayOptions{
+					Amount:       int64(amount),
+					Restrictions: &pb.NodeRestrictions{},
+				},
+			}, &overlay.NodeSelectionConfig{NewNodeFraction: newNodes})
+		}
+
+		nodes, err := find(2, 0)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, storj.NodeIDList{ids[0], ids[3]}, nodeIDs(nodes))
+
+		// the failing node is neither reputable nor new
+		_, err = find(3, 0)
+		assert.True(t, overlay.ErrNotEnoughNodes.Has(err))
+
+		// the only new node is selected, a vetted node replaces the missing one
+		nodes, err = find(2, 1)
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+		assert.Equal(t, ids[2], nodes[0].Id)
+		assert.Contains(t, storj.NodeIDList{ids[0], ids[3]}, nodes[1].Id)
+	})
+}
+
+func TestFindStorageNodes_Capacity(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB(), db.NodePings(), overlay.PingConfig{}, reputation.Config{}, overlay.DefaultSubnets)
+
+		// a node with enough capacity, a full one and one without a wallet
+		nodes := []pb.Node{
+			{
+				Restrictions: &pb.NodeRestrictions{FreeBandwidth: 10 * memory.GB.Int64(), FreeDisk: 10 * memory.GB.Int64()},
+				Metadata:     &pb.NodeMetadata{Wallet: "0x01", Email: "a@example.com", Version: "v0.1.0"},
+			},
+			{
+				Restrictions: &pb.NodeRestrictions{FreeBandwidth: 10 * memory.GB.Int64(), FreeDisk: memory.MB.Int64()},
+				Metadata:     &pb.NodeMetadata{Wallet: "0x02", Email: "b@example.com", Version: "v0.1.0"},
+			},
+			{
+				Restrictions: &pb.NodeRestrictions{FreeBandwidth: 10 * memory.GB.Int64(), FreeDisk: 10 * memory.GB.Int64()},
+				Metadata:     &pb.NodeMetadata{Email: "c@example.com", Version: "v0.1.0"},
+			},
+		}
+		var ids storj.NodeIDList
+		for _, node := range nodes {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+			ids = append(ids, id)
+
+			node.Id = id
+			node.Type = pb.NodeType_STORAGE
+			node.Address = &pb.NodeAddress{Address: "127.0.0.1:7777"}
+			require.NoError(t, cache.Put(ctx, id, node))
+		}
+
+		// the reported metadata is stored
+		node, err := cache.Get(ctx, ids[0])
+		require.NoError(t, err)
+		assert.Equal(t, "0x01", node.Metadata.Wallet)
+		assert.Equal(t, "v0.1.0", node.Metadata.Version)
+
+		find := func(amount int, config overlay.NodeSelectionConfig) ([]*pb.Node, error) {
+			return cache.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+				Opts: &pb.OverlayOptions{
+					Amount:       int64(amount),
+					Restrictions: &pb.NodeRestrictions{},
+				},
+			}, &config)
+		}
+
+		selected, err := find(3, overlay.NodeSelectionConfig{})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, ids, nodeIDs(selected))
+
+		config := overlay.NodeSelectionConfig{
+			MinimumDisk:      memory.GB,
+			MinimumBandwidth: memory.GB,
+			RequireWallet:    true,
+		}
+		selected, err = find(1, config)
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{ids[0]}, nodeIDs(selected))
+
+		_, err = find(2, config)
+		assert.True(t, overlay.ErrNotEnoughNodes.Has(err))
+	})
+}
+
+func nodeIDs(nodes []*pb.Node) (ids storj.NodeIDList) {
+	for _, node := range nodes {
+		ids = append(ids, node.Id)
+	}
+	return ids
+}
+
+func TestSimulateSelection(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB(), db.NodePings(), overlay.PingConfig{}, reputation.Config{}, overlay.DefaultSubnets)
+
+		addresses := []string{"10.0.1.1:7777", "10.0.1.2:7777", "10.0.2.1:7777", "10.0.3.1:7777"}
+		regions := []string{"eu", "eu", "us", ""}
+		for i, address := range addresses {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+
+			err := cache.Put(ctx, id, pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Address:      &pb.NodeAddress{Address: address},
+				Metadata:     &pb.NodeMetadata{Region: regions[i]},
+				Restrictions: &pb.NodeRestrictions{FreeBandwidth: 1, FreeDisk: 1},
+			})
+			require.NoError(t, err)
+		}
+
+		stats, err := cache.SimulateSelection(ctx, &pb.FindStorageNodesRequest{
+			Opts: &pb.OverlayOptions{Amount: 2, Restrictions: &pb.NodeRestrictions{}},
+		}, &overlay.NodeSelectionConfig{}, 50)
+		require.NoError(t, err)
+
+		assert.Equal(t, 50, stats.Runs)
+		assert.Equal(t, 0, stats.Failures)
+		assert.Equal(t, int64(100), stats.Pieces)
+		assert.Len(t, stats.Nodes, 4)
+		assert.Len(t, stats.Subnets, 3)
+		assert.Len(t, stats.Regions, 3)
+		assert.True(t, stats.Gini >= 0 && stats.Gini < 1)
+
+		var total float64
+		for _, share := range stats.Regions {
+			total += share.Share
+		}
+		assert.InDelta(t, 1, total, 0.0001)
+
+		// selections of more nodes than there are fail
+		stats, err = cache.SimulateSelection(ctx, &pb.FindStorageNodesRequest{
+			Opts: &pb.OverlayOptions{Amount: 5, Restrictions: &pb.NodeRestrictions{}},
+		}, &overlay.NodeSelectionConfig{}, 3)
+		require.NoError(t, err)
+		assert.Equal(t, 3, stats.Failures)
+	})
+}
+
+func TestNodeSelectionConfig(t *testing.T) {
+	config := overlay.NodeSelectionConfig{NewNodeFraction: 0.25}
+	require.NoError(t, config.Verify())
+	assert.Equal(t, 0, config.NewNodeCount(3))
+	assert.Equal(t, 1, config.NewNodeCount(4))
+	assert.Equal(t, 10, config.NewNodeCount(40))
+
+	assert.Error(t, overlay.NodeSelectionConfig{NewNodeFraction: 1.5}.Verify())
+	assert.Error(t, overlay.NodeSelectionConfig{NewNodeFraction: -0.5}.Verify())
+	assert.Error(t, overlay.NodeSelectionConfig{NewNodeAuditThreshold: -1}.Verify())
+
+	// the deprecated key overrides the new one when it's set
+	config = overlay.NodeSelectionConfig{NewNodeFraction: 0.05, NewNodePercentage: 0.5}
+	require.NoError(t, config.Verify())
+	assert.Equal(t, 20, config.NewNodeCount(40))
+	assert.Error(t, overlay.NodeSelectionConfig{NewNodeFraction: 0.05, NewNodePercentage: 2}.Verify())
+}
+
+func TestSubnet(t *testing.T) {
+	for _, tt := range []struct {
+		subnets overlay.SubnetConfig
+		address string
+		subnet  string
+	}{
+		{overlay.DefaultSubnets, "10.0.1.15:7777", "10.0.1.0/24"},
+		{overlay.DefaultSubnets, "10.0.1.15", "10.0.1.0/24"},
+		{overlay.DefaultSubnets, "[2001:db8:1:2:3:4:5:6]:7777", "2001:db8:1:2::/64"},
+		{overlay.DefaultSubnets, "storj.example.com:7777", "storj.example.com"},
+		{overlay.SubnetConfig{IPv4Bits: 16, IPv6Bits: 48}, "10.0.1.15:7777", "10.0.0.0/16"},
+		{overlay.SubnetConfig{IPv4Bits: 16, IPv6Bits: 48}, "[2001:db8:1:2:3:4:5:6]:7777", "2001:db8:1::/48"},
+	} {
+		assert.Equal(t, tt.subnet, tt.subnets.Subnet(tt.address), tt.address)
+	}
+
+	assert.NoError(t, overlay.DefaultSubnets.Verify())
+	assert.Error(t, overlay.SubnetConfig{IPv4Bits: 33, IPv6Bits: 64}.Verify())
+}
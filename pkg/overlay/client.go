@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/zeebo/errs"
+	"google.golang.org/grpc"
 
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
@@ -47,17 +48,18 @@ type Options struct {
 	AuditSuccess float64
 	AuditCount   int64
 	Excluded     storj.NodeIDList
+	Placement    *pb.PlacementConstraints
 }
 
 // NewClient returns a new intialized Overlay Client
-func NewClient(identity *identity.FullIdentity, address string) (Client, error) {
-	return NewClientContext(context.TODO(), identity, address)
+func NewClient(identity *identity.FullIdentity, address string, opts ...grpc.DialOption) (Client, error) {
+	return NewClientContext(context.TODO(), identity, address, opts...)
 }
 
 // NewClientContext returns a new intialized Overlay Client
-func NewClientContext(ctx context.Context, identity *identity.FullIdentity, address string) (Client, error) {
+func NewClientContext(ctx context.Context, identity *identity.FullIdentity, address string, opts ...grpc.DialOption) (Client, error) {
 	tc := transport.NewClient(identity, &Cache{}) // add overlay to transport client as observer
-	conn, err := tc.DialAddress(ctx, address)
+	conn, err := tc.DialAddress(ctx, address, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +85,7 @@ func (client *client) Choose(ctx context.Context, op Options) ([]*pb.Node, error
 			Amount:        int64(op.Amount),
 			Restrictions:  &pb.NodeRestrictions{FreeDisk: op.Space, FreeBandwidth: op.Bandwidth},
 			ExcludedNodes: exIDs,
+			Placement:     op.Placement,
 		},
 	})
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/utils"
 )
@@ -25,6 +26,19 @@ var (
 type Config struct {
 	RefreshInterval time.Duration `help:"the interval at which the cache refreshes itself in seconds" default:"1s"`
 	Node            NodeSelectionConfig
+	Ping            PingConfig
+	Subnets         SubnetConfig
+}
+
+// PingConfig is a configuration struct for tracking the ping success rate of nodes
+type PingConfig struct {
+	Decay            float64 `help:"the weight of past pings in a node's ping success rate, 0 only considers the last ping" default:"0.5"`
+	OfflineThreshold float64 `help:"the ping success rate below which a node is considered offline" default:"0.5"`
+}
+
+// Offline returns whether a node with the given ping stats is considered offline
+func (config PingConfig) Offline(stats *PingStats) bool {
+	return stats.SuccessRate < config.OfflineThreshold
 }
 
 // LookupConfig is a configuration struct for querying the overlay cache with one or more node IDs
@@ -33,16 +47,44 @@ type LookupConfig struct {
 	Delimiter     string `help:"delimiter used for parsing node IDs" default:","`
 }
 
-// NodeSelectionConfig is a configuration struct to determine the minimum
-// values for nodes to select
+// NodeSelectionConfig is a configuration struct to determine how many new
+// nodes are selected, the reputation requirements of nodes are configured
+// with the reputation service
 type NodeSelectionConfig struct {
-	UptimeRatio       float64 `help:"a node's ratio of being up/online vs. down/offline" default:"0"`
-	UptimeCount       int64   `help:"the number of times a node's uptime has been checked" default:"0"`
-	AuditSuccessRatio float64 `help:"a node's ratio of successful audits" default:"0"`
-	AuditCount        int64   `help:"the number of times a node has been audited" default:"0"`
+	NewNodeAuditThreshold int64   `help:"the number of audits a node must have to not be considered a New Node, raises the reputation vetting audit count" default:"0"`
+	NewNodeFraction       float64 `help:"the fraction of the nodes selected for each upload which are new nodes, the others are vetted nodes" default:"0.05"`
+	// NewNodePercentage is the name NewNodeFraction had before, it's kept so that existing configs still work
+	NewNodePercentage float64 `help:"deprecated, use new-node-fraction instead, it overrides new-node-fraction when it isn't 0" default:"0"`
+
+	DistinctSubnets bool `help:"select at most one node per subnet for the pieces of a segment" default:"false"`
+
+	MinimumDisk      memory.Size `help:"how much disk space a node must report to be selected, nodes reporting less are considered full" default:"500MB"`
+	MinimumBandwidth memory.Size `help:"how much bandwidth a node must report to be selected, nodes reporting less are considered full" default:"500MB"`
+	RequireWallet    bool        `help:"only select nodes which reported an operator wallet" default:"true"`
+}
 
-	NewNodeAuditThreshold int64   `help:"the number of audits a node must have to not be considered a New Node" default:"0"`
-	NewNodePercentage     float64 `help:"the percentage of new nodes allowed per request" default:"0.05"` // TODO: fix, this is not percentage, it's ratio
+// Verify verifies whether the node selection parameters are valid
+func (config NodeSelectionConfig) Verify() error {
+	if config.NewNodeAuditThreshold < 0 {
+		return Error.New("new node audit threshold can't be negative, got %d", config.NewNodeAuditThreshold)
+	}
+	if fraction := config.newNodeFraction(); fraction < 0 || fraction > 1 {
+		return Error.New("new node fraction must be in [0, 1], got %v", fraction)
+	}
+	return nil
+}
+
+// NewNodeCount returns how many of count selected nodes are new nodes
+func (config NodeSelectionConfig) NewNodeCount(count int) int {
+	return int(float64(count) * config.newNodeFraction())
+}
+
+// newNodeFraction returns NewNodeFraction, unless the deprecated NewNodePercentage is set
+func (config NodeSelectionConfig) newNodeFraction() float64 {
+	if config.NewNodePercentage != 0 {
+		return config.NewNodePercentage
+	}
+	return config.NewNodeFraction
 }
 
 // ParseIDs converts the base58check encoded node ID strings from the config into node IDs
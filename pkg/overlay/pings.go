@@ -0,0 +1,42 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// PingDB stores the outcome of the periodic pings to nodes
+type PingDB interface {
+	// Get returns the ping stats of a node
+	Get(ctx context.Context, nodeID storj.NodeID) (*PingStats, error)
+	// Record updates the ping stats of a node with the outcome of a single ping, weighting the previous success rate by decay
+	Record(ctx context.Context, nodeID storj.NodeID, success bool, decay float64) (*PingStats, error)
+}
+
+// PingStats contains the exponentially decayed ping success rate of a node
+type PingStats struct {
+	NodeID        storj.NodeID
+	SuccessRate   float64
+	PingCount     int64
+	LastPingAt    time.Time
+	LastSuccessAt time.Time
+}
+
+// UpdateSuccessRate returns the success rate after a single ping, new nodes
+// start with a success rate of 1 so that a single failed first ping doesn't
+// take them offline
+func UpdateSuccessRate(rate float64, count int64, success bool, decay float64) float64 {
+	outcome := 0.0
+	if success {
+		outcome = 1
+	}
+	if count <= 0 {
+		rate = 1
+	}
+	return decay*rate + (1-decay)*outcome
+}
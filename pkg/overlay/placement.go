@@ -0,0 +1,171 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"net"
+	"sort"
+	"strconv"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// maxPlacementAttempts is how many times the database is queried for
+// replacements of nodes rejected by the placement constraints
+const maxPlacementAttempts = 5
+
+// PlacementConfig is a configuration struct for constraining where the pieces
+// of a segment are placed in relation to each other
+type PlacementConfig struct {
+	DistinctSubnets bool `help:"don't select nodes in the same /24 IPv4 or /64 IPv6 subnet as other pieces of the segment" default:"false"`
+	DistinctRegions bool `help:"don't select nodes in the same operator declared region as other pieces of the segment" default:"false"`
+}
+
+// Constraints returns the placement constraints sent with node selection
+// requests, nil when nothing is constrained
+func (config PlacementConfig) Constraints() *pb.PlacementConstraints {
+	if !config.DistinctSubnets && !config.DistinctRegions {
+		return nil
+	}
+	return &pb.PlacementConstraints{
+		DistinctSubnets: config.DistinctSubnets,
+		DistinctRegions: config.DistinctRegions,
+	}
+}
+
+// SubnetConfig is a configuration struct for the subnets nodes are grouped
+// in, all nodes of a subnet are treated as a single failure domain
+type SubnetConfig struct {
+	IPv4Bits int `help:"prefix length of the IPv4 subnets whose nodes are treated as a single failure domain" default:"24"`
+	IPv6Bits int `help:"prefix length of the IPv6 subnets whose nodes are treated as a single failure domain" default:"64"`
+}
+
+// DefaultSubnets groups nodes by their /24 IPv4 or /64 IPv6 subnet
+var DefaultSubnets = SubnetConfig{IPv4Bits: 24, IPv6Bits: 64}
+
+// Subnet returns the subnet of a node address, addresses which aren't IPs
+// are returned as their host name
+func (config SubnetConfig) Subnet(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return host
+	}
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return ipv4.Mask(net.CIDRMask(config.IPv4Bits, 32)).String() + "/" + strconv.Itoa(config.IPv4Bits)
+	}
+	return ip.Mask(net.CIDRMask(config.IPv6Bits, 128)).String() + "/" + strconv.Itoa(config.IPv6Bits)
+}
+
+// Verify verifies whether the subnet prefix lengths are valid
+func (config SubnetConfig) Verify() error {
+	if config.IPv4Bits < 0 || config.IPv4Bits > 32 {
+		return Error.New("invalid IPv4 subnet prefix length %d", config.IPv4Bits)
+	}
+	if config.IPv6Bits < 0 || config.IPv6Bits > 128 {
+		return Error.New("invalid IPv6 subnet prefix length %d", config.IPv6Bits)
+	}
+	return nil
+}
+
+// placement keeps track of the subnets and regions occupied by the pieces of a segment
+type placement struct {
+	constraints *pb.PlacementConstraints
+	subnetOf    SubnetConfig
+	subnets     map[string]bool
+	regions     map[string]bool
+}
+
+// newPlacement creates a placement with the subnets and regions of the existing nodes occupied
+func newPlacement(constraints *pb.PlacementConstraints, subnetOf SubnetConfig, existing []*pb.Node) *placement {
+	p := &placement{
+		constraints: constraints,
+		subnetOf:    subnetOf,
+		subnets:     make(map[string]bool),
+		regions:     make(map[string]bool),
+	}
+	for _, node := range existing {
+		if node != nil {
+			p.add(node)
+		}
+	}
+	return p
+}
+
+// add occupies the subnet and region of the node, false is returned without
+// changing anything when either is already occupied. Nodes without an address
+// or region are not constrained by it.
+func (p *placement) add(node *pb.Node) bool {
+	var subnet, region string
+	if p.constraints.GetDistinctSubnets() {
+		subnet = node.GetLastNet()
+		if address := node.GetAddress().GetAddress(); subnet == "" && address != "" {
+			subnet = p.subnetOf.Subnet(address)
+		}
+	}
+	if p.constraints.GetDistinctRegions() {
+		region = node.GetMetadata().GetRegion()
+	}
+
+	if (subnet != "" && p.subnets[subnet]) || (region != "" && p.regions[region]) {
+		return false
+	}
+	if subnet != "" {
+		p.subnets[subnet] = true
+	}
+	if region != "" {
+		p.regions[region] = true
+	}
+	return true
+}
+
+// occupiedSubnets returns the subnets of the placed nodes when they are constrained
+func (p *placement) occupiedSubnets() []string {
+	if !p.constraints.GetDistinctSubnets() {
+		return nil
+	}
+	subnets := make([]string, 0, len(p.subnets))
+	for subnet := range p.subnets {
+		subnets = append(subnets, subnet)
+	}
+	sort.Strings(subnets)
+	return subnets
+}
+
+// nodeSelection selects count nodes which aren't excluded
+type nodeSelection func(count int, excluded storj.NodeIDList) ([]*pb.Node, error)
+
+// placedSelection wraps selectNodes to only return nodes which satisfy the
+// placement, rejected nodes are excluded and replaced in further attempts
+func placedSelection(p *placement, selectNodes nodeSelection) nodeSelection {
+	return func(count int, excluded storj.NodeIDList) ([]*pb.Node, error) {
+		excluded = append(storj.NodeIDList{}, excluded...)
+
+		var selected []*pb.Node
+		for attempt := 0; attempt < maxPlacementAttempts && len(selected) < count; attempt++ {
+			requested := count - len(selected)
+			nodes, err := selectNodes(requested, excluded)
+			if err != nil {
+				return selected, err
+			}
+
+			for _, node := range nodes {
+				excluded = append(excluded, node.Id)
+				if p.add(node) {
+					selected = append(selected, node)
+				}
+			}
+
+			if len(nodes) < requested {
+				break
+			}
+		}
+		return selected, nil
+	}
+}
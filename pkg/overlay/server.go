@@ -72,12 +72,21 @@ type NodeCriteria struct {
 	FreeBandwidth int64
 	FreeDisk      int64
 
-	AuditCount         int64
-	AuditSuccessRatio  float64
-	UptimeCount        int64
-	UptimeSuccessRatio float64
+	// RequireWallet skips the nodes which didn't report an operator wallet
+	RequireWallet bool
+
+	// AuditCount is the number of audits of vetted nodes
+	AuditCount int64
+
+	AuditReputationScore  float64
+	UptimeReputationScore float64
 
 	Excluded []storj.NodeID
+
+	// DistinctSubnets selects at most one node of each subnet,
+	// excluding the nodes of ExcludedSubnets
+	DistinctSubnets bool
+	ExcludedSubnets []string
 }
 
 // NewNodeCriteria are the requirement for selecting new nodes
@@ -87,9 +96,20 @@ type NewNodeCriteria struct {
 	FreeBandwidth int64
 	FreeDisk      int64
 
+	// RequireWallet skips the nodes which didn't report an operator wallet
+	RequireWallet bool
+
 	AuditThreshold int64
 
+	AuditReputationScore  float64
+	UptimeReputationScore float64
+
 	Excluded []storj.NodeID
+
+	// DistinctSubnets selects at most one node of each subnet,
+	// excluding the nodes of ExcludedSubnets
+	DistinctSubnets bool
+	ExcludedSubnets []string
 }
 
 // FindStorageNodes searches the overlay network for nodes that meet the provided requirements
@@ -15,6 +15,7 @@ import (
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -71,9 +72,10 @@ func TestNodeSelection(t *testing.T) {
 		time.Sleep(2 * time.Second)
 
 		// This sets a reputable audit count for a certain number of nodes.
+		model := reputation.Model{Alpha0: 1, Beta0: 0, Lambda: 0.95, Weight: 1, DQ: 0.6}
 		for i, node := range planet.StorageNodes {
 			for k := 0; k < i; k++ {
-				_, err := satellite.DB.StatDB().UpdateAuditSuccess(ctx, node.ID(), true)
+				_, err := satellite.DB.StatDB().UpdateAuditSuccess(ctx, node.ID(), true, model)
 				assert.NoError(t, err)
 			}
 		}
@@ -92,77 +94,95 @@ func TestNodeSelection(t *testing.T) {
 			ShouldFailWith *errs.Class
 		}
 
+		// node i has been audited i times, with the new node audit threshold T
+		// the nodes 0..T-1 are new nodes and the others are vetted
 		for i, tt := range []test{
-			{ // all reputable nodes, only reputable nodes requested
+			{ // all vetted nodes, only vetted nodes requested
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 0,
-					NewNodePercentage:     0,
+					NewNodeFraction:       0,
 				},
 				RequestCount:  5,
 				ExpectedCount: 5,
 			},
-			{ // all reputable nodes, reputable and new nodes requested
+			{ // all vetted nodes, vetted nodes replace the missing new nodes
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 0,
-					NewNodePercentage:     1,
+					NewNodeFraction:       1,
 				},
 				RequestCount:  5,
 				ExpectedCount: 5,
 			},
-			{ // all reputable nodes except one, reputable and new nodes requested
+			{ // all vetted nodes except one, vetted nodes replace the missing new nodes
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 1,
-					NewNodePercentage:     1,
+					NewNodeFraction:       1,
 				},
 				RequestCount:  5,
-				ExpectedCount: 6,
+				ExpectedCount: 5,
 			},
-			{ // 50-50 reputable and new nodes, reputable and new nodes requested (new node ratio 1.0)
+			{ // 50-50 vetted and new nodes, only new nodes requested
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 5,
-					NewNodePercentage:     1,
+					NewNodeFraction:       1,
 				},
 				RequestCount:  2,
-				ExpectedCount: 4,
+				ExpectedCount: 2,
 			},
-			{ // 50-50 reputable and new nodes, reputable and new nodes requested (new node ratio 0.5)
+			{ // 50-50 vetted and new nodes, half of the nodes are new nodes
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 5,
-					NewNodePercentage:     0.5,
+					NewNodeFraction:       0.5,
 				},
 				RequestCount:  4,
-				ExpectedCount: 6,
+				ExpectedCount: 4,
 			},
-			{ // all new nodes except one, reputable and new nodes requested (happy path)
+			{ // 50-50 vetted and new nodes, a fifth of the nodes are new nodes
+				Preferences: overlay.NodeSelectionConfig{
+					NewNodeAuditThreshold: 5,
+					NewNodeFraction:       0.2,
+				},
+				RequestCount:  5,
+				ExpectedCount: 5,
+			},
+			{ // mostly new nodes, enough vetted nodes
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 8,
-					NewNodePercentage:     1,
+					NewNodeFraction:       0.5,
 				},
-				RequestCount:  1,
-				ExpectedCount: 2,
+				RequestCount:  4,
+				ExpectedCount: 4,
 			},
-			{ // all new nodes except one, reputable and new nodes requested (not happy path)
+			{ // all new nodes except one, not enough vetted nodes
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 9,
-					NewNodePercentage:     1,
+					NewNodeFraction:       0.5,
 				},
-				RequestCount:   2,
+				RequestCount:   4,
 				ExpectedCount:  3,
 				ShouldFailWith: &overlay.ErrNotEnoughNodes,
 			},
-			{ // all new nodes, reputable and new nodes requested
+			{ // all new nodes, only new nodes requested
+				Preferences: overlay.NodeSelectionConfig{
+					NewNodeAuditThreshold: 50,
+					NewNodeFraction:       1,
+				},
+				RequestCount:  2,
+				ExpectedCount: 2,
+			},
+			{ // all new nodes, only vetted nodes requested
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 50,
-					NewNodePercentage:     1,
+					NewNodeFraction:       0,
 				},
 				RequestCount:   2,
-				ExpectedCount:  2,
+				ExpectedCount:  0,
 				ShouldFailWith: &overlay.ErrNotEnoughNodes,
 			},
 			{ // audit threshold edge case (1)
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 9,
-					NewNodePercentage:     0,
+					NewNodeFraction:       0,
 				},
 				RequestCount:  1,
 				ExpectedCount: 1,
@@ -170,7 +190,7 @@ func TestNodeSelection(t *testing.T) {
 			{ // audit threshold edge case (2)
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 0,
-					NewNodePercentage:     1,
+					NewNodeFraction:       1,
 				},
 				RequestCount:  1,
 				ExpectedCount: 1,
@@ -178,7 +198,7 @@ func TestNodeSelection(t *testing.T) {
 			{ // excluded node ids being excluded
 				Preferences: overlay.NodeSelectionConfig{
 					NewNodeAuditThreshold: 5,
-					NewNodePercentage:     0,
+					NewNodeFraction:       0,
 				},
 				ExcludeCount:   7,
 				RequestCount:   5,
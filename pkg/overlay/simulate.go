@@ -0,0 +1,123 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"sort"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// unknownRegion is the region of nodes which don't declare one
+const unknownRegion = "unknown"
+
+// SelectionShare is how many of the selected pieces a node, subnet or region got
+type SelectionShare struct {
+	Group  string  `json:"group"`
+	Pieces int64   `json:"pieces"`
+	Share  float64 `json:"share"` // fraction of all selected pieces
+}
+
+// SelectionStats is the distribution of the pieces of simulated node selections
+type SelectionStats struct {
+	Runs     int   `json:"runs"`
+	Failures int   `json:"failures"` // selections which found too few nodes
+	Pieces   int64 `json:"pieces"`
+
+	// Gini is the Gini coefficient of the pieces of the selected nodes, 0 when
+	// they got the same number of pieces, towards 1 when a few got most pieces
+	Gini float64 `json:"gini"`
+
+	Nodes   []SelectionShare `json:"nodes"`
+	Subnets []SelectionShare `json:"subnets"`
+	Regions []SelectionShare `json:"regions"`
+}
+
+// SimulateSelection runs the node selection of req runs times against the
+// current nodes without storing anything, the shares are ordered by the most
+// pieces first
+func (cache *Cache) SimulateSelection(ctx context.Context, req *pb.FindStorageNodesRequest, preferences *NodeSelectionConfig, runs int) (stats *SelectionStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodes := map[string]int64{}
+	subnets := map[string]int64{}
+	regions := map[string]int64{}
+
+	stats = &SelectionStats{Runs: runs}
+	for run := 0; run < runs; run++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		selected, err := cache.FindStorageNodes(ctx, req, preferences)
+		if ErrNotEnoughNodes.Has(err) {
+			stats.Failures++
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, node := range selected {
+			subnet := node.GetLastNet()
+			if subnet == "" {
+				subnet = cache.subnets.Subnet(node.GetAddress().GetAddress())
+			}
+			region := node.GetMetadata().GetRegion()
+			if region == "" {
+				region = unknownRegion
+			}
+
+			nodes[node.Id.String()]++
+			subnets[subnet]++
+			regions[region]++
+			stats.Pieces++
+		}
+	}
+
+	stats.Nodes = shares(nodes, stats.Pieces)
+	stats.Subnets = shares(subnets, stats.Pieces)
+	stats.Regions = shares(regions, stats.Pieces)
+	stats.Gini = gini(stats.Nodes)
+	return stats, nil
+}
+
+// shares converts the pieces of groups into shares of total, the most pieces first
+func shares(groups map[string]int64, total int64) []SelectionShare {
+	list := make([]SelectionShare, 0, len(groups))
+	for group, pieces := range groups {
+		list = append(list, SelectionShare{
+			Group:  group,
+			Pieces: pieces,
+			Share:  float64(pieces) / float64(total),
+		})
+	}
+	sort.Slice(list, func(i, k int) bool {
+		if list[i].Pieces == list[k].Pieces {
+			return list[i].Group < list[k].Group
+		}
+		return list[i].Pieces > list[k].Pieces
+	})
+	return list
+}
+
+// gini returns the Gini coefficient of the pieces of shares, which are
+// ordered by the most pieces first
+func gini(shares []SelectionShare) float64 {
+	n := len(shares)
+	if n == 0 {
+		return 0
+	}
+
+	var total, weighted float64
+	for i, share := range shares {
+		// rank in ascending order starting at 1
+		rank := float64(n - i)
+		total += float64(share.Pieces)
+		weighted += rank * float64(share.Pieces)
+	}
+	if total == 0 {
+		return 0
+	}
+	return (2*weighted)/(float64(n)*total) - float64(n+1)/float64(n)
+}
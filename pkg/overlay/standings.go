@@ -0,0 +1,35 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// StandingDB stores since when nodes have their current standing
+type StandingDB interface {
+	// Get returns the last recorded standing of a node
+	Get(ctx context.Context, nodeID storj.NodeID) (*NodeStanding, error)
+	// Set records a new standing of a node
+	Set(ctx context.Context, standing NodeStanding) error
+}
+
+// NodeStanding is the status a node has with the satellite, why it has it
+// and since when
+type NodeStanding struct {
+	NodeID storj.NodeID
+	Status pb.NodeStanding_Status
+	Reason string
+	Since  time.Time
+}
+
+// Standing returns the status of node derived from its reputation and the
+// reason for it
+func (cache *Cache) Standing(node *pb.Node) (status pb.NodeStanding_Status, reason string) {
+	return cache.reputation.Standing(node.GetReputation())
+}
@@ -58,3 +58,43 @@ func (m *RenterBandwidthAllocation) SetCerts(certs [][]byte) {
 func (m *RenterBandwidthAllocation) SetSignature(signature []byte) {
 	m.Signature = signature
 }
+
+//IsValid returns true if the action is one of the defined bandwidth actions
+func (action BandwidthAction) IsValid() bool {
+	_, ok := BandwidthAction_name[int32(action)]
+	return ok
+}
+
+//IsGet returns true if the action allows downloading a piece
+func (action BandwidthAction) IsGet() bool {
+	switch action {
+	case BandwidthAction_GET, BandwidthAction_GET_AUDIT, BandwidthAction_GET_REPAIR:
+		return true
+	}
+	return false
+}
+
+//IsPut returns true if the action allows uploading a piece
+func (action BandwidthAction) IsPut() bool {
+	switch action {
+	case BandwidthAction_PUT, BandwidthAction_PUT_REPAIR:
+		return true
+	}
+	return false
+}
+
+//IsCustomer returns true if the action is customer traffic, audit and repair
+//traffic is issued by the satellite to itself and paid for differently
+func (action BandwidthAction) IsCustomer() bool {
+	return action == BandwidthAction_PUT || action == BandwidthAction_GET
+}
+
+//SetCerts updates the certs field, completing the auth.SignedMsg interface
+func (m *Notice) SetCerts(certs [][]byte) {
+	m.Certs = certs
+}
+
+//SetSignature updates the signature field, completing the auth.SignedMsg interface
+func (m *Notice) SetSignature(signature []byte) {
+	m.Signature = signature
+}
@@ -22,6 +22,8 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type InjuredSegment struct {
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	LostPieces           []int32  `protobuf:"varint,2,rep,packed,name=lost_pieces,json=lostPieces,proto3" json:"lost_pieces,omitempty"`
+	HealthyPieces        int32    `protobuf:"varint,3,opt,name=healthy_pieces,json=healthyPieces,proto3" json:"healthy_pieces,omitempty"`
+	Critical             bool     `protobuf:"varint,4,opt,name=critical,proto3" json:"critical,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -65,6 +67,20 @@ func (m *InjuredSegment) GetLostPieces() []int32 {
 	return nil
 }
 
+func (m *InjuredSegment) GetHealthyPieces() int32 {
+	if m != nil {
+		return m.HealthyPieces
+	}
+	return 0
+}
+
+func (m *InjuredSegment) GetCritical() bool {
+	if m != nil {
+		return m.Critical
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*InjuredSegment)(nil), "repair.InjuredSegment")
 }
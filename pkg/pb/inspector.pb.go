@@ -24,1140 +24,3410 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
-// GetStats
-type GetStatsRequest struct {
-	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+// ListIrreparableSegments
+type ListIrreparableSegmentsRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetStatsRequest) Reset()         { *m = GetStatsRequest{} }
-func (m *GetStatsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetStatsRequest) ProtoMessage()    {}
-func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{0}
+func (m *ListIrreparableSegmentsRequest) Reset()         { *m = ListIrreparableSegmentsRequest{} }
+func (m *ListIrreparableSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListIrreparableSegmentsRequest) ProtoMessage()    {}
+func (*ListIrreparableSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{0}
 }
-func (m *GetStatsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetStatsRequest.Unmarshal(m, b)
+func (m *ListIrreparableSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIrreparableSegmentsRequest.Unmarshal(m, b)
 }
-func (m *GetStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetStatsRequest.Marshal(b, m, deterministic)
+func (m *ListIrreparableSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIrreparableSegmentsRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetStatsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStatsRequest.Merge(dst, src)
+func (dst *ListIrreparableSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIrreparableSegmentsRequest.Merge(dst, src)
 }
-func (m *GetStatsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetStatsRequest.Size(m)
+func (m *ListIrreparableSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListIrreparableSegmentsRequest.Size(m)
 }
-func (m *GetStatsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetStatsRequest.DiscardUnknown(m)
+func (m *ListIrreparableSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIrreparableSegmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetStatsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListIrreparableSegmentsRequest proto.InternalMessageInfo
 
-type GetStatsResponse struct {
-	AuditCount           int64    `protobuf:"varint,1,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
-	AuditRatio           float64  `protobuf:"fixed64,2,opt,name=audit_ratio,json=auditRatio,proto3" json:"audit_ratio,omitempty"`
-	UptimeCount          int64    `protobuf:"varint,3,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
-	UptimeRatio          float64  `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+func (m *ListIrreparableSegmentsRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *ListIrreparableSegmentsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListIrreparableSegmentsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type IrreparableSegment struct {
+	Path                 []byte   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	LostPieces           int64    `protobuf:"varint,2,opt,name=lost_pieces,json=lostPieces,proto3" json:"lost_pieces,omitempty"`
+	LastRepairSeconds    int64    `protobuf:"varint,3,opt,name=last_repair_seconds,json=lastRepairSeconds,proto3" json:"last_repair_seconds,omitempty"`
+	RepairAttemptCount   int64    `protobuf:"varint,4,opt,name=repair_attempt_count,json=repairAttemptCount,proto3" json:"repair_attempt_count,omitempty"`
+	LastError            string   `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetStatsResponse) Reset()         { *m = GetStatsResponse{} }
-func (m *GetStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetStatsResponse) ProtoMessage()    {}
-func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{1}
+func (m *IrreparableSegment) Reset()         { *m = IrreparableSegment{} }
+func (m *IrreparableSegment) String() string { return proto.CompactTextString(m) }
+func (*IrreparableSegment) ProtoMessage()    {}
+func (*IrreparableSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{1}
 }
-func (m *GetStatsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetStatsResponse.Unmarshal(m, b)
+func (m *IrreparableSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IrreparableSegment.Unmarshal(m, b)
 }
-func (m *GetStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetStatsResponse.Marshal(b, m, deterministic)
+func (m *IrreparableSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IrreparableSegment.Marshal(b, m, deterministic)
 }
-func (dst *GetStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetStatsResponse.Merge(dst, src)
+func (dst *IrreparableSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IrreparableSegment.Merge(dst, src)
 }
-func (m *GetStatsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetStatsResponse.Size(m)
+func (m *IrreparableSegment) XXX_Size() int {
+	return xxx_messageInfo_IrreparableSegment.Size(m)
 }
-func (m *GetStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetStatsResponse.DiscardUnknown(m)
+func (m *IrreparableSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_IrreparableSegment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetStatsResponse proto.InternalMessageInfo
+var xxx_messageInfo_IrreparableSegment proto.InternalMessageInfo
 
-func (m *GetStatsResponse) GetAuditCount() int64 {
+func (m *IrreparableSegment) GetPath() []byte {
 	if m != nil {
-		return m.AuditCount
+		return m.Path
 	}
-	return 0
+	return nil
 }
 
-func (m *GetStatsResponse) GetAuditRatio() float64 {
+func (m *IrreparableSegment) GetLostPieces() int64 {
 	if m != nil {
-		return m.AuditRatio
+		return m.LostPieces
 	}
 	return 0
 }
 
-func (m *GetStatsResponse) GetUptimeCount() int64 {
+func (m *IrreparableSegment) GetLastRepairSeconds() int64 {
 	if m != nil {
-		return m.UptimeCount
+		return m.LastRepairSeconds
 	}
 	return 0
 }
 
-func (m *GetStatsResponse) GetUptimeRatio() float64 {
+func (m *IrreparableSegment) GetRepairAttemptCount() int64 {
 	if m != nil {
-		return m.UptimeRatio
+		return m.RepairAttemptCount
 	}
 	return 0
 }
 
-// CreateStats
-type CreateStatsRequest struct {
-	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
-	AuditCount           int64    `protobuf:"varint,2,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
-	AuditSuccessCount    int64    `protobuf:"varint,3,opt,name=audit_success_count,json=auditSuccessCount,proto3" json:"audit_success_count,omitempty"`
-	UptimeCount          int64    `protobuf:"varint,4,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
-	UptimeSuccessCount   int64    `protobuf:"varint,5,opt,name=uptime_success_count,json=uptimeSuccessCount,proto3" json:"uptime_success_count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *IrreparableSegment) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
 }
 
-func (m *CreateStatsRequest) Reset()         { *m = CreateStatsRequest{} }
-func (m *CreateStatsRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateStatsRequest) ProtoMessage()    {}
-func (*CreateStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{2}
+type ListIrreparableSegmentsResponse struct {
+	Segments             []*IrreparableSegment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (m *CreateStatsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateStatsRequest.Unmarshal(m, b)
+
+func (m *ListIrreparableSegmentsResponse) Reset()         { *m = ListIrreparableSegmentsResponse{} }
+func (m *ListIrreparableSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListIrreparableSegmentsResponse) ProtoMessage()    {}
+func (*ListIrreparableSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{2}
 }
-func (m *CreateStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateStatsRequest.Marshal(b, m, deterministic)
+func (m *ListIrreparableSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListIrreparableSegmentsResponse.Unmarshal(m, b)
 }
-func (dst *CreateStatsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateStatsRequest.Merge(dst, src)
+func (m *ListIrreparableSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListIrreparableSegmentsResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateStatsRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateStatsRequest.Size(m)
+func (dst *ListIrreparableSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIrreparableSegmentsResponse.Merge(dst, src)
 }
-func (m *CreateStatsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateStatsRequest.DiscardUnknown(m)
+func (m *ListIrreparableSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListIrreparableSegmentsResponse.Size(m)
+}
+func (m *ListIrreparableSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIrreparableSegmentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateStatsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListIrreparableSegmentsResponse proto.InternalMessageInfo
 
-func (m *CreateStatsRequest) GetAuditCount() int64 {
+func (m *ListIrreparableSegmentsResponse) GetSegments() []*IrreparableSegment {
 	if m != nil {
-		return m.AuditCount
+		return m.Segments
 	}
-	return 0
+	return nil
 }
 
-func (m *CreateStatsRequest) GetAuditSuccessCount() int64 {
+// ObjectHealth
+type ObjectHealthRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Bucket               []byte   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,3,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectHealthRequest) Reset()         { *m = ObjectHealthRequest{} }
+func (m *ObjectHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectHealthRequest) ProtoMessage()    {}
+func (*ObjectHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{3}
+}
+func (m *ObjectHealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectHealthRequest.Unmarshal(m, b)
+}
+func (m *ObjectHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectHealthRequest.Marshal(b, m, deterministic)
+}
+func (dst *ObjectHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectHealthRequest.Merge(dst, src)
+}
+func (m *ObjectHealthRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectHealthRequest.Size(m)
+}
+func (m *ObjectHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectHealthRequest proto.InternalMessageInfo
+
+func (m *ObjectHealthRequest) GetProjectId() []byte {
 	if m != nil {
-		return m.AuditSuccessCount
+		return m.ProjectId
 	}
-	return 0
+	return nil
 }
 
-func (m *CreateStatsRequest) GetUptimeCount() int64 {
+func (m *ObjectHealthRequest) GetBucket() []byte {
 	if m != nil {
-		return m.UptimeCount
+		return m.Bucket
 	}
-	return 0
+	return nil
 }
 
-func (m *CreateStatsRequest) GetUptimeSuccessCount() int64 {
+func (m *ObjectHealthRequest) GetEncryptedPath() []byte {
 	if m != nil {
-		return m.UptimeSuccessCount
+		return m.EncryptedPath
 	}
-	return 0
+	return nil
 }
 
-type CreateStatsResponse struct {
+type PiecePlacement struct {
+	PieceNum             int32    `protobuf:"varint,1,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	NodeId               NodeID   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Online               bool     `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateStatsResponse) Reset()         { *m = CreateStatsResponse{} }
-func (m *CreateStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateStatsResponse) ProtoMessage()    {}
-func (*CreateStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{3}
+func (m *PiecePlacement) Reset()         { *m = PiecePlacement{} }
+func (m *PiecePlacement) String() string { return proto.CompactTextString(m) }
+func (*PiecePlacement) ProtoMessage()    {}
+func (*PiecePlacement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{4}
 }
-func (m *CreateStatsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateStatsResponse.Unmarshal(m, b)
+func (m *PiecePlacement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PiecePlacement.Unmarshal(m, b)
 }
-func (m *CreateStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateStatsResponse.Marshal(b, m, deterministic)
+func (m *PiecePlacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PiecePlacement.Marshal(b, m, deterministic)
 }
-func (dst *CreateStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateStatsResponse.Merge(dst, src)
+func (dst *PiecePlacement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PiecePlacement.Merge(dst, src)
 }
-func (m *CreateStatsResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateStatsResponse.Size(m)
+func (m *PiecePlacement) XXX_Size() int {
+	return xxx_messageInfo_PiecePlacement.Size(m)
 }
-func (m *CreateStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateStatsResponse.DiscardUnknown(m)
+func (m *PiecePlacement) XXX_DiscardUnknown() {
+	xxx_messageInfo_PiecePlacement.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateStatsResponse proto.InternalMessageInfo
+var xxx_messageInfo_PiecePlacement proto.InternalMessageInfo
 
-// CountNodes
-type CountNodesResponse struct {
-	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PiecePlacement) GetPieceNum() int32 {
+	if m != nil {
+		return m.PieceNum
+	}
+	return 0
 }
 
-func (m *CountNodesResponse) Reset()         { *m = CountNodesResponse{} }
-func (m *CountNodesResponse) String() string { return proto.CompactTextString(m) }
-func (*CountNodesResponse) ProtoMessage()    {}
-func (*CountNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{4}
+func (m *PiecePlacement) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
 }
-func (m *CountNodesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CountNodesResponse.Unmarshal(m, b)
+
+type SegmentHealth struct {
+	SegmentIndex         int64             `protobuf:"varint,1,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	Path                 []byte            `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Inline               bool              `protobuf:"varint,3,opt,name=inline,proto3" json:"inline,omitempty"`
+	MinReq               int32             `protobuf:"varint,4,opt,name=min_req,json=minReq,proto3" json:"min_req,omitempty"`
+	RepairThreshold      int32             `protobuf:"varint,5,opt,name=repair_threshold,json=repairThreshold,proto3" json:"repair_threshold,omitempty"`
+	SuccessThreshold     int32             `protobuf:"varint,6,opt,name=success_threshold,json=successThreshold,proto3" json:"success_threshold,omitempty"`
+	Total                int32             `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	LastAuditSeconds     int64             `protobuf:"varint,8,opt,name=last_audit_seconds,json=lastAuditSeconds,proto3" json:"last_audit_seconds,omitempty"`
+	LastRepairSeconds    int64             `protobuf:"varint,9,opt,name=last_repair_seconds,json=lastRepairSeconds,proto3" json:"last_repair_seconds,omitempty"`
+	Pieces               []*PiecePlacement `protobuf:"bytes,10,rep,name=pieces,proto3" json:"pieces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *CountNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CountNodesResponse.Marshal(b, m, deterministic)
+
+func (m *SegmentHealth) Reset()         { *m = SegmentHealth{} }
+func (m *SegmentHealth) String() string { return proto.CompactTextString(m) }
+func (*SegmentHealth) ProtoMessage()    {}
+func (*SegmentHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{5}
 }
-func (dst *CountNodesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CountNodesResponse.Merge(dst, src)
+func (m *SegmentHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentHealth.Unmarshal(m, b)
 }
-func (m *CountNodesResponse) XXX_Size() int {
-	return xxx_messageInfo_CountNodesResponse.Size(m)
+func (m *SegmentHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentHealth.Marshal(b, m, deterministic)
 }
-func (m *CountNodesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CountNodesResponse.DiscardUnknown(m)
+func (dst *SegmentHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentHealth.Merge(dst, src)
+}
+func (m *SegmentHealth) XXX_Size() int {
+	return xxx_messageInfo_SegmentHealth.Size(m)
+}
+func (m *SegmentHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentHealth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CountNodesResponse proto.InternalMessageInfo
+var xxx_messageInfo_SegmentHealth proto.InternalMessageInfo
 
-func (m *CountNodesResponse) GetCount() int64 {
+func (m *SegmentHealth) GetSegmentIndex() int64 {
 	if m != nil {
-		return m.Count
+		return m.SegmentIndex
 	}
 	return 0
 }
 
-type CountNodesRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SegmentHealth) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
 }
 
-func (m *CountNodesRequest) Reset()         { *m = CountNodesRequest{} }
-func (m *CountNodesRequest) String() string { return proto.CompactTextString(m) }
-func (*CountNodesRequest) ProtoMessage()    {}
-func (*CountNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{5}
+func (m *SegmentHealth) GetInline() bool {
+	if m != nil {
+		return m.Inline
+	}
+	return false
 }
-func (m *CountNodesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CountNodesRequest.Unmarshal(m, b)
+
+func (m *SegmentHealth) GetMinReq() int32 {
+	if m != nil {
+		return m.MinReq
+	}
+	return 0
 }
-func (m *CountNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CountNodesRequest.Marshal(b, m, deterministic)
+
+func (m *SegmentHealth) GetRepairThreshold() int32 {
+	if m != nil {
+		return m.RepairThreshold
+	}
+	return 0
 }
-func (dst *CountNodesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CountNodesRequest.Merge(dst, src)
+
+func (m *SegmentHealth) GetSuccessThreshold() int32 {
+	if m != nil {
+		return m.SuccessThreshold
+	}
+	return 0
 }
-func (m *CountNodesRequest) XXX_Size() int {
-	return xxx_messageInfo_CountNodesRequest.Size(m)
+
+func (m *SegmentHealth) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
-func (m *CountNodesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CountNodesRequest.DiscardUnknown(m)
+
+func (m *SegmentHealth) GetLastAuditSeconds() int64 {
+	if m != nil {
+		return m.LastAuditSeconds
+	}
+	return 0
 }
 
-var xxx_messageInfo_CountNodesRequest proto.InternalMessageInfo
+func (m *SegmentHealth) GetLastRepairSeconds() int64 {
+	if m != nil {
+		return m.LastRepairSeconds
+	}
+	return 0
+}
 
-// GetBuckets
-type GetBucketsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SegmentHealth) GetPieces() []*PiecePlacement {
+	if m != nil {
+		return m.Pieces
+	}
+	return nil
 }
 
-func (m *GetBucketsRequest) Reset()         { *m = GetBucketsRequest{} }
-func (m *GetBucketsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBucketsRequest) ProtoMessage()    {}
-func (*GetBucketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{6}
+type ObjectHealthResponse struct {
+	Segments             []*SegmentHealth `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *GetBucketsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBucketsRequest.Unmarshal(m, b)
+
+func (m *ObjectHealthResponse) Reset()         { *m = ObjectHealthResponse{} }
+func (m *ObjectHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectHealthResponse) ProtoMessage()    {}
+func (*ObjectHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{6}
 }
-func (m *GetBucketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBucketsRequest.Marshal(b, m, deterministic)
+func (m *ObjectHealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectHealthResponse.Unmarshal(m, b)
 }
-func (dst *GetBucketsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBucketsRequest.Merge(dst, src)
+func (m *ObjectHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectHealthResponse.Marshal(b, m, deterministic)
 }
-func (m *GetBucketsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetBucketsRequest.Size(m)
+func (dst *ObjectHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectHealthResponse.Merge(dst, src)
 }
-func (m *GetBucketsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBucketsRequest.DiscardUnknown(m)
+func (m *ObjectHealthResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectHealthResponse.Size(m)
+}
+func (m *ObjectHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectHealthResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBucketsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ObjectHealthResponse proto.InternalMessageInfo
 
-type GetBucketsResponse struct {
-	Total                int64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Ids                  []NodeID `protobuf:"bytes,2,rep,name=ids,proto3,customtype=NodeID" json:"ids,omitempty"`
+func (m *ObjectHealthResponse) GetSegments() []*SegmentHealth {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+// ScheduleMaintenance
+type ScheduleMaintenanceRequest struct {
+	StartSeconds         int64    `protobuf:"varint,1,opt,name=start_seconds,json=startSeconds,proto3" json:"start_seconds,omitempty"`
+	UntilSeconds         int64    `protobuf:"varint,2,opt,name=until_seconds,json=untilSeconds,proto3" json:"until_seconds,omitempty"`
+	Cancel               bool     `protobuf:"varint,3,opt,name=cancel,proto3" json:"cancel,omitempty"`
+	StatusOnly           bool     `protobuf:"varint,4,opt,name=status_only,json=statusOnly,proto3" json:"status_only,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetBucketsResponse) Reset()         { *m = GetBucketsResponse{} }
-func (m *GetBucketsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetBucketsResponse) ProtoMessage()    {}
-func (*GetBucketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{7}
+func (m *ScheduleMaintenanceRequest) Reset()         { *m = ScheduleMaintenanceRequest{} }
+func (m *ScheduleMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduleMaintenanceRequest) ProtoMessage()    {}
+func (*ScheduleMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{7}
 }
-func (m *GetBucketsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBucketsResponse.Unmarshal(m, b)
+func (m *ScheduleMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduleMaintenanceRequest.Unmarshal(m, b)
 }
-func (m *GetBucketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBucketsResponse.Marshal(b, m, deterministic)
+func (m *ScheduleMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduleMaintenanceRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetBucketsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBucketsResponse.Merge(dst, src)
+func (dst *ScheduleMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleMaintenanceRequest.Merge(dst, src)
 }
-func (m *GetBucketsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetBucketsResponse.Size(m)
+func (m *ScheduleMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_ScheduleMaintenanceRequest.Size(m)
 }
-func (m *GetBucketsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBucketsResponse.DiscardUnknown(m)
+func (m *ScheduleMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleMaintenanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBucketsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ScheduleMaintenanceRequest proto.InternalMessageInfo
 
-func (m *GetBucketsResponse) GetTotal() int64 {
+func (m *ScheduleMaintenanceRequest) GetStartSeconds() int64 {
 	if m != nil {
-		return m.Total
+		return m.StartSeconds
 	}
 	return 0
 }
 
-// GetBucket
-type GetBucketRequest struct {
-	Id                   NodeID   `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+func (m *ScheduleMaintenanceRequest) GetUntilSeconds() int64 {
+	if m != nil {
+		return m.UntilSeconds
+	}
+	return 0
+}
+
+func (m *ScheduleMaintenanceRequest) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
+	}
+	return false
+}
+
+func (m *ScheduleMaintenanceRequest) GetStatusOnly() bool {
+	if m != nil {
+		return m.StatusOnly
+	}
+	return false
+}
+
+type ScheduleMaintenanceResponse struct {
+	StartSeconds         int64    `protobuf:"varint,1,opt,name=start_seconds,json=startSeconds,proto3" json:"start_seconds,omitempty"`
+	UntilSeconds         int64    `protobuf:"varint,2,opt,name=until_seconds,json=untilSeconds,proto3" json:"until_seconds,omitempty"`
+	Active               bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetBucketRequest) Reset()         { *m = GetBucketRequest{} }
-func (m *GetBucketRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBucketRequest) ProtoMessage()    {}
-func (*GetBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{8}
+func (m *ScheduleMaintenanceResponse) Reset()         { *m = ScheduleMaintenanceResponse{} }
+func (m *ScheduleMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ScheduleMaintenanceResponse) ProtoMessage()    {}
+func (*ScheduleMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{8}
 }
-func (m *GetBucketRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBucketRequest.Unmarshal(m, b)
+func (m *ScheduleMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduleMaintenanceResponse.Unmarshal(m, b)
 }
-func (m *GetBucketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBucketRequest.Marshal(b, m, deterministic)
+func (m *ScheduleMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduleMaintenanceResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetBucketRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBucketRequest.Merge(dst, src)
+func (dst *ScheduleMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleMaintenanceResponse.Merge(dst, src)
 }
-func (m *GetBucketRequest) XXX_Size() int {
-	return xxx_messageInfo_GetBucketRequest.Size(m)
+func (m *ScheduleMaintenanceResponse) XXX_Size() int {
+	return xxx_messageInfo_ScheduleMaintenanceResponse.Size(m)
 }
-func (m *GetBucketRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBucketRequest.DiscardUnknown(m)
+func (m *ScheduleMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleMaintenanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBucketRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScheduleMaintenanceResponse proto.InternalMessageInfo
 
-type GetBucketResponse struct {
-	Id                   NodeID   `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
-	Nodes                []*Node  `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+func (m *ScheduleMaintenanceResponse) GetStartSeconds() int64 {
+	if m != nil {
+		return m.StartSeconds
+	}
+	return 0
+}
+
+func (m *ScheduleMaintenanceResponse) GetUntilSeconds() int64 {
+	if m != nil {
+		return m.UntilSeconds
+	}
+	return 0
+}
+
+func (m *ScheduleMaintenanceResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+// RepairStats
+type RepairStatsRequest struct {
+	NodeLimit            int32    `protobuf:"varint,1,opt,name=node_limit,json=nodeLimit,proto3" json:"node_limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetBucketResponse) Reset()         { *m = GetBucketResponse{} }
-func (m *GetBucketResponse) String() string { return proto.CompactTextString(m) }
-func (*GetBucketResponse) ProtoMessage()    {}
-func (*GetBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{9}
+func (m *RepairStatsRequest) Reset()         { *m = RepairStatsRequest{} }
+func (m *RepairStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*RepairStatsRequest) ProtoMessage()    {}
+func (*RepairStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{9}
 }
-func (m *GetBucketResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBucketResponse.Unmarshal(m, b)
+func (m *RepairStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairStatsRequest.Unmarshal(m, b)
 }
-func (m *GetBucketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBucketResponse.Marshal(b, m, deterministic)
+func (m *RepairStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairStatsRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetBucketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBucketResponse.Merge(dst, src)
+func (dst *RepairStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairStatsRequest.Merge(dst, src)
 }
-func (m *GetBucketResponse) XXX_Size() int {
-	return xxx_messageInfo_GetBucketResponse.Size(m)
+func (m *RepairStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_RepairStatsRequest.Size(m)
 }
-func (m *GetBucketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBucketResponse.DiscardUnknown(m)
+func (m *RepairStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBucketResponse proto.InternalMessageInfo
+var xxx_messageInfo_RepairStatsRequest proto.InternalMessageInfo
 
-func (m *GetBucketResponse) GetNodes() []*Node {
+func (m *RepairStatsRequest) GetNodeLimit() int32 {
 	if m != nil {
-		return m.Nodes
+		return m.NodeLimit
 	}
-	return nil
+	return 0
 }
 
-type Bucket struct {
-	Nodes                []*Node  `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+type RepairOutcomeStats struct {
+	Outcome              string   `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalBytes           int64    `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	SizeCounts           []int64  `protobuf:"varint,4,rep,packed,name=size_counts,json=sizeCounts,proto3" json:"size_counts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Bucket) Reset()         { *m = Bucket{} }
-func (m *Bucket) String() string { return proto.CompactTextString(m) }
-func (*Bucket) ProtoMessage()    {}
-func (*Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{10}
+func (m *RepairOutcomeStats) Reset()         { *m = RepairOutcomeStats{} }
+func (m *RepairOutcomeStats) String() string { return proto.CompactTextString(m) }
+func (*RepairOutcomeStats) ProtoMessage()    {}
+func (*RepairOutcomeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{10}
 }
-func (m *Bucket) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Bucket.Unmarshal(m, b)
+func (m *RepairOutcomeStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairOutcomeStats.Unmarshal(m, b)
 }
-func (m *Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Bucket.Marshal(b, m, deterministic)
+func (m *RepairOutcomeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairOutcomeStats.Marshal(b, m, deterministic)
 }
-func (dst *Bucket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Bucket.Merge(dst, src)
+func (dst *RepairOutcomeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairOutcomeStats.Merge(dst, src)
 }
-func (m *Bucket) XXX_Size() int {
-	return xxx_messageInfo_Bucket.Size(m)
+func (m *RepairOutcomeStats) XXX_Size() int {
+	return xxx_messageInfo_RepairOutcomeStats.Size(m)
 }
-func (m *Bucket) XXX_DiscardUnknown() {
-	xxx_messageInfo_Bucket.DiscardUnknown(m)
+func (m *RepairOutcomeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairOutcomeStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Bucket proto.InternalMessageInfo
+var xxx_messageInfo_RepairOutcomeStats proto.InternalMessageInfo
 
-func (m *Bucket) GetNodes() []*Node {
+func (m *RepairOutcomeStats) GetOutcome() string {
 	if m != nil {
-		return m.Nodes
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *RepairOutcomeStats) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *RepairOutcomeStats) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *RepairOutcomeStats) GetSizeCounts() []int64 {
+	if m != nil {
+		return m.SizeCounts
 	}
 	return nil
 }
 
-type BucketList struct {
-	Nodes                []*Node  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+type NodeRepairFailures struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	DownloadFailures     int64    `protobuf:"varint,2,opt,name=download_failures,json=downloadFailures,proto3" json:"download_failures,omitempty"`
+	UploadFailures       int64    `protobuf:"varint,3,opt,name=upload_failures,json=uploadFailures,proto3" json:"upload_failures,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BucketList) Reset()         { *m = BucketList{} }
-func (m *BucketList) String() string { return proto.CompactTextString(m) }
-func (*BucketList) ProtoMessage()    {}
-func (*BucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{11}
+func (m *NodeRepairFailures) Reset()         { *m = NodeRepairFailures{} }
+func (m *NodeRepairFailures) String() string { return proto.CompactTextString(m) }
+func (*NodeRepairFailures) ProtoMessage()    {}
+func (*NodeRepairFailures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{11}
 }
-func (m *BucketList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BucketList.Unmarshal(m, b)
+func (m *NodeRepairFailures) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeRepairFailures.Unmarshal(m, b)
 }
-func (m *BucketList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BucketList.Marshal(b, m, deterministic)
+func (m *NodeRepairFailures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeRepairFailures.Marshal(b, m, deterministic)
 }
-func (dst *BucketList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BucketList.Merge(dst, src)
+func (dst *NodeRepairFailures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeRepairFailures.Merge(dst, src)
 }
-func (m *BucketList) XXX_Size() int {
-	return xxx_messageInfo_BucketList.Size(m)
+func (m *NodeRepairFailures) XXX_Size() int {
+	return xxx_messageInfo_NodeRepairFailures.Size(m)
 }
-func (m *BucketList) XXX_DiscardUnknown() {
-	xxx_messageInfo_BucketList.DiscardUnknown(m)
+func (m *NodeRepairFailures) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeRepairFailures.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BucketList proto.InternalMessageInfo
+var xxx_messageInfo_NodeRepairFailures proto.InternalMessageInfo
 
-func (m *BucketList) GetNodes() []*Node {
+func (m *NodeRepairFailures) GetDownloadFailures() int64 {
 	if m != nil {
-		return m.Nodes
+		return m.DownloadFailures
 	}
-	return nil
+	return 0
 }
 
-// PingNode
-type PingNodeRequest struct {
-	Id                   NodeID   `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *NodeRepairFailures) GetUploadFailures() int64 {
+	if m != nil {
+		return m.UploadFailures
+	}
+	return 0
 }
 
-func (m *PingNodeRequest) Reset()         { *m = PingNodeRequest{} }
-func (m *PingNodeRequest) String() string { return proto.CompactTextString(m) }
-func (*PingNodeRequest) ProtoMessage()    {}
-func (*PingNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{12}
+type RepairStatsResponse struct {
+	SizeBuckets          []int64               `protobuf:"varint,1,rep,packed,name=size_buckets,json=sizeBuckets,proto3" json:"size_buckets,omitempty"`
+	Outcomes             []*RepairOutcomeStats `protobuf:"bytes,2,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	Nodes                []*NodeRepairFailures `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (m *PingNodeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingNodeRequest.Unmarshal(m, b)
+
+func (m *RepairStatsResponse) Reset()         { *m = RepairStatsResponse{} }
+func (m *RepairStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*RepairStatsResponse) ProtoMessage()    {}
+func (*RepairStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{12}
 }
-func (m *PingNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingNodeRequest.Marshal(b, m, deterministic)
+func (m *RepairStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairStatsResponse.Unmarshal(m, b)
 }
-func (dst *PingNodeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingNodeRequest.Merge(dst, src)
+func (m *RepairStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairStatsResponse.Marshal(b, m, deterministic)
 }
-func (m *PingNodeRequest) XXX_Size() int {
-	return xxx_messageInfo_PingNodeRequest.Size(m)
+func (dst *RepairStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairStatsResponse.Merge(dst, src)
 }
-func (m *PingNodeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingNodeRequest.DiscardUnknown(m)
+func (m *RepairStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_RepairStatsResponse.Size(m)
+}
+func (m *RepairStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairStatsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingNodeRequest proto.InternalMessageInfo
+var xxx_messageInfo_RepairStatsResponse proto.InternalMessageInfo
 
-func (m *PingNodeRequest) GetAddress() string {
+func (m *RepairStatsResponse) GetSizeBuckets() []int64 {
 	if m != nil {
-		return m.Address
+		return m.SizeBuckets
 	}
-	return ""
+	return nil
 }
 
-type PingNodeResponse struct {
-	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+func (m *RepairStatsResponse) GetOutcomes() []*RepairOutcomeStats {
+	if m != nil {
+		return m.Outcomes
+	}
+	return nil
+}
+
+func (m *RepairStatsResponse) GetNodes() []*NodeRepairFailures {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// Broadcast
+type BroadcastRequest struct {
+	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PingNodeResponse) Reset()         { *m = PingNodeResponse{} }
-func (m *PingNodeResponse) String() string { return proto.CompactTextString(m) }
-func (*PingNodeResponse) ProtoMessage()    {}
-func (*PingNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{13}
+func (m *BroadcastRequest) Reset()         { *m = BroadcastRequest{} }
+func (m *BroadcastRequest) String() string { return proto.CompactTextString(m) }
+func (*BroadcastRequest) ProtoMessage()    {}
+func (*BroadcastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{13}
 }
-func (m *PingNodeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingNodeResponse.Unmarshal(m, b)
+func (m *BroadcastRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BroadcastRequest.Unmarshal(m, b)
 }
-func (m *PingNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingNodeResponse.Marshal(b, m, deterministic)
+func (m *BroadcastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BroadcastRequest.Marshal(b, m, deterministic)
 }
-func (dst *PingNodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingNodeResponse.Merge(dst, src)
+func (dst *BroadcastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastRequest.Merge(dst, src)
 }
-func (m *PingNodeResponse) XXX_Size() int {
-	return xxx_messageInfo_PingNodeResponse.Size(m)
+func (m *BroadcastRequest) XXX_Size() int {
+	return xxx_messageInfo_BroadcastRequest.Size(m)
 }
-func (m *PingNodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingNodeResponse.DiscardUnknown(m)
+func (m *BroadcastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingNodeResponse proto.InternalMessageInfo
+var xxx_messageInfo_BroadcastRequest proto.InternalMessageInfo
 
-func (m *PingNodeResponse) GetOk() bool {
+func (m *BroadcastRequest) GetMessage() string {
 	if m != nil {
-		return m.Ok
+		return m.Message
 	}
-	return false
+	return ""
 }
 
-type LookupNodeRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+type BroadcastResponse struct {
+	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeCount            int64    `protobuf:"varint,2,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupNodeRequest) Reset()         { *m = LookupNodeRequest{} }
-func (m *LookupNodeRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupNodeRequest) ProtoMessage()    {}
-func (*LookupNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{14}
+func (m *BroadcastResponse) Reset()         { *m = BroadcastResponse{} }
+func (m *BroadcastResponse) String() string { return proto.CompactTextString(m) }
+func (*BroadcastResponse) ProtoMessage()    {}
+func (*BroadcastResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{14}
 }
-func (m *LookupNodeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupNodeRequest.Unmarshal(m, b)
+func (m *BroadcastResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BroadcastResponse.Unmarshal(m, b)
 }
-func (m *LookupNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupNodeRequest.Marshal(b, m, deterministic)
+func (m *BroadcastResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BroadcastResponse.Marshal(b, m, deterministic)
 }
-func (dst *LookupNodeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupNodeRequest.Merge(dst, src)
+func (dst *BroadcastResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastResponse.Merge(dst, src)
 }
-func (m *LookupNodeRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupNodeRequest.Size(m)
+func (m *BroadcastResponse) XXX_Size() int {
+	return xxx_messageInfo_BroadcastResponse.Size(m)
 }
-func (m *LookupNodeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupNodeRequest.DiscardUnknown(m)
+func (m *BroadcastResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupNodeRequest proto.InternalMessageInfo
+var xxx_messageInfo_BroadcastResponse proto.InternalMessageInfo
 
-func (m *LookupNodeRequest) GetId() string {
+func (m *BroadcastResponse) GetId() []byte {
 	if m != nil {
 		return m.Id
 	}
-	return ""
+	return nil
 }
 
-func (m *LookupNodeRequest) GetAddress() string {
+func (m *BroadcastResponse) GetNodeCount() int64 {
 	if m != nil {
-		return m.Address
+		return m.NodeCount
 	}
-	return ""
+	return 0
 }
 
-type LookupNodeResponse struct {
-	Node                 *Node         `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Meta                 *NodeMetadata `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+// BroadcastStatus
+type BroadcastStatusRequest struct {
+	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupNodeResponse) Reset()         { *m = LookupNodeResponse{} }
-func (m *LookupNodeResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupNodeResponse) ProtoMessage()    {}
-func (*LookupNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{15}
+func (m *BroadcastStatusRequest) Reset()         { *m = BroadcastStatusRequest{} }
+func (m *BroadcastStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*BroadcastStatusRequest) ProtoMessage()    {}
+func (*BroadcastStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{15}
 }
-func (m *LookupNodeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupNodeResponse.Unmarshal(m, b)
+func (m *BroadcastStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BroadcastStatusRequest.Unmarshal(m, b)
 }
-func (m *LookupNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupNodeResponse.Marshal(b, m, deterministic)
+func (m *BroadcastStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BroadcastStatusRequest.Marshal(b, m, deterministic)
 }
-func (dst *LookupNodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupNodeResponse.Merge(dst, src)
+func (dst *BroadcastStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastStatusRequest.Merge(dst, src)
 }
-func (m *LookupNodeResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupNodeResponse.Size(m)
+func (m *BroadcastStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_BroadcastStatusRequest.Size(m)
 }
-func (m *LookupNodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupNodeResponse.DiscardUnknown(m)
+func (m *BroadcastStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupNodeResponse proto.InternalMessageInfo
+var xxx_messageInfo_BroadcastStatusRequest proto.InternalMessageInfo
 
-func (m *LookupNodeResponse) GetNode() *Node {
+func (m *BroadcastStatusRequest) GetId() []byte {
 	if m != nil {
-		return m.Node
+		return m.Id
 	}
 	return nil
 }
 
-func (m *LookupNodeResponse) GetMeta() *NodeMetadata {
+type NoticeDelivery struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Attempts             int32    `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NoticeDelivery) Reset()         { *m = NoticeDelivery{} }
+func (m *NoticeDelivery) String() string { return proto.CompactTextString(m) }
+func (*NoticeDelivery) ProtoMessage()    {}
+func (*NoticeDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{16}
+}
+func (m *NoticeDelivery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NoticeDelivery.Unmarshal(m, b)
+}
+func (m *NoticeDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NoticeDelivery.Marshal(b, m, deterministic)
+}
+func (dst *NoticeDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoticeDelivery.Merge(dst, src)
+}
+func (m *NoticeDelivery) XXX_Size() int {
+	return xxx_messageInfo_NoticeDelivery.Size(m)
+}
+func (m *NoticeDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoticeDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoticeDelivery proto.InternalMessageInfo
+
+func (m *NoticeDelivery) GetAttempts() int32 {
 	if m != nil {
-		return m.Meta
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *NoticeDelivery) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type BroadcastStatusResponse struct {
+	Message              string            `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedSeconds       int64             `protobuf:"varint,2,opt,name=created_seconds,json=createdSeconds,proto3" json:"created_seconds,omitempty"`
+	Pending              int64             `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Delivered            int64             `protobuf:"varint,4,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Failed               int64             `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Failures             []*NoticeDelivery `protobuf:"bytes,6,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BroadcastStatusResponse) Reset()         { *m = BroadcastStatusResponse{} }
+func (m *BroadcastStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*BroadcastStatusResponse) ProtoMessage()    {}
+func (*BroadcastStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{17}
+}
+func (m *BroadcastStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BroadcastStatusResponse.Unmarshal(m, b)
+}
+func (m *BroadcastStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BroadcastStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *BroadcastStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastStatusResponse.Merge(dst, src)
+}
+func (m *BroadcastStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_BroadcastStatusResponse.Size(m)
+}
+func (m *BroadcastStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BroadcastStatusResponse proto.InternalMessageInfo
+
+func (m *BroadcastStatusResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *BroadcastStatusResponse) GetCreatedSeconds() int64 {
+	if m != nil {
+		return m.CreatedSeconds
+	}
+	return 0
+}
+
+func (m *BroadcastStatusResponse) GetPending() int64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *BroadcastStatusResponse) GetDelivered() int64 {
+	if m != nil {
+		return m.Delivered
+	}
+	return 0
+}
+
+func (m *BroadcastStatusResponse) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *BroadcastStatusResponse) GetFailures() []*NoticeDelivery {
+	if m != nil {
+		return m.Failures
 	}
 	return nil
 }
 
-type FindNearRequest struct {
-	Id                   NodeID   `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
-	Start                NodeID   `protobuf:"bytes,2,opt,name=start,proto3,customtype=NodeID" json:"start"`
-	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+// GetStats
+type GetStatsRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindNearRequest) Reset()         { *m = FindNearRequest{} }
-func (m *FindNearRequest) String() string { return proto.CompactTextString(m) }
-func (*FindNearRequest) ProtoMessage()    {}
-func (*FindNearRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{16}
+func (m *GetStatsRequest) Reset()         { *m = GetStatsRequest{} }
+func (m *GetStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStatsRequest) ProtoMessage()    {}
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{18}
 }
-func (m *FindNearRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindNearRequest.Unmarshal(m, b)
+func (m *GetStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStatsRequest.Unmarshal(m, b)
 }
-func (m *FindNearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindNearRequest.Marshal(b, m, deterministic)
+func (m *GetStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStatsRequest.Marshal(b, m, deterministic)
 }
-func (dst *FindNearRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindNearRequest.Merge(dst, src)
+func (dst *GetStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStatsRequest.Merge(dst, src)
 }
-func (m *FindNearRequest) XXX_Size() int {
-	return xxx_messageInfo_FindNearRequest.Size(m)
+func (m *GetStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStatsRequest.Size(m)
 }
-func (m *FindNearRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindNearRequest.DiscardUnknown(m)
+func (m *GetStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindNearRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetStatsRequest proto.InternalMessageInfo
 
-func (m *FindNearRequest) GetLimit() int64 {
+type GetStatsResponse struct {
+	AuditCount           int64    `protobuf:"varint,1,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
+	AuditRatio           float64  `protobuf:"fixed64,2,opt,name=audit_ratio,json=auditRatio,proto3" json:"audit_ratio,omitempty"`
+	UptimeCount          int64    `protobuf:"varint,3,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
+	UptimeRatio          float64  `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStatsResponse) Reset()         { *m = GetStatsResponse{} }
+func (m *GetStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStatsResponse) ProtoMessage()    {}
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{19}
+}
+func (m *GetStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStatsResponse.Unmarshal(m, b)
+}
+func (m *GetStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStatsResponse.Merge(dst, src)
+}
+func (m *GetStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStatsResponse.Size(m)
+}
+func (m *GetStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStatsResponse proto.InternalMessageInfo
+
+func (m *GetStatsResponse) GetAuditCount() int64 {
 	if m != nil {
-		return m.Limit
+		return m.AuditCount
 	}
 	return 0
 }
 
-type FindNearResponse struct {
-	Nodes                []*Node  `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+func (m *GetStatsResponse) GetAuditRatio() float64 {
+	if m != nil {
+		return m.AuditRatio
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetUptimeCount() int64 {
+	if m != nil {
+		return m.UptimeCount
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetUptimeRatio() float64 {
+	if m != nil {
+		return m.UptimeRatio
+	}
+	return 0
+}
+
+// CreateStats
+type CreateStatsRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	AuditCount           int64    `protobuf:"varint,2,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
+	AuditSuccessCount    int64    `protobuf:"varint,3,opt,name=audit_success_count,json=auditSuccessCount,proto3" json:"audit_success_count,omitempty"`
+	UptimeCount          int64    `protobuf:"varint,4,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
+	UptimeSuccessCount   int64    `protobuf:"varint,5,opt,name=uptime_success_count,json=uptimeSuccessCount,proto3" json:"uptime_success_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindNearResponse) Reset()         { *m = FindNearResponse{} }
-func (m *FindNearResponse) String() string { return proto.CompactTextString(m) }
-func (*FindNearResponse) ProtoMessage()    {}
-func (*FindNearResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{17}
+func (m *CreateStatsRequest) Reset()         { *m = CreateStatsRequest{} }
+func (m *CreateStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateStatsRequest) ProtoMessage()    {}
+func (*CreateStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{20}
 }
-func (m *FindNearResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindNearResponse.Unmarshal(m, b)
+func (m *CreateStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateStatsRequest.Unmarshal(m, b)
 }
-func (m *FindNearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindNearResponse.Marshal(b, m, deterministic)
+func (m *CreateStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateStatsRequest.Marshal(b, m, deterministic)
 }
-func (dst *FindNearResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindNearResponse.Merge(dst, src)
+func (dst *CreateStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStatsRequest.Merge(dst, src)
 }
-func (m *FindNearResponse) XXX_Size() int {
-	return xxx_messageInfo_FindNearResponse.Size(m)
+func (m *CreateStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateStatsRequest.Size(m)
 }
-func (m *FindNearResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindNearResponse.DiscardUnknown(m)
+func (m *CreateStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindNearResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateStatsRequest proto.InternalMessageInfo
 
-func (m *FindNearResponse) GetNodes() []*Node {
+func (m *CreateStatsRequest) GetAuditCount() int64 {
 	if m != nil {
-		return m.Nodes
+		return m.AuditCount
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*GetStatsRequest)(nil), "inspector.GetStatsRequest")
-	proto.RegisterType((*GetStatsResponse)(nil), "inspector.GetStatsResponse")
-	proto.RegisterType((*CreateStatsRequest)(nil), "inspector.CreateStatsRequest")
-	proto.RegisterType((*CreateStatsResponse)(nil), "inspector.CreateStatsResponse")
-	proto.RegisterType((*CountNodesResponse)(nil), "inspector.CountNodesResponse")
-	proto.RegisterType((*CountNodesRequest)(nil), "inspector.CountNodesRequest")
-	proto.RegisterType((*GetBucketsRequest)(nil), "inspector.GetBucketsRequest")
-	proto.RegisterType((*GetBucketsResponse)(nil), "inspector.GetBucketsResponse")
-	proto.RegisterType((*GetBucketRequest)(nil), "inspector.GetBucketRequest")
-	proto.RegisterType((*GetBucketResponse)(nil), "inspector.GetBucketResponse")
-	proto.RegisterType((*Bucket)(nil), "inspector.Bucket")
-	proto.RegisterType((*BucketList)(nil), "inspector.BucketList")
-	proto.RegisterType((*PingNodeRequest)(nil), "inspector.PingNodeRequest")
-	proto.RegisterType((*PingNodeResponse)(nil), "inspector.PingNodeResponse")
-	proto.RegisterType((*LookupNodeRequest)(nil), "inspector.LookupNodeRequest")
-	proto.RegisterType((*LookupNodeResponse)(nil), "inspector.LookupNodeResponse")
-	proto.RegisterType((*FindNearRequest)(nil), "inspector.FindNearRequest")
-	proto.RegisterType((*FindNearResponse)(nil), "inspector.FindNearResponse")
+func (m *CreateStatsRequest) GetAuditSuccessCount() int64 {
+	if m != nil {
+		return m.AuditSuccessCount
+	}
+	return 0
+}
+
+func (m *CreateStatsRequest) GetUptimeCount() int64 {
+	if m != nil {
+		return m.UptimeCount
+	}
+	return 0
+}
+
+func (m *CreateStatsRequest) GetUptimeSuccessCount() int64 {
+	if m != nil {
+		return m.UptimeSuccessCount
+	}
+	return 0
+}
+
+type CreateStatsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateStatsResponse) Reset()         { *m = CreateStatsResponse{} }
+func (m *CreateStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateStatsResponse) ProtoMessage()    {}
+func (*CreateStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{21}
+}
+func (m *CreateStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateStatsResponse.Unmarshal(m, b)
+}
+func (m *CreateStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStatsResponse.Merge(dst, src)
+}
+func (m *CreateStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateStatsResponse.Size(m)
+}
+func (m *CreateStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateStatsResponse proto.InternalMessageInfo
+
+// CountNodes
+type CountNodesResponse struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountNodesResponse) Reset()         { *m = CountNodesResponse{} }
+func (m *CountNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*CountNodesResponse) ProtoMessage()    {}
+func (*CountNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{22}
+}
+func (m *CountNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountNodesResponse.Unmarshal(m, b)
+}
+func (m *CountNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountNodesResponse.Marshal(b, m, deterministic)
+}
+func (dst *CountNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountNodesResponse.Merge(dst, src)
+}
+func (m *CountNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_CountNodesResponse.Size(m)
+}
+func (m *CountNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountNodesResponse proto.InternalMessageInfo
+
+func (m *CountNodesResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type CountNodesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountNodesRequest) Reset()         { *m = CountNodesRequest{} }
+func (m *CountNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*CountNodesRequest) ProtoMessage()    {}
+func (*CountNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{23}
+}
+func (m *CountNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountNodesRequest.Unmarshal(m, b)
+}
+func (m *CountNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountNodesRequest.Marshal(b, m, deterministic)
+}
+func (dst *CountNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountNodesRequest.Merge(dst, src)
+}
+func (m *CountNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_CountNodesRequest.Size(m)
+}
+func (m *CountNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountNodesRequest proto.InternalMessageInfo
+
+// GetBuckets
+type GetBucketsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketsRequest) Reset()         { *m = GetBucketsRequest{} }
+func (m *GetBucketsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketsRequest) ProtoMessage()    {}
+func (*GetBucketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{24}
+}
+func (m *GetBucketsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketsRequest.Unmarshal(m, b)
+}
+func (m *GetBucketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetBucketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketsRequest.Merge(dst, src)
+}
+func (m *GetBucketsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketsRequest.Size(m)
+}
+func (m *GetBucketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketsRequest proto.InternalMessageInfo
+
+type GetBucketsResponse struct {
+	Total                int64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Ids                  []NodeID `protobuf:"bytes,2,rep,name=ids,proto3,customtype=NodeID" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketsResponse) Reset()         { *m = GetBucketsResponse{} }
+func (m *GetBucketsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketsResponse) ProtoMessage()    {}
+func (*GetBucketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{25}
+}
+func (m *GetBucketsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketsResponse.Unmarshal(m, b)
+}
+func (m *GetBucketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBucketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketsResponse.Merge(dst, src)
+}
+func (m *GetBucketsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketsResponse.Size(m)
+}
+func (m *GetBucketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketsResponse proto.InternalMessageInfo
+
+func (m *GetBucketsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// GetBucket
+type GetBucketRequest struct {
+	Id                   NodeID   `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketRequest) Reset()         { *m = GetBucketRequest{} }
+func (m *GetBucketRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketRequest) ProtoMessage()    {}
+func (*GetBucketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{26}
+}
+func (m *GetBucketRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketRequest.Unmarshal(m, b)
+}
+func (m *GetBucketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetBucketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketRequest.Merge(dst, src)
+}
+func (m *GetBucketRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketRequest.Size(m)
+}
+func (m *GetBucketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketRequest proto.InternalMessageInfo
+
+type GetBucketResponse struct {
+	Id                   NodeID   `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	Nodes                []*Node  `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketResponse) Reset()         { *m = GetBucketResponse{} }
+func (m *GetBucketResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketResponse) ProtoMessage()    {}
+func (*GetBucketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{27}
+}
+func (m *GetBucketResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketResponse.Unmarshal(m, b)
+}
+func (m *GetBucketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBucketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketResponse.Merge(dst, src)
+}
+func (m *GetBucketResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketResponse.Size(m)
+}
+func (m *GetBucketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketResponse proto.InternalMessageInfo
+
+func (m *GetBucketResponse) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type Bucket struct {
+	Nodes                []*Node  `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Bucket) Reset()         { *m = Bucket{} }
+func (m *Bucket) String() string { return proto.CompactTextString(m) }
+func (*Bucket) ProtoMessage()    {}
+func (*Bucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{28}
+}
+func (m *Bucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bucket.Unmarshal(m, b)
+}
+func (m *Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bucket.Marshal(b, m, deterministic)
+}
+func (dst *Bucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bucket.Merge(dst, src)
+}
+func (m *Bucket) XXX_Size() int {
+	return xxx_messageInfo_Bucket.Size(m)
+}
+func (m *Bucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bucket proto.InternalMessageInfo
+
+func (m *Bucket) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type BucketList struct {
+	Nodes                []*Node  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketList) Reset()         { *m = BucketList{} }
+func (m *BucketList) String() string { return proto.CompactTextString(m) }
+func (*BucketList) ProtoMessage()    {}
+func (*BucketList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{29}
+}
+func (m *BucketList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketList.Unmarshal(m, b)
+}
+func (m *BucketList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketList.Marshal(b, m, deterministic)
+}
+func (dst *BucketList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketList.Merge(dst, src)
+}
+func (m *BucketList) XXX_Size() int {
+	return xxx_messageInfo_BucketList.Size(m)
+}
+func (m *BucketList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketList proto.InternalMessageInfo
+
+func (m *BucketList) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// PingNode
+type PingNodeRequest struct {
+	Id                   NodeID   `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingNodeRequest) Reset()         { *m = PingNodeRequest{} }
+func (m *PingNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*PingNodeRequest) ProtoMessage()    {}
+func (*PingNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{30}
+}
+func (m *PingNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingNodeRequest.Unmarshal(m, b)
+}
+func (m *PingNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingNodeRequest.Marshal(b, m, deterministic)
+}
+func (dst *PingNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingNodeRequest.Merge(dst, src)
+}
+func (m *PingNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_PingNodeRequest.Size(m)
+}
+func (m *PingNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingNodeRequest proto.InternalMessageInfo
+
+func (m *PingNodeRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type PingNodeResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PingNodeResponse) Reset()         { *m = PingNodeResponse{} }
+func (m *PingNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*PingNodeResponse) ProtoMessage()    {}
+func (*PingNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{31}
+}
+func (m *PingNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingNodeResponse.Unmarshal(m, b)
+}
+func (m *PingNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingNodeResponse.Marshal(b, m, deterministic)
+}
+func (dst *PingNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingNodeResponse.Merge(dst, src)
+}
+func (m *PingNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_PingNodeResponse.Size(m)
+}
+func (m *PingNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingNodeResponse proto.InternalMessageInfo
+
+func (m *PingNodeResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type LookupNodeRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupNodeRequest) Reset()         { *m = LookupNodeRequest{} }
+func (m *LookupNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupNodeRequest) ProtoMessage()    {}
+func (*LookupNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{32}
+}
+func (m *LookupNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupNodeRequest.Unmarshal(m, b)
+}
+func (m *LookupNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupNodeRequest.Marshal(b, m, deterministic)
+}
+func (dst *LookupNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupNodeRequest.Merge(dst, src)
+}
+func (m *LookupNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupNodeRequest.Size(m)
+}
+func (m *LookupNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupNodeRequest proto.InternalMessageInfo
+
+func (m *LookupNodeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LookupNodeRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type LookupNodeResponse struct {
+	Node                 *Node         `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Meta                 *NodeMetadata `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *LookupNodeResponse) Reset()         { *m = LookupNodeResponse{} }
+func (m *LookupNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupNodeResponse) ProtoMessage()    {}
+func (*LookupNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{33}
+}
+func (m *LookupNodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupNodeResponse.Unmarshal(m, b)
+}
+func (m *LookupNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupNodeResponse.Marshal(b, m, deterministic)
+}
+func (dst *LookupNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupNodeResponse.Merge(dst, src)
+}
+func (m *LookupNodeResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupNodeResponse.Size(m)
+}
+func (m *LookupNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupNodeResponse proto.InternalMessageInfo
+
+func (m *LookupNodeResponse) GetNode() *Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *LookupNodeResponse) GetMeta() *NodeMetadata {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+type FindNearRequest struct {
+	Id                   NodeID   `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	Start                NodeID   `protobuf:"bytes,2,opt,name=start,proto3,customtype=NodeID" json:"start"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindNearRequest) Reset()         { *m = FindNearRequest{} }
+func (m *FindNearRequest) String() string { return proto.CompactTextString(m) }
+func (*FindNearRequest) ProtoMessage()    {}
+func (*FindNearRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{34}
+}
+func (m *FindNearRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindNearRequest.Unmarshal(m, b)
+}
+func (m *FindNearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindNearRequest.Marshal(b, m, deterministic)
+}
+func (dst *FindNearRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindNearRequest.Merge(dst, src)
+}
+func (m *FindNearRequest) XXX_Size() int {
+	return xxx_messageInfo_FindNearRequest.Size(m)
+}
+func (m *FindNearRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindNearRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindNearRequest proto.InternalMessageInfo
+
+func (m *FindNearRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type FindNearResponse struct {
+	Nodes                []*Node  `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindNearResponse) Reset()         { *m = FindNearResponse{} }
+func (m *FindNearResponse) String() string { return proto.CompactTextString(m) }
+func (*FindNearResponse) ProtoMessage()    {}
+func (*FindNearResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{35}
+}
+func (m *FindNearResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindNearResponse.Unmarshal(m, b)
+}
+func (m *FindNearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindNearResponse.Marshal(b, m, deterministic)
+}
+func (dst *FindNearResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindNearResponse.Merge(dst, src)
+}
+func (m *FindNearResponse) XXX_Size() int {
+	return xxx_messageInfo_FindNearResponse.Size(m)
+}
+func (m *FindNearResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindNearResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindNearResponse proto.InternalMessageInfo
+
+func (m *FindNearResponse) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// Topology
+type TopologyRequest struct {
+	LookupLimit          int32    `protobuf:"varint,1,opt,name=lookup_limit,json=lookupLimit,proto3" json:"lookup_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TopologyRequest) Reset()         { *m = TopologyRequest{} }
+func (m *TopologyRequest) String() string { return proto.CompactTextString(m) }
+func (*TopologyRequest) ProtoMessage()    {}
+func (*TopologyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{36}
+}
+func (m *TopologyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopologyRequest.Unmarshal(m, b)
+}
+func (m *TopologyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopologyRequest.Marshal(b, m, deterministic)
+}
+func (dst *TopologyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopologyRequest.Merge(dst, src)
+}
+func (m *TopologyRequest) XXX_Size() int {
+	return xxx_messageInfo_TopologyRequest.Size(m)
+}
+func (m *TopologyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopologyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopologyRequest proto.InternalMessageInfo
+
+func (m *TopologyRequest) GetLookupLimit() int32 {
+	if m != nil {
+		return m.LookupLimit
+	}
+	return 0
+}
+
+type KBucket struct {
+	Id                   NodeID   `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	Depth                int32    `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	LastRefreshedSeconds int64    `protobuf:"varint,3,opt,name=last_refreshed_seconds,json=lastRefreshedSeconds,proto3" json:"last_refreshed_seconds,omitempty"`
+	Nodes                []*Node  `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	ReplacementCache     []*Node  `protobuf:"bytes,5,rep,name=replacement_cache,json=replacementCache,proto3" json:"replacement_cache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KBucket) Reset()         { *m = KBucket{} }
+func (m *KBucket) String() string { return proto.CompactTextString(m) }
+func (*KBucket) ProtoMessage()    {}
+func (*KBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{37}
+}
+func (m *KBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KBucket.Unmarshal(m, b)
+}
+func (m *KBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KBucket.Marshal(b, m, deterministic)
+}
+func (dst *KBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KBucket.Merge(dst, src)
+}
+func (m *KBucket) XXX_Size() int {
+	return xxx_messageInfo_KBucket.Size(m)
+}
+func (m *KBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_KBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KBucket proto.InternalMessageInfo
+
+func (m *KBucket) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *KBucket) GetLastRefreshedSeconds() int64 {
+	if m != nil {
+		return m.LastRefreshedSeconds
+	}
+	return 0
+}
+
+func (m *KBucket) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *KBucket) GetReplacementCache() []*Node {
+	if m != nil {
+		return m.ReplacementCache
+	}
+	return nil
+}
+
+type LookupStats struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Found                int64    `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	NotFound             int64    `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	Failed               int64    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	QueriedNodes         int64    `protobuf:"varint,5,opt,name=queried_nodes,json=queriedNodes,proto3" json:"queried_nodes,omitempty"`
+	TotalDurationMs      int64    `protobuf:"varint,6,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupStats) Reset()         { *m = LookupStats{} }
+func (m *LookupStats) String() string { return proto.CompactTextString(m) }
+func (*LookupStats) ProtoMessage()    {}
+func (*LookupStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{38}
+}
+func (m *LookupStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupStats.Unmarshal(m, b)
+}
+func (m *LookupStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupStats.Marshal(b, m, deterministic)
+}
+func (dst *LookupStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupStats.Merge(dst, src)
+}
+func (m *LookupStats) XXX_Size() int {
+	return xxx_messageInfo_LookupStats.Size(m)
+}
+func (m *LookupStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupStats proto.InternalMessageInfo
+
+func (m *LookupStats) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LookupStats) GetFound() int64 {
+	if m != nil {
+		return m.Found
+	}
+	return 0
+}
+
+func (m *LookupStats) GetNotFound() int64 {
+	if m != nil {
+		return m.NotFound
+	}
+	return 0
+}
+
+func (m *LookupStats) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *LookupStats) GetQueriedNodes() int64 {
+	if m != nil {
+		return m.QueriedNodes
+	}
+	return 0
+}
+
+func (m *LookupStats) GetTotalDurationMs() int64 {
+	if m != nil {
+		return m.TotalDurationMs
+	}
+	return 0
+}
+
+type Lookup struct {
+	Target               NodeID   `protobuf:"bytes,1,opt,name=target,proto3,customtype=NodeID" json:"target"`
+	StartedSeconds       int64    `protobuf:"varint,2,opt,name=started_seconds,json=startedSeconds,proto3" json:"started_seconds,omitempty"`
+	DurationMs           int64    `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	QueriedNodes         int32    `protobuf:"varint,4,opt,name=queried_nodes,json=queriedNodes,proto3" json:"queried_nodes,omitempty"`
+	Found                bool     `protobuf:"varint,5,opt,name=found,proto3" json:"found,omitempty"`
+	Error                string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Lookup) Reset()         { *m = Lookup{} }
+func (m *Lookup) String() string { return proto.CompactTextString(m) }
+func (*Lookup) ProtoMessage()    {}
+func (*Lookup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{39}
+}
+func (m *Lookup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Lookup.Unmarshal(m, b)
+}
+func (m *Lookup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Lookup.Marshal(b, m, deterministic)
+}
+func (dst *Lookup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lookup.Merge(dst, src)
+}
+func (m *Lookup) XXX_Size() int {
+	return xxx_messageInfo_Lookup.Size(m)
+}
+func (m *Lookup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lookup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lookup proto.InternalMessageInfo
+
+func (m *Lookup) GetStartedSeconds() int64 {
+	if m != nil {
+		return m.StartedSeconds
+	}
+	return 0
+}
+
+func (m *Lookup) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+func (m *Lookup) GetQueriedNodes() int32 {
+	if m != nil {
+		return m.QueriedNodes
+	}
+	return 0
+}
+
+func (m *Lookup) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *Lookup) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type TopologyResponse struct {
+	Self                 *Node        `protobuf:"bytes,1,opt,name=self,proto3" json:"self,omitempty"`
+	BucketSize           int32        `protobuf:"varint,2,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+	Buckets              []*KBucket   `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	Neighborhood         []*Node      `protobuf:"bytes,4,rep,name=neighborhood,proto3" json:"neighborhood,omitempty"`
+	LookupStats          *LookupStats `protobuf:"bytes,5,opt,name=lookup_stats,json=lookupStats,proto3" json:"lookup_stats,omitempty"`
+	RecentLookups        []*Lookup    `protobuf:"bytes,6,rep,name=recent_lookups,json=recentLookups,proto3" json:"recent_lookups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TopologyResponse) Reset()         { *m = TopologyResponse{} }
+func (m *TopologyResponse) String() string { return proto.CompactTextString(m) }
+func (*TopologyResponse) ProtoMessage()    {}
+func (*TopologyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{40}
+}
+func (m *TopologyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopologyResponse.Unmarshal(m, b)
+}
+func (m *TopologyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopologyResponse.Marshal(b, m, deterministic)
+}
+func (dst *TopologyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopologyResponse.Merge(dst, src)
+}
+func (m *TopologyResponse) XXX_Size() int {
+	return xxx_messageInfo_TopologyResponse.Size(m)
+}
+func (m *TopologyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopologyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopologyResponse proto.InternalMessageInfo
+
+func (m *TopologyResponse) GetSelf() *Node {
+	if m != nil {
+		return m.Self
+	}
+	return nil
+}
+
+func (m *TopologyResponse) GetBucketSize() int32 {
+	if m != nil {
+		return m.BucketSize
+	}
+	return 0
+}
+
+func (m *TopologyResponse) GetBuckets() []*KBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *TopologyResponse) GetNeighborhood() []*Node {
+	if m != nil {
+		return m.Neighborhood
+	}
+	return nil
+}
+
+func (m *TopologyResponse) GetLookupStats() *LookupStats {
+	if m != nil {
+		return m.LookupStats
+	}
+	return nil
+}
+
+func (m *TopologyResponse) GetRecentLookups() []*Lookup {
+	if m != nil {
+		return m.RecentLookups
+	}
+	return nil
+}
+
+// AuditHistory
+type AuditHistoryRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	SinceSeconds         int64    `protobuf:"varint,2,opt,name=since_seconds,json=sinceSeconds,proto3" json:"since_seconds,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditHistoryRequest) Reset()         { *m = AuditHistoryRequest{} }
+func (m *AuditHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditHistoryRequest) ProtoMessage()    {}
+func (*AuditHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{41}
+}
+func (m *AuditHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditHistoryRequest.Unmarshal(m, b)
+}
+func (m *AuditHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditHistoryRequest.Marshal(b, m, deterministic)
+}
+func (dst *AuditHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditHistoryRequest.Merge(dst, src)
+}
+func (m *AuditHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditHistoryRequest.Size(m)
+}
+func (m *AuditHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditHistoryRequest proto.InternalMessageInfo
+
+func (m *AuditHistoryRequest) GetSinceSeconds() int64 {
+	if m != nil {
+		return m.SinceSeconds
+	}
+	return 0
+}
+
+func (m *AuditHistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AuditRecord struct {
+	SegmentHash          []byte   `protobuf:"bytes,1,opt,name=segment_hash,json=segmentHash,proto3" json:"segment_hash,omitempty"`
+	StripeIndex          int64    `protobuf:"varint,2,opt,name=stripe_index,json=stripeIndex,proto3" json:"stripe_index,omitempty"`
+	PieceNum             int32    `protobuf:"varint,3,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	Outcome              string   `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs           int64    `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	AuditedSeconds       int64    `protobuf:"varint,7,opt,name=audited_seconds,json=auditedSeconds,proto3" json:"audited_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditRecord) Reset()         { *m = AuditRecord{} }
+func (m *AuditRecord) String() string { return proto.CompactTextString(m) }
+func (*AuditRecord) ProtoMessage()    {}
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{42}
+}
+func (m *AuditRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditRecord.Unmarshal(m, b)
+}
+func (m *AuditRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditRecord.Marshal(b, m, deterministic)
+}
+func (dst *AuditRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRecord.Merge(dst, src)
+}
+func (m *AuditRecord) XXX_Size() int {
+	return xxx_messageInfo_AuditRecord.Size(m)
+}
+func (m *AuditRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRecord proto.InternalMessageInfo
+
+func (m *AuditRecord) GetSegmentHash() []byte {
+	if m != nil {
+		return m.SegmentHash
+	}
+	return nil
+}
+
+func (m *AuditRecord) GetStripeIndex() int64 {
+	if m != nil {
+		return m.StripeIndex
+	}
+	return 0
+}
+
+func (m *AuditRecord) GetPieceNum() int32 {
+	if m != nil {
+		return m.PieceNum
+	}
+	return 0
+}
+
+func (m *AuditRecord) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+func (m *AuditRecord) GetAuditedSeconds() int64 {
+	if m != nil {
+		return m.AuditedSeconds
+	}
+	return 0
+}
+
+type AuditHistoryResponse struct {
+	Records              []*AuditRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AuditHistoryResponse) Reset()         { *m = AuditHistoryResponse{} }
+func (m *AuditHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditHistoryResponse) ProtoMessage()    {}
+func (*AuditHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{43}
+}
+func (m *AuditHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditHistoryResponse.Unmarshal(m, b)
+}
+func (m *AuditHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditHistoryResponse.Marshal(b, m, deterministic)
+}
+func (dst *AuditHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditHistoryResponse.Merge(dst, src)
+}
+func (m *AuditHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditHistoryResponse.Size(m)
+}
+func (m *AuditHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditHistoryResponse proto.InternalMessageInfo
+
+func (m *AuditHistoryResponse) GetRecords() []*AuditRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// DialStats
+type DialStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DialStatsRequest) Reset()         { *m = DialStatsRequest{} }
+func (m *DialStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*DialStatsRequest) ProtoMessage()    {}
+func (*DialStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{44}
+}
+func (m *DialStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DialStatsRequest.Unmarshal(m, b)
+}
+func (m *DialStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DialStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *DialStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DialStatsRequest.Merge(dst, src)
+}
+func (m *DialStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_DialStatsRequest.Size(m)
+}
+func (m *DialStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DialStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DialStatsRequest proto.InternalMessageInfo
+
+type DialGroupStats struct {
+	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Successes            int64    `protobuf:"varint,2,opt,name=successes,proto3" json:"successes,omitempty"`
+	Failures             int64    `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	TotalLatencyMs       int64    `protobuf:"varint,4,opt,name=total_latency_ms,json=totalLatencyMs,proto3" json:"total_latency_ms,omitempty"`
+	LatencyCounts        []int64  `protobuf:"varint,5,rep,packed,name=latency_counts,json=latencyCounts,proto3" json:"latency_counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DialGroupStats) Reset()         { *m = DialGroupStats{} }
+func (m *DialGroupStats) String() string { return proto.CompactTextString(m) }
+func (*DialGroupStats) ProtoMessage()    {}
+func (*DialGroupStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{45}
+}
+func (m *DialGroupStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DialGroupStats.Unmarshal(m, b)
+}
+func (m *DialGroupStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DialGroupStats.Marshal(b, m, deterministic)
+}
+func (dst *DialGroupStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DialGroupStats.Merge(dst, src)
+}
+func (m *DialGroupStats) XXX_Size() int {
+	return xxx_messageInfo_DialGroupStats.Size(m)
+}
+func (m *DialGroupStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DialGroupStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DialGroupStats proto.InternalMessageInfo
+
+func (m *DialGroupStats) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *DialGroupStats) GetSuccesses() int64 {
+	if m != nil {
+		return m.Successes
+	}
+	return 0
+}
+
+func (m *DialGroupStats) GetFailures() int64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *DialGroupStats) GetTotalLatencyMs() int64 {
+	if m != nil {
+		return m.TotalLatencyMs
+	}
+	return 0
+}
+
+func (m *DialGroupStats) GetLatencyCounts() []int64 {
+	if m != nil {
+		return m.LatencyCounts
+	}
+	return nil
+}
+
+type DialStatsResponse struct {
+	LatencyBucketsMs     []int64           `protobuf:"varint,1,rep,packed,name=latency_buckets_ms,json=latencyBucketsMs,proto3" json:"latency_buckets_ms,omitempty"`
+	Regions              []*DialGroupStats `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"`
+	Subnets              []*DialGroupStats `protobuf:"bytes,3,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DialStatsResponse) Reset()         { *m = DialStatsResponse{} }
+func (m *DialStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*DialStatsResponse) ProtoMessage()    {}
+func (*DialStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_0eb04a4904fded80, []int{46}
+}
+func (m *DialStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DialStatsResponse.Unmarshal(m, b)
+}
+func (m *DialStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DialStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *DialStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DialStatsResponse.Merge(dst, src)
+}
+func (m *DialStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_DialStatsResponse.Size(m)
+}
+func (m *DialStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DialStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DialStatsResponse proto.InternalMessageInfo
+
+func (m *DialStatsResponse) GetLatencyBucketsMs() []int64 {
+	if m != nil {
+		return m.LatencyBucketsMs
+	}
+	return nil
+}
+
+func (m *DialStatsResponse) GetRegions() []*DialGroupStats {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
+}
+
+func (m *DialStatsResponse) GetSubnets() []*DialGroupStats {
+	if m != nil {
+		return m.Subnets
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListIrreparableSegmentsRequest)(nil), "inspector.ListIrreparableSegmentsRequest")
+	proto.RegisterType((*IrreparableSegment)(nil), "inspector.IrreparableSegment")
+	proto.RegisterType((*ListIrreparableSegmentsResponse)(nil), "inspector.ListIrreparableSegmentsResponse")
+	proto.RegisterType((*ObjectHealthRequest)(nil), "inspector.ObjectHealthRequest")
+	proto.RegisterType((*PiecePlacement)(nil), "inspector.PiecePlacement")
+	proto.RegisterType((*SegmentHealth)(nil), "inspector.SegmentHealth")
+	proto.RegisterType((*ObjectHealthResponse)(nil), "inspector.ObjectHealthResponse")
+	proto.RegisterType((*ScheduleMaintenanceRequest)(nil), "inspector.ScheduleMaintenanceRequest")
+	proto.RegisterType((*ScheduleMaintenanceResponse)(nil), "inspector.ScheduleMaintenanceResponse")
+	proto.RegisterType((*RepairStatsRequest)(nil), "inspector.RepairStatsRequest")
+	proto.RegisterType((*RepairOutcomeStats)(nil), "inspector.RepairOutcomeStats")
+	proto.RegisterType((*NodeRepairFailures)(nil), "inspector.NodeRepairFailures")
+	proto.RegisterType((*RepairStatsResponse)(nil), "inspector.RepairStatsResponse")
+	proto.RegisterType((*BroadcastRequest)(nil), "inspector.BroadcastRequest")
+	proto.RegisterType((*BroadcastResponse)(nil), "inspector.BroadcastResponse")
+	proto.RegisterType((*BroadcastStatusRequest)(nil), "inspector.BroadcastStatusRequest")
+	proto.RegisterType((*NoticeDelivery)(nil), "inspector.NoticeDelivery")
+	proto.RegisterType((*BroadcastStatusResponse)(nil), "inspector.BroadcastStatusResponse")
+	proto.RegisterType((*GetStatsRequest)(nil), "inspector.GetStatsRequest")
+	proto.RegisterType((*GetStatsResponse)(nil), "inspector.GetStatsResponse")
+	proto.RegisterType((*CreateStatsRequest)(nil), "inspector.CreateStatsRequest")
+	proto.RegisterType((*CreateStatsResponse)(nil), "inspector.CreateStatsResponse")
+	proto.RegisterType((*CountNodesResponse)(nil), "inspector.CountNodesResponse")
+	proto.RegisterType((*CountNodesRequest)(nil), "inspector.CountNodesRequest")
+	proto.RegisterType((*GetBucketsRequest)(nil), "inspector.GetBucketsRequest")
+	proto.RegisterType((*GetBucketsResponse)(nil), "inspector.GetBucketsResponse")
+	proto.RegisterType((*GetBucketRequest)(nil), "inspector.GetBucketRequest")
+	proto.RegisterType((*GetBucketResponse)(nil), "inspector.GetBucketResponse")
+	proto.RegisterType((*Bucket)(nil), "inspector.Bucket")
+	proto.RegisterType((*BucketList)(nil), "inspector.BucketList")
+	proto.RegisterType((*PingNodeRequest)(nil), "inspector.PingNodeRequest")
+	proto.RegisterType((*PingNodeResponse)(nil), "inspector.PingNodeResponse")
+	proto.RegisterType((*LookupNodeRequest)(nil), "inspector.LookupNodeRequest")
+	proto.RegisterType((*LookupNodeResponse)(nil), "inspector.LookupNodeResponse")
+	proto.RegisterType((*FindNearRequest)(nil), "inspector.FindNearRequest")
+	proto.RegisterType((*FindNearResponse)(nil), "inspector.FindNearResponse")
+	proto.RegisterType((*TopologyRequest)(nil), "inspector.TopologyRequest")
+	proto.RegisterType((*KBucket)(nil), "inspector.KBucket")
+	proto.RegisterType((*LookupStats)(nil), "inspector.LookupStats")
+	proto.RegisterType((*Lookup)(nil), "inspector.Lookup")
+	proto.RegisterType((*TopologyResponse)(nil), "inspector.TopologyResponse")
+	proto.RegisterType((*AuditHistoryRequest)(nil), "inspector.AuditHistoryRequest")
+	proto.RegisterType((*AuditRecord)(nil), "inspector.AuditRecord")
+	proto.RegisterType((*AuditHistoryResponse)(nil), "inspector.AuditHistoryResponse")
+	proto.RegisterType((*DialStatsRequest)(nil), "inspector.DialStatsRequest")
+	proto.RegisterType((*DialGroupStats)(nil), "inspector.DialGroupStats")
+	proto.RegisterType((*DialStatsResponse)(nil), "inspector.DialStatsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// KadInspectorClient is the client API for KadInspector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type KadInspectorClient interface {
+	// CountNodes returns the number of nodes in the routing table
+	CountNodes(ctx context.Context, in *CountNodesRequest, opts ...grpc.CallOption) (*CountNodesResponse, error)
+	// PingNodes sends a PING RPC to a node and returns it's availability
+	PingNode(ctx context.Context, in *PingNodeRequest, opts ...grpc.CallOption) (*PingNodeResponse, error)
+	// LookupNode triggers a Kademlia FindNode and returns the response
+	LookupNode(ctx context.Context, in *LookupNodeRequest, opts ...grpc.CallOption) (*LookupNodeResponse, error)
+	// FindNear returns limit number of IDs "near" the Start ID
+	FindNear(ctx context.Context, in *FindNearRequest, opts ...grpc.CallOption) (*FindNearResponse, error)
+	// Topology returns the routing table, neighborhood and recent lookups of the node
+	Topology(ctx context.Context, in *TopologyRequest, opts ...grpc.CallOption) (*TopologyResponse, error)
+}
+
+type kadInspectorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewKadInspectorClient(cc *grpc.ClientConn) KadInspectorClient {
+	return &kadInspectorClient{cc}
+}
+
+func (c *kadInspectorClient) CountNodes(ctx context.Context, in *CountNodesRequest, opts ...grpc.CallOption) (*CountNodesResponse, error) {
+	out := new(CountNodesResponse)
+	err := c.cc.Invoke(ctx, "/inspector.KadInspector/CountNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kadInspectorClient) PingNode(ctx context.Context, in *PingNodeRequest, opts ...grpc.CallOption) (*PingNodeResponse, error) {
+	out := new(PingNodeResponse)
+	err := c.cc.Invoke(ctx, "/inspector.KadInspector/PingNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kadInspectorClient) LookupNode(ctx context.Context, in *LookupNodeRequest, opts ...grpc.CallOption) (*LookupNodeResponse, error) {
+	out := new(LookupNodeResponse)
+	err := c.cc.Invoke(ctx, "/inspector.KadInspector/LookupNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kadInspectorClient) FindNear(ctx context.Context, in *FindNearRequest, opts ...grpc.CallOption) (*FindNearResponse, error) {
+	out := new(FindNearResponse)
+	err := c.cc.Invoke(ctx, "/inspector.KadInspector/FindNear", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kadInspectorClient) Topology(ctx context.Context, in *TopologyRequest, opts ...grpc.CallOption) (*TopologyResponse, error) {
+	out := new(TopologyResponse)
+	err := c.cc.Invoke(ctx, "/inspector.KadInspector/Topology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KadInspectorServer is the server API for KadInspector service.
+type KadInspectorServer interface {
+	// CountNodes returns the number of nodes in the routing table
+	CountNodes(context.Context, *CountNodesRequest) (*CountNodesResponse, error)
+	// PingNodes sends a PING RPC to a node and returns it's availability
+	PingNode(context.Context, *PingNodeRequest) (*PingNodeResponse, error)
+	// LookupNode triggers a Kademlia FindNode and returns the response
+	LookupNode(context.Context, *LookupNodeRequest) (*LookupNodeResponse, error)
+	// FindNear returns limit number of IDs "near" the Start ID
+	FindNear(context.Context, *FindNearRequest) (*FindNearResponse, error)
+	// Topology returns the routing table, neighborhood and recent lookups of the node
+	Topology(context.Context, *TopologyRequest) (*TopologyResponse, error)
+}
+
+func RegisterKadInspectorServer(s *grpc.Server, srv KadInspectorServer) {
+	s.RegisterService(&_KadInspector_serviceDesc, srv)
+}
+
+func _KadInspector_CountNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KadInspectorServer).CountNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.KadInspector/CountNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KadInspectorServer).CountNodes(ctx, req.(*CountNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KadInspector_PingNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KadInspectorServer).PingNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.KadInspector/PingNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KadInspectorServer).PingNode(ctx, req.(*PingNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KadInspector_LookupNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KadInspectorServer).LookupNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.KadInspector/LookupNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KadInspectorServer).LookupNode(ctx, req.(*LookupNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KadInspector_FindNear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KadInspectorServer).FindNear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.KadInspector/FindNear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KadInspectorServer).FindNear(ctx, req.(*FindNearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KadInspector_Topology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KadInspectorServer).Topology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.KadInspector/Topology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KadInspectorServer).Topology(ctx, req.(*TopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _KadInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.KadInspector",
+	HandlerType: (*KadInspectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CountNodes",
+			Handler:    _KadInspector_CountNodes_Handler,
+		},
+		{
+			MethodName: "PingNode",
+			Handler:    _KadInspector_PingNode_Handler,
+		},
+		{
+			MethodName: "LookupNode",
+			Handler:    _KadInspector_LookupNode_Handler,
+		},
+		{
+			MethodName: "FindNear",
+			Handler:    _KadInspector_FindNear_Handler,
+		},
+		{
+			MethodName: "Topology",
+			Handler:    _KadInspector_Topology_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
+}
+
+// OverlayInspectorClient is the client API for OverlayInspector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OverlayInspectorClient interface {
+	// CountNodes returns the number of nodes in the cache
+	CountNodes(ctx context.Context, in *CountNodesRequest, opts ...grpc.CallOption) (*CountNodesResponse, error)
+}
+
+type overlayInspectorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOverlayInspectorClient(cc *grpc.ClientConn) OverlayInspectorClient {
+	return &overlayInspectorClient{cc}
+}
+
+func (c *overlayInspectorClient) CountNodes(ctx context.Context, in *CountNodesRequest, opts ...grpc.CallOption) (*CountNodesResponse, error) {
+	out := new(CountNodesResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayInspector/CountNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OverlayInspectorServer is the server API for OverlayInspector service.
+type OverlayInspectorServer interface {
+	// CountNodes returns the number of nodes in the cache
+	CountNodes(context.Context, *CountNodesRequest) (*CountNodesResponse, error)
+}
+
+func RegisterOverlayInspectorServer(s *grpc.Server, srv OverlayInspectorServer) {
+	s.RegisterService(&_OverlayInspector_serviceDesc, srv)
+}
+
+func _OverlayInspector_CountNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayInspectorServer).CountNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayInspector/CountNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayInspectorServer).CountNodes(ctx, req.(*CountNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _OverlayInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.OverlayInspector",
+	HandlerType: (*OverlayInspectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CountNodes",
+			Handler:    _OverlayInspector_CountNodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
+}
+
+// StatDBInspectorClient is the client API for StatDBInspector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StatDBInspectorClient interface {
+	// GetStats returns the stats for a particular node ID
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	// CreateStats creates a node with specified stats
+	CreateStats(ctx context.Context, in *CreateStatsRequest, opts ...grpc.CallOption) (*CreateStatsResponse, error)
+}
+
+type statDBInspectorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStatDBInspectorClient(cc *grpc.ClientConn) StatDBInspectorClient {
+	return &statDBInspectorClient{cc}
+}
+
+func (c *statDBInspectorClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.StatDBInspector/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statDBInspectorClient) CreateStats(ctx context.Context, in *CreateStatsRequest, opts ...grpc.CallOption) (*CreateStatsResponse, error) {
+	out := new(CreateStatsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.StatDBInspector/CreateStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StatDBInspectorServer is the server API for StatDBInspector service.
+type StatDBInspectorServer interface {
+	// GetStats returns the stats for a particular node ID
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	// CreateStats creates a node with specified stats
+	CreateStats(context.Context, *CreateStatsRequest) (*CreateStatsResponse, error)
+}
+
+func RegisterStatDBInspectorServer(s *grpc.Server, srv StatDBInspectorServer) {
+	s.RegisterService(&_StatDBInspector_serviceDesc, srv)
+}
+
+func _StatDBInspector_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatDBInspectorServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.StatDBInspector/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatDBInspectorServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatDBInspector_CreateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatDBInspectorServer).CreateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.StatDBInspector/CreateStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatDBInspectorServer).CreateStats(ctx, req.(*CreateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _StatDBInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.StatDBInspector",
+	HandlerType: (*StatDBInspectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStats",
+			Handler:    _StatDBInspector_GetStats_Handler,
+		},
+		{
+			MethodName: "CreateStats",
+			Handler:    _StatDBInspector_CreateStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// KadInspectorClient is the client API for KadInspector service.
+// IrreparableInspectorClient is the client API for IrreparableInspector service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type KadInspectorClient interface {
-	// CountNodes returns the number of nodes in the routing table
-	CountNodes(ctx context.Context, in *CountNodesRequest, opts ...grpc.CallOption) (*CountNodesResponse, error)
-	// PingNodes sends a PING RPC to a node and returns it's availability
-	PingNode(ctx context.Context, in *PingNodeRequest, opts ...grpc.CallOption) (*PingNodeResponse, error)
-	// LookupNode triggers a Kademlia FindNode and returns the response
-	LookupNode(ctx context.Context, in *LookupNodeRequest, opts ...grpc.CallOption) (*LookupNodeResponse, error)
-	// FindNear returns limit number of IDs "near" the Start ID
-	FindNear(ctx context.Context, in *FindNearRequest, opts ...grpc.CallOption) (*FindNearResponse, error)
+type IrreparableInspectorClient interface {
+	// ListIrreparableSegments returns the irreparable segments of a project
+	ListIrreparableSegments(ctx context.Context, in *ListIrreparableSegmentsRequest, opts ...grpc.CallOption) (*ListIrreparableSegmentsResponse, error)
 }
 
-type kadInspectorClient struct {
+type irreparableInspectorClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewKadInspectorClient(cc *grpc.ClientConn) KadInspectorClient {
-	return &kadInspectorClient{cc}
+func NewIrreparableInspectorClient(cc *grpc.ClientConn) IrreparableInspectorClient {
+	return &irreparableInspectorClient{cc}
 }
 
-func (c *kadInspectorClient) CountNodes(ctx context.Context, in *CountNodesRequest, opts ...grpc.CallOption) (*CountNodesResponse, error) {
-	out := new(CountNodesResponse)
-	err := c.cc.Invoke(ctx, "/inspector.KadInspector/CountNodes", in, out, opts...)
+func (c *irreparableInspectorClient) ListIrreparableSegments(ctx context.Context, in *ListIrreparableSegmentsRequest, opts ...grpc.CallOption) (*ListIrreparableSegmentsResponse, error) {
+	out := new(ListIrreparableSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.IrreparableInspector/ListIrreparableSegments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kadInspectorClient) PingNode(ctx context.Context, in *PingNodeRequest, opts ...grpc.CallOption) (*PingNodeResponse, error) {
-	out := new(PingNodeResponse)
-	err := c.cc.Invoke(ctx, "/inspector.KadInspector/PingNode", in, out, opts...)
-	if err != nil {
+// IrreparableInspectorServer is the server API for IrreparableInspector service.
+type IrreparableInspectorServer interface {
+	// ListIrreparableSegments returns the irreparable segments of a project
+	ListIrreparableSegments(context.Context, *ListIrreparableSegmentsRequest) (*ListIrreparableSegmentsResponse, error)
+}
+
+func RegisterIrreparableInspectorServer(s *grpc.Server, srv IrreparableInspectorServer) {
+	s.RegisterService(&_IrreparableInspector_serviceDesc, srv)
+}
+
+func _IrreparableInspector_ListIrreparableSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIrreparableSegmentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IrreparableInspectorServer).ListIrreparableSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.IrreparableInspector/ListIrreparableSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IrreparableInspectorServer).ListIrreparableSegments(ctx, req.(*ListIrreparableSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *kadInspectorClient) LookupNode(ctx context.Context, in *LookupNodeRequest, opts ...grpc.CallOption) (*LookupNodeResponse, error) {
-	out := new(LookupNodeResponse)
-	err := c.cc.Invoke(ctx, "/inspector.KadInspector/LookupNode", in, out, opts...)
+var _IrreparableInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.IrreparableInspector",
+	HandlerType: (*IrreparableInspectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListIrreparableSegments",
+			Handler:    _IrreparableInspector_ListIrreparableSegments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
+}
+
+// PointerDBInspectorClient is the client API for PointerDBInspector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PointerDBInspectorClient interface {
+	// ObjectHealth returns where the pieces of the segments of an object are stored and how healthy they are
+	ObjectHealth(ctx context.Context, in *ObjectHealthRequest, opts ...grpc.CallOption) (*ObjectHealthResponse, error)
+	// ScheduleMaintenance schedules or cancels a maintenance window during which no new uploads are accepted
+	ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceResponse, error)
+}
+
+type pointerDBInspectorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPointerDBInspectorClient(cc *grpc.ClientConn) PointerDBInspectorClient {
+	return &pointerDBInspectorClient{cc}
+}
+
+func (c *pointerDBInspectorClient) ObjectHealth(ctx context.Context, in *ObjectHealthRequest, opts ...grpc.CallOption) (*ObjectHealthResponse, error) {
+	out := new(ObjectHealthResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PointerDBInspector/ObjectHealth", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *kadInspectorClient) FindNear(ctx context.Context, in *FindNearRequest, opts ...grpc.CallOption) (*FindNearResponse, error) {
-	out := new(FindNearResponse)
-	err := c.cc.Invoke(ctx, "/inspector.KadInspector/FindNear", in, out, opts...)
+func (c *pointerDBInspectorClient) ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceResponse, error) {
+	out := new(ScheduleMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PointerDBInspector/ScheduleMaintenance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// KadInspectorServer is the server API for KadInspector service.
-type KadInspectorServer interface {
-	// CountNodes returns the number of nodes in the routing table
-	CountNodes(context.Context, *CountNodesRequest) (*CountNodesResponse, error)
-	// PingNodes sends a PING RPC to a node and returns it's availability
-	PingNode(context.Context, *PingNodeRequest) (*PingNodeResponse, error)
-	// LookupNode triggers a Kademlia FindNode and returns the response
-	LookupNode(context.Context, *LookupNodeRequest) (*LookupNodeResponse, error)
-	// FindNear returns limit number of IDs "near" the Start ID
-	FindNear(context.Context, *FindNearRequest) (*FindNearResponse, error)
+// PointerDBInspectorServer is the server API for PointerDBInspector service.
+type PointerDBInspectorServer interface {
+	// ObjectHealth returns where the pieces of the segments of an object are stored and how healthy they are
+	ObjectHealth(context.Context, *ObjectHealthRequest) (*ObjectHealthResponse, error)
+	// ScheduleMaintenance schedules or cancels a maintenance window during which no new uploads are accepted
+	ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*ScheduleMaintenanceResponse, error)
 }
 
-func RegisterKadInspectorServer(s *grpc.Server, srv KadInspectorServer) {
-	s.RegisterService(&_KadInspector_serviceDesc, srv)
+func RegisterPointerDBInspectorServer(s *grpc.Server, srv PointerDBInspectorServer) {
+	s.RegisterService(&_PointerDBInspector_serviceDesc, srv)
 }
 
-func _KadInspector_CountNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountNodesRequest)
+func _PointerDBInspector_ObjectHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KadInspectorServer).CountNodes(ctx, in)
+		return srv.(PointerDBInspectorServer).ObjectHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/inspector.KadInspector/CountNodes",
+		FullMethod: "/inspector.PointerDBInspector/ObjectHealth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KadInspectorServer).CountNodes(ctx, req.(*CountNodesRequest))
+		return srv.(PointerDBInspectorServer).ObjectHealth(ctx, req.(*ObjectHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KadInspector_PingNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingNodeRequest)
+func _PointerDBInspector_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KadInspectorServer).PingNode(ctx, in)
+		return srv.(PointerDBInspectorServer).ScheduleMaintenance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/inspector.KadInspector/PingNode",
+		FullMethod: "/inspector.PointerDBInspector/ScheduleMaintenance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KadInspectorServer).PingNode(ctx, req.(*PingNodeRequest))
+		return srv.(PointerDBInspectorServer).ScheduleMaintenance(ctx, req.(*ScheduleMaintenanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KadInspector_LookupNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LookupNodeRequest)
+var _PointerDBInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.PointerDBInspector",
+	HandlerType: (*PointerDBInspectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ObjectHealth",
+			Handler:    _PointerDBInspector_ObjectHealth_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _PointerDBInspector_ScheduleMaintenance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
+}
+
+// BroadcastInspectorClient is the client API for BroadcastInspector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BroadcastInspectorClient interface {
+	// Broadcast signs a notice and delivers it to the nodes the satellite contacted recently
+	Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error)
+	// BroadcastStatus returns how many nodes a broadcast notice was delivered to
+	BroadcastStatus(ctx context.Context, in *BroadcastStatusRequest, opts ...grpc.CallOption) (*BroadcastStatusResponse, error)
+}
+
+type broadcastInspectorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBroadcastInspectorClient(cc *grpc.ClientConn) BroadcastInspectorClient {
+	return &broadcastInspectorClient{cc}
+}
+
+func (c *broadcastInspectorClient) Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error) {
+	out := new(BroadcastResponse)
+	err := c.cc.Invoke(ctx, "/inspector.BroadcastInspector/Broadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *broadcastInspectorClient) BroadcastStatus(ctx context.Context, in *BroadcastStatusRequest, opts ...grpc.CallOption) (*BroadcastStatusResponse, error) {
+	out := new(BroadcastStatusResponse)
+	err := c.cc.Invoke(ctx, "/inspector.BroadcastInspector/BroadcastStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BroadcastInspectorServer is the server API for BroadcastInspector service.
+type BroadcastInspectorServer interface {
+	// Broadcast signs a notice and delivers it to the nodes the satellite contacted recently
+	Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error)
+	// BroadcastStatus returns how many nodes a broadcast notice was delivered to
+	BroadcastStatus(context.Context, *BroadcastStatusRequest) (*BroadcastStatusResponse, error)
+}
+
+func RegisterBroadcastInspectorServer(s *grpc.Server, srv BroadcastInspectorServer) {
+	s.RegisterService(&_BroadcastInspector_serviceDesc, srv)
+}
+
+func _BroadcastInspector_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KadInspectorServer).LookupNode(ctx, in)
+		return srv.(BroadcastInspectorServer).Broadcast(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/inspector.KadInspector/LookupNode",
+		FullMethod: "/inspector.BroadcastInspector/Broadcast",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KadInspectorServer).LookupNode(ctx, req.(*LookupNodeRequest))
+		return srv.(BroadcastInspectorServer).Broadcast(ctx, req.(*BroadcastRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KadInspector_FindNear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindNearRequest)
+func _BroadcastInspector_BroadcastStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KadInspectorServer).FindNear(ctx, in)
+		return srv.(BroadcastInspectorServer).BroadcastStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/inspector.KadInspector/FindNear",
+		FullMethod: "/inspector.BroadcastInspector/BroadcastStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KadInspectorServer).FindNear(ctx, req.(*FindNearRequest))
+		return srv.(BroadcastInspectorServer).BroadcastStatus(ctx, req.(*BroadcastStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _KadInspector_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "inspector.KadInspector",
-	HandlerType: (*KadInspectorServer)(nil),
+var _BroadcastInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.BroadcastInspector",
+	HandlerType: (*BroadcastInspectorServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CountNodes",
-			Handler:    _KadInspector_CountNodes_Handler,
-		},
-		{
-			MethodName: "PingNode",
-			Handler:    _KadInspector_PingNode_Handler,
-		},
-		{
-			MethodName: "LookupNode",
-			Handler:    _KadInspector_LookupNode_Handler,
+			MethodName: "Broadcast",
+			Handler:    _BroadcastInspector_Broadcast_Handler,
 		},
 		{
-			MethodName: "FindNear",
-			Handler:    _KadInspector_FindNear_Handler,
+			MethodName: "BroadcastStatus",
+			Handler:    _BroadcastInspector_BroadcastStatus_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
 }
 
-// OverlayInspectorClient is the client API for OverlayInspector service.
+// RepairInspectorClient is the client API for RepairInspector service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type OverlayInspectorClient interface {
-	// CountNodes returns the number of nodes in the cache
-	CountNodes(ctx context.Context, in *CountNodesRequest, opts ...grpc.CallOption) (*CountNodesResponse, error)
+type RepairInspectorClient interface {
+	// RepairStats returns the outcomes of the repairs done by the satellite's repair worker
+	RepairStats(ctx context.Context, in *RepairStatsRequest, opts ...grpc.CallOption) (*RepairStatsResponse, error)
 }
 
-type overlayInspectorClient struct {
+type repairInspectorClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewOverlayInspectorClient(cc *grpc.ClientConn) OverlayInspectorClient {
-	return &overlayInspectorClient{cc}
+func NewRepairInspectorClient(cc *grpc.ClientConn) RepairInspectorClient {
+	return &repairInspectorClient{cc}
 }
 
-func (c *overlayInspectorClient) CountNodes(ctx context.Context, in *CountNodesRequest, opts ...grpc.CallOption) (*CountNodesResponse, error) {
-	out := new(CountNodesResponse)
-	err := c.cc.Invoke(ctx, "/inspector.OverlayInspector/CountNodes", in, out, opts...)
+func (c *repairInspectorClient) RepairStats(ctx context.Context, in *RepairStatsRequest, opts ...grpc.CallOption) (*RepairStatsResponse, error) {
+	out := new(RepairStatsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.RepairInspector/RepairStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// OverlayInspectorServer is the server API for OverlayInspector service.
-type OverlayInspectorServer interface {
-	// CountNodes returns the number of nodes in the cache
-	CountNodes(context.Context, *CountNodesRequest) (*CountNodesResponse, error)
+// RepairInspectorServer is the server API for RepairInspector service.
+type RepairInspectorServer interface {
+	// RepairStats returns the outcomes of the repairs done by the satellite's repair worker
+	RepairStats(context.Context, *RepairStatsRequest) (*RepairStatsResponse, error)
 }
 
-func RegisterOverlayInspectorServer(s *grpc.Server, srv OverlayInspectorServer) {
-	s.RegisterService(&_OverlayInspector_serviceDesc, srv)
+func RegisterRepairInspectorServer(s *grpc.Server, srv RepairInspectorServer) {
+	s.RegisterService(&_RepairInspector_serviceDesc, srv)
 }
 
-func _OverlayInspector_CountNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountNodesRequest)
+func _RepairInspector_RepairStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OverlayInspectorServer).CountNodes(ctx, in)
+		return srv.(RepairInspectorServer).RepairStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/inspector.OverlayInspector/CountNodes",
+		FullMethod: "/inspector.RepairInspector/RepairStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OverlayInspectorServer).CountNodes(ctx, req.(*CountNodesRequest))
+		return srv.(RepairInspectorServer).RepairStats(ctx, req.(*RepairStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _OverlayInspector_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "inspector.OverlayInspector",
-	HandlerType: (*OverlayInspectorServer)(nil),
+var _RepairInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.RepairInspector",
+	HandlerType: (*RepairInspectorServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CountNodes",
-			Handler:    _OverlayInspector_CountNodes_Handler,
+			MethodName: "RepairStats",
+			Handler:    _RepairInspector_RepairStats_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
 }
 
-// StatDBInspectorClient is the client API for StatDBInspector service.
+// AuditInspectorClient is the client API for AuditInspector service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type StatDBInspectorClient interface {
-	// GetStats returns the stats for a particular node ID
-	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
-	// CreateStats creates a node with specified stats
-	CreateStats(ctx context.Context, in *CreateStatsRequest, opts ...grpc.CallOption) (*CreateStatsResponse, error)
+type AuditInspectorClient interface {
+	// AuditHistory returns the outcomes of the recent audits of a node
+	AuditHistory(ctx context.Context, in *AuditHistoryRequest, opts ...grpc.CallOption) (*AuditHistoryResponse, error)
 }
 
-type statDBInspectorClient struct {
+type auditInspectorClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewStatDBInspectorClient(cc *grpc.ClientConn) StatDBInspectorClient {
-	return &statDBInspectorClient{cc}
-}
-
-func (c *statDBInspectorClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
-	out := new(GetStatsResponse)
-	err := c.cc.Invoke(ctx, "/inspector.StatDBInspector/GetStats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewAuditInspectorClient(cc *grpc.ClientConn) AuditInspectorClient {
+	return &auditInspectorClient{cc}
 }
 
-func (c *statDBInspectorClient) CreateStats(ctx context.Context, in *CreateStatsRequest, opts ...grpc.CallOption) (*CreateStatsResponse, error) {
-	out := new(CreateStatsResponse)
-	err := c.cc.Invoke(ctx, "/inspector.StatDBInspector/CreateStats", in, out, opts...)
+func (c *auditInspectorClient) AuditHistory(ctx context.Context, in *AuditHistoryRequest, opts ...grpc.CallOption) (*AuditHistoryResponse, error) {
+	out := new(AuditHistoryResponse)
+	err := c.cc.Invoke(ctx, "/inspector.AuditInspector/AuditHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// StatDBInspectorServer is the server API for StatDBInspector service.
-type StatDBInspectorServer interface {
-	// GetStats returns the stats for a particular node ID
-	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
-	// CreateStats creates a node with specified stats
-	CreateStats(context.Context, *CreateStatsRequest) (*CreateStatsResponse, error)
+// AuditInspectorServer is the server API for AuditInspector service.
+type AuditInspectorServer interface {
+	// AuditHistory returns the outcomes of the recent audits of a node
+	AuditHistory(context.Context, *AuditHistoryRequest) (*AuditHistoryResponse, error)
 }
 
-func RegisterStatDBInspectorServer(s *grpc.Server, srv StatDBInspectorServer) {
-	s.RegisterService(&_StatDBInspector_serviceDesc, srv)
+func RegisterAuditInspectorServer(s *grpc.Server, srv AuditInspectorServer) {
+	s.RegisterService(&_AuditInspector_serviceDesc, srv)
 }
 
-func _StatDBInspector_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStatsRequest)
+func _AuditInspector_AuditHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StatDBInspectorServer).GetStats(ctx, in)
+		return srv.(AuditInspectorServer).AuditHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/inspector.StatDBInspector/GetStats",
+		FullMethod: "/inspector.AuditInspector/AuditHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StatDBInspectorServer).GetStats(ctx, req.(*GetStatsRequest))
+		return srv.(AuditInspectorServer).AuditHistory(ctx, req.(*AuditHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StatDBInspector_CreateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateStatsRequest)
+var _AuditInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.AuditInspector",
+	HandlerType: (*AuditInspectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AuditHistory",
+			Handler:    _AuditInspector_AuditHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
+}
+
+// TransportInspectorClient is the client API for TransportInspector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TransportInspectorClient interface {
+	// DialStats returns the success rates and latencies of the dials of the peer by region and subnet of the dialed nodes
+	DialStats(ctx context.Context, in *DialStatsRequest, opts ...grpc.CallOption) (*DialStatsResponse, error)
+}
+
+type transportInspectorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTransportInspectorClient(cc *grpc.ClientConn) TransportInspectorClient {
+	return &transportInspectorClient{cc}
+}
+
+func (c *transportInspectorClient) DialStats(ctx context.Context, in *DialStatsRequest, opts ...grpc.CallOption) (*DialStatsResponse, error) {
+	out := new(DialStatsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.TransportInspector/DialStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransportInspectorServer is the server API for TransportInspector service.
+type TransportInspectorServer interface {
+	// DialStats returns the success rates and latencies of the dials of the peer by region and subnet of the dialed nodes
+	DialStats(context.Context, *DialStatsRequest) (*DialStatsResponse, error)
+}
+
+func RegisterTransportInspectorServer(s *grpc.Server, srv TransportInspectorServer) {
+	s.RegisterService(&_TransportInspector_serviceDesc, srv)
+}
+
+func _TransportInspector_DialStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DialStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StatDBInspectorServer).CreateStats(ctx, in)
+		return srv.(TransportInspectorServer).DialStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/inspector.StatDBInspector/CreateStats",
+		FullMethod: "/inspector.TransportInspector/DialStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StatDBInspectorServer).CreateStats(ctx, req.(*CreateStatsRequest))
+		return srv.(TransportInspectorServer).DialStats(ctx, req.(*DialStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _StatDBInspector_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "inspector.StatDBInspector",
-	HandlerType: (*StatDBInspectorServer)(nil),
+var _TransportInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.TransportInspector",
+	HandlerType: (*TransportInspectorServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetStats",
-			Handler:    _StatDBInspector_GetStats_Handler,
-		},
-		{
-			MethodName: "CreateStats",
-			Handler:    _StatDBInspector_CreateStats_Handler,
+			MethodName: "DialStats",
+			Handler:    _TransportInspector_DialStats_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
 }
 
-func init() { proto.RegisterFile("inspector.proto", fileDescriptor_inspector_3c76240ddc9521cd) }
-
-var fileDescriptor_inspector_3c76240ddc9521cd = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x6e, 0x13, 0x31,
-	0x14, 0x65, 0x26, 0x8f, 0xa6, 0x37, 0x51, 0x93, 0xba, 0x45, 0x8a, 0x42, 0x69, 0x83, 0x85, 0xa0,
-	0xea, 0x22, 0x42, 0x81, 0x15, 0x12, 0x9b, 0xa4, 0xa2, 0x44, 0x2d, 0x05, 0x4d, 0xc5, 0x06, 0x81,
-	0x2a, 0x37, 0xb6, 0x2a, 0x2b, 0x4d, 0x3c, 0x8c, 0x3d, 0x48, 0xfc, 0x0a, 0x6b, 0xd6, 0x7c, 0x07,
-	0xdf, 0xc0, 0xa2, 0x1b, 0x7e, 0x04, 0xd9, 0x9e, 0x89, 0xe7, 0xd1, 0xa8, 0x15, 0x12, 0xbb, 0xf1,
-	0x3d, 0x67, 0xce, 0x7d, 0x1c, 0x3f, 0xa0, 0xcd, 0x17, 0x32, 0x64, 0x53, 0x25, 0xa2, 0x41, 0x18,
-	0x09, 0x25, 0xd0, 0xfa, 0x32, 0xd0, 0x83, 0x4b, 0x71, 0x29, 0x6c, 0xb8, 0x07, 0x0b, 0x41, 0x99,
-	0xfd, 0xc6, 0x2f, 0xa1, 0x7d, 0xc4, 0xd4, 0x99, 0x22, 0x4a, 0x06, 0xec, 0x4b, 0xcc, 0xa4, 0x42,
-	0x4f, 0x61, 0x4d, 0x13, 0xce, 0x39, 0xed, 0x7a, 0x7d, 0x6f, 0xbf, 0x35, 0xda, 0xf8, 0x75, 0xbd,
-	0x77, 0xef, 0xf7, 0xf5, 0x5e, 0xfd, 0x54, 0x50, 0x36, 0x39, 0x0c, 0xea, 0x1a, 0x9e, 0x50, 0xfc,
-	0xdd, 0x83, 0x8e, 0xfb, 0x59, 0x86, 0x62, 0x21, 0x19, 0xda, 0x83, 0x26, 0x89, 0x29, 0x57, 0xe7,
-	0x53, 0x11, 0x2f, 0x94, 0x51, 0xa8, 0x04, 0x60, 0x42, 0x63, 0x1d, 0x71, 0x84, 0x88, 0x28, 0x2e,
-	0xba, 0x7e, 0xdf, 0xdb, 0xf7, 0x12, 0x42, 0xa0, 0x23, 0xe8, 0x11, 0xb4, 0xe2, 0x50, 0xf1, 0x39,
-	0x4b, 0x24, 0x2a, 0x46, 0xa2, 0x69, 0x63, 0x56, 0xc3, 0x51, 0xac, 0x48, 0xd5, 0x88, 0x24, 0x14,
-	0xa3, 0x82, 0xff, 0x78, 0x80, 0xc6, 0x11, 0x23, 0x8a, 0xfd, 0x53, 0x73, 0xc5, 0x3e, 0xfc, 0x52,
-	0x1f, 0x03, 0xd8, 0xb2, 0x04, 0x19, 0x4f, 0xa7, 0x4c, 0xca, 0x5c, 0xb5, 0x9b, 0x06, 0x3a, 0xb3,
-	0x48, 0xb1, 0x66, 0x4b, 0xac, 0x96, 0xdb, 0x7a, 0x06, 0xdb, 0x09, 0x25, 0xaf, 0x59, 0x33, 0x54,
-	0x64, 0xb1, 0xac, 0x28, 0xbe, 0x0f, 0x5b, 0xb9, 0x26, 0xad, 0x09, 0xf8, 0x00, 0x90, 0xc1, 0x75,
-	0x4f, 0xce, 0x9a, 0x6d, 0xa8, 0x65, 0x4d, 0xb1, 0x0b, 0xbc, 0x05, 0x9b, 0x59, 0xae, 0x19, 0x93,
-	0x0e, 0x1e, 0x31, 0x35, 0x8a, 0xa7, 0x33, 0xb6, 0x9c, 0x1d, 0x7e, 0x03, 0x28, 0x1b, 0x74, 0xaa,
-	0x4a, 0x28, 0x72, 0x95, 0xaa, 0x9a, 0x05, 0xda, 0x81, 0x0a, 0xa7, 0xb2, 0xeb, 0xf7, 0x2b, 0xfb,
-	0xad, 0x11, 0x64, 0xe6, 0xab, 0xc3, 0x78, 0x68, 0x36, 0x8e, 0x55, 0x4a, 0x9d, 0xd9, 0x05, 0x7f,
-	0xa5, 0x29, 0x3e, 0xa7, 0xf8, 0x43, 0xa6, 0xa4, 0x65, 0xf2, 0x5b, 0x7e, 0x42, 0x7d, 0xa8, 0x69,
-	0x3f, 0x6d, 0x21, 0xcd, 0x21, 0x0c, 0xcc, 0xd6, 0xd7, 0x84, 0xc0, 0x02, 0xf8, 0x00, 0xea, 0x56,
-	0xf3, 0x0e, 0xdc, 0x01, 0x80, 0xe5, 0x9e, 0x70, 0x99, 0xe1, 0x7b, 0xab, 0xf8, 0xc7, 0xd0, 0x7e,
-	0xcf, 0x17, 0x97, 0x26, 0x74, 0xb7, 0x2e, 0x51, 0x17, 0xd6, 0x08, 0xa5, 0x11, 0x93, 0xd2, 0x6c,
-	0xb9, 0xf5, 0x20, 0x5d, 0x62, 0x0c, 0x1d, 0x27, 0x96, 0xb4, 0xbf, 0x01, 0xbe, 0x98, 0x19, 0xb5,
-	0x46, 0xe0, 0x8b, 0x19, 0x7e, 0x05, 0x9b, 0x27, 0x42, 0xcc, 0xe2, 0x30, 0x9b, 0x72, 0x63, 0x99,
-	0x72, 0xfd, 0x96, 0x14, 0x9f, 0x00, 0x65, 0x7f, 0x5f, 0xce, 0xb8, 0xaa, 0xdb, 0x31, 0x0a, 0xf9,
-	0x36, 0x4d, 0x1c, 0x3d, 0x81, 0xea, 0x9c, 0x29, 0x62, 0xc4, 0x9a, 0x43, 0xe4, 0xf0, 0xb7, 0x4c,
-	0x11, 0x4a, 0x14, 0x09, 0x0c, 0x8e, 0xe7, 0xd0, 0x7e, 0xcd, 0x17, 0xf4, 0x94, 0x91, 0xe8, 0xae,
-	0xd3, 0x78, 0x0c, 0x35, 0xa9, 0x48, 0x64, 0x8f, 0x5f, 0x99, 0x62, 0x41, 0xbd, 0x03, 0xaf, 0xf8,
-	0x9c, 0xa7, 0x67, 0xcf, 0x2e, 0xf0, 0x0b, 0xe8, 0xb8, 0x74, 0x49, 0x2b, 0xb7, 0x5a, 0x3c, 0xfc,
-	0xe9, 0x43, 0xeb, 0x98, 0xd0, 0x49, 0x7a, 0x71, 0xa2, 0x09, 0x80, 0x3b, 0x1e, 0x68, 0x67, 0xe0,
-	0xee, 0xd8, 0xd2, 0xa9, 0xe9, 0x3d, 0x5c, 0x81, 0x26, 0xd9, 0xc7, 0xd0, 0x48, 0x1d, 0x44, 0xbd,
-	0x0c, 0xb5, 0xb0, 0x47, 0x7a, 0x0f, 0x6e, 0xc4, 0x12, 0x91, 0x09, 0x80, 0xf3, 0x28, 0x57, 0x4f,
-	0xc9, 0xf9, 0x5c, 0x3d, 0x37, 0x18, 0x3b, 0x86, 0x46, 0x3a, 0xa1, 0x5c, 0x3d, 0x05, 0x97, 0x72,
-	0xf5, 0x14, 0x47, 0x3a, 0xfc, 0x0c, 0x9d, 0x77, 0x5f, 0x59, 0x74, 0x45, 0xbe, 0xfd, 0x8f, 0x99,
-	0x0d, 0x7f, 0x78, 0xd0, 0xd6, 0x77, 0xdb, 0xe1, 0xc8, 0xc9, 0x8f, 0xa1, 0x91, 0x3e, 0x3b, 0xb9,
-	0xba, 0x0b, 0x0f, 0x59, 0xae, 0xee, 0xd2, 0x3b, 0x75, 0x02, 0xcd, 0xcc, 0xcd, 0x89, 0x72, 0x65,
-	0x94, 0x9e, 0x8d, 0xde, 0xee, 0x2a, 0xd8, 0xaa, 0x8d, 0xaa, 0x1f, 0xfd, 0xf0, 0xe2, 0xa2, 0x6e,
-	0xde, 0xd4, 0xe7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xca, 0xf7, 0xf6, 0x6e, 0x89, 0x07, 0x00,
-	0x00,
+func init() { proto.RegisterFile("inspector.proto", fileDescriptor_inspector_0eb04a4904fded80) }
+
+var fileDescriptor_inspector_0eb04a4904fded80 = []byte{
+	// 2335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x59, 0x3d, 0x6c, 0x24, 0x49,
+	0x15, 0x66, 0x3c, 0x3f, 0x9e, 0x79, 0x33, 0x9e, 0x9f, 0xb2, 0xd9, 0x35, 0xb3, 0x3f, 0xbe, 0x6b,
+	0xd8, 0x63, 0x6f, 0x39, 0x59, 0x87, 0x77, 0x11, 0x3f, 0x12, 0xc1, 0xd9, 0x66, 0x6f, 0xad, 0xf5,
+	0xae, 0xad, 0xf6, 0x22, 0x21, 0x74, 0x68, 0xd4, 0x9e, 0x2e, 0x8f, 0x9b, 0xed, 0xe9, 0x9e, 0xeb,
+	0xee, 0x01, 0x4c, 0x42, 0x86, 0xc8, 0xc9, 0x48, 0x48, 0x10, 0x42, 0x22, 0x22, 0x21, 0x25, 0x45,
+	0x90, 0x10, 0x13, 0x5c, 0x42, 0x76, 0x29, 0x09, 0x21, 0xaf, 0xea, 0x55, 0x75, 0x57, 0x4d, 0xcf,
+	0xac, 0x7d, 0x27, 0xc8, 0xa6, 0xbe, 0xf7, 0xfa, 0xd5, 0xfb, 0xaf, 0x57, 0x35, 0xd0, 0x0b, 0xa2,
+	0x74, 0xc6, 0xc7, 0x59, 0x9c, 0xec, 0xce, 0x92, 0x38, 0x8b, 0x59, 0x2b, 0x07, 0x86, 0x30, 0x89,
+	0x27, 0x31, 0xc1, 0x43, 0x88, 0x62, 0x9f, 0xd3, 0x6f, 0x67, 0x0a, 0xf7, 0x8f, 0x83, 0x34, 0x3b,
+	0x4a, 0x12, 0x3e, 0xf3, 0x12, 0xef, 0x3c, 0xe4, 0x67, 0x7c, 0x32, 0xe5, 0x51, 0x96, 0xba, 0xfc,
+	0xe3, 0x39, 0x4f, 0x33, 0x76, 0x0f, 0x00, 0x59, 0x7f, 0x8c, 0x62, 0x46, 0x81, 0xbf, 0x5d, 0x79,
+	0xab, 0xf2, 0xb0, 0xe3, 0xb6, 0x14, 0x72, 0xe4, 0xb3, 0x2d, 0xa8, 0x87, 0xc1, 0x34, 0xc8, 0xb6,
+	0xd7, 0x90, 0x52, 0x77, 0x69, 0xc1, 0x6e, 0x41, 0x23, 0xbe, 0xb8, 0x48, 0x79, 0xb6, 0x5d, 0x45,
+	0xb8, 0xea, 0xaa, 0x95, 0xf3, 0xb7, 0x0a, 0xb0, 0xf2, 0x5e, 0x8c, 0x41, 0x6d, 0xe6, 0x65, 0x97,
+	0x4a, 0xba, 0xfc, 0xcd, 0x76, 0xa0, 0x1d, 0xc6, 0x69, 0x36, 0x9a, 0x05, 0x7c, 0xcc, 0x53, 0x29,
+	0xbe, 0xea, 0x82, 0x80, 0x4e, 0x25, 0xc2, 0x76, 0x61, 0x33, 0xf4, 0x90, 0x41, 0x48, 0x0b, 0x92,
+	0x51, 0xca, 0xc7, 0x71, 0xe4, 0xa7, 0x6a, 0xc3, 0x81, 0x20, 0xb9, 0x92, 0x72, 0x46, 0x04, 0xf6,
+	0x3e, 0x6c, 0x29, 0x56, 0x2f, 0xcb, 0xf8, 0x74, 0x96, 0x8d, 0xc6, 0xf1, 0x3c, 0xca, 0xb6, 0x6b,
+	0xf2, 0x03, 0x46, 0xb4, 0x0f, 0x88, 0x74, 0x20, 0x28, 0xc2, 0x74, 0xb9, 0x03, 0x4f, 0x92, 0x38,
+	0xd9, 0xae, 0x23, 0x5f, 0xcb, 0x6d, 0x09, 0xe4, 0x7b, 0x02, 0x70, 0x3e, 0x82, 0x9d, 0x95, 0xbe,
+	0x4b, 0x67, 0x71, 0x94, 0x72, 0xf6, 0x6d, 0x68, 0xa6, 0x0a, 0x43, 0xe3, 0xaa, 0x0f, 0xdb, 0x7b,
+	0xf7, 0x76, 0x8b, 0x28, 0x95, 0xbf, 0x74, 0x73, 0x76, 0x27, 0x85, 0xcd, 0x93, 0x73, 0xe1, 0xe4,
+	0x67, 0xdc, 0x0b, 0xb3, 0xcb, 0x1b, 0x86, 0x03, 0x1d, 0x7f, 0x3e, 0x1f, 0xbf, 0xe6, 0x14, 0x8f,
+	0x8e, 0xab, 0x56, 0xec, 0x01, 0x74, 0x79, 0x34, 0x4e, 0xae, 0x66, 0x19, 0xf7, 0x47, 0xd2, 0xd7,
+	0x55, 0x49, 0xdf, 0xc8, 0xd1, 0x53, 0x04, 0x9d, 0x08, 0xba, 0xd2, 0xbb, 0xa7, 0xa1, 0x37, 0xe6,
+	0x32, 0x34, 0x77, 0xa0, 0x25, 0x23, 0x30, 0x8a, 0xe6, 0x53, 0xb9, 0x5d, 0xdd, 0x6d, 0x4a, 0xe0,
+	0xe5, 0x7c, 0xca, 0xbe, 0x0a, 0xeb, 0x22, 0x97, 0x84, 0x26, 0x72, 0xbb, 0xfd, 0xee, 0x5f, 0x3f,
+	0xd9, 0xf9, 0xc2, 0x3f, 0x3f, 0xd9, 0x69, 0xbc, 0x44, 0xf8, 0xe8, 0xd0, 0x6d, 0x08, 0x32, 0xa9,
+	0x15, 0x47, 0x61, 0x10, 0x71, 0xb9, 0x6d, 0xd3, 0x55, 0x2b, 0xe7, 0x3f, 0x6b, 0xb0, 0xa1, 0x4c,
+	0x27, 0x33, 0xd9, 0x97, 0x61, 0x43, 0xb9, 0x60, 0x14, 0x44, 0x3e, 0xff, 0x99, 0xdc, 0xb3, 0xea,
+	0x76, 0x14, 0x78, 0x24, 0xb0, 0x3c, 0x5f, 0xd6, 0x8c, 0x7c, 0xc1, 0x2d, 0x02, 0x6b, 0x0b, 0x5a,
+	0xb1, 0xdb, 0xb0, 0x3e, 0x0d, 0x22, 0xcc, 0x92, 0x8f, 0x65, 0xa4, 0xeb, 0x6e, 0x03, 0x97, 0xe8,
+	0x4d, 0xf6, 0x2e, 0xf4, 0x55, 0x3e, 0x64, 0x97, 0x09, 0x4f, 0x2f, 0xe3, 0xd0, 0x97, 0x31, 0xae,
+	0xbb, 0x3d, 0xc2, 0x5f, 0x69, 0x98, 0x7d, 0x0d, 0x06, 0xe9, 0x7c, 0x8c, 0x49, 0x97, 0x1a, 0xbc,
+	0x0d, 0xc9, 0xdb, 0x57, 0x84, 0x82, 0x19, 0x2b, 0x22, 0x8b, 0x33, 0x2f, 0xdc, 0x5e, 0xa7, 0x8a,
+	0x90, 0x0b, 0xf6, 0x1e, 0x30, 0x99, 0x4b, 0xde, 0xdc, 0x0f, 0xb2, 0x3c, 0x59, 0x9b, 0xd2, 0xb8,
+	0xbe, 0xa0, 0x7c, 0x20, 0x08, 0x3a, 0x57, 0x57, 0xe4, 0x76, 0x6b, 0x55, 0x6e, 0x7f, 0x1d, 0x1a,
+	0xaa, 0x4e, 0x40, 0x66, 0xd9, 0x97, 0x8c, 0x2c, 0xb3, 0x03, 0xea, 0x2a, 0x46, 0xe7, 0x18, 0xb6,
+	0xec, 0xfc, 0x52, 0x29, 0xfb, 0xa4, 0x94, 0xb2, 0xdb, 0x86, 0x30, 0x2b, 0x58, 0x46, 0xb6, 0xfe,
+	0xb6, 0x02, 0xc3, 0xb3, 0xf1, 0x25, 0xf7, 0xe7, 0x21, 0x7f, 0xe1, 0x05, 0x51, 0xc6, 0x23, 0x2f,
+	0x1a, 0x73, 0x9d, 0xb5, 0x22, 0xaa, 0x99, 0x97, 0x14, 0x86, 0xeb, 0xa8, 0x0a, 0x50, 0x1b, 0x81,
+	0x4c, 0x58, 0x75, 0x41, 0x98, 0x33, 0x51, 0xcd, 0x77, 0x24, 0xa8, 0x99, 0x30, 0xcc, 0x63, 0x21,
+	0x39, 0xd4, 0x61, 0xa6, 0x95, 0x68, 0x17, 0x28, 0x2c, 0x9b, 0xa7, 0x23, 0x4c, 0xad, 0x2b, 0x19,
+	0xea, 0xa6, 0x0b, 0x04, 0x9d, 0x20, 0xe2, 0xfc, 0x02, 0xee, 0x2c, 0x55, 0x50, 0x99, 0xfd, 0x3f,
+	0xd5, 0xd0, 0x1b, 0x67, 0xc1, 0x4f, 0xf2, 0x44, 0xa4, 0x95, 0xf3, 0x18, 0x98, 0x0a, 0x1a, 0x2a,
+	0x65, 0xb6, 0x57, 0x59, 0x42, 0xd4, 0x44, 0xa9, 0xc0, 0x5a, 0x02, 0x39, 0x16, 0x80, 0xf3, 0xcb,
+	0x8a, 0xfe, 0xea, 0x64, 0x9e, 0x8d, 0xe3, 0x29, 0x97, 0x1f, 0xb3, 0x6d, 0x58, 0x8f, 0x69, 0x2d,
+	0x3f, 0x69, 0xb9, 0x7a, 0x29, 0xb2, 0x8f, 0xda, 0x1a, 0xa9, 0x46, 0x0b, 0xe1, 0x1d, 0x99, 0x86,
+	0xa3, 0xf3, 0xab, 0x8c, 0xeb, 0x1e, 0x09, 0x12, 0xda, 0x17, 0x88, 0x74, 0x5f, 0xf0, 0x73, 0x4e,
+	0x2d, 0x31, 0x45, 0xf7, 0x55, 0x05, 0x83, 0x80, 0x64, 0x2b, 0x4c, 0x9d, 0x5f, 0xa3, 0x22, 0xa2,
+	0xa8, 0x49, 0x99, 0xa7, 0x5e, 0x10, 0xce, 0x31, 0xe1, 0xcd, 0x0e, 0x50, 0x79, 0x63, 0x07, 0xc0,
+	0x12, 0xf2, 0xe3, 0x9f, 0x46, 0x61, 0xec, 0xf9, 0xa3, 0x0b, 0xf5, 0xb5, 0xd2, 0xb1, 0xaf, 0x09,
+	0x86, 0xd4, 0xde, 0x7c, 0x66, 0xb3, 0x92, 0xca, 0x5d, 0x82, 0x35, 0xa3, 0xf3, 0x87, 0x0a, 0x6c,
+	0x5a, 0x4e, 0x55, 0xd1, 0x7c, 0x1b, 0x3a, 0xd2, 0x1c, 0xea, 0x7e, 0x94, 0xc8, 0x55, 0x57, 0x9a,
+	0xb8, 0x4f, 0x90, 0x68, 0xcd, 0xca, 0x67, 0x42, 0x8f, 0xc5, 0xd6, 0x5c, 0xf6, 0xb9, 0x9b, 0xb3,
+	0xb3, 0xc7, 0x50, 0x17, 0x56, 0x09, 0xa5, 0x16, 0xbf, 0x2b, 0xbb, 0xc8, 0x25, 0x5e, 0xe7, 0x3d,
+	0xe8, 0xef, 0x27, 0xa8, 0xfb, 0x58, 0x16, 0x2f, 0x05, 0x1f, 0xc3, 0x88, 0xf2, 0x52, 0x6f, 0x92,
+	0x87, 0x51, 0x2d, 0x9d, 0x7d, 0x18, 0x18, 0xdc, 0xca, 0xaa, 0x2e, 0xac, 0xe5, 0x3d, 0x1f, 0x7f,
+	0xe5, 0xb9, 0x63, 0x06, 0x5c, 0xe6, 0x8e, 0x8c, 0x99, 0xf3, 0x10, 0x6e, 0xe5, 0x32, 0xce, 0x64,
+	0x21, 0xe8, 0x7d, 0x17, 0x04, 0x39, 0xaf, 0xa1, 0xfb, 0x32, 0xce, 0x82, 0x31, 0x3f, 0xe4, 0x21,
+	0xa6, 0x6a, 0x72, 0x75, 0xf3, 0xb8, 0x0e, 0xa1, 0xa9, 0x8e, 0xd3, 0x54, 0x8d, 0x00, 0xf9, 0x5a,
+	0xe4, 0x22, 0x1d, 0x9d, 0x55, 0x69, 0x1c, 0x2d, 0x9c, 0x7f, 0x55, 0xe0, 0x76, 0x49, 0x2f, 0x65,
+	0xe1, 0x4a, 0x87, 0x88, 0x94, 0x18, 0x27, 0xdc, 0x13, 0xc7, 0x97, 0x5d, 0x7c, 0x5d, 0x05, 0xeb,
+	0xf2, 0x43, 0x11, 0x33, 0x1e, 0xf9, 0x41, 0x34, 0x51, 0x39, 0xa3, 0x97, 0xec, 0x2e, 0xb4, 0x7c,
+	0xb2, 0x8f, 0xfb, 0xea, 0xd4, 0x2f, 0x00, 0x51, 0xb6, 0x22, 0xd9, 0x38, 0x1d, 0x02, 0x38, 0xb2,
+	0xd0, 0x8a, 0x7d, 0x03, 0x9a, 0x79, 0x12, 0x36, 0x4a, 0xcd, 0xd5, 0x76, 0x9b, 0x9b, 0xb3, 0x3a,
+	0xdf, 0x81, 0xde, 0x87, 0x3c, 0xb3, 0x4a, 0xfd, 0xa6, 0x3e, 0x75, 0x7e, 0x53, 0x81, 0x7e, 0xf1,
+	0xb1, 0x72, 0x0d, 0x56, 0x28, 0x9d, 0x1d, 0x14, 0x6d, 0x6a, 0x4f, 0x20, 0xa1, 0x03, 0x5d, 0xe3,
+	0xc4, 0x90, 0x78, 0x59, 0x10, 0x4b, 0xef, 0x54, 0x14, 0x83, 0x2b, 0x10, 0x51, 0x14, 0xf3, 0x59,
+	0x16, 0x4c, 0x75, 0xc2, 0x90, 0x7b, 0xda, 0x84, 0x91, 0x8c, 0x82, 0x85, 0x84, 0xd4, 0xa4, 0x10,
+	0xc5, 0x22, 0xa5, 0x88, 0xf0, 0xb1, 0x03, 0xe9, 0xf2, 0xcf, 0x65, 0xdc, 0xa2, 0x1d, 0x6b, 0x25,
+	0x3b, 0xf0, 0xec, 0x53, 0x87, 0xa4, 0x3a, 0x72, 0x4d, 0x6d, 0x07, 0x92, 0x74, 0x46, 0x94, 0x45,
+	0x9d, 0xcd, 0x79, 0xce, 0x32, 0x0b, 0x47, 0x3f, 0xc5, 0x62, 0xcb, 0xa4, 0x48, 0x33, 0xa2, 0x99,
+	0x42, 0x9d, 0x2f, 0xc2, 0xa6, 0x65, 0x24, 0x05, 0xc1, 0x79, 0x84, 0xb6, 0x0b, 0xba, 0xb0, 0xa9,
+	0x08, 0x4d, 0xde, 0x73, 0x2b, 0x46, 0xcf, 0x75, 0x36, 0x61, 0x60, 0xf2, 0x4a, 0x37, 0x09, 0x10,
+	0x23, 0xab, 0x7a, 0x90, 0x06, 0x9f, 0x01, 0x33, 0xc1, 0x42, 0x2a, 0xcd, 0x11, 0x4a, 0x2a, 0xcd,
+	0x11, 0x77, 0xa1, 0x1a, 0xf8, 0xd4, 0xb1, 0x3a, 0xfb, 0x60, 0xf8, 0x57, 0xc0, 0xce, 0x9e, 0x4c,
+	0x1c, 0x92, 0xa4, 0x23, 0x73, 0xbf, 0x28, 0xf6, 0x52, 0x50, 0x44, 0xf1, 0x7f, 0xdf, 0x50, 0x29,
+	0xdf, 0xfc, 0x9a, 0x8f, 0xd8, 0x5b, 0xba, 0x05, 0x52, 0xeb, 0x84, 0x5d, 0x79, 0xa7, 0x90, 0xdd,
+	0x4f, 0xf5, 0xbb, 0x47, 0xd0, 0x20, 0x99, 0x37, 0xe0, 0xdd, 0x05, 0x20, 0x5e, 0x31, 0x4f, 0x17,
+	0xfc, 0x95, 0x55, 0xfc, 0xcf, 0xa1, 0x77, 0x8a, 0x15, 0x4d, 0xcd, 0xf6, 0x46, 0x56, 0x8a, 0xb6,
+	0xe0, 0xf9, 0x3e, 0x56, 0x26, 0xf5, 0x0d, 0xec, 0x2c, 0x6a, 0xe9, 0x38, 0xd0, 0x2f, 0x84, 0x15,
+	0x9d, 0x36, 0x7e, 0x2d, 0xa5, 0x35, 0x5d, 0xfc, 0xe5, 0x7c, 0x17, 0x06, 0xc7, 0x71, 0xfc, 0x7a,
+	0x3e, 0x33, 0xb7, 0x2c, 0xba, 0x68, 0xeb, 0x9a, 0x2d, 0x3e, 0x02, 0x66, 0x7e, 0x9e, 0xfb, 0xb8,
+	0x26, 0xcc, 0x91, 0x12, 0x6c, 0x33, 0x25, 0xce, 0xde, 0x81, 0xda, 0x94, 0x67, 0x9e, 0x14, 0xd6,
+	0xde, 0x63, 0x05, 0xfd, 0x05, 0xa2, 0xbe, 0x97, 0x79, 0xae, 0xa4, 0xe3, 0x1d, 0xae, 0xf7, 0x14,
+	0x67, 0xe5, 0x97, 0xdc, 0x4b, 0x6e, 0xea, 0x8d, 0xaf, 0x40, 0x5d, 0x0e, 0x36, 0x2b, 0xc6, 0x76,
+	0x22, 0x16, 0x77, 0x3b, 0xaa, 0x3d, 0x5a, 0x38, 0x4f, 0xa0, 0x5f, 0x6c, 0xa7, 0x4c, 0xb9, 0x3e,
+	0xc4, 0x4f, 0xa0, 0xf7, 0x2a, 0x9e, 0xc5, 0x61, 0x3c, 0xb9, 0xd2, 0x4a, 0x62, 0xe1, 0x86, 0xd2,
+	0x2b, 0xd6, 0xf0, 0xd3, 0x26, 0x8c, 0xc6, 0x9f, 0x7f, 0x54, 0x60, 0xfd, 0xb9, 0x4a, 0xa3, 0xeb,
+	0x6c, 0x42, 0x6d, 0x7d, 0x3e, 0x53, 0xb7, 0x02, 0x9c, 0xbb, 0xe5, 0x02, 0xc7, 0xd9, 0x5b, 0x6a,
+	0x92, 0xbe, 0x10, 0x03, 0xba, 0x71, 0x7c, 0x90, 0x51, 0x5b, 0x34, 0x4c, 0x2b, 0xa2, 0x3e, 0x44,
+	0x72, 0x7b, 0x6a, 0x2b, 0xec, 0x61, 0xdf, 0x84, 0x01, 0x0e, 0xe7, 0x7a, 0xaa, 0x1e, 0x8d, 0x3d,
+	0x9c, 0x2d, 0xb1, 0x9f, 0x2c, 0x72, 0xf7, 0x0d, 0xa6, 0x03, 0xc1, 0xe3, 0xfc, 0xa5, 0x02, 0x6d,
+	0x4a, 0x06, 0x1a, 0xe5, 0x96, 0x36, 0x0f, 0x81, 0x5e, 0xe0, 0x0f, 0x5f, 0x8f, 0x71, 0x72, 0x21,
+	0x2e, 0x63, 0x51, 0x9c, 0x8d, 0x88, 0x42, 0xfa, 0x37, 0x11, 0x78, 0x2a, 0x89, 0xc5, 0x01, 0x56,
+	0xb3, 0x0e, 0x30, 0x1c, 0x5a, 0xd1, 0xdf, 0x49, 0x80, 0xa6, 0x93, 0x4d, 0xd4, 0xf5, 0x3a, 0x0a,
+	0x94, 0xed, 0x89, 0x3d, 0x82, 0x01, 0x0d, 0x88, 0xfe, 0x5c, 0x76, 0xfe, 0x68, 0x34, 0x4d, 0xe5,
+	0x0d, 0xa7, 0xea, 0xf6, 0x24, 0xe1, 0x50, 0xe1, 0x2f, 0x52, 0xe7, 0xef, 0x15, 0x68, 0x90, 0x05,
+	0x98, 0xa2, 0x0d, 0xcc, 0x94, 0x09, 0xcf, 0x56, 0x35, 0x7d, 0xa2, 0x8a, 0xd3, 0x5b, 0xa6, 0x54,
+	0xf9, 0xf4, 0x56, 0xb0, 0x76, 0x3c, 0x9e, 0x0e, 0xa6, 0x06, 0x6a, 0x50, 0xf5, 0xf3, 0xcd, 0xcb,
+	0xd6, 0xd0, 0xa5, 0xce, 0xb6, 0x26, 0xf7, 0x5e, 0x5d, 0x56, 0xb0, 0xf2, 0x5e, 0x3e, 0x8e, 0x34,
+	0xcc, 0x71, 0xe4, 0xf7, 0x6b, 0xd0, 0x2f, 0x32, 0xb3, 0x28, 0xcd, 0x94, 0x87, 0x17, 0xcb, 0x4a,
+	0x53, 0xe0, 0x42, 0x4d, 0x1a, 0x2d, 0x47, 0x62, 0xa4, 0x54, 0x19, 0x07, 0x04, 0x9d, 0x21, 0x82,
+	0xd7, 0xbd, 0x75, 0x3d, 0x7b, 0xd2, 0x90, 0xc8, 0x8c, 0xa1, 0x41, 0x65, 0xb4, 0xab, 0x59, 0xf0,
+	0xc8, 0xeb, 0x44, 0x3c, 0x98, 0x5c, 0x9e, 0xc7, 0xc9, 0x65, 0x1c, 0xfb, 0x4b, 0xb2, 0xce, 0xa2,
+	0xe3, 0xec, 0xaa, 0x2b, 0x47, 0x5c, 0x70, 0x28, 0xa2, 0xed, 0xbd, 0x5b, 0xc6, 0x16, 0x46, 0x86,
+	0xe9, 0x8a, 0xa2, 0x74, 0xfb, 0x16, 0x74, 0x13, 0xbc, 0xff, 0x61, 0xca, 0x12, 0xaa, 0x87, 0x9a,
+	0x41, 0xe9, 0x63, 0x77, 0x83, 0x18, 0x69, 0x95, 0x3a, 0x57, 0xb0, 0x29, 0xef, 0xa8, 0xcf, 0xb0,
+	0x47, 0xc7, 0xc9, 0xd5, 0x67, 0x3e, 0xf8, 0xc5, 0x0d, 0x2b, 0xc0, 0x2b, 0xd7, 0xe2, 0xe5, 0x49,
+	0x82, 0x3a, 0xfe, 0x56, 0xcb, 0xd1, 0xcf, 0x49, 0xce, 0xa7, 0x58, 0x33, 0x72, 0x6f, 0x17, 0xd9,
+	0x12, 0x5f, 0x8e, 0xf7, 0xea, 0x91, 0xe0, 0xd2, 0x4b, 0xf5, 0xbb, 0x51, 0x5b, 0x61, 0xcf, 0x10,
+	0x92, 0x2c, 0x59, 0x12, 0xcc, 0xb8, 0x7a, 0x46, 0xa0, 0xcd, 0xda, 0x84, 0xd1, 0x2b, 0x82, 0xf5,
+	0xb4, 0x51, 0x5d, 0x78, 0xda, 0x30, 0x6e, 0x58, 0xb5, 0xd2, 0x0d, 0xcb, 0x7c, 0x10, 0xa2, 0xc5,
+	0x62, 0xe2, 0x36, 0x4a, 0x89, 0x8b, 0x25, 0x20, 0x67, 0x17, 0xa3, 0x04, 0xd6, 0xa9, 0x04, 0x14,
+	0xac, 0x5c, 0x80, 0xd3, 0xc0, 0x96, 0xed, 0x67, 0x95, 0x93, 0xef, 0xc3, 0x7a, 0x22, 0xcd, 0xd7,
+	0x07, 0xa3, 0x19, 0x6f, 0xc3, 0x3b, 0xae, 0x66, 0x73, 0x18, 0xf4, 0x0f, 0x03, 0x2f, 0x34, 0xe7,
+	0x34, 0xe7, 0x4f, 0x15, 0xe8, 0x0a, 0xf0, 0xc3, 0x24, 0x36, 0x3a, 0xd0, 0x44, 0xac, 0xd4, 0x51,
+	0x46, 0x0b, 0x31, 0x2d, 0xab, 0x61, 0x29, 0xbf, 0xa8, 0x15, 0x80, 0x18, 0xfb, 0x17, 0xae, 0x66,
+	0xf9, 0x9a, 0x3d, 0x84, 0x3e, 0xf5, 0x92, 0x10, 0xe7, 0xa7, 0x68, 0x7c, 0x25, 0xfc, 0x41, 0x2d,
+	0xa9, 0x2b, 0xf1, 0x63, 0x82, 0xd1, 0x27, 0x0f, 0xa0, 0xab, 0x79, 0xd4, 0xc5, 0xb3, 0x2e, 0x2f,
+	0x6a, 0x1b, 0x0a, 0x55, 0x77, 0xcf, 0x3f, 0x56, 0x60, 0x60, 0x18, 0xa2, 0xfc, 0x21, 0x5f, 0x54,
+	0xe8, 0x63, 0x55, 0x47, 0x62, 0x23, 0xba, 0xe9, 0xf5, 0x15, 0x45, 0xcd, 0x54, 0x2f, 0xc4, 0x9d,
+	0x0d, 0xdd, 0x32, 0xc1, 0x50, 0xe8, 0x33, 0xca, 0x9c, 0xe2, 0x6d, 0x87, 0xb8, 0x9a, 0x53, 0x7c,
+	0x94, 0xce, 0xcf, 0xa3, 0xa2, 0x8a, 0xdf, 0xf4, 0x91, 0xe2, 0xdc, 0xfb, 0xf7, 0x1a, 0x74, 0x9e,
+	0x7b, 0xfe, 0x91, 0x66, 0x64, 0x47, 0x00, 0xc5, 0x20, 0xc8, 0xee, 0x1a, 0x22, 0x4a, 0xf3, 0xe1,
+	0xf0, 0xde, 0x0a, 0xaa, 0xb2, 0xf9, 0x00, 0x9a, 0x7a, 0x56, 0x61, 0x43, 0xeb, 0x8d, 0xc7, 0x9a,
+	0x86, 0x86, 0x77, 0x96, 0xd2, 0x94, 0x10, 0xd4, 0xa7, 0x98, 0x46, 0x2c, 0x7d, 0x4a, 0x33, 0x8e,
+	0xa5, 0xcf, 0x92, 0x11, 0x06, 0xf5, 0xd1, 0xb3, 0x80, 0xa5, 0xcf, 0xc2, 0x3c, 0x62, 0xe9, 0x53,
+	0x1a, 0x1e, 0x50, 0x88, 0x6e, 0xc0, 0x96, 0x90, 0x85, 0x79, 0xc1, 0x12, 0xb2, 0xd8, 0xb1, 0xf7,
+	0x7e, 0x04, 0xfd, 0x13, 0xbc, 0x82, 0x85, 0xde, 0xd5, 0xff, 0xc3, 0xf1, 0x7b, 0xbf, 0xab, 0x40,
+	0x4f, 0xc4, 0xf9, 0x70, 0xbf, 0x10, 0x8f, 0x7a, 0xeb, 0x5b, 0x9a, 0xa5, 0xf7, 0xc2, 0xbd, 0xcf,
+	0xd2, 0xbb, 0x74, 0xad, 0x3b, 0x86, 0xb6, 0x71, 0xd1, 0x60, 0x96, 0x1a, 0xa5, 0x5b, 0xd6, 0xf0,
+	0xfe, 0x2a, 0xb2, 0x52, 0xf3, 0x57, 0x15, 0xd8, 0x32, 0x5e, 0x95, 0x0b, 0x5d, 0x67, 0x70, 0x7b,
+	0xc5, 0x5b, 0x35, 0x7b, 0xd7, 0x0c, 0xf1, 0x1b, 0xff, 0x0b, 0x18, 0x3e, 0xba, 0x09, 0xab, 0x52,
+	0x05, 0xa7, 0x04, 0x76, 0x1a, 0x8b, 0x87, 0xb6, 0xc4, 0x74, 0xda, 0x09, 0x74, 0xcc, 0x67, 0x47,
+	0x66, 0x5a, 0xb4, 0xe4, 0xbd, 0x7b, 0xb8, 0xb3, 0x92, 0xae, 0x1c, 0xe8, 0xc3, 0xe6, 0x92, 0x77,
+	0x3d, 0xf6, 0xc0, 0x7c, 0xb4, 0x5c, 0xf9, 0x30, 0x39, 0x7c, 0xe7, 0x3a, 0x36, 0x65, 0xcd, 0x9f,
+	0xd1, 0x9a, 0xfc, 0xd1, 0xa2, 0xb0, 0xe6, 0x29, 0xb4, 0x72, 0x94, 0x99, 0x71, 0x5e, 0x7c, 0xea,
+	0x19, 0xde, 0x5d, 0x4e, 0x54, 0x46, 0xfc, 0x00, 0x7a, 0x0b, 0x4f, 0x22, 0xec, 0xed, 0x65, 0x1f,
+	0x58, 0xcf, 0x38, 0x43, 0xe7, 0x4d, 0x2c, 0x4a, 0xf1, 0x11, 0xf4, 0xe8, 0x3d, 0xaa, 0x50, 0x1a,
+	0x53, 0xce, 0x78, 0x33, 0x63, 0xe5, 0x67, 0xaf, 0x95, 0x29, 0xb7, 0xe4, 0xa9, 0x6d, 0xcf, 0x83,
+	0xae, 0x3c, 0x7c, 0xac, 0x10, 0x9b, 0x07, 0x98, 0x15, 0xe2, 0x25, 0x13, 0x84, 0x15, 0xe2, 0x65,
+	0x27, 0xdf, 0x1e, 0x5e, 0x9f, 0x5e, 0x25, 0x1e, 0xf2, 0xc4, 0x89, 0xed, 0xfb, 0xfc, 0x50, 0xb0,
+	0x7c, 0xbf, 0x78, 0xe6, 0x59, 0xbe, 0x2f, 0x9d, 0x23, 0xfb, 0xb5, 0x1f, 0xae, 0xcd, 0xce, 0xcf,
+	0x1b, 0xf2, 0xff, 0xb0, 0xc7, 0xff, 0x05, 0xd2, 0x9d, 0xf1, 0x88, 0x45, 0x1b, 0x00, 0x00,
 }
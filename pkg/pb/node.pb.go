@@ -49,7 +49,7 @@ func (x NodeType) String() string {
 	return proto.EnumName(NodeType_name, int32(x))
 }
 func (NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{0}
+	return fileDescriptor_node_df9d2b8965c3db78, []int{0}
 }
 
 // NodeTransport is an enum of possible transports for the overlay network
@@ -57,23 +57,28 @@ type NodeTransport int32
 
 const (
 	NodeTransport_TCP_TLS_GRPC NodeTransport = 0
+	// RELAY_TCP_TLS_GRPC nodes can't accept inbound connections, they are
+	// dialed through the relay at the address
+	NodeTransport_RELAY_TCP_TLS_GRPC NodeTransport = 1
 )
 
 var NodeTransport_name = map[int32]string{
 	0: "TCP_TLS_GRPC",
+	1: "RELAY_TCP_TLS_GRPC",
 }
 var NodeTransport_value = map[string]int32{
-	"TCP_TLS_GRPC": 0,
+	"TCP_TLS_GRPC":       0,
+	"RELAY_TCP_TLS_GRPC": 1,
 }
 
 func (x NodeTransport) String() string {
 	return proto.EnumName(NodeTransport_name, int32(x))
 }
 func (NodeTransport) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{1}
+	return fileDescriptor_node_df9d2b8965c3db78, []int{1}
 }
 
-//  NodeRestrictions contains all relevant data about a nodes ability to store data
+// NodeRestrictions contains all relevant data about a nodes ability to store data
 type NodeRestrictions struct {
 	FreeBandwidth        int64    `protobuf:"varint,1,opt,name=free_bandwidth,json=freeBandwidth,proto3" json:"free_bandwidth,omitempty"`
 	FreeDisk             int64    `protobuf:"varint,2,opt,name=free_disk,json=freeDisk,proto3" json:"free_disk,omitempty"`
@@ -86,7 +91,7 @@ func (m *NodeRestrictions) Reset()         { *m = NodeRestrictions{} }
 func (m *NodeRestrictions) String() string { return proto.CompactTextString(m) }
 func (*NodeRestrictions) ProtoMessage()    {}
 func (*NodeRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{0}
+	return fileDescriptor_node_df9d2b8965c3db78, []int{0}
 }
 func (m *NodeRestrictions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeRestrictions.Unmarshal(m, b)
@@ -136,6 +141,7 @@ type Node struct {
 	UpdateLatency        bool              `protobuf:"varint,10,opt,name=update_latency,json=updateLatency,proto3" json:"update_latency,omitempty"`
 	UpdateAuditSuccess   bool              `protobuf:"varint,11,opt,name=update_audit_success,json=updateAuditSuccess,proto3" json:"update_audit_success,omitempty"`
 	UpdateUptime         bool              `protobuf:"varint,12,opt,name=update_uptime,json=updateUptime,proto3" json:"update_uptime,omitempty"`
+	LastNet              string            `protobuf:"bytes,13,opt,name=last_net,json=lastNet,proto3" json:"last_net,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -145,7 +151,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{1}
+	return fileDescriptor_node_df9d2b8965c3db78, []int{1}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Node.Unmarshal(m, b)
@@ -242,6 +248,13 @@ func (m *Node) GetUpdateUptime() bool {
 	return false
 }
 
+func (m *Node) GetLastNet() string {
+	if m != nil {
+		return m.LastNet
+	}
+	return ""
+}
+
 // NodeAddress contains the information needed to communicate with a node on the network
 type NodeAddress struct {
 	Transport            NodeTransport `protobuf:"varint,1,opt,name=transport,proto3,enum=node.NodeTransport" json:"transport,omitempty"`
@@ -255,7 +268,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{2}
+	return fileDescriptor_node_df9d2b8965c3db78, []int{2}
 }
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
@@ -291,24 +304,26 @@ func (m *NodeAddress) GetAddress() string {
 
 // NodeStats is the reputation characteristics of a node
 type NodeStats struct {
-	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
-	Latency_90           int64    `protobuf:"varint,2,opt,name=latency_90,json=latency90,proto3" json:"latency_90,omitempty"`
-	AuditSuccessRatio    float64  `protobuf:"fixed64,3,opt,name=audit_success_ratio,json=auditSuccessRatio,proto3" json:"audit_success_ratio,omitempty"`
-	UptimeRatio          float64  `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
-	AuditCount           int64    `protobuf:"varint,5,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
-	AuditSuccessCount    int64    `protobuf:"varint,6,opt,name=audit_success_count,json=auditSuccessCount,proto3" json:"audit_success_count,omitempty"`
-	UptimeCount          int64    `protobuf:"varint,7,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
-	UptimeSuccessCount   int64    `protobuf:"varint,8,opt,name=uptime_success_count,json=uptimeSuccessCount,proto3" json:"uptime_success_count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	NodeId                NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Latency_90            int64    `protobuf:"varint,2,opt,name=latency_90,json=latency90,proto3" json:"latency_90,omitempty"`
+	AuditSuccessRatio     float64  `protobuf:"fixed64,3,opt,name=audit_success_ratio,json=auditSuccessRatio,proto3" json:"audit_success_ratio,omitempty"`
+	UptimeRatio           float64  `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+	AuditCount            int64    `protobuf:"varint,5,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
+	AuditSuccessCount     int64    `protobuf:"varint,6,opt,name=audit_success_count,json=auditSuccessCount,proto3" json:"audit_success_count,omitempty"`
+	UptimeCount           int64    `protobuf:"varint,7,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
+	UptimeSuccessCount    int64    `protobuf:"varint,8,opt,name=uptime_success_count,json=uptimeSuccessCount,proto3" json:"uptime_success_count,omitempty"`
+	AuditReputationScore  float64  `protobuf:"fixed64,9,opt,name=audit_reputation_score,json=auditReputationScore,proto3" json:"audit_reputation_score,omitempty"`
+	UptimeReputationScore float64  `protobuf:"fixed64,10,opt,name=uptime_reputation_score,json=uptimeReputationScore,proto3" json:"uptime_reputation_score,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *NodeStats) Reset()         { *m = NodeStats{} }
 func (m *NodeStats) String() string { return proto.CompactTextString(m) }
 func (*NodeStats) ProtoMessage()    {}
 func (*NodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{3}
+	return fileDescriptor_node_df9d2b8965c3db78, []int{3}
 }
 func (m *NodeStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeStats.Unmarshal(m, b)
@@ -377,9 +392,25 @@ func (m *NodeStats) GetUptimeSuccessCount() int64 {
 	return 0
 }
 
+func (m *NodeStats) GetAuditReputationScore() float64 {
+	if m != nil {
+		return m.AuditReputationScore
+	}
+	return 0
+}
+
+func (m *NodeStats) GetUptimeReputationScore() float64 {
+	if m != nil {
+		return m.UptimeReputationScore
+	}
+	return 0
+}
+
 type NodeMetadata struct {
 	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Wallet               string   `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Region               string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Version              string   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -389,7 +420,7 @@ func (m *NodeMetadata) Reset()         { *m = NodeMetadata{} }
 func (m *NodeMetadata) String() string { return proto.CompactTextString(m) }
 func (*NodeMetadata) ProtoMessage()    {}
 func (*NodeMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{4}
+	return fileDescriptor_node_df9d2b8965c3db78, []int{4}
 }
 func (m *NodeMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeMetadata.Unmarshal(m, b)
@@ -423,6 +454,20 @@ func (m *NodeMetadata) GetWallet() string {
 	return ""
 }
 
+func (m *NodeMetadata) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *NodeMetadata) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*NodeRestrictions)(nil), "node.NodeRestrictions")
 	proto.RegisterType((*Node)(nil), "node.Node")
@@ -433,49 +478,55 @@ func init() {
 	proto.RegisterEnum("node.NodeTransport", NodeTransport_name, NodeTransport_value)
 }
 
-func init() { proto.RegisterFile("node.proto", fileDescriptor_node_d0632cceb21d65c0) }
-
-var fileDescriptor_node_d0632cceb21d65c0 = []byte{
-	// 652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xc1, 0x4e, 0xdb, 0x40,
-	0x10, 0x86, 0x49, 0x6c, 0x9c, 0x78, 0xec, 0xa4, 0x66, 0x40, 0xc8, 0x6a, 0xd5, 0x12, 0x82, 0xaa,
-	0x46, 0x54, 0x4a, 0x29, 0x3d, 0x51, 0xf5, 0x92, 0x00, 0x42, 0x51, 0xdd, 0x10, 0x6d, 0x0c, 0x07,
-	0x2e, 0x96, 0x89, 0xb7, 0x74, 0x45, 0x88, 0x2d, 0x7b, 0x2d, 0xc4, 0x1b, 0xf6, 0xd0, 0x27, 0xe8,
-	0x81, 0x57, 0xe8, 0x2b, 0x54, 0xbb, 0xeb, 0x24, 0xb6, 0xaa, 0xde, 0xb2, 0xff, 0xff, 0x79, 0xc6,
-	0x3b, 0xff, 0xc4, 0x00, 0x8b, 0x38, 0xa2, 0xfd, 0x24, 0x8d, 0x79, 0x8c, 0xba, 0xf8, 0xfd, 0x12,
-	0xee, 0xe2, 0xbb, 0x58, 0x29, 0xdd, 0x6b, 0x70, 0xc6, 0x71, 0x44, 0x09, 0xcd, 0x78, 0xca, 0x66,
-	0x9c, 0xc5, 0x8b, 0x0c, 0xdf, 0x42, 0xfb, 0x7b, 0x4a, 0x69, 0x70, 0x1b, 0x2e, 0xa2, 0x47, 0x16,
-	0xf1, 0x1f, 0x6e, 0xad, 0x53, 0xeb, 0x69, 0xa4, 0x25, 0xd4, 0xe1, 0x52, 0xc4, 0x57, 0x60, 0x4a,
-	0x2c, 0x62, 0xd9, 0xbd, 0x5b, 0x97, 0x44, 0x53, 0x08, 0x67, 0x2c, 0xbb, 0xef, 0xfe, 0xd1, 0x40,
-	0x17, 0x85, 0xf1, 0x0d, 0xd4, 0x59, 0x24, 0x0b, 0xd8, 0xc3, 0xf6, 0xcf, 0xe7, 0xbd, 0x8d, 0xdf,
-	0xcf, 0x7b, 0x86, 0x70, 0x46, 0x67, 0xa4, 0xce, 0x22, 0x7c, 0x0f, 0x8d, 0x30, 0x8a, 0x52, 0x9a,
-	0x65, 0xb2, 0x86, 0x75, 0xbc, 0xd5, 0x97, 0x2f, 0x2c, 0x90, 0x81, 0x32, 0xc8, 0x92, 0xc0, 0x2e,
-	0xe8, 0xfc, 0x29, 0xa1, 0xae, 0xd6, 0xa9, 0xf5, 0xda, 0xc7, 0xed, 0x35, 0xe9, 0x3f, 0x25, 0x94,
-	0x48, 0x0f, 0x3f, 0x83, 0x9d, 0x96, 0x6e, 0xe3, 0xea, 0xb2, 0xea, 0xee, 0x9a, 0x2d, 0xdf, 0x95,
-	0x54, 0x58, 0xfc, 0x00, 0x90, 0xd2, 0x24, 0xe7, 0xa1, 0x38, 0xba, 0x9b, 0xf2, 0xc9, 0x17, 0xeb,
-	0x27, 0xa7, 0x3c, 0xe4, 0x19, 0x29, 0x21, 0xd8, 0x87, 0xe6, 0x03, 0xe5, 0x61, 0x14, 0xf2, 0xd0,
-	0x35, 0x24, 0x8e, 0x6b, 0xfc, 0x5b, 0xe1, 0x90, 0x15, 0x83, 0xfb, 0x60, 0xcf, 0x43, 0x4e, 0x17,
-	0xb3, 0xa7, 0x60, 0xce, 0x32, 0xee, 0x36, 0x3a, 0x5a, 0x4f, 0x23, 0x56, 0xa1, 0x79, 0x2c, 0xe3,
-	0x78, 0x00, 0xad, 0x30, 0x8f, 0x18, 0x0f, 0xb2, 0x7c, 0x36, 0x13, 0x63, 0x69, 0x76, 0x6a, 0xbd,
-	0x26, 0xb1, 0xa5, 0x38, 0x55, 0x1a, 0x6e, 0xc3, 0x26, 0xcb, 0x82, 0x3c, 0x71, 0x4d, 0x69, 0xea,
-	0x2c, 0xbb, 0x4a, 0x44, 0x6e, 0x79, 0x12, 0x85, 0x9c, 0x06, 0x45, 0x3d, 0x17, 0xa4, 0xdb, 0x52,
-	0xaa, 0xa7, 0x44, 0x3c, 0x82, 0x9d, 0x02, 0xab, 0xf6, 0xb1, 0x24, 0x8c, 0xca, 0x1b, 0x94, 0xbb,
-	0x1d, 0x40, 0x51, 0x22, 0xc8, 0x13, 0xce, 0x1e, 0xa8, 0x6b, 0xab, 0x57, 0x52, 0xe2, 0x95, 0xd4,
-	0xba, 0x37, 0x60, 0x95, 0x32, 0xc3, 0x8f, 0x60, 0xf2, 0x34, 0x5c, 0x64, 0x49, 0x9c, 0x72, 0x19,
-	0x7f, 0xfb, 0x78, 0xbb, 0x94, 0xd7, 0xd2, 0x22, 0x6b, 0x0a, 0xdd, 0xea, 0x2a, 0x98, 0xab, 0xdc,
-	0xbb, 0xbf, 0xea, 0x60, 0xae, 0x02, 0xc0, 0x77, 0xd0, 0x10, 0x85, 0x82, 0xff, 0xee, 0x95, 0x21,
-	0xec, 0x51, 0x84, 0xaf, 0x01, 0x96, 0xd3, 0x3e, 0x39, 0x2a, 0x56, 0xd4, 0x2c, 0x94, 0x93, 0x23,
-	0xec, 0xc3, 0x76, 0x65, 0x02, 0x41, 0x2a, 0x42, 0x95, 0xcb, 0x55, 0x23, 0x5b, 0xe5, 0x79, 0x13,
-	0x61, 0x88, 0xf0, 0xd4, 0xfd, 0x0b, 0x50, 0x97, 0xa0, 0xa5, 0x34, 0x85, 0xec, 0x81, 0xa5, 0x4a,
-	0xce, 0xe2, 0x7c, 0xc1, 0xe5, 0x06, 0x69, 0x04, 0xa4, 0x74, 0x2a, 0x94, 0x7f, 0x7b, 0x2a, 0xd0,
-	0x90, 0x60, 0xa5, 0xa7, 0xe2, 0xd7, 0x3d, 0x15, 0xd8, 0x90, 0x60, 0xd1, 0x53, 0x21, 0x32, 0x4f,
-	0x89, 0x54, 0x6b, 0x36, 0x25, 0x8a, 0xca, 0x2b, 0x17, 0xed, 0x7e, 0x01, 0xbb, 0xbc, 0x9f, 0xb8,
-	0x03, 0x9b, 0xf4, 0x21, 0x64, 0x73, 0x39, 0x4e, 0x93, 0xa8, 0x03, 0xee, 0x82, 0xf1, 0x18, 0xce,
-	0xe7, 0x94, 0x17, 0x69, 0x14, 0xa7, 0xc3, 0x31, 0x34, 0x97, 0x7f, 0x39, 0xb4, 0xa0, 0x31, 0x1a,
-	0x5f, 0x0f, 0xbc, 0xd1, 0x99, 0xb3, 0x81, 0x2d, 0x30, 0xa7, 0x03, 0xff, 0xdc, 0xf3, 0x46, 0xfe,
-	0xb9, 0x53, 0x13, 0xde, 0xd4, 0xbf, 0x24, 0x83, 0x8b, 0x73, 0xa7, 0x8e, 0x00, 0xc6, 0xd5, 0xc4,
-	0x1b, 0x8d, 0xbf, 0x3a, 0x9a, 0xe0, 0x86, 0x97, 0x97, 0xfe, 0xd4, 0x27, 0x83, 0x89, 0xa3, 0x1f,
-	0xee, 0x43, 0xab, 0xb2, 0x12, 0xe8, 0x80, 0xed, 0x9f, 0x4e, 0x02, 0xdf, 0x9b, 0x06, 0x17, 0x64,
-	0x72, 0xea, 0x6c, 0x0c, 0xf5, 0x9b, 0x7a, 0x72, 0x7b, 0x6b, 0xc8, 0x4f, 0xd6, 0xa7, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xc0, 0x8a, 0x68, 0xa5, 0xd2, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("node.proto", fileDescriptor_node_df9d2b8965c3db78) }
+
+var fileDescriptor_node_df9d2b8965c3db78 = []byte{
+	// 740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xdf, 0x6e, 0xd3, 0x4a,
+	0x10, 0xc6, 0xeb, 0xd8, 0x4d, 0xe2, 0xc9, 0x9f, 0xe3, 0x4e, 0x7b, 0x72, 0x7c, 0x40, 0xd0, 0x90,
+	0x0a, 0x11, 0x15, 0x29, 0x94, 0x82, 0x90, 0xca, 0x5d, 0xd2, 0x46, 0x55, 0x84, 0x49, 0xa3, 0x8d,
+	0x5b, 0x89, 0xde, 0x58, 0x6e, 0xbc, 0x14, 0xab, 0xa9, 0x6d, 0x79, 0x37, 0x54, 0x7d, 0x05, 0x1e,
+	0x89, 0x27, 0xe0, 0x19, 0xb8, 0xe8, 0xb3, 0xa0, 0xdd, 0x75, 0x12, 0x1b, 0xc4, 0x5d, 0xe6, 0xfb,
+	0x7e, 0x3b, 0x63, 0xef, 0x7c, 0x31, 0x40, 0x14, 0x07, 0xb4, 0x97, 0xa4, 0x31, 0x8f, 0xd1, 0x10,
+	0xbf, 0x1f, 0xc1, 0x75, 0x7c, 0x1d, 0x2b, 0xa5, 0x73, 0x01, 0xd6, 0x38, 0x0e, 0x28, 0xa1, 0x8c,
+	0xa7, 0xe1, 0x8c, 0x87, 0x71, 0xc4, 0xf0, 0x39, 0x34, 0x3f, 0xa7, 0x94, 0x7a, 0x57, 0x7e, 0x14,
+	0xdc, 0x85, 0x01, 0xff, 0x62, 0x6b, 0x6d, 0xad, 0xab, 0x93, 0x86, 0x50, 0x07, 0x4b, 0x11, 0x1f,
+	0x83, 0x29, 0xb1, 0x20, 0x64, 0x37, 0x76, 0x49, 0x12, 0x55, 0x21, 0x9c, 0x84, 0xec, 0xa6, 0xf3,
+	0xcd, 0x00, 0x43, 0x34, 0xc6, 0xa7, 0x50, 0x0a, 0x03, 0xd9, 0xa0, 0x3e, 0x68, 0xfe, 0x78, 0xd8,
+	0xdd, 0xf8, 0xf9, 0xb0, 0x5b, 0x16, 0xce, 0xe8, 0x84, 0x94, 0xc2, 0x00, 0x5f, 0x42, 0xc5, 0x0f,
+	0x82, 0x94, 0x32, 0x26, 0x7b, 0xd4, 0x0e, 0xb7, 0x7a, 0xf2, 0x81, 0x05, 0xd2, 0x57, 0x06, 0x59,
+	0x12, 0xd8, 0x01, 0x83, 0xdf, 0x27, 0xd4, 0xd6, 0xdb, 0x5a, 0xb7, 0x79, 0xd8, 0x5c, 0x93, 0xee,
+	0x7d, 0x42, 0x89, 0xf4, 0xf0, 0x3d, 0xd4, 0xd3, 0xdc, 0xdb, 0xd8, 0x86, 0xec, 0xda, 0x5a, 0xb3,
+	0xf9, 0x77, 0x25, 0x05, 0x16, 0x5f, 0x01, 0xa4, 0x34, 0x59, 0x70, 0x5f, 0x94, 0xf6, 0xa6, 0x3c,
+	0xf9, 0xcf, 0xfa, 0xe4, 0x94, 0xfb, 0x9c, 0x91, 0x1c, 0x82, 0x3d, 0xa8, 0xde, 0x52, 0xee, 0x07,
+	0x3e, 0xf7, 0xed, 0xb2, 0xc4, 0x71, 0x8d, 0x7f, 0xcc, 0x1c, 0xb2, 0x62, 0xf0, 0x19, 0xd4, 0xe7,
+	0x3e, 0xa7, 0xd1, 0xec, 0xde, 0x9b, 0x87, 0x8c, 0xdb, 0x95, 0xb6, 0xde, 0xd5, 0x49, 0x2d, 0xd3,
+	0x9c, 0x90, 0x71, 0xdc, 0x83, 0x86, 0xbf, 0x08, 0x42, 0xee, 0xb1, 0xc5, 0x6c, 0x26, 0xae, 0xa5,
+	0xda, 0xd6, 0xba, 0x55, 0x52, 0x97, 0xe2, 0x54, 0x69, 0xb8, 0x0d, 0x9b, 0x21, 0xf3, 0x16, 0x89,
+	0x6d, 0x4a, 0xd3, 0x08, 0xd9, 0x79, 0x22, 0xf6, 0xb6, 0x48, 0x02, 0x9f, 0x53, 0x2f, 0xeb, 0x67,
+	0x83, 0x74, 0x1b, 0x4a, 0x75, 0x94, 0x88, 0x07, 0xb0, 0x93, 0x61, 0xc5, 0x39, 0x35, 0x09, 0xa3,
+	0xf2, 0xfa, 0xf9, 0x69, 0x7b, 0x90, 0xb5, 0xf0, 0x16, 0x09, 0x0f, 0x6f, 0xa9, 0x5d, 0x57, 0x8f,
+	0xa4, 0xc4, 0x73, 0xa9, 0xe1, 0xff, 0x50, 0x9d, 0xfb, 0x8c, 0x7b, 0x11, 0xe5, 0x76, 0xa3, 0xad,
+	0x75, 0x4d, 0x52, 0x11, 0xf5, 0x98, 0xf2, 0xce, 0x25, 0xd4, 0x72, 0xeb, 0xc4, 0xd7, 0x60, 0xf2,
+	0xd4, 0x8f, 0x58, 0x12, 0xa7, 0x5c, 0x26, 0xa3, 0x79, 0xb8, 0x9d, 0x5b, 0xe5, 0xd2, 0x22, 0x6b,
+	0x0a, 0xed, 0x62, 0x4a, 0xcc, 0x55, 0x24, 0x3a, 0xdf, 0x75, 0x30, 0x57, 0xbb, 0xc1, 0x17, 0x50,
+	0x11, 0x8d, 0xbc, 0xbf, 0x46, 0xae, 0x2c, 0xec, 0x51, 0x80, 0x4f, 0x00, 0x96, 0x8b, 0x38, 0x3a,
+	0xc8, 0xd2, 0x6b, 0x66, 0xca, 0xd1, 0x01, 0xf6, 0x60, 0xbb, 0x70, 0x39, 0x5e, 0x2a, 0xf6, 0x2d,
+	0x73, 0xa7, 0x91, 0xad, 0xfc, 0x2a, 0x88, 0x30, 0xc4, 0x5e, 0xd5, 0xd5, 0x64, 0xa0, 0x21, 0xc1,
+	0x9a, 0xd2, 0x14, 0xb2, 0x0b, 0x35, 0xd5, 0x72, 0x16, 0x2f, 0x22, 0x2e, 0xc3, 0xa5, 0x13, 0x90,
+	0xd2, 0xb1, 0x50, 0xfe, 0x9c, 0xa9, 0xc0, 0xb2, 0x04, 0x0b, 0x33, 0x15, 0xbf, 0x9e, 0xa9, 0xc0,
+	0x8a, 0x04, 0xb3, 0x99, 0x0a, 0x91, 0xab, 0x96, 0x48, 0xb1, 0x67, 0x55, 0xa2, 0xa8, 0xbc, 0x42,
+	0xd3, 0xb7, 0xd0, 0x52, 0x0f, 0xb1, 0x0e, 0xb9, 0xc7, 0x66, 0x71, 0x4a, 0x65, 0xd2, 0x34, 0xb2,
+	0x23, 0x5d, 0xb2, 0x32, 0xa7, 0xc2, 0xc3, 0x77, 0xf0, 0xdf, 0xf2, 0xf5, 0x7f, 0x3f, 0x06, 0xf2,
+	0xd8, 0xbf, 0xd9, 0x4d, 0x14, 0xcf, 0x75, 0x22, 0xa8, 0xe7, 0xff, 0x28, 0xb8, 0x03, 0x9b, 0xf4,
+	0xd6, 0x0f, 0xe7, 0x72, 0x79, 0x26, 0x51, 0x05, 0xb6, 0xa0, 0x7c, 0xe7, 0xcf, 0xe7, 0x94, 0x67,
+	0xbb, 0xcf, 0x2a, 0xa1, 0xa7, 0xf4, 0x5a, 0xfc, 0x53, 0x75, 0xa5, 0xab, 0x4a, 0x84, 0xe5, 0x2b,
+	0x4d, 0x99, 0x30, 0x0c, 0x15, 0x96, 0xac, 0xdc, 0x1f, 0x43, 0x75, 0xf9, 0xb5, 0xc0, 0x1a, 0x54,
+	0x46, 0xe3, 0x8b, 0xbe, 0x33, 0x3a, 0xb1, 0x36, 0xb0, 0x01, 0xe6, 0xb4, 0xef, 0x0e, 0x1d, 0x67,
+	0xe4, 0x0e, 0x2d, 0x4d, 0x78, 0x53, 0xf7, 0x8c, 0xf4, 0x4f, 0x87, 0x56, 0x09, 0x01, 0xca, 0xe7,
+	0x13, 0x67, 0x34, 0xfe, 0x60, 0xe9, 0x82, 0x1b, 0x9c, 0x9d, 0xb9, 0x53, 0x97, 0xf4, 0x27, 0x96,
+	0xb1, 0x7f, 0x04, 0x8d, 0x42, 0x64, 0xd1, 0x82, 0xba, 0x7b, 0x3c, 0xf1, 0x5c, 0x67, 0xea, 0x9d,
+	0x92, 0xc9, 0xb1, 0xb5, 0x81, 0x2d, 0x40, 0x32, 0x74, 0xfa, 0x9f, 0xbc, 0x82, 0xae, 0x0d, 0x8c,
+	0xcb, 0x52, 0x72, 0x75, 0x55, 0x96, 0x5f, 0xe1, 0x37, 0xbf, 0x06, 0x00, 0x63, 0x78, 0x6b, 0xcc,
+	0xa5, 0x05, 0x00, 0x00,
 }
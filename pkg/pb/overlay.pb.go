@@ -25,6 +25,32 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type NodeStanding_Status int32
+
+const (
+	NodeStanding_GOOD         NodeStanding_Status = 0
+	NodeStanding_SUSPENDED    NodeStanding_Status = 1
+	NodeStanding_DISQUALIFIED NodeStanding_Status = 2
+)
+
+var NodeStanding_Status_name = map[int32]string{
+	0: "GOOD",
+	1: "SUSPENDED",
+	2: "DISQUALIFIED",
+}
+var NodeStanding_Status_value = map[string]int32{
+	"GOOD":         0,
+	"SUSPENDED":    1,
+	"DISQUALIFIED": 2,
+}
+
+func (x NodeStanding_Status) String() string {
+	return proto.EnumName(NodeStanding_Status_name, int32(x))
+}
+func (NodeStanding_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_overlay_3b0125c94e387113, []int{11, 0}
+}
+
 type Restriction_Operator int32
 
 const (
@@ -54,7 +80,7 @@ func (x Restriction_Operator) String() string {
 	return proto.EnumName(Restriction_Operator_name, int32(x))
 }
 func (Restriction_Operator) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{11, 0}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{15, 0}
 }
 
 type Restriction_Operand int32
@@ -77,7 +103,7 @@ func (x Restriction_Operand) String() string {
 	return proto.EnumName(Restriction_Operand_name, int32(x))
 }
 func (Restriction_Operand) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{11, 1}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{15, 1}
 }
 
 // LookupRequest is is request message for the lookup rpc call
@@ -92,7 +118,7 @@ func (m *LookupRequest) Reset()         { *m = LookupRequest{} }
 func (m *LookupRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupRequest) ProtoMessage()    {}
 func (*LookupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{0}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{0}
 }
 func (m *LookupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupRequest.Unmarshal(m, b)
@@ -124,7 +150,7 @@ func (m *LookupResponse) Reset()         { *m = LookupResponse{} }
 func (m *LookupResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupResponse) ProtoMessage()    {}
 func (*LookupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{1}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{1}
 }
 func (m *LookupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupResponse.Unmarshal(m, b)
@@ -163,7 +189,7 @@ func (m *LookupRequests) Reset()         { *m = LookupRequests{} }
 func (m *LookupRequests) String() string { return proto.CompactTextString(m) }
 func (*LookupRequests) ProtoMessage()    {}
 func (*LookupRequests) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{2}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{2}
 }
 func (m *LookupRequests) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupRequests.Unmarshal(m, b)
@@ -202,7 +228,7 @@ func (m *LookupResponses) Reset()         { *m = LookupResponses{} }
 func (m *LookupResponses) String() string { return proto.CompactTextString(m) }
 func (*LookupResponses) ProtoMessage()    {}
 func (*LookupResponses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{3}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{3}
 }
 func (m *LookupResponses) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupResponses.Unmarshal(m, b)
@@ -241,7 +267,7 @@ func (m *FindStorageNodesResponse) Reset()         { *m = FindStorageNodesRespon
 func (m *FindStorageNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*FindStorageNodesResponse) ProtoMessage()    {}
 func (*FindStorageNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{4}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{4}
 }
 func (m *FindStorageNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindStorageNodesResponse.Unmarshal(m, b)
@@ -284,7 +310,7 @@ func (m *FindStorageNodesRequest) Reset()         { *m = FindStorageNodesRequest
 func (m *FindStorageNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*FindStorageNodesRequest) ProtoMessage()    {}
 func (*FindStorageNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{5}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{5}
 }
 func (m *FindStorageNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindStorageNodesRequest.Unmarshal(m, b)
@@ -334,22 +360,23 @@ func (m *FindStorageNodesRequest) GetMinNodes() int64 {
 
 // OverlayOptions is a set of criteria that a node must meet to be considered for a storage opportunity
 type OverlayOptions struct {
-	MaxLatency           *duration.Duration `protobuf:"bytes,1,opt,name=max_latency,json=maxLatency,proto3" json:"max_latency,omitempty"`
-	MinStats             *NodeStats         `protobuf:"bytes,2,opt,name=min_stats,json=minStats,proto3" json:"min_stats,omitempty"`
-	MinSpeedKbps         int64              `protobuf:"varint,3,opt,name=min_speed_kbps,json=minSpeedKbps,proto3" json:"min_speed_kbps,omitempty"`
-	Amount               int64              `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Restrictions         *NodeRestrictions  `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
-	ExcludedNodes        []NodeID           `protobuf:"bytes,6,rep,name=excluded_nodes,json=excludedNodes,proto3,customtype=NodeID" json:"excluded_nodes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	MaxLatency           *duration.Duration    `protobuf:"bytes,1,opt,name=max_latency,json=maxLatency,proto3" json:"max_latency,omitempty"`
+	MinStats             *NodeStats            `protobuf:"bytes,2,opt,name=min_stats,json=minStats,proto3" json:"min_stats,omitempty"`
+	MinSpeedKbps         int64                 `protobuf:"varint,3,opt,name=min_speed_kbps,json=minSpeedKbps,proto3" json:"min_speed_kbps,omitempty"`
+	Amount               int64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Restrictions         *NodeRestrictions     `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	ExcludedNodes        []NodeID              `protobuf:"bytes,6,rep,name=excluded_nodes,json=excludedNodes,proto3,customtype=NodeID" json:"excluded_nodes,omitempty"`
+	Placement            *PlacementConstraints `protobuf:"bytes,7,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *OverlayOptions) Reset()         { *m = OverlayOptions{} }
 func (m *OverlayOptions) String() string { return proto.CompactTextString(m) }
 func (*OverlayOptions) ProtoMessage()    {}
 func (*OverlayOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{6}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{6}
 }
 func (m *OverlayOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OverlayOptions.Unmarshal(m, b)
@@ -404,6 +431,60 @@ func (m *OverlayOptions) GetRestrictions() *NodeRestrictions {
 	return nil
 }
 
+func (m *OverlayOptions) GetPlacement() *PlacementConstraints {
+	if m != nil {
+		return m.Placement
+	}
+	return nil
+}
+
+// PlacementConstraints restricts the selected nodes in relation to the excluded nodes and each other
+type PlacementConstraints struct {
+	DistinctSubnets      bool     `protobuf:"varint,1,opt,name=distinct_subnets,json=distinctSubnets,proto3" json:"distinct_subnets,omitempty"`
+	DistinctRegions      bool     `protobuf:"varint,2,opt,name=distinct_regions,json=distinctRegions,proto3" json:"distinct_regions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlacementConstraints) Reset()         { *m = PlacementConstraints{} }
+func (m *PlacementConstraints) String() string { return proto.CompactTextString(m) }
+func (*PlacementConstraints) ProtoMessage()    {}
+func (*PlacementConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_overlay_3b0125c94e387113, []int{7}
+}
+func (m *PlacementConstraints) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlacementConstraints.Unmarshal(m, b)
+}
+func (m *PlacementConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlacementConstraints.Marshal(b, m, deterministic)
+}
+func (dst *PlacementConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementConstraints.Merge(dst, src)
+}
+func (m *PlacementConstraints) XXX_Size() int {
+	return xxx_messageInfo_PlacementConstraints.Size(m)
+}
+func (m *PlacementConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementConstraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlacementConstraints proto.InternalMessageInfo
+
+func (m *PlacementConstraints) GetDistinctSubnets() bool {
+	if m != nil {
+		return m.DistinctSubnets
+	}
+	return false
+}
+
+func (m *PlacementConstraints) GetDistinctRegions() bool {
+	if m != nil {
+		return m.DistinctRegions
+	}
+	return false
+}
+
 type QueryRequest struct {
 	Sender               *Node    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Target               *Node    `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
@@ -418,7 +499,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{7}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{8}
 }
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
@@ -478,7 +559,7 @@ func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
 func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryResponse) ProtoMessage()    {}
 func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{8}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{9}
 }
 func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponse.Unmarshal(m, b)
@@ -512,7 +593,13 @@ func (m *QueryResponse) GetResponse() []*Node {
 	return nil
 }
 
+// PingRequest is sent to check in on a node, satellites tell the node how
+// they treat it
 type PingRequest struct {
+	Standing *NodeStanding `protobuf:"bytes,1,opt,name=standing,proto3" json:"standing,omitempty"`
+	// pingback asks the node to ping the sender at this address, it must be
+	// the sender itself
+	Pingback             *Node    `protobuf:"bytes,2,opt,name=pingback,proto3" json:"pingback,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -522,7 +609,7 @@ func (m *PingRequest) Reset()         { *m = PingRequest{} }
 func (m *PingRequest) String() string { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()    {}
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{9}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{10}
 }
 func (m *PingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
@@ -542,7 +629,96 @@ func (m *PingRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PingRequest proto.InternalMessageInfo
 
+func (m *PingRequest) GetStanding() *NodeStanding {
+	if m != nil {
+		return m.Standing
+	}
+	return nil
+}
+
+func (m *PingRequest) GetPingback() *Node {
+	if m != nil {
+		return m.Pingback
+	}
+	return nil
+}
+
+// NodeStanding is the status a satellite gives a node, suspended nodes get no
+// new pieces and disqualified nodes no traffic at all
+type NodeStanding struct {
+	Status NodeStanding_Status `protobuf:"varint,1,opt,name=status,proto3,enum=overlay.NodeStanding_Status" json:"status,omitempty"`
+	Reason string              `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// since_unix_sec is when the status started
+	SinceUnixSec int64 `protobuf:"varint,3,opt,name=since_unix_sec,json=sinceUnixSec,proto3" json:"since_unix_sec,omitempty"`
+	// sent_unix_sec is the time of the satellite when it sent the standing
+	SentUnixSec          int64    `protobuf:"varint,4,opt,name=sent_unix_sec,json=sentUnixSec,proto3" json:"sent_unix_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeStanding) Reset()         { *m = NodeStanding{} }
+func (m *NodeStanding) String() string { return proto.CompactTextString(m) }
+func (*NodeStanding) ProtoMessage()    {}
+func (*NodeStanding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_overlay_3b0125c94e387113, []int{11}
+}
+func (m *NodeStanding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeStanding.Unmarshal(m, b)
+}
+func (m *NodeStanding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeStanding.Marshal(b, m, deterministic)
+}
+func (dst *NodeStanding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStanding.Merge(dst, src)
+}
+func (m *NodeStanding) XXX_Size() int {
+	return xxx_messageInfo_NodeStanding.Size(m)
+}
+func (m *NodeStanding) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStanding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeStanding proto.InternalMessageInfo
+
+func (m *NodeStanding) GetStatus() NodeStanding_Status {
+	if m != nil {
+		return m.Status
+	}
+	return NodeStanding_GOOD
+}
+
+func (m *NodeStanding) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *NodeStanding) GetSinceUnixSec() int64 {
+	if m != nil {
+		return m.SinceUnixSec
+	}
+	return 0
+}
+
+func (m *NodeStanding) GetSentUnixSec() int64 {
+	if m != nil {
+		return m.SentUnixSec
+	}
+	return 0
+}
+
+// PingResponse is the check-in of the pinged node, it reports the node's
+// current capacity and metadata
 type PingResponse struct {
+	Restrictions *NodeRestrictions `protobuf:"bytes,1,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	Metadata     *NodeMetadata     `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// sent_unix_sec is the clock of the node when it responded
+	SentUnixSec int64 `protobuf:"varint,3,opt,name=sent_unix_sec,json=sentUnixSec,proto3" json:"sent_unix_sec,omitempty"`
+	// pingback_ok tells whether the requested pingback succeeded
+	PingbackOk           bool     `protobuf:"varint,4,opt,name=pingback_ok,json=pingbackOk,proto3" json:"pingback_ok,omitempty"`
+	PingbackError        string   `protobuf:"bytes,5,opt,name=pingback_error,json=pingbackError,proto3" json:"pingback_error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -552,7 +728,7 @@ func (m *PingResponse) Reset()         { *m = PingResponse{} }
 func (m *PingResponse) String() string { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()    {}
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{10}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{12}
 }
 func (m *PingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
@@ -572,6 +748,144 @@ func (m *PingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PingResponse proto.InternalMessageInfo
 
+func (m *PingResponse) GetRestrictions() *NodeRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
+func (m *PingResponse) GetMetadata() *NodeMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PingResponse) GetSentUnixSec() int64 {
+	if m != nil {
+		return m.SentUnixSec
+	}
+	return 0
+}
+
+func (m *PingResponse) GetPingbackOk() bool {
+	if m != nil {
+		return m.PingbackOk
+	}
+	return false
+}
+
+func (m *PingResponse) GetPingbackError() string {
+	if m != nil {
+		return m.PingbackError
+	}
+	return ""
+}
+
+// Notice is a message signed by a satellite, which it broadcasts to the nodes
+// it contacted recently, e.g. to announce a protocol upgrade
+type Notice struct {
+	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SatelliteId          NodeID   `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedUnixSec       int64    `protobuf:"varint,4,opt,name=created_unix_sec,json=createdUnixSec,proto3" json:"created_unix_sec,omitempty"`
+	Certs                [][]byte `protobuf:"bytes,5,rep,name=certs,proto3" json:"certs,omitempty"`
+	Signature            []byte   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Notice) Reset()         { *m = Notice{} }
+func (m *Notice) String() string { return proto.CompactTextString(m) }
+func (*Notice) ProtoMessage()    {}
+func (*Notice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_overlay_3b0125c94e387113, []int{13}
+}
+func (m *Notice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notice.Unmarshal(m, b)
+}
+func (m *Notice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notice.Marshal(b, m, deterministic)
+}
+func (dst *Notice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notice.Merge(dst, src)
+}
+func (m *Notice) XXX_Size() int {
+	return xxx_messageInfo_Notice.Size(m)
+}
+func (m *Notice) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notice proto.InternalMessageInfo
+
+func (m *Notice) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Notice) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Notice) GetCreatedUnixSec() int64 {
+	if m != nil {
+		return m.CreatedUnixSec
+	}
+	return 0
+}
+
+func (m *Notice) GetCerts() [][]byte {
+	if m != nil {
+		return m.Certs
+	}
+	return nil
+}
+
+func (m *Notice) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type NoticeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NoticeResponse) Reset()         { *m = NoticeResponse{} }
+func (m *NoticeResponse) String() string { return proto.CompactTextString(m) }
+func (*NoticeResponse) ProtoMessage()    {}
+func (*NoticeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_overlay_3b0125c94e387113, []int{14}
+}
+func (m *NoticeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NoticeResponse.Unmarshal(m, b)
+}
+func (m *NoticeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NoticeResponse.Marshal(b, m, deterministic)
+}
+func (dst *NoticeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoticeResponse.Merge(dst, src)
+}
+func (m *NoticeResponse) XXX_Size() int {
+	return xxx_messageInfo_NoticeResponse.Size(m)
+}
+func (m *NoticeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoticeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoticeResponse proto.InternalMessageInfo
+
 type Restriction struct {
 	Operator             Restriction_Operator `protobuf:"varint,1,opt,name=operator,proto3,enum=overlay.Restriction_Operator" json:"operator,omitempty"`
 	Operand              Restriction_Operand  `protobuf:"varint,2,opt,name=operand,proto3,enum=overlay.Restriction_Operand" json:"operand,omitempty"`
@@ -585,7 +899,7 @@ func (m *Restriction) Reset()         { *m = Restriction{} }
 func (m *Restriction) String() string { return proto.CompactTextString(m) }
 func (*Restriction) ProtoMessage()    {}
 func (*Restriction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{11}
+	return fileDescriptor_overlay_3b0125c94e387113, []int{15}
 }
 func (m *Restriction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Restriction.Unmarshal(m, b)
@@ -634,11 +948,16 @@ func init() {
 	proto.RegisterType((*FindStorageNodesResponse)(nil), "overlay.FindStorageNodesResponse")
 	proto.RegisterType((*FindStorageNodesRequest)(nil), "overlay.FindStorageNodesRequest")
 	proto.RegisterType((*OverlayOptions)(nil), "overlay.OverlayOptions")
+	proto.RegisterType((*PlacementConstraints)(nil), "overlay.PlacementConstraints")
 	proto.RegisterType((*QueryRequest)(nil), "overlay.QueryRequest")
 	proto.RegisterType((*QueryResponse)(nil), "overlay.QueryResponse")
 	proto.RegisterType((*PingRequest)(nil), "overlay.PingRequest")
+	proto.RegisterType((*NodeStanding)(nil), "overlay.NodeStanding")
 	proto.RegisterType((*PingResponse)(nil), "overlay.PingResponse")
+	proto.RegisterType((*Notice)(nil), "overlay.Notice")
+	proto.RegisterType((*NoticeResponse)(nil), "overlay.NoticeResponse")
 	proto.RegisterType((*Restriction)(nil), "overlay.Restriction")
+	proto.RegisterEnum("overlay.NodeStanding_Status", NodeStanding_Status_name, NodeStanding_Status_value)
 	proto.RegisterEnum("overlay.Restriction_Operator", Restriction_Operator_name, Restriction_Operator_value)
 	proto.RegisterEnum("overlay.Restriction_Operand", Restriction_Operand_name, Restriction_Operand_value)
 }
@@ -793,6 +1112,8 @@ var _Overlay_serviceDesc = grpc.ServiceDesc{
 type NodesClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// Notify delivers a notice broadcast by a satellite
+	Notify(ctx context.Context, in *Notice, opts ...grpc.CallOption) (*NoticeResponse, error)
 }
 
 type nodesClient struct {
@@ -821,10 +1142,21 @@ func (c *nodesClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *nodesClient) Notify(ctx context.Context, in *Notice, opts ...grpc.CallOption) (*NoticeResponse, error) {
+	out := new(NoticeResponse)
+	err := c.cc.Invoke(ctx, "/overlay.Nodes/Notify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodesServer is the server API for Nodes service.
 type NodesServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// Notify delivers a notice broadcast by a satellite
+	Notify(context.Context, *Notice) (*NoticeResponse, error)
 }
 
 func RegisterNodesServer(s *grpc.Server, srv NodesServer) {
@@ -867,6 +1199,24 @@ func _Nodes_Ping_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nodes_Notify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Notice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodesServer).Notify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/overlay.Nodes/Notify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodesServer).Notify(ctx, req.(*Notice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Nodes_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "overlay.Nodes",
 	HandlerType: (*NodesServer)(nil),
@@ -879,66 +1229,94 @@ var _Nodes_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _Nodes_Ping_Handler,
 		},
+		{
+			MethodName: "Notify",
+			Handler:    _Nodes_Notify_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "overlay.proto",
 }
 
-func init() { proto.RegisterFile("overlay.proto", fileDescriptor_overlay_b711a2281a37fa0c) }
-
-var fileDescriptor_overlay_b711a2281a37fa0c = []byte{
-	// 841 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xef, 0x8e, 0xdb, 0x44,
-	0x10, 0x3f, 0xc7, 0x89, 0x93, 0x4e, 0x12, 0x9f, 0xb5, 0x6a, 0xef, 0x82, 0x81, 0x5e, 0xb0, 0x2a,
-	0x38, 0x89, 0x2a, 0x85, 0x14, 0x55, 0xb4, 0x02, 0x01, 0x51, 0xd2, 0x72, 0x6a, 0xd4, 0x50, 0x27,
-	0x52, 0x25, 0xf8, 0x60, 0x39, 0xf1, 0x62, 0x4c, 0x1c, 0xaf, 0xf1, 0xae, 0xab, 0xbb, 0x3e, 0x01,
-	0x6f, 0xc2, 0xab, 0xf0, 0x0c, 0x7c, 0xb8, 0x47, 0xe0, 0x01, 0xf8, 0x84, 0xf6, 0x8f, 0x1d, 0xe7,
-	0xee, 0x72, 0xea, 0xa7, 0xdd, 0x99, 0xf9, 0xfd, 0x66, 0xf7, 0x37, 0x3b, 0x3b, 0xd0, 0x25, 0x6f,
-	0x71, 0x16, 0xfb, 0x17, 0x83, 0x34, 0x23, 0x8c, 0xa0, 0xa6, 0x32, 0xed, 0xfb, 0x21, 0x21, 0x61,
-	0x8c, 0x1f, 0x09, 0xf7, 0x32, 0xff, 0xf5, 0x51, 0x90, 0x67, 0x3e, 0x8b, 0x48, 0x22, 0x81, 0x36,
-	0x84, 0x24, 0x24, 0xc5, 0x3e, 0x21, 0x01, 0x96, 0x7b, 0xe7, 0x6b, 0xe8, 0x4e, 0x09, 0x59, 0xe7,
-	0xa9, 0x8b, 0xff, 0xc8, 0x31, 0x65, 0xe8, 0x33, 0x68, 0xf2, 0xb0, 0x17, 0x05, 0x3d, 0xad, 0xaf,
-	0x9d, 0x76, 0x46, 0xe6, 0xdf, 0x97, 0x27, 0x07, 0xff, 0x5c, 0x9e, 0x18, 0xaf, 0x48, 0x80, 0xcf,
-	0xc6, 0xae, 0xc1, 0xc3, 0x67, 0x81, 0xf3, 0x05, 0x98, 0x05, 0x93, 0xa6, 0x24, 0xa1, 0x18, 0xdd,
-	0x87, 0x3a, 0x8f, 0x09, 0x5e, 0x7b, 0x08, 0x03, 0x71, 0x0c, 0x67, 0xb9, 0xc2, 0xef, 0xcc, 0xb6,
-	0x0c, 0x71, 0x16, 0x45, 0xdf, 0x82, 0x19, 0x0b, 0x8f, 0x97, 0x49, 0x57, 0x4f, 0xeb, 0xeb, 0xa7,
-	0xed, 0xe1, 0xd1, 0xa0, 0x90, 0xb9, 0x43, 0x70, 0xbb, 0x71, 0xd5, 0x74, 0xe6, 0x70, 0xb8, 0x7b,
-	0x05, 0x8a, 0xbe, 0x87, 0xc3, 0x32, 0xa3, 0xf4, 0xa9, 0x94, 0xc7, 0xd7, 0x52, 0xca, 0xb0, 0x6b,
-	0xc6, 0x3b, 0xb6, 0xf3, 0x0d, 0xf4, 0x9e, 0x47, 0x49, 0x30, 0x67, 0x24, 0xf3, 0x43, 0xcc, 0xaf,
-	0x4f, 0x4b, 0x85, 0x7d, 0x68, 0x70, 0x25, 0x54, 0xe5, 0xac, 0x4a, 0x94, 0x01, 0xe7, 0x5f, 0x0d,
-	0x8e, 0xaf, 0xd3, 0x65, 0x69, 0x4f, 0xa0, 0x4d, 0x96, 0xbf, 0xe3, 0x15, 0xf3, 0x68, 0xf4, 0x4e,
-	0x96, 0x49, 0x77, 0x41, 0xba, 0xe6, 0xd1, 0x3b, 0x8c, 0x46, 0x70, 0xb8, 0x22, 0x09, 0xcb, 0xfc,
-	0x15, 0xf3, 0x62, 0x9c, 0x84, 0xec, 0xb7, 0x5e, 0x4d, 0xd4, 0xf2, 0x83, 0x81, 0x7c, 0xde, 0x41,
-	0xf1, 0xbc, 0x83, 0xb1, 0x7a, 0x5e, 0xd7, 0x2c, 0x18, 0x53, 0x41, 0x40, 0x9f, 0x43, 0x9d, 0xa4,
-	0x8c, 0xf6, 0x74, 0x41, 0xdc, 0xaa, 0x9e, 0xc9, 0x75, 0x96, 0x72, 0x16, 0x75, 0x05, 0x08, 0x3d,
-	0x80, 0x06, 0x65, 0x7e, 0xc6, 0x7a, 0xf5, 0x1b, 0x9f, 0x5a, 0x06, 0xd1, 0x87, 0x70, 0x67, 0x13,
-	0x25, 0x9e, 0x54, 0xde, 0x10, 0xb7, 0x6e, 0x6d, 0xa2, 0x44, 0x68, 0x73, 0xfe, 0xaa, 0x81, 0xb9,
-	0x9b, 0x1b, 0x3d, 0x83, 0xf6, 0xc6, 0x3f, 0xf7, 0x62, 0x9f, 0xe1, 0x64, 0x75, 0xa1, 0xda, 0xe1,
-	0x16, 0x09, 0xb0, 0xf1, 0xcf, 0xa7, 0x12, 0x8c, 0x1e, 0xca, 0xb3, 0x28, 0xf3, 0x19, 0x55, 0xe2,
-	0x0f, 0xb7, 0x55, 0x9e, 0x73, 0xb7, 0x38, 0x5c, 0xec, 0xd0, 0x03, 0x30, 0x05, 0x3a, 0xc5, 0x38,
-	0xf0, 0xd6, 0xcb, 0x54, 0xca, 0xd6, 0xdd, 0x0e, 0x47, 0x70, 0xe7, 0xcb, 0x65, 0x4a, 0xd1, 0x11,
-	0x18, 0xfe, 0x86, 0xe4, 0x89, 0x94, 0xa9, 0xbb, 0xca, 0x42, 0xcf, 0xa0, 0x93, 0x61, 0xca, 0xb2,
-	0x68, 0x25, 0xee, 0x2d, 0xa4, 0xf1, 0xde, 0xdb, 0x3e, 0x6a, 0x25, 0xea, 0xee, 0x60, 0xd1, 0x97,
-	0x60, 0xe2, 0xf3, 0x55, 0x9c, 0x07, 0x38, 0x50, 0x85, 0x31, 0xfa, 0xfa, 0x69, 0x67, 0x04, 0x95,
-	0xf2, 0x75, 0x0b, 0x84, 0xac, 0xd4, 0x9f, 0x1a, 0x74, 0x5e, 0xe7, 0x38, 0xbb, 0x28, 0xfa, 0xc1,
-	0x01, 0x83, 0xe2, 0x24, 0xc0, 0xd9, 0x0d, 0x3f, 0x46, 0x45, 0x38, 0x86, 0xf9, 0x59, 0x88, 0x99,
-	0x2a, 0xc6, 0x0e, 0x46, 0x46, 0xd0, 0x5d, 0x68, 0xc4, 0xd1, 0x26, 0x62, 0x4a, 0xbc, 0x34, 0x90,
-	0x0d, 0xad, 0x34, 0x4a, 0xc2, 0xa5, 0xbf, 0x5a, 0x0b, 0xdd, 0x2d, 0xb7, 0xb4, 0x9d, 0x5f, 0xa0,
-	0xab, 0x6e, 0xa2, 0x1a, 0xfb, 0x7d, 0xae, 0xf2, 0x29, 0xb4, 0xca, 0x3f, 0x55, 0xbb, 0xd6, 0xff,
-	0x65, 0xcc, 0xe9, 0x42, 0xfb, 0xa7, 0x28, 0x09, 0x8b, 0x4f, 0x6a, 0x42, 0x47, 0x9a, 0x2a, 0xfc,
-	0x9f, 0x06, 0xed, 0x4a, 0x61, 0xd1, 0x53, 0x68, 0x91, 0x14, 0x67, 0x3e, 0x23, 0xf2, 0x70, 0x73,
-	0xf8, 0x71, 0xd9, 0xb4, 0x15, 0xdc, 0x60, 0xa6, 0x40, 0x6e, 0x09, 0x47, 0x4f, 0xa0, 0x29, 0xf6,
-	0x49, 0x20, 0xaa, 0x63, 0x0e, 0x3f, 0xda, 0xcf, 0x4c, 0x02, 0xb7, 0x00, 0xf3, 0x82, 0xbd, 0xf5,
-	0xe3, 0x1c, 0x17, 0x05, 0x13, 0x86, 0xf3, 0x15, 0xb4, 0x8a, 0x33, 0x90, 0x01, 0xb5, 0xe9, 0xc2,
-	0x3a, 0xe0, 0xeb, 0xe4, 0xb5, 0xa5, 0xf1, 0xf5, 0xc5, 0xc2, 0xaa, 0xa1, 0x26, 0xe8, 0xd3, 0xc5,
-	0xc4, 0xd2, 0xf9, 0xe6, 0xc5, 0x62, 0x62, 0xd5, 0x9d, 0x87, 0xd0, 0x54, 0xf9, 0x11, 0x02, 0xf3,
-	0xb9, 0x3b, 0x99, 0x78, 0xa3, 0x1f, 0x5e, 0x8d, 0xdf, 0x9c, 0x8d, 0x17, 0x3f, 0x5a, 0x07, 0xa8,
-	0x0b, 0x77, 0x84, 0x6f, 0x7c, 0x36, 0x7f, 0x69, 0x69, 0xc3, 0x4b, 0x0d, 0x9a, 0xea, 0xb7, 0xa0,
-	0xa7, 0x60, 0xc8, 0x51, 0x84, 0xf6, 0x8c, 0x3b, 0x7b, 0xdf, 0xcc, 0x42, 0xdf, 0x01, 0x8c, 0xf2,
-	0x78, 0xad, 0xe8, 0xc7, 0x37, 0xd3, 0xa9, 0xdd, 0xdb, 0xc3, 0xa7, 0xe8, 0x0d, 0x58, 0x57, 0xa7,
-	0x14, 0xea, 0x97, 0xe8, 0x3d, 0x03, 0xcc, 0xfe, 0xe4, 0x16, 0x84, 0xcc, 0x3c, 0x64, 0xd0, 0x90,
-	0xd9, 0x9e, 0x40, 0x43, 0xb4, 0x18, 0xba, 0x57, 0x92, 0xaa, 0xcd, 0x6f, 0x1f, 0x5d, 0x75, 0x2b,
-	0x69, 0x8f, 0xa1, 0xce, 0xdb, 0x05, 0xdd, 0x2d, 0xe3, 0x95, 0x66, 0xb2, 0xef, 0x5d, 0xf1, 0x4a,
-	0xd2, 0xa8, 0xfe, 0x73, 0x2d, 0x5d, 0x2e, 0x0d, 0x31, 0x5a, 0x1e, 0xff, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0x95, 0x8a, 0xf4, 0x24, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("overlay.proto", fileDescriptor_overlay_3b0125c94e387113) }
+
+var fileDescriptor_overlay_3b0125c94e387113 = []byte{
+	// 1217 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x56, 0x5b, 0x6f, 0x1b, 0x45,
+	0x14, 0xae, 0xef, 0xf6, 0xb1, 0xbd, 0xb6, 0x46, 0x69, 0x62, 0x4c, 0x69, 0xca, 0x8a, 0x4b, 0x11,
+	0x95, 0x4b, 0x93, 0xaa, 0xe2, 0x2a, 0xa8, 0xb1, 0x13, 0xac, 0x9a, 0x38, 0x19, 0x27, 0xaa, 0x04,
+	0x0f, 0xd6, 0x7a, 0x77, 0xea, 0x2e, 0x59, 0xef, 0x9a, 0xbd, 0x54, 0x09, 0xbf, 0x80, 0x9f, 0xc2,
+	0xff, 0xe0, 0x85, 0xdf, 0x00, 0x52, 0x9e, 0x10, 0x8f, 0xfc, 0x00, 0x9e, 0x38, 0x73, 0xd9, 0x5d,
+	0x3b, 0x76, 0x2a, 0x78, 0xda, 0x99, 0x6f, 0xbe, 0x33, 0x67, 0xbe, 0x73, 0xce, 0x9c, 0x1d, 0xa8,
+	0x7b, 0xaf, 0x98, 0xef, 0x18, 0x97, 0x9d, 0x85, 0xef, 0x85, 0x1e, 0x29, 0xa9, 0x69, 0xfb, 0xee,
+	0xcc, 0xf3, 0x66, 0x0e, 0x7b, 0x28, 0xe0, 0x69, 0xf4, 0xe2, 0xa1, 0x15, 0xf9, 0x46, 0x68, 0x7b,
+	0xae, 0x24, 0xb6, 0x61, 0xe6, 0xcd, 0xbc, 0x78, 0xec, 0x7a, 0x16, 0x93, 0x63, 0xfd, 0x63, 0xa8,
+	0x0f, 0x3d, 0xef, 0x3c, 0x5a, 0x50, 0xf6, 0x63, 0xc4, 0x82, 0x90, 0xbc, 0x0f, 0x25, 0xbe, 0x3c,
+	0xb1, 0xad, 0x56, 0xe6, 0x5e, 0xe6, 0x7e, 0xad, 0xab, 0xfd, 0x76, 0xb5, 0x7b, 0xeb, 0xf7, 0xab,
+	0xdd, 0xe2, 0x11, 0xc2, 0x83, 0x1e, 0x2d, 0xf2, 0xe5, 0x81, 0xa5, 0x7f, 0x04, 0x5a, 0x6c, 0x19,
+	0x2c, 0x3c, 0x37, 0x60, 0xe4, 0x2e, 0xe4, 0xf9, 0x9a, 0xb0, 0xab, 0xee, 0x41, 0x47, 0xb8, 0xe1,
+	0x56, 0x54, 0xe0, 0xfa, 0x28, 0xb5, 0x10, 0xbe, 0x02, 0xf2, 0x05, 0x68, 0x8e, 0x40, 0x26, 0xbe,
+	0x84, 0xd0, 0x36, 0x87, 0xb6, 0xdb, 0x9d, 0x58, 0xe6, 0x8a, 0x01, 0xad, 0x3b, 0xcb, 0x53, 0x7d,
+	0x0c, 0x8d, 0xd5, 0x23, 0x04, 0xe4, 0x2b, 0x68, 0x24, 0x3b, 0x4a, 0x4c, 0x6d, 0xb9, 0xb3, 0xb6,
+	0xa5, 0x5c, 0xa6, 0x9a, 0xb3, 0x32, 0xd7, 0x3f, 0x87, 0xd6, 0x81, 0xed, 0x5a, 0xe3, 0xd0, 0xf3,
+	0x8d, 0x19, 0xe3, 0xc7, 0x0f, 0x12, 0x85, 0xf7, 0xa0, 0xc0, 0x95, 0x04, 0x6a, 0xcf, 0x65, 0x89,
+	0x72, 0x41, 0xff, 0x3b, 0x03, 0x3b, 0xeb, 0xe6, 0x32, 0xb4, 0xbb, 0x50, 0xf5, 0xa6, 0x3f, 0x30,
+	0x33, 0x9c, 0x04, 0xf6, 0x4f, 0x32, 0x4c, 0x39, 0x0a, 0x12, 0x1a, 0x23, 0x42, 0xba, 0xd0, 0x30,
+	0x3d, 0x37, 0xf4, 0x0d, 0xa4, 0x38, 0xcc, 0x9d, 0x85, 0x2f, 0x5b, 0x59, 0x11, 0xcb, 0x37, 0x3a,
+	0x32, 0xbd, 0x9d, 0x38, 0xbd, 0x9d, 0x9e, 0x4a, 0x2f, 0xd5, 0x62, 0x8b, 0xa1, 0x30, 0x20, 0x1f,
+	0x42, 0xde, 0x5b, 0x84, 0x41, 0x2b, 0x27, 0x0c, 0x53, 0xd5, 0x23, 0xf9, 0x1d, 0x2d, 0xb8, 0x55,
+	0x40, 0x05, 0x89, 0xbc, 0x03, 0x85, 0x20, 0x34, 0xfc, 0xb0, 0x95, 0xdf, 0x98, 0x6a, 0xb9, 0x48,
+	0xde, 0x84, 0xca, 0xdc, 0x76, 0x27, 0x52, 0x79, 0x41, 0x9c, 0xba, 0x8c, 0x80, 0xd0, 0xa6, 0xff,
+	0x99, 0x05, 0x6d, 0x75, 0x6f, 0xf2, 0x29, 0x54, 0xe7, 0xc6, 0xc5, 0xc4, 0x31, 0x42, 0xe6, 0x9a,
+	0x97, 0xaa, 0x1c, 0x5e, 0x23, 0x01, 0x90, 0x3d, 0x94, 0x64, 0xf2, 0x40, 0xfa, 0x42, 0xc7, 0xa8,
+	0x41, 0x8a, 0x6f, 0xa4, 0x51, 0x1e, 0x73, 0x58, 0x38, 0x17, 0x23, 0x3c, 0xbf, 0x26, 0xd8, 0x0b,
+	0xc6, 0xac, 0xc9, 0xf9, 0x74, 0x21, 0x65, 0xe7, 0x68, 0x8d, 0x33, 0x38, 0xf8, 0x0c, 0x31, 0xb2,
+	0x0d, 0x45, 0x63, 0xee, 0x45, 0xae, 0x94, 0x99, 0xa3, 0x6a, 0x86, 0xe7, 0xac, 0x61, 0x91, 0x84,
+	0xbe, 0x6d, 0x8a, 0x73, 0x0b, 0x69, 0xbc, 0xf6, 0xd2, 0xa4, 0x2e, 0xad, 0xd2, 0x15, 0x2e, 0x79,
+	0x04, 0x1a, 0xbb, 0x30, 0x9d, 0xc8, 0x42, 0xc7, 0x32, 0x30, 0x45, 0x2c, 0x89, 0x5a, 0x17, 0x96,
+	0xc2, 0x57, 0x8f, 0x19, 0x22, 0x52, 0xe4, 0x33, 0xa8, 0x2c, 0x1c, 0xc3, 0x64, 0x73, 0x86, 0x27,
+	0x29, 0x09, 0x5f, 0x6f, 0x25, 0xe9, 0x39, 0x8e, 0x57, 0xbe, 0xc6, 0xdd, 0x31, 0x9f, 0xb6, 0x8b,
+	0x42, 0x53, 0xbe, 0xee, 0xc0, 0xd6, 0x26, 0x0a, 0xf9, 0x00, 0x9a, 0x96, 0x1d, 0x84, 0xb6, 0xcb,
+	0xab, 0x2a, 0x9a, 0xba, 0x2c, 0x0c, 0x44, 0xc0, 0xcb, 0xb4, 0x11, 0xe3, 0x63, 0x09, 0xaf, 0x50,
+	0x7d, 0x36, 0x13, 0x92, 0xb3, 0xab, 0x54, 0x2a, 0x61, 0xfd, 0xe7, 0x0c, 0xd4, 0x4e, 0x22, 0xe6,
+	0x5f, 0xc6, 0xa5, 0xab, 0x43, 0x31, 0x60, 0xae, 0xc5, 0xfc, 0x0d, 0x97, 0x5b, 0xad, 0x70, 0x0e,
+	0x96, 0xcb, 0x8c, 0x85, 0x2a, 0x6f, 0x2b, 0x1c, 0xb9, 0x42, 0xb6, 0xa0, 0xe0, 0xd8, 0x73, 0x3b,
+	0x54, 0x79, 0x92, 0x13, 0xd2, 0x86, 0xf2, 0xc2, 0x76, 0x67, 0x53, 0xc3, 0x3c, 0x17, 0x29, 0x2a,
+	0xd3, 0x64, 0xae, 0x7f, 0x0f, 0x75, 0x75, 0x12, 0x75, 0x07, 0xff, 0xcb, 0x51, 0xde, 0x83, 0x72,
+	0x72, 0xfd, 0xb3, 0x6b, 0x57, 0x35, 0x59, 0xd3, 0x5f, 0x42, 0xf5, 0x18, 0x1d, 0xc5, 0x2a, 0x1f,
+	0x41, 0x19, 0x0b, 0xcf, 0xb5, 0x10, 0x52, 0x9b, 0xdf, 0x4e, 0x12, 0xa4, 0xca, 0x4f, 0x2c, 0xd2,
+	0x84, 0xc6, 0x3d, 0x25, 0x47, 0x5f, 0x97, 0x9d, 0xca, 0xf8, 0x03, 0x23, 0xba, 0xbc, 0x05, 0x79,
+	0x8c, 0x32, 0xb0, 0x86, 0x23, 0x99, 0x2e, 0x6d, 0xef, 0xce, 0x46, 0x4f, 0x9d, 0xb1, 0xe0, 0x50,
+	0xc5, 0xe5, 0xa5, 0xec, 0x33, 0x23, 0xf0, 0x5c, 0xe1, 0xac, 0x42, 0xd5, 0x8c, 0x5f, 0x84, 0x00,
+	0x13, 0xc8, 0x26, 0x91, 0x6b, 0x5f, 0x4c, 0x02, 0x66, 0xc6, 0x17, 0x41, 0xa0, 0x67, 0x08, 0x8e,
+	0x99, 0x89, 0xa1, 0xab, 0x63, 0x80, 0xc2, 0x94, 0x24, 0xef, 0x43, 0x95, 0x83, 0x8a, 0xa3, 0xef,
+	0x43, 0x51, 0xfa, 0x24, 0x65, 0xc8, 0x1f, 0x8e, 0x46, 0xbd, 0xe6, 0x2d, 0x52, 0x87, 0xca, 0xf8,
+	0x6c, 0x7c, 0xdc, 0x3f, 0xea, 0xf5, 0x7b, 0xcd, 0x0c, 0x69, 0x42, 0xad, 0x37, 0x18, 0x9f, 0x9c,
+	0x3d, 0x1d, 0x0e, 0x0e, 0x06, 0x88, 0x64, 0xf5, 0xbf, 0x50, 0x9d, 0x0c, 0xa4, 0x4a, 0xd2, 0xf5,
+	0xab, 0x95, 0xf9, 0x1f, 0x57, 0xab, 0x03, 0xe5, 0x39, 0x0b, 0x0d, 0xcb, 0x08, 0x0d, 0x15, 0x52,
+	0x92, 0xda, 0x7d, 0xab, 0x56, 0x68, 0xc2, 0x59, 0x57, 0x95, 0x5b, 0x53, 0xc5, 0x5b, 0x6f, 0x9c,
+	0x8a, 0x89, 0x17, 0x17, 0x19, 0xc4, 0xd0, 0xe8, 0x9c, 0xbc, 0x0b, 0x5a, 0x42, 0x60, 0xbe, 0xef,
+	0xf9, 0xa2, 0x1b, 0x54, 0x68, 0x3d, 0x46, 0xfb, 0x1c, 0xd4, 0x7f, 0xcd, 0x00, 0xde, 0xee, 0xd0,
+	0x36, 0x19, 0xd1, 0x20, 0x1b, 0xff, 0x23, 0x29, 0x8e, 0xb0, 0x78, 0x6a, 0x01, 0x36, 0x31, 0xc7,
+	0xb1, 0x43, 0xf1, 0xf7, 0xcc, 0x6e, 0x6c, 0xa9, 0xd5, 0x84, 0x33, 0xb0, 0x48, 0x0b, 0x4a, 0x73,
+	0x16, 0x04, 0xf8, 0x9f, 0x10, 0x67, 0xae, 0xd0, 0x78, 0x4a, 0xee, 0x43, 0xd3, 0xc4, 0xd4, 0x86,
+	0xd8, 0x5d, 0xae, 0x25, 0x4b, 0x53, 0x78, 0xac, 0x0c, 0x6f, 0x94, 0xc9, 0xfc, 0x90, 0x77, 0x2f,
+	0xec, 0x3f, 0x54, 0x4e, 0xc8, 0x1d, 0xa8, 0x04, 0xf6, 0xcc, 0xc5, 0x3c, 0xfa, 0x0c, 0x3b, 0x13,
+	0x3f, 0x63, 0x0a, 0xe8, 0x4d, 0xd0, 0xa4, 0x88, 0xe4, 0xa7, 0xf7, 0x4f, 0x06, 0xaa, 0x4b, 0x29,
+	0x21, 0x9f, 0x40, 0xd9, 0x5b, 0x30, 0xec, 0xcf, 0x9e, 0xaf, 0xea, 0x33, 0x6d, 0x55, 0x4b, 0xbc,
+	0xce, 0x48, 0x91, 0x68, 0x42, 0x27, 0x4f, 0xa0, 0x24, 0xc6, 0xae, 0x0c, 0xc1, 0x72, 0x65, 0xaf,
+	0x59, 0xba, 0x16, 0x8d, 0xc9, 0x5c, 0xc8, 0x2b, 0xc3, 0x89, 0x58, 0xdc, 0x1a, 0xc4, 0x44, 0x7f,
+	0x0c, 0xe5, 0xd8, 0x07, 0x29, 0x42, 0x76, 0x78, 0x8a, 0xe5, 0x88, 0xdf, 0xfe, 0x09, 0xd6, 0x21,
+	0x7e, 0x0f, 0x4f, 0x9b, 0x59, 0x52, 0x82, 0xdc, 0xf0, 0xb4, 0xdf, 0xcc, 0xf1, 0xc1, 0x21, 0x0e,
+	0xf2, 0xfa, 0x03, 0x28, 0xa9, 0xfd, 0x09, 0x01, 0xed, 0x80, 0xf6, 0xfb, 0x93, 0xee, 0xd3, 0xa3,
+	0xde, 0xf3, 0x41, 0xef, 0xf4, 0x1b, 0x59, 0xcf, 0x02, 0xc3, 0x2a, 0x7e, 0xd6, 0xcc, 0xec, 0x5d,
+	0x65, 0x90, 0x2e, 0x8f, 0x88, 0xc2, 0x8b, 0xf2, 0x7d, 0x40, 0x6e, 0x78, 0x83, 0xb4, 0x6f, 0x7a,
+	0x48, 0x90, 0x2f, 0x01, 0xba, 0x91, 0x73, 0xae, 0xcc, 0x77, 0x36, 0x9b, 0x07, 0xed, 0xd6, 0x0d,
+	0xf6, 0x01, 0x79, 0x0e, 0xcd, 0xeb, 0x4f, 0x07, 0x72, 0x2f, 0x61, 0xdf, 0xf0, 0xaa, 0x68, 0xbf,
+	0xfd, 0x1a, 0x86, 0xdc, 0x79, 0xef, 0x97, 0x0c, 0x14, 0xe4, 0x76, 0x4f, 0xa0, 0x20, 0xba, 0x29,
+	0x49, 0x1b, 0xdb, 0x72, 0x9f, 0x6f, 0x6f, 0x5f, 0x87, 0x95, 0xb6, 0x7d, 0xc8, 0xf3, 0xfb, 0x4d,
+	0xb6, 0xd2, 0x1f, 0x56, 0xda, 0x37, 0xdb, 0xb7, 0xaf, 0xa1, 0xca, 0x68, 0x4f, 0xde, 0x95, 0x17,
+	0x97, 0xa4, 0xb1, 0xd4, 0xdc, 0x78, 0xdd, 0x2d, 0x05, 0x71, 0xb5, 0x10, 0xbb, 0xf9, 0xef, 0xb2,
+	0x8b, 0xe9, 0xb4, 0x28, 0x1e, 0x09, 0xfb, 0xff, 0x02, 0xdc, 0x1a, 0x8b, 0x49, 0xee, 0x0a, 0x00,
+	0x00,
 }
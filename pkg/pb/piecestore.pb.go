@@ -54,7 +54,36 @@ func (x BandwidthAction) String() string {
 	return proto.EnumName(BandwidthAction_name, int32(x))
 }
 func (BandwidthAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{0}
+	return fileDescriptor_piecestore_75746a8536902839, []int{0}
+}
+
+// IntegrityCapability flags the integrity checks of a piece transfer
+type IntegrityCapability int32
+
+const (
+	IntegrityCapability_INTEGRITY_NONE IntegrityCapability = 0
+	// a crc32c checksum of at most frame_size bytes in every frame
+	IntegrityCapability_INTEGRITY_CRC32C_FRAMES IntegrityCapability = 1
+	// resuming an upload after the last verified frame
+	IntegrityCapability_INTEGRITY_RESUME IntegrityCapability = 2
+)
+
+var IntegrityCapability_name = map[int32]string{
+	0: "INTEGRITY_NONE",
+	1: "INTEGRITY_CRC32C_FRAMES",
+	2: "INTEGRITY_RESUME",
+}
+var IntegrityCapability_value = map[string]int32{
+	"INTEGRITY_NONE":          0,
+	"INTEGRITY_CRC32C_FRAMES": 1,
+	"INTEGRITY_RESUME":        2,
+}
+
+func (x IntegrityCapability) String() string {
+	return proto.EnumName(IntegrityCapability_name, int32(x))
+}
+func (IntegrityCapability) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_75746a8536902839, []int{1}
 }
 
 type PayerBandwidthAllocation struct {
@@ -76,7 +105,7 @@ func (m *PayerBandwidthAllocation) Reset()         { *m = PayerBandwidthAllocati
 func (m *PayerBandwidthAllocation) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocation) ProtoMessage()    {}
 func (*PayerBandwidthAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{0}
+	return fileDescriptor_piecestore_75746a8536902839, []int{0}
 }
 func (m *PayerBandwidthAllocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocation.Unmarshal(m, b)
@@ -160,7 +189,7 @@ func (m *RenterBandwidthAllocation) Reset()         { *m = RenterBandwidthAlloca
 func (m *RenterBandwidthAllocation) String() string { return proto.CompactTextString(m) }
 func (*RenterBandwidthAllocation) ProtoMessage()    {}
 func (*RenterBandwidthAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{1}
+	return fileDescriptor_piecestore_75746a8536902839, []int{1}
 }
 func (m *RenterBandwidthAllocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenterBandwidthAllocation.Unmarshal(m, b)
@@ -209,19 +238,23 @@ func (m *RenterBandwidthAllocation) GetSignature() []byte {
 }
 
 type PieceStore struct {
-	BandwidthAllocation  *RenterBandwidthAllocation `protobuf:"bytes,1,opt,name=bandwidth_allocation,json=bandwidthAllocation,proto3" json:"bandwidth_allocation,omitempty"`
-	PieceData            *PieceStore_PieceData      `protobuf:"bytes,2,opt,name=piece_data,json=pieceData,proto3" json:"piece_data,omitempty"`
-	Authorization        *SignedMessage             `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	BandwidthAllocation *RenterBandwidthAllocation `protobuf:"bytes,1,opt,name=bandwidth_allocation,json=bandwidthAllocation,proto3" json:"bandwidth_allocation,omitempty"`
+	PieceData           *PieceStore_PieceData      `protobuf:"bytes,2,opt,name=piece_data,json=pieceData,proto3" json:"piece_data,omitempty"`
+	Authorization       *SignedMessage             `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// integrity requests integrity checks in the first message of an upload
+	Integrity *IntegrityOptions `protobuf:"bytes,4,opt,name=integrity,proto3" json:"integrity,omitempty"`
+	// frame covers the content sent since the previous frame
+	Frame                *IntegrityFrame `protobuf:"bytes,5,opt,name=frame,proto3" json:"frame,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *PieceStore) Reset()         { *m = PieceStore{} }
 func (m *PieceStore) String() string { return proto.CompactTextString(m) }
 func (*PieceStore) ProtoMessage()    {}
 func (*PieceStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{2}
+	return fileDescriptor_piecestore_75746a8536902839, []int{2}
 }
 func (m *PieceStore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStore.Unmarshal(m, b)
@@ -262,11 +295,27 @@ func (m *PieceStore) GetAuthorization() *SignedMessage {
 	return nil
 }
 
+func (m *PieceStore) GetIntegrity() *IntegrityOptions {
+	if m != nil {
+		return m.Integrity
+	}
+	return nil
+}
+
+func (m *PieceStore) GetFrame() *IntegrityFrame {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
 type PieceStore_PieceData struct {
 	// TODO: may want to use customtype and fixed-length byte slice
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ExpirationUnixSec    int64    `protobuf:"varint,2,opt,name=expiration_unix_sec,json=expirationUnixSec,proto3" json:"expiration_unix_sec,omitempty"`
-	Content              []byte   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpirationUnixSec int64  `protobuf:"varint,2,opt,name=expiration_unix_sec,json=expirationUnixSec,proto3" json:"expiration_unix_sec,omitempty"`
+	Content           []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// offset in the piece the upload continues at, when resuming it
+	Offset               int64    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -276,7 +325,7 @@ func (m *PieceStore_PieceData) Reset()         { *m = PieceStore_PieceData{} }
 func (m *PieceStore_PieceData) String() string { return proto.CompactTextString(m) }
 func (*PieceStore_PieceData) ProtoMessage()    {}
 func (*PieceStore_PieceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{2, 0}
+	return fileDescriptor_piecestore_75746a8536902839, []int{2, 0}
 }
 func (m *PieceStore_PieceData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStore_PieceData.Unmarshal(m, b)
@@ -317,6 +366,105 @@ func (m *PieceStore_PieceData) GetContent() []byte {
 	return nil
 }
 
+func (m *PieceStore_PieceData) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type IntegrityOptions struct {
+	Capabilities         uint32   `protobuf:"varint,1,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	FrameSize            int64    `protobuf:"varint,2,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IntegrityOptions) Reset()         { *m = IntegrityOptions{} }
+func (m *IntegrityOptions) String() string { return proto.CompactTextString(m) }
+func (*IntegrityOptions) ProtoMessage()    {}
+func (*IntegrityOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_75746a8536902839, []int{3}
+}
+func (m *IntegrityOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntegrityOptions.Unmarshal(m, b)
+}
+func (m *IntegrityOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntegrityOptions.Marshal(b, m, deterministic)
+}
+func (dst *IntegrityOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegrityOptions.Merge(dst, src)
+}
+func (m *IntegrityOptions) XXX_Size() int {
+	return xxx_messageInfo_IntegrityOptions.Size(m)
+}
+func (m *IntegrityOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegrityOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegrityOptions proto.InternalMessageInfo
+
+func (m *IntegrityOptions) GetCapabilities() uint32 {
+	if m != nil {
+		return m.Capabilities
+	}
+	return 0
+}
+
+func (m *IntegrityOptions) GetFrameSize() int64 {
+	if m != nil {
+		return m.FrameSize
+	}
+	return 0
+}
+
+type IntegrityFrame struct {
+	Offset               int64    `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Crc32C               uint32   `protobuf:"varint,2,opt,name=crc32c,proto3" json:"crc32c,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IntegrityFrame) Reset()         { *m = IntegrityFrame{} }
+func (m *IntegrityFrame) String() string { return proto.CompactTextString(m) }
+func (*IntegrityFrame) ProtoMessage()    {}
+func (*IntegrityFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_75746a8536902839, []int{4}
+}
+func (m *IntegrityFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntegrityFrame.Unmarshal(m, b)
+}
+func (m *IntegrityFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntegrityFrame.Marshal(b, m, deterministic)
+}
+func (dst *IntegrityFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegrityFrame.Merge(dst, src)
+}
+func (m *IntegrityFrame) XXX_Size() int {
+	return xxx_messageInfo_IntegrityFrame.Size(m)
+}
+func (m *IntegrityFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegrityFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegrityFrame proto.InternalMessageInfo
+
+func (m *IntegrityFrame) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *IntegrityFrame) GetCrc32C() uint32 {
+	if m != nil {
+		return m.Crc32C
+	}
+	return 0
+}
+
 type PieceId struct {
 	// TODO: may want to use customtype and fixed-length byte slice
 	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -330,7 +478,7 @@ func (m *PieceId) Reset()         { *m = PieceId{} }
 func (m *PieceId) String() string { return proto.CompactTextString(m) }
 func (*PieceId) ProtoMessage()    {}
 func (*PieceId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{3}
+	return fileDescriptor_piecestore_75746a8536902839, []int{5}
 }
 func (m *PieceId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceId.Unmarshal(m, b)
@@ -377,7 +525,7 @@ func (m *PieceSummary) Reset()         { *m = PieceSummary{} }
 func (m *PieceSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceSummary) ProtoMessage()    {}
 func (*PieceSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{4}
+	return fileDescriptor_piecestore_75746a8536902839, []int{6}
 }
 func (m *PieceSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceSummary.Unmarshal(m, b)
@@ -431,7 +579,7 @@ func (m *PieceRetrieval) Reset()         { *m = PieceRetrieval{} }
 func (m *PieceRetrieval) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrieval) ProtoMessage()    {}
 func (*PieceRetrieval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{5}
+	return fileDescriptor_piecestore_75746a8536902839, []int{7}
 }
 func (m *PieceRetrieval) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrieval.Unmarshal(m, b)
@@ -486,7 +634,7 @@ func (m *PieceRetrieval_PieceData) Reset()         { *m = PieceRetrieval_PieceDa
 func (m *PieceRetrieval_PieceData) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrieval_PieceData) ProtoMessage()    {}
 func (*PieceRetrieval_PieceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{5, 0}
+	return fileDescriptor_piecestore_75746a8536902839, []int{7, 0}
 }
 func (m *PieceRetrieval_PieceData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrieval_PieceData.Unmarshal(m, b)
@@ -539,7 +687,7 @@ func (m *PieceRetrievalStream) Reset()         { *m = PieceRetrievalStream{} }
 func (m *PieceRetrievalStream) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrievalStream) ProtoMessage()    {}
 func (*PieceRetrievalStream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{6}
+	return fileDescriptor_piecestore_75746a8536902839, []int{8}
 }
 func (m *PieceRetrievalStream) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrievalStream.Unmarshal(m, b)
@@ -586,7 +734,7 @@ func (m *PieceDelete) Reset()         { *m = PieceDelete{} }
 func (m *PieceDelete) String() string { return proto.CompactTextString(m) }
 func (*PieceDelete) ProtoMessage()    {}
 func (*PieceDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{7}
+	return fileDescriptor_piecestore_75746a8536902839, []int{9}
 }
 func (m *PieceDelete) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceDelete.Unmarshal(m, b)
@@ -631,7 +779,7 @@ func (m *PieceDeleteSummary) Reset()         { *m = PieceDeleteSummary{} }
 func (m *PieceDeleteSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceDeleteSummary) ProtoMessage()    {}
 func (*PieceDeleteSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{8}
+	return fileDescriptor_piecestore_75746a8536902839, []int{10}
 }
 func (m *PieceDeleteSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceDeleteSummary.Unmarshal(m, b)
@@ -659,8 +807,13 @@ func (m *PieceDeleteSummary) GetMessage() string {
 }
 
 type PieceStoreSummary struct {
-	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	TotalReceived        int64    `protobuf:"varint,2,opt,name=total_received,json=totalReceived,proto3" json:"total_received,omitempty"`
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	TotalReceived int64  `protobuf:"varint,2,opt,name=total_received,json=totalReceived,proto3" json:"total_received,omitempty"`
+	// integrity are the integrity checks the storage node agreed to
+	Integrity *IntegrityOptions `protobuf:"bytes,3,opt,name=integrity,proto3" json:"integrity,omitempty"`
+	// corrupted is set when the upload stopped at a corrupted frame, the
+	// total_received bytes before it were verified and can be resumed from
+	Corrupted            bool     `protobuf:"varint,4,opt,name=corrupted,proto3" json:"corrupted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -670,7 +823,7 @@ func (m *PieceStoreSummary) Reset()         { *m = PieceStoreSummary{} }
 func (m *PieceStoreSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceStoreSummary) ProtoMessage()    {}
 func (*PieceStoreSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{9}
+	return fileDescriptor_piecestore_75746a8536902839, []int{11}
 }
 func (m *PieceStoreSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStoreSummary.Unmarshal(m, b)
@@ -704,6 +857,20 @@ func (m *PieceStoreSummary) GetTotalReceived() int64 {
 	return 0
 }
 
+func (m *PieceStoreSummary) GetIntegrity() *IntegrityOptions {
+	if m != nil {
+		return m.Integrity
+	}
+	return nil
+}
+
+func (m *PieceStoreSummary) GetCorrupted() bool {
+	if m != nil {
+		return m.Corrupted
+	}
+	return false
+}
+
 type StatsReq struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -714,7 +881,7 @@ func (m *StatsReq) Reset()         { *m = StatsReq{} }
 func (m *StatsReq) String() string { return proto.CompactTextString(m) }
 func (*StatsReq) ProtoMessage()    {}
 func (*StatsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{10}
+	return fileDescriptor_piecestore_75746a8536902839, []int{12}
 }
 func (m *StatsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsReq.Unmarshal(m, b)
@@ -735,20 +902,24 @@ func (m *StatsReq) XXX_DiscardUnknown() {
 var xxx_messageInfo_StatsReq proto.InternalMessageInfo
 
 type StatSummary struct {
-	UsedSpace            int64    `protobuf:"varint,1,opt,name=used_space,json=usedSpace,proto3" json:"used_space,omitempty"`
-	AvailableSpace       int64    `protobuf:"varint,2,opt,name=available_space,json=availableSpace,proto3" json:"available_space,omitempty"`
-	UsedBandwidth        int64    `protobuf:"varint,3,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
-	AvailableBandwidth   int64    `protobuf:"varint,4,opt,name=available_bandwidth,json=availableBandwidth,proto3" json:"available_bandwidth,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UsedSpace          int64 `protobuf:"varint,1,opt,name=used_space,json=usedSpace,proto3" json:"used_space,omitempty"`
+	AvailableSpace     int64 `protobuf:"varint,2,opt,name=available_space,json=availableSpace,proto3" json:"available_space,omitempty"`
+	UsedBandwidth      int64 `protobuf:"varint,3,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
+	AvailableBandwidth int64 `protobuf:"varint,4,opt,name=available_bandwidth,json=availableBandwidth,proto3" json:"available_bandwidth,omitempty"`
+	UsedPieces         int64 `protobuf:"varint,5,opt,name=used_pieces,json=usedPieces,proto3" json:"used_pieces,omitempty"`
+	// used_space_reconciled_unix_sec is when the used space was last reconciled
+	// with the pieces on disk, zero when it never was
+	UsedSpaceReconciledUnixSec int64    `protobuf:"varint,6,opt,name=used_space_reconciled_unix_sec,json=usedSpaceReconciledUnixSec,proto3" json:"used_space_reconciled_unix_sec,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
 }
 
 func (m *StatSummary) Reset()         { *m = StatSummary{} }
 func (m *StatSummary) String() string { return proto.CompactTextString(m) }
 func (*StatSummary) ProtoMessage()    {}
 func (*StatSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{11}
+	return fileDescriptor_piecestore_75746a8536902839, []int{13}
 }
 func (m *StatSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummary.Unmarshal(m, b)
@@ -796,6 +967,20 @@ func (m *StatSummary) GetAvailableBandwidth() int64 {
 	return 0
 }
 
+func (m *StatSummary) GetUsedPieces() int64 {
+	if m != nil {
+		return m.UsedPieces
+	}
+	return 0
+}
+
+func (m *StatSummary) GetUsedSpaceReconciledUnixSec() int64 {
+	if m != nil {
+		return m.UsedSpaceReconciledUnixSec
+	}
+	return 0
+}
+
 type SignedMessage struct {
 	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
@@ -809,7 +994,7 @@ func (m *SignedMessage) Reset()         { *m = SignedMessage{} }
 func (m *SignedMessage) String() string { return proto.CompactTextString(m) }
 func (*SignedMessage) ProtoMessage()    {}
 func (*SignedMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{12}
+	return fileDescriptor_piecestore_75746a8536902839, []int{14}
 }
 func (m *SignedMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignedMessage.Unmarshal(m, b)
@@ -860,7 +1045,7 @@ func (m *DashboardReq) Reset()         { *m = DashboardReq{} }
 func (m *DashboardReq) String() string { return proto.CompactTextString(m) }
 func (*DashboardReq) ProtoMessage()    {}
 func (*DashboardReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{13}
+	return fileDescriptor_piecestore_75746a8536902839, []int{15}
 }
 func (m *DashboardReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardReq.Unmarshal(m, b)
@@ -889,6 +1074,7 @@ type DashboardStats struct {
 	Stats                *StatSummary       `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
 	Connection           bool               `protobuf:"varint,7,opt,name=connection,proto3" json:"connection,omitempty"`
 	Uptime               *duration.Duration `protobuf:"bytes,8,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	DiskHealth           *DiskHealth        `protobuf:"bytes,9,opt,name=disk_health,json=diskHealth,proto3" json:"disk_health,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -898,7 +1084,7 @@ func (m *DashboardStats) Reset()         { *m = DashboardStats{} }
 func (m *DashboardStats) String() string { return proto.CompactTextString(m) }
 func (*DashboardStats) ProtoMessage()    {}
 func (*DashboardStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{14}
+	return fileDescriptor_piecestore_75746a8536902839, []int{16}
 }
 func (m *DashboardStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardStats.Unmarshal(m, b)
@@ -974,11 +1160,386 @@ func (m *DashboardStats) GetUptime() *duration.Duration {
 	return nil
 }
 
+func (m *DashboardStats) GetDiskHealth() *DiskHealth {
+	if m != nil {
+		return m.DiskHealth
+	}
+	return nil
+}
+
+type DiskHealth struct {
+	// passed is the overall SMART self-assessment of the disk
+	Passed               bool  `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	ReallocatedSectors   int64 `protobuf:"varint,2,opt,name=reallocated_sectors,json=reallocatedSectors,proto3" json:"reallocated_sectors,omitempty"`
+	PendingSectors       int64 `protobuf:"varint,3,opt,name=pending_sectors,json=pendingSectors,proto3" json:"pending_sectors,omitempty"`
+	UncorrectableSectors int64 `protobuf:"varint,4,opt,name=uncorrectable_sectors,json=uncorrectableSectors,proto3" json:"uncorrectable_sectors,omitempty"`
+	// temperature is in degrees celsius, 0 when unknown
+	Temperature int64    `protobuf:"varint,5,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Warnings    []string `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// ingress_stopped is true when uploads are rejected since the disk is failing
+	IngressStopped       bool     `protobuf:"varint,7,opt,name=ingress_stopped,json=ingressStopped,proto3" json:"ingress_stopped,omitempty"`
+	CheckedUnixSec       int64    `protobuf:"varint,8,opt,name=checked_unix_sec,json=checkedUnixSec,proto3" json:"checked_unix_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiskHealth) Reset()         { *m = DiskHealth{} }
+func (m *DiskHealth) String() string { return proto.CompactTextString(m) }
+func (*DiskHealth) ProtoMessage()    {}
+func (*DiskHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_75746a8536902839, []int{17}
+}
+func (m *DiskHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiskHealth.Unmarshal(m, b)
+}
+func (m *DiskHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiskHealth.Marshal(b, m, deterministic)
+}
+func (dst *DiskHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskHealth.Merge(dst, src)
+}
+func (m *DiskHealth) XXX_Size() int {
+	return xxx_messageInfo_DiskHealth.Size(m)
+}
+func (m *DiskHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskHealth proto.InternalMessageInfo
+
+func (m *DiskHealth) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *DiskHealth) GetReallocatedSectors() int64 {
+	if m != nil {
+		return m.ReallocatedSectors
+	}
+	return 0
+}
+
+func (m *DiskHealth) GetPendingSectors() int64 {
+	if m != nil {
+		return m.PendingSectors
+	}
+	return 0
+}
+
+func (m *DiskHealth) GetUncorrectableSectors() int64 {
+	if m != nil {
+		return m.UncorrectableSectors
+	}
+	return 0
+}
+
+func (m *DiskHealth) GetTemperature() int64 {
+	if m != nil {
+		return m.Temperature
+	}
+	return 0
+}
+
+func (m *DiskHealth) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *DiskHealth) GetIngressStopped() bool {
+	if m != nil {
+		return m.IngressStopped
+	}
+	return false
+}
+
+func (m *DiskHealth) GetCheckedUnixSec() int64 {
+	if m != nil {
+		return m.CheckedUnixSec
+	}
+	return 0
+}
+
+type RetainRequest struct {
+	// filter is a bloom filter of the pieces the satellite expects the node to hold
+	Filter []byte `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// only pieces stored before this time are garbage collected
+	CreationUnixSec      int64    `protobuf:"varint,2,opt,name=creation_unix_sec,json=creationUnixSec,proto3" json:"creation_unix_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainRequest) Reset()         { *m = RetainRequest{} }
+func (m *RetainRequest) String() string { return proto.CompactTextString(m) }
+func (*RetainRequest) ProtoMessage()    {}
+func (*RetainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_75746a8536902839, []int{18}
+}
+func (m *RetainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainRequest.Unmarshal(m, b)
+}
+func (m *RetainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainRequest.Marshal(b, m, deterministic)
+}
+func (dst *RetainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainRequest.Merge(dst, src)
+}
+func (m *RetainRequest) XXX_Size() int {
+	return xxx_messageInfo_RetainRequest.Size(m)
+}
+func (m *RetainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainRequest proto.InternalMessageInfo
+
+func (m *RetainRequest) GetFilter() []byte {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *RetainRequest) GetCreationUnixSec() int64 {
+	if m != nil {
+		return m.CreationUnixSec
+	}
+	return 0
+}
+
+type RetainResponse struct {
+	// trashed is the number of pieces moved to the trash
+	Trashed              int64    `protobuf:"varint,1,opt,name=trashed,proto3" json:"trashed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainResponse) Reset()         { *m = RetainResponse{} }
+func (m *RetainResponse) String() string { return proto.CompactTextString(m) }
+func (*RetainResponse) ProtoMessage()    {}
+func (*RetainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_75746a8536902839, []int{19}
+}
+func (m *RetainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainResponse.Unmarshal(m, b)
+}
+func (m *RetainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainResponse.Marshal(b, m, deterministic)
+}
+func (dst *RetainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainResponse.Merge(dst, src)
+}
+func (m *RetainResponse) XXX_Size() int {
+	return xxx_messageInfo_RetainResponse.Size(m)
+}
+func (m *RetainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainResponse proto.InternalMessageInfo
+
+func (m *RetainResponse) GetTrashed() int64 {
+	if m != nil {
+		return m.Trashed
+	}
+	return 0
+}
+
+type RestoreTrashRequest struct {
+	// only pieces trashed at or after this time are restored, 0 restores all of them
+	TrashedAfterUnixSec  int64    `protobuf:"varint,1,opt,name=trashed_after_unix_sec,json=trashedAfterUnixSec,proto3" json:"trashed_after_unix_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTrashRequest) Reset()         { *m = RestoreTrashRequest{} }
+func (m *RestoreTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashRequest) ProtoMessage()    {}
+func (*RestoreTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_75746a8536902839, []int{20}
+}
+func (m *RestoreTrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashRequest.Unmarshal(m, b)
+}
+func (m *RestoreTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashRequest.Marshal(b, m, deterministic)
+}
+func (dst *RestoreTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashRequest.Merge(dst, src)
+}
+func (m *RestoreTrashRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashRequest.Size(m)
+}
+func (m *RestoreTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTrashRequest proto.InternalMessageInfo
+
+func (m *RestoreTrashRequest) GetTrashedAfterUnixSec() int64 {
+	if m != nil {
+		return m.TrashedAfterUnixSec
+	}
+	return 0
+}
+
+type RestoreTrashResponse struct {
+	// restored is the number of pieces moved back from the trash
+	Restored             int64    `protobuf:"varint,1,opt,name=restored,proto3" json:"restored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTrashResponse) Reset()         { *m = RestoreTrashResponse{} }
+func (m *RestoreTrashResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreTrashResponse) ProtoMessage()    {}
+func (*RestoreTrashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_75746a8536902839, []int{21}
+}
+func (m *RestoreTrashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTrashResponse.Unmarshal(m, b)
+}
+func (m *RestoreTrashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTrashResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreTrashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTrashResponse.Merge(dst, src)
+}
+func (m *RestoreTrashResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreTrashResponse.Size(m)
+}
+func (m *RestoreTrashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTrashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTrashResponse proto.InternalMessageInfo
+
+func (m *RestoreTrashResponse) GetRestored() int64 {
+	if m != nil {
+		return m.Restored
+	}
+	return 0
+}
+
+// ReportStatsRequest is sent by a satellite to tell the node how it rates it
+type ReportStatsRequest struct {
+	AuditSuccessRatio float64 `protobuf:"fixed64,1,opt,name=audit_success_ratio,json=auditSuccessRatio,proto3" json:"audit_success_ratio,omitempty"`
+	UptimeRatio       float64 `protobuf:"fixed64,2,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+	// held_amount is held back from the payouts in the smallest unit of the payout currency
+	HeldAmount int64 `protobuf:"varint,3,opt,name=held_amount,json=heldAmount,proto3" json:"held_amount,omitempty"`
+	// minimum_version is the oldest version of the node the satellite accepts
+	MinimumVersion string `protobuf:"bytes,4,opt,name=minimum_version,json=minimumVersion,proto3" json:"minimum_version,omitempty"`
+	// sent_unix_sec is the time of the satellite when it sent the stats
+	SentUnixSec          int64    `protobuf:"varint,5,opt,name=sent_unix_sec,json=sentUnixSec,proto3" json:"sent_unix_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportStatsRequest) Reset()         { *m = ReportStatsRequest{} }
+func (m *ReportStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReportStatsRequest) ProtoMessage()    {}
+func (*ReportStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_75746a8536902839, []int{22}
+}
+func (m *ReportStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportStatsRequest.Unmarshal(m, b)
+}
+func (m *ReportStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReportStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportStatsRequest.Merge(dst, src)
+}
+func (m *ReportStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReportStatsRequest.Size(m)
+}
+func (m *ReportStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportStatsRequest proto.InternalMessageInfo
+
+func (m *ReportStatsRequest) GetAuditSuccessRatio() float64 {
+	if m != nil {
+		return m.AuditSuccessRatio
+	}
+	return 0
+}
+
+func (m *ReportStatsRequest) GetUptimeRatio() float64 {
+	if m != nil {
+		return m.UptimeRatio
+	}
+	return 0
+}
+
+func (m *ReportStatsRequest) GetHeldAmount() int64 {
+	if m != nil {
+		return m.HeldAmount
+	}
+	return 0
+}
+
+func (m *ReportStatsRequest) GetMinimumVersion() string {
+	if m != nil {
+		return m.MinimumVersion
+	}
+	return ""
+}
+
+func (m *ReportStatsRequest) GetSentUnixSec() int64 {
+	if m != nil {
+		return m.SentUnixSec
+	}
+	return 0
+}
+
+type ReportStatsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportStatsResponse) Reset()         { *m = ReportStatsResponse{} }
+func (m *ReportStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReportStatsResponse) ProtoMessage()    {}
+func (*ReportStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_75746a8536902839, []int{23}
+}
+func (m *ReportStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportStatsResponse.Unmarshal(m, b)
+}
+func (m *ReportStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReportStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportStatsResponse.Merge(dst, src)
+}
+func (m *ReportStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_ReportStatsResponse.Size(m)
+}
+func (m *ReportStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportStatsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*PayerBandwidthAllocation)(nil), "piecestoreroutes.PayerBandwidthAllocation")
 	proto.RegisterType((*RenterBandwidthAllocation)(nil), "piecestoreroutes.RenterBandwidthAllocation")
 	proto.RegisterType((*PieceStore)(nil), "piecestoreroutes.PieceStore")
 	proto.RegisterType((*PieceStore_PieceData)(nil), "piecestoreroutes.PieceStore.PieceData")
+	proto.RegisterType((*IntegrityOptions)(nil), "piecestoreroutes.IntegrityOptions")
+	proto.RegisterType((*IntegrityFrame)(nil), "piecestoreroutes.IntegrityFrame")
 	proto.RegisterType((*PieceId)(nil), "piecestoreroutes.PieceId")
 	proto.RegisterType((*PieceSummary)(nil), "piecestoreroutes.PieceSummary")
 	proto.RegisterType((*PieceRetrieval)(nil), "piecestoreroutes.PieceRetrieval")
@@ -992,7 +1553,15 @@ func init() {
 	proto.RegisterType((*SignedMessage)(nil), "piecestoreroutes.SignedMessage")
 	proto.RegisterType((*DashboardReq)(nil), "piecestoreroutes.DashboardReq")
 	proto.RegisterType((*DashboardStats)(nil), "piecestoreroutes.DashboardStats")
+	proto.RegisterType((*DiskHealth)(nil), "piecestoreroutes.DiskHealth")
+	proto.RegisterType((*RetainRequest)(nil), "piecestoreroutes.RetainRequest")
+	proto.RegisterType((*RetainResponse)(nil), "piecestoreroutes.RetainResponse")
+	proto.RegisterType((*RestoreTrashRequest)(nil), "piecestoreroutes.RestoreTrashRequest")
+	proto.RegisterType((*RestoreTrashResponse)(nil), "piecestoreroutes.RestoreTrashResponse")
+	proto.RegisterType((*ReportStatsRequest)(nil), "piecestoreroutes.ReportStatsRequest")
+	proto.RegisterType((*ReportStatsResponse)(nil), "piecestoreroutes.ReportStatsResponse")
 	proto.RegisterEnum("piecestoreroutes.BandwidthAction", BandwidthAction_name, BandwidthAction_value)
+	proto.RegisterEnum("piecestoreroutes.IntegrityCapability", IntegrityCapability_name, IntegrityCapability_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1013,6 +1582,9 @@ type PieceStoreRoutesClient interface {
 	Delete(ctx context.Context, in *PieceDelete, opts ...grpc.CallOption) (*PieceDeleteSummary, error)
 	Stats(ctx context.Context, in *StatsReq, opts ...grpc.CallOption) (*StatSummary, error)
 	Dashboard(ctx context.Context, in *DashboardReq, opts ...grpc.CallOption) (PieceStoreRoutes_DashboardClient, error)
+	Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error)
+	RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error)
+	ReportStats(ctx context.Context, in *ReportStatsRequest, opts ...grpc.CallOption) (*ReportStatsResponse, error)
 }
 
 type pieceStoreRoutesClient struct {
@@ -1147,6 +1719,33 @@ func (x *pieceStoreRoutesDashboardClient) Recv() (*DashboardStats, error) {
 	return m, nil
 }
 
+func (c *pieceStoreRoutesClient) Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error) {
+	out := new(RetainResponse)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/Retain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreRoutesClient) RestoreTrash(ctx context.Context, in *RestoreTrashRequest, opts ...grpc.CallOption) (*RestoreTrashResponse, error) {
+	out := new(RestoreTrashResponse)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/RestoreTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreRoutesClient) ReportStats(ctx context.Context, in *ReportStatsRequest, opts ...grpc.CallOption) (*ReportStatsResponse, error) {
+	out := new(ReportStatsResponse)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/ReportStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PieceStoreRoutesServer is the server API for PieceStoreRoutes service.
 type PieceStoreRoutesServer interface {
 	Piece(context.Context, *PieceId) (*PieceSummary, error)
@@ -1155,6 +1754,9 @@ type PieceStoreRoutesServer interface {
 	Delete(context.Context, *PieceDelete) (*PieceDeleteSummary, error)
 	Stats(context.Context, *StatsReq) (*StatSummary, error)
 	Dashboard(*DashboardReq, PieceStoreRoutes_DashboardServer) error
+	Retain(context.Context, *RetainRequest) (*RetainResponse, error)
+	RestoreTrash(context.Context, *RestoreTrashRequest) (*RestoreTrashResponse, error)
+	ReportStats(context.Context, *ReportStatsRequest) (*ReportStatsResponse, error)
 }
 
 func RegisterPieceStoreRoutesServer(s *grpc.Server, srv PieceStoreRoutesServer) {
@@ -1288,6 +1890,60 @@ func (x *pieceStoreRoutesDashboardServer) Send(m *DashboardStats) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _PieceStoreRoutes_Retain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).Retain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/Retain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).Retain(ctx, req.(*RetainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreRoutes_RestoreTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).RestoreTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/RestoreTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).RestoreTrash(ctx, req.(*RestoreTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreRoutes_ReportStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).ReportStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/ReportStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).ReportStats(ctx, req.(*ReportStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "piecestoreroutes.PieceStoreRoutes",
 	HandlerType: (*PieceStoreRoutesServer)(nil),
@@ -1304,6 +1960,18 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Stats",
 			Handler:    _PieceStoreRoutes_Stats_Handler,
 		},
+		{
+			MethodName: "Retain",
+			Handler:    _PieceStoreRoutes_Retain_Handler,
+		},
+		{
+			MethodName: "RestoreTrash",
+			Handler:    _PieceStoreRoutes_RestoreTrash_Handler,
+		},
+		{
+			MethodName: "ReportStats",
+			Handler:    _PieceStoreRoutes_ReportStats_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1326,81 +1994,122 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 	Metadata: "piecestore.proto",
 }
 
-func init() { proto.RegisterFile("piecestore.proto", fileDescriptor_piecestore_ef88e5b6fd61ab5b) }
-
-var fileDescriptor_piecestore_ef88e5b6fd61ab5b = []byte{
-	// 1159 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0xa9, 0xff, 0xd1, 0x6f, 0x36, 0x46, 0x2b, 0x0b, 0xb1, 0xad, 0x32, 0x4d, 0xaa, 0xda,
-	0x80, 0x9c, 0x38, 0x40, 0x81, 0x1e, 0xed, 0xca, 0x08, 0x84, 0xa2, 0x8e, 0xbb, 0xb2, 0x2f, 0x29,
-	0x50, 0x66, 0x45, 0x8e, 0x65, 0x22, 0x14, 0xa9, 0x92, 0x4b, 0x57, 0xf6, 0xb5, 0xcf, 0xd3, 0xf7,
-	0xe8, 0x13, 0xf4, 0xd0, 0x43, 0x8a, 0xbe, 0x40, 0x1f, 0xa0, 0xa7, 0x82, 0xbb, 0xfc, 0x91, 0xf5,
-	0x67, 0x20, 0x40, 0x6e, 0x9c, 0x6f, 0x3f, 0xce, 0xce, 0x7c, 0x33, 0x3b, 0xbb, 0xd0, 0x9c, 0x5a,
-	0x68, 0xa0, 0xcf, 0x5d, 0x0f, 0x7b, 0x53, 0xcf, 0xe5, 0x2e, 0x99, 0x43, 0x3c, 0x37, 0xe0, 0xe8,
-	0xb7, 0x61, 0xec, 0x8e, 0x5d, 0xb9, 0xda, 0xde, 0x1d, 0xbb, 0xee, 0xd8, 0xc6, 0x43, 0x61, 0x8d,
-	0x82, 0xab, 0x43, 0x33, 0xf0, 0x18, 0xb7, 0x5c, 0x47, 0xae, 0x6b, 0xbf, 0x65, 0xa1, 0x75, 0xce,
-	0x6e, 0xd1, 0x3b, 0x61, 0x8e, 0xf9, 0xab, 0x65, 0xf2, 0xeb, 0x63, 0xdb, 0x76, 0x0d, 0x41, 0x21,
-	0x2f, 0xa1, 0xea, 0x33, 0x8e, 0xb6, 0x6d, 0x71, 0xd4, 0x2d, 0xb3, 0xa5, 0x74, 0x94, 0x6e, 0xf5,
-	0xa4, 0xfe, 0xc7, 0x87, 0xbd, 0xcc, 0x5f, 0x1f, 0xf6, 0x0a, 0x67, 0xae, 0x89, 0x83, 0x3e, 0xad,
-	0x24, 0x9c, 0x81, 0x49, 0x0e, 0xa0, 0x1c, 0x4c, 0x6d, 0xcb, 0x79, 0x1f, 0xf2, 0xd5, 0x95, 0xfc,
-	0x92, 0x24, 0x0c, 0x4c, 0xb2, 0x0d, 0xa5, 0x09, 0x9b, 0xe9, 0xbe, 0x75, 0x87, 0xad, 0x6c, 0x47,
-	0xe9, 0x66, 0x69, 0x71, 0xc2, 0x66, 0x43, 0xeb, 0x0e, 0x49, 0x0f, 0x1e, 0xe3, 0x6c, 0x6a, 0xc9,
-	0x58, 0xf5, 0xc0, 0xb1, 0x66, 0xba, 0x8f, 0x46, 0x2b, 0x27, 0x58, 0x8f, 0xd2, 0xa5, 0x4b, 0xc7,
-	0x9a, 0x0d, 0xd1, 0x20, 0x4f, 0xa1, 0xe6, 0xa3, 0x67, 0x31, 0x5b, 0x77, 0x82, 0xc9, 0x08, 0xbd,
-	0x56, 0xbe, 0xa3, 0x74, 0xcb, 0xb4, 0x2a, 0xc1, 0x33, 0x81, 0x91, 0x6f, 0xa1, 0xc0, 0x8c, 0xf0,
-	0xaf, 0x56, 0xa1, 0xa3, 0x74, 0xeb, 0x47, 0x5f, 0xf4, 0x16, 0xb5, 0xeb, 0xa5, 0x32, 0x08, 0x22,
-	0x8d, 0x7e, 0x20, 0x5d, 0x68, 0x1a, 0x1e, 0x32, 0x8e, 0x66, 0x1a, 0x4c, 0x51, 0x04, 0x53, 0x8f,
-	0xf0, 0x38, 0x92, 0x2d, 0xc8, 0x1b, 0xe8, 0x71, 0xbf, 0x55, 0xea, 0x64, 0xbb, 0x55, 0x2a, 0x0d,
-	0xf2, 0x04, 0xca, 0xbe, 0x35, 0x76, 0x18, 0x0f, 0x3c, 0x6c, 0x95, 0x43, 0x5d, 0x68, 0x0a, 0x68,
-	0xff, 0x29, 0xb0, 0x4d, 0xd1, 0xe1, 0xab, 0xcb, 0xf0, 0x13, 0x34, 0xa7, 0x61, 0x89, 0x74, 0x96,
-	0x60, 0xa2, 0x14, 0x95, 0xa3, 0xfd, 0xe5, 0x04, 0xd6, 0x15, 0xf3, 0x24, 0x17, 0x96, 0x81, 0x36,
-	0x84, 0xa7, 0x39, 0xe7, 0x5b, 0x90, 0xe7, 0x2e, 0x67, 0xb6, 0x28, 0x56, 0x96, 0x4a, 0x83, 0x7c,
-	0x03, 0x8d, 0xd0, 0x29, 0x1b, 0xa3, 0xee, 0xb8, 0xa6, 0x28, 0x7e, 0x76, 0x65, 0x31, 0x6b, 0x11,
-	0x4d, 0x98, 0x66, 0x9a, 0x7c, 0x6e, 0x6d, 0xf2, 0xf9, 0xc5, 0xe4, 0xff, 0x51, 0x01, 0xce, 0xc3,
-	0x34, 0x86, 0x61, 0x1a, 0xe4, 0x67, 0xd8, 0x1a, 0xc5, 0xe1, 0x2f, 0x67, 0x7c, 0xb0, 0x9c, 0xf1,
-	0x5a, 0xe1, 0xe8, 0xe3, 0xd1, 0x0a, 0x35, 0x4f, 0x01, 0x84, 0x0b, 0xdd, 0x64, 0x9c, 0x89, 0xac,
-	0x2b, 0x47, 0xcf, 0x57, 0xe8, 0x98, 0x44, 0x24, 0x3f, 0xfb, 0x8c, 0x33, 0x5a, 0x9e, 0xc6, 0x9f,
-	0xe4, 0x14, 0x6a, 0x2c, 0xe0, 0xd7, 0xae, 0x67, 0xdd, 0xc9, 0xf8, 0xb2, 0xc2, 0xd3, 0xde, 0xb2,
-	0xa7, 0xa1, 0x35, 0x76, 0xd0, 0xfc, 0x01, 0x7d, 0x9f, 0x8d, 0x91, 0xde, 0xff, 0xab, 0x8d, 0x50,
-	0x4e, 0xdc, 0x93, 0x3a, 0xa8, 0xd1, 0x29, 0x2b, 0x53, 0xd5, 0x32, 0xd7, 0x1d, 0x02, 0x75, 0xdd,
-	0x21, 0x68, 0x41, 0xd1, 0x70, 0x1d, 0x8e, 0x0e, 0x97, 0xd5, 0xa2, 0xb1, 0xa9, 0xbd, 0x83, 0xa2,
-	0xd8, 0x66, 0x60, 0x2e, 0x6d, 0xb2, 0x94, 0x88, 0xfa, 0x31, 0x89, 0x68, 0x13, 0xa8, 0x4a, 0xc9,
-	0x82, 0xc9, 0x84, 0x79, 0xb7, 0x4b, 0xdb, 0xec, 0xc4, 0xb2, 0x8b, 0xd3, 0x2e, 0x53, 0x90, 0x72,
-	0x6e, 0x3a, 0xef, 0xd9, 0x35, 0xa9, 0x6a, 0x7f, 0xaa, 0x50, 0x17, 0xfb, 0x51, 0xe4, 0x9e, 0x85,
-	0x37, 0xcc, 0xfe, 0xe4, 0x8d, 0x33, 0x58, 0xd1, 0x38, 0xfb, 0x6b, 0x1a, 0x27, 0x89, 0xea, 0x93,
-	0x36, 0x0f, 0xdd, 0xd4, 0x3c, 0x0f, 0x08, 0xfe, 0x19, 0x14, 0xdc, 0xab, 0x2b, 0x1f, 0x79, 0xa4,
-	0x71, 0x64, 0x69, 0x6f, 0x60, 0xeb, 0x7e, 0x06, 0x43, 0xee, 0x21, 0x9b, 0x2c, 0xb8, 0x53, 0x16,
-	0xdd, 0xcd, 0xb5, 0x9e, 0x7a, 0xbf, 0xf5, 0x4c, 0xa8, 0xc8, 0x20, 0xd1, 0x46, 0x8e, 0x0f, 0xb7,
-	0xdf, 0x47, 0x49, 0xa1, 0xf5, 0x80, 0xcc, 0xed, 0x12, 0x37, 0x61, 0x0b, 0x8a, 0x13, 0xc9, 0x8f,
-	0x76, 0x8c, 0x4d, 0xed, 0x02, 0x1e, 0xa5, 0x27, 0xfc, 0x41, 0x3a, 0x79, 0x06, 0x75, 0x31, 0x18,
-	0x75, 0x0f, 0x0d, 0xb4, 0x6e, 0xd0, 0x8c, 0x04, 0xad, 0x09, 0x94, 0x46, 0xa0, 0x06, 0x50, 0x1a,
-	0x72, 0xc6, 0x7d, 0x8a, 0xbf, 0x68, 0xbf, 0x2b, 0x50, 0x09, 0x8d, 0xd8, 0xf9, 0x0e, 0x40, 0xe0,
-	0xa3, 0xa9, 0xfb, 0x53, 0x66, 0x24, 0x02, 0x86, 0xc8, 0x30, 0x04, 0xc8, 0x57, 0xd0, 0x60, 0x37,
-	0xcc, 0xb2, 0xd9, 0xc8, 0xc6, 0x88, 0x23, 0xb7, 0xa8, 0x27, 0xb0, 0x24, 0x3e, 0x83, 0xba, 0xf0,
-	0x93, 0xb4, 0x68, 0x54, 0xc0, 0x5a, 0x88, 0x26, 0xcd, 0x4c, 0x0e, 0xe1, 0x71, 0xea, 0x2f, 0xe5,
-	0xca, 0x0b, 0x94, 0x24, 0x4b, 0xc9, 0x0f, 0xda, 0x3b, 0xa8, 0xdd, 0x53, 0x98, 0x10, 0xc8, 0x89,
-	0x4e, 0x17, 0xb7, 0x3e, 0x15, 0xdf, 0xf7, 0x27, 0xb9, 0xba, 0x30, 0xc9, 0x45, 0x8f, 0x04, 0x23,
-	0xdb, 0x32, 0xf4, 0xf7, 0x78, 0x1b, 0x8d, 0xa0, 0xb2, 0x44, 0xbe, 0xc7, 0x5b, 0xad, 0x0e, 0xd5,
-	0x3e, 0xf3, 0xaf, 0x47, 0x2e, 0xf3, 0xcc, 0x50, 0xa1, 0xbf, 0x55, 0xa8, 0x27, 0x80, 0xd0, 0x8d,
-	0x7c, 0x0e, 0xc5, 0xf8, 0xbe, 0x91, 0x15, 0x28, 0x38, 0xf2, 0x62, 0xf9, 0x1a, 0x9a, 0x62, 0xc1,
-	0x70, 0x1d, 0x07, 0xc5, 0x95, 0xec, 0x47, 0xfa, 0x34, 0x42, 0xfc, 0xbb, 0x14, 0x26, 0x07, 0xf0,
-	0x68, 0xe4, 0xba, 0xdc, 0xe7, 0x1e, 0x9b, 0xea, 0xcc, 0x34, 0x3d, 0xf4, 0x7d, 0x11, 0x4c, 0x99,
-	0x36, 0x93, 0x85, 0x63, 0x89, 0x87, 0x7e, 0xad, 0x70, 0x0a, 0x38, 0xcc, 0x4e, 0xb8, 0x39, 0xc1,
-	0x6d, 0xc4, 0xf8, 0x1c, 0x15, 0x67, 0x0b, 0x54, 0xf9, 0xca, 0x68, 0xc4, 0x78, 0x4c, 0x7d, 0x05,
-	0x79, 0x3f, 0xcc, 0x47, 0xbc, 0x33, 0x2a, 0x47, 0x3b, 0x2b, 0x9a, 0x39, 0xed, 0x0c, 0x2a, 0xb9,
-	0x64, 0x17, 0x20, 0xcd, 0x4e, 0x3c, 0x2e, 0x4a, 0x74, 0x0e, 0x21, 0x2f, 0xa1, 0x10, 0x4c, 0xb9,
-	0x35, 0xc1, 0x56, 0x49, 0x78, 0xdd, 0xee, 0xc9, 0xb7, 0x5d, 0x2f, 0x7e, 0xdb, 0xf5, 0xfa, 0xd1,
-	0xdb, 0x8e, 0x46, 0xc4, 0x7d, 0x0a, 0x8d, 0x85, 0x07, 0x0d, 0x29, 0x42, 0xf6, 0xfc, 0xf2, 0xa2,
-	0x99, 0x09, 0x3f, 0x5e, 0x9f, 0x5e, 0x34, 0x15, 0x52, 0x83, 0xf2, 0xeb, 0xd3, 0x0b, 0xfd, 0xf8,
-	0xb2, 0x3f, 0xb8, 0x68, 0xaa, 0xa4, 0x0e, 0x10, 0x9a, 0xf4, 0xf4, 0xfc, 0x78, 0x40, 0x9b, 0xd9,
-	0xd0, 0x3e, 0xbf, 0x4c, 0xec, 0xdc, 0xd1, 0xbf, 0x59, 0x68, 0xa6, 0x47, 0x87, 0x8a, 0x74, 0x48,
-	0x1f, 0xf2, 0x02, 0x23, 0xdb, 0x6b, 0x06, 0xe2, 0xc0, 0x6c, 0xef, 0xae, 0xbb, 0x64, 0xa5, 0x0c,
-	0x5a, 0x86, 0xbc, 0x85, 0x52, 0x34, 0x76, 0x90, 0x74, 0x1e, 0x9a, 0xac, 0xed, 0xe7, 0x0f, 0x31,
-	0xe4, 0xe4, 0xd2, 0x32, 0x5d, 0xe5, 0x85, 0x42, 0xce, 0x20, 0x2f, 0xdf, 0x17, 0x4f, 0x36, 0xdd,
-	0xf5, 0xed, 0xa7, 0x9b, 0x56, 0x93, 0x48, 0xbb, 0x0a, 0x79, 0x03, 0x85, 0x68, 0xa2, 0xed, 0xac,
-	0xf9, 0x45, 0x2e, 0xb7, 0xbf, 0xdc, 0xb8, 0x9c, 0x26, 0xdf, 0x0f, 0x03, 0x0c, 0xfb, 0xa0, 0xbd,
-	0xba, 0x5b, 0xc2, 0xa1, 0xd2, 0xde, 0xdc, 0x49, 0x5a, 0x86, 0xfc, 0x08, 0xe5, 0xe4, 0x48, 0x91,
-	0x15, 0x8a, 0xcf, 0x1f, 0xc0, 0x76, 0x67, 0xc3, 0xba, 0xd8, 0x52, 0xcb, 0xbc, 0x50, 0x4e, 0x72,
-	0x6f, 0xd5, 0xe9, 0x68, 0x54, 0x10, 0x5d, 0xf6, 0xea, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7f,
-	0x14, 0x59, 0xb7, 0x81, 0x0c, 0x00, 0x00,
+func init() { proto.RegisterFile("piecestore.proto", fileDescriptor_piecestore_75746a8536902839) }
+
+var fileDescriptor_piecestore_75746a8536902839 = []byte{
+	// 1815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x57, 0x49, 0x6f, 0x23, 0xd5,
+	0x16, 0x8e, 0x5d, 0x89, 0x63, 0x1f, 0x0f, 0x71, 0x6e, 0x02, 0xb8, 0x4d, 0x77, 0x27, 0x5d, 0xd0,
+	0xbc, 0xbc, 0x20, 0xa5, 0xdf, 0x73, 0x24, 0x24, 0x16, 0x48, 0x64, 0x02, 0xcc, 0x53, 0xa7, 0xfb,
+	0x5d, 0x27, 0x48, 0x0c, 0xa2, 0xb8, 0xae, 0xba, 0x49, 0x4a, 0x6d, 0x57, 0x15, 0x35, 0x34, 0x1d,
+	0xa4, 0xb7, 0x7a, 0xff, 0x80, 0x1f, 0xc0, 0xaf, 0xe0, 0x27, 0xb0, 0xe0, 0x0f, 0xc0, 0x82, 0x05,
+	0x7f, 0x82, 0x1d, 0x62, 0xc1, 0xb9, 0x43, 0x0d, 0x9e, 0x12, 0xa9, 0x25, 0x56, 0xf6, 0x39, 0xe7,
+	0xbb, 0xe7, 0x9e, 0xf9, 0x9e, 0x82, 0x76, 0xe0, 0x72, 0x9b, 0x47, 0xb1, 0x1f, 0xf2, 0xbd, 0x20,
+	0xf4, 0x63, 0x9f, 0x14, 0x38, 0xa1, 0x9f, 0xc4, 0x3c, 0xea, 0xc2, 0xa5, 0x7f, 0xe9, 0x2b, 0x69,
+	0xf7, 0xfe, 0xa5, 0xef, 0x5f, 0x8e, 0xf8, 0x23, 0x49, 0x0d, 0x93, 0x8b, 0x47, 0x4e, 0x12, 0xb2,
+	0xd8, 0xf5, 0x3d, 0x25, 0x37, 0xff, 0x6f, 0x40, 0xe7, 0x29, 0xbb, 0xe6, 0xe1, 0x21, 0xf3, 0x9c,
+	0x6f, 0x5c, 0x27, 0xbe, 0x3a, 0x18, 0x8d, 0x7c, 0x5b, 0x42, 0xc8, 0xbf, 0xa1, 0x11, 0xb1, 0x98,
+	0x8f, 0x46, 0x6e, 0xcc, 0x2d, 0xd7, 0xe9, 0x94, 0xb6, 0x4b, 0x3b, 0x8d, 0xc3, 0xd6, 0x4f, 0xbf,
+	0x6d, 0x2d, 0xfd, 0xfa, 0xdb, 0x56, 0xe5, 0xd4, 0x77, 0x78, 0xff, 0x98, 0xd6, 0x33, 0x4c, 0xdf,
+	0x21, 0x6f, 0x43, 0x2d, 0x09, 0x46, 0xae, 0xf7, 0x4c, 0xe0, 0xcb, 0x73, 0xf1, 0x55, 0x05, 0x40,
+	0xf0, 0x1d, 0xa8, 0x8e, 0xd9, 0x0b, 0x2b, 0x72, 0xbf, 0xe5, 0x1d, 0x03, 0xb1, 0x06, 0x5d, 0x45,
+	0x7a, 0x80, 0x24, 0xd9, 0x83, 0x0d, 0xfe, 0x22, 0x70, 0x95, 0xad, 0x56, 0xe2, 0xb9, 0x08, 0xe3,
+	0x76, 0x67, 0x59, 0xa2, 0xd6, 0x73, 0xd1, 0x39, 0x4a, 0x06, 0xdc, 0x26, 0x6f, 0x40, 0x33, 0xe2,
+	0xa1, 0xcb, 0x46, 0x96, 0x97, 0x8c, 0x87, 0x3c, 0xec, 0xac, 0x20, 0xb2, 0x46, 0x1b, 0x8a, 0x79,
+	0x2a, 0x79, 0xe4, 0x5d, 0xa8, 0x30, 0x5b, 0x9c, 0xea, 0x54, 0x50, 0xda, 0xea, 0x3d, 0xd8, 0x9b,
+	0x8e, 0xdd, 0x5e, 0x1e, 0x06, 0x09, 0xa4, 0xfa, 0x00, 0xd9, 0x81, 0xb6, 0x1d, 0x72, 0x74, 0xd4,
+	0xc9, 0x8d, 0x59, 0x95, 0xc6, 0xb4, 0x34, 0x3f, 0xb5, 0x64, 0x13, 0x56, 0x6c, 0x1e, 0xc6, 0x51,
+	0xa7, 0xba, 0x6d, 0xec, 0x34, 0xa8, 0x22, 0xc8, 0x5d, 0xa8, 0x45, 0xee, 0xa5, 0xc7, 0xe2, 0x24,
+	0xe4, 0x9d, 0x9a, 0x88, 0x0b, 0xcd, 0x19, 0xe6, 0x1f, 0x25, 0xb8, 0x43, 0xb9, 0x17, 0xcf, 0x4f,
+	0xc3, 0xe7, 0xd0, 0x0e, 0x44, 0x8a, 0x2c, 0x96, 0xf1, 0x64, 0x2a, 0xea, 0xbd, 0xdd, 0x59, 0x07,
+	0x16, 0x25, 0xf3, 0x70, 0x59, 0xa4, 0x81, 0xae, 0x49, 0x4d, 0x05, 0xe5, 0x68, 0x6e, 0xec, 0xc7,
+	0x6c, 0x24, 0x93, 0x65, 0x50, 0x45, 0x90, 0x77, 0x60, 0x4d, 0x28, 0x65, 0x97, 0xdc, 0xf2, 0x30,
+	0x6b, 0x22, 0x99, 0xc6, 0xdc, 0x64, 0x36, 0x35, 0x4c, 0x92, 0x4e, 0xee, 0xfc, 0xf2, 0x42, 0xe7,
+	0x57, 0xa6, 0x9d, 0xff, 0xdd, 0x00, 0x78, 0x2a, 0xdc, 0x18, 0x08, 0x37, 0xc8, 0x97, 0xb0, 0x39,
+	0x4c, 0xcd, 0x9f, 0xf5, 0xf8, 0xed, 0x59, 0x8f, 0x17, 0x06, 0x8e, 0x6e, 0x0c, 0xe7, 0x44, 0xf3,
+	0x04, 0x40, 0xaa, 0xb0, 0x1c, 0x16, 0x33, 0xe9, 0x75, 0xbd, 0xf7, 0xd6, 0x9c, 0x38, 0x66, 0x16,
+	0xa9, 0xbf, 0xc7, 0x88, 0xa6, 0xb5, 0x20, 0xfd, 0x8b, 0x6a, 0x9a, 0x2c, 0x89, 0xaf, 0xfc, 0xd0,
+	0xfd, 0x56, 0xd9, 0x67, 0x48, 0x4d, 0x5b, 0xb3, 0x9a, 0x06, 0xe8, 0x29, 0x77, 0x1e, 0xf3, 0x28,
+	0xc2, 0x38, 0xd1, 0xc9, 0x53, 0xe4, 0x7d, 0xa8, 0xb9, 0x68, 0xfe, 0x65, 0xe8, 0xc6, 0xd7, 0xb2,
+	0xba, 0xeb, 0x3d, 0x73, 0x56, 0x45, 0x3f, 0x85, 0x3c, 0x09, 0xc4, 0xa9, 0x88, 0xe6, 0x87, 0x30,
+	0x55, 0x2b, 0x17, 0x21, 0x1b, 0xab, 0xc0, 0xd6, 0x7b, 0xdb, 0x37, 0x9c, 0xfe, 0x40, 0xe0, 0xa8,
+	0x82, 0x77, 0xff, 0x07, 0xb5, 0xcc, 0x31, 0xd2, 0x82, 0xb2, 0xee, 0xef, 0x1a, 0xc5, 0x7f, 0x8b,
+	0xda, 0xaf, 0xbc, 0xa8, 0xfd, 0x3a, 0xb0, 0x6a, 0xfb, 0x78, 0x8d, 0x17, 0xab, 0x3a, 0xa1, 0x29,
+	0x49, 0x5e, 0x85, 0x8a, 0x7f, 0x71, 0x11, 0xf1, 0x58, 0xf7, 0xae, 0xa6, 0xcc, 0x73, 0x68, 0x4f,
+	0x7b, 0x45, 0x4c, 0x68, 0xd8, 0x2c, 0x60, 0x43, 0x17, 0x87, 0x89, 0xcb, 0x23, 0x69, 0x4f, 0x93,
+	0x4e, 0xf0, 0xc8, 0x3d, 0x00, 0x69, 0xbf, 0x9a, 0x1a, 0xca, 0xa0, 0x9a, 0xe4, 0x88, 0xb9, 0x61,
+	0xbe, 0x0f, 0xad, 0x49, 0x77, 0x0b, 0x06, 0x94, 0x8a, 0x06, 0x08, 0xbe, 0x1d, 0xda, 0xfb, 0x3d,
+	0xe5, 0x55, 0x93, 0x6a, 0xca, 0xfc, 0x0a, 0x56, 0x65, 0x5c, 0xb0, 0x9a, 0xa7, 0xa3, 0x32, 0x93,
+	0xf3, 0xf2, 0xcb, 0xe4, 0xdc, 0x1c, 0x43, 0x43, 0x55, 0x57, 0x32, 0x1e, 0xb3, 0xf0, 0x7a, 0xe6,
+	0x9a, 0x7b, 0x69, 0x85, 0x16, 0x5d, 0x94, 0x9c, 0x9b, 0x46, 0xa3, 0xb1, 0x20, 0x37, 0xe6, 0x2f,
+	0x65, 0x68, 0xc9, 0xfb, 0x28, 0x8f, 0x43, 0x97, 0x3f, 0xc7, 0xf6, 0xfe, 0xbb, 0x7b, 0xac, 0x3f,
+	0xa7, 0xc7, 0x76, 0x17, 0xf4, 0x58, 0x66, 0xd5, 0xdf, 0xd9, 0x67, 0x5d, 0x7a, 0x53, 0xb5, 0xdf,
+	0x12, 0xf0, 0xbc, 0x82, 0x8c, 0x89, 0x12, 0x7e, 0x02, 0x9b, 0x93, 0x1e, 0x0c, 0x62, 0x7c, 0x0a,
+	0xc6, 0x53, 0xea, 0x4a, 0xd3, 0xea, 0x0a, 0xbd, 0x52, 0x9e, 0xe8, 0x15, 0xd3, 0x81, 0xba, 0x32,
+	0x92, 0x8f, 0x78, 0xcc, 0x6f, 0x2f, 0xbf, 0x97, 0x0a, 0x85, 0xb9, 0x07, 0xa4, 0x70, 0x4b, 0x5a,
+	0x84, 0x68, 0xd5, 0x58, 0xe1, 0xf5, 0x8d, 0x29, 0x69, 0xfe, 0x50, 0x82, 0xf5, 0x7c, 0x1a, 0xde,
+	0x8a, 0x27, 0x0f, 0xa1, 0x25, 0x1f, 0x11, 0x2b, 0xc4, 0x33, 0xee, 0x73, 0xee, 0xe8, 0x88, 0x36,
+	0x25, 0x97, 0x6a, 0xe6, 0xe4, 0xe4, 0x33, 0x5e, 0x66, 0xf2, 0xe1, 0xb3, 0x62, 0xfb, 0x61, 0x98,
+	0x04, 0xf8, 0xfa, 0xca, 0xe9, 0x52, 0xa5, 0x39, 0xc3, 0x04, 0xa8, 0x0e, 0x62, 0x16, 0x47, 0x94,
+	0x7f, 0x6d, 0x7e, 0x57, 0x86, 0xba, 0x20, 0x52, 0xe3, 0x31, 0x43, 0x49, 0x84, 0x4f, 0x79, 0x14,
+	0x30, 0x3b, 0xcb, 0x90, 0xe0, 0x0c, 0x04, 0x83, 0xfc, 0x03, 0xd6, 0xd8, 0x73, 0xe6, 0x8e, 0xd8,
+	0x70, 0xc4, 0x35, 0x46, 0xb9, 0xd0, 0xca, 0xd8, 0x0a, 0x88, 0xae, 0x4a, 0x3d, 0x59, 0x0f, 0xe8,
+	0x0a, 0x69, 0x0a, 0x6e, 0xd6, 0x2d, 0xe4, 0x11, 0x6c, 0xe4, 0xfa, 0x72, 0xac, 0x1a, 0x88, 0x24,
+	0x13, 0xe5, 0x07, 0xb6, 0xa0, 0x2e, 0xf5, 0xaa, 0x70, 0xc8, 0xc9, 0x6e, 0x50, 0x69, 0xb2, 0x4c,
+	0x44, 0x44, 0x0e, 0xe1, 0x7e, 0xee, 0x80, 0x08, 0xb4, 0xef, 0xd9, 0xee, 0xa8, 0xb8, 0x9c, 0x54,
+	0xe4, 0x99, 0x6e, 0xe6, 0x14, 0xcd, 0x30, 0xe9, 0x5c, 0xf8, 0x0a, 0x9a, 0x13, 0x75, 0x42, 0x08,
+	0x2c, 0xcb, 0x7e, 0x95, 0x6b, 0x1e, 0x95, 0xff, 0x27, 0x9f, 0xee, 0xf2, 0xd4, 0xd3, 0x2d, 0x2b,
+	0x3d, 0x19, 0x8e, 0x5c, 0xdb, 0x7a, 0xc6, 0xaf, 0xf5, 0xe4, 0xaf, 0x29, 0xce, 0x7f, 0xf8, 0xb5,
+	0xd9, 0x82, 0xc6, 0x31, 0x8b, 0xae, 0x86, 0x3e, 0x0b, 0x1d, 0x91, 0x86, 0xef, 0x0d, 0x68, 0x65,
+	0x0c, 0x99, 0x1c, 0xf2, 0x1a, 0xac, 0xa6, 0x0b, 0x86, 0x2a, 0xa3, 0x8a, 0xa7, 0x36, 0x89, 0x7f,
+	0x42, 0x5b, 0x0a, 0xd0, 0x6a, 0x8f, 0xcb, 0x1d, 0x2c, 0xd2, 0x49, 0x58, 0x13, 0xfc, 0xa3, 0x9c,
+	0x8d, 0x3b, 0xe7, 0xfa, 0xd0, 0xf7, 0xe3, 0x28, 0x0e, 0x59, 0x60, 0x31, 0xc7, 0x09, 0xd1, 0x1f,
+	0x69, 0x4c, 0x8d, 0xb6, 0x33, 0xc1, 0x81, 0xe2, 0x0b, 0xbd, 0xa2, 0x82, 0x42, 0x0f, 0x0b, 0x34,
+	0xc5, 0x2e, 0x4b, 0xec, 0x5a, 0xca, 0x2f, 0x40, 0xf9, 0x8b, 0x29, 0xa8, 0x5a, 0x2b, 0xd7, 0x52,
+	0x7e, 0x0a, 0xdd, 0x87, 0x95, 0x48, 0xf8, 0x23, 0xc3, 0x5e, 0xef, 0xdd, 0x9b, 0xd3, 0x92, 0x79,
+	0xf9, 0x51, 0x85, 0x25, 0xf7, 0x01, 0x72, 0xef, 0xe4, 0x36, 0x59, 0xa5, 0x05, 0x0e, 0xae, 0xdf,
+	0x15, 0x2c, 0x65, 0x17, 0x9f, 0xf6, 0xaa, 0xd4, 0x7a, 0x67, 0x4f, 0x2d, 0xf3, 0x7b, 0xe9, 0x32,
+	0xbf, 0x77, 0xac, 0x97, 0x79, 0xaa, 0x81, 0xe4, 0x3d, 0xa8, 0x3b, 0x6e, 0xf4, 0xcc, 0xba, 0xe2,
+	0x6c, 0x84, 0x15, 0x56, 0x93, 0xe7, 0xee, 0xce, 0x5a, 0x73, 0x8c, 0xa0, 0x8f, 0x24, 0x86, 0x82,
+	0x93, 0xfd, 0x37, 0x7f, 0x2c, 0x03, 0xe4, 0x22, 0x31, 0xf8, 0x02, 0x16, 0x61, 0x05, 0xc9, 0xdc,
+	0x54, 0xa9, 0xa6, 0x44, 0x3d, 0xe3, 0xa0, 0x53, 0xe3, 0x5e, 0x14, 0x21, 0xda, 0xeb, 0x87, 0x69,
+	0x7a, 0x48, 0x41, 0x34, 0x50, 0x12, 0xd1, 0x50, 0x01, 0xf7, 0x1c, 0xd7, 0xbb, 0xcc, 0xc0, 0xaa,
+	0x51, 0x5a, 0x9a, 0x9d, 0x02, 0xf7, 0xe1, 0x95, 0xc4, 0x13, 0x3d, 0x8c, 0xb4, 0xea, 0x3e, 0x0d,
+	0x57, 0xbd, 0xb2, 0x39, 0x21, 0x4c, 0x0f, 0x6d, 0x43, 0x3d, 0xe6, 0xe3, 0x80, 0x87, 0xf9, 0x82,
+	0x69, 0xd0, 0x22, 0x8b, 0x74, 0xa1, 0xfa, 0x0d, 0x0b, 0x3d, 0xbc, 0x48, 0x64, 0xc8, 0xc0, 0x0c,
+	0x66, 0xb4, 0xb0, 0x0d, 0x7f, 0x45, 0x16, 0x2d, 0x8c, 0x50, 0x10, 0xa0, 0xb7, 0x2a, 0x15, 0x2d,
+	0xcd, 0x1e, 0x28, 0xae, 0xfc, 0x04, 0xb8, 0xe2, 0xf6, 0xb3, 0x62, 0x97, 0x55, 0xf5, 0x27, 0x80,
+	0xe2, 0xa7, 0x9d, 0x35, 0x80, 0x26, 0xbe, 0x09, 0xcc, 0xf5, 0xb0, 0xe8, 0x13, 0x8c, 0xbb, 0x08,
+	0xe4, 0x85, 0x3b, 0xc2, 0x92, 0xd1, 0xbd, 0xa5, 0x29, 0xb2, 0x0b, 0xeb, 0xf2, 0xeb, 0x61, 0xce,
+	0x92, 0xb5, 0x96, 0x0a, 0x52, 0xa5, 0xbb, 0xd0, 0x4a, 0x95, 0x46, 0x01, 0x96, 0xbd, 0x7c, 0x48,
+	0xb0, 0xb2, 0xa3, 0x2b, 0x9d, 0x1f, 0xfc, 0x7a, 0xd2, 0xa4, 0xf9, 0x31, 0x6c, 0x50, 0x95, 0xef,
+	0x33, 0xc1, 0x49, 0xcd, 0xd8, 0x87, 0x57, 0x35, 0xc2, 0x62, 0x17, 0x78, 0x7f, 0x7e, 0xa7, 0x3a,
+	0xbf, 0xa1, 0xa5, 0x07, 0x42, 0x98, 0xde, 0xdb, 0x83, 0xcd, 0x49, 0x5d, 0xfa, 0x76, 0x8c, 0x69,
+	0xa8, 0xf8, 0xe9, 0xf5, 0x19, 0x6d, 0xfe, 0x5c, 0x02, 0x42, 0x79, 0xe0, 0x87, 0x71, 0x3a, 0x82,
+	0xe5, 0xfd, 0xb8, 0xb9, 0xb0, 0xc4, 0x71, 0x63, 0x2b, 0x4a, 0x6c, 0x5b, 0x04, 0x5c, 0x56, 0xaf,
+	0x3c, 0x5d, 0xa2, 0xeb, 0x52, 0x34, 0x50, 0x12, 0x2a, 0x04, 0xe4, 0x01, 0x34, 0x54, 0x5d, 0x6b,
+	0x60, 0x59, 0x02, 0xeb, 0x8a, 0xa7, 0x20, 0x38, 0x29, 0xaf, 0xf8, 0x08, 0xfd, 0x19, 0xfb, 0x89,
+	0x97, 0x3e, 0xd0, 0x20, 0x58, 0x07, 0x92, 0x23, 0xd2, 0x3b, 0x76, 0x3d, 0x77, 0x9c, 0x8c, 0xad,
+	0xe7, 0x3c, 0x8c, 0x44, 0xa7, 0xa9, 0x76, 0x6f, 0x69, 0xf6, 0x27, 0x8a, 0x8b, 0xcb, 0x27, 0x7e,
+	0x41, 0x7a, 0x71, 0x1e, 0x13, 0x5d, 0x47, 0x82, 0x99, 0xc6, 0xe2, 0x15, 0x11, 0xd7, 0x82, 0x5b,
+	0x2a, 0x14, 0xbb, 0x14, 0xd6, 0xa6, 0xbe, 0x1b, 0xc9, 0x2a, 0x18, 0x4f, 0xcf, 0xcf, 0xda, 0x4b,
+	0xe2, 0xcf, 0x87, 0x27, 0x67, 0xed, 0x12, 0x69, 0x42, 0x0d, 0xff, 0x58, 0x07, 0xe7, 0xc7, 0xfd,
+	0xb3, 0x76, 0x19, 0x1f, 0x77, 0x10, 0x24, 0x3d, 0x79, 0x7a, 0xd0, 0xa7, 0x6d, 0x43, 0xd0, 0x78,
+	0x20, 0xa5, 0x97, 0x77, 0xbf, 0x80, 0x8d, 0xec, 0xed, 0x3b, 0x4a, 0x17, 0xe0, 0x6b, 0x9c, 0xd1,
+	0xad, 0xfe, 0xe9, 0xd9, 0xc9, 0x87, 0xb4, 0x7f, 0xf6, 0xa9, 0x75, 0xfa, 0xe4, 0xf4, 0x04, 0xaf,
+	0x78, 0x1d, 0x5e, 0xcb, 0x79, 0x47, 0xf4, 0x68, 0xbf, 0x77, 0x64, 0x7d, 0x40, 0x0f, 0x1e, 0x9f,
+	0x0c, 0xf0, 0xda, 0x4d, 0xdc, 0xb3, 0x33, 0x21, 0x3d, 0x19, 0x9c, 0x3f, 0x3e, 0x69, 0x97, 0x7b,
+	0x7f, 0xae, 0x40, 0x3b, 0x7f, 0xd3, 0xa9, 0x1c, 0x0a, 0xe4, 0x18, 0x56, 0x24, 0x8f, 0xdc, 0x59,
+	0xb0, 0xaa, 0xf5, 0x9d, 0xee, 0xfd, 0x45, 0x5f, 0x4a, 0x6a, 0xb4, 0x99, 0x4b, 0xe4, 0x33, 0xa8,
+	0xea, 0x85, 0x88, 0x93, 0xed, 0xdb, 0x76, 0xbe, 0xee, 0x5b, 0xb7, 0x21, 0xd4, 0x4e, 0x65, 0x2e,
+	0xed, 0x94, 0xfe, 0x55, 0x22, 0xa7, 0xb0, 0xa2, 0x3e, 0x12, 0xef, 0xde, 0xf4, 0xc1, 0xd6, 0x7d,
+	0xe3, 0x26, 0x69, 0x66, 0xe9, 0x4e, 0x89, 0x3c, 0x81, 0x8a, 0xde, 0xb5, 0xee, 0x2d, 0x38, 0xa2,
+	0xc4, 0xdd, 0x37, 0x6f, 0x14, 0xe7, 0xce, 0x1f, 0x0b, 0x03, 0xc5, 0x6c, 0xef, 0xce, 0x7f, 0x01,
+	0x44, 0x2b, 0x74, 0x6f, 0x7e, 0x1d, 0x50, 0xcb, 0x7f, 0xa1, 0x96, 0x3d, 0x93, 0x64, 0x4e, 0xc4,
+	0x8b, 0x8f, 0x6a, 0x77, 0xfb, 0x06, 0xb9, 0xbc, 0xd2, 0x5c, 0xc2, 0xc8, 0x3d, 0x86, 0x8a, 0x9a,
+	0x1e, 0x64, 0x6b, 0xde, 0x76, 0x5f, 0x18, 0x56, 0xf3, 0x14, 0x4e, 0x0e, 0x1e, 0xb4, 0xd0, 0x82,
+	0x46, 0x71, 0x28, 0x90, 0x87, 0xf3, 0xce, 0xcc, 0x0c, 0xa0, 0x79, 0xd9, 0x9e, 0x37, 0x5b, 0xf0,
+	0x82, 0x2f, 0xa0, 0x5e, 0xe8, 0x34, 0xf2, 0xe6, 0xbc, 0x83, 0xd3, 0xf3, 0xa5, 0xfb, 0xf0, 0x16,
+	0x54, 0xaa, 0xfd, 0x70, 0xf9, 0xb3, 0x72, 0x30, 0x1c, 0x56, 0xe4, 0x3b, 0xba, 0xff, 0x17, 0x3b,
+	0x00, 0x01, 0x69, 0x54, 0x13, 0x00, 0x00,
 }
@@ -80,6 +80,60 @@ func (mr *MockPieceStoreRoutesClientMockRecorder) Piece(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Piece", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).Piece), varargs...)
 }
 
+// ReportStats mocks base method
+func (m *MockPieceStoreRoutesClient) ReportStats(arg0 context.Context, arg1 *ReportStatsRequest, arg2 ...grpc.CallOption) (*ReportStatsResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReportStats", varargs...)
+	ret0, _ := ret[0].(*ReportStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportStats indicates an expected call of ReportStats
+func (mr *MockPieceStoreRoutesClientMockRecorder) ReportStats(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportStats", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).ReportStats), varargs...)
+}
+
+// RestoreTrash mocks base method
+func (m *MockPieceStoreRoutesClient) RestoreTrash(arg0 context.Context, arg1 *RestoreTrashRequest, arg2 ...grpc.CallOption) (*RestoreTrashResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreTrash", varargs...)
+	ret0, _ := ret[0].(*RestoreTrashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTrash indicates an expected call of RestoreTrash
+func (mr *MockPieceStoreRoutesClientMockRecorder) RestoreTrash(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTrash", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).RestoreTrash), varargs...)
+}
+
+// Retain mocks base method
+func (m *MockPieceStoreRoutesClient) Retain(arg0 context.Context, arg1 *RetainRequest, arg2 ...grpc.CallOption) (*RetainResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Retain", varargs...)
+	ret0, _ := ret[0].(*RetainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retain indicates an expected call of Retain
+func (mr *MockPieceStoreRoutesClientMockRecorder) Retain(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retain", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).Retain), varargs...)
+}
+
 // Retrieve mocks base method
 func (m *MockPieceStoreRoutesClient) Retrieve(arg0 context.Context, arg1 ...grpc.CallOption) (PieceStoreRoutes_RetrieveClient, error) {
 	varargs := []interface{}{arg0}
@@ -42,7 +42,7 @@ func (x RedundancyScheme_SchemeType) String() string {
 	return proto.EnumName(RedundancyScheme_SchemeType_name, int32(x))
 }
 func (RedundancyScheme_SchemeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{0, 0}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{0, 0}
 }
 
 type Pointer_DataType int32
@@ -65,7 +65,7 @@ func (x Pointer_DataType) String() string {
 	return proto.EnumName(Pointer_DataType_name, int32(x))
 }
 func (Pointer_DataType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{3, 0}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{3, 0}
 }
 
 type RedundancyScheme struct {
@@ -85,7 +85,7 @@ func (m *RedundancyScheme) Reset()         { *m = RedundancyScheme{} }
 func (m *RedundancyScheme) String() string { return proto.CompactTextString(m) }
 func (*RedundancyScheme) ProtoMessage()    {}
 func (*RedundancyScheme) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{0}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{0}
 }
 func (m *RedundancyScheme) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RedundancyScheme.Unmarshal(m, b)
@@ -148,18 +148,19 @@ func (m *RedundancyScheme) GetErasureShareSize() int32 {
 }
 
 type RemotePiece struct {
-	PieceNum             int32    `protobuf:"varint,1,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
-	NodeId               NodeID   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PieceNum             int32          `protobuf:"varint,1,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	NodeId               NodeID         `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Hash                 *SignedMessage `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *RemotePiece) Reset()         { *m = RemotePiece{} }
 func (m *RemotePiece) String() string { return proto.CompactTextString(m) }
 func (*RemotePiece) ProtoMessage()    {}
 func (*RemotePiece) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{1}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{1}
 }
 func (m *RemotePiece) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemotePiece.Unmarshal(m, b)
@@ -186,12 +187,20 @@ func (m *RemotePiece) GetPieceNum() int32 {
 	return 0
 }
 
+func (m *RemotePiece) GetHash() *SignedMessage {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type RemoteSegment struct {
 	Redundancy *RedundancyScheme `protobuf:"bytes,1,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
 	// TODO: may want to use customtype and fixed-length byte slice
 	PieceId              string         `protobuf:"bytes,2,opt,name=piece_id,json=pieceId,proto3" json:"piece_id,omitempty"`
 	RemotePieces         []*RemotePiece `protobuf:"bytes,3,rep,name=remote_pieces,json=remotePieces,proto3" json:"remote_pieces,omitempty"`
 	MerkleRoot           []byte         `protobuf:"bytes,4,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	UploaderKey          []byte         `protobuf:"bytes,5,opt,name=uploader_key,json=uploaderKey,proto3" json:"uploader_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -201,7 +210,7 @@ func (m *RemoteSegment) Reset()         { *m = RemoteSegment{} }
 func (m *RemoteSegment) String() string { return proto.CompactTextString(m) }
 func (*RemoteSegment) ProtoMessage()    {}
 func (*RemoteSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{2}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{2}
 }
 func (m *RemoteSegment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteSegment.Unmarshal(m, b)
@@ -249,6 +258,13 @@ func (m *RemoteSegment) GetMerkleRoot() []byte {
 	return nil
 }
 
+func (m *RemoteSegment) GetUploaderKey() []byte {
+	if m != nil {
+		return m.UploaderKey
+	}
+	return nil
+}
+
 type Pointer struct {
 	Type                 Pointer_DataType     `protobuf:"varint,1,opt,name=type,proto3,enum=pointerdb.Pointer_DataType" json:"type,omitempty"`
 	InlineSegment        []byte               `protobuf:"bytes,3,opt,name=inline_segment,json=inlineSegment,proto3" json:"inline_segment,omitempty"`
@@ -266,7 +282,7 @@ func (m *Pointer) Reset()         { *m = Pointer{} }
 func (m *Pointer) String() string { return proto.CompactTextString(m) }
 func (*Pointer) ProtoMessage()    {}
 func (*Pointer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{3}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{3}
 }
 func (m *Pointer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Pointer.Unmarshal(m, b)
@@ -348,7 +364,7 @@ func (m *PutRequest) Reset()         { *m = PutRequest{} }
 func (m *PutRequest) String() string { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()    {}
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{4}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{4}
 }
 func (m *PutRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutRequest.Unmarshal(m, b)
@@ -394,7 +410,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{5}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{5}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
@@ -438,7 +454,7 @@ func (m *ListRequest) Reset()         { *m = ListRequest{} }
 func (m *ListRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()    {}
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{6}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{6}
 }
 func (m *ListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
@@ -511,7 +527,7 @@ func (m *PutResponse) Reset()         { *m = PutResponse{} }
 func (m *PutResponse) String() string { return proto.CompactTextString(m) }
 func (*PutResponse) ProtoMessage()    {}
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{7}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{7}
 }
 func (m *PutResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutResponse.Unmarshal(m, b)
@@ -546,7 +562,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{8}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{8}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
@@ -607,7 +623,7 @@ func (m *ListResponse) Reset()         { *m = ListResponse{} }
 func (m *ListResponse) String() string { return proto.CompactTextString(m) }
 func (*ListResponse) ProtoMessage()    {}
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{9}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{9}
 }
 func (m *ListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
@@ -654,7 +670,7 @@ func (m *ListResponse_Item) Reset()         { *m = ListResponse_Item{} }
 func (m *ListResponse_Item) String() string { return proto.CompactTextString(m) }
 func (*ListResponse_Item) ProtoMessage()    {}
 func (*ListResponse_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{9, 0}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{9, 0}
 }
 func (m *ListResponse_Item) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse_Item.Unmarshal(m, b)
@@ -706,7 +722,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{10}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{10}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
@@ -744,7 +760,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{11}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{11}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
@@ -779,7 +795,7 @@ func (m *IterateRequest) Reset()         { *m = IterateRequest{} }
 func (m *IterateRequest) String() string { return proto.CompactTextString(m) }
 func (*IterateRequest) ProtoMessage()    {}
 func (*IterateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{12}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{12}
 }
 func (m *IterateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IterateRequest.Unmarshal(m, b)
@@ -829,6 +845,7 @@ func (m *IterateRequest) GetReverse() bool {
 
 type PayerBandwidthAllocationRequest struct {
 	Action               BandwidthAction `protobuf:"varint,1,opt,name=action,proto3,enum=piecestoreroutes.BandwidthAction" json:"action,omitempty"`
+	Path                 string          `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -838,7 +855,7 @@ func (m *PayerBandwidthAllocationRequest) Reset()         { *m = PayerBandwidthA
 func (m *PayerBandwidthAllocationRequest) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocationRequest) ProtoMessage()    {}
 func (*PayerBandwidthAllocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{13}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{13}
 }
 func (m *PayerBandwidthAllocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocationRequest.Unmarshal(m, b)
@@ -865,6 +882,13 @@ func (m *PayerBandwidthAllocationRequest) GetAction() BandwidthAction {
 	return BandwidthAction_PUT
 }
 
+func (m *PayerBandwidthAllocationRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 type PayerBandwidthAllocationResponse struct {
 	Pba                  *PayerBandwidthAllocation `protobuf:"bytes,1,opt,name=pba,proto3" json:"pba,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
@@ -876,7 +900,7 @@ func (m *PayerBandwidthAllocationResponse) Reset()         { *m = PayerBandwidth
 func (m *PayerBandwidthAllocationResponse) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocationResponse) ProtoMessage()    {}
 func (*PayerBandwidthAllocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{14}
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{14}
 }
 func (m *PayerBandwidthAllocationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocationResponse.Unmarshal(m, b)
@@ -903,218 +927,1394 @@ func (m *PayerBandwidthAllocationResponse) GetPba() *PayerBandwidthAllocation {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*RedundancyScheme)(nil), "pointerdb.RedundancyScheme")
-	proto.RegisterType((*RemotePiece)(nil), "pointerdb.RemotePiece")
-	proto.RegisterType((*RemoteSegment)(nil), "pointerdb.RemoteSegment")
-	proto.RegisterType((*Pointer)(nil), "pointerdb.Pointer")
-	proto.RegisterType((*PutRequest)(nil), "pointerdb.PutRequest")
-	proto.RegisterType((*GetRequest)(nil), "pointerdb.GetRequest")
-	proto.RegisterType((*ListRequest)(nil), "pointerdb.ListRequest")
-	proto.RegisterType((*PutResponse)(nil), "pointerdb.PutResponse")
-	proto.RegisterType((*GetResponse)(nil), "pointerdb.GetResponse")
-	proto.RegisterType((*ListResponse)(nil), "pointerdb.ListResponse")
-	proto.RegisterType((*ListResponse_Item)(nil), "pointerdb.ListResponse.Item")
-	proto.RegisterType((*DeleteRequest)(nil), "pointerdb.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "pointerdb.DeleteResponse")
-	proto.RegisterType((*IterateRequest)(nil), "pointerdb.IterateRequest")
-	proto.RegisterType((*PayerBandwidthAllocationRequest)(nil), "pointerdb.PayerBandwidthAllocationRequest")
-	proto.RegisterType((*PayerBandwidthAllocationResponse)(nil), "pointerdb.PayerBandwidthAllocationResponse")
-	proto.RegisterEnum("pointerdb.RedundancyScheme_SchemeType", RedundancyScheme_SchemeType_name, RedundancyScheme_SchemeType_value)
-	proto.RegisterEnum("pointerdb.Pointer_DataType", Pointer_DataType_name, Pointer_DataType_value)
+// ObjectTag is a plaintext key/value pair used for searching objects
+type ObjectTag struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ObjectTag) Reset()         { *m = ObjectTag{} }
+func (m *ObjectTag) String() string { return proto.CompactTextString(m) }
+func (*ObjectTag) ProtoMessage()    {}
+func (*ObjectTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{15}
+}
+func (m *ObjectTag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectTag.Unmarshal(m, b)
+}
+func (m *ObjectTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectTag.Marshal(b, m, deterministic)
+}
+func (dst *ObjectTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectTag.Merge(dst, src)
+}
+func (m *ObjectTag) XXX_Size() int {
+	return xxx_messageInfo_ObjectTag.Size(m)
+}
+func (m *ObjectTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectTag.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ObjectTag proto.InternalMessageInfo
 
-// PointerDBClient is the client API for PointerDB service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PointerDBClient interface {
-	// Put formats and hands off a file path to be saved to boltdb
-	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
-	// Get formats and hands off a file path to get a small value from boltdb
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// List calls the bolt client's List function and returns all file paths
-	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
-	// Delete formats and hands off a file path to delete from boltdb
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
-	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
-	PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error)
+func (m *ObjectTag) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-type pointerDBClient struct {
-	cc *grpc.ClientConn
+func (m *ObjectTag) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func NewPointerDBClient(cc *grpc.ClientConn) PointerDBClient {
-	return &pointerDBClient{cc}
+// SetObjectTagsRequest is a request message for the SetObjectTags rpc call
+type SetObjectTagsRequest struct {
+	Bucket               string       `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path                 string       `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Tags                 []*ObjectTag `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (c *pointerDBClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
-	out := new(PutResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Put", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SetObjectTagsRequest) Reset()         { *m = SetObjectTagsRequest{} }
+func (m *SetObjectTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetObjectTagsRequest) ProtoMessage()    {}
+func (*SetObjectTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{16}
+}
+func (m *SetObjectTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetObjectTagsRequest.Unmarshal(m, b)
+}
+func (m *SetObjectTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetObjectTagsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetObjectTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetObjectTagsRequest.Merge(dst, src)
+}
+func (m *SetObjectTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetObjectTagsRequest.Size(m)
+}
+func (m *SetObjectTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetObjectTagsRequest.DiscardUnknown(m)
 }
 
-func (c *pointerDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetObjectTagsRequest proto.InternalMessageInfo
+
+func (m *SetObjectTagsRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
 	}
-	return out, nil
+	return ""
 }
 
-func (c *pointerDBClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
-	out := new(ListResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetObjectTagsRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return out, nil
+	return ""
 }
 
-func (c *pointerDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetObjectTagsRequest) GetTags() []*ObjectTag {
+	if m != nil {
+		return m.Tags
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pointerDBClient) PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error) {
-	out := new(PayerBandwidthAllocationResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/PayerBandwidthAllocation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// SetObjectTagsResponse is a response message for the SetObjectTags rpc call
+type SetObjectTagsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// PointerDBServer is the server API for PointerDB service.
-type PointerDBServer interface {
-	// Put formats and hands off a file path to be saved to boltdb
-	Put(context.Context, *PutRequest) (*PutResponse, error)
-	// Get formats and hands off a file path to get a small value from boltdb
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// List calls the bolt client's List function and returns all file paths
-	List(context.Context, *ListRequest) (*ListResponse, error)
-	// Delete formats and hands off a file path to delete from boltdb
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
-	PayerBandwidthAllocation(context.Context, *PayerBandwidthAllocationRequest) (*PayerBandwidthAllocationResponse, error)
+func (m *SetObjectTagsResponse) Reset()         { *m = SetObjectTagsResponse{} }
+func (m *SetObjectTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetObjectTagsResponse) ProtoMessage()    {}
+func (*SetObjectTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{17}
+}
+func (m *SetObjectTagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetObjectTagsResponse.Unmarshal(m, b)
+}
+func (m *SetObjectTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetObjectTagsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetObjectTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetObjectTagsResponse.Merge(dst, src)
+}
+func (m *SetObjectTagsResponse) XXX_Size() int {
+	return xxx_messageInfo_SetObjectTagsResponse.Size(m)
+}
+func (m *SetObjectTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetObjectTagsResponse.DiscardUnknown(m)
 }
 
-func RegisterPointerDBServer(s *grpc.Server, srv PointerDBServer) {
-	s.RegisterService(&_PointerDB_serviceDesc, srv)
+var xxx_messageInfo_SetObjectTagsResponse proto.InternalMessageInfo
+
+// SearchObjectsRequest is a request message for the SearchObjects rpc call
+type SearchObjectsRequest struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	TagKey               string   `protobuf:"bytes,2,opt,name=tag_key,json=tagKey,proto3" json:"tag_key,omitempty"`
+	TagValue             string   `protobuf:"bytes,3,opt,name=tag_value,json=tagValue,proto3" json:"tag_value,omitempty"`
+	Prefix               bool     `protobuf:"varint,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int64    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _PointerDB_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PointerDBServer).Put(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pointerdb.PointerDB/Put",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PointerDBServer).Put(ctx, req.(*PutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *SearchObjectsRequest) Reset()         { *m = SearchObjectsRequest{} }
+func (m *SearchObjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchObjectsRequest) ProtoMessage()    {}
+func (*SearchObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{18}
+}
+func (m *SearchObjectsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchObjectsRequest.Unmarshal(m, b)
+}
+func (m *SearchObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchObjectsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SearchObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchObjectsRequest.Merge(dst, src)
+}
+func (m *SearchObjectsRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchObjectsRequest.Size(m)
+}
+func (m *SearchObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchObjectsRequest.DiscardUnknown(m)
 }
 
-func _PointerDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PointerDBServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pointerdb.PointerDB/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PointerDBServer).Get(ctx, req.(*GetRequest))
+var xxx_messageInfo_SearchObjectsRequest proto.InternalMessageInfo
+
+func (m *SearchObjectsRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PointerDB_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PointerDBServer).List(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pointerdb.PointerDB/List",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PointerDBServer).List(ctx, req.(*ListRequest))
+func (m *SearchObjectsRequest) GetTagKey() string {
+	if m != nil {
+		return m.TagKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PointerDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PointerDBServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pointerdb.PointerDB/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PointerDBServer).Delete(ctx, req.(*DeleteRequest))
+func (m *SearchObjectsRequest) GetTagValue() string {
+	if m != nil {
+		return m.TagValue
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PointerDB_PayerBandwidthAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PayerBandwidthAllocationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PointerDBServer).PayerBandwidthAllocation(ctx, in)
+func (m *SearchObjectsRequest) GetPrefix() bool {
+	if m != nil {
+		return m.Prefix
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pointerdb.PointerDB/PayerBandwidthAllocation",
+	return false
+}
+
+func (m *SearchObjectsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PointerDBServer).PayerBandwidthAllocation(ctx, req.(*PayerBandwidthAllocationRequest))
+	return 0
+}
+
+func (m *SearchObjectsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _PointerDB_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pointerdb.PointerDB",
-	HandlerType: (*PointerDBServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Put",
+// SearchObjectsResponse is a response message for the SearchObjects rpc call
+type SearchObjectsResponse struct {
+	Items                []*SearchObjectsResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	More                 bool                          `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *SearchObjectsResponse) Reset()         { *m = SearchObjectsResponse{} }
+func (m *SearchObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchObjectsResponse) ProtoMessage()    {}
+func (*SearchObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{19}
+}
+func (m *SearchObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchObjectsResponse.Unmarshal(m, b)
+}
+func (m *SearchObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchObjectsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SearchObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchObjectsResponse.Merge(dst, src)
+}
+func (m *SearchObjectsResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchObjectsResponse.Size(m)
+}
+func (m *SearchObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchObjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchObjectsResponse proto.InternalMessageInfo
+
+func (m *SearchObjectsResponse) GetItems() []*SearchObjectsResponse_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *SearchObjectsResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type SearchObjectsResponse_Item struct {
+	Path                 string     `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Tag                  *ObjectTag `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SearchObjectsResponse_Item) Reset()         { *m = SearchObjectsResponse_Item{} }
+func (m *SearchObjectsResponse_Item) String() string { return proto.CompactTextString(m) }
+func (*SearchObjectsResponse_Item) ProtoMessage()    {}
+func (*SearchObjectsResponse_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{19, 0}
+}
+func (m *SearchObjectsResponse_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchObjectsResponse_Item.Unmarshal(m, b)
+}
+func (m *SearchObjectsResponse_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchObjectsResponse_Item.Marshal(b, m, deterministic)
+}
+func (dst *SearchObjectsResponse_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchObjectsResponse_Item.Merge(dst, src)
+}
+func (m *SearchObjectsResponse_Item) XXX_Size() int {
+	return xxx_messageInfo_SearchObjectsResponse_Item.Size(m)
+}
+func (m *SearchObjectsResponse_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchObjectsResponse_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchObjectsResponse_Item proto.InternalMessageInfo
+
+func (m *SearchObjectsResponse_Item) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SearchObjectsResponse_Item) GetTag() *ObjectTag {
+	if m != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+// BucketTemplateRequest is a request message for the BucketTemplate rpc call
+type BucketTemplateRequest struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketTemplateRequest) Reset()         { *m = BucketTemplateRequest{} }
+func (m *BucketTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketTemplateRequest) ProtoMessage()    {}
+func (*BucketTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{20}
+}
+func (m *BucketTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketTemplateRequest.Unmarshal(m, b)
+}
+func (m *BucketTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketTemplateRequest.Marshal(b, m, deterministic)
+}
+func (dst *BucketTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketTemplateRequest.Merge(dst, src)
+}
+func (m *BucketTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketTemplateRequest.Size(m)
+}
+func (m *BucketTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketTemplateRequest proto.InternalMessageInfo
+
+func (m *BucketTemplateRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+// BucketTemplateResponse is a response message for the BucketTemplate rpc call
+type BucketTemplateResponse struct {
+	Found                bool              `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	PathCipher           int32             `protobuf:"varint,2,opt,name=path_cipher,json=pathCipher,proto3" json:"path_cipher,omitempty"`
+	DataCipher           int32             `protobuf:"varint,3,opt,name=data_cipher,json=dataCipher,proto3" json:"data_cipher,omitempty"`
+	BlockSize            int32             `protobuf:"varint,4,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+	Redundancy           *RedundancyScheme `protobuf:"bytes,5,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+	DistinctSubnets      bool              `protobuf:"varint,6,opt,name=distinct_subnets,json=distinctSubnets,proto3" json:"distinct_subnets,omitempty"`
+	DistinctRegions      bool              `protobuf:"varint,7,opt,name=distinct_regions,json=distinctRegions,proto3" json:"distinct_regions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BucketTemplateResponse) Reset()         { *m = BucketTemplateResponse{} }
+func (m *BucketTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketTemplateResponse) ProtoMessage()    {}
+func (*BucketTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{21}
+}
+func (m *BucketTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketTemplateResponse.Unmarshal(m, b)
+}
+func (m *BucketTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketTemplateResponse.Marshal(b, m, deterministic)
+}
+func (dst *BucketTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketTemplateResponse.Merge(dst, src)
+}
+func (m *BucketTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketTemplateResponse.Size(m)
+}
+func (m *BucketTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketTemplateResponse proto.InternalMessageInfo
+
+func (m *BucketTemplateResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *BucketTemplateResponse) GetPathCipher() int32 {
+	if m != nil {
+		return m.PathCipher
+	}
+	return 0
+}
+
+func (m *BucketTemplateResponse) GetDataCipher() int32 {
+	if m != nil {
+		return m.DataCipher
+	}
+	return 0
+}
+
+func (m *BucketTemplateResponse) GetBlockSize() int32 {
+	if m != nil {
+		return m.BlockSize
+	}
+	return 0
+}
+
+func (m *BucketTemplateResponse) GetRedundancy() *RedundancyScheme {
+	if m != nil {
+		return m.Redundancy
+	}
+	return nil
+}
+
+func (m *BucketTemplateResponse) GetDistinctSubnets() bool {
+	if m != nil {
+		return m.DistinctSubnets
+	}
+	return false
+}
+
+func (m *BucketTemplateResponse) GetDistinctRegions() bool {
+	if m != nil {
+		return m.DistinctRegions
+	}
+	return false
+}
+
+// BucketStatsRequest is a request message for the BucketStats rpc call
+type BucketStatsRequest struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketStatsRequest) Reset()         { *m = BucketStatsRequest{} }
+func (m *BucketStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketStatsRequest) ProtoMessage()    {}
+func (*BucketStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{22}
+}
+func (m *BucketStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketStatsRequest.Unmarshal(m, b)
+}
+func (m *BucketStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *BucketStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketStatsRequest.Merge(dst, src)
+}
+func (m *BucketStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketStatsRequest.Size(m)
+}
+func (m *BucketStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketStatsRequest proto.InternalMessageInfo
+
+func (m *BucketStatsRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+// BucketStatsResponse is a response message for the BucketStats rpc call
+type BucketStatsResponse struct {
+	ObjectCount          int64    `protobuf:"varint,1,opt,name=object_count,json=objectCount,proto3" json:"object_count,omitempty"`
+	TotalBytes           int64    `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketStatsResponse) Reset()         { *m = BucketStatsResponse{} }
+func (m *BucketStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketStatsResponse) ProtoMessage()    {}
+func (*BucketStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{23}
+}
+func (m *BucketStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketStatsResponse.Unmarshal(m, b)
+}
+func (m *BucketStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *BucketStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketStatsResponse.Merge(dst, src)
+}
+func (m *BucketStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketStatsResponse.Size(m)
+}
+func (m *BucketStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketStatsResponse proto.InternalMessageInfo
+
+func (m *BucketStatsResponse) GetObjectCount() int64 {
+	if m != nil {
+		return m.ObjectCount
+	}
+	return 0
+}
+
+func (m *BucketStatsResponse) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+// MoveRequest is a request message for the Move rpc call
+type MoveRequest struct {
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	NewPath string `protobuf:"bytes,2,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	// metadata replaces the metadata of the pointer, since the uplink encrypts
+	// it with a key derived from the path
+	Metadata             []byte   `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveRequest) Reset()         { *m = MoveRequest{} }
+func (m *MoveRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveRequest) ProtoMessage()    {}
+func (*MoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{24}
+}
+func (m *MoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveRequest.Unmarshal(m, b)
+}
+func (m *MoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveRequest.Marshal(b, m, deterministic)
+}
+func (dst *MoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveRequest.Merge(dst, src)
+}
+func (m *MoveRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveRequest.Size(m)
+}
+func (m *MoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveRequest proto.InternalMessageInfo
+
+func (m *MoveRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *MoveRequest) GetNewPath() string {
+	if m != nil {
+		return m.NewPath
+	}
+	return ""
+}
+
+func (m *MoveRequest) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// MoveResponse is a response message for the Move rpc call
+type MoveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveResponse) Reset()         { *m = MoveResponse{} }
+func (m *MoveResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveResponse) ProtoMessage()    {}
+func (*MoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{25}
+}
+func (m *MoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveResponse.Unmarshal(m, b)
+}
+func (m *MoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveResponse.Marshal(b, m, deterministic)
+}
+func (dst *MoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveResponse.Merge(dst, src)
+}
+func (m *MoveResponse) XXX_Size() int {
+	return xxx_messageInfo_MoveResponse.Size(m)
+}
+func (m *MoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveResponse proto.InternalMessageInfo
+
+// BucketLifecycle is the configuration of when the satellite deletes the
+// objects of a bucket, zero days keep the objects
+type BucketLifecycle struct {
+	// expire_after_days deletes the objects uploaded more than the number of days ago
+	ExpireAfterDays int32 `protobuf:"varint,1,opt,name=expire_after_days,json=expireAfterDays,proto3" json:"expire_after_days,omitempty"`
+	// versions_prefix is the encrypted path of the prefix the versions of the
+	// objects are kept under, the satellite can't decrypt the paths
+	VersionsPrefix string `protobuf:"bytes,2,opt,name=versions_prefix,json=versionsPrefix,proto3" json:"versions_prefix,omitempty"`
+	// versions_expire_after_days deletes the versions uploaded more than the
+	// number of days ago
+	VersionsExpireAfterDays int32    `protobuf:"varint,3,opt,name=versions_expire_after_days,json=versionsExpireAfterDays,proto3" json:"versions_expire_after_days,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *BucketLifecycle) Reset()         { *m = BucketLifecycle{} }
+func (m *BucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*BucketLifecycle) ProtoMessage()    {}
+func (*BucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{26}
+}
+func (m *BucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketLifecycle.Unmarshal(m, b)
+}
+func (m *BucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketLifecycle.Marshal(b, m, deterministic)
+}
+func (dst *BucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketLifecycle.Merge(dst, src)
+}
+func (m *BucketLifecycle) XXX_Size() int {
+	return xxx_messageInfo_BucketLifecycle.Size(m)
+}
+func (m *BucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketLifecycle proto.InternalMessageInfo
+
+func (m *BucketLifecycle) GetExpireAfterDays() int32 {
+	if m != nil {
+		return m.ExpireAfterDays
+	}
+	return 0
+}
+
+func (m *BucketLifecycle) GetVersionsPrefix() string {
+	if m != nil {
+		return m.VersionsPrefix
+	}
+	return ""
+}
+
+func (m *BucketLifecycle) GetVersionsExpireAfterDays() int32 {
+	if m != nil {
+		return m.VersionsExpireAfterDays
+	}
+	return 0
+}
+
+// SetBucketLifecycleRequest is a request message for the SetBucketLifecycle rpc call,
+// a lifecycle without expiration removes the configuration
+type SetBucketLifecycleRequest struct {
+	Bucket               string           `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Lifecycle            *BucketLifecycle `protobuf:"bytes,2,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetBucketLifecycleRequest) Reset()         { *m = SetBucketLifecycleRequest{} }
+func (m *SetBucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleRequest) ProtoMessage()    {}
+func (*SetBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{27}
+}
+func (m *SetBucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetBucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleRequest.Merge(dst, src)
+}
+func (m *SetBucketLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Size(m)
+}
+func (m *SetBucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *SetBucketLifecycleRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *SetBucketLifecycleRequest) GetLifecycle() *BucketLifecycle {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return nil
+}
+
+// SetBucketLifecycleResponse is a response message for the SetBucketLifecycle rpc call
+type SetBucketLifecycleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketLifecycleResponse) Reset()         { *m = SetBucketLifecycleResponse{} }
+func (m *SetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleResponse) ProtoMessage()    {}
+func (*SetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{28}
+}
+func (m *SetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleResponse.Merge(dst, src)
+}
+func (m *SetBucketLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Size(m)
+}
+func (m *SetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleResponse proto.InternalMessageInfo
+
+// BucketLifecycleRequest is a request message for the BucketLifecycle rpc call
+type BucketLifecycleRequest struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketLifecycleRequest) Reset()         { *m = BucketLifecycleRequest{} }
+func (m *BucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketLifecycleRequest) ProtoMessage()    {}
+func (*BucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{29}
+}
+func (m *BucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketLifecycleRequest.Unmarshal(m, b)
+}
+func (m *BucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (dst *BucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketLifecycleRequest.Merge(dst, src)
+}
+func (m *BucketLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketLifecycleRequest.Size(m)
+}
+func (m *BucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *BucketLifecycleRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+// BucketLifecycleResponse is a response message for the BucketLifecycle rpc call
+type BucketLifecycleResponse struct {
+	Found                bool             `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Lifecycle            *BucketLifecycle `protobuf:"bytes,2,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BucketLifecycleResponse) Reset()         { *m = BucketLifecycleResponse{} }
+func (m *BucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketLifecycleResponse) ProtoMessage()    {}
+func (*BucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{30}
+}
+func (m *BucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketLifecycleResponse.Unmarshal(m, b)
+}
+func (m *BucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (dst *BucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketLifecycleResponse.Merge(dst, src)
+}
+func (m *BucketLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketLifecycleResponse.Size(m)
+}
+func (m *BucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketLifecycleResponse proto.InternalMessageInfo
+
+func (m *BucketLifecycleResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *BucketLifecycleResponse) GetLifecycle() *BucketLifecycle {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return nil
+}
+
+// SetBucketAttributionRequest is a request message for the SetBucketAttribution rpc call
+type SetBucketAttributionRequest struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	PartnerId            string   `protobuf:"bytes,2,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketAttributionRequest) Reset()         { *m = SetBucketAttributionRequest{} }
+func (m *SetBucketAttributionRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketAttributionRequest) ProtoMessage()    {}
+func (*SetBucketAttributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{31}
+}
+func (m *SetBucketAttributionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketAttributionRequest.Unmarshal(m, b)
+}
+func (m *SetBucketAttributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketAttributionRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetBucketAttributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketAttributionRequest.Merge(dst, src)
+}
+func (m *SetBucketAttributionRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketAttributionRequest.Size(m)
+}
+func (m *SetBucketAttributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketAttributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketAttributionRequest proto.InternalMessageInfo
+
+func (m *SetBucketAttributionRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *SetBucketAttributionRequest) GetPartnerId() string {
+	if m != nil {
+		return m.PartnerId
+	}
+	return ""
+}
+
+// SetBucketAttributionResponse is a response message for the SetBucketAttribution rpc call
+type SetBucketAttributionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketAttributionResponse) Reset()         { *m = SetBucketAttributionResponse{} }
+func (m *SetBucketAttributionResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketAttributionResponse) ProtoMessage()    {}
+func (*SetBucketAttributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_0986083ea8e303b4, []int{32}
+}
+func (m *SetBucketAttributionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketAttributionResponse.Unmarshal(m, b)
+}
+func (m *SetBucketAttributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketAttributionResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetBucketAttributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketAttributionResponse.Merge(dst, src)
+}
+func (m *SetBucketAttributionResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketAttributionResponse.Size(m)
+}
+func (m *SetBucketAttributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketAttributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketAttributionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*RedundancyScheme)(nil), "pointerdb.RedundancyScheme")
+	proto.RegisterType((*RemotePiece)(nil), "pointerdb.RemotePiece")
+	proto.RegisterType((*RemoteSegment)(nil), "pointerdb.RemoteSegment")
+	proto.RegisterType((*Pointer)(nil), "pointerdb.Pointer")
+	proto.RegisterType((*PutRequest)(nil), "pointerdb.PutRequest")
+	proto.RegisterType((*GetRequest)(nil), "pointerdb.GetRequest")
+	proto.RegisterType((*ListRequest)(nil), "pointerdb.ListRequest")
+	proto.RegisterType((*PutResponse)(nil), "pointerdb.PutResponse")
+	proto.RegisterType((*GetResponse)(nil), "pointerdb.GetResponse")
+	proto.RegisterType((*ListResponse)(nil), "pointerdb.ListResponse")
+	proto.RegisterType((*ListResponse_Item)(nil), "pointerdb.ListResponse.Item")
+	proto.RegisterType((*DeleteRequest)(nil), "pointerdb.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "pointerdb.DeleteResponse")
+	proto.RegisterType((*IterateRequest)(nil), "pointerdb.IterateRequest")
+	proto.RegisterType((*PayerBandwidthAllocationRequest)(nil), "pointerdb.PayerBandwidthAllocationRequest")
+	proto.RegisterType((*PayerBandwidthAllocationResponse)(nil), "pointerdb.PayerBandwidthAllocationResponse")
+	proto.RegisterType((*ObjectTag)(nil), "pointerdb.ObjectTag")
+	proto.RegisterType((*SetObjectTagsRequest)(nil), "pointerdb.SetObjectTagsRequest")
+	proto.RegisterType((*SetObjectTagsResponse)(nil), "pointerdb.SetObjectTagsResponse")
+	proto.RegisterType((*SearchObjectsRequest)(nil), "pointerdb.SearchObjectsRequest")
+	proto.RegisterType((*SearchObjectsResponse)(nil), "pointerdb.SearchObjectsResponse")
+	proto.RegisterType((*SearchObjectsResponse_Item)(nil), "pointerdb.SearchObjectsResponse.Item")
+	proto.RegisterType((*BucketTemplateRequest)(nil), "pointerdb.BucketTemplateRequest")
+	proto.RegisterType((*BucketTemplateResponse)(nil), "pointerdb.BucketTemplateResponse")
+	proto.RegisterType((*BucketStatsRequest)(nil), "pointerdb.BucketStatsRequest")
+	proto.RegisterType((*BucketStatsResponse)(nil), "pointerdb.BucketStatsResponse")
+	proto.RegisterType((*MoveRequest)(nil), "pointerdb.MoveRequest")
+	proto.RegisterType((*MoveResponse)(nil), "pointerdb.MoveResponse")
+	proto.RegisterType((*BucketLifecycle)(nil), "pointerdb.BucketLifecycle")
+	proto.RegisterType((*SetBucketLifecycleRequest)(nil), "pointerdb.SetBucketLifecycleRequest")
+	proto.RegisterType((*SetBucketLifecycleResponse)(nil), "pointerdb.SetBucketLifecycleResponse")
+	proto.RegisterType((*BucketLifecycleRequest)(nil), "pointerdb.BucketLifecycleRequest")
+	proto.RegisterType((*BucketLifecycleResponse)(nil), "pointerdb.BucketLifecycleResponse")
+	proto.RegisterType((*SetBucketAttributionRequest)(nil), "pointerdb.SetBucketAttributionRequest")
+	proto.RegisterType((*SetBucketAttributionResponse)(nil), "pointerdb.SetBucketAttributionResponse")
+	proto.RegisterEnum("pointerdb.RedundancyScheme_SchemeType", RedundancyScheme_SchemeType_name, RedundancyScheme_SchemeType_value)
+	proto.RegisterEnum("pointerdb.Pointer_DataType", Pointer_DataType_name, Pointer_DataType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PointerDBClient is the client API for PointerDB service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PointerDBClient interface {
+	// Put formats and hands off a file path to be saved to boltdb
+	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
+	// Get formats and hands off a file path to get a small value from boltdb
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// List calls the bolt client's List function and returns all file paths
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Delete formats and hands off a file path to delete from boltdb
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
+	PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error)
+	// SetObjectTags replaces the plaintext search tags of an object
+	SetObjectTags(ctx context.Context, in *SetObjectTagsRequest, opts ...grpc.CallOption) (*SetObjectTagsResponse, error)
+	// SearchObjects returns the objects of a bucket which have a matching tag
+	SearchObjects(ctx context.Context, in *SearchObjectsRequest, opts ...grpc.CallOption) (*SearchObjectsResponse, error)
+	// BucketTemplate returns the settings the project applies to new buckets
+	BucketTemplate(ctx context.Context, in *BucketTemplateRequest, opts ...grpc.CallOption) (*BucketTemplateResponse, error)
+	// BucketStats returns the number of objects and bytes of a bucket
+	BucketStats(ctx context.Context, in *BucketStatsRequest, opts ...grpc.CallOption) (*BucketStatsResponse, error)
+	// Move moves a segment to a new path without touching its pieces
+	Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error)
+	// SetBucketLifecycle replaces the lifecycle configuration of a bucket
+	SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest, opts ...grpc.CallOption) (*SetBucketLifecycleResponse, error)
+	// BucketLifecycle returns the lifecycle configuration of a bucket
+	BucketLifecycle(ctx context.Context, in *BucketLifecycleRequest, opts ...grpc.CallOption) (*BucketLifecycleResponse, error)
+	// SetBucketAttribution attributes a new bucket to a partner
+	SetBucketAttribution(ctx context.Context, in *SetBucketAttributionRequest, opts ...grpc.CallOption) (*SetBucketAttributionResponse, error)
+}
+
+type pointerDBClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPointerDBClient(cc *grpc.ClientConn) PointerDBClient {
+	return &pointerDBClient{cc}
+}
+
+func (c *pointerDBClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
+	out := new(PutResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Put", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error) {
+	out := new(PayerBandwidthAllocationResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/PayerBandwidthAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) SetObjectTags(ctx context.Context, in *SetObjectTagsRequest, opts ...grpc.CallOption) (*SetObjectTagsResponse, error) {
+	out := new(SetObjectTagsResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/SetObjectTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) SearchObjects(ctx context.Context, in *SearchObjectsRequest, opts ...grpc.CallOption) (*SearchObjectsResponse, error) {
+	out := new(SearchObjectsResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/SearchObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) BucketTemplate(ctx context.Context, in *BucketTemplateRequest, opts ...grpc.CallOption) (*BucketTemplateResponse, error) {
+	out := new(BucketTemplateResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/BucketTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) BucketStats(ctx context.Context, in *BucketStatsRequest, opts ...grpc.CallOption) (*BucketStatsResponse, error) {
+	out := new(BucketStatsResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/BucketStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error) {
+	out := new(MoveResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Move", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest, opts ...grpc.CallOption) (*SetBucketLifecycleResponse, error) {
+	out := new(SetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/SetBucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) BucketLifecycle(ctx context.Context, in *BucketLifecycleRequest, opts ...grpc.CallOption) (*BucketLifecycleResponse, error) {
+	out := new(BucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/BucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) SetBucketAttribution(ctx context.Context, in *SetBucketAttributionRequest, opts ...grpc.CallOption) (*SetBucketAttributionResponse, error) {
+	out := new(SetBucketAttributionResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/SetBucketAttribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PointerDBServer is the server API for PointerDB service.
+type PointerDBServer interface {
+	// Put formats and hands off a file path to be saved to boltdb
+	Put(context.Context, *PutRequest) (*PutResponse, error)
+	// Get formats and hands off a file path to get a small value from boltdb
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// List calls the bolt client's List function and returns all file paths
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Delete formats and hands off a file path to delete from boltdb
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
+	PayerBandwidthAllocation(context.Context, *PayerBandwidthAllocationRequest) (*PayerBandwidthAllocationResponse, error)
+	// SetObjectTags replaces the plaintext search tags of an object
+	SetObjectTags(context.Context, *SetObjectTagsRequest) (*SetObjectTagsResponse, error)
+	// SearchObjects returns the objects of a bucket which have a matching tag
+	SearchObjects(context.Context, *SearchObjectsRequest) (*SearchObjectsResponse, error)
+	// BucketTemplate returns the settings the project applies to new buckets
+	BucketTemplate(context.Context, *BucketTemplateRequest) (*BucketTemplateResponse, error)
+	// BucketStats returns the number of objects and bytes of a bucket
+	BucketStats(context.Context, *BucketStatsRequest) (*BucketStatsResponse, error)
+	// Move moves a segment to a new path without touching its pieces
+	Move(context.Context, *MoveRequest) (*MoveResponse, error)
+	// SetBucketLifecycle replaces the lifecycle configuration of a bucket
+	SetBucketLifecycle(context.Context, *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error)
+	// BucketLifecycle returns the lifecycle configuration of a bucket
+	BucketLifecycle(context.Context, *BucketLifecycleRequest) (*BucketLifecycleResponse, error)
+	// SetBucketAttribution attributes a new bucket to a partner
+	SetBucketAttribution(context.Context, *SetBucketAttributionRequest) (*SetBucketAttributionResponse, error)
+}
+
+func RegisterPointerDBServer(s *grpc.Server, srv PointerDBServer) {
+	s.RegisterService(&_PointerDB_serviceDesc, srv)
+}
+
+func _PointerDB_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Put",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Put(ctx, req.(*PutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_PayerBandwidthAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayerBandwidthAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).PayerBandwidthAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/PayerBandwidthAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).PayerBandwidthAllocation(ctx, req.(*PayerBandwidthAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_SetObjectTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetObjectTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).SetObjectTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/SetObjectTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).SetObjectTags(ctx, req.(*SetObjectTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_SearchObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).SearchObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/SearchObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).SearchObjects(ctx, req.(*SearchObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_BucketTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BucketTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).BucketTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/BucketTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).BucketTemplate(ctx, req.(*BucketTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_BucketStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BucketStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).BucketStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/BucketStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).BucketStats(ctx, req.(*BucketStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Move",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Move(ctx, req.(*MoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_SetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).SetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/SetBucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).SetBucketLifecycle(ctx, req.(*SetBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_BucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).BucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/BucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).BucketLifecycle(ctx, req.(*BucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_SetBucketAttribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketAttributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).SetBucketAttribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/SetBucketAttribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).SetBucketAttribution(ctx, req.(*SetBucketAttributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PointerDB_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pointerdb.PointerDB",
+	HandlerType: (*PointerDBServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Put",
 			Handler:    _PointerDB_Put_Handler,
 		},
 		{
@@ -1133,82 +2333,157 @@ var _PointerDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PayerBandwidthAllocation",
 			Handler:    _PointerDB_PayerBandwidthAllocation_Handler,
 		},
+		{
+			MethodName: "SetObjectTags",
+			Handler:    _PointerDB_SetObjectTags_Handler,
+		},
+		{
+			MethodName: "SearchObjects",
+			Handler:    _PointerDB_SearchObjects_Handler,
+		},
+		{
+			MethodName: "BucketTemplate",
+			Handler:    _PointerDB_BucketTemplate_Handler,
+		},
+		{
+			MethodName: "BucketStats",
+			Handler:    _PointerDB_BucketStats_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _PointerDB_Move_Handler,
+		},
+		{
+			MethodName: "SetBucketLifecycle",
+			Handler:    _PointerDB_SetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "BucketLifecycle",
+			Handler:    _PointerDB_BucketLifecycle_Handler,
+		},
+		{
+			MethodName: "SetBucketAttribution",
+			Handler:    _PointerDB_SetBucketAttribution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pointerdb.proto",
 }
 
-func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_pointerdb_afdf954a8fe5efcf) }
-
-var fileDescriptor_pointerdb_afdf954a8fe5efcf = []byte{
-	// 1090 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xaf, 0xff, 0xc7, 0x73, 0x76, 0x6a, 0x56, 0x25, 0xbd, 0xba, 0x45, 0x49, 0x0f, 0x01, 0xa5,
-	0xad, 0x5c, 0x64, 0x2a, 0x21, 0x28, 0x08, 0x35, 0x24, 0x54, 0x96, 0xda, 0x10, 0xad, 0xf3, 0x84,
-	0x90, 0x8e, 0xb5, 0x6f, 0x6c, 0xaf, 0xf0, 0xfd, 0xe9, 0xee, 0x5e, 0x69, 0xf2, 0x4d, 0xf8, 0x26,
-	0xbc, 0xf0, 0x88, 0xc4, 0x67, 0xe0, 0xa1, 0x0f, 0x88, 0x8f, 0xc1, 0x03, 0xda, 0x3f, 0x67, 0x9f,
-	0x1b, 0x92, 0x22, 0x78, 0x49, 0x6e, 0x66, 0x7e, 0x33, 0x3b, 0x33, 0xbf, 0xdf, 0xae, 0xe1, 0x6a,
-	0x96, 0xf2, 0x44, 0xa1, 0x88, 0x26, 0x83, 0x4c, 0xa4, 0x2a, 0x25, 0xed, 0x95, 0xa3, 0xbf, 0x3b,
-	0x4f, 0xd3, 0xf9, 0x12, 0x1f, 0x98, 0xc0, 0x24, 0x9f, 0x3d, 0x50, 0x3c, 0x46, 0xa9, 0x58, 0x9c,
-	0x59, 0x6c, 0x1f, 0xe6, 0xe9, 0x3c, 0x2d, 0xbe, 0x93, 0x34, 0x42, 0xf7, 0xdd, 0xcb, 0x38, 0x4e,
-	0x51, 0xaa, 0x54, 0x38, 0x4f, 0xf0, 0x53, 0x15, 0x7a, 0x14, 0xa3, 0x3c, 0x89, 0x58, 0x32, 0x3d,
-	0x1d, 0x4f, 0x17, 0x18, 0x23, 0xf9, 0x0c, 0xea, 0xea, 0x34, 0x43, 0xbf, 0xb2, 0x57, 0xb9, 0xb3,
-	0x3d, 0x7c, 0x7f, 0xb0, 0x6e, 0xe5, 0x75, 0xe8, 0xc0, 0xfe, 0x3b, 0x39, 0xcd, 0x90, 0x9a, 0x1c,
-	0x72, 0x1d, 0x5a, 0x31, 0x4f, 0x42, 0x81, 0xcf, 0xfd, 0xea, 0x5e, 0xe5, 0x4e, 0x83, 0x36, 0x63,
-	0x9e, 0x50, 0x7c, 0x4e, 0xae, 0x41, 0x43, 0xa5, 0x8a, 0x2d, 0xfd, 0x9a, 0x71, 0x5b, 0x83, 0x7c,
-	0x08, 0x3d, 0x81, 0x19, 0xe3, 0x22, 0x54, 0x0b, 0x81, 0x72, 0x91, 0x2e, 0x23, 0xbf, 0x6e, 0x00,
-	0x57, 0xad, 0xff, 0xa4, 0x70, 0x93, 0x7b, 0xf0, 0x96, 0xcc, 0xa7, 0x53, 0x94, 0xb2, 0x84, 0x6d,
-	0x18, 0x6c, 0xcf, 0x05, 0xd6, 0xe0, 0xfb, 0x40, 0x50, 0x30, 0x99, 0x0b, 0x0c, 0xe5, 0x82, 0xe9,
-	0xbf, 0xfc, 0x0c, 0xfd, 0xa6, 0x45, 0xbb, 0xc8, 0x58, 0x07, 0xc6, 0xfc, 0x0c, 0x83, 0x6b, 0x00,
-	0xeb, 0x41, 0x48, 0x13, 0xaa, 0x74, 0xdc, 0xbb, 0x12, 0x8c, 0xc1, 0xa3, 0x18, 0xa7, 0x0a, 0x8f,
-	0xf5, 0xd6, 0xc8, 0x4d, 0x68, 0x9b, 0xf5, 0x85, 0x49, 0x1e, 0x9b, 0xd5, 0x34, 0xe8, 0x96, 0x71,
-	0x1c, 0xe5, 0x31, 0xf9, 0x00, 0x5a, 0x7a, 0xcf, 0x21, 0x8f, 0xcc, 0xd8, 0x9d, 0xfd, 0xed, 0xdf,
-	0x5e, 0xed, 0x5e, 0xf9, 0xfd, 0xd5, 0x6e, 0xf3, 0x28, 0x8d, 0x70, 0x74, 0x40, 0x9b, 0x3a, 0x3c,
-	0x8a, 0x82, 0x5f, 0x2b, 0xd0, 0xb5, 0x55, 0xc7, 0x38, 0x8f, 0x31, 0x51, 0xe4, 0x11, 0x80, 0x58,
-	0xad, 0xd5, 0x14, 0xf6, 0x86, 0x37, 0x2f, 0xd9, 0x39, 0x2d, 0xc1, 0xc9, 0x0d, 0xb0, 0x3d, 0x14,
-	0x07, 0xb7, 0x69, 0xcb, 0xd8, 0xa3, 0x88, 0x3c, 0x82, 0xae, 0x30, 0x07, 0x85, 0x96, 0x75, 0xbf,
-	0xb6, 0x57, 0xbb, 0xe3, 0x0d, 0x77, 0x36, 0x4a, 0xaf, 0xc6, 0xa3, 0x1d, 0xb1, 0x36, 0x24, 0xd9,
-	0x05, 0x2f, 0x46, 0xf1, 0xc3, 0x12, 0x43, 0x91, 0xa6, 0xca, 0x50, 0xd2, 0xa1, 0x60, 0x5d, 0x34,
-	0x4d, 0x55, 0xf0, 0x57, 0x15, 0x5a, 0xc7, 0xb6, 0x10, 0x79, 0xb0, 0xa1, 0x97, 0x72, 0xef, 0x0e,
-	0x31, 0x38, 0x60, 0x8a, 0x95, 0x44, 0xf2, 0x1e, 0x6c, 0xf3, 0x64, 0xc9, 0x13, 0x0c, 0xa5, 0x5d,
-	0x82, 0x11, 0x45, 0x87, 0x76, 0xad, 0xb7, 0xd8, 0xcc, 0x47, 0xd0, 0xb4, 0x4d, 0x99, 0xf3, 0xbd,
-	0xa1, 0x7f, 0xae, 0x75, 0x87, 0xa4, 0x0e, 0x47, 0x6e, 0x43, 0xc7, 0x55, 0xb4, 0x84, 0x6b, 0x79,
-	0xd4, 0xa8, 0xe7, 0x7c, 0x9a, 0x6b, 0xf2, 0x25, 0x74, 0xa7, 0x02, 0x99, 0xe2, 0x69, 0x12, 0x46,
-	0x4c, 0x59, 0x51, 0x78, 0xc3, 0xfe, 0xc0, 0x5e, 0xaa, 0x41, 0x71, 0xa9, 0x06, 0x27, 0xc5, 0xa5,
-	0xa2, 0x9d, 0x22, 0xe1, 0x80, 0x29, 0x24, 0x5f, 0xc1, 0x55, 0x7c, 0x99, 0x71, 0x51, 0x2a, 0xd1,
-	0x7a, 0x63, 0x89, 0xed, 0x75, 0x8a, 0x29, 0xd2, 0x87, 0xad, 0x18, 0x15, 0x8b, 0x98, 0x62, 0xfe,
-	0x96, 0x99, 0x7d, 0x65, 0x07, 0x01, 0x6c, 0x15, 0xfb, 0x22, 0x00, 0xcd, 0xd1, 0xd1, 0xd3, 0xd1,
-	0xd1, 0x61, 0xef, 0x8a, 0xfe, 0xa6, 0x87, 0xcf, 0xbe, 0x39, 0x39, 0xec, 0x55, 0x82, 0x23, 0x80,
-	0xe3, 0x5c, 0x51, 0x7c, 0x9e, 0xa3, 0x54, 0x84, 0x40, 0x3d, 0x63, 0x6a, 0x61, 0x08, 0x68, 0x53,
-	0xf3, 0x4d, 0xee, 0x43, 0xcb, 0x6d, 0xcb, 0x08, 0xc3, 0x1b, 0x92, 0xf3, 0xbc, 0xd0, 0x02, 0x12,
-	0xec, 0x01, 0x3c, 0xc1, 0xcb, 0xea, 0x05, 0x3f, 0x57, 0xc0, 0x7b, 0xca, 0xe5, 0x0a, 0xb3, 0x03,
-	0xcd, 0x4c, 0xe0, 0x8c, 0xbf, 0x74, 0x28, 0x67, 0x69, 0xe5, 0x48, 0xc5, 0x84, 0x0a, 0xd9, 0xac,
-	0x38, 0xbb, 0x4d, 0xc1, 0xb8, 0x1e, 0x6b, 0x0f, 0x79, 0x07, 0x00, 0x93, 0x28, 0x9c, 0xe0, 0x2c,
-	0x15, 0x68, 0x88, 0x6f, 0xd3, 0x36, 0x26, 0xd1, 0xbe, 0x71, 0x90, 0x5b, 0xd0, 0x16, 0x38, 0xcd,
-	0x85, 0xe4, 0x2f, 0x2c, 0xef, 0x5b, 0x74, 0xed, 0xd0, 0xaf, 0xc8, 0x92, 0xc7, 0x5c, 0xb9, 0x8b,
-	0x6f, 0x0d, 0x5d, 0x52, 0x6f, 0x2f, 0x9c, 0x2d, 0xd9, 0x5c, 0x1a, 0x42, 0x5b, 0xb4, 0xad, 0x3d,
-	0x5f, 0x6b, 0x47, 0xd0, 0x05, 0xcf, 0x2c, 0x4b, 0x66, 0x69, 0x22, 0x31, 0xf8, 0xa3, 0x02, 0x9e,
-	0x19, 0xd6, 0xda, 0xe5, 0x4d, 0x55, 0xde, 0xb8, 0x29, 0xb2, 0x07, 0x0d, 0x7d, 0x95, 0xa5, 0x5f,
-	0x35, 0xd7, 0x09, 0x06, 0xe6, 0x7d, 0xd5, 0xb7, 0x9c, 0xda, 0x00, 0xf9, 0x1c, 0x6a, 0xd9, 0x84,
-	0x99, 0xc9, 0xbc, 0xe1, 0xdd, 0xc1, 0xfa, 0xcd, 0x15, 0x69, 0xae, 0x50, 0x0e, 0x8e, 0xd9, 0x29,
-	0x8a, 0x7d, 0x96, 0x44, 0x3f, 0xf2, 0x48, 0x2d, 0x1e, 0x2f, 0x97, 0xe9, 0xd4, 0x08, 0x83, 0xea,
-	0x34, 0x72, 0x08, 0x5d, 0x96, 0xab, 0x45, 0x2a, 0xf8, 0x99, 0xf1, 0x3a, 0xed, 0xef, 0x9e, 0xaf,
-	0x33, 0xe6, 0xf3, 0x04, 0xa3, 0x67, 0x28, 0x25, 0x9b, 0x23, 0xdd, 0xcc, 0x0a, 0x7e, 0xa9, 0x40,
-	0xc7, 0xd2, 0xe5, 0xa6, 0x1c, 0x42, 0x83, 0x2b, 0x8c, 0xa5, 0x5f, 0x31, 0x7d, 0xdf, 0x2a, 0xcd,
-	0x58, 0xc6, 0x0d, 0x46, 0x0a, 0x63, 0x6a, 0xa1, 0x5a, 0x07, 0xb1, 0x26, 0xa9, 0x6a, 0x68, 0x30,
-	0xdf, 0x7d, 0x84, 0xba, 0x86, 0xfc, 0x7f, 0xcd, 0xe9, 0x07, 0x95, 0xcb, 0xd0, 0x89, 0xa8, 0x66,
-	0x8e, 0xd8, 0xe2, 0xf2, 0xd8, 0xd8, 0xc1, 0xbb, 0xd0, 0x3d, 0xc0, 0x25, 0x2a, 0xbc, 0x4c, 0x93,
-	0x3d, 0xd8, 0x2e, 0x40, 0x8e, 0x5b, 0x01, 0xdb, 0x23, 0x85, 0x82, 0xad, 0xf3, 0x2e, 0xd2, 0xe9,
-	0x35, 0x68, 0xcc, 0xb8, 0x90, 0xca, 0x29, 0xd4, 0x1a, 0xc4, 0x87, 0x96, 0x15, 0x1b, 0xba, 0x8e,
-	0x0a, 0xd3, 0x46, 0x5e, 0xa0, 0x8e, 0xd4, 0x8b, 0x88, 0x31, 0x83, 0xef, 0x60, 0xf7, 0x42, 0x4a,
-	0x5d, 0x13, 0x9f, 0x42, 0x93, 0x4d, 0x0d, 0x9b, 0xf6, 0x8d, 0xbc, 0x7d, 0x9e, 0xcd, 0x75, 0xb6,
-	0x01, 0x52, 0x97, 0x10, 0x7c, 0x0f, 0x7b, 0x17, 0x57, 0x77, 0xdc, 0x3a, 0xc5, 0x55, 0xfe, 0x93,
-	0xe2, 0x86, 0x7f, 0x56, 0xa1, 0xed, 0xc8, 0x39, 0xd8, 0x27, 0x0f, 0xa1, 0x76, 0x9c, 0x2b, 0xf2,
-	0x76, 0x99, 0xb9, 0xd5, 0x4b, 0xd3, 0xdf, 0x79, 0xdd, 0xed, 0x3a, 0x78, 0x08, 0xb5, 0x27, 0xb8,
-	0x99, 0xb5, 0x7e, 0x4f, 0x36, 0xb2, 0xca, 0x37, 0xef, 0x13, 0xa8, 0x6b, 0xed, 0x91, 0x9d, 0x73,
-	0x62, 0xb4, 0x79, 0xd7, 0x2f, 0x10, 0x29, 0xf9, 0x02, 0x9a, 0x96, 0x78, 0x52, 0xfe, 0x4d, 0xd8,
-	0x10, 0x4c, 0xff, 0xc6, 0x3f, 0x44, 0x5c, 0xba, 0x04, 0xff, 0xa2, 0x95, 0x90, 0xbb, 0xe5, 0x09,
-	0x2f, 0xa7, 0xb5, 0x7f, 0xef, 0x5f, 0x61, 0xed, 0xa1, 0xfb, 0xf5, 0x6f, 0xab, 0xd9, 0x64, 0xd2,
-	0x34, 0x3f, 0x0e, 0x1f, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x68, 0xba, 0x5b, 0xd0, 0xe0, 0x09,
-	0x00, 0x00,
+func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_pointerdb_0986083ea8e303b4) }
+
+var fileDescriptor_pointerdb_0986083ea8e303b4 = []byte{
+	// 1792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0x57, 0x4b, 0x73, 0x1b, 0x45,
+	0x10, 0x8e, 0xde, 0x52, 0x4b, 0x96, 0x95, 0xc1, 0xb1, 0x15, 0x25, 0xc1, 0xf6, 0x42, 0x1e, 0x24,
+	0x94, 0x9c, 0x52, 0xa8, 0xe2, 0x11, 0x28, 0x2a, 0x8a, 0x0d, 0xe5, 0xc2, 0x71, 0x5c, 0x23, 0x43,
+	0x05, 0x2e, 0xcb, 0x6a, 0x35, 0x92, 0x16, 0x4b, 0xbb, 0x9b, 0xdd, 0x59, 0x27, 0xca, 0x2f, 0xe0,
+	0xc8, 0x95, 0x2b, 0xdc, 0x28, 0x7e, 0x00, 0x17, 0xee, 0xfc, 0x06, 0x0e, 0x39, 0x70, 0xe2, 0x47,
+	0x70, 0x60, 0x5e, 0x2b, 0xed, 0xea, 0x19, 0xc2, 0xc5, 0xde, 0xe9, 0xf9, 0xba, 0xa7, 0xdf, 0xdd,
+	0x82, 0x75, 0xd7, 0xb1, 0x6c, 0x4a, 0xbc, 0x4e, 0xbb, 0xee, 0x7a, 0x0e, 0x75, 0x50, 0x61, 0x4c,
+	0xa8, 0x6d, 0xf7, 0x1c, 0xa7, 0x37, 0x20, 0x7b, 0xe2, 0xa2, 0x1d, 0x74, 0xf7, 0xa8, 0x35, 0x24,
+	0x3e, 0x35, 0x86, 0xae, 0xc4, 0xd6, 0xa0, 0xe7, 0xf4, 0x9c, 0xf0, 0xdb, 0x76, 0x3a, 0x44, 0x7d,
+	0x57, 0x5c, 0x8b, 0x98, 0x0c, 0xe9, 0x78, 0x8a, 0xa2, 0xfd, 0x98, 0x84, 0x0a, 0x26, 0x9d, 0xc0,
+	0xee, 0x18, 0xb6, 0x39, 0x6a, 0x99, 0x7d, 0x32, 0x24, 0xe8, 0x23, 0x48, 0xd3, 0x91, 0x4b, 0xaa,
+	0x89, 0x9d, 0xc4, 0xad, 0x72, 0xe3, 0x46, 0x7d, 0xa2, 0xca, 0x34, 0xb4, 0x2e, 0xff, 0x9d, 0x32,
+	0x34, 0x16, 0x3c, 0x68, 0x0b, 0x72, 0x43, 0xcb, 0xd6, 0x3d, 0xf2, 0xb4, 0x9a, 0x64, 0xec, 0x19,
+	0x9c, 0x65, 0x47, 0x4c, 0x9e, 0xa2, 0x0d, 0xc8, 0x50, 0x87, 0x1a, 0x83, 0x6a, 0x4a, 0x90, 0xe5,
+	0x01, 0xbd, 0x03, 0x15, 0x8f, 0xb8, 0x86, 0xe5, 0xe9, 0xb4, 0xef, 0x11, 0xbf, 0xef, 0x0c, 0x3a,
+	0xd5, 0xb4, 0x00, 0xac, 0x4b, 0xfa, 0x69, 0x48, 0x46, 0x77, 0xe0, 0xa2, 0x1f, 0x98, 0x4c, 0x7d,
+	0x3f, 0x82, 0xcd, 0x08, 0x6c, 0x45, 0x5d, 0x4c, 0xc0, 0xef, 0x02, 0x22, 0x9e, 0xe1, 0x07, 0x1e,
+	0xd1, 0xfd, 0xbe, 0xc1, 0xff, 0x5a, 0x2f, 0x48, 0x35, 0x2b, 0xd1, 0xea, 0xa6, 0xc5, 0x2f, 0x5a,
+	0x8c, 0xae, 0x6d, 0x00, 0x4c, 0x0c, 0x41, 0x59, 0x48, 0xe2, 0x56, 0xe5, 0x82, 0xf6, 0x7d, 0x02,
+	0x8a, 0x98, 0x0c, 0x1d, 0x4a, 0x4e, 0xb8, 0xdb, 0xd0, 0x15, 0x28, 0x08, 0xff, 0xe9, 0x76, 0x30,
+	0x14, 0xbe, 0xc9, 0xe0, 0xbc, 0x20, 0x1c, 0x07, 0x43, 0x74, 0x13, 0x72, 0xdc, 0xd1, 0xba, 0xd5,
+	0x11, 0x76, 0x97, 0x9a, 0xe5, 0x3f, 0x5e, 0x6e, 0x5f, 0xf8, 0xf3, 0xe5, 0x76, 0xf6, 0x98, 0x91,
+	0x0f, 0xf7, 0x71, 0x96, 0x5f, 0x1f, 0x76, 0xd0, 0x3d, 0x48, 0xf7, 0x0d, 0xbf, 0x2f, 0xdc, 0x50,
+	0x6c, 0x6c, 0xd7, 0x27, 0x21, 0xf1, 0x9c, 0x80, 0x12, 0xbf, 0xde, 0xb2, 0x7a, 0x36, 0xe9, 0x3c,
+	0x62, 0xe6, 0x18, 0x3d, 0xe6, 0x55, 0x0e, 0xd6, 0xfe, 0x4e, 0xc0, 0x9a, 0x54, 0xa5, 0x45, 0x7a,
+	0x43, 0x62, 0x53, 0x74, 0x1f, 0xc0, 0x1b, 0x07, 0x43, 0x68, 0x53, 0x6c, 0x5c, 0x59, 0x12, 0x29,
+	0x1c, 0x81, 0xa3, 0xcb, 0x20, 0x15, 0x0f, 0xb5, 0x2d, 0xe0, 0x9c, 0x38, 0x33, 0xf5, 0xee, 0xc3,
+	0x9a, 0x27, 0x1e, 0xd2, 0xa5, 0x62, 0x4c, 0xcf, 0x14, 0x13, 0xbd, 0x19, 0x13, 0x3d, 0xf6, 0x09,
+	0x2e, 0x79, 0x93, 0x83, 0x8f, 0xb6, 0xa1, 0x38, 0x24, 0xde, 0xd9, 0x80, 0xe8, 0x9e, 0xe3, 0x50,
+	0x11, 0xc8, 0x12, 0x06, 0x49, 0xc2, 0x8c, 0x82, 0x76, 0xa1, 0x14, 0xb8, 0x03, 0xc7, 0xe8, 0x10,
+	0x4f, 0x3f, 0x23, 0x23, 0x11, 0xbe, 0x12, 0x2e, 0x86, 0xb4, 0x2f, 0xc8, 0x48, 0xfb, 0x27, 0x09,
+	0xb9, 0x13, 0xf9, 0x16, 0xda, 0x8b, 0x25, 0x62, 0xd4, 0x3c, 0x85, 0xa8, 0xef, 0x1b, 0xd4, 0x88,
+	0x64, 0xdf, 0x75, 0x28, 0x5b, 0xf6, 0xc0, 0xb2, 0x59, 0xbc, 0xa5, 0x9f, 0x84, 0x9b, 0x4b, 0x78,
+	0x4d, 0x52, 0x43, 0xe7, 0xdd, 0x85, 0xac, 0xd4, 0x5b, 0xa8, 0x58, 0x6c, 0x54, 0x67, 0xac, 0x53,
+	0x48, 0xac, 0x70, 0x5c, 0x71, 0x25, 0x51, 0x66, 0x12, 0x57, 0x3c, 0x85, 0x8b, 0x8a, 0xc6, 0x93,
+	0x08, 0x7d, 0x0a, 0x6b, 0xa6, 0x47, 0x0c, 0x6a, 0x39, 0xb6, 0xde, 0x31, 0xa8, 0xcc, 0xb6, 0x62,
+	0xa3, 0x56, 0x97, 0xd5, 0x5a, 0x0f, 0xab, 0xb5, 0x7e, 0x1a, 0x56, 0x2b, 0x2e, 0x85, 0x0c, 0xcc,
+	0x0c, 0x82, 0x1e, 0xc2, 0x3a, 0x79, 0xee, 0x5a, 0x5e, 0x44, 0x44, 0x6e, 0xa5, 0x88, 0xf2, 0x84,
+	0x45, 0x08, 0xa9, 0x41, 0x7e, 0x48, 0xa8, 0xc1, 0xb8, 0x8d, 0x6a, 0x5e, 0xd8, 0x3e, 0x3e, 0x6b,
+	0x1a, 0xe4, 0x43, 0x7f, 0x21, 0x80, 0xec, 0xe1, 0xf1, 0xd1, 0xe1, 0xf1, 0x41, 0xe5, 0x02, 0xff,
+	0xc6, 0x07, 0x8f, 0x1e, 0x9f, 0x1e, 0x54, 0x12, 0xda, 0x31, 0xc0, 0x49, 0x40, 0x59, 0xc1, 0x06,
+	0xec, 0x01, 0x84, 0x20, 0xed, 0x1a, 0xb4, 0x2f, 0x02, 0x50, 0xc0, 0xe2, 0x9b, 0x95, 0x56, 0x4e,
+	0x79, 0x4b, 0xe4, 0x4e, 0xb1, 0x81, 0x66, 0xe3, 0x82, 0x43, 0x88, 0xb6, 0x03, 0xf0, 0x39, 0x59,
+	0x26, 0x4f, 0xfb, 0x8d, 0x95, 0xd9, 0x91, 0xe5, 0x8f, 0x31, 0x9b, 0x90, 0x75, 0x3d, 0xd2, 0xb5,
+	0x9e, 0x2b, 0x94, 0x3a, 0xf1, 0xe4, 0x62, 0x26, 0x7b, 0x54, 0x37, 0xba, 0xe1, 0xdb, 0x05, 0x0c,
+	0x82, 0xf4, 0x80, 0x53, 0xd0, 0x35, 0x00, 0x62, 0x77, 0xf4, 0x36, 0xe9, 0xb2, 0x62, 0x12, 0x81,
+	0x2f, 0xe0, 0x02, 0xa3, 0x34, 0x05, 0x01, 0x5d, 0x85, 0x82, 0x47, 0xcc, 0xc0, 0xf3, 0xad, 0x73,
+	0x19, 0xf7, 0x3c, 0x9e, 0x10, 0x78, 0x7b, 0x1a, 0x58, 0x43, 0x8b, 0xaa, 0x8e, 0x22, 0x0f, 0x5c,
+	0x24, 0xf7, 0x9e, 0xde, 0x1d, 0x18, 0x3d, 0x5f, 0x04, 0x34, 0x87, 0x0b, 0x9c, 0xf2, 0x19, 0x27,
+	0x68, 0x6b, 0x50, 0x14, 0xce, 0xf2, 0x5d, 0xc7, 0xf6, 0x89, 0xf6, 0x17, 0xb3, 0x44, 0x18, 0x2b,
+	0xcf, 0x51, 0x4f, 0x25, 0x56, 0x7a, 0x0a, 0xed, 0x40, 0x86, 0xb7, 0x08, 0x9f, 0x59, 0xc6, 0x2b,
+	0x0e, 0xea, 0xa2, 0x71, 0xf3, 0xee, 0x81, 0xe5, 0x05, 0xfa, 0x18, 0x52, 0x6e, 0xdb, 0x50, 0x9d,
+	0xe3, 0xf6, 0x6c, 0xe7, 0x38, 0x31, 0x46, 0xc4, 0x6b, 0x1a, 0x76, 0xe7, 0x99, 0xd5, 0xa1, 0xfd,
+	0x07, 0x83, 0x81, 0x63, 0x8a, 0xc4, 0xc0, 0x9c, 0x0d, 0x1d, 0xc0, 0x9a, 0x11, 0xd0, 0xbe, 0xe3,
+	0x59, 0x2f, 0x04, 0x55, 0xe5, 0xfe, 0xca, 0x0e, 0x14, 0xe7, 0xd2, 0x7e, 0x4f, 0x40, 0x49, 0x86,
+	0x4b, 0x59, 0xd9, 0x80, 0x8c, 0x45, 0xc9, 0xd0, 0x67, 0x36, 0x72, 0xbd, 0xaf, 0x46, 0x6c, 0x8c,
+	0xe2, 0xea, 0x87, 0x0c, 0x84, 0x25, 0x94, 0xe7, 0xc1, 0x90, 0x07, 0x29, 0x29, 0xc2, 0x20, 0xbe,
+	0x6b, 0x04, 0xd2, 0x1c, 0xf2, 0xff, 0x73, 0x8e, 0x37, 0x6a, 0xcb, 0xd7, 0x55, 0x12, 0xa5, 0xc4,
+	0x13, 0x79, 0xcb, 0x3f, 0x11, 0x67, 0xed, 0x2d, 0x58, 0xdb, 0x27, 0x03, 0x42, 0xc9, 0xb2, 0x9c,
+	0xac, 0x40, 0x39, 0x04, 0xa9, 0xd8, 0x7a, 0x50, 0x66, 0xda, 0xb1, 0x42, 0x23, 0xab, 0xf2, 0x94,
+	0x65, 0x52, 0xd7, 0xf2, 0x7c, 0xaa, 0x32, 0x54, 0x1e, 0x50, 0x15, 0x72, 0x32, 0xd9, 0x88, 0xd2,
+	0x28, 0x3c, 0xca, 0x9b, 0x73, 0xc2, 0x6f, 0xd2, 0xe1, 0x8d, 0x38, 0x6a, 0x2e, 0x6c, 0x2f, 0x0c,
+	0xa9, 0x52, 0xe2, 0x43, 0xc8, 0x1a, 0xa6, 0x88, 0xa6, 0xec, 0x91, 0xbb, 0xb3, 0xd1, 0x9c, 0x70,
+	0x0b, 0x20, 0x56, 0x0c, 0x63, 0xbb, 0x93, 0x11, 0xbb, 0xbf, 0x85, 0x9d, 0xc5, 0x2f, 0xaa, 0x78,
+	0xab, 0x2c, 0x4c, 0xbc, 0x56, 0x16, 0x6a, 0xf7, 0xa0, 0xf0, 0xb8, 0xfd, 0x1d, 0x31, 0xe9, 0xa9,
+	0xd1, 0x43, 0x15, 0x48, 0xf1, 0x29, 0x20, 0xfd, 0xc7, 0x3f, 0xb9, 0xf3, 0xce, 0x8d, 0x41, 0x40,
+	0x42, 0xe7, 0x89, 0x83, 0x36, 0x80, 0x8d, 0x16, 0xa1, 0x63, 0x3e, 0x3f, 0x12, 0x82, 0x76, 0x60,
+	0x9e, 0x11, 0x1a, 0x86, 0x40, 0x9e, 0xe6, 0x99, 0x86, 0x6e, 0xb1, 0x59, 0xc2, 0x8b, 0x58, 0xce,
+	0xb3, 0x8d, 0x48, 0xfe, 0x8c, 0xe5, 0x62, 0x81, 0xd0, 0xb6, 0xe0, 0xd2, 0xd4, 0x6b, 0x2a, 0x07,
+	0x7e, 0x49, 0x70, 0x3d, 0x0c, 0xcf, 0xec, 0xcb, 0xcb, 0x95, 0x7a, 0xb0, 0x65, 0x88, 0x49, 0x14,
+	0x93, 0x4e, 0xaa, 0x92, 0x65, 0x47, 0x36, 0xe4, 0x78, 0x86, 0xf2, 0x0b, 0x69, 0xaa, 0xec, 0x54,
+	0x79, 0x46, 0xf8, 0x8a, 0x9f, 0x23, 0x89, 0x25, 0xf3, 0x21, 0x92, 0x58, 0x73, 0x5a, 0x14, 0x43,
+	0x3b, 0xdd, 0xae, 0xcf, 0xde, 0xce, 0x8a, 0x99, 0xa4, 0x4e, 0xda, 0xaf, 0x09, 0x6e, 0x46, 0x4c,
+	0x59, 0x15, 0xc0, 0xfb, 0xf1, 0x82, 0xbd, 0x1e, 0x71, 0xc5, 0x5c, 0x86, 0x95, 0x95, 0xdb, 0x5c,
+	0x52, 0xb9, 0x37, 0x20, 0xc5, 0x0c, 0x53, 0x55, 0x3b, 0xdf, 0xeb, 0x1c, 0xa0, 0xed, 0xc1, 0xa5,
+	0xa6, 0x70, 0xda, 0x29, 0x19, 0xba, 0x83, 0x78, 0x99, 0xcd, 0xf3, 0xad, 0xf6, 0x53, 0x12, 0x36,
+	0xa7, 0x39, 0x94, 0x81, 0xbc, 0x02, 0x1d, 0xb6, 0xeb, 0x08, 0x8e, 0x3c, 0x96, 0x07, 0x3e, 0x3f,
+	0xb8, 0x46, 0xba, 0x69, 0xb9, 0x7d, 0xd5, 0x47, 0x32, 0x18, 0x38, 0xe9, 0xa1, 0xa0, 0x70, 0x00,
+	0x1f, 0x93, 0x21, 0x40, 0xee, 0xa9, 0xc0, 0x49, 0x0a, 0xc0, 0xa6, 0x41, 0x9b, 0x65, 0xf3, 0x99,
+	0x5c, 0x01, 0xe4, 0x9a, 0x5a, 0x10, 0x14, 0xb1, 0x00, 0xc4, 0x57, 0xb2, 0xcc, 0x7f, 0x5b, 0xc9,
+	0xd8, 0x22, 0xdc, 0x61, 0xdd, 0xd2, 0xb2, 0x4d, 0xb6, 0x61, 0x04, 0x6d, 0x9b, 0x50, 0x39, 0x6f,
+	0xf2, 0x78, 0x3d, 0xa4, 0xb7, 0x24, 0x39, 0x06, 0xf5, 0x48, 0x8f, 0x95, 0x96, 0x2f, 0x16, 0x85,
+	0x08, 0x14, 0x4b, 0xb2, 0xc6, 0xd6, 0x60, 0xe9, 0xa3, 0x16, 0x35, 0x56, 0xa6, 0xab, 0xf6, 0x35,
+	0xbc, 0x11, 0x43, 0x2b, 0x77, 0xb2, 0xdd, 0xc7, 0x11, 0xc1, 0xd2, 0x4d, 0xe6, 0x48, 0xc9, 0xc4,
+	0x76, 0x1f, 0x49, 0x7b, 0xc8, 0x49, 0xdc, 0x75, 0x62, 0x9f, 0xd7, 0xdb, 0x23, 0x2a, 0x26, 0x18,
+	0x47, 0x80, 0x20, 0x35, 0x39, 0x45, 0x7b, 0x02, 0xc5, 0x47, 0xce, 0xf9, 0xb2, 0x9e, 0xcb, 0x97,
+	0x52, 0x9b, 0x3c, 0xd3, 0x23, 0x85, 0x9b, 0x63, 0xe7, 0x13, 0x7e, 0x15, 0x5d, 0x6a, 0x52, 0x53,
+	0x4b, 0x4d, 0x19, 0x4a, 0x52, 0xb2, 0x2a, 0xd2, 0x9f, 0x13, 0xb0, 0x2e, 0xad, 0x38, 0xb2, 0xba,
+	0xc4, 0x1c, 0x99, 0x03, 0x82, 0x6e, 0xc3, 0x45, 0xb1, 0x26, 0x11, 0xb9, 0x3b, 0xb0, 0xdd, 0x6a,
+	0xe4, 0xab, 0x0d, 0x5e, 0xae, 0x5c, 0x44, 0x6c, 0x10, 0xfb, 0x8c, 0xcc, 0x16, 0xf9, 0x75, 0xde,
+	0x7d, 0xb9, 0xfb, 0xc2, 0x11, 0x22, 0xb5, 0x29, 0x87, 0x64, 0x39, 0x48, 0x58, 0xb8, 0x6b, 0x63,
+	0xe0, 0xac, 0x74, 0x99, 0x3d, 0x5b, 0x21, 0xe2, 0x20, 0xfe, 0x8a, 0x36, 0x84, 0xcb, 0xac, 0xc7,
+	0x4c, 0xe9, 0xb9, 0xaa, 0x9d, 0x7c, 0x00, 0x85, 0x41, 0x88, 0x55, 0x15, 0x55, 0x8b, 0xe4, 0xd7,
+	0xb4, 0xb4, 0x09, 0x58, 0xbb, 0x0a, 0xb5, 0x79, 0xcf, 0x29, 0x97, 0xdd, 0x0d, 0x2b, 0xe9, 0x55,
+	0x35, 0xd1, 0x2c, 0xd8, 0x5a, 0x20, 0x6c, 0x41, 0xf1, 0xbd, 0xbe, 0xea, 0xa7, 0x70, 0x65, 0xac,
+	0xfa, 0x03, 0x4a, 0x3d, 0xab, 0x1d, 0x44, 0x07, 0xe0, 0x22, 0x5f, 0xb1, 0x5a, 0x75, 0xd9, 0x66,
+	0x68, 0xb3, 0x78, 0x8c, 0x7f, 0xe4, 0x14, 0x14, 0xe5, 0xb0, 0xa3, 0xbd, 0x09, 0x57, 0xe7, 0x4b,
+	0x95, 0x56, 0x34, 0x7e, 0xc8, 0x43, 0x41, 0xed, 0x15, 0xfb, 0x4d, 0xf4, 0x1e, 0xa4, 0xd8, 0x9e,
+	0x87, 0x2e, 0x45, 0x97, 0x8e, 0xf1, 0x92, 0x5c, 0xdb, 0x9c, 0x26, 0x2b, 0x4f, 0x30, 0x2e, 0xb6,
+	0x0d, 0xc6, 0xb8, 0x26, 0xab, 0x70, 0x8c, 0x2b, 0xba, 0x34, 0xbe, 0x0f, 0x69, 0xbe, 0x36, 0xa1,
+	0xcd, 0x99, 0x3d, 0x4a, 0xf2, 0x6d, 0x2d, 0xd8, 0xaf, 0xd0, 0x27, 0x90, 0x95, 0x3b, 0x0b, 0x8a,
+	0xfe, 0x9c, 0x89, 0xed, 0x3a, 0xb5, 0xcb, 0x73, 0x6e, 0x14, 0xbb, 0x0f, 0xd5, 0x45, 0x93, 0x1b,
+	0xdd, 0x8e, 0x5a, 0xb8, 0x7c, 0x23, 0xa9, 0xdd, 0x79, 0x25, 0xac, 0x7a, 0x14, 0xc3, 0x5a, 0x6c,
+	0xd4, 0xa2, 0xed, 0xd8, 0x30, 0x9a, 0x1d, 0xf9, 0xb5, 0x9d, 0xc5, 0x80, 0xa8, 0xcc, 0xc8, 0x18,
+	0x9b, 0x92, 0x39, 0x3b, 0xbe, 0xa7, 0x64, 0xce, 0x1b, 0x99, 0x5f, 0x42, 0x39, 0x3e, 0x6b, 0xd0,
+	0xce, 0x4c, 0xf6, 0x4e, 0x0d, 0xae, 0xda, 0xee, 0x12, 0x84, 0x12, 0x7b, 0x04, 0xc5, 0x48, 0xc3,
+	0x45, 0xd7, 0x66, 0x38, 0xa2, 0x6d, 0xbb, 0xf6, 0xe6, 0xa2, 0xeb, 0x49, 0xe6, 0xf0, 0x4e, 0x18,
+	0xcb, 0x9c, 0x48, 0xd3, 0x8d, 0x65, 0x4e, 0xb4, 0x65, 0x22, 0x03, 0xd0, 0x6c, 0x77, 0x40, 0x6f,
+	0xc7, 0x3d, 0x3d, 0xbf, 0x43, 0xd4, 0xae, 0xaf, 0x40, 0xa9, 0x27, 0x9e, 0xcc, 0x36, 0xe5, 0xdd,
+	0x25, 0xf5, 0xaf, 0x84, 0x6b, 0xcb, 0x20, 0x4a, 0x72, 0x4f, 0xec, 0x86, 0x33, 0x95, 0x8c, 0x6e,
+	0xcc, 0x53, 0x6c, 0xb6, 0x81, 0xd4, 0x6e, 0xae, 0xc4, 0xc9, 0x87, 0x9a, 0xe9, 0x6f, 0x92, 0x6e,
+	0xbb, 0x9d, 0x15, 0xbf, 0xc1, 0xef, 0xfd, 0x0b, 0xee, 0x00, 0xb8, 0x40, 0xa0, 0x13, 0x00, 0x00,
 }
@@ -30,7 +30,7 @@ func (m *SegmentMeta) Reset()         { *m = SegmentMeta{} }
 func (m *SegmentMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentMeta) ProtoMessage()    {}
 func (*SegmentMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streams_bbbe703970d9d652, []int{0}
+	return fileDescriptor_streams_fbd985059902f41f, []int{0}
 }
 func (m *SegmentMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentMeta.Unmarshal(m, b)
@@ -65,10 +65,13 @@ func (m *SegmentMeta) GetKeyNonce() []byte {
 }
 
 type StreamInfo struct {
-	NumberOfSegments     int64    `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
-	SegmentsSize         int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
-	LastSegmentSize      int64    `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
-	Metadata             []byte   `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	NumberOfSegments int64  `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
+	SegmentsSize     int64  `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
+	LastSegmentSize  int64  `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
+	Metadata         []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// etag is the md5 hash of the plain content
+	Etag                 []byte   `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+	VersionId            string   `protobuf:"bytes,6,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -78,7 +81,7 @@ func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
 func (m *StreamInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamInfo) ProtoMessage()    {}
 func (*StreamInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streams_bbbe703970d9d652, []int{1}
+	return fileDescriptor_streams_fbd985059902f41f, []int{1}
 }
 func (m *StreamInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfo.Unmarshal(m, b)
@@ -126,6 +129,20 @@ func (m *StreamInfo) GetMetadata() []byte {
 	return nil
 }
 
+func (m *StreamInfo) GetEtag() []byte {
+	if m != nil {
+		return m.Etag
+	}
+	return nil
+}
+
+func (m *StreamInfo) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
 type StreamMeta struct {
 	EncryptedStreamInfo  []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
 	EncryptionType       int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
@@ -140,7 +157,7 @@ func (m *StreamMeta) Reset()         { *m = StreamMeta{} }
 func (m *StreamMeta) String() string { return proto.CompactTextString(m) }
 func (*StreamMeta) ProtoMessage()    {}
 func (*StreamMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streams_bbbe703970d9d652, []int{2}
+	return fileDescriptor_streams_fbd985059902f41f, []int{2}
 }
 func (m *StreamMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamMeta.Unmarshal(m, b)
@@ -194,27 +211,29 @@ func init() {
 	proto.RegisterType((*StreamMeta)(nil), "streams.StreamMeta")
 }
 
-func init() { proto.RegisterFile("streams.proto", fileDescriptor_streams_bbbe703970d9d652) }
-
-var fileDescriptor_streams_bbbe703970d9d652 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xcb, 0x4e, 0xc3, 0x30,
-	0x10, 0x54, 0x5f, 0x50, 0xb6, 0x29, 0x05, 0x03, 0x52, 0x04, 0x17, 0x14, 0x0e, 0x20, 0x84, 0x7a,
-	0x28, 0x3f, 0x80, 0x7a, 0x43, 0x08, 0x2a, 0x25, 0x9c, 0xb8, 0x58, 0x4e, 0xba, 0x41, 0x51, 0x1a,
-	0x3b, 0x8a, 0xcd, 0xc1, 0xfd, 0x21, 0x3e, 0x8b, 0x5f, 0x41, 0xb6, 0xf3, 0x82, 0xe3, 0xce, 0x8c,
-	0x66, 0x67, 0x76, 0x61, 0x2e, 0x55, 0x85, 0xac, 0x90, 0xcb, 0xb2, 0x12, 0x4a, 0x90, 0xc3, 0x7a,
-	0x0c, 0x36, 0x30, 0x8b, 0xf0, 0xb3, 0x40, 0xae, 0x5e, 0x51, 0x31, 0x72, 0x03, 0x73, 0xe4, 0x49,
-	0xa5, 0x4b, 0x85, 0x5b, 0x9a, 0xa3, 0xf6, 0x07, 0xd7, 0x83, 0x3b, 0x2f, 0xf4, 0x5a, 0xf0, 0x05,
-	0x35, 0xb9, 0x82, 0xa3, 0x1c, 0x35, 0xe5, 0x82, 0x27, 0xe8, 0x0f, 0xad, 0x60, 0x9a, 0xa3, 0x7e,
-	0x33, 0x73, 0xf0, 0x3d, 0x00, 0x88, 0xac, 0xf9, 0x33, 0x4f, 0x05, 0x79, 0x00, 0xc2, 0xbf, 0x8a,
-	0x18, 0x2b, 0x2a, 0x52, 0x2a, 0xdd, 0x26, 0x69, 0x5d, 0x47, 0xe1, 0x89, 0x63, 0x36, 0x69, 0x9d,
-	0x40, 0x9a, 0xf5, 0x8d, 0x86, 0xca, 0x6c, 0xef, 0xdc, 0x47, 0xa1, 0xd7, 0x80, 0x51, 0xb6, 0x47,
-	0x72, 0x0f, 0xa7, 0x3b, 0x26, 0x55, 0xe3, 0xe6, 0x84, 0x23, 0x2b, 0x5c, 0x18, 0xa2, 0x76, 0xb3,
-	0xda, 0x4b, 0x98, 0x16, 0xa8, 0xd8, 0x96, 0x29, 0xe6, 0x8f, 0x5d, 0xd2, 0x66, 0x0e, 0x7e, 0xda,
-	0xa4, 0xb6, 0xfa, 0x0a, 0x2e, 0xba, 0xea, 0xee, 0x3c, 0x34, 0xe3, 0xa9, 0xa8, 0x4f, 0x70, 0xd6,
-	0x92, 0xbd, 0x76, 0xb7, 0xb0, 0xa8, 0xe1, 0x4c, 0x70, 0xaa, 0x74, 0xe9, 0x12, 0x4f, 0xc2, 0xe3,
-	0x0e, 0x7e, 0xd7, 0x25, 0xf6, 0xcc, 0x8d, 0x30, 0xde, 0x89, 0x24, 0xef, 0x72, 0x4f, 0x5a, 0xf3,
-	0x4c, 0xf0, 0xb5, 0xe1, 0x6c, 0xf6, 0xa7, 0x7f, 0x3d, 0x4d, 0x70, 0x5b, 0x62, 0xb6, 0x3a, 0x5f,
-	0x36, 0xef, 0xec, 0x3d, 0xef, 0x4f, 0x7b, 0x03, 0xac, 0xc7, 0x1f, 0xc3, 0x32, 0x8e, 0x0f, 0xec,
-	0xcb, 0x1f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x5e, 0x32, 0x31, 0x03, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("streams.proto", fileDescriptor_streams_fbd985059902f41f) }
+
+var fileDescriptor_streams_fbd985059902f41f = []byte{
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5d, 0x52, 0xcb, 0x4a, 0xc3, 0x40,
+	0x14, 0x25, 0x4d, 0x53, 0xdb, 0xdb, 0xd4, 0xea, 0xa8, 0x10, 0x14, 0x41, 0xea, 0x42, 0x11, 0xe9,
+	0xa2, 0xfe, 0x80, 0x74, 0x57, 0x44, 0x0b, 0x89, 0x2b, 0x37, 0x43, 0x1e, 0x93, 0x12, 0xda, 0xcc,
+	0x84, 0xcc, 0x54, 0x88, 0x9f, 0xea, 0xc6, 0x5f, 0x71, 0x1e, 0x79, 0x54, 0x77, 0x73, 0xcf, 0x39,
+	0x9c, 0x3b, 0xe7, 0xcc, 0xc0, 0x84, 0x8b, 0x92, 0x84, 0x39, 0x9f, 0x17, 0x25, 0x13, 0x0c, 0x1d,
+	0xd5, 0xe3, 0x6c, 0x0d, 0xe3, 0x80, 0x6c, 0x72, 0x42, 0xc5, 0x2b, 0x11, 0x21, 0xba, 0x85, 0x09,
+	0xa1, 0x71, 0x59, 0x15, 0x82, 0x24, 0x78, 0x4b, 0x2a, 0xcf, 0xba, 0xb1, 0xee, 0x5d, 0xdf, 0x6d,
+	0xc1, 0x17, 0x52, 0xa1, 0x2b, 0x18, 0x49, 0x0a, 0x53, 0x46, 0x63, 0xe2, 0xf5, 0xb4, 0x60, 0x28,
+	0x81, 0x37, 0x35, 0xcf, 0xbe, 0x2d, 0x80, 0x40, 0x9b, 0xaf, 0x68, 0xca, 0xd0, 0x23, 0x20, 0xba,
+	0xcf, 0x23, 0x52, 0x62, 0x96, 0x62, 0x6e, 0x36, 0x71, 0xed, 0x6a, 0xfb, 0x27, 0x86, 0x59, 0xa7,
+	0xf5, 0x0d, 0xb8, 0x5a, 0xdf, 0x68, 0x30, 0xcf, 0xbe, 0x8c, 0xbb, 0xed, 0xbb, 0x0d, 0x18, 0x48,
+	0x0c, 0x3d, 0xc0, 0xe9, 0x2e, 0xe4, 0xa2, 0x71, 0x33, 0x42, 0x5b, 0x0b, 0xa7, 0x8a, 0xa8, 0xdd,
+	0xb4, 0xf6, 0x12, 0x86, 0xb9, 0xcc, 0x95, 0x84, 0x22, 0xf4, 0xfa, 0xe6, 0xa6, 0xcd, 0x8c, 0x10,
+	0xf4, 0xe5, 0x71, 0xe3, 0x39, 0x1a, 0xd7, 0x67, 0x74, 0x0d, 0xf0, 0x49, 0x4a, 0x9e, 0x31, 0x8a,
+	0xb3, 0xc4, 0x1b, 0x48, 0x66, 0xe4, 0x8f, 0x6a, 0x64, 0x95, 0xcc, 0x7e, 0xda, 0x70, 0xba, 0xad,
+	0x05, 0x5c, 0x74, 0x6d, 0x99, 0x46, 0x71, 0x26, 0x53, 0xd7, 0xad, 0x9d, 0xb5, 0xe4, 0x41, 0x21,
+	0x77, 0x30, 0xad, 0x61, 0xb5, 0x44, 0x54, 0x85, 0x09, 0xe9, 0xf8, 0xc7, 0x1d, 0xfc, 0x2e, 0xd1,
+	0x03, 0x73, 0x25, 0x8c, 0x76, 0x2c, 0xde, 0x76, 0x51, 0x9d, 0xd6, 0x5c, 0x92, 0x4b, 0xc5, 0xe9,
+	0xb8, 0xcf, 0xff, 0xaa, 0x51, 0x59, 0x75, 0xee, 0xf1, 0xe2, 0x7c, 0xde, 0xfc, 0x80, 0x83, 0xf7,
+	0xfe, 0x53, 0x98, 0x02, 0x96, 0xfd, 0x8f, 0x5e, 0x11, 0x45, 0x03, 0xfd, 0x4b, 0x9e, 0x7e, 0x01,
+	0xb6, 0x87, 0x60, 0xb2, 0x36, 0x02, 0x00, 0x00,
 }
@@ -58,6 +58,7 @@ func CopyNode(src *Node) (dst *Node) {
 		node.Metadata = &NodeMetadata{
 			Email:  src.Metadata.Email,
 			Wallet: src.Metadata.Wallet,
+			Region: src.Metadata.Region,
 		}
 	}
 	if src.Restrictions != nil {
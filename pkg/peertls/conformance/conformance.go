@@ -0,0 +1,288 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package conformance checks an implementation of node IDs, certificate
+// chains, certificate extensions and revocations against fixed test vectors.
+// The vectors are plain data, so other implementations (e.g. an uplink in
+// another language) can check their compatibility with the same vectors.
+package conformance
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/peertls"
+)
+
+// Error is the default conformance errs class
+var Error = errs.Class("conformance error")
+
+// Vectors are the test vectors of every suite. Keys, certificates and
+// extensions are PEM encoded, hashes and node IDs hex encoded.
+type Vectors struct {
+	NodeIDs     []NodeIDVector     `json:"nodeIDs"`
+	Chains      []ChainVector      `json:"chains"`
+	Extensions  []ExtensionVector  `json:"extensions"`
+	Revocations []RevocationVector `json:"revocations"`
+}
+
+// NodeIDVector is a public key and the node ID derived from it,
+// i.e. sha256(sha256(pkix(key)))
+type NodeIDVector struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"publicKey"`
+	NodeID    string `json:"nodeID"`
+	// Encoded is the base58check encoding of the node ID
+	Encoded    string `json:"encoded"`
+	Difficulty uint16 `json:"difficulty"`
+}
+
+// ChainVector is a certificate chain, leaf first, followed by the chains of
+// the CA. The node ID is derived from the key of the CA.
+type ChainVector struct {
+	Name   string `json:"name"`
+	Chain  string `json:"chain"`
+	NodeID string `json:"nodeID"`
+	Valid  bool   `json:"valid"`
+}
+
+// ExtensionVector is a signed certificate extension of a leaf, which is valid
+// when the whitelisted authority signed the leaf
+type ExtensionVector struct {
+	Name      string `json:"name"`
+	Leaf      string `json:"leaf"`
+	Authority string `json:"authority"`
+	Extension string `json:"extension"`
+	Valid     bool   `json:"valid"`
+}
+
+// RevocationVector is a revocation extension of a certificate, which is valid
+// when the authority signed it
+type RevocationVector struct {
+	Name      string `json:"name"`
+	Authority string `json:"authority"`
+	Revoked   string `json:"revoked"`
+	Extension string `json:"extension"`
+	Timestamp int64  `json:"timestamp"`
+	// CertHash is the hash of the revoked certificate
+	CertHash string `json:"certHash"`
+	// SignedHash is the hash the signature of the revocation is of
+	SignedHash string `json:"signedHash"`
+	Valid      bool   `json:"valid"`
+}
+
+// Result is the outcome of checking one vector, Err is nil when it passed
+type Result struct {
+	Suite string
+	Name  string
+	Err   error
+}
+
+// ReadVectors reads vectors encoded as json
+func ReadVectors(r io.Reader) (vectors Vectors, err error) {
+	err = json.NewDecoder(r).Decode(&vectors)
+	return vectors, Error.Wrap(err)
+}
+
+// WriteVectors writes vectors encoded as json
+func WriteVectors(w io.Writer, vectors Vectors) error {
+	data, err := json.MarshalIndent(vectors, "", "\t")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	_, err = w.Write(append(data, '\n'))
+	return Error.Wrap(err)
+}
+
+// Run checks every vector against this implementation
+func Run(vectors Vectors) (results []Result) {
+	for _, vector := range vectors.NodeIDs {
+		results = append(results, Result{Suite: "node id", Name: vector.Name, Err: CheckNodeID(vector)})
+	}
+	for _, vector := range vectors.Chains {
+		results = append(results, Result{Suite: "chain", Name: vector.Name, Err: CheckChain(vector)})
+	}
+	for _, vector := range vectors.Extensions {
+		results = append(results, Result{Suite: "extension", Name: vector.Name, Err: CheckExtension(vector)})
+	}
+	for _, vector := range vectors.Revocations {
+		results = append(results, Result{Suite: "revocation", Name: vector.Name, Err: CheckRevocation(vector)})
+	}
+	return results
+}
+
+// CheckNodeID checks the node ID derived from the public key
+func CheckNodeID(vector NodeIDVector) error {
+	block, _ := pem.Decode([]byte(vector.PublicKey))
+	if block == nil {
+		return Error.New("no PEM encoded public key")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	id, err := identity.NodeIDFromKey(key)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := compareHex("node ID", id.Bytes(), vector.NodeID); err != nil {
+		return err
+	}
+	if id.String() != vector.Encoded {
+		return Error.New("encoded node ID %s, expected %s", id.String(), vector.Encoded)
+	}
+
+	difficulty, err := id.Difficulty()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if difficulty != vector.Difficulty {
+		return Error.New("difficulty %d, expected %d", difficulty, vector.Difficulty)
+	}
+	return nil
+}
+
+// CheckChain checks the node ID and the signatures of the certificate chain
+func CheckChain(vector ChainVector) error {
+	certs, err := identity.DecodeAndParseChainPEM([]byte(vector.Chain))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	peer, err := identity.PeerIdentityFromChain(certs)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := compareHex("node ID", peer.ID.Bytes(), vector.NodeID); err != nil {
+		return err
+	}
+
+	raw := make([][]byte, 0, len(certs))
+	for _, cert := range certs {
+		raw = append(raw, cert.Raw)
+	}
+	return expect(vector.Valid, peertls.VerifyPeerFunc(peertls.VerifyPeerCertChains)(raw, nil))
+}
+
+// CheckExtension checks the signed certificate extension of the leaf against
+// the whitelisted authority
+func CheckExtension(vector ExtensionVector) error {
+	leaf, err := parseCert(vector.Leaf)
+	if err != nil {
+		return err
+	}
+	authority, err := parseCert(vector.Authority)
+	if err != nil {
+		return err
+	}
+	ext, err := parseExtension(vector.Extension, peertls.SignedCertExtID)
+	if err != nil {
+		return err
+	}
+	leaf.ExtraExtensions = []pkix.Extension{ext}
+
+	handlers := peertls.ParseExtensions(peertls.TLSExtConfig{WhitelistSignedLeaf: true}, peertls.ParseExtOptions{
+		CAWhitelist: []*x509.Certificate{authority},
+	})
+	return expect(vector.Valid, handlers.VerifyFunc()(nil, [][]*x509.Certificate{{leaf, authority}}))
+}
+
+// CheckRevocation checks the encoding, hashes and signature of the revocation
+func CheckRevocation(vector RevocationVector) error {
+	authority, err := parseCert(vector.Authority)
+	if err != nil {
+		return err
+	}
+	revoked, err := parseCert(vector.Revoked)
+	if err != nil {
+		return err
+	}
+	ext, err := parseExtension(vector.Extension, peertls.RevocationExtID)
+	if err != nil {
+		return err
+	}
+
+	var revocation peertls.Revocation
+	if err := revocation.Unmarshal(ext.Value); err != nil {
+		return Error.Wrap(err)
+	}
+	if revocation.Timestamp != vector.Timestamp {
+		return Error.New("timestamp %d, expected %d", revocation.Timestamp, vector.Timestamp)
+	}
+
+	certHash := sha256.Sum256(revoked.Raw)
+	if err := compareHex("hash of the revoked certificate", certHash[:], vector.CertHash); err != nil {
+		return err
+	}
+	if err := compareHex("revoked certificate hash", revocation.CertHash, vector.CertHash); err != nil {
+		return err
+	}
+
+	signed, err := revocation.TBSBytes()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := compareHex("signed hash", signed, vector.SignedHash); err != nil {
+		return err
+	}
+
+	return expect(vector.Valid, revocation.Verify(authority))
+}
+
+// parseCert parses a PEM encoded certificate
+func parseCert(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != peertls.BlockTypeCertificate {
+		return nil, Error.New("no PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	return cert, Error.Wrap(err)
+}
+
+// parseExtension parses a PEM encoded extension and checks its id
+func parseExtension(data string, extID int) (ext pkix.Extension, err error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != peertls.BlockTypeExtension {
+		return ext, Error.New("no PEM encoded extension")
+	}
+	rest, err := asn1.Unmarshal(block.Bytes, &ext)
+	if err != nil {
+		return ext, Error.Wrap(err)
+	}
+	if len(rest) > 0 {
+		return ext, Error.New("%d trailing bytes after the extension", len(rest))
+	}
+	if !ext.Id.Equal(peertls.ExtensionIDs[extID]) {
+		return ext, Error.New("extension id %s, expected %s", ext.Id, peertls.ExtensionIDs[extID])
+	}
+	return ext, nil
+}
+
+// compareHex compares value with the hex encoded expected value
+func compareHex(what string, value []byte, expected string) error {
+	if hex.EncodeToString(value) != expected {
+		return Error.New("%s %x, expected %s", what, value, expected)
+	}
+	return nil
+}
+
+// expect checks that the verification of a vector failed when it's invalid
+func expect(valid bool, err error) error {
+	switch {
+	case valid && err != nil:
+		return Error.New("expected to be valid: %v", err)
+	case !valid && err == nil:
+		return Error.New("expected to be invalid")
+	}
+	return nil
+}
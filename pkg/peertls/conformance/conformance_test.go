@@ -0,0 +1,49 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package conformance_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/peertls/conformance"
+)
+
+func TestDefault(t *testing.T) {
+	results := conformance.Run(conformance.Default)
+	require.NotEmpty(t, results)
+	for _, result := range results {
+		assert.NoError(t, result.Err, result.Suite+": "+result.Name)
+	}
+}
+
+func TestTampered(t *testing.T) {
+	nodeID := conformance.Default.NodeIDs[0]
+	nodeID.Difficulty++
+	assert.Error(t, conformance.CheckNodeID(nodeID))
+
+	chain := conformance.Default.Chains[0]
+	chain.Valid = !chain.Valid
+	assert.Error(t, conformance.CheckChain(chain))
+
+	extension := conformance.Default.Extensions[0]
+	extension.Authority = conformance.Default.Extensions[0].Leaf
+	assert.Error(t, conformance.CheckExtension(extension))
+
+	revocation := conformance.Default.Revocations[0]
+	revocation.Timestamp++
+	assert.Error(t, conformance.CheckRevocation(revocation))
+}
+
+func TestReadWriteVectors(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, conformance.WriteVectors(&buf, conformance.Default))
+
+	vectors, err := conformance.ReadVectors(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, conformance.Default, vectors)
+}
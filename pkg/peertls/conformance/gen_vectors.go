@@ -0,0 +1,218 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information
+
+// +build ignore
+
+// gen_vectors generates the test vectors of the conformance suites
+package main
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/peertls"
+	"storj.io/storj/pkg/peertls/conformance"
+	"storj.io/storj/pkg/storj"
+)
+
+func main() {
+	out := flag.String("out", "vectors.go", "generated file")
+	flag.Parse()
+
+	var vectors conformance.Vectors
+
+	for _, difficulty := range []uint16{0, 10} {
+		key, id := keyWithDifficulty(difficulty)
+		publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		check(err)
+		actual, err := id.Difficulty()
+		check(err)
+		vectors.NodeIDs = append(vectors.NodeIDs, conformance.NodeIDVector{
+			Name:       fmt.Sprintf("difficulty of at least %d", difficulty),
+			PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+			NodeID:     hex.EncodeToString(id.Bytes()),
+			Encoded:    id.String(),
+			Difficulty: actual,
+		})
+	}
+
+	rootKey, root := newCert(nil, nil, true)
+	caKey, ca := newCert(rootKey, root, true)
+	_, leaf := newCert(caKey, ca, false)
+	selfSignedCA := newCertWithKey(caKey, nil, nil, true)
+	otherRootKey, otherRoot := newCert(nil, nil, true)
+	crossSignedCA := newCertWithKey(caKey, otherRootKey, otherRoot, true)
+	otherKey, other := newCert(nil, nil, true)
+	_, foreignLeaf := newCert(otherKey, other, false)
+	caID, err := identity.NodeIDFromKey(&caKey.PublicKey)
+	check(err)
+
+	for _, chain := range []struct {
+		name  string
+		certs []*x509.Certificate
+		valid bool
+	}{
+		{"leaf and self-signed CA", []*x509.Certificate{leaf, selfSignedCA}, true},
+		{"leaf, CA and root", []*x509.Certificate{leaf, ca, root}, true},
+		{"cross-signed CA", []*x509.Certificate{leaf, ca, root, crossSignedCA, otherRoot}, true},
+		{"leaf not signed by the CA", []*x509.Certificate{foreignLeaf, ca, root}, false},
+	} {
+		encoded, err := peertls.ChainBytes(chain.certs...)
+		check(err)
+		vectors.Chains = append(vectors.Chains, conformance.ChainVector{
+			Name:   chain.name,
+			Chain:  string(encoded),
+			NodeID: hex.EncodeToString(caID.Bytes()),
+			Valid:  chain.valid,
+		})
+	}
+
+	for _, signer := range []struct {
+		name  string
+		key   crypto.PrivateKey
+		valid bool
+	}{
+		{"signed by the whitelisted CA", caKey, true},
+		{"signed by another CA", otherKey, false},
+	} {
+		_, signed := newCert(caKey, ca, false)
+		check(peertls.AddSignedCertExt(signer.key, signed))
+		vectors.Extensions = append(vectors.Extensions, conformance.ExtensionVector{
+			Name:      signer.name,
+			Leaf:      encodeCert(signed),
+			Authority: encodeCert(ca),
+			Extension: encodeExtension(signed.ExtraExtensions[0]),
+			Valid:     signer.valid,
+		})
+	}
+
+	for _, signer := range []struct {
+		name  string
+		key   crypto.PrivateKey
+		valid bool
+	}{
+		{"revoked by the CA", caKey, true},
+		{"revoked by another key", otherKey, false},
+	} {
+		ext, err := peertls.NewRevocationExt(signer.key, leaf)
+		check(err)
+		var revocation peertls.Revocation
+		check(revocation.Unmarshal(ext.Value))
+		signed, err := revocation.TBSBytes()
+		check(err)
+		certHash := sha256.Sum256(leaf.Raw)
+		vectors.Revocations = append(vectors.Revocations, conformance.RevocationVector{
+			Name:       signer.name,
+			Authority:  encodeCert(ca),
+			Revoked:    encodeCert(leaf),
+			Extension:  encodeExtension(ext),
+			Timestamp:  revocation.Timestamp,
+			CertHash:   hex.EncodeToString(certHash[:]),
+			SignedHash: hex.EncodeToString(signed),
+			Valid:      signer.valid,
+		})
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`
+		// Copyright (C) 2019 Storj Labs, Inc.
+		// See LICENSE for copying information
+
+		// Code generated by gen_vectors. DO NOT EDIT.
+
+		package conformance
+
+		// Default are the test vectors every implementation is checked against
+		var Default = Vectors{
+	`)
+
+	buf.WriteString("NodeIDs: []NodeIDVector{\n")
+	for _, v := range vectors.NodeIDs {
+		fmt.Fprintf(&buf, "{Name: %q, PublicKey: %q, NodeID: %q, Encoded: %q, Difficulty: %d},\n",
+			v.Name, v.PublicKey, v.NodeID, v.Encoded, v.Difficulty)
+	}
+	buf.WriteString("},\nChains: []ChainVector{\n")
+	for _, v := range vectors.Chains {
+		fmt.Fprintf(&buf, "{Name: %q, Chain: %q, NodeID: %q, Valid: %t},\n",
+			v.Name, v.Chain, v.NodeID, v.Valid)
+	}
+	buf.WriteString("},\nExtensions: []ExtensionVector{\n")
+	for _, v := range vectors.Extensions {
+		fmt.Fprintf(&buf, "{Name: %q, Leaf: %q, Authority: %q, Extension: %q, Valid: %t},\n",
+			v.Name, v.Leaf, v.Authority, v.Extension, v.Valid)
+	}
+	buf.WriteString("},\nRevocations: []RevocationVector{\n")
+	for _, v := range vectors.Revocations {
+		fmt.Fprintf(&buf, "{Name: %q, Authority: %q, Revoked: %q, Extension: %q, Timestamp: %d, CertHash: %q, SignedHash: %q, Valid: %t},\n",
+			v.Name, v.Authority, v.Revoked, v.Extension, v.Timestamp, v.CertHash, v.SignedHash, v.Valid)
+	}
+	buf.WriteString("},\n}\n")
+
+	formatted, err := format.Source(buf.Bytes())
+	check(err)
+	check(ioutil.WriteFile(*out, formatted, 0644))
+}
+
+// keyWithDifficulty generates keys until the node ID of one has at least difficulty
+func keyWithDifficulty(difficulty uint16) (*ecdsa.PrivateKey, storj.NodeID) {
+	for {
+		key, err := peertls.NewKey()
+		check(err)
+		id, err := identity.NodeIDFromKey(&key.PublicKey)
+		check(err)
+		actual, err := id.Difficulty()
+		check(err)
+		if actual >= difficulty {
+			return key, id
+		}
+	}
+}
+
+// newCert creates a key and a certificate for it signed by parentKey, or
+// self-signed when parentKey is nil
+func newCert(parentKey crypto.PrivateKey, parent *x509.Certificate, isCA bool) (*ecdsa.PrivateKey, *x509.Certificate) {
+	key, err := peertls.NewKey()
+	check(err)
+	return key, newCertWithKey(key, parentKey, parent, isCA)
+}
+
+// newCertWithKey creates a certificate for key signed by parentKey, or
+// self-signed when parentKey is nil
+func newCertWithKey(key, parentKey crypto.PrivateKey, parent *x509.Certificate, isCA bool) *x509.Certificate {
+	template, err := peertls.LeafTemplate()
+	if isCA {
+		template, err = peertls.CATemplate()
+	}
+	check(err)
+	cert, err := peertls.NewCert(key, parentKey, template, parent)
+	check(err)
+	return cert
+}
+
+func encodeCert(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(peertls.NewCertBlock(cert.Raw)))
+}
+
+func encodeExtension(ext pkix.Extension) string {
+	data, err := asn1.Marshal(ext)
+	check(err)
+	return string(pem.EncodeToMemory(peertls.NewExtensionBlock(data)))
+}
+
+func check(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -69,10 +69,22 @@ func VerifyPeerFunc(next ...PeerCertVerificationFunc) PeerCertVerificationFunc {
 		if err != nil {
 			return ErrVerifyPeerCert.Wrap(err)
 		}
+		if len(c) == 0 {
+			return ErrVerifyPeerCert.New("no certificates")
+		}
+
+		// cross-signed chains follow the primary chain without repeating the leaf
+		chains := [][]*x509.Certificate{c}
+		if len(c) > CAIndex {
+			chains = SplitChains(c[CAIndex:])
+			for i := range chains {
+				chains[i] = append([]*x509.Certificate{c[LeafIndex]}, chains[i]...)
+			}
+		}
 
 		for _, n := range next {
 			if n != nil {
-				if err := n(chain, [][]*x509.Certificate{c}); err != nil {
+				if err := n(chain, chains); err != nil {
 					return ErrVerifyPeerCert.Wrap(err)
 				}
 			}
@@ -81,29 +93,70 @@ func VerifyPeerFunc(next ...PeerCertVerificationFunc) PeerCertVerificationFunc {
 	}
 }
 
-// VerifyPeerCertChains verifies that the first certificate chain contains certificates
-// which are signed by their respective parents, ending with a self-signed root.
+// VerifyPeerCertChains verifies that every certificate chain contains certificates
+// which are signed by their respective parents, ending with a self-signed root,
+// and that cross-signed chains are for the same CA key as the first chain.
 func VerifyPeerCertChains(_ [][]byte, parsedChains [][]*x509.Certificate) error {
-	return verifyChainSignatures(parsedChains[0])
+	for i, chain := range parsedChains {
+		if i > 0 {
+			if len(chain) <= CAIndex {
+				return ErrVerifyCertificateChain.New("cross-signed chain without CA certificate")
+			}
+			if !bytes.Equal(chain[CAIndex].RawSubjectPublicKeyInfo, parsedChains[0][CAIndex].RawSubjectPublicKeyInfo) {
+				return ErrVerifyCertificateChain.New("cross-signed chain for a different CA key")
+			}
+		}
+		if err := verifyChainSignatures(chain); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // VerifyCAWhitelist verifies that the peer identity's CA was signed by any one
-// of the (certificate authority) certificates in the provided whitelist.
+// of the (certificate authority) certificates in the provided whitelist, in
+// any of the peer's chains.
 func VerifyCAWhitelist(cas []*x509.Certificate) PeerCertVerificationFunc {
 	if cas == nil {
 		return nil
 	}
 	return func(_ [][]byte, parsedChains [][]*x509.Certificate) error {
-		for _, ca := range cas {
-			err := verifyCertSignature(ca, parsedChains[0][CAIndex])
-			if err == nil {
-				return nil
+		for _, chain := range parsedChains {
+			if len(chain) <= CAIndex {
+				continue
+			}
+			for _, ca := range cas {
+				err := verifyCertSignature(ca, chain[CAIndex])
+				if err == nil {
+					return nil
+				}
 			}
 		}
 		return ErrVerifyCAWhitelist.New("CA cert")
 	}
 }
 
+// SplitChains splits a CA certificate chain (i.e. without leaf) into the
+// parallel chains it consists of. Every chain ends with a self-signed root and
+// the certificates following a root start the next chain. The first chain is
+// the primary one, the others are chains of the same CA key cross-signed by
+// other authorities.
+func SplitChains(certs []*x509.Certificate) [][]*x509.Certificate {
+	var chains [][]*x509.Certificate
+	var chain []*x509.Certificate
+	for _, cert := range certs {
+		chain = append(chain, cert)
+		if verifyCertSignature(cert, cert) == nil {
+			chains = append(chains, chain)
+			chain = nil
+		}
+	}
+	if len(chain) > 0 {
+		chains = append(chains, chain)
+	}
+	return chains
+}
+
 // NewKeyBlock converts an ASN1/DER-encoded byte-slice of a private key into
 // a `pem.Block` pointer.
 func NewKeyBlock(b []byte) *pem.Block {
@@ -0,0 +1,27 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lazyfilewalker
+
+import (
+	"github.com/zeebo/errs"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ioprioWhoPgrp    = 2
+	ioprioClassIdle  = 3
+	ioprioClassShift = 13
+)
+
+// lowerPriority sets the lowest CPU priority and the idle IO class for the
+// process group, on Linux both are per thread, so the process group is used
+// to include all threads of the process
+func lowerPriority() error {
+	var group errs.Group
+	group.Add(unix.Setpriority(unix.PRIO_PGRP, 0, 19))
+	if _, _, errno := unix.Syscall(unix.SYS_IOPRIO_SET, ioprioWhoPgrp, 0, ioprioClassIdle<<ioprioClassShift); errno != 0 {
+		group.Add(errno)
+	}
+	return group.Err()
+}
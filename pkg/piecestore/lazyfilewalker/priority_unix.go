@@ -0,0 +1,15 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build !linux,!windows
+
+package lazyfilewalker
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// lowerPriority sets the lowest CPU priority for the process group
+func lowerPriority() error {
+	return unix.Setpriority(unix.PRIO_PGRP, 0, 19)
+}
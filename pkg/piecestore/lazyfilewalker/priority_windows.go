@@ -0,0 +1,28 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build windows
+
+package lazyfilewalker
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+const processModeBackgroundBegin = 0x00100000
+
+var procSetPriorityClass = windows.NewLazySystemDLL("kernel32.dll").NewProc("SetPriorityClass")
+
+// lowerPriority switches the process into background mode, which lowers
+// both the CPU and the IO priority
+func lowerPriority() error {
+	process, err := windows.GetCurrentProcess()
+	if err != nil {
+		return err
+	}
+	r, _, err := procSetPriorityClass.Call(uintptr(process), processModeBackgroundBegin)
+	if r == 0 {
+		return err
+	}
+	return nil
+}
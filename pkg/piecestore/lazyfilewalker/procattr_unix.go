@@ -0,0 +1,17 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build !windows
+
+package lazyfilewalker
+
+import (
+	"syscall"
+)
+
+// sysProcAttr returns the attributes for starting the filewalker process,
+// it is started in a separate process group, so lowering the priority of
+// the group doesn't affect the storage node
+func sysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true}
+}
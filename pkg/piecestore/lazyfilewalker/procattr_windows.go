@@ -0,0 +1,13 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build windows
+
+package lazyfilewalker
+
+import (
+	"syscall"
+)
+
+// sysProcAttr returns the attributes for starting the filewalker process
+func sysProcAttr() *syscall.SysProcAttr { return nil }
@@ -0,0 +1,99 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package lazyfilewalker runs the piece filewalkers in a separate process
+// with a low IO and CPU priority, so that walking all the pieces doesn't
+// compete with serving uploads and downloads.
+package lazyfilewalker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	pstore "storj.io/storj/pkg/piecestore"
+)
+
+var (
+	// Error is the default error class for lazyfilewalker
+	Error = errs.Class("lazyfilewalker error")
+	mon   = monkit.Package()
+)
+
+// UsedSpaceCommand is the subcommand of the storage node binary which runs the used space filewalker
+const UsedSpaceCommand = "used-space-filewalker"
+
+// Supervisor starts the filewalker processes and reads their results
+type Supervisor struct {
+	log        *zap.Logger
+	executable string
+}
+
+// NewSupervisor creates a new supervisor, executable is the storage node
+// binary which implements the filewalker subcommands
+func NewSupervisor(log *zap.Logger, executable string) *Supervisor {
+	return &Supervisor{
+		log:        log,
+		executable: executable,
+	}
+}
+
+// WalkUsedSpace walks the pieces stored in dir in a separate low priority process
+func (supervisor *Supervisor) WalkUsedSpace(ctx context.Context, dir string) (used pstore.UsedSpace, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the result is read from stdout, so ensure logs never end up there
+	cmd := exec.CommandContext(ctx, supervisor.executable, UsedSpaceCommand, "--storage-dir", dir, "--log.output", "stderr")
+	cmd.SysProcAttr = sysProcAttr()
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return pstore.UsedSpace{}, Error.Wrap(err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return pstore.UsedSpace{}, Error.Wrap(err)
+	}
+	supervisor.log.Debug("started used space filewalker", zap.Int("pid", cmd.Process.Pid), zap.String("dir", dir))
+
+	decodeErr := json.NewDecoder(stdout).Decode(&used)
+	// read the rest of the output, so the process doesn't block on writing
+	_, _ = io.Copy(ioutil.Discard, stdout)
+
+	if err := cmd.Wait(); err != nil {
+		return pstore.UsedSpace{}, Error.New("%v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	if decodeErr != nil {
+		return pstore.UsedSpace{}, Error.New("invalid output: %v", decodeErr)
+	}
+	if stderr.Len() > 0 {
+		supervisor.log.Debug("used space filewalker output", zap.String("stderr", stderr.String()))
+	}
+	return used, nil
+}
+
+// RunUsedSpace is run by the used space filewalker process, it lowers the
+// priority of the process, walks the pieces stored in dir and writes the
+// result to w
+func RunUsedSpace(ctx context.Context, log *zap.Logger, dir string, w io.Writer) (err error) {
+	if err := lowerPriority(); err != nil {
+		log.Warn("unable to lower the priority of the filewalker", zap.Error(err))
+	}
+
+	used, err := pstore.NewStorage(dir).WalkUsedSpace(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(json.NewEncoder(w).Encode(used))
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lazyfilewalker_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/lazyfilewalker"
+)
+
+const childEnv = "STORJ_TEST_LAZYFILEWALKER_CHILD"
+
+// TestMain lets the test binary act as the filewalker process
+func TestMain(m *testing.M) {
+	if os.Getenv(childEnv) == "1" && len(os.Args) > 3 && os.Args[1] == lazyfilewalker.UsedSpaceCommand {
+		err := lazyfilewalker.RunUsedSpace(context.Background(), zap.NewNop(), os.Args[3], os.Stdout)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestWalkUsedSpace(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	require.NoError(t, os.Setenv(childEnv, "1"))
+	defer func() { _ = os.Unsetenv(childEnv) }()
+
+	store := pstore.NewStorage(ctx.Dir("pieces"))
+	defer ctx.Check(store.Close)
+
+	for i, size := range []int{1000, 2000} {
+		w, err := store.Writer(strings.Repeat(fmt.Sprintf("CD%02d", i), 10))
+		require.NoError(t, err)
+		_, err = w.Write(make([]byte, size))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+	}
+
+	supervisor := lazyfilewalker.NewSupervisor(zap.NewNop(), os.Args[0])
+
+	used, err := supervisor.WalkUsedSpace(ctx, store.Dir())
+	require.NoError(t, err)
+	assert.Equal(t, pstore.UsedSpace{Pieces: 2, Bytes: 3000}, used)
+
+	// errors in the process are returned
+	_, err = supervisor.WalkUsedSpace(ctx, ctx.File("missing"))
+	assert.Error(t, err)
+}
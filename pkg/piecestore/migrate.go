@@ -0,0 +1,215 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// MigrateError is a type of error for failures when migrating the storage directory
+var MigrateError = errs.Class("migrate storage error")
+
+// migratingSuffix is added to files while they are copied, so an interrupted
+// copy is never mistaken for a complete one
+const migratingSuffix = ".migrating"
+
+// MigrateOptions configures how the storage directory is migrated
+type MigrateOptions struct {
+	// SampleSize is how many pieces are compared by hash after copying,
+	// the databases are always compared
+	SampleSize int
+}
+
+// MigrateProgress describes how far the migration got
+type MigrateProgress struct {
+	Files   int64 // files copied or skipped so far
+	Bytes   int64 // bytes of these files
+	Skipped int64 // files which were already copied by an interrupted migration
+}
+
+// Migrate copies the pieces and databases in the storage directory from to
+// the directory to and verifies the copy. Pieces which were already copied by
+// an interrupted migration are skipped, the databases are always copied
+// again. The storage node must not run while migrating.
+func Migrate(ctx context.Context, from, to string, options MigrateOptions, progress func(MigrateProgress)) (result MigrateProgress, err error) {
+	from, to = filepath.Clean(from), filepath.Clean(to)
+	if rel, err := filepath.Rel(from, to); err == nil && !strings.HasPrefix(rel, "..") {
+		return result, MigrateError.New("%q is inside of %q", to, from)
+	}
+
+	err = walkFiles(ctx, from, func(rel string, info os.FileInfo) error {
+		dst := filepath.Join(to, rel)
+
+		// pieces don't change once stored, unlike the databases in the root
+		if isPieceFile(rel) {
+			if existing, err := os.Stat(dst); err == nil && existing.Size() == info.Size() {
+				result.Skipped++
+				result.Files++
+				result.Bytes += info.Size()
+				if progress != nil {
+					progress(result)
+				}
+				return nil
+			}
+		}
+
+		if err := copyFile(filepath.Join(from, rel), dst, info.Mode()); err != nil {
+			return err
+		}
+
+		result.Files++
+		result.Bytes += info.Size()
+		if progress != nil {
+			progress(result)
+		}
+		return nil
+	})
+	if err != nil {
+		return result, MigrateError.Wrap(err)
+	}
+
+	return result, MigrateError.Wrap(VerifyMigration(ctx, from, to, options.SampleSize))
+}
+
+// VerifyMigration checks that to contains the same number of files and bytes
+// as from, and that the databases and a random sample of the pieces are equal
+func VerifyMigration(ctx context.Context, from, to string, sampleSize int) error {
+	var sourceFiles, sourceBytes, pieces int64
+	var compare, sample []string
+	err := walkFiles(ctx, from, func(rel string, info os.FileInfo) error {
+		sourceFiles++
+		sourceBytes += info.Size()
+		if !isPieceFile(rel) {
+			compare = append(compare, rel)
+			return nil
+		}
+
+		// reservoir sampling of the pieces
+		pieces++
+		if len(sample) < sampleSize {
+			sample = append(sample, rel)
+		} else if i := rand.Int63n(pieces); i < int64(sampleSize) {
+			sample[i] = rel
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var targetFiles, targetBytes int64
+	err = walkFiles(ctx, to, func(rel string, info os.FileInfo) error {
+		targetFiles++
+		targetBytes += info.Size()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if sourceFiles != targetFiles || sourceBytes != targetBytes {
+		return errs.New("copied %d files with %d bytes, expected %d files with %d bytes", targetFiles, targetBytes, sourceFiles, sourceBytes)
+	}
+
+	for _, rel := range append(compare, sample...) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		sourceHash, err := hashFile(filepath.Join(from, rel))
+		if err != nil {
+			return err
+		}
+		targetHash, err := hashFile(filepath.Join(to, rel))
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(sourceHash, targetHash) {
+			return errs.New("copy of %q differs", rel)
+		}
+	}
+	return nil
+}
+
+// walkFiles calls fn with the path relative to root of every file in root,
+// the files left by an interrupted copy are skipped
+func walkFiles(ctx context.Context, root string, fn func(rel string, info os.FileInfo) error) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(path, migratingSuffix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		return fn(rel, info)
+	})
+}
+
+// isPieceFile returns whether the file at rel is a piece or a trashed piece,
+// every other file, such as the databases, is in the root of the storage directory
+func isPieceFile(rel string) bool {
+	return strings.ContainsRune(rel, filepath.Separator)
+}
+
+// copyFile copies src to dst, dst only appears once it's completely written
+func copyFile(src, dst string, mode os.FileMode) (err error) {
+	if err := os.MkdirAll(filepath.Dir(dst), 0700); err != nil {
+		return err
+	}
+
+	source, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, source.Close()) }()
+
+	tmp := dst + migratingSuffix
+	target, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(target, source)
+	if err == nil {
+		err = target.Sync()
+	}
+	err = errs.Combine(err, target.Close())
+	if err != nil {
+		return errs.Combine(err, os.Remove(tmp))
+	}
+
+	return os.Rename(tmp, dst)
+}
+
+// hashFile returns the sha256 hash of the content of the file at path
+func hashFile(path string) (_ []byte, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
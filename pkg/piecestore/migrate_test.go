@@ -0,0 +1,66 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pstore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+)
+
+func TestMigrate(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	from, to := ctx.Dir("from"), ctx.Dir("to")
+
+	files := map[string]string{
+		"piecestore.db": "database",
+		filepath.Join("ab", "cd", "efghijklmnop"):    "piece1",
+		filepath.Join("ab", "ce", "efghijklmnop"):    "piece2",
+		filepath.Join(trashDir, "zz", "yy", "xwvut"): "trashed",
+	}
+	for rel, content := range files {
+		path := filepath.Join(from, rel)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	}
+
+	// an interrupted migration already copied a piece and left a partial copy
+	copied := filepath.Join(to, "ab", "cd", "efghijklmnop")
+	require.NoError(t, os.MkdirAll(filepath.Dir(copied), 0700))
+	require.NoError(t, ioutil.WriteFile(copied, []byte("piece1"), 0600))
+	partial := filepath.Join(to, "ab", "ce", "efghijklmnop") + migratingSuffix
+	require.NoError(t, os.MkdirAll(filepath.Dir(partial), 0700))
+	require.NoError(t, ioutil.WriteFile(partial, []byte("pie"), 0600))
+
+	var reports int
+	result, err := Migrate(ctx, from, to, MigrateOptions{SampleSize: 10}, func(MigrateProgress) { reports++ })
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), result.Files)
+	assert.Equal(t, int64(1), result.Skipped)
+	assert.Equal(t, 4, reports)
+
+	for rel, content := range files {
+		data, err := ioutil.ReadFile(filepath.Join(to, rel))
+		require.NoError(t, err)
+		assert.Equal(t, content, string(data))
+	}
+	_, err = os.Stat(partial)
+	assert.True(t, os.IsNotExist(err))
+
+	// a corrupted copy is found when verifying
+	require.NoError(t, ioutil.WriteFile(filepath.Join(to, "piecestore.db"), []byte("databasf"), 0600))
+	assert.Error(t, VerifyMigration(ctx, from, to, 10))
+
+	// migrating into the storage directory itself isn't allowed
+	_, err = Migrate(ctx, from, filepath.Join(from, "new"), MigrateOptions{}, nil)
+	assert.True(t, MigrateError.Has(err))
+}
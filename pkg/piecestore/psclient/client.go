@@ -16,6 +16,7 @@ import (
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/bloomfilter"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/ranger"
@@ -29,6 +30,7 @@ var ClientError = errs.Class("piecestore client error")
 var (
 	defaultBandwidthMsgSize = 32 * memory.KB
 	maxBandwidthMsgSize     = 64 * memory.KB
+	integrityFrameSize      = 256 * memory.KiB
 )
 
 func init() {
@@ -38,6 +40,9 @@ func init() {
 	flag.Var(&maxBandwidthMsgSize,
 		"piecestore.rpc.client.max-bandwidth-msg-size",
 		"max bandwidth message size in bytes")
+	flag.Var(&integrityFrameSize,
+		"piecestore.rpc.client.integrity-frame-size",
+		"bytes covered by each integrity checksum of uploads, 0 disables them")
 }
 
 // Client is an interface describing the functions for interacting with piecestore nodes
@@ -46,6 +51,9 @@ type Client interface {
 	Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error
 	Get(ctx context.Context, id PieceID, size int64, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (ranger.Ranger, error)
 	Delete(ctx context.Context, pieceID PieceID, authorization *pb.SignedMessage) error
+	Retain(ctx context.Context, filter *bloomfilter.Filter, createdBefore time.Time) (trashed int64, err error)
+	RestoreTrash(ctx context.Context, trashedAfter time.Time) (restored int64, err error)
+	ReportStats(ctx context.Context, stats *pb.ReportStatsRequest) error
 	io.Closer
 }
 
@@ -118,39 +126,57 @@ func (ps *PieceStore) Meta(ctx context.Context, id PieceID) (*pb.PieceSummary, e
 
 // Put uploads a Piece to a piece store Server
 func (ps *PieceStore) Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error {
-	stream, err := ps.client.Store(ctx)
-	if err != nil {
-		return err
+	var frames *frameWriter
+	if integrityFrameSize > 0 {
+		frames = newFrameWriter(integrityFrameSize.Int64())
 	}
 
-	msg := &pb.PieceStore{
-		PieceData:     &pb.PieceStore_PieceData{Id: id.String(), ExpirationUnixSec: ttl.Unix()},
-		Authorization: authorization,
-	}
-	if err = stream.Send(msg); err != nil {
-		if _, closeErr := stream.CloseAndRecv(); closeErr != nil {
-			zap.S().Errorf("error closing stream %s :: %v.Send() = %v", closeErr, stream, closeErr)
+	// open starts an upload stream continuing at offset
+	open := func(offset int64) (pb.PieceStoreRoutes_StoreClient, error) {
+		stream, err := ps.client.Store(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		msg := &pb.PieceStore{
+			PieceData:     &pb.PieceStore_PieceData{Id: id.String(), ExpirationUnixSec: ttl.Unix(), Offset: offset},
+			Authorization: authorization,
+		}
+		if frames != nil {
+			msg.Integrity = frames.options()
 		}
+		if err = stream.Send(msg); err != nil {
+			if _, closeErr := stream.CloseAndRecv(); closeErr != nil {
+				zap.S().Errorf("error closing stream %s :: %v.Send() = %v", closeErr, stream, closeErr)
+			}
 
-		return fmt.Errorf("%v.Send() = %v", stream, err)
+			return nil, fmt.Errorf("%v.Send() = %v", stream, err)
+		}
+		return stream, nil
 	}
 
-	writer := &StreamWriter{signer: ps, stream: stream, pba: ba}
+	stream, err := open(0)
+	if err != nil {
+		return err
+	}
 
-	defer func() {
-		if err := writer.Close(); err != nil && err != io.EOF {
-			zap.S().Debugf("failed to close writer: %s\n", err)
-		}
-	}()
+	writer := &StreamWriter{signer: ps, stream: stream, pba: ba, frames: frames, resume: open}
 
 	bufw := bufio.NewWriterSize(writer, 32*1024)
 
 	_, err = io.Copy(bufw, data)
+	if err == nil {
+		err = bufw.Flush()
+	}
 	if err != nil {
+		if closeErr := writer.Close(); closeErr != nil && closeErr != io.EOF {
+			zap.S().Debugf("failed to close writer: %s\n", closeErr)
+		}
 		return err
 	}
 
-	return bufw.Flush()
+	// the storage node verifies the last frames when closing
+	return writer.Close()
 }
 
 // Get begins downloading a Piece from a piece store Server
@@ -173,6 +199,41 @@ func (ps *PieceStore) Delete(ctx context.Context, id PieceID, authorization *pb.
 	return nil
 }
 
+// Retain sends a bloom filter of the pieces the satellite expects the node to
+// hold, the node trashes the other pieces stored before createdBefore
+func (ps *PieceStore) Retain(ctx context.Context, filter *bloomfilter.Filter, createdBefore time.Time) (trashed int64, err error) {
+	reply, err := ps.client.Retain(ctx, &pb.RetainRequest{
+		Filter:          filter.Bytes(),
+		CreationUnixSec: createdBefore.Unix(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.GetTrashed(), nil
+}
+
+// RestoreTrash asks the node to move the pieces of the satellite trashed at
+// or after trashedAfter back from the trash, a zero time restores all of them
+func (ps *PieceStore) RestoreTrash(ctx context.Context, trashedAfter time.Time) (restored int64, err error) {
+	var trashedAfterUnix int64
+	if !trashedAfter.IsZero() {
+		trashedAfterUnix = trashedAfter.Unix()
+	}
+	reply, err := ps.client.RestoreTrash(ctx, &pb.RestoreTrashRequest{
+		TrashedAfterUnixSec: trashedAfterUnix,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.GetRestored(), nil
+}
+
+// ReportStats tells the node how the satellite rates it, so it can be shown on its dashboard
+func (ps *PieceStore) ReportStats(ctx context.Context, stats *pb.ReportStatsRequest) error {
+	_, err := ps.client.ReportStats(ctx, stats)
+	return err
+}
+
 // sign a message using the clients private key
 func (ps *PieceStore) sign(rba *pb.RenterBandwidthAllocation) (err error) {
 	return auth.SignMessage(rba, *ps.selfID)
@@ -0,0 +1,107 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"hash"
+	"hash/crc32"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// requestedIntegrity are the integrity capabilities the client asks for
+const requestedIntegrity = uint32(pb.IntegrityCapability_INTEGRITY_CRC32C_FRAMES | pb.IntegrityCapability_INTEGRITY_RESUME)
+
+// retainedFrames is how many frames of an upload are kept for resending them
+// after a corrupted frame, the storage node only reports corruption once the
+// frames in flight after it were sent
+const retainedFrames = 16
+
+// maxResumes is how many times an upload is resumed before giving up
+const maxResumes = 3
+
+// frameWriter splits the content of an upload into integrity frames and
+// retains the last frames for resuming the upload
+type frameWriter struct {
+	frameSize int64
+	crc       hash.Hash32
+	offset    int64 // offset of the content sent so far
+	framed    int64 // offset of the end of the last frame
+
+	retained       []byte // content sent after retainedOffset
+	retainedOffset int64
+	resumes        int
+}
+
+func newFrameWriter(frameSize int64) *frameWriter {
+	return &frameWriter{
+		frameSize: frameSize,
+		crc:       crc32.New(crc32.MakeTable(crc32.Castagnoli)),
+	}
+}
+
+// options returns the integrity options requested from the storage node
+func (frames *frameWriter) options() *pb.IntegrityOptions {
+	return &pb.IntegrityOptions{
+		Capabilities: requestedIntegrity,
+		FrameSize:    frames.frameSize,
+	}
+}
+
+// next returns how much of b fits into the current frame
+func (frames *frameWriter) next(b []byte) []byte {
+	if remaining := frames.frameSize - (frames.offset - frames.framed); int64(len(b)) > remaining {
+		return b[:remaining]
+	}
+	return b
+}
+
+// add adds content to the current frame, it returns the frame when it's full
+func (frames *frameWriter) add(content []byte) *pb.IntegrityFrame {
+	_, _ = frames.crc.Write(content)
+	frames.offset += int64(len(content))
+	frames.retain(content)
+
+	if frames.offset-frames.framed < frames.frameSize {
+		return nil
+	}
+	return frames.frame()
+}
+
+// frame ends the current frame
+func (frames *frameWriter) frame() *pb.IntegrityFrame {
+	frame := &pb.IntegrityFrame{Offset: frames.offset, Crc32C: frames.crc.Sum32()}
+	frames.framed = frames.offset
+	frames.crc.Reset()
+	return frame
+}
+
+// pending returns whether there is content without a frame
+func (frames *frameWriter) pending() bool { return frames.offset > frames.framed }
+
+// retain keeps content for resending it, dropping whole frames beyond the
+// retained ones
+func (frames *frameWriter) retain(content []byte) {
+	frames.retained = append(frames.retained, content...)
+	if excess := int64(len(frames.retained)) - retainedFrames*frames.frameSize; excess > 0 {
+		excess -= excess % frames.frameSize
+		frames.retained = frames.retained[excess:]
+		frames.retainedOffset += excess
+	}
+}
+
+// rewind rewinds to verified and returns the retained content after it, ok
+// is false when the upload can't be resumed from there
+func (frames *frameWriter) rewind(verified int64) (resend []byte, ok bool) {
+	if frames.resumes >= maxResumes || verified < frames.retainedOffset || verified > frames.framed {
+		return nil, false
+	}
+	frames.resumes++
+
+	resend = append([]byte(nil), frames.retained[verified-frames.retainedOffset:]...)
+	frames.retained = frames.retained[:verified-frames.retainedOffset]
+	frames.offset, frames.framed = verified, verified
+	frames.crc.Reset()
+	return resend, true
+}
@@ -50,3 +50,14 @@ func (id PieceID) Derive(secret []byte) (derived PieceID, err error) {
 	}
 	return PieceID(base58.Encode(h)), nil
 }
+
+// Namespaced returns the id the piece is stored under on a storage node, the
+// namespace is the id of the satellite which authorized storing the piece
+func (id PieceID) Namespaced(namespace []byte) (string, error) {
+	mac := hmac.New(sha512.New, namespace)
+	_, err := mac.Write([]byte(id))
+	if err != nil {
+		return "", err
+	}
+	return base58.Encode(mac.Sum(nil)), nil
+}
@@ -5,6 +5,7 @@ package psclient
 
 import (
 	"fmt"
+	"io"
 
 	"go.uber.org/zap"
 
@@ -20,11 +21,38 @@ type StreamWriter struct {
 	signer       *PieceStore // We need this for signing
 	totalWritten int64
 	pba          *pb.PayerBandwidthAllocation
+
+	// frames is nil when the upload doesn't use integrity frames
+	frames *frameWriter
+	// resume opens a new upload stream continuing at offset
+	resume func(offset int64) (pb.PieceStoreRoutes_StoreClient, error)
 }
 
 // Write Piece data to a piece store server upload stream
 func (s *StreamWriter) Write(b []byte) (int, error) {
-	updatedAllocation := s.totalWritten + int64(len(b))
+	if s.frames == nil {
+		if err := s.send(b, nil); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+
+	written := 0
+	for len(b) > 0 {
+		// a frame ends with the message completing it
+		content := s.frames.next(b)
+		if err := s.send(content, s.frames.add(content)); err != nil {
+			return written, err
+		}
+		written += len(content)
+		b = b[len(content):]
+	}
+	return written, nil
+}
+
+// send sends content with a new bandwidth allocation covering it
+func (s *StreamWriter) send(content []byte, frame *pb.IntegrityFrame) error {
+	updatedAllocation := s.totalWritten + int64(len(content))
 	rba := &pb.RenterBandwidthAllocation{
 		PayerAllocation: *s.pba,
 		Total:           updatedAllocation,
@@ -32,26 +60,77 @@ func (s *StreamWriter) Write(b []byte) (int, error) {
 	}
 	err := auth.SignMessage(rba, *s.signer.selfID)
 	if err != nil {
-		return 0, err
+		return err
 	}
 	msg := &pb.PieceStore{
-		PieceData:           &pb.PieceStore_PieceData{Content: b},
+		PieceData:           &pb.PieceStore_PieceData{Content: content},
 		BandwidthAllocation: rba,
+		Frame:               frame,
 	}
 	s.totalWritten = updatedAllocation
 	// Second we send the actual content
 	if err := s.stream.Send(msg); err != nil {
-		return 0, fmt.Errorf("%v.Send() = %v", s.stream, err)
+		// the storage node stops uploads at a corrupted frame
+		if err == io.EOF && s.frames != nil {
+			return s.closeAndResume()
+		}
+		return fmt.Errorf("%v.Send() = %v", s.stream, err)
+	}
+	return nil
+}
+
+// closeAndResume closes the stream and resumes the upload when the storage
+// node stopped it at a corrupted frame
+func (s *StreamWriter) closeAndResume() error {
+	reply, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return err
 	}
-	return len(b), nil
+	if !reply.GetCorrupted() {
+		return ClientError.New("upload stopped: %s", reply.GetMessage())
+	}
+	return s.resumeAfter(reply)
+}
+
+// resumeAfter resends the content after the last verified frame over a new
+// stream
+func (s *StreamWriter) resumeAfter(reply *pb.PieceStoreSummary) error {
+	verified := reply.GetTotalReceived()
+	resend, ok := s.frames.rewind(verified)
+	if !ok {
+		return ClientError.New("can't resume upload at %d: %s", verified, reply.GetMessage())
+	}
+	zap.S().Debugf("resuming upload at %d: %s", verified, reply.GetMessage())
+
+	stream, err := s.resume(verified)
+	if err != nil {
+		return err
+	}
+	s.stream = stream
+	_, err = s.Write(resend)
+	return err
 }
 
 // Close the piece store Write Stream
 func (s *StreamWriter) Close() error {
+	// resuming may leave content without a frame again
+	for s.frames != nil && s.frames.pending() {
+		if err := s.send(nil, s.frames.frame()); err != nil {
+			return err
+		}
+	}
+
 	reply, err := s.stream.CloseAndRecv()
 	if err != nil {
 		return err
 	}
+	// the last frame may be corrupted as well
+	if reply.GetCorrupted() && s.frames != nil {
+		if err := s.resumeAfter(reply); err != nil {
+			return err
+		}
+		return s.Close()
+	}
 
 	zap.S().Infof("Stream close and recv summary: %v", reply)
 
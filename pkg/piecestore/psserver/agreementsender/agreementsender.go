@@ -4,12 +4,14 @@
 package agreementsender
 
 import (
+	"math/rand"
 	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
@@ -23,37 +25,81 @@ var (
 	ASError = errs.Class("agreement sender error")
 )
 
+// Config contains how agreements are settled with the satellites
+type Config struct {
+	// CheckInterval is the duration between settlement rounds
+	CheckInterval time.Duration
+	// Jitter is the maximum random delay added before each settlement round
+	Jitter time.Duration
+	// BatchWindow holds back agreements until the window they were created in
+	// has ended, so agreements created in the same window are settled together
+	BatchWindow time.Duration
+	// Shuffle randomizes the order satellites and agreements are settled in
+	Shuffle bool
+	// SettlementWindow is how long after their expiration the satellites
+	// accept agreements, afterwards unsent agreements are deleted and the
+	// serials of sent ones are forgotten
+	SettlementWindow time.Duration
+	// VacuumInterval is the duration between compactions of the database
+	// after agreements or serials were deleted, 0 disables them
+	VacuumInterval time.Duration
+}
+
 // AgreementSender maintains variables required for reading bandwidth agreements from a DB and sending them to a Payers
 type AgreementSender struct { // TODO: rename to service
-	DB            *psdb.DB
-	log           *zap.Logger
-	transport     transport.Client
-	kad           *kademlia.Kademlia
-	checkInterval time.Duration
+	DB        *psdb.DB
+	log       *zap.Logger
+	transport transport.Client
+	kad       *kademlia.Kademlia
+	config    Config
+	rand      *rand.Rand
+
+	lastVacuum time.Time
+	deleted    int64 // rows deleted since the last vacuum
 }
 
 // TODO: take transport instead of identity as argument
 
 // New creates an Agreement Sender
-func New(log *zap.Logger, DB *psdb.DB, identity *identity.FullIdentity, kad *kademlia.Kademlia, checkInterval time.Duration) *AgreementSender {
-	return &AgreementSender{DB: DB, log: log, transport: transport.NewClient(identity), kad: kad, checkInterval: checkInterval}
+func New(log *zap.Logger, DB *psdb.DB, identity *identity.FullIdentity, kad *kademlia.Kademlia, config Config) *AgreementSender {
+	return &AgreementSender{
+		DB:        DB,
+		log:       log,
+		transport: transport.NewClient(identity),
+		kad:       kad,
+		config:    config,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+
+		lastVacuum: time.Now(),
+	}
 }
 
 // Run the agreement sender with a context to check for cancel
 func (as *AgreementSender) Run(ctx context.Context) error {
 	//todo:  we likely don't want to stop on err, but consider returning errors via a channel
-	ticker := time.NewTicker(as.checkInterval)
+	ticker := time.NewTicker(as.config.CheckInterval)
 	defer ticker.Stop()
 	for {
-		as.log.Debug("AgreementSender is running", zap.Duration("duration", as.checkInterval))
+		as.log.Debug("AgreementSender is running", zap.Duration("duration", as.config.CheckInterval))
+		if as.config.Jitter > 0 {
+			if !sync2.Sleep(ctx, time.Duration(as.rand.Int63n(int64(as.config.Jitter)))) {
+				return ctx.Err()
+			}
+		}
+
 		agreementGroups, err := as.DB.GetBandwidthAllocations()
 		if err != nil {
 			as.log.Error("Agreementsender could not retrieve bandwidth allocations", zap.Error(err))
-			continue
 		}
-		for satellite, agreements := range agreementGroups {
+		for _, satellite := range as.satelliteOrder(agreementGroups) {
+			agreements := as.batch(agreementGroups[satellite], time.Now())
+			if len(agreements) == 0 {
+				continue
+			}
 			as.SendAgreementsToSatellite(ctx, satellite, agreements)
 		}
+		as.cleanup(time.Now())
+
 		select {
 		case <-ticker.C:
 		case <-ctx.Done():
@@ -62,6 +108,44 @@ func (as *AgreementSender) Run(ctx context.Context) error {
 	}
 }
 
+// satelliteOrder returns the satellites in the order they should be settled with
+func (as *AgreementSender) satelliteOrder(agreementGroups map[storj.NodeID][]*psdb.Agreement) storj.NodeIDList {
+	satellites := make(storj.NodeIDList, 0, len(agreementGroups))
+	for satellite := range agreementGroups {
+		satellites = append(satellites, satellite)
+	}
+	if as.config.Shuffle {
+		as.rand.Shuffle(len(satellites), func(i, k int) {
+			satellites[i], satellites[k] = satellites[k], satellites[i]
+		})
+	}
+	return satellites
+}
+
+// batch returns the agreements which are ready to be settled at now, in the
+// order they should be sent
+func (as *AgreementSender) batch(agreements []*psdb.Agreement, now time.Time) []*psdb.Agreement {
+	var ready []*psdb.Agreement
+	if as.config.BatchWindow > 0 {
+		windowStart := now.Truncate(as.config.BatchWindow)
+		for _, agreement := range agreements {
+			created := time.Unix(agreement.Agreement.PayerAllocation.CreatedUnixSec, 0)
+			if created.Before(windowStart) {
+				ready = append(ready, agreement)
+			}
+		}
+	} else {
+		ready = append(ready, agreements...)
+	}
+
+	if as.config.Shuffle {
+		as.rand.Shuffle(len(ready), func(i, k int) {
+			ready[i], ready[k] = ready[k], ready[i]
+		})
+	}
+	return ready
+}
+
 //SendAgreementsToSatellite uploads agreements to the satellite
 func (as *AgreementSender) SendAgreementsToSatellite(ctx context.Context, satID storj.NodeID, agreements []*psdb.Agreement) {
 	as.log.Info("Sending agreements to satellite", zap.Int("number of agreements", len(agreements)), zap.String("satellite id", satID.String()))
@@ -87,24 +171,85 @@ func (as *AgreementSender) SendAgreementsToSatellite(ctx context.Context, satID
 	}()
 
 	//todo:  stop sending these one-by-one, send all at once
+	now := time.Now()
 	for _, agreement := range agreements {
 		rba := agreement.Agreement
+		pba := rba.PayerAllocation
+
+		if as.settlementClosed(pba, now) {
+			as.log.Warn("Agreementsender can no longer settle expired agreement : will delete", zap.String("serial", pba.SerialNumber))
+			as.delete(agreement)
+			continue
+		}
+
+		used, err := as.DB.IsSerialUsed(satID, pba.SerialNumber)
 		if err != nil {
-			as.log.Warn("Agreementsender failed to deserialize agreement : will delete", zap.Error(err))
-		} else {
-			// Send agreement to satellite
-			r, err := client.BandwidthAgreements(ctx, &rba)
-			if err != nil || r.GetStatus() == pb.AgreementsSummary_FAIL {
-				as.log.Warn("Agreementsender failed to send agreement to satellite : will retry", zap.Error(err))
-				continue
-			} else if r.GetStatus() == pb.AgreementsSummary_REJECTED {
-				//todo: something better than a delete here?
-				as.log.Error("Agreementsender had agreement explicitly rejected by satellite : will delete", zap.Error(err))
-			}
+			as.log.Warn("Agreementsender failed to check serial : will retry", zap.Error(err))
+			continue
+		}
+		if used {
+			// the satellite rejects further agreements with a settled serial
+			as.log.Debug("Agreementsender already settled serial : will delete", zap.String("serial", pba.SerialNumber))
+			as.delete(agreement)
+			continue
+		}
+
+		// Send agreement to satellite
+		r, err := client.BandwidthAgreements(ctx, &rba)
+		if err != nil || r.GetStatus() == pb.AgreementsSummary_FAIL {
+			as.log.Warn("Agreementsender failed to send agreement to satellite : will retry", zap.Error(err))
+			continue
+		} else if r.GetStatus() == pb.AgreementsSummary_REJECTED {
+			//todo: something better than a delete here?
+			as.log.Error("Agreementsender had agreement explicitly rejected by satellite : will delete", zap.Error(err))
 		}
-		// Delete from PSDB by signature
-		if err = as.DB.DeleteBandwidthAllocationBySignature(agreement.Signature); err != nil {
-			as.log.Error("Agreementsender failed to delete bandwidth allocation", zap.Error(err))
+
+		// the serial is kept until its settlement window closed
+		if err = as.DB.SettleBandwidthAllocation(agreement); err != nil {
+			as.log.Error("Agreementsender failed to settle bandwidth allocation", zap.Error(err))
+			continue
 		}
+		as.deleted++
+	}
+}
+
+// settlementClosed returns whether the satellite no longer accepts agreements of pba at now
+func (as *AgreementSender) settlementClosed(pba pb.PayerBandwidthAllocation, now time.Time) bool {
+	if as.config.SettlementWindow <= 0 || pba.ExpirationUnixSec == 0 {
+		return false
+	}
+	return time.Unix(pba.ExpirationUnixSec, 0).Add(as.config.SettlementWindow).Before(now)
+}
+
+// delete deletes an agreement which won't be sent
+func (as *AgreementSender) delete(agreement *psdb.Agreement) {
+	if err := as.DB.DeleteBandwidthAllocationBySignature(agreement.Signature); err != nil {
+		as.log.Error("Agreementsender failed to delete bandwidth allocation", zap.Error(err))
+		return
+	}
+	as.deleted++
+}
+
+// cleanup forgets the settled serials whose settlement window closed and
+// compacts the database after rows were deleted
+func (as *AgreementSender) cleanup(now time.Time) {
+	if as.config.SettlementWindow > 0 {
+		deleted, err := as.DB.DeleteUsedSerials(now.Add(-as.config.SettlementWindow))
+		if err != nil {
+			as.log.Error("Agreementsender failed to delete used serials", zap.Error(err))
+		} else if deleted > 0 {
+			as.log.Debug("Agreementsender deleted used serials", zap.Int64("count", deleted))
+			as.deleted += deleted
+		}
+	}
+
+	if as.config.VacuumInterval <= 0 || as.deleted == 0 || now.Sub(as.lastVacuum) < as.config.VacuumInterval {
+		return
+	}
+	if err := as.DB.Vacuum(); err != nil {
+		as.log.Error("Agreementsender failed to vacuum the database", zap.Error(err))
+		return
 	}
+	as.log.Info("Agreementsender vacuumed the database", zap.Int64("deleted rows", as.deleted))
+	as.lastVacuum, as.deleted = now, 0
 }
@@ -0,0 +1,72 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package agreementsender
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+func TestBatch(t *testing.T) {
+	now := time.Date(2019, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	agreement := func(created time.Time) *psdb.Agreement {
+		return &psdb.Agreement{
+			Agreement: pb.RenterBandwidthAllocation{
+				PayerAllocation: pb.PayerBandwidthAllocation{CreatedUnixSec: created.Unix()},
+			},
+			Signature: []byte(created.String()),
+		}
+	}
+	previousWindow := agreement(now.Add(-45 * time.Minute))
+	currentWindow := agreement(now.Add(-15 * time.Minute))
+	agreements := []*psdb.Agreement{previousWindow, currentWindow}
+
+	{ // without a batch window every agreement is sent right away
+		sender := &AgreementSender{config: Config{}, rand: rand.New(rand.NewSource(0))}
+		assert.Equal(t, agreements, sender.batch(agreements, now))
+	}
+
+	{ // agreements of the current window are held back
+		sender := &AgreementSender{config: Config{BatchWindow: time.Hour}, rand: rand.New(rand.NewSource(0))}
+		assert.Equal(t, []*psdb.Agreement{previousWindow}, sender.batch(agreements, now))
+		assert.Equal(t, agreements, sender.batch(agreements, now.Add(time.Hour)))
+	}
+
+	{ // shuffling keeps every agreement
+		var many []*psdb.Agreement
+		for i := 0; i < 100; i++ {
+			many = append(many, agreement(now.Add(-time.Duration(i)*time.Second)))
+		}
+		sender := &AgreementSender{config: Config{Shuffle: true}, rand: rand.New(rand.NewSource(0))}
+		shuffled := sender.batch(many, now)
+		require.Len(t, shuffled, len(many))
+		assert.ElementsMatch(t, many, shuffled)
+		assert.NotEqual(t, many, shuffled)
+	}
+}
+
+func TestSettlementClosed(t *testing.T) {
+	now := time.Date(2019, 3, 1, 12, 30, 0, 0, time.UTC)
+	pba := func(expiration time.Time) pb.PayerBandwidthAllocation {
+		return pb.PayerBandwidthAllocation{ExpirationUnixSec: expiration.Unix()}
+	}
+
+	sender := &AgreementSender{config: Config{SettlementWindow: 3 * time.Hour}}
+	assert.False(t, sender.settlementClosed(pba(now.Add(time.Hour)), now))
+	assert.False(t, sender.settlementClosed(pba(now.Add(-2*time.Hour)), now))
+	assert.True(t, sender.settlementClosed(pba(now.Add(-4*time.Hour)), now))
+	assert.False(t, sender.settlementClosed(pb.PayerBandwidthAllocation{}, now))
+
+	// without a window the agreements are kept until they are sent
+	unlimited := &AgreementSender{config: Config{}}
+	assert.False(t, unlimited.settlementClosed(pba(now.Add(-4*time.Hour)), now))
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+// ErrBandwidthExhausted is returned when a daily or monthly bandwidth cap of the node was reached
+var ErrBandwidthExhausted = errs.Class("bandwidth exhausted")
+
+// bandwidthCaps are the maximum bytes transferred per day and month, 0 means no limit
+type bandwidthCaps struct {
+	IngressPerDay   int64
+	IngressPerMonth int64
+	EgressPerDay    int64
+	EgressPerMonth  int64
+}
+
+// get returns the daily and monthly cap of direction
+func (caps bandwidthCaps) get(direction psdb.Direction) (perDay, perMonth int64) {
+	if direction == psdb.Ingress {
+		return caps.IngressPerDay, caps.IngressPerMonth
+	}
+	return caps.EgressPerDay, caps.EgressPerMonth
+}
+
+// bandwidthLeft returns how many bytes can be transferred in direction before
+// a cap is reached, limited is false when direction has no caps
+func (s *Server) bandwidthLeft(direction psdb.Direction, now time.Time) (left int64, limited bool, err error) {
+	perDay, perMonth := s.bandwidthCaps.get(direction)
+
+	check := func(limit int64, since time.Time) error {
+		if limit <= 0 {
+			return nil
+		}
+		used, err := s.DB.GetDirectionalBandwidthSince(direction, since)
+		if err != nil {
+			return err
+		}
+		if !limited || limit-used < left {
+			left = limit - used
+		}
+		limited = true
+		return nil
+	}
+
+	if err := check(perDay, now); err != nil {
+		return 0, false, err
+	}
+	if err := check(perMonth, getBeginningOfMonth()); err != nil {
+		return 0, false, err
+	}
+	return left, limited, nil
+}
+
+// checkBandwidthCap returns an ErrBandwidthExhausted error when a cap of direction was reached
+func (s *Server) checkBandwidthCap(direction psdb.Direction) error {
+	left, limited, err := s.bandwidthLeft(direction, time.Now())
+	if err != nil {
+		return err
+	}
+	if limited && left <= 0 {
+		mon.Event("bandwidth_cap_reached")
+		return ErrBandwidthExhausted.New("%s cap reached", direction)
+	}
+	return nil
+}
+
+// availableBandwidth returns the bandwidth which can still be used this month,
+// which is reduced to what the caps allow. Satellites don't select the node
+// when it has no bandwidth available.
+func (s *Server) availableBandwidth(usedBandwidth int64) (int64, error) {
+	available := s.allocatedBandwidth() - usedBandwidth
+
+	now := time.Now()
+	for _, direction := range []psdb.Direction{psdb.Ingress, psdb.Egress} {
+		left, limited, err := s.bandwidthLeft(direction, now)
+		if err != nil {
+			return 0, err
+		}
+		if limited && left < available {
+			available = left
+		}
+	}
+	if available < 0 {
+		available = 0
+	}
+	return available, nil
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+func TestBandwidthCaps(t *testing.T) {
+	db, err := psdb.OpenInMemory()
+	require.NoError(t, err)
+	defer func() { require.NoError(t, db.Close()) }()
+
+	s := &Server{
+		DB:               db,
+		totalBwAllocated: 10000,
+		bandwidthCaps: bandwidthCaps{
+			IngressPerDay:  1000,
+			EgressPerMonth: 3000,
+		},
+	}
+
+	available, err := s.availableBandwidth(0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), available)
+
+	require.NoError(t, s.checkBandwidthCap(psdb.Ingress))
+	require.NoError(t, s.checkBandwidthCap(psdb.Egress))
+
+	require.NoError(t, db.AddDirectionalBandwidthUsed(psdb.Egress, 2500))
+
+	// the egress cap is closer to being reached
+	available, err = s.availableBandwidth(2500)
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), available)
+
+	require.NoError(t, db.AddDirectionalBandwidthUsed(psdb.Ingress, 1000))
+
+	// the daily ingress cap was reached
+	err = s.checkBandwidthCap(psdb.Ingress)
+	assert.True(t, ErrBandwidthExhausted.Has(err))
+	require.NoError(t, s.checkBandwidthCap(psdb.Egress))
+
+	// the node reports no bandwidth, so satellites stop selecting it
+	available, err = s.availableBandwidth(3500)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), available)
+
+	// the bandwidth is also recorded in the total usage
+	total, err := db.GetBandwidthUsedByDay(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3500), total)
+}
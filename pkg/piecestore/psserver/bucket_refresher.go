@@ -53,6 +53,12 @@ func (service *Monitor) Run(ctx context.Context) error {
 	}
 }
 
+// Refresh updates the capacity the node reports right away, e.g. after the
+// allocated space changed
+func (service *Monitor) Refresh(ctx context.Context) error {
+	return service.process(ctx)
+}
+
 // process will attempt to update the kademlia bucket with the latest information about the storage node
 func (service *Monitor) process(ctx context.Context) error {
 	stats, err := service.server.Stats(ctx, nil)
@@ -60,15 +66,12 @@ func (service *Monitor) process(ctx context.Context) error {
 		return Error.Wrap(err)
 	}
 
-	self := service.rt.Local()
-
-	self.Restrictions = &pb.NodeRestrictions{
+	// Update the routing table with latest restrictions
+	err = service.rt.UpdateRestrictions(&pb.NodeRestrictions{
 		FreeBandwidth: stats.AvailableBandwidth,
 		FreeDisk:      stats.AvailableSpace,
-	}
-
-	// Update the routing table with latest restrictions
-	if err := service.rt.UpdateSelf(&self); err != nil {
+	})
+	if err != nil {
 		return Error.Wrap(err)
 	}
 
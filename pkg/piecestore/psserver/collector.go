@@ -17,48 +17,97 @@ import (
 // ErrorCollector is error class for piece collector
 var ErrorCollector = errs.Class("piecestore collector")
 
+// minExpirationWait is the shortest time the collector waits for the next piece to expire,
+// so pieces expiring at almost the same moment are collected together
+const minExpirationWait = time.Second
+
 // Collector collects expired pieces from database and disk.
 type Collector struct {
 	log     *zap.Logger
 	db      *psdb.DB
 	storage *pstore.Storage
 
-	interval time.Duration
+	interval       time.Duration
+	trashRetention time.Duration
+	batchSize      int
 }
 
 // NewCollector returns a new piece collector
-func NewCollector(log *zap.Logger, db *psdb.DB, storage *pstore.Storage, interval time.Duration) *Collector {
+func NewCollector(log *zap.Logger, db *psdb.DB, storage *pstore.Storage, interval, trashRetention time.Duration, batchSize int) *Collector {
 	return &Collector{
-		log:      log,
-		db:       db,
-		storage:  storage,
-		interval: interval,
+		log:            log,
+		db:             db,
+		storage:        storage,
+		interval:       interval,
+		trashRetention: trashRetention,
+		batchSize:      batchSize,
 	}
 }
 
-// Run runs the collector at regular intervals
+// Run runs the collector at regular intervals, or as soon as the next piece
+// expires when that happens before the next interval
 func (service *Collector) Run(ctx context.Context) error {
-	ticker := time.NewTicker(service.interval)
-	defer ticker.Stop()
-
 	for {
 		err := service.Collect(ctx)
 		if err != nil {
 			service.log.Error("collect", zap.Error(err))
 		}
 
+		timer := time.NewTimer(service.nextWait(ctx))
 		select {
-		case <-ticker.C: // wait for the next interval to happen
-		case <-ctx.Done(): // or the bucket refresher service is canceled via context
+		case <-timer.C: // wait for the next interval or expiration to happen
+		case <-ctx.Done(): // or the collector is canceled via context
+			timer.Stop()
 			return ctx.Err()
 		}
 	}
 }
 
+// nextWait returns how long to wait before collecting again
+func (service *Collector) nextWait(ctx context.Context) time.Duration {
+	wait := service.interval
+
+	next, ok, err := service.db.NextExpiration(ctx)
+	if err != nil {
+		service.log.Error("next expiration", zap.Error(err))
+		return wait
+	}
+	if ok {
+		if untilNext := time.Until(next); untilNext < wait {
+			wait = untilNext
+		}
+	}
+	if wait < minExpirationWait {
+		wait = minExpirationWait
+	}
+	return wait
+}
+
 // Collect collects expired pieces att this moment.
 func (service *Collector) Collect(ctx context.Context) error {
+	trashedBefore := time.Now().Add(-service.trashRetention)
+	deleted, err := service.storage.EmptyTrash(ctx, trashedBefore)
+	if deleted > 0 {
+		service.log.Info("deleted trashed pieces", zap.Int64("count", deleted))
+	}
+	if err != nil {
+		return ErrorCollector.Wrap(err)
+	}
+	// the trashed pieces can't be restored anymore
+	if _, err := service.db.DeleteTrashedBefore(trashedBefore); err != nil {
+		return ErrorCollector.Wrap(err)
+	}
+
+	return service.CollectExpired(ctx, time.Now())
+}
+
+// CollectExpired deletes the pieces which expired before now from disk,
+// together with their rows in the database
+func (service *Collector) CollectExpired(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	for {
-		expired, err := service.db.DeleteExpired(ctx)
+		expired, err := service.db.GetExpired(ctx, now, service.batchSize)
 		if err != nil {
 			return ErrorCollector.Wrap(err)
 		}
@@ -66,9 +115,25 @@ func (service *Collector) Collect(ctx context.Context) error {
 			return nil
 		}
 
+		// the rows of a piece are only deleted once the piece is gone from disk,
+		// so a piece which fails to be deleted is retried on the next collection
 		var errlist errs.Group
+		deleted := 0
 		for _, id := range expired {
-			errlist.Add(service.storage.Delete(id))
+			if err := service.storage.Delete(id); err != nil {
+				errlist.Add(err)
+				continue
+			}
+			if err := service.db.DeleteTTLByID(id); err != nil {
+				errlist.Add(err)
+				continue
+			}
+			deleted++
+		}
+
+		mon.IntVal("expired_pieces_deleted").Observe(int64(deleted))
+		if deleted > 0 {
+			service.log.Debug("deleted expired pieces", zap.Int("count", deleted))
 		}
 
 		if err := errlist.Err(); err != nil {
@@ -4,11 +4,14 @@
 package psserver
 
 import (
+	"strings"
 	"time"
 
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/storj"
 )
 
 var (
@@ -22,8 +25,82 @@ type Config struct {
 	SatelliteIDRestriction  bool          `help:"if true, only allow data from approved satellites" default:"false"`
 	AllocatedDiskSpace      memory.Size   `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
 	AllocatedBandwidth      memory.Size   `user:"true" help:"total allocated bandwidth in bytes" default:"500GiB"`
+	MaxIngressPerDay        memory.Size   `user:"true" help:"maximum bytes uploaded to the node per day, 0 for unlimited" default:"0"`
+	MaxIngressPerMonth      memory.Size   `user:"true" help:"maximum bytes uploaded to the node per month, 0 for unlimited" default:"0"`
+	MaxEgressPerDay         memory.Size   `user:"true" help:"maximum bytes downloaded from the node per day, 0 for unlimited" default:"0"`
+	MaxEgressPerMonth       memory.Size   `user:"true" help:"maximum bytes downloaded from the node per month, 0 for unlimited" default:"0"`
 	KBucketRefreshInterval  time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
 
+	MaxConcurrentUploads   int `user:"true" help:"maximum number of concurrent piece uploads, further uploads are rejected to be retried elsewhere, 0 for unlimited" default:"0"`
+	MaxConcurrentDownloads int `user:"true" help:"maximum number of concurrent piece downloads, further downloads are rejected to be retried later, 0 for unlimited" default:"0"`
+
 	AgreementSenderCheckInterval time.Duration `help:"duration between agreement checks" default:"1h0m0s"`
+	AgreementSenderJitter        time.Duration `help:"maximum random delay before sending agreements, to hide when they were checked" default:"10m0s"`
+	AgreementSenderBatchWindow   time.Duration `help:"agreements are held back until the window they were created in has ended, 0 sends them right away" default:"1h0m0s"`
+	AgreementSenderShuffle       bool          `help:"if true, agreements are sent to the satellites in a random order" default:"true"`
+	AgreementSettlementWindow    time.Duration `help:"how long after their expiration the satellites accept agreements, older unsent agreements are deleted and the serials of sent ones are forgotten, 0 keeps them" default:"3h0m0s"`
+	DatabaseVacuumInterval       time.Duration `help:"minimum duration between compactions of the database after agreements or serials were deleted, 0 disables them" default:"168h0m0s"`
 	CollectorInterval            time.Duration `help:"interval to check for expired pieces" default:"1h0m0s"`
+	CollectorBatchSize           int           `help:"number of expired pieces deleted from the database at once" default:"1000"`
+	TrashRetention               time.Duration `help:"how long deleted and garbage collected pieces are kept in the trash, where satellites can restore them, before they are deleted" default:"168h0m0s"`
+
+	WritePreallocate       memory.Size   `user:"true" help:"disk space reserved for every uploaded piece and released once it's complete, reduces fragmentation e.g. on SMR drives, 0 disables it" default:"0"`
+	WriteSync              string        `user:"true" help:"when uploaded pieces are flushed to the disk: none leaves it to the operating system, piece flushes every piece before the upload completes, batch flushes pieces together" default:"none"`
+	WriteSyncBatchSize     int           `help:"number of pieces flushed together when syncing in batches" default:"100"`
+	WriteSyncBatchInterval time.Duration `help:"longest time a piece waits to be flushed when syncing in batches" default:"1s"`
+	WriteDirectIO          bool          `user:"true" help:"if true, pieces are written bypassing the page cache (O_DIRECT), where the platform and the filesystem support it" default:"false"`
+
+	UsedSpaceInterval     time.Duration `help:"interval to walk the stored pieces and calculate the used space" default:"12h0m0s"`
+	LazyFilewalkerEnabled bool          `help:"run the filewalker in a separate process with a low IO and CPU priority" default:"false"`
+
+	DiskHealthEnabled               bool          `help:"if true, the SMART attributes of the disk are monitored with smartctl" default:"false"`
+	DiskHealthDevice                string        `help:"the device storing the pieces, e.g. /dev/sda" default:""`
+	DiskHealthSmartctl              string        `help:"path to the smartctl executable" default:"smartctl"`
+	DiskHealthInterval              time.Duration `help:"interval to check the SMART attributes of the disk" default:"1h0m0s"`
+	DiskHealthMaxReallocatedSectors int64         `user:"true" help:"stop accepting uploads when the disk has more reallocated sectors, 0 never stops them" default:"0"`
+}
+
+// WriteOptions returns how pieces are written
+func (config Config) WriteOptions() pstore.WriteOptions {
+	return pstore.WriteOptions{
+		Preallocate:   config.WritePreallocate.Int64(),
+		Sync:          pstore.SyncPolicy(config.WriteSync),
+		BatchSize:     config.WriteSyncBatchSize,
+		BatchInterval: config.WriteSyncBatchInterval,
+		DirectIO:      config.WriteDirectIO,
+	}
+}
+
+// Trust decides which satellites the node stores data for
+type Trust interface {
+	// IsTrusted returns whether the node stores data for the satellite
+	IsTrusted(id storj.NodeID) bool
+}
+
+// StaticTrust trusts the satellites in it, or every satellite when it's empty
+type StaticTrust map[storj.NodeID]struct{}
+
+// IsTrusted returns whether the satellite is in trust or trust is empty
+func (trust StaticTrust) IsTrusted(id storj.NodeID) bool {
+	if len(trust) == 0 {
+		return true
+	}
+	_, found := trust[id]
+	return found
+}
+
+// WhitelistedSatellites returns the ids of the approved satellites, it's
+// empty when the node doesn't restrict the satellites it stores data for
+func (config Config) WhitelistedSatellites() (ids storj.NodeIDList, err error) {
+	if !config.SatelliteIDRestriction {
+		return nil, nil
+	}
+	for _, s := range strings.Split(config.WhitelistedSatelliteIDs, ",") {
+		id, err := storj.NodeIDFromString(s)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
 }
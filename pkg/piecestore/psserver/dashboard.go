@@ -0,0 +1,259 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
+)
+
+// ReportStatsError is a type of error for failures in Server.ReportStats()
+var ReportStatsError = errs.Class("report stats error")
+
+// ReportStats stores how the calling satellite rates the node, so it can be
+// shown on the dashboard
+func (s *Server) ReportStats(ctx context.Context, in *pb.ReportStatsRequest) (_ *pb.ReportStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, ReportStatsError.Wrap(err)
+	}
+	if !s.isWhitelisted(peer.ID) {
+		return nil, ReportStatsError.New("satellite %s is not whitelisted", peer.ID)
+	}
+
+	received := time.Now()
+	var skew time.Duration
+	if in.GetSentUnixSec() != 0 {
+		skew = time.Unix(in.GetSentUnixSec(), 0).Sub(received)
+	}
+
+	err = s.DB.SetSatelliteStats(psdb.SatelliteStats{
+		SatelliteID:       peer.ID,
+		AuditSuccessRatio: in.GetAuditSuccessRatio(),
+		UptimeRatio:       in.GetUptimeRatio(),
+		HeldAmount:        in.GetHeldAmount(),
+		MinimumVersion:    in.GetMinimumVersion(),
+		ClockSkew:         skew,
+		Received:          received,
+	})
+	if err != nil {
+		return nil, ReportStatsError.Wrap(err)
+	}
+
+	s.log.Debug("Received stats", zap.Stringer("Satellite ID", peer.ID))
+	return &pb.ReportStatsResponse{}, nil
+}
+
+// ReceiveStanding stores whether the satellite suspended or disqualified the
+// node, the satellite sends it when it checks in with the node
+func (s *Server) ReceiveStanding(ctx context.Context, satelliteID storj.NodeID, standing *pb.NodeStanding) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !s.isWhitelisted(satelliteID) {
+		return ReportStatsError.New("satellite %s is not whitelisted", satelliteID)
+	}
+
+	received := standingFromPB(satelliteID, standing)
+	received.Received = time.Now()
+	if err := s.DB.SetSatelliteStanding(received); err != nil {
+		return ReportStatsError.Wrap(err)
+	}
+
+	if standing.GetStatus() != pb.NodeStanding_GOOD {
+		s.log.Warn("Satellite reported a bad standing", zap.Stringer("Satellite ID", satelliteID),
+			zap.Stringer("status", standing.GetStatus()), zap.String("reason", standing.GetReason()))
+	}
+	return nil
+}
+
+// standingFromPB converts the standing sent by a satellite
+func standingFromPB(satelliteID storj.NodeID, standing *pb.NodeStanding) psdb.SatelliteStanding {
+	converted := psdb.SatelliteStanding{
+		SatelliteID: satelliteID,
+		Status:      standing.GetStatus(),
+		Reason:      standing.GetReason(),
+	}
+	if standing.GetSinceUnixSec() != 0 {
+		converted.Since = time.Unix(standing.GetSinceUnixSec(), 0)
+	}
+	return converted
+}
+
+// NodeDashboard is the state of the node shown by the dashboard API
+type NodeDashboard struct {
+	NodeID          storj.NodeID `json:"nodeID"`
+	Version         string       `json:"version"`
+	ExternalAddress string       `json:"externalAddress"`
+	StartedAt       time.Time    `json:"startedAt"`
+
+	UsedSpace          int64 `json:"usedSpace"`
+	AvailableSpace     int64 `json:"availableSpace"`
+	UsedPieces         int64 `json:"usedPieces"`
+	UsedBandwidth      int64 `json:"usedBandwidth"`
+	AvailableBandwidth int64 `json:"availableBandwidth"`
+
+	Uploads   TransferStats `json:"uploads"`
+	Downloads TransferStats `json:"downloads"`
+
+	DiskHealth *pb.DiskHealth       `json:"diskHealth,omitempty"`
+	Satellites []SatelliteDashboard `json:"satellites"`
+}
+
+// SatelliteDashboard is the breakdown of the node state for a satellite
+type SatelliteDashboard struct {
+	SatelliteID storj.NodeID `json:"satelliteID"`
+
+	UsedSpace  int64            `json:"usedSpace"`
+	UsedPieces int64            `json:"usedPieces"`
+	Bandwidth  []DailyBandwidth `json:"bandwidth"`
+
+	// the stats are zero until the satellite reported them
+	AuditSuccessRatio float64       `json:"auditSuccessRatio"`
+	UptimeRatio       float64       `json:"uptimeRatio"`
+	HeldAmount        int64         `json:"heldAmount"`
+	MinimumVersion    string        `json:"minimumVersion"`
+	ClockSkew         time.Duration `json:"clockSkew"`
+	StatsReceivedAt   time.Time     `json:"statsReceivedAt"`
+
+	// the standing is good until the satellite reported otherwise
+	Standing           string    `json:"standing"`
+	StandingReason     string    `json:"standingReason"`
+	StandingSince      time.Time `json:"standingSince"`
+	StandingReceivedAt time.Time `json:"standingReceivedAt"`
+}
+
+// DailyBandwidth is the bandwidth used for a satellite on a day by action
+type DailyBandwidth struct {
+	Day       time.Time `json:"day"`
+	Put       int64     `json:"put"`
+	Get       int64     `json:"get"`
+	GetAudit  int64     `json:"getAudit"`
+	GetRepair int64     `json:"getRepair"`
+	PutRepair int64     `json:"putRepair"`
+}
+
+// add adds size to the bandwidth used for action
+func (daily *DailyBandwidth) add(action pb.BandwidthAction, size int64) {
+	switch action {
+	case pb.BandwidthAction_PUT:
+		daily.Put += size
+	case pb.BandwidthAction_GET:
+		daily.Get += size
+	case pb.BandwidthAction_GET_AUDIT:
+		daily.GetAudit += size
+	case pb.BandwidthAction_GET_REPAIR:
+		daily.GetRepair += size
+	case pb.BandwidthAction_PUT_REPAIR:
+		daily.PutRepair += size
+	}
+}
+
+// NodeDashboard returns the state of the node with the bandwidth of the satellites
+// per day since the start of the day of since
+func (s *Server) NodeDashboard(ctx context.Context, since time.Time) (dashboard *NodeDashboard, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats, err := s.retrieveStats()
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	dashboard = &NodeDashboard{
+		Version:            version.Version,
+		StartedAt:          s.startTime,
+		UsedSpace:          stats.GetUsedSpace(),
+		AvailableSpace:     stats.GetAvailableSpace(),
+		UsedPieces:         stats.GetUsedPieces(),
+		UsedBandwidth:      stats.GetUsedBandwidth(),
+		AvailableBandwidth: stats.GetAvailableBandwidth(),
+		Uploads:            s.uploads.stats(),
+		Downloads:          s.downloads.stats(),
+		DiskHealth:         s.getDiskHealth(),
+		Satellites:         []SatelliteDashboard{},
+	}
+	if s.kad != nil {
+		local := s.kad.GetRoutingTable().Local()
+		dashboard.NodeID = local.Id
+		dashboard.ExternalAddress = local.GetAddress().GetAddress()
+	}
+
+	satellites := map[storj.NodeID]*SatelliteDashboard{}
+	satellite := func(id storj.NodeID) *SatelliteDashboard {
+		if _, ok := satellites[id]; !ok {
+			satellites[id] = &SatelliteDashboard{SatelliteID: id, Bandwidth: []DailyBandwidth{}, Standing: pb.NodeStanding_GOOD.String()}
+		}
+		return satellites[id]
+	}
+
+	used, err := s.DB.GetUsedSpaceBySatellite()
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+	for _, space := range used {
+		sat := satellite(space.SatelliteID)
+		sat.UsedSpace, sat.UsedPieces = space.Bytes, space.Pieces
+	}
+
+	// the usage is ordered by day, so the days of a satellite are as well
+	usage, err := s.DB.GetSatelliteBandwidthSince(since)
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+	for _, bandwidth := range usage {
+		sat := satellite(bandwidth.SatelliteID)
+		last := len(sat.Bandwidth) - 1
+		if last < 0 || !sat.Bandwidth[last].Day.Equal(bandwidth.Day) {
+			sat.Bandwidth = append(sat.Bandwidth, DailyBandwidth{Day: bandwidth.Day})
+			last++
+		}
+		sat.Bandwidth[last].add(bandwidth.Action, bandwidth.Size)
+	}
+
+	reported, err := s.DB.GetSatelliteStats()
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+	for _, stats := range reported {
+		sat := satellite(stats.SatelliteID)
+		sat.AuditSuccessRatio = stats.AuditSuccessRatio
+		sat.UptimeRatio = stats.UptimeRatio
+		sat.HeldAmount = stats.HeldAmount
+		sat.MinimumVersion = stats.MinimumVersion
+		sat.ClockSkew = stats.ClockSkew
+		sat.StatsReceivedAt = stats.Received
+	}
+
+	standings, err := s.DB.GetSatelliteStandings()
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+	for _, standing := range standings {
+		sat := satellite(standing.SatelliteID)
+		sat.Standing = standing.Status.String()
+		sat.StandingReason = standing.Reason
+		sat.StandingSince = standing.Since
+		sat.StandingReceivedAt = standing.Received
+	}
+
+	for _, sat := range satellites {
+		dashboard.Satellites = append(dashboard.Satellites, *sat)
+	}
+	sort.Slice(dashboard.Satellites, func(i, k int) bool {
+		return dashboard.Satellites[i].SatelliteID.Less(dashboard.Satellites[k].SatelliteID)
+	})
+
+	return dashboard, nil
+}
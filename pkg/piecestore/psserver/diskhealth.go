@@ -0,0 +1,230 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrDiskHealth is error class for the disk health monitor
+	ErrDiskHealth = errs.Class("disk health")
+	// ErrDiskUnhealthy is returned when uploads are rejected because the disk is failing
+	ErrDiskUnhealthy = errs.Class("disk unhealthy")
+)
+
+// SMART ATA attribute ids which indicate a failing disk
+const (
+	smartReallocatedSectors   = 5
+	smartPendingSectors       = 197
+	smartUncorrectableSectors = 198
+)
+
+// SMART contains the SMART attributes of a disk which indicate its health
+type SMART struct {
+	// Passed is the overall self-assessment of the disk
+	Passed bool
+
+	ReallocatedSectors   int64
+	PendingSectors       int64
+	UncorrectableSectors int64
+
+	// Temperature is in degrees celsius, it's 0 when the disk doesn't report it
+	Temperature int64
+}
+
+// SMARTReader reads the SMART attributes of a disk
+type SMARTReader interface {
+	ReadSMART(ctx context.Context) (SMART, error)
+}
+
+// Smartctl reads the SMART attributes of Device by running smartctl
+type Smartctl struct {
+	Path   string
+	Device string
+}
+
+// ReadSMART implements SMARTReader
+func (smartctl Smartctl) ReadSMART(ctx context.Context) (SMART, error) {
+	output, err := exec.CommandContext(ctx, smartctl.Path, "--json", "--health", "--attributes", smartctl.Device).Output()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		// the exit status is a bitmask, only the two lowest bits mean that
+		// smartctl couldn't read the disk, the others describe its health
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.ExitStatus()&3 == 0 {
+			err = nil
+		}
+	}
+	if err != nil {
+		return SMART{}, ErrDiskHealth.Wrap(err)
+	}
+	return ParseSmartctl(output)
+}
+
+// ParseSmartctl parses the json output of smartctl
+func ParseSmartctl(output []byte) (SMART, error) {
+	var report struct {
+		SmartStatus struct {
+			Passed bool `json:"passed"`
+		} `json:"smart_status"`
+		Attributes struct {
+			Table []struct {
+				ID  int `json:"id"`
+				Raw struct {
+					Value int64 `json:"value"`
+				} `json:"raw"`
+			} `json:"table"`
+		} `json:"ata_smart_attributes"`
+		Temperature struct {
+			Current int64 `json:"current"`
+		} `json:"temperature"`
+	}
+	if err := json.Unmarshal(output, &report); err != nil {
+		return SMART{}, ErrDiskHealth.Wrap(err)
+	}
+
+	smart := SMART{
+		Passed:      report.SmartStatus.Passed,
+		Temperature: report.Temperature.Current,
+	}
+	for _, attribute := range report.Attributes.Table {
+		switch attribute.ID {
+		case smartReallocatedSectors:
+			smart.ReallocatedSectors = attribute.Raw.Value
+		case smartPendingSectors:
+			smart.PendingSectors = attribute.Raw.Value
+		case smartUncorrectableSectors:
+			smart.UncorrectableSectors = attribute.Raw.Value
+		}
+	}
+	return smart, nil
+}
+
+// DiskStatus is the result of the last disk health check
+type DiskStatus struct {
+	SMART
+	Checked  time.Time
+	Warnings []string
+
+	// IngressStopped is true when the disk has more reallocated sectors than allowed
+	IngressStopped bool
+}
+
+// DiskHealth periodically reads the SMART attributes of the disk storing the
+// pieces and stops accepting uploads when the disk is failing
+type DiskHealth struct {
+	log    *zap.Logger
+	reader SMARTReader
+
+	interval              time.Duration
+	maxReallocatedSectors int64
+
+	mu     sync.Mutex
+	status DiskStatus
+}
+
+// NewDiskHealth returns a new disk health monitor, uploads are rejected once
+// the disk has more than maxReallocatedSectors reallocated sectors, 0 never
+// rejects them
+func NewDiskHealth(log *zap.Logger, reader SMARTReader, interval time.Duration, maxReallocatedSectors int64) *DiskHealth {
+	return &DiskHealth{
+		log:                   log,
+		reader:                reader,
+		interval:              interval,
+		maxReallocatedSectors: maxReallocatedSectors,
+	}
+}
+
+// Run checks the disk health at regular intervals
+func (health *DiskHealth) Run(ctx context.Context) error {
+	ticker := time.NewTicker(health.interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := health.Check(ctx)
+		if err != nil {
+			health.log.Error("check", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the disk health monitor is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// Check reads the SMART attributes at this moment and updates the status,
+// the previous status is kept when they can't be read
+func (health *DiskHealth) Check(ctx context.Context) (status DiskStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	smart, err := health.reader.ReadSMART(ctx)
+	if err != nil {
+		return health.Status(), err
+	}
+
+	status = DiskStatus{
+		SMART:          smart,
+		Checked:        time.Now(),
+		Warnings:       smartWarnings(smart),
+		IngressStopped: health.maxReallocatedSectors > 0 && smart.ReallocatedSectors > health.maxReallocatedSectors,
+	}
+
+	mon.IntVal("disk_reallocated_sectors").Observe(smart.ReallocatedSectors)
+	mon.IntVal("disk_pending_sectors").Observe(smart.PendingSectors)
+	mon.IntVal("disk_uncorrectable_sectors").Observe(smart.UncorrectableSectors)
+
+	for _, warning := range status.Warnings {
+		health.log.Warn(warning)
+	}
+	if status.IngressStopped {
+		health.log.Error("not accepting uploads since the disk is failing",
+			zap.Int64("reallocated sectors", smart.ReallocatedSectors),
+			zap.Int64("max reallocated sectors", health.maxReallocatedSectors),
+		)
+	}
+
+	health.mu.Lock()
+	health.status = status
+	health.mu.Unlock()
+	return status, nil
+}
+
+// Status returns the result of the last successful check
+func (health *DiskHealth) Status() DiskStatus {
+	health.mu.Lock()
+	defer health.mu.Unlock()
+	return health.status
+}
+
+// IngressAllowed returns whether the disk is healthy enough to store new pieces
+func (health *DiskHealth) IngressAllowed() bool {
+	return !health.Status().IngressStopped
+}
+
+// smartWarnings describes the problems smart indicates
+func smartWarnings(smart SMART) (warnings []string) {
+	if !smart.Passed {
+		warnings = append(warnings, "disk failed its SMART self-assessment")
+	}
+	if smart.ReallocatedSectors > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d reallocated sectors", smart.ReallocatedSectors))
+	}
+	if smart.PendingSectors > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d sectors pending reallocation", smart.PendingSectors))
+	}
+	if smart.UncorrectableSectors > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d uncorrectable sectors", smart.UncorrectableSectors))
+	}
+	return warnings
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const smartctlOutput = `{
+  "smart_status": {"passed": true},
+  "ata_smart_attributes": {
+    "table": [
+      {"id": 1, "name": "Raw_Read_Error_Rate", "raw": {"value": 12}},
+      {"id": 5, "name": "Reallocated_Sector_Ct", "raw": {"value": 24}},
+      {"id": 197, "name": "Current_Pending_Sector", "raw": {"value": 2}},
+      {"id": 198, "name": "Offline_Uncorrectable", "raw": {"value": 0}}
+    ]
+  },
+  "temperature": {"current": 38}
+}`
+
+type fakeSMART struct {
+	smart SMART
+	err   error
+}
+
+func (fake *fakeSMART) ReadSMART(ctx context.Context) (SMART, error) {
+	return fake.smart, fake.err
+}
+
+func TestParseSmartctl(t *testing.T) {
+	smart, err := ParseSmartctl([]byte(smartctlOutput))
+	require.NoError(t, err)
+	assert.Equal(t, SMART{
+		Passed:             true,
+		ReallocatedSectors: 24,
+		PendingSectors:     2,
+		Temperature:        38,
+	}, smart)
+
+	_, err = ParseSmartctl([]byte("not json"))
+	assert.True(t, ErrDiskHealth.Has(err))
+}
+
+func TestDiskHealth(t *testing.T) {
+	ctx := context.Background()
+	reader := &fakeSMART{smart: SMART{Passed: true}}
+	health := NewDiskHealth(zap.NewNop(), reader, 0, 10)
+	s := &Server{diskHealth: health}
+
+	status, err := health.Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, status.Warnings)
+	assert.False(t, status.IngressStopped)
+	assert.True(t, s.ingressAllowed())
+	assert.Equal(t, int64(0), s.getDiskHealth().GetReallocatedSectors())
+
+	reader.smart.ReallocatedSectors = 10
+	status, err = health.Check(ctx)
+	require.NoError(t, err)
+	assert.Len(t, status.Warnings, 1)
+	assert.True(t, s.ingressAllowed())
+
+	reader.smart.ReallocatedSectors = 11
+	status, err = health.Check(ctx)
+	require.NoError(t, err)
+	assert.True(t, status.IngressStopped)
+	assert.False(t, s.ingressAllowed())
+	assert.True(t, s.getDiskHealth().GetIngressStopped())
+
+	// a failed read keeps the last status
+	reader.err = ErrDiskHealth.New("smartctl not found")
+	_, err = health.Check(ctx)
+	assert.Error(t, err)
+	assert.False(t, s.ingressAllowed())
+
+	// disk health isn't monitored
+	assert.True(t, (&Server{}).ingressAllowed())
+	assert.Nil(t, (&Server{}).getDiskHealth())
+}
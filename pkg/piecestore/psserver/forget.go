@@ -0,0 +1,100 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
+)
+
+// ForgetError is a type of error for failures when forgetting a satellite
+var ForgetError = errs.Class("forget satellite error")
+
+// ForgetProgress describes how far forgetting a satellite got
+type ForgetProgress struct {
+	Pieces  int   // number of pieces of the satellite
+	Deleted int   // number of pieces deleted so far
+	Failed  int   // number of pieces which couldn't be deleted
+	Freed   int64 // bytes freed so far
+}
+
+// ForgetSatellite deletes the pieces and the bandwidth agreements of a
+// satellite which the node no longer trusts, so the space can be used by the
+// trusted satellites. progress is called after every deleted piece.
+func ForgetSatellite(ctx context.Context, log *zap.Logger, trust Trust, db *psdb.DB, storage *pstore.Storage, satelliteID storj.NodeID, progress func(ForgetProgress)) (result ForgetProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if trust.IsTrusted(satelliteID) {
+		return result, ForgetError.New("satellite %s is trusted, untrust it first", satelliteID)
+	}
+
+	// include the pieces stored within the current second
+	ids, err := db.GetPiecesBySatellite(satelliteID, time.Now().Add(time.Second))
+	if err != nil {
+		return result, ForgetError.Wrap(err)
+	}
+	result.Pieces = len(ids)
+
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		size := pieceSize(storage, id)
+		if err := storage.Delete(id); err != nil {
+			log.Warn("failed to delete piece", zap.String("Piece ID", id), zap.Error(err))
+			result.Failed++
+			continue
+		}
+		if err := db.DeleteTTLByID(id); err != nil {
+			log.Warn("failed to delete piece info", zap.String("Piece ID", id), zap.Error(err))
+			result.Failed++
+			continue
+		}
+
+		result.Deleted++
+		result.Freed += size
+		if progress != nil {
+			progress(result)
+		}
+	}
+
+	agreements, err := db.DeleteBandwidthAllocationsBySatellite(satelliteID)
+	if err != nil {
+		return result, ForgetError.Wrap(err)
+	}
+
+	log.Info("Forgot satellite",
+		zap.Stringer("Satellite ID", satelliteID),
+		zap.Int("Deleted", result.Deleted),
+		zap.Int("Failed", result.Failed),
+		zap.Int64("Freed", result.Freed),
+		zap.Int64("Agreements", agreements))
+
+	if result.Failed > 0 {
+		return result, ForgetError.New("failed to delete %d of %d pieces", result.Failed, result.Pieces)
+	}
+	return result, nil
+}
+
+// pieceSize returns the size of the piece on disk, 0 when it's missing
+func pieceSize(storage *pstore.Storage, id string) int64 {
+	path, err := storage.PiecePath(id)
+	if err != nil {
+		return 0
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+func TestForgetSatellite(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := psdb.OpenInMemory()
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	storage := pstore.NewStorage(ctx.Dir("storage"))
+	s := &Server{storage: storage, DB: db}
+
+	trusted := teststorj.NodeIDFromString("trusted")
+	untrusted := teststorj.NodeIDFromString("untrusted")
+
+	pieces := map[string]bool{
+		"11111111111111111111": true,
+		"22222222222222222222": true,
+		"33333333333333333333": false,
+	}
+	for id, forget := range pieces {
+		require.NoError(t, writeFile(s, id))
+		require.NoError(t, db.AddTTL(id, 0, 5))
+
+		satelliteID := trusted
+		if forget {
+			satelliteID = untrusted
+		}
+		require.NoError(t, db.AddPieceSatellite(id, satelliteID))
+	}
+
+	trust := StaticTrust{trusted: struct{}{}}
+
+	_, err = ForgetSatellite(ctx, zaptest.NewLogger(t), trust, db, storage, trusted, nil)
+	assert.True(t, ForgetError.Has(err), "trusted satellites can't be forgotten")
+
+	_, err = ForgetSatellite(ctx, zaptest.NewLogger(t), StaticTrust{}, db, storage, untrusted, nil)
+	assert.True(t, ForgetError.Has(err), "satellites can't be forgotten when all are trusted")
+
+	var reports int
+	result, err := ForgetSatellite(ctx, zaptest.NewLogger(t), trust, db, storage, untrusted, func(ForgetProgress) { reports++ })
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Pieces)
+	assert.Equal(t, 2, result.Deleted)
+	assert.Equal(t, int64(10), result.Freed)
+	assert.Equal(t, 2, reports)
+
+	for id, forget := range pieces {
+		_, err := db.GetTTLByID(id)
+		assert.Equal(t, forget, err != nil, id)
+		assert.Equal(t, forget, pieceSize(storage, id) == 0, id)
+	}
+
+	used, err := db.GetUsedSpace()
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), used.Bytes)
+}
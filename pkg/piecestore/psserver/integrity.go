@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"hash"
+	"hash/crc32"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+)
+
+// ErrCorrupted is the error class of uploads with a corrupted integrity frame
+var ErrCorrupted = errs.Class("corrupted frame")
+
+// supportedIntegrity are the integrity capabilities of the storage node
+const supportedIntegrity = uint32(pb.IntegrityCapability_INTEGRITY_CRC32C_FRAMES | pb.IntegrityCapability_INTEGRITY_RESUME)
+
+// maxFrameSize bounds how much an integrity frame may cover, so corruption
+// is caught while little has been transferred after it
+var maxFrameSize = 4 * memory.MiB
+
+// negotiateIntegrity returns the integrity checks of the requested ones the
+// storage node agrees to, nil when there are none
+func negotiateIntegrity(requested *pb.IntegrityOptions) *pb.IntegrityOptions {
+	capabilities := requested.GetCapabilities() & supportedIntegrity
+	if capabilities&uint32(pb.IntegrityCapability_INTEGRITY_CRC32C_FRAMES) == 0 {
+		return nil
+	}
+	frameSize := requested.GetFrameSize()
+	if frameSize <= 0 || frameSize > maxFrameSize.Int64() {
+		return nil
+	}
+	return &pb.IntegrityOptions{Capabilities: capabilities, FrameSize: frameSize}
+}
+
+// resumable returns whether the options allow resuming an upload
+func resumable(options *pb.IntegrityOptions) bool {
+	return options.GetCapabilities()&uint32(pb.IntegrityCapability_INTEGRITY_RESUME) != 0
+}
+
+// frameVerifier verifies the integrity frames of an upload
+type frameVerifier struct {
+	frameSize int64
+	crc       hash.Hash32
+	offset    int64 // offset of the content received so far
+	verified  int64 // offset of the end of the last verified frame
+}
+
+func newFrameVerifier(options *pb.IntegrityOptions, offset int64) *frameVerifier {
+	return &frameVerifier{
+		frameSize: options.FrameSize,
+		crc:       crc32.New(crc32.MakeTable(crc32.Castagnoli)),
+		offset:    offset,
+		verified:  offset,
+	}
+}
+
+// add adds the content of a message and verifies the frame sent with it
+func (verifier *frameVerifier) add(content []byte, frame *pb.IntegrityFrame) error {
+	_, _ = verifier.crc.Write(content)
+	verifier.offset += int64(len(content))
+
+	if frame == nil {
+		if verifier.offset-verifier.verified > verifier.frameSize {
+			return ErrCorrupted.New("frame at %d exceeds %d bytes", verifier.verified, verifier.frameSize)
+		}
+		return nil
+	}
+	if frame.Offset != verifier.offset {
+		return ErrCorrupted.New("frame ends at %d, received %d", frame.Offset, verifier.offset)
+	}
+	if frame.Crc32C != verifier.crc.Sum32() {
+		return ErrCorrupted.New("checksum mismatch of frame at %d", verifier.verified)
+	}
+
+	verifier.verified = verifier.offset
+	verifier.crc.Reset()
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/bwagreement/testbwagreement"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/storj"
+)
+
+// corruptingClient flips a bit of the content of the uploads once the
+// countdown of messages reaches zero
+type corruptingClient struct {
+	pb.PieceStoreRoutesClient
+	countdown *int32
+}
+
+func (client corruptingClient) Store(ctx context.Context, opts ...grpc.CallOption) (pb.PieceStoreRoutes_StoreClient, error) {
+	stream, err := client.PieceStoreRoutesClient.Store(ctx, opts...)
+	return corruptingStream{stream, client.countdown}, err
+}
+
+type corruptingStream struct {
+	pb.PieceStoreRoutes_StoreClient
+	countdown *int32
+}
+
+func (stream corruptingStream) Send(msg *pb.PieceStore) error {
+	content := msg.GetPieceData().GetContent()
+	if len(content) > 0 && atomic.AddInt32(stream.countdown, -1) == 0 {
+		corrupted := *msg
+		corrupted.PieceData = &pb.PieceStore_PieceData{Content: append([]byte(nil), content...)}
+		corrupted.PieceData.Content[len(content)/2] ^= 1
+		msg = &corrupted
+	}
+	return stream.PieceStoreRoutes_StoreClient.Send(msg)
+}
+
+func TestStoreIntegrity(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	snID, upID := newTestID(ctx, t), newTestID(ctx, t)
+	s, c, cleanup := NewTest(ctx, t, snID, upID, []storj.NodeID{})
+	defer cleanup()
+
+	// the content is sent in 9 messages of at most a frame
+	data := make([]byte, 2*memory.MiB.Int()+1000)
+	_, _ = rand.Read(data)
+
+	for _, countdown := range []int32{3, 8, 9} {
+		countdown := countdown
+		client, err := psclient.NewCustomRoute(corruptingClient{c, &countdown}, &pb.Node{Id: snID.ID, Type: pb.NodeType_STORAGE}, 0, upID)
+		require.NoError(t, err)
+
+		pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_PUT, snID, upID, time.Hour)
+		require.NoError(t, err)
+
+		// the upload is resumed after the last verified frame
+		id := psclient.NewPieceID()
+		err = client.Put(ctx, id, bytes.NewReader(data), time.Now().Add(time.Hour), pba, nil)
+		require.NoError(t, err)
+		assert.True(t, atomic.LoadInt32(&countdown) <= 0, "content wasn't corrupted")
+
+		namespaced, err := getNamespacedPieceID([]byte(id.String()), nil)
+		require.NoError(t, err)
+		reader, err := s.storage.Reader(ctx, namespaced, 0, -1)
+		require.NoError(t, err)
+		stored, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.True(t, bytes.Equal(data, stored), "stored piece differs")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// transferLimiter limits the number of concurrent transfers and counts the
+// transfers rejected because of the limit
+type transferLimiter struct {
+	name     string
+	limit    int64 // 0 for unlimited
+	active   int64
+	rejected int64
+}
+
+// newTransferLimiter creates a limiter of concurrent transfers, a limit of 0
+// doesn't limit them
+func newTransferLimiter(name string, limit int) *transferLimiter {
+	return &transferLimiter{name: name, limit: int64(limit)}
+}
+
+// acquire starts a transfer, it returns a retryable status when the limit of
+// concurrent transfers is reached. release has to be called when acquire
+// succeeded and the transfer finished.
+func (limiter *transferLimiter) acquire() error {
+	active := atomic.AddInt64(&limiter.active, 1)
+	if limiter.limit > 0 && active > limiter.limit {
+		atomic.AddInt64(&limiter.active, -1)
+		atomic.AddInt64(&limiter.rejected, 1)
+		mon.Meter("rejected_" + limiter.name).Mark(1)
+		// the status isn't wrapped, so clients get the code and can retry
+		return status.Errorf(codes.Unavailable, "too many concurrent %s, limit is %d", limiter.name, limiter.limit)
+	}
+	mon.IntVal("active_" + limiter.name).Observe(active)
+	return nil
+}
+
+// release finishes a transfer started with acquire
+func (limiter *transferLimiter) release() {
+	atomic.AddInt64(&limiter.active, -1)
+}
+
+// stats returns the current transfers and the transfers rejected since the start
+func (limiter *transferLimiter) stats() TransferStats {
+	return TransferStats{
+		Limit:    limiter.limit,
+		Active:   atomic.LoadInt64(&limiter.active),
+		Rejected: atomic.LoadInt64(&limiter.rejected),
+	}
+}
+
+// TransferStats are the concurrent transfers of a direction
+type TransferStats struct {
+	Limit    int64 `json:"limit"` // 0 for unlimited
+	Active   int64 `json:"active"`
+	Rejected int64 `json:"rejected"` // since the start of the node
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTransferLimiter(t *testing.T) {
+	limiter := newTransferLimiter("uploads", 2)
+
+	require.NoError(t, limiter.acquire())
+	require.NoError(t, limiter.acquire())
+
+	err := limiter.acquire()
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, TransferStats{Limit: 2, Active: 2, Rejected: 1}, limiter.stats())
+
+	limiter.release()
+	require.NoError(t, limiter.acquire())
+	assert.Equal(t, TransferStats{Limit: 2, Active: 2, Rejected: 1}, limiter.stats())
+
+	unlimited := newTransferLimiter("downloads", 0)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, unlimited.acquire())
+	}
+	assert.Equal(t, TransferStats{Active: 100}, unlimited.stats())
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"fmt"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// transferAction names the action of a transfer in the metrics
+func transferAction(action pb.BandwidthAction) string {
+	switch action {
+	case pb.BandwidthAction_PUT:
+		return "upload"
+	case pb.BandwidthAction_PUT_REPAIR:
+		return "repair_upload"
+	case pb.BandwidthAction_GET:
+		return "download"
+	case pb.BandwidthAction_GET_REPAIR:
+		return "repair_download"
+	case pb.BandwidthAction_GET_AUDIT:
+		return "audit"
+	default:
+		return "unknown"
+	}
+}
+
+// transferMetric is the name of a metric of the action of a satellite, the
+// monkit version used doesn't support tags so both are part of the name,
+// e.g. "upload_latency_ms_<satellite id>"
+func transferMetric(action, metric, satellite string) string {
+	return fmt.Sprintf("%s_%s_%s", action, metric, satellite)
+}
+
+// observeTransfer records the size, throughput and latency of a finished
+// transfer, which the debug server exposes at /mon/ so operators can see
+// which satellite drives the load of the node. The payer allocation is nil
+// when the transfer failed before the first allocation was received.
+func observeTransfer(payer *pb.PayerBandwidthAllocation, bytes int64, duration time.Duration, err error) {
+	satellite, name := "unknown", "unknown"
+	if payer != nil {
+		satellite, name = payer.SatelliteId.String(), transferAction(payer.Action)
+	}
+
+	if err != nil {
+		mon.Meter(transferMetric(name, "failed", satellite)).Mark(1)
+		return
+	}
+
+	mon.Meter(transferMetric(name, "bytes", satellite)).Mark64(bytes)
+	mon.IntVal(transferMetric(name, "size", satellite)).Observe(bytes)
+	mon.IntVal(transferMetric(name, "latency_ms", satellite)).Observe(int64(duration / time.Millisecond))
+	if duration > 0 {
+		mon.IntVal(transferMetric(name, "throughput_bps", satellite)).Observe(int64(float64(bytes) / duration.Seconds()))
+	}
+}
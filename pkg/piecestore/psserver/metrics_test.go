@@ -0,0 +1,48 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestObserveTransfer(t *testing.T) {
+	satellite := teststorj.NodeIDFromString("satellite")
+	audit := &pb.PayerBandwidthAllocation{SatelliteId: satellite, Action: pb.BandwidthAction_GET_AUDIT}
+
+	observeTransfer(audit, 4096, 100*time.Millisecond, nil)
+	observeTransfer(nil, 0, time.Millisecond, errors.New("canceled"))
+
+	stats := map[string]float64{}
+	mon.Stats(func(name string, val float64) {
+		stats[name] = val
+	})
+
+	expected := []string{
+		transferMetric("audit", "bytes", satellite.String()),
+		transferMetric("audit", "latency_ms", satellite.String()),
+		transferMetric("audit", "throughput_bps", satellite.String()),
+		transferMetric("unknown", "failed", "unknown"),
+	}
+	for _, metric := range expected {
+		found := false
+		for name := range stats {
+			if strings.HasPrefix(name, metric) {
+				found = true
+			}
+		}
+		assert.True(t, found, metric)
+	}
+
+	assert.Equal(t, "upload", transferAction(pb.BandwidthAction_PUT))
+	assert.Equal(t, "repair_download", transferAction(pb.BandwidthAction_GET_REPAIR))
+}
@@ -107,6 +107,87 @@ func (db *DB) init() (err error) {
 		return err
 	}
 
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `bandwidth_usage` (`direction` INT(10), `size` INT(10), `daystartdate` INT(10), UNIQUE (`direction`, `daystartdate`));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `piece_satellite` (`id` BLOB UNIQUE, `satellite` BLOB);")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE INDEX IF NOT EXISTS idx_piece_satellite_satellite ON piece_satellite (satellite);")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `piece_hash` (`id` BLOB UNIQUE, `hash` BLOB);")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `trash` (`id` BLOB UNIQUE, `satellite` BLOB, `created` INT(10), `expires` INT(10), `size` INT(10), `trashed` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `used_space` (`pieces` INT(10), `bytes` INT(10), `reconciled` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `satellite_bandwidth` (`satellite` BLOB, `action` INT(10), `size` INT(10), `daystartdate` INT(10), UNIQUE (`satellite`, `action`, `daystartdate`));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `satellite_stats` (`satellite` BLOB UNIQUE, `audit_success_ratio` REAL, `uptime_ratio` REAL, `held_amount` INT(10), `minimum_version` TEXT, `clock_skew` INT(10), `received` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `satellite_standings` (`satellite` BLOB UNIQUE, `status` INT(10), `reason` TEXT, `since` INT(10), `received` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `notifications` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, `type` TEXT, `dedup_key` TEXT, `title` TEXT, `message` TEXT, `created` INT(10), `read` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `satellite_trust` (`satellite` BLOB UNIQUE, `trusted` INT(10), `updated` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `untrusted_satellites` (`satellite` BLOB UNIQUE, `since` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `used_serials` (`satellite` BLOB, `serial` TEXT, `expiration` INT(10), UNIQUE (`satellite`, `serial`));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `paired_agents` (`name` TEXT UNIQUE, `node_id` BLOB, `token_hash` BLOB UNIQUE, `created` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `node_identity` (`node_id` BLOB, `recorded` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	// databases created before the used space was tracked start from the ttl table
+	_, err = tx.Exec("INSERT INTO used_space (pieces, bytes, reconciled) SELECT pieces, bytes, 0 FROM (SELECT COUNT(*) AS pieces, COALESCE(SUM(size), 0) AS bytes FROM ttl) WHERE NOT EXISTS (SELECT 1 FROM used_space);")
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -128,25 +209,16 @@ func (db *DB) locked() func() {
 	return db.mu.Unlock
 }
 
-// DeleteExpired deletes expired pieces
-func (db *DB) DeleteExpired(ctx context.Context) (expired []string, err error) {
+// GetExpired returns the ids of at most limit pieces which expired before now
+func (db *DB) GetExpired(ctx context.Context, now time.Time, limit int) (expired []string, err error) {
 	defer mon.Task()(&ctx)(&err)
 	defer db.locked()()
 
-	// TODO: add limit
-
-	tx, err := db.DB.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = tx.Rollback() }()
-
-	now := time.Now().Unix()
-
-	rows, err := tx.Query("SELECT id FROM ttl WHERE 0 < expires AND ? < expires", now)
+	rows, err := db.DB.QueryContext(ctx, `SELECT id FROM ttl WHERE 0 < expires AND expires <= ? ORDER BY expires LIMIT ?`, now.Unix(), limit)
 	if err != nil {
 		return nil, err
 	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
 
 	for rows.Next() {
 		var id string
@@ -155,16 +227,20 @@ func (db *DB) DeleteExpired(ctx context.Context) (expired []string, err error) {
 		}
 		expired = append(expired, id)
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
+	return expired, rows.Err()
+}
 
-	_, err = tx.Exec(`DELETE FROM ttl WHERE 0 < expires AND ? < expires`, now)
-	if err != nil {
-		return nil, err
-	}
+// NextExpiration returns when the next piece expires, ok is false when no piece has an expiration
+func (db *DB) NextExpiration(ctx context.Context) (next time.Time, ok bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer db.locked()()
 
-	return expired, tx.Commit()
+	var expires sql.NullInt64
+	err = db.DB.QueryRowContext(ctx, `SELECT MIN(expires) FROM ttl WHERE 0 < expires`).Scan(&expires)
+	if err != nil || !expires.Valid {
+		return time.Time{}, false, err
+	}
+	return time.Unix(expires.Int64, 0), true, nil
 }
 
 // WriteBandwidthAllocToDB inserts bandwidth agreement into DB
@@ -180,6 +256,20 @@ func (db *DB) WriteBandwidthAllocToDB(rba *pb.RenterBandwidthAllocation) error {
 	// If the agreements are sorted we can send them in bulk streams to the satellite
 	_, err = db.DB.Exec(`INSERT INTO bandwidth_agreements (satellite, agreement, signature) VALUES (?, ?, ?)`,
 		rba.PayerAllocation.SatelliteId.Bytes(), rbaBytes, rba.GetSignature())
+	if err != nil {
+		return err
+	}
+
+	// the agreements are deleted once they are sent, so the bandwidth used
+	// for each satellite is kept separately
+	satellite := rba.PayerAllocation.SatelliteId.Bytes()
+	action := rba.PayerAllocation.Action
+	daystartunixtime := dayStart(time.Now()).Unix()
+	_, err = db.DB.Exec(`INSERT OR IGNORE INTO satellite_bandwidth (satellite, action, size, daystartdate) VALUES (?, ?, 0, ?)`, satellite, action, daystartunixtime)
+	if err != nil {
+		return err
+	}
+	_, err = db.DB.Exec(`UPDATE satellite_bandwidth SET size = size + ? WHERE satellite = ? AND action = ? AND daystartdate = ?`, rba.GetTotal(), satellite, action, daystartunixtime)
 	return err
 }
 
@@ -193,6 +283,69 @@ func (db *DB) DeleteBandwidthAllocationBySignature(signature []byte) error {
 	return err
 }
 
+// SettleBandwidthAllocation deletes the submitted agreement and remembers its
+// serial, the satellite accepts only one agreement of a serial from the node
+func (db *DB) SettleBandwidthAllocation(agreement *Agreement) error {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.Exec(`DELETE FROM bandwidth_agreements WHERE signature = ?`, agreement.Signature)
+	if err != nil {
+		return err
+	}
+
+	pba := agreement.Agreement.PayerAllocation
+	_, err = tx.Exec(`INSERT OR IGNORE INTO used_serials (satellite, serial, expiration) VALUES (?, ?, ?)`,
+		pba.SatelliteId.Bytes(), pba.SerialNumber, pba.ExpirationUnixSec)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// IsSerialUsed returns whether an agreement with the serial was settled with the satellite
+func (db *DB) IsSerialUsed(satelliteID storj.NodeID, serial string) (used bool, err error) {
+	defer db.locked()()
+
+	err = db.DB.QueryRow(`SELECT EXISTS (SELECT 1 FROM used_serials WHERE satellite = ? AND serial = ?)`, satelliteID.Bytes(), serial).Scan(&used)
+	return used, err
+}
+
+// DeleteUsedSerials deletes the settled serials which expired before expiredBefore
+func (db *DB) DeleteUsedSerials(expiredBefore time.Time) (deleted int64, err error) {
+	defer db.locked()()
+
+	result, err := db.DB.Exec(`DELETE FROM used_serials WHERE expiration < ?`, expiredBefore.Unix())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// Vacuum rebuilds the database file to return the space of deleted rows to the disk
+func (db *DB) Vacuum() error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`VACUUM`)
+	return err
+}
+
+// DeleteBandwidthAllocationsBySatellite deletes the bandwidth agreements of a satellite
+func (db *DB) DeleteBandwidthAllocationsBySatellite(satelliteID storj.NodeID) (deleted int64, err error) {
+	defer db.locked()()
+
+	result, err := db.DB.Exec(`DELETE FROM bandwidth_agreements WHERE satellite = ?`, satelliteID.Bytes())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 // GetBandwidthAllocationBySignature finds allocation info by signature
 func (db *DB) GetBandwidthAllocationBySignature(signature []byte) ([]*pb.RenterBandwidthAllocation, error) {
 	defer db.locked()()
@@ -267,9 +420,34 @@ func (db *DB) GetBandwidthAllocations() (map[storj.NodeID][]*Agreement, error) {
 func (db *DB) AddTTL(id string, expiration, size int64) error {
 	defer db.locked()()
 
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// a replaced piece only changes the used bytes by the difference in size
+	pieces, bytes := int64(1), size
+	var previous sql.NullInt64
+	err = tx.QueryRow(`SELECT size FROM ttl WHERE id=?`, id).Scan(&previous)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return err
+	default:
+		pieces, bytes = 0, size-previous.Int64
+	}
+
 	created := time.Now().Unix()
-	_, err := db.DB.Exec("INSERT OR REPLACE INTO ttl (id, created, expires, size) VALUES (?, ?, ?, ?)", id, created, expiration, size)
-	return err
+	_, err = tx.Exec("INSERT OR REPLACE INTO ttl (id, created, expires, size) VALUES (?, ?, ?, ?)", id, created, expiration, size)
+	if err != nil {
+		return err
+	}
+
+	if err = addUsedSpace(tx, pieces, bytes); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // GetTTLByID finds the TTL in the database by id and return it
@@ -303,17 +481,763 @@ func (db *DB) SumTTLSizes() (sum int64, err error) {
 func (db *DB) DeleteTTLByID(id string) error {
 	defer db.locked()()
 
-	_, err := db.DB.Exec(`DELETE FROM ttl WHERE id=?`, id)
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := deleteTTL(tx, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// deleteTTL deletes the rows of the piece within tx
+func deleteTTL(tx *sql.Tx, id string) error {
+	var size sql.NullInt64
+	err := tx.QueryRow(`SELECT size FROM ttl WHERE id=?`, id).Scan(&size)
+	switch {
+	case err == sql.ErrNoRows:
+		// nothing to delete from the ttl table
+	case err != nil:
+		return err
+	default:
+		_, err = tx.Exec(`DELETE FROM ttl WHERE id=?`, id)
+		if err != nil {
+			return err
+		}
+		if err = addUsedSpace(tx, -1, -size.Int64); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`DELETE FROM piece_satellite WHERE id=?`, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM piece_hash WHERE id=?`, id)
+	return err
+}
+
+// TrashTTLByID moves the rows of the piece to the trash table, so they can
+// be restored together with the piece
+func (db *DB) TrashTTLByID(id string, trashed time.Time) error {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.Exec(`INSERT OR REPLACE INTO trash (id, satellite, created, expires, size, trashed)
+		SELECT ttl.id, piece_satellite.satellite, ttl.created, ttl.expires, ttl.size, ? FROM ttl
+		LEFT JOIN piece_satellite ON ttl.id = piece_satellite.id
+		WHERE ttl.id = ?`, trashed.Unix(), id)
+	if err != nil {
+		return err
+	}
+
+	if err := deleteTTL(tx, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// RestoreTrashedTTL moves the rows of a trashed piece back from the trash
+// table, ok is false when the piece has no rows in it
+func (db *DB) RestoreTrashedTTL(id string) (ok bool, err error) {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var satellite []byte
+	var created, expires, size int64
+	err = tx.QueryRow(`SELECT satellite, created, expires, size FROM trash WHERE id=?`, id).Scan(&satellite, &created, &expires, &size)
 	if err == sql.ErrNoRows {
-		err = nil
+		return false, nil
 	}
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tx.Exec(`INSERT INTO ttl (id, created, expires, size) VALUES (?, ?, ?, ?)`, id, created, expires, size)
+	if err != nil {
+		return false, err
+	}
+	if satellite != nil {
+		_, err = tx.Exec(`INSERT OR REPLACE INTO piece_satellite (id, satellite) VALUES (?, ?)`, id, satellite)
+		if err != nil {
+			return false, err
+		}
+	}
+	if err := addUsedSpace(tx, 1, size); err != nil {
+		return false, err
+	}
+
+	_, err = tx.Exec(`DELETE FROM trash WHERE id=?`, id)
+	if err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// DeleteTrashedBefore deletes the rows of the pieces trashed before trashedBefore
+func (db *DB) DeleteTrashedBefore(trashedBefore time.Time) (deleted int64, err error) {
+	defer db.locked()()
+
+	result, err := db.DB.Exec(`DELETE FROM trash WHERE trashed < ?`, trashedBefore.Unix())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// UsedSpace is the running total of the space used by the stored pieces
+type UsedSpace struct {
+	Pieces int64
+	Bytes  int64
+	// Reconciled is when the total was last reconciled with the pieces on disk,
+	// zero when it never was
+	Reconciled time.Time
+}
+
+// GetUsedSpace returns the running total of the space used by the stored pieces
+func (db *DB) GetUsedSpace() (used UsedSpace, err error) {
+	defer db.locked()()
+
+	var reconciled int64
+	err = db.DB.QueryRow(`SELECT pieces, bytes, reconciled FROM used_space`).Scan(&used.Pieces, &used.Bytes, &reconciled)
+	if err != nil {
+		return UsedSpace{}, err
+	}
+	if reconciled > 0 {
+		used.Reconciled = time.Unix(reconciled, 0)
+	}
+	return used, nil
+}
+
+// ReconcileUsedSpace corrects the running total of the used space by the
+// drift found when walking the pieces on disk
+func (db *DB) ReconcileUsedSpace(pieces, bytes int64, reconciled time.Time) (err error) {
+	defer db.locked()()
+
+	_, err = db.DB.Exec(`UPDATE used_space SET pieces = pieces + ?, bytes = bytes + ?, reconciled = ?`, pieces, bytes, reconciled.Unix())
 	return err
 }
 
-// AddBandwidthUsed adds bandwidth usage into database by date
-func (db *DB) AddBandwidthUsed(size int64) (err error) {
+// addUsedSpace adds to the running total of the used space within tx
+func addUsedSpace(tx *sql.Tx, pieces, bytes int64) error {
+	if pieces == 0 && bytes == 0 {
+		return nil
+	}
+	_, err := tx.Exec(`UPDATE used_space SET pieces = pieces + ?, bytes = bytes + ?`, pieces, bytes)
+	return err
+}
+
+// SatelliteSpace is the space used by the pieces of a satellite
+type SatelliteSpace struct {
+	SatelliteID storj.NodeID
+	Pieces      int64
+	Bytes       int64
+}
+
+// GetUsedSpaceBySatellite returns the space used by the pieces of each satellite,
+// pieces stored before their satellite was recorded aren't included
+func (db *DB) GetUsedSpaceBySatellite() (used []SatelliteSpace, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT piece_satellite.satellite, COUNT(*), COALESCE(SUM(ttl.size), 0) FROM ttl INNER JOIN piece_satellite ON ttl.id = piece_satellite.id GROUP BY piece_satellite.satellite`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var satellite []byte
+		var space SatelliteSpace
+		if err := rows.Scan(&satellite, &space.Pieces, &space.Bytes); err != nil {
+			return nil, err
+		}
+		space.SatelliteID, err = storj.NodeIDFromBytes(satellite)
+		if err != nil {
+			return nil, err
+		}
+		used = append(used, space)
+	}
+	return used, rows.Err()
+}
+
+// AddPieceSatellite records the satellite which authorized storing the piece
+func (db *DB) AddPieceSatellite(id string, satelliteID storj.NodeID) error {
 	defer db.locked()()
 
+	_, err := db.DB.Exec("INSERT OR REPLACE INTO piece_satellite (id, satellite) VALUES (?, ?)", id, satelliteID.Bytes())
+	return err
+}
+
+// AddPieceHash records the sha256 hash of the content of the stored piece
+func (db *DB) AddPieceHash(id string, hash []byte) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec("INSERT OR REPLACE INTO piece_hash (id, hash) VALUES (?, ?)", id, hash)
+	return err
+}
+
+// PieceInfo is what the database knows about a stored piece
+type PieceInfo struct {
+	Size int64
+	// Hash is the sha256 hash of the content, nil when it wasn't recorded
+	Hash []byte
+}
+
+// GetPieceInfo returns the size and hash of the stored piece,
+// sql.ErrNoRows is returned when the piece is unknown
+func (db *DB) GetPieceInfo(id string) (info PieceInfo, err error) {
+	defer db.locked()()
+
+	var size sql.NullInt64
+	err = db.DB.QueryRow(`SELECT ttl.size, piece_hash.hash FROM ttl
+		LEFT JOIN piece_hash ON ttl.id = piece_hash.id
+		WHERE ttl.id = ?`, id).Scan(&size, &info.Hash)
+	info.Size = size.Int64
+	return info, err
+}
+
+// GetPieceSatellite returns the satellite which authorized storing the piece,
+// sql.ErrNoRows is returned when it's unknown
+func (db *DB) GetPieceSatellite(id string) (satelliteID storj.NodeID, err error) {
+	defer db.locked()()
+
+	var satellite []byte
+	err = db.DB.QueryRow(`SELECT satellite FROM piece_satellite WHERE id=?`, id).Scan(&satellite)
+	if err != nil {
+		return storj.NodeID{}, err
+	}
+	return storj.NodeIDFromBytes(satellite)
+}
+
+// GetPiecesBySatellite returns the ids of the pieces stored for the satellite before createdBefore
+func (db *DB) GetPiecesBySatellite(satelliteID storj.NodeID, createdBefore time.Time) (ids []string, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT ttl.id FROM ttl
+		INNER JOIN piece_satellite ON ttl.id = piece_satellite.id
+		WHERE piece_satellite.satellite = ? AND ttl.created < ?`, satelliteID.Bytes(), createdBefore.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// GetSatellitesWithPieces returns the satellites the node stores pieces for
+func (db *DB) GetSatellitesWithPieces() (ids storj.NodeIDList, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT DISTINCT satellite FROM piece_satellite`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var satellite []byte
+		if err := rows.Scan(&satellite); err != nil {
+			return nil, err
+		}
+		id, err := storj.NodeIDFromBytes(satellite)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// SetSatelliteTrust stores that the operator explicitly trusted or untrusted the satellite
+func (db *DB) SetSatelliteTrust(satelliteID storj.NodeID, trusted bool) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`INSERT OR REPLACE INTO satellite_trust (satellite, trusted, updated) VALUES (?, ?, ?)`,
+		satelliteID.Bytes(), trusted, time.Now().Unix())
+	return err
+}
+
+// GetSatelliteTrust returns whether the operator explicitly trusted or untrusted satellites
+func (db *DB) GetSatelliteTrust() (trust map[storj.NodeID]bool, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT satellite, trusted FROM satellite_trust`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	trust = make(map[storj.NodeID]bool)
+	for rows.Next() {
+		var satellite []byte
+		var trusted bool
+		if err := rows.Scan(&satellite, &trusted); err != nil {
+			return nil, err
+		}
+		id, err := storj.NodeIDFromBytes(satellite)
+		if err != nil {
+			return nil, err
+		}
+		trust[id] = trusted
+	}
+	return trust, rows.Err()
+}
+
+// SetUntrustedSince stores since when a satellite with pieces on the node
+// isn't trusted, an earlier time isn't replaced
+func (db *DB) SetUntrustedSince(satelliteID storj.NodeID, since time.Time) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`INSERT OR IGNORE INTO untrusted_satellites (satellite, since) VALUES (?, ?)`, satelliteID.Bytes(), since.Unix())
+	return err
+}
+
+// DeleteUntrustedSince forgets that the satellite isn't trusted
+func (db *DB) DeleteUntrustedSince(satelliteID storj.NodeID) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`DELETE FROM untrusted_satellites WHERE satellite = ?`, satelliteID.Bytes())
+	return err
+}
+
+// GetUntrustedSince returns since when the satellites with pieces on the
+// node aren't trusted
+func (db *DB) GetUntrustedSince() (untrusted map[storj.NodeID]time.Time, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT satellite, since FROM untrusted_satellites`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	untrusted = make(map[storj.NodeID]time.Time)
+	for rows.Next() {
+		var satellite []byte
+		var since int64
+		if err := rows.Scan(&satellite, &since); err != nil {
+			return nil, err
+		}
+		id, err := storj.NodeIDFromBytes(satellite)
+		if err != nil {
+			return nil, err
+		}
+		untrusted[id] = time.Unix(since, 0)
+	}
+	return untrusted, rows.Err()
+}
+
+// Direction is the direction in which bandwidth is used
+type Direction int
+
+const (
+	// Ingress is the bandwidth used for uploads to the node
+	Ingress = Direction(1)
+	// Egress is the bandwidth used for downloads from the node
+	Egress = Direction(2)
+)
+
+// String returns the name of the direction
+func (direction Direction) String() string {
+	switch direction {
+	case Ingress:
+		return "ingress"
+	case Egress:
+		return "egress"
+	default:
+		return "unknown"
+	}
+}
+
+// AddBandwidthUsed adds bandwidth usage into database by date
+func (db *DB) AddBandwidthUsed(size int64) (err error) {
+	defer db.locked()()
+
+	return db.addBandwidthUsed(size)
+}
+
+// AddDirectionalBandwidthUsed adds bandwidth usage into database by date,
+// it's also recorded by direction so the daily and monthly caps can be enforced
+func (db *DB) AddDirectionalBandwidthUsed(direction Direction, size int64) (err error) {
+	defer db.locked()()
+
+	if err := db.addBandwidthUsed(size); err != nil {
+		return err
+	}
+
+	daystartunixtime := dayStart(time.Now()).Unix()
+	_, err = db.DB.Exec("INSERT OR IGNORE INTO bandwidth_usage (direction, size, daystartdate) VALUES (?, 0, ?)", direction, daystartunixtime)
+	if err != nil {
+		return err
+	}
+	_, err = db.DB.Exec("UPDATE bandwidth_usage SET size = size + ? WHERE direction = ? AND daystartdate = ?", size, direction, daystartunixtime)
+	return err
+}
+
+// GetDirectionalBandwidthSince returns the bandwidth used in direction since the start of the day of since
+func (db *DB) GetDirectionalBandwidthSince(direction Direction, since time.Time) (size int64, err error) {
+	defer db.locked()()
+
+	err = db.DB.QueryRow(`SELECT COALESCE(SUM(size), 0) FROM bandwidth_usage WHERE direction = ? AND daystartdate >= ?`, direction, dayStart(since).Unix()).Scan(&size)
+	return size, err
+}
+
+// SatelliteBandwidth is the bandwidth used for a satellite on a day
+type SatelliteBandwidth struct {
+	SatelliteID storj.NodeID
+	Action      pb.BandwidthAction
+	Day         time.Time
+	Size        int64
+}
+
+// GetSatelliteBandwidthSince returns the bandwidth used for each satellite
+// per action and day since the start of the day of since, oldest first
+func (db *DB) GetSatelliteBandwidthSince(since time.Time) (usage []SatelliteBandwidth, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT satellite, action, daystartdate, size FROM satellite_bandwidth WHERE daystartdate >= ? ORDER BY daystartdate, satellite, action`, dayStart(since).Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var satellite []byte
+		var day int64
+		var bandwidth SatelliteBandwidth
+		if err := rows.Scan(&satellite, &bandwidth.Action, &day, &bandwidth.Size); err != nil {
+			return nil, err
+		}
+		bandwidth.SatelliteID, err = storj.NodeIDFromBytes(satellite)
+		if err != nil {
+			return nil, err
+		}
+		bandwidth.Day = time.Unix(day, 0)
+		usage = append(usage, bandwidth)
+	}
+	return usage, rows.Err()
+}
+
+// SatelliteStats are the scores and held amount a satellite reported for the node
+type SatelliteStats struct {
+	SatelliteID       storj.NodeID
+	AuditSuccessRatio float64
+	UptimeRatio       float64
+	// HeldAmount is held back from the payouts in the smallest unit of the payout currency
+	HeldAmount int64
+	// MinimumVersion is the oldest version of the node the satellite accepts
+	MinimumVersion string
+	// ClockSkew is how far the clock of the satellite was ahead of the node's
+	ClockSkew time.Duration
+	Received  time.Time
+}
+
+// SetSatelliteStats replaces the stats last reported by the satellite
+func (db *DB) SetSatelliteStats(stats SatelliteStats) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`INSERT OR REPLACE INTO satellite_stats (satellite, audit_success_ratio, uptime_ratio, held_amount, minimum_version, clock_skew, received) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		stats.SatelliteID.Bytes(), stats.AuditSuccessRatio, stats.UptimeRatio, stats.HeldAmount, stats.MinimumVersion, int64(stats.ClockSkew/time.Second), stats.Received.Unix())
+	return err
+}
+
+// GetSatelliteStats returns the stats last reported by each satellite
+func (db *DB) GetSatelliteStats() (stats []SatelliteStats, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT satellite, audit_success_ratio, uptime_ratio, held_amount, minimum_version, clock_skew, received FROM satellite_stats`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var satellite []byte
+		var skew, received int64
+		var stat SatelliteStats
+		if err := rows.Scan(&satellite, &stat.AuditSuccessRatio, &stat.UptimeRatio, &stat.HeldAmount, &stat.MinimumVersion, &skew, &received); err != nil {
+			return nil, err
+		}
+		stat.SatelliteID, err = storj.NodeIDFromBytes(satellite)
+		if err != nil {
+			return nil, err
+		}
+		stat.ClockSkew = time.Duration(skew) * time.Second
+		stat.Received = time.Unix(received, 0)
+		stats = append(stats, stat)
+	}
+	return stats, rows.Err()
+}
+
+// SatelliteStanding is whether a satellite suspended or disqualified the node
+type SatelliteStanding struct {
+	SatelliteID storj.NodeID
+	Status      pb.NodeStanding_Status
+	Reason      string
+	// Since is when the satellite changed the status, zero when it didn't say
+	Since    time.Time
+	Received time.Time
+}
+
+// SetSatelliteStanding replaces the standing last reported by the satellite
+func (db *DB) SetSatelliteStanding(standing SatelliteStanding) error {
+	defer db.locked()()
+
+	var since int64
+	if !standing.Since.IsZero() {
+		since = standing.Since.Unix()
+	}
+	_, err := db.DB.Exec(`INSERT OR REPLACE INTO satellite_standings (satellite, status, reason, since, received) VALUES (?, ?, ?, ?, ?)`,
+		standing.SatelliteID.Bytes(), int(standing.Status), standing.Reason, since, standing.Received.Unix())
+	return err
+}
+
+// GetSatelliteStandings returns the standing last reported by each satellite
+func (db *DB) GetSatelliteStandings() (standings []SatelliteStanding, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT satellite, status, reason, since, received FROM satellite_standings`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var satellite []byte
+		var status int
+		var since, received int64
+		var standing SatelliteStanding
+		if err := rows.Scan(&satellite, &status, &standing.Reason, &since, &received); err != nil {
+			return nil, err
+		}
+		standing.SatelliteID, err = storj.NodeIDFromBytes(satellite)
+		if err != nil {
+			return nil, err
+		}
+		standing.Status = pb.NodeStanding_Status(status)
+		if since != 0 {
+			standing.Since = time.Unix(since, 0)
+		}
+		standing.Received = time.Unix(received, 0)
+		standings = append(standings, standing)
+	}
+	return standings, rows.Err()
+}
+
+// Notification is an event the operator of the node should know about
+type Notification struct {
+	ID   int64
+	Type string
+	// Key identifies the cause of the notification, a notification isn't
+	// added again while an unread one with the same key exists
+	Key     string
+	Title   string
+	Message string
+	Created time.Time
+	Read    bool
+}
+
+// AddNotification adds a notification unless an unread one with the same key
+// exists, added reports whether it was added
+func (db *DB) AddNotification(notification Notification) (added bool, err error) {
+	defer db.locked()()
+
+	result, err := db.DB.Exec(`INSERT INTO notifications (type, dedup_key, title, message, created, read)
+		SELECT ?, ?, ?, ?, ?, 0 WHERE NOT EXISTS (SELECT 1 FROM notifications WHERE dedup_key = ? AND read = 0)`,
+		notification.Type, notification.Key, notification.Title, notification.Message, notification.Created.Unix(), notification.Key)
+	if err != nil {
+		return false, err
+	}
+	inserted, err := result.RowsAffected()
+	return inserted > 0, err
+}
+
+// GetNotifications returns up to limit notifications, most recent first
+func (db *DB) GetNotifications(unreadOnly bool, limit int) (notifications []Notification, err error) {
+	defer db.locked()()
+
+	query := `SELECT id, type, dedup_key, title, message, created, read FROM notifications`
+	if unreadOnly {
+		query += ` WHERE read = 0`
+	}
+	rows, err := db.DB.Query(query+` ORDER BY id DESC LIMIT ?`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var created, read int64
+		var notification Notification
+		if err := rows.Scan(&notification.ID, &notification.Type, &notification.Key, &notification.Title, &notification.Message, &created, &read); err != nil {
+			return nil, err
+		}
+		notification.Created = time.Unix(created, 0)
+		notification.Read = read != 0
+		notifications = append(notifications, notification)
+	}
+	return notifications, rows.Err()
+}
+
+// ReadNotification marks the notification with id as read
+func (db *DB) ReadNotification(id int64) error {
+	defer db.locked()()
+
+	result, err := db.DB.Exec(`UPDATE notifications SET read = 1 WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ReadAllNotifications marks every notification as read
+func (db *DB) ReadAllNotifications() error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`UPDATE notifications SET read = 1 WHERE read = 0`)
+	return err
+}
+
+// PairedAgent is a client the operator allowed to use the local api of the node
+type PairedAgent struct {
+	Name string
+	// NodeID is the identity the agent connects with, zero when it only
+	// authenticates with its token
+	NodeID    storj.NodeID
+	TokenHash []byte
+	Created   time.Time
+}
+
+// AddPairedAgent adds the agent, it replaces an agent with the same name
+func (db *DB) AddPairedAgent(agent PairedAgent) error {
+	defer db.locked()()
+
+	var nodeID []byte
+	if !agent.NodeID.IsZero() {
+		nodeID = agent.NodeID.Bytes()
+	}
+	_, err := db.DB.Exec(`INSERT OR REPLACE INTO paired_agents (name, node_id, token_hash, created) VALUES (?, ?, ?, ?)`,
+		agent.Name, nodeID, agent.TokenHash, agent.Created.Unix())
+	return err
+}
+
+// DeletePairedAgent deletes the agent with name, sql.ErrNoRows is returned
+// when it's unknown
+func (db *DB) DeletePairedAgent(name string) error {
+	defer db.locked()()
+
+	result, err := db.DB.Exec(`DELETE FROM paired_agents WHERE name = ?`, name)
+	if err != nil {
+		return err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// GetPairedAgents returns the paired agents ordered by name
+func (db *DB) GetPairedAgents() (agents []PairedAgent, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT name, node_id, token_hash, created FROM paired_agents ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var nodeID []byte
+		var created int64
+		var agent PairedAgent
+		if err := rows.Scan(&agent.Name, &nodeID, &agent.TokenHash, &created); err != nil {
+			return nil, err
+		}
+		if len(nodeID) > 0 {
+			agent.NodeID, err = storj.NodeIDFromBytes(nodeID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		agent.Created = time.Unix(created, 0)
+		agents = append(agents, agent)
+	}
+	return agents, rows.Err()
+}
+
+// GetNodeID returns the ID of the node the database belongs to, it's zero
+// until SetNodeID recorded it
+func (db *DB) GetNodeID() (id storj.NodeID, err error) {
+	defer db.locked()()
+
+	var nodeID []byte
+	err = db.DB.QueryRow(`SELECT node_id FROM node_identity`).Scan(&nodeID)
+	if err == sql.ErrNoRows {
+		return storj.NodeID{}, nil
+	}
+	if err != nil {
+		return storj.NodeID{}, err
+	}
+	return storj.NodeIDFromBytes(nodeID)
+}
+
+// SetNodeID records the ID of the node the database belongs to
+func (db *DB) SetNodeID(id storj.NodeID) error {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.Exec(`DELETE FROM node_identity`); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`INSERT INTO node_identity (node_id, recorded) VALUES (?, ?)`, id.Bytes(), time.Now().Unix()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// dayStart returns the start of the day of t
+func dayStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func (db *DB) addBandwidthUsed(size int64) (err error) {
 	t := time.Now()
 	daystartunixtime := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).Unix()
 	dayendunixtime := time.Date(t.Year(), t.Month(), t.Day(), 24, 0, 0, 0, t.Location()).Unix()
@@ -4,6 +4,8 @@
 package psdb
 
 import (
+	"context"
+	"database/sql"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -290,3 +292,432 @@ func BenchmarkWriteBandwidthAllocation(b *testing.B) {
 		}
 	})
 }
+
+func TestPiecesBySatellite(t *testing.T) {
+	db, cleanup := newDB(t, "3")
+	defer cleanup()
+
+	satellite1 := teststorj.NodeIDFromString("satellite1")
+	satellite2 := teststorj.NodeIDFromString("satellite2")
+
+	for id, satelliteID := range map[string]storj.NodeID{
+		"piece1": satellite1,
+		"piece2": satellite1,
+		"piece3": satellite2,
+	} {
+		if err := db.AddTTL(id, 0, 100); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.AddPieceSatellite(id, satelliteID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// pieces stored without a satellite are never returned
+	if err := db.AddTTL("piece4", 0, 100); err != nil {
+		t.Fatal(err)
+	}
+
+	ids, err := db.GetPiecesBySatellite(satellite1, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("expected 2 pieces got %v", ids)
+	}
+
+	ids, err = db.GetPiecesBySatellite(satellite1, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 0 {
+		t.Fatalf("expected no pieces stored before the time got %v", ids)
+	}
+
+	if err := db.DeleteTTLByID("piece3"); err != nil {
+		t.Fatal(err)
+	}
+	ids, err = db.GetPiecesBySatellite(satellite2, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 0 {
+		t.Fatalf("expected deleted pieces to be removed got %v", ids)
+	}
+}
+
+func TestUsedSpace(t *testing.T) {
+	db, cleanup := newDB(t, "4")
+	defer cleanup()
+
+	expect := func(pieces, bytes int64) {
+		t.Helper()
+		used, err := db.GetUsedSpace()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if used.Pieces != pieces || used.Bytes != bytes {
+			t.Fatalf("expected %d pieces and %d bytes got %+v", pieces, bytes, used)
+		}
+		sum, err := db.SumTTLSizes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sum != used.Bytes {
+			t.Fatalf("expected the running total %d to match the ttl table %d", used.Bytes, sum)
+		}
+	}
+
+	expect(0, 0)
+
+	for _, id := range []string{"piece1", "piece2", "piece3"} {
+		if err := db.AddTTL(id, 0, 100); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expect(3, 300)
+
+	// replacing a piece only counts the difference in size
+	if err := db.AddTTL("piece1", 0, 150); err != nil {
+		t.Fatal(err)
+	}
+	expect(3, 350)
+
+	if err := db.DeleteTTLByID("piece2"); err != nil {
+		t.Fatal(err)
+	}
+	expect(2, 250)
+
+	// deleting a missing piece doesn't change the total
+	if err := db.DeleteTTLByID("missing"); err != nil {
+		t.Fatal(err)
+	}
+	expect(2, 250)
+
+	reconciled := time.Now()
+	if err := db.ReconcileUsedSpace(1, -50, reconciled); err != nil {
+		t.Fatal(err)
+	}
+	used, err := db.GetUsedSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used.Pieces != 3 || used.Bytes != 200 {
+		t.Fatalf("expected the drift to be applied got %+v", used)
+	}
+	if used.Reconciled.Unix() != reconciled.Unix() {
+		t.Fatalf("expected reconciled at %v got %v", reconciled, used.Reconciled)
+	}
+}
+
+func TestExpired(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := newDB(t, "5")
+	defer cleanup()
+
+	now := time.Now()
+
+	_, ok, err := db.NextExpiration(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("expected no expiration without pieces")
+	}
+
+	pieces := map[string]time.Time{
+		"expired1": now.Add(-2 * time.Hour),
+		"expired2": now.Add(-time.Hour),
+		"future":   now.Add(time.Hour),
+	}
+	for id, expiration := range pieces {
+		if err := db.AddTTL(id, expiration.Unix(), 100); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// pieces without an expiration are never collected
+	if err := db.AddTTL("forever", 0, 100); err != nil {
+		t.Fatal(err)
+	}
+
+	next, ok, err := db.NextExpiration(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || next.Unix() != pieces["expired1"].Unix() {
+		t.Fatalf("expected next expiration %v got %v", pieces["expired1"], next)
+	}
+
+	expired, err := db.GetExpired(ctx, now, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(expired) != 1 || expired[0] != "expired1" {
+		t.Fatalf("expected the earliest expired piece got %v", expired)
+	}
+
+	expired, err = db.GetExpired(ctx, now, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(expired) != 2 || expired[0] != "expired1" || expired[1] != "expired2" {
+		t.Fatalf("expected the expired pieces got %v", expired)
+	}
+}
+
+func TestTrash(t *testing.T) {
+	db, cleanup := newDB(t, "6")
+	defer cleanup()
+
+	satelliteID := teststorj.NodeIDFromString("satellite")
+	if err := db.AddTTL("piece1", 1234, 100); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddPieceSatellite("piece1", satelliteID); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := db.GetPieceSatellite("piece1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != satelliteID {
+		t.Fatalf("expected satellite %v got %v", satelliteID, got)
+	}
+	if _, err := db.GetPieceSatellite("missing"); err != sql.ErrNoRows {
+		t.Fatalf("expected no rows for a piece without a satellite got %v", err)
+	}
+
+	trashed := time.Now()
+	if err := db.TrashTTLByID("piece1", trashed); err != nil {
+		t.Fatal(err)
+	}
+	used, err := db.GetUsedSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used.Pieces != 0 || used.Bytes != 0 {
+		t.Fatalf("expected trashed pieces not to use space got %+v", used)
+	}
+	ids, err := db.GetPiecesBySatellite(satelliteID, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 0 {
+		t.Fatalf("expected trashed pieces to be removed got %v", ids)
+	}
+
+	ok, err := db.RestoreTrashedTTL("piece1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("expected the trashed piece to be restored")
+	}
+	expiration, err := db.GetTTLByID("piece1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expiration != 1234 {
+		t.Fatalf("expected the expiration to be restored got %d", expiration)
+	}
+	ids, err = db.GetPiecesBySatellite(satelliteID, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 {
+		t.Fatalf("expected the restored piece got %v", ids)
+	}
+
+	// a piece can only be restored once
+	ok, err = db.RestoreTrashedTTL("piece1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("expected no trashed piece")
+	}
+
+	if err := db.TrashTTLByID("piece1", trashed); err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := db.DeleteTrashedBefore(trashed.Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 0 {
+		t.Fatalf("expected the recently trashed piece to be kept got %d deleted", deleted)
+	}
+	deleted, err = db.DeleteTrashedBefore(trashed.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 1 {
+		t.Fatalf("expected the trashed piece to be deleted got %d deleted", deleted)
+	}
+}
+
+func TestSatelliteDashboard(t *testing.T) {
+	db, cleanup := newDB(t, "7")
+	defer cleanup()
+
+	satellite1 := teststorj.NodeIDFromString("satellite1")
+	satellite2 := teststorj.NodeIDFromString("satellite2")
+
+	for id, satelliteID := range map[string]storj.NodeID{
+		"piece1": satellite1,
+		"piece2": satellite1,
+		"piece3": satellite2,
+	} {
+		if err := db.AddTTL(id, 0, 100); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.AddPieceSatellite(id, satelliteID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	used, err := db.GetUsedSpaceBySatellite()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(used) != 2 {
+		t.Fatalf("expected the space of 2 satellites got %v", used)
+	}
+	for _, space := range used {
+		if space.SatelliteID == satellite1 && (space.Pieces != 2 || space.Bytes != 200) {
+			t.Fatalf("expected 2 pieces of 200 bytes got %+v", space)
+		}
+	}
+
+	for _, rba := range []*pb.RenterBandwidthAllocation{
+		{PayerAllocation: pb.PayerBandwidthAllocation{SatelliteId: satellite1, Action: pb.BandwidthAction_PUT}, Total: 100},
+		{PayerAllocation: pb.PayerBandwidthAllocation{SatelliteId: satellite1, Action: pb.BandwidthAction_PUT}, Total: 50},
+		{PayerAllocation: pb.PayerBandwidthAllocation{SatelliteId: satellite1, Action: pb.BandwidthAction_GET_AUDIT}, Total: 10},
+		{PayerAllocation: pb.PayerBandwidthAllocation{SatelliteId: satellite2, Action: pb.BandwidthAction_GET}, Total: 20},
+	} {
+		if err := db.WriteBandwidthAllocToDB(rba); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	usage, err := db.GetSatelliteBandwidthSince(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(usage) != 3 {
+		t.Fatalf("expected 3 satellite actions got %v", usage)
+	}
+	for _, bandwidth := range usage {
+		if bandwidth.SatelliteID == satellite1 && bandwidth.Action == pb.BandwidthAction_PUT && bandwidth.Size != 150 {
+			t.Fatalf("expected 150 bytes uploaded got %d", bandwidth.Size)
+		}
+	}
+
+	// the bandwidth is kept after the agreements were sent
+	if _, err := db.DeleteBandwidthAllocationsBySatellite(satellite1); err != nil {
+		t.Fatal(err)
+	}
+	usage, err = db.GetSatelliteBandwidthSince(time.Now().AddDate(0, 0, -30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(usage) != 3 {
+		t.Fatalf("expected 3 satellite actions got %v", usage)
+	}
+
+	received := time.Now().Truncate(time.Second)
+	for _, ratio := range []float64{0.5, 0.9} {
+		err := db.SetSatelliteStats(SatelliteStats{SatelliteID: satellite2, AuditSuccessRatio: ratio, UptimeRatio: 0.99, HeldAmount: 1000, Received: received})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := db.GetSatelliteStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 1 {
+		t.Fatalf("expected the stats of 1 satellite got %v", stats)
+	}
+	if stats[0].SatelliteID != satellite2 || stats[0].AuditSuccessRatio != 0.9 || stats[0].HeldAmount != 1000 || !stats[0].Received.Equal(received) {
+		t.Fatalf("expected the last reported stats got %+v", stats[0])
+	}
+
+	since := received.Add(-time.Hour)
+	for _, status := range []pb.NodeStanding_Status{pb.NodeStanding_GOOD, pb.NodeStanding_SUSPENDED} {
+		err := db.SetSatelliteStanding(SatelliteStanding{SatelliteID: satellite1, Status: status, Reason: "audit score", Since: since, Received: received})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	standings, err := db.GetSatelliteStandings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(standings) != 1 {
+		t.Fatalf("expected the standing of 1 satellite got %v", standings)
+	}
+	if standings[0].SatelliteID != satellite1 || standings[0].Status != pb.NodeStanding_SUSPENDED || !standings[0].Since.Equal(since) || !standings[0].Received.Equal(received) {
+		t.Fatalf("expected the last reported standing got %+v", standings[0])
+	}
+}
+
+func TestUsedSerials(t *testing.T) {
+	db, cleanup := newDB(t, "8")
+	defer cleanup()
+
+	satellite := teststorj.NodeIDFromString("satellite")
+	now := time.Now()
+
+	agreement := func(serial string, expiration time.Time) *Agreement {
+		rba := &pb.RenterBandwidthAllocation{
+			PayerAllocation: pb.PayerBandwidthAllocation{SatelliteId: satellite, SerialNumber: serial, ExpirationUnixSec: expiration.Unix()},
+			Signature:       []byte(serial),
+		}
+		if err := db.WriteBandwidthAllocToDB(rba); err != nil {
+			t.Fatal(err)
+		}
+		return &Agreement{Agreement: *rba, Signature: rba.Signature}
+	}
+	expired := agreement("expired", now.Add(-time.Hour))
+	current := agreement("current", now.Add(time.Hour))
+	pending := agreement("pending", now.Add(time.Hour))
+
+	for _, settled := range []*Agreement{expired, current} {
+		if err := db.SettleBandwidthAllocation(settled); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	agreements, err := db.GetBandwidthAllocations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(agreements[satellite]) != 1 || agreements[satellite][0].Agreement.PayerAllocation.SerialNumber != "pending" {
+		t.Fatalf("expected only the pending agreement got %v", agreements[satellite])
+	}
+
+	deleted, err := db.DeleteUsedSerials(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 1 {
+		t.Fatalf("expected 1 expired serial deleted got %d", deleted)
+	}
+
+	for serial, expected := range map[string]bool{"expired": false, "current": true, pending.Agreement.PayerAllocation.SerialNumber: false} {
+		used, err := db.IsSerialUsed(satellite, serial)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if used != expected {
+			t.Fatalf("expected serial %q used %v got %v", serial, expected, used)
+		}
+	}
+
+	if err := db.Vacuum(); err != nil {
+		t.Fatal(err)
+	}
+}
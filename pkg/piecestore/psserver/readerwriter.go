@@ -42,6 +42,7 @@ type StreamReader struct {
 	bandwidthRemaining  int64
 	spaceRemaining      int64
 	sofar               int64
+	frames              *frameVerifier // nil without integrity frames
 }
 
 // NewStreamReader returns a new StreamReader for Server.Store
@@ -64,20 +65,23 @@ func NewStreamReader(s *Server, stream pb.PieceStoreRoutes_StoreServer, bandwidt
 			return nil, err
 		}
 		pba := rba.PayerAllocation
-		if err = s.verifyPayerAllocation(&pba, "PUT"); err != nil {
+		if err = s.verifyPayerAllocation(&pba, pb.BandwidthAction.IsPut); err != nil {
 			return nil, err
 		}
-		// if whitelist does not contain PBA satellite ID, reject storage request
-		if len(s.whitelist) != 0 {
-			if !s.isWhitelisted(pba.SatelliteId) {
-				return nil, StoreError.New("Satellite ID not approved")
-			}
+		// reject storage requests of satellites which aren't trusted
+		if !s.isWhitelisted(pba.SatelliteId) {
+			return nil, StoreError.New("Satellite ID not approved")
 		}
 		// Update bandwidthallocation to be stored
 		if rba.Total > sr.currentTotal {
 			sr.bandwidthAllocation = rba
 			sr.currentTotal = rba.Total
 		}
+		if sr.frames != nil {
+			if err := sr.frames.add(pd.GetContent(), recv.GetFrame()); err != nil {
+				return nil, err
+			}
+		}
 		return pd.GetContent(), nil
 	})
 
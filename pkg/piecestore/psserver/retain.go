@@ -0,0 +1,67 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+// RetainError is a type of error for failures in Server.Retain()
+var RetainError = errs.Class("retain error")
+
+// Retain moves the pieces of the calling satellite which are not in its bloom
+// filter and were stored before the filter was created to the trash
+func (s *Server) Retain(ctx context.Context, in *pb.RetainRequest) (_ *pb.RetainResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, RetainError.Wrap(err)
+	}
+	if !s.isWhitelisted(peer.ID) {
+		return nil, RetainError.New("satellite %s is not whitelisted", peer.ID)
+	}
+
+	filter, err := bloomfilter.NewFromBytes(in.GetFilter())
+	if err != nil {
+		return nil, RetainError.Wrap(err)
+	}
+	createdBefore := time.Unix(in.GetCreationUnixSec(), 0)
+
+	ids, err := s.DB.GetPiecesBySatellite(peer.ID, createdBefore)
+	if err != nil {
+		return nil, RetainError.Wrap(err)
+	}
+
+	var trashed int64
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if filter.Contains([]byte(id)) {
+			continue
+		}
+		if err := s.trashByID(id); err != nil {
+			s.log.Warn("failed to trash piece", zap.String("Piece ID", id), zap.Error(err))
+			continue
+		}
+		trashed++
+	}
+
+	mon.IntVal("retain_pieces_trashed").Observe(trashed)
+	s.log.Info("Garbage collected pieces",
+		zap.Stringer("Satellite ID", peer.ID),
+		zap.Int("Checked", len(ids)),
+		zap.Int64("Trashed", trashed))
+
+	return &pb.RetainResponse{Trashed: trashed}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 	"sync/atomic"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -16,6 +17,7 @@ import (
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
 )
 
 // RetrieveError is a type of error for failures in Server.Retrieve()
@@ -26,6 +28,11 @@ func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error)
 	ctx := stream.Context()
 	defer mon.Task()(&ctx)(&err)
 
+	if err := s.downloads.acquire(); err != nil {
+		return err
+	}
+	defer s.downloads.release()
+
 	// Receive Signature
 	recv, err := stream.Recv()
 	if err != nil {
@@ -56,6 +63,15 @@ func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error)
 		zap.Int64("Size", pd.GetPieceSize()),
 	)
 
+	if err := s.checkBandwidthCap(psdb.Egress); err != nil {
+		return err
+	}
+
+	// expired pieces aren't served anymore, even when they weren't collected yet
+	if expiration, err := s.DB.GetTTLByID(id); err == nil && expired(expiration, time.Now()) {
+		return RetrieveError.New("piece %s expired", id)
+	}
+
 	// Get path to data being retrieved
 	path, err := s.storage.PiecePath(id)
 	if err != nil {
@@ -106,6 +122,14 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 	allocationTracking := sync2.NewThrottle()
 	totalAllocated := int64(0)
 
+	// the first payer allocation tells the satellite and the action of the download
+	var payer atomic.Value
+	start := time.Now()
+	defer func() {
+		first, _ := payer.Load().(*pb.PayerBandwidthAllocation)
+		observeTransfer(first, retrieved, time.Since(start), err)
+	}()
+
 	// Bandwidth Allocation recv loop
 	go func() {
 		var lastTotal int64
@@ -133,7 +157,7 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 				return
 			}
 			pba := rba.PayerAllocation
-			if err = s.verifyPayerAllocation(&pba, "GET"); err != nil {
+			if err = s.verifyPayerAllocation(&pba, pb.BandwidthAction.IsGet); err != nil {
 				allocationTracking.Fail(RetrieveError.Wrap(err))
 				return
 			}
@@ -151,6 +175,9 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 				return
 			}
 
+			if lastAllocation == nil {
+				payer.Store(&pba)
+			}
 			lastAllocation = rba
 			lastTotal = rba.Total
 		}
@@ -190,7 +217,7 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 	}
 
 	// write to bandwidth usage table
-	if err = s.DB.AddBandwidthUsed(used); err != nil {
+	if err = s.DB.AddDirectionalBandwidthUsed(psdb.Egress, used); err != nil {
 		return retrieved, allocated, RetrieveError.New("failed to write bandwidth info to database: %v", err)
 	}
 
@@ -0,0 +1,186 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+// ScrubError is a type of error for failures when scrubbing the stored pieces
+var ScrubError = errs.Class("scrub error")
+
+// ScrubOptions configures how the stored pieces are scrubbed
+type ScrubOptions struct {
+	// Rate limits how many bytes are read per second, 0 is unlimited
+	Rate memory.Size
+	// Quarantine moves the corrupted pieces to the quarantine
+	Quarantine bool
+}
+
+// CorruptedPiece is a piece which doesn't match what the database knows about it
+type CorruptedPiece struct {
+	ID     string
+	Reason string
+}
+
+// ScrubProgress describes how far scrubbing the stored pieces got
+type ScrubProgress struct {
+	Pieces    int64 // pieces checked so far
+	Bytes     int64 // bytes read so far
+	Unknown   int64 // pieces the database doesn't know, such as unfinished uploads
+	Unhashed  int64 // pieces only checked by size since their hash wasn't recorded
+	Corrupted []CorruptedPiece
+}
+
+// Scrub reads every stored piece and compares it with the size and hash the
+// database recorded when it was stored, so bit rot is detected before audits
+// do. progress is called after every checked piece.
+func Scrub(ctx context.Context, log *zap.Logger, db *psdb.DB, storage *pstore.Storage, options ScrubOptions, progress func(ScrubProgress)) (result ScrubProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limiter := newReadLimiter(options.Rate.Int64())
+
+	err = storage.WalkPieces(ctx, func(id string, info os.FileInfo) error {
+		piece, err := db.GetPieceInfo(id)
+		if err == sql.ErrNoRows {
+			result.Unknown++
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		reason, read, err := scrubPiece(ctx, storage, id, piece, limiter)
+		if err != nil {
+			return err
+		}
+
+		result.Pieces++
+		result.Bytes += read
+		if piece.Hash == nil {
+			result.Unhashed++
+		}
+
+		if reason != "" {
+			log.Warn("corrupted piece", zap.String("Piece ID", id), zap.String("Reason", reason))
+			result.Corrupted = append(result.Corrupted, CorruptedPiece{ID: id, Reason: reason})
+
+			if options.Quarantine {
+				if err := storage.Quarantine(id); err != nil {
+					return err
+				}
+				if err := db.DeleteTTLByID(id); err != nil {
+					return err
+				}
+			}
+		}
+
+		if progress != nil {
+			progress(result)
+		}
+		return nil
+	})
+
+	mon.IntVal("scrub_corrupted_pieces").Observe(int64(len(result.Corrupted)))
+	log.Info("Scrubbed pieces",
+		zap.Int64("Pieces", result.Pieces),
+		zap.Int64("Unknown", result.Unknown),
+		zap.Int("Corrupted", len(result.Corrupted)))
+
+	return result, ScrubError.Wrap(err)
+}
+
+// scrubPiece reads the piece and returns why it's corrupted, an empty reason
+// when it isn't. Failing to read the piece is a reason rather than an error,
+// it's how a failing disk shows up.
+func scrubPiece(ctx context.Context, storage *pstore.Storage, id string, piece psdb.PieceInfo, limiter *readLimiter) (reason string, read int64, err error) {
+	path, err := storage.PiecePath(id)
+	if err != nil {
+		return "", 0, err
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		// deleted while scrubbing
+		return "", 0, nil
+	}
+	if err != nil {
+		return fmt.Sprintf("open failed: %v", err), 0, nil
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	hash := sha256.New()
+	read, err = io.Copy(hash, &limitedReader{ctx: ctx, reader: file, limiter: limiter})
+	if err := ctx.Err(); err != nil {
+		return "", read, err
+	}
+	if err != nil {
+		return fmt.Sprintf("read failed: %v", err), read, nil
+	}
+
+	if read != piece.Size {
+		return fmt.Sprintf("size is %d, expected %d", read, piece.Size), read, nil
+	}
+	if piece.Hash != nil && !bytes.Equal(hash.Sum(nil), piece.Hash) {
+		return "hash mismatch", read, nil
+	}
+	return "", read, nil
+}
+
+// readLimiter limits the rate of reads to bytes per second
+type readLimiter struct {
+	rate  int64
+	start time.Time
+	read  int64
+}
+
+func newReadLimiter(rate int64) *readLimiter {
+	return &readLimiter{rate: rate, start: time.Now()}
+}
+
+// wait waits until n more bytes may be read
+func (limiter *readLimiter) wait(ctx context.Context, n int64) error {
+	if limiter.rate <= 0 {
+		return nil
+	}
+	limiter.read += n
+	due := limiter.start.Add(time.Duration(float64(limiter.read) / float64(limiter.rate) * float64(time.Second)))
+
+	timer := time.NewTimer(time.Until(due))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// limitedReader reads from reader at the rate of limiter
+type limitedReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *readLimiter
+}
+
+func (reader *limitedReader) Read(p []byte) (n int, err error) {
+	n, err = reader.reader.Read(p)
+	if waitErr := reader.limiter.wait(reader.ctx, int64(n)); waitErr != nil {
+		return n, waitErr
+	}
+	return n, err
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"crypto/sha256"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+func TestScrub(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := psdb.OpenInMemory()
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	storage := pstore.NewStorage(ctx.Dir("storage"))
+	s := &Server{storage: storage, DB: db}
+
+	hash := sha256.Sum256([]byte("xyzwq"))
+	const (
+		healthy   = "11111111111111111111"
+		unhashed  = "22222222222222222222"
+		truncated = "33333333333333333333"
+		rotten    = "44444444444444444444"
+		unknown   = "55555555555555555555"
+	)
+	for _, id := range []string{healthy, unhashed, truncated, rotten, unknown} {
+		require.NoError(t, writeFile(s, id))
+		if id == unknown {
+			continue
+		}
+		require.NoError(t, db.AddTTL(id, 0, 5))
+		if id != unhashed {
+			require.NoError(t, db.AddPieceHash(id, hash[:]))
+		}
+	}
+
+	path, err := storage.PiecePath(truncated)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, []byte("xyz"), 0600))
+	path, err = storage.PiecePath(rotten)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, []byte("xyzwa"), 0600))
+
+	result, err := Scrub(ctx, zaptest.NewLogger(t), db, storage, ScrubOptions{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), result.Pieces)
+	assert.Equal(t, int64(1), result.Unknown)
+	assert.Equal(t, int64(1), result.Unhashed)
+	require.Len(t, result.Corrupted, 2)
+	assert.Equal(t, truncated, result.Corrupted[0].ID)
+	assert.Equal(t, rotten, result.Corrupted[1].ID)
+
+	// the corrupted pieces are kept unless they are quarantined
+	assert.Equal(t, int64(3), pieceSize(storage, truncated))
+
+	result, err = Scrub(ctx, zaptest.NewLogger(t), db, storage, ScrubOptions{Quarantine: true}, nil)
+	require.NoError(t, err)
+	assert.Len(t, result.Corrupted, 2)
+
+	for _, id := range []string{truncated, rotten} {
+		assert.Equal(t, int64(0), pieceSize(storage, id))
+		_, err := db.GetTTLByID(id)
+		assert.Error(t, err)
+	}
+
+	result, err = Scrub(ctx, zaptest.NewLogger(t), db, storage, ScrubOptions{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.Pieces)
+	assert.Empty(t, result.Corrupted)
+}
@@ -7,27 +7,27 @@ import (
 	"context"
 	"crypto"
 	"crypto/ecdsa"
-	"crypto/hmac"
-	"crypto/sha512"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
-	"github.com/mr-tron/base58/base58"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/peertls"
 	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/psclient"
 	"storj.io/storj/pkg/piecestore/psserver/psdb"
 	"storj.io/storj/pkg/storj"
 )
@@ -57,20 +57,28 @@ func DirSize(path string) (int64, error) {
 
 // Server -- GRPC server meta data used in route calls
 type Server struct {
-	startTime        time.Time
-	log              *zap.Logger
-	storage          *pstore.Storage
-	DB               *psdb.DB
-	pkey             crypto.PrivateKey
+	// first in the struct to be 64-bit aligned for atomic access
 	totalAllocated   int64 // TODO: use memory.Size
 	totalBwAllocated int64 // TODO: use memory.Size
-	whitelist        map[storj.NodeID]*ecdsa.PublicKey
-	verifier         auth.SignedMessageVerifier
-	kad              *kademlia.Kademlia
+
+	startTime     time.Time
+	log           *zap.Logger
+	storage       *pstore.Storage
+	DB            *psdb.DB
+	pkey          crypto.PrivateKey
+	bandwidthCaps bandwidthCaps
+	uploads       *transferLimiter
+	downloads     *transferLimiter
+	diskHealth    *DiskHealth
+	trust         Trust
+	verifier      auth.SignedMessageVerifier
+	kad           *kademlia.Kademlia
 }
 
-// NewEndpoint creates a new endpoint
-func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *psdb.DB, pkey crypto.PrivateKey, k *kademlia.Kademlia) (*Server, error) {
+// NewEndpoint creates a new endpoint, which stores data for the satellites
+// trust trusts. Uploads are rejected while diskHealth reports a failing disk
+// unless it's nil
+func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *psdb.DB, pkey crypto.PrivateKey, k *kademlia.Kademlia, diskHealth *DiskHealth, trust Trust) (*Server, error) {
 	// read the allocated disk space from the config file
 	allocatedDiskSpace := config.AllocatedDiskSpace.Int64()
 	allocatedBandwidth := config.AllocatedBandwidth.Int64()
@@ -84,10 +92,9 @@ func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *ps
 	freeDiskSpace := info.AvailableSpace
 
 	// get how much is currently used, if for the first time totalUsed = 0
-	totalUsed, err := db.SumTTLSizes()
-	if err != nil {
-		//first time setup
-		totalUsed = 0
+	var totalUsed int64
+	if used, err := db.GetUsedSpace(); err == nil {
+		totalUsed = used.Bytes
 	}
 
 	usedBandwidth, err := db.GetTotalBandwidthBetween(getBeginningOfMonth(), time.Now())
@@ -122,19 +129,6 @@ func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *ps
 		log.Warn("Disk space is less than requested. Allocating space", zap.Int64("bytes", allocatedDiskSpace))
 	}
 
-	// parse the comma separated list of approved satellite IDs into an array of storj.NodeIDs
-	whitelist := make(map[storj.NodeID]*ecdsa.PublicKey)
-	if config.SatelliteIDRestriction {
-		idStrings := strings.Split(config.WhitelistedSatelliteIDs, ",")
-		for _, s := range idStrings {
-			satID, err := storj.NodeIDFromString(s)
-			if err != nil {
-				return nil, err
-			}
-			whitelist[satID] = nil // we will set these later
-		}
-	}
-
 	return &Server{
 		startTime:        time.Now(),
 		log:              log,
@@ -143,12 +137,59 @@ func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *ps
 		pkey:             pkey,
 		totalAllocated:   allocatedDiskSpace,
 		totalBwAllocated: allocatedBandwidth,
-		whitelist:        whitelist,
+		trust:            trust,
 		verifier:         auth.NewSignedMessageVerifier(),
 		kad:              k,
+		diskHealth:       diskHealth,
+		uploads:          newTransferLimiter("uploads", config.MaxConcurrentUploads),
+		downloads:        newTransferLimiter("downloads", config.MaxConcurrentDownloads),
+		bandwidthCaps: bandwidthCaps{
+			IngressPerDay:   config.MaxIngressPerDay.Int64(),
+			IngressPerMonth: config.MaxIngressPerMonth.Int64(),
+			EgressPerDay:    config.MaxEgressPerDay.Int64(),
+			EgressPerMonth:  config.MaxEgressPerMonth.Int64(),
+		},
 	}, nil
 }
 
+// SetAllocated changes the allocated disk space and bandwidth without a
+// restart, the disk space is reduced to what the disk has available
+func (s *Server) SetAllocated(diskSpace, bandwidth memory.Size) error {
+	if diskSpace <= 0 || bandwidth <= 0 {
+		return ServerError.New("allocated disk space and bandwidth must be positive")
+	}
+
+	info, err := s.storage.Info()
+	if err != nil {
+		return ServerError.Wrap(err)
+	}
+	used, err := s.DB.GetUsedSpace()
+	if err != nil {
+		return ServerError.Wrap(err)
+	}
+
+	allocated := diskSpace.Int64()
+	if available := used.Bytes + info.AvailableSpace; allocated > available {
+		allocated = available
+		s.log.Warn("Disk space is less than requested. Allocating space", zap.Int64("bytes", allocated))
+	}
+
+	atomic.StoreInt64(&s.totalAllocated, allocated)
+	atomic.StoreInt64(&s.totalBwAllocated, bandwidth.Int64())
+	s.log.Info("Allocation changed", zap.Int64("disk space", allocated), zap.Int64("bandwidth", bandwidth.Int64()))
+	return nil
+}
+
+// allocatedSpace returns the disk space allocated to the node
+func (s *Server) allocatedSpace() int64 {
+	return atomic.LoadInt64(&s.totalAllocated)
+}
+
+// allocatedBandwidth returns the bandwidth allocated to the node per month
+func (s *Server) allocatedBandwidth() int64 {
+	return atomic.LoadInt64(&s.totalBwAllocated)
+}
+
 // Close stops the server
 func (s *Server) Close() error { return nil }
 
@@ -198,6 +239,9 @@ func (s *Server) Piece(ctx context.Context, in *pb.PieceId) (*pb.PieceSummary, e
 	if err != nil {
 		return nil, err
 	}
+	if expired(ttl, time.Now()) {
+		return nil, ServerError.New("piece %s expired", id)
+	}
 
 	s.log.Info("Successfully retrieved meta", zap.String("Piece ID", in.GetId()))
 	return &pb.PieceSummary{Id: in.GetId(), PieceSize: fileInfo.Size(), ExpirationUnixSec: ttl}, nil
@@ -218,7 +262,7 @@ func (s *Server) Stats(ctx context.Context, in *pb.StatsReq) (*pb.StatSummary, e
 }
 
 func (s *Server) retrieveStats() (*pb.StatSummary, error) {
-	totalUsed, err := s.DB.SumTTLSizes()
+	used, err := s.DB.GetUsedSpace()
 	if err != nil {
 		return nil, err
 	}
@@ -228,7 +272,30 @@ func (s *Server) retrieveStats() (*pb.StatSummary, error) {
 		return nil, err
 	}
 
-	return &pb.StatSummary{UsedSpace: totalUsed, AvailableSpace: (s.totalAllocated - totalUsed), UsedBandwidth: totalUsedBandwidth, AvailableBandwidth: (s.totalBwAllocated - totalUsedBandwidth)}, nil
+	availableBandwidth, err := s.availableBandwidth(totalUsedBandwidth)
+	if err != nil {
+		return nil, err
+	}
+
+	var reconciled int64
+	if !used.Reconciled.IsZero() {
+		reconciled = used.Reconciled.Unix()
+	}
+
+	// satellites don't select nodes without space for uploads
+	availableSpace := s.allocatedSpace() - used.Bytes
+	if !s.ingressAllowed() {
+		availableSpace = 0
+	}
+
+	return &pb.StatSummary{
+		UsedSpace:                  used.Bytes,
+		AvailableSpace:             availableSpace,
+		UsedBandwidth:              totalUsedBandwidth,
+		AvailableBandwidth:         availableBandwidth,
+		UsedPieces:                 used.Pieces,
+		UsedSpaceReconciledUnixSec: reconciled,
+	}, nil
 }
 
 // Dashboard is a stream that sends data every `interval` seconds to the listener.
@@ -270,7 +337,7 @@ func (s *Server) Delete(ctx context.Context, in *pb.PieceDelete) (*pb.PieceDelet
 	if err != nil {
 		return nil, err
 	}
-	if err := s.deleteByID(id); err != nil {
+	if err := s.trashByID(id); err != nil {
 		return nil, err
 	}
 
@@ -279,6 +346,12 @@ func (s *Server) Delete(ctx context.Context, in *pb.PieceDelete) (*pb.PieceDelet
 	return &pb.PieceDeleteSummary{Message: OK}, nil
 }
 
+// expired returns whether a piece with the expiration in unix seconds expired at now,
+// pieces without an expiration never expire
+func expired(expiration int64, now time.Time) bool {
+	return expiration > 0 && expiration <= now.Unix()
+}
+
 func (s *Server) deleteByID(id string) error {
 	if err := s.storage.Delete(id); err != nil {
 		return err
@@ -325,25 +398,27 @@ func (s *Server) verifySignature(ctx context.Context, rba *pb.RenterBandwidthAll
 	return nil
 }
 
-func (s *Server) verifyPayerAllocation(pba *pb.PayerBandwidthAllocation, actionPrefix string) (err error) {
+// verifyPayerAllocation checks the payer allocation is usable for a request,
+// allowed reports whether the action is valid for the kind of request
+func (s *Server) verifyPayerAllocation(pba *pb.PayerBandwidthAllocation, allowed func(pb.BandwidthAction) bool) (err error) {
 	switch {
 	case pba.SatelliteId.IsZero():
 		return StoreError.New("payer bandwidth allocation: missing satellite id")
 	case pba.UplinkId.IsZero():
 		return StoreError.New("payer bandwidth allocation: missing uplink id")
-	case !strings.HasPrefix(pba.Action.String(), actionPrefix):
+	case !allowed(pba.Action):
 		return StoreError.New("payer bandwidth allocation: invalid action %v", pba.Action.String())
+	case !pba.Action.IsCustomer() && pba.UplinkId != pba.SatelliteId:
+		// audit and repair traffic is paid differently than customer traffic,
+		// so only the satellite itself may use those allocations
+		return StoreError.New("payer bandwidth allocation: %v allocation not issued to the satellite", pba.Action.String())
 	}
 	return nil
 }
 
-//isWhitelisted returns true if a node ID exists in a list of approved node IDs
+//isWhitelisted returns true if the node stores data for the satellite
 func (s *Server) isWhitelisted(id storj.NodeID) bool {
-	if len(s.whitelist) == 0 {
-		return true // don't whitelist if the whitelist is empty
-	}
-	_, found := s.whitelist[id]
-	return found
+	return s.trust.IsTrusted(id)
 }
 
 func (s *Server) getPublicKey(ctx context.Context, id storj.NodeID) (*ecdsa.PublicKey, error) {
@@ -368,14 +443,7 @@ func getNamespacedPieceID(pieceID, namespace []byte) (string, error) {
 	if namespace == nil {
 		return string(pieceID), nil
 	}
-
-	mac := hmac.New(sha512.New, namespace)
-	_, err := mac.Write(pieceID)
-	if err != nil {
-		return "", err
-	}
-	h := mac.Sum(nil)
-	return base58.Encode(h), nil
+	return psclient.PieceID(pieceID).Namespaced(namespace)
 }
 
 func getNamespace(signedMessage *pb.SignedMessage) []byte {
@@ -412,5 +480,33 @@ func (s *Server) getDashboardData(ctx context.Context) (*pb.DashboardStats, erro
 		Connection:       true,
 		Uptime:           ptypes.DurationProto(time.Since(s.startTime)),
 		Stats:            statsSummary,
+		DiskHealth:       s.getDiskHealth(),
 	}, nil
 }
+
+// ingressAllowed returns whether the disk is healthy enough to store new pieces
+func (s *Server) ingressAllowed() bool {
+	return s.diskHealth == nil || s.diskHealth.IngressAllowed()
+}
+
+// getDiskHealth returns the result of the last disk health check, it's nil
+// when the disk isn't monitored or wasn't checked yet
+func (s *Server) getDiskHealth() *pb.DiskHealth {
+	if s.diskHealth == nil {
+		return nil
+	}
+	status := s.diskHealth.Status()
+	if status.Checked.IsZero() {
+		return nil
+	}
+	return &pb.DiskHealth{
+		Passed:               status.Passed,
+		ReallocatedSectors:   status.ReallocatedSectors,
+		PendingSectors:       status.PendingSectors,
+		UncorrectableSectors: status.UncorrectableSectors,
+		Temperature:          status.Temperature,
+		Warnings:             status.Warnings,
+		IngressStopped:       status.IngressStopped,
+		CheckedUnixSec:       status.Checked.Unix(),
+	}
+}
@@ -4,7 +4,6 @@
 package psserver
 
 import (
-	"crypto/ecdsa"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -562,9 +561,9 @@ func NewTest(ctx context.Context, t *testing.T, snID, upID *identity.FullIdentit
 	verifier := func(authorization *pb.SignedMessage) error {
 		return nil
 	}
-	whitelist := make(map[storj.NodeID]*ecdsa.PublicKey)
+	trust := make(StaticTrust)
 	for _, id := range ids {
-		whitelist[id] = nil
+		trust[id] = struct{}{}
 	}
 	psServer := &Server{
 		log:              zaptest.NewLogger(t),
@@ -573,7 +572,9 @@ func NewTest(ctx context.Context, t *testing.T, snID, upID *identity.FullIdentit
 		verifier:         verifier,
 		totalAllocated:   math.MaxInt64,
 		totalBwAllocated: math.MaxInt64,
-		whitelist:        whitelist,
+		trust:            trust,
+		uploads:          newTransferLimiter("uploads", 0),
+		downloads:        newTransferLimiter("downloads", 0),
 	}
 	//init ps server grpc
 	listener, err := net.Listen("tcp", "127.0.0.1:0")
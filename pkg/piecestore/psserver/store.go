@@ -5,7 +5,9 @@ package psserver
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"hash"
 	"io"
 	"time"
 
@@ -13,6 +15,8 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/utils"
 )
 
@@ -26,6 +30,13 @@ var StoreError = errs.Class("store error")
 func (s *Server) Store(reqStream pb.PieceStoreRoutes_StoreServer) (err error) {
 	ctx := reqStream.Context()
 	defer mon.Task()(&ctx)(&err)
+
+	// rejecting uploads early is cheaper than thrashing the disk with them
+	if err := s.uploads.acquire(); err != nil {
+		return err
+	}
+	defer s.uploads.release()
+
 	// Receive id/ttl
 	recv, err := reqStream.Recv()
 	if err != nil {
@@ -51,11 +62,46 @@ func (s *Server) Store(reqStream pb.PieceStoreRoutes_StoreServer) (err error) {
 		return StoreError.New("piece ID not specified")
 	}
 
+	if expired(pd.GetExpirationUnixSec(), time.Now()) {
+		return StoreError.New("piece expiration is in the past")
+	}
+
+	if err := s.checkBandwidthCap(psdb.Ingress); err != nil {
+		return err
+	}
+
+	if !s.ingressAllowed() {
+		return ErrDiskUnhealthy.New("not accepting uploads")
+	}
+
 	id, err := getNamespacedPieceID([]byte(pd.GetId()), getNamespace(authorization))
 	if err != nil {
 		return err
 	}
-	total, err := s.storeData(ctx, reqStream, id)
+
+	integrity := negotiateIntegrity(recv.GetIntegrity())
+	offset := pd.GetOffset()
+	if offset < 0 || (offset > 0 && !resumable(integrity)) {
+		return StoreError.New("can't resume upload at offset %d", offset)
+	}
+
+	total, sum, err := s.storeData(ctx, reqStream, id, integrity, offset)
+	if ErrCorrupted.Has(err) && resumable(integrity) {
+		// the verified part expires with the piece unless the upload is resumed
+		if ttlErr := s.DB.AddTTL(id, pd.GetExpirationUnixSec(), total); ttlErr != nil {
+			deleteErr := s.deleteByID(id)
+			return StoreError.New("failed to write piece meta data to database: %v", utils.CombineErrors(ttlErr, deleteErr))
+		}
+		s.addPieceSatellite(id, authorization)
+		s.log.Info("Upload stopped at corrupted frame", zap.String("Piece ID", fmt.Sprint(pd.GetId())), zap.Int64("Verified", total), zap.Error(err))
+
+		return reqStream.SendAndClose(&pb.PieceStoreSummary{
+			Message:       err.Error(),
+			TotalReceived: total,
+			Integrity:     integrity,
+			Corrupted:     true,
+		})
+	}
 	if err != nil {
 		return err
 	}
@@ -64,21 +110,48 @@ func (s *Server) Store(reqStream pb.PieceStoreRoutes_StoreServer) (err error) {
 		deleteErr := s.deleteByID(id)
 		return StoreError.New("failed to write piece meta data to database: %v", utils.CombineErrors(err, deleteErr))
 	}
+	s.addPieceSatellite(id, authorization)
+	if sum != nil {
+		if err := s.DB.AddPieceHash(id, sum); err != nil {
+			s.log.Warn("failed to record the hash of the piece", zap.String("Piece ID", id), zap.Error(err))
+		}
+	}
 
-	if err = s.DB.AddBandwidthUsed(total); err != nil {
+	if err = s.DB.AddDirectionalBandwidthUsed(psdb.Ingress, total-offset); err != nil {
 		return StoreError.New("failed to write bandwidth info to database: %v", err)
 	}
 	s.log.Info("Successfully stored", zap.String("Piece ID", fmt.Sprint(pd.GetId())))
 
-	return reqStream.SendAndClose(&pb.PieceStoreSummary{Message: OK, TotalReceived: total})
+	return reqStream.SendAndClose(&pb.PieceStoreSummary{Message: OK, TotalReceived: total, Integrity: integrity})
 }
 
-func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_StoreServer, id string) (total int64, err error) {
+// addPieceSatellite records the satellite which authorized storing the piece,
+// so the piece can be garbage collected when the satellite no longer needs it
+func (s *Server) addPieceSatellite(id string, authorization *pb.SignedMessage) {
+	satelliteID, err := storj.NodeIDFromBytes(getNamespace(authorization))
+	if err != nil {
+		// pieces without a satellite namespace are never garbage collected
+		return
+	}
+	if err := s.DB.AddPieceSatellite(id, satelliteID); err != nil {
+		s.log.Warn("failed to record the satellite of the piece", zap.String("Piece ID", id), zap.Error(err))
+	}
+}
+
+// storeData stores the piece from the stream, it continues the partially
+// stored piece when offset isn't 0. The returned total is the piece size,
+// which for an upload stopped at a corrupted frame is the verified part.
+// The returned sum is the sha256 hash of the piece, it's nil for resumed
+// uploads since the part stored before isn't read again.
+func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_StoreServer, id string, integrity *pb.IntegrityOptions, offset int64) (total int64, sum []byte, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	// the verified part of a corrupted upload is kept for resuming it
+	keep := false
+
 	// Delete data if we error
 	defer func() {
-		if err != nil && err != io.EOF {
+		if err != nil && err != io.EOF && !keep {
 			if deleteErr := s.deleteByID(id); deleteErr != nil {
 				s.log.Error("Failed on deleteByID in Store", zap.Error(deleteErr))
 			}
@@ -86,9 +159,14 @@ func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_Store
 	}()
 
 	// Initialize file for storing data
-	storeFile, err := s.storage.Writer(id)
+	var storeFile io.WriteCloser
+	if offset > 0 {
+		storeFile, err = s.storage.Resume(id, offset)
+	} else {
+		storeFile, err = s.storage.Writer(id)
+	}
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
 	defer func() {
@@ -97,23 +175,66 @@ func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_Store
 
 	bwUsed, err := s.DB.GetTotalBandwidthBetween(getBeginningOfMonth(), time.Now())
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
-	spaceUsed, err := s.DB.SumTTLSizes()
+	spaceUsed, err := s.DB.GetUsedSpace()
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
-	bwLeft := s.totalBwAllocated - bwUsed
-	spaceLeft := s.totalAllocated - spaceUsed
+	bwLeft := s.allocatedBandwidth() - bwUsed
+	ingressLeft, limited, err := s.bandwidthLeft(psdb.Ingress, time.Now())
+	if err != nil {
+		return 0, nil, err
+	}
+	if limited && ingressLeft < bwLeft {
+		bwLeft = ingressLeft
+	}
+	spaceLeft := s.allocatedSpace() - spaceUsed.Bytes
 	reader := NewStreamReader(s, stream, bwLeft, spaceLeft)
+	start := time.Now()
+	defer func() {
+		var payer *pb.PayerBandwidthAllocation
+		if reader.bandwidthAllocation != nil {
+			payer = &reader.bandwidthAllocation.PayerAllocation
+		}
+		observeTransfer(payer, total-offset, time.Since(start), err)
+	}()
+	if integrity != nil {
+		reader.frames = newFrameVerifier(integrity, offset)
+	}
 
-	total, err = io.Copy(storeFile, reader)
+	var writer io.Writer = storeFile
+	var hasher hash.Hash
+	if offset == 0 {
+		hasher = sha256.New()
+		writer = io.MultiWriter(storeFile, hasher)
+	}
 
+	total, err = io.Copy(writer, reader)
+
+	if ErrCorrupted.Has(err) && resumable(integrity) {
+		verified := reader.frames.verified
+		// data buffered for direct IO would be written after the truncated end
+		if flusher, ok := storeFile.(interface{ Flush() error }); ok {
+			if flushErr := flusher.Flush(); flushErr != nil {
+				return 0, nil, errs.Combine(err, flushErr)
+			}
+		}
+		if truncateErr := s.storage.Truncate(id, verified); truncateErr != nil {
+			return 0, nil, errs.Combine(err, truncateErr)
+		}
+		// the bandwidth allocation is stored once the resumed upload completes
+		keep = true
+		return verified, nil, err
+	}
 	if err != nil && err != io.EOF {
-		return 0, err
+		return 0, nil, err
 	}
 
 	err = s.DB.WriteBandwidthAllocToDB(reader.bandwidthAllocation)
 
-	return total, err
+	if hasher != nil {
+		sum = hasher.Sum(nil)
+	}
+	return offset + total, sum, err
 }
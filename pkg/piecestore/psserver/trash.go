@@ -0,0 +1,93 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// RestoreTrashError is a type of error for failures in Server.RestoreTrash()
+var RestoreTrashError = errs.Class("restore trash error")
+
+// RestoreTrash moves the pieces of the calling satellite back from the trash,
+// so the pieces a faulty garbage collection trashed are not lost
+func (s *Server) RestoreTrash(ctx context.Context, in *pb.RestoreTrashRequest) (_ *pb.RestoreTrashResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, RestoreTrashError.Wrap(err)
+	}
+	if !s.isWhitelisted(peer.ID) {
+		return nil, RestoreTrashError.New("satellite %s is not whitelisted", peer.ID)
+	}
+
+	var trashedAfter time.Time
+	if in.GetTrashedAfterUnixSec() > 0 {
+		trashedAfter = time.Unix(in.GetTrashedAfterUnixSec(), 0)
+	}
+
+	// the rows of the pieces which were moved back are restored even when
+	// restoring the others failed
+	ids, restoreErr := s.storage.RestoreTrash(ctx, peer.ID, trashedAfter)
+
+	var restored int64
+	for _, id := range ids {
+		if err := s.restoreTTL(peer.ID, id); err != nil {
+			s.log.Warn("failed to restore piece info", zap.String("Piece ID", id), zap.Error(err))
+			continue
+		}
+		restored++
+	}
+
+	mon.IntVal("trash_pieces_restored").Observe(restored)
+	s.log.Info("Restored trashed pieces",
+		zap.Stringer("Satellite ID", peer.ID),
+		zap.Int64("Restored", restored))
+
+	if restoreErr != nil {
+		return nil, RestoreTrashError.Wrap(restoreErr)
+	}
+	return &pb.RestoreTrashResponse{Restored: restored}, nil
+}
+
+// trashByID moves the piece to the trash of its satellite, pieces whose
+// satellite is unknown are deleted since nobody could restore them
+func (s *Server) trashByID(id string) error {
+	satelliteID, err := s.DB.GetPieceSatellite(id)
+	if err == sql.ErrNoRows {
+		return s.deleteByID(id)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := s.storage.Trash(satelliteID, id); err != nil {
+		return err
+	}
+	return s.DB.TrashTTLByID(id, time.Now())
+}
+
+// restoreTTL restores the rows of a piece moved back from the trash, the
+// rows of pieces trashed without them are recreated from the piece on disk
+func (s *Server) restoreTTL(satelliteID storj.NodeID, id string) error {
+	ok, err := s.DB.RestoreTrashedTTL(id)
+	if err != nil || ok {
+		return err
+	}
+
+	if err := s.DB.AddTTL(id, 0, pieceSize(s.storage, id)); err != nil {
+		return err
+	}
+	return s.DB.AddPieceSatellite(id, satelliteID)
+}
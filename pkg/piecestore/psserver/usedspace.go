@@ -0,0 +1,103 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/lazyfilewalker"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+// ErrorUsedSpace is error class for the used space walker
+var ErrorUsedSpace = errs.Class("piecestore used space")
+
+// UsedSpaceWalker walks the stored pieces to calculate the space they use on disk.
+type UsedSpaceWalker struct {
+	log     *zap.Logger
+	db      *psdb.DB
+	storage *pstore.Storage
+	lazy    *lazyfilewalker.Supervisor
+
+	interval time.Duration
+}
+
+// NewUsedSpaceWalker returns a new used space walker, when lazy is not nil
+// the pieces are walked in a separate low priority process
+func NewUsedSpaceWalker(log *zap.Logger, db *psdb.DB, storage *pstore.Storage, lazy *lazyfilewalker.Supervisor, interval time.Duration) *UsedSpaceWalker {
+	return &UsedSpaceWalker{
+		log:      log,
+		db:       db,
+		storage:  storage,
+		lazy:     lazy,
+		interval: interval,
+	}
+}
+
+// Run runs the used space walker at regular intervals
+func (service *UsedSpaceWalker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(service.interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := service.Walk(ctx)
+		if err != nil {
+			service.log.Error("walk", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the used space walker is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// Walk walks the stored pieces at this moment and reconciles the running
+// total of the used space with what was found on disk.
+func (service *UsedSpaceWalker) Walk(ctx context.Context) (used pstore.UsedSpace, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// pieces stored or deleted while walking change the running total as
+	// well, so only the difference to the total before the walk is drift
+	before, err := service.db.GetUsedSpace()
+	if err != nil {
+		return pstore.UsedSpace{}, ErrorUsedSpace.Wrap(err)
+	}
+
+	if service.lazy != nil {
+		used, err = service.lazy.WalkUsedSpace(ctx, service.storage.Dir())
+	} else {
+		used, err = service.storage.WalkUsedSpace(ctx)
+	}
+	if err != nil {
+		return pstore.UsedSpace{}, ErrorUsedSpace.Wrap(err)
+	}
+
+	mon.IntVal("used_space_walked_pieces").Observe(used.Pieces)
+	mon.IntVal("used_space_walked_bytes").Observe(used.Bytes)
+
+	driftPieces := used.Pieces - before.Pieces
+	driftBytes := used.Bytes - before.Bytes
+	mon.IntVal("used_space_drift_pieces").Observe(driftPieces)
+	mon.IntVal("used_space_drift_bytes").Observe(driftBytes)
+
+	err = service.db.ReconcileUsedSpace(driftPieces, driftBytes, time.Now())
+	if err != nil {
+		return used, ErrorUsedSpace.Wrap(err)
+	}
+
+	service.log.Info("used space",
+		zap.Int64("pieces", used.Pieces),
+		zap.Int64("bytes on disk", used.Bytes),
+		zap.Int64("drift pieces", driftPieces),
+		zap.Int64("drift bytes", driftBytes),
+	)
+	return used, nil
+}
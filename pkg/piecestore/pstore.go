@@ -6,27 +6,36 @@ package pstore
 import (
 	"context"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/shirou/gopsutil/disk"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storj"
 )
 
 // Storage stores piecestore pieces
 type Storage struct {
-	dir string
+	dir     string
+	options WriteOptions
+	batch   syncBatch
 }
 
 // NewStorage creates database for storing pieces
 func NewStorage(dir string) *Storage {
-	return &Storage{dir}
+	return NewStorageWithOptions(dir, WriteOptions{})
 }
 
-// Close closes resources
-func (storage *Storage) Close() error { return nil }
+// Close flushes the pieces waiting for their batch
+func (storage *Storage) Close() error { return storage.Flush() }
+
+// Dir returns the directory pieces are stored in
+func (storage *Storage) Dir() string { return storage.dir }
 
 // DiskInfo contains statistics about the disk
 type DiskInfo struct {
@@ -73,11 +82,56 @@ func (storage *Storage) Writer(pieceID string) (io.WriteCloser, error) {
 	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
 		return nil, MkDir.Wrap(err)
 	}
-	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	flag, perm := os.O_CREATE|os.O_EXCL|os.O_WRONLY, os.FileMode(0600)
+	if storage.options.DirectIO {
+		file, direct, err := openDirect(path, flag, perm)
+		if err != nil {
+			return nil, Open.Wrap(err)
+		}
+		return storage.newPieceWriter(path, file, 0, direct), nil
+	}
+	file, err := os.OpenFile(path, flag, perm)
+	if err != nil {
+		return nil, Open.Wrap(err)
+	}
+	return storage.newPieceWriter(path, file, 0, false), nil
+}
+
+// Resume returns a writer continuing a partially stored piece at offset,
+// anything stored after offset is dropped. Resumed pieces are written
+// without direct IO, since offset usually isn't aligned.
+func (storage *Storage) Resume(pieceID string, offset int64) (io.WriteCloser, error) {
+	path, err := storage.PiecePath(pieceID)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY, 0600)
 	if err != nil {
 		return nil, Open.Wrap(err)
 	}
-	return file, nil
+	info, err := file.Stat()
+	if err == nil && info.Size() < offset {
+		err = Error.New("invalid offset: %v, only %v bytes stored", offset, info.Size())
+	}
+	if err == nil {
+		err = file.Truncate(offset)
+	}
+	if err == nil {
+		_, err = file.Seek(offset, io.SeekStart)
+	}
+	if err != nil {
+		return nil, errs.Combine(err, file.Close())
+	}
+	return storage.newPieceWriter(path, file, offset, false), nil
+}
+
+// Truncate drops anything stored after size bytes of the piece
+func (storage *Storage) Truncate(pieceID string, size int64) error {
+	path, err := storage.PiecePath(pieceID)
+	if err != nil {
+		return err
+	}
+	return os.Truncate(path, size)
 }
 
 // Reader returns a reader for the specified piece at the location
@@ -120,3 +174,217 @@ func (storage *Storage) Delete(pieceID string) error {
 	}
 	return err
 }
+
+// trashDir is the folder in the storage dir deleted and garbage collected
+// pieces are moved to, each satellite has its own folder in it
+const trashDir = "trash"
+
+// trashPath returns the path of the trash folder of the satellite
+func (storage *Storage) trashPath(satelliteID storj.NodeID) string {
+	return filepath.Join(storage.dir, trashDir, satelliteID.String())
+}
+
+// Trash moves a piece of the satellite to the trash, where it's kept until
+// the trash is emptied or the satellite restores it
+func (storage *Storage) Trash(satelliteID storj.NodeID, pieceID string) error {
+	path, err := storage.PiecePath(pieceID)
+	if err != nil {
+		return err
+	}
+
+	trash := storage.trashPath(satelliteID)
+	if err := os.MkdirAll(trash, 0700); err != nil {
+		return MkDir.Wrap(err)
+	}
+
+	trashed := filepath.Join(trash, pieceID)
+	err = os.Rename(path, trashed)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the modification time is when the piece was trashed
+	now := time.Now()
+	return Error.Wrap(os.Chtimes(trashed, now, now))
+}
+
+// quarantineDir is the folder in the storage dir corrupted pieces are moved
+// to, so operators can inspect them
+const quarantineDir = "quarantine"
+
+// QuarantineDir returns the directory corrupted pieces are moved to
+func (storage *Storage) QuarantineDir() string {
+	return filepath.Join(storage.dir, quarantineDir)
+}
+
+// Quarantine moves a corrupted piece to the quarantine
+func (storage *Storage) Quarantine(pieceID string) error {
+	path, err := storage.PiecePath(pieceID)
+	if err != nil {
+		return err
+	}
+
+	quarantine := storage.QuarantineDir()
+	if err := os.MkdirAll(quarantine, 0700); err != nil {
+		return MkDir.Wrap(err)
+	}
+
+	err = os.Rename(path, filepath.Join(quarantine, pieceID))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return Error.Wrap(err)
+}
+
+// RestoreTrash moves the pieces of the satellite which were trashed at or
+// after trashedAfter back to where they were stored and returns their ids
+func (storage *Storage) RestoreTrash(ctx context.Context, satelliteID storj.NodeID, trashedAfter time.Time) (restored []string, err error) {
+	trash := storage.trashPath(satelliteID)
+	infos, err := ioutil.ReadDir(trash)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var errlist errs.Group
+	for _, info := range infos {
+		if err := ctx.Err(); err != nil {
+			return restored, err
+		}
+		if info.IsDir() || info.ModTime().Before(trashedAfter) {
+			continue
+		}
+
+		pieceID := info.Name()
+		path, err := storage.PiecePath(pieceID)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+		// the piece was stored again after it was trashed
+		if _, err := os.Stat(path); err == nil {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			errlist.Add(err)
+			continue
+		}
+		if err := os.Rename(filepath.Join(trash, pieceID), path); err != nil {
+			errlist.Add(err)
+			continue
+		}
+		restored = append(restored, pieceID)
+	}
+	return restored, Error.Wrap(errlist.Err())
+}
+
+// EmptyTrash deletes the pieces which were trashed before trashedBefore
+func (storage *Storage) EmptyTrash(ctx context.Context, trashedBefore time.Time) (deleted int64, err error) {
+	trash := filepath.Join(storage.dir, trashDir)
+	infos, err := ioutil.ReadDir(trash)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	var errlist errs.Group
+	for _, info := range infos {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+
+		// pieces trashed before the trash was split by satellite are in its root
+		dir := trash
+		pieces := []os.FileInfo{info}
+		if info.IsDir() {
+			dir = filepath.Join(trash, info.Name())
+			pieces, err = ioutil.ReadDir(dir)
+			if err != nil {
+				errlist.Add(err)
+				continue
+			}
+		}
+
+		for _, piece := range pieces {
+			if err := ctx.Err(); err != nil {
+				return deleted, err
+			}
+			if piece.IsDir() || !piece.ModTime().Before(trashedBefore) {
+				continue
+			}
+
+			err := os.Remove(filepath.Join(dir, piece.Name()))
+			if err != nil && !os.IsNotExist(err) {
+				errlist.Add(err)
+				continue
+			}
+			deleted++
+		}
+	}
+	return deleted, Error.Wrap(errlist.Err())
+}
+
+// UsedSpace contains the result of walking the stored pieces
+type UsedSpace struct {
+	Pieces int64 `json:"pieces"`
+	Bytes  int64 `json:"bytes"`
+}
+
+// WalkUsedSpace walks all the stored pieces and sums up their sizes,
+// files which are not pieces, such as databases, are skipped
+func (storage *Storage) WalkUsedSpace(ctx context.Context) (used UsedSpace, err error) {
+	err = storage.WalkPieces(ctx, func(pieceID string, info os.FileInfo) error {
+		used.Pieces++
+		used.Bytes += info.Size()
+		return nil
+	})
+	if err != nil {
+		return UsedSpace{}, err
+	}
+	return used, nil
+}
+
+// WalkPieces calls fn for every stored piece, the trashed and quarantined
+// pieces and files which are not pieces, such as databases, are skipped
+func (storage *Storage) WalkPieces(ctx context.Context, fn func(pieceID string, info os.FileInfo) error) error {
+	root := filepath.Clean(storage.dir)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path != root {
+				// deleted while walking
+				return nil
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			// trashed and quarantined pieces are no longer stored pieces
+			if path == filepath.Join(root, trashDir) || path == filepath.Join(root, quarantineDir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// pieces are stored as folder1/folder2/filename
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		parts := strings.Split(rel, string(filepath.Separator))
+		if len(parts) != 3 {
+			return nil
+		}
+
+		return fn(strings.Join(parts, ""), info)
+	})
+	return Error.Wrap(err)
+}
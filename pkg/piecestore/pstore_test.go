@@ -5,16 +5,20 @@ package pstore
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
 )
 
 func TestStore(t *testing.T) {
@@ -79,3 +83,146 @@ func TestStore(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestWriteOptions(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	for k, options := range []WriteOptions{
+		{},
+		{Preallocate: 1 << 20},
+		{Sync: SyncPiece},
+		{Sync: SyncBatch, BatchSize: 2, BatchInterval: time.Hour},
+		{DirectIO: true},
+		{Preallocate: 1 << 20, Sync: SyncPiece, DirectIO: true},
+	} {
+		require.NoError(t, options.Verify())
+		store := NewStorageWithOptions(ctx.Dir(fmt.Sprint(k)), options)
+
+		// sizes around the blocks and the buffer of direct IO
+		for i, size := range []int{0, 100, directBlockSize, directBufferSize + 100} {
+			pieceID := strings.Repeat(fmt.Sprintf("%04d", i), 10)
+			source := make([]byte, size)
+			_, _ = rand.Read(source)
+
+			w, err := store.Writer(pieceID)
+			require.NoError(t, err)
+			_, err = io.Copy(w, bytes.NewReader(source))
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			{ // resume after half of the piece
+				w, err := store.Resume(pieceID, int64(size/2))
+				require.NoError(t, err)
+				_, err = w.Write(source[size/2:])
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+			}
+
+			path, err := store.PiecePath(pieceID)
+			require.NoError(t, err)
+			stored, err := ioutil.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, source, stored, "%+v", options)
+		}
+
+		assert.NoError(t, store.Close())
+	}
+
+	assert.Error(t, WriteOptions{Sync: "sometimes"}.Verify())
+	assert.Error(t, WriteOptions{Sync: SyncBatch}.Verify())
+	assert.Error(t, WriteOptions{Preallocate: -1}.Verify())
+}
+
+func TestWalkUsedSpace(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := NewStorage(ctx.Dir("example"))
+	defer ctx.Check(store.Close)
+
+	used, err := store.WalkUsedSpace(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, UsedSpace{}, used)
+
+	for i, size := range []int{100, 2000, 30000} {
+		w, err := store.Writer(strings.Repeat(fmt.Sprintf("AB%02d", i), 10))
+		require.NoError(t, err)
+		_, err = w.Write(make([]byte, size))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+	}
+
+	// files outside of the piece folders are not counted
+	err = ioutil.WriteFile(filepath.Join(store.Dir(), "piecestore.db"), make([]byte, 500), 0644)
+	require.NoError(t, err)
+
+	used, err = store.WalkUsedSpace(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, UsedSpace{Pieces: 3, Bytes: 32100}, used)
+}
+
+func TestTrash(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := NewStorage(ctx.Dir("example"))
+	defer ctx.Check(store.Close)
+
+	satelliteID := teststorj.NodeIDFromString("satellite")
+	pieceID := strings.Repeat("AB01", 10)
+
+	w, err := store.Writer(pieceID)
+	require.NoError(t, err)
+	_, err = w.Write(make([]byte, 100))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	require.NoError(t, store.Trash(satelliteID, pieceID))
+	// trashing a missing piece is not an error
+	require.NoError(t, store.Trash(satelliteID, pieceID))
+
+	_, err = store.Reader(ctx, pieceID, 0, -1)
+	assert.Error(t, err)
+
+	// trashed pieces are not counted as used space
+	used, err := store.WalkUsedSpace(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, UsedSpace{}, used)
+
+	// the pieces of other satellites aren't restored
+	restored, err := store.RestoreTrash(ctx, teststorj.NodeIDFromString("other"), time.Time{})
+	require.NoError(t, err)
+	assert.Empty(t, restored)
+
+	// the piece was trashed before this time, so it's not restored
+	restored, err = store.RestoreTrash(ctx, satelliteID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, restored)
+
+	restored, err = store.RestoreTrash(ctx, satelliteID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{pieceID}, restored)
+
+	reader, err := store.Reader(ctx, pieceID, 0, -1)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Len(t, data, 100)
+
+	require.NoError(t, store.Trash(satelliteID, pieceID))
+
+	// a piece trashed before the trash was split by satellite
+	legacy := strings.Repeat("CD02", 10)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(store.Dir(), trashDir, legacy), make([]byte, 10), 0600))
+
+	// the pieces were trashed after this time, so they're kept
+	deleted, err := store.EmptyTrash(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	deleted, err = store.EmptyTrash(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+}
@@ -0,0 +1,260 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pstore
+
+import (
+	"os"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/zeebo/errs"
+)
+
+// SyncPolicy decides when stored pieces are flushed to the disk
+type SyncPolicy string
+
+const (
+	// SyncNone leaves flushing the pieces to the operating system
+	SyncNone = SyncPolicy("none")
+	// SyncPiece flushes every piece before the upload is acknowledged
+	SyncPiece = SyncPolicy("piece")
+	// SyncBatch flushes the pieces stored since the last batch together,
+	// a piece is acknowledged before it's flushed
+	SyncBatch = SyncPolicy("batch")
+)
+
+// WriteOptions tune how pieces are written, e.g. for SMR drives, ZFS or
+// network filesystems. The zero value writes pieces like a plain file.
+type WriteOptions struct {
+	// Preallocate is the size reserved on the disk for a new piece, the
+	// unused part is released once the piece is complete
+	Preallocate int64
+	// Sync decides when pieces are flushed to the disk, empty is SyncNone
+	Sync SyncPolicy
+	// BatchSize is the number of pieces flushed together by SyncBatch
+	BatchSize int
+	// BatchInterval is the longest a piece waits for its batch with SyncBatch
+	BatchInterval time.Duration
+	// DirectIO bypasses the page cache, it's ignored where the platform or
+	// the filesystem doesn't support it and for resumed uploads
+	DirectIO bool
+}
+
+// Verify checks that the options are usable
+func (options WriteOptions) Verify() error {
+	if options.Preallocate < 0 {
+		return Error.New("invalid preallocation: %d", options.Preallocate)
+	}
+	switch options.Sync {
+	case "", SyncNone, SyncPiece:
+	case SyncBatch:
+		if options.BatchSize <= 0 || options.BatchInterval <= 0 {
+			return Error.New("batch size and interval must be positive to sync in batches")
+		}
+	default:
+		return Error.New("invalid sync policy %q, expected %q, %q or %q", options.Sync, SyncNone, SyncPiece, SyncBatch)
+	}
+	return nil
+}
+
+// NewStorageWithOptions creates database for storing pieces, which are written with options
+func NewStorageWithOptions(dir string, options WriteOptions) *Storage {
+	return &Storage{dir: dir, options: options}
+}
+
+// syncBatch collects the pieces which haven't been flushed yet
+type syncBatch struct {
+	mu      sync.Mutex
+	pending []string
+	started time.Time
+}
+
+// add adds the piece at path to the batch and returns the pieces to flush
+// when the batch is full or waited long enough
+func (batch *syncBatch) add(path string, options WriteOptions) []string {
+	batch.mu.Lock()
+	defer batch.mu.Unlock()
+
+	if len(batch.pending) == 0 {
+		batch.started = time.Now()
+	}
+	batch.pending = append(batch.pending, path)
+	if len(batch.pending) < options.BatchSize && time.Since(batch.started) < options.BatchInterval {
+		return nil
+	}
+	return batch.take()
+}
+
+// take empties the batch and returns the pieces in it
+func (batch *syncBatch) take() []string {
+	paths := batch.pending
+	batch.pending = nil
+	return paths
+}
+
+// flush flushes the pieces at paths to the disk, pieces deleted in the meantime are skipped
+func (batch *syncBatch) flush(paths []string) error {
+	var group errs.Group
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+		group.Add(file.Sync(), file.Close())
+	}
+	return Error.Wrap(group.Err())
+}
+
+// Flush flushes the pieces waiting for their batch to the disk
+func (storage *Storage) Flush() error {
+	storage.batch.mu.Lock()
+	paths := storage.batch.take()
+	storage.batch.mu.Unlock()
+
+	return storage.batch.flush(paths)
+}
+
+// pieceWriter writes a piece with the write options of the storage
+type pieceWriter struct {
+	storage *Storage
+	path    string
+	file    *os.File
+	direct  *directWriter
+	// preallocated is whether space was reserved for the piece
+	preallocated bool
+}
+
+// newPieceWriter writes the piece to file, which is at offset
+func (storage *Storage) newPieceWriter(path string, file *os.File, offset int64, direct bool) *pieceWriter {
+	writer := &pieceWriter{
+		storage: storage,
+		path:    path,
+		file:    file,
+	}
+	if direct {
+		writer.direct = newDirectWriter(file)
+	}
+	if storage.options.Preallocate > offset {
+		// preallocation is best effort, the piece is stored without it
+		writer.preallocated = preallocate(file, storage.options.Preallocate) == nil
+	}
+	return writer
+}
+
+// Write writes data to the piece
+func (writer *pieceWriter) Write(data []byte) (n int, err error) {
+	if writer.direct != nil {
+		return writer.direct.Write(data)
+	}
+	return writer.file.Write(data)
+}
+
+// Flush writes the data buffered for direct IO to the file, it must be
+// called before the piece is truncated while it's written
+func (writer *pieceWriter) Flush() error {
+	if writer.direct == nil {
+		return nil
+	}
+	return writer.direct.Flush()
+}
+
+// Close completes the piece and flushes it as the sync policy requires
+func (writer *pieceWriter) Close() (err error) {
+	defer func() { err = errs.Combine(err, writer.file.Close()) }()
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if writer.preallocated {
+		// truncating to the size releases the space reserved after it
+		info, err := writer.file.Stat()
+		if err != nil {
+			return err
+		}
+		if err := writer.file.Truncate(info.Size()); err != nil {
+			return err
+		}
+	}
+
+	switch writer.storage.options.Sync {
+	case SyncPiece:
+		return writer.file.Sync()
+	case SyncBatch:
+		if paths := writer.storage.batch.add(writer.path, writer.storage.options); len(paths) > 0 {
+			return writer.storage.batch.flush(paths)
+		}
+	}
+	return nil
+}
+
+// directBlockSize is the alignment of the buffers, offsets and sizes of direct IO
+const directBlockSize = 4096
+
+// directBufferSize is the size of the buffer collecting data for direct IO
+const directBufferSize = 256 * directBlockSize
+
+// directWriter collects data in an aligned buffer and writes it with direct IO
+// in full blocks, only the last block of the piece is written through the page cache
+type directWriter struct {
+	file *os.File
+	buf  []byte
+	n    int
+}
+
+func newDirectWriter(file *os.File) *directWriter {
+	return &directWriter{file: file, buf: alignedBuffer(directBufferSize, directBlockSize)}
+}
+
+// Write collects data and writes the buffer once it's full
+func (writer *directWriter) Write(data []byte) (written int, err error) {
+	for len(data) > 0 {
+		n := copy(writer.buf[writer.n:], data)
+		writer.n += n
+		written += n
+		data = data[n:]
+
+		if writer.n == len(writer.buf) {
+			if _, err := writer.file.Write(writer.buf); err != nil {
+				return written, err
+			}
+			writer.n = 0
+		}
+	}
+	return written, nil
+}
+
+// Flush writes the collected full blocks with direct IO and the rest through the page cache
+func (writer *directWriter) Flush() error {
+	full := writer.n - writer.n%directBlockSize
+	if full > 0 {
+		if _, err := writer.file.Write(writer.buf[:full]); err != nil {
+			return err
+		}
+	}
+	if full < writer.n {
+		if err := disableDirectIO(writer.file); err != nil {
+			return err
+		}
+		if _, err := writer.file.Write(writer.buf[full:writer.n]); err != nil {
+			return err
+		}
+	}
+	writer.n = 0
+	return nil
+}
+
+// alignedBuffer returns a buffer of size whose address is a multiple of align
+func alignedBuffer(size, align int) []byte {
+	buf := make([]byte, size+align)
+	offset := 0
+	if rem := int(uintptr(unsafe.Pointer(&buf[0])) % uintptr(align)); rem != 0 {
+		offset = align - rem
+	}
+	return buf[offset : offset+size : offset+size]
+}
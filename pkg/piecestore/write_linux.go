@@ -0,0 +1,41 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pstore
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// fallocKeepSize keeps the size of the file when preallocating
+const fallocKeepSize = 0x01
+
+// preallocate reserves size bytes on the disk for file without changing its size
+func preallocate(file *os.File, size int64) error {
+	return unix.Fallocate(int(file.Fd()), fallocKeepSize, 0, size)
+}
+
+// openDirect opens the file with direct IO, it opens the file through the
+// page cache when the filesystem doesn't support direct IO (e.g. tmpfs)
+func openDirect(path string, flag int, perm os.FileMode) (file *os.File, direct bool, err error) {
+	file, err = os.OpenFile(path, flag|unix.O_DIRECT, perm)
+	if err == nil {
+		return file, true, nil
+	}
+	if pathErr, ok := err.(*os.PathError); ok && pathErr.Err == unix.EINVAL {
+		file, err = os.OpenFile(path, flag, perm)
+	}
+	return file, false, err
+}
+
+// disableDirectIO writes the rest of the file through the page cache
+func disableDirectIO(file *os.File) error {
+	flags, err := unix.FcntlInt(file.Fd(), unix.F_GETFL, 0)
+	if err != nil {
+		return err
+	}
+	_, err = unix.FcntlInt(file.Fd(), unix.F_SETFL, flags&^unix.O_DIRECT)
+	return err
+}
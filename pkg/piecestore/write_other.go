@@ -0,0 +1,25 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build !linux
+
+package pstore
+
+import (
+	"os"
+)
+
+// preallocate isn't supported on this platform, pieces are stored without it
+func preallocate(file *os.File, size int64) error {
+	return Error.New("preallocation isn't supported on this platform")
+}
+
+// openDirect opens the file through the page cache, direct IO isn't
+// supported on this platform
+func openDirect(path string, flag int, perm os.FileMode) (*os.File, bool, error) {
+	file, err := os.OpenFile(path, flag, perm)
+	return file, false, err
+}
+
+// disableDirectIO does nothing, files are never opened with direct IO on this platform
+func disableDirectIO(file *os.File) error { return nil }
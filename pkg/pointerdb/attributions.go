@@ -0,0 +1,127 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// MaxPartnerIDLength is the longest partner id a bucket can be attributed to
+const MaxPartnerIDLength = 64
+
+// BucketAttribution is the partner a bucket was attributed to when it was
+// created, the usage of the bucket is reported to the partner
+type BucketAttribution struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	PartnerID  string
+	CreatedAt  time.Time
+}
+
+// BucketAttributions stores the partners buckets are attributed to
+type BucketAttributions interface {
+	// Get returns the attribution of a bucket, sql.ErrNoRows when it has none
+	Get(ctx context.Context, projectID uuid.UUID, bucket string) (*BucketAttribution, error)
+	// Create attributes a bucket to a partner
+	Create(ctx context.Context, attribution *BucketAttribution) error
+	// GetAll returns the attributions of all buckets
+	GetAll(ctx context.Context) ([]BucketAttribution, error)
+	// CreateSerial records the serial number of a download allocation of a bucket attributed to a partner
+	CreateSerial(ctx context.Context, serialNumber string, partnerID string) error
+}
+
+// SetBucketAttribution attributes a bucket to a partner. Only buckets without
+// objects can be attributed, and the attribution can't be changed later.
+func (s *Server) SetBucketAttribution(ctx context.Context, req *pb.SetBucketAttributionRequest) (resp *pb.SetBucketAttributionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx, macaroon.Action{Op: macaroon.ActionWrite, Bucket: []byte(req.GetBucket())})
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.GetBucket() == "":
+		return nil, status.Error(codes.InvalidArgument, "bucket is required")
+	case req.GetPartnerId() == "":
+		return nil, status.Error(codes.InvalidArgument, "partner id is required")
+	case len(req.GetPartnerId()) > MaxPartnerIDLength:
+		return nil, status.Errorf(codes.InvalidArgument, "partner id is longer than %d bytes", MaxPartnerIDLength)
+	}
+
+	if s.attributions == nil {
+		return nil, status.Error(codes.Unimplemented, "bucket attributions are not supported")
+	}
+
+	existing, err := s.attributions.Get(ctx, keyInfo.ProjectID, req.GetBucket())
+	switch {
+	case err == nil && existing.PartnerID == req.GetPartnerId():
+		return &pb.SetBucketAttributionResponse{}, nil
+	case err == nil:
+		return nil, status.Error(codes.AlreadyExists, "bucket is already attributed to another partner")
+	case err != sql.ErrNoRows:
+		s.logger.Error("err getting bucket attribution", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if s.bucketStats != nil {
+		stat, err := s.bucketStats.Get(ctx, keyInfo.ProjectID, req.GetBucket())
+		if err != nil {
+			s.logger.Error("err getting bucket stats", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if stat.ObjectCount > 0 {
+			return nil, status.Error(codes.FailedPrecondition, "only buckets without objects can be attributed")
+		}
+	}
+
+	err = s.attributions.Create(ctx, &BucketAttribution{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: req.GetBucket(),
+		PartnerID:  req.GetPartnerId(),
+	})
+	if err != nil {
+		s.logger.Error("err creating bucket attribution", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SetBucketAttributionResponse{}, nil
+}
+
+// recordAttributedDownload records the serial number of a download allocation
+// of a segment of an attributed bucket, the bandwidth agreements with the
+// serial number are the egress of the partner
+func (s *Server) recordAttributedDownload(ctx context.Context, projectID uuid.UUID, path storj.Path, pba *pb.PayerBandwidthAllocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.attributions == nil || pba == nil {
+		return nil
+	}
+
+	_, bucket, _ := splitSegmentPath(path)
+	if bucket == "" {
+		return nil
+	}
+
+	attribution, err := s.attributions.Get(ctx, projectID, bucket)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.attributions.CreateSerial(ctx, pba.SerialNumber, attribution.PartnerID)
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+// BucketStats is bucket counters store methods used by pointerdb
+type BucketStats interface {
+	Add(ctx context.Context, projectID uuid.UUID, bucketName string, objects, bytes int64) error
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string) (*console.BucketStat, error)
+}
+
+// BucketStats returns the number of objects and bytes of a bucket of the project of the api key
+func (s *Server) BucketStats(ctx context.Context, req *pb.BucketStatsRequest) (resp *pb.BucketStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx, macaroon.Action{Op: macaroon.ActionRead, Bucket: []byte(req.GetBucket())})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetBucket() == "" {
+		return nil, status.Error(codes.InvalidArgument, "bucket is required")
+	}
+
+	if s.bucketStats == nil {
+		return &pb.BucketStatsResponse{}, nil
+	}
+
+	stat, err := s.bucketStats.Get(ctx, keyInfo.ProjectID, req.GetBucket())
+	if err != nil {
+		s.logger.Error("err getting bucket stats", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BucketStatsResponse{
+		ObjectCount: stat.ObjectCount,
+		TotalBytes:  stat.TotalBytes,
+	}, nil
+}
+
+// currentPointer returns the pointer stored at path before it is replaced or
+// deleted, it's only read when the bucket counters are kept
+func (s *Server) currentPointer(path storj.Path) (*pb.Pointer, error) {
+	if s.bucketStats == nil {
+		return nil, nil
+	}
+
+	pointer, err := s.service.Get(path)
+	if storage.ErrKeyNotFound.Has(err) {
+		return nil, nil
+	}
+	return pointer, err
+}
+
+// updateBucketStats counts the change of a segment of a bucket from old to
+// new, either of them is nil when the segment is created or deleted. An object
+// is counted when its last segment is committed.
+func (s *Server) updateBucketStats(ctx context.Context, projectID uuid.UUID, path storj.Path, old, new *pb.Pointer) error {
+	if s.bucketStats == nil {
+		return nil
+	}
+
+	segment, bucket, objectPath := splitSegmentPath(path)
+	if bucket == "" || objectPath == "" {
+		return nil
+	}
+
+	var objects int64
+	if segment == "l" {
+		switch {
+		case old == nil && new != nil:
+			objects = 1
+		case old != nil && new == nil:
+			objects = -1
+		}
+	}
+
+	bytes := SegmentSize(new) - SegmentSize(old)
+	if objects == 0 && bytes == 0 {
+		return nil
+	}
+	return s.bucketStats.Add(ctx, projectID, bucket, objects, bytes)
+}
+
+// SegmentSize returns the number of bytes of the data of a segment
+func SegmentSize(pointer *pb.Pointer) int64 {
+	switch {
+	case pointer == nil:
+		return 0
+	case pointer.GetType() == pb.Pointer_INLINE:
+		return int64(len(pointer.InlineSegment))
+	default:
+		return pointer.GetSegmentSize()
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/console"
+)
+
+// BucketTemplates is bucket template store methods used by pointerdb
+type BucketTemplates interface {
+	Get(ctx context.Context, projectID uuid.UUID) (*console.BucketTemplate, error)
+}
+
+// BucketTemplate returns the settings the project of the api key applies to new buckets
+func (s *Server) BucketTemplate(ctx context.Context, req *pb.BucketTemplateRequest) (resp *pb.BucketTemplateResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx, macaroon.Action{Op: macaroon.ActionWrite, Bucket: []byte(req.GetBucket())})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.templates == nil {
+		return &pb.BucketTemplateResponse{}, nil
+	}
+
+	template, err := s.templates.Get(ctx, keyInfo.ProjectID)
+	if err == sql.ErrNoRows {
+		return &pb.BucketTemplateResponse{}, nil
+	}
+	if err != nil {
+		s.logger.Error("err getting bucket template", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BucketTemplateResponse{
+		Found:      true,
+		PathCipher: int32(template.PathCipher),
+		DataCipher: int32(template.DataCipher),
+		BlockSize:  int32(template.BlockSize),
+		Redundancy: &pb.RedundancyScheme{
+			Type:             pb.RedundancyScheme_RS,
+			MinReq:           int32(template.RequiredShares),
+			RepairThreshold:  int32(template.RepairShares),
+			SuccessThreshold: int32(template.OptimalShares),
+			Total:            int32(template.TotalShares),
+			ErasureShareSize: int32(template.ShareSize),
+		},
+		DistinctSubnets: template.DistinctSubnets,
+		DistinctRegions: template.DistinctRegions,
+	}, nil
+}
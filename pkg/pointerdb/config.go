@@ -24,6 +24,8 @@ type Config struct {
 	MaxInlineSegmentSize memory.Size `default:"8000" help:"maximum inline segment size"`
 	Overlay              bool        `default:"true" help:"toggle flag if overlay is enabled"`
 	BwExpiration         int         `default:"45"   help:"lifespan of bandwidth agreements in days"`
+	Loop                 LoopConfig
+	Maintenance          MaintenanceConfig
 }
 
 // NewStore returns database for storing pointer data
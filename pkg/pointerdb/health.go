@@ -0,0 +1,29 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// SegmentHealth contains when a segment was last audited and repaired,
+// zero times mean it hasn't been
+type SegmentHealth struct {
+	Path         storj.Path
+	LastAudited  time.Time
+	LastRepaired time.Time
+}
+
+// HealthDB stores when segments were last audited and repaired
+type HealthDB interface {
+	// RecordAudit records that the segment was audited at the given time
+	RecordAudit(ctx context.Context, path storj.Path, audited time.Time) error
+	// RecordRepair records that the segment was repaired at the given time
+	RecordRepair(ctx context.Context, path storj.Path, repaired time.Time) error
+	// Get returns the health of a segment, segments without any records have zero times
+	Get(ctx context.Context, path storj.Path) (*SegmentHealth, error)
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestHealthDB(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		healthdb := db.SegmentHealth()
+		path := "projectid/l/bucket/encrypted"
+
+		{ // segments without records were never audited nor repaired
+			health, err := healthdb.Get(ctx, path)
+			require.NoError(t, err)
+			assert.Equal(t, path, health.Path)
+			assert.True(t, health.LastAudited.IsZero())
+			assert.True(t, health.LastRepaired.IsZero())
+		}
+
+		audited := time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
+		repaired := time.Now().Truncate(time.Second).UTC()
+
+		{ // the first record creates the entry
+			require.NoError(t, healthdb.RecordAudit(ctx, path, audited))
+
+			health, err := healthdb.Get(ctx, path)
+			require.NoError(t, err)
+			assert.True(t, audited.Equal(health.LastAudited))
+			assert.True(t, health.LastRepaired.IsZero())
+		}
+
+		{ // recording a repair keeps the audit time
+			require.NoError(t, healthdb.RecordRepair(ctx, path, repaired))
+
+			health, err := healthdb.Get(ctx, path)
+			require.NoError(t, err)
+			assert.True(t, audited.Equal(health.LastAudited))
+			assert.True(t, repaired.Equal(health.LastRepaired))
+		}
+
+		{ // later audits replace the earlier ones
+			require.NoError(t, healthdb.RecordAudit(ctx, path, repaired))
+
+			health, err := healthdb.Get(ctx, path)
+			require.NoError(t, err)
+			assert.True(t, repaired.Equal(health.LastAudited))
+		}
+	})
+}
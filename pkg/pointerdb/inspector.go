@@ -0,0 +1,158 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// Inspector is a gRPC service for inspecting where the segments of objects
+// are stored and how healthy they are, and for scheduling maintenance
+type Inspector struct {
+	service     *Service
+	cache       *overlay.Cache
+	health      HealthDB
+	maintenance *Maintenance
+}
+
+// NewInspector creates an Inspector
+func NewInspector(service *Service, cache *overlay.Cache, health HealthDB, maintenance *Maintenance) *Inspector {
+	return &Inspector{service: service, cache: cache, health: health, maintenance: maintenance}
+}
+
+// ScheduleMaintenance schedules or cancels a maintenance and returns the
+// scheduled maintenance
+func (srv *Inspector) ScheduleMaintenance(ctx context.Context, req *pb.ScheduleMaintenanceRequest) (resp *pb.ScheduleMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case req.StatusOnly:
+	case req.Cancel:
+		srv.maintenance.Cancel()
+	default:
+		var start time.Time
+		if req.StartSeconds != 0 {
+			start = time.Unix(req.StartSeconds, 0)
+		}
+		if err := srv.maintenance.Schedule(start, time.Unix(req.UntilSeconds, 0)); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	resp = &pb.ScheduleMaintenanceResponse{}
+	if start, until := srv.maintenance.Scheduled(now); !until.IsZero() {
+		resp.StartSeconds = start.Unix()
+		resp.UntilSeconds = until.Unix()
+		_, resp.Active = srv.maintenance.Active(now)
+	}
+	return resp, nil
+}
+
+// ObjectHealth returns the piece placement and the last audit and repair
+// times of every segment of an object
+func (srv *Inspector) ObjectHealth(ctx context.Context, req *pb.ObjectHealthRequest) (resp *pb.ObjectHealthResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(req.ProjectId) != len(uuid.UUID{}) {
+		return nil, Error.New("invalid project id length %d", len(req.ProjectId))
+	}
+	var projectID uuid.UUID
+	copy(projectID[:], req.ProjectId)
+
+	if len(req.Bucket) == 0 || len(req.EncryptedPath) == 0 {
+		return nil, Error.New("bucket and encrypted path are required")
+	}
+
+	segmentPath := func(segment string) storj.Path {
+		return storj.JoinPaths(projectID.String(), segment, string(req.Bucket), string(req.EncryptedPath))
+	}
+
+	resp = &pb.ObjectHealthResponse{}
+	for index := int64(0); ; index++ {
+		segment, err := srv.segmentHealth(ctx, segmentPath(fmt.Sprintf("s%d", index)), index)
+		if storage.ErrKeyNotFound.Has(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		resp.Segments = append(resp.Segments, segment)
+	}
+
+	last, err := srv.segmentHealth(ctx, segmentPath("l"), -1)
+	if err != nil {
+		return nil, err
+	}
+	resp.Segments = append(resp.Segments, last)
+
+	return resp, nil
+}
+
+// segmentHealth looks up the pointer and the health records of a segment
+func (srv *Inspector) segmentHealth(ctx context.Context, path storj.Path, index int64) (_ *pb.SegmentHealth, err error) {
+	pointer, err := srv.service.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	health, err := srv.health.Get(ctx, path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	segment := &pb.SegmentHealth{
+		SegmentIndex: index,
+		Path:         []byte(path),
+		Inline:       pointer.GetType() == pb.Pointer_INLINE,
+	}
+	if !health.LastAudited.IsZero() {
+		segment.LastAuditSeconds = health.LastAudited.Unix()
+	}
+	if !health.LastRepaired.IsZero() {
+		segment.LastRepairSeconds = health.LastRepaired.Unix()
+	}
+
+	remote := pointer.GetRemote()
+	if remote == nil {
+		return segment, nil
+	}
+
+	redundancy := remote.GetRedundancy()
+	segment.MinReq = redundancy.GetMinReq()
+	segment.RepairThreshold = redundancy.GetRepairThreshold()
+	segment.SuccessThreshold = redundancy.GetSuccessThreshold()
+	segment.Total = redundancy.GetTotal()
+
+	var nodeIDs storj.NodeIDList
+	for _, piece := range remote.GetRemotePieces() {
+		nodeIDs = append(nodeIDs, piece.NodeId)
+	}
+
+	var nodes []*pb.Node
+	if len(nodeIDs) > 0 {
+		nodes, err = srv.cache.GetAll(ctx, nodeIDs)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	for i, piece := range remote.GetRemotePieces() {
+		segment.Pieces = append(segment.Pieces, &pb.PiecePlacement{
+			PieceNum: piece.GetPieceNum(),
+			NodeId:   piece.NodeId,
+			Online:   i < len(nodes) && nodes[i] != nil,
+		})
+	}
+	return segment, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// MaxLifecycleDays is the longest expiration of a bucket lifecycle, about a century
+const MaxLifecycleDays = 36500
+
+var lifecycleError = errs.Class("bucket lifecycle error")
+
+// BucketLifecycle is the configuration of when the satellite deletes the
+// objects of a bucket, zero days keep the objects
+type BucketLifecycle struct {
+	ProjectID  uuid.UUID
+	BucketName string
+
+	// ExpireAfterDays deletes the objects uploaded more than the number of days ago
+	ExpireAfterDays int
+	// VersionsPrefix is the encrypted path of the prefix the versions of the
+	// objects are kept under, the satellite can't decrypt the paths to find it
+	VersionsPrefix storj.Path
+	// VersionsExpireAfterDays deletes the versions uploaded more than the
+	// number of days ago
+	VersionsExpireAfterDays int
+
+	UpdatedAt time.Time
+}
+
+// Empty returns whether the lifecycle doesn't expire anything
+func (lifecycle *BucketLifecycle) Empty() bool {
+	return lifecycle.ExpireAfterDays == 0 && lifecycle.VersionsExpireAfterDays == 0
+}
+
+// BucketLifecycles stores the lifecycle configurations of buckets
+type BucketLifecycles interface {
+	// Get returns the lifecycle of a bucket, sql.ErrNoRows when it has none
+	Get(ctx context.Context, projectID uuid.UUID, bucket string) (*BucketLifecycle, error)
+	// Set creates or replaces the lifecycle of a bucket
+	Set(ctx context.Context, lifecycle *BucketLifecycle) error
+	// Delete removes the lifecycle of a bucket
+	Delete(ctx context.Context, projectID uuid.UUID, bucket string) error
+	// GetAll returns the lifecycles of all buckets
+	GetAll(ctx context.Context) ([]BucketLifecycle, error)
+}
+
+// ValidateBucketLifecycle checks whether lifecycle can be stored for a bucket
+func ValidateBucketLifecycle(lifecycle *BucketLifecycle) error {
+	switch {
+	case lifecycle.ExpireAfterDays < 0 || lifecycle.ExpireAfterDays > MaxLifecycleDays:
+		return lifecycleError.New("object expiration of %d days is not between 0 and %d", lifecycle.ExpireAfterDays, MaxLifecycleDays)
+	case lifecycle.VersionsExpireAfterDays < 0 || lifecycle.VersionsExpireAfterDays > MaxLifecycleDays:
+		return lifecycleError.New("version expiration of %d days is not between 0 and %d", lifecycle.VersionsExpireAfterDays, MaxLifecycleDays)
+	case lifecycle.VersionsExpireAfterDays > 0 && lifecycle.VersionsPrefix == "":
+		return lifecycleError.New("versions can't expire without the prefix they are kept under")
+	}
+	return nil
+}
+
+// SetBucketLifecycle replaces the lifecycle configuration of a bucket, a
+// lifecycle without expiration removes the configuration
+func (s *Server) SetBucketLifecycle(ctx context.Context, req *pb.SetBucketLifecycleRequest) (resp *pb.SetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the lifecycle deletes objects on behalf of the api key
+	keyInfo, err := s.validateAuth(ctx,
+		macaroon.Action{Op: macaroon.ActionWrite, Bucket: []byte(req.GetBucket())},
+		macaroon.Action{Op: macaroon.ActionDelete, Bucket: []byte(req.GetBucket())},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetBucket() == "" {
+		return nil, status.Error(codes.InvalidArgument, "bucket is required")
+	}
+
+	if s.lifecycles == nil {
+		return nil, status.Error(codes.Unimplemented, "bucket lifecycles are not supported")
+	}
+
+	lifecycle := &BucketLifecycle{
+		ProjectID:               keyInfo.ProjectID,
+		BucketName:              req.GetBucket(),
+		ExpireAfterDays:         int(req.GetLifecycle().GetExpireAfterDays()),
+		VersionsPrefix:          req.GetLifecycle().GetVersionsPrefix(),
+		VersionsExpireAfterDays: int(req.GetLifecycle().GetVersionsExpireAfterDays()),
+	}
+	if err = ValidateBucketLifecycle(lifecycle); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if lifecycle.Empty() {
+		err = s.lifecycles.Delete(ctx, keyInfo.ProjectID, req.GetBucket())
+	} else {
+		err = s.lifecycles.Set(ctx, lifecycle)
+	}
+	if err != nil {
+		s.logger.Error("err setting bucket lifecycle", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SetBucketLifecycleResponse{}, nil
+}
+
+// BucketLifecycle returns the lifecycle configuration of a bucket
+func (s *Server) BucketLifecycle(ctx context.Context, req *pb.BucketLifecycleRequest) (resp *pb.BucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx, macaroon.Action{Op: macaroon.ActionRead, Bucket: []byte(req.GetBucket())})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetBucket() == "" {
+		return nil, status.Error(codes.InvalidArgument, "bucket is required")
+	}
+
+	if s.lifecycles == nil {
+		return &pb.BucketLifecycleResponse{}, nil
+	}
+
+	lifecycle, err := s.lifecycles.Get(ctx, keyInfo.ProjectID, req.GetBucket())
+	if err == sql.ErrNoRows {
+		return &pb.BucketLifecycleResponse{}, nil
+	}
+	if err != nil {
+		s.logger.Error("err getting bucket lifecycle", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BucketLifecycleResponse{
+		Found: true,
+		Lifecycle: &pb.BucketLifecycle{
+			ExpireAfterDays:         int32(lifecycle.ExpireAfterDays),
+			VersionsPrefix:          lifecycle.VersionsPrefix,
+			VersionsExpireAfterDays: int32(lifecycle.VersionsExpireAfterDays),
+		},
+	}, nil
+}
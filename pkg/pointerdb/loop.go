@@ -0,0 +1,240 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// LoopError is a standard error class for the metainfo loop
+var LoopError = errs.Class("metainfo loop error")
+
+// LoopConfig contains configurable values for the metainfo loop
+type LoopConfig struct {
+	CoalesceDuration time.Duration `help:"how long to wait for more observers to join before iterating the segments" default:"5s"`
+	Interval         time.Duration `help:"the minimum time between the starts of two iterations, observers joining earlier wait for the next scheduled one" default:"30s"`
+	RateLimit        int           `help:"how many segments are iterated per second at most, 0 doesn't limit the rate" default:"0"`
+}
+
+// Observer is notified about every segment during a metainfo loop iteration,
+// returning an error removes the observer from the iteration
+type Observer interface {
+	RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
+	InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
+}
+
+// states of a joined observer
+const (
+	observerWaiting int32 = iota
+	observerIterating
+	observerCanceled
+)
+
+// observerContext tracks an observer which has joined the loop
+type observerContext struct {
+	Observer
+	ctx   context.Context
+	state int32
+	done  chan error
+}
+
+// finish notifies the joined observer that the iteration has ended
+func (observer *observerContext) finish(err error) {
+	observer.done <- err
+	close(observer.done)
+}
+
+// Loop iterates over all segments once for all joined observers, so the
+// services which need to look at every segment don't each scan pointerdb.
+// Iterations start at most every Interval, and go through the segments at
+// RateLimit at most.
+type Loop struct {
+	config   LoopConfig
+	pointers *Service
+	limiter  *sync2.RateLimiter
+	join     chan *observerContext
+	done     chan struct{}
+
+	// lastStart is when the last iteration started, it's only used by Run
+	lastStart time.Time
+}
+
+// NewLoop creates a new metainfo loop
+func NewLoop(config LoopConfig, pointers *Service) *Loop {
+	return &Loop{
+		config:   config,
+		pointers: pointers,
+		limiter:  sync2.NewRateLimiter(int64(config.RateLimit)),
+		join:     make(chan *observerContext),
+		done:     make(chan struct{}),
+	}
+}
+
+// Join waits for the next iteration of the loop and blocks until the observer
+// has seen every segment, ctx is checked while waiting and between segments
+func (loop *Loop) Join(ctx context.Context, observer Observer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	joined := &observerContext{
+		Observer: observer,
+		ctx:      ctx,
+		done:     make(chan error, 1),
+	}
+
+	select {
+	case loop.join <- joined:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-loop.done:
+		return LoopError.New("loop has stopped")
+	}
+
+	select {
+	case err := <-joined.done:
+		return err
+	case <-ctx.Done():
+		// give up waiting unless the iteration has already started
+		if atomic.CompareAndSwapInt32(&joined.state, observerWaiting, observerCanceled) {
+			return ctx.Err()
+		}
+		return <-joined.done
+	}
+}
+
+// Run starts the loop, an iteration starts when an observer joins
+func (loop *Loop) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer close(loop.done)
+
+	for {
+		err := loop.runOnce(ctx)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// runOnce waits for observers and iterates over all segments once
+func (loop *Loop) runOnce(ctx context.Context) (err error) {
+	var observers []*observerContext
+
+	// wait for the first observer
+	select {
+	case observer := <-loop.join:
+		observers = append(observers, observer)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	// give other observers a chance to join the same iteration, which
+	// doesn't start before the next scheduled one
+	wait := loop.config.CoalesceDuration
+	if scheduled := time.Until(loop.lastStart.Add(loop.config.Interval)); scheduled > wait {
+		wait = scheduled
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+waitForObservers:
+	for {
+		select {
+		case observer := <-loop.join:
+			observers = append(observers, observer)
+		case <-timer.C:
+			break waitForObservers
+		case <-ctx.Done():
+			finishObservers(observers, ctx.Err())
+			return ctx.Err()
+		}
+	}
+
+	// the observers which gave up waiting don't take part in the iteration
+	iterating := observers[:0]
+	for _, observer := range observers {
+		if atomic.CompareAndSwapInt32(&observer.state, observerWaiting, observerIterating) {
+			iterating = append(iterating, observer)
+		}
+	}
+	if len(iterating) == 0 {
+		return ctx.Err()
+	}
+
+	loop.lastStart = time.Now()
+	observers, err = loop.iterate(ctx, iterating)
+	finishObservers(observers, err)
+
+	// the loop only stops when it's canceled, errors are reported to the observers
+	return ctx.Err()
+}
+
+// iterate notifies the observers about every segment and returns the observers
+// which haven't failed or been canceled
+func (loop *Loop) iterate(ctx context.Context, observers []*observerContext) (_ []*observerContext, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mon.IntVal("metainfo_loop_observers").Observe(int64(len(observers)))
+	var segments int64
+	defer func() { mon.IntVal("metainfo_loop_segments").Observe(segments) }()
+
+	err = loop.pointers.Iterate("", "", true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for len(observers) > 0 && it.Next(&item) {
+				if err := loop.limiter.Wait(ctx, 1); err != nil {
+					return err
+				}
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				segments++
+
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return LoopError.New("error unmarshalling pointer %s", err)
+				}
+
+				path := storj.Path(item.Key)
+				remaining := observers[:0]
+				for _, observer := range observers {
+					if err := observer.ctx.Err(); err != nil {
+						observer.finish(err)
+						continue
+					}
+
+					var err error
+					if pointer.GetRemote() != nil {
+						err = observer.RemoteSegment(observer.ctx, path, pointer)
+					} else {
+						err = observer.InlineSegment(observer.ctx, path, pointer)
+					}
+					if err != nil {
+						observer.finish(err)
+						continue
+					}
+					remaining = append(remaining, observer)
+				}
+				observers = remaining
+			}
+			return nil
+		},
+	)
+	return observers, LoopError.Wrap(err)
+}
+
+// finishObservers notifies all observers that the iteration has ended
+func finishObservers(observers []*observerContext, err error) {
+	for _, observer := range observers {
+		observer.finish(err)
+	}
+}
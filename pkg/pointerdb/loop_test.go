@@ -0,0 +1,136 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage/teststore"
+)
+
+// countObserver counts the segments it has seen
+type countObserver struct {
+	remote, inline int
+	fail           error
+}
+
+func (observer *countObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.remote++
+	return observer.fail
+}
+
+func (observer *countObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.inline++
+	return observer.fail
+}
+
+func TestLoop(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	service := pointerdb.NewService(zap.NewNop(), teststore.New())
+	for _, path := range []storj.Path{"a/l/bucket/remote1", "a/l/bucket/remote2", "a/s0/bucket/remote2"} {
+		require.NoError(t, service.Put(path, &pb.Pointer{Type: pb.Pointer_REMOTE, Remote: &pb.RemoteSegment{}}))
+	}
+	require.NoError(t, service.Put("a/l/bucket/inline", &pb.Pointer{Type: pb.Pointer_INLINE}))
+
+	loop := pointerdb.NewLoop(pointerdb.LoopConfig{CoalesceDuration: time.Second}, service)
+
+	loopCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error {
+		err := loop.Run(loopCtx)
+		if err == context.Canceled {
+			return nil
+		}
+		return err
+	})
+
+	failure := errors.New("observer failed")
+	observers := []*countObserver{{}, {}, {fail: failure}}
+	errs := make([]error, len(observers))
+
+	var wg sync.WaitGroup
+	for i, observer := range observers {
+		wg.Add(1)
+		go func(i int, observer *countObserver) {
+			defer wg.Done()
+			errs[i] = loop.Join(ctx, observer)
+		}(i, observer)
+	}
+	wg.Wait()
+
+	for _, observer := range observers[:2] {
+		assert.Equal(t, 3, observer.remote)
+		assert.Equal(t, 1, observer.inline)
+	}
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+
+	// the failed observer is removed after its first segment
+	assert.Equal(t, 1, observers[2].remote+observers[2].inline)
+	assert.Equal(t, failure, errs[2])
+
+	cancel()
+}
+
+func TestLoopCanceledObserver(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	service := pointerdb.NewService(zap.NewNop(), teststore.New())
+	loop := pointerdb.NewLoop(pointerdb.LoopConfig{CoalesceDuration: time.Hour}, service)
+
+	// the loop isn't running, joining has to give up when the context is canceled
+	joinCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	err := loop.Join(joinCtx, &countObserver{})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestLoopInterval(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	service := pointerdb.NewService(zap.NewNop(), teststore.New())
+	require.NoError(t, service.Put("a/l/bucket/inline", &pb.Pointer{Type: pb.Pointer_INLINE}))
+
+	loop := pointerdb.NewLoop(pointerdb.LoopConfig{CoalesceDuration: time.Millisecond, Interval: time.Hour}, service)
+
+	loopCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error {
+		err := loop.Run(loopCtx)
+		if err == context.Canceled {
+			return nil
+		}
+		return err
+	})
+
+	// the first iteration starts right away
+	observer := &countObserver{}
+	require.NoError(t, loop.Join(ctx, observer))
+	assert.Equal(t, 1, observer.inline)
+
+	// the next one not before the interval has passed
+	joinCtx, cancelJoin := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancelJoin()
+	observer = &countObserver{}
+	err := loop.Join(joinCtx, observer)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 0, observer.inline)
+
+	cancel()
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"sync"
+	"time"
+)
+
+// MaintenanceConfig schedules a maintenance of the satellite
+type MaintenanceConfig struct {
+	Start string `help:"start of a scheduled maintenance in RFC3339 format, empty starts it immediately when until is set" default:""`
+	Until string `help:"end of a scheduled maintenance in RFC3339 format, during which no new uploads are accepted, empty schedules none" default:""`
+}
+
+// Maintenance is a window during which the satellite issues no allocations
+// for uploads, so that uploads in progress and downloads can finish before
+// the satellite is taken down. Clients are told when the maintenance ends.
+type Maintenance struct {
+	mu    sync.Mutex
+	start time.Time
+	until time.Time
+}
+
+// NewMaintenance creates a maintenance window from config
+func NewMaintenance(config MaintenanceConfig) (*Maintenance, error) {
+	maintenance := &Maintenance{}
+	if config.Until == "" {
+		return maintenance, nil
+	}
+
+	var start time.Time
+	if config.Start != "" {
+		var err error
+		start, err = time.Parse(time.RFC3339, config.Start)
+		if err != nil {
+			return nil, Error.New("invalid maintenance start %q: %v", config.Start, err)
+		}
+	}
+	until, err := time.Parse(time.RFC3339, config.Until)
+	if err != nil {
+		return nil, Error.New("invalid maintenance end %q: %v", config.Until, err)
+	}
+
+	if err := maintenance.Schedule(start, until); err != nil {
+		return nil, err
+	}
+	return maintenance, nil
+}
+
+// Schedule replaces the scheduled maintenance, a zero start starts it immediately
+func (maintenance *Maintenance) Schedule(start, until time.Time) error {
+	if start.IsZero() {
+		start = time.Now()
+	}
+	if !until.After(start) {
+		return Error.New("maintenance ends at %s before it starts at %s", until.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+
+	maintenance.mu.Lock()
+	defer maintenance.mu.Unlock()
+	maintenance.start, maintenance.until = start, until
+	return nil
+}
+
+// Cancel cancels the scheduled maintenance, or ends it when it's active
+func (maintenance *Maintenance) Cancel() {
+	maintenance.mu.Lock()
+	defer maintenance.mu.Unlock()
+	maintenance.start, maintenance.until = time.Time{}, time.Time{}
+}
+
+// Scheduled returns the scheduled maintenance, the times are zero when none
+// is scheduled or it has ended
+func (maintenance *Maintenance) Scheduled(now time.Time) (start, until time.Time) {
+	maintenance.mu.Lock()
+	defer maintenance.mu.Unlock()
+	if !now.Before(maintenance.until) {
+		return time.Time{}, time.Time{}
+	}
+	return maintenance.start, maintenance.until
+}
+
+// Active returns whether the satellite is in maintenance at now and until
+// when it lasts
+func (maintenance *Maintenance) Active(now time.Time) (until time.Time, active bool) {
+	start, until := maintenance.Scheduled(now)
+	if until.IsZero() || now.Before(start) {
+		return time.Time{}, false
+	}
+	return until, true
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/pointerdb/pdbclient"
+)
+
+func TestMaintenance(t *testing.T) {
+	now := time.Now()
+	start, until := now.Add(time.Hour), now.Add(2*time.Hour)
+
+	maintenance, err := pointerdb.NewMaintenance(pointerdb.MaintenanceConfig{
+		Start: start.Format(time.RFC3339),
+		Until: until.Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+
+	_, active := maintenance.Active(now)
+	assert.False(t, active, "active before the start")
+
+	activeUntil, active := maintenance.Active(start.Add(time.Minute))
+	assert.True(t, active)
+	assert.Equal(t, until.Unix(), activeUntil.Unix())
+
+	_, active = maintenance.Active(until.Add(time.Minute))
+	assert.False(t, active, "active after the end")
+
+	maintenance.Cancel()
+	_, active = maintenance.Active(start.Add(time.Minute))
+	assert.False(t, active, "active after canceling")
+
+	assert.Error(t, maintenance.Schedule(until, start))
+
+	_, err = pointerdb.NewMaintenance(pointerdb.MaintenanceConfig{Until: "tomorrow"})
+	assert.Error(t, err)
+}
+
+func TestMaintenanceStatus(t *testing.T) {
+	until := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	parsed, ok := pdbclient.MaintenanceUntil(pdbclient.MaintenanceStatus(until))
+	require.True(t, ok)
+	assert.True(t, until.Equal(parsed))
+
+	parsed, ok = pdbclient.MaintenanceUntil(pdbclient.Error.Wrap(&pdbclient.MaintenanceError{Until: until}))
+	require.True(t, ok)
+	assert.True(t, until.Equal(parsed))
+
+	_, ok = pdbclient.MaintenanceUntil(pdbclient.Error.New("unavailable"))
+	assert.False(t, ok)
+}
@@ -0,0 +1,242 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+const (
+	// MaxObjectTags is the maximum number of tags a single object can have
+	MaxObjectTags = 32
+	// MaxTagKeyLength is the maximum length of a tag key in bytes
+	MaxTagKeyLength = 128
+	// MaxTagValueLength is the maximum length of a tag value in bytes
+	MaxTagValueLength = 256
+	// MaxSearchLimit is the maximum number of objects returned by a single search
+	MaxSearchLimit = 1000
+)
+
+var tagError = errs.Class("object tag error")
+
+// ObjectTag is a plaintext key/value pair chosen by the uplink, unlike the
+// object paths and metadata the satellite is able to read and index it
+type ObjectTag struct {
+	Key   string
+	Value string
+}
+
+// TaggedObject is an object found by a tag search
+type TaggedObject struct {
+	// Path is the encrypted path of the object inside the bucket
+	Path storj.Path
+	Tag  ObjectTag
+}
+
+// ObjectTags stores the search tags of objects
+type ObjectTags interface {
+	// Set replaces all tags of an object
+	Set(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path, tags []ObjectTag) error
+	// Delete removes all tags of an object
+	Delete(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path) error
+	// Move moves all tags of an object to the new path of the object
+	Move(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path, newBucket string, newPath storj.Path) error
+	// Search returns the objects of a bucket which have a tag with the given key
+	// and value, when prefix is set the value only has to start with the given value
+	Search(ctx context.Context, projectID uuid.UUID, bucket string, key, value string, prefix bool, limit int, offset int64) ([]TaggedObject, error)
+}
+
+// ValidateObjectTags checks whether tags can be stored for an object
+func ValidateObjectTags(tags []ObjectTag) error {
+	if len(tags) > MaxObjectTags {
+		return tagError.New("%d tags exceed the maximum of %d", len(tags), MaxObjectTags)
+	}
+
+	keys := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		if err := validateTagKey(tag.Key); err != nil {
+			return err
+		}
+		if err := validateTagValue(tag.Value); err != nil {
+			return err
+		}
+		if _, ok := keys[tag.Key]; ok {
+			return tagError.New("duplicate tag key %q", tag.Key)
+		}
+		keys[tag.Key] = struct{}{}
+	}
+	return nil
+}
+
+func validateTagKey(key string) error {
+	switch {
+	case key == "":
+		return tagError.New("empty tag key")
+	case len(key) > MaxTagKeyLength:
+		return tagError.New("tag key length %d exceeds the maximum of %d", len(key), MaxTagKeyLength)
+	case !utf8.ValidString(key):
+		return tagError.New("tag key is not valid utf-8")
+	}
+	return nil
+}
+
+func validateTagValue(value string) error {
+	switch {
+	case len(value) > MaxTagValueLength:
+		return tagError.New("tag value length %d exceeds the maximum of %d", len(value), MaxTagValueLength)
+	case !utf8.ValidString(value):
+		return tagError.New("tag value is not valid utf-8")
+	}
+	return nil
+}
+
+// SetObjectTags replaces the search tags of an existing object
+func (s *Server) SetObjectTags(ctx context.Context, req *pb.SetObjectTagsRequest) (resp *pb.SetObjectTagsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        []byte(req.GetBucket()),
+		EncryptedPath: []byte(req.GetPath()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetBucket() == "" || req.GetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "bucket and path are required")
+	}
+
+	tags := make([]ObjectTag, 0, len(req.GetTags()))
+	for _, tag := range req.GetTags() {
+		tags = append(tags, ObjectTag{Key: tag.GetKey(), Value: tag.GetValue()})
+	}
+	if err = ValidateObjectTags(tags); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// only objects which were fully uploaded can be tagged
+	path := storj.JoinPaths(keyInfo.ProjectID.String(), "l", req.GetBucket(), req.GetPath())
+	if _, err = s.service.Get(path); err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		s.logger.Error("err getting pointer", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.objectTags.Set(ctx, keyInfo.ProjectID, req.GetBucket(), req.GetPath(), tags)
+	if err != nil {
+		s.logger.Error("err setting object tags", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SetObjectTagsResponse{}, nil
+}
+
+// SearchObjects returns the objects of a bucket which have a matching tag
+func (s *Server) SearchObjects(ctx context.Context, req *pb.SearchObjectsRequest) (resp *pb.SearchObjectsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx, macaroon.Action{Op: macaroon.ActionList, Bucket: []byte(req.GetBucket())})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetBucket() == "" {
+		return nil, status.Error(codes.InvalidArgument, "bucket is required")
+	}
+	if err = validateTagKey(req.GetTagKey()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err = validateTagValue(req.GetTagValue()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative offset %d", req.GetOffset())
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+
+	// ask for one more object to know whether there are more results
+	objects, err := s.objectTags.Search(ctx, keyInfo.ProjectID, req.GetBucket(), req.GetTagKey(), req.GetTagValue(), req.GetPrefix(), limit+1, req.GetOffset())
+	if err != nil {
+		s.logger.Error("err searching objects", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp = &pb.SearchObjectsResponse{}
+	if len(objects) > limit {
+		objects, resp.More = objects[:limit], true
+	}
+
+	// the search is authorized on the bucket, the objects which keys
+	// restricted to paths in the bucket don't allow to list are left out
+	var actions []macaroon.Action
+	for _, object := range objects {
+		actions = append(actions, macaroon.Action{
+			Op:            macaroon.ActionList,
+			Bucket:        []byte(req.GetBucket()),
+			EncryptedPath: []byte(object.Path),
+		})
+	}
+	allowed, err := s.allowedActions(ctx, actions)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, object := range objects {
+		if !allowed[i] {
+			continue
+		}
+		resp.Items = append(resp.Items, &pb.SearchObjectsResponse_Item{
+			Path: object.Path,
+			Tag:  &pb.ObjectTag{Key: object.Tag.Key, Value: object.Tag.Value},
+		})
+	}
+	return resp, nil
+}
+
+// deleteObjectTags removes the tags of an object when its last segment is deleted
+func (s *Server) deleteObjectTags(ctx context.Context, projectID uuid.UUID, path storj.Path) error {
+	segment, bucket, objectPath := splitSegmentPath(path)
+	if segment != "l" || bucket == "" || objectPath == "" {
+		return nil
+	}
+	return s.objectTags.Delete(ctx, projectID, bucket, objectPath)
+}
+
+// moveObjectTags moves the tags of an object when its last segment is moved
+func (s *Server) moveObjectTags(ctx context.Context, projectID uuid.UUID, path, newPath storj.Path) error {
+	segment, bucket, objectPath := splitSegmentPath(path)
+	_, newBucket, newObjectPath := splitSegmentPath(newPath)
+	if segment != "l" || bucket == "" || objectPath == "" || newBucket == "" || newObjectPath == "" {
+		return nil
+	}
+	return s.objectTags.Move(ctx, projectID, bucket, objectPath, newBucket, newObjectPath)
+}
+
+// splitSegmentPath splits a segment path of the form "segment/bucket/path"
+func splitSegmentPath(path storj.Path) (segment, bucket string, objectPath storj.Path) {
+	components := storj.SplitPath(path)
+	if len(components) < 3 {
+		return "", "", ""
+	}
+	return components[0], components[1], storj.JoinPaths(components[2:]...)
+}
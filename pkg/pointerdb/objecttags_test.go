@@ -0,0 +1,140 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb_test
+
+import (
+	"testing"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestObjectTags(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		tags := db.ObjectTags()
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		otherProjectID, err := uuid.New()
+		require.NoError(t, err)
+
+		require.NoError(t, tags.Set(ctx, *projectID, "photos", "enc/a", []pointerdb.ObjectTag{
+			{Key: "camera", Value: "nikon d750"},
+			{Key: "place", Value: "paris"},
+		}))
+		require.NoError(t, tags.Set(ctx, *projectID, "photos", "enc/b", []pointerdb.ObjectTag{
+			{Key: "camera", Value: "nikon d3"},
+		}))
+		require.NoError(t, tags.Set(ctx, *projectID, "photos", "enc/c", []pointerdb.ObjectTag{
+			{Key: "camera", Value: "canon"},
+		}))
+		require.NoError(t, tags.Set(ctx, *projectID, "videos", "enc/d", []pointerdb.ObjectTag{
+			{Key: "camera", Value: "nikon d750"},
+		}))
+		require.NoError(t, tags.Set(ctx, *otherProjectID, "photos", "enc/e", []pointerdb.ObjectTag{
+			{Key: "camera", Value: "nikon d750"},
+		}))
+
+		paths := func(objects []pointerdb.TaggedObject) (paths []string) {
+			for _, object := range objects {
+				paths = append(paths, object.Path)
+			}
+			return paths
+		}
+
+		{ // equality only matches the same project and bucket
+			objects, err := tags.Search(ctx, *projectID, "photos", "camera", "nikon d750", false, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, []pointerdb.TaggedObject{
+				{Path: "enc/a", Tag: pointerdb.ObjectTag{Key: "camera", Value: "nikon d750"}},
+			}, objects)
+		}
+
+		{ // prefix
+			objects, err := tags.Search(ctx, *projectID, "photos", "camera", "nikon", true, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"enc/b", "enc/a"}, paths(objects))
+
+			objects, err = tags.Search(ctx, *projectID, "photos", "camera", "nikon", true, 1, 1)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"enc/a"}, paths(objects))
+		}
+
+		{ // empty prefix matches every value of the key
+			objects, err := tags.Search(ctx, *projectID, "photos", "camera", "", true, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"enc/c", "enc/b", "enc/a"}, paths(objects))
+		}
+
+		{ // set replaces the previous tags
+			require.NoError(t, tags.Set(ctx, *projectID, "photos", "enc/a", []pointerdb.ObjectTag{
+				{Key: "camera", Value: "leica"},
+			}))
+
+			objects, err := tags.Search(ctx, *projectID, "photos", "place", "paris", false, 10, 0)
+			require.NoError(t, err)
+			assert.Empty(t, objects)
+
+			objects, err = tags.Search(ctx, *projectID, "photos", "camera", "leica", false, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"enc/a"}, paths(objects))
+		}
+
+		{ // delete
+			require.NoError(t, tags.Delete(ctx, *projectID, "photos", "enc/a"))
+
+			objects, err := tags.Search(ctx, *projectID, "photos", "camera", "", true, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"enc/c", "enc/b"}, paths(objects))
+
+			objects, err = tags.Search(ctx, *otherProjectID, "photos", "camera", "", true, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"enc/e"}, paths(objects))
+		}
+
+		{ // move
+			require.NoError(t, tags.Move(ctx, *projectID, "photos", "enc/b", "archive", "enc/f"))
+
+			objects, err := tags.Search(ctx, *projectID, "photos", "camera", "", true, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"enc/c"}, paths(objects))
+
+			objects, err = tags.Search(ctx, *projectID, "archive", "camera", "", true, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, []pointerdb.TaggedObject{
+				{Path: "enc/f", Tag: pointerdb.ObjectTag{Key: "camera", Value: "nikon d3"}},
+			}, objects)
+		}
+	})
+}
+
+func TestValidateObjectTags(t *testing.T) {
+	tooMany := make([]pointerdb.ObjectTag, pointerdb.MaxObjectTags+1)
+	for i := range tooMany {
+		tooMany[i] = pointerdb.ObjectTag{Key: string(rune('a' + i))}
+	}
+
+	for i, tags := range [][]pointerdb.ObjectTag{
+		tooMany,
+		{{Key: "", Value: "value"}},
+		{{Key: "key", Value: "a"}, {Key: "key", Value: "b"}},
+		{{Key: "key", Value: "\xff"}},
+		{{Key: string(make([]byte, pointerdb.MaxTagKeyLength+1)), Value: "value"}},
+		{{Key: "key", Value: string(make([]byte, pointerdb.MaxTagValueLength+1))}},
+	} {
+		assert.Error(t, pointerdb.ValidateObjectTags(tags), i)
+	}
+
+	assert.NoError(t, pointerdb.ValidateObjectTags(nil))
+	assert.NoError(t, pointerdb.ValidateObjectTags(tooMany[:pointerdb.MaxObjectTags]))
+}
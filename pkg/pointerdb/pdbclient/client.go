@@ -46,32 +46,50 @@ type ListItem struct {
 	IsPrefix bool
 }
 
+// SearchItem is a single object found by a tag search
+type SearchItem struct {
+	Path storj.Path
+	Tag  *pb.ObjectTag
+}
+
 // Client services offerred for the interface
 type Client interface {
 	Put(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
 	Get(ctx context.Context, path storj.Path) (*pb.Pointer, []*pb.Node, *pb.PayerBandwidthAllocation, error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 	Delete(ctx context.Context, path storj.Path) error
+	Move(ctx context.Context, path, newPath storj.Path, metadata []byte) error
+
+	SetObjectTags(ctx context.Context, bucket string, path storj.Path, tags []*pb.ObjectTag) error
+	SearchObjects(ctx context.Context, bucket, tagKey, tagValue string, prefix bool, limit int, offset int64) (items []SearchItem, more bool, err error)
+
+	BucketTemplate(ctx context.Context, bucket string) (*pb.BucketTemplateResponse, error)
+	BucketStats(ctx context.Context, bucket string) (*pb.BucketStatsResponse, error)
+
+	SetBucketLifecycle(ctx context.Context, bucket string, lifecycle *pb.BucketLifecycle) error
+	BucketLifecycle(ctx context.Context, bucket string) (*pb.BucketLifecycleResponse, error)
+
+	SetBucketAttribution(ctx context.Context, bucket, partnerID string) error
 
 	SignedMessage() *pb.SignedMessage
-	PayerBandwidthAllocation(context.Context, pb.BandwidthAction) (*pb.PayerBandwidthAllocation, error)
+	PayerBandwidthAllocation(ctx context.Context, action pb.BandwidthAction, path storj.Path) (*pb.PayerBandwidthAllocation, error)
 
 	// Disconnect() error // TODO: implement
 }
 
 // NewClient initializes a new pointerdb client
-func NewClient(identity *identity.FullIdentity, address string, APIKey string) (*PointerDB, error) {
-	return NewClientContext(context.TODO(), identity, address, APIKey)
+func NewClient(identity *identity.FullIdentity, address string, APIKey string, opts ...grpc.DialOption) (*PointerDB, error) {
+	return NewClientContext(context.TODO(), identity, address, APIKey, opts...)
 }
 
 // NewClientContext initializes a new pointerdb client
-func NewClientContext(ctx context.Context, identity *identity.FullIdentity, address string, APIKey string) (*PointerDB, error) {
+func NewClientContext(ctx context.Context, identity *identity.FullIdentity, address string, APIKey string, opts ...grpc.DialOption) (*PointerDB, error) {
 	apiKeyInjector := grpcauth.NewAPIKeyInjector(APIKey)
 	tc := transport.NewClient(identity)
 	conn, err := tc.DialAddress(
 		ctx,
 		address,
-		grpc.WithUnaryInterceptor(apiKeyInjector),
+		append([]grpc.DialOption{grpc.WithUnaryInterceptor(apiKeyInjector)}, opts...)...,
 	)
 	if err != nil {
 		return nil, err
@@ -166,11 +184,129 @@ func (pdb *PointerDB) Delete(ctx context.Context, path storj.Path) (err error) {
 	return err
 }
 
-// PayerBandwidthAllocation gets payer bandwidth allocation message
-func (pdb *PointerDB) PayerBandwidthAllocation(ctx context.Context, action pb.BandwidthAction) (resp *pb.PayerBandwidthAllocation, err error) {
+// Move moves the segment at path to newPath and replaces its metadata,
+// the pieces of the segment aren't touched
+func (pdb *PointerDB) Move(ctx context.Context, path, newPath storj.Path, metadata []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pdb.client.Move(ctx, &pb.MoveRequest{Path: path, NewPath: newPath, Metadata: metadata})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// SetObjectTags replaces the search tags of the object at path in bucket
+func (pdb *PointerDB) SetObjectTags(ctx context.Context, bucket string, path storj.Path, tags []*pb.ObjectTag) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pdb.client.SetObjectTags(ctx, &pb.SetObjectTagsRequest{Bucket: bucket, Path: path, Tags: tags})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// SearchObjects returns the objects of bucket which have a tag tagKey with the value tagValue,
+// when prefix is set the value of the tag only has to start with tagValue
+func (pdb *PointerDB) SearchObjects(ctx context.Context, bucket, tagKey, tagValue string, prefix bool, limit int, offset int64) (items []SearchItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.client.SearchObjects(ctx, &pb.SearchObjectsRequest{
+		Bucket:   bucket,
+		TagKey:   tagKey,
+		TagValue: tagValue,
+		Prefix:   prefix,
+		Limit:    int32(limit),
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	list := res.GetItems()
+	items = make([]SearchItem, len(list))
+	for i, itm := range list {
+		items[i] = SearchItem{
+			Path: itm.GetPath(),
+			Tag:  itm.GetTag(),
+		}
+	}
+
+	return items, res.GetMore(), nil
+}
+
+// BucketTemplate returns the settings the project applies to the new bucket,
+// Found is false when the project has no template
+func (pdb *PointerDB) BucketTemplate(ctx context.Context, bucket string) (resp *pb.BucketTemplateResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resp, err = pdb.client.BucketTemplate(ctx, &pb.BucketTemplateRequest{Bucket: bucket})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			// satellites without bucket templates
+			return &pb.BucketTemplateResponse{}, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+	return resp, nil
+}
+
+// BucketStats returns the number of objects and bytes of a bucket
+func (pdb *PointerDB) BucketStats(ctx context.Context, bucket string) (resp *pb.BucketStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resp, err = pdb.client.BucketStats(ctx, &pb.BucketStatsRequest{Bucket: bucket})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return resp, nil
+}
+
+// SetBucketLifecycle replaces the lifecycle configuration of a bucket, a
+// lifecycle without expiration removes it
+func (pdb *PointerDB) SetBucketLifecycle(ctx context.Context, bucket string, lifecycle *pb.BucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pdb.client.SetBucketLifecycle(ctx, &pb.SetBucketLifecycleRequest{Bucket: bucket, Lifecycle: lifecycle})
+	return Error.Wrap(err)
+}
+
+// BucketLifecycle returns the lifecycle configuration of a bucket, Found is
+// false when the bucket has none
+func (pdb *PointerDB) BucketLifecycle(ctx context.Context, bucket string) (resp *pb.BucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resp, err = pdb.client.BucketLifecycle(ctx, &pb.BucketLifecycleRequest{Bucket: bucket})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return resp, nil
+}
+
+// SetBucketAttribution attributes a new bucket to a partner
+func (pdb *PointerDB) SetBucketAttribution(ctx context.Context, bucket, partnerID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pdb.client.SetBucketAttribution(ctx, &pb.SetBucketAttributionRequest{Bucket: bucket, PartnerId: partnerID})
+	return Error.Wrap(err)
+}
+
+// PayerBandwidthAllocation gets payer bandwidth allocation message for the
+// segment at path, a *MaintenanceError is returned while the satellite is in maintenance
+func (pdb *PointerDB) PayerBandwidthAllocation(ctx context.Context, action pb.BandwidthAction, path storj.Path) (resp *pb.PayerBandwidthAllocation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	response, err := pdb.client.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{Action: action})
+	response, err := pdb.client.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{Action: action, Path: path})
+	if until, ok := MaintenanceUntil(err); ok {
+		return nil, &MaintenanceError{Until: until}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -298,3 +298,56 @@ func TestDelete(t *testing.T) {
 		}
 	}
 }
+
+func TestSearchObjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for i, tt := range []struct {
+		items     []*pb.SearchObjectsResponse_Item
+		more      bool
+		err       error
+		errString string
+	}{
+		{nil, false, nil, ""},
+		{[]*pb.SearchObjectsResponse_Item{
+			{Path: "enc/a", Tag: &pb.ObjectTag{Key: "camera", Value: "nikon d750"}},
+			{Path: "enc/b", Tag: &pb.ObjectTag{Key: "camera", Value: "nikon d3"}},
+		}, true, nil, ""},
+		{nil, false, ErrUnauthenticated, Error.Wrap(ErrUnauthenticated).Error()},
+	} {
+		ctx := context.Background()
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		gc := NewMockPointerDBClient(ctrl)
+		pdb := PointerDB{client: gc}
+
+		searchRequest := pb.SearchObjectsRequest{
+			Bucket:   "photos",
+			TagKey:   "camera",
+			TagValue: "nikon",
+			Prefix:   true,
+			Limit:    10,
+		}
+
+		var searchResponse *pb.SearchObjectsResponse
+		if tt.err == nil {
+			searchResponse = &pb.SearchObjectsResponse{Items: tt.items, More: tt.more}
+		}
+		gc.EXPECT().SearchObjects(gomock.Any(), &searchRequest).Return(searchResponse, tt.err)
+
+		items, more, err := pdb.SearchObjects(ctx, "photos", "camera", "nikon", true, 10, 0)
+		if err != nil {
+			assert.EqualError(t, err, tt.errString, errTag)
+			continue
+		}
+
+		assert.NoError(t, err, errTag)
+		assert.Equal(t, tt.more, more, errTag)
+		assert.Equal(t, len(tt.items), len(items), errTag)
+		for i := range items {
+			assert.Equal(t, tt.items[i].GetPath(), items[i].Path, errTag)
+			assert.Equal(t, tt.items[i].GetTag(), items[i].Tag, errTag)
+		}
+	}
+}
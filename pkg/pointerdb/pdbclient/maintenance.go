@@ -0,0 +1,54 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pdbclient
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maintenancePrefix starts the status message of requests the satellite
+// rejects during maintenance, it's followed by the end of the maintenance
+const maintenancePrefix = "satellite maintenance until "
+
+// MaintenanceError is returned when the satellite doesn't accept new uploads
+// because of a planned maintenance
+type MaintenanceError struct {
+	Until time.Time
+}
+
+// Error implements error
+func (err *MaintenanceError) Error() string {
+	return fmt.Sprintf("%s%s, try again later", maintenancePrefix, err.Until.Format(time.RFC3339))
+}
+
+// MaintenanceStatus returns the status the satellite rejects requests with
+// during a maintenance lasting until
+func MaintenanceStatus(until time.Time) error {
+	return status.Error(codes.Unavailable, maintenancePrefix+until.UTC().Format(time.RFC3339))
+}
+
+// MaintenanceUntil returns until when the satellite is in maintenance, when
+// err was returned because of the maintenance
+func MaintenanceUntil(err error) (until time.Time, ok bool) {
+	err = errs.Unwrap(err)
+	if maintenance, ok := err.(*MaintenanceError); ok {
+		return maintenance.Until, true
+	}
+
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Unavailable || !strings.HasPrefix(st.Message(), maintenancePrefix) {
+		return time.Time{}, false
+	}
+	until, parseErr := time.Parse(time.RFC3339, strings.TrimPrefix(st.Message(), maintenancePrefix))
+	if parseErr != nil {
+		return time.Time{}, false
+	}
+	return until, true
+}
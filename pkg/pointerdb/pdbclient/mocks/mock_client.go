@@ -37,6 +37,45 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// BucketLifecycle mocks base method
+func (m *MockClient) BucketLifecycle(arg0 context.Context, arg1 string) (*pb.BucketLifecycleResponse, error) {
+	ret := m.ctrl.Call(m, "BucketLifecycle", arg0, arg1)
+	ret0, _ := ret[0].(*pb.BucketLifecycleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BucketLifecycle indicates an expected call of BucketLifecycle
+func (mr *MockClientMockRecorder) BucketLifecycle(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketLifecycle", reflect.TypeOf((*MockClient)(nil).BucketLifecycle), arg0, arg1)
+}
+
+// BucketStats mocks base method
+func (m *MockClient) BucketStats(arg0 context.Context, arg1 string) (*pb.BucketStatsResponse, error) {
+	ret := m.ctrl.Call(m, "BucketStats", arg0, arg1)
+	ret0, _ := ret[0].(*pb.BucketStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BucketStats indicates an expected call of BucketStats
+func (mr *MockClientMockRecorder) BucketStats(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketStats", reflect.TypeOf((*MockClient)(nil).BucketStats), arg0, arg1)
+}
+
+// BucketTemplate mocks base method
+func (m *MockClient) BucketTemplate(arg0 context.Context, arg1 string) (*pb.BucketTemplateResponse, error) {
+	ret := m.ctrl.Call(m, "BucketTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*pb.BucketTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BucketTemplate indicates an expected call of BucketTemplate
+func (mr *MockClientMockRecorder) BucketTemplate(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketTemplate", reflect.TypeOf((*MockClient)(nil).BucketTemplate), arg0, arg1)
+}
+
 // Delete mocks base method
 func (m *MockClient) Delete(arg0 context.Context, arg1 string) error {
 	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
@@ -78,17 +117,29 @@ func (mr *MockClientMockRecorder) List(arg0, arg1, arg2, arg3, arg4, arg5, arg6
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClient)(nil).List), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// Move mocks base method
+func (m *MockClient) Move(arg0 context.Context, arg1, arg2 string, arg3 []byte) error {
+	ret := m.ctrl.Call(m, "Move", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move
+func (mr *MockClientMockRecorder) Move(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockClient)(nil).Move), arg0, arg1, arg2, arg3)
+}
+
 // PayerBandwidthAllocation mocks base method
-func (m *MockClient) PayerBandwidthAllocation(arg0 context.Context, arg1 pb.BandwidthAction) (*pb.PayerBandwidthAllocation, error) {
-	ret := m.ctrl.Call(m, "PayerBandwidthAllocation", arg0, arg1)
+func (m *MockClient) PayerBandwidthAllocation(arg0 context.Context, arg1 pb.BandwidthAction, arg2 string) (*pb.PayerBandwidthAllocation, error) {
+	ret := m.ctrl.Call(m, "PayerBandwidthAllocation", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*pb.PayerBandwidthAllocation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PayerBandwidthAllocation indicates an expected call of PayerBandwidthAllocation
-func (mr *MockClientMockRecorder) PayerBandwidthAllocation(arg0, arg1 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayerBandwidthAllocation", reflect.TypeOf((*MockClient)(nil).PayerBandwidthAllocation), arg0, arg1)
+func (mr *MockClientMockRecorder) PayerBandwidthAllocation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayerBandwidthAllocation", reflect.TypeOf((*MockClient)(nil).PayerBandwidthAllocation), arg0, arg1, arg2)
 }
 
 // Put mocks base method
@@ -103,6 +154,56 @@ func (mr *MockClientMockRecorder) Put(arg0, arg1, arg2 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockClient)(nil).Put), arg0, arg1, arg2)
 }
 
+// SearchObjects mocks base method
+func (m *MockClient) SearchObjects(arg0 context.Context, arg1, arg2, arg3 string, arg4 bool, arg5 int, arg6 int64) ([]pdbclient.SearchItem, bool, error) {
+	ret := m.ctrl.Call(m, "SearchObjects", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].([]pdbclient.SearchItem)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchObjects indicates an expected call of SearchObjects
+func (mr *MockClientMockRecorder) SearchObjects(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchObjects", reflect.TypeOf((*MockClient)(nil).SearchObjects), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// SetBucketAttribution mocks base method
+func (m *MockClient) SetBucketAttribution(arg0 context.Context, arg1, arg2 string) error {
+	ret := m.ctrl.Call(m, "SetBucketAttribution", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBucketAttribution indicates an expected call of SetBucketAttribution
+func (mr *MockClientMockRecorder) SetBucketAttribution(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketAttribution", reflect.TypeOf((*MockClient)(nil).SetBucketAttribution), arg0, arg1, arg2)
+}
+
+// SetBucketLifecycle mocks base method
+func (m *MockClient) SetBucketLifecycle(arg0 context.Context, arg1 string, arg2 *pb.BucketLifecycle) error {
+	ret := m.ctrl.Call(m, "SetBucketLifecycle", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBucketLifecycle indicates an expected call of SetBucketLifecycle
+func (mr *MockClientMockRecorder) SetBucketLifecycle(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketLifecycle", reflect.TypeOf((*MockClient)(nil).SetBucketLifecycle), arg0, arg1, arg2)
+}
+
+// SetObjectTags mocks base method
+func (m *MockClient) SetObjectTags(arg0 context.Context, arg1, arg2 string, arg3 []*pb.ObjectTag) error {
+	ret := m.ctrl.Call(m, "SetObjectTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetObjectTags indicates an expected call of SetObjectTags
+func (mr *MockClientMockRecorder) SetObjectTags(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetObjectTags", reflect.TypeOf((*MockClient)(nil).SetObjectTags), arg0, arg1, arg2, arg3)
+}
+
 // SignedMessage mocks base method
 func (m *MockClient) SignedMessage() *pb.SignedMessage {
 	ret := m.ctrl.Call(m, "SignedMessage")
@@ -40,6 +40,60 @@ func (m *MockPointerDBClient) EXPECT() *MockPointerDBClientMockRecorder {
 	return m.recorder
 }
 
+// BucketLifecycle mocks base method
+func (m *MockPointerDBClient) BucketLifecycle(arg0 context.Context, arg1 *pb.BucketLifecycleRequest, arg2 ...grpc.CallOption) (*pb.BucketLifecycleResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BucketLifecycle", varargs...)
+	ret0, _ := ret[0].(*pb.BucketLifecycleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BucketLifecycle indicates an expected call of BucketLifecycle
+func (mr *MockPointerDBClientMockRecorder) BucketLifecycle(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketLifecycle", reflect.TypeOf((*MockPointerDBClient)(nil).BucketLifecycle), varargs...)
+}
+
+// BucketStats mocks base method
+func (m *MockPointerDBClient) BucketStats(arg0 context.Context, arg1 *pb.BucketStatsRequest, arg2 ...grpc.CallOption) (*pb.BucketStatsResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BucketStats", varargs...)
+	ret0, _ := ret[0].(*pb.BucketStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BucketStats indicates an expected call of BucketStats
+func (mr *MockPointerDBClientMockRecorder) BucketStats(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketStats", reflect.TypeOf((*MockPointerDBClient)(nil).BucketStats), varargs...)
+}
+
+// BucketTemplate mocks base method
+func (m *MockPointerDBClient) BucketTemplate(arg0 context.Context, arg1 *pb.BucketTemplateRequest, arg2 ...grpc.CallOption) (*pb.BucketTemplateResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BucketTemplate", varargs...)
+	ret0, _ := ret[0].(*pb.BucketTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BucketTemplate indicates an expected call of BucketTemplate
+func (mr *MockPointerDBClientMockRecorder) BucketTemplate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketTemplate", reflect.TypeOf((*MockPointerDBClient)(nil).BucketTemplate), varargs...)
+}
+
 // Delete mocks base method
 func (m *MockPointerDBClient) Delete(arg0 context.Context, arg1 *pb.DeleteRequest, arg2 ...grpc.CallOption) (*pb.DeleteResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -94,6 +148,24 @@ func (mr *MockPointerDBClientMockRecorder) List(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPointerDBClient)(nil).List), varargs...)
 }
 
+// Move mocks base method
+func (m *MockPointerDBClient) Move(arg0 context.Context, arg1 *pb.MoveRequest, arg2 ...grpc.CallOption) (*pb.MoveResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Move", varargs...)
+	ret0, _ := ret[0].(*pb.MoveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move
+func (mr *MockPointerDBClientMockRecorder) Move(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockPointerDBClient)(nil).Move), varargs...)
+}
+
 // PayerBandwidthAllocation mocks base method
 func (m *MockPointerDBClient) PayerBandwidthAllocation(arg0 context.Context, arg1 *pb.PayerBandwidthAllocationRequest, arg2 ...grpc.CallOption) (*pb.PayerBandwidthAllocationResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -129,3 +201,75 @@ func (mr *MockPointerDBClientMockRecorder) Put(arg0, arg1 interface{}, arg2 ...i
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockPointerDBClient)(nil).Put), varargs...)
 }
+
+// SearchObjects mocks base method
+func (m *MockPointerDBClient) SearchObjects(arg0 context.Context, arg1 *pb.SearchObjectsRequest, arg2 ...grpc.CallOption) (*pb.SearchObjectsResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchObjects", varargs...)
+	ret0, _ := ret[0].(*pb.SearchObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchObjects indicates an expected call of SearchObjects
+func (mr *MockPointerDBClientMockRecorder) SearchObjects(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchObjects", reflect.TypeOf((*MockPointerDBClient)(nil).SearchObjects), varargs...)
+}
+
+// SetBucketAttribution mocks base method
+func (m *MockPointerDBClient) SetBucketAttribution(arg0 context.Context, arg1 *pb.SetBucketAttributionRequest, arg2 ...grpc.CallOption) (*pb.SetBucketAttributionResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetBucketAttribution", varargs...)
+	ret0, _ := ret[0].(*pb.SetBucketAttributionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBucketAttribution indicates an expected call of SetBucketAttribution
+func (mr *MockPointerDBClientMockRecorder) SetBucketAttribution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketAttribution", reflect.TypeOf((*MockPointerDBClient)(nil).SetBucketAttribution), varargs...)
+}
+
+// SetBucketLifecycle mocks base method
+func (m *MockPointerDBClient) SetBucketLifecycle(arg0 context.Context, arg1 *pb.SetBucketLifecycleRequest, arg2 ...grpc.CallOption) (*pb.SetBucketLifecycleResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetBucketLifecycle", varargs...)
+	ret0, _ := ret[0].(*pb.SetBucketLifecycleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBucketLifecycle indicates an expected call of SetBucketLifecycle
+func (mr *MockPointerDBClientMockRecorder) SetBucketLifecycle(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketLifecycle", reflect.TypeOf((*MockPointerDBClient)(nil).SetBucketLifecycle), varargs...)
+}
+
+// SetObjectTags mocks base method
+func (m *MockPointerDBClient) SetObjectTags(arg0 context.Context, arg1 *pb.SetObjectTagsRequest, arg2 ...grpc.CallOption) (*pb.SetObjectTagsResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetObjectTags", varargs...)
+	ret0, _ := ret[0].(*pb.SetObjectTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetObjectTags indicates an expected call of SetObjectTags
+func (mr *MockPointerDBClientMockRecorder) SetObjectTags(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetObjectTags", reflect.TypeOf((*MockPointerDBClient)(nil).SetObjectTags), varargs...)
+}
@@ -4,8 +4,12 @@
 package pointerdb
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
+	"time"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -14,9 +18,11 @@ import (
 
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	_ "storj.io/storj/pkg/pointerdb/auth" // ensures that we add api key flag to current executable
+	"storj.io/storj/pkg/pointerdb/pdbclient"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/storage"
@@ -30,6 +36,12 @@ var (
 // APIKeys is api keys store methods used by pointerdb
 type APIKeys interface {
 	GetByKey(ctx context.Context, key console.APIKey) (*console.APIKeyInfo, error)
+	GetWithKey(ctx context.Context, id uuid.UUID) (*console.APIKeyInfo, *console.APIKey, error)
+}
+
+// ProjectDeletions is project deletions store methods used by pointerdb
+type ProjectDeletions interface {
+	Get(ctx context.Context, projectID uuid.UUID) (*console.ProjectDeletion, error)
 }
 
 // Server implements the network state RPC service
@@ -41,10 +53,19 @@ type Server struct {
 	config     Config
 	identity   *identity.FullIdentity
 	apiKeys    APIKeys
+	deletions  ProjectDeletions
+	objectTags ObjectTags
+	templates  BucketTemplates
+
+	bucketStats  BucketStats
+	lifecycles   BucketLifecycles
+	maintenance  *Maintenance
+	attributions BucketAttributions
 }
 
-// NewServer creates instance of Server
-func NewServer(logger *zap.Logger, service *Service, allocation *AllocationSigner, cache *overlay.Cache, config Config, identity *identity.FullIdentity, apiKeys APIKeys) *Server {
+// NewServer creates instance of Server, which issues no upload allocations
+// during maintenance
+func NewServer(logger *zap.Logger, service *Service, allocation *AllocationSigner, cache *overlay.Cache, config Config, identity *identity.FullIdentity, apiKeys APIKeys, deletions ProjectDeletions, objectTags ObjectTags, templates BucketTemplates, bucketStats BucketStats, lifecycles BucketLifecycles, maintenance *Maintenance, attributions BucketAttributions) *Server {
 	return &Server{
 		logger:     logger,
 		service:    service,
@@ -53,19 +74,48 @@ func NewServer(logger *zap.Logger, service *Service, allocation *AllocationSigne
 		config:     config,
 		identity:   identity,
 		apiKeys:    apiKeys,
+		deletions:  deletions,
+		objectTags: objectTags,
+		templates:  templates,
+
+		bucketStats:  bucketStats,
+		lifecycles:   lifecycles,
+		maintenance:  maintenance,
+		attributions: attributions,
 	}
 }
 
 // Close closes resources
 func (s *Server) Close() error { return nil }
 
-func (s *Server) validateAuth(ctx context.Context) (*console.APIKeyInfo, error) {
+// validateAuth returns the info of the api key of the request, restricted
+// keys have to allow all of actions and the project can't be scheduled for
+// deletion
+func (s *Server) validateAuth(ctx context.Context, actions ...macaroon.Action) (*console.APIKeyInfo, error) {
+	keyInfo, err := s.validateKey(ctx, actions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProject(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	return keyInfo, nil
+}
+
+// validateKey checks the api key of the request and returns the key info
+func (s *Server) validateKey(ctx context.Context, actions []macaroon.Action) (*console.APIKeyInfo, error) {
 	APIKey, ok := auth.GetAPIKey(ctx)
 	if !ok {
 		s.logger.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "Invalid API credential")))
 		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
 	}
 
+	if restricted, err := macaroon.ParseAPIKey(string(APIKey)); err == nil {
+		return s.validateRestricted(ctx, restricted, actions)
+	}
+
 	key, err := console.APIKeyFromBase64(string(APIKey))
 	if err != nil {
 		s.logger.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "Invalid API credential")))
@@ -81,6 +131,93 @@ func (s *Server) validateAuth(ctx context.Context) (*console.APIKeyInfo, error)
 	return keyInfo, nil
 }
 
+// validateProject rejects requests to projects which are scheduled for deletion,
+// their data is purged once the grace period ends
+func (s *Server) validateProject(ctx context.Context, projectID uuid.UUID) error {
+	if s.deletions == nil {
+		return nil
+	}
+
+	_, err := s.deletions.Get(ctx, projectID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		s.logger.Error("err getting project deletion", zap.Error(err))
+		return status.Errorf(codes.Internal, err.Error())
+	}
+
+	return status.Errorf(codes.PermissionDenied, "project is scheduled for deletion")
+}
+
+// validateRestricted checks a restricted api key with the key it was
+// issued for, which is looked up by the head of the macaroon
+func (s *Server) validateRestricted(ctx context.Context, restricted *macaroon.APIKey, actions []macaroon.Action) (*console.APIKeyInfo, error) {
+	var id uuid.UUID
+	if head := restricted.Head(); len(head) == len(id) {
+		copy(id[:], head)
+	} else {
+		s.logger.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "invalid api key id")))
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	}
+
+	keyInfo, key, err := s.apiKeys.GetWithKey(ctx, id)
+	if err != nil {
+		s.logger.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, err.Error())))
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	}
+
+	if err = restricted.Check(key[:], time.Now(), actions...); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+
+	return keyInfo, nil
+}
+
+// allowedActions returns which of actions the api key of the request allows,
+// the key has to be validated before
+func (s *Server) allowedActions(ctx context.Context, actions []macaroon.Action) ([]bool, error) {
+	allowed := make([]bool, len(actions))
+
+	APIKey, _ := auth.GetAPIKey(ctx)
+	restricted, err := macaroon.ParseAPIKey(string(APIKey))
+	if err != nil {
+		// unrestricted keys allow every action
+		for i := range allowed {
+			allowed[i] = true
+		}
+		return allowed, nil
+	}
+
+	var id uuid.UUID
+	copy(id[:], restricted.Head())
+	_, key, err := s.apiKeys.GetWithKey(ctx, id)
+	if err != nil {
+		s.logger.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, err.Error())))
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	}
+
+	now := time.Now()
+	for i, action := range actions {
+		allowed[i] = restricted.Check(key[:], now, action) == nil
+	}
+	return allowed, nil
+}
+
+// segmentAction returns the action of op on path, whose first component is
+// the segment and the second one the bucket
+func segmentAction(op macaroon.ActionType, path storj.Path) macaroon.Action {
+	action := macaroon.Action{Op: op}
+	components := storj.SplitPath(path)
+	if len(components) > 1 {
+		action.Bucket = []byte(components[1])
+	}
+	if len(components) > 2 {
+		action.EncryptedPath = []byte(storj.JoinPaths(components[2:]...))
+	}
+	return action
+}
+
 func (s *Server) validateSegment(req *pb.PutRequest) error {
 	min := s.config.MinRemoteSegmentSize
 	remote := req.GetPointer().Remote
@@ -100,6 +237,42 @@ func (s *Server) validateSegment(req *pb.PutRequest) error {
 	return nil
 }
 
+// recordUploader checks that the piece hashes of a remote segment are signed by
+// the uplink putting it and records its key, audits only accept piece hashes
+// signed with the recorded key or by the satellite
+func (s *Server) recordUploader(ctx context.Context, pointer *pb.Pointer) error {
+	remote := pointer.GetRemote()
+	if remote == nil {
+		return nil
+	}
+	remote.UploaderKey = nil
+
+	hashed := false
+	for _, piece := range remote.GetRemotePieces() {
+		hashed = hashed || piece.GetHash() != nil
+	}
+	if !hashed {
+		return nil
+	}
+
+	pi, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, err.Error())
+	}
+	key, err := auth.EncodePublicKey(pi.Leaf.PublicKey)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	for _, piece := range remote.GetRemotePieces() {
+		if hash := piece.GetHash(); hash != nil && !bytes.Equal(hash.GetPublicKey(), key) {
+			return status.Errorf(codes.InvalidArgument, "hash of piece %d is not signed by the uploader", piece.GetPieceNum())
+		}
+	}
+	remote.UploaderKey = key
+	return nil
+}
+
 // Put formats and hands off a key/value (path/pointer) to be saved to boltdb
 func (s *Server) Put(ctx context.Context, req *pb.PutRequest) (resp *pb.PutResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -109,17 +282,36 @@ func (s *Server) Put(ctx context.Context, req *pb.PutRequest) (resp *pb.PutRespo
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	keyInfo, err := s.validateAuth(ctx)
+	keyInfo, err := s.validateAuth(ctx, segmentAction(macaroon.ActionWrite, req.GetPath()))
 	if err != nil {
 		return nil, err
 	}
 
+	if err = s.recordUploader(ctx, req.GetPointer()); err != nil {
+		return nil, err
+	}
+
 	path := storj.JoinPaths(keyInfo.ProjectID.String(), req.GetPath())
+
+	// the replaced segment is subtracted from the bucket counters
+	old, err := s.currentPointer(path)
+	if err != nil {
+		s.logger.Error("err getting pointer", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
 	if err = s.service.Put(path, req.GetPointer()); err != nil {
 		s.logger.Error("err putting pointer", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	// the pointer is stored already, the counters are corrected by tally
+	// when they can't be updated
+	if err = s.updateBucketStats(ctx, keyInfo.ProjectID, req.GetPath(), old, req.GetPointer()); err != nil {
+		mon.Event("bucket_stats_update_failed")
+		s.logger.Error("err updating bucket stats", zap.Error(err))
+	}
+
 	return &pb.PutResponse{}, nil
 }
 
@@ -127,7 +319,7 @@ func (s *Server) Put(ctx context.Context, req *pb.PutRequest) (resp *pb.PutRespo
 func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	keyInfo, err := s.validateAuth(ctx)
+	keyInfo, err := s.validateAuth(ctx, segmentAction(macaroon.ActionRead, req.GetPath()))
 	if err != nil {
 		return nil, err
 	}
@@ -142,12 +334,20 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	pba, err := s.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{Action: pb.BandwidthAction_GET})
+	pba, err := s.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{
+		Action: pb.BandwidthAction_GET,
+		Path:   req.GetPath(),
+	})
 	if err != nil {
 		s.logger.Error("err getting payer bandwidth allocation", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	// the download isn't failed when its attribution can't be recorded
+	if err := s.recordAttributedDownload(ctx, keyInfo.ProjectID, req.GetPath(), pba.GetPba()); err != nil {
+		s.logger.Error("err recording attributed download", zap.Error(err))
+	}
+
 	authorization, err := s.getSignedMessage()
 	if err != nil {
 		s.logger.Error("err getting signed message", zap.Error(err))
@@ -195,7 +395,7 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 func (s *Server) List(ctx context.Context, req *pb.ListRequest) (resp *pb.ListResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	keyInfo, err := s.validateAuth(ctx)
+	keyInfo, err := s.validateAuth(ctx, segmentAction(macaroon.ActionList, req.Prefix))
 	if err != nil {
 		return nil, err
 	}
@@ -213,26 +413,117 @@ func (s *Server) List(ctx context.Context, req *pb.ListRequest) (resp *pb.ListRe
 func (s *Server) Delete(ctx context.Context, req *pb.DeleteRequest) (resp *pb.DeleteResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	keyInfo, err := s.validateAuth(ctx)
+	keyInfo, err := s.validateAuth(ctx, segmentAction(macaroon.ActionDelete, req.GetPath()))
 	if err != nil {
 		return nil, err
 	}
 
 	path := storj.JoinPaths(keyInfo.ProjectID.String(), req.GetPath())
+
+	// the deleted segment is subtracted from the bucket counters
+	old, err := s.currentPointer(path)
+	if err != nil {
+		s.logger.Error("err getting pointer", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
 	err = s.service.Delete(path)
 	if err != nil {
 		s.logger.Error("err deleting path and pointer", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	if err = s.updateBucketStats(ctx, keyInfo.ProjectID, req.GetPath(), old, nil); err != nil {
+		mon.Event("bucket_stats_update_failed")
+		s.logger.Error("err updating bucket stats", zap.Error(err))
+	}
+
+	err = s.deleteObjectTags(ctx, keyInfo.ProjectID, req.GetPath())
+	if err != nil {
+		s.logger.Error("err deleting object tags", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &pb.DeleteResponse{}, nil
 }
 
+// Move moves a segment to a new path, the pieces of the segment stay where
+// they are, only the pointer is stored at the new path with the metadata
+// the uplink encrypted for it
+func (s *Server) Move(ctx context.Context, req *pb.MoveRequest) (resp *pb.MoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx,
+		segmentAction(macaroon.ActionRead, req.GetPath()),
+		segmentAction(macaroon.ActionDelete, req.GetPath()),
+		segmentAction(macaroon.ActionWrite, req.GetNewPath()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// a segment keeps its position in the stream
+	segment, _, _ := splitSegmentPath(req.GetPath())
+	newSegment, _, _ := splitSegmentPath(req.GetNewPath())
+	if segment == "" || segment != newSegment {
+		return nil, status.Errorf(codes.InvalidArgument, "can't move segment %q to %q", req.GetPath(), req.GetNewPath())
+	}
+
+	path := storj.JoinPaths(keyInfo.ProjectID.String(), req.GetPath())
+	newPath := storj.JoinPaths(keyInfo.ProjectID.String(), req.GetNewPath())
+
+	pointer, err := s.service.Get(path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		s.logger.Error("err getting pointer", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	// the pieces of a replaced segment would never be deleted, so the
+	// uplink has to delete the segment first
+	_, err = s.service.Get(newPath)
+	switch {
+	case err == nil:
+		return nil, status.Errorf(codes.AlreadyExists, "segment %q exists", req.GetNewPath())
+	case !storage.ErrKeyNotFound.Has(err):
+		s.logger.Error("err getting pointer", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	pointer.Metadata = req.GetMetadata()
+	if err = s.service.Put(newPath, pointer); err != nil {
+		s.logger.Error("err putting pointer", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if err = s.service.Delete(path); err != nil {
+		s.logger.Error("err deleting pointer", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	if err = errs.Combine(
+		s.updateBucketStats(ctx, keyInfo.ProjectID, req.GetPath(), pointer, nil),
+		s.updateBucketStats(ctx, keyInfo.ProjectID, req.GetNewPath(), nil, pointer),
+	); err != nil {
+		mon.Event("bucket_stats_update_failed")
+		s.logger.Error("err updating bucket stats", zap.Error(err))
+	}
+
+	err = s.moveObjectTags(ctx, keyInfo.ProjectID, req.GetPath(), req.GetNewPath())
+	if err != nil {
+		s.logger.Error("err moving object tags", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.MoveResponse{}, nil
+}
+
 // Iterate iterates over items based on IterateRequest
 func (s *Server) Iterate(ctx context.Context, req *pb.IterateRequest, f func(it storage.Iterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	keyInfo, err := s.validateAuth(ctx)
+	keyInfo, err := s.validateAuth(ctx, segmentAction(macaroon.ActionList, req.Prefix))
 	if err != nil {
 		return err
 	}
@@ -245,7 +536,13 @@ func (s *Server) Iterate(ctx context.Context, req *pb.IterateRequest, f func(it
 func (s *Server) PayerBandwidthAllocation(ctx context.Context, req *pb.PayerBandwidthAllocationRequest) (res *pb.PayerBandwidthAllocationResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, err = s.validateAuth(ctx)
+	// restricted keys need to allow reading the segment for downloads and
+	// writing it for uploads
+	op := macaroon.ActionRead
+	if req.GetAction().IsPut() {
+		op = macaroon.ActionWrite
+	}
+	_, err = s.validateAuth(ctx, segmentAction(op, req.GetPath()))
 	if err != nil {
 		return nil, err
 	}
@@ -257,7 +554,24 @@ func (s *Server) PayerBandwidthAllocation(ctx context.Context, req *pb.PayerBand
 		return nil, err
 	}
 
-	pba, err := s.allocation.PayerBandwidthAllocation(ctx, pi, req.GetAction())
+	action := req.GetAction()
+	if !action.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bandwidth action %d", action)
+	}
+	// audit and repair allocations are priced differently than customer
+	// traffic, only the satellite itself may request them
+	if !action.IsCustomer() && pi.ID != s.identity.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "%v allocations are only issued to the satellite", action)
+	}
+
+	// uploads in progress are still committed with Put and downloads have
+	// their own allocations, so only new uploads are stopped
+	if until, active := s.maintenance.Active(time.Now()); active && action.IsPut() {
+		mon.Event("maintenance_rejected_allocation")
+		return nil, pdbclient.MaintenanceStatus(until)
+	}
+
+	pba, err := s.allocation.PayerBandwidthAllocation(ctx, pi, action)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
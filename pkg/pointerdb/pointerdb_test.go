@@ -7,22 +7,30 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
@@ -34,6 +42,7 @@ import (
 // mockAPIKeys is mock for api keys store of pointerdb
 type mockAPIKeys struct {
 	info console.APIKeyInfo
+	key  console.APIKey
 	err  error
 }
 
@@ -42,6 +51,71 @@ func (keys *mockAPIKeys) GetByKey(ctx context.Context, key console.APIKey) (*con
 	return &keys.info, keys.err
 }
 
+// GetWithKey return api key info and the key for given id
+func (keys *mockAPIKeys) GetWithKey(ctx context.Context, id uuid.UUID) (*console.APIKeyInfo, *console.APIKey, error) {
+	return &keys.info, &keys.key, keys.err
+}
+
+// mockProjectDeletions is mock for project deletions store of pointerdb
+type mockProjectDeletions struct {
+	scheduled map[uuid.UUID]bool
+}
+
+// Get returns the deletion of a project, sql.ErrNoRows when it isn't scheduled
+func (deletions *mockProjectDeletions) Get(ctx context.Context, projectID uuid.UUID) (*console.ProjectDeletion, error) {
+	if !deletions.scheduled[projectID] {
+		return nil, sql.ErrNoRows
+	}
+	return &console.ProjectDeletion{ProjectID: projectID}, nil
+}
+
+// mockObjectTags is an in-memory object tag store
+type mockObjectTags struct {
+	objects map[storj.Path][]ObjectTag
+}
+
+func (tags *mockObjectTags) Set(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path, objectTags []ObjectTag) error {
+	tags.objects[storj.JoinPaths(projectID.String(), bucket, path)] = objectTags
+	return nil
+}
+
+func (tags *mockObjectTags) Delete(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path) error {
+	delete(tags.objects, storj.JoinPaths(projectID.String(), bucket, path))
+	return nil
+}
+
+func (tags *mockObjectTags) Move(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path, newBucket string, newPath storj.Path) error {
+	key := storj.JoinPaths(projectID.String(), bucket, path)
+	if objectTags, ok := tags.objects[key]; ok {
+		delete(tags.objects, key)
+		tags.objects[storj.JoinPaths(projectID.String(), newBucket, newPath)] = objectTags
+	}
+	return nil
+}
+
+func (tags *mockObjectTags) Search(ctx context.Context, projectID uuid.UUID, bucket string, key, value string, prefix bool, limit int, offset int64) (objects []TaggedObject, err error) {
+	prefixPath := storj.JoinPaths(projectID.String(), bucket) + "/"
+	for path, objectTags := range tags.objects {
+		if !strings.HasPrefix(path, prefixPath) {
+			continue
+		}
+		for _, tag := range objectTags {
+			if tag.Key == key && (tag.Value == value || prefix && strings.HasPrefix(tag.Value, value)) {
+				objects = append(objects, TaggedObject{Path: strings.TrimPrefix(path, prefixPath), Tag: tag})
+			}
+		}
+	}
+	sort.Slice(objects, func(i, k int) bool { return objects[i].Path < objects[k].Path })
+	if int64(len(objects)) < offset {
+		return nil, nil
+	}
+	objects = objects[offset:]
+	if len(objects) > limit {
+		objects = objects[:limit]
+	}
+	return objects, nil
+}
+
 func TestServicePut(t *testing.T) {
 	validAPIKey := console.APIKey{}
 	apiKeys := &mockAPIKeys{}
@@ -82,6 +156,158 @@ func TestServicePut(t *testing.T) {
 	}
 }
 
+func TestServicePutRecordsUploader(t *testing.T) {
+	ctx := context.Background()
+	ctx = auth.WithAPIKey(ctx, []byte(console.APIKey{}.String()))
+
+	uplink, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+	other, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	info := credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{uplink.Leaf, uplink.CA}}}
+	peerCtx := peer.NewContext(ctx, &peer.Peer{AuthInfo: info})
+
+	db := teststore.New()
+	service := NewService(zap.NewNop(), db)
+	s := Server{service: service, logger: zap.NewNop(), apiKeys: &mockAPIKeys{}}
+
+	hashed := func(signer *identity.FullIdentity) *pb.Pointer {
+		hash, err := auth.NewSignedData([]byte("piece hash"), signer)
+		require.NoError(t, err)
+		return &pb.Pointer{Type: pb.Pointer_REMOTE, Remote: &pb.RemoteSegment{
+			RemotePieces: []*pb.RemotePiece{{PieceNum: 0, Hash: hash}},
+			UploaderKey:  []byte("chosen by the uplink"),
+		}}
+	}
+
+	// the hashes have to be signed by the authenticated uplink
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: hashed(uplink)})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.Put(peerCtx, &pb.PutRequest{Path: "a/b/c", Pointer: hashed(other)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	pointer := hashed(uplink)
+	_, err = s.Put(peerCtx, &pb.PutRequest{Path: "a/b/c", Pointer: pointer})
+	require.NoError(t, err)
+
+	key, err := auth.EncodePublicKey(uplink.Leaf.PublicKey)
+	require.NoError(t, err)
+	assert.Equal(t, key, pointer.Remote.UploaderKey)
+}
+
+func TestServiceDeletedProject(t *testing.T) {
+	ctx := context.Background()
+	ctx = auth.WithAPIKey(ctx, []byte(console.APIKey{}.String()))
+
+	apiKeys := &mockAPIKeys{}
+	deletions := &mockProjectDeletions{scheduled: map[uuid.UUID]bool{}}
+
+	db := teststore.New()
+	service := NewService(zap.NewNop(), db)
+	s := Server{service: service, logger: zap.NewNop(), apiKeys: apiKeys, deletions: deletions}
+
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: &pb.Pointer{}})
+	require.NoError(t, err)
+
+	deletions.scheduled[apiKeys.info.ProjectID] = true
+
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "a/b/c"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "a/b/d", Pointer: &pb.Pointer{}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestServiceRestrictedKey(t *testing.T) {
+	apiKeys := &mockAPIKeys{key: console.APIKey{1, 2, 3}}
+	unrestricted := apiKeys.key.Macaroon(apiKeys.info.ID)
+
+	restrict := func(caveat pb.Caveat) *macaroon.APIKey {
+		key, err := unrestricted.Restrict(caveat)
+		require.NoError(t, err)
+		return key
+	}
+
+	readOnly := restrict(pb.Caveat{DisallowWrites: true, DisallowDeletes: true})
+	prefixOnly := restrict(pb.Caveat{AllowedPaths: []*pb.CaveatPath{
+		{Bucket: []byte("bucket"), EncryptedPathPrefix: []byte("c")},
+	}})
+	expired := restrict(pb.Caveat{NotAfter: ptypes.TimestampNow()})
+	forged := macaroon.NewAPIKey(apiKeys.info.ID[:], []byte("other secret"))
+
+	tags := &mockObjectTags{objects: map[storj.Path][]ObjectTag{}}
+
+	db := teststore.New()
+	service := NewService(zap.NewNop(), db)
+	s := Server{service: service, logger: zap.NewNop(), apiKeys: apiKeys, objectTags: tags}
+
+	for i, tt := range []struct {
+		key     *macaroon.APIKey
+		path    string
+		allowed bool
+	}{
+		{unrestricted, "l/bucket/c/d", true},
+		{readOnly, "l/bucket/c/d", false},
+		{prefixOnly, "l/bucket/c/d", true},
+		{prefixOnly, "s0/bucket/c", true},
+		{prefixOnly, "l/bucket/cd", false},
+		{prefixOnly, "l/other/c/d", false},
+		{expired, "l/bucket/c/d", false},
+		{forged, "l/bucket/c/d", false},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+		ctx := auth.WithAPIKey(context.Background(), []byte(tt.key.Serialize()))
+
+		_, err := s.Put(ctx, &pb.PutRequest{Path: tt.path, Pointer: &pb.Pointer{}})
+		if tt.allowed {
+			assert.NoError(t, err, errTag)
+		} else {
+			assert.Equal(t, codes.PermissionDenied, status.Code(err), errTag)
+		}
+	}
+
+	// listing the parents of an allowed prefix and reading from it is allowed
+	ctx := auth.WithAPIKey(context.Background(), []byte(readOnly.Serialize()))
+	_, err := s.List(ctx, &pb.ListRequest{Prefix: "l/bucket"})
+	assert.NoError(t, err)
+	ctx = auth.WithAPIKey(context.Background(), []byte(prefixOnly.Serialize()))
+	_, err = s.List(ctx, &pb.ListRequest{Prefix: "l"})
+	assert.NoError(t, err)
+	_, err = s.List(ctx, &pb.ListRequest{Prefix: "l/bucket/c", Recursive: true})
+	assert.NoError(t, err)
+	_, err = s.List(ctx, &pb.ListRequest{Prefix: "l/other"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.Delete(ctx, &pb.DeleteRequest{Path: "s0/bucket/c"})
+	assert.NoError(t, err)
+	_, err = s.Delete(ctx, &pb.DeleteRequest{Path: "s0/bucket/e"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// searching a bucket only returns the found objects within the allowed paths
+	leica := []ObjectTag{{Key: "camera", Value: "leica"}}
+	tags.objects[storj.JoinPaths(apiKeys.info.ProjectID.String(), "bucket", "c/d")] = leica
+	tags.objects[storj.JoinPaths(apiKeys.info.ProjectID.String(), "bucket", "e")] = leica
+	resp, err := s.SearchObjects(ctx, &pb.SearchObjectsRequest{Bucket: "bucket", TagKey: "camera", TagValue: "leica"})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "c/d", resp.Items[0].Path)
+
+	// bandwidth allocations are authorized for the segment they are used for
+	_, err = s.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{Action: pb.BandwidthAction_PUT, Path: "l/other/c"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{Action: pb.BandwidthAction_GET, Path: "s0/bucket/e"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	ctx = auth.WithAPIKey(context.Background(), []byte(readOnly.Serialize()))
+	_, err = s.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{Action: pb.BandwidthAction_PUT, Path: "l/bucket/c/d"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// bucket templates are only returned to keys which may create the bucket
+	_, err = s.BucketTemplate(ctx, &pb.BucketTemplateRequest{Bucket: "bucket"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	ctx = auth.WithAPIKey(context.Background(), []byte(unrestricted.Serialize()))
+	_, err = s.BucketTemplate(ctx, &pb.BucketTemplateRequest{Bucket: "bucket"})
+	assert.NoError(t, err)
+}
+
 func TestServiceGet(t *testing.T) {
 	ctx := context.Background()
 	ca, err := testidentity.NewTestCA(ctx)
@@ -116,7 +342,7 @@ func TestServiceGet(t *testing.T) {
 		service := NewService(zap.NewNop(), db)
 		allocation := NewAllocationSigner(identity, 45)
 
-		s := NewServer(zap.NewNop(), service, allocation, nil, Config{}, identity, apiKeys)
+		s := NewServer(zap.NewNop(), service, allocation, nil, Config{}, identity, apiKeys, nil, nil, nil, nil, nil, &Maintenance{}, nil)
 
 		path := "a/b/c"
 
@@ -393,3 +619,331 @@ func TestServiceList(t *testing.T) {
 		}
 	}
 }
+
+func TestServiceObjectTags(t *testing.T) {
+	ctx := context.Background()
+	ctx = auth.WithAPIKey(ctx, []byte(console.APIKey{}.String()))
+
+	apiKeys := &mockAPIKeys{}
+	tags := &mockObjectTags{objects: map[storj.Path][]ObjectTag{}}
+
+	db := teststore.New()
+	service := NewService(zap.NewNop(), db)
+	s := Server{service: service, logger: zap.NewNop(), apiKeys: apiKeys, objectTags: tags}
+
+	camera := []*pb.ObjectTag{{Key: "camera", Value: "nikon d750"}}
+
+	_, err := s.SetObjectTags(ctx, &pb.SetObjectTagsRequest{Bucket: "photos", Path: "enc/a", Tags: camera})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	for _, path := range []string{"l/photos/enc/a", "l/photos/enc/b"} {
+		_, err = s.Put(ctx, &pb.PutRequest{Path: path, Pointer: &pb.Pointer{}})
+		require.NoError(t, err)
+	}
+
+	_, err = s.SetObjectTags(ctx, &pb.SetObjectTagsRequest{Bucket: "photos", Path: "enc/a", Tags: []*pb.ObjectTag{{Key: ""}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.SetObjectTags(ctx, &pb.SetObjectTagsRequest{Bucket: "photos", Path: "enc/a", Tags: camera})
+	require.NoError(t, err)
+	_, err = s.SetObjectTags(ctx, &pb.SetObjectTagsRequest{Bucket: "photos", Path: "enc/b", Tags: []*pb.ObjectTag{{Key: "camera", Value: "nikon d3"}}})
+	require.NoError(t, err)
+
+	resp, err := s.SearchObjects(ctx, &pb.SearchObjectsRequest{Bucket: "photos", TagKey: "camera", TagValue: "nikon", Prefix: true, Limit: 1})
+	require.NoError(t, err)
+	assert.True(t, resp.More)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "enc/a", resp.Items[0].Path)
+	assert.Equal(t, "nikon d750", resp.Items[0].Tag.Value)
+
+	resp, err = s.SearchObjects(ctx, &pb.SearchObjectsRequest{Bucket: "photos", TagKey: "camera", TagValue: "nikon d3"})
+	require.NoError(t, err)
+	assert.False(t, resp.More)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "enc/b", resp.Items[0].Path)
+
+	_, err = s.SearchObjects(ctx, &pb.SearchObjectsRequest{Bucket: "photos", TagKey: ""})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// deleting the last segment removes the tags of the object
+	_, err = s.Delete(ctx, &pb.DeleteRequest{Path: "l/photos/enc/a"})
+	require.NoError(t, err)
+
+	resp, err = s.SearchObjects(ctx, &pb.SearchObjectsRequest{Bucket: "photos", TagKey: "camera", TagValue: "", Prefix: true})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "enc/b", resp.Items[0].Path)
+}
+
+// mockBucketTemplates is mock for bucket template store of pointerdb
+type mockBucketTemplates struct {
+	templates map[uuid.UUID]*console.BucketTemplate
+}
+
+// Get returns the bucket template of the project
+func (templates *mockBucketTemplates) Get(ctx context.Context, projectID uuid.UUID) (*console.BucketTemplate, error) {
+	template, ok := templates.templates[projectID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return template, nil
+}
+
+func TestServiceBucketTemplate(t *testing.T) {
+	ctx := context.Background()
+	ctx = auth.WithAPIKey(ctx, []byte(console.APIKey{}.String()))
+
+	projectID, err := uuid.New()
+	require.NoError(t, err)
+
+	apiKeys := &mockAPIKeys{info: console.APIKeyInfo{ProjectID: *projectID}}
+	templates := &mockBucketTemplates{templates: map[uuid.UUID]*console.BucketTemplate{}}
+	s := Server{logger: zap.NewNop(), apiKeys: apiKeys, templates: templates}
+
+	resp, err := s.BucketTemplate(ctx, &pb.BucketTemplateRequest{})
+	require.NoError(t, err)
+	assert.False(t, resp.Found)
+
+	templates.templates[*projectID] = &console.BucketTemplate{
+		ProjectID:       *projectID,
+		PathCipher:      storj.SecretBox,
+		DataCipher:      storj.AESGCM,
+		BlockSize:       1024,
+		ShareSize:       1024,
+		RequiredShares:  4,
+		RepairShares:    6,
+		OptimalShares:   8,
+		TotalShares:     10,
+		DistinctSubnets: true,
+	}
+
+	resp, err = s.BucketTemplate(ctx, &pb.BucketTemplateRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.Found)
+	assert.Equal(t, int32(storj.SecretBox), resp.PathCipher)
+	assert.Equal(t, int32(storj.AESGCM), resp.DataCipher)
+	assert.Equal(t, int32(4), resp.Redundancy.MinReq)
+	assert.Equal(t, int32(10), resp.Redundancy.Total)
+	assert.True(t, resp.DistinctSubnets)
+	assert.False(t, resp.DistinctRegions)
+}
+
+// mockBucketStats is mock for bucket counters store of pointerdb
+type mockBucketStats struct {
+	stats map[string]*console.BucketStat
+}
+
+// Add adds deltas to the counters of the bucket
+func (stats *mockBucketStats) Add(ctx context.Context, projectID uuid.UUID, bucketName string, objects, bytes int64) error {
+	stat, ok := stats.stats[bucketName]
+	if !ok {
+		stat = &console.BucketStat{ProjectID: projectID, BucketName: bucketName}
+		stats.stats[bucketName] = stat
+	}
+	stat.ObjectCount += objects
+	stat.TotalBytes += bytes
+	return nil
+}
+
+// Get returns the counters of the bucket
+func (stats *mockBucketStats) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (*console.BucketStat, error) {
+	if stat, ok := stats.stats[bucketName]; ok {
+		return stat, nil
+	}
+	return &console.BucketStat{ProjectID: projectID, BucketName: bucketName}, nil
+}
+
+func TestServiceBucketStats(t *testing.T) {
+	ctx := context.Background()
+	ctx = auth.WithAPIKey(ctx, []byte(console.APIKey{}.String()))
+
+	apiKeys := &mockAPIKeys{}
+	stats := &mockBucketStats{stats: map[string]*console.BucketStat{}}
+
+	db := teststore.New()
+	service := NewService(zap.NewNop(), db)
+	config := Config{MaxInlineSegmentSize: memory.KiB}
+	s := Server{service: service, logger: zap.NewNop(), apiKeys: apiKeys, config: config, bucketStats: stats}
+
+	remote := func(size int64) *pb.Pointer {
+		return &pb.Pointer{Type: pb.Pointer_REMOTE, SegmentSize: size, Remote: &pb.RemoteSegment{}}
+	}
+	inline := &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("inline")}
+
+	for _, put := range []struct {
+		path    string
+		pointer *pb.Pointer
+	}{
+		{"s0/photos/enc/a", remote(1000)},
+		{"l/photos/enc/a", remote(500)},
+		{"l/photos/enc/b", inline},
+		// the object is replaced, it is counted once
+		{"l/photos/enc/b", remote(300)},
+		{"l/backups/enc/c", remote(2000)},
+	} {
+		_, err := s.Put(ctx, &pb.PutRequest{Path: put.path, Pointer: put.pointer})
+		require.NoError(t, err)
+	}
+
+	resp, err := s.BucketStats(ctx, &pb.BucketStatsRequest{Bucket: "photos"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.ObjectCount)
+	assert.Equal(t, int64(1800), resp.TotalBytes)
+
+	for _, path := range []string{"s0/photos/enc/a", "l/photos/enc/a"} {
+		_, err = s.Delete(ctx, &pb.DeleteRequest{Path: path})
+		require.NoError(t, err)
+	}
+
+	resp, err = s.BucketStats(ctx, &pb.BucketStatsRequest{Bucket: "photos"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.ObjectCount)
+	assert.Equal(t, int64(300), resp.TotalBytes)
+
+	resp, err = s.BucketStats(ctx, &pb.BucketStatsRequest{Bucket: "videos"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), resp.ObjectCount)
+
+	_, err = s.BucketStats(ctx, &pb.BucketStatsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// mockBucketLifecycles is mock for bucket lifecycle store of pointerdb
+type mockBucketLifecycles struct {
+	lifecycles map[string]*BucketLifecycle
+}
+
+// Get returns the lifecycle of the bucket
+func (lifecycles *mockBucketLifecycles) Get(ctx context.Context, projectID uuid.UUID, bucket string) (*BucketLifecycle, error) {
+	if lifecycle, ok := lifecycles.lifecycles[bucket]; ok {
+		return lifecycle, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+// Set replaces the lifecycle of the bucket
+func (lifecycles *mockBucketLifecycles) Set(ctx context.Context, lifecycle *BucketLifecycle) error {
+	lifecycles.lifecycles[lifecycle.BucketName] = lifecycle
+	return nil
+}
+
+// Delete removes the lifecycle of the bucket
+func (lifecycles *mockBucketLifecycles) Delete(ctx context.Context, projectID uuid.UUID, bucket string) error {
+	delete(lifecycles.lifecycles, bucket)
+	return nil
+}
+
+// GetAll returns the lifecycles of all buckets
+func (lifecycles *mockBucketLifecycles) GetAll(ctx context.Context) (all []BucketLifecycle, err error) {
+	for _, lifecycle := range lifecycles.lifecycles {
+		all = append(all, *lifecycle)
+	}
+	return all, nil
+}
+
+func TestServiceBucketLifecycle(t *testing.T) {
+	ctx := context.Background()
+	ctx = auth.WithAPIKey(ctx, []byte(console.APIKey{}.String()))
+
+	apiKeys := &mockAPIKeys{}
+	lifecycles := &mockBucketLifecycles{lifecycles: map[string]*BucketLifecycle{}}
+	s := Server{logger: zap.NewNop(), apiKeys: apiKeys, lifecycles: lifecycles}
+
+	resp, err := s.BucketLifecycle(ctx, &pb.BucketLifecycleRequest{Bucket: "photos"})
+	require.NoError(t, err)
+	assert.False(t, resp.Found)
+
+	lifecycle := &pb.BucketLifecycle{ExpireAfterDays: 30, VersionsPrefix: "enc", VersionsExpireAfterDays: 7}
+	_, err = s.SetBucketLifecycle(ctx, &pb.SetBucketLifecycleRequest{Bucket: "photos", Lifecycle: lifecycle})
+	require.NoError(t, err)
+
+	resp, err = s.BucketLifecycle(ctx, &pb.BucketLifecycleRequest{Bucket: "photos"})
+	require.NoError(t, err)
+	assert.True(t, resp.Found)
+	assert.Equal(t, int32(30), resp.Lifecycle.ExpireAfterDays)
+	assert.Equal(t, "enc", resp.Lifecycle.VersionsPrefix)
+	assert.Equal(t, int32(7), resp.Lifecycle.VersionsExpireAfterDays)
+
+	for _, invalid := range []*pb.BucketLifecycle{
+		{ExpireAfterDays: -1},
+		{ExpireAfterDays: MaxLifecycleDays + 1},
+		{VersionsExpireAfterDays: 7},
+	} {
+		_, err = s.SetBucketLifecycle(ctx, &pb.SetBucketLifecycleRequest{Bucket: "photos", Lifecycle: invalid})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	_, err = s.SetBucketLifecycle(ctx, &pb.SetBucketLifecycleRequest{Lifecycle: lifecycle})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a lifecycle without expiration removes the configuration
+	_, err = s.SetBucketLifecycle(ctx, &pb.SetBucketLifecycleRequest{Bucket: "photos", Lifecycle: &pb.BucketLifecycle{}})
+	require.NoError(t, err)
+	assert.Empty(t, lifecycles.lifecycles)
+
+	resp, err = s.BucketLifecycle(ctx, &pb.BucketLifecycleRequest{Bucket: "photos"})
+	require.NoError(t, err)
+	assert.False(t, resp.Found)
+}
+
+func TestServiceMove(t *testing.T) {
+	ctx := context.Background()
+	ctx = auth.WithAPIKey(ctx, []byte(console.APIKey{}.String()))
+
+	apiKeys := &mockAPIKeys{}
+	tags := &mockObjectTags{objects: map[storj.Path][]ObjectTag{}}
+	stats := &mockBucketStats{stats: map[string]*console.BucketStat{}}
+
+	db := teststore.New()
+	service := NewService(zap.NewNop(), db)
+	s := Server{service: service, logger: zap.NewNop(), apiKeys: apiKeys, objectTags: tags, bucketStats: stats}
+
+	remote := &pb.Pointer{Type: pb.Pointer_REMOTE, SegmentSize: 1000, Remote: &pb.RemoteSegment{}, Metadata: []byte("old")}
+	for _, path := range []string{"s0/photos/enc/a", "l/photos/enc/a", "l/photos/enc/b"} {
+		_, err := s.Put(ctx, &pb.PutRequest{Path: path, Pointer: remote})
+		require.NoError(t, err)
+	}
+	_, err := s.SetObjectTags(ctx, &pb.SetObjectTagsRequest{Bucket: "photos", Path: "enc/a", Tags: []*pb.ObjectTag{{Key: "camera", Value: "leica"}}})
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		path, newPath string
+		code          codes.Code
+	}{
+		{"s0/photos/enc/a", "l/photos/enc/c", codes.InvalidArgument},
+		{"s0/photos/enc/c", "s0/photos/enc/d", codes.NotFound},
+		{"l/photos/enc/a", "l/photos/enc/b", codes.AlreadyExists},
+	} {
+		_, err = s.Move(ctx, &pb.MoveRequest{Path: tt.path, NewPath: tt.newPath})
+		assert.Equal(t, tt.code, status.Code(err), tt.path)
+	}
+
+	for _, path := range []string{"s0/photos/enc/a", "l/photos/enc/a"} {
+		newPath := strings.Replace(path, "photos/enc/a", "backups/enc/c", 1)
+		_, err = s.Move(ctx, &pb.MoveRequest{Path: path, NewPath: newPath, Metadata: []byte("new")})
+		require.NoError(t, err)
+
+		_, err = service.Get(storj.JoinPaths(apiKeys.info.ProjectID.String(), path))
+		assert.True(t, storage.ErrKeyNotFound.Has(err), path)
+		pointer, err := service.Get(storj.JoinPaths(apiKeys.info.ProjectID.String(), newPath))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("new"), pointer.Metadata)
+		assert.Equal(t, remote.SegmentSize, pointer.SegmentSize)
+	}
+
+	// the counters and the tags follow the object
+	photos, err := s.BucketStats(ctx, &pb.BucketStatsRequest{Bucket: "photos"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), photos.ObjectCount)
+	assert.Equal(t, int64(1000), photos.TotalBytes)
+
+	backups, err := s.BucketStats(ctx, &pb.BucketStatsRequest{Bucket: "backups"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), backups.ObjectCount)
+	assert.Equal(t, int64(2000), backups.TotalBytes)
+
+	resp, err := s.SearchObjects(ctx, &pb.SearchObjectsRequest{Bucket: "backups", TagKey: "camera", TagValue: "leica"})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "enc/c", resp.Items[0].Path)
+}
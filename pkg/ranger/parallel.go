@@ -0,0 +1,189 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ranger
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// ConcatParallel concatenates Rangers like Concat, but its readers fetch up
+// to parallelism of the Rangers concurrently. Every fetched part is buffered
+// in memory until it's read, so a reader uses up to parallelism times the
+// size of the largest Ranger.
+func ConcatParallel(parallelism int, r ...Ranger) Ranger {
+	if parallelism <= 1 || len(r) <= 1 {
+		return Concat(r...)
+	}
+
+	var size int64
+	for _, rr := range r {
+		size += rr.Size()
+	}
+	return &parallelConcat{rangers: r, size: size, parallelism: parallelism}
+}
+
+type parallelConcat struct {
+	rangers     []Ranger
+	size        int64
+	parallelism int
+}
+
+// Size implements Ranger.Size
+func (c *parallelConcat) Size() int64 { return c.size }
+
+// Range implements Ranger.Range
+func (c *parallelConcat) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 {
+		return nil, Error.New("negative offset")
+	}
+	if length < 0 {
+		return nil, Error.New("negative length")
+	}
+	if offset+length > c.size {
+		return nil, Error.New("range beyond end")
+	}
+
+	// the parts of the rangers which overlap the range
+	var parts []part
+	var start int64
+	for _, rr := range c.rangers {
+		end := start + rr.Size()
+		if end > offset && start < offset+length {
+			partOffset := max(offset, start) - start
+			partEnd := min(offset+length, end) - start
+			parts = append(parts, part{ranger: rr, offset: partOffset, length: partEnd - partOffset})
+		}
+		start = end
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	reader := &parallelReader{
+		ctx:         ctx,
+		cancel:      cancel,
+		parts:       parts,
+		fetches:     make([]*fetch, len(parts)),
+		parallelism: c.parallelism,
+	}
+	reader.startFetches()
+	return reader, nil
+}
+
+// part is the range of one ranger which is read
+type part struct {
+	ranger Ranger
+	offset int64
+	length int64
+}
+
+// fetch is a part read into memory
+type fetch struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// parallelReader reads the parts in order while fetching the next ones
+type parallelReader struct {
+	ctx    context.Context
+	cancel func()
+	wg     sync.WaitGroup
+
+	parts       []part
+	fetches     []*fetch
+	parallelism int
+	// started is the number of parts whose fetch was started
+	started int
+	// current is the index of the part which is read next
+	current int
+	buf     []byte
+}
+
+// startFetches starts fetching parts until parallelism parts are fetched
+// or waiting to be read
+func (reader *parallelReader) startFetches() {
+	for reader.started < len(reader.parts) && reader.started < reader.current+reader.parallelism {
+		fetch := &fetch{done: make(chan struct{})}
+		reader.fetches[reader.started] = fetch
+
+		reader.wg.Add(1)
+		go func(part part) {
+			defer reader.wg.Done()
+			defer close(fetch.done)
+			fetch.data, fetch.err = readPart(reader.ctx, part)
+		}(reader.parts[reader.started])
+
+		reader.started++
+	}
+}
+
+// readPart reads part into memory
+func readPart(ctx context.Context, part part) (_ []byte, err error) {
+	rc, err := part.ranger.Range(ctx, part.offset, part.length)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rc.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	data := make([]byte, part.length)
+	_, err = io.ReadFull(rc, data)
+	return data, err
+}
+
+// Read implements io.Reader
+func (reader *parallelReader) Read(p []byte) (n int, err error) {
+	for len(reader.buf) == 0 {
+		if reader.current >= len(reader.parts) {
+			return 0, io.EOF
+		}
+
+		// the previous part was read, so the next fetch can start
+		reader.startFetches()
+
+		fetch := reader.fetches[reader.current]
+		select {
+		case <-fetch.done:
+		case <-reader.ctx.Done():
+			return 0, reader.ctx.Err()
+		}
+		if fetch.err != nil {
+			return 0, fetch.err
+		}
+
+		reader.buf = fetch.data
+		reader.fetches[reader.current] = nil
+		reader.current++
+	}
+
+	n = copy(p, reader.buf)
+	reader.buf = reader.buf[n:]
+	return n, nil
+}
+
+// Close cancels the fetches in progress
+func (reader *parallelReader) Close() error {
+	reader.cancel()
+	reader.wg.Wait()
+	reader.buf = nil
+	return nil
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ranger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcatParallel(t *testing.T) {
+	for _, parallelism := range []int{1, 2, 3, 8} {
+		for _, example := range []struct {
+			data                 []string
+			size, offset, length int64
+			substr               string
+		}{
+			{[]string{}, 0, 0, 0, ""},
+			{[]string{"abcdef", "ghijkl"}, 12, 1, 4, "bcde"},
+			{[]string{"abcdef", "ghijkl"}, 12, 1, 6, "bcdefg"},
+			{[]string{"abcdef", "ghijkl"}, 12, 6, 4, "ghij"},
+			{[]string{"abcdef", "", "ghijkl"}, 12, 0, 12, "abcdefghijkl"},
+			{[]string{"abcdef", "ghijkl", "mnopqr"}, 18, 7, 7, "hijklmn"},
+			{[]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}, 12, 7, 3, "hij"},
+			{[]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}, 12, 0, 12, "abcdefghijkl"},
+		} {
+			var rangers []Ranger
+			for _, data := range example.data {
+				rangers = append(rangers, ByteRanger([]byte(data)))
+			}
+			rr := ConcatParallel(parallelism, rangers...)
+			assert.Equal(t, example.size, rr.Size())
+
+			r, err := rr.Range(context.Background(), example.offset, example.length)
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.Equal(t, example.substr, string(data), "parallelism %d", parallelism)
+		}
+	}
+}
+
+// countingRanger counts the ranges read concurrently
+type countingRanger struct {
+	Ranger
+	active, peak *int64
+	err          error
+}
+
+func (rr *countingRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	active := atomic.AddInt64(rr.active, 1)
+	for {
+		peak := atomic.LoadInt64(rr.peak)
+		if active <= peak || atomic.CompareAndSwapInt64(rr.peak, peak, active) {
+			break
+		}
+	}
+	defer atomic.AddInt64(rr.active, -1)
+
+	if rr.err != nil {
+		return nil, rr.err
+	}
+	return rr.Ranger.Range(ctx, offset, length)
+}
+
+func TestConcatParallelBounded(t *testing.T) {
+	var active, peak int64
+	var rangers []Ranger
+	var expected []byte
+	for i := 0; i < 20; i++ {
+		data := bytes.Repeat([]byte{byte(i)}, 100)
+		expected = append(expected, data...)
+		rangers = append(rangers, &countingRanger{Ranger: ByteRanger(data), active: &active, peak: &peak})
+	}
+
+	rr := ConcatParallel(3, rangers...)
+	r, err := rr.Range(context.Background(), 0, rr.Size())
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, expected, data)
+	assert.True(t, peak <= 3, "%d parts fetched concurrently", peak)
+
+	// a failed part fails the read
+	failure := errors.New("failure")
+	rangers[10] = &countingRanger{Ranger: rangers[10], active: &active, peak: &peak, err: failure}
+	rr = ConcatParallel(3, rangers...)
+	r, err = rr.Range(context.Background(), 0, rr.Size())
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(r)
+	assert.Equal(t, failure, err)
+	require.NoError(t, r.Close())
+}
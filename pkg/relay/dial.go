@@ -0,0 +1,55 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package relay
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"strings"
+	"time"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/storj"
+)
+
+// Dial connects to the node through the relay at address, the relay is
+// told who dials with the identity. The returned connection is spliced to
+// the node, any handshake happens over it.
+func Dial(ctx context.Context, ident *identity.FullIdentity, address string, nodeID storj.NodeID) (_ net.Conn, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tlsConfig, err := ident.ClientTLSConfig(storj.NodeID{})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	if err := writeLine(conn, cmdDial, nodeID.String()); err != nil {
+		_ = conn.Close()
+		return nil, Error.Wrap(err)
+	}
+
+	tlsConn := tls.Client(conn, tlsConfig)
+	line, err := readLine(tlsConn)
+	if err != nil {
+		_ = tlsConn.Close()
+		return nil, Error.Wrap(err)
+	}
+	if line != replyOK {
+		_ = tlsConn.Close()
+		return nil, Error.New("dialing %s: %s", nodeID, strings.TrimPrefix(line, replyError+" "))
+	}
+	_ = tlsConn.SetDeadline(time.Time{})
+
+	return tlsConn, nil
+}
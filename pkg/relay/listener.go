@@ -0,0 +1,219 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package relay
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/storj"
+)
+
+// Listener accepts connections both from a local listener and through a
+// relay, so a storage node serves the same way whether or not it can accept
+// inbound connections
+type Listener struct {
+	log      *zap.Logger
+	identity *identity.FullIdentity
+	local    net.Listener
+	config   Config
+
+	conns  chan net.Conn
+	errs   chan error
+	closed chan struct{}
+	once   sync.Once
+}
+
+// NewListener creates a listener accepting connections from local and
+// through the relay in config
+func NewListener(log *zap.Logger, identity *identity.FullIdentity, local net.Listener, config Config) *Listener {
+	listener := &Listener{
+		log:      log,
+		identity: identity,
+		local:    local,
+		config:   config,
+		conns:    make(chan net.Conn),
+		errs:     make(chan error, 1),
+		closed:   make(chan struct{}),
+	}
+	go listener.acceptLocal()
+	return listener
+}
+
+// acceptLocal forwards the connections of the local listener
+func (listener *Listener) acceptLocal() {
+	for {
+		conn, err := listener.local.Accept()
+		if err != nil {
+			listener.errs <- err
+			return
+		}
+		if !listener.deliver(conn) {
+			return
+		}
+	}
+}
+
+// deliver hands a connection to Accept, it returns false when the listener
+// has been closed
+func (listener *Listener) deliver(conn net.Conn) bool {
+	select {
+	case listener.conns <- conn:
+		return true
+	case <-listener.closed:
+		_ = conn.Close()
+		return false
+	}
+}
+
+// Accept waits for the next connection
+func (listener *Listener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-listener.conns:
+		return conn, nil
+	case err := <-listener.errs:
+		return nil, err
+	case <-listener.closed:
+		return nil, Error.New("listener closed")
+	}
+}
+
+// Close closes the listener
+func (listener *Listener) Close() (err error) {
+	listener.once.Do(func() {
+		close(listener.closed)
+		err = listener.local.Close()
+	})
+	return err
+}
+
+// Addr returns the address of the local listener
+func (listener *Listener) Addr() net.Addr { return listener.local.Addr() }
+
+// Run keeps a control connection to the relay until the context is canceled
+// or the listener is closed
+func (listener *Listener) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-listener.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		err := listener.control(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		listener.log.Warn("lost connection to relay", zap.String("relay", listener.config.Address), zap.Error(err))
+
+		timer := time.NewTimer(listener.config.ReconnectInterval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// control holds a single control connection to the relay
+func (listener *Listener) control(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tlsConfig, err := listener.identity.ClientTLSConfig(storj.NodeID{})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", listener.config.Address)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := writeLine(conn, cmdControl); err != nil {
+		_ = conn.Close()
+		return Error.Wrap(err)
+	}
+
+	tlsConn := tls.Client(conn, tlsConfig)
+	_ = tlsConn.SetDeadline(time.Now().Add(handshakeTimeout))
+	if err := tlsConn.Handshake(); err != nil {
+		_ = tlsConn.Close()
+		return Error.Wrap(err)
+	}
+	_ = tlsConn.SetDeadline(time.Time{})
+	listener.log.Info("accepting connections through relay", zap.String("relay", listener.config.Address))
+
+	// only the keep alive writes to the control connection
+	ping := func() error {
+		_ = tlsConn.SetWriteDeadline(time.Now().Add(handshakeTimeout))
+		return writeLine(tlsConn, cmdPing)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(listener.config.KeepAlive)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if ping() != nil {
+					_ = tlsConn.Close()
+					return
+				}
+			case <-ctx.Done():
+				_ = tlsConn.Close()
+				return
+			case <-done:
+				return
+			}
+		}
+	}()
+	defer func() { _ = tlsConn.Close() }()
+
+	for {
+		line, err := readLine(tlsConn)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != cmdConnect {
+			return Error.New("unexpected line from relay: %q", line)
+		}
+		go listener.connectBack(ctx, fields[1])
+	}
+}
+
+// connectBack connects to the relay for the dial of token and accepts the
+// connection
+func (listener *Listener) connectBack(ctx context.Context, token string) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", listener.config.Address)
+	if err != nil {
+		listener.log.Debug("connecting back to relay failed", zap.Error(err))
+		return
+	}
+	if err := writeLine(conn, cmdAccept, token); err != nil {
+		_ = conn.Close()
+		return
+	}
+	listener.deliver(conn)
+}
+
+// RelayAddress returns the address of the relay
+func (listener *Listener) RelayAddress() string { return listener.config.Address }
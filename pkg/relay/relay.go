@@ -0,0 +1,99 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package relay lets storage nodes which can't accept inbound connections
+// serve requests anyway.
+//
+// A node behind a firewall keeps a control connection to a relay, usually
+// run by a satellite. When someone dials the node through the relay, the
+// relay asks the node over the control connection to connect back and
+// splices both connections together. The relay only forwards bytes, TLS is
+// negotiated end to end between the dialer and the node.
+//
+// The protocol starts every connection to the relay with a single line:
+//
+//	CONTROL            the node's control connection, continued with TLS,
+//	                   the relay sends "CONNECT <token>" lines over it and
+//	                   the node sends "PING" lines to keep it alive
+//	DIAL <node id>     a dial of the node, continued with TLS so that the relay
+//	                   knows who dials, answered with "OK" or "ERROR <reason>"
+//	                   after which the TLS connection is spliced
+//	ACCEPT <token>     the node connecting back for the dial of the token
+package relay
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+)
+
+var (
+	// Error is the default relay errs class
+	Error = errs.Class("relay error")
+	mon   = monkit.Package()
+)
+
+const (
+	cmdControl = "CONTROL"
+	cmdDial    = "DIAL"
+	cmdAccept  = "ACCEPT"
+	cmdConnect = "CONNECT"
+	cmdPing    = "PING"
+	replyOK    = "OK"
+	replyError = "ERROR"
+
+	// maxLineLength limits the length of a protocol line
+	maxLineLength = 256
+	// handshakeTimeout limits how long the first line of a connection and
+	// the tls handshake of a control connection may take
+	handshakeTimeout = 10 * time.Second
+)
+
+// ServerConfig configures the relay run by a satellite, limits which are 0 are unlimited
+type ServerConfig struct {
+	Address     string        `help:"address the relay for storage nodes behind firewalls listens on, the relay is disabled when empty" default:""`
+	DialTimeout time.Duration `help:"how long a dial through the relay waits for the storage node to connect back" default:"10s"`
+	IdleTimeout time.Duration `help:"how long a control connection may stay silent before the storage node is considered gone" default:"2m0s"`
+
+	MaxConnections     int         `help:"how many relayed connections may be open at once, 0 is unlimited" default:"1000"`
+	MaxPeerConnections int         `help:"how many relayed connections a single dialer may have open at once, 0 is unlimited" default:"10"`
+	Bandwidth          memory.Size `help:"how many bytes per second all relayed connections may transfer together, 0 is unlimited" default:"100MB"`
+	PeerBandwidth      memory.Size `help:"how many bytes per second the relayed connections of a single dialer may transfer together, 0 is unlimited" default:"10MB"`
+}
+
+// Config configures how a storage node accepts connections through a relay
+type Config struct {
+	Address           string        `help:"address of the relay to accept connections through when the node can't accept inbound connections, the relay isn't used when empty" default:""`
+	KeepAlive         time.Duration `help:"how often the control connection to the relay is pinged" default:"30s"`
+	ReconnectInterval time.Duration `help:"how long to wait before reconnecting to the relay after losing the control connection" default:"5s"`
+}
+
+// readLine reads a single protocol line from r. It reads byte by byte, so
+// nothing after the line is consumed.
+func readLine(r io.Reader) (string, error) {
+	var line []byte
+	var b [1]byte
+	for {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return "", err
+		}
+		if b[0] == '\n' {
+			return string(line), nil
+		}
+		if len(line) >= maxLineLength {
+			return "", Error.New("line too long")
+		}
+		line = append(line, b[0])
+	}
+}
+
+// writeLine writes a single protocol line to w
+func writeLine(w io.Writer, fields ...string) error {
+	_, err := io.WriteString(w, strings.Join(fields, " ")+"\n")
+	return err
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package relay_test
+
+import (
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/relay"
+)
+
+func TestRelay(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	satellite, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+	storageNode, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+	uplink, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+	other, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	relayListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server, err := relay.NewServer(zaptest.NewLogger(t), satellite, relayListener, relay.ServerConfig{
+		DialTimeout: 5 * time.Second,
+		IdleTimeout: time.Minute,
+
+		MaxPeerConnections: 1,
+	})
+	require.NoError(t, err)
+	defer ctx.Check(server.Close)
+	ctx.Go(func() error {
+		_ = server.Run(ctx)
+		return nil
+	})
+
+	local, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	listener := relay.NewListener(zaptest.NewLogger(t), storageNode, local, relay.Config{
+		Address:           server.Addr().String(),
+		KeepAlive:         time.Second,
+		ReconnectInterval: 100 * time.Millisecond,
+	})
+	defer ctx.Check(listener.Close)
+	ctx.Go(func() error {
+		_ = listener.Run(ctx)
+		return nil
+	})
+
+	// the storage node echoes everything over tls
+	serverTLS, err := storageNode.ServerTLSConfig()
+	require.NoError(t, err)
+	ctx.Go(func() error {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return nil
+			}
+			go func() {
+				tlsConn := tls.Server(conn, serverTLS)
+				defer func() { _ = tlsConn.Close() }()
+				_, _ = io.Copy(tlsConn, tlsConn)
+			}()
+		}
+	})
+
+	// dialing fails until the storage node holds a control connection
+	var conn net.Conn
+	for i := 0; i < 50; i++ {
+		conn, err = relay.Dial(ctx, uplink, server.Addr().String(), storageNode.ID)
+		if err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.NoError(t, err)
+
+	clientTLS, err := uplink.ClientTLSConfig(storageNode.ID)
+	require.NoError(t, err)
+	tlsConn := tls.Client(conn, clientTLS)
+	defer ctx.Check(tlsConn.Close)
+
+	message := []byte("hello through the relay")
+	_, err = tlsConn.Write(message)
+	require.NoError(t, err)
+
+	received := make([]byte, len(message))
+	_, err = io.ReadFull(tlsConn, received)
+	require.NoError(t, err)
+	assert.Equal(t, string(message), string(received))
+
+	{ // a dialer can't exceed its connection limit
+		_, err := relay.Dial(ctx, uplink, server.Addr().String(), storageNode.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "too many connections")
+	}
+
+	{ // dials without a tls identity are refused
+		conn, err := net.Dial("tcp", server.Addr().String())
+		require.NoError(t, err)
+		_, err = conn.Write([]byte("DIAL " + storageNode.ID.String() + "\nnot a tls handshake\n"))
+		require.NoError(t, err)
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		reply, _ := ioutil.ReadAll(conn)
+		assert.NotContains(t, string(reply), "OK")
+		_ = conn.Close()
+	}
+
+	{ // the handshake fails when the node isn't the expected one
+		conn, err := relay.Dial(ctx, other, server.Addr().String(), storageNode.ID)
+		require.NoError(t, err)
+
+		clientTLS, err := other.ClientTLSConfig(other.ID)
+		require.NoError(t, err)
+		tlsConn := tls.Client(conn, clientTLS)
+		assert.Error(t, tlsConn.Handshake())
+		_ = tlsConn.Close()
+	}
+
+	{ // nodes without a control connection can't be dialed
+		_, err := relay.Dial(ctx, other, server.Addr().String(), uplink.ID)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,356 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package relay
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/storj"
+)
+
+// Server relays connections to the storage nodes which hold a control
+// connection to it
+type Server struct {
+	log       *zap.Logger
+	listener  net.Listener
+	tlsConfig *tls.Config
+	config    ServerConfig
+	limiter   *sync2.RateLimiter
+
+	mu          sync.Mutex
+	nodes       map[storj.NodeID]*control
+	pending     map[string]chan net.Conn
+	dialers     map[storj.NodeID]*dialer
+	connections int
+}
+
+// dialer are the relayed connections of a dialing peer
+type dialer struct {
+	connections int
+	limiter     *sync2.RateLimiter
+}
+
+// control is the control connection of a storage node
+type control struct {
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// send writes a line to the storage node
+func (c *control) send(fields ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(handshakeTimeout))
+	return writeLine(c.conn, fields...)
+}
+
+// NewServer creates a relay accepting connections from listener
+func NewServer(log *zap.Logger, full *identity.FullIdentity, listener net.Listener, config ServerConfig) (*Server, error) {
+	tlsConfig, err := full.ServerTLSConfig()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &Server{
+		log:       log,
+		listener:  listener,
+		tlsConfig: tlsConfig,
+		config:    config,
+		limiter:   sync2.NewRateLimiter(config.Bandwidth.Int64()),
+		nodes:     make(map[storj.NodeID]*control),
+		pending:   make(map[string]chan net.Conn),
+		dialers:   make(map[storj.NodeID]*dialer),
+	}, nil
+}
+
+// Addr returns the address the relay listens on
+func (server *Server) Addr() net.Addr { return server.listener.Addr() }
+
+// Run accepts and relays connections until the context is canceled
+func (server *Server) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = server.listener.Close()
+	}()
+
+	for {
+		conn, err := server.listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return Error.Wrap(err)
+		}
+		go server.handle(ctx, conn)
+	}
+}
+
+// Close closes the listener and the control connections
+func (server *Server) Close() error {
+	server.mu.Lock()
+	for id, c := range server.nodes {
+		_ = c.conn.Close()
+		delete(server.nodes, id)
+	}
+	server.mu.Unlock()
+
+	err := server.listener.Close()
+	if err != nil && strings.Contains(err.Error(), "use of closed network connection") {
+		return nil
+	}
+	return Error.Wrap(err)
+}
+
+// handle dispatches a new connection by its first line
+func (server *Server) handle(ctx context.Context, conn net.Conn) {
+	_ = conn.SetReadDeadline(time.Now().Add(handshakeTimeout))
+	line, err := readLine(conn)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+
+	fields := strings.Fields(line)
+	switch {
+	case len(fields) == 1 && fields[0] == cmdControl:
+		server.control(ctx, conn)
+	case len(fields) == 2 && fields[0] == cmdDial:
+		server.dial(ctx, conn, fields[1])
+	case len(fields) == 2 && fields[0] == cmdAccept:
+		server.accept(conn, fields[1])
+	default:
+		_ = writeLine(conn, replyError, "unknown command")
+		_ = conn.Close()
+	}
+}
+
+// control registers the storage node of a control connection and keeps it
+// registered until the connection is lost
+func (server *Server) control(ctx context.Context, conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	tlsConn := tls.Server(conn, server.tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		server.log.Debug("control handshake failed", zap.Error(err))
+		return
+	}
+	peer, err := identity.PeerIdentityFromChain(tlsConn.ConnectionState().PeerCertificates)
+	if err != nil {
+		server.log.Debug("control identity invalid", zap.Error(err))
+		return
+	}
+
+	c := &control{conn: tlsConn}
+	server.mu.Lock()
+	if previous, ok := server.nodes[peer.ID]; ok {
+		_ = previous.conn.Close()
+	}
+	server.nodes[peer.ID] = c
+	server.mu.Unlock()
+	server.log.Debug("storage node connected", zap.String("node", peer.ID.String()))
+
+	defer func() {
+		server.mu.Lock()
+		if server.nodes[peer.ID] == c {
+			delete(server.nodes, peer.ID)
+		}
+		server.mu.Unlock()
+		server.log.Debug("storage node disconnected", zap.String("node", peer.ID.String()))
+	}()
+
+	for {
+		_ = tlsConn.SetReadDeadline(time.Now().Add(server.config.IdleTimeout))
+		if _, err := readLine(tlsConn); err != nil {
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// dial asks the storage node to connect back and splices the connections,
+// the dialing peer has to identify itself with tls first
+func (server *Server) dial(ctx context.Context, rawConn net.Conn, nodeIDString string) {
+	defer mon.Task()(&ctx)(nil)
+
+	conn := tls.Server(rawConn, server.tlsConfig)
+	if err := conn.Handshake(); err != nil {
+		server.log.Debug("dial handshake failed", zap.Error(err))
+		_ = conn.Close()
+		return
+	}
+	peer, err := identity.PeerIdentityFromChain(conn.ConnectionState().PeerCertificates)
+	if err != nil {
+		server.log.Debug("dial identity invalid", zap.Error(err))
+		_ = conn.Close()
+		return
+	}
+
+	dialing, ok := server.acquire(peer.ID)
+	if !ok {
+		mon.Event("relay_dial_limited")
+		_ = writeLine(conn, replyError, "too many connections")
+		_ = conn.Close()
+		return
+	}
+	defer server.release(peer.ID)
+
+	nodeID, err := storj.NodeIDFromString(nodeIDString)
+	if err != nil {
+		_ = writeLine(conn, replyError, "invalid node id")
+		_ = conn.Close()
+		return
+	}
+
+	server.mu.Lock()
+	c, ok := server.nodes[nodeID]
+	server.mu.Unlock()
+	if !ok {
+		_ = writeLine(conn, replyError, "node not connected")
+		_ = conn.Close()
+		return
+	}
+
+	token, err := newToken()
+	if err != nil {
+		_ = writeLine(conn, replyError, "internal error")
+		_ = conn.Close()
+		return
+	}
+	accepted := make(chan net.Conn, 1)
+	server.mu.Lock()
+	server.pending[token] = accepted
+	server.mu.Unlock()
+
+	var nodeConn net.Conn
+	if err = c.send(cmdConnect, token); err == nil {
+		timer := time.NewTimer(server.config.DialTimeout)
+		select {
+		case nodeConn = <-accepted:
+		case <-timer.C:
+			err = Error.New("node didn't connect back")
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		timer.Stop()
+	}
+
+	server.mu.Lock()
+	delete(server.pending, token)
+	server.mu.Unlock()
+	if nodeConn == nil {
+		// the node may have connected back after the deadline
+		select {
+		case late := <-accepted:
+			_ = late.Close()
+		default:
+		}
+		server.log.Debug("relayed dial failed", zap.String("node", nodeID.String()), zap.Error(err))
+		_ = writeLine(conn, replyError, "node unreachable")
+		_ = conn.Close()
+		return
+	}
+
+	_ = conn.SetReadDeadline(time.Time{})
+	if err := writeLine(conn, replyOK); err != nil {
+		_ = conn.Close()
+		_ = nodeConn.Close()
+		return
+	}
+	splice(ctx, conn, nodeConn, server.limiter, dialing.limiter)
+}
+
+// acquire counts a relayed connection of the dialing peer, it returns false
+// when the peer or the relay reached the connection limit
+func (server *Server) acquire(peerID storj.NodeID) (*dialer, bool) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if server.config.MaxConnections > 0 && server.connections >= server.config.MaxConnections {
+		return nil, false
+	}
+	d, ok := server.dialers[peerID]
+	if !ok {
+		d = &dialer{limiter: sync2.NewRateLimiter(server.config.PeerBandwidth.Int64())}
+		server.dialers[peerID] = d
+	}
+	if server.config.MaxPeerConnections > 0 && d.connections >= server.config.MaxPeerConnections {
+		return nil, false
+	}
+
+	d.connections++
+	server.connections++
+	return d, true
+}
+
+// release stops counting a relayed connection of the dialing peer
+func (server *Server) release(peerID storj.NodeID) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	d := server.dialers[peerID]
+	d.connections--
+	server.connections--
+	if d.connections == 0 {
+		delete(server.dialers, peerID)
+	}
+}
+
+// accept hands the connection of a storage node over to the dial waiting
+// for it
+func (server *Server) accept(conn net.Conn, token string) {
+	server.mu.Lock()
+	accepted, ok := server.pending[token]
+	delete(server.pending, token)
+	server.mu.Unlock()
+	if !ok {
+		_ = conn.Close()
+		return
+	}
+
+	_ = conn.SetReadDeadline(time.Time{})
+	accepted <- conn
+}
+
+// newToken returns a random token identifying a dial
+func newToken() (string, error) {
+	var token [16]byte
+	if _, err := rand.Read(token[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token[:]), nil
+}
+
+// splice copies between a and b until either side is closed, both
+// directions are limited to the rate of every limiter
+func splice(ctx context.Context, a, b net.Conn, limiters ...*sync2.RateLimiter) {
+	copyAndClose := func(dst, src net.Conn) {
+		var r io.Reader = src
+		for _, limiter := range limiters {
+			r = sync2.LimitReader(ctx, limiter, r)
+		}
+		_, _ = io.Copy(dst, r)
+		_ = dst.Close()
+		_ = src.Close()
+	}
+	go copyAndClose(a, b)
+	copyAndClose(b, a)
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"github.com/zeebo/errs"
+)
+
+// Error is the default reputation errs class
+var Error = errs.Class("reputation error")
+
+// Config contains the parameters of the audit and uptime reputation models
+type Config struct {
+	AuditAlpha0 float64 `help:"the initial shape 'alpha' of the audit reputation" default:"1"`
+	AuditBeta0  float64 `help:"the initial shape 'beta' of the audit reputation" default:"0"`
+	AuditLambda float64 `help:"the forgetting factor applied to past audits, 1 never forgets" default:"0.95"`
+	AuditWeight float64 `help:"the weight of a single audit outcome" default:"1"`
+	AuditDQ     float64 `help:"the audit score below which a node is disqualified" default:"0.6"`
+
+	UptimeAlpha0 float64 `help:"the initial shape 'alpha' of the uptime reputation" default:"1"`
+	UptimeBeta0  float64 `help:"the initial shape 'beta' of the uptime reputation" default:"0"`
+	UptimeLambda float64 `help:"the forgetting factor applied to past uptime checks, 1 never forgets" default:"0.99"`
+	UptimeWeight float64 `help:"the weight of a single uptime check outcome" default:"1"`
+	UptimeDQ     float64 `help:"the uptime score below which a node is disqualified" default:"0.6"`
+
+	Selection SelectionConfig
+}
+
+// Audit returns the model used for audit outcomes
+func (config Config) Audit() Model {
+	return Model{
+		Alpha0: config.AuditAlpha0,
+		Beta0:  config.AuditBeta0,
+		Lambda: config.AuditLambda,
+		Weight: config.AuditWeight,
+		DQ:     config.AuditDQ,
+	}
+}
+
+// Uptime returns the model used for uptime check outcomes
+func (config Config) Uptime() Model {
+	return Model{
+		Alpha0: config.UptimeAlpha0,
+		Beta0:  config.UptimeBeta0,
+		Lambda: config.UptimeLambda,
+		Weight: config.UptimeWeight,
+		DQ:     config.UptimeDQ,
+	}
+}
+
+// Verify checks whether both models and the selection parameters are usable
+func (config Config) Verify() error {
+	return errs.Combine(
+		config.Audit().Verify("audit"),
+		config.Uptime().Verify("uptime"),
+		config.Selection.Verify(),
+	)
+}
+
+// Reputation is the state of a beta distribution describing the
+// likelihood of a node succeeding
+type Reputation struct {
+	Alpha float64
+	Beta  float64
+}
+
+// Model updates and scores reputations.
+//
+// On every outcome both shapes decay by Lambda, then Weight is added to
+// alpha on success or to beta on failure. The score is the mean of the
+// distribution, alpha / (alpha + beta).
+type Model struct {
+	Alpha0 float64
+	Beta0  float64
+	Lambda float64
+	Weight float64
+	DQ     float64
+}
+
+// Verify checks whether the model parameters are in range
+func (model Model) Verify(name string) error {
+	switch {
+	case model.Alpha0 < 0 || model.Beta0 < 0:
+		return Error.New("%s: initial shapes can't be negative", name)
+	case model.Alpha0+model.Beta0 <= 0:
+		return Error.New("%s: initial shapes can't both be zero", name)
+	case model.Lambda <= 0 || model.Lambda > 1:
+		return Error.New("%s: lambda must be in (0, 1], got %v", name, model.Lambda)
+	case model.Weight <= 0:
+		return Error.New("%s: weight must be positive, got %v", name, model.Weight)
+	case model.DQ < 0 || model.DQ > 1:
+		return Error.New("%s: disqualification score must be in [0, 1], got %v", name, model.DQ)
+	}
+	return nil
+}
+
+// Initial returns the reputation of a node without any history
+func (model Model) Initial() Reputation {
+	return Reputation{Alpha: model.Alpha0, Beta: model.Beta0}
+}
+
+// Update returns rep after recording a single outcome.
+// A reputation without any history starts from the initial shapes.
+func (model Model) Update(rep Reputation, success bool) Reputation {
+	if rep.Alpha+rep.Beta <= 0 {
+		rep = model.Initial()
+	}
+
+	rep.Alpha *= model.Lambda
+	rep.Beta *= model.Lambda
+	if success {
+		rep.Alpha += model.Weight
+	} else {
+		rep.Beta += model.Weight
+	}
+	return rep
+}
+
+// Score returns the expected success rate for rep.
+// A reputation without any history is scored by the initial shapes.
+func (model Model) Score(rep Reputation) float64 {
+	if rep.Alpha+rep.Beta <= 0 {
+		rep = model.Initial()
+	}
+	if rep.Alpha+rep.Beta <= 0 {
+		return 0
+	}
+	return rep.Alpha / (rep.Alpha + rep.Beta)
+}
+
+// Disqualified returns whether rep scores below the disqualification threshold
+func (model Model) Disqualified(rep Reputation) bool {
+	return model.Score(rep) < model.DQ
+}
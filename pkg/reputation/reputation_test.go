@@ -0,0 +1,79 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/pkg/reputation"
+)
+
+var testModel = reputation.Model{
+	Alpha0: 1,
+	Beta0:  0,
+	Lambda: 0.5,
+	Weight: 1,
+	DQ:     0.6,
+}
+
+func TestUpdate(t *testing.T) {
+	rep := testModel.Update(reputation.Reputation{}, true)
+	assert.Equal(t, reputation.Reputation{Alpha: 1.5, Beta: 0}, rep)
+
+	rep = testModel.Update(rep, false)
+	assert.Equal(t, reputation.Reputation{Alpha: 0.75, Beta: 1}, rep)
+
+	rep = testModel.Update(rep, false)
+	assert.Equal(t, reputation.Reputation{Alpha: 0.375, Beta: 1.5}, rep)
+}
+
+func TestScore(t *testing.T) {
+	for i, tt := range []struct {
+		rep          reputation.Reputation
+		score        float64
+		disqualified bool
+	}{
+		{reputation.Reputation{}, 1, false},
+		{reputation.Reputation{Alpha: 3, Beta: 1}, 0.75, false},
+		{reputation.Reputation{Alpha: 3, Beta: 2}, 0.6, false},
+		{reputation.Reputation{Alpha: 1, Beta: 3}, 0.25, true},
+		{reputation.Reputation{Alpha: 0, Beta: 2}, 0, true},
+	} {
+		assert.Equal(t, tt.score, testModel.Score(tt.rep), i)
+		assert.Equal(t, tt.disqualified, testModel.Disqualified(tt.rep), i)
+	}
+}
+
+func TestForgetting(t *testing.T) {
+	rep := testModel.Initial()
+	for i := 0; i < 10; i++ {
+		rep = testModel.Update(rep, false)
+	}
+	assert.True(t, testModel.Disqualified(rep))
+
+	// with lambda below one, recent successes outweigh old failures
+	for i := 0; i < 10; i++ {
+		rep = testModel.Update(rep, true)
+	}
+	assert.False(t, testModel.Disqualified(rep))
+}
+
+func TestVerify(t *testing.T) {
+	assert.NoError(t, testModel.Verify("test"))
+
+	for i, model := range []reputation.Model{
+		{Alpha0: -1, Beta0: 1, Lambda: 1, Weight: 1, DQ: 0.5},
+		{Alpha0: 0, Beta0: 0, Lambda: 1, Weight: 1, DQ: 0.5},
+		{Alpha0: 1, Beta0: 0, Lambda: 0, Weight: 1, DQ: 0.5},
+		{Alpha0: 1, Beta0: 0, Lambda: 1.1, Weight: 1, DQ: 0.5},
+		{Alpha0: 1, Beta0: 0, Lambda: 1, Weight: 0, DQ: 0.5},
+		{Alpha0: 1, Beta0: 0, Lambda: 1, Weight: 1, DQ: 1.5},
+	} {
+		assert.Error(t, model.Verify("test"), i)
+	}
+
+	assert.Error(t, reputation.Config{}.Verify())
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// SelectionConfig contains the reputation requirements and preferences for
+// selecting nodes to store pieces on
+type SelectionConfig struct {
+	MinAuditScore      float64 `help:"the minimum audit reputation score of selected nodes, never lower than the audit disqualification score" default:"0.6"`
+	MinUptimeScore     float64 `help:"the minimum uptime reputation score of selected nodes, never lower than the uptime disqualification score" default:"0.6"`
+	VettingAuditCount  int64   `help:"the number of audits a node must pass before it is vetted, unvetted nodes are only selected as new nodes" default:"0"`
+	PreferenceExponent float64 `help:"how strongly nodes with higher reputation scores are preferred, 0 selects eligible nodes uniformly" default:"1"`
+	Oversampling       float64 `help:"how many times the requested number of eligible nodes is considered when preferring nodes with higher scores" default:"2"`
+}
+
+// Verify checks whether the selection parameters are in range
+func (config SelectionConfig) Verify() error {
+	switch {
+	case config.MinAuditScore < 0 || config.MinAuditScore > 1:
+		return Error.New("selection: minimum audit score must be in [0, 1], got %v", config.MinAuditScore)
+	case config.MinUptimeScore < 0 || config.MinUptimeScore > 1:
+		return Error.New("selection: minimum uptime score must be in [0, 1], got %v", config.MinUptimeScore)
+	case config.VettingAuditCount < 0:
+		return Error.New("selection: vetting audit count can't be negative, got %d", config.VettingAuditCount)
+	case config.PreferenceExponent < 0:
+		return Error.New("selection: preference exponent can't be negative, got %v", config.PreferenceExponent)
+	case config.Oversampling < 0:
+		return Error.New("selection: oversampling can't be negative, got %v", config.Oversampling)
+	}
+	return nil
+}
+
+// Service combines the audit score, the uptime score and the vetting status
+// of nodes into the criteria and preferences used for selecting nodes
+type Service struct {
+	Config
+}
+
+// NewService creates a reputation service
+func NewService(config Config) *Service {
+	return &Service{Config: config}
+}
+
+// MinAuditScore returns the minimum audit score of selectable nodes
+func (service *Service) MinAuditScore() float64 {
+	return math.Max(service.Selection.MinAuditScore, service.AuditDQ)
+}
+
+// MinUptimeScore returns the minimum uptime score of selectable nodes
+func (service *Service) MinUptimeScore() float64 {
+	return math.Max(service.Selection.MinUptimeScore, service.UptimeDQ)
+}
+
+// VettingAuditCount returns the number of audits after which a node is
+// vetted, threshold raises it for a single selection
+func (service *Service) VettingAuditCount(threshold int64) int64 {
+	if threshold > service.Selection.VettingAuditCount {
+		return threshold
+	}
+	return service.Selection.VettingAuditCount
+}
+
+// Eligible returns whether a node meets the minimum reputation scores
+func (service *Service) Eligible(stats *pb.NodeStats) bool {
+	return stats.GetAuditReputationScore() >= service.MinAuditScore() &&
+		stats.GetUptimeReputationScore() >= service.MinUptimeScore()
+}
+
+// Standing returns the status of a node and the reason for it. Nodes below
+// a disqualification score are disqualified, nodes only below the minimum
+// scores of selected nodes are suspended and get no new pieces.
+func (service *Service) Standing(stats *pb.NodeStats) (status pb.NodeStanding_Status, reason string) {
+	audit, uptime := stats.GetAuditReputationScore(), stats.GetUptimeReputationScore()
+	switch {
+	case audit < service.AuditDQ:
+		return pb.NodeStanding_DISQUALIFIED, fmt.Sprintf("audit score %.3f is below the disqualification score %.3f", audit, service.AuditDQ)
+	case uptime < service.UptimeDQ:
+		return pb.NodeStanding_DISQUALIFIED, fmt.Sprintf("uptime score %.3f is below the disqualification score %.3f", uptime, service.UptimeDQ)
+	case audit < service.MinAuditScore():
+		return pb.NodeStanding_SUSPENDED, fmt.Sprintf("audit score %.3f is below the minimum score %.3f of nodes selected for uploads", audit, service.MinAuditScore())
+	case uptime < service.MinUptimeScore():
+		return pb.NodeStanding_SUSPENDED, fmt.Sprintf("uptime score %.3f is below the minimum score %.3f of nodes selected for uploads", uptime, service.MinUptimeScore())
+	}
+	return pb.NodeStanding_GOOD, ""
+}
+
+// Vetted returns whether a node has passed enough audits to be selected as
+// a reputable node
+func (service *Service) Vetted(stats *pb.NodeStats, threshold int64) bool {
+	return stats.GetAuditCount() >= service.VettingAuditCount(threshold)
+}
+
+// Preference returns how strongly a node is preferred, the product of its
+// audit and uptime scores raised to the preference exponent
+func (service *Service) Preference(stats *pb.NodeStats) float64 {
+	score := stats.GetAuditReputationScore() * stats.GetUptimeReputationScore()
+	if score <= 0 {
+		return 0
+	}
+	return math.Pow(score, service.Selection.PreferenceExponent)
+}
+
+// Candidates returns how many eligible nodes should be considered to
+// select count nodes
+func (service *Service) Candidates(count int) int {
+	if service.Selection.PreferenceExponent == 0 || service.Selection.Oversampling <= 1 {
+		return count
+	}
+	return int(math.Ceil(float64(count) * service.Selection.Oversampling))
+}
+
+// Prefer selects count of the candidates at random, weighted by their
+// preference. The candidates are expected to be in random order.
+func (service *Service) Prefer(candidates []*pb.Node, count int) []*pb.Node {
+	if count >= len(candidates) {
+		return candidates
+	}
+	if service.Selection.PreferenceExponent == 0 {
+		return candidates[:count]
+	}
+
+	// weighted sampling without replacement, every candidate draws the key
+	// u^(1/weight) and the candidates with the largest keys are selected
+	type keyed struct {
+		node *pb.Node
+		key  float64
+	}
+	keys := make([]keyed, 0, len(candidates))
+	for _, node := range candidates {
+		key := 0.0
+		if weight := service.Preference(node.GetReputation()); weight > 0 {
+			key = math.Pow(rand.Float64(), 1/weight)
+		}
+		keys = append(keys, keyed{node: node, key: key})
+	}
+	sort.SliceStable(keys, func(i, k int) bool {
+		return keys[i].key > keys[k].key
+	})
+
+	selected := make([]*pb.Node, 0, count)
+	for _, keyed := range keys[:count] {
+		selected = append(selected, keyed.node)
+	}
+	return selected
+}
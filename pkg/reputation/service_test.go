@@ -0,0 +1,121 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/reputation"
+)
+
+func TestSelectionCriteria(t *testing.T) {
+	service := reputation.NewService(reputation.Config{
+		AuditDQ:  0.6,
+		UptimeDQ: 0.5,
+		Selection: reputation.SelectionConfig{
+			MinAuditScore:     0.4,
+			MinUptimeScore:    0.8,
+			VettingAuditCount: 10,
+		},
+	})
+
+	// the minimum scores are never below the disqualification scores
+	assert.Equal(t, 0.6, service.MinAuditScore())
+	assert.Equal(t, 0.8, service.MinUptimeScore())
+
+	assert.True(t, service.Eligible(&pb.NodeStats{AuditReputationScore: 0.6, UptimeReputationScore: 0.8}))
+	assert.False(t, service.Eligible(&pb.NodeStats{AuditReputationScore: 0.5, UptimeReputationScore: 0.9}))
+	assert.False(t, service.Eligible(&pb.NodeStats{AuditReputationScore: 0.9, UptimeReputationScore: 0.7}))
+	assert.False(t, service.Eligible(nil))
+
+	// the vetting audit count can be raised for a single selection
+	assert.Equal(t, int64(10), service.VettingAuditCount(5))
+	assert.Equal(t, int64(20), service.VettingAuditCount(20))
+	assert.True(t, service.Vetted(&pb.NodeStats{AuditCount: 10}, 0))
+	assert.False(t, service.Vetted(&pb.NodeStats{AuditCount: 10}, 11))
+}
+
+func TestStanding(t *testing.T) {
+	service := reputation.NewService(reputation.Config{
+		AuditDQ:  0.6,
+		UptimeDQ: 0.5,
+		Selection: reputation.SelectionConfig{
+			MinAuditScore:  0.7,
+			MinUptimeScore: 0.8,
+		},
+	})
+
+	for _, test := range []struct {
+		audit, uptime float64
+		status        pb.NodeStanding_Status
+	}{
+		{0.9, 0.9, pb.NodeStanding_GOOD},
+		{0.7, 0.8, pb.NodeStanding_GOOD},
+		{0.65, 0.9, pb.NodeStanding_SUSPENDED},
+		{0.9, 0.7, pb.NodeStanding_SUSPENDED},
+		{0.5, 0.9, pb.NodeStanding_DISQUALIFIED},
+		{0.9, 0.4, pb.NodeStanding_DISQUALIFIED},
+	} {
+		status, reason := service.Standing(&pb.NodeStats{AuditReputationScore: test.audit, UptimeReputationScore: test.uptime})
+		assert.Equal(t, test.status, status, "audit %v, uptime %v", test.audit, test.uptime)
+		assert.Equal(t, test.status == pb.NodeStanding_GOOD, reason == "", reason)
+	}
+}
+
+func TestSelectionVerify(t *testing.T) {
+	assert.NoError(t, reputation.SelectionConfig{PreferenceExponent: 1, Oversampling: 2}.Verify())
+
+	for i, config := range []reputation.SelectionConfig{
+		{MinAuditScore: -0.1},
+		{MinAuditScore: 1.1},
+		{MinUptimeScore: 2},
+		{VettingAuditCount: -1},
+		{PreferenceExponent: -1},
+		{Oversampling: -1},
+	} {
+		assert.Error(t, config.Verify(), i)
+	}
+}
+
+func TestPrefer(t *testing.T) {
+	node := func(audit, uptime float64) *pb.Node {
+		return &pb.Node{Reputation: &pb.NodeStats{AuditReputationScore: audit, UptimeReputationScore: uptime}}
+	}
+
+	{ // without a preference the first candidates are selected
+		service := reputation.NewService(reputation.Config{})
+		candidates := []*pb.Node{node(1, 1), node(0.7, 0.7), node(0.9, 0.9)}
+
+		assert.Equal(t, 3, service.Candidates(3))
+		assert.Equal(t, candidates[:2], service.Prefer(candidates, 2))
+		assert.Equal(t, candidates, service.Prefer(candidates, 5))
+	}
+
+	{ // with a steep preference curve higher scores are selected more often
+		service := reputation.NewService(reputation.Config{
+			Selection: reputation.SelectionConfig{PreferenceExponent: 8, Oversampling: 2},
+		})
+		assert.Equal(t, 6, service.Candidates(3))
+		assert.InDelta(t, 0.00390625, service.Preference(node(0.5, 1).Reputation), 1e-9)
+		assert.InDelta(t, 0.00390625*0.00390625, service.Preference(node(0.5, 0.5).Reputation), 1e-9)
+		assert.Equal(t, 0.0, service.Preference(node(0, 1).Reputation))
+
+		good, bad := node(1, 1), node(0.6, 0.6)
+		var goodSelected, badSelected int
+		for i := 0; i < 1000; i++ {
+			selected := service.Prefer([]*pb.Node{bad, good}, 1)
+			require.Len(t, selected, 1)
+			if selected[0] == good {
+				goodSelected++
+			} else {
+				badSelected++
+			}
+		}
+		assert.True(t, goodSelected > 10*badSelected, "good %d, bad %d", goodSelected, badSelected)
+	}
+}
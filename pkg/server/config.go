@@ -6,6 +6,7 @@ package server
 import (
 	"context"
 	"net"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -22,6 +23,11 @@ type Config struct {
 	UsePeerCAWhitelist  bool   `help:"if true, uses peer ca whitelist checking" default:"false"`
 	Address             string `user:"true" help:"address to listen on" default:":7777"`
 	Extensions          peertls.TLSExtConfig
+
+	DenylistPath           string        `help:"path to a file of node IDs and IP ranges (CIDR) refused by the server, one per line, changes are applied without restarting"`
+	DenylistReloadInterval time.Duration `help:"how frequently the denylist file is checked for changes" default:"10s"`
+
+	ConnectionLimit ConnLimitConfig
 }
 
 // Run will run the given responsibilities with the configured identity.
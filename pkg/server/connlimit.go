@@ -0,0 +1,205 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrConnLimited is the class of errors for connections over the per IP limits
+var ErrConnLimited = errs.Class("connection limited")
+
+// maxConnLimitSources is how many IP addresses are tracked before the idle ones are pruned
+const maxConnLimitSources = 4096
+
+// ConnLimitConfig limits the connections peers can open to the server
+type ConnLimitConfig struct {
+	MaxPerIP  int     `help:"maximum open connections from a single IP address, 0 disables the limit" default:"256"`
+	Rate      float64 `help:"new connections per second each IP address may open, 0 disables the limit" default:"16"`
+	Burst     int     `help:"new connections each IP address may open at once" default:"64"`
+	Allowlist string  `help:"comma-separated IP addresses and ranges (CIDR) exempt from the connection limits" default:"127.0.0.0/8,::1"`
+}
+
+// Enabled returns whether any of the limits is enabled
+func (config ConnLimitConfig) Enabled() bool {
+	return config.MaxPerIP > 0 || config.Rate > 0
+}
+
+// ConnLimiter caps the open connections and limits the rate of new
+// connections of each IP address. It's applied to the accepted connections
+// before the TLS handshake, so flooding peers don't cost any crypto work.
+type ConnLimiter struct {
+	config    ConnLimitConfig
+	allowlist []*net.IPNet
+	now       func() time.Time
+
+	mu      sync.Mutex
+	open    map[string]int
+	sources map[string]*tokenBucket
+}
+
+// tokenBucket are the connections a source may still open
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// NewConnLimiter creates a connection limiter
+func NewConnLimiter(config ConnLimitConfig) (*ConnLimiter, error) {
+	var allowlist []*net.IPNet
+	for _, entry := range strings.Split(config.Allowlist, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		network, ok := parseNetwork(entry)
+		if !ok {
+			return nil, Error.New("invalid connection limit allowlist entry %q", entry)
+		}
+		allowlist = append(allowlist, network)
+	}
+
+	return &ConnLimiter{
+		config:    config,
+		allowlist: allowlist,
+		now:       time.Now,
+		open:      make(map[string]int),
+		sources:   make(map[string]*tokenBucket),
+	}, nil
+}
+
+// Allows returns whether the IP address is exempt from the limits
+func (limiter *ConnLimiter) Allows(ip net.IP) bool {
+	for _, network := range limiter.allowlist {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Acquire reserves a connection of the IP address of addr, release must be
+// called once the connection is closed
+func (limiter *ConnLimiter) Acquire(addr net.Addr) (release func(), err error) {
+	ip := addrIP(addr)
+	if ip == nil || limiter.Allows(ip) {
+		return func() {}, nil
+	}
+	source := ip.String()
+	now := limiter.now()
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if limiter.config.MaxPerIP > 0 && limiter.open[source] >= limiter.config.MaxPerIP {
+		mon.Meter("connlimit_connections_refused_max").Mark(1)
+		return nil, ErrConnLimited.New("%s has %d open connections", source, limiter.open[source])
+	}
+	if !limiter.take(source, now) {
+		mon.Meter("connlimit_connections_refused_rate").Mark(1)
+		return nil, ErrConnLimited.New("%s opens connections too fast", source)
+	}
+
+	limiter.open[source]++
+	mon.Meter("connlimit_connections_accepted").Mark(1)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { limiter.release(source) })
+	}, nil
+}
+
+// take takes a token of source, it fails when there are none left
+func (limiter *ConnLimiter) take(source string, now time.Time) bool {
+	if limiter.config.Rate <= 0 {
+		return true
+	}
+
+	bucket, ok := limiter.sources[source]
+	if !ok {
+		if len(limiter.sources) >= maxConnLimitSources {
+			limiter.prune(now)
+		}
+		bucket = &tokenBucket{tokens: float64(limiter.config.Burst), updated: now}
+		limiter.sources[source] = bucket
+	}
+
+	bucket.tokens += now.Sub(bucket.updated).Seconds() * limiter.config.Rate
+	if bucket.tokens > float64(limiter.config.Burst) {
+		bucket.tokens = float64(limiter.config.Burst)
+	}
+	bucket.updated = now
+
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// release frees a connection of source
+func (limiter *ConnLimiter) release(source string) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.open[source]--
+	if limiter.open[source] <= 0 {
+		delete(limiter.open, source)
+	}
+}
+
+// prune forgets the sources which have been idle long enough to have a full bucket again
+func (limiter *ConnLimiter) prune(now time.Time) {
+	refill := time.Duration(float64(limiter.config.Burst) / limiter.config.Rate * float64(time.Second))
+	for source, bucket := range limiter.sources {
+		if now.Sub(bucket.updated) >= refill {
+			delete(limiter.sources, source)
+		}
+	}
+}
+
+// Listener wraps lis to close the connections over the limits as soon as
+// they are accepted
+func (limiter *ConnLimiter) Listener(lis net.Listener) net.Listener {
+	return &connLimitListener{Listener: lis, limiter: limiter}
+}
+
+// connLimitListener closes accepted connections over the limits
+type connLimitListener struct {
+	net.Listener
+	limiter *ConnLimiter
+}
+
+// Accept waits for the next connection within the limits
+func (lis *connLimitListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := lis.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		release, err := lis.limiter.Acquire(conn.RemoteAddr())
+		if err != nil {
+			_ = conn.Close()
+			continue
+		}
+		return &limitedConn{Conn: conn, release: release}, nil
+	}
+}
+
+// limitedConn releases its reservation when it's closed
+type limitedConn struct {
+	net.Conn
+	release func()
+}
+
+// Close closes the connection and releases its reservation
+func (conn *limitedConn) Close() error {
+	defer conn.release()
+	return conn.Conn.Close()
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/server"
+)
+
+func TestConnLimiterMaxPerIP(t *testing.T) {
+	limiter, err := server.NewConnLimiter(server.ConnLimitConfig{MaxPerIP: 2})
+	require.NoError(t, err)
+
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1000}
+	other := &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 1000}
+
+	release1, err := limiter.Acquire(addr)
+	require.NoError(t, err)
+	_, err = limiter.Acquire(addr)
+	require.NoError(t, err)
+
+	_, err = limiter.Acquire(addr)
+	assert.True(t, server.ErrConnLimited.Has(err))
+
+	// other addresses have their own limit
+	_, err = limiter.Acquire(other)
+	assert.NoError(t, err)
+
+	// releasing twice frees a single connection
+	release1()
+	release1()
+	_, err = limiter.Acquire(addr)
+	assert.NoError(t, err)
+	_, err = limiter.Acquire(addr)
+	assert.True(t, server.ErrConnLimited.Has(err))
+}
+
+func TestConnLimiterRate(t *testing.T) {
+	limiter, err := server.NewConnLimiter(server.ConnLimitConfig{Rate: 0.001, Burst: 2})
+	require.NoError(t, err)
+
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1000}
+	for i := 0; i < 2; i++ {
+		release, err := limiter.Acquire(addr)
+		require.NoError(t, err)
+		release()
+	}
+
+	// closed connections don't return tokens
+	_, err = limiter.Acquire(addr)
+	assert.True(t, server.ErrConnLimited.Has(err))
+}
+
+func TestConnLimiterAllowlist(t *testing.T) {
+	_, err := server.NewConnLimiter(server.ConnLimitConfig{Allowlist: "not an address"})
+	assert.Error(t, err)
+
+	limiter, err := server.NewConnLimiter(server.ConnLimitConfig{
+		MaxPerIP:  1,
+		Allowlist: "127.0.0.0/8, 10.1.2.3",
+	})
+	require.NoError(t, err)
+
+	assert.True(t, limiter.Allows(net.ParseIP("127.0.0.1")))
+	assert.True(t, limiter.Allows(net.ParseIP("10.1.2.3")))
+	assert.False(t, limiter.Allows(net.ParseIP("10.1.2.4")))
+
+	for i := 0; i < 3; i++ {
+		_, err := limiter.Acquire(&net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 1000})
+		assert.NoError(t, err)
+	}
+}
+
+func TestConnLimiterListener(t *testing.T) {
+	limiter, err := server.NewConnLimiter(server.ConnLimitConfig{MaxPerIP: 1})
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	limited := limiter.Listener(lis)
+	defer func() { _ = limited.Close() }()
+
+	accepted := make(chan net.Conn, 2)
+	go func() {
+		for {
+			conn, err := limited.Accept()
+			if err != nil {
+				close(accepted)
+				return
+			}
+			accepted <- conn
+		}
+	}()
+
+	first, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	defer func() { _ = first.Close() }()
+	firstAccepted := <-accepted
+
+	// the second connection is closed by the server
+	second, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	_, err = second.Read(make([]byte, 1))
+	assert.Error(t, err)
+	_ = second.Close()
+
+	// closing the first connection frees the slot
+	require.NoError(t, firstAccepted.Close())
+	third, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	defer func() { _ = third.Close() }()
+	assert.NotNil(t, <-accepted)
+}
@@ -0,0 +1,293 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/peertls"
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrDenied is the class of errors for peers on the denylist
+var ErrDenied = errs.Class("denied peer")
+
+// Denylist refuses connections and requests of the node IDs and IP ranges
+// listed in a file. The file is reloaded when it changes, so malicious peers
+// can be cut off without restarting the server.
+//
+// The file contains one entry per line, either a node ID, an IP address or
+// an IP range in CIDR notation. Empty lines and lines starting with # are ignored.
+type Denylist struct {
+	log  *zap.Logger
+	path string
+	Loop sync2.Cycle
+
+	mu       sync.RWMutex
+	modTime  time.Time
+	ids      map[storj.NodeID]struct{}
+	networks []*net.IPNet
+}
+
+// NewDenylist loads the denylist at path, which is checked for changes every interval
+func NewDenylist(log *zap.Logger, path string, interval time.Duration) (*Denylist, error) {
+	if interval <= 0 {
+		return nil, Error.New("denylist reload interval must be positive, got %v", interval)
+	}
+
+	denylist := &Denylist{
+		log:  log,
+		path: path,
+		ids:  make(map[storj.NodeID]struct{}),
+	}
+	if _, err := denylist.Reload(); err != nil {
+		return nil, err
+	}
+	denylist.Loop.SetInterval(interval)
+	return denylist, nil
+}
+
+// Run reloads the denylist whenever the file changes, failing reloads keep
+// the previously loaded entries
+func (denylist *Denylist) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return denylist.Loop.Run(ctx, func(ctx context.Context) error {
+		reloaded, err := denylist.Reload()
+		if err != nil {
+			denylist.log.Error("reloading denylist", zap.String("path", denylist.path), zap.Error(err))
+			return nil
+		}
+		if reloaded {
+			ids, networks := denylist.Count()
+			denylist.log.Info("reloaded denylist", zap.Int("node ids", ids), zap.Int("ip ranges", networks))
+		}
+		return nil
+	})
+}
+
+// Close stops reloading the denylist
+func (denylist *Denylist) Close() error {
+	denylist.Loop.Close()
+	return nil
+}
+
+// Reload reads the file again when it has been modified since it was last loaded
+func (denylist *Denylist) Reload() (reloaded bool, err error) {
+	info, err := os.Stat(denylist.path)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	denylist.mu.RLock()
+	unchanged := info.ModTime().Equal(denylist.modTime)
+	denylist.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	data, err := ioutil.ReadFile(denylist.path)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	ids, networks, err := parseDenylist(data)
+	if err != nil {
+		return false, err
+	}
+
+	denylist.mu.Lock()
+	denylist.modTime = info.ModTime()
+	denylist.ids = ids
+	denylist.networks = networks
+	denylist.mu.Unlock()
+	return true, nil
+}
+
+// Count returns the number of denied node IDs and IP ranges
+func (denylist *Denylist) Count() (ids, networks int) {
+	denylist.mu.RLock()
+	defer denylist.mu.RUnlock()
+	return len(denylist.ids), len(denylist.networks)
+}
+
+// DeniesID returns whether the node ID is denied
+func (denylist *Denylist) DeniesID(id storj.NodeID) bool {
+	denylist.mu.RLock()
+	defer denylist.mu.RUnlock()
+	_, denied := denylist.ids[id]
+	return denied
+}
+
+// DeniesIP returns whether the IP address is in a denied range
+func (denylist *Denylist) DeniesIP(ip net.IP) bool {
+	denylist.mu.RLock()
+	defer denylist.mu.RUnlock()
+	for _, network := range denylist.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeniesAddr returns whether the IP address of a network address is in a denied range
+func (denylist *Denylist) DeniesAddr(addr net.Addr) bool {
+	ip := addrIP(addr)
+	return ip != nil && denylist.DeniesIP(ip)
+}
+
+// VerifyPeerCertificate refuses the TLS handshake of denied node IDs
+func (denylist *Denylist) VerifyPeerCertificate(_ [][]byte, parsedChains [][]*x509.Certificate) error {
+	if len(parsedChains) == 0 || len(parsedChains[0]) <= peertls.CAIndex {
+		return ErrDenied.New("missing CA certificate")
+	}
+	id, err := identity.NodeIDFromKey(parsedChains[0][peertls.CAIndex].PublicKey)
+	if err != nil {
+		return ErrDenied.Wrap(err)
+	}
+	if denylist.DeniesID(id) {
+		mon.Meter("denylist_handshakes_refused").Mark(1)
+		return ErrDenied.New("node %s", id)
+	}
+	return nil
+}
+
+// Listener wraps lis to close connections from denied IP ranges as soon as
+// they are accepted
+func (denylist *Denylist) Listener(lis net.Listener) net.Listener {
+	return &denylistListener{Listener: lis, denylist: denylist}
+}
+
+// UnaryInterceptor refuses requests of denied peers over already established
+// connections, so entries added while reloading take effect immediately
+func (denylist *Denylist) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := denylist.verifyContext(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor refuses streams of denied peers over already established connections
+func (denylist *Denylist) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := denylist.verifyContext(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// verifyContext checks the address and identity of the peer of a request
+func (denylist *Denylist) verifyContext(ctx context.Context) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if p.Addr != nil && denylist.DeniesAddr(p.Addr) {
+		mon.Meter("denylist_requests_refused").Mark(1)
+		return status.Error(codes.PermissionDenied, ErrDenied.New("address %s", p.Addr).Error())
+	}
+	peerIdentity, err := identity.PeerIdentityFromPeer(p)
+	if err != nil {
+		return nil
+	}
+	if denylist.DeniesID(peerIdentity.ID) {
+		mon.Meter("denylist_requests_refused").Mark(1)
+		return status.Error(codes.PermissionDenied, ErrDenied.New("node %s", peerIdentity.ID).Error())
+	}
+	return nil
+}
+
+// denylistListener closes accepted connections from denied IP ranges
+type denylistListener struct {
+	net.Listener
+	denylist *Denylist
+}
+
+// Accept waits for the next connection which isn't denied
+func (lis *denylistListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := lis.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		if !lis.denylist.DeniesAddr(conn.RemoteAddr()) {
+			return conn, nil
+		}
+		mon.Meter("denylist_connections_refused").Mark(1)
+		_ = conn.Close()
+	}
+}
+
+// parseDenylist parses the node IDs and IP ranges of a denylist file
+func parseDenylist(data []byte) (ids map[storj.NodeID]struct{}, networks []*net.IPNet, err error) {
+	ids = make(map[storj.NodeID]struct{})
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		if network, ok := parseNetwork(entry); ok {
+			networks = append(networks, network)
+			continue
+		}
+		id, err := storj.NodeIDFromString(entry)
+		if err != nil {
+			return nil, nil, Error.New("invalid denylist entry on line %d: %q", line, entry)
+		}
+		ids[id] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	return ids, networks, nil
+}
+
+// parseNetwork parses an IP range in CIDR notation or a single IP address
+func parseNetwork(entry string) (*net.IPNet, bool) {
+	if _, network, err := net.ParseCIDR(entry); err == nil {
+		return network, true
+	}
+	if ip := net.ParseIP(entry); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, true
+	}
+	return nil, false
+}
+
+// addrIP returns the IP address of a network address, nil when it has none
+func addrIP(addr net.Addr) net.IP {
+	if addr == nil {
+		return nil
+	}
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+		return tcpAddr.IP
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
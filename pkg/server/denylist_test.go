@@ -0,0 +1,93 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server_test
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/server"
+)
+
+func TestDenylist(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	fi := pregeneratedIdentity(t)
+	other := teststorj.NodeIDFromString("other")
+	path := ctx.File("denylist")
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(
+		"# compromised nodes\n"+
+			fi.ID.String()+"\n"+
+			"\n"+
+			"10.1.0.0/16\n"+
+			"  192.168.1.7  \n"+
+			"2001:db8::/32\n",
+	), 0644))
+
+	denylist, err := server.NewDenylist(zap.NewNop(), path, time.Second)
+	require.NoError(t, err)
+
+	ids, networks := denylist.Count()
+	assert.Equal(t, 1, ids)
+	assert.Equal(t, 3, networks)
+
+	assert.True(t, denylist.DeniesID(fi.ID))
+	assert.False(t, denylist.DeniesID(other))
+
+	assert.True(t, denylist.DeniesIP(net.ParseIP("10.1.200.3")))
+	assert.False(t, denylist.DeniesIP(net.ParseIP("10.2.0.1")))
+	assert.True(t, denylist.DeniesIP(net.ParseIP("192.168.1.7")))
+	assert.False(t, denylist.DeniesIP(net.ParseIP("192.168.1.8")))
+	assert.True(t, denylist.DeniesIP(net.ParseIP("2001:db8::1")))
+
+	assert.True(t, denylist.DeniesAddr(&net.TCPAddr{IP: net.ParseIP("10.1.0.1"), Port: 7777}))
+	assert.False(t, denylist.DeniesAddr(&net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 7777}))
+
+	// the handshake of the denied identity is refused
+	chain := [][]*x509.Certificate{{fi.Leaf, fi.CA}}
+	assert.True(t, server.ErrDenied.Has(denylist.VerifyPeerCertificate(nil, chain)))
+
+	{ // unchanged files aren't reloaded
+		reloaded, err := denylist.Reload()
+		require.NoError(t, err)
+		assert.False(t, reloaded)
+	}
+
+	{ // changes are applied on reload
+		require.NoError(t, ioutil.WriteFile(path, []byte(other.String()+"\n"), 0644))
+		later := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(path, later, later))
+
+		reloaded, err := denylist.Reload()
+		require.NoError(t, err)
+		assert.True(t, reloaded)
+
+		assert.False(t, denylist.DeniesID(fi.ID))
+		assert.True(t, denylist.DeniesID(other))
+		assert.False(t, denylist.DeniesIP(net.ParseIP("10.1.200.3")))
+		assert.NoError(t, denylist.VerifyPeerCertificate(nil, chain))
+	}
+
+	{ // invalid files keep the previous entries
+		require.NoError(t, ioutil.WriteFile(path, []byte("not a node id\n"), 0644))
+		later := time.Now().Add(2 * time.Minute)
+		require.NoError(t, os.Chtimes(path, later, later))
+
+		_, err := denylist.Reload()
+		assert.Error(t, err)
+		assert.True(t, denylist.DeniesID(other))
+	}
+}
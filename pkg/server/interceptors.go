@@ -34,8 +34,8 @@ func unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServ
 	err error) {
 	resp, err = handler(ctx, req)
 	if err != nil {
-		// no zap errors for wrong file downloads
-		if status.Code(err) == codes.NotFound {
+		// no zap errors for wrong file downloads or requests rejected during maintenance
+		if status.Code(err) == codes.NotFound || status.Code(err) == codes.Unavailable {
 			return resp, err
 		}
 		zap.S().Errorf("%+v", err)
@@ -43,6 +43,14 @@ func unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServ
 	return resp, err
 }
 
+func combineStreamInterceptors(a, b grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return a(srv, ss, info, func(asrv interface{}, ass grpc.ServerStream) error {
+			return b(asrv, ass, info, handler)
+		})
+	}
+}
+
 func combineInterceptors(a, b grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		return a(ctx, req, info, func(actx context.Context, areq interface{}) (interface{}, error) {
@@ -6,6 +6,7 @@ package server
 import (
 	"io/ioutil"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	"storj.io/storj/pkg/identity"
@@ -14,10 +15,15 @@ import (
 
 // Options holds config, identity, and peer verification function data for use with a grpc server.
 type Options struct {
-	Config   Config
-	Ident    *identity.FullIdentity
-	RevDB    *peertls.RevocationDB
-	PCVFuncs []peertls.PeerCertVerificationFunc
+	Config      Config
+	Ident       *identity.FullIdentity
+	RevDB       *peertls.RevocationDB
+	Denylist    *Denylist
+	ConnLimiter *ConnLimiter
+	PCVFuncs    []peertls.PeerCertVerificationFunc
+
+	// StreamInterceptor is run for every stream after the default interceptor
+	StreamInterceptor grpc.StreamServerInterceptor
 }
 
 // NewOptions is a constructor for `serverOptions` given an identity and config
@@ -69,6 +75,21 @@ func (opts *Options) configure(c Config) (err error) {
 		pcvs = append(pcvs, peertls.VerifyUnrevokedChainFunc(opts.RevDB))
 	}
 
+	if c.DenylistPath != "" {
+		opts.Denylist, err = NewDenylist(zap.L().Named("denylist"), c.DenylistPath, c.DenylistReloadInterval)
+		if err != nil {
+			return err
+		}
+		pcvs = append(pcvs, opts.Denylist.VerifyPeerCertificate)
+	}
+
+	if c.ConnectionLimit.Enabled() {
+		opts.ConnLimiter, err = NewConnLimiter(c.ConnectionLimit)
+		if err != nil {
+			return err
+		}
+	}
+
 	exts := peertls.ParseExtensions(c.Extensions, parseOpts)
 	pcvs = append(pcvs, exts.VerifyFunc())
 
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -30,6 +31,10 @@ func TestNewOptions(t *testing.T) {
 	err = ioutil.WriteFile(whitelistPath, chainData, 0644)
 	assert.NoError(t, err)
 
+	denylistPath := ctx.File("denylist")
+	err = ioutil.WriteFile(denylistPath, []byte("10.0.0.0/8\n"), 0644)
+	assert.NoError(t, err)
+
 	cases := []struct {
 		testID      string
 		config      server.Config
@@ -91,6 +96,22 @@ func TestNewOptions(t *testing.T) {
 				},
 			},
 			3,
+		}, {
+			"denylist",
+			server.Config{
+				DenylistPath:           denylistPath,
+				DenylistReloadInterval: time.Second,
+			},
+			1,
+		}, {
+			"connection limits",
+			server.Config{
+				ConnectionLimit: server.ConnLimitConfig{
+					MaxPerIP:  10,
+					Allowlist: "127.0.0.1",
+				},
+			},
+			0,
 		},
 	}
 
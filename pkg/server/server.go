@@ -27,6 +27,7 @@ type Server struct {
 	grpc     *grpc.Server
 	next     []Service
 	identity *identity.FullIdentity
+	denylist *Denylist
 }
 
 // New creates a Server out of an Identity, a net.Listener,
@@ -44,6 +45,21 @@ func New(opts *Options, lis net.Listener,
 		unaryInterceptor = combineInterceptors(unaryInterceptor, interceptor)
 	}
 
+	streamInterceptor := grpc.StreamServerInterceptor(streamInterceptor)
+	if opts.StreamInterceptor != nil {
+		streamInterceptor = combineStreamInterceptors(streamInterceptor, opts.StreamInterceptor)
+	}
+	if opts.Denylist != nil {
+		// denied peers are refused before anything else handles their requests
+		lis = opts.Denylist.Listener(lis)
+		unaryInterceptor = combineInterceptors(opts.Denylist.UnaryInterceptor, unaryInterceptor)
+		streamInterceptor = combineStreamInterceptors(opts.Denylist.StreamInterceptor, streamInterceptor)
+	}
+	if opts.ConnLimiter != nil {
+		// wraps the denylist, so denied connections don't count against the limits
+		lis = opts.ConnLimiter.Listener(lis)
+	}
+
 	return &Server{
 		lis: lis,
 		grpc: grpc.NewServer(
@@ -53,6 +69,7 @@ func New(opts *Options, lis net.Listener,
 		),
 		next:     services,
 		identity: opts.Ident,
+		denylist: opts.Denylist,
 	}, nil
 }
 
@@ -93,6 +110,16 @@ func (p *Server) Run(ctx context.Context) (err error) {
 		defer cancel()
 		return p.grpc.Serve(p.lis)
 	})
+	if p.denylist != nil {
+		group.Go(func() error {
+			defer func() { _ = p.denylist.Close() }()
+			err := p.denylist.Run(ctx)
+			if err == context.Canceled {
+				return nil
+			}
+			return err
+		})
+	}
 
 	return group.Wait()
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -24,12 +25,14 @@ type DB interface {
 	Get(ctx context.Context, nodeID storj.NodeID) (stats *NodeStats, err error)
 	// FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements.
 	FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *NodeStats) (invalid storj.NodeIDList, err error)
+	// FindDisqualifiedNodes finds a subset of storagenodes whose reputation scores are below auditDQ or uptimeDQ.
+	FindDisqualifiedNodes(ctx context.Context, nodeIDs storj.NodeIDList, auditDQ, uptimeDQ float64) (disqualified storj.NodeIDList, err error)
 	// Update all parts of single storagenode's stats.
 	Update(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error)
 	// UpdateUptime updates a single storagenode's uptime stats.
-	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error)
+	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, model reputation.Model) (stats *NodeStats, err error)
 	// UpdateAuditSuccess updates a single storagenode's audit stats.
-	UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool) (stats *NodeStats, err error)
+	UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool, model reputation.Model) (stats *NodeStats, err error)
 	// UpdateBatch for updating multiple storage nodes' stats.
 	UpdateBatch(ctx context.Context, requests []*UpdateRequest) (statslist []*NodeStats, failed []*UpdateRequest, err error)
 	// CreateEntryIfNotExists creates a node stats entry if it didn't already exist.
@@ -41,6 +44,9 @@ type UpdateRequest struct {
 	NodeID       storj.NodeID
 	AuditSuccess bool
 	IsUp         bool
+
+	AuditModel  reputation.Model
+	UptimeModel reputation.Model
 }
 
 // NodeStats contains statistics abot a node.
@@ -52,4 +58,19 @@ type NodeStats struct {
 	UptimeRatio        float64
 	UptimeSuccessCount int64
 	UptimeCount        int64
+
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+}
+
+// AuditReputation returns the shapes of the node's audit reputation.
+func (stats *NodeStats) AuditReputation() reputation.Reputation {
+	return reputation.Reputation{Alpha: stats.AuditReputationAlpha, Beta: stats.AuditReputationBeta}
+}
+
+// UptimeReputation returns the shapes of the node's uptime reputation.
+func (stats *NodeStats) UptimeReputation() reputation.Reputation {
+	return reputation.Reputation{Alpha: stats.UptimeReputationAlpha, Beta: stats.UptimeReputationBeta}
 }
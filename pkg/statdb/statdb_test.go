@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
@@ -31,6 +32,8 @@ func TestStatdb(t *testing.T) {
 }
 
 func testDatabase(ctx context.Context, t *testing.T, sdb statdb.DB) {
+	model := reputation.Model{Alpha0: 1, Beta0: 0, Lambda: 0.95, Weight: 1, DQ: 0.6}
+
 	nodeID := storj.NodeID{1, 2, 3, 4, 5}
 	currAuditSuccess := int64(4)
 	currAuditCount := int64(10)
@@ -140,6 +143,43 @@ func testDatabase(ctx context.Context, t *testing.T, sdb statdb.DB) {
 		assert.Len(t, invalid, 3)
 	}
 
+	{ // TestFindDisqualifiedNodes
+		for _, tt := range []struct {
+			nodeID      storj.NodeID
+			auditAlpha  float64
+			auditBeta   float64
+			uptimeAlpha float64
+			uptimeBeta  float64
+		}{
+			{storj.NodeID{10}, 9, 1, 9, 1}, // good audit and uptime scores
+			{storj.NodeID{11}, 2, 8, 9, 1}, // bad audit score
+			{storj.NodeID{12}, 9, 1, 1, 9}, // bad uptime score
+			{storj.NodeID{13}, 0, 0, 0, 0}, // no history
+		} {
+			nodeStats := &statdb.NodeStats{
+				AuditReputationAlpha:  tt.auditAlpha,
+				AuditReputationBeta:   tt.auditBeta,
+				UptimeReputationAlpha: tt.uptimeAlpha,
+				UptimeReputationBeta:  tt.uptimeBeta,
+			}
+
+			_, err := sdb.Create(ctx, tt.nodeID, nodeStats)
+			assert.NoError(t, err)
+		}
+
+		nodeIds := storj.NodeIDList{
+			storj.NodeID{10}, storj.NodeID{11},
+			storj.NodeID{12}, storj.NodeID{13},
+		}
+
+		disqualified, err := sdb.FindDisqualifiedNodes(ctx, nodeIds, 0.6, 0.6)
+		assert.NoError(t, err)
+
+		assert.Contains(t, disqualified, storj.NodeID{11})
+		assert.Contains(t, disqualified, storj.NodeID{12})
+		assert.Len(t, disqualified, 2)
+	}
+
 	{ // TestUpdateExists
 		auditSuccessRatio := getRatio(currAuditSuccess, currAuditCount)
 		uptimeRatio := getRatio(currUptimeSuccess, currUptimeCount)
@@ -188,7 +228,7 @@ func testDatabase(ctx context.Context, t *testing.T, sdb statdb.DB) {
 		assert.EqualValues(t, currUptimeSuccess, stats.UptimeSuccessCount)
 		assert.EqualValues(t, uptimeRatio, stats.UptimeRatio)
 
-		stats, err = sdb.UpdateUptime(ctx, nodeID, false)
+		stats, err = sdb.UpdateUptime(ctx, nodeID, false, model)
 		assert.NoError(t, err)
 
 		currUptimeCount++
@@ -213,7 +253,7 @@ func testDatabase(ctx context.Context, t *testing.T, sdb statdb.DB) {
 		assert.EqualValues(t, currUptimeSuccess, stats.UptimeSuccessCount)
 		assert.EqualValues(t, uptimeRatio, stats.UptimeRatio)
 
-		stats, err = sdb.UpdateAuditSuccess(ctx, nodeID, false)
+		stats, err = sdb.UpdateAuditSuccess(ctx, nodeID, false, model)
 		assert.NoError(t, err)
 
 		currAuditCount++
@@ -26,6 +26,7 @@ var mon = monkit.Package()
 type Store interface {
 	Get(ctx context.Context, bucket string) (meta Meta, err error)
 	Put(ctx context.Context, bucket string, pathCipher storj.Cipher) (meta Meta, err error)
+	SetVersioning(ctx context.Context, bucket string, enabled bool) (meta Meta, err error)
 	Delete(ctx context.Context, bucket string) (err error)
 	List(ctx context.Context, startAfter, endBefore string, limit int) (items []ListItem, more bool, err error)
 	GetObjectStore(ctx context.Context, bucketName string) (store objects.Store, err error)
@@ -47,6 +48,7 @@ type BucketStore struct {
 type Meta struct {
 	Created            time.Time
 	PathEncryptionType storj.Cipher
+	Versioning         bool
 }
 
 // NewStore instantiates BucketStore
@@ -107,9 +109,33 @@ func (b *BucketStore) Put(ctx context.Context, bucket string, pathCipher storj.C
 		return Meta{}, encryption.ErrInvalidConfig.New("encryption type %d is not supported", pathCipher)
 	}
 
+	return b.put(ctx, bucket, Meta{PathEncryptionType: pathCipher})
+}
+
+// SetVersioning enables or disables keeping versions of the objects in bucket
+func (b *BucketStore) SetVersioning(ctx context.Context, bucket string, enabled bool) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	meta, err = b.Get(ctx, bucket)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	meta.Versioning = enabled
+	return b.put(ctx, bucket, meta)
+}
+
+// put stores the metadata of bucket, the creation time is kept when it's set
+func (b *BucketStore) put(ctx context.Context, bucket string, meta Meta) (_ Meta, err error) {
 	r := bytes.NewReader(nil)
 	userMeta := map[string]string{
-		"path-enc-type": strconv.Itoa(int(pathCipher)),
+		"path-enc-type": strconv.Itoa(int(meta.PathEncryptionType)),
+	}
+	if !meta.Created.IsZero() {
+		userMeta["created"] = meta.Created.UTC().Format(time.RFC3339Nano)
+	}
+	if meta.Versioning {
+		userMeta["versioning"] = "true"
 	}
 	var exp time.Time
 	m, err := b.store.Put(ctx, bucket, r, pb.SerializableMeta{UserDefined: userMeta}, exp)
@@ -179,8 +205,19 @@ func convertMeta(m objects.Meta) (Meta, error) {
 		cipher = storj.Cipher(pet)
 	}
 
+	created := m.Modified
+	if value, ok := m.UserDefined["created"]; ok {
+		// buckets, which were stored again, keep their creation time
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return Meta{}, err
+		}
+		created = parsed
+	}
+
 	return Meta{
-		Created:            m.Modified,
+		Created:            created,
 		PathEncryptionType: cipher,
+		Versioning:         m.UserDefined["versioning"] == "true",
 	}, nil
 }
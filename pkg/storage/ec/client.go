@@ -5,6 +5,7 @@ package ecclient
 
 import (
 	"context"
+	"crypto/sha256"
 	"io"
 	"io/ioutil"
 	"sort"
@@ -13,8 +14,11 @@ import (
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
@@ -27,9 +31,15 @@ import (
 
 var mon = monkit.Package()
 
+// DefaultLongTailMargin is how many times the time it took to reach the
+// repair threshold an upload waits for the success threshold, before the
+// slowest piece uploads are canceled
+const DefaultLongTailMargin = 1.5
+
 // Client defines an interface for storing erasure coded data to piece store nodes
 type Client interface {
-	Put(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, err error)
+	Put(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, successfulHashes []*pb.SignedMessage, err error)
+	Repair(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, successfulHashes []*pb.SignedMessage, err error)
 	Get(ctx context.Context, nodes []*pb.Node, es eestream.ErasureScheme, pieceID psclient.PieceID, size int64, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (ranger.Ranger, error)
 	Delete(ctx context.Context, nodes []*pb.Node, pieceID psclient.PieceID, authorization *pb.SignedMessage) error
 }
@@ -38,19 +48,67 @@ type psClientFunc func(context.Context, transport.Client, *pb.Node, int) (psclie
 type psClientHelper func(context.Context, *pb.Node) (psclient.Client, error)
 
 type ecClient struct {
+	identity        *identity.FullIdentity
 	transport       transport.Client
 	memoryLimit     int
 	newPSClientFunc psClientFunc
+
+	// the rates of the piece transfers of all the uploads and downloads,
+	// nil doesn't limit them
+	uploadLimit   *sync2.RateLimiter
+	downloadLimit *sync2.RateLimiter
+
+	longTailMargin float64
+
+	// downloaded is called with the number of bytes read from the pieces
+	// downloaded from the storage nodes, nil doesn't meter them
+	downloaded func(n int64)
+}
+
+// NewClient from the given identity and max buffer memory, the storage nodes
+// are dialed with opts
+func NewClient(identity *identity.FullIdentity, memoryLimit int, opts ...grpc.DialOption) Client {
+	tc := transport.NewClientWithOptions(identity, opts)
+	return &ecClient{
+		identity:        identity,
+		transport:       tc,
+		memoryLimit:     memoryLimit,
+		newPSClientFunc: psclient.NewPSClient,
+	}
 }
 
-// NewClient from the given identity and max buffer memory
-func NewClient(identity *identity.FullIdentity, memoryLimit int) Client {
-	tc := transport.NewClient(identity)
+// NewMeteredClient creates a client like NewClient, which reports the number
+// of bytes it downloads from the storage nodes to downloaded
+func NewMeteredClient(identity *identity.FullIdentity, memoryLimit int, downloaded func(n int64)) Client {
+	client := NewClient(identity, memoryLimit).(*ecClient)
+	client.downloaded = downloaded
+	return client
+}
+
+// NewClientFromTransport creates a client with max buffer memory, which
+// dials the storage nodes with tc. The piece transfers of all uploads and
+// downloads are limited to the rates of uploadLimit and downloadLimit.
+// Uploads wait longTailMargin times the time it took to reach the repair
+// threshold for the success threshold, before canceling the slowest piece
+// uploads, 0 uses DefaultLongTailMargin.
+func NewClientFromTransport(tc transport.Client, memoryLimit int, uploadLimit, downloadLimit *sync2.RateLimiter, longTailMargin float64) Client {
 	return &ecClient{
+		identity:        tc.Identity(),
 		transport:       tc,
 		memoryLimit:     memoryLimit,
 		newPSClientFunc: psclient.NewPSClient,
+		uploadLimit:     uploadLimit,
+		downloadLimit:   downloadLimit,
+		longTailMargin:  longTailMargin,
+	}
+}
+
+// getLongTailMargin returns the long tail margin of the uploads
+func (ec *ecClient) getLongTailMargin() float64 {
+	if ec.longTailMargin <= 0 {
+		return DefaultLongTailMargin
 	}
+	return ec.longTailMargin
 }
 
 func (ec *ecClient) newPSClient(ctx context.Context, n *pb.Node) (psclient.Client, error) {
@@ -58,29 +116,35 @@ func (ec *ecClient) newPSClient(ctx context.Context, n *pb.Node) (psclient.Clien
 	return ec.newPSClientFunc(ctx, ec.transport, n, 0)
 }
 
-func (ec *ecClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, err error) {
+func (ec *ecClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, successfulHashes []*pb.SignedMessage, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if len(nodes) != rs.TotalCount() {
-		return nil, Error.New("size of nodes slice (%d) does not match total count (%d) of erasure scheme", len(nodes), rs.TotalCount())
+		return nil, nil, Error.New("size of nodes slice (%d) does not match total count (%d) of erasure scheme", len(nodes), rs.TotalCount())
 	}
 
 	if nonNilCount(nodes) < rs.RepairThreshold() {
-		return nil, Error.New("number of non-nil nodes (%d) is less than repair threshold (%d) of erasure scheme", nonNilCount(nodes), rs.RepairThreshold())
+		return nil, nil, Error.New("number of non-nil nodes (%d) is less than repair threshold (%d) of erasure scheme", nonNilCount(nodes), rs.RepairThreshold())
 	}
 
 	if !unique(nodes) {
-		return nil, Error.New("duplicated nodes are not allowed")
+		return nil, nil, Error.New("duplicated nodes are not allowed")
+	}
+
+	// skip the nodes which were slow for the earlier segments of the object
+	if placement, ok := GetPlacement(ctx); ok {
+		nodes = placement.Select(nodes, rs.OptimalThreshold())
 	}
 
 	padded := eestream.PadReader(ioutil.NopCloser(data), rs.StripeSize())
 	readers, err := eestream.EncodeReader(ctx, padded, rs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	type info struct {
-		i   int
-		err error
+		i    int
+		hash *pb.SignedMessage
+		err  error
 	}
 	infos := make(chan info, len(nodes))
 
@@ -95,40 +159,60 @@ func (ec *ecClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.Redun
 		}
 
 		go func(i int, node *pb.Node) {
-			err := ec.putPiece(psCtx, ctx, node, pieceID, readers[i], expiration, pba, authorization)
-			infos <- info{i: i, err: err}
+			hash, err := ec.putPiece(psCtx, ctx, node, pieceID, readers[i], expiration, pba, authorization)
+			infos <- info{i: i, hash: hash, err: err}
 		}(i, node)
 	}
 
 	successfulNodes = make([]*pb.Node, len(nodes))
+	successfulHashes = make([]*pb.SignedMessage, len(nodes))
 	var successfulCount int32
+	var failedCount, canceledCount int
 	var timer *time.Timer
 
 	for range nodes {
 		info := <-infos
-		if info.err == nil {
+		if nodes[info.i] == nil {
+			continue
+		}
+		if info.err == context.Canceled {
+			canceledCount++
+		} else if info.err != nil {
+			failedCount++
+		} else {
 			successfulNodes[info.i] = nodes[info.i]
+			successfulHashes[info.i] = info.hash
 
 			switch int(atomic.AddInt32(&successfulCount, 1)) {
 			case rs.RepairThreshold():
 				elapsed := time.Since(start)
-				more := elapsed * 3 / 2
+				more := time.Duration(float64(elapsed) * ec.getLongTailMargin())
 
 				zap.S().Infof("Repair threshold (%d nodes) reached in %.2f s. Starting a timer for %.2f s for reaching the success threshold (%d nodes)...",
 					rs.RepairThreshold(), elapsed.Seconds(), more.Seconds(), rs.OptimalThreshold())
 
 				timer = time.AfterFunc(more, func() {
 					zap.S().Infof("Timer expired. Successfully uploaded to %d nodes. Canceling the long tail...", atomic.LoadInt32(&successfulCount))
+					mon.Meter("upload_long_tail_timer_expired").Mark(1)
 					cancel()
 				})
 			case rs.OptimalThreshold():
 				zap.S().Infof("Success threshold (%d nodes) reached. Canceling the long tail...", rs.OptimalThreshold())
+				mon.Meter("upload_success_threshold_reached").Mark(1)
 				timer.Stop()
 				cancel()
 			}
 		}
 	}
 
+	// the pieces canceled by the user aren't the long tail
+	if ctx.Err() == nil {
+		dialed := nonNilCount(nodes)
+		mon.IntVal("upload_pieces_canceled").Observe(int64(canceledCount))
+		mon.IntVal("upload_pieces_failed").Observe(int64(failedCount))
+		mon.FloatVal("upload_long_tail_cancel_rate").Observe(float64(canceledCount) / float64(dialed))
+	}
+
 	/* clean up the partially uploaded segment's pieces */
 	defer func() {
 		select {
@@ -142,32 +226,115 @@ func (ec *ecClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.Redun
 	}()
 
 	if int(atomic.LoadInt32(&successfulCount)) < rs.RepairThreshold() {
-		return nil, Error.New("successful puts (%d) less than repair threshold (%d)", successfulCount, rs.RepairThreshold())
+		return nil, nil, Error.New("successful puts (%d) less than repair threshold (%d)", successfulCount, rs.RepairThreshold())
 	}
 
-	return successfulNodes, nil
+	return successfulNodes, successfulHashes, nil
 }
 
-func (ec *ecClient) putPiece(ctx, parent context.Context, node *pb.Node, pieceID psclient.PieceID, data io.ReadCloser, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (err error) {
+// Repair uploads only the pieces for the non-nil nodes, the other pieces are
+// never encoded. Unlike Put it doesn't require the repair threshold and doesn't
+// cancel the long tail, pieces which failed to upload are nil in the result.
+func (ec *ecClient) Repair(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, successfulHashes []*pb.SignedMessage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(nodes) != rs.TotalCount() {
+		return nil, nil, Error.New("size of nodes slice (%d) does not match total count (%d) of erasure scheme", len(nodes), rs.TotalCount())
+	}
+
+	if nonNilCount(nodes) == 0 {
+		return nil, nil, Error.New("no nodes to repair pieces to")
+	}
+
+	if !unique(nodes) {
+		return nil, nil, Error.New("duplicated nodes are not allowed")
+	}
+
+	padded := eestream.PadReader(ioutil.NopCloser(data), rs.StripeSize())
+	readers, err := eestream.EncodeReader(ctx, padded, rs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type info struct {
+		i    int
+		hash *pb.SignedMessage
+		err  error
+	}
+	infos := make(chan info, len(nodes))
+
+	for i, node := range nodes {
+		if node == nil {
+			// closing the reader ensures the piece is not encoded
+			infos <- info{i: i, err: readers[i].Close()}
+			continue
+		}
+		node.Type.DPanicOnInvalid("ec client Repair")
+
+		go func(i int, node *pb.Node) {
+			hash, err := ec.putPiece(ctx, ctx, node, pieceID, readers[i], expiration, pba, authorization)
+			infos <- info{i: i, hash: hash, err: err}
+		}(i, node)
+	}
+
+	successfulNodes = make([]*pb.Node, len(nodes))
+	successfulHashes = make([]*pb.SignedMessage, len(nodes))
+	var successfulCount int
+	var errlist errs.Group
+
+	for range nodes {
+		info := <-infos
+		if nodes[info.i] == nil {
+			continue
+		}
+		if info.err != nil {
+			errlist.Add(info.err)
+			continue
+		}
+		successfulNodes[info.i] = nodes[info.i]
+		successfulHashes[info.i] = info.hash
+		successfulCount++
+	}
+
+	if successfulCount == 0 {
+		return nil, nil, Error.New("repairing pieces failed: %v", errlist.Err())
+	}
+
+	return successfulNodes, successfulHashes, nil
+}
+
+func (ec *ecClient) putPiece(ctx, parent context.Context, node *pb.Node, pieceID psclient.PieceID, data io.ReadCloser, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (hash *pb.SignedMessage, err error) {
 	defer func() { err = errs.Combine(err, data.Close()) }()
 
 	if node == nil {
 		_, err = io.Copy(ioutil.Discard, data)
-		return err
+		return nil, err
 	}
 	derivedPieceID, err := pieceID.Derive(node.Id.Bytes())
 
 	if err != nil {
 		zap.S().Errorf("Failed deriving piece id for %s: %v", pieceID, err)
-		return err
+		return nil, err
 	}
+	start := time.Now()
+	counter := &countingReader{R: sync2.LimitReader(ctx, ec.uploadLimit, data)}
+	// record the throughput for placing the pieces of the later segments,
+	// unless the whole upload was canceled
+	if placement, ok := GetPlacement(parent); ok {
+		defer func() {
+			if parent.Err() == nil {
+				placement.Observe(node.Id, counter.Count(), time.Since(start))
+			}
+		}()
+	}
+
 	ps, err := ec.newPSClient(ctx, node)
 	if err != nil {
 		zap.S().Errorf("Failed dialing for putting piece %s -> %s to node %s: %v",
 			pieceID, derivedPieceID, node.Id, err)
-		return err
+		return nil, err
 	}
-	err = ps.Put(ctx, derivedPieceID, data, expiration, pba, authorization)
+	hasher := sha256.New()
+	err = ps.Put(ctx, derivedPieceID, io.TeeReader(counter, hasher), expiration, pba, authorization)
 	defer func() { err = errs.Combine(err, ps.Close()) }()
 	// Canceled context means the piece upload was interrupted by user or due
 	// to slow connection. No error logging for this case.
@@ -186,8 +353,12 @@ func (ec *ecClient) putPiece(ctx, parent context.Context, node *pb.Node, pieceID
 		zap.S().Errorf("Failed putting piece %s -> %s to node %s (%+v): %v",
 			pieceID, derivedPieceID, node.Id, nodeAddress, err)
 	}
+	if err != nil || ec.identity == nil {
+		return nil, err
+	}
 
-	return err
+	// sign the piece hash so audits can later check the stored data
+	return auth.NewSignedData(hasher.Sum(nil), ec.identity)
 }
 
 func (ec *ecClient) Get(ctx context.Context, nodes []*pb.Node, es eestream.ErasureScheme,
@@ -239,6 +410,8 @@ func (ec *ecClient) Get(ctx context.Context, nodes []*pb.Node, es eestream.Erasu
 				size:              pieceSize,
 				pba:               pba,
 				authorization:     authorization,
+				limit:             ec.downloadLimit,
+				downloaded:        ec.downloaded,
 			}
 
 			ch <- rangerInfo{i: i, rr: rr, err: nil}
@@ -350,6 +523,12 @@ func unique(nodes []*pb.Node) bool {
 	return true
 }
 
+// CalcPieceSize returns the size of the pieces of a segment with the given
+// size when it's erasure coded with es
+func CalcPieceSize(size int64, es eestream.ErasureScheme) int64 {
+	return calcPadded(size, es.StripeSize()) / int64(es.RequiredCount())
+}
+
 func calcPadded(size int64, blockSize int) int64 {
 	mod := size % int64(blockSize)
 	if mod == 0 {
@@ -366,6 +545,8 @@ type lazyPieceRanger struct {
 	size              int64
 	pba               *pb.PayerBandwidthAllocation
 	authorization     *pb.SignedMessage
+	limit             *sync2.RateLimiter
+	downloaded        func(n int64)
 }
 
 // Size implements Ranger.Size
@@ -387,7 +568,27 @@ func (lr *lazyPieceRanger) Range(ctx context.Context, offset, length int64) (io.
 		}
 		lr.ranger = ranger
 	}
-	return lr.ranger.Range(ctx, offset, length)
+	reader, err := lr.ranger.Range(ctx, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	if lr.downloaded != nil {
+		reader = &meteredReadCloser{ReadCloser: reader, downloaded: lr.downloaded}
+	}
+	return sync2.LimitReadCloser(ctx, lr.limit, reader), nil
+}
+
+// meteredReadCloser reports the number of bytes read from a piece
+type meteredReadCloser struct {
+	io.ReadCloser
+	downloaded func(n int64)
+}
+
+// Read implements io.Reader
+func (r *meteredReadCloser) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	r.downloaded(int64(n))
+	return n, err
 }
 
 func nonNilCount(nodes []*pb.Node) int {
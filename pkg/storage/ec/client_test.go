@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -147,7 +148,7 @@ TestLoop:
 		r := io.LimitReader(rand.Reader, int64(size))
 		ec := ecClient{newPSClientFunc: mockNewPSClient(clients)}
 
-		successfulNodes, err := ec.Put(ctx, tt.nodes, rs, id, r, ttl, nil, nil)
+		successfulNodes, successfulHashes, err := ec.Put(ctx, tt.nodes, rs, id, r, ttl, nil, nil)
 
 		if tt.errString != "" {
 			assert.EqualError(t, err, tt.errString, errTag)
@@ -156,6 +157,7 @@ TestLoop:
 
 		assert.NoError(t, err, errTag)
 		assert.Equal(t, len(tt.nodes), len(successfulNodes), errTag)
+		assert.Equal(t, len(tt.nodes), len(successfulHashes), errTag)
 
 		slowNodes := 0
 		for i := range tt.nodes {
@@ -188,6 +190,185 @@ func mockNewPSClient(clients map[*pb.Node]psclient.Client) psClientFunc {
 	}
 }
 
+func TestPutLongTail(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the pieces are over-provisioned to twice the success threshold
+	size := 32 * 1024
+	k, m, o, n := 2, 2, 3, 6
+	fc, err := infectious.NewFEC(k, n)
+	if !assert.NoError(t, err) {
+		return
+	}
+	es := eestream.NewRSScheme(fc, size/n)
+	rs, err := eestream.NewRedundancyStrategy(es, m, o)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	delay := 100 * time.Millisecond
+	margin := 2.0
+
+	for i, tt := range []struct {
+		fast int
+		// whether the slow nodes are canceled by the long tail timer,
+		// otherwise by reaching the success threshold
+		timer bool
+	}{
+		{fast: o, timer: false},
+		{fast: m, timer: true},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		id := psclient.NewPieceID()
+		ttl := time.Now()
+
+		nodes := make([]*pb.Node, n)
+		for j := range nodes {
+			nodes[j] = teststorj.MockNode(fmt.Sprintf("node-%d", j))
+		}
+
+		var puts int32
+		canceled := make([]time.Duration, n)
+		start := time.Now()
+
+		clients := make(map[*pb.Node]psclient.Client, len(nodes))
+		for j, node := range nodes {
+			j, slow := j, j >= tt.fast
+			ps := NewMockPSClient(ctrl)
+			gomock.InOrder(
+				ps.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), ttl, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id psclient.PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error {
+						atomic.AddInt32(&puts, 1)
+						if _, err := io.Copy(ioutil.Discard, data); err != nil {
+							return err
+						}
+						if !slow {
+							time.Sleep(delay)
+							return nil
+						}
+						select {
+						case <-ctx.Done():
+							canceled[j] = time.Since(start)
+							return ctx.Err()
+						case <-time.After(10 * time.Second):
+							return nil
+						}
+					}),
+				ps.EXPECT().Close().Return(nil),
+			)
+			clients[node] = ps
+		}
+
+		r := io.LimitReader(rand.Reader, int64(size))
+		ec := ecClient{newPSClientFunc: mockNewPSClient(clients), longTailMargin: margin}
+
+		successfulNodes, _, err := ec.Put(ctx, nodes, rs, id, r, ttl, nil, nil)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+
+		// the pieces are uploaded to all the dialed nodes
+		assert.EqualValues(t, n, atomic.LoadInt32(&puts), errTag)
+
+		// the timer waits margin times the time it took to reach the
+		// repair threshold, which is at least delay
+		cutoff := time.Duration(float64(delay) * (1 + margin))
+		for j := range nodes {
+			if j < tt.fast {
+				assert.Equal(t, nodes[j], successfulNodes[j], errTag)
+				continue
+			}
+			assert.Nil(t, successfulNodes[j], errTag)
+			assert.NotZero(t, canceled[j], errTag)
+			if tt.timer {
+				assert.True(t, canceled[j] >= cutoff, "%s: canceled after %v, before %v", errTag, canceled[j], cutoff)
+			} else {
+				assert.True(t, canceled[j] < cutoff, "%s: canceled after %v, not before %v", errTag, canceled[j], cutoff)
+			}
+		}
+	}
+}
+
+func TestRepair(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	size := 32 * 1024
+	k := 2
+	n := 4
+	fc, err := infectious.NewFEC(k, n)
+	if !assert.NoError(t, err) {
+		return
+	}
+	es := eestream.NewRSScheme(fc, size/n)
+
+	for i, tt := range []struct {
+		nodes     []*pb.Node
+		errs      []error
+		errString string
+	}{
+		{[]*pb.Node{nil, nil, nil, nil}, []error{nil, nil, nil, nil},
+			"ecclient error: no nodes to repair pieces to"},
+		{[]*pb.Node{nil, node1, nil, nil}, []error{nil, nil, nil, nil}, ""},
+		{[]*pb.Node{nil, node1, node2, nil}, []error{nil, ErrOpFailed, nil, nil}, ""},
+		{[]*pb.Node{nil, node1, nil, nil}, []error{nil, ErrDialFailed, nil, nil},
+			"ecclient error: repairing pieces failed: dial failed"},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		id := psclient.NewPieceID()
+		ttl := time.Now()
+
+		clients := make(map[*pb.Node]psclient.Client, len(tt.nodes))
+		for i, n := range tt.nodes {
+			if n == nil || tt.errs[i] == ErrDialFailed {
+				continue
+			}
+			derivedID, err := id.Derive(n.Id.Bytes())
+			if !assert.NoError(t, err, errTag) {
+				return
+			}
+			ps := NewMockPSClient(ctrl)
+			gomock.InOrder(
+				ps.EXPECT().Put(gomock.Any(), derivedID, gomock.Any(), ttl, gomock.Any(), gomock.Any()).Return(tt.errs[i]).
+					Do(func(ctx context.Context, id psclient.PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) {
+						_, err := io.Copy(ioutil.Discard, data)
+						assert.NoError(t, err, errTag)
+					}),
+				ps.EXPECT().Close().Return(nil),
+			)
+			clients[n] = ps
+		}
+		rs, err := eestream.NewRedundancyStrategy(es, 0, 0)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		r := io.LimitReader(rand.Reader, int64(size))
+		ec := ecClient{newPSClientFunc: mockNewPSClient(clients)}
+
+		successfulNodes, successfulHashes, err := ec.Repair(ctx, tt.nodes, rs, id, r, ttl, nil, nil)
+
+		if tt.errString != "" {
+			assert.EqualError(t, err, tt.errString, errTag)
+			continue
+		}
+
+		assert.NoError(t, err, errTag)
+		assert.Equal(t, len(tt.nodes), len(successfulHashes), errTag)
+		for i := range tt.nodes {
+			if tt.errs[i] != nil {
+				assert.Nil(t, successfulNodes[i], errTag)
+			} else {
+				assert.Equal(t, tt.nodes[i], successfulNodes[i], errTag)
+			}
+		}
+	}
+}
+
 func TestGet(t *testing.T) {
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
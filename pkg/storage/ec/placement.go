@@ -0,0 +1,142 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+const (
+	// placementWeight is the weight of a new observation in the smoothed throughput
+	placementWeight = 0.5
+	// slowFraction is the fraction of the median throughput below which a node is slow
+	slowFraction = 0.25
+)
+
+// placementKey is the context key of the placement of the current upload
+type placementKey struct{}
+
+// WithPlacement returns a context which makes the uploads done with it
+// record node throughput into placement and skip the nodes it found slow
+func WithPlacement(ctx context.Context, placement *Placement) context.Context {
+	return context.WithValue(ctx, placementKey{}, placement)
+}
+
+// GetPlacement returns the placement of the context if it exists
+func GetPlacement(ctx context.Context) (*Placement, bool) {
+	placement, ok := ctx.Value(placementKey{}).(*Placement)
+	return placement, ok && placement != nil
+}
+
+// Placement keeps the throughput observed for the nodes during the upload
+// of an object, so the pieces of its later segments can be biased toward the
+// faster nodes of the selection
+type Placement struct {
+	mu         sync.Mutex
+	throughput map[storj.NodeID]float64 // smoothed, in bytes per second
+}
+
+// NewPlacement returns a placement without any observations
+func NewPlacement() *Placement {
+	return &Placement{throughput: make(map[storj.NodeID]float64)}
+}
+
+// Observe records that bytes were uploaded to node within duration
+func (placement *Placement) Observe(nodeID storj.NodeID, bytes int64, duration time.Duration) {
+	if duration <= 0 {
+		duration = time.Nanosecond
+	}
+	observed := float64(bytes) / duration.Seconds()
+
+	placement.mu.Lock()
+	defer placement.mu.Unlock()
+
+	if previous, ok := placement.throughput[nodeID]; ok {
+		observed = placementWeight*observed + (1-placementWeight)*previous
+	}
+	placement.throughput[nodeID] = observed
+}
+
+// Throughput returns the smoothed throughput of node in bytes per second
+func (placement *Placement) Throughput(nodeID storj.NodeID) (bytesPerSecond float64, ok bool) {
+	placement.mu.Lock()
+	defer placement.mu.Unlock()
+
+	bytesPerSecond, ok = placement.throughput[nodeID]
+	return bytesPerSecond, ok
+}
+
+// Select returns a copy of nodes where the nodes which were observed to be
+// much slower than the others are replaced with nil. Nodes without
+// observations are kept and at least keep nodes remain non-nil.
+func (placement *Placement) Select(nodes []*pb.Node, keep int) []*pb.Node {
+	placement.mu.Lock()
+	defer placement.mu.Unlock()
+
+	selected := make([]*pb.Node, len(nodes))
+	copy(selected, nodes)
+
+	type observed struct {
+		i          int
+		throughput float64
+	}
+	var known []observed
+	var speeds []float64
+	nonNil := 0
+	for i, node := range selected {
+		if node == nil {
+			continue
+		}
+		nonNil++
+		if throughput, ok := placement.throughput[node.Id]; ok {
+			known = append(known, observed{i: i, throughput: throughput})
+			speeds = append(speeds, throughput)
+		}
+	}
+	if len(known) < 2 || nonNil <= keep {
+		return selected
+	}
+
+	sort.Float64s(speeds)
+	threshold := speeds[len(speeds)/2] * slowFraction
+
+	// drop the slowest nodes first
+	sort.Slice(known, func(a, b int) bool {
+		return known[a].throughput < known[b].throughput
+	})
+	for _, node := range known {
+		if nonNil <= keep || node.throughput >= threshold {
+			break
+		}
+		selected[node.i] = nil
+		nonNil--
+	}
+
+	return selected
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	R io.Reader
+	N int64 // accessed atomically
+}
+
+func (cr *countingReader) Read(p []byte) (n int, err error) {
+	n, err = cr.R.Read(p)
+	atomic.AddInt64(&cr.N, int64(n))
+	return n, err
+}
+
+// Count returns the number of bytes read so far
+func (cr *countingReader) Count() int64 {
+	return atomic.LoadInt64(&cr.N)
+}
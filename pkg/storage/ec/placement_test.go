@@ -0,0 +1,69 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestPlacement(t *testing.T) {
+	nodes := []*pb.Node{
+		{Id: teststorj.NodeIDFromString("fast-1"), Type: pb.NodeType_STORAGE},
+		{Id: teststorj.NodeIDFromString("fast-2"), Type: pb.NodeType_STORAGE},
+		{Id: teststorj.NodeIDFromString("fast-3"), Type: pb.NodeType_STORAGE},
+		{Id: teststorj.NodeIDFromString("slow-1"), Type: pb.NodeType_STORAGE},
+		{Id: teststorj.NodeIDFromString("slow-2"), Type: pb.NodeType_STORAGE},
+		{Id: teststorj.NodeIDFromString("unknown"), Type: pb.NodeType_STORAGE},
+	}
+
+	placement := NewPlacement()
+
+	// nothing is dropped without observations
+	assert.Equal(t, nodes, placement.Select(nodes, 1))
+
+	for _, node := range nodes[:3] {
+		placement.Observe(node.Id, 1<<20, time.Second)
+	}
+	placement.Observe(nodes[3].Id, 1<<10, time.Second)
+	placement.Observe(nodes[4].Id, 0, time.Second)
+
+	throughput, ok := placement.Throughput(nodes[0].Id)
+	assert.True(t, ok)
+	assert.Equal(t, float64(1<<20), throughput)
+
+	// the slow nodes are dropped, the unknown node is kept
+	selected := placement.Select(nodes, 3)
+	assert.Equal(t, []*pb.Node{nodes[0], nodes[1], nodes[2], nil, nil, nodes[5]}, selected)
+	assert.Equal(t, len(nodes), nonNilCount(nodes), "nodes must not be modified")
+
+	// the slowest node is dropped first, but enough nodes are kept
+	selected = placement.Select(nodes, 5)
+	assert.Equal(t, []*pb.Node{nodes[0], nodes[1], nodes[2], nodes[3], nil, nodes[5]}, selected)
+
+	// observations are smoothed
+	placement.Observe(nodes[4].Id, 2<<20, time.Second)
+	throughput, _ = placement.Throughput(nodes[4].Id)
+	assert.Equal(t, float64(1<<20), throughput)
+	selected = placement.Select(nodes, 1)
+	assert.Equal(t, []*pb.Node{nodes[0], nodes[1], nodes[2], nil, nodes[4], nodes[5]}, selected)
+}
+
+func TestPlacementContext(t *testing.T) {
+	ctx := context.Background()
+
+	_, ok := GetPlacement(ctx)
+	assert.False(t, ok)
+
+	placement := NewPlacement()
+	got, ok := GetPlacement(WithPlacement(ctx, placement))
+	assert.True(t, ok)
+	assert.Equal(t, placement, got)
+}
@@ -17,6 +17,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 
+	bloomfilter "storj.io/storj/pkg/bloomfilter"
 	pb "storj.io/storj/pkg/pb"
 	client "storj.io/storj/pkg/piecestore/psclient"
 	ranger "storj.io/storj/pkg/ranger"
@@ -107,6 +108,44 @@ func (mr *MockPSClientMockRecorder) Put(arg0, arg1, arg2, arg3, arg4, arg5 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockPSClient)(nil).Put), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// ReportStats mocks base method
+func (m *MockPSClient) ReportStats(arg0 context.Context, arg1 *pb.ReportStatsRequest) error {
+	ret := m.ctrl.Call(m, "ReportStats", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportStats indicates an expected call of ReportStats
+func (mr *MockPSClientMockRecorder) ReportStats(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportStats", reflect.TypeOf((*MockPSClient)(nil).ReportStats), arg0, arg1)
+}
+
+// RestoreTrash mocks base method
+func (m *MockPSClient) RestoreTrash(arg0 context.Context, arg1 time.Time) (int64, error) {
+	ret := m.ctrl.Call(m, "RestoreTrash", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTrash indicates an expected call of RestoreTrash
+func (mr *MockPSClientMockRecorder) RestoreTrash(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTrash", reflect.TypeOf((*MockPSClient)(nil).RestoreTrash), arg0, arg1)
+}
+
+// Retain mocks base method
+func (m *MockPSClient) Retain(arg0 context.Context, arg1 *bloomfilter.Filter, arg2 time.Time) (int64, error) {
+	ret := m.ctrl.Call(m, "Retain", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retain indicates an expected call of Retain
+func (mr *MockPSClientMockRecorder) Retain(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retain", reflect.TypeOf((*MockPSClient)(nil).Retain), arg0, arg1, arg2)
+}
+
 // Stats mocks base method
 func (m *MockPSClient) Stats(arg0 context.Context) (*pb.StatSummary, error) {
 	ret := m.ctrl.Call(m, "Stats", arg0)
@@ -28,6 +28,7 @@ type Meta struct {
 	Expiration time.Time
 	Size       int64
 	Checksum   string
+	VersionID  string
 }
 
 // ListItem is a single item in a listing
@@ -155,6 +156,8 @@ func convertMeta(m streams.Meta) Meta {
 		Modified:         m.Modified,
 		Expiration:       m.Expiration,
 		Size:             m.Size,
+		Checksum:         string(m.ETag),
+		VersionID:        m.VersionID,
 		SerializableMeta: ser,
 	}
 }
@@ -79,16 +79,16 @@ func (mr *MockStoreMockRecorder) Repair(ctx, path, lostPieces interface{}) *gomo
 }
 
 // Put mocks base method
-func (m *MockStore) Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (Meta, error) {
-	ret := m.ctrl.Call(m, "Put", ctx, data, expiration, segmentInfo)
+func (m *MockStore) Put(ctx context.Context, objectPath storj.Path, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (Meta, error) {
+	ret := m.ctrl.Call(m, "Put", ctx, objectPath, data, expiration, segmentInfo)
 	ret0, _ := ret[0].(Meta)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Put indicates an expected call of Put
-func (mr *MockStoreMockRecorder) Put(ctx, data, expiration, segmentInfo interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), ctx, data, expiration, segmentInfo)
+func (mr *MockStoreMockRecorder) Put(ctx, objectPath, data, expiration, segmentInfo interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), ctx, objectPath, data, expiration, segmentInfo)
 }
 
 // Delete mocks base method
@@ -103,6 +103,18 @@ func (mr *MockStoreMockRecorder) Delete(ctx, path interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), ctx, path)
 }
 
+// Move mocks base method
+func (m *MockStore) Move(ctx context.Context, path, newPath storj.Path, metadata []byte) error {
+	ret := m.ctrl.Call(m, "Move", ctx, path, newPath, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move
+func (mr *MockStoreMockRecorder) Move(ctx, path, newPath, metadata interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockStore)(nil).Move), ctx, path, newPath, metadata)
+}
+
 // List mocks base method
 func (m *MockStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) ([]ListItem, bool, error) {
 	ret := m.ctrl.Call(m, "List", ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags)
@@ -0,0 +1,162 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segments
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storj"
+)
+
+const (
+	// allocationMargin is how long before its expiration a payer bandwidth
+	// allocation is renewed instead of being used for new requests
+	allocationMargin = time.Minute
+	// maxAllocationRenewals bounds how often a single read of a segment
+	// renews its payer bandwidth allocation
+	maxAllocationRenewals = 16
+)
+
+// expiring returns whether the payer bandwidth allocation expires within allocationMargin of now
+func expiring(pba *pb.PayerBandwidthAllocation, now time.Time) bool {
+	if pba == nil || pba.GetExpirationUnixSec() == 0 {
+		return false
+	}
+	return !now.Add(allocationMargin).Before(time.Unix(pba.GetExpirationUnixSec(), 0))
+}
+
+// renewingRanger downloads a remote segment and requests a fresh payer
+// bandwidth allocation from the pointerdb whenever the current one expires,
+// so downloads taking longer than the lifespan of an allocation succeed
+type renewingRanger struct {
+	store   *segmentStore
+	path    storj.Path
+	pointer *pb.Pointer
+
+	mu     sync.Mutex
+	ranger ranger.Ranger
+	pba    *pb.PayerBandwidthAllocation
+}
+
+// Size implements Ranger.Size
+func (rr *renewingRanger) Size() int64 {
+	return rr.pointer.GetSegmentSize()
+}
+
+// Range implements Ranger.Range, the allocation is renewed while reading
+// until the deadline of ctx
+func (rr *renewingRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	reader := &renewingReader{
+		ctx:    ctx,
+		ranger: rr,
+		offset: offset,
+		length: length,
+	}
+	if err := reader.open(); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// current returns the ranger for the segment and the allocation it uses,
+// the allocation is renewed first if it is about to expire
+func (rr *renewingRanger) current(ctx context.Context) (_ ranger.Ranger, _ *pb.PayerBandwidthAllocation, err error) {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+
+	if !expiring(rr.pba, time.Now()) {
+		return rr.ranger, rr.pba, nil
+	}
+
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, nodes, pba, err := rr.store.pdb.Get(ctx, rr.path)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	if !samePointer(pointer, rr.pointer) {
+		return nil, nil, Error.New("segment %s changed during download", rr.path)
+	}
+
+	remote, err := rr.store.remoteRanger(ctx, pointer, nodes, pba)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zap.S().Debugf("Renewed expired payer bandwidth allocation for %s", rr.path)
+	rr.ranger, rr.pba = remote, pba
+	return rr.ranger, rr.pba, nil
+}
+
+// renewingReader reads a range of a segment and reopens the remaining part
+// of the range with a renewed allocation when reading fails after the
+// allocation expired
+type renewingReader struct {
+	ctx    context.Context
+	ranger *renewingRanger
+	offset int64
+	length int64
+
+	reader   io.ReadCloser
+	pba      *pb.PayerBandwidthAllocation
+	renewals int
+}
+
+// open starts reading the remaining part of the range, replacing the previous reader
+func (r *renewingReader) open() error {
+	rr, pba, err := r.ranger.current(r.ctx)
+	if err != nil {
+		return err
+	}
+	reader, err := rr.Range(r.ctx, r.offset, r.length)
+	if err != nil {
+		return err
+	}
+
+	if r.reader != nil {
+		if err := r.reader.Close(); err != nil {
+			zap.S().Debugf("Failed closing expired download of %s: %v", r.ranger.path, err)
+		}
+	}
+	r.reader, r.pba = reader, pba
+	return nil
+}
+
+// renewable returns whether a failed read should be retried with a renewed allocation
+func (r *renewingReader) renewable() bool {
+	return r.renewals < maxAllocationRenewals && r.ctx.Err() == nil && expiring(r.pba, time.Now())
+}
+
+// Read implements io.Reader
+func (r *renewingReader) Read(p []byte) (n int, err error) {
+	for {
+		n, err = r.reader.Read(p)
+		r.offset += int64(n)
+		r.length -= int64(n)
+		if err == nil || err == io.EOF || !r.renewable() {
+			return n, err
+		}
+
+		r.renewals++
+		if renewErr := r.open(); renewErr != nil {
+			return n, errs.Combine(err, renewErr)
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// Close implements io.Closer
+func (r *renewingReader) Close() error {
+	return r.reader.Close()
+}
@@ -0,0 +1,175 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segments
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mock_overlay "storj.io/storj/pkg/overlay/mocks"
+	"storj.io/storj/pkg/pb"
+	mock_pointerdb "storj.io/storj/pkg/pointerdb/pdbclient/mocks"
+	"storj.io/storj/pkg/ranger"
+	mock_ecclient "storj.io/storj/pkg/storage/ec/mocks"
+)
+
+// expiredRanger returns readers which fail after reading a few bytes, like
+// downloads with an allocation expiring during the transfer
+type expiredRanger struct {
+	data  []byte
+	after int
+}
+
+func (rr *expiredRanger) Size() int64 { return int64(len(rr.data)) }
+
+func (rr *expiredRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	return ioutil.NopCloser(io.MultiReader(
+		bytes.NewReader(rr.data[offset:offset+int64(rr.after)]),
+		&failingReader{errors.New("allocation expired")},
+	)), nil
+}
+
+type failingReader struct{ err error }
+
+func (r *failingReader) Read(p []byte) (int, error) { return 0, r.err }
+
+func TestRenewingRanger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := []byte("0123456789abcdef")
+	pointer := &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			Redundancy: &pb.RedundancyScheme{
+				Type:             pb.RedundancyScheme_RS,
+				MinReq:           1,
+				Total:            2,
+				RepairThreshold:  1,
+				SuccessThreshold: 2,
+			},
+			PieceId:      "here's my piece id",
+			RemotePieces: []*pb.RemotePiece{},
+		},
+		SegmentSize: int64(len(data)),
+	}
+
+	expired := &pb.PayerBandwidthAllocation{ExpirationUnixSec: time.Now().Add(-time.Hour).Unix()}
+	fresh := &pb.PayerBandwidthAllocation{ExpirationUnixSec: time.Now().Add(time.Hour).Unix()}
+
+	newRanger := func(mockPDB *mock_pointerdb.MockClient, mockOC *mock_overlay.MockClient, mockEC *mock_ecclient.MockClient, pba *pb.PayerBandwidthAllocation) *renewingRanger {
+		return &renewingRanger{
+			store:   &segmentStore{oc: mockOC, ec: mockEC, pdb: mockPDB},
+			path:    "s0/path",
+			pointer: pointer,
+			ranger:  &expiredRanger{data: data, after: 3},
+			pba:     pba,
+		}
+	}
+
+	t.Run("renewed", func(t *testing.T) {
+		mockOC := mock_overlay.NewMockClient(ctrl)
+		mockEC := mock_ecclient.NewMockClient(ctrl)
+		mockPDB := mock_pointerdb.NewMockClient(ctrl)
+
+		// the allocation expires during the first read
+		pba := &pb.PayerBandwidthAllocation{ExpirationUnixSec: time.Now().Add(time.Hour).Unix()}
+		rr := newRanger(mockPDB, mockOC, mockEC, pba)
+
+		gomock.InOrder(
+			mockPDB.EXPECT().Get(gomock.Any(), "s0/path").Return(pointer, nil, fresh, nil),
+			mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()),
+			mockPDB.EXPECT().SignedMessage(),
+			mockEC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), fresh, gomock.Any()).
+				Return(ranger.ByteRanger(data), nil),
+		)
+
+		reader, err := rr.Range(ctx, 2, 12)
+		require.NoError(t, err)
+		defer func() { assert.NoError(t, reader.Close()) }()
+
+		pba.ExpirationUnixSec = time.Now().Add(-time.Second).Unix()
+
+		read, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, data[2:14], read)
+	})
+
+	t.Run("expired before reading", func(t *testing.T) {
+		mockOC := mock_overlay.NewMockClient(ctrl)
+		mockEC := mock_ecclient.NewMockClient(ctrl)
+		mockPDB := mock_pointerdb.NewMockClient(ctrl)
+		rr := newRanger(mockPDB, mockOC, mockEC, expired)
+
+		gomock.InOrder(
+			mockPDB.EXPECT().Get(gomock.Any(), "s0/path").Return(pointer, nil, fresh, nil),
+			mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()),
+			mockPDB.EXPECT().SignedMessage(),
+			mockEC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), fresh, gomock.Any()).
+				Return(ranger.ByteRanger(data), nil),
+		)
+
+		reader, err := rr.Range(ctx, 0, int64(len(data)))
+		require.NoError(t, err)
+		read, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, data, read)
+		assert.NoError(t, reader.Close())
+	})
+
+	t.Run("not expired", func(t *testing.T) {
+		mockOC := mock_overlay.NewMockClient(ctrl)
+		mockEC := mock_ecclient.NewMockClient(ctrl)
+		mockPDB := mock_pointerdb.NewMockClient(ctrl)
+		rr := newRanger(mockPDB, mockOC, mockEC, fresh)
+
+		// failures unrelated to the allocation are returned
+		reader, err := rr.Range(ctx, 0, int64(len(data)))
+		require.NoError(t, err)
+		_, err = ioutil.ReadAll(reader)
+		assert.Error(t, err)
+		assert.NoError(t, reader.Close())
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		mockOC := mock_overlay.NewMockClient(ctrl)
+		mockEC := mock_ecclient.NewMockClient(ctrl)
+		mockPDB := mock_pointerdb.NewMockClient(ctrl)
+
+		pba := &pb.PayerBandwidthAllocation{ExpirationUnixSec: time.Now().Add(time.Hour).Unix()}
+		rr := newRanger(mockPDB, mockOC, mockEC, pba)
+
+		readCtx, cancel := context.WithCancel(ctx)
+		reader, err := rr.Range(readCtx, 0, int64(len(data)))
+		require.NoError(t, err)
+
+		pba.ExpirationUnixSec = time.Now().Add(-time.Second).Unix()
+		cancel()
+
+		_, err = ioutil.ReadAll(reader)
+		assert.Error(t, err)
+		assert.NoError(t, reader.Close())
+	})
+
+	t.Run("segment changed", func(t *testing.T) {
+		mockOC := mock_overlay.NewMockClient(ctrl)
+		mockEC := mock_ecclient.NewMockClient(ctrl)
+		mockPDB := mock_pointerdb.NewMockClient(ctrl)
+		rr := newRanger(mockPDB, mockOC, mockEC, expired)
+
+		mockPDB.EXPECT().Get(gomock.Any(), "s0/path").Return(&pb.Pointer{Type: pb.Pointer_INLINE}, nil, fresh, nil)
+
+		_, err := rr.Range(ctx, 0, int64(len(data)))
+		assert.Error(t, err)
+	})
+}
@@ -4,10 +4,14 @@
 package segments
 
 import (
+	"bytes"
 	"context"
+	"io"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/piecestore/psclient"
@@ -22,25 +26,143 @@ type Repairer struct {
 	ec        ecclient.Client
 	pdb       pdbclient.Client
 	nodeStats *pb.NodeStats
+	placement *pb.PlacementConstraints
+	scheduler DownloadScheduler
 }
 
-// NewSegmentRepairer creates a new instance of SegmentRepairer
-func NewSegmentRepairer(oc overlay.Client, ec ecclient.Client, pdb pdbclient.Client) *Repairer {
-	return &Repairer{oc: oc, ec: ec, pdb: pdb}
+// DownloadScheduler selects the nodes repairs download pieces from
+type DownloadScheduler interface {
+	// Schedule selects count of the non-nil nodes, keeping their positions,
+	// and may wait until the nodes have room for another download. release
+	// must be called once the downloads from the selected nodes are done.
+	Schedule(ctx context.Context, nodes []*pb.Node, count int) (selected []*pb.Node, release func(), err error)
 }
 
-// Repair retrieves an at-risk segment and repairs and stores lost pieces on new nodes
-func (s *Repairer) Repair(ctx context.Context, path storj.Path, lostPieces []int32) (err error) {
+// NewSegmentRepairer creates a new instance of SegmentRepairer, the new nodes
+// of repaired segments are selected with the placement constraints. When
+// scheduler is nil the pieces are downloaded from the first healthy nodes.
+func NewSegmentRepairer(oc overlay.Client, ec ecclient.Client, pdb pdbclient.Client, placement *pb.PlacementConstraints, scheduler DownloadScheduler) *Repairer {
+	return &Repairer{oc: oc, ec: ec, pdb: pdb, placement: placement, scheduler: scheduler}
+}
+
+// RepairPlan describes the transfers needed to repair a segment
+type RepairPlan struct {
+	Path storj.Path
+	// HealthyPieces is the number of pieces which don't need to be repaired
+	HealthyPieces int
+	// DownloadNodes are the nodes the pieces to rebuild the segment are downloaded from
+	DownloadNodes storj.NodeIDList
+	// UploadNodes are the new nodes the repaired pieces are uploaded to
+	UploadNodes storj.NodeIDList
+	PieceSize   int64
+}
+
+// DownloadBytes returns the number of bytes downloaded to repair the segment
+func (plan *RepairPlan) DownloadBytes() int64 {
+	return int64(len(plan.DownloadNodes)) * plan.PieceSize
+}
+
+// UploadBytes returns the number of bytes uploaded to repair the segment
+func (plan *RepairPlan) UploadBytes() int64 {
+	return int64(len(plan.UploadNodes)) * plan.PieceSize
+}
+
+// RepairOutcome classifies how a segment repair ended
+type RepairOutcome string
+
+const (
+	// RepairSucceeded means the lost pieces were recreated and the pointer updated
+	RepairSucceeded = RepairOutcome("success")
+	// RepairDownloadFailed means the healthy pieces couldn't be downloaded and decoded
+	RepairDownloadFailed = RepairOutcome("failed_download")
+	// RepairUploadFailed means none of the recreated pieces could be uploaded
+	RepairUploadFailed = RepairOutcome("failed_upload")
+	// RepairNotEnoughNodes means there weren't enough healthy pieces to
+	// download or enough new nodes to upload to
+	RepairNotEnoughNodes = RepairOutcome("not_enough_nodes")
+	// RepairPointerChanged means the segment was deleted or overwritten during the repair
+	RepairPointerChanged = RepairOutcome("pointer_changed")
+	// RepairFailed is any other failure, e.g. looking up the pointer
+	RepairFailed = RepairOutcome("failed")
+)
+
+// RepairOutcomes lists all repair outcomes
+var RepairOutcomes = []RepairOutcome{
+	RepairSucceeded, RepairDownloadFailed, RepairUploadFailed,
+	RepairNotEnoughNodes, RepairPointerChanged, RepairFailed,
+}
+
+// RepairReport describes how a segment repair ended
+type RepairReport struct {
+	Path        storj.Path
+	Outcome     RepairOutcome
+	SegmentSize int64
+	// FailedDownloads are the nodes pieces were downloaded from when the
+	// download failed, decoding doesn't tell which of them caused it
+	FailedDownloads storj.NodeIDList
+	// FailedUploads are the new nodes recreated pieces failed to upload to,
+	// these are reported for successful repairs as well
+	FailedUploads storj.NodeIDList
+}
+
+// errNotEnoughNodes is the class of errors for repairs without enough nodes
+var errNotEnoughNodes = errs.Class("not enough nodes")
+
+// segmentRepair contains everything needed to transfer the pieces of a segment repair
+type segmentRepair struct {
+	pointer       *pb.Pointer
+	pieceID       psclient.PieceID
+	rs            eestream.RedundancyStrategy
+	healthyNodes  []*pb.Node
+	downloadNodes []*pb.Node
+	repairNodes   []*pb.Node
+}
+
+// Plan evaluates how an at-risk segment would be repaired, without
+// downloading, uploading or modifying anything
+func (s *Repairer) Plan(ctx context.Context, path storj.Path, lostPieces []int32) (plan *RepairPlan, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	repair, err := s.prepare(ctx, path, lostPieces)
+	if err != nil {
+		return nil, err
+	}
+
+	plan = &RepairPlan{Path: path}
+	if repair == nil {
+		return plan, nil
+	}
+
+	plan.PieceSize = ecclient.CalcPieceSize(repair.pointer.GetSegmentSize(), repair.rs)
+	for _, node := range repair.healthyNodes {
+		if node != nil {
+			plan.HealthyPieces++
+		}
+	}
+	for _, node := range repair.downloadNodes {
+		if node != nil {
+			plan.DownloadNodes = append(plan.DownloadNodes, node.Id)
+		}
+	}
+	for _, node := range repair.repairNodes {
+		if node != nil {
+			plan.UploadNodes = append(plan.UploadNodes, node.Id)
+		}
+	}
+	return plan, nil
+}
+
+// prepare looks up the pointer of a segment and selects the nodes to
+// download from and to upload to, nil is returned when there's nothing to repair
+func (s *Repairer) prepare(ctx context.Context, path storj.Path, lostPieces []int32) (repair *segmentRepair, err error) {
 	// Read the segment's pointer's info from the PointerDB
 	pr, originalNodes, _, err := s.pdb.Get(ctx, path)
 	if err != nil {
-		return Error.Wrap(err)
+		return nil, Error.Wrap(err)
 	}
 
 	if pr.GetType() != pb.Pointer_REMOTE {
-		return Error.New("cannot repair inline segment %s", psclient.PieceID(pr.GetInlineSegment()))
+		return nil, Error.New("cannot repair inline segment %s", psclient.PieceID(pr.GetInlineSegment()))
 	}
 
 	seg := pr.GetRemote()
@@ -48,119 +170,241 @@ func (s *Repairer) Repair(ctx context.Context, path storj.Path, lostPieces []int
 
 	originalNodes, err = lookupAndAlignNodes(ctx, s.oc, originalNodes, seg)
 	if err != nil {
-		return Error.Wrap(err)
+		return nil, Error.Wrap(err)
+	}
+
+	rs, err := makeRedundancyStrategy(seg.GetRedundancy())
+	if err != nil {
+		return nil, Error.Wrap(err)
 	}
 
 	// Get the nodes list that needs to be excluded
 	var excludeNodeIDs storj.NodeIDList
 
-	// Count the number of nil nodes thats needs to be repaired
-	totalNilNodes := 0
-
 	healthyNodes := make([]*pb.Node, len(originalNodes))
+	healthyCount := 0
 
 	// Populate healthyNodes with all nodes from originalNodes except those correlating to indices in lostPieces
 	for i, v := range originalNodes {
 		if v == nil {
-			totalNilNodes++
 			continue
 		}
 		v.Type.DPanicOnInvalid("repair")
 		excludeNodeIDs = append(excludeNodeIDs, v.Id)
 
-		// If node index exists in lostPieces, skip adding it to healthyNodes
-		if contains(lostPieces, i) {
-			totalNilNodes++
-		} else {
+		if !contains(lostPieces, i) {
 			healthyNodes[i] = v
+			healthyCount++
 		}
 	}
 
-	// Request Overlay for n-h new storage nodes
-	op := overlay.Options{Amount: totalNilNodes, Space: 0, Excluded: excludeNodeIDs}
+	if healthyCount < rs.RequiredCount() {
+		return nil, Error.Wrap(errNotEnoughNodes.New("healthy pieces (%d) below the %d required to repair", healthyCount, rs.RequiredCount()))
+	}
+
+	// Only the pieces missing to reach the success threshold are recreated,
+	// uploads don't store more pieces than that either
+	missingCount := rs.OptimalThreshold() - healthyCount
+	if missingCount <= 0 {
+		return nil, nil
+	}
+
+	// Request Overlay for the new storage nodes
+	op := overlay.Options{Amount: missingCount, Space: 0, Excluded: excludeNodeIDs, Placement: s.placement}
 	newNodes, err := s.oc.Choose(ctx, op)
 	if err != nil {
-		return err
+		if overlay.ErrNotEnoughNodes.Has(err) {
+			return nil, Error.Wrap(errNotEnoughNodes.Wrap(err))
+		}
+		return nil, err
 	}
 
-	if totalNilNodes != len(newNodes) {
-		return Error.New("Number of new nodes from overlay (%d) does not equal total nil nodes (%d)", len(newNodes), totalNilNodes)
+	if missingCount != len(newNodes) {
+		return nil, Error.Wrap(errNotEnoughNodes.New("number of new nodes from overlay (%d) does not equal missing pieces (%d)", len(newNodes), missingCount))
 	}
 
-	totalRepairCount := len(newNodes)
-
-	// Make a repair nodes list just with new unique ids
+	// Assign the new nodes to the first missing piece indices
 	repairNodes := make([]*pb.Node, len(healthyNodes))
-	for i, vr := range healthyNodes {
-		// Check that totalRepairCount is non-negative
-		if totalRepairCount < 0 {
-			return Error.New("Total repair count (%d) less than zero", totalRepairCount)
+	for i, v := range healthyNodes {
+		if v == nil && len(newNodes) > 0 {
+			repairNodes[i] = newNodes[0]
+			newNodes = newNodes[1:]
 		}
+	}
 
-		// Find the nil nodes in the healthyNodes list
-		if vr == nil {
-			// Assign the item in repairNodes list with an item from the newNode list
-			totalRepairCount--
-			repairNodes[i] = newNodes[totalRepairCount]
+	// Download only the minimum number of pieces required to decode the segment
+	downloadNodes := make([]*pb.Node, len(healthyNodes))
+	downloadCount := 0
+	for i, v := range healthyNodes {
+		if v != nil && downloadCount < rs.RequiredCount() {
+			downloadNodes[i] = v
+			downloadCount++
 		}
 	}
-	for _, v := range repairNodes {
-		if v != nil {
-			v.Type.DPanicOnInvalid("repair 2")
+
+	return &segmentRepair{
+		pointer:       pr,
+		pieceID:       pid,
+		rs:            rs,
+		healthyNodes:  healthyNodes,
+		downloadNodes: downloadNodes,
+		repairNodes:   repairNodes,
+	}, nil
+}
+
+// Repair retrieves an at-risk segment and repairs and stores lost pieces on
+// new nodes, the report describes how the repair ended even when it failed
+func (s *Repairer) Repair(ctx context.Context, path storj.Path, lostPieces []int32) (report *RepairReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report = &RepairReport{Path: path, Outcome: RepairSucceeded}
+	err = s.repair(ctx, report, lostPieces)
+	if err != nil && report.Outcome == RepairSucceeded {
+		report.Outcome = RepairFailed
+		if errNotEnoughNodes.Has(err) {
+			report.Outcome = RepairNotEnoughNodes
 		}
 	}
+	return report, err
+}
+
+// repair repairs the segment, classifying failures in report
+func (s *Repairer) repair(ctx context.Context, report *RepairReport, lostPieces []int32) (err error) {
+	path := report.Path
+
+	repair, err := s.prepare(ctx, path, lostPieces)
+	if err != nil || repair == nil {
+		return err
+	}
+
+	pr, pid, rs := repair.pointer, repair.pieceID, repair.rs
+	seg := pr.GetRemote()
+	healthyNodes, downloadNodes, repairNodes := repair.healthyNodes, repair.downloadNodes, repair.repairNodes
+	report.SegmentSize = pr.GetSegmentSize()
 
-	// Check that all nil nodes have a replacement prepared
-	if totalRepairCount != 0 {
-		return Error.New("Failed to replace all nil nodes (%d). (%d) new nodes not inserted", len(newNodes), totalRepairCount)
+	if s.scheduler != nil {
+		var release func()
+		downloadNodes, release, err = s.scheduler.Schedule(ctx, healthyNodes, rs.RequiredCount())
+		if err != nil {
+			return Error.Wrap(errNotEnoughNodes.Wrap(err))
+		}
+		defer release()
 	}
 
-	rs, err := makeRedundancyStrategy(pr.GetRemote().GetRedundancy())
-	if err != nil {
+	downloadFailed := func(err error) error {
+		report.Outcome = RepairDownloadFailed
+		for _, node := range downloadNodes {
+			if node != nil {
+				report.FailedDownloads = append(report.FailedDownloads, node.Id)
+			}
+		}
 		return Error.Wrap(err)
 	}
 
 	signedMessage := s.pdb.SignedMessage()
-	pbaGet, err := s.pdb.PayerBandwidthAllocation(ctx, pb.BandwidthAction_GET_REPAIR)
+	pbaGet, err := s.pdb.PayerBandwidthAllocation(ctx, pb.BandwidthAction_GET_REPAIR, path)
 	if err != nil {
 		return Error.Wrap(err)
 	}
-	// Download the segment using just the healthyNodes
-	rr, err := s.ec.Get(ctx, healthyNodes, rs, pid, pr.GetSegmentSize(), pbaGet, signedMessage)
+	rr, err := s.ec.Get(ctx, downloadNodes, rs, pid, pr.GetSegmentSize(), pbaGet, signedMessage)
 	if err != nil {
-		return Error.Wrap(err)
+		return downloadFailed(err)
 	}
 
-	r, err := rr.Range(ctx, 0, rr.Size())
+	rc, err := rr.Range(ctx, 0, rr.Size())
 	if err != nil {
-		return Error.Wrap(err)
+		return downloadFailed(err)
 	}
-	defer func() { err = errs.Combine(err, r.Close()) }()
+	defer func() { err = errs.Combine(err, rc.Close()) }()
 
-	pbaPut, err := s.pdb.PayerBandwidthAllocation(ctx, pb.BandwidthAction_PUT_REPAIR)
+	// the pieces are downloaded while they are uploaded, reading errors
+	// tell download failures apart from upload failures
+	r := &readErrorTracker{Reader: rc}
+
+	pbaPut, err := s.pdb.PayerBandwidthAllocation(ctx, pb.BandwidthAction_PUT_REPAIR, path)
 	if err != nil {
 		return Error.Wrap(err)
 	}
-	// Upload the repaired pieces to the repairNodes
-	successfulNodes, err := s.ec.Put(ctx, repairNodes, rs, pid, r, convertTime(pr.GetExpirationDate()), pbaPut, signedMessage)
+	// Upload only the missing pieces to the repairNodes
+	successfulNodes, successfulHashes, err := s.ec.Repair(ctx, repairNodes, rs, pid, r, convertTime(pr.GetExpirationDate()), pbaPut, signedMessage)
+	if r.err != nil {
+		return downloadFailed(errs.Combine(r.err, err))
+	}
+	for i, node := range repairNodes {
+		if node != nil && (i >= len(successfulNodes) || successfulNodes[i] == nil) {
+			report.FailedUploads = append(report.FailedUploads, node.Id)
+		}
+	}
 	if err != nil {
+		report.Outcome = RepairUploadFailed
 		return Error.Wrap(err)
 	}
 
+	// Keep the piece hashes of the healthy nodes
+	hashes := make([]*pb.SignedMessage, len(healthyNodes))
+	for _, piece := range seg.GetRemotePieces() {
+		i := int(piece.GetPieceNum())
+		if i < len(healthyNodes) && healthyNodes[i] != nil {
+			hashes[i] = piece.GetHash()
+		}
+	}
+
 	// Merge the successful nodes list into the healthy nodes list
-	for i, v := range healthyNodes {
-		if v == nil {
-			// copy the successfuNode info
-			healthyNodes[i] = successfulNodes[i]
+	for i, v := range successfulNodes {
+		if v != nil {
+			healthyNodes[i] = v
+			hashes[i] = successfulHashes[i]
 		}
 	}
 
-	metadata := pr.GetMetadata()
-	pointer, err := makeRemotePointer(healthyNodes, rs, pid, rr.Size(), pr.GetExpirationDate(), metadata)
+	pointer, err := makeRemotePointer(healthyNodes, hashes, rs, pid, rr.Size(), pr.GetExpirationDate(), pr.GetMetadata())
 	if err != nil {
 		return err
 	}
+	// the repaired pieces are signed by the satellite, the others still by the uploader
+	pointer.Remote.UploaderKey = seg.GetUploaderKey()
+
+	// The segment may have been deleted or overwritten while it was repaired,
+	// in that case the repaired pieces are removed instead of replacing the pointer
+	current, _, _, err := s.pdb.Get(ctx, path)
+	if err != nil || !samePointer(current, pr) {
+		report.Outcome = RepairPointerChanged
+		return errs.Combine(
+			Error.New("segment %s changed during repair", path),
+			err,
+			s.ec.Delete(ctx, successfulNodes, pid, signedMessage),
+		)
+	}
 
 	// update the segment info in the pointerDB
 	return s.pdb.Put(ctx, path, pointer)
 }
+
+// readErrorTracker remembers the first error reading failed with
+type readErrorTracker struct {
+	io.Reader
+	err error
+}
+
+// Read reads from the underlying reader, recording errors other than io.EOF
+func (tracker *readErrorTracker) Read(p []byte) (n int, err error) {
+	n, err = tracker.Reader.Read(p)
+	if err != nil && err != io.EOF && tracker.err == nil {
+		tracker.err = err
+	}
+	return n, err
+}
+
+// samePointer compares pointers by their encoding, proto.Equal doesn't
+// support the custom types used in pointers
+func samePointer(a, b *pb.Pointer) bool {
+	encodedA, err := proto.Marshal(a)
+	if err != nil {
+		return false
+	}
+	encodedB, err := proto.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(encodedA, encodedB)
+}
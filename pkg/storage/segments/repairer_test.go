@@ -4,6 +4,7 @@
 package segments
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -12,11 +13,13 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/overlay"
 	mock_overlay "storj.io/storj/pkg/overlay/mocks"
 	"storj.io/storj/pkg/pb"
 	mock_pointerdb "storj.io/storj/pkg/pointerdb/pdbclient/mocks"
 	"storj.io/storj/pkg/ranger"
 	mock_ecclient "storj.io/storj/pkg/storage/ec/mocks"
+	"storj.io/storj/pkg/storj"
 )
 
 func TestNewSegmentRepairer(t *testing.T) {
@@ -27,7 +30,7 @@ func TestNewSegmentRepairer(t *testing.T) {
 	mockEC := mock_ecclient.NewMockClient(ctrl)
 	mockPDB := mock_pointerdb.NewMockClient(ctrl)
 
-	ss := NewSegmentRepairer(mockOC, mockEC, mockPDB)
+	ss := NewSegmentRepairer(mockOC, mockEC, mockPDB, nil, nil)
 	assert.NotNil(t, ss)
 }
 
@@ -39,84 +42,263 @@ func TestSegmentStoreRepairRemote(t *testing.T) {
 	someTime, err := ptypes.TimestampProto(ti)
 	assert.NoError(t, err)
 
-	for _, tt := range []struct {
-		pathInput               string
-		thresholdSize           int
-		pointerType             pb.Pointer_DataType
-		size                    int64
-		metadata                []byte
-		lostPieces              []int32
-		newNodes                []*pb.Node
-		data                    string
-		strsize, offset, length int64
-		substr                  string
-		meta                    Meta
-	}{
-		{
-			"path/1/2/3",
-			10,
-			pb.Pointer_REMOTE,
-			int64(3),
-			[]byte("metadata"),
-			[]int32{},
-			[]*pb.Node{
-				teststorj.MockNode("1"),
-				teststorj.MockNode("2"),
+	mockOC := mock_overlay.NewMockClient(ctrl)
+	mockEC := mock_ecclient.NewMockClient(ctrl)
+	mockPDB := mock_pointerdb.NewMockClient(ctrl)
+
+	sr := Repairer{mockOC, mockEC, mockPDB, &pb.NodeStats{}, nil, nil}
+
+	// pieces 0, 1 and 2 are healthy, piece 3 is lost and piece 4 is missing
+	oldNodes := []*pb.Node{
+		teststorj.MockNode("1"),
+		teststorj.MockNode("2"),
+		teststorj.MockNode("3"),
+		teststorj.MockNode("4"),
+	}
+	var pieces []*pb.RemotePiece
+	for i, node := range oldNodes {
+		pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: node.Id})
+	}
+	pointer := &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			Redundancy: &pb.RedundancyScheme{
+				Type:             pb.RedundancyScheme_RS,
+				MinReq:           2,
+				Total:            6,
+				RepairThreshold:  3,
+				SuccessThreshold: 5,
+				ErasureShareSize: 256,
+			},
+			PieceId:      "here's my piece id",
+			RemotePieces: pieces,
+		},
+		CreationDate:   someTime,
+		ExpirationDate: someTime,
+		SegmentSize:    int64(12),
+		Metadata:       []byte("metadata"),
+	}
+	newNodes := []*pb.Node{
+		teststorj.MockNode("5"),
+		teststorj.MockNode("6"),
+	}
+
+	gomock.InOrder(
+		mockPDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(pointer, nil, nil, nil),
+		mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()).Return(oldNodes, nil),
+		mockOC.EXPECT().Choose(gomock.Any(), overlay.Options{
+			Amount:   2,
+			Excluded: storj.NodeIDList{oldNodes[0].Id, oldNodes[1].Id, oldNodes[2].Id, oldNodes[3].Id},
+		}).Return(newNodes, nil),
+		mockPDB.EXPECT().SignedMessage(),
+		mockPDB.EXPECT().PayerBandwidthAllocation(gomock.Any(), gomock.Any(), gomock.Any()),
+		mockEC.EXPECT().Get(
+			gomock.Any(), []*pb.Node{oldNodes[0], oldNodes[1], nil, nil, nil, nil},
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Return(ranger.ByteRanger([]byte("abcdefghijkl")), nil),
+		mockPDB.EXPECT().PayerBandwidthAllocation(gomock.Any(), gomock.Any(), gomock.Any()),
+		mockEC.EXPECT().Repair(
+			gomock.Any(), []*pb.Node{nil, nil, nil, newNodes[0], newNodes[1], nil},
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Return([]*pb.Node{nil, nil, nil, newNodes[0], nil, nil}, make([]*pb.SignedMessage, 6), nil),
+		mockPDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(pointer, nil, nil, nil),
+		mockPDB.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, path string, repaired *pb.Pointer) error {
+				// the piece which failed to upload stays missing
+				var nums []int32
+				for _, piece := range repaired.GetRemote().GetRemotePieces() {
+					nums = append(nums, piece.PieceNum)
+				}
+				assert.Equal(t, []int32{0, 1, 2, 3}, nums)
+				assert.Equal(t, newNodes[0].Id, repaired.GetRemote().GetRemotePieces()[3].NodeId)
+				return nil
+			}),
+	)
+
+	report, err := sr.Repair(ctx, "path/1/2/3", []int32{3})
+	assert.NoError(t, err)
+	assert.Equal(t, RepairSucceeded, report.Outcome)
+	assert.Equal(t, int64(12), report.SegmentSize)
+	assert.Equal(t, storj.NodeIDList{newNodes[1].Id}, report.FailedUploads)
+}
+
+func TestSegmentStoreRepairChangedPointer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOC := mock_overlay.NewMockClient(ctrl)
+	mockEC := mock_ecclient.NewMockClient(ctrl)
+	mockPDB := mock_pointerdb.NewMockClient(ctrl)
+
+	sr := Repairer{mockOC, mockEC, mockPDB, &pb.NodeStats{}, nil, nil}
+
+	oldNodes := []*pb.Node{teststorj.MockNode("1"), teststorj.MockNode("2")}
+	pointer := &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			Redundancy: &pb.RedundancyScheme{
+				Type:             pb.RedundancyScheme_RS,
+				MinReq:           1,
+				Total:            3,
+				RepairThreshold:  2,
+				SuccessThreshold: 3,
+				ErasureShareSize: 256,
+			},
+			PieceId: "here's my piece id",
+			RemotePieces: []*pb.RemotePiece{
+				{PieceNum: 0, NodeId: oldNodes[0].Id},
+				{PieceNum: 1, NodeId: oldNodes[1].Id},
 			},
-			"abcdefghijkl",
-			12,
-			1,
-			4,
-			"bcde",
-			Meta{},
 		},
-	} {
-		mockOC := mock_overlay.NewMockClient(ctrl)
-		mockEC := mock_ecclient.NewMockClient(ctrl)
-		mockPDB := mock_pointerdb.NewMockClient(ctrl)
-
-		sr := Repairer{mockOC, mockEC, mockPDB, &pb.NodeStats{}}
-		assert.NotNil(t, sr)
-
-		calls := []*gomock.Call{
-			mockPDB.EXPECT().Get(
-				gomock.Any(), gomock.Any(),
-			).Return(&pb.Pointer{
-				Type: tt.pointerType,
-				Remote: &pb.RemoteSegment{
-					Redundancy: &pb.RedundancyScheme{
-						Type:             pb.RedundancyScheme_RS,
-						MinReq:           1,
-						Total:            2,
-						RepairThreshold:  1,
-						SuccessThreshold: 2,
-					},
-					PieceId:      "here's my piece id",
-					RemotePieces: []*pb.RemotePiece{},
-				},
-				CreationDate:   someTime,
-				ExpirationDate: someTime,
-				SegmentSize:    tt.size,
-				Metadata:       tt.metadata,
-			}, nil, nil, nil),
-			mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()),
-			mockOC.EXPECT().Choose(gomock.Any(), gomock.Any()).Return(tt.newNodes, nil),
+		SegmentSize: int64(12),
+	}
+	changed := *pointer
+	changed.SegmentSize = 24
+	newNode := teststorj.MockNode("3")
+	repaired := []*pb.Node{nil, nil, newNode}
+
+	gomock.InOrder(
+		mockPDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(pointer, nil, nil, nil),
+		mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()).Return(oldNodes, nil),
+		mockOC.EXPECT().Choose(gomock.Any(), gomock.Any()).Return([]*pb.Node{newNode}, nil),
+		mockPDB.EXPECT().SignedMessage(),
+		mockPDB.EXPECT().PayerBandwidthAllocation(gomock.Any(), gomock.Any(), gomock.Any()),
+		mockEC.EXPECT().Get(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Return(ranger.ByteRanger([]byte("abcdefghijkl")), nil),
+		mockPDB.EXPECT().PayerBandwidthAllocation(gomock.Any(), gomock.Any(), gomock.Any()),
+		mockEC.EXPECT().Repair(
+			gomock.Any(), repaired, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Return(repaired, make([]*pb.SignedMessage, 3), nil),
+		mockPDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&changed, nil, nil, nil),
+		// the repaired pieces are removed again
+		mockEC.EXPECT().Delete(gomock.Any(), repaired, gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	report, err := sr.Repair(ctx, "path/1/2/3", nil)
+	assert.Error(t, err)
+	assert.Equal(t, RepairPointerChanged, report.Outcome)
+}
+
+func TestSegmentStoreRepairPlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOC := mock_overlay.NewMockClient(ctrl)
+	mockEC := mock_ecclient.NewMockClient(ctrl)
+	mockPDB := mock_pointerdb.NewMockClient(ctrl)
+
+	sr := Repairer{mockOC, mockEC, mockPDB, &pb.NodeStats{}, nil, nil}
+
+	// pieces 0, 1 and 2 are healthy, piece 3 is lost
+	oldNodes := []*pb.Node{
+		teststorj.MockNode("1"),
+		teststorj.MockNode("2"),
+		teststorj.MockNode("3"),
+		teststorj.MockNode("4"),
+	}
+	var pieces []*pb.RemotePiece
+	for i, node := range oldNodes {
+		pieces = append(pieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: node.Id})
+	}
+	pointer := &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			Redundancy: &pb.RedundancyScheme{
+				Type:             pb.RedundancyScheme_RS,
+				MinReq:           2,
+				Total:            6,
+				RepairThreshold:  3,
+				SuccessThreshold: 5,
+				ErasureShareSize: 256,
+			},
+			PieceId:      "here's my piece id",
+			RemotePieces: pieces,
+		},
+		SegmentSize: int64(12),
+	}
+	newNodes := []*pb.Node{
+		teststorj.MockNode("5"),
+		teststorj.MockNode("6"),
+	}
+
+	// planning doesn't transfer pieces or update the pointer
+	gomock.InOrder(
+		mockPDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(pointer, nil, nil, nil),
+		mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()).Return(oldNodes, nil),
+		mockOC.EXPECT().Choose(gomock.Any(), gomock.Any()).Return(newNodes, nil),
+	)
+
+	plan, err := sr.Plan(ctx, "path/1/2/3", []int32{3})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, plan.HealthyPieces)
+	assert.Equal(t, storj.NodeIDList{oldNodes[0].Id, oldNodes[1].Id}, plan.DownloadNodes)
+	assert.Equal(t, storj.NodeIDList{newNodes[0].Id, newNodes[1].Id}, plan.UploadNodes)
+	// the segment is padded to a single stripe of two erasure shares
+	assert.Equal(t, int64(256), plan.PieceSize)
+	assert.Equal(t, int64(512), plan.DownloadBytes())
+	assert.Equal(t, int64(512), plan.UploadBytes())
+}
+
+func TestSegmentStoreRepairOutcomes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOC := mock_overlay.NewMockClient(ctrl)
+	mockEC := mock_ecclient.NewMockClient(ctrl)
+	mockPDB := mock_pointerdb.NewMockClient(ctrl)
+
+	sr := Repairer{mockOC, mockEC, mockPDB, &pb.NodeStats{}, nil, nil}
+
+	oldNodes := []*pb.Node{teststorj.MockNode("1"), teststorj.MockNode("2")}
+	pointer := &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			Redundancy: &pb.RedundancyScheme{
+				Type:             pb.RedundancyScheme_RS,
+				MinReq:           1,
+				Total:            3,
+				RepairThreshold:  2,
+				SuccessThreshold: 3,
+				ErasureShareSize: 256,
+			},
+			PieceId: "here's my piece id",
+			RemotePieces: []*pb.RemotePiece{
+				{PieceNum: 0, NodeId: oldNodes[0].Id},
+				{PieceNum: 1, NodeId: oldNodes[1].Id},
+			},
+		},
+		SegmentSize: int64(12),
+	}
+
+	{ // all pieces are lost
+		gomock.InOrder(
+			mockPDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(pointer, nil, nil, nil),
+			mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()).Return(oldNodes, nil),
+		)
+
+		report, err := sr.Repair(ctx, "path/1/2/3", []int32{0, 1})
+		assert.Error(t, err)
+		assert.Equal(t, RepairNotEnoughNodes, report.Outcome)
+	}
+
+	{ // downloading the healthy pieces fails
+		gomock.InOrder(
+			mockPDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(pointer, nil, nil, nil),
+			mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()).Return(oldNodes, nil),
+			mockOC.EXPECT().Choose(gomock.Any(), gomock.Any()).Return([]*pb.Node{teststorj.MockNode("3")}, nil),
 			mockPDB.EXPECT().SignedMessage(),
-			mockPDB.EXPECT().PayerBandwidthAllocation(gomock.Any(), gomock.Any()),
+			mockPDB.EXPECT().PayerBandwidthAllocation(gomock.Any(), gomock.Any(), gomock.Any()),
 			mockEC.EXPECT().Get(
 				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-			).Return(ranger.ByteRanger([]byte(tt.data)), nil),
-			mockPDB.EXPECT().PayerBandwidthAllocation(gomock.Any(), gomock.Any()),
-			mockEC.EXPECT().Put(
-				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-			).Return(tt.newNodes, nil),
-			mockPDB.EXPECT().Put(
-				gomock.Any(), gomock.Any(), gomock.Any(),
-			).Return(nil),
-		}
-		gomock.InOrder(calls...)
-
-		err := sr.Repair(ctx, tt.pathInput, tt.lostPieces)
-		assert.NoError(t, err)
+			).Return(nil, Error.New("download failed")),
+		)
+
+		report, err := sr.Repair(ctx, "path/1/2/3", nil)
+		assert.Error(t, err)
+		assert.Equal(t, RepairDownloadFailed, report.Outcome)
+		assert.Equal(t, storj.NodeIDList{oldNodes[0].Id}, report.FailedDownloads)
+		assert.Equal(t, int64(12), report.SegmentSize)
 	}
 }
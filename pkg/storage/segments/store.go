@@ -48,8 +48,11 @@ type ListItem struct {
 type Store interface {
 	Meta(ctx context.Context, path storj.Path) (meta Meta, err error)
 	Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error)
-	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
+	// Put uploads a segment of the object at the encrypted objectPath, the
+	// path of the segment is returned by segmentInfo once the data is read
+	Put(ctx context.Context, objectPath storj.Path, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
+	Move(ctx context.Context, path, newPath storj.Path, metadata []byte) (err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
@@ -85,7 +88,7 @@ func (s *segmentStore) Meta(ctx context.Context, path storj.Path) (meta Meta, er
 }
 
 // Put uploads a segment to an erasure code client
-func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error) {
+func (s *segmentStore) Put(ctx context.Context, objectPath storj.Path, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	exp, err := ptypes.TimestampProto(expiration)
@@ -138,12 +141,14 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 		pieceID := psclient.NewPieceID()
 
 		authorization := s.pdb.SignedMessage()
-		pba, err := s.pdb.PayerBandwidthAllocation(ctx, pb.BandwidthAction_PUT)
+		// all the segments of an object are in its bucket and below its
+		// encrypted path, which is what restricted api keys are checked for
+		pba, err := s.pdb.PayerBandwidthAllocation(ctx, pb.BandwidthAction_PUT, storj.JoinPaths("l", objectPath))
 		if err != nil {
 			return Meta{}, Error.Wrap(err)
 		}
 
-		successfulNodes, err := s.ec.Put(ctx, nodes, s.rs, pieceID, sizedReader, expiration, pba, authorization)
+		successfulNodes, successfulHashes, err := s.ec.Put(ctx, nodes, s.rs, pieceID, sizedReader, expiration, pba, authorization)
 		if err != nil {
 			return Meta{}, Error.Wrap(err)
 		}
@@ -154,7 +159,7 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 		}
 		path = p
 
-		pointer, err = makeRemotePointer(successfulNodes, s.rs, pieceID, sizedReader.Size(), exp, metadata)
+		pointer, err = makeRemotePointer(successfulNodes, successfulHashes, s.rs, pieceID, sizedReader.Size(), exp, metadata)
 		if err != nil {
 			return Meta{}, err
 		}
@@ -187,60 +192,82 @@ func (s *segmentStore) Get(ctx context.Context, path storj.Path) (rr ranger.Rang
 	case pb.Pointer_INLINE:
 		rr = ranger.ByteRanger(pr.InlineSegment)
 	case pb.Pointer_REMOTE:
-		seg := pr.GetRemote()
-		pid := psclient.PieceID(seg.GetPieceId())
-
-		nodes, err = lookupAndAlignNodes(ctx, s.oc, nodes, seg)
-		if err != nil {
-			return nil, Meta{}, Error.Wrap(err)
-		}
-
-		rs, err := makeRedundancyStrategy(pr.GetRemote().GetRedundancy())
+		remote, err := s.remoteRanger(ctx, pr, nodes, pba)
 		if err != nil {
 			return nil, Meta{}, err
 		}
+		rr = &renewingRanger{
+			store:   s,
+			path:    path,
+			pointer: pr,
+			ranger:  remote,
+			pba:     pba,
+		}
+	default:
+		return nil, Meta{}, Error.New("unsupported pointer type: %d", pr.GetType())
+	}
 
-		needed := calcNeededNodes(pr.GetRemote().GetRedundancy())
-		selected := make([]*pb.Node, rs.TotalCount())
+	return rr, convertMeta(pr), nil
+}
 
-		for _, i := range rand.Perm(len(nodes)) {
-			node := nodes[i]
-			if node == nil {
-				continue
-			}
+// remoteRanger returns a ranger downloading the pieces of a remote segment
+// from a random selection of its nodes with the payer bandwidth allocation
+func (s *segmentStore) remoteRanger(ctx context.Context, pr *pb.Pointer, nodes []*pb.Node, pba *pb.PayerBandwidthAllocation) (rr ranger.Ranger, err error) {
+	seg := pr.GetRemote()
+	pid := psclient.PieceID(seg.GetPieceId())
 
-			selected[i] = node
+	nodes, err = lookupAndAlignNodes(ctx, s.oc, nodes, seg)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
 
-			needed--
-			if needed <= 0 {
-				break
-			}
-			node.Type.DPanicOnInvalid("ss get")
+	rs, err := makeRedundancyStrategy(seg.GetRedundancy())
+	if err != nil {
+		return nil, err
+	}
+
+	needed := calcNeededNodes(seg.GetRedundancy())
+	selected := make([]*pb.Node, rs.TotalCount())
+
+	for _, i := range rand.Perm(len(nodes)) {
+		node := nodes[i]
+		if node == nil {
+			continue
 		}
 
-		authorization := s.pdb.SignedMessage()
-		rr, err = s.ec.Get(ctx, selected, rs, pid, pr.GetSegmentSize(), pba, authorization)
-		if err != nil {
-			return nil, Meta{}, Error.Wrap(err)
+		selected[i] = node
+
+		needed--
+		if needed <= 0 {
+			break
 		}
-	default:
-		return nil, Meta{}, Error.New("unsupported pointer type: %d", pr.GetType())
+		node.Type.DPanicOnInvalid("ss get")
 	}
 
-	return rr, convertMeta(pr), nil
+	authorization := s.pdb.SignedMessage()
+	rr, err = s.ec.Get(ctx, selected, rs, pid, pr.GetSegmentSize(), pba, authorization)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return rr, nil
 }
 
 // makeRemotePointer creates a pointer of type remote
-func makeRemotePointer(nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, readerSize int64, exp *timestamp.Timestamp, metadata []byte) (pointer *pb.Pointer, err error) {
+func makeRemotePointer(nodes []*pb.Node, hashes []*pb.SignedMessage, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, readerSize int64, exp *timestamp.Timestamp, metadata []byte) (pointer *pb.Pointer, err error) {
 	var remotePieces []*pb.RemotePiece
 	for i := range nodes {
 		if nodes[i] == nil {
 			continue
 		}
 		nodes[i].Type.DPanicOnInvalid("makeremotepointer")
+		var hash *pb.SignedMessage
+		if i < len(hashes) {
+			hash = hashes[i]
+		}
 		remotePieces = append(remotePieces, &pb.RemotePiece{
 			PieceNum: int32(i),
 			NodeId:   nodes[i].Id,
+			Hash:     hash,
 		})
 	}
 
@@ -300,6 +327,14 @@ func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error)
 	return s.pdb.Delete(ctx, path)
 }
 
+// Move moves the segment at path to newPath with new metadata, only the
+// pointer is moved, the pieces stay on the storage nodes
+func (s *segmentStore) Move(ctx context.Context, path, newPath storj.Path, metadata []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(s.pdb.Move(ctx, path, newPath, metadata))
+}
+
 // List retrieves paths to segments and their metadata stored in the pointerdb
 func (s *segmentStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
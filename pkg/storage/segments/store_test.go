@@ -119,7 +119,7 @@ func TestSegmentStorePutRemote(t *testing.T) {
 				},
 			}, nil),
 			mockPDB.EXPECT().SignedMessage(),
-			mockPDB.EXPECT().PayerBandwidthAllocation(gomock.Any(), gomock.Any()),
+			mockPDB.EXPECT().PayerBandwidthAllocation(gomock.Any(), gomock.Any(), gomock.Any()),
 			mockEC.EXPECT().Put(
 				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 			),
@@ -135,7 +135,7 @@ func TestSegmentStorePutRemote(t *testing.T) {
 		}
 		gomock.InOrder(calls...)
 
-		_, err := ss.Put(ctx, strings.NewReader(tt.readerContent), tt.expiration, func() (storj.Path, []byte, error) {
+		_, err := ss.Put(ctx, "bucket/object", strings.NewReader(tt.readerContent), tt.expiration, func() (storj.Path, []byte, error) {
 			return tt.pathInput, tt.mdInput, nil
 		})
 		assert.NoError(t, err, tt.name)
@@ -177,7 +177,7 @@ func TestSegmentStorePutInline(t *testing.T) {
 		}
 		gomock.InOrder(calls...)
 
-		_, err := ss.Put(ctx, strings.NewReader(tt.readerContent), tt.expiration, func() (storj.Path, []byte, error) {
+		_, err := ss.Put(ctx, "bucket/object", strings.NewReader(tt.readerContent), tt.expiration, func() (storj.Path, []byte, error) {
 			return tt.pathInput, tt.mdInput, nil
 		})
 		assert.NoError(t, err, tt.name)
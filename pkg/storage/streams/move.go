@@ -0,0 +1,136 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+	"crypto/rand"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// Move moves the stream at path to newPath, replacing the stream at newPath.
+// The content of the segments is encrypted with random keys, only these keys
+// are encrypted with a key derived from the path, so the segments are moved
+// with their keys reencrypted and the data isn't downloaded. The last segment
+// is moved last, so an interrupted move continues when it's retried.
+func (s *streamStore) Move(ctx context.Context, path storj.Path, pathCipher storj.Cipher, newPath storj.Path, newPathCipher storj.Cipher) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encPath, err := s.access.EncryptPath(path, pathCipher)
+	if err != nil {
+		return err
+	}
+	newEncPath, err := s.access.EncryptPath(newPath, newPathCipher)
+	if err != nil {
+		return err
+	}
+	if encPath == newEncPath {
+		return nil
+	}
+
+	lastSegmentMeta, err := s.segments.Meta(ctx, storj.JoinPaths("l", encPath))
+	if err != nil {
+		return err
+	}
+	streamInfo, err := DecryptStreamInfo(ctx, lastSegmentMeta, path, s.access)
+	if err != nil {
+		return err
+	}
+	stream := pb.StreamInfo{}
+	if err = proto.Unmarshal(streamInfo, &stream); err != nil {
+		return err
+	}
+	streamMeta := pb.StreamMeta{}
+	if err = proto.Unmarshal(lastSegmentMeta.Data, &streamMeta); err != nil {
+		return err
+	}
+	cipher := storj.Cipher(streamMeta.EncryptionType)
+
+	derivedKey, err := s.access.ContentKey(path)
+	if err != nil {
+		return err
+	}
+	newDerivedKey, err := s.access.ContentKey(newPath)
+	if err != nil {
+		return err
+	}
+
+	// the pieces of the replaced stream are deleted by the uplink
+	err = s.delete(ctx, newPath, newPathCipher)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return err
+	}
+
+	for i := int64(0); i < stream.NumberOfSegments-1; i++ {
+		segmentPath := getSegmentPath(encPath, i)
+		newSegmentPath := getSegmentPath(newEncPath, i)
+
+		meta, err := s.segments.Meta(ctx, segmentPath)
+		if storage.ErrKeyNotFound.Has(err) {
+			// the segment was moved by an interrupted move
+			if _, newErr := s.segments.Meta(ctx, newSegmentPath); newErr == nil {
+				continue
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		segmentMeta := pb.SegmentMeta{}
+		if err = proto.Unmarshal(meta.Data, &segmentMeta); err != nil {
+			return err
+		}
+		if err = reencryptKey(&segmentMeta, cipher, derivedKey, newDerivedKey); err != nil {
+			return err
+		}
+		metadata, err := proto.Marshal(&segmentMeta)
+		if err != nil {
+			return err
+		}
+
+		if err = s.segments.Move(ctx, segmentPath, newSegmentPath, metadata); err != nil {
+			return err
+		}
+	}
+
+	if err = reencryptKey(streamMeta.LastSegmentMeta, cipher, derivedKey, newDerivedKey); err != nil {
+		return err
+	}
+	metadata, err := proto.Marshal(&streamMeta)
+	if err != nil {
+		return err
+	}
+	return s.segments.Move(ctx, storj.JoinPaths("l", encPath), storj.JoinPaths("l", newEncPath), metadata)
+}
+
+// reencryptKey decrypts the content key of a segment with derivedKey and
+// encrypts it with newDerivedKey and a new nonce
+func reencryptKey(meta *pb.SegmentMeta, cipher storj.Cipher, derivedKey, newDerivedKey *storj.Key) error {
+	if meta == nil || len(meta.EncryptedKey) == 0 {
+		return nil
+	}
+
+	encryptedKey, keyNonce := getEncryptedKeyAndNonce(meta)
+	contentKey, err := encryption.DecryptKey(encryptedKey, cipher, derivedKey, keyNonce)
+	if err != nil {
+		return err
+	}
+
+	var newKeyNonce storj.Nonce
+	if _, err = rand.Read(newKeyNonce[:]); err != nil {
+		return err
+	}
+	meta.EncryptedKey, err = encryption.EncryptKey(contentKey, cipher, newDerivedKey, &newKeyNonce)
+	if err != nil {
+		return err
+	}
+	meta.KeyNonce = newKeyNonce[:]
+	return nil
+}
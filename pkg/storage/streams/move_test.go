@@ -0,0 +1,71 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+func TestMove(t *testing.T) {
+	segmentStore := newMemorySegments()
+	streamStore, err := NewStreamStore(segmentStore, 100, encryption.NewAccess(new(storj.Key)), 64, storj.AESGCM)
+	require.NoError(t, err)
+
+	data := make([]byte, 370)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+
+	path := storj.JoinPaths("bucket", "object")
+	newPath := storj.JoinPaths("other", "moved")
+
+	_, err = streamStore.Put(ctx, path, storj.AESGCM, bytes.NewReader(data), []byte("metadata"), time.Time{})
+	require.NoError(t, err)
+	_, err = streamStore.Put(ctx, newPath, storj.SecretBox, bytes.NewReader([]byte("replaced")), nil, time.Time{})
+	require.NoError(t, err)
+
+	encrypted := map[storj.Path][]byte{}
+	for segmentPath, segmentData := range segmentStore.data {
+		encrypted[segmentPath] = segmentData
+	}
+
+	require.NoError(t, streamStore.Move(ctx, path, storj.AESGCM, newPath, storj.SecretBox))
+
+	_, err = streamStore.Meta(ctx, path, storj.AESGCM)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	// the stream is decrypted with the key derived from the new path
+	rr, meta, err := streamStore.Get(ctx, newPath, storj.SecretBox)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(data), meta.Size)
+	assert.Equal(t, []byte("metadata"), meta.Data)
+
+	reader, err := rr.Range(ctx, 0, rr.Size())
+	require.NoError(t, err)
+	downloaded, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, data, downloaded)
+
+	// the encrypted data of the segments wasn't touched, and the replaced
+	// stream was deleted
+	assert.Len(t, segmentStore.data, 4)
+	for _, segmentData := range segmentStore.data {
+		found := false
+		for _, encryptedData := range encrypted {
+			found = found || bytes.Equal(segmentData, encryptedData)
+		}
+		assert.True(t, found)
+	}
+}
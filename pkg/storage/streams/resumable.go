@@ -0,0 +1,240 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	ecclient "storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// Error is the error class of resumable uploads
+var Error = errs.Class("streams error")
+
+// PendingUpload is the state of a resumable upload, which is kept by the
+// caller between sessions, e.g. in a file. Only full segments are uploaded
+// before the upload is committed, so an interrupted upload continues at
+// Offset.
+type PendingUpload struct {
+	Path        storj.Path   `json:"path"`
+	PathCipher  storj.Cipher `json:"pathCipher"`
+	Metadata    []byte       `json:"metadata"`
+	Expiration  time.Time    `json:"expiration"`
+	SegmentSize int64        `json:"segmentSize"`
+	// Segments is the number of uploaded segments
+	Segments int64 `json:"segments"`
+	// ETag is the state of the md5 hash of the uploaded segments
+	ETag      []byte `json:"etag"`
+	VersionID string `json:"versionID,omitempty"`
+}
+
+// Offset is the size of the uploaded segments, where the upload continues
+func (upload *PendingUpload) Offset() int64 {
+	return upload.Segments * upload.SegmentSize
+}
+
+// BeginUpload replaces the stream at path with a pending upload, which
+// isn't listed until it's committed
+func (s *streamStore) BeginUpload(ctx context.Context, path storj.Path, pathCipher storj.Cipher, metadata []byte, expiration time.Time) (upload *PendingUpload, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the segments of the pending upload overwrite the segments of the previous stream
+	err = s.Delete(ctx, path, pathCipher)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return nil, err
+	}
+
+	upload = &PendingUpload{
+		Path:        path,
+		PathCipher:  pathCipher,
+		Metadata:    metadata,
+		Expiration:  expiration,
+		SegmentSize: s.segmentSize,
+	}
+	if versioningEnabled(ctx) {
+		upload.VersionID, err = newVersionID()
+		if err != nil {
+			return nil, err
+		}
+	}
+	upload.ETag, err = marshalHash(md5.New())
+	if err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+// UploadSegments uploads the full segments of data, which continues the
+// stream at upload.Offset(), and updates upload. The data after the last
+// full segment is returned, it's uploaded with the next data or when the
+// upload is committed. Each segment is read into memory before it's
+// uploaded, since the last segment is stored differently.
+func (s *streamStore) UploadSegments(ctx context.Context, upload *PendingUpload, data io.Reader) (rest []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if upload.SegmentSize != s.segmentSize {
+		return nil, Error.New("upload was begun with segment size %d, but the segment size is %d", upload.SegmentSize, s.segmentSize)
+	}
+
+	// bias the pieces of the later segments toward the nodes which were
+	// fast for the earlier ones
+	ctx = ecclient.WithPlacement(ctx, ecclient.NewPlacement())
+
+	buf := make([]byte, s.segmentSize)
+	for {
+		n, err := io.ReadFull(data, buf)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return buf[:n], nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.uploadSegment(ctx, upload, buf, false); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// CommitUpload uploads the full segments of data and the rest as the last
+// segment, which makes the stream visible
+func (s *streamStore) CommitUpload(ctx context.Context, upload *PendingUpload, data io.Reader) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rest, err := s.UploadSegments(ctx, upload, data)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	if err := s.uploadSegment(ctx, upload, rest, true); err != nil {
+		return Meta{}, err
+	}
+
+	etag, err := unmarshalHash(upload.ETag)
+	if err != nil {
+		return Meta{}, err
+	}
+	return Meta{
+		Modified:   time.Now(),
+		Expiration: upload.Expiration,
+		Size:       (upload.Segments-1)*upload.SegmentSize + int64(len(rest)),
+		Data:       upload.Metadata,
+		ETag:       etag.Sum(nil),
+		VersionID:  upload.VersionID,
+	}, nil
+}
+
+// AbortUpload deletes the uploaded segments of the upload
+func (s *streamStore) AbortUpload(ctx context.Context, upload *PendingUpload) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encPath, err := s.access.EncryptPath(upload.Path, upload.PathCipher)
+	if err != nil {
+		return err
+	}
+	for i := int64(0); i < upload.Segments; i++ {
+		err := s.segments.Delete(ctx, getSegmentPath(encPath, i))
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return err
+		}
+	}
+	upload.Segments = 0
+	return nil
+}
+
+// uploadSegment uploads data as the next segment of the upload
+func (s *streamStore) uploadSegment(ctx context.Context, upload *PendingUpload, data []byte, last bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	derivedKey, err := s.access.ContentKey(upload.Path)
+	if err != nil {
+		return err
+	}
+	encPath, err := s.access.EncryptPath(upload.Path, upload.PathCipher)
+	if err != nil {
+		return err
+	}
+
+	etag, err := unmarshalHash(upload.ETag)
+	if err != nil {
+		return err
+	}
+	_, _ = etag.Write(data)
+
+	enc, err := s.newSegmentEncryption(derivedKey, upload.Segments)
+	if err != nil {
+		return err
+	}
+	encrypted, err := enc.encryptReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.segments.Put(ctx, encPath, encrypted, upload.Expiration, func() (storj.Path, []byte, error) {
+		if !last {
+			segmentMeta, err := s.segmentMeta(enc)
+			return getSegmentPath(encPath, upload.Segments), segmentMeta, err
+		}
+
+		lastSegmentMeta, err := s.lastSegmentMeta(enc, &pb.StreamInfo{
+			NumberOfSegments: upload.Segments + 1,
+			SegmentsSize:     upload.SegmentSize,
+			LastSegmentSize:  int64(len(data)),
+			Metadata:         upload.Metadata,
+			Etag:             etag.Sum(nil),
+			VersionId:        upload.VersionID,
+		})
+		return storj.JoinPaths("l", encPath), lastSegmentMeta, err
+	})
+	if err != nil {
+		return err
+	}
+
+	upload.ETag, err = marshalHash(etag)
+	if err != nil {
+		return err
+	}
+	upload.Segments++
+	return nil
+}
+
+// hashState is a hash whose state can be persisted, which the hashes of
+// the standard library are
+type hashState interface {
+	io.Writer
+	Sum(b []byte) []byte
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+// marshalHash returns the state of the hash
+func marshalHash(hash io.Writer) ([]byte, error) {
+	marshaler, ok := hash.(encoding.BinaryMarshaler)
+	if !ok {
+		return nil, Error.New("hash state can't be persisted")
+	}
+	return marshaler.MarshalBinary()
+}
+
+// unmarshalHash restores an md5 hash from its state
+func unmarshalHash(state []byte) (hashState, error) {
+	hash, ok := md5.New().(hashState)
+	if !ok {
+		return nil, Error.New("hash state can't be restored")
+	}
+	if err := hash.UnmarshalBinary(state); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return hash, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// memorySegments stores segments in memory
+type memorySegments struct {
+	data map[storj.Path][]byte
+	meta map[storj.Path]segments.Meta
+}
+
+func newMemorySegments() *memorySegments {
+	return &memorySegments{
+		data: map[storj.Path][]byte{},
+		meta: map[storj.Path]segments.Meta{},
+	}
+}
+
+func (store *memorySegments) Meta(ctx context.Context, path storj.Path) (segments.Meta, error) {
+	meta, ok := store.meta[path]
+	if !ok {
+		return segments.Meta{}, storage.ErrKeyNotFound.New("%q", path)
+	}
+	return meta, nil
+}
+
+func (store *memorySegments) Get(ctx context.Context, path storj.Path) (ranger.Ranger, segments.Meta, error) {
+	meta, err := store.Meta(ctx, path)
+	if err != nil {
+		return nil, segments.Meta{}, err
+	}
+	return ranger.ByteRanger(store.data[path]), meta, nil
+}
+
+func (store *memorySegments) Put(ctx context.Context, objectPath storj.Path, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (segments.Meta, error) {
+	content, err := ioutil.ReadAll(data)
+	if err != nil {
+		return segments.Meta{}, err
+	}
+	path, metadata, err := segmentInfo()
+	if err != nil {
+		return segments.Meta{}, err
+	}
+	meta := segments.Meta{Modified: time.Now(), Expiration: expiration, Size: int64(len(content)), Data: metadata}
+	store.data[path], store.meta[path] = content, meta
+	return meta, nil
+}
+
+func (store *memorySegments) Delete(ctx context.Context, path storj.Path) error {
+	if _, ok := store.meta[path]; !ok {
+		return storage.ErrKeyNotFound.New("%q", path)
+	}
+	delete(store.data, path)
+	delete(store.meta, path)
+	return nil
+}
+
+func (store *memorySegments) Move(ctx context.Context, path, newPath storj.Path, metadata []byte) error {
+	meta, ok := store.meta[path]
+	if !ok {
+		return storage.ErrKeyNotFound.New("%q", path)
+	}
+	meta.Data = metadata
+	store.data[newPath], store.meta[newPath] = store.data[path], meta
+	delete(store.data, path)
+	delete(store.meta, path)
+	return nil
+}
+
+func (store *memorySegments) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) ([]segments.ListItem, bool, error) {
+	return nil, false, nil
+}
+
+func TestResumableUpload(t *testing.T) {
+	segmentStore := newMemorySegments()
+	streamStore, err := NewStreamStore(segmentStore, 100, encryption.NewAccess(new(storj.Key)), 64, storj.AESGCM)
+	require.NoError(t, err)
+
+	data := make([]byte, 370)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+
+	path := storj.JoinPaths("bucket", "object")
+	upload, err := streamStore.BeginUpload(ctx, path, storj.AESGCM, []byte("metadata"), time.Time{})
+	require.NoError(t, err)
+
+	rest, err := streamStore.UploadSegments(ctx, upload, bytes.NewReader(data[:250]))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, upload.Segments)
+	assert.EqualValues(t, 200, upload.Offset())
+	assert.Equal(t, data[200:250], rest)
+
+	{ // the upload continues in another session
+		state, err := json.Marshal(upload)
+		require.NoError(t, err)
+		upload = &PendingUpload{}
+		require.NoError(t, json.Unmarshal(state, upload))
+	}
+
+	rest, err = streamStore.UploadSegments(ctx, upload, bytes.NewReader(data[upload.Offset():300]))
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, upload.Segments)
+	assert.Empty(t, rest)
+
+	_, err = streamStore.Meta(ctx, path, storj.AESGCM)
+	assert.True(t, storage.ErrKeyNotFound.Has(err), "pending uploads aren't visible")
+
+	meta, err := streamStore.CommitUpload(ctx, upload, bytes.NewReader(data[300:]))
+	require.NoError(t, err)
+	etag := md5.Sum(data)
+	assert.EqualValues(t, len(data), meta.Size)
+	assert.Equal(t, etag[:], meta.ETag)
+
+	rr, meta, err := streamStore.Get(ctx, path, storj.AESGCM)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(data), meta.Size)
+	assert.Equal(t, etag[:], meta.ETag)
+	assert.Equal(t, []byte("metadata"), meta.Data)
+
+	reader, err := rr.Range(ctx, 0, rr.Size())
+	require.NoError(t, err)
+	downloaded, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, data, downloaded)
+
+	{ // aborting deletes the uploaded segments
+		upload, err := streamStore.BeginUpload(ctx, path, storj.AESGCM, nil, time.Time{})
+		require.NoError(t, err)
+		assert.Empty(t, segmentStore.meta, "the previous stream is replaced")
+
+		_, err = streamStore.UploadSegments(ctx, upload, bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.Len(t, segmentStore.meta, 3)
+
+		require.NoError(t, streamStore.AbortUpload(ctx, upload))
+		assert.Empty(t, segmentStore.meta)
+	}
+}
@@ -6,7 +6,9 @@ package streams
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -22,6 +24,7 @@ import (
 	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/ranger"
+	ecclient "storj.io/storj/pkg/storage/ec"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storage/segments"
 	"storj.io/storj/pkg/storj"
@@ -36,6 +39,73 @@ type Meta struct {
 	Expiration time.Time
 	Size       int64
 	Data       []byte
+	// ETag is the md5 hash of the content, it doesn't change unless the
+	// content changes
+	ETag []byte
+	// VersionID identifies the upload of the stream, it is only set when the
+	// stream was uploaded with versioning enabled
+	VersionID string
+}
+
+// versioningKey is the context key which enables versioning
+type versioningKey struct{}
+
+// WithVersioning returns a context which makes the uploads done with it
+// generate a new version ID for the stream
+func WithVersioning(ctx context.Context) context.Context {
+	return context.WithValue(ctx, versioningKey{}, true)
+}
+
+// versioningEnabled returns whether uploads done with ctx generate version IDs
+func versioningEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(versioningKey{}).(bool)
+	return enabled || keepVersions(ctx)
+}
+
+// keepVersionsKey is the context key which keeps replaced streams as versions
+type keepVersionsKey struct{}
+
+// WithKeptVersions returns a context which makes the uploads and deletes
+// done with it keep the replaced and deleted streams as versions, the
+// uploads generate a new version ID for the stream
+func WithKeptVersions(ctx context.Context) context.Context {
+	return context.WithValue(ctx, keepVersionsKey{}, true)
+}
+
+// keepVersions returns whether the streams replaced or deleted with ctx are
+// kept as versions
+func keepVersions(ctx context.Context) bool {
+	keep, _ := ctx.Value(keepVersionsKey{}).(bool)
+	return keep
+}
+
+// downloadParallelismKey is the context key of the download parallelism
+type downloadParallelismKey struct{}
+
+// WithDownloadParallelism returns a context which makes the streams
+// downloaded with it fetch up to parallelism segments concurrently. Every
+// fetched segment is buffered in memory until it's read.
+func WithDownloadParallelism(ctx context.Context, parallelism int) context.Context {
+	return context.WithValue(ctx, downloadParallelismKey{}, parallelism)
+}
+
+// downloadParallelism returns the number of segments downloaded concurrently
+// with ctx
+func downloadParallelism(ctx context.Context) int {
+	parallelism, ok := ctx.Value(downloadParallelismKey{}).(int)
+	if !ok || parallelism < 1 {
+		return 1
+	}
+	return parallelism
+}
+
+// newVersionID returns a new random version ID
+func newVersionID() (string, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id[:]), nil
 }
 
 // convertMeta converts segment metadata to stream metadata
@@ -51,6 +121,8 @@ func convertMeta(lastSegmentMeta segments.Meta) (Meta, error) {
 		Expiration: lastSegmentMeta.Expiration,
 		Size:       ((stream.NumberOfSegments - 1) * stream.SegmentsSize) + stream.LastSegmentSize,
 		Data:       stream.Metadata,
+		ETag:       stream.Etag,
+		VersionID:  stream.VersionId,
 	}, nil
 }
 
@@ -61,24 +133,30 @@ type Store interface {
 	Put(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
 	Delete(ctx context.Context, path storj.Path, pathCipher storj.Cipher) error
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	Move(ctx context.Context, path storj.Path, pathCipher storj.Cipher, newPath storj.Path, newPathCipher storj.Cipher) error
+
+	BeginUpload(ctx context.Context, path storj.Path, pathCipher storj.Cipher, metadata []byte, expiration time.Time) (*PendingUpload, error)
+	UploadSegments(ctx context.Context, upload *PendingUpload, data io.Reader) (rest []byte, err error)
+	CommitUpload(ctx context.Context, upload *PendingUpload, data io.Reader) (Meta, error)
+	AbortUpload(ctx context.Context, upload *PendingUpload) error
 }
 
 // streamStore is a store for streams
 type streamStore struct {
 	segments     segments.Store
 	segmentSize  int64
-	rootKey      *storj.Key
+	access       *encryption.Access
 	encBlockSize int
 	cipher       storj.Cipher
 }
 
 // NewStreamStore stuff
-func NewStreamStore(segments segments.Store, segmentSize int64, rootKey *storj.Key, encBlockSize int, cipher storj.Cipher) (Store, error) {
+func NewStreamStore(segments segments.Store, segmentSize int64, access *encryption.Access, encBlockSize int, cipher storj.Cipher) (Store, error) {
 	if segmentSize <= 0 {
 		return nil, errs.New("segment size must be larger than 0")
 	}
-	if rootKey == nil {
-		return nil, errs.New("encryption key must not be empty")
+	if access == nil {
+		return nil, errs.New("encryption access must not be empty")
 	}
 	if encBlockSize <= 0 {
 		return nil, errs.New("encryption block size must be larger than 0")
@@ -87,7 +165,7 @@ func NewStreamStore(segments segments.Store, segmentSize int64, rootKey *storj.K
 	return &streamStore{
 		segments:     segments,
 		segmentSize:  segmentSize,
-		rootKey:      rootKey,
+		access:       access,
 		encBlockSize: encBlockSize,
 		cipher:       cipher,
 	}, nil
@@ -130,131 +208,60 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 		}
 	}()
 
-	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	derivedKey, err := s.access.ContentKey(path)
+	if err != nil {
+		return Meta{}, currentSegment, err
+	}
+	encPath, err := s.access.EncryptPath(path, pathCipher)
 	if err != nil {
 		return Meta{}, currentSegment, err
 	}
 
-	eofReader := NewEOFReader(data)
-
-	for !eofReader.isEOF() && !eofReader.hasError() {
-		// generate random key for encrypting the segment's content
-		var contentKey storj.Key
-		_, err = rand.Read(contentKey[:])
-		if err != nil {
-			return Meta{}, currentSegment, err
-		}
-
-		// Initialize the content nonce with the segment's index incremented by 1.
-		// The increment by 1 is to avoid nonce reuse with the metadata encryption,
-		// which is encrypted with the zero nonce.
-		var contentNonce storj.Nonce
-		_, err := encryption.Increment(&contentNonce, currentSegment+1)
-		if err != nil {
-			return Meta{}, currentSegment, err
-		}
+	// bias the pieces of the later segments toward the nodes which were
+	// fast for the earlier ones
+	ctx = ecclient.WithPlacement(ctx, ecclient.NewPlacement())
 
-		encrypter, err := encryption.NewEncrypter(s.cipher, &contentKey, &contentNonce, s.encBlockSize)
+	var versionID string
+	if versioningEnabled(ctx) {
+		versionID, err = newVersionID()
 		if err != nil {
 			return Meta{}, currentSegment, err
 		}
+	}
 
-		// generate random nonce for encrypting the content key
-		var keyNonce storj.Nonce
-		_, err = rand.Read(keyNonce[:])
-		if err != nil {
-			return Meta{}, currentSegment, err
-		}
+	// the etag is complete when the last segment is committed, since all
+	// data has been read by then
+	etag := md5.New()
+	eofReader := NewEOFReader(io.TeeReader(data, etag))
 
-		encryptedKey, err := encryption.EncryptKey(&contentKey, s.cipher, derivedKey, &keyNonce)
+	for !eofReader.isEOF() && !eofReader.hasError() {
+		enc, err := s.newSegmentEncryption(derivedKey, currentSegment)
 		if err != nil {
 			return Meta{}, currentSegment, err
 		}
 
 		sizeReader := NewSizeReader(eofReader)
 		segmentReader := io.LimitReader(sizeReader, s.segmentSize)
-		peekReader := segments.NewPeekThresholdReader(segmentReader)
-		largeData, err := peekReader.IsLargerThan(encrypter.InBlockSize())
+		transformedReader, err := enc.encryptReader(segmentReader)
 		if err != nil {
 			return Meta{}, currentSegment, err
 		}
-		var transformedReader io.Reader
-		if largeData {
-			paddedReader := eestream.PadReader(ioutil.NopCloser(peekReader), encrypter.InBlockSize())
-			transformedReader = encryption.TransformReader(paddedReader, encrypter, 0)
-		} else {
-			data, err := ioutil.ReadAll(peekReader)
-			if err != nil {
-				return Meta{}, currentSegment, err
-			}
-			cipherData, err := encryption.Encrypt(data, s.cipher, &contentKey, &contentNonce)
-			if err != nil {
-				return Meta{}, currentSegment, err
-			}
-			transformedReader = bytes.NewReader(cipherData)
-		}
-
-		putMeta, err = s.segments.Put(ctx, transformedReader, expiration, func() (storj.Path, []byte, error) {
-			encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
-			if err != nil {
-				return "", nil, err
-			}
 
+		putMeta, err = s.segments.Put(ctx, encPath, transformedReader, expiration, func() (storj.Path, []byte, error) {
 			if !eofReader.isEOF() {
-				segmentPath := getSegmentPath(encPath, currentSegment)
-
-				if s.cipher == storj.Unencrypted {
-					return segmentPath, nil, nil
-				}
-
-				segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				})
-				if err != nil {
-					return "", nil, err
-				}
-
-				return segmentPath, segmentMeta, nil
+				segmentMeta, err := s.segmentMeta(enc)
+				return getSegmentPath(encPath, currentSegment), segmentMeta, err
 			}
 
-			lastSegmentPath := storj.JoinPaths("l", encPath)
-
-			streamInfo, err := proto.Marshal(&pb.StreamInfo{
+			lastSegmentMeta, err := s.lastSegmentMeta(enc, &pb.StreamInfo{
 				NumberOfSegments: currentSegment + 1,
 				SegmentsSize:     s.segmentSize,
 				LastSegmentSize:  sizeReader.Size(),
 				Metadata:         metadata,
+				Etag:             etag.Sum(nil),
+				VersionId:        versionID,
 			})
-			if err != nil {
-				return "", nil, err
-			}
-
-			// encrypt metadata with the content encryption key and zero nonce
-			encryptedStreamInfo, err := encryption.Encrypt(streamInfo, s.cipher, &contentKey, &storj.Nonce{})
-			if err != nil {
-				return "", nil, err
-			}
-
-			streamMeta := pb.StreamMeta{
-				EncryptedStreamInfo: encryptedStreamInfo,
-				EncryptionType:      int32(s.cipher),
-				EncryptionBlockSize: int32(s.encBlockSize),
-			}
-
-			if s.cipher != storj.Unencrypted {
-				streamMeta.LastSegmentMeta = &pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				}
-			}
-
-			lastSegmentMeta, err := proto.Marshal(&streamMeta)
-			if err != nil {
-				return "", nil, err
-			}
-
-			return lastSegmentPath, lastSegmentMeta, nil
+			return storj.JoinPaths("l", encPath), lastSegmentMeta, err
 		})
 		if err != nil {
 			return Meta{}, currentSegment, err
@@ -273,11 +280,123 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 		Expiration: expiration,
 		Size:       streamSize,
 		Data:       metadata,
+		ETag:       etag.Sum(nil),
+		VersionID:  versionID,
 	}
 
 	return resultMeta, currentSegment, nil
 }
 
+// segmentEncryption encrypts the content of a segment with a random key
+type segmentEncryption struct {
+	cipher       storj.Cipher
+	contentKey   storj.Key
+	contentNonce storj.Nonce
+	encryptedKey storj.EncryptedPrivateKey
+	keyNonce     storj.Nonce
+	encrypter    encryption.Transformer
+}
+
+// newSegmentEncryption creates the encryption of the segment at index
+func (s *streamStore) newSegmentEncryption(derivedKey *storj.Key, index int64) (*segmentEncryption, error) {
+	enc := &segmentEncryption{cipher: s.cipher}
+
+	// generate random key for encrypting the segment's content
+	_, err := rand.Read(enc.contentKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize the content nonce with the segment's index incremented by 1.
+	// The increment by 1 is to avoid nonce reuse with the metadata encryption,
+	// which is encrypted with the zero nonce.
+	_, err = encryption.Increment(&enc.contentNonce, index+1)
+	if err != nil {
+		return nil, err
+	}
+
+	enc.encrypter, err = encryption.NewEncrypter(s.cipher, &enc.contentKey, &enc.contentNonce, s.encBlockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// generate random nonce for encrypting the content key
+	_, err = rand.Read(enc.keyNonce[:])
+	if err != nil {
+		return nil, err
+	}
+
+	enc.encryptedKey, err = encryption.EncryptKey(&enc.contentKey, s.cipher, derivedKey, &enc.keyNonce)
+	if err != nil {
+		return nil, err
+	}
+	return enc, nil
+}
+
+// encryptReader returns the encrypted content of the segment read from data
+func (enc *segmentEncryption) encryptReader(data io.Reader) (io.Reader, error) {
+	peekReader := segments.NewPeekThresholdReader(data)
+	largeData, err := peekReader.IsLargerThan(enc.encrypter.InBlockSize())
+	if err != nil {
+		return nil, err
+	}
+	if largeData {
+		paddedReader := eestream.PadReader(ioutil.NopCloser(peekReader), enc.encrypter.InBlockSize())
+		return encryption.TransformReader(paddedReader, enc.encrypter, 0), nil
+	}
+
+	plainData, err := ioutil.ReadAll(peekReader)
+	if err != nil {
+		return nil, err
+	}
+	cipherData, err := encryption.Encrypt(plainData, enc.cipher, &enc.contentKey, &enc.contentNonce)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(cipherData), nil
+}
+
+// segmentMeta returns the metadata of a segment which isn't the last one
+func (s *streamStore) segmentMeta(enc *segmentEncryption) ([]byte, error) {
+	if s.cipher == storj.Unencrypted {
+		return nil, nil
+	}
+	return proto.Marshal(&pb.SegmentMeta{
+		EncryptedKey: enc.encryptedKey,
+		KeyNonce:     enc.keyNonce[:],
+	})
+}
+
+// lastSegmentMeta returns the metadata of the last segment, which contains
+// the encrypted stream info
+func (s *streamStore) lastSegmentMeta(enc *segmentEncryption, info *pb.StreamInfo) ([]byte, error) {
+	streamInfo, err := proto.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+
+	// encrypt metadata with the content encryption key and zero nonce
+	encryptedStreamInfo, err := encryption.Encrypt(streamInfo, s.cipher, &enc.contentKey, &storj.Nonce{})
+	if err != nil {
+		return nil, err
+	}
+
+	streamMeta := pb.StreamMeta{
+		EncryptedStreamInfo: encryptedStreamInfo,
+		EncryptionType:      int32(s.cipher),
+		EncryptionBlockSize: int32(s.encBlockSize),
+	}
+
+	if s.cipher != storj.Unencrypted {
+		streamMeta.LastSegmentMeta = &pb.SegmentMeta{
+			EncryptedKey: enc.encryptedKey,
+			KeyNonce:     enc.keyNonce[:],
+		}
+	}
+
+	return proto.Marshal(&streamMeta)
+}
+
 // getSegmentPath returns the unique path for a particular segment
 func getSegmentPath(path storj.Path, segNum int64) storj.Path {
 	return storj.JoinPaths(fmt.Sprintf("s%d", segNum), path)
@@ -289,7 +408,7 @@ func getSegmentPath(path storj.Path, segNum int64) storj.Path {
 func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (rr ranger.Ranger, meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	encPath, err := s.access.EncryptPath(path, pathCipher)
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -299,7 +418,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 		return nil, Meta{}, err
 	}
 
-	streamInfo, err := DecryptStreamInfo(ctx, lastSegmentMeta, path, s.rootKey)
+	streamInfo, err := DecryptStreamInfo(ctx, lastSegmentMeta, path, s.access)
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -316,7 +435,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 		return nil, Meta{}, err
 	}
 
-	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	derivedKey, err := s.access.ContentKey(path)
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -364,7 +483,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 	}
 	rangers = append(rangers, decryptedLastSegmentRanger)
 
-	catRangers := ranger.Concat(rangers...)
+	catRangers := ranger.ConcatParallel(downloadParallelism(ctx), rangers...)
 
 	lastSegmentMeta.Data = streamInfo
 	meta, err = convertMeta(lastSegmentMeta)
@@ -379,7 +498,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 func (s *streamStore) Meta(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	encPath, err := s.access.EncryptPath(path, pathCipher)
 	if err != nil {
 		return Meta{}, err
 	}
@@ -389,7 +508,7 @@ func (s *streamStore) Meta(ctx context.Context, path storj.Path, pathCipher stor
 		return Meta{}, err
 	}
 
-	streamInfo, err := DecryptStreamInfo(ctx, lastSegmentMeta, path, s.rootKey)
+	streamInfo, err := DecryptStreamInfo(ctx, lastSegmentMeta, path, s.access)
 	if err != nil {
 		return Meta{}, err
 	}
@@ -403,11 +522,20 @@ func (s *streamStore) Meta(ctx context.Context, path storj.Path, pathCipher stor
 	return newStreamMeta, nil
 }
 
-// Delete all the segments, with the last one last
+// Delete all the segments, with the last one last. The stream is moved to
+// its version instead when ctx keeps versions.
 func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	if keepVersions(ctx) {
+		return s.archive(ctx, path, pathCipher)
+	}
+	return s.delete(ctx, path, pathCipher)
+}
+
+// delete deletes all the segments, with the last one last
+func (s *streamStore) delete(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (err error) {
+	encPath, err := s.access.EncryptPath(path, pathCipher)
 	if err != nil {
 		return err
 	}
@@ -416,7 +544,7 @@ func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher st
 		return err
 	}
 
-	streamInfo, err := DecryptStreamInfo(ctx, lastSegmentMeta, path, s.rootKey)
+	streamInfo, err := DecryptStreamInfo(ctx, lastSegmentMeta, path, s.access)
 	if err != nil {
 		return err
 	}
@@ -428,7 +556,7 @@ func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher st
 	}
 
 	for i := 0; i < int(stream.NumberOfSegments-1); i++ {
-		encPath, err = EncryptAfterBucket(path, pathCipher, s.rootKey)
+		encPath, err = s.access.EncryptPath(path, pathCipher)
 		if err != nil {
 			return err
 		}
@@ -461,22 +589,17 @@ func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore st
 
 	prefix = strings.TrimSuffix(prefix, "/")
 
-	encPrefix, err := EncryptAfterBucket(prefix, pathCipher, s.rootKey)
-	if err != nil {
-		return nil, false, err
-	}
-
-	prefixKey, err := encryption.DerivePathKey(prefix, s.rootKey, len(storj.SplitPath(prefix)))
+	encPrefix, err := s.access.EncryptPath(prefix, pathCipher)
 	if err != nil {
 		return nil, false, err
 	}
 
-	encStartAfter, err := s.encryptMarker(startAfter, pathCipher, prefixKey)
+	encStartAfter, err := s.encryptMarker(prefix, startAfter, pathCipher)
 	if err != nil {
 		return nil, false, err
 	}
 
-	encEndBefore, err := s.encryptMarker(endBefore, pathCipher, prefixKey)
+	encEndBefore, err := s.encryptMarker(prefix, endBefore, pathCipher)
 	if err != nil {
 		return nil, false, err
 	}
@@ -488,12 +611,12 @@ func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore st
 
 	items = make([]ListItem, len(segments))
 	for i, item := range segments {
-		path, err := s.decryptMarker(item.Path, pathCipher, prefixKey)
+		path, err := s.decryptMarker(encPrefix, item.Path, pathCipher)
 		if err != nil {
 			return nil, false, err
 		}
 
-		streamInfo, err := DecryptStreamInfo(ctx, item.Meta, storj.JoinPaths(prefix, path), s.rootKey)
+		streamInfo, err := DecryptStreamInfo(ctx, item.Meta, storj.JoinPaths(prefix, path), s.access)
 		if err != nil {
 			return nil, false, err
 		}
@@ -510,20 +633,35 @@ func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore st
 	return items, more, nil
 }
 
-// encryptMarker is a helper method for encrypting startAfter and endBefore markers
-func (s *streamStore) encryptMarker(marker storj.Path, pathCipher storj.Cipher, prefixKey *storj.Key) (storj.Path, error) {
-	if bytes.Equal(s.rootKey[:], prefixKey[:]) { // empty prefix
-		return EncryptAfterBucket(marker, pathCipher, s.rootKey)
+// encryptMarker is a helper method for encrypting startAfter and endBefore
+// markers, which are relative to prefix
+func (s *streamStore) encryptMarker(prefix, marker storj.Path, pathCipher storj.Cipher) (storj.Path, error) {
+	if prefix == "" || marker == "" {
+		return s.access.EncryptPath(marker, pathCipher)
+	}
+	encrypted, err := s.access.EncryptPath(storj.JoinPaths(prefix, marker), pathCipher)
+	if err != nil {
+		return "", err
 	}
-	return encryption.EncryptPath(marker, pathCipher, prefixKey)
+	return trimPathComponents(encrypted, len(storj.SplitPath(prefix))), nil
 }
 
-// decryptMarker is a helper method for decrypting listed path markers
-func (s *streamStore) decryptMarker(marker storj.Path, pathCipher storj.Cipher, prefixKey *storj.Key) (storj.Path, error) {
-	if bytes.Equal(s.rootKey[:], prefixKey[:]) { // empty prefix
-		return DecryptAfterBucket(marker, pathCipher, s.rootKey)
+// decryptMarker is a helper method for decrypting listed path markers, which
+// are relative to encPrefix
+func (s *streamStore) decryptMarker(encPrefix, marker storj.Path, pathCipher storj.Cipher) (storj.Path, error) {
+	if encPrefix == "" {
+		return s.access.DecryptPath(marker, pathCipher)
+	}
+	decrypted, err := s.access.DecryptPath(storj.JoinPaths(encPrefix, marker), pathCipher)
+	if err != nil {
+		return "", err
 	}
-	return encryption.DecryptPath(marker, pathCipher, prefixKey)
+	return trimPathComponents(decrypted, len(storj.SplitPath(encPrefix))), nil
+}
+
+// trimPathComponents returns path without its first n components
+func trimPathComponents(path storj.Path, n int) storj.Path {
+	return storj.JoinPaths(storj.SplitPath(path)[n:]...)
 }
 
 type lazySegmentRanger struct {
@@ -641,7 +779,7 @@ func DecryptAfterBucket(path storj.Path, cipher storj.Cipher, key *storj.Key) (d
 // CancelHandler handles clean up of segments on receiving CTRL+C
 func (s *streamStore) cancelHandler(ctx context.Context, totalSegments int64, path storj.Path, pathCipher storj.Cipher) {
 	for i := int64(0); i < totalSegments; i++ {
-		encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+		encPath, err := s.access.EncryptPath(path, pathCipher)
 		if err != nil {
 			zap.S().Warnf("Failed deleting a segment due to encryption path %v %v", i, err)
 		}
@@ -666,14 +804,20 @@ func getEncryptedKeyAndNonce(m *pb.SegmentMeta) (storj.EncryptedPrivateKey, *sto
 }
 
 // DecryptStreamInfo decrypts stream info
-func DecryptStreamInfo(ctx context.Context, item segments.Meta, path storj.Path, rootKey *storj.Key) (streamInfo []byte, err error) {
+func DecryptStreamInfo(ctx context.Context, item segments.Meta, path storj.Path, access *encryption.Access) (streamInfo []byte, err error) {
 	streamMeta := pb.StreamMeta{}
 	err = proto.Unmarshal(item.Data, &streamMeta)
 	if err != nil {
 		return nil, err
 	}
 
-	derivedKey, err := encryption.DeriveContentKey(path, rootKey)
+	// listings without the metadata have nothing to decrypt, and the
+	// access may not have the keys of all the listed paths
+	if len(streamMeta.EncryptedStreamInfo) == 0 {
+		return []byte{}, nil
+	}
+
+	derivedKey, err := access.ContentKey(path)
 	if err != nil {
 		return nil, err
 	}
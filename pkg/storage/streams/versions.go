@@ -0,0 +1,55 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+	"strings"
+
+	"storj.io/storj/pkg/storj"
+)
+
+const (
+	// VersionsPrefix is the prefix in a bucket under which the versions of
+	// the objects of the bucket are kept
+	VersionsPrefix = ".storj-versions"
+	// NullVersionID is the version ID of the versions of streams, which were
+	// uploaded without a version ID
+	NullVersionID = "null"
+)
+
+// VersionPath returns the path the version versionID of the stream at path
+// is kept at, path starts with the bucket
+func VersionPath(path storj.Path, versionID string) storj.Path {
+	bucket, objectPath := path, ""
+	if i := strings.IndexByte(path, '/'); i >= 0 {
+		bucket, objectPath = path[:i], path[i+1:]
+	}
+	return storj.JoinPaths(bucket, VersionsPrefix, objectPath, versionID)
+}
+
+// IsVersionPath returns whether the object path, which doesn't start with
+// the bucket, is reserved for versions
+func IsVersionPath(path storj.Path) bool {
+	return path == VersionsPrefix || strings.HasPrefix(path, VersionsPrefix+"/")
+}
+
+// archive moves the stream at path to the path of its version. The segments
+// are moved without downloading them, a version of the stream with the same
+// version ID is replaced.
+func (s *streamStore) archive(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	meta, err := s.Meta(ctx, path, pathCipher)
+	if err != nil {
+		return err
+	}
+
+	versionID := meta.VersionID
+	if versionID == "" {
+		versionID = NullVersionID
+	}
+
+	return s.Move(ctx, path, pathCipher, VersionPath(path, versionID), pathCipher)
+}
@@ -22,6 +22,13 @@ type Metainfo interface {
 	GetBucket(ctx context.Context, bucket string) (Bucket, error)
 	// ListBuckets lists buckets starting from first
 	ListBuckets(ctx context.Context, options BucketListOptions) (BucketList, error)
+	// SetBucketVersioning enables or disables keeping the replaced and
+	// deleted objects of the bucket as versions
+	SetBucketVersioning(ctx context.Context, bucket string, enabled bool) error
+	// GetBucketLifecycle returns when the objects of the bucket expire
+	GetBucketLifecycle(ctx context.Context, bucket string) (BucketLifecycle, error)
+	// SetBucketLifecycle replaces when the objects of the bucket expire
+	SetBucketLifecycle(ctx context.Context, bucket string, lifecycle BucketLifecycle) error
 
 	// GetObject returns information about an object
 	GetObject(ctx context.Context, bucket string, path Path) (Object, error)
@@ -34,9 +41,23 @@ type Metainfo interface {
 	ModifyObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
 	// DeleteObject deletes an object from database
 	DeleteObject(ctx context.Context, bucket string, path Path) error
+	// MoveObject moves an object to a new path, replacing the object at the
+	// new path, without downloading its data
+	MoveObject(ctx context.Context, bucket string, path Path, newBucket string, newPath Path) error
 	// ListObjects lists objects in bucket based on the ListOptions
 	ListObjects(ctx context.Context, bucket string, options ListOptions) (ObjectList, error)
 
+	// ListObjectVersions returns the kept versions of the object at path,
+	// newest first, the current object isn't one of them
+	ListObjectVersions(ctx context.Context, bucket string, path Path) ([]Object, error)
+	// GetObjectVersion returns information about a kept version of an object
+	GetObjectVersion(ctx context.Context, bucket string, path Path, versionID string) (Object, error)
+	// GetObjectVersionStream returns interface for reading the stream of a
+	// kept version of an object
+	GetObjectVersionStream(ctx context.Context, bucket string, path Path, versionID string) (ReadOnlyStream, error)
+	// PurgeObjectVersion deletes a kept version of an object
+	PurgeObjectVersion(ctx context.Context, bucket string, path Path, versionID string) error
+
 	// ModifyPendingObject creates a mutable object for updating a partially uploaded object
 	ModifyPendingObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
 	// ListPendingObjects lists pending objects in bucket based on the ListOptions
@@ -91,10 +112,15 @@ const (
 type ListOptions struct {
 	Prefix    Path
 	Cursor    Path // Cursor is relative to Prefix, full path is Prefix + Cursor
-	Delimiter rune
+	Delimiter rune // Delimiter must be 0 or '/'
 	Recursive bool
 	Direction ListDirection
 	Limit     int
+
+	// NamePrefix lists only the items whose path, relative to Prefix, starts
+	// with NamePrefix. It can only be used with the Forward and After
+	// directions.
+	NamePrefix Path
 }
 
 // ObjectList is a list of objects
@@ -117,17 +143,23 @@ func (opts ListOptions) NextPage(list ObjectList) ListOptions {
 	switch opts.Direction {
 	case Before, Backward:
 		return ListOptions{
-			Prefix:    opts.Prefix,
-			Cursor:    list.Items[0].Path,
-			Direction: Before,
-			Limit:     opts.Limit,
+			Prefix:     opts.Prefix,
+			Cursor:     list.Items[0].Path,
+			Delimiter:  opts.Delimiter,
+			Recursive:  opts.Recursive,
+			Direction:  Before,
+			Limit:      opts.Limit,
+			NamePrefix: opts.NamePrefix,
 		}
 	case After, Forward:
 		return ListOptions{
-			Prefix:    opts.Prefix,
-			Cursor:    list.Items[len(list.Items)-1].Path,
-			Direction: After,
-			Limit:     opts.Limit,
+			Prefix:     opts.Prefix,
+			Cursor:     list.Items[len(list.Items)-1].Path,
+			Delimiter:  opts.Delimiter,
+			Recursive:  opts.Recursive,
+			Direction:  After,
+			Limit:      opts.Limit,
+			NamePrefix: opts.NamePrefix,
 		}
 	}
 
@@ -28,6 +28,23 @@ type Bucket struct {
 	Name       string
 	Created    time.Time
 	PathCipher Cipher
+	// Versioning keeps the replaced and deleted objects of the bucket as
+	// versions
+	Versioning bool
+	// PartnerID is the partner the bucket is attributed to when it's
+	// created, the satellite reports the usage of the bucket to the partner
+	PartnerID string
+}
+
+// BucketLifecycle is when the satellite deletes the objects of a bucket,
+// zero days keep them
+type BucketLifecycle struct {
+	// ExpireAfterDays deletes the objects uploaded more than the number of
+	// days ago
+	ExpireAfterDays int
+	// VersionsExpireAfterDays deletes the versions of the objects kept more
+	// than the number of days ago
+	VersionsExpireAfterDays int
 }
 
 // Object contains information about a specific object
@@ -37,6 +54,9 @@ type Object struct {
 	Path     Path
 	IsPrefix bool
 
+	// VersionID identifies the upload of the object when versioning is enabled
+	VersionID string
+
 	Metadata map[string]string
 
 	ContentType string
@@ -51,7 +71,7 @@ type Object struct {
 type Stream struct {
 	// Size is the total size of the stream in bytes
 	Size int64
-	// Checksum is the checksum of the segment checksums
+	// Checksum is the md5 hash of the content, it is used as the ETag
 	Checksum []byte
 
 	// SegmentCount is the number of segments
@@ -9,3 +9,7 @@ import (
 
 // Error is the errs class of stream errors
 var Error = errs.Class("stream error")
+
+// ErrChecksum is the errs class of downloads whose content doesn't match the
+// checksum computed at upload
+var ErrChecksum = errs.Class("checksum mismatch")
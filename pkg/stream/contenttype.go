@@ -0,0 +1,35 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// sniffLen is the number of bytes http.DetectContentType considers
+const sniffLen = 512
+
+// DetectContentType returns the content type of an object by the extension
+// of its path, or by the first bytes of data when the extension is unknown.
+// The returned reader reads all of data, including the sniffed bytes.
+func DetectContentType(objectPath storj.Path, data io.Reader) (contentType string, _ io.Reader, err error) {
+	if contentType = mime.TypeByExtension(path.Ext(objectPath)); contentType != "" {
+		return contentType, data, nil
+	}
+
+	buf := make([]byte, sniffLen)
+	n, err := io.ReadFull(data, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, Error.Wrap(err)
+	}
+	buf = buf[:n]
+
+	return http.DetectContentType(buf), io.MultiReader(bytes.NewReader(buf), data), nil
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/stream"
+)
+
+func TestDetectContentType(t *testing.T) {
+	html := []byte("<html><body>hello</body></html>")
+	large := append([]byte("%PDF-"), bytes.Repeat([]byte{'x'}, 1000)...)
+
+	for _, tt := range []struct {
+		path        string
+		data        []byte
+		contentType string
+	}{
+		{"index.html", html, "text/html; charset=utf-8"},
+		{"photos/cat.png", html, "image/png"},
+		{"noextension", html, "text/html; charset=utf-8"},
+		{"noextension", large, "application/pdf"},
+		{"empty", nil, "text/plain; charset=utf-8"},
+	} {
+		contentType, reader, err := stream.DetectContentType(tt.path, bytes.NewReader(tt.data))
+		require.NoError(t, err, tt.path)
+		assert.Equal(t, tt.contentType, contentType, tt.path)
+
+		// the reader still returns all of the data
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err, tt.path)
+		assert.Equal(t, string(tt.data), string(data), tt.path)
+	}
+}
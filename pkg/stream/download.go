@@ -4,30 +4,103 @@
 package stream
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"hash"
 	"io"
+	"sync"
 
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/ranger"
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 )
 
-// Download implements Reader, Seeker and Closer for reading from stream.
+// Download implements Reader, Seeker, ReaderAt and Closer for reading from
+// stream. Only the segments, and the stripes of the segments, which overlap
+// the read ranges are downloaded, so arbitrary ranges of large objects can
+// be read without downloading the whole object.
+//
+// When the whole stream is read sequentially from its start, its content is
+// verified against the checksum computed at upload, and the Read which
+// reaches the end fails with ErrChecksum if they don't match.
 type Download struct {
 	ctx     context.Context
 	stream  storj.ReadOnlyStream
 	streams streams.Store
-	reader  io.ReadCloser
-	offset  int64
-	closed  bool
+
+	// the downloaded section of the stream
+	start, size int64
+
+	mu     sync.Mutex
+	ranger ranger.Ranger
+	closed bool
+
+	// reader reads from offset, it's opened by the first Read after a Seek
+	reader io.ReadCloser
+	offset int64
+
+	// checksum hashes the content read so far, it's nil when the content
+	// isn't verified
+	checksum hash.Hash
 }
 
 // NewDownload creates new stream download.
 func NewDownload(ctx context.Context, stream storj.ReadOnlyStream, streams streams.Store) *Download {
 	return &Download{
+		ctx:      ctx,
+		stream:   stream,
+		streams:  streams,
+		size:     stream.Info().Size,
+		checksum: newChecksum(stream.Info()),
+	}
+}
+
+// NewDownloadRange creates new download of the section of the stream which
+// starts at offset and is length bytes long. The offsets of the download
+// are relative to the start of the section, like the ones of
+// io.SectionReader.
+func NewDownloadRange(ctx context.Context, stream storj.ReadOnlyStream, streams streams.Store, offset, length int64) (*Download, error) {
+	size := stream.Info().Size
+	if offset < 0 || offset > size {
+		return nil, Error.New("invalid offset %d of stream of %d bytes", offset, size)
+	}
+	if length < 0 || offset+length > size {
+		return nil, Error.New("invalid length %d at offset %d of stream of %d bytes", length, offset, size)
+	}
+
+	download := &Download{
 		ctx:     ctx,
 		stream:  stream,
 		streams: streams,
+		start:   offset,
+		size:    length,
 	}
+	if offset == 0 && length == size {
+		download.checksum = newChecksum(stream.Info())
+	}
+	return download, nil
+}
+
+// newChecksum returns the hash verifying the content of obj, or nil if obj
+// has no checksum
+func newChecksum(obj storj.Object) hash.Hash {
+	if len(obj.Checksum) == 0 {
+		return nil
+	}
+	return md5.New()
+}
+
+// Info returns the information about the object of the download.
+func (download *Download) Info() storj.Object {
+	return download.stream.Info()
+}
+
+// Size returns the size of the download.
+func (download *Download) Size() int64 {
+	return download.size
 }
 
 // Read reads up to len(data) bytes into data.
@@ -37,12 +110,20 @@ func NewDownload(ctx context.Context, stream storj.ReadOnlyStream, streams strea
 //
 // See io.Reader for more details.
 func (download *Download) Read(data []byte) (n int, err error) {
-	if download.closed {
+	if download.isClosed() {
 		return 0, Error.New("already closed")
 	}
 
+	if download.offset >= download.size {
+		return 0, io.EOF
+	}
+
 	if download.reader == nil {
-		err = download.resetReader(0)
+		rr, err := download.getRanger()
+		if err != nil {
+			return 0, err
+		}
+		download.reader, err = rr.Range(download.ctx, download.start+download.offset, download.size-download.offset)
 		if err != nil {
 			return 0, err
 		}
@@ -52,14 +133,26 @@ func (download *Download) Read(data []byte) (n int, err error) {
 
 	download.offset += int64(n)
 
+	if download.checksum != nil {
+		_, _ = download.checksum.Write(data[:n])
+		if download.offset == download.size {
+			sum, expected := download.checksum.Sum(nil), download.Info().Checksum
+			download.checksum = nil
+			if !bytes.Equal(sum, expected) {
+				return n, ErrChecksum.New("content of %q is %x, expected %x", download.Info().Path, sum, expected)
+			}
+		}
+	}
+
 	return n, err
 }
 
-// Seek changes the offset for the next Read call.
+// Seek changes the offset for the next Read call. Seeking past the end of
+// the download is allowed, the reads after it return io.EOF.
 //
 // See io.Seeker for more details.
 func (download *Download) Seek(offset int64, whence int) (int64, error) {
-	if download.closed {
+	if download.isClosed() {
 		return 0, Error.New("already closed")
 	}
 
@@ -67,22 +160,82 @@ func (download *Download) Seek(offset int64, whence int) (int64, error) {
 	switch whence {
 	case io.SeekStart:
 		off = offset
-	case io.SeekEnd:
-		off = download.stream.Info().Size - offset
 	case io.SeekCurrent:
-		off += offset
+		off = download.offset + offset
+	case io.SeekEnd:
+		off = download.size + offset
+	default:
+		return download.offset, Error.New("invalid whence %d", whence)
+	}
+	if off < 0 {
+		return download.offset, Error.New("negative offset %d", off)
+	}
+
+	if off == download.offset {
+		return off, nil
 	}
 
-	err := download.resetReader(off)
+	// the content isn't read sequentially anymore, so it can't be verified
+	download.checksum = nil
+
+	// the reader at the new offset is opened by the next Read
+	if download.reader != nil {
+		err := download.reader.Close()
+		download.reader = nil
+		if err != nil {
+			return download.offset, err
+		}
+	}
+
+	download.offset = off
+
+	return off, nil
+}
+
+// ReadAt reads len(data) bytes at offset off, independently of the offset
+// of Read. Every call downloads the range it reads, so it's best used with
+// large buffers. ReadAt may be called concurrently.
+//
+// See io.ReaderAt for more details.
+func (download *Download) ReadAt(data []byte, off int64) (n int, err error) {
+	if download.isClosed() {
+		return 0, Error.New("already closed")
+	}
+
+	if off < 0 {
+		return 0, Error.New("negative offset %d", off)
+	}
+	if off >= download.size {
+		return 0, io.EOF
+	}
+
+	length := int64(len(data))
+	if length > download.size-off {
+		length = download.size - off
+	}
+
+	rr, err := download.getRanger()
+	if err != nil {
+		return 0, err
+	}
+	reader, err := rr.Range(download.ctx, download.start+off, length)
 	if err != nil {
-		return off, err
+		return 0, err
 	}
 
-	return download.offset, nil
+	n, err = io.ReadFull(reader, data[:length])
+	err = errs.Combine(err, reader.Close())
+	if err == nil && n < len(data) {
+		err = io.EOF
+	}
+	return n, err
 }
 
 // Close closes the stream and releases the underlying resources.
 func (download *Download) Close() error {
+	download.mu.Lock()
+	defer download.mu.Unlock()
+
 	if download.closed {
 		return Error.New("already closed")
 	}
@@ -96,27 +249,28 @@ func (download *Download) Close() error {
 	return download.reader.Close()
 }
 
-func (download *Download) resetReader(offset int64) error {
-	if download.reader != nil {
-		err := download.reader.Close()
-		if err != nil {
-			return err
-		}
+func (download *Download) isClosed() bool {
+	download.mu.Lock()
+	defer download.mu.Unlock()
+	return download.closed
+}
+
+// getRanger returns the ranger of the stream, which is only looked up once
+func (download *Download) getRanger() (ranger.Ranger, error) {
+	download.mu.Lock()
+	defer download.mu.Unlock()
+
+	if download.ranger != nil {
+		return download.ranger, nil
 	}
 
 	obj := download.stream.Info()
 
 	rr, _, err := download.streams.Get(download.ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	download.reader, err = rr.Range(download.ctx, offset, obj.Size-offset)
-	if err != nil {
-		return err
-	}
-
-	download.offset = offset
-
-	return nil
+	download.ranger = rr
+	return rr, nil
 }
@@ -0,0 +1,171 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream_test
+
+import (
+	"context"
+	"crypto/md5"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+// byteStreams stores a single stream in memory
+type byteStreams struct {
+	streams.Store
+	data []byte
+}
+
+func (s *byteStreams) Get(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (ranger.Ranger, streams.Meta, error) {
+	return ranger.ByteRanger(s.data), streams.Meta{Size: int64(len(s.data))}, nil
+}
+
+// byteStream is the read-only stream of byteStreams
+type byteStream struct {
+	storj.ReadOnlyStream
+	size     int64
+	checksum []byte
+}
+
+func (s *byteStream) Info() storj.Object {
+	return storj.Object{Bucket: storj.Bucket{Name: "bucket"}, Path: "object", Size: s.size, Checksum: s.checksum}
+}
+
+func TestDownloadSeek(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("0123456789abcdefghij")
+	download := stream.NewDownload(ctx, &byteStream{size: int64(len(data))}, &byteStreams{data: data})
+
+	read := func(n int) string {
+		buf := make([]byte, n)
+		n, err := io.ReadFull(download, buf)
+		require.NoError(t, err)
+		return string(buf[:n])
+	}
+
+	assert.Equal(t, "0123", read(4))
+
+	for _, tt := range []struct {
+		offset int64
+		whence int
+		pos    int64
+		data   string
+	}{
+		{10, io.SeekStart, 10, "abc"},
+		{-5, io.SeekCurrent, 8, "89a"},
+		{-4, io.SeekEnd, 16, "ghi"},
+		{0, io.SeekCurrent, 19, "j"},
+		{2, io.SeekStart, 2, "234"},
+	} {
+		pos, err := download.Seek(tt.offset, tt.whence)
+		require.NoError(t, err)
+		assert.Equal(t, tt.pos, pos)
+		assert.Equal(t, tt.data, read(len(tt.data)))
+	}
+
+	// seeking past the end is allowed, but there's nothing to read
+	pos, err := download.Seek(5, io.SeekEnd)
+	require.NoError(t, err)
+	assert.EqualValues(t, 25, pos)
+	_, err = download.Read(make([]byte, 1))
+	assert.Equal(t, io.EOF, err)
+
+	_, err = download.Seek(-1, io.SeekStart)
+	assert.Error(t, err)
+
+	require.NoError(t, download.Close())
+	_, err = download.Seek(0, io.SeekStart)
+	assert.Error(t, err)
+}
+
+func TestDownloadRange(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("0123456789abcdefghij")
+	object := &byteStream{size: int64(len(data))}
+	streams := &byteStreams{data: data}
+
+	_, err := stream.NewDownloadRange(ctx, object, streams, 15, 10)
+	assert.Error(t, err)
+
+	download, err := stream.NewDownloadRange(ctx, object, streams, 5, 10)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, download.Size())
+
+	all, err := ioutil.ReadAll(download)
+	require.NoError(t, err)
+	assert.Equal(t, "56789abcde", string(all))
+
+	// the offsets are relative to the section
+	buf := make([]byte, 4)
+	n, err := download.ReadAt(buf, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "789a", string(buf[:n]))
+
+	n, err = download.ReadAt(buf, 8)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "de", string(buf[:n]))
+
+	_, err = download.ReadAt(buf, 10)
+	assert.Equal(t, io.EOF, err)
+
+	// ReadAt may be called concurrently
+	var wg sync.WaitGroup
+	for i := int64(0); i < 6; i++ {
+		wg.Add(1)
+		go func(off int64) {
+			defer wg.Done()
+			buf := make([]byte, 4)
+			n, err := download.ReadAt(buf, off)
+			assert.NoError(t, err)
+			assert.Equal(t, string(data[5+off:9+off]), string(buf[:n]))
+		}(i)
+	}
+	wg.Wait()
+
+	require.NoError(t, download.Close())
+}
+
+func TestDownloadChecksum(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("0123456789abcdefghij")
+	checksum := md5.Sum(data)
+	streams := &byteStreams{data: data}
+
+	// the content matches the checksum
+	download := stream.NewDownload(ctx, &byteStream{size: int64(len(data)), checksum: checksum[:]}, streams)
+	all, err := ioutil.ReadAll(download)
+	require.NoError(t, err)
+	assert.Equal(t, data, all)
+	require.NoError(t, download.Close())
+
+	// the content was corrupted
+	corrupted := md5.Sum([]byte("corrupted"))
+	download = stream.NewDownload(ctx, &byteStream{size: int64(len(data)), checksum: corrupted[:]}, streams)
+	_, err = ioutil.ReadAll(download)
+	assert.True(t, stream.ErrChecksum.Has(err))
+	require.NoError(t, download.Close())
+
+	// sections and seeked reads aren't verified
+	download, err = stream.NewDownloadRange(ctx, &byteStream{size: int64(len(data)), checksum: corrupted[:]}, streams, 5, 10)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(download)
+	require.NoError(t, err)
+	require.NoError(t, download.Close())
+
+	download = stream.NewDownload(ctx, &byteStream{size: int64(len(data)), checksum: corrupted[:]}, streams)
+	_, err = download.Seek(5, io.SeekStart)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(download)
+	require.NoError(t, err)
+	require.NoError(t, download.Close())
+}
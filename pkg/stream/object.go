@@ -0,0 +1,42 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+// NewWriter creates the object at path in bucket and returns a writer for
+// its data. The data is segmented, encrypted and erasure coded while it's
+// written, so data of unknown length can be uploaded without buffering it.
+// The object is committed by Close, it isn't stored when Close fails or ctx
+// is canceled before.
+func NewWriter(ctx context.Context, metainfo storj.Metainfo, streams streams.Store, bucket string, path storj.Path, createInfo *storj.CreateObject) (*Upload, error) {
+	object, err := metainfo.CreateObject(ctx, bucket, path, createInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	mutableStream, err := object.CreateStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewUpload(ctx, mutableStream, streams), nil
+}
+
+// NewReader returns a reader of the data of the object at path in bucket.
+// The segments are downloaded and decrypted while the data is read, Close
+// releases the connections to the storage nodes.
+func NewReader(ctx context.Context, metainfo storj.Metainfo, streams streams.Store, bucket string, path storj.Path) (*Download, error) {
+	readOnlyStream, err := metainfo.GetObjectStream(ctx, bucket, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDownload(ctx, readOnlyStream, streams), nil
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream_test
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+// memoryMetainfo keeps the information about a single object
+type memoryMetainfo struct {
+	storj.Metainfo
+	object storj.Object
+}
+
+func (db *memoryMetainfo) CreateObject(ctx context.Context, bucket string, path storj.Path, info *storj.CreateObject) (storj.MutableObject, error) {
+	db.object = storj.Object{Bucket: storj.Bucket{Name: bucket}, Path: path, ContentType: info.ContentType}
+	return &memoryObject{db: db}, nil
+}
+
+func (db *memoryMetainfo) GetObjectStream(ctx context.Context, bucket string, path storj.Path) (storj.ReadOnlyStream, error) {
+	if db.object.Bucket.Name != bucket || db.object.Path != path {
+		return nil, storj.ErrObjectNotFound.New(path)
+	}
+	return &byteStream{size: db.object.Size}, nil
+}
+
+// memoryObject is the object, and its stream, of memoryMetainfo
+type memoryObject struct {
+	storj.MutableObject
+	storj.MutableStream
+	db *memoryMetainfo
+}
+
+func (object *memoryObject) CreateStream(ctx context.Context) (storj.MutableStream, error) {
+	return object, nil
+}
+
+func (object *memoryObject) Info() storj.Object { return object.db.object }
+
+// memoryStreams stores the data of a single stream
+type memoryStreams struct {
+	byteStreams
+	db *memoryMetainfo
+}
+
+func (s *memoryStreams) Put(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (streams.Meta, error) {
+	var err error
+	s.data, err = ioutil.ReadAll(data)
+	if err != nil {
+		return streams.Meta{}, err
+	}
+	s.db.object.Size = int64(len(s.data))
+	return streams.Meta{Size: int64(len(s.data))}, nil
+}
+
+func TestWriterReader(t *testing.T) {
+	ctx := context.Background()
+	db := &memoryMetainfo{}
+	store := &memoryStreams{db: db}
+
+	data := make([]byte, 10000)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	writer, err := stream.NewWriter(ctx, db, store, "bucket", "object", &storj.CreateObject{ContentType: "text/plain"})
+	require.NoError(t, err)
+
+	// the data is written in pieces of unknown total length
+	for offset := 0; offset < len(data); offset += 3000 {
+		end := offset + 3000
+		if end > len(data) {
+			end = len(data)
+		}
+		n, err := writer.Write(data[offset:end])
+		require.NoError(t, err)
+		assert.Equal(t, end-offset, n)
+	}
+	require.NoError(t, writer.Close())
+	assert.Equal(t, data, store.data)
+
+	reader, err := stream.NewReader(ctx, db, store, "bucket", "object")
+	require.NoError(t, err)
+	assert.EqualValues(t, len(data), reader.Size())
+
+	downloaded, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, data, downloaded)
+
+	_, err = stream.NewReader(ctx, db, store, "bucket", "missing")
+	assert.True(t, storj.ErrObjectNotFound.Has(err))
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+// ResumableUpload uploads a stream in parts, which may be pushed in several
+// sessions. The state of the upload is saved to a file after every uploaded
+// segment, so an upload interrupted by a restart of the process is continued
+// with ResumeUpload. The data after the last uploaded segment is kept in
+// memory until the next part or the commit, which is why a resumed upload
+// continues at Offset. A ResumableUpload isn't safe for concurrent use.
+type ResumableUpload struct {
+	streams   streams.Store
+	statePath string
+	state     uploadState
+	rest      []byte
+}
+
+// uploadState is the state of a resumable upload saved between sessions
+type uploadState struct {
+	Bucket  string                 `json:"bucket"`
+	Path    storj.Path             `json:"path"`
+	Pending *streams.PendingUpload `json:"pending"`
+}
+
+// BeginUpload begins a resumable upload of the stream, the state of the
+// upload is saved to statePath, which mustn't exist yet
+func BeginUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store, statePath string) (_ *ResumableUpload, err error) {
+	obj := stream.Info()
+
+	metadata, err := proto.Marshal(&pb.SerializableMeta{
+		ContentType: obj.ContentType,
+		UserDefined: obj.Metadata,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// claim the state file before anything is uploaded
+	file, err := os.OpenFile(statePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	pending, err := streams.BeginUpload(keepVersions(ctx, obj), storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, metadata, obj.Expires)
+	if err != nil {
+		return nil, Error.Wrap(errs.Combine(err, os.Remove(statePath)))
+	}
+
+	upload := &ResumableUpload{
+		streams:   streams,
+		statePath: statePath,
+		state: uploadState{
+			Bucket:  obj.Bucket.Name,
+			Path:    obj.Path,
+			Pending: pending,
+		},
+	}
+	return upload, upload.save()
+}
+
+// ResumeUpload continues the resumable upload whose state was saved to statePath
+func ResumeUpload(ctx context.Context, streams streams.Store, statePath string) (*ResumableUpload, error) {
+	data, err := ioutil.ReadFile(statePath)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	upload := &ResumableUpload{
+		streams:   streams,
+		statePath: statePath,
+	}
+	if err := json.Unmarshal(data, &upload.state); err != nil {
+		return nil, Error.New("invalid upload state %q: %v", statePath, err)
+	}
+	if upload.state.Pending == nil {
+		return nil, Error.New("invalid upload state %q: no pending upload", statePath)
+	}
+	return upload, nil
+}
+
+// Bucket returns the bucket of the uploaded object
+func (upload *ResumableUpload) Bucket() string { return upload.state.Bucket }
+
+// Path returns the path of the uploaded object
+func (upload *ResumableUpload) Path() storj.Path { return upload.state.Path }
+
+// Offset returns the size of the data pushed so far, the next part
+// continues the stream at Offset
+func (upload *ResumableUpload) Offset() int64 {
+	return upload.state.Pending.Offset() + int64(len(upload.rest))
+}
+
+// PutPart uploads the next part of the stream. When it fails, the part is
+// pushed again from Offset.
+func (upload *ResumableUpload) PutPart(ctx context.Context, data io.Reader) (err error) {
+	reader := io.MultiReader(bytes.NewReader(upload.rest), data)
+	upload.rest = nil
+
+	pending := upload.state.Pending
+	for {
+		// the state is saved after every segment
+		segments := pending.Segments
+		rest, err := upload.streams.UploadSegments(ctx, pending, io.LimitReader(reader, pending.SegmentSize))
+		if err != nil {
+			return Error.Wrap(errs.Combine(err, upload.save()))
+		}
+		if err := upload.save(); err != nil {
+			return err
+		}
+		if len(rest) > 0 || pending.Segments == segments {
+			upload.rest = rest
+			return nil
+		}
+	}
+}
+
+// Commit uploads the rest of the stream, which makes the object visible,
+// and deletes the saved state
+func (upload *ResumableUpload) Commit(ctx context.Context) (meta streams.Meta, err error) {
+	meta, err = upload.streams.CommitUpload(ctx, upload.state.Pending, bytes.NewReader(upload.rest))
+	if err != nil {
+		return streams.Meta{}, Error.Wrap(errs.Combine(err, upload.save()))
+	}
+	upload.rest = nil
+	return meta, Error.Wrap(os.Remove(upload.statePath))
+}
+
+// Abort deletes the uploaded segments and the saved state
+func (upload *ResumableUpload) Abort(ctx context.Context) error {
+	upload.rest = nil
+	if err := upload.streams.AbortUpload(ctx, upload.state.Pending); err != nil {
+		return Error.Wrap(errs.Combine(err, upload.save()))
+	}
+	return Error.Wrap(os.Remove(upload.statePath))
+}
+
+// save replaces the saved state, a crash while saving keeps the previous state
+func (upload *ResumableUpload) save() error {
+	data, err := json.Marshal(upload.state)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(upload.statePath), filepath.Base(upload.statePath)+".")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	_, err = tmp.Write(data)
+	err = errs.Combine(err, tmp.Sync(), tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), upload.statePath)
+	}
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, os.Remove(tmp.Name())))
+	}
+	return nil
+}
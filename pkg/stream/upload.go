@@ -49,7 +49,7 @@ func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.
 			return utils.CombineErrors(err, reader.CloseWithError(err))
 		}
 
-		_, err = streams.Put(ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, reader, metadata, obj.Expires)
+		_, err = streams.Put(keepVersions(ctx, obj), storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, reader, metadata, obj.Expires)
 		if err != nil {
 			return utils.CombineErrors(err, reader.CloseWithError(err))
 		}
@@ -84,3 +84,12 @@ func (upload *Upload) Close() error {
 	// Wait for streams.Put to commit the upload to the PointerDB
 	return utils.CombineErrors(err, upload.errgroup.Wait())
 }
+
+// keepVersions returns a context, which keeps the object replaced by the
+// upload of obj as a version when the bucket of obj is versioned
+func keepVersions(ctx context.Context, obj storj.Object) context.Context {
+	if !obj.Bucket.Versioning {
+		return ctx
+	}
+	return streams.WithKeptVersions(ctx)
+}
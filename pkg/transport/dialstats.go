@@ -0,0 +1,184 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transport
+
+import (
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// LatencyBuckets are the upper bounds of the latency buckets of successful
+// dials, slower dials are counted in an additional last bucket
+var LatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+}
+
+// unknownGroup is the group of nodes which don't declare a region or
+// whose address isn't an IP
+const unknownGroup = "unknown"
+
+// GroupStats contains the outcomes of the dials to a group of nodes
+type GroupStats struct {
+	Group        string
+	Successes    int64
+	Failures     int64
+	TotalLatency time.Duration
+	// LatencyCounts are the number of successful dials in each latency bucket
+	LatencyCounts []int64
+}
+
+// SuccessRatio returns the ratio of the dials to the group which succeeded
+func (group *GroupStats) SuccessRatio() float64 {
+	total := group.Successes + group.Failures
+	if total == 0 {
+		return 0
+	}
+	return float64(group.Successes) / float64(total)
+}
+
+// DialStats collects the outcomes of dials by the region and the subnet of
+// the dialed nodes, so degraded connectivity to a part of the network can be
+// told apart from failing nodes
+type DialStats struct {
+	mu      sync.Mutex
+	regions map[string]*GroupStats
+	subnets map[string]*GroupStats
+}
+
+// NewDialStats creates empty dial stats
+func NewDialStats() *DialStats {
+	return &DialStats{
+		regions: make(map[string]*GroupStats),
+		subnets: make(map[string]*GroupStats),
+	}
+}
+
+// dialStats are the stats of every dial of the process
+var dialStats = NewDialStats()
+
+// Stats returns the stats of the dials made by the transport clients of the process
+func Stats() *DialStats {
+	return dialStats
+}
+
+// Record adds the outcome of a dial to node which took latency to the stats
+func (stats *DialStats) Record(node *pb.Node, latency time.Duration, err error) {
+	region := node.GetMetadata().GetRegion()
+	if region == "" {
+		region = unknownGroup
+	}
+	subnet := subnetOf(node.GetAddress().GetAddress())
+
+	if err != nil {
+		mon.Meter("dial_failure").Mark(1)
+		mon.Meter("dial_failure_region_" + region).Mark(1)
+	} else {
+		mon.Meter("dial_success").Mark(1)
+		mon.Meter("dial_success_region_" + region).Mark(1)
+		mon.IntVal("dial_latency_ms").Observe(latency.Nanoseconds() / int64(time.Millisecond))
+		mon.IntVal("dial_latency_ms_region_" + region).Observe(latency.Nanoseconds() / int64(time.Millisecond))
+	}
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	group(stats.regions, region).add(latency, err)
+	group(stats.subnets, subnet).add(latency, err)
+}
+
+// Regions returns the stats of every region ordered by name
+func (stats *DialStats) Regions() []GroupStats {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	return sorted(stats.regions)
+}
+
+// Subnets returns up to limit subnets with the most failed dials, ordered by
+// their number of failures
+func (stats *DialStats) Subnets(limit int) []GroupStats {
+	stats.mu.Lock()
+	subnets := sorted(stats.subnets)
+	stats.mu.Unlock()
+
+	sort.SliceStable(subnets, func(i, k int) bool {
+		return subnets[i].Failures > subnets[k].Failures
+	})
+	if limit >= 0 && limit < len(subnets) {
+		subnets = subnets[:limit]
+	}
+	return subnets
+}
+
+// group returns the stats of name in groups, creating them when missing
+func group(groups map[string]*GroupStats, name string) *GroupStats {
+	stats, ok := groups[name]
+	if !ok {
+		stats = &GroupStats{
+			Group:         name,
+			LatencyCounts: make([]int64, len(LatencyBuckets)+1),
+		}
+		groups[name] = stats
+	}
+	return stats
+}
+
+// add adds the outcome of a dial to the group
+func (group *GroupStats) add(latency time.Duration, err error) {
+	if err != nil {
+		group.Failures++
+		return
+	}
+	group.Successes++
+	group.TotalLatency += latency
+	group.LatencyCounts[latencyBucket(latency)]++
+}
+
+// sorted copies the stats of groups ordered by name
+func sorted(groups map[string]*GroupStats) []GroupStats {
+	copies := make([]GroupStats, 0, len(groups))
+	for _, stats := range groups {
+		copied := *stats
+		copied.LatencyCounts = append([]int64(nil), stats.LatencyCounts...)
+		copies = append(copies, copied)
+	}
+	sort.Slice(copies, func(i, k int) bool {
+		return copies[i].Group < copies[k].Group
+	})
+	return copies
+}
+
+// latencyBucket returns the index of the latency bucket of a dial
+func latencyBucket(latency time.Duration) int {
+	for i, limit := range LatencyBuckets {
+		if latency <= limit {
+			return i
+		}
+	}
+	return len(LatencyBuckets)
+}
+
+// subnetOf returns the /24 IPv4 or /64 IPv6 subnet of a node address
+func subnetOf(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return unknownGroup
+	}
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return (&net.IPNet{IP: ipv4.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}).String()
+	}
+	return (&net.IPNet{IP: ip.Mask(net.CIDRMask(64, 128)), Mask: net.CIDRMask(64, 128)}).String()
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transport_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/transport"
+)
+
+func TestDialStats(t *testing.T) {
+	stats := transport.NewDialStats()
+
+	node := func(address, region string) *pb.Node {
+		return &pb.Node{
+			Address:  &pb.NodeAddress{Address: address},
+			Metadata: &pb.NodeMetadata{Region: region},
+		}
+	}
+	failed := errors.New("dial failed")
+
+	stats.Record(node("10.0.1.1:7777", "eu"), 20*time.Millisecond, nil)
+	stats.Record(node("10.0.1.2:7777", "eu"), 2*time.Second, nil)
+	stats.Record(node("10.0.2.1:7777", "eu"), 0, failed)
+	stats.Record(node("10.0.2.2:7777", ""), 0, failed)
+	stats.Record(node("[2001:db8::1]:7777", "us"), 10*time.Second, nil)
+
+	regions := stats.Regions()
+	require.Len(t, regions, 3)
+	assert.Equal(t, "eu", regions[0].Group)
+	assert.Equal(t, int64(2), regions[0].Successes)
+	assert.Equal(t, int64(1), regions[0].Failures)
+	assert.Equal(t, 2020*time.Millisecond, regions[0].TotalLatency)
+	assert.Equal(t, []int64{1, 0, 0, 0, 0, 1, 0}, regions[0].LatencyCounts)
+	assert.InDelta(t, 2.0/3.0, regions[0].SuccessRatio(), 0.001)
+	assert.Equal(t, "unknown", regions[1].Group)
+	assert.Equal(t, "us", regions[2].Group)
+	assert.Equal(t, []int64{0, 0, 0, 0, 0, 0, 1}, regions[2].LatencyCounts)
+
+	subnets := stats.Subnets(-1)
+	require.Len(t, subnets, 3)
+	assert.Equal(t, "10.0.2.0/24", subnets[0].Group)
+	assert.Equal(t, int64(2), subnets[0].Failures)
+
+	subnets = stats.Subnets(2)
+	require.Len(t, subnets, 2)
+	assert.Equal(t, "10.0.1.0/24", subnets[1].Group)
+	assert.Equal(t, int64(2), subnets[1].Successes)
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transport
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// inspectorSubnetLimit is the number of subnets with the most failed dials
+// returned by the inspector
+const inspectorSubnetLimit = 100
+
+// Inspector is a gRPC service for inspecting the dials of the process
+type Inspector struct {
+	stats *DialStats
+}
+
+// NewInspector creates an Inspector
+func NewInspector(stats *DialStats) *Inspector {
+	return &Inspector{stats: stats}
+}
+
+// DialStats returns the outcomes of the dials by region and subnet
+func (srv *Inspector) DialStats(ctx context.Context, req *pb.DialStatsRequest) (_ *pb.DialStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resp := &pb.DialStatsResponse{}
+	for _, limit := range LatencyBuckets {
+		resp.LatencyBucketsMs = append(resp.LatencyBucketsMs, int64(limit/time.Millisecond))
+	}
+	for _, region := range srv.stats.Regions() {
+		resp.Regions = append(resp.Regions, groupToPB(region))
+	}
+	for _, subnet := range srv.stats.Subnets(inspectorSubnetLimit) {
+		resp.Subnets = append(resp.Subnets, groupToPB(subnet))
+	}
+	return resp, nil
+}
+
+// groupToPB converts the stats of a group to protobuf
+func groupToPB(group GroupStats) *pb.DialGroupStats {
+	return &pb.DialGroupStats{
+		Group:          group.Group,
+		Successes:      group.Successes,
+		Failures:       group.Failures,
+		TotalLatencyMs: int64(group.TotalLatency / time.Millisecond),
+		LatencyCounts:  group.LatencyCounts,
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transport
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc"
+)
+
+// maxResolvedHosts is how many hosts are cached before the expired ones are pruned
+const maxResolvedHosts = 4096
+
+// Resolver resolves host names, caching the addresses for a fixed time and
+// dialing pinned hosts at static addresses
+type Resolver struct {
+	ttl    time.Duration
+	pins   map[string]string
+	lookup func(ctx context.Context, host string) ([]string, error)
+	now    func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]resolvedHost
+}
+
+// resolvedHost are the addresses of a host and when they expire
+type resolvedHost struct {
+	addrs   []string
+	expires time.Time
+}
+
+// NewResolver returns a Resolver caching addresses for ttl, a ttl of 0
+// disables the cache. The hosts in pins are never resolved.
+func NewResolver(ttl time.Duration, pins map[string]string) *Resolver {
+	return &Resolver{
+		ttl:    ttl,
+		pins:   pins,
+		lookup: net.DefaultResolver.LookupHost,
+		now:    time.Now,
+		cache:  make(map[string]resolvedHost),
+	}
+}
+
+// Resolve returns the addresses of host. When resolving fails the expired
+// addresses are returned if there are any.
+func (resolver *Resolver) Resolve(ctx context.Context, host string) (addrs []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if addr, ok := resolver.pins[host]; ok {
+		return []string{addr}, nil
+	}
+	if net.ParseIP(host) != nil {
+		return []string{host}, nil
+	}
+
+	now := resolver.now()
+	resolver.mu.Lock()
+	cached, ok := resolver.cache[host]
+	resolver.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.addrs, nil
+	}
+
+	addrs, err = resolver.lookup(ctx, host)
+	if err != nil {
+		if ok {
+			mon.Meter("dns_stale_addresses").Mark(1)
+			return cached.addrs, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+	if resolver.ttl <= 0 {
+		return addrs, nil
+	}
+
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+	if len(resolver.cache) >= maxResolvedHosts {
+		for host, cached := range resolver.cache {
+			if !now.Before(cached.expires) {
+				delete(resolver.cache, host)
+			}
+		}
+	}
+	resolver.cache[host] = resolvedHost{addrs: addrs, expires: now.Add(resolver.ttl)}
+	return addrs, nil
+}
+
+// DialContext connects to address, trying each address of its host in turn
+func (resolver *Resolver) DialContext(ctx context.Context, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	addrs, err := resolver.Resolve(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var dialer net.Dialer
+	var group errs.Group
+	for _, addr := range addrs {
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(addr, port))
+		if err == nil {
+			return conn, nil
+		}
+		group.Add(err)
+	}
+	return nil, Error.Wrap(group.Err())
+}
+
+// DialOption returns the option to dial grpc connections through the resolver
+func (resolver *Resolver) DialOption() grpc.DialOption {
+	return grpc.WithDialer(func(address string, timeout time.Duration) (net.Conn, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return resolver.DialContext(ctx, address)
+	})
+}
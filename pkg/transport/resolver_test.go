@@ -0,0 +1,80 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver(t *testing.T) {
+	ctx := context.Background()
+
+	resolver := NewResolver(time.Minute, map[string]string{"satellite.test": "10.0.0.1"})
+	now := time.Unix(1e9, 0)
+	resolver.now = func() time.Time { return now }
+
+	lookups := 0
+	var lookupErr error
+	resolver.lookup = func(ctx context.Context, host string) ([]string, error) {
+		lookups++
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		return []string{"10.0.0.2"}, nil
+	}
+
+	// pinned hosts and addresses aren't resolved
+	addrs, err := resolver.Resolve(ctx, "satellite.test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1"}, addrs)
+	addrs, err = resolver.Resolve(ctx, "10.0.0.3")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.3"}, addrs)
+	assert.Equal(t, 0, lookups)
+
+	// resolved addresses are cached until they expire
+	for i := 0; i < 3; i++ {
+		addrs, err = resolver.Resolve(ctx, "node.test")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"10.0.0.2"}, addrs)
+	}
+	assert.Equal(t, 1, lookups)
+
+	now = now.Add(2 * time.Minute)
+	_, err = resolver.Resolve(ctx, "node.test")
+	require.NoError(t, err)
+	assert.Equal(t, 2, lookups)
+
+	// expired addresses are used when resolving fails
+	now = now.Add(2 * time.Minute)
+	lookupErr = errors.New("resolver unavailable")
+	addrs, err = resolver.Resolve(ctx, "node.test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.2"}, addrs)
+
+	_, err = resolver.Resolve(ctx, "other.test")
+	assert.Error(t, err)
+}
+
+func TestResolver_DialContext(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = lis.Close() }()
+
+	_, port, err := net.SplitHostPort(lis.Addr().String())
+	require.NoError(t, err)
+
+	// the pinned host is dialed at the listener
+	resolver := NewResolver(0, map[string]string{"satellite.test": "127.0.0.1"})
+	conn, err := resolver.DialContext(context.Background(), net.JoinHostPort("satellite.test", port))
+	require.NoError(t, err)
+	assert.NoError(t, conn.Close())
+}
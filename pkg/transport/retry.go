@@ -0,0 +1,165 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transport
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/peertls"
+)
+
+// retryBudgetBurst is how many retries the budget allows before it has to be
+// refilled by first attempts
+const retryBudgetBurst = 10
+
+// RetryConfig is the configuration of how failed dials are retried
+type RetryConfig struct {
+	MaxAttempts    int           `help:"how often a dial is attempted, 1 disables retries" default:"3"`
+	InitialBackoff time.Duration `help:"how long to wait before the first retry, the wait doubles for every retry" default:"100ms"`
+	MaxBackoff     time.Duration `help:"the longest wait between two attempts" default:"2s"`
+	Jitter         float64       `help:"fraction of the wait which is randomized, so clients don't retry in lockstep" default:"0.5"`
+	Budget         float64       `help:"retries allowed per first attempt on average, failures beyond the budget aren't retried" default:"0.2"`
+}
+
+// RetryPolicy retries failed operations with exponential backoff and jitter.
+// Only errors Retryable classifies as transient are retried, and the retries
+// are limited by a budget, which is refilled by first attempts, so a failing
+// network isn't flooded with retries.
+type RetryPolicy struct {
+	config RetryConfig
+
+	// Retryable returns whether an operation failing with err is retried
+	Retryable func(err error) bool
+
+	mu     sync.Mutex
+	tokens float64
+	rand   *rand.Rand
+}
+
+// NewRetryPolicy creates a retry policy from config, it's safe for
+// concurrent use
+func NewRetryPolicy(config RetryConfig) *RetryPolicy {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 1
+	}
+	return &RetryPolicy{
+		config:    config,
+		Retryable: Retryable,
+		tokens:    retryBudgetBurst,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Do calls fn until it succeeds, it fails with an error which isn't
+// retryable, the attempts or the budget are exhausted, or ctx is done
+func (policy *RetryPolicy) Do(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	policy.deposit()
+
+	for attempt := 1; ; attempt++ {
+		err = fn(ctx)
+		if err == nil || attempt >= policy.config.MaxAttempts || ctx.Err() != nil {
+			return err
+		}
+		if !policy.Retryable(err) {
+			mon.Meter("retry_not_retryable").Mark(1)
+			return err
+		}
+		if !policy.withdraw() {
+			mon.Meter("retry_budget_exhausted").Mark(1)
+			return err
+		}
+
+		mon.Meter("retry").Mark(1)
+		if !sync2.Sleep(ctx, policy.backoff(attempt)) {
+			return errs.Combine(err, ctx.Err())
+		}
+	}
+}
+
+// backoff returns how long to wait after attempt failed
+func (policy *RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := policy.config.InitialBackoff
+	for i := 1; i < attempt && backoff < policy.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if policy.config.MaxBackoff > 0 && backoff > policy.config.MaxBackoff {
+		backoff = policy.config.MaxBackoff
+	}
+
+	jitter := policy.config.Jitter
+	if jitter <= 0 {
+		return backoff
+	}
+	if jitter > 1 {
+		jitter = 1
+	}
+
+	policy.mu.Lock()
+	random := policy.rand.Float64()
+	policy.mu.Unlock()
+
+	return backoff - time.Duration(jitter*random*float64(backoff))
+}
+
+// deposit refills the budget for a first attempt
+func (policy *RetryPolicy) deposit() {
+	policy.mu.Lock()
+	defer policy.mu.Unlock()
+
+	policy.tokens += policy.config.Budget
+	if policy.tokens > retryBudgetBurst {
+		policy.tokens = retryBudgetBurst
+	}
+}
+
+// withdraw takes a retry from the budget, it returns false when the budget
+// is exhausted
+func (policy *RetryPolicy) withdraw() bool {
+	policy.mu.Lock()
+	defer policy.mu.Unlock()
+
+	if policy.tokens < 1 {
+		return false
+	}
+	policy.tokens--
+	return true
+}
+
+// Retryable returns whether err is transient. Failed dials and unavailable
+// or overloaded peers are retried, canceled requests and errors verifying
+// identities or signatures are not.
+func Retryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if peertls.ErrVerifyPeerCert.Has(err) || peertls.ErrExtension.Has(err) || peertls.ErrRevocation.Has(err) ||
+		identity.Error.Has(err) || identity.ErrChainLength.Has(err) {
+		return false
+	}
+
+	err = errs.Unwrap(err)
+	if err == context.Canceled {
+		return false
+	}
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded:
+			return true
+		default:
+			return false
+		}
+	}
+	// errors, which aren't statuses, are failed dials
+	return true
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/peertls"
+	"storj.io/storj/pkg/transport"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	ctx := context.Background()
+	config := transport.RetryConfig{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+		Jitter:         0.5,
+		Budget:         0,
+	}
+	failed := errors.New("dial failed")
+
+	for _, test := range []struct {
+		name     string
+		fail     []error
+		attempts int
+		err      bool
+	}{
+		{name: "success", attempts: 1},
+		{name: "retried dial", fail: []error{failed}, attempts: 2},
+		{name: "unavailable", fail: []error{status.Error(codes.Unavailable, "busy"), status.Error(codes.ResourceExhausted, "busy")}, attempts: 3},
+		{name: "attempts exhausted", fail: []error{failed, failed, failed, failed}, attempts: 3, err: true},
+		{name: "signature", fail: []error{peertls.ErrVerifyPeerCert.New("invalid signature")}, attempts: 1, err: true},
+		{name: "permission", fail: []error{status.Error(codes.PermissionDenied, "denied")}, attempts: 1, err: true},
+		{name: "canceled", fail: []error{context.Canceled}, attempts: 1, err: true},
+	} {
+		policy := transport.NewRetryPolicy(config)
+
+		attempts := 0
+		err := policy.Do(ctx, func(ctx context.Context) error {
+			attempts++
+			if attempts <= len(test.fail) {
+				return test.fail[attempts-1]
+			}
+			return nil
+		})
+		assert.Equal(t, test.attempts, attempts, test.name)
+		assert.Equal(t, test.err, err != nil, test.name)
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	ctx := context.Background()
+	policy := transport.NewRetryPolicy(transport.RetryConfig{
+		MaxAttempts: 2,
+		Budget:      0.5,
+	})
+	failed := errors.New("dial failed")
+
+	attempts := 0
+	for i := 0; i < 40; i++ {
+		_ = policy.Do(ctx, func(ctx context.Context) error {
+			attempts++
+			return failed
+		})
+	}
+	// every dial is retried until the burst of the budget is used up, then
+	// every other dial is retried
+	assert.Equal(t, 40+19+10, attempts)
+}
+
+func TestRetryCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := transport.NewRetryPolicy(transport.RetryConfig{
+		MaxAttempts:    10,
+		InitialBackoff: time.Hour,
+	})
+
+	attempts := 0
+	err := policy.Do(ctx, func(ctx context.Context) error {
+		attempts++
+		cancel()
+		return errors.New("dial failed")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryable(t *testing.T) {
+	assert.False(t, transport.Retryable(nil))
+	assert.True(t, transport.Retryable(transport.Error.Wrap(context.DeadlineExceeded)))
+	assert.True(t, transport.Retryable(status.Error(codes.Unavailable, "too many concurrent uploads")))
+	assert.False(t, transport.Retryable(status.Error(codes.Unauthenticated, "invalid api key")))
+	assert.False(t, transport.Retryable(transport.Error.Wrap(peertls.ErrVerifyPeerCert.New("invalid signature"))))
+}
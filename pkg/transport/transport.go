@@ -5,6 +5,7 @@ package transport
 
 import (
 	"context"
+	"net"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -13,6 +14,7 @@ import (
 
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/relay"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -42,6 +44,8 @@ type Client interface {
 type Transport struct {
 	identity  *identity.FullIdentity
 	observers []Observer
+	options   []grpc.DialOption
+	retries   *RetryPolicy
 }
 
 // NewClient returns a newly instantiated Transport Client
@@ -52,6 +56,27 @@ func NewClient(identity *identity.FullIdentity, obs ...Observer) Client {
 	}
 }
 
+// NewClientWithOptions returns a newly instantiated Transport Client which
+// dials with options in addition to the ones of each call
+func NewClientWithOptions(identity *identity.FullIdentity, options []grpc.DialOption, obs ...Observer) Client {
+	return &Transport{
+		identity:  identity,
+		observers: obs,
+		options:   options,
+	}
+}
+
+// NewClientWithRetries returns a newly instantiated Transport Client which
+// dials with options and retries failed dials with retries
+func NewClientWithRetries(identity *identity.FullIdentity, options []grpc.DialOption, retries *RetryPolicy, obs ...Observer) Client {
+	return &Transport{
+		identity:  identity,
+		observers: obs,
+		options:   options,
+		retries:   retries,
+	}
+}
+
 // DialNode returns a grpc connection with tls to a node
 func (transport *Transport) DialNode(ctx context.Context, node *pb.Node, opts ...grpc.DialOption) (conn *grpc.ClientConn, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -68,20 +93,42 @@ func (transport *Transport) DialNode(ctx context.Context, node *pb.Node, opts ..
 		return nil, Error.Wrap(err)
 	}
 
-	options := append([]grpc.DialOption{dialOpt, grpc.WithBlock()}, opts...)
+	options := append([]grpc.DialOption{dialOpt, grpc.WithBlock()}, transport.options...)
+	if node.Address.Transport == pb.NodeTransport_RELAY_TCP_TLS_GRPC {
+		// the address is the relay the node accepts connections through
+		nodeID := node.Id
+		options = append(options, grpc.WithDialer(func(address string, timeout time.Duration) (net.Conn, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			return relay.Dial(ctx, transport.identity, address, nodeID)
+		}))
+	}
+	options = append(options, opts...)
+
+	err = transport.retry(ctx, func(ctx context.Context) (err error) {
+		conn, err = transport.dialNode(ctx, node, options)
+		return err
+	})
+	return conn, err
+}
 
+// dialNode makes a single attempt to dial node
+func (transport *Transport) dialNode(ctx context.Context, node *pb.Node, options []grpc.DialOption) (conn *grpc.ClientConn, err error) {
 	ctx, cf := context.WithTimeout(ctx, timeout)
 	defer cf()
 
+	start := time.Now()
 	conn, err = grpc.DialContext(ctx, node.GetAddress().Address, options...)
 	if err != nil {
 		if err == context.Canceled {
 			return nil, err
 		}
+		dialStats.Record(node, time.Since(start), err)
 		alertFail(ctx, transport.observers, node, err)
 		return nil, Error.Wrap(err)
 	}
 
+	dialStats.Record(node, time.Since(start), nil)
 	alertSuccess(ctx, transport.observers, node)
 
 	return conn, nil
@@ -96,12 +143,25 @@ func (transport *Transport) DialAddress(ctx context.Context, address string, opt
 		return nil, Error.Wrap(err)
 	}
 
-	options := append([]grpc.DialOption{dialOpt, grpc.WithBlock()}, opts...)
-	conn, err = grpc.DialContext(ctx, address, options...)
-	if err == context.Canceled {
-		return nil, err
+	options := append([]grpc.DialOption{dialOpt, grpc.WithBlock()}, transport.options...)
+	options = append(options, opts...)
+
+	err = transport.retry(ctx, func(ctx context.Context) (err error) {
+		conn, err = grpc.DialContext(ctx, address, options...)
+		if err == context.Canceled {
+			return err
+		}
+		return Error.Wrap(err)
+	})
+	return conn, err
+}
+
+// retry calls dial once, or according to the retry policy of the transport
+func (transport *Transport) retry(ctx context.Context, dial func(ctx context.Context) error) error {
+	if transport.retries == nil {
+		return dial(ctx)
 	}
-	return conn, Error.Wrap(err)
+	return transport.retries.Do(ctx, dial)
 }
 
 // Identity is a getter for the transport's identity
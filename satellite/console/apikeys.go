@@ -12,6 +12,8 @@ import (
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/macaroon"
 )
 
 // APIKeys is interface for working with api keys store
@@ -22,6 +24,9 @@ type APIKeys interface {
 	Get(ctx context.Context, id uuid.UUID) (*APIKeyInfo, error)
 	//GetByKey retrieves APIKeyInfo for given key
 	GetByKey(ctx context.Context, key APIKey) (*APIKeyInfo, error)
+	// GetWithKey retrieves APIKeyInfo and the key with given ID, the key is
+	// the secret restricted api keys are checked with
+	GetWithKey(ctx context.Context, id uuid.UUID) (*APIKeyInfo, *APIKey, error)
 	// Create creates and stores new APIKeyInfo
 	Create(ctx context.Context, key APIKey, info APIKeyInfo) (*APIKeyInfo, error)
 	// Update updates APIKeyInfo in store
@@ -50,6 +55,13 @@ func (key APIKey) String() string {
 	return base64.URLEncoding.EncodeToString(key[:])
 }
 
+// Macaroon returns the restrictable form of the key with the given id. The
+// id identifies the key and the key is the secret the macaroon is signed
+// with, so restricted keys don't reveal it.
+func (key APIKey) Macaroon(id uuid.UUID) *macaroon.APIKey {
+	return macaroon.NewAPIKey(id[:], key[:])
+}
+
 // APIKeyFromBytes creates new key from byte slice
 func APIKeyFromBytes(b []byte) *APIKey {
 	key := new(APIKey)
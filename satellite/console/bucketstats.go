@@ -0,0 +1,38 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// BucketStats exposes methods to manage the object and byte counters of buckets.
+type BucketStats interface {
+	// Add is a method for atomically adding deltas to the counters of a bucket.
+	Add(ctx context.Context, projectID uuid.UUID, bucketName string, objects, bytes int64) error
+	// Get is a method for querying the counters of a bucket, zero counters are returned for unknown buckets.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string) (*BucketStat, error)
+	// GetByProject is a method for querying the counters of all buckets of a project.
+	GetByProject(ctx context.Context, projectID uuid.UUID) ([]BucketStat, error)
+	// GetAll is a method for querying the counters of all buckets.
+	GetAll(ctx context.Context) ([]BucketStat, error)
+	// Reconcile is a method for replacing the counters of a bucket, unless they were
+	// changed after unchangedSince. Zero counters remove the bucket.
+	Reconcile(ctx context.Context, stat BucketStat, unchangedSince time.Time) (reconciled bool, err error)
+}
+
+// BucketStat is a database object that describes the number of committed objects
+// of a bucket and the number of bytes its segments store.
+type BucketStat struct {
+	ProjectID  uuid.UUID `json:"projectID"`
+	BucketName string    `json:"bucketName"`
+
+	ObjectCount int64 `json:"objectCount"`
+	TotalBytes  int64 `json:"totalBytes"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+}
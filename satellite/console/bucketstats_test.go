@@ -0,0 +1,90 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestBucketStatsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		stats := db.Console().BucketStats()
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+
+		{ // unknown buckets have zero counters
+			stat, err := stats.Get(ctx, *projectID, "photos")
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), stat.ObjectCount)
+			assert.Equal(t, int64(0), stat.TotalBytes)
+		}
+
+		{ // deltas are added up
+			require.NoError(t, stats.Add(ctx, *projectID, "photos", 1, 1000))
+			require.NoError(t, stats.Add(ctx, *projectID, "photos", 1, 500))
+			require.NoError(t, stats.Add(ctx, *projectID, "photos", -1, -1000))
+			require.NoError(t, stats.Add(ctx, *projectID, "backups", 1, 2000))
+
+			stat, err := stats.Get(ctx, *projectID, "photos")
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), stat.ObjectCount)
+			assert.Equal(t, int64(500), stat.TotalBytes)
+
+			byProject, err := stats.GetByProject(ctx, *projectID)
+			require.NoError(t, err)
+			require.Len(t, byProject, 2)
+			assert.Equal(t, "backups", byProject[0].BucketName)
+			assert.Equal(t, "photos", byProject[1].BucketName)
+
+			all, err := stats.GetAll(ctx)
+			require.NoError(t, err)
+			assert.Len(t, all, 2)
+		}
+
+		{ // counters changed after the reconciliation started are left alone
+			reconciled, err := stats.Reconcile(ctx, console.BucketStat{ProjectID: *projectID, BucketName: "photos", ObjectCount: 3, TotalBytes: 3000}, time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			assert.False(t, reconciled)
+
+			reconciled, err = stats.Reconcile(ctx, console.BucketStat{ProjectID: *projectID, BucketName: "photos", ObjectCount: 3, TotalBytes: 3000}, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			assert.True(t, reconciled)
+
+			stat, err := stats.Get(ctx, *projectID, "photos")
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), stat.ObjectCount)
+			assert.Equal(t, int64(3000), stat.TotalBytes)
+		}
+
+		{ // zero counters remove the bucket, missing counters are created
+			reconciled, err := stats.Reconcile(ctx, console.BucketStat{ProjectID: *projectID, BucketName: "backups"}, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			assert.True(t, reconciled)
+
+			reconciled, err = stats.Reconcile(ctx, console.BucketStat{ProjectID: *projectID, BucketName: "videos", ObjectCount: 1, TotalBytes: 100}, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			assert.True(t, reconciled)
+
+			byProject, err := stats.GetByProject(ctx, *projectID)
+			require.NoError(t, err)
+			require.Len(t, byProject, 2)
+			assert.Equal(t, "photos", byProject[0].BucketName)
+			assert.Equal(t, "videos", byProject[1].BucketName)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// maxTemplateShares is the largest number of shares a redundancy scheme can have
+const maxTemplateShares = 255
+
+// BucketTemplates exposes methods to manage the default settings of new buckets of projects.
+type BucketTemplates interface {
+	// Get is a method for querying the bucket template of a project.
+	Get(ctx context.Context, projectID uuid.UUID) (*BucketTemplate, error)
+	// Upsert is a method for creating or replacing the bucket template of a project.
+	Upsert(ctx context.Context, template *BucketTemplate) (*BucketTemplate, error)
+	// Delete is a method for removing the bucket template of a project.
+	Delete(ctx context.Context, projectID uuid.UUID) error
+}
+
+// BucketTemplate is a database object that describes the settings applied to buckets
+// when they are created in a project, so every bucket of an organization follows the same policy.
+type BucketTemplate struct {
+	ProjectID uuid.UUID `json:"projectID"`
+
+	PathCipher storj.Cipher `json:"pathCipher"`
+	DataCipher storj.Cipher `json:"dataCipher"`
+	BlockSize  int          `json:"blockSize"`
+
+	ShareSize      int `json:"shareSize"`
+	RequiredShares int `json:"requiredShares"`
+	RepairShares   int `json:"repairShares"`
+	OptimalShares  int `json:"optimalShares"`
+	TotalShares    int `json:"totalShares"`
+
+	// DistinctSubnets and DistinctRegions restrict the placement of the pieces of a segment
+	DistinctSubnets bool `json:"distinctSubnets"`
+	DistinctRegions bool `json:"distinctRegions"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// EncryptionScheme returns the encryption scheme of the data of new buckets.
+func (template *BucketTemplate) EncryptionScheme() storj.EncryptionScheme {
+	return storj.EncryptionScheme{
+		Cipher:    template.DataCipher,
+		BlockSize: int32(template.BlockSize),
+	}
+}
+
+// RedundancyScheme returns the redundancy scheme of the segments of new buckets.
+func (template *BucketTemplate) RedundancyScheme() storj.RedundancyScheme {
+	return storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      int32(template.ShareSize),
+		RequiredShares: int16(template.RequiredShares),
+		RepairShares:   int16(template.RepairShares),
+		OptimalShares:  int16(template.OptimalShares),
+		TotalShares:    int16(template.TotalShares),
+	}
+}
+
+// validateBucketTemplate checks whether buckets can be created with the template.
+func validateBucketTemplate(template *BucketTemplate) error {
+	var errs validationErrors
+
+	if template.PathCipher > storj.SecretBox {
+		errs.Add("unsupported path cipher %d", template.PathCipher)
+	}
+	if template.DataCipher > storj.SecretBox {
+		errs.Add("unsupported data cipher %d", template.DataCipher)
+	}
+
+	if template.RequiredShares <= 0 {
+		errs.Add("required shares should be positive")
+	}
+	if template.RepairShares < template.RequiredShares {
+		errs.Add("repair shares can't be less than required shares")
+	}
+	if template.OptimalShares < template.RepairShares {
+		errs.Add("optimal shares can't be less than repair shares")
+	}
+	if template.TotalShares < template.OptimalShares {
+		errs.Add("total shares can't be less than optimal shares")
+	}
+	if template.TotalShares > maxTemplateShares {
+		errs.Add("total shares can't be more than %d", maxTemplateShares)
+	}
+
+	if template.ShareSize <= 0 {
+		errs.Add("share size should be positive")
+	}
+	if template.BlockSize <= 0 {
+		errs.Add("block size should be positive")
+	} else if template.ShareSize > 0 && template.RequiredShares > 0 &&
+		(template.ShareSize*template.RequiredShares)%template.BlockSize != 0 {
+		// the erasure coded stripes have to be made of whole encryption blocks
+		errs.Add("block size %d should evenly divide the stripe size %d", template.BlockSize, template.ShareSize*template.RequiredShares)
+	}
+
+	return errs.Combine()
+}
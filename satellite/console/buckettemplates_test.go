@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestBucketTemplatesRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		templates := db.Console().BucketTemplates()
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+
+		template := &console.BucketTemplate{
+			ProjectID:      *projectID,
+			PathCipher:     storj.AESGCM,
+			DataCipher:     storj.SecretBox,
+			BlockSize:      1024,
+			ShareSize:      1024,
+			RequiredShares: 29,
+			RepairShares:   35,
+			OptimalShares:  80,
+			TotalShares:    95,
+		}
+
+		t.Run("Get without template", func(t *testing.T) {
+			_, err := templates.Get(ctx, *projectID)
+			assert.Equal(t, sql.ErrNoRows, err)
+		})
+
+		t.Run("Upsert creates", func(t *testing.T) {
+			created, err := templates.Upsert(ctx, template)
+			require.NoError(t, err)
+			assert.Equal(t, *projectID, created.ProjectID)
+			assert.Equal(t, storj.SecretBox, created.DataCipher)
+			assert.Equal(t, 95, created.TotalShares)
+			assert.False(t, created.DistinctSubnets)
+		})
+
+		t.Run("Upsert replaces", func(t *testing.T) {
+			template.RequiredShares = 4
+			template.RepairShares = 6
+			template.OptimalShares = 8
+			template.TotalShares = 10
+			template.DistinctSubnets = true
+
+			_, err := templates.Upsert(ctx, template)
+			require.NoError(t, err)
+
+			updated, err := templates.Get(ctx, *projectID)
+			require.NoError(t, err)
+			assert.Equal(t, template.RedundancyScheme(), updated.RedundancyScheme())
+			assert.Equal(t, template.EncryptionScheme(), updated.EncryptionScheme())
+			assert.True(t, updated.DistinctSubnets)
+			assert.False(t, updated.DistinctRegions)
+		})
+
+		t.Run("Delete success", func(t *testing.T) {
+			err := templates.Delete(ctx, *projectID)
+			require.NoError(t, err)
+
+			_, err = templates.Get(ctx, *projectID)
+			assert.Equal(t, sql.ErrNoRows, err)
+		})
+	})
+}
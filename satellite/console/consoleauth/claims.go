@@ -16,6 +16,7 @@ import (
 // Claims represents data signed by server and used for authentication
 type Claims struct {
 	ID         uuid.UUID `json:"id"`
+	Session    uuid.UUID `json:"session"`
 	Email      string    `json:"email,omitempty"`
 	Expiration time.Time `json:"expires,omitempty"`
 }
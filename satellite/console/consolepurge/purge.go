@@ -0,0 +1,264 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consolepurge
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default purge errs class
+	Error = errs.Class("purge error")
+)
+
+// Config contains configurable values for the purge job
+type Config struct {
+	Interval  time.Duration `help:"how frequently projects scheduled for deletion are purged" default:"1h"`
+	BatchSize int           `help:"number of segments deleted per pointerdb pass" default:"100"`
+}
+
+// Service purges the data of projects whose deletion grace period has ended
+type Service struct {
+	log       *zap.Logger
+	config    Config
+	console   console.DB
+	pointerdb *pointerdb.Service
+	overlay   *overlay.Cache
+	transport transport.Client
+	identity  *identity.FullIdentity
+	ticker    *time.Ticker
+}
+
+// New creates a new purge Service
+func New(log *zap.Logger, config Config, console console.DB, pointerdb *pointerdb.Service, overlay *overlay.Cache, transport transport.Client, identity *identity.FullIdentity) *Service {
+	return &Service{
+		log:       log,
+		config:    config,
+		console:   console,
+		pointerdb: pointerdb,
+		overlay:   overlay,
+		transport: transport,
+		identity:  identity,
+		ticker:    time.NewTicker(config.Interval),
+	}
+}
+
+// Run runs the purge loop
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	service.log.Info("Purge service starting up")
+	for {
+		if err := service.Purge(ctx); err != nil {
+			service.log.Error("purge failed", zap.Error(err))
+		}
+
+		select {
+		case <-service.ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Close stops the purge loop
+func (service *Service) Close() error {
+	service.ticker.Stop()
+	return nil
+}
+
+// Purge purges all projects which are due for deletion
+func (service *Service) Purge(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deletions, err := service.console.ProjectDeletions().GetDue(ctx, time.Now())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for i := range deletions {
+		group.Add(service.purgeProject(ctx, &deletions[i]))
+	}
+	return group.Err()
+}
+
+// purgeProject deletes all segments, pieces, api keys and usage data of a project and records the results
+func (service *Service) purgeProject(ctx context.Context, deletion *console.ProjectDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	log := service.log.With(
+		zap.String("Project ID", deletion.ProjectID.String()),
+		zap.String("Requested By", deletion.RequestedBy.String()))
+	log.Info("purging project")
+
+	segments, pieces, err := service.deleteSegments(ctx, deletion.ProjectID)
+	deletion.SegmentsDeleted += segments
+	deletion.PiecesDeleted += pieces
+	if err != nil {
+		// keep the progress, the remaining segments are retried on the next run
+		return Error.Wrap(errs.Combine(err, service.console.ProjectDeletions().Update(ctx, deletion)))
+	}
+
+	// the project rows are deleted together, a failed purge leaves none of
+	// them behind half deleted and is retried on the next run
+	if err := service.console.ProjectDeletions().Purge(ctx, deletion); err != nil {
+		return Error.Wrap(err)
+	}
+
+	log.Info("project purged",
+		zap.Int64("Segments", deletion.SegmentsDeleted),
+		zap.Int64("Pieces", deletion.PiecesDeleted),
+		zap.Int64("API Keys", deletion.APIKeysDeleted),
+		zap.Time("Requested At", deletion.CreatedAt),
+		zap.Time("Purged At", deletion.PurgedAt))
+	return nil
+}
+
+// deleteSegments deletes all pointers under the project prefix in batches
+func (service *Service) deleteSegments(ctx context.Context, projectID uuid.UUID) (segments, pieces int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix := projectID.String() + "/"
+	for {
+		var paths []string
+		err = service.pointerdb.Iterate(prefix, "", true, false,
+			func(it storage.Iterator) error {
+				var item storage.ListItem
+				for len(paths) < service.config.BatchSize && it.Next(&item) {
+					paths = append(paths, item.Key.String())
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return segments, pieces, err
+		}
+		if len(paths) == 0 {
+			return segments, pieces, nil
+		}
+
+		for _, path := range paths {
+			if err := ctx.Err(); err != nil {
+				return segments, pieces, err
+			}
+
+			deleted, err := service.deleteSegment(ctx, path)
+			pieces += deleted
+			if err != nil {
+				return segments, pieces, err
+			}
+			segments++
+		}
+	}
+}
+
+// deleteSegment deletes pieces of a remote segment from the storage nodes and removes the pointer
+func (service *Service) deleteSegment(ctx context.Context, path string) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointerBytes, err := service.pointerdb.DB.Get(storage.Key(path))
+	if err != nil {
+		return 0, err
+	}
+
+	pointer := &pb.Pointer{}
+	if err := proto.Unmarshal(pointerBytes, pointer); err != nil {
+		return 0, err
+	}
+
+	if remote := pointer.GetRemote(); remote != nil {
+		deleted = service.deletePieces(ctx, remote)
+	}
+
+	return deleted, service.pointerdb.Delete(path)
+}
+
+// deletePieces fans out piece deletion to the storage nodes and returns the
+// number of successfully deleted pieces. Pieces on unreachable nodes are left
+// for garbage collection.
+func (service *Service) deletePieces(ctx context.Context, remote *pb.RemoteSegment) (deleted int64) {
+	var ids storj.NodeIDList
+	for _, piece := range remote.GetRemotePieces() {
+		ids = append(ids, piece.NodeId)
+	}
+
+	nodes, err := service.overlay.GetAll(ctx, ids)
+	if err != nil {
+		service.log.Warn("unable to look up nodes for piece deletion", zap.Error(err))
+		return 0
+	}
+
+	authorization, err := service.authorization()
+	if err != nil {
+		service.log.Warn("unable to sign piece deletion", zap.Error(err))
+		return 0
+	}
+
+	pieceID := psclient.PieceID(remote.GetPieceId())
+	results := make(chan error, len(nodes))
+	for _, node := range nodes {
+		go func(node *pb.Node) {
+			results <- service.deletePiece(ctx, node, pieceID, authorization)
+		}(node)
+	}
+
+	for range nodes {
+		if err := <-results; err != nil {
+			service.log.Debug("piece deletion failed", zap.Error(err))
+			continue
+		}
+		deleted++
+	}
+	return deleted
+}
+
+// deletePiece deletes a single piece from node
+func (service *Service) deletePiece(ctx context.Context, node *pb.Node, pieceID psclient.PieceID, authorization *pb.SignedMessage) (err error) {
+	if node == nil {
+		return Error.New("node not found in overlay")
+	}
+	node.Type.DPanicOnInvalid("purge delete piece")
+
+	derivedPieceID, err := pieceID.Derive(node.Id.Bytes())
+	if err != nil {
+		return err
+	}
+
+	ps, err := psclient.NewPSClient(ctx, service.transport, node, 0)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, ps.Close()) }()
+
+	return ps.Delete(ctx, derivedPieceID, authorization)
+}
+
+// authorization creates the satellite signed message used for piece deletion
+func (service *Service) authorization() (*pb.SignedMessage, error) {
+	signature, err := auth.GenerateSignature(service.identity.ID.Bytes(), service.identity)
+	if err != nil {
+		return nil, err
+	}
+	return auth.NewSignedMessage(signature, service.identity)
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consolepurge_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+func TestPurgeProject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		consoleDB := satellite.DB.Console()
+
+		require.NoError(t, uplink.Upload(ctx, satellite, "bucket", "remote", make([]byte, 10*memory.KiB)))
+		require.NoError(t, uplink.Upload(ctx, satellite, "bucket", "inline", []byte("inline")))
+
+		key, err := console.APIKeyFromBase64(uplink.APIKey[satellite.ID()])
+		require.NoError(t, err)
+		keyInfo, err := consoleDB.APIKeys().GetByKey(ctx, *key)
+		require.NoError(t, err)
+		projectID := keyInfo.ProjectID
+
+		err = satellite.DB.Accounting().SaveProjectStorageTally(ctx, time.Now(), map[uuid.UUID]float64{projectID: 1000})
+		require.NoError(t, err)
+		err = satellite.DB.ObjectTags().Set(ctx, projectID, "bucket", "remote", []pointerdb.ObjectTag{{Key: "camera", Value: "leica"}})
+		require.NoError(t, err)
+		err = satellite.DB.BucketLifecycles().Set(ctx, &pointerdb.BucketLifecycle{ProjectID: projectID, BucketName: "bucket", ExpireAfterDays: 30})
+		require.NoError(t, err)
+
+		stats, err := consoleDB.BucketStats().GetByProject(ctx, projectID)
+		require.NoError(t, err)
+		require.NotEmpty(t, stats)
+		segments := countSegments(t, satellite.Metainfo.Service, projectID)
+		require.NotZero(t, segments)
+
+		requestedBy, err := uuid.New()
+		require.NoError(t, err)
+		_, err = consoleDB.ProjectDeletions().Insert(ctx, &console.ProjectDeletion{
+			ProjectID:   projectID,
+			RequestedBy: *requestedBy,
+			PurgeAfter:  time.Now().Add(-time.Minute),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, satellite.Console.Purge.Purge(ctx))
+
+		assert.Zero(t, countSegments(t, satellite.Metainfo.Service, projectID))
+
+		keys, err := consoleDB.APIKeys().GetByProjectID(ctx, projectID)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+
+		stats, err = consoleDB.BucketStats().GetByProject(ctx, projectID)
+		require.NoError(t, err)
+		assert.Empty(t, stats)
+
+		stored, err := satellite.DB.Accounting().QueryProjectStorage(ctx, projectID, time.Time{}, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, stored)
+
+		tagged, err := satellite.DB.ObjectTags().Search(ctx, projectID, "bucket", "camera", "leica", false, 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, tagged)
+
+		_, err = satellite.DB.BucketLifecycles().Get(ctx, projectID, "bucket")
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		_, err = consoleDB.Projects().Get(ctx, projectID)
+		assert.Error(t, err)
+
+		deletion, err := consoleDB.ProjectDeletions().Get(ctx, projectID)
+		require.NoError(t, err)
+		assert.True(t, deletion.Purged())
+		assert.Equal(t, int64(segments), deletion.SegmentsDeleted)
+		assert.Equal(t, int64(1), deletion.APIKeysDeleted)
+	})
+}
+
+// countSegments counts the pointers stored under the project prefix
+func countSegments(t *testing.T, pointers *pointerdb.Service, projectID uuid.UUID) (count int) {
+	err := pointers.Iterate(projectID.String()+"/", "", true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				count++
+			}
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	return count
+}
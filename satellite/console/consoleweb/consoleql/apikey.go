@@ -17,6 +17,9 @@ const (
 	CreateAPIKeyType = "graphqlCreateAPIKey"
 	// FieldKey is field name for the actual key in createAPIKey
 	FieldKey = "key"
+	// FieldMacaroon is field name for the restrictable form of the key in
+	// createAPIKey
+	FieldMacaroon = "macaroon"
 )
 
 // graphqlAPIKeyInfo creates satellite.APIKeyInfo graphql object
@@ -48,6 +51,9 @@ func graphqlCreateAPIKey(types Types) *graphql.Object {
 			FieldKey: &graphql.Field{
 				Type: graphql.String,
 			},
+			FieldMacaroon: &graphql.Field{
+				Type: graphql.String,
+			},
 			APIKeyInfoType: &graphql.Field{
 				Type: types.APIKeyInfo(),
 			},
@@ -57,6 +63,7 @@ func graphqlCreateAPIKey(types Types) *graphql.Object {
 
 // createAPIKey holds satellite.APIKey and satellite.APIKeyInfo
 type createAPIKey struct {
-	Key     *console.APIKey
-	KeyInfo *console.APIKeyInfo
+	Key      *console.APIKey
+	Macaroon string
+	KeyInfo  *console.APIKeyInfo
 }
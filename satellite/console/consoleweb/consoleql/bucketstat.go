@@ -0,0 +1,40 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	// BucketStatType is a graphql type name for bucket stat
+	BucketStatType = "bucketStat"
+	// FieldBucketName is a field name for the name of a bucket
+	FieldBucketName = "bucketName"
+	// FieldObjectCount is a field name for the number of objects of a bucket
+	FieldObjectCount = "objectCount"
+	// FieldTotalBytes is a field name for the number of bytes stored by a bucket
+	FieldTotalBytes = "totalBytes"
+)
+
+// graphqlBucketStat creates bucket stat type
+func graphqlBucketStat() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: BucketStatType,
+		Fields: graphql.Fields{
+			FieldBucketName: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldObjectCount: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldTotalBytes: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldUpdatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
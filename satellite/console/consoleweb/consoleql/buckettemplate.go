@@ -0,0 +1,154 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// BucketTemplateType is a graphql type name for bucket template
+	BucketTemplateType = "bucketTemplate"
+	// BucketTemplateInputType is a graphql type name for bucket template input
+	BucketTemplateInputType = "bucketTemplateInput"
+	// FieldPathCipher is a field name for the cipher of object paths
+	FieldPathCipher = "pathCipher"
+	// FieldDataCipher is a field name for the cipher of object data
+	FieldDataCipher = "dataCipher"
+	// FieldBlockSize is a field name for the encryption block size
+	FieldBlockSize = "blockSize"
+	// FieldShareSize is a field name for the erasure share size
+	FieldShareSize = "shareSize"
+	// FieldRequiredShares is a field name for the shares needed to reconstruct a segment
+	FieldRequiredShares = "requiredShares"
+	// FieldRepairShares is a field name for the shares below which a segment is repaired
+	FieldRepairShares = "repairShares"
+	// FieldOptimalShares is a field name for the shares an upload stops at
+	FieldOptimalShares = "optimalShares"
+	// FieldTotalShares is a field name for the shares an upload starts with
+	FieldTotalShares = "totalShares"
+	// FieldDistinctSubnets is a field name for placing pieces on distinct subnets
+	FieldDistinctSubnets = "distinctSubnets"
+	// FieldDistinctRegions is a field name for placing pieces in distinct regions
+	FieldDistinctRegions = "distinctRegions"
+	// FieldUpdatedAt is a field name for last update timestamp
+	FieldUpdatedAt = "updatedAt"
+)
+
+// graphqlBucketTemplate creates bucket template type
+func graphqlBucketTemplate() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: BucketTemplateType,
+		Fields: graphql.Fields{
+			FieldProjectID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldPathCipher: &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					template, _ := p.Source.(*console.BucketTemplate)
+					return int(template.PathCipher), nil
+				},
+			},
+			FieldDataCipher: &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					template, _ := p.Source.(*console.BucketTemplate)
+					return int(template.DataCipher), nil
+				},
+			},
+			FieldBlockSize: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldShareSize: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldRequiredShares: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldRepairShares: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldOptimalShares: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldTotalShares: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldDistinctSubnets: &graphql.Field{
+				Type: graphql.Boolean,
+			},
+			FieldDistinctRegions: &graphql.Field{
+				Type: graphql.Boolean,
+			},
+			FieldUpdatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			FieldCreatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
+
+// graphqlBucketTemplateInput creates graphql.InputObject type needed to set console.BucketTemplate
+func graphqlBucketTemplateInput() *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: BucketTemplateInputType,
+		Fields: graphql.InputObjectConfigFieldMap{
+			FieldPathCipher: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldDataCipher: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldBlockSize: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldShareSize: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldRequiredShares: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldRepairShares: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldOptimalShares: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldTotalShares: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			FieldDistinctSubnets: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+			FieldDistinctRegions: &graphql.InputObjectFieldConfig{
+				Type: graphql.Boolean,
+			},
+		},
+	})
+}
+
+// fromMapBucketTemplate creates console.BucketTemplate from input args
+func fromMapBucketTemplate(args map[string]interface{}) (template console.BucketTemplate) {
+	pathCipher, _ := args[FieldPathCipher].(int)
+	dataCipher, _ := args[FieldDataCipher].(int)
+
+	template.PathCipher = storj.Cipher(pathCipher)
+	template.DataCipher = storj.Cipher(dataCipher)
+	template.BlockSize, _ = args[FieldBlockSize].(int)
+	template.ShareSize, _ = args[FieldShareSize].(int)
+	template.RequiredShares, _ = args[FieldRequiredShares].(int)
+	template.RepairShares, _ = args[FieldRepairShares].(int)
+	template.OptimalShares, _ = args[FieldOptimalShares].(int)
+	template.TotalShares, _ = args[FieldTotalShares].(int)
+	template.DistinctSubnets, _ = args[FieldDistinctSubnets].(bool)
+	template.DistinctRegions, _ = args[FieldDistinctRegions].(bool)
+
+	return
+}
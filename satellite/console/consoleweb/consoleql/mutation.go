@@ -28,6 +28,14 @@ const (
 	CreateProjectMutation = "createProject"
 	// DeleteProjectMutation is a mutation name for project deletion
 	DeleteProjectMutation = "deleteProject"
+	// ScheduleProjectDeletionMutation is a mutation name for scheduling project data purge
+	ScheduleProjectDeletionMutation = "scheduleProjectDeletion"
+	// CancelProjectDeletionMutation is a mutation name for canceling scheduled project data purge
+	CancelProjectDeletionMutation = "cancelProjectDeletion"
+	// SetBucketTemplateMutation is a mutation name for setting the settings of new buckets of a project
+	SetBucketTemplateMutation = "setBucketTemplate"
+	// DeleteBucketTemplateMutation is a mutation name for removing the settings of new buckets of a project
+	DeleteBucketTemplateMutation = "deleteBucketTemplate"
 	// UpdateProjectDescriptionMutation is a mutation name for project updating
 	UpdateProjectDescriptionMutation = "updateProjectDescription"
 
@@ -41,6 +49,11 @@ const (
 	// DeleteAPIKeyMutation is a mutation name for api key deleting
 	DeleteAPIKeyMutation = "deleteAPIKey"
 
+	// RefreshSessionMutation is a mutation name for exchanging a refresh token for new tokens
+	RefreshSessionMutation = "refreshSession"
+	// RevokeSessionMutation is a mutation name for ending a session
+	RevokeSessionMutation = "revokeSession"
+
 	// InputArg is argument name for all input types
 	InputArg = "input"
 	// FieldProjectID is field name for projectID
@@ -208,6 +221,110 @@ func rootMutation(service *console.Service, types Types) *graphql.Object {
 					return project, nil
 				},
 			},
+			// schedules purge of all project data after the grace period
+			ScheduleProjectDeletionMutation: &graphql.Field{
+				Type: types.Project(),
+				Args: graphql.FieldConfigArgument{
+					FieldID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					inputID := p.Args[FieldID].(string)
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					project, err := service.GetProject(p.Context, *projectID)
+					if err != nil {
+						return nil, err
+					}
+
+					if _, err = service.ScheduleProjectDeletion(p.Context, project.ID); err != nil {
+						return nil, err
+					}
+
+					return project, nil
+				},
+			},
+			// cancels scheduled purge of project data during the grace period
+			CancelProjectDeletionMutation: &graphql.Field{
+				Type: types.Project(),
+				Args: graphql.FieldConfigArgument{
+					FieldID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					inputID := p.Args[FieldID].(string)
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					project, err := service.GetProject(p.Context, *projectID)
+					if err != nil {
+						return nil, err
+					}
+
+					if err = service.CancelProjectDeletion(p.Context, project.ID); err != nil {
+						return nil, err
+					}
+
+					return project, nil
+				},
+			},
+			// replaces the settings applied to new buckets of the project
+			SetBucketTemplateMutation: &graphql.Field{
+				Type: types.BucketTemplate(),
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					InputArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(types.BucketTemplateInput()),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					inputID, _ := p.Args[FieldProjectID].(string)
+					input, _ := p.Args[InputArg].(map[string]interface{})
+
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.SetBucketTemplate(p.Context, *projectID, fromMapBucketTemplate(input))
+				},
+			},
+			// removes the settings applied to new buckets of the project
+			DeleteBucketTemplateMutation: &graphql.Field{
+				Type: types.Project(),
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					inputID, _ := p.Args[FieldProjectID].(string)
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					project, err := service.GetProject(p.Context, *projectID)
+					if err != nil {
+						return nil, err
+					}
+
+					if err = service.DeleteBucketTemplate(p.Context, project.ID); err != nil {
+						return nil, err
+					}
+
+					return project, nil
+				},
+			},
 			// updates project description
 			UpdateProjectDescriptionMutation: &graphql.Field{
 				Type: types.Project(),
@@ -323,8 +440,9 @@ func rootMutation(service *console.Service, types Types) *graphql.Object {
 					}
 
 					return createAPIKey{
-						Key:     key,
-						KeyInfo: info,
+						Key:      key,
+						Macaroon: key.Macaroon(info.ID).Serialize(),
+						KeyInfo:  info,
 					}, nil
 				},
 			},
@@ -357,6 +475,47 @@ func rootMutation(service *console.Service, types Types) *graphql.Object {
 					return key, nil
 				},
 			},
+			RefreshSessionMutation: &graphql.Field{
+				Type: types.Token(),
+				Args: graphql.FieldConfigArgument{
+					FieldRefreshToken: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					refreshToken, _ := p.Args[FieldRefreshToken].(string)
+
+					tokens, err := service.RefreshSession(p.Context, refreshToken)
+					if err != nil {
+						return nil, err
+					}
+
+					return tokenWrapper{Token: tokens.AccessToken, RefreshToken: tokens.RefreshToken}, nil
+				},
+			},
+			RevokeSessionMutation: &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					FieldID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					inputID, _ := p.Args[FieldID].(string)
+
+					id, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					err = service.RevokeSession(p.Context, *id)
+					if err != nil {
+						return nil, err
+					}
+
+					return id.String(), nil
+				},
+			},
 		},
 	})
 }
@@ -35,6 +35,8 @@ func TestGrapqhlMutation(t *testing.T) {
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			db.Console(),
 			console.TestPasswordCost,
+			console.DefaultDeletionGracePeriod,
+			console.SessionConfig{},
 		)
 
 		if err != nil {
@@ -98,12 +100,12 @@ func TestGrapqhlMutation(t *testing.T) {
 			rootUser.Email = createUser.Email
 		})
 
-		token, err := service.Token(ctx, createUser.Email, createUser.Password)
+		tokens, err := service.Token(ctx, createUser.Email, createUser.Password)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+		sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(tokens.AccessToken)))
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -295,12 +297,12 @@ func TestGrapqhlMutation(t *testing.T) {
 			createUser.Password = newPassword
 		})
 
-		token, err = service.Token(ctx, rootUser.Email, createUser.Password)
+		tokens, err = service.Token(ctx, rootUser.Email, createUser.Password)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		sauth, err = service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+		sauth, err = service.Authorize(auth.WithAPIKey(ctx, []byte(tokens.AccessToken)))
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -22,6 +22,10 @@ const (
 	FieldMembers = "members"
 	// FieldAPIKeys is a field name for api keys
 	FieldAPIKeys = "apiKeys"
+	// FieldBucketTemplate is a field name for the settings of new buckets
+	FieldBucketTemplate = "bucketTemplate"
+	// FieldBucketStats is a field name for the object and byte counters of the buckets
+	FieldBucketStats = "bucketStats"
 
 	// LimitArg is argument name for limit
 	LimitArg = "limit"
@@ -110,6 +114,27 @@ func graphqlProject(service *console.Service, types Types) *graphql.Object {
 					return service.GetAPIKeysInfoByProjectID(p.Context, project.ID)
 				},
 			},
+			FieldBucketTemplate: &graphql.Field{
+				Type: types.BucketTemplate(),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					template, err := service.GetBucketTemplate(p.Context, project.ID)
+					if err != nil || template == nil {
+						return nil, err
+					}
+
+					return template, nil
+				},
+			},
+			FieldBucketStats: &graphql.Field{
+				Type: graphql.NewList(types.BucketStat()),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					return service.GetBucketStats(p.Context, project.ID)
+				},
+			},
 		},
 	})
 }
@@ -21,6 +21,8 @@ const (
 	MyProjectsQuery = "myProjects"
 	// TokenQuery is a query name for token
 	TokenQuery = "token"
+	// MySessionsQuery is a query name for the sessions of the account
+	MySessionsQuery = "mySessions"
 )
 
 // rootQuery creates query for graphql populated by AccountsClient
@@ -82,12 +84,18 @@ func rootQuery(service *console.Service, types Types) *graphql.Object {
 					email, _ := p.Args[FieldEmail].(string)
 					pass, _ := p.Args[FieldPassword].(string)
 
-					token, err := service.Token(p.Context, email, pass)
+					tokens, err := service.Token(p.Context, email, pass)
 					if err != nil {
 						return nil, err
 					}
 
-					return tokenWrapper{Token: token}, nil
+					return tokenWrapper{Token: tokens.AccessToken, RefreshToken: tokens.RefreshToken}, nil
+				},
+			},
+			MySessionsQuery: &graphql.Field{
+				Type: graphql.NewList(types.Session()),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return service.GetSessions(p.Context)
 				},
 			},
 		},
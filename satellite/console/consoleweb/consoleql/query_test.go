@@ -33,6 +33,8 @@ func TestGraphqlQuery(t *testing.T) {
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			db.Console(),
 			console.TestPasswordCost,
+			console.DefaultDeletionGracePeriod,
+			console.SessionConfig{},
 		)
 
 		if err != nil {
@@ -87,12 +89,12 @@ func TestGraphqlQuery(t *testing.T) {
 			rootUser.Email = "mtest@email.com"
 		})
 
-		token, err := service.Token(ctx, createUser.Email, createUser.Password)
+		tokens, err := service.Token(ctx, createUser.Email, createUser.Password)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+		sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(tokens.AccessToken)))
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -0,0 +1,65 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// SessionType is a graphql type name for session
+	SessionType = "session"
+	// FieldDevice is a field name for the device a session was started from
+	FieldDevice = "device"
+	// FieldAddress is a field name for the address a session was started from
+	FieldAddress = "address"
+	// FieldExpiresAt is a field name for expiration timestamp
+	FieldExpiresAt = "expiresAt"
+	// FieldLastUsedAt is a field name for last use timestamp
+	FieldLastUsedAt = "lastUsedAt"
+	// FieldCurrent is a field name for whether the session is the one of the request
+	FieldCurrent = "current"
+)
+
+// graphqlSession creates session type
+func graphqlSession() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: SessionType,
+		Fields: graphql.Fields{
+			FieldID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldDevice: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldAddress: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldExpiresAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			FieldLastUsedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			FieldCreatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			FieldCurrent: &graphql.Field{
+				Type: graphql.Boolean,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					session, _ := p.Source.(console.Session)
+
+					auth, err := console.GetAuth(p.Context)
+					if err != nil {
+						return false, nil
+					}
+
+					return auth.Claims.Session == session.ID, nil
+				},
+			},
+		},
+	})
+}
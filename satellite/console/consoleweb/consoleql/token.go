@@ -13,6 +13,8 @@ import (
 const (
 	// TokenType is graphql type name for token
 	TokenType = "token"
+	// FieldRefreshToken is a field name for the refresh token of a session
+	FieldRefreshToken = "refreshToken"
 )
 
 // graphqlToken creates *graphql.Object type that encapsulates user and token string
@@ -23,6 +25,9 @@ func graphqlToken(service *console.Service, types Types) *graphql.Object {
 			TokenType: &graphql.Field{
 				Type: graphql.String,
 			},
+			FieldRefreshToken: &graphql.Field{
+				Type: graphql.String,
+			},
 			UserType: &graphql.Field{
 				Type: types.User(),
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
@@ -46,7 +51,8 @@ func graphqlToken(service *console.Service, types Types) *graphql.Object {
 	})
 }
 
-// tokenWrapper holds token string value so it can be parsed by graphql pkg
+// tokenWrapper holds token string values so they can be parsed by graphql pkg
 type tokenWrapper struct {
-	Token string
+	Token        string
+	RefreshToken string `json:"refreshToken"`
 }
@@ -21,9 +21,13 @@ type Types interface {
 	ProjectMember() *graphql.Object
 	APIKeyInfo() *graphql.Object
 	CreateAPIKey() *graphql.Object
+	Session() *graphql.Object
+	BucketTemplate() *graphql.Object
+	BucketStat() *graphql.Object
 
 	UserInput() *graphql.InputObject
 	ProjectInput() *graphql.InputObject
+	BucketTemplateInput() *graphql.InputObject
 }
 
 // TypeCreator handles graphql type creation and error checking
@@ -33,14 +37,18 @@ type TypeCreator struct {
 
 	token *graphql.Object
 
-	user          *graphql.Object
-	project       *graphql.Object
-	projectMember *graphql.Object
-	apiKeyInfo    *graphql.Object
-	createAPIKey  *graphql.Object
-
-	userInput    *graphql.InputObject
-	projectInput *graphql.InputObject
+	user           *graphql.Object
+	project        *graphql.Object
+	projectMember  *graphql.Object
+	apiKeyInfo     *graphql.Object
+	createAPIKey   *graphql.Object
+	session        *graphql.Object
+	bucketTemplate *graphql.Object
+	bucketStat     *graphql.Object
+
+	userInput           *graphql.InputObject
+	projectInput        *graphql.InputObject
+	bucketTemplateInput *graphql.InputObject
 }
 
 // Create create types and check for error
@@ -56,6 +64,11 @@ func (c *TypeCreator) Create(service *console.Service) error {
 		return err
 	}
 
+	c.bucketTemplateInput = graphqlBucketTemplateInput()
+	if err := c.bucketTemplateInput.Error(); err != nil {
+		return err
+	}
+
 	// entities
 	c.user = graphqlUser()
 	if err := c.user.Error(); err != nil {
@@ -72,6 +85,21 @@ func (c *TypeCreator) Create(service *console.Service) error {
 		return err
 	}
 
+	c.session = graphqlSession()
+	if err := c.session.Error(); err != nil {
+		return err
+	}
+
+	c.bucketTemplate = graphqlBucketTemplate()
+	if err := c.bucketTemplate.Error(); err != nil {
+		return err
+	}
+
+	c.bucketStat = graphqlBucketStat()
+	if err := c.bucketStat.Error(); err != nil {
+		return err
+	}
+
 	c.projectMember = graphqlProjectMember(service, c)
 	if err := c.projectMember.Error(); err != nil {
 		return err
@@ -132,6 +160,21 @@ func (c *TypeCreator) CreateAPIKey() *graphql.Object {
 	return c.createAPIKey
 }
 
+// Session returns instance of satellite.Session *graphql.Object
+func (c *TypeCreator) Session() *graphql.Object {
+	return c.session
+}
+
+// BucketTemplate returns instance of console.BucketTemplate *graphql.Object
+func (c *TypeCreator) BucketTemplate() *graphql.Object {
+	return c.bucketTemplate
+}
+
+// BucketStat returns instance of console.BucketStat *graphql.Object
+func (c *TypeCreator) BucketStat() *graphql.Object {
+	return c.bucketStat
+}
+
 // Project returns instance of satellite.Project *graphql.Object
 func (c *TypeCreator) Project() *graphql.Object {
 	return c.project
@@ -151,3 +194,8 @@ func (c *TypeCreator) UserInput() *graphql.InputObject {
 func (c *TypeCreator) ProjectInput() *graphql.InputObject {
 	return c.projectInput
 }
+
+// BucketTemplateInput returns instance of BucketTemplateInput *graphql.Object
+func (c *TypeCreator) BucketTemplateInput() *graphql.InputObject {
+	return c.bucketTemplateInput
+}
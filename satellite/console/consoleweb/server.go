@@ -9,6 +9,7 @@ import (
 	"net"
 	"net/http"
 	"path/filepath"
+	"time"
 
 	"github.com/graphql-go/graphql"
 	"github.com/zeebo/errs"
@@ -38,7 +39,10 @@ type Config struct {
 	Address   string `help:"server address of the graphql api gateway and frontend app" default:"127.0.0.1:8081"`
 	StaticDir string `help:"path to static resources" default:""`
 
-	PasswordCost int `internal:"true" help:"password hashing cost (0=automatic)" default:"0"`
+	PasswordCost        int           `internal:"true" help:"password hashing cost (0=automatic)" default:"0"`
+	DeletionGracePeriod time.Duration `help:"how long a deleted project can be restored before its data is purged" default:"720h"`
+
+	Session console.SessionConfig
 }
 
 // Server represents console web server
@@ -50,6 +54,7 @@ type Server struct {
 	listener net.Listener
 
 	schema graphql.Schema
+	mux    *http.ServeMux
 	server http.Server
 }
 
@@ -64,6 +69,7 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, list
 
 	mux := http.NewServeMux()
 	fs := http.FileServer(http.Dir(server.config.StaticDir))
+	server.mux = mux
 
 	mux.Handle("/api/graphql/v0", http.HandlerFunc(server.grapqlHandler))
 
@@ -79,6 +85,11 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, list
 	return &server
 }
 
+// Handle registers an additional handler for the pattern, it has to be called before Run
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
 // appHandler is web app http handler function
 func (s *Server) appHandler(w http.ResponseWriter, req *http.Request) {
 	http.ServeFile(w, req, filepath.Join(s.config.StaticDir, "dist", "public", "index.html"))
@@ -96,6 +107,11 @@ func (s *Server) grapqlHandler(w http.ResponseWriter, req *http.Request) {
 	}
 
 	ctx := auth.WithAPIKey(context.Background(), []byte(token))
+	ctx = console.WithClient(ctx, console.Client{
+		Device:  req.UserAgent(),
+		Address: req.RemoteAddr,
+	})
+
 	auth, err := s.service.Authorize(ctx)
 	if err != nil {
 		ctx = console.WithAuthFailure(ctx, err)
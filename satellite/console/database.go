@@ -15,6 +15,16 @@ type DB interface {
 	ProjectMembers() ProjectMembers
 	// APIKeys is a getter for APIKeys repository
 	APIKeys() APIKeys
+	// ProjectDeletions is a getter for ProjectDeletions repository
+	ProjectDeletions() ProjectDeletions
+	// Sessions is a getter for Sessions repository
+	Sessions() Sessions
+	// AuthEvents is a getter for AuthEvents repository
+	AuthEvents() AuthEvents
+	// BucketTemplates is a getter for BucketTemplates repository
+	BucketTemplates() BucketTemplates
+	// BucketStats is a getter for BucketStats repository
+	BucketStats() BucketStats
 
 	// CreateTables is a method for creating all tables for satellitedb
 	CreateTables() error
@@ -0,0 +1,51 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// ProjectDeletions exposes methods to manage scheduled project deletions.
+type ProjectDeletions interface {
+	// Get is a method for querying the deletion of a project.
+	Get(ctx context.Context, projectID uuid.UUID) (*ProjectDeletion, error)
+	// GetDue is a method for querying all not yet purged deletions whose grace period ended before the given time.
+	GetDue(ctx context.Context, before time.Time) ([]ProjectDeletion, error)
+	// Insert is a method for scheduling deletion of a project.
+	Insert(ctx context.Context, deletion *ProjectDeletion) (*ProjectDeletion, error)
+	// Delete is a method for canceling a scheduled deletion.
+	Delete(ctx context.Context, projectID uuid.UUID) error
+	// Update is a method for recording the purge results of a deletion.
+	Update(ctx context.Context, deletion *ProjectDeletion) error
+	// Purge is a method for deleting the project with its api keys, members, usage data and bucket settings
+	// and recording the deletion as purged in one transaction.
+	Purge(ctx context.Context, deletion *ProjectDeletion) error
+}
+
+// ProjectDeletion is a database object that describes a scheduled deletion of a project and all of its data.
+// It is kept after the purge as a record of what was deleted.
+type ProjectDeletion struct {
+	ProjectID   uuid.UUID `json:"projectID"`
+	RequestedBy uuid.UUID `json:"requestedBy"`
+
+	// PurgeAfter is the end of the grace period, until then the deletion can be canceled
+	PurgeAfter time.Time `json:"purgeAfter"`
+	// PurgedAt is zero until the purge job has finished
+	PurgedAt time.Time `json:"purgedAt"`
+
+	SegmentsDeleted int64 `json:"segmentsDeleted"`
+	PiecesDeleted   int64 `json:"piecesDeleted"`
+	APIKeysDeleted  int64 `json:"apiKeysDeleted"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Purged returns whether the project data was already deleted.
+func (deletion *ProjectDeletion) Purged() bool {
+	return !deletion.PurgedAt.IsZero()
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestProjectDeletionsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		deletions := db.Console().ProjectDeletions()
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		requestedBy, err := uuid.New()
+		require.NoError(t, err)
+
+		purgeAfter := time.Now().Add(time.Hour)
+
+		t.Run("Insert success", func(t *testing.T) {
+			deletion, err := deletions.Insert(ctx, &console.ProjectDeletion{
+				ProjectID:   *projectID,
+				RequestedBy: *requestedBy,
+				PurgeAfter:  purgeAfter,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, *projectID, deletion.ProjectID)
+			assert.Equal(t, *requestedBy, deletion.RequestedBy)
+			assert.False(t, deletion.Purged())
+		})
+
+		t.Run("Insert twice fails", func(t *testing.T) {
+			_, err := deletions.Insert(ctx, &console.ProjectDeletion{
+				ProjectID:   *projectID,
+				RequestedBy: *requestedBy,
+				PurgeAfter:  purgeAfter,
+			})
+			assert.Error(t, err)
+		})
+
+		t.Run("GetDue respects grace period", func(t *testing.T) {
+			due, err := deletions.GetDue(ctx, time.Now())
+			require.NoError(t, err)
+			assert.Len(t, due, 0)
+
+			due, err = deletions.GetDue(ctx, purgeAfter.Add(time.Minute))
+			require.NoError(t, err)
+			require.Len(t, due, 1)
+			assert.Equal(t, *projectID, due[0].ProjectID)
+		})
+
+		t.Run("Update records purge", func(t *testing.T) {
+			deletion, err := deletions.Get(ctx, *projectID)
+			require.NoError(t, err)
+
+			deletion.SegmentsDeleted = 3
+			deletion.PiecesDeleted = 12
+			deletion.APIKeysDeleted = 1
+			deletion.PurgedAt = time.Now()
+			require.NoError(t, deletions.Update(ctx, deletion))
+
+			deletion, err = deletions.Get(ctx, *projectID)
+			require.NoError(t, err)
+			assert.True(t, deletion.Purged())
+			assert.Equal(t, int64(3), deletion.SegmentsDeleted)
+			assert.Equal(t, int64(12), deletion.PiecesDeleted)
+			assert.Equal(t, int64(1), deletion.APIKeysDeleted)
+
+			due, err := deletions.GetDue(ctx, purgeAfter.Add(time.Minute))
+			require.NoError(t, err)
+			assert.Len(t, due, 0)
+		})
+
+		t.Run("Delete success", func(t *testing.T) {
+			require.NoError(t, deletions.Delete(ctx, *projectID))
+
+			_, err := deletions.Get(ctx, *projectID)
+			assert.Error(t, err)
+		})
+	})
+}
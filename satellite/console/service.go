@@ -5,7 +5,11 @@ package console
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
 	"fmt"
 	"time"
 
@@ -32,8 +36,23 @@ const (
 	DefaultPasswordCost = bcrypt.DefaultCost
 	// TestPasswordCost is the hashing complexity to use for testing
 	TestPasswordCost = bcrypt.MinCost
+
+	// DefaultDeletionGracePeriod is how long a deleted project can be restored before its data is purged
+	DefaultDeletionGracePeriod = 30 * 24 * time.Hour
+
+	// refreshSecretSize is the size of the random part of refresh tokens
+	refreshSecretSize = 32
+	// sessionTouchInterval is how often the last use of a session is saved
+	sessionTouchInterval = time.Minute
 )
 
+// SessionConfig contains the configurable values of login sessions
+type SessionConfig struct {
+	AccessTokenExpiration  time.Duration `help:"how long an access token is valid before the session has to be refreshed" default:"1h0m0s"`
+	RefreshTokenExpiration time.Duration `help:"how long a session can be refreshed after logging in" default:"720h0m0s"`
+	InactivityTimeout      time.Duration `help:"how long a session stays valid without being used (0=forever)" default:"24h0m0s"`
+}
+
 // Service is handling accounts related logic
 type Service struct {
 	Signer
@@ -41,11 +60,13 @@ type Service struct {
 	store DB
 	log   *zap.Logger
 
-	passwordCost int
+	passwordCost        int
+	deletionGracePeriod time.Duration
+	sessions            SessionConfig
 }
 
 // NewService returns new instance of Service
-func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int) (*Service, error) {
+func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int, deletionGracePeriod time.Duration, sessions SessionConfig) (*Service, error) {
 	if signer == nil {
 		return nil, errs.New("signer can't be nil")
 	}
@@ -62,7 +83,30 @@ func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int) (*Se
 		passwordCost = bcrypt.DefaultCost
 	}
 
-	return &Service{Signer: signer, store: store, log: log, passwordCost: passwordCost}, nil
+	if deletionGracePeriod < 0 {
+		return nil, errs.New("deletion grace period can't be negative")
+	}
+
+	if sessions.AccessTokenExpiration == 0 {
+		sessions.AccessTokenExpiration = tokenExpirationTime
+	}
+
+	if sessions.RefreshTokenExpiration == 0 {
+		sessions.RefreshTokenExpiration = 30 * tokenExpirationTime
+	}
+
+	if sessions.InactivityTimeout < 0 {
+		return nil, errs.New("session inactivity timeout can't be negative")
+	}
+
+	return &Service{
+		Signer:              signer,
+		store:               store,
+		log:                 log,
+		passwordCost:        passwordCost,
+		deletionGracePeriod: deletionGracePeriod,
+		sessions:            sessions,
+	}, nil
 }
 
 // CreateUser gets password hash value and creates new inactive User
@@ -150,46 +194,124 @@ func (s *Service) ActivateAccount(ctx context.Context, activationToken string) (
 		return "", err
 	}
 
-	claims = &consoleauth.Claims{
-		ID:         user.ID,
-		Expiration: time.Now().Add(tokenExpirationTime),
-	}
-
-	authToken, err = s.createToken(claims)
+	tokens, err := s.createSession(ctx, user)
 	if err != nil {
 		return "", err
 	}
 
-	return authToken, err
+	return tokens.AccessToken, nil
 }
 
-// Token authenticates User by credentials and returns auth token
-func (s *Service) Token(ctx context.Context, email, password string) (token string, err error) {
+// Token authenticates User by credentials and starts a new session for the device of the request
+func (s *Service) Token(ctx context.Context, email, password string) (tokens SessionTokens, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	email = normalizeEmail(email)
 
 	user, err := s.store.Users().GetByEmail(ctx, email)
 	if err != nil {
-		return "", err
+		return SessionTokens{}, err
 	}
 
 	err = bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password))
 	if err != nil {
-		return "", ErrUnauthorized.New("password is incorrect: %s", err.Error())
+		s.recordAuthEvent(ctx, user.ID, uuid.UUID{}, AuthEventLoginFailed)
+		return SessionTokens{}, ErrUnauthorized.New("password is incorrect: %s", err.Error())
 	}
 
-	claims := consoleauth.Claims{
-		ID:         user.ID,
-		Expiration: time.Now().Add(tokenExpirationTime),
+	return s.createSession(ctx, user)
+}
+
+// RefreshSession exchanges a refresh token for new tokens of its session,
+// the refresh token can't be used again afterwards
+func (s *Service) RefreshSession(ctx context.Context, refreshToken string) (tokens SessionTokens, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sessionID, secret, err := parseRefreshToken(refreshToken)
+	if err != nil {
+		return SessionTokens{}, ErrUnauthorized.Wrap(err)
 	}
 
-	token, err = s.createToken(&claims)
+	session, err := s.store.Sessions().Get(ctx, sessionID)
 	if err != nil {
-		return "", err
+		return SessionTokens{}, ErrUnauthorized.New("session is revoked")
+	}
+
+	hash := sha256.Sum256(secret)
+	if subtle.ConstantTimeCompare(hash[:], session.RefreshTokenHash) != 1 {
+		// the token was rotated already, so it must have been copied
+		s.recordAuthEvent(ctx, session.UserID, session.ID, AuthEventRefreshReused)
+		return SessionTokens{}, ErrUnauthorized.Wrap(errs.Combine(
+			errs.New("refresh token was already used"),
+			s.store.Sessions().Delete(ctx, session.ID),
+		))
+	}
+
+	now := time.Now()
+	if err := s.checkSession(ctx, session, now); err != nil {
+		return SessionTokens{}, ErrUnauthorized.Wrap(err)
+	}
+
+	secret, hash, err = newRefreshSecret()
+	if err != nil {
+		return SessionTokens{}, err
+	}
+
+	session.RefreshTokenHash = hash[:]
+	session.LastUsedAt = now
+	if err := s.store.Sessions().Update(ctx, session); err != nil {
+		return SessionTokens{}, err
+	}
+
+	s.recordAuthEvent(ctx, session.UserID, session.ID, AuthEventRefresh)
+	return s.sessionTokens(session, secret)
+}
+
+// GetSessions returns the sessions of the authorized User
+func (s *Service) GetSessions(ctx context.Context) (sessions []Session, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.Sessions().GetByUserID(ctx, auth.User.ID)
+}
+
+// RevokeSession ends a session of the authorized User, its tokens can't be used afterwards
+func (s *Service) RevokeSession(ctx context.Context, sessionID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	session, err := s.store.Sessions().Get(ctx, sessionID)
+	if err != nil || session.UserID != auth.User.ID {
+		return ErrUnauthorized.New("no session with id: %s", sessionID)
+	}
+
+	if err := s.store.Sessions().Delete(ctx, session.ID); err != nil {
+		return err
+	}
+
+	s.recordAuthEvent(ctx, session.UserID, session.ID, AuthEventRevoke)
+	return nil
+}
+
+// GetAuthEvents returns the most recent login related events of the authorized User
+func (s *Service) GetAuthEvents(ctx context.Context, limit int) (events []AuthEvent, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
 	}
 
-	return token, nil
+	return s.store.AuthEvents().GetByUserID(ctx, auth.User.ID, limit)
 }
 
 // GetUser returns User by id
@@ -252,10 +374,31 @@ func (s *Service) ChangePassword(ctx context.Context, pass, newPass string) (err
 	}
 
 	auth.User.PasswordHash = hash
-	return s.store.Users().Update(ctx, &auth.User)
+	if err := s.store.Users().Update(ctx, &auth.User); err != nil {
+		return err
+	}
+
+	// the sessions on other devices may have been started by whoever knew the old password
+	sessions, err := s.store.Sessions().GetByUserID(ctx, auth.User.ID)
+	if err != nil {
+		return err
+	}
+
+	var errlist errs.Group
+	for _, session := range sessions {
+		if session.ID == auth.Claims.Session {
+			continue
+		}
+		if err := s.store.Sessions().Delete(ctx, session.ID); err != nil {
+			errlist.Add(err)
+			continue
+		}
+		s.recordAuthEvent(ctx, session.UserID, session.ID, AuthEventRevoke)
+	}
+	return errlist.Err()
 }
 
-// DeleteAccount deletes User
+// DeleteAccount deletes User and schedules deletion of the projects where User is the only member
 func (s *Service) DeleteAccount(ctx context.Context, password string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	auth, err := GetAuth(ctx)
@@ -268,7 +411,49 @@ func (s *Service) DeleteAccount(ctx context.Context, password string) (err error
 		return ErrUnauthorized.New("origin password is incorrect")
 	}
 
-	return s.store.Users().Delete(ctx, auth.User.ID)
+	projects, err := s.store.Projects().GetByUserID(ctx, auth.User.ID)
+	if err != nil {
+		return err
+	}
+
+	// projects shared with other users are left to the remaining members
+	var owned []uuid.UUID
+	for _, project := range projects {
+		members, err := s.store.ProjectMembers().GetByProjectID(ctx, project.ID, Pagination{Limit: 2})
+		if err != nil {
+			return err
+		}
+
+		if len(members) <= 1 {
+			owned = append(owned, project.ID)
+		}
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, tx.Rollback())
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	for _, projectID := range owned {
+		if err = s.scheduleProjectDeletion(ctx, tx, projectID, auth.User.ID); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Sessions().DeleteByUserID(ctx, auth.User.ID); err != nil {
+		return err
+	}
+
+	return tx.Users().Delete(ctx, auth.User.ID)
 }
 
 // GetProject is a method for querying project by id
@@ -345,6 +530,145 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 	return s.store.Projects().Delete(ctx, projectID)
 }
 
+// ScheduleProjectDeletion marks project for deletion, all buckets, objects and api keys
+// are purged after the grace period unless the deletion is canceled
+func (s *Service) ScheduleProjectDeletion(ctx context.Context, projectID uuid.UUID) (deletion *ProjectDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if err = s.scheduleProjectDeletion(ctx, s.store, projectID, auth.User.ID); err != nil {
+		return nil, err
+	}
+
+	return s.store.ProjectDeletions().Get(ctx, projectID)
+}
+
+// scheduleProjectDeletion inserts deletion for project unless one exists already
+func (s *Service) scheduleProjectDeletion(ctx context.Context, store DB, projectID, requestedBy uuid.UUID) error {
+	existing, err := store.ProjectDeletions().Get(ctx, projectID)
+	if err == nil && existing != nil {
+		return nil
+	}
+
+	_, err = store.ProjectDeletions().Insert(ctx, &ProjectDeletion{
+		ProjectID:   projectID,
+		RequestedBy: requestedBy,
+		PurgeAfter:  time.Now().Add(s.deletionGracePeriod),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Info("project deletion scheduled",
+		zap.String("Project ID", projectID.String()),
+		zap.String("Requested By", requestedBy.String()),
+		zap.Duration("Grace Period", s.deletionGracePeriod))
+	return nil
+}
+
+// CancelProjectDeletion restores project scheduled for deletion if the grace period has not ended
+func (s *Service) CancelProjectDeletion(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	deletion, err := s.store.ProjectDeletions().Get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	if deletion.Purged() || time.Now().After(deletion.PurgeAfter) {
+		return errs.New("project deletion can't be canceled after the grace period")
+	}
+
+	return s.store.ProjectDeletions().Delete(ctx, projectID)
+}
+
+// GetBucketTemplate returns the settings applied to new buckets of the project,
+// nil when the project has no template
+func (s *Service) GetBucketTemplate(ctx context.Context, projectID uuid.UUID) (template *BucketTemplate, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	template, err = s.store.BucketTemplates().Get(ctx, projectID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return template, err
+}
+
+// SetBucketTemplate replaces the settings applied to new buckets of the project,
+// existing buckets keep their settings
+func (s *Service) SetBucketTemplate(ctx context.Context, projectID uuid.UUID, template BucketTemplate) (_ *BucketTemplate, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	template.ProjectID = projectID
+	if err = validateBucketTemplate(&template); err != nil {
+		return nil, err
+	}
+
+	return s.store.BucketTemplates().Upsert(ctx, &template)
+}
+
+// DeleteBucketTemplate removes the bucket template of the project, new buckets
+// get the defaults of the uplink again
+func (s *Service) DeleteBucketTemplate(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	return s.store.BucketTemplates().Delete(ctx, projectID)
+}
+
+// GetBucketStats returns the number of objects and bytes of every bucket of the project
+func (s *Service) GetBucketStats(ctx context.Context, projectID uuid.UUID) (stats []BucketStat, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return s.store.BucketStats().GetByProject(ctx, projectID)
+}
+
 // UpdateProject is a method for updating project description by id
 func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, description string) (p *Project, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -650,20 +974,142 @@ func (s *Service) authenticate(token consoleauth.Token) (*consoleauth.Claims, er
 	return claims, nil
 }
 
-// authorize checks claims and returns authorized User
+// authorize checks claims and the session they belong to and returns authorized User
 func (s *Service) authorize(ctx context.Context, claims *consoleauth.Claims) (*User, error) {
-	if !claims.Expiration.IsZero() && claims.Expiration.Before(time.Now()) {
+	now := time.Now()
+	if !claims.Expiration.IsZero() && claims.Expiration.Before(now) {
 		return nil, errs.New("token is outdated")
 	}
 
+	session, err := s.store.Sessions().Get(ctx, claims.Session)
+	if err != nil || session.UserID != claims.ID {
+		return nil, errs.New("session is revoked")
+	}
+
+	if err := s.checkSession(ctx, session, now); err != nil {
+		return nil, err
+	}
+
 	user, err := s.store.Users().Get(ctx, claims.ID)
 	if err != nil {
 		return nil, errs.New("authorization failed. no user with id: %s", claims.ID.String())
 	}
 
+	if now.Sub(session.LastUsedAt) > sessionTouchInterval {
+		session.LastUsedAt = now
+		if err := s.store.Sessions().Update(ctx, session); err != nil {
+			s.log.Warn("failed to save the last use of a session", zap.Error(err))
+		}
+	}
+
 	return user, nil
 }
 
+// createSession starts a new session of user for the device of the request
+func (s *Service) createSession(ctx context.Context, user *User) (SessionTokens, error) {
+	secret, hash, err := newRefreshSecret()
+	if err != nil {
+		return SessionTokens{}, err
+	}
+
+	client := GetClient(ctx)
+	now := time.Now()
+
+	session, err := s.store.Sessions().Insert(ctx, &Session{
+		UserID:           user.ID,
+		Device:           client.Device,
+		Address:          client.Address,
+		RefreshTokenHash: hash[:],
+		ExpiresAt:        now.Add(s.sessions.RefreshTokenExpiration),
+		LastUsedAt:       now,
+	})
+	if err != nil {
+		return SessionTokens{}, err
+	}
+
+	s.recordAuthEvent(ctx, user.ID, session.ID, AuthEventLogin)
+	return s.sessionTokens(session, secret)
+}
+
+// checkSession returns an error and ends the session when it has expired
+// or wasn't used for longer than the inactivity timeout
+func (s *Service) checkSession(ctx context.Context, session *Session, now time.Time) error {
+	expired := now.After(session.ExpiresAt)
+	inactive := s.sessions.InactivityTimeout > 0 && now.Sub(session.LastUsedAt) > s.sessions.InactivityTimeout
+	if !expired && !inactive {
+		return nil
+	}
+
+	if err := s.store.Sessions().Delete(ctx, session.ID); err != nil {
+		s.log.Warn("failed to end an expired session", zap.Error(err))
+	} else {
+		s.recordAuthEvent(ctx, session.UserID, session.ID, AuthEventExpire)
+	}
+
+	if expired {
+		return errs.New("session is expired")
+	}
+	return errs.New("session is inactive")
+}
+
+// sessionTokens returns a new access token and the refresh token with secret of session
+func (s *Service) sessionTokens(session *Session, secret []byte) (SessionTokens, error) {
+	accessToken, err := s.createToken(&consoleauth.Claims{
+		ID:         session.UserID,
+		Session:    session.ID,
+		Expiration: time.Now().Add(s.sessions.AccessTokenExpiration),
+	})
+	if err != nil {
+		return SessionTokens{}, err
+	}
+
+	return SessionTokens{
+		AccessToken:  accessToken,
+		RefreshToken: base64.RawURLEncoding.EncodeToString(append(session.ID[:], secret...)),
+		Session:      *session,
+	}, nil
+}
+
+// recordAuthEvent saves an audit record of a login related event, failing to
+// do so is logged and doesn't fail the request
+func (s *Service) recordAuthEvent(ctx context.Context, userID, sessionID uuid.UUID, kind AuthEventKind) {
+	client := GetClient(ctx)
+
+	err := s.store.AuthEvents().Insert(ctx, &AuthEvent{
+		UserID:    userID,
+		SessionID: sessionID,
+		Kind:      kind,
+		Device:    client.Device,
+		Address:   client.Address,
+	})
+	if err != nil {
+		s.log.Error("failed to record auth event", zap.String("kind", string(kind)), zap.Error(err))
+	}
+}
+
+// newRefreshSecret returns a random secret for a refresh token and its hash
+func newRefreshSecret() (secret []byte, hash [sha256.Size]byte, err error) {
+	secret = make([]byte, refreshSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, hash, err
+	}
+	return secret, sha256.Sum256(secret), nil
+}
+
+// parseRefreshToken returns the session id and the secret of a refresh token
+func parseRefreshToken(refreshToken string) (sessionID uuid.UUID, secret []byte, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(refreshToken)
+	if err != nil {
+		return sessionID, nil, errs.New("invalid refresh token: %v", err)
+	}
+	if len(data) != len(sessionID)+refreshSecretSize {
+		return sessionID, nil, errs.New("invalid refresh token length")
+	}
+
+	copy(sessionID[:], data)
+	return sessionID, data[len(sessionID):], nil
+}
+
 // isProjectMember is return type of isProjectMember service method
 type isProjectMember struct {
 	project    *Project
@@ -0,0 +1,116 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// Sessions exposes methods to manage the login sessions of users.
+type Sessions interface {
+	// Get is a method for querying a session by id.
+	Get(ctx context.Context, id uuid.UUID) (*Session, error)
+	// GetByUserID is a method for querying all sessions of a user, most recently used first.
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]Session, error)
+	// Insert is a method for creating a session.
+	Insert(ctx context.Context, session *Session) (*Session, error)
+	// Update is a method for rotating the refresh token and recording the last use of a session.
+	Update(ctx context.Context, session *Session) error
+	// Delete is a method for revoking a session.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// DeleteByUserID is a method for revoking all sessions of a user.
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
+
+// Session is a database object that describes a login of a user from a device.
+// The access tokens of a session are valid until the session is revoked, expires
+// or isn't used for longer than the inactivity timeout.
+type Session struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"userID"`
+
+	// Device and Address identify where the user logged in from
+	Device  string `json:"device"`
+	Address string `json:"address"`
+
+	// RefreshTokenHash is the hash of the secret of the current refresh token,
+	// it changes every time the session is refreshed
+	RefreshTokenHash []byte `json:"-"`
+
+	ExpiresAt  time.Time `json:"expiresAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// SessionTokens are the tokens issued when logging in or refreshing a session.
+type SessionTokens struct {
+	// AccessToken authorizes requests until it expires
+	AccessToken string
+	// RefreshToken can be used once to get new tokens for the session
+	RefreshToken string
+
+	Session Session
+}
+
+// AuthEvents exposes methods to manage the audit records of logins.
+type AuthEvents interface {
+	// GetByUserID is a method for querying the most recent events of a user.
+	GetByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]AuthEvent, error)
+	// Insert is a method for recording an event.
+	Insert(ctx context.Context, event *AuthEvent) error
+}
+
+// AuthEventKind describes what happened to a session
+type AuthEventKind string
+
+const (
+	// AuthEventLogin is recorded when a user logs in successfully
+	AuthEventLogin = AuthEventKind("login")
+	// AuthEventLoginFailed is recorded when a login fails because of an incorrect password
+	AuthEventLoginFailed = AuthEventKind("login_failed")
+	// AuthEventRefresh is recorded when a session is refreshed
+	AuthEventRefresh = AuthEventKind("refresh")
+	// AuthEventRefreshReused is recorded when an already used refresh token is
+	// presented, the session is revoked since the token may have been stolen
+	AuthEventRefreshReused = AuthEventKind("refresh_reused")
+	// AuthEventRevoke is recorded when a session is revoked by the user
+	AuthEventRevoke = AuthEventKind("revoke")
+	// AuthEventExpire is recorded when a session is ended because it expired
+	// or wasn't used for longer than the inactivity timeout
+	AuthEventExpire = AuthEventKind("expire")
+)
+
+// AuthEvent is a database object that records a login related event of a user.
+type AuthEvent struct {
+	ID        int64         `json:"id"`
+	UserID    uuid.UUID     `json:"userID"`
+	SessionID uuid.UUID     `json:"sessionID"`
+	Kind      AuthEventKind `json:"kind"`
+	Device    string        `json:"device"`
+	Address   string        `json:"address"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
+
+// Client describes the device a request comes from
+type Client struct {
+	Device  string
+	Address string
+}
+
+// clientKey is context key for Client
+const clientKey key = 1
+
+// WithClient returns a context which records that the requests done with it come from client
+func WithClient(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, clientKey, client)
+}
+
+// GetClient returns the client of the request, the zero value when it is unknown
+func GetClient(ctx context.Context) Client {
+	client, _ := ctx.Value(clientKey).(Client)
+	return client
+}
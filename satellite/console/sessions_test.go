@@ -0,0 +1,107 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestSessionsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		sessions := db.Console().Sessions()
+		events := db.Console().AuthEvents()
+
+		userID, err := uuid.New()
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+
+		var ids []uuid.UUID
+		for i, device := range []string{"laptop", "phone"} {
+			id, err := uuid.New()
+			require.NoError(t, err)
+
+			session, err := sessions.Insert(ctx, &console.Session{
+				ID:               *id,
+				UserID:           *userID,
+				Device:           device,
+				Address:          "127.0.0.1:1234",
+				RefreshTokenHash: []byte{byte(i)},
+				ExpiresAt:        now.Add(time.Hour),
+				LastUsedAt:       now.Add(time.Duration(i) * time.Minute),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, device, session.Device)
+
+			ids = append(ids, *id)
+		}
+
+		t.Run("GetByUserID returns most recently used first", func(t *testing.T) {
+			list, err := sessions.GetByUserID(ctx, *userID)
+			require.NoError(t, err)
+			require.Len(t, list, 2)
+			assert.Equal(t, "phone", list[0].Device)
+			assert.Equal(t, "laptop", list[1].Device)
+		})
+
+		t.Run("Update rotates refresh token", func(t *testing.T) {
+			session, err := sessions.Get(ctx, ids[0])
+			require.NoError(t, err)
+
+			session.RefreshTokenHash = []byte{42}
+			session.LastUsedAt = now.Add(time.Hour)
+			require.NoError(t, sessions.Update(ctx, session))
+
+			session, err = sessions.Get(ctx, ids[0])
+			require.NoError(t, err)
+			assert.Equal(t, []byte{42}, session.RefreshTokenHash)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			require.NoError(t, sessions.Delete(ctx, ids[0]))
+
+			_, err := sessions.Get(ctx, ids[0])
+			assert.Error(t, err)
+
+			require.NoError(t, sessions.DeleteByUserID(ctx, *userID))
+
+			list, err := sessions.GetByUserID(ctx, *userID)
+			require.NoError(t, err)
+			assert.Len(t, list, 0)
+		})
+
+		t.Run("AuthEvents", func(t *testing.T) {
+			for _, kind := range []console.AuthEventKind{console.AuthEventLogin, console.AuthEventRevoke} {
+				err := events.Insert(ctx, &console.AuthEvent{
+					UserID:    *userID,
+					SessionID: ids[0],
+					Kind:      kind,
+					Device:    "laptop",
+				})
+				require.NoError(t, err)
+			}
+
+			list, err := events.GetByUserID(ctx, *userID, 10)
+			require.NoError(t, err)
+			assert.Len(t, list, 2)
+
+			list, err = events.GetByUserID(ctx, *userID, 1)
+			require.NoError(t, err)
+			assert.Len(t, list, 1)
+		})
+	})
+}
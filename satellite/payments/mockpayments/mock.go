@@ -0,0 +1,145 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mockpayments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"storj.io/storj/satellite/payments"
+)
+
+// Provider is an in-memory payments.Provider for tests, invoices stay open until
+// a payment is simulated with an event
+type Provider struct {
+	mu        sync.Mutex
+	customers map[string]*Customer
+	invoices  map[string]*Invoice
+	// idempotent contains the invoices by their idempotency key
+	idempotent map[string]*Invoice
+	next       int
+}
+
+// Customer is a customer account of the mock provider
+type Customer struct {
+	payments.CustomerParams
+	ID     string
+	Coupon string
+}
+
+// Invoice is an invoice created by the mock provider
+type Invoice struct {
+	payments.InvoiceParams
+	ID     string
+	Amount int64
+	Status payments.InvoiceStatus
+}
+
+// New creates a new mock payment provider
+func New() *Provider {
+	return &Provider{
+		customers:  make(map[string]*Customer),
+		invoices:   make(map[string]*Invoice),
+		idempotent: make(map[string]*Invoice),
+	}
+}
+
+// newID returns an unique id with the prefix
+func (provider *Provider) newID(prefix string) string {
+	provider.next++
+	return fmt.Sprintf("%s_%d", prefix, provider.next)
+}
+
+// CreateCustomer creates a customer account and returns its id
+func (provider *Provider) CreateCustomer(ctx context.Context, params payments.CustomerParams) (string, error) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	customer := &Customer{CustomerParams: params, ID: provider.newID("cus")}
+	provider.customers[customer.ID] = customer
+	return customer.ID, nil
+}
+
+// ApplyCoupon applies a discount coupon to the future invoices of a customer
+func (provider *Provider) ApplyCoupon(ctx context.Context, customerID string, coupon string) error {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	customer, ok := provider.customers[customerID]
+	if !ok {
+		return payments.Error.New("no such customer: %s", customerID)
+	}
+	customer.Coupon = coupon
+	return nil
+}
+
+// CreateInvoice creates an open invoice for the sum of the line items, the invoice
+// created earlier is returned when the idempotency key was used before
+func (provider *Provider) CreateInvoice(ctx context.Context, params payments.InvoiceParams) (*payments.Invoice, error) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if _, ok := provider.customers[params.CustomerID]; !ok {
+		return nil, payments.Error.New("no such customer: %s", params.CustomerID)
+	}
+
+	invoice, ok := provider.idempotent[params.IdempotencyKey]
+	if !ok {
+		invoice = &Invoice{
+			InvoiceParams: params,
+			ID:            provider.newID("in"),
+			Status:        payments.InvoiceOpen,
+		}
+		for _, item := range params.Items {
+			invoice.Amount += item.Amount
+		}
+		provider.invoices[invoice.ID] = invoice
+		if params.IdempotencyKey != "" {
+			provider.idempotent[params.IdempotencyKey] = invoice
+		}
+	}
+
+	return &payments.Invoice{
+		ID:         invoice.ID,
+		CustomerID: params.CustomerID,
+		Amount:     invoice.Amount,
+		Status:     invoice.Status,
+	}, nil
+}
+
+// ParseEvent decodes an unsigned JSON encoded payments.Event
+func (provider *Provider) ParseEvent(payload []byte, header http.Header) (*payments.Event, error) {
+	event := &payments.Event{}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, payments.Error.Wrap(err)
+	}
+	return event, nil
+}
+
+// Customers returns the customer accounts created so far
+func (provider *Provider) Customers() []Customer {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	var customers []Customer
+	for _, customer := range provider.customers {
+		customers = append(customers, *customer)
+	}
+	return customers
+}
+
+// Invoices returns the invoices created so far
+func (provider *Provider) Invoices() []Invoice {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	var invoices []Invoice
+	for _, invoice := range provider.invoices {
+		invoices = append(invoices, *invoice)
+	}
+	return invoices
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default payments errs class
+	Error = errs.Class("payments error")
+
+	// ErrCustomerNotFound is returned when a user has no customer account yet
+	ErrCustomerNotFound = Error.New("customer not found")
+	// ErrInvoiceNotFound is returned when an invoice doesn't exist
+	ErrInvoiceNotFound = Error.New("invoice not found")
+)
+
+// Provider is a payment processor which keeps the customer accounts and charges the invoices
+type Provider interface {
+	// CreateCustomer creates a customer account and returns its id
+	CreateCustomer(ctx context.Context, params CustomerParams) (customerID string, err error)
+	// ApplyCoupon applies a discount coupon to the future invoices of a customer
+	ApplyCoupon(ctx context.Context, customerID string, coupon string) error
+	// CreateInvoice creates and finalizes an invoice, the customer is charged automatically
+	CreateInvoice(ctx context.Context, params InvoiceParams) (*Invoice, error)
+	// ParseEvent verifies the signature of a webhook request and decodes the event
+	ParseEvent(payload []byte, header http.Header) (*Event, error)
+}
+
+// CustomerParams contains the details of a new customer
+type CustomerParams struct {
+	Email string
+	Name  string
+	// UserID is stored with the customer to find the console user it belongs to
+	UserID uuid.UUID
+}
+
+// InvoiceParams contains the details of a new invoice
+type InvoiceParams struct {
+	CustomerID  string
+	Description string
+	Items       []LineItem
+	// IdempotencyKey makes the provider create the invoice only once when the
+	// same params are sent again after a failure
+	IdempotencyKey string
+}
+
+// LineItem is a single charge on an invoice
+type LineItem struct {
+	Description string
+	// Amount is in cents, credits have a negative amount
+	Amount int64
+}
+
+// InvoiceStatus is the payment status of an invoice
+type InvoiceStatus string
+
+const (
+	// InvoicePending is the status of an invoice which is being created at the payment provider
+	InvoicePending = InvoiceStatus("pending")
+	// InvoiceOpen is the status of an invoice waiting for payment
+	InvoiceOpen = InvoiceStatus("open")
+	// InvoicePaid is the status of an invoice which was paid
+	InvoicePaid = InvoiceStatus("paid")
+	// InvoiceFailed is the status of an invoice whose last payment attempt failed
+	InvoiceFailed = InvoiceStatus("failed")
+	// InvoiceVoid is the status of an invoice which was canceled
+	InvoiceVoid = InvoiceStatus("void")
+)
+
+// Invoice is an invoice charging a project for the usage during a billing period
+type Invoice struct {
+	// ID is the id of the invoice at the payment provider
+	ID         string
+	ProjectID  uuid.UUID
+	CustomerID string
+
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+
+	// Amount is the amount due in cents
+	Amount int64
+	Status InvoiceStatus
+
+	CreatedAt time.Time
+}
+
+// Event is a notification about a changed invoice sent by the payment provider
+type Event struct {
+	Type      string
+	InvoiceID string
+	// Status is empty for events which don't change the status of an invoice
+	Status InvoiceStatus
+}
+
+// Customer links a console user to their customer account at the payment provider
+type Customer struct {
+	UserID     uuid.UUID
+	CustomerID string
+	// Credit is the amount in cents which is deducted from the following invoices
+	Credit int64
+
+	CreatedAt time.Time
+}
+
+// DB stores customers and invoices
+type DB interface {
+	// CreateCustomer stores the customer account of a user
+	CreateCustomer(ctx context.Context, customer Customer) (*Customer, error)
+	// GetCustomer returns the customer account of a user
+	GetCustomer(ctx context.Context, userID uuid.UUID) (*Customer, error)
+	// UpdateCredit sets the remaining credit of a user
+	UpdateCredit(ctx context.Context, userID uuid.UUID, credit int64) error
+
+	// CreateInvoice stores an invoice
+	CreateInvoice(ctx context.Context, invoice Invoice) (*Invoice, error)
+	// FinishInvoice replaces the pending invoice pendingID with the invoice created at the payment provider
+	FinishInvoice(ctx context.Context, pendingID string, invoice Invoice) error
+	// GetInvoice returns an invoice by its id at the payment provider
+	GetInvoice(ctx context.Context, id string) (*Invoice, error)
+	// GetProjectInvoice returns the invoice of a project for the billing period starting at periodStart
+	GetProjectInvoice(ctx context.Context, projectID uuid.UUID, periodStart time.Time) (*Invoice, error)
+	// UpdateInvoiceStatus sets the payment status of an invoice
+	UpdateInvoiceStatus(ctx context.Context, id string, status InvoiceStatus) error
+}
@@ -0,0 +1,333 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/satellite/console"
+)
+
+// maxWebhookSize is the largest webhook payload which is accepted
+const maxWebhookSize = 64 * memory.KiB
+
+// Config contains configurable values for customer billing
+type Config struct {
+	Provider            string        `help:"payment provider used for billing customers (stripe or empty to disable billing)" default:""`
+	StripeSecretKey     string        `help:"secret api key of the stripe account, required with stripe" default:""`
+	StripeWebhookSecret string        `help:"signing secret of the stripe webhook endpoint, required with stripe" default:""`
+	Interval            time.Duration `help:"how frequently invoices for the previous month are generated" default:"24h"`
+	StorageTBMonthPrice int64         `help:"price in cents for storing one terabyte for a month" default:"1000"`
+}
+
+// Service creates customer accounts for console users and invoices the usage of their projects
+type Service struct {
+	log        *zap.Logger
+	config     Config
+	provider   Provider
+	db         DB
+	console    console.DB
+	accounting accounting.DB
+
+	Loop sync2.Cycle
+}
+
+// NewService creates a new billing Service
+func NewService(log *zap.Logger, config Config, provider Provider, db DB, console console.DB, accounting accounting.DB) *Service {
+	service := &Service{
+		log:        log,
+		config:     config,
+		provider:   provider,
+		db:         db,
+		console:    console,
+		accounting: accounting,
+	}
+	service.Loop.SetInterval(config.Interval)
+	return service
+}
+
+// Run invoices the previous month until the context is canceled
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	service.log.Info("Billing service starting up")
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		start, end := BillingPeriod(time.Now().AddDate(0, -1, 0))
+		if err := service.InvoiceProjects(ctx, start, end); err != nil {
+			service.log.Error("invoicing failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the billing loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// BillingPeriod returns the start (inclusive) and end (exclusive) of the month containing t
+func BillingPeriod(t time.Time) (start, end time.Time) {
+	t = t.UTC()
+	start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// Customer returns the customer account of a user, it's created at the payment provider on first use
+func (service *Service) Customer(ctx context.Context, userID uuid.UUID) (customer *Customer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	customer, err = service.db.GetCustomer(ctx, userID)
+	if err != ErrCustomerNotFound {
+		return customer, err
+	}
+
+	user, err := service.console.Users().Get(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	customerID, err := service.provider.CreateCustomer(ctx, CustomerParams{
+		Email:  user.Email,
+		Name:   strings.TrimSpace(user.FirstName + " " + user.LastName),
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	service.log.Info("customer created",
+		zap.String("User ID", userID.String()),
+		zap.String("Customer ID", customerID))
+
+	return service.db.CreateCustomer(ctx, Customer{
+		UserID:     userID,
+		CustomerID: customerID,
+	})
+}
+
+// ApplyCoupon applies a discount coupon of the payment provider to the following invoices of a user
+func (service *Service) ApplyCoupon(ctx context.Context, userID uuid.UUID, coupon string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	customer, err := service.Customer(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return Error.Wrap(service.provider.ApplyCoupon(ctx, customer.CustomerID, coupon))
+}
+
+// AddCredit adds an amount in cents which is deducted from the following invoices of a user
+func (service *Service) AddCredit(ctx context.Context, userID uuid.UUID, amount int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if amount <= 0 {
+		return Error.New("credit has to be positive")
+	}
+
+	customer, err := service.Customer(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return service.db.UpdateCredit(ctx, userID, customer.Credit+amount)
+}
+
+// InvoiceProjects invoices the usage of all projects during the billing period,
+// projects which already have an invoice for the period are skipped
+func (service *Service) InvoiceProjects(ctx context.Context, start, end time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projects, err := service.console.Projects().GetAll(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, project := range projects {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		group.Add(service.invoiceProject(ctx, project, start, end))
+	}
+	return group.Err()
+}
+
+// invoiceProject invoices the usage of a single project to the customer account of its owner.
+// A pending invoice is stored before the invoice is created at the payment provider, when
+// creating it fails the following run retries it with the same idempotency key.
+func (service *Service) invoiceProject(ctx context.Context, project console.Project, start, end time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pending, err := service.db.GetProjectInvoice(ctx, project.ID, start)
+	switch {
+	case err == ErrInvoiceNotFound:
+		pending = nil
+	case err != nil:
+		return err
+	case pending.Status != InvoicePending:
+		return nil
+	}
+
+	byteHours, err := service.accounting.QueryProjectStorage(ctx, project.ID, start, end)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	tbMonths := byteHours / memory.TB.Float64() / end.Sub(start).Hours()
+	storage := int64(math.Round(tbMonths * float64(service.config.StorageTBMonthPrice)))
+	if storage <= 0 {
+		return nil
+	}
+
+	// the first member of a project is the user who created it
+	members, err := service.console.ProjectMembers().GetByProjectID(ctx, project.ID, console.Pagination{
+		Limit: 1,
+		Order: console.Created,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(members) == 0 {
+		return Error.New("project %s has no members", project.ID.String())
+	}
+
+	customer, err := service.Customer(ctx, members[0].MemberID)
+	if err != nil {
+		return err
+	}
+
+	items := []LineItem{{
+		Description: fmt.Sprintf("Storage for %s: %.4f TB months", project.Name, tbMonths),
+		Amount:      storage,
+	}}
+
+	credit := customer.Credit
+	if credit > storage {
+		credit = storage
+	}
+	if credit > 0 {
+		items = append(items, LineItem{Description: "Credit", Amount: -credit})
+	}
+
+	idempotencyKey := invoiceIdempotencyKey(project.ID, start)
+	if pending == nil {
+		// the idempotency key is unique per project and period, it's used as
+		// the id until the provider assigns one
+		pending, err = service.db.CreateInvoice(ctx, Invoice{
+			ID:          idempotencyKey,
+			ProjectID:   project.ID,
+			CustomerID:  customer.CustomerID,
+			PeriodStart: start,
+			PeriodEnd:   end,
+			Amount:      storage - credit,
+			Status:      InvoicePending,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	invoice, err := service.provider.CreateInvoice(ctx, InvoiceParams{
+		CustomerID:     customer.CustomerID,
+		Description:    fmt.Sprintf("%s %s", project.Name, start.Format("January 2006")),
+		Items:          items,
+		IdempotencyKey: idempotencyKey,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	invoice.ProjectID = project.ID
+	invoice.PeriodStart = start
+	invoice.PeriodEnd = end
+	if err := service.db.FinishInvoice(ctx, pending.ID, *invoice); err != nil {
+		return err
+	}
+
+	service.log.Info("project invoiced",
+		zap.String("Project ID", project.ID.String()),
+		zap.String("Invoice ID", invoice.ID),
+		zap.Int64("Amount", invoice.Amount),
+		zap.Int64("Credit", credit))
+
+	// the credit is only deducted once the invoice is finished, otherwise a retry
+	// would send different items with the same idempotency key
+	if credit > 0 {
+		return service.db.UpdateCredit(ctx, customer.UserID, customer.Credit-credit)
+	}
+	return nil
+}
+
+// invoiceIdempotencyKey returns the idempotency key of the invoice of a project for the
+// billing period starting at periodStart
+func invoiceIdempotencyKey(projectID uuid.UUID, periodStart time.Time) string {
+	return fmt.Sprintf("invoice-%s-%s", projectID.String(), periodStart.UTC().Format("2006-01"))
+}
+
+// HandleEvent updates the status of the invoice a webhook event refers to
+func (service *Service) HandleEvent(ctx context.Context, event *Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if event.InvoiceID == "" || event.Status == "" {
+		return nil
+	}
+
+	err = service.db.UpdateInvoiceStatus(ctx, event.InvoiceID, event.Status)
+	if err == ErrInvoiceNotFound {
+		// invoices not created by the billing service are ignored
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	service.log.Info("invoice status changed",
+		zap.String("Invoice ID", event.InvoiceID),
+		zap.String("Status", string(event.Status)))
+	return nil
+}
+
+// ServeHTTP handles the webhook requests of the payment provider
+func (service *Service) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	payload, err := ioutil.ReadAll(http.MaxBytesReader(w, req.Body, maxWebhookSize.Int64()))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event, err := service.provider.ParseEvent(payload, req.Header)
+	if err != nil {
+		service.log.Warn("invalid webhook request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := service.HandleEvent(ctx, event); err != nil {
+		service.log.Error("handling webhook event failed", zap.String("Type", event.Type), zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
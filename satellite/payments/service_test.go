@@ -0,0 +1,212 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/mockpayments"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestBillingPeriod(t *testing.T) {
+	start, end := payments.BillingPeriod(time.Date(2019, 2, 15, 13, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), end)
+}
+
+func TestInvoiceProjects(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		user, err := db.Console().Users().Insert(ctx, &console.User{
+			FirstName:    "Alice",
+			LastName:     "Smith",
+			Email:        "alice@example.com",
+			PasswordHash: []byte("password"),
+		})
+		require.NoError(t, err)
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "stored"})
+		require.NoError(t, err)
+		_, err = db.Console().ProjectMembers().Insert(ctx, user.ID, project.ID)
+		require.NoError(t, err)
+
+		// projects without usage aren't invoiced
+		empty, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "empty"})
+		require.NoError(t, err)
+		_, err = db.Console().ProjectMembers().Insert(ctx, user.ID, empty.ID)
+		require.NoError(t, err)
+
+		start, end := payments.BillingPeriod(time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC))
+
+		// 2TB stored for the whole month
+		byteHours := 2 * memory.TB.Float64() * end.Sub(start).Hours()
+		err = db.Accounting().SaveProjectStorageTally(ctx, start.Add(time.Hour), map[uuid.UUID]float64{
+			project.ID: byteHours,
+		})
+		require.NoError(t, err)
+
+		provider := mockpayments.New()
+		service := payments.NewService(zap.NewNop(), payments.Config{
+			StorageTBMonthPrice: 1000,
+		}, provider, db.Payments(), db.Console(), db.Accounting())
+
+		require.NoError(t, service.AddCredit(ctx, user.ID, 500))
+		require.NoError(t, service.ApplyCoupon(ctx, user.ID, "launch"))
+
+		customers := provider.Customers()
+		require.Len(t, customers, 1)
+		assert.Equal(t, "alice@example.com", customers[0].Email)
+		assert.Equal(t, "Alice Smith", customers[0].Name)
+		assert.Equal(t, "launch", customers[0].Coupon)
+
+		require.NoError(t, service.InvoiceProjects(ctx, start, end))
+
+		invoice, err := db.Payments().GetProjectInvoice(ctx, project.ID, start)
+		require.NoError(t, err)
+		assert.Equal(t, customers[0].ID, invoice.CustomerID)
+		assert.Equal(t, int64(1500), invoice.Amount)
+		assert.Equal(t, payments.InvoiceOpen, invoice.Status)
+
+		invoices := provider.Invoices()
+		require.Len(t, invoices, 1)
+		assert.Equal(t, []payments.LineItem{
+			{Description: "Storage for stored: 2.0000 TB months", Amount: 2000},
+			{Description: "Credit", Amount: -500},
+		}, invoices[0].Items)
+
+		_, err = db.Payments().GetProjectInvoice(ctx, empty.ID, start)
+		assert.Equal(t, payments.ErrInvoiceNotFound, err)
+
+		// the credit is used up by the invoice
+		customer, err := service.Customer(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), customer.Credit)
+
+		// invoicing the same period again doesn't create another invoice
+		require.NoError(t, service.InvoiceProjects(ctx, start, end))
+		assert.Len(t, provider.Invoices(), 1)
+
+		t.Run("webhook", func(t *testing.T) {
+			payload, err := json.Marshal(payments.Event{
+				Type:      "invoice.payment_succeeded",
+				InvoiceID: invoice.ID,
+				Status:    payments.InvoicePaid,
+			})
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			service.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/payments/webhook", bytes.NewReader(payload)))
+			assert.Equal(t, http.StatusOK, recorder.Code)
+
+			invoice, err := db.Payments().GetInvoice(ctx, invoice.ID)
+			require.NoError(t, err)
+			assert.Equal(t, payments.InvoicePaid, invoice.Status)
+
+			// events about unknown invoices are acknowledged
+			recorder = httptest.NewRecorder()
+			service.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/payments/webhook",
+				bytes.NewReader([]byte(`{"Type":"invoice.payment_failed","InvoiceID":"in_unknown","Status":"failed"}`))))
+			assert.Equal(t, http.StatusOK, recorder.Code)
+
+			recorder = httptest.NewRecorder()
+			service.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/payments/webhook", bytes.NewReader([]byte("{"))))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+	})
+}
+
+// failingProvider loses the response of the first invoice created at the provider
+type failingProvider struct {
+	*mockpayments.Provider
+	failed bool
+}
+
+func (provider *failingProvider) CreateInvoice(ctx context.Context, params payments.InvoiceParams) (*payments.Invoice, error) {
+	invoice, err := provider.Provider.CreateInvoice(ctx, params)
+	if err == nil && !provider.failed {
+		provider.failed = true
+		return nil, errs.New("connection reset")
+	}
+	return invoice, err
+}
+
+func TestInvoiceProjectsRetry(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		user, err := db.Console().Users().Insert(ctx, &console.User{
+			FirstName:    "Alice",
+			Email:        "alice@example.com",
+			PasswordHash: []byte("password"),
+		})
+		require.NoError(t, err)
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "stored"})
+		require.NoError(t, err)
+		_, err = db.Console().ProjectMembers().Insert(ctx, user.ID, project.ID)
+		require.NoError(t, err)
+
+		start, end := payments.BillingPeriod(time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC))
+		err = db.Accounting().SaveProjectStorageTally(ctx, start.Add(time.Hour), map[uuid.UUID]float64{
+			project.ID: memory.TB.Float64() * end.Sub(start).Hours(),
+		})
+		require.NoError(t, err)
+
+		provider := &failingProvider{Provider: mockpayments.New()}
+		service := payments.NewService(zap.NewNop(), payments.Config{
+			StorageTBMonthPrice: 1000,
+		}, provider, db.Payments(), db.Console(), db.Accounting())
+
+		require.NoError(t, service.AddCredit(ctx, user.ID, 300))
+
+		// the invoice stays pending when the response of the provider is lost
+		require.Error(t, service.InvoiceProjects(ctx, start, end))
+
+		invoice, err := db.Payments().GetProjectInvoice(ctx, project.ID, start)
+		require.NoError(t, err)
+		assert.Equal(t, payments.InvoicePending, invoice.Status)
+		assert.Equal(t, int64(700), invoice.Amount)
+
+		customer, err := service.Customer(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(300), customer.Credit)
+
+		// the retry gets the same invoice from the provider and finishes it
+		require.NoError(t, service.InvoiceProjects(ctx, start, end))
+
+		invoices := provider.Invoices()
+		require.Len(t, invoices, 1)
+
+		invoice, err = db.Payments().GetProjectInvoice(ctx, project.ID, start)
+		require.NoError(t, err)
+		assert.Equal(t, invoices[0].ID, invoice.ID)
+		assert.Equal(t, payments.InvoiceOpen, invoice.Status)
+		assert.Equal(t, int64(700), invoice.Amount)
+
+		customer, err = service.Customer(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), customer.Credit)
+	})
+}
@@ -0,0 +1,291 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripepayments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/satellite/payments"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default stripe errs class
+	Error = errs.Class("stripe error")
+)
+
+const (
+	// DefaultURL is the address of the stripe api
+	DefaultURL = "https://api.stripe.com/v1"
+	// SignatureHeader is the header containing the signature of webhook requests
+	SignatureHeader = "Stripe-Signature"
+	// SignatureTolerance is how old the timestamp of a webhook signature is allowed to be
+	SignatureTolerance = 5 * time.Minute
+)
+
+// Provider implements payments.Provider using the stripe api
+type Provider struct {
+	// URL is the address of the stripe api
+	URL      string
+	Currency string
+	Client   *http.Client
+
+	secretKey     string
+	webhookSecret string
+}
+
+// New creates a stripe provider using the secret api key of the account and the
+// signing secret of the webhook endpoint
+func New(secretKey, webhookSecret string) *Provider {
+	return &Provider{
+		URL:           DefaultURL,
+		Currency:      "usd",
+		Client:        &http.Client{Timeout: 30 * time.Second},
+		secretKey:     secretKey,
+		webhookSecret: webhookSecret,
+	}
+}
+
+// object is the part of the stripe objects used by the provider
+type object struct {
+	ID        string `json:"id"`
+	Customer  string `json:"customer"`
+	AmountDue int64  `json:"amount_due"`
+	Status    string `json:"status"`
+}
+
+// post sends a form encoded request to the stripe api and decodes the returned object,
+// requests with the same non-empty idempotency key are only executed once by stripe
+func (provider *Provider) post(ctx context.Context, path string, form url.Values, idempotencyKey string) (_ *object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequest(http.MethodPost, provider.URL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	req = req.WithContext(ctx)
+	req.SetBasicAuth(provider.secretKey, "")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := provider.Client.Do(req)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var failure struct {
+			Error struct {
+				Type    string `json:"type"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(body, &failure); err != nil || failure.Error.Message == "" {
+			return nil, Error.New("%s: unexpected status %d", path, resp.StatusCode)
+		}
+		return nil, Error.New("%s: %s: %s", path, failure.Error.Type, failure.Error.Message)
+	}
+
+	result := &object{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return result, nil
+}
+
+// CreateCustomer creates a customer and returns its id
+func (provider *Provider) CreateCustomer(ctx context.Context, params payments.CustomerParams) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	form := url.Values{}
+	form.Set("email", params.Email)
+	form.Set("name", params.Name)
+	form.Set("metadata[user_id]", params.UserID.String())
+
+	customer, err := provider.post(ctx, "/customers", form, "")
+	if err != nil {
+		return "", err
+	}
+	return customer.ID, nil
+}
+
+// ApplyCoupon applies a coupon to the future invoices of a customer
+func (provider *Provider) ApplyCoupon(ctx context.Context, customerID string, coupon string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	form := url.Values{}
+	form.Set("coupon", coupon)
+
+	_, err = provider.post(ctx, "/customers/"+url.PathEscape(customerID), form, "")
+	return err
+}
+
+// CreateInvoice adds the line items as pending invoice items of the customer and
+// creates a finalized invoice for them, which is charged automatically. Every request
+// derives its own idempotency key from params.IdempotencyKey, so that retrying after
+// a failure doesn't add the items twice.
+func (provider *Provider) CreateInvoice(ctx context.Context, params payments.InvoiceParams) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	idempotencyKey := func(suffix string) string {
+		if params.IdempotencyKey == "" {
+			return ""
+		}
+		return params.IdempotencyKey + "-" + suffix
+	}
+
+	for i, item := range params.Items {
+		form := url.Values{}
+		form.Set("customer", params.CustomerID)
+		form.Set("amount", strconv.FormatInt(item.Amount, 10))
+		form.Set("currency", provider.Currency)
+		form.Set("description", item.Description)
+
+		if _, err := provider.post(ctx, "/invoiceitems", form, idempotencyKey("item-"+strconv.Itoa(i))); err != nil {
+			return nil, err
+		}
+	}
+
+	form := url.Values{}
+	form.Set("customer", params.CustomerID)
+	form.Set("description", params.Description)
+	form.Set("collection_method", "charge_automatically")
+	form.Set("auto_advance", "true")
+
+	draft, err := provider.post(ctx, "/invoices", form, idempotencyKey("invoice"))
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := provider.post(ctx, "/invoices/"+url.PathEscape(draft.ID)+"/finalize", url.Values{}, idempotencyKey("finalize"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &payments.Invoice{
+		ID:         invoice.ID,
+		CustomerID: params.CustomerID,
+		Amount:     invoice.AmountDue,
+		Status:     invoiceStatus(invoice.Status),
+	}, nil
+}
+
+// ParseEvent verifies the signature of a webhook request and decodes the invoice event
+func (provider *Provider) ParseEvent(payload []byte, header http.Header) (*payments.Event, error) {
+	if provider.webhookSecret == "" {
+		return nil, Error.New("webhook secret is not configured")
+	}
+	if err := VerifySignature(payload, header.Get(SignatureHeader), provider.webhookSecret, time.Now()); err != nil {
+		return nil, err
+	}
+
+	var event struct {
+		Type string `json:"type"`
+		Data struct {
+			Object struct {
+				Object string `json:"object"`
+				object
+			} `json:"object"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	result := &payments.Event{Type: event.Type}
+	if event.Data.Object.Object != "invoice" {
+		return result, nil
+	}
+
+	result.InvoiceID = event.Data.Object.ID
+	switch event.Type {
+	case "invoice.payment_succeeded":
+		result.Status = payments.InvoicePaid
+	case "invoice.payment_failed":
+		result.Status = payments.InvoiceFailed
+	case "invoice.voided":
+		result.Status = payments.InvoiceVoid
+	}
+	return result, nil
+}
+
+// invoiceStatus converts the status of a stripe invoice
+func invoiceStatus(status string) payments.InvoiceStatus {
+	switch status {
+	case "paid":
+		return payments.InvoicePaid
+	case "void", "uncollectible":
+		return payments.InvoiceVoid
+	default:
+		return payments.InvoiceOpen
+	}
+}
+
+// VerifySignature checks that the Stripe-Signature header of a webhook request
+// contains a valid signature of the payload which isn't older than SignatureTolerance
+func VerifySignature(payload []byte, header, secret string, now time.Time) error {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			timestamp = kv[1]
+		case "v1":
+			signatures = append(signatures, kv[1])
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return Error.New("missing webhook signature")
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return Error.New("invalid webhook signature timestamp")
+	}
+	if now.Sub(time.Unix(unix, 0)) > SignatureTolerance {
+		return Error.New("webhook signature expired")
+	}
+
+	expected := Sign(payload, timestamp, secret)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return nil
+		}
+	}
+	return Error.New("invalid webhook signature")
+}
+
+// Sign returns the hex encoded signature of a webhook payload sent at timestamp
+func Sign(payload []byte, timestamp, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(timestamp + "."))
+	_, _ = mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripepayments_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/stripepayments"
+)
+
+func TestCreateInvoice(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var requests, keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		key, _, _ := req.BasicAuth()
+		if key != "sk_test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = fmt.Fprint(w, `{"error": {"type": "invalid_request_error", "message": "Invalid API Key provided"}}`)
+			return
+		}
+		require.NoError(t, req.ParseForm())
+		requests = append(requests, req.URL.Path+" "+req.PostForm.Encode())
+		keys = append(keys, req.Header.Get("Idempotency-Key"))
+
+		switch req.URL.Path {
+		case "/customers":
+			_, _ = fmt.Fprint(w, `{"id": "cus_1"}`)
+		case "/invoiceitems":
+			_, _ = fmt.Fprint(w, `{"id": "ii_1"}`)
+		case "/invoices":
+			_, _ = fmt.Fprint(w, `{"id": "in_1", "status": "draft", "amount_due": 1500}`)
+		case "/invoices/in_1/finalize":
+			_, _ = fmt.Fprint(w, `{"id": "in_1", "status": "open", "amount_due": 1500}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := stripepayments.New("sk_test", "whsec_test")
+	provider.URL = server.URL
+
+	customerID, err := provider.CreateCustomer(ctx, payments.CustomerParams{Email: "alice@example.com", Name: "Alice"})
+	require.NoError(t, err)
+	assert.Equal(t, "cus_1", customerID)
+
+	invoice, err := provider.CreateInvoice(ctx, payments.InvoiceParams{
+		CustomerID:  customerID,
+		Description: "usage",
+		Items: []payments.LineItem{
+			{Description: "Storage", Amount: 2000},
+			{Description: "Credit", Amount: -500},
+		},
+		IdempotencyKey: "invoice-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &payments.Invoice{
+		ID:         "in_1",
+		CustomerID: "cus_1",
+		Amount:     1500,
+		Status:     payments.InvoiceOpen,
+	}, invoice)
+
+	assert.Equal(t, []string{
+		"/customers email=alice%40example.com&metadata%5Buser_id%5D=00000000-0000-0000-0000-000000000000&name=Alice",
+		"/invoiceitems amount=2000&currency=usd&customer=cus_1&description=Storage",
+		"/invoiceitems amount=-500&currency=usd&customer=cus_1&description=Credit",
+		"/invoices auto_advance=true&collection_method=charge_automatically&customer=cus_1&description=usage",
+		"/invoices/in_1/finalize ",
+	}, requests)
+	assert.Equal(t, []string{
+		"",
+		"invoice-1-item-0",
+		"invoice-1-item-1",
+		"invoice-1-invoice",
+		"invoice-1-finalize",
+	}, keys)
+
+	provider = stripepayments.New("sk_invalid", "")
+	provider.URL = server.URL
+	_, err = provider.CreateCustomer(ctx, payments.CustomerParams{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid API Key provided")
+}
+
+func TestParseEvent(t *testing.T) {
+	provider := stripepayments.New("sk_test", "whsec_test")
+
+	payload := []byte(`{"type": "invoice.payment_succeeded", "data": {"object": {"object": "invoice", "id": "in_1", "status": "paid"}}}`)
+	sign := func(payload []byte, timestamp time.Time, secret string) http.Header {
+		unix := strconv.FormatInt(timestamp.Unix(), 10)
+		header := http.Header{}
+		header.Set(stripepayments.SignatureHeader, "t="+unix+",v1="+stripepayments.Sign(payload, unix, secret))
+		return header
+	}
+
+	event, err := provider.ParseEvent(payload, sign(payload, time.Now(), "whsec_test"))
+	require.NoError(t, err)
+	assert.Equal(t, &payments.Event{
+		Type:      "invoice.payment_succeeded",
+		InvoiceID: "in_1",
+		Status:    payments.InvoicePaid,
+	}, event)
+
+	_, err = provider.ParseEvent(payload, sign(payload, time.Now(), "whsec_other"))
+	assert.Error(t, err)
+
+	_, err = provider.ParseEvent(payload, sign(payload, time.Now().Add(-time.Hour), "whsec_test"))
+	assert.Error(t, err)
+
+	_, err = provider.ParseEvent(payload, http.Header{})
+	assert.Error(t, err)
+
+	// without a webhook secret every event is rejected, even one signed with an empty secret
+	_, err = stripepayments.New("sk_test", "").ParseEvent(payload, sign(payload, time.Now(), ""))
+	assert.Error(t, err)
+
+	// events about other objects don't change invoices
+	other := []byte(`{"type": "customer.created", "data": {"object": {"object": "customer", "id": "cus_1"}}}`)
+	event, err = provider.ParseEvent(other, sign(other, time.Now(), "whsec_test"))
+	require.NoError(t, err)
+	assert.Equal(t, &payments.Event{Type: "customer.created"}, event)
+}
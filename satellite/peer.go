@@ -21,24 +21,32 @@ import (
 	"storj.io/storj/pkg/accounting/tally"
 	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/auth/grpcauth"
+	"storj.io/storj/pkg/broadcast"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/datarepair/checker"
 	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/datarepair/repairer"
 	"storj.io/storj/pkg/discovery"
+	"storj.io/storj/pkg/gc"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/lifecycle"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/relay"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/consolepurge"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/stripepayments"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/boltdb"
 	"storj.io/storj/storage/storelogger"
@@ -62,6 +70,10 @@ type DB interface {
 	StatDB() statdb.DB
 	// OverlayCache returns database for caching overlay information
 	OverlayCache() overlay.DB
+	// NodePings returns database for tracking the ping success rate of nodes
+	NodePings() overlay.PingDB
+	// NodeStandings returns database for tracking since when nodes have their standing
+	NodeStandings() overlay.StandingDB
 	// Accounting returns database for storing information about data use
 	Accounting() accounting.DB
 	// RepairQueue returns queue for segments that need repairing
@@ -70,6 +82,18 @@ type DB interface {
 	Irreparable() irreparable.DB
 	// Console returns database for satellite console
 	Console() console.DB
+	// ObjectTags returns database for searching objects by their tags
+	ObjectTags() pointerdb.ObjectTags
+	// BucketAttributions returns database for the partners buckets are attributed to
+	BucketAttributions() pointerdb.BucketAttributions
+	// BucketLifecycles returns database for the lifecycle configurations of buckets
+	BucketLifecycles() pointerdb.BucketLifecycles
+	// Payments returns database for storing customers and invoices
+	Payments() payments.DB
+	// SegmentHealth returns database for tracking when segments were audited and repaired
+	SegmentHealth() pointerdb.HealthDB
+	// AuditLog returns database for keeping the outcomes of audits
+	AuditLog() audit.LogDB
 }
 
 // Config is the global config satellite
@@ -79,9 +103,11 @@ type Config struct {
 	// TODO: switch to using server.Config when Identity has been removed from it
 	Server server.Config
 
-	Kademlia  kademlia.Config
-	Overlay   overlay.Config
-	Discovery discovery.Config
+	Kademlia   kademlia.Config
+	Overlay    overlay.Config
+	Discovery  discovery.Config
+	Reputation reputation.Config
+	Broadcast  broadcast.Config
 
 	PointerDB   pointerdb.Config
 	BwAgreement bwagreement.Config // TODO: decide whether to keep empty configs for consistency
@@ -90,10 +116,19 @@ type Config struct {
 	Repairer repairer.Config
 	Audit    audit.Config
 
-	Tally  tally.Config
-	Rollup rollup.Config
+	GarbageCollection gc.Config
+	Lifecycle         lifecycle.Config
 
-	Console consoleweb.Config
+	Tally      tally.Config
+	Rollup     rollup.Config
+	Invariants accounting.InvariantConfig
+
+	Console      consoleweb.Config
+	ConsolePurge consolepurge.Config
+
+	Payments payments.Config
+
+	Relay relay.ServerConfig
 }
 
 // Peer is the satellite
@@ -103,7 +138,8 @@ type Peer struct {
 	Identity *identity.FullIdentity
 	DB       DB
 
-	Transport transport.Client
+	Transport          transport.Client
+	TransportInspector *transport.Inspector
 
 	// servers
 	Public struct {
@@ -131,15 +167,23 @@ type Peer struct {
 		Service *discovery.Discovery
 	}
 
+	Broadcast struct {
+		Service   *broadcast.Service
+		Inspector *broadcast.Inspector
+	}
+
 	Reputation struct {
 		Inspector *statdb.Inspector
 	}
 
 	Metainfo struct {
-		Database   storage.KeyValueStore // TODO: move into pointerDB
-		Allocation *pointerdb.AllocationSigner
-		Service    *pointerdb.Service
-		Endpoint   *pointerdb.Server
+		Database    storage.KeyValueStore // TODO: move into pointerDB
+		Allocation  *pointerdb.AllocationSigner
+		Service     *pointerdb.Service
+		Loop        *pointerdb.Loop
+		Maintenance *pointerdb.Maintenance
+		Endpoint    *pointerdb.Server
+		Inspector   *pointerdb.Inspector
 	}
 
 	Agreements struct {
@@ -147,22 +191,46 @@ type Peer struct {
 	}
 
 	Repair struct {
-		Checker  checker.Checker // TODO: convert to actual struct
-		Repairer *repairer.Service
+		Checker        checker.Checker // TODO: convert to actual struct
+		Repairer       *repairer.Service
+		Inspector      *irreparable.Inspector
+		StatsInspector *repairer.Inspector
 	}
 	Audit struct {
-		Service *audit.Service
+		Service   *audit.Service
+		Inspector *audit.Inspector
+	}
+
+	GarbageCollection struct {
+		Service *gc.Service
+	}
+
+	Lifecycle struct {
+		Service *lifecycle.Service
 	}
 
 	Accounting struct {
-		Tally  *tally.Tally
-		Rollup *rollup.Rollup
+		Tally      *tally.Tally
+		Rollup     *rollup.Rollup
+		Invariants *accounting.InvariantChecker
+		Endpoint   *accounting.Endpoint
 	}
 
 	Console struct {
 		Listener net.Listener
 		Service  *console.Service
 		Endpoint *consoleweb.Server
+		Purge    *consolepurge.Service
+	}
+
+	Payments struct {
+		Provider payments.Provider
+		Service  *payments.Service
+	}
+
+	Relay struct {
+		Listener net.Listener
+		Server   *relay.Server
 	}
 }
 
@@ -177,13 +245,31 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 
 	var err error
 
+	if err := config.Reputation.Verify(); err != nil {
+		return nil, err
+	}
+	if err := config.Overlay.Subnets.Verify(); err != nil {
+		return nil, err
+	}
+	if err := config.Overlay.Node.Verify(); err != nil {
+		return nil, err
+	}
+	if config.Overlay.Node.NewNodePercentage != 0 {
+		log.Warn("overlay.node.new-node-percentage is deprecated, use overlay.node.new-node-fraction instead")
+	}
+
 	{ // setup listener and server
 		peer.Public.Listener, err = net.Listen("tcp", config.Server.Address)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		publicConfig := server.Config{Address: peer.Public.Listener.Addr().String()}
+		publicConfig := server.Config{
+			Address:                peer.Public.Listener.Addr().String(),
+			DenylistPath:           config.Server.DenylistPath,
+			DenylistReloadInterval: config.Server.DenylistReloadInterval,
+			ConnectionLimit:        config.Server.ConnectionLimit,
+		}
 		publicOptions, err := server.NewOptions(peer.Identity, publicConfig)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -193,6 +279,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+
+		// the dials of audits and repairs are made by separate transport clients,
+		// so the stats of the whole process are inspected
+		peer.TransportInspector = transport.NewInspector(transport.Stats())
+		pb.RegisterTransportInspectorServer(peer.Public.Server.GRPC(), peer.TransportInspector)
 	}
 
 	{ // setup kademlia
@@ -241,7 +332,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Kademlia.Endpoint = kademlia.NewEndpoint(peer.Log.Named("kademlia:endpoint"), peer.Kademlia.Service, peer.Kademlia.RoutingTable)
+		peer.Kademlia.Endpoint = kademlia.NewEndpoint(peer.Log.Named("kademlia:endpoint"), peer.Kademlia.Service, peer.Kademlia.RoutingTable, config.RateLimit)
 		pb.RegisterNodesServer(peer.Public.Server.GRPC(), peer.Kademlia.Endpoint)
 
 		peer.Kademlia.Inspector = kademlia.NewInspector(peer.Kademlia.Service, peer.Identity)
@@ -249,16 +340,18 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 	}
 
 	{ // setup overlay
+		reputation := config.Reputation
 		config := config.Overlay
-		peer.Overlay.Service = overlay.NewCache(peer.DB.OverlayCache(), peer.DB.StatDB())
+		peer.Overlay.Service = overlay.NewCache(peer.DB.OverlayCache(), peer.DB.StatDB(), peer.DB.NodePings(), config.Ping, reputation, config.Subnets)
 
 		nodeSelectionConfig := &overlay.NodeSelectionConfig{
-			UptimeCount:           config.Node.UptimeCount,
-			UptimeRatio:           config.Node.UptimeRatio,
-			AuditSuccessRatio:     config.Node.AuditSuccessRatio,
-			AuditCount:            config.Node.AuditCount,
 			NewNodeAuditThreshold: config.Node.NewNodeAuditThreshold,
+			NewNodeFraction:       config.Node.NewNodeFraction,
 			NewNodePercentage:     config.Node.NewNodePercentage,
+			DistinctSubnets:       config.Node.DistinctSubnets,
+			MinimumDisk:           config.Node.MinimumDisk,
+			MinimumBandwidth:      config.Node.MinimumBandwidth,
+			RequireWallet:         config.Node.RequireWallet,
 		}
 
 		peer.Overlay.Endpoint = overlay.NewServer(peer.Log.Named("overlay:endpoint"), peer.Overlay.Service, nodeSelectionConfig)
@@ -276,7 +369,15 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 
 	{ // setup discovery
 		config := config.Discovery
-		peer.Discovery.Service = discovery.New(peer.Log.Named("discovery"), peer.Overlay.Service, peer.Kademlia.Service, peer.DB.StatDB(), config)
+		peer.Discovery.Service = discovery.New(peer.Log.Named("discovery"), peer.Overlay.Service, peer.Kademlia.Service, peer.DB.StatDB(), peer.DB.NodeStandings(), config)
+	}
+
+	{ // setup broadcast
+		config := config.Broadcast
+		peer.Broadcast.Service = broadcast.NewService(peer.Log.Named("broadcast"), peer.Identity, peer.Overlay.Service, peer.DB.NodePings(), peer.Kademlia.Service, config)
+
+		peer.Broadcast.Inspector = broadcast.NewInspector(peer.Broadcast.Service)
+		pb.RegisterBroadcastInspectorServer(peer.Public.Server.GRPC(), peer.Broadcast.Inspector)
 	}
 
 	{ // setup metainfo
@@ -287,56 +388,108 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 
 		peer.Metainfo.Database = storelogger.New(peer.Log.Named("pdb"), db)
 		peer.Metainfo.Service = pointerdb.NewService(peer.Log.Named("pointerdb"), peer.Metainfo.Database)
+		peer.Metainfo.Loop = pointerdb.NewLoop(config.PointerDB.Loop, peer.Metainfo.Service)
 		peer.Metainfo.Allocation = pointerdb.NewAllocationSigner(peer.Identity, config.PointerDB.BwExpiration)
+		peer.Metainfo.Maintenance, err = pointerdb.NewMaintenance(config.PointerDB.Maintenance)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 		peer.Metainfo.Endpoint = pointerdb.NewServer(peer.Log.Named("pointerdb:endpoint"),
 			peer.Metainfo.Service,
 			peer.Metainfo.Allocation,
 			peer.Overlay.Service,
 			config.PointerDB,
-			peer.Identity, peer.DB.Console().APIKeys())
+			peer.Identity, peer.DB.Console().APIKeys(),
+			peer.DB.Console().ProjectDeletions(),
+			peer.DB.ObjectTags(),
+			peer.DB.Console().BucketTemplates(),
+			peer.DB.Console().BucketStats(),
+			peer.DB.BucketLifecycles(),
+			peer.Metainfo.Maintenance,
+			peer.DB.BucketAttributions())
 
 		pb.RegisterPointerDBServer(peer.Public.Server.GRPC(), peer.Metainfo.Endpoint)
+
+		peer.Metainfo.Inspector = pointerdb.NewInspector(peer.Metainfo.Service, peer.Overlay.Service, peer.DB.SegmentHealth(), peer.Metainfo.Maintenance)
+		pb.RegisterPointerDBInspectorServer(peer.Public.Server.GRPC(), peer.Metainfo.Inspector)
 	}
 
 	{ // setup agreements
-		bwServer := bwagreement.NewServer(peer.DB.BandwidthAgreement(), peer.Log.Named("agreements"), peer.Identity.ID)
+		bwServer := bwagreement.NewServer(peer.DB.BandwidthAgreement(), config.BwAgreement, peer.Log.Named("agreements"), peer.Identity.ID)
 		peer.Agreements.Endpoint = bwServer
 		pb.RegisterBandwidthServer(peer.Public.Server.GRPC(), peer.Agreements.Endpoint)
 	}
 
 	{ // setup datarepair
 		// TODO: simplify argument list somehow
-		peer.Repair.Checker = checker.NewChecker(
-			peer.Metainfo.Service,
+		peer.Repair.Checker, err = checker.NewChecker(
+			peer.Metainfo.Service, peer.Metainfo.Loop,
 			peer.DB.StatDB(), peer.DB.RepairQueue(),
 			peer.Overlay.Endpoint, peer.DB.Irreparable(),
 			0, peer.Log.Named("checker"),
-			config.Checker.Interval)
+			config.Checker, config.Reputation)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
-		peer.Repair.Repairer = repairer.NewService(peer.DB.RepairQueue(), &config.Repairer, peer.Identity, config.Repairer.Interval, config.Repairer.MaxRepair)
+		peer.Repair.Inspector = irreparable.NewInspector(peer.DB.Irreparable())
+		pb.RegisterIrreparableInspectorServer(peer.Public.Server.GRPC(), peer.Repair.Inspector)
+
+		peer.Repair.Repairer = repairer.NewService(peer.DB.RepairQueue(), peer.DB.SegmentHealth(), &config.Repairer, peer.Identity, config.Repairer.Interval, config.Repairer.MaxRepair)
+
+		peer.Repair.StatsInspector = repairer.NewInspector(peer.Repair.Repairer)
+		pb.RegisterRepairInspectorServer(peer.Public.Server.GRPC(), peer.Repair.StatsInspector)
 	}
 
 	{ // setup audit
+		reputation := config.Reputation
 		config := config.Audit
 
 		// TODO: use common transport Client and close to avoid leak
 		transportClient := transport.NewClient(peer.Identity)
 
 		peer.Audit.Service, err = audit.NewService(peer.Log.Named("audit"),
-			peer.DB.StatDB(),
-			config.Interval, config.MaxRetriesStatDB,
-			peer.Metainfo.Service, peer.Metainfo.Allocation,
+			peer.DB.StatDB(), peer.DB.SegmentHealth(), peer.DB.AuditLog(), config.LogRetention,
+			config.Interval, config.MaxRetriesStatDB, config.VerifyPieceHashes, config.ReservoirSize, reputation,
+			peer.Metainfo.Service, peer.Metainfo.Loop, peer.Metainfo.Allocation,
 			transportClient, peer.Overlay.Service,
 			peer.Identity,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+
+		peer.Audit.Inspector = audit.NewInspector(peer.DB.AuditLog())
+		pb.RegisterAuditInspectorServer(peer.Public.Server.GRPC(), peer.Audit.Inspector)
+	}
+
+	{ // setup garbage collection
+		peer.GarbageCollection.Service = gc.NewService(peer.Log.Named("gc"),
+			config.GarbageCollection,
+			peer.Metainfo.Loop,
+			peer.Overlay.Service,
+			peer.Transport,
+			peer.Identity.ID,
+		)
+	}
+
+	{ // setup bucket lifecycles
+		peer.Lifecycle.Service = lifecycle.NewService(peer.Log.Named("lifecycle"),
+			config.Lifecycle,
+			peer.DB.BucketLifecycles(),
+			peer.DB.ObjectTags(),
+			peer.Metainfo.Loop,
+			peer.Metainfo.Service,
+		)
 	}
 
 	{ // setup accounting
-		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.Accounting(), peer.DB.BandwidthAgreement(), peer.Metainfo.Service, peer.Overlay.Endpoint, 0, config.Tally.Interval)
-		peer.Accounting.Rollup = rollup.New(peer.Log.Named("rollup"), peer.DB.Accounting(), config.Rollup.Interval)
+		peer.Accounting.Invariants = accounting.NewInvariantChecker(peer.Log.Named("accounting:invariants"), peer.DB.Accounting(), config.Invariants)
+		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.Accounting(), peer.DB.BandwidthAgreement(), peer.DB.Console().BucketStats(), peer.DB.BucketAttributions(), peer.Metainfo.Loop, peer.Overlay.Endpoint, 0, config.Tally.Interval, peer.Accounting.Invariants)
+		peer.Accounting.Rollup = rollup.New(peer.Log.Named("rollup"), peer.DB.Accounting(), config.Rollup.Interval, peer.Accounting.Invariants)
+
+		peer.Accounting.Endpoint = accounting.NewEndpoint(peer.Log.Named("accounting:endpoint"), peer.DB.Accounting())
+		pb.RegisterAccountingServer(peer.Public.Server.GRPC(), peer.Accounting.Endpoint)
 	}
 
 	{ // setup console
@@ -352,6 +505,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			peer.DB.Console(),
 			config.PasswordCost,
+			config.DeletionGracePeriod,
+			config.Session,
 		)
 
 		if err != nil {
@@ -364,9 +519,66 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 			peer.Console.Listener)
 	}
 
+	{ // setup console purge
+		peer.Console.Purge = consolepurge.New(peer.Log.Named("console:purge"),
+			config.ConsolePurge,
+			peer.DB.Console(),
+			peer.Metainfo.Service,
+			peer.Overlay.Service,
+			peer.Transport,
+			peer.Identity,
+		)
+	}
+
+	if config.Payments.Provider != "" { // setup payments
+		config := config.Payments
+
+		var provider payments.Provider
+		switch config.Provider {
+		case "stripe":
+			// anyone could mark invoices as paid with unsigned webhook requests
+			if config.StripeSecretKey == "" || config.StripeWebhookSecret == "" {
+				return nil, errs.Combine(errs.New("stripe payments require a secret key and a webhook secret"), peer.Close())
+			}
+			provider = stripepayments.New(config.StripeSecretKey, config.StripeWebhookSecret)
+		default:
+			return nil, errs.Combine(errs.New("unknown payment provider %q", config.Provider), peer.Close())
+		}
+
+		peer.SetupPayments(config, provider)
+	}
+
+	if config.Relay.Address != "" { // setup relay for storage nodes behind firewalls
+		config := config.Relay
+
+		peer.Relay.Listener, err = net.Listen("tcp", config.Address)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Relay.Server, err = relay.NewServer(peer.Log.Named("relay"), peer.Identity, peer.Relay.Listener, config)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	return peer, nil
 }
 
+// SetupPayments bills the usage of the projects through provider, it has to
+// be called before the peer runs
+func (peer *Peer) SetupPayments(config payments.Config, provider payments.Provider) {
+	peer.Payments.Provider = provider
+	peer.Payments.Service = payments.NewService(peer.Log.Named("payments"),
+		config,
+		peer.Payments.Provider,
+		peer.DB.Payments(),
+		peer.DB.Console(),
+		peer.DB.Accounting(),
+	)
+	peer.Console.Endpoint.Handle("/api/payments/webhook", peer.Payments.Service)
+}
+
 func ignoreCancel(err error) error {
 	if err == context.Canceled || err == grpc.ErrServerStopped || err == http.ErrServerClosed {
 		return nil
@@ -387,6 +599,12 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return ignoreCancel(peer.Discovery.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Broadcast.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Metainfo.Loop.Run(ctx))
+	})
 	group.Go(func() error {
 		return ignoreCancel(peer.Repair.Checker.Run(ctx))
 	})
@@ -402,6 +620,12 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return ignoreCancel(peer.Audit.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.GarbageCollection.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Lifecycle.Service.Run(ctx))
+	})
 	group.Go(func() error {
 		// TODO: move the message into Server instead
 		peer.Log.Sugar().Infof("Node %s started on %s", peer.Identity.ID, peer.Public.Server.Addr().String())
@@ -410,6 +634,19 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return ignoreCancel(peer.Console.Endpoint.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Console.Purge.Run(ctx))
+	})
+	if peer.Payments.Service != nil {
+		group.Go(func() error {
+			return ignoreCancel(peer.Payments.Service.Run(ctx))
+		})
+	}
+	if peer.Relay.Server != nil {
+		group.Go(func() error {
+			return ignoreCancel(peer.Relay.Server.Run(ctx))
+		})
+	}
 
 	return group.Wait()
 }
@@ -430,6 +667,12 @@ func (peer *Peer) Close() error {
 		}
 	}
 
+	if peer.Relay.Server != nil {
+		errlist.Add(peer.Relay.Server.Close())
+	} else if peer.Relay.Listener != nil {
+		errlist.Add(peer.Relay.Listener.Close())
+	}
+
 	if peer.Console.Endpoint != nil {
 		errlist.Add(peer.Console.Endpoint.Close())
 	} else {
@@ -439,6 +682,30 @@ func (peer *Peer) Close() error {
 	}
 
 	// close services in reverse initialization order
+	if peer.Payments.Service != nil {
+		errlist.Add(peer.Payments.Service.Close())
+	}
+	if peer.Console.Purge != nil {
+		errlist.Add(peer.Console.Purge.Close())
+	}
+	if peer.Accounting.Endpoint != nil {
+		errlist.Add(peer.Accounting.Endpoint.Close())
+	}
+	if peer.Accounting.Rollup != nil {
+		errlist.Add(peer.Accounting.Rollup.Close())
+	}
+	if peer.Accounting.Tally != nil {
+		errlist.Add(peer.Accounting.Tally.Close())
+	}
+	if peer.Lifecycle.Service != nil {
+		errlist.Add(peer.Lifecycle.Service.Close())
+	}
+	if peer.GarbageCollection.Service != nil {
+		errlist.Add(peer.GarbageCollection.Service.Close())
+	}
+	if peer.Audit.Service != nil {
+		errlist.Add(peer.Audit.Service.Close())
+	}
 	if peer.Repair.Repairer != nil {
 		errlist.Add(peer.Repair.Repairer.Close())
 	}
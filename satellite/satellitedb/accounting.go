@@ -7,11 +7,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
-	"storj.io/storj/pkg/utils"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
 
@@ -22,25 +23,21 @@ type accountingDB struct {
 
 // LastTimestamp records the greatest last tallied time
 func (db *accountingDB) LastTimestamp(ctx context.Context, timestampType string) (last time.Time, err error) {
-	// todo: use WithTx https://github.com/spacemonkeygo/dbx#transactions
-	tx, err := db.db.Open(ctx)
-	if err != nil {
-		return last, Error.Wrap(err)
-	}
-	defer func() {
-		if err == nil {
-			err = tx.Commit()
-		} else {
-			err = errs.Combine(err, tx.Rollback())
+	err = withTx(ctx, db.db, func(tx *dbx.Tx) error {
+		lastTally, err := tx.Find_AccountingTimestamps_Value_By_Name(ctx, dbx.AccountingTimestamps_Name(timestampType))
+		if err != nil {
+			return err
 		}
-	}()
-	lastTally, err := tx.Find_AccountingTimestamps_Value_By_Name(ctx, dbx.AccountingTimestamps_Name(timestampType))
-	if lastTally == nil {
-		update := dbx.AccountingTimestamps_Value(time.Time{})
-		_, err = tx.Create_AccountingTimestamps(ctx, dbx.AccountingTimestamps_Name(timestampType), update)
-		return time.Time{}, err
-	}
-	return lastTally.Value, err
+		if lastTally == nil {
+			last = time.Time{}
+			update := dbx.AccountingTimestamps_Value(time.Time{})
+			_, err = tx.Create_AccountingTimestamps(ctx, dbx.AccountingTimestamps_Name(timestampType), update)
+			return err
+		}
+		last = lastTally.Value
+		return nil
+	})
+	return last, Error.Wrap(err)
 }
 
 // SaveBWRaw records granular tallies (sums of bw agreement values) to the database and updates the LastTimestamp
@@ -51,34 +48,25 @@ func (db *accountingDB) SaveBWRaw(ctx context.Context, tallyEnd time.Time, bwTot
 		return Error.New("In SaveBWRaw with empty bwtotals")
 	}
 	//insert all records in a transaction so if we fail, we don't have partial info stored
-	tx, err := db.db.Open(ctx)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-	defer func() {
-		if err == nil {
-			err = tx.Commit()
-		} else {
-			err = utils.CombineErrors(err, tx.Rollback())
-		}
-	}()
-	//create a granular record per node id
-	for nodeID, totals := range bwTotals {
-		for actionType, total := range totals {
-			nID := dbx.AccountingRaw_NodeId(nodeID.Bytes())
-			end := dbx.AccountingRaw_IntervalEndTime(tallyEnd)
-			total := dbx.AccountingRaw_DataTotal(float64(total))
-			dataType := dbx.AccountingRaw_DataType(actionType)
-			_, err = tx.Create_AccountingRaw(ctx, nID, end, total, dataType)
-			if err != nil {
-				return Error.Wrap(err)
+	return Error.Wrap(withTx(ctx, db.db, func(tx *dbx.Tx) error {
+		//create a granular record per node id
+		for nodeID, totals := range bwTotals {
+			for actionType, total := range totals {
+				nID := dbx.AccountingRaw_NodeId(nodeID.Bytes())
+				end := dbx.AccountingRaw_IntervalEndTime(tallyEnd)
+				total := dbx.AccountingRaw_DataTotal(float64(total))
+				dataType := dbx.AccountingRaw_DataType(actionType)
+				_, err := tx.Create_AccountingRaw(ctx, nID, end, total, dataType)
+				if err != nil {
+					return err
+				}
 			}
 		}
-	}
-	//save this batch's greatest time
-	update := dbx.AccountingTimestamps_Update_Fields{Value: dbx.AccountingTimestamps_Value(tallyEnd)}
-	_, err = tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastBandwidthTally), update)
-	return err
+		//save this batch's greatest time
+		update := dbx.AccountingTimestamps_Update_Fields{Value: dbx.AccountingTimestamps_Value(tallyEnd)}
+		_, err := tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastBandwidthTally), update)
+		return err
+	}))
 }
 
 // SaveAtRestRaw records raw tallies of at rest data to the database
@@ -86,30 +74,166 @@ func (db *accountingDB) SaveAtRestRaw(ctx context.Context, latestTally time.Time
 	if len(nodeData) == 0 {
 		return Error.New("In SaveAtRestRaw with empty nodeData")
 	}
-	tx, err := db.db.Open(ctx)
-	if err != nil {
-		return Error.Wrap(err)
+	return Error.Wrap(withTx(ctx, db.db, func(tx *dbx.Tx) error {
+		return saveAtRestRaw(ctx, tx, latestTally, nodeData)
+	}))
+}
+
+// SaveAtRestTallies records the raw tallies of each node and the tallies of each project and
+// partner, the last at-rest tally timestamp is only moved when all of them are saved
+func (db *accountingDB) SaveAtRestTallies(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64, projectData map[uuid.UUID]float64, partnerData map[string]float64) error {
+	if len(nodeData) == 0 {
+		return Error.New("In SaveAtRestTallies with empty nodeData")
 	}
-	defer func() {
-		if err == nil {
-			err = tx.Commit()
-		} else {
-			err = utils.CombineErrors(err, tx.Rollback())
+	return Error.Wrap(withTx(ctx, db.db, func(tx *dbx.Tx) error {
+		if err := saveProjectStorageTally(ctx, tx, latestTally, projectData); err != nil {
+			return err
 		}
-	}()
+		if err := savePartnerStorageTally(ctx, tx, latestTally, partnerData); err != nil {
+			return err
+		}
+		return saveAtRestRaw(ctx, tx, latestTally, nodeData)
+	}))
+}
+
+// saveAtRestRaw records raw tallies of at-rest-data and updates the last at-rest tally timestamp in tx
+func saveAtRestRaw(ctx context.Context, tx *dbx.Tx, latestTally time.Time, nodeData map[storj.NodeID]float64) error {
 	for k, v := range nodeData {
 		nID := dbx.AccountingRaw_NodeId(k.Bytes())
 		end := dbx.AccountingRaw_IntervalEndTime(latestTally)
 		total := dbx.AccountingRaw_DataTotal(v)
 		dataType := dbx.AccountingRaw_DataType(accounting.AtRest)
-		_, err = tx.Create_AccountingRaw(ctx, nID, end, total, dataType)
+		_, err := tx.Create_AccountingRaw(ctx, nID, end, total, dataType)
 		if err != nil {
-			return Error.Wrap(err)
+			return err
 		}
 	}
 	update := dbx.AccountingTimestamps_Update_Fields{Value: dbx.AccountingTimestamps_Value(latestTally)}
-	_, err = tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastAtRestTally), update)
-	return Error.Wrap(err)
+	_, err := tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastAtRestTally), update)
+	return err
+}
+
+// SaveProjectStorageTally records the at-rest byte hours of each project
+func (db *accountingDB) SaveProjectStorageTally(ctx context.Context, intervalEnd time.Time, projectData map[uuid.UUID]float64) (err error) {
+	if len(projectData) == 0 {
+		return nil
+	}
+	return Error.Wrap(withTx(ctx, db.db, func(tx *dbx.Tx) error {
+		return saveProjectStorageTally(ctx, tx, intervalEnd, projectData)
+	}))
+}
+
+// saveProjectStorageTally records the at-rest byte hours of each project in tx
+func saveProjectStorageTally(ctx context.Context, tx *dbx.Tx, intervalEnd time.Time, projectData map[uuid.UUID]float64) error {
+	for projectID, total := range projectData {
+		projectID := projectID
+		_, err := tx.Create_ProjectStorageTally(ctx,
+			dbx.ProjectStorageTally_ProjectId(projectID[:]),
+			dbx.ProjectStorageTally_IntervalEndTime(intervalEnd),
+			dbx.ProjectStorageTally_DataTotal(total),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// QueryProjectStorage returns the at-rest byte hours of a project tallied between start (inclusive) and end (exclusive)
+func (db *accountingDB) QueryProjectStorage(ctx context.Context, projectID uuid.UUID, start time.Time, end time.Time) (total float64, err error) {
+	err = db.db.DB.QueryRow(db.db.Rebind(`
+		SELECT COALESCE(SUM(data_total), 0) FROM project_storage_tallies
+		WHERE project_id = ? AND interval_end_time >= ? AND interval_end_time < ?`),
+		projectID[:], start, end,
+	).Scan(&total)
+	return total, Error.Wrap(err)
+}
+
+// QueryProjectStorageTotal returns the at-rest byte hours of all projects tallied since (inclusive)
+func (db *accountingDB) QueryProjectStorageTotal(ctx context.Context, since time.Time) (total float64, err error) {
+	err = db.db.DB.QueryRow(db.db.Rebind(`
+		SELECT COALESCE(SUM(data_total), 0) FROM project_storage_tallies
+		WHERE interval_end_time >= ?`),
+		since,
+	).Scan(&total)
+	return total, Error.Wrap(err)
+}
+
+// SavePartnerStorageTally records the at-rest byte hours of the buckets attributed to each partner
+func (db *accountingDB) SavePartnerStorageTally(ctx context.Context, intervalEnd time.Time, partnerData map[string]float64) (err error) {
+	if len(partnerData) == 0 {
+		return nil
+	}
+	return Error.Wrap(withTx(ctx, db.db, func(tx *dbx.Tx) error {
+		return savePartnerStorageTally(ctx, tx, intervalEnd, partnerData)
+	}))
+}
+
+// savePartnerStorageTally records the at-rest byte hours of the buckets attributed to each partner in tx
+func savePartnerStorageTally(ctx context.Context, tx *dbx.Tx, intervalEnd time.Time, partnerData map[string]float64) error {
+	for partnerID, total := range partnerData {
+		_, err := tx.Create_PartnerStorageTally(ctx,
+			dbx.PartnerStorageTally_PartnerId(partnerID),
+			dbx.PartnerStorageTally_IntervalEndTime(intervalEnd),
+			dbx.PartnerStorageTally_DataTotal(total),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// QueryPartnerStorage returns the at-rest byte hours of each partner tallied between start (inclusive) and end (exclusive)
+func (db *accountingDB) QueryPartnerStorage(ctx context.Context, start time.Time, end time.Time) (_ map[string]float64, err error) {
+	rows, err := db.db.DB.Query(db.db.Rebind(`
+		SELECT partner_id, SUM(data_total) FROM partner_storage_tallies
+		WHERE interval_end_time >= ? AND interval_end_time < ?
+		GROUP BY partner_id`),
+		start, end,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	partnerData := make(map[string]float64)
+	for rows.Next() {
+		var partnerID string
+		var total float64
+		if err := rows.Scan(&partnerID, &total); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		partnerData[partnerID] = total
+	}
+	return partnerData, Error.Wrap(rows.Err())
+}
+
+// QueryPartnerEgress returns the downloaded bytes of each partner agreed between start (inclusive) and end (exclusive),
+// the serial numbers of the bandwidth agreements are the serial numbers of the allocations followed by the storage node id
+func (db *accountingDB) QueryPartnerEgress(ctx context.Context, start time.Time, end time.Time) (_ map[string]int64, err error) {
+	rows, err := db.db.DB.Query(db.db.Rebind(`
+		SELECT partner_serials.partner_id, SUM(bwagreements.total) FROM bwagreements
+		JOIN partner_serials ON bwagreements.serialnum LIKE partner_serials.serial_number || '%'
+		WHERE bwagreements.action = ? AND bwagreements.created_at >= ? AND bwagreements.created_at < ?
+		GROUP BY partner_serials.partner_id`),
+		int64(pb.BandwidthAction_GET), start, end,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	partnerData := make(map[string]int64)
+	for rows.Next() {
+		var partnerID string
+		var total int64
+		if err := rows.Scan(&partnerID, &total); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		partnerData[partnerID] = total
+	}
+	return partnerData, Error.Wrap(rows.Err())
 }
 
 // GetRaw retrieves all raw tallies
@@ -159,36 +283,27 @@ func (db *accountingDB) SaveRollup(ctx context.Context, latestRollup time.Time,
 	if len(stats) == 0 {
 		return Error.New("In SaveRollup with empty nodeData")
 	}
-	tx, err := db.db.Open(ctx)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-	defer func() {
-		if err == nil {
-			err = tx.Commit()
-		} else {
-			err = utils.CombineErrors(err, tx.Rollback())
-		}
-	}()
-	for _, arsByDate := range stats {
-		for _, ar := range arsByDate {
-			nID := dbx.AccountingRollup_NodeId(ar.NodeID.Bytes())
-			start := dbx.AccountingRollup_StartTime(ar.StartTime)
-			put := dbx.AccountingRollup_PutTotal(ar.PutTotal)
-			get := dbx.AccountingRollup_GetTotal(ar.GetTotal)
-			audit := dbx.AccountingRollup_GetAuditTotal(ar.GetAuditTotal)
-			getRepair := dbx.AccountingRollup_GetRepairTotal(ar.GetRepairTotal)
-			putRepair := dbx.AccountingRollup_PutRepairTotal(ar.PutRepairTotal)
-			atRest := dbx.AccountingRollup_AtRestTotal(ar.AtRestTotal)
-			_, err = tx.Create_AccountingRollup(ctx, nID, start, put, get, audit, getRepair, putRepair, atRest)
-			if err != nil {
-				return Error.Wrap(err)
+	return Error.Wrap(withTx(ctx, db.db, func(tx *dbx.Tx) error {
+		for _, arsByDate := range stats {
+			for _, ar := range arsByDate {
+				nID := dbx.AccountingRollup_NodeId(ar.NodeID.Bytes())
+				start := dbx.AccountingRollup_StartTime(ar.StartTime)
+				put := dbx.AccountingRollup_PutTotal(ar.PutTotal)
+				get := dbx.AccountingRollup_GetTotal(ar.GetTotal)
+				audit := dbx.AccountingRollup_GetAuditTotal(ar.GetAuditTotal)
+				getRepair := dbx.AccountingRollup_GetRepairTotal(ar.GetRepairTotal)
+				putRepair := dbx.AccountingRollup_PutRepairTotal(ar.PutRepairTotal)
+				atRest := dbx.AccountingRollup_AtRestTotal(ar.AtRestTotal)
+				_, err := tx.Create_AccountingRollup(ctx, nID, start, put, get, audit, getRepair, putRepair, atRest)
+				if err != nil {
+					return err
+				}
 			}
 		}
-	}
-	update := dbx.AccountingTimestamps_Update_Fields{Value: dbx.AccountingTimestamps_Value(latestRollup)}
-	_, err = tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastRollup), update)
-	return Error.Wrap(err)
+		update := dbx.AccountingTimestamps_Update_Fields{Value: dbx.AccountingTimestamps_Value(latestRollup)}
+		_, err := tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastRollup), update)
+		return err
+	}))
 }
 
 // QueryPaymentInfo queries StatDB, Accounting Rollup on nodeID
@@ -221,3 +336,108 @@ func (db *accountingDB) QueryPaymentInfo(ctx context.Context, start time.Time, e
 	}
 	return rows, nil
 }
+
+// QueryNodeRollups returns the rollups of a node starting between start (inclusive) and end (exclusive)
+func (db *accountingDB) QueryNodeRollups(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) ([]*accounting.Rollup, error) {
+	rollups, err := db.db.All_AccountingRollup_By_NodeId_And_StartTime_GreaterOrEqual_And_StartTime_Less_OrderBy_Asc_StartTime(ctx,
+		dbx.AccountingRollup_NodeId(nodeID.Bytes()),
+		dbx.AccountingRollup_StartTime(start),
+		dbx.AccountingRollup_StartTime(end),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	out := make([]*accounting.Rollup, len(rollups))
+	for i, r := range rollups {
+		out[i] = &accounting.Rollup{
+			ID:             r.Id,
+			NodeID:         nodeID,
+			StartTime:      r.StartTime,
+			PutTotal:       r.PutTotal,
+			GetTotal:       r.GetTotal,
+			GetAuditTotal:  r.GetAuditTotal,
+			GetRepairTotal: r.GetRepairTotal,
+			PutRepairTotal: r.PutRepairTotal,
+			AtRestTotal:    r.AtRestTotal,
+		}
+	}
+	return out, nil
+}
+
+// QueryRawTotals returns the raw tallies since (inclusive) summed by interval end time and data type, ordered by interval end time
+func (db *accountingDB) QueryRawTotals(ctx context.Context, since time.Time) (totals []*accounting.RawTotal, err error) {
+	rows, err := db.db.DB.Query(db.db.Rebind(`
+		SELECT interval_end_time, data_type, SUM(data_total) FROM accounting_raws
+		WHERE interval_end_time >= ?
+		GROUP BY interval_end_time, data_type
+		ORDER BY interval_end_time, data_type`),
+		since,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		total := &accounting.RawTotal{}
+		if err := rows.Scan(&total.IntervalEndTime, &total.DataType, &total.DataTotal); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		totals = append(totals, total)
+	}
+	return totals, Error.Wrap(rows.Err())
+}
+
+// QueryRollupTotals returns the sums of the rollups starting between start (inclusive) and end (exclusive)
+func (db *accountingDB) QueryRollupTotals(ctx context.Context, start time.Time, end time.Time) (*accounting.Rollup, error) {
+	totals := &accounting.Rollup{StartTime: start}
+	err := db.db.DB.QueryRow(db.db.Rebind(`
+		SELECT COALESCE(SUM(put_total), 0), COALESCE(SUM(get_total), 0), COALESCE(SUM(get_audit_total), 0),
+			COALESCE(SUM(get_repair_total), 0), COALESCE(SUM(put_repair_total), 0), COALESCE(SUM(at_rest_total), 0)
+		FROM accounting_rollups
+		WHERE start_time >= ? AND start_time < ?`),
+		start, end,
+	).Scan(&totals.PutTotal, &totals.GetTotal, &totals.GetAuditTotal, &totals.GetRepairTotal, &totals.PutRepairTotal, &totals.AtRestTotal)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return totals, nil
+}
+
+// SaveViolation records a broken invariant
+func (db *accountingDB) SaveViolation(ctx context.Context, violation *accounting.Violation) error {
+	_, err := db.db.Create_AccountingViolation(ctx,
+		dbx.AccountingViolation_Name(violation.Name),
+		dbx.AccountingViolation_IntervalStart(violation.IntervalStart),
+		dbx.AccountingViolation_IntervalEnd(violation.IntervalEnd),
+		dbx.AccountingViolation_Expected(violation.Expected),
+		dbx.AccountingViolation_Actual(violation.Actual),
+		dbx.AccountingViolation_Details(violation.Details),
+	)
+	return Error.Wrap(err)
+}
+
+// QueryViolations returns the broken invariants recorded since (inclusive), ordered by creation time
+func (db *accountingDB) QueryViolations(ctx context.Context, since time.Time) (violations []*accounting.Violation, err error) {
+	rows, err := db.db.DB.Query(db.db.Rebind(`
+		SELECT id, name, interval_start, interval_end, expected, actual, details, created_at FROM accounting_violations
+		WHERE created_at >= ?
+		ORDER BY created_at, id`),
+		since,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		violation := &accounting.Violation{}
+		err := rows.Scan(&violation.ID, &violation.Name, &violation.IntervalStart, &violation.IntervalEnd,
+			&violation.Expected, &violation.Actual, &violation.Details, &violation.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		violations = append(violations, violation)
+	}
+	return violations, Error.Wrap(rows.Err())
+}
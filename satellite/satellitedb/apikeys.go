@@ -55,6 +55,21 @@ func (keys *apikeys) Get(ctx context.Context, id uuid.UUID) (*console.APIKeyInfo
 	return fromDBXAPIKey(dbKey)
 }
 
+// GetWithKey implements satellite.APIKeys
+func (keys *apikeys) GetWithKey(ctx context.Context, id uuid.UUID) (*console.APIKeyInfo, *console.APIKey, error) {
+	dbKey, err := keys.db.Get_ApiKey_By_Id(ctx, dbx.ApiKey_Id(id[:]))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, err := fromDBXAPIKey(dbKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return info, console.APIKeyFromBytes(dbKey.Key), nil
+}
+
 // GetByKey implements satellite.APIKeys
 func (keys *apikeys) GetByKey(ctx context.Context, key console.APIKey) (*console.APIKeyInfo, error) {
 	dbKey, err := keys.db.Get_ApiKey_By_Key(ctx, dbx.ApiKey_Key(key[:]))
@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/storj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// auditLog implements audit.LogDB
+type auditLog struct {
+	db *dbx.DB
+}
+
+// Record stores the records of an audit
+func (db *auditLog) Record(ctx context.Context, records []audit.Record) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withTx(ctx, db.db, func(tx *dbx.Tx) error {
+		for _, record := range records {
+			_, err := tx.Create_AuditRecord(ctx,
+				dbx.AuditRecord_NodeId(record.NodeID.Bytes()),
+				dbx.AuditRecord_AuditedAt(record.AuditedAt.UTC()),
+				dbx.AuditRecord_SegmentHash(record.SegmentHash),
+				dbx.AuditRecord_StripeIndex(record.StripeIndex),
+				dbx.AuditRecord_PieceNum(int(record.PieceNum)),
+				dbx.AuditRecord_Outcome(string(record.Outcome)),
+				dbx.AuditRecord_Error(record.Error),
+				dbx.AuditRecord_DurationMs(int64(record.Duration/time.Millisecond)),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return Error.Wrap(err)
+}
+
+// ListByNode returns the most recent records of a node since the given time
+func (db *auditLog) ListByNode(ctx context.Context, nodeID storj.NodeID, since time.Time, limit int) (_ []audit.Record, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Limited_AuditRecord_By_NodeId_And_AuditedAt_GreaterOrEqual_OrderBy_Desc_AuditedAt(ctx,
+		dbx.AuditRecord_NodeId(nodeID.Bytes()),
+		dbx.AuditRecord_AuditedAt(since.UTC()),
+		limit, 0)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	records := make([]audit.Record, 0, len(rows))
+	for _, row := range rows {
+		id, err := storj.NodeIDFromBytes(row.NodeId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		records = append(records, audit.Record{
+			NodeID:      id,
+			SegmentHash: row.SegmentHash,
+			StripeIndex: row.StripeIndex,
+			PieceNum:    int32(row.PieceNum),
+			Outcome:     audit.Outcome(row.Outcome),
+			Error:       row.Error,
+			Duration:    time.Duration(row.DurationMs) * time.Millisecond,
+			AuditedAt:   row.AuditedAt,
+		})
+	}
+	return records, nil
+}
+
+// DeleteBefore deletes the records of audits before the given time
+func (db *auditLog) DeleteBefore(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := db.db.Delete_AuditRecord_By_AuditedAt_Less(ctx, dbx.AuditRecord_AuditedAt(before.UTC()))
+	return deleted, Error.Wrap(err)
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/utils"
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// implementation of AuthEvents interface repository using spacemonkeygo/dbx orm
+type authEvents struct {
+	db dbx.Methods
+}
+
+// GetByUserID is a method for querying the most recent events of a user.
+func (events *authEvents) GetByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]console.AuthEvent, error) {
+	eventsDbx, err := events.db.Limited_AuthEvent_By_UserId_OrderBy_Desc_CreatedAt(ctx, dbx.AuthEvent_UserId(userID[:]), limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []console.AuthEvent
+	var errors []error
+
+	for _, eventDbx := range eventsDbx {
+		event, err := authEventFromDBX(eventDbx)
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+
+		result = append(result, *event)
+	}
+
+	return result, utils.CombineErrors(errors...)
+}
+
+// Insert is a method for recording an event.
+func (events *authEvents) Insert(ctx context.Context, event *console.AuthEvent) error {
+	_, err := events.db.Create_AuthEvent(ctx,
+		dbx.AuthEvent_UserId(event.UserID[:]),
+		dbx.AuthEvent_SessionId(event.SessionID[:]),
+		dbx.AuthEvent_Kind(string(event.Kind)),
+		dbx.AuthEvent_Device(event.Device),
+		dbx.AuthEvent_Address(event.Address),
+	)
+
+	return err
+}
+
+// authEventFromDBX is used for creating AuthEvent entity from autogenerated dbx.AuthEvent struct
+func authEventFromDBX(event *dbx.AuthEvent) (*console.AuthEvent, error) {
+	if event == nil {
+		return nil, errs.New("auth event parameter is nil")
+	}
+
+	userID, err := bytesToUUID(event.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := bytesToUUID(event.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.AuthEvent{
+		ID:        event.Id,
+		UserID:    userID,
+		SessionID: sessionID,
+		Kind:      console.AuthEventKind(event.Kind),
+		Device:    event.Device,
+		Address:   event.Address,
+		CreatedAt: event.CreatedAt,
+	}, nil
+}
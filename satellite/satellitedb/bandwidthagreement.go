@@ -22,16 +22,18 @@ type bandwidthagreement struct {
 
 func (b *bandwidthagreement) CreateAgreement(ctx context.Context, rba *pb.RenterBandwidthAllocation) (err error) {
 	expiration := time.Unix(rba.PayerAllocation.ExpirationUnixSec, 0)
-	_, err = b.db.Create_Bwagreement(
-		ctx,
-		dbx.Bwagreement_Serialnum(rba.PayerAllocation.SerialNumber+rba.StorageNodeId.String()),
-		dbx.Bwagreement_StorageNodeId(rba.StorageNodeId.Bytes()),
-		dbx.Bwagreement_UplinkId(rba.PayerAllocation.UplinkId.Bytes()),
-		dbx.Bwagreement_Action(int64(rba.PayerAllocation.Action)),
-		dbx.Bwagreement_Total(rba.Total),
-		dbx.Bwagreement_ExpiresAt(expiration),
-	)
-	return err
+	return withRetry(ctx, func(ctx context.Context) error {
+		_, err := b.db.Create_Bwagreement(
+			ctx,
+			dbx.Bwagreement_Serialnum(rba.PayerAllocation.SerialNumber+rba.StorageNodeId.String()),
+			dbx.Bwagreement_StorageNodeId(rba.StorageNodeId.Bytes()),
+			dbx.Bwagreement_UplinkId(rba.PayerAllocation.UplinkId.Bytes()),
+			dbx.Bwagreement_Action(int64(rba.PayerAllocation.Action)),
+			dbx.Bwagreement_Total(rba.Total),
+			dbx.Bwagreement_ExpiresAt(expiration),
+		)
+		return err
+	})
 }
 
 //GetTotals returns stats about an uplink
@@ -39,10 +41,14 @@ func (b *bandwidthagreement) GetUplinkStats(ctx context.Context, from, to time.T
 
 	var uplinkSQL = fmt.Sprintf(`SELECT uplink_id, SUM(total), 
 		COUNT(CASE WHEN action = %d THEN total ELSE null END), 
+		COUNT(CASE WHEN action = %d THEN total ELSE null END),
+		COUNT(CASE WHEN action = %d THEN total ELSE null END),
+		COUNT(CASE WHEN action = %d THEN total ELSE null END),
 		COUNT(CASE WHEN action = %d THEN total ELSE null END), COUNT(*)
 		FROM bwagreements WHERE created_at > ? 
 		AND created_at <= ? GROUP BY uplink_id ORDER BY uplink_id`,
-		pb.BandwidthAction_PUT, pb.BandwidthAction_GET)
+		pb.BandwidthAction_PUT, pb.BandwidthAction_GET, pb.BandwidthAction_GET_AUDIT,
+		pb.BandwidthAction_GET_REPAIR, pb.BandwidthAction_PUT_REPAIR)
 	rows, err := b.db.DB.Query(b.db.Rebind(uplinkSQL), from.UTC(), to.UTC())
 	if err != nil {
 		return nil, err
@@ -51,7 +57,8 @@ func (b *bandwidthagreement) GetUplinkStats(ctx context.Context, from, to time.T
 	for rows.Next() {
 		var nodeID []byte
 		stat := bwagreement.UplinkStat{}
-		err := rows.Scan(&nodeID, &stat.TotalBytes, &stat.PutActionCount, &stat.GetActionCount, &stat.TotalTransactions)
+		err := rows.Scan(&nodeID, &stat.TotalBytes, &stat.PutActionCount, &stat.GetActionCount,
+			&stat.GetAuditActionCount, &stat.GetRepairActionCount, &stat.PutRepairActionCount, &stat.TotalTransactions)
 		if err != nil {
 			return stats, err
 		}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pointerdb"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// bucketAttributions implements pointerdb.BucketAttributions
+type bucketAttributions struct {
+	methods dbx.Methods
+	db      *dbx.DB
+}
+
+// Get returns the attribution of a bucket, sql.ErrNoRows when it has none
+func (attributions *bucketAttributions) Get(ctx context.Context, projectID uuid.UUID, bucket string) (_ *pointerdb.BucketAttribution, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	attribution, err := attributions.methods.Get_BucketAttribution_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketAttribution_ProjectId(projectID[:]),
+		dbx.BucketAttribution_BucketName([]byte(bucket)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucketAttributionFromDBX(attribution)
+}
+
+// Create attributes a bucket to a partner
+func (attributions *bucketAttributions) Create(ctx context.Context, attribution *pointerdb.BucketAttribution) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = attributions.methods.Create_BucketAttribution(ctx,
+		dbx.BucketAttribution_ProjectId(attribution.ProjectID[:]),
+		dbx.BucketAttribution_BucketName([]byte(attribution.BucketName)),
+		dbx.BucketAttribution_PartnerId(attribution.PartnerID),
+	)
+	return err
+}
+
+// CreateSerial records the serial number of a download allocation of a bucket attributed to a partner
+func (attributions *bucketAttributions) CreateSerial(ctx context.Context, serialNumber string, partnerID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = attributions.methods.Create_PartnerSerial(ctx,
+		dbx.PartnerSerial_SerialNumber(serialNumber),
+		dbx.PartnerSerial_PartnerId(partnerID),
+	)
+	return err
+}
+
+// GetAll returns the attributions of all buckets
+func (attributions *bucketAttributions) GetAll(ctx context.Context) (result []pointerdb.BucketAttribution, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := attributions.db.DB.Query(`SELECT project_id, bucket_name, partner_id, created_at FROM bucket_attributions`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		row := &dbx.BucketAttribution{}
+		err = rows.Scan(&row.ProjectId, &row.BucketName, &row.PartnerId, &row.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		attribution, err := bucketAttributionFromDBX(row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *attribution)
+	}
+	return result, rows.Err()
+}
+
+// bucketAttributionFromDBX is used for creating BucketAttribution entity from autogenerated dbx.BucketAttribution struct
+func bucketAttributionFromDBX(attribution *dbx.BucketAttribution) (*pointerdb.BucketAttribution, error) {
+	if attribution == nil {
+		return nil, errs.New("bucket attribution parameter is nil")
+	}
+
+	projectID, err := bytesToUUID(attribution.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pointerdb.BucketAttribution{
+		ProjectID:  projectID,
+		BucketName: string(attribution.BucketName),
+		PartnerID:  attribution.PartnerId,
+		CreatedAt:  attribution.CreatedAt,
+	}, nil
+}
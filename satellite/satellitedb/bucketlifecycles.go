@@ -0,0 +1,122 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pointerdb"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// bucketLifecycles implements pointerdb.BucketLifecycles
+type bucketLifecycles struct {
+	methods dbx.Methods
+	db      *dbx.DB
+}
+
+// Get returns the lifecycle of a bucket, sql.ErrNoRows when it has none
+func (lifecycles *bucketLifecycles) Get(ctx context.Context, projectID uuid.UUID, bucket string) (_ *pointerdb.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lifecycle, err := lifecycles.methods.Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycle_ProjectId(projectID[:]),
+		dbx.BucketLifecycle_BucketName([]byte(bucket)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucketLifecycleFromDBX(lifecycle)
+}
+
+// Set creates or replaces the lifecycle of a bucket
+func (lifecycles *bucketLifecycles) Set(ctx context.Context, lifecycle *pointerdb.BucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := lifecycles.methods.Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycle_ProjectId(lifecycle.ProjectID[:]),
+		dbx.BucketLifecycle_BucketName([]byte(lifecycle.BucketName)),
+		dbx.BucketLifecycle_Update_Fields{
+			ExpireAfterDays:         dbx.BucketLifecycle_ExpireAfterDays(lifecycle.ExpireAfterDays),
+			VersionsPrefix:          dbx.BucketLifecycle_VersionsPrefix([]byte(lifecycle.VersionsPrefix)),
+			VersionsExpireAfterDays: dbx.BucketLifecycle_VersionsExpireAfterDays(lifecycle.VersionsExpireAfterDays),
+			UpdatedAt:               dbx.BucketLifecycle_UpdatedAt(time.Now()),
+		})
+	if err != nil || updated != nil {
+		return err
+	}
+
+	_, err = lifecycles.methods.Create_BucketLifecycle(ctx,
+		dbx.BucketLifecycle_ProjectId(lifecycle.ProjectID[:]),
+		dbx.BucketLifecycle_BucketName([]byte(lifecycle.BucketName)),
+		dbx.BucketLifecycle_ExpireAfterDays(lifecycle.ExpireAfterDays),
+		dbx.BucketLifecycle_VersionsPrefix([]byte(lifecycle.VersionsPrefix)),
+		dbx.BucketLifecycle_VersionsExpireAfterDays(lifecycle.VersionsExpireAfterDays),
+		dbx.BucketLifecycle_UpdatedAt(time.Now()),
+	)
+	return err
+}
+
+// Delete removes the lifecycle of a bucket
+func (lifecycles *bucketLifecycles) Delete(ctx context.Context, projectID uuid.UUID, bucket string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = lifecycles.methods.Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycle_ProjectId(projectID[:]),
+		dbx.BucketLifecycle_BucketName([]byte(bucket)),
+	)
+	return err
+}
+
+// GetAll returns the lifecycles of all buckets
+func (lifecycles *bucketLifecycles) GetAll(ctx context.Context) (result []pointerdb.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := lifecycles.db.DB.Query(`SELECT project_id, bucket_name, expire_after_days, versions_prefix, versions_expire_after_days, updated_at FROM bucket_lifecycles`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		row := &dbx.BucketLifecycle{}
+		err = rows.Scan(&row.ProjectId, &row.BucketName, &row.ExpireAfterDays, &row.VersionsPrefix, &row.VersionsExpireAfterDays, &row.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		lifecycle, err := bucketLifecycleFromDBX(row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *lifecycle)
+	}
+	return result, rows.Err()
+}
+
+// bucketLifecycleFromDBX is used for creating BucketLifecycle entity from autogenerated dbx.BucketLifecycle struct
+func bucketLifecycleFromDBX(lifecycle *dbx.BucketLifecycle) (*pointerdb.BucketLifecycle, error) {
+	if lifecycle == nil {
+		return nil, errs.New("bucket lifecycle parameter is nil")
+	}
+
+	projectID, err := bytesToUUID(lifecycle.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pointerdb.BucketLifecycle{
+		ProjectID:               projectID,
+		BucketName:              string(lifecycle.BucketName),
+		ExpireAfterDays:         lifecycle.ExpireAfterDays,
+		VersionsPrefix:          string(lifecycle.VersionsPrefix),
+		VersionsExpireAfterDays: lifecycle.VersionsExpireAfterDays,
+		UpdatedAt:               lifecycle.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// implementation of BucketStats interface repository using spacemonkeygo/dbx orm,
+// the counters are changed with raw queries so concurrent commits don't overwrite each other
+type bucketStats struct {
+	methods dbx.Methods
+	db      *dbx.DB
+}
+
+// Add is a method for atomically adding deltas to the counters of a bucket.
+func (stats *bucketStats) Add(ctx context.Context, projectID uuid.UUID, bucketName string, objects, bytes int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	added, err := stats.add(projectID, bucketName, objects, bytes)
+	if err != nil || added {
+		return err
+	}
+
+	_, err = stats.methods.Create_BucketStat(ctx,
+		dbx.BucketStat_ProjectId(projectID[:]),
+		dbx.BucketStat_BucketName([]byte(bucketName)),
+		dbx.BucketStat_ObjectCount(objects),
+		dbx.BucketStat_TotalBytes(bytes),
+		dbx.BucketStat_UpdatedAt(time.Now()),
+	)
+	if err != nil {
+		// the counters were created by a concurrent commit in the meantime
+		added, addErr := stats.add(projectID, bucketName, objects, bytes)
+		if addErr != nil || !added {
+			return errs.Combine(err, addErr)
+		}
+	}
+	return nil
+}
+
+// add adds deltas to the counters of a bucket, added is false when the bucket has no counters yet
+func (stats *bucketStats) add(projectID uuid.UUID, bucketName string, objects, bytes int64) (added bool, err error) {
+	result, err := stats.db.DB.Exec(stats.db.Rebind(`
+		UPDATE bucket_stats
+		SET object_count = object_count + ?, total_bytes = total_bytes + ?, updated_at = ?
+		WHERE project_id = ? AND bucket_name = ?`),
+		objects, bytes, time.Now(), projectID[:], []byte(bucketName))
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// Get is a method for querying the counters of a bucket, zero counters are returned for unknown buckets.
+func (stats *bucketStats) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *console.BucketStat, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stat, err := stats.methods.Get_BucketStat_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketStat_ProjectId(projectID[:]),
+		dbx.BucketStat_BucketName([]byte(bucketName)),
+	)
+	if err == sql.ErrNoRows {
+		return &console.BucketStat{ProjectID: projectID, BucketName: bucketName}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return bucketStatFromDBX(stat)
+}
+
+// GetByProject is a method for querying the counters of all buckets of a project.
+func (stats *bucketStats) GetByProject(ctx context.Context, projectID uuid.UUID) (_ []console.BucketStat, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := stats.methods.All_BucketStat_By_ProjectId_OrderBy_Asc_BucketName(ctx, dbx.BucketStat_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []console.BucketStat
+	for _, row := range rows {
+		stat, err := bucketStatFromDBX(row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *stat)
+	}
+	return result, nil
+}
+
+// GetAll is a method for querying the counters of all buckets.
+func (stats *bucketStats) GetAll(ctx context.Context) (result []console.BucketStat, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := stats.db.DB.Query(`SELECT project_id, bucket_name, object_count, total_bytes, updated_at FROM bucket_stats`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		row := &dbx.BucketStat{}
+		err = rows.Scan(&row.ProjectId, &row.BucketName, &row.ObjectCount, &row.TotalBytes, &row.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		stat, err := bucketStatFromDBX(row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *stat)
+	}
+	return result, rows.Err()
+}
+
+// Reconcile is a method for replacing the counters of a bucket, unless they were
+// changed after unchangedSince. Zero counters remove the bucket.
+func (stats *bucketStats) Reconcile(ctx context.Context, stat console.BucketStat, unchangedSince time.Time) (reconciled bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var result sql.Result
+	if stat.ObjectCount == 0 && stat.TotalBytes == 0 {
+		result, err = stats.db.DB.Exec(stats.db.Rebind(`
+			DELETE FROM bucket_stats
+			WHERE project_id = ? AND bucket_name = ? AND updated_at < ?`),
+			stat.ProjectID[:], []byte(stat.BucketName), unchangedSince)
+	} else {
+		result, err = stats.db.DB.Exec(stats.db.Rebind(`
+			UPDATE bucket_stats
+			SET object_count = ?, total_bytes = ?, updated_at = ?
+			WHERE project_id = ? AND bucket_name = ? AND updated_at < ?`),
+			stat.ObjectCount, stat.TotalBytes, time.Now(), stat.ProjectID[:], []byte(stat.BucketName), unchangedSince)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil || affected > 0 || stat.ObjectCount == 0 && stat.TotalBytes == 0 {
+		return affected > 0, err
+	}
+
+	// the bucket has no counters when its objects were committed before
+	// they were counted, otherwise they changed and are left alone
+	_, err = stats.methods.Get_BucketStat_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketStat_ProjectId(stat.ProjectID[:]),
+		dbx.BucketStat_BucketName([]byte(stat.BucketName)),
+	)
+	if err != sql.ErrNoRows {
+		return false, err
+	}
+
+	_, err = stats.methods.Create_BucketStat(ctx,
+		dbx.BucketStat_ProjectId(stat.ProjectID[:]),
+		dbx.BucketStat_BucketName([]byte(stat.BucketName)),
+		dbx.BucketStat_ObjectCount(stat.ObjectCount),
+		dbx.BucketStat_TotalBytes(stat.TotalBytes),
+		dbx.BucketStat_UpdatedAt(time.Now()),
+	)
+	return err == nil, err
+}
+
+// bucketStatFromDBX is used for creating BucketStat entity from autogenerated dbx.BucketStat struct
+func bucketStatFromDBX(stat *dbx.BucketStat) (*console.BucketStat, error) {
+	if stat == nil {
+		return nil, errs.New("bucket stat parameter is nil")
+	}
+
+	projectID, err := bytesToUUID(stat.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.BucketStat{
+		ProjectID:   projectID,
+		BucketName:  string(stat.BucketName),
+		ObjectCount: stat.ObjectCount,
+		TotalBytes:  stat.TotalBytes,
+		UpdatedAt:   stat.UpdatedAt,
+	}, nil
+}
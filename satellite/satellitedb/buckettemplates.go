@@ -0,0 +1,111 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// implementation of BucketTemplates interface repository using spacemonkeygo/dbx orm
+type bucketTemplates struct {
+	db dbx.Methods
+}
+
+// Get is a method for querying the bucket template of a project.
+func (templates *bucketTemplates) Get(ctx context.Context, projectID uuid.UUID) (*console.BucketTemplate, error) {
+	template, err := templates.db.Get_BucketTemplate_By_ProjectId(ctx, dbx.BucketTemplate_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return bucketTemplateFromDBX(template)
+}
+
+// Upsert is a method for creating or replacing the bucket template of a project.
+func (templates *bucketTemplates) Upsert(ctx context.Context, template *console.BucketTemplate) (*console.BucketTemplate, error) {
+	updated, err := templates.db.Update_BucketTemplate_By_ProjectId(ctx,
+		dbx.BucketTemplate_ProjectId(template.ProjectID[:]),
+		dbx.BucketTemplate_Update_Fields{
+			PathCipher:      dbx.BucketTemplate_PathCipher(int(template.PathCipher)),
+			DataCipher:      dbx.BucketTemplate_DataCipher(int(template.DataCipher)),
+			BlockSize:       dbx.BucketTemplate_BlockSize(template.BlockSize),
+			ShareSize:       dbx.BucketTemplate_ShareSize(template.ShareSize),
+			RequiredShares:  dbx.BucketTemplate_RequiredShares(template.RequiredShares),
+			RepairShares:    dbx.BucketTemplate_RepairShares(template.RepairShares),
+			OptimalShares:   dbx.BucketTemplate_OptimalShares(template.OptimalShares),
+			TotalShares:     dbx.BucketTemplate_TotalShares(template.TotalShares),
+			DistinctSubnets: dbx.BucketTemplate_DistinctSubnets(template.DistinctSubnets),
+			DistinctRegions: dbx.BucketTemplate_DistinctRegions(template.DistinctRegions),
+			UpdatedAt:       dbx.BucketTemplate_UpdatedAt(time.Now()),
+		})
+	if err != nil {
+		return nil, err
+	}
+	if updated != nil {
+		return bucketTemplateFromDBX(updated)
+	}
+
+	created, err := templates.db.Create_BucketTemplate(ctx,
+		dbx.BucketTemplate_ProjectId(template.ProjectID[:]),
+		dbx.BucketTemplate_PathCipher(int(template.PathCipher)),
+		dbx.BucketTemplate_DataCipher(int(template.DataCipher)),
+		dbx.BucketTemplate_BlockSize(template.BlockSize),
+		dbx.BucketTemplate_ShareSize(template.ShareSize),
+		dbx.BucketTemplate_RequiredShares(template.RequiredShares),
+		dbx.BucketTemplate_RepairShares(template.RepairShares),
+		dbx.BucketTemplate_OptimalShares(template.OptimalShares),
+		dbx.BucketTemplate_TotalShares(template.TotalShares),
+		dbx.BucketTemplate_DistinctSubnets(template.DistinctSubnets),
+		dbx.BucketTemplate_DistinctRegions(template.DistinctRegions),
+		dbx.BucketTemplate_UpdatedAt(time.Now()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucketTemplateFromDBX(created)
+}
+
+// Delete is a method for removing the bucket template of a project.
+func (templates *bucketTemplates) Delete(ctx context.Context, projectID uuid.UUID) error {
+	_, err := templates.db.Delete_BucketTemplate_By_ProjectId(ctx, dbx.BucketTemplate_ProjectId(projectID[:]))
+
+	return err
+}
+
+// bucketTemplateFromDBX is used for creating BucketTemplate entity from autogenerated dbx.BucketTemplate struct
+func bucketTemplateFromDBX(template *dbx.BucketTemplate) (*console.BucketTemplate, error) {
+	if template == nil {
+		return nil, errs.New("bucket template parameter is nil")
+	}
+
+	projectID, err := bytesToUUID(template.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.BucketTemplate{
+		ProjectID:       projectID,
+		PathCipher:      storj.Cipher(template.PathCipher),
+		DataCipher:      storj.Cipher(template.DataCipher),
+		BlockSize:       template.BlockSize,
+		ShareSize:       template.ShareSize,
+		RequiredShares:  template.RequiredShares,
+		RepairShares:    template.RepairShares,
+		OptimalShares:   template.OptimalShares,
+		TotalShares:     template.TotalShares,
+		DistinctSubnets: template.DistinctSubnets,
+		DistinctRegions: template.DistinctRegions,
+		UpdatedAt:       template.UpdatedAt,
+		CreatedAt:       template.CreatedAt,
+	}, nil
+}
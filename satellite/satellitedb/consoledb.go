@@ -57,6 +57,31 @@ func (db *ConsoleDB) APIKeys() console.APIKeys {
 	return &apikeys{db.methods}
 }
 
+// ProjectDeletions is a getter for ProjectDeletions repository
+func (db *ConsoleDB) ProjectDeletions() console.ProjectDeletions {
+	return &projectDeletions{db.methods, db.db}
+}
+
+// Sessions is a getter for Sessions repository
+func (db *ConsoleDB) Sessions() console.Sessions {
+	return &sessions{db.methods}
+}
+
+// AuthEvents is a getter for AuthEvents repository
+func (db *ConsoleDB) AuthEvents() console.AuthEvents {
+	return &authEvents{db.methods}
+}
+
+// BucketTemplates is a getter for BucketTemplates repository
+func (db *ConsoleDB) BucketTemplates() console.BucketTemplates {
+	return &bucketTemplates{db.methods}
+}
+
+// BucketStats is a getter for BucketStats repository
+func (db *ConsoleDB) BucketStats() console.BucketStats {
+	return &bucketStats{db.methods, db.db}
+}
+
 // CreateTables is a method for creating all tables for satellitedb
 func (db *ConsoleDB) CreateTables() error {
 	if db.db == nil {
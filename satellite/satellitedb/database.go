@@ -10,14 +10,17 @@ import (
 
 	"storj.io/storj/internal/migrate"
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/utils"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
 
@@ -34,14 +37,20 @@ type DB struct {
 	driver string
 }
 
-// New creates instance of database (supports: postgres, sqlite3)
+// New creates instance of database (supports: postgres, cockroach, sqlite3)
 func New(databaseURL string) (satellite.DB, error) {
 	driver, source, err := utils.SplitDBURL(databaseURL)
 	if err != nil {
 		return nil, err
 	}
 
-	db, err := dbx.Open(driver, source)
+	dialect := driver
+	if driver == "cockroach" {
+		// cockroach speaks the postgres wire protocol and sql dialect
+		dialect, source = "postgres", "postgres://"+source
+	}
+
+	db, err := dbx.Open(dialect, source)
 	if err != nil {
 		return nil, Error.New("failed opening database %q, %q: %v",
 			driver, source, err)
@@ -65,6 +74,10 @@ func (db *DB) CreateSchema(schema string) error {
 	case "postgres":
 		_, err := db.db.Exec(`create schema if not exists ` + quoteSchema(schema) + `;`)
 		return err
+	case "cockroach":
+		// cockroach has no schemas, a database is used instead
+		_, err := db.db.Exec(`create database if not exists ` + quoteSchema(schema) + `;`)
+		return err
 	}
 	return nil
 }
@@ -75,11 +88,15 @@ func (db *DB) DropSchema(schema string) error {
 	case "postgres":
 		_, err := db.db.Exec(`drop schema ` + quoteSchema(schema) + ` cascade;`)
 		return err
+	case "cockroach":
+		_, err := db.db.Exec(`drop database ` + quoteSchema(schema) + ` cascade;`)
+		return err
 	}
 	return nil
 }
 
-// quoteSchema quotes schema name such that it can be used in a postgres query
+// quoteSchema quotes schema name such that it can be used in a postgres or
+// cockroach query
 func quoteSchema(schema string) string {
 	return strconv.QuoteToASCII(schema)
 }
@@ -101,7 +118,17 @@ func (db *DB) StatDB() statdb.DB {
 
 // OverlayCache is a getter for overlay cache repository
 func (db *DB) OverlayCache() overlay.DB {
-	return &overlaycache{db: db.db}
+	return &overlaycache{db: db.db, driver: db.driver}
+}
+
+// NodePings is a getter for node pings repository
+func (db *DB) NodePings() overlay.PingDB {
+	return &nodePings{db: db.db}
+}
+
+// NodeStandings is a getter for node standings repository
+func (db *DB) NodeStandings() overlay.StandingDB {
+	return &nodeStandings{db: db.db}
 }
 
 // RepairQueue is a getter for RepairQueue repository
@@ -127,6 +154,36 @@ func (db *DB) Console() console.DB {
 	}
 }
 
+// ObjectTags returns database for searching objects by their tags
+func (db *DB) ObjectTags() pointerdb.ObjectTags {
+	return &objectTags{db: db.db}
+}
+
+// BucketAttributions returns database for the partners buckets are attributed to
+func (db *DB) BucketAttributions() pointerdb.BucketAttributions {
+	return &bucketAttributions{methods: db.db, db: db.db}
+}
+
+// BucketLifecycles returns database for the lifecycle configurations of buckets
+func (db *DB) BucketLifecycles() pointerdb.BucketLifecycles {
+	return &bucketLifecycles{methods: db.db, db: db.db}
+}
+
+// Payments returns database for storing customers and invoices
+func (db *DB) Payments() payments.DB {
+	return &paymentsDB{db: db.db}
+}
+
+// SegmentHealth returns database for tracking when segments were audited and repaired
+func (db *DB) SegmentHealth() pointerdb.HealthDB {
+	return &segmentHealth{db: db.db}
+}
+
+// AuditLog returns database for keeping the outcomes of audits
+func (db *DB) AuditLog() audit.LogDB {
+	return &auditLog{db: db.db}
+}
+
 // CreateTables is a method for creating all tables for database
 func (db *DB) CreateTables() error {
 	return migrate.Create("database", db.db)
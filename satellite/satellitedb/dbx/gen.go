@@ -20,3 +20,6 @@ func init() {
 		return c.Wrap(e)
 	}
 }
+
+// Cause returns the underlying error of the database driver
+func (e *Error) Cause() error { return e.Err }
@@ -299,6 +299,78 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE accounting_violations (
+	id bigserial NOT NULL,
+	name text NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	interval_end timestamp with time zone NOT NULL,
+	expected double precision NOT NULL,
+	actual double precision NOT NULL,
+	details text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE audit_records (
+	node_id bytea NOT NULL,
+	audited_at timestamp with time zone NOT NULL,
+	segment_hash bytea NOT NULL,
+	stripe_index bigint NOT NULL,
+	piece_num integer NOT NULL,
+	outcome text NOT NULL,
+	error text NOT NULL,
+	duration_ms bigint NOT NULL,
+	PRIMARY KEY ( node_id, audited_at, segment_hash )
+);
+CREATE TABLE auth_events (
+	id bigserial NOT NULL,
+	user_id bytea NOT NULL,
+	session_id bytea NOT NULL,
+	kind text NOT NULL,
+	device text NOT NULL,
+	address text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_attributions (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	partner_id text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_lifecycles (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	expire_after_days integer NOT NULL,
+	versions_prefix bytea NOT NULL,
+	versions_expire_after_days integer NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_stats (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_count bigint NOT NULL,
+	total_bytes bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_templates (
+	project_id bytea NOT NULL,
+	path_cipher integer NOT NULL,
+	data_cipher integer NOT NULL,
+	block_size integer NOT NULL,
+	share_size integer NOT NULL,
+	required_shares integer NOT NULL,
+	repair_shares integer NOT NULL,
+	optimal_shares integer NOT NULL,
+	total_shares integer NOT NULL,
+	distinct_subnets boolean NOT NULL,
+	distinct_regions boolean NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE bwagreements (
 	serialnum text NOT NULL,
 	storage_node_id bytea NOT NULL,
@@ -311,36 +383,74 @@ CREATE TABLE bwagreements (
 );
 CREATE TABLE injuredsegments (
 	id bigserial NOT NULL,
+	path text NOT NULL,
+	priority bigint NOT NULL,
 	info bytea NOT NULL,
-	PRIMARY KEY ( id )
+	attempts bigint NOT NULL,
+	leased_until timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( path )
 );
 CREATE TABLE irreparabledbs (
 	segmentpath bytea NOT NULL,
+	project_id bytea NOT NULL,
 	segmentdetail bytea NOT NULL,
 	pieces_lost_count bigint NOT NULL,
 	seg_damaged_unix_sec bigint NOT NULL,
 	repair_attempt_count bigint NOT NULL,
+	last_error text NOT NULL,
 	PRIMARY KEY ( segmentpath )
 );
+CREATE TABLE node_pings (
+	id bytea NOT NULL,
+	success_rate double precision NOT NULL,
+	ping_count bigint NOT NULL,
+	last_ping_at timestamp with time zone NOT NULL,
+	last_success_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_standings (
+	id bytea NOT NULL,
+	status integer NOT NULL,
+	reason text NOT NULL,
+	since timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	audit_success_count bigint NOT NULL,
 	total_audit_count bigint NOT NULL,
 	audit_success_ratio double precision NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
 	uptime_success_count bigint NOT NULL,
 	total_uptime_count bigint NOT NULL,
 	uptime_ratio double precision NOT NULL,
+	uptime_reputation_alpha double precision NOT NULL,
+	uptime_reputation_beta double precision NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE object_tags (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	tag_key bytea NOT NULL,
+	tag_value bytea NOT NULL,
+	encrypted_path bytea NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, tag_key, tag_value, encrypted_path )
+);
 CREATE TABLE overlay_cache_nodes (
 	node_id bytea NOT NULL,
 	node_type integer NOT NULL,
 	address text NOT NULL,
 	protocol integer NOT NULL,
+	last_net text NOT NULL,
 	operator_email text NOT NULL,
 	operator_wallet text NOT NULL,
+	operator_region text NOT NULL,
+	version text NOT NULL,
 	free_bandwidth bigint NOT NULL,
 	free_disk bigint NOT NULL,
 	latency_90 bigint NOT NULL,
@@ -350,9 +460,56 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count bigint NOT NULL,
 	uptime_count bigint NOT NULL,
 	uptime_success_count bigint NOT NULL,
+	audit_reputation_score double precision NOT NULL,
+	uptime_reputation_score double precision NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
+CREATE TABLE partner_serials (
+	serial_number text NOT NULL,
+	partner_id text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
+CREATE TABLE partner_storage_tallies (
+	id bigserial NOT NULL,
+	partner_id text NOT NULL,
+	interval_end_time timestamp with time zone NOT NULL,
+	data_total double precision NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_deletions (
+	project_id bytea NOT NULL,
+	requested_by bytea NOT NULL,
+	purge_after timestamp with time zone NOT NULL,
+	purged_at timestamp with time zone,
+	segments_deleted bigint NOT NULL,
+	pieces_deleted bigint NOT NULL,
+	api_keys_deleted bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_invoices (
+	id text NOT NULL,
+	project_id bytea NOT NULL,
+	customer_id text NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	amount bigint NOT NULL,
+	status text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, period_start )
+);
+CREATE TABLE project_storage_tallies (
+	id bigserial NOT NULL,
+	project_id bytea NOT NULL,
+	interval_end_time timestamp with time zone NOT NULL,
+	data_total double precision NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -360,6 +517,30 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE segment_healths (
+	path bytea NOT NULL,
+	last_audited timestamp with time zone,
+	last_repaired timestamp with time zone,
+	PRIMARY KEY ( path )
+);
+CREATE TABLE sessions (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	device text NOT NULL,
+	address text NOT NULL,
+	refresh_token_hash bytea NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	last_used_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE user_payments (
+	user_id bytea NOT NULL,
+	customer_id text NOT NULL,
+	credit bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE users (
 	id bytea NOT NULL,
 	first_name text NOT NULL,
@@ -475,6 +656,78 @@ CREATE TABLE accounting_timestamps (
 	value TIMESTAMP NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE accounting_violations (
+	id INTEGER NOT NULL,
+	name TEXT NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	interval_end TIMESTAMP NOT NULL,
+	expected REAL NOT NULL,
+	actual REAL NOT NULL,
+	details TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE audit_records (
+	node_id BLOB NOT NULL,
+	audited_at TIMESTAMP NOT NULL,
+	segment_hash BLOB NOT NULL,
+	stripe_index INTEGER NOT NULL,
+	piece_num INTEGER NOT NULL,
+	outcome TEXT NOT NULL,
+	error TEXT NOT NULL,
+	duration_ms INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, audited_at, segment_hash )
+);
+CREATE TABLE auth_events (
+	id INTEGER NOT NULL,
+	user_id BLOB NOT NULL,
+	session_id BLOB NOT NULL,
+	kind TEXT NOT NULL,
+	device TEXT NOT NULL,
+	address TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_attributions (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	partner_id TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_lifecycles (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	expire_after_days INTEGER NOT NULL,
+	versions_prefix BLOB NOT NULL,
+	versions_expire_after_days INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_stats (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	object_count INTEGER NOT NULL,
+	total_bytes INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_templates (
+	project_id BLOB NOT NULL,
+	path_cipher INTEGER NOT NULL,
+	data_cipher INTEGER NOT NULL,
+	block_size INTEGER NOT NULL,
+	share_size INTEGER NOT NULL,
+	required_shares INTEGER NOT NULL,
+	repair_shares INTEGER NOT NULL,
+	optimal_shares INTEGER NOT NULL,
+	total_shares INTEGER NOT NULL,
+	distinct_subnets INTEGER NOT NULL,
+	distinct_regions INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE bwagreements (
 	serialnum TEXT NOT NULL,
 	storage_node_id BLOB NOT NULL,
@@ -487,36 +740,74 @@ CREATE TABLE bwagreements (
 );
 CREATE TABLE injuredsegments (
 	id INTEGER NOT NULL,
+	path TEXT NOT NULL,
+	priority INTEGER NOT NULL,
 	info BLOB NOT NULL,
-	PRIMARY KEY ( id )
+	attempts INTEGER NOT NULL,
+	leased_until TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( path )
 );
 CREATE TABLE irreparabledbs (
 	segmentpath BLOB NOT NULL,
+	project_id BLOB NOT NULL,
 	segmentdetail BLOB NOT NULL,
 	pieces_lost_count INTEGER NOT NULL,
 	seg_damaged_unix_sec INTEGER NOT NULL,
 	repair_attempt_count INTEGER NOT NULL,
+	last_error TEXT NOT NULL,
 	PRIMARY KEY ( segmentpath )
 );
+CREATE TABLE node_pings (
+	id BLOB NOT NULL,
+	success_rate REAL NOT NULL,
+	ping_count INTEGER NOT NULL,
+	last_ping_at TIMESTAMP NOT NULL,
+	last_success_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_standings (
+	id BLOB NOT NULL,
+	status INTEGER NOT NULL,
+	reason TEXT NOT NULL,
+	since TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	audit_success_count INTEGER NOT NULL,
 	total_audit_count INTEGER NOT NULL,
 	audit_success_ratio REAL NOT NULL,
+	audit_reputation_alpha REAL NOT NULL,
+	audit_reputation_beta REAL NOT NULL,
 	uptime_success_count INTEGER NOT NULL,
 	total_uptime_count INTEGER NOT NULL,
 	uptime_ratio REAL NOT NULL,
+	uptime_reputation_alpha REAL NOT NULL,
+	uptime_reputation_beta REAL NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	updated_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE object_tags (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	tag_key BLOB NOT NULL,
+	tag_value BLOB NOT NULL,
+	encrypted_path BLOB NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, tag_key, tag_value, encrypted_path )
+);
 CREATE TABLE overlay_cache_nodes (
 	node_id BLOB NOT NULL,
 	node_type INTEGER NOT NULL,
 	address TEXT NOT NULL,
 	protocol INTEGER NOT NULL,
+	last_net TEXT NOT NULL,
 	operator_email TEXT NOT NULL,
 	operator_wallet TEXT NOT NULL,
+	operator_region TEXT NOT NULL,
+	version TEXT NOT NULL,
 	free_bandwidth INTEGER NOT NULL,
 	free_disk INTEGER NOT NULL,
 	latency_90 INTEGER NOT NULL,
@@ -526,9 +817,56 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count INTEGER NOT NULL,
 	uptime_count INTEGER NOT NULL,
 	uptime_success_count INTEGER NOT NULL,
+	audit_reputation_score REAL NOT NULL,
+	uptime_reputation_score REAL NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
+CREATE TABLE partner_serials (
+	serial_number TEXT NOT NULL,
+	partner_id TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
+CREATE TABLE partner_storage_tallies (
+	id INTEGER NOT NULL,
+	partner_id TEXT NOT NULL,
+	interval_end_time TIMESTAMP NOT NULL,
+	data_total REAL NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_deletions (
+	project_id BLOB NOT NULL,
+	requested_by BLOB NOT NULL,
+	purge_after TIMESTAMP NOT NULL,
+	purged_at TIMESTAMP,
+	segments_deleted INTEGER NOT NULL,
+	pieces_deleted INTEGER NOT NULL,
+	api_keys_deleted INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_invoices (
+	id TEXT NOT NULL,
+	project_id BLOB NOT NULL,
+	customer_id TEXT NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	amount INTEGER NOT NULL,
+	status TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, period_start )
+);
+CREATE TABLE project_storage_tallies (
+	id INTEGER NOT NULL,
+	project_id BLOB NOT NULL,
+	interval_end_time TIMESTAMP NOT NULL,
+	data_total REAL NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE projects (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
@@ -536,6 +874,30 @@ CREATE TABLE projects (
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE segment_healths (
+	path BLOB NOT NULL,
+	last_audited TIMESTAMP,
+	last_repaired TIMESTAMP,
+	PRIMARY KEY ( path )
+);
+CREATE TABLE sessions (
+	id BLOB NOT NULL,
+	user_id BLOB NOT NULL,
+	device TEXT NOT NULL,
+	address TEXT NOT NULL,
+	refresh_token_hash BLOB NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	last_used_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE user_payments (
+	user_id BLOB NOT NULL,
+	customer_id TEXT NOT NULL,
+	credit INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE users (
 	id BLOB NOT NULL,
 	first_name TEXT NOT NULL,
@@ -989,4056 +1351,12217 @@ func (f AccountingTimestamps_Value_Field) value() interface{} {
 
 func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
-type Bwagreement struct {
-	Serialnum     string
-	StorageNodeId []byte
-	UplinkId      []byte
-	Action        int64
-	Total         int64
+type AccountingViolation struct {
+	Id            int64
+	Name          string
+	IntervalStart time.Time
+	IntervalEnd   time.Time
+	Expected      float64
+	Actual        float64
+	Details       string
 	CreatedAt     time.Time
-	ExpiresAt     time.Time
 }
 
-func (Bwagreement) _Table() string { return "bwagreements" }
+func (AccountingViolation) _Table() string { return "accounting_violations" }
 
-type Bwagreement_Update_Fields struct {
+type AccountingViolation_Update_Fields struct {
 }
 
-type Bwagreement_Serialnum_Field struct {
+type AccountingViolation_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func AccountingViolation_Id(v int64) AccountingViolation_Id_Field {
+	return AccountingViolation_Id_Field{_set: true, _value: v}
+}
+
+func (f AccountingViolation_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AccountingViolation_Id_Field) _Column() string { return "id" }
+
+type AccountingViolation_Name_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Bwagreement_Serialnum(v string) Bwagreement_Serialnum_Field {
-	return Bwagreement_Serialnum_Field{_set: true, _value: v}
+func AccountingViolation_Name(v string) AccountingViolation_Name_Field {
+	return AccountingViolation_Name_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_Serialnum_Field) value() interface{} {
+func (f AccountingViolation_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_Serialnum_Field) _Column() string { return "serialnum" }
+func (AccountingViolation_Name_Field) _Column() string { return "name" }
 
-type Bwagreement_StorageNodeId_Field struct {
+type AccountingViolation_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Bwagreement_StorageNodeId(v []byte) Bwagreement_StorageNodeId_Field {
-	return Bwagreement_StorageNodeId_Field{_set: true, _value: v}
+func AccountingViolation_IntervalStart(v time.Time) AccountingViolation_IntervalStart_Field {
+	return AccountingViolation_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_StorageNodeId_Field) value() interface{} {
+func (f AccountingViolation_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_StorageNodeId_Field) _Column() string { return "storage_node_id" }
+func (AccountingViolation_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type Bwagreement_UplinkId_Field struct {
+type AccountingViolation_IntervalEnd_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Bwagreement_UplinkId(v []byte) Bwagreement_UplinkId_Field {
-	return Bwagreement_UplinkId_Field{_set: true, _value: v}
+func AccountingViolation_IntervalEnd(v time.Time) AccountingViolation_IntervalEnd_Field {
+	return AccountingViolation_IntervalEnd_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_UplinkId_Field) value() interface{} {
+func (f AccountingViolation_IntervalEnd_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_UplinkId_Field) _Column() string { return "uplink_id" }
+func (AccountingViolation_IntervalEnd_Field) _Column() string { return "interval_end" }
 
-type Bwagreement_Action_Field struct {
+type AccountingViolation_Expected_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value float64
 }
 
-func Bwagreement_Action(v int64) Bwagreement_Action_Field {
-	return Bwagreement_Action_Field{_set: true, _value: v}
+func AccountingViolation_Expected(v float64) AccountingViolation_Expected_Field {
+	return AccountingViolation_Expected_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_Action_Field) value() interface{} {
+func (f AccountingViolation_Expected_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_Action_Field) _Column() string { return "action" }
+func (AccountingViolation_Expected_Field) _Column() string { return "expected" }
 
-type Bwagreement_Total_Field struct {
+type AccountingViolation_Actual_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value float64
 }
 
-func Bwagreement_Total(v int64) Bwagreement_Total_Field {
-	return Bwagreement_Total_Field{_set: true, _value: v}
+func AccountingViolation_Actual(v float64) AccountingViolation_Actual_Field {
+	return AccountingViolation_Actual_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_Total_Field) value() interface{} {
+func (f AccountingViolation_Actual_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_Total_Field) _Column() string { return "total" }
+func (AccountingViolation_Actual_Field) _Column() string { return "actual" }
 
-type Bwagreement_CreatedAt_Field struct {
+type AccountingViolation_Details_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Bwagreement_CreatedAt(v time.Time) Bwagreement_CreatedAt_Field {
-	return Bwagreement_CreatedAt_Field{_set: true, _value: v}
+func AccountingViolation_Details(v string) AccountingViolation_Details_Field {
+	return AccountingViolation_Details_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_CreatedAt_Field) value() interface{} {
+func (f AccountingViolation_Details_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_CreatedAt_Field) _Column() string { return "created_at" }
+func (AccountingViolation_Details_Field) _Column() string { return "details" }
 
-type Bwagreement_ExpiresAt_Field struct {
+type AccountingViolation_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Bwagreement_ExpiresAt(v time.Time) Bwagreement_ExpiresAt_Field {
-	return Bwagreement_ExpiresAt_Field{_set: true, _value: v}
+func AccountingViolation_CreatedAt(v time.Time) AccountingViolation_CreatedAt_Field {
+	return AccountingViolation_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_ExpiresAt_Field) value() interface{} {
+func (f AccountingViolation_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (AccountingViolation_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Injuredsegment struct {
-	Id   int64
-	Info []byte
+type AuditRecord struct {
+	NodeId      []byte
+	AuditedAt   time.Time
+	SegmentHash []byte
+	StripeIndex int64
+	PieceNum    int
+	Outcome     string
+	Error       string
+	DurationMs  int64
 }
 
-func (Injuredsegment) _Table() string { return "injuredsegments" }
+func (AuditRecord) _Table() string { return "audit_records" }
 
-type Injuredsegment_Update_Fields struct {
+type AuditRecord_Update_Fields struct {
 }
 
-type Injuredsegment_Id_Field struct {
+type AuditRecord_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Injuredsegment_Id(v int64) Injuredsegment_Id_Field {
-	return Injuredsegment_Id_Field{_set: true, _value: v}
+func AuditRecord_NodeId(v []byte) AuditRecord_NodeId_Field {
+	return AuditRecord_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Id_Field) value() interface{} {
+func (f AuditRecord_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Id_Field) _Column() string { return "id" }
+func (AuditRecord_NodeId_Field) _Column() string { return "node_id" }
 
-type Injuredsegment_Info_Field struct {
+type AuditRecord_AuditedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Injuredsegment_Info(v []byte) Injuredsegment_Info_Field {
-	return Injuredsegment_Info_Field{_set: true, _value: v}
+func AuditRecord_AuditedAt(v time.Time) AuditRecord_AuditedAt_Field {
+	return AuditRecord_AuditedAt_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Info_Field) value() interface{} {
+func (f AuditRecord_AuditedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Info_Field) _Column() string { return "info" }
+func (AuditRecord_AuditedAt_Field) _Column() string { return "audited_at" }
 
-type Irreparabledb struct {
-	Segmentpath        []byte
-	Segmentdetail      []byte
-	PiecesLostCount    int64
-	SegDamagedUnixSec  int64
-	RepairAttemptCount int64
+type AuditRecord_SegmentHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (Irreparabledb) _Table() string { return "irreparabledbs" }
+func AuditRecord_SegmentHash(v []byte) AuditRecord_SegmentHash_Field {
+	return AuditRecord_SegmentHash_Field{_set: true, _value: v}
+}
 
-type Irreparabledb_Update_Fields struct {
-	Segmentdetail      Irreparabledb_Segmentdetail_Field
-	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
-	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
-	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+func (f AuditRecord_SegmentHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Irreparabledb_Segmentpath_Field struct {
+func (AuditRecord_SegmentHash_Field) _Column() string { return "segment_hash" }
+
+type AuditRecord_StripeIndex_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
-	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+func AuditRecord_StripeIndex(v int64) AuditRecord_StripeIndex_Field {
+	return AuditRecord_StripeIndex_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+func (f AuditRecord_StripeIndex_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+func (AuditRecord_StripeIndex_Field) _Column() string { return "stripe_index" }
 
-type Irreparabledb_Segmentdetail_Field struct {
+type AuditRecord_PieceNum_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
-	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+func AuditRecord_PieceNum(v int) AuditRecord_PieceNum_Field {
+	return AuditRecord_PieceNum_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+func (f AuditRecord_PieceNum_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+func (AuditRecord_PieceNum_Field) _Column() string { return "piece_num" }
 
-type Irreparabledb_PiecesLostCount_Field struct {
+type AuditRecord_Outcome_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
-	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+func AuditRecord_Outcome(v string) AuditRecord_Outcome_Field {
+	return AuditRecord_Outcome_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+func (f AuditRecord_Outcome_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+func (AuditRecord_Outcome_Field) _Column() string { return "outcome" }
 
-type Irreparabledb_SegDamagedUnixSec_Field struct {
+type AuditRecord_Error_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
-	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+func AuditRecord_Error(v string) AuditRecord_Error_Field {
+	return AuditRecord_Error_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+func (f AuditRecord_Error_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+func (AuditRecord_Error_Field) _Column() string { return "error" }
 
-type Irreparabledb_RepairAttemptCount_Field struct {
+type AuditRecord_DurationMs_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
-	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+func AuditRecord_DurationMs(v int64) AuditRecord_DurationMs_Field {
+	return AuditRecord_DurationMs_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+func (f AuditRecord_DurationMs_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
+func (AuditRecord_DurationMs_Field) _Column() string { return "duration_ms" }
 
-type Node struct {
-	Id                 []byte
-	AuditSuccessCount  int64
-	TotalAuditCount    int64
-	AuditSuccessRatio  float64
-	UptimeSuccessCount int64
-	TotalUptimeCount   int64
-	UptimeRatio        float64
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
+type AuthEvent struct {
+	Id        int64
+	UserId    []byte
+	SessionId []byte
+	Kind      string
+	Device    string
+	Address   string
+	CreatedAt time.Time
 }
 
-func (Node) _Table() string { return "nodes" }
+func (AuthEvent) _Table() string { return "auth_events" }
 
-type Node_Update_Fields struct {
-	AuditSuccessCount  Node_AuditSuccessCount_Field
-	TotalAuditCount    Node_TotalAuditCount_Field
-	AuditSuccessRatio  Node_AuditSuccessRatio_Field
-	UptimeSuccessCount Node_UptimeSuccessCount_Field
-	TotalUptimeCount   Node_TotalUptimeCount_Field
-	UptimeRatio        Node_UptimeRatio_Field
+type AuthEvent_Update_Fields struct {
 }
 
-type Node_Id_Field struct {
+type AuthEvent_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func AuthEvent_Id(v int64) AuthEvent_Id_Field {
+	return AuthEvent_Id_Field{_set: true, _value: v}
+}
+
+func (f AuthEvent_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuthEvent_Id_Field) _Column() string { return "id" }
+
+type AuthEvent_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func AuthEvent_UserId(v []byte) AuthEvent_UserId_Field {
+	return AuthEvent_UserId_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f AuthEvent_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (AuthEvent_UserId_Field) _Column() string { return "user_id" }
 
-type Node_AuditSuccessCount_Field struct {
+type AuthEvent_SessionId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
-	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+func AuthEvent_SessionId(v []byte) AuthEvent_SessionId_Field {
+	return AuthEvent_SessionId_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditSuccessCount_Field) value() interface{} {
+func (f AuthEvent_SessionId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (AuthEvent_SessionId_Field) _Column() string { return "session_id" }
 
-type Node_TotalAuditCount_Field struct {
+type AuthEvent_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
-	return Node_TotalAuditCount_Field{_set: true, _value: v}
+func AuthEvent_Kind(v string) AuthEvent_Kind_Field {
+	return AuthEvent_Kind_Field{_set: true, _value: v}
 }
 
-func (f Node_TotalAuditCount_Field) value() interface{} {
+func (f AuthEvent_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+func (AuthEvent_Kind_Field) _Column() string { return "kind" }
 
-type Node_AuditSuccessRatio_Field struct {
+type AuthEvent_Device_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value string
 }
 
-func Node_AuditSuccessRatio(v float64) Node_AuditSuccessRatio_Field {
-	return Node_AuditSuccessRatio_Field{_set: true, _value: v}
+func AuthEvent_Device(v string) AuthEvent_Device_Field {
+	return AuthEvent_Device_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditSuccessRatio_Field) value() interface{} {
+func (f AuthEvent_Device_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+func (AuthEvent_Device_Field) _Column() string { return "device" }
 
-type Node_UptimeSuccessCount_Field struct {
+type AuthEvent_Address_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_UptimeSuccessCount(v int64) Node_UptimeSuccessCount_Field {
-	return Node_UptimeSuccessCount_Field{_set: true, _value: v}
+func AuthEvent_Address(v string) AuthEvent_Address_Field {
+	return AuthEvent_Address_Field{_set: true, _value: v}
 }
 
-func (f Node_UptimeSuccessCount_Field) value() interface{} {
+func (f AuthEvent_Address_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+func (AuthEvent_Address_Field) _Column() string { return "address" }
 
-type Node_TotalUptimeCount_Field struct {
+type AuthEvent_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_TotalUptimeCount(v int64) Node_TotalUptimeCount_Field {
-	return Node_TotalUptimeCount_Field{_set: true, _value: v}
+func AuthEvent_CreatedAt(v time.Time) AuthEvent_CreatedAt_Field {
+	return AuthEvent_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_TotalUptimeCount_Field) value() interface{} {
+func (f AuthEvent_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalUptimeCount_Field) _Column() string { return "total_uptime_count" }
+func (AuthEvent_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_UptimeRatio_Field struct {
+type BucketAttribution struct {
+	ProjectId  []byte
+	BucketName []byte
+	PartnerId  string
+	CreatedAt  time.Time
+}
+
+func (BucketAttribution) _Table() string { return "bucket_attributions" }
+
+type BucketAttribution_Update_Fields struct {
+}
+
+type BucketAttribution_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func Node_UptimeRatio(v float64) Node_UptimeRatio_Field {
-	return Node_UptimeRatio_Field{_set: true, _value: v}
+func BucketAttribution_ProjectId(v []byte) BucketAttribution_ProjectId_Field {
+	return BucketAttribution_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Node_UptimeRatio_Field) value() interface{} {
+func (f BucketAttribution_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeRatio_Field) _Column() string { return "uptime_ratio" }
+func (BucketAttribution_ProjectId_Field) _Column() string { return "project_id" }
 
-type Node_CreatedAt_Field struct {
+type BucketAttribution_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
-	return Node_CreatedAt_Field{_set: true, _value: v}
+func BucketAttribution_BucketName(v []byte) BucketAttribution_BucketName_Field {
+	return BucketAttribution_BucketName_Field{_set: true, _value: v}
 }
 
-func (f Node_CreatedAt_Field) value() interface{} {
+func (f BucketAttribution_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketAttribution_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Node_UpdatedAt_Field struct {
+type BucketAttribution_PartnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func BucketAttribution_PartnerId(v string) BucketAttribution_PartnerId_Field {
+	return BucketAttribution_PartnerId_Field{_set: true, _value: v}
+}
+
+func (f BucketAttribution_PartnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketAttribution_PartnerId_Field) _Column() string { return "partner_id" }
+
+type BucketAttribution_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
-	return Node_UpdatedAt_Field{_set: true, _value: v}
+func BucketAttribution_CreatedAt(v time.Time) BucketAttribution_CreatedAt_Field {
+	return BucketAttribution_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_UpdatedAt_Field) value() interface{} {
+func (f BucketAttribution_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (BucketAttribution_CreatedAt_Field) _Column() string { return "created_at" }
 
-type OverlayCacheNode struct {
-	NodeId             []byte
-	NodeType           int
-	Address            string
-	Protocol           int
-	OperatorEmail      string
-	OperatorWallet     string
-	FreeBandwidth      int64
-	FreeDisk           int64
-	Latency90          int64
-	AuditSuccessRatio  float64
-	AuditUptimeRatio   float64
-	AuditCount         int64
-	AuditSuccessCount  int64
-	UptimeCount        int64
-	UptimeSuccessCount int64
+type BucketLifecycle struct {
+	ProjectId               []byte
+	BucketName              []byte
+	ExpireAfterDays         int
+	VersionsPrefix          []byte
+	VersionsExpireAfterDays int
+	UpdatedAt               time.Time
 }
 
-func (OverlayCacheNode) _Table() string { return "overlay_cache_nodes" }
+func (BucketLifecycle) _Table() string { return "bucket_lifecycles" }
 
-type OverlayCacheNode_Update_Fields struct {
-	Address            OverlayCacheNode_Address_Field
-	Protocol           OverlayCacheNode_Protocol_Field
-	OperatorEmail      OverlayCacheNode_OperatorEmail_Field
-	OperatorWallet     OverlayCacheNode_OperatorWallet_Field
-	FreeBandwidth      OverlayCacheNode_FreeBandwidth_Field
-	FreeDisk           OverlayCacheNode_FreeDisk_Field
-	Latency90          OverlayCacheNode_Latency90_Field
-	AuditSuccessRatio  OverlayCacheNode_AuditSuccessRatio_Field
-	AuditUptimeRatio   OverlayCacheNode_AuditUptimeRatio_Field
-	AuditCount         OverlayCacheNode_AuditCount_Field
-	AuditSuccessCount  OverlayCacheNode_AuditSuccessCount_Field
-	UptimeCount        OverlayCacheNode_UptimeCount_Field
-	UptimeSuccessCount OverlayCacheNode_UptimeSuccessCount_Field
+type BucketLifecycle_Update_Fields struct {
+	ExpireAfterDays         BucketLifecycle_ExpireAfterDays_Field
+	VersionsPrefix          BucketLifecycle_VersionsPrefix_Field
+	VersionsExpireAfterDays BucketLifecycle_VersionsExpireAfterDays_Field
+	UpdatedAt               BucketLifecycle_UpdatedAt_Field
 }
 
-type OverlayCacheNode_NodeId_Field struct {
+type BucketLifecycle_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OverlayCacheNode_NodeId(v []byte) OverlayCacheNode_NodeId_Field {
-	return OverlayCacheNode_NodeId_Field{_set: true, _value: v}
+func BucketLifecycle_ProjectId(v []byte) BucketLifecycle_ProjectId_Field {
+	return BucketLifecycle_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_NodeId_Field) value() interface{} {
+func (f BucketLifecycle_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_NodeId_Field) _Column() string { return "node_id" }
+func (BucketLifecycle_ProjectId_Field) _Column() string { return "project_id" }
 
-type OverlayCacheNode_NodeType_Field struct {
+type BucketLifecycle_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func OverlayCacheNode_NodeType(v int) OverlayCacheNode_NodeType_Field {
-	return OverlayCacheNode_NodeType_Field{_set: true, _value: v}
+func BucketLifecycle_BucketName(v []byte) BucketLifecycle_BucketName_Field {
+	return BucketLifecycle_BucketName_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_NodeType_Field) value() interface{} {
+func (f BucketLifecycle_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_NodeType_Field) _Column() string { return "node_type" }
+func (BucketLifecycle_BucketName_Field) _Column() string { return "bucket_name" }
 
-type OverlayCacheNode_Address_Field struct {
+type BucketLifecycle_ExpireAfterDays_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func OverlayCacheNode_Address(v string) OverlayCacheNode_Address_Field {
-	return OverlayCacheNode_Address_Field{_set: true, _value: v}
+func BucketLifecycle_ExpireAfterDays(v int) BucketLifecycle_ExpireAfterDays_Field {
+	return BucketLifecycle_ExpireAfterDays_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_Address_Field) value() interface{} {
+func (f BucketLifecycle_ExpireAfterDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_Address_Field) _Column() string { return "address" }
+func (BucketLifecycle_ExpireAfterDays_Field) _Column() string { return "expire_after_days" }
 
-type OverlayCacheNode_Protocol_Field struct {
+type BucketLifecycle_VersionsPrefix_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func OverlayCacheNode_Protocol(v int) OverlayCacheNode_Protocol_Field {
-	return OverlayCacheNode_Protocol_Field{_set: true, _value: v}
+func BucketLifecycle_VersionsPrefix(v []byte) BucketLifecycle_VersionsPrefix_Field {
+	return BucketLifecycle_VersionsPrefix_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_Protocol_Field) value() interface{} {
+func (f BucketLifecycle_VersionsPrefix_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_Protocol_Field) _Column() string { return "protocol" }
+func (BucketLifecycle_VersionsPrefix_Field) _Column() string { return "versions_prefix" }
 
-type OverlayCacheNode_OperatorEmail_Field struct {
+type BucketLifecycle_VersionsExpireAfterDays_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func OverlayCacheNode_OperatorEmail(v string) OverlayCacheNode_OperatorEmail_Field {
-	return OverlayCacheNode_OperatorEmail_Field{_set: true, _value: v}
+func BucketLifecycle_VersionsExpireAfterDays(v int) BucketLifecycle_VersionsExpireAfterDays_Field {
+	return BucketLifecycle_VersionsExpireAfterDays_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_OperatorEmail_Field) value() interface{} {
+func (f BucketLifecycle_VersionsExpireAfterDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_OperatorEmail_Field) _Column() string { return "operator_email" }
+func (BucketLifecycle_VersionsExpireAfterDays_Field) _Column() string {
+	return "versions_expire_after_days"
+}
 
-type OverlayCacheNode_OperatorWallet_Field struct {
+type BucketLifecycle_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func OverlayCacheNode_OperatorWallet(v string) OverlayCacheNode_OperatorWallet_Field {
-	return OverlayCacheNode_OperatorWallet_Field{_set: true, _value: v}
+func BucketLifecycle_UpdatedAt(v time.Time) BucketLifecycle_UpdatedAt_Field {
+	return BucketLifecycle_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_OperatorWallet_Field) value() interface{} {
+func (f BucketLifecycle_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_OperatorWallet_Field) _Column() string { return "operator_wallet" }
+func (BucketLifecycle_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type OverlayCacheNode_FreeBandwidth_Field struct {
+type BucketStat struct {
+	ProjectId   []byte
+	BucketName  []byte
+	ObjectCount int64
+	TotalBytes  int64
+	UpdatedAt   time.Time
+}
+
+func (BucketStat) _Table() string { return "bucket_stats" }
+
+type BucketStat_Update_Fields struct {
+	ObjectCount BucketStat_ObjectCount_Field
+	TotalBytes  BucketStat_TotalBytes_Field
+	UpdatedAt   BucketStat_UpdatedAt_Field
+}
+
+type BucketStat_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func OverlayCacheNode_FreeBandwidth(v int64) OverlayCacheNode_FreeBandwidth_Field {
-	return OverlayCacheNode_FreeBandwidth_Field{_set: true, _value: v}
+func BucketStat_ProjectId(v []byte) BucketStat_ProjectId_Field {
+	return BucketStat_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_FreeBandwidth_Field) value() interface{} {
+func (f BucketStat_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_FreeBandwidth_Field) _Column() string { return "free_bandwidth" }
+func (BucketStat_ProjectId_Field) _Column() string { return "project_id" }
 
-type OverlayCacheNode_FreeDisk_Field struct {
+type BucketStat_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func OverlayCacheNode_FreeDisk(v int64) OverlayCacheNode_FreeDisk_Field {
-	return OverlayCacheNode_FreeDisk_Field{_set: true, _value: v}
+func BucketStat_BucketName(v []byte) BucketStat_BucketName_Field {
+	return BucketStat_BucketName_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_FreeDisk_Field) value() interface{} {
+func (f BucketStat_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_FreeDisk_Field) _Column() string { return "free_disk" }
+func (BucketStat_BucketName_Field) _Column() string { return "bucket_name" }
 
-type OverlayCacheNode_Latency90_Field struct {
+type BucketStat_ObjectCount_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func OverlayCacheNode_Latency90(v int64) OverlayCacheNode_Latency90_Field {
-	return OverlayCacheNode_Latency90_Field{_set: true, _value: v}
+func BucketStat_ObjectCount(v int64) BucketStat_ObjectCount_Field {
+	return BucketStat_ObjectCount_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_Latency90_Field) value() interface{} {
+func (f BucketStat_ObjectCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_Latency90_Field) _Column() string { return "latency_90" }
+func (BucketStat_ObjectCount_Field) _Column() string { return "object_count" }
 
-type OverlayCacheNode_AuditSuccessRatio_Field struct {
+type BucketStat_TotalBytes_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func OverlayCacheNode_AuditSuccessRatio(v float64) OverlayCacheNode_AuditSuccessRatio_Field {
-	return OverlayCacheNode_AuditSuccessRatio_Field{_set: true, _value: v}
+func BucketStat_TotalBytes(v int64) BucketStat_TotalBytes_Field {
+	return BucketStat_TotalBytes_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_AuditSuccessRatio_Field) value() interface{} {
+func (f BucketStat_TotalBytes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+func (BucketStat_TotalBytes_Field) _Column() string { return "total_bytes" }
 
-type OverlayCacheNode_AuditUptimeRatio_Field struct {
+type BucketStat_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func OverlayCacheNode_AuditUptimeRatio(v float64) OverlayCacheNode_AuditUptimeRatio_Field {
-	return OverlayCacheNode_AuditUptimeRatio_Field{_set: true, _value: v}
+func BucketStat_UpdatedAt(v time.Time) BucketStat_UpdatedAt_Field {
+	return BucketStat_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_AuditUptimeRatio_Field) value() interface{} {
+func (f BucketStat_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_AuditUptimeRatio_Field) _Column() string { return "audit_uptime_ratio" }
+func (BucketStat_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type BucketTemplate struct {
+	ProjectId       []byte
+	PathCipher      int
+	DataCipher      int
+	BlockSize       int
+	ShareSize       int
+	RequiredShares  int
+	RepairShares    int
+	OptimalShares   int
+	TotalShares     int
+	DistinctSubnets bool
+	DistinctRegions bool
+	UpdatedAt       time.Time
+	CreatedAt       time.Time
+}
 
-type OverlayCacheNode_AuditCount_Field struct {
+func (BucketTemplate) _Table() string { return "bucket_templates" }
+
+type BucketTemplate_Update_Fields struct {
+	PathCipher      BucketTemplate_PathCipher_Field
+	DataCipher      BucketTemplate_DataCipher_Field
+	BlockSize       BucketTemplate_BlockSize_Field
+	ShareSize       BucketTemplate_ShareSize_Field
+	RequiredShares  BucketTemplate_RequiredShares_Field
+	RepairShares    BucketTemplate_RepairShares_Field
+	OptimalShares   BucketTemplate_OptimalShares_Field
+	TotalShares     BucketTemplate_TotalShares_Field
+	DistinctSubnets BucketTemplate_DistinctSubnets_Field
+	DistinctRegions BucketTemplate_DistinctRegions_Field
+	UpdatedAt       BucketTemplate_UpdatedAt_Field
+}
+
+type BucketTemplate_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func OverlayCacheNode_AuditCount(v int64) OverlayCacheNode_AuditCount_Field {
-	return OverlayCacheNode_AuditCount_Field{_set: true, _value: v}
+func BucketTemplate_ProjectId(v []byte) BucketTemplate_ProjectId_Field {
+	return BucketTemplate_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_AuditCount_Field) value() interface{} {
+func (f BucketTemplate_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_AuditCount_Field) _Column() string { return "audit_count" }
+func (BucketTemplate_ProjectId_Field) _Column() string { return "project_id" }
 
-type OverlayCacheNode_AuditSuccessCount_Field struct {
+type BucketTemplate_PathCipher_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func OverlayCacheNode_AuditSuccessCount(v int64) OverlayCacheNode_AuditSuccessCount_Field {
-	return OverlayCacheNode_AuditSuccessCount_Field{_set: true, _value: v}
+func BucketTemplate_PathCipher(v int) BucketTemplate_PathCipher_Field {
+	return BucketTemplate_PathCipher_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_AuditSuccessCount_Field) value() interface{} {
+func (f BucketTemplate_PathCipher_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (BucketTemplate_PathCipher_Field) _Column() string { return "path_cipher" }
 
-type OverlayCacheNode_UptimeCount_Field struct {
+type BucketTemplate_DataCipher_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func OverlayCacheNode_UptimeCount(v int64) OverlayCacheNode_UptimeCount_Field {
-	return OverlayCacheNode_UptimeCount_Field{_set: true, _value: v}
+func BucketTemplate_DataCipher(v int) BucketTemplate_DataCipher_Field {
+	return BucketTemplate_DataCipher_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_UptimeCount_Field) value() interface{} {
+func (f BucketTemplate_DataCipher_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_UptimeCount_Field) _Column() string { return "uptime_count" }
+func (BucketTemplate_DataCipher_Field) _Column() string { return "data_cipher" }
 
-type OverlayCacheNode_UptimeSuccessCount_Field struct {
+type BucketTemplate_BlockSize_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func OverlayCacheNode_UptimeSuccessCount(v int64) OverlayCacheNode_UptimeSuccessCount_Field {
-	return OverlayCacheNode_UptimeSuccessCount_Field{_set: true, _value: v}
+func BucketTemplate_BlockSize(v int) BucketTemplate_BlockSize_Field {
+	return BucketTemplate_BlockSize_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_UptimeSuccessCount_Field) value() interface{} {
+func (f BucketTemplate_BlockSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+func (BucketTemplate_BlockSize_Field) _Column() string { return "block_size" }
 
-type Project struct {
-	Id          []byte
-	Name        string
-	Description string
-	CreatedAt   time.Time
+type BucketTemplate_ShareSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (Project) _Table() string { return "projects" }
+func BucketTemplate_ShareSize(v int) BucketTemplate_ShareSize_Field {
+	return BucketTemplate_ShareSize_Field{_set: true, _value: v}
+}
 
-type Project_Update_Fields struct {
-	Description Project_Description_Field
+func (f BucketTemplate_ShareSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Project_Id_Field struct {
+func (BucketTemplate_ShareSize_Field) _Column() string { return "share_size" }
+
+type BucketTemplate_RequiredShares_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func Project_Id(v []byte) Project_Id_Field {
-	return Project_Id_Field{_set: true, _value: v}
+func BucketTemplate_RequiredShares(v int) BucketTemplate_RequiredShares_Field {
+	return BucketTemplate_RequiredShares_Field{_set: true, _value: v}
 }
 
-func (f Project_Id_Field) value() interface{} {
+func (f BucketTemplate_RequiredShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Id_Field) _Column() string { return "id" }
+func (BucketTemplate_RequiredShares_Field) _Column() string { return "required_shares" }
 
-type Project_Name_Field struct {
+type BucketTemplate_RepairShares_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func Project_Name(v string) Project_Name_Field {
-	return Project_Name_Field{_set: true, _value: v}
+func BucketTemplate_RepairShares(v int) BucketTemplate_RepairShares_Field {
+	return BucketTemplate_RepairShares_Field{_set: true, _value: v}
 }
 
-func (f Project_Name_Field) value() interface{} {
+func (f BucketTemplate_RepairShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Name_Field) _Column() string { return "name" }
+func (BucketTemplate_RepairShares_Field) _Column() string { return "repair_shares" }
 
-type Project_Description_Field struct {
+type BucketTemplate_OptimalShares_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func Project_Description(v string) Project_Description_Field {
-	return Project_Description_Field{_set: true, _value: v}
+func BucketTemplate_OptimalShares(v int) BucketTemplate_OptimalShares_Field {
+	return BucketTemplate_OptimalShares_Field{_set: true, _value: v}
 }
 
-func (f Project_Description_Field) value() interface{} {
+func (f BucketTemplate_OptimalShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Description_Field) _Column() string { return "description" }
+func (BucketTemplate_OptimalShares_Field) _Column() string { return "optimal_shares" }
 
-type Project_CreatedAt_Field struct {
+type BucketTemplate_TotalShares_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
-	return Project_CreatedAt_Field{_set: true, _value: v}
+func BucketTemplate_TotalShares(v int) BucketTemplate_TotalShares_Field {
+	return BucketTemplate_TotalShares_Field{_set: true, _value: v}
 }
 
-func (f Project_CreatedAt_Field) value() interface{} {
+func (f BucketTemplate_TotalShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketTemplate_TotalShares_Field) _Column() string { return "total_shares" }
 
-type User struct {
-	Id           []byte
-	FirstName    string
-	LastName     string
-	Email        *string
-	PasswordHash []byte
-	CreatedAt    time.Time
+type BucketTemplate_DistinctSubnets_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
 }
 
-func (User) _Table() string { return "users" }
-
-type User_Create_Fields struct {
-	Email User_Email_Field
+func BucketTemplate_DistinctSubnets(v bool) BucketTemplate_DistinctSubnets_Field {
+	return BucketTemplate_DistinctSubnets_Field{_set: true, _value: v}
 }
 
-type User_Update_Fields struct {
-	FirstName    User_FirstName_Field
-	LastName     User_LastName_Field
-	Email        User_Email_Field
-	PasswordHash User_PasswordHash_Field
+func (f BucketTemplate_DistinctSubnets_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type User_Id_Field struct {
+func (BucketTemplate_DistinctSubnets_Field) _Column() string { return "distinct_subnets" }
+
+type BucketTemplate_DistinctRegions_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func User_Id(v []byte) User_Id_Field {
-	return User_Id_Field{_set: true, _value: v}
+func BucketTemplate_DistinctRegions(v bool) BucketTemplate_DistinctRegions_Field {
+	return BucketTemplate_DistinctRegions_Field{_set: true, _value: v}
 }
 
-func (f User_Id_Field) value() interface{} {
+func (f BucketTemplate_DistinctRegions_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Id_Field) _Column() string { return "id" }
+func (BucketTemplate_DistinctRegions_Field) _Column() string { return "distinct_regions" }
 
-type User_FirstName_Field struct {
+type BucketTemplate_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func User_FirstName(v string) User_FirstName_Field {
-	return User_FirstName_Field{_set: true, _value: v}
+func BucketTemplate_UpdatedAt(v time.Time) BucketTemplate_UpdatedAt_Field {
+	return BucketTemplate_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f User_FirstName_Field) value() interface{} {
+func (f BucketTemplate_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_FirstName_Field) _Column() string { return "first_name" }
+func (BucketTemplate_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type User_LastName_Field struct {
+type BucketTemplate_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func User_LastName(v string) User_LastName_Field {
-	return User_LastName_Field{_set: true, _value: v}
+func BucketTemplate_CreatedAt(v time.Time) BucketTemplate_CreatedAt_Field {
+	return BucketTemplate_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f User_LastName_Field) value() interface{} {
+func (f BucketTemplate_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_LastName_Field) _Column() string { return "last_name" }
+func (BucketTemplate_CreatedAt_Field) _Column() string { return "created_at" }
 
-type User_Email_Field struct {
-	_set   bool
-	_null  bool
-	_value *string
+type Bwagreement struct {
+	Serialnum     string
+	StorageNodeId []byte
+	UplinkId      []byte
+	Action        int64
+	Total         int64
+	CreatedAt     time.Time
+	ExpiresAt     time.Time
 }
 
-func User_Email(v string) User_Email_Field {
-	return User_Email_Field{_set: true, _value: &v}
-}
+func (Bwagreement) _Table() string { return "bwagreements" }
 
-func User_Email_Raw(v *string) User_Email_Field {
-	if v == nil {
-		return User_Email_Null()
-	}
-	return User_Email(*v)
+type Bwagreement_Update_Fields struct {
 }
 
-func User_Email_Null() User_Email_Field {
-	return User_Email_Field{_set: true, _null: true}
+type Bwagreement_Serialnum_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f User_Email_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Bwagreement_Serialnum(v string) Bwagreement_Serialnum_Field {
+	return Bwagreement_Serialnum_Field{_set: true, _value: v}
+}
 
-func (f User_Email_Field) value() interface{} {
+func (f Bwagreement_Serialnum_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Email_Field) _Column() string { return "email" }
+func (Bwagreement_Serialnum_Field) _Column() string { return "serialnum" }
 
-type User_PasswordHash_Field struct {
+type Bwagreement_StorageNodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func User_PasswordHash(v []byte) User_PasswordHash_Field {
-	return User_PasswordHash_Field{_set: true, _value: v}
+func Bwagreement_StorageNodeId(v []byte) Bwagreement_StorageNodeId_Field {
+	return Bwagreement_StorageNodeId_Field{_set: true, _value: v}
 }
 
-func (f User_PasswordHash_Field) value() interface{} {
+func (f Bwagreement_StorageNodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+func (Bwagreement_StorageNodeId_Field) _Column() string { return "storage_node_id" }
 
-type User_CreatedAt_Field struct {
+type Bwagreement_UplinkId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func User_CreatedAt(v time.Time) User_CreatedAt_Field {
-	return User_CreatedAt_Field{_set: true, _value: v}
+func Bwagreement_UplinkId(v []byte) Bwagreement_UplinkId_Field {
+	return Bwagreement_UplinkId_Field{_set: true, _value: v}
 }
 
-func (f User_CreatedAt_Field) value() interface{} {
+func (f Bwagreement_UplinkId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CreatedAt_Field) _Column() string { return "created_at" }
+func (Bwagreement_UplinkId_Field) _Column() string { return "uplink_id" }
 
-type ApiKey struct {
-	Id        []byte
-	ProjectId []byte
-	Key       []byte
-	Name      string
-	CreatedAt time.Time
+type Bwagreement_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (ApiKey) _Table() string { return "api_keys" }
+func Bwagreement_Action(v int64) Bwagreement_Action_Field {
+	return Bwagreement_Action_Field{_set: true, _value: v}
+}
 
-type ApiKey_Update_Fields struct {
-	Name ApiKey_Name_Field
+func (f Bwagreement_Action_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type ApiKey_Id_Field struct {
+func (Bwagreement_Action_Field) _Column() string { return "action" }
+
+type Bwagreement_Total_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ApiKey_Id(v []byte) ApiKey_Id_Field {
-	return ApiKey_Id_Field{_set: true, _value: v}
+func Bwagreement_Total(v int64) Bwagreement_Total_Field {
+	return Bwagreement_Total_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Id_Field) value() interface{} {
+func (f Bwagreement_Total_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Id_Field) _Column() string { return "id" }
+func (Bwagreement_Total_Field) _Column() string { return "total" }
 
-type ApiKey_ProjectId_Field struct {
+type Bwagreement_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
-	return ApiKey_ProjectId_Field{_set: true, _value: v}
+func Bwagreement_CreatedAt(v time.Time) Bwagreement_CreatedAt_Field {
+	return Bwagreement_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_ProjectId_Field) value() interface{} {
+func (f Bwagreement_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
+func (Bwagreement_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ApiKey_Key_Field struct {
+type Bwagreement_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func ApiKey_Key(v []byte) ApiKey_Key_Field {
-	return ApiKey_Key_Field{_set: true, _value: v}
+func Bwagreement_ExpiresAt(v time.Time) Bwagreement_ExpiresAt_Field {
+	return Bwagreement_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Key_Field) value() interface{} {
+func (f Bwagreement_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Key_Field) _Column() string { return "key" }
+func (Bwagreement_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type ApiKey_Name_Field struct {
+type Injuredsegment struct {
+	Id          int64
+	Path        string
+	Priority    int64
+	Info        []byte
+	Attempts    int64
+	LeasedUntil time.Time
+}
+
+func (Injuredsegment) _Table() string { return "injuredsegments" }
+
+type Injuredsegment_Update_Fields struct {
+	Priority    Injuredsegment_Priority_Field
+	Info        Injuredsegment_Info_Field
+	Attempts    Injuredsegment_Attempts_Field
+	LeasedUntil Injuredsegment_LeasedUntil_Field
+}
+
+type Injuredsegment_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func ApiKey_Name(v string) ApiKey_Name_Field {
-	return ApiKey_Name_Field{_set: true, _value: v}
+func Injuredsegment_Id(v int64) Injuredsegment_Id_Field {
+	return Injuredsegment_Id_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Name_Field) value() interface{} {
+func (f Injuredsegment_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Name_Field) _Column() string { return "name" }
+func (Injuredsegment_Id_Field) _Column() string { return "id" }
 
-type ApiKey_CreatedAt_Field struct {
+type Injuredsegment_Path_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
-	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+func Injuredsegment_Path(v string) Injuredsegment_Path_Field {
+	return Injuredsegment_Path_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_CreatedAt_Field) value() interface{} {
+func (f Injuredsegment_Path_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
+func (Injuredsegment_Path_Field) _Column() string { return "path" }
 
-type ProjectMember struct {
-	MemberId  []byte
-	ProjectId []byte
-	CreatedAt time.Time
+type Injuredsegment_Priority_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (ProjectMember) _Table() string { return "project_members" }
+func Injuredsegment_Priority(v int64) Injuredsegment_Priority_Field {
+	return Injuredsegment_Priority_Field{_set: true, _value: v}
+}
 
-type ProjectMember_Update_Fields struct {
+func (f Injuredsegment_Priority_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type ProjectMember_MemberId_Field struct {
+func (Injuredsegment_Priority_Field) _Column() string { return "priority" }
+
+type Injuredsegment_Info_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
-	return ProjectMember_MemberId_Field{_set: true, _value: v}
+func Injuredsegment_Info(v []byte) Injuredsegment_Info_Field {
+	return Injuredsegment_Info_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_MemberId_Field) value() interface{} {
+func (f Injuredsegment_Info_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
+func (Injuredsegment_Info_Field) _Column() string { return "info" }
 
-type ProjectMember_ProjectId_Field struct {
+type Injuredsegment_Attempts_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
-	return ProjectMember_ProjectId_Field{_set: true, _value: v}
+func Injuredsegment_Attempts(v int64) Injuredsegment_Attempts_Field {
+	return Injuredsegment_Attempts_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_ProjectId_Field) value() interface{} {
+func (f Injuredsegment_Attempts_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
+func (Injuredsegment_Attempts_Field) _Column() string { return "attempts" }
 
-type ProjectMember_CreatedAt_Field struct {
+type Injuredsegment_LeasedUntil_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
-	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
+func Injuredsegment_LeasedUntil(v time.Time) Injuredsegment_LeasedUntil_Field {
+	return Injuredsegment_LeasedUntil_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_CreatedAt_Field) value() interface{} {
+func (f Injuredsegment_LeasedUntil_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
-
-func toUTC(t time.Time) time.Time {
-	return t.UTC()
-}
+func (Injuredsegment_LeasedUntil_Field) _Column() string { return "leased_until" }
 
-func toDate(t time.Time) time.Time {
-	// keep up the minute portion so that translations between timezones will
-	// continue to reflect properly.
-	return t.Truncate(time.Minute)
+type Irreparabledb struct {
+	Segmentpath        []byte
+	ProjectId          []byte
+	Segmentdetail      []byte
+	PiecesLostCount    int64
+	SegDamagedUnixSec  int64
+	RepairAttemptCount int64
+	LastError          string
 }
 
-//
-// runtime support for building sql statements
-//
+func (Irreparabledb) _Table() string { return "irreparabledbs" }
 
-type __sqlbundle_SQL interface {
-	Render() string
+type Irreparabledb_Update_Fields struct {
+	Segmentdetail      Irreparabledb_Segmentdetail_Field
+	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
+	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
+	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+	LastError          Irreparabledb_LastError_Field
+}
 
-	private()
+type Irreparabledb_Segmentpath_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-type __sqlbundle_Dialect interface {
-	Rebind(sql string) string
+func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
+	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
 }
 
-type __sqlbundle_RenderOp int
+func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-const (
-	__sqlbundle_NoFlatten __sqlbundle_RenderOp = iota
-	__sqlbundle_NoTerminate
-)
+func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
 
-func __sqlbundle_Render(dialect __sqlbundle_Dialect, sql __sqlbundle_SQL, ops ...__sqlbundle_RenderOp) string {
-	out := sql.Render()
+type Irreparabledb_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	flatten := true
-	terminate := true
-	for _, op := range ops {
-		switch op {
-		case __sqlbundle_NoFlatten:
-			flatten = false
-		case __sqlbundle_NoTerminate:
-			terminate = false
-		}
-	}
+func Irreparabledb_ProjectId(v []byte) Irreparabledb_ProjectId_Field {
+	return Irreparabledb_ProjectId_Field{_set: true, _value: v}
+}
 
-	if flatten {
-		out = __sqlbundle_flattenSQL(out)
-	}
-	if terminate {
-		out += ";"
+func (f Irreparabledb_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	return dialect.Rebind(out)
+func (Irreparabledb_ProjectId_Field) _Column() string { return "project_id" }
+
+type Irreparabledb_Segmentdetail_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-var __sqlbundle_reSpace = regexp.MustCompile(`\s+`)
+func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
+	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+}
 
-func __sqlbundle_flattenSQL(s string) string {
-	return strings.TrimSpace(__sqlbundle_reSpace.ReplaceAllString(s, " "))
+func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-// this type is specially named to match up with the name returned by the
-// dialect impl in the sql package.
-type __sqlbundle_postgres struct{}
+func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
 
-func (p __sqlbundle_postgres) Rebind(sql string) string {
-	out := make([]byte, 0, len(sql)+10)
+type Irreparabledb_PiecesLostCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	j := 1
-	for i := 0; i < len(sql); i++ {
-		ch := sql[i]
-		if ch != '?' {
-			out = append(out, ch)
-			continue
-		}
+func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
+	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+}
 
-		out = append(out, '$')
-		out = append(out, strconv.Itoa(j)...)
-		j++
+func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-
-	return string(out)
+	return f._value
 }
 
-// this type is specially named to match up with the name returned by the
-// dialect impl in the sql package.
-type __sqlbundle_sqlite3 struct{}
+func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
 
-func (s __sqlbundle_sqlite3) Rebind(sql string) string {
-	return sql
+type Irreparabledb_SegDamagedUnixSec_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-type __sqlbundle_Literal string
+func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
+	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+}
 
-func (__sqlbundle_Literal) private() {}
+func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-func (l __sqlbundle_Literal) Render() string { return string(l) }
+func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
 
-type __sqlbundle_Literals struct {
-	Join string
-	SQLs []__sqlbundle_SQL
+type Irreparabledb_RepairAttemptCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (__sqlbundle_Literals) private() {}
-
-func (l __sqlbundle_Literals) Render() string {
-	var out bytes.Buffer
+func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
+	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+}
 
-	first := true
-	for _, sql := range l.SQLs {
-		if sql == nil {
-			continue
-		}
-		if !first {
-			out.WriteString(l.Join)
-		}
-		first = false
-		out.WriteString(sql.Render())
+func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-
-	return out.String()
+	return f._value
 }
 
-type __sqlbundle_Condition struct {
-	// set at compile/embed time
-	Name  string
-	Left  string
-	Equal bool
-	Right string
+func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
 
-	// set at runtime
-	Null bool
+type Irreparabledb_LastError_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (*__sqlbundle_Condition) private() {}
-
-func (c *__sqlbundle_Condition) Render() string {
+func Irreparabledb_LastError(v string) Irreparabledb_LastError_Field {
+	return Irreparabledb_LastError_Field{_set: true, _value: v}
+}
 
-	switch {
-	case c.Equal && c.Null:
-		return c.Left + " is null"
-	case c.Equal && !c.Null:
-		return c.Left + " = " + c.Right
-	case !c.Equal && c.Null:
-		return c.Left + " is not null"
-	case !c.Equal && !c.Null:
-		return c.Left + " != " + c.Right
-	default:
-		panic("unhandled case")
+func (f Irreparabledb_LastError_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
 }
 
-type __sqlbundle_Hole struct {
-	// set at compiile/embed time
-	Name string
+func (Irreparabledb_LastError_Field) _Column() string { return "last_error" }
 
-	// set at runtime
-	SQL __sqlbundle_SQL
+type NodePing struct {
+	Id            []byte
+	SuccessRate   float64
+	PingCount     int64
+	LastPingAt    time.Time
+	LastSuccessAt *time.Time
+	CreatedAt     time.Time
 }
 
-func (*__sqlbundle_Hole) private() {}
+func (NodePing) _Table() string { return "node_pings" }
 
-func (h *__sqlbundle_Hole) Render() string { return h.SQL.Render() }
+type NodePing_Create_Fields struct {
+	LastSuccessAt NodePing_LastSuccessAt_Field
+}
 
-//
-// end runtime support for building sql statements
-//
+type NodePing_Update_Fields struct {
+	SuccessRate   NodePing_SuccessRate_Field
+	PingCount     NodePing_PingCount_Field
+	LastPingAt    NodePing_LastPingAt_Field
+	LastSuccessAt NodePing_LastSuccessAt_Field
+}
 
-type Id_Row struct {
-	Id []byte
+type NodePing_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-type Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row struct {
-	Node_Id                         []byte
-	Node_CreatedAt                  time.Time
-	Node_AuditSuccessRatio          float64
-	AccountingRollup_StartTime      time.Time
-	AccountingRollup_PutTotal       int64
-	AccountingRollup_GetTotal       int64
-	AccountingRollup_GetAuditTotal  int64
-	AccountingRollup_GetRepairTotal int64
-	AccountingRollup_PutRepairTotal int64
-	AccountingRollup_AtRestTotal    float64
+func NodePing_Id(v []byte) NodePing_Id_Field {
+	return NodePing_Id_Field{_set: true, _value: v}
 }
 
-type OperatorWallet_Row struct {
-	OperatorWallet string
+func (f NodePing_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Value_Row struct {
-	Value time.Time
+func (NodePing_Id_Field) _Column() string { return "id" }
+
+type NodePing_SuccessRate_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
 }
 
-func (obj *postgresImpl) Create_Bwagreement(ctx context.Context,
-	bwagreement_serialnum Bwagreement_Serialnum_Field,
-	bwagreement_storage_node_id Bwagreement_StorageNodeId_Field,
-	bwagreement_uplink_id Bwagreement_UplinkId_Field,
-	bwagreement_action Bwagreement_Action_Field,
-	bwagreement_total Bwagreement_Total_Field,
-	bwagreement_expires_at Bwagreement_ExpiresAt_Field) (
-	bwagreement *Bwagreement, err error) {
+func NodePing_SuccessRate(v float64) NodePing_SuccessRate_Field {
+	return NodePing_SuccessRate_Field{_set: true, _value: v}
+}
 
-	__now := obj.db.Hooks.Now().UTC()
-	__serialnum_val := bwagreement_serialnum.value()
-	__storage_node_id_val := bwagreement_storage_node_id.value()
-	__uplink_id_val := bwagreement_uplink_id.value()
-	__action_val := bwagreement_action.value()
-	__total_val := bwagreement_total.value()
-	__created_at_val := __now
-	__expires_at_val := bwagreement_expires_at.value()
+func (f NodePing_SuccessRate_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreements ( serialnum, storage_node_id, uplink_id, action, total, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at")
+func (NodePing_SuccessRate_Field) _Column() string { return "success_rate" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __serialnum_val, __storage_node_id_val, __uplink_id_val, __action_val, __total_val, __created_at_val, __expires_at_val)
+type NodePing_PingCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	bwagreement = &Bwagreement{}
-	err = obj.driver.QueryRow(__stmt, __serialnum_val, __storage_node_id_val, __uplink_id_val, __action_val, __total_val, __created_at_val, __expires_at_val).Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return bwagreement, nil
+func NodePing_PingCount(v int64) NodePing_PingCount_Field {
+	return NodePing_PingCount_Field{_set: true, _value: v}
+}
 
+func (f NodePing_PingCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-func (obj *postgresImpl) Create_Irreparabledb(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
-	irreparabledb_segmentdetail Irreparabledb_Segmentdetail_Field,
-	irreparabledb_pieces_lost_count Irreparabledb_PiecesLostCount_Field,
-	irreparabledb_seg_damaged_unix_sec Irreparabledb_SegDamagedUnixSec_Field,
-	irreparabledb_repair_attempt_count Irreparabledb_RepairAttemptCount_Field) (
-	irreparabledb *Irreparabledb, err error) {
-	__segmentpath_val := irreparabledb_segmentpath.value()
-	__segmentdetail_val := irreparabledb_segmentdetail.value()
-	__pieces_lost_count_val := irreparabledb_pieces_lost_count.value()
-	__seg_damaged_unix_sec_val := irreparabledb_seg_damaged_unix_sec.value()
-	__repair_attempt_count_val := irreparabledb_repair_attempt_count.value()
+func (NodePing_PingCount_Field) _Column() string { return "ping_count" }
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO irreparabledbs ( segmentpath, segmentdetail, pieces_lost_count, seg_damaged_unix_sec, repair_attempt_count ) VALUES ( ?, ?, ?, ?, ? ) RETURNING irreparabledbs.segmentpath, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count")
+type NodePing_LastPingAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __segmentpath_val, __segmentdetail_val, __pieces_lost_count_val, __seg_damaged_unix_sec_val, __repair_attempt_count_val)
+func NodePing_LastPingAt(v time.Time) NodePing_LastPingAt_Field {
+	return NodePing_LastPingAt_Field{_set: true, _value: v}
+}
 
-	irreparabledb = &Irreparabledb{}
-	err = obj.driver.QueryRow(__stmt, __segmentpath_val, __segmentdetail_val, __pieces_lost_count_val, __seg_damaged_unix_sec_val, __repair_attempt_count_val).Scan(&irreparabledb.Segmentpath, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f NodePing_LastPingAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return irreparabledb, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) Create_AccountingTimestamps(ctx context.Context,
-	accounting_timestamps_name AccountingTimestamps_Name_Field,
-	accounting_timestamps_value AccountingTimestamps_Value_Field) (
-	accounting_timestamps *AccountingTimestamps, err error) {
-	__name_val := accounting_timestamps_name.value()
-	__value_val := accounting_timestamps_value.value()
+func (NodePing_LastPingAt_Field) _Column() string { return "last_ping_at" }
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_timestamps ( name, value ) VALUES ( ?, ? ) RETURNING accounting_timestamps.name, accounting_timestamps.value")
+type NodePing_LastSuccessAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __name_val, __value_val)
+func NodePing_LastSuccessAt(v time.Time) NodePing_LastSuccessAt_Field {
+	return NodePing_LastSuccessAt_Field{_set: true, _value: &v}
+}
 
-	accounting_timestamps = &AccountingTimestamps{}
-	err = obj.driver.QueryRow(__stmt, __name_val, __value_val).Scan(&accounting_timestamps.Name, &accounting_timestamps.Value)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func NodePing_LastSuccessAt_Raw(v *time.Time) NodePing_LastSuccessAt_Field {
+	if v == nil {
+		return NodePing_LastSuccessAt_Null()
 	}
-	return accounting_timestamps, nil
+	return NodePing_LastSuccessAt(*v)
+}
 
+func NodePing_LastSuccessAt_Null() NodePing_LastSuccessAt_Field {
+	return NodePing_LastSuccessAt_Field{_set: true, _null: true}
 }
 
-func (obj *postgresImpl) Create_AccountingRollup(ctx context.Context,
-	accounting_rollup_node_id AccountingRollup_NodeId_Field,
-	accounting_rollup_start_time AccountingRollup_StartTime_Field,
-	accounting_rollup_put_total AccountingRollup_PutTotal_Field,
-	accounting_rollup_get_total AccountingRollup_GetTotal_Field,
-	accounting_rollup_get_audit_total AccountingRollup_GetAuditTotal_Field,
-	accounting_rollup_get_repair_total AccountingRollup_GetRepairTotal_Field,
-	accounting_rollup_put_repair_total AccountingRollup_PutRepairTotal_Field,
-	accounting_rollup_at_rest_total AccountingRollup_AtRestTotal_Field) (
-	accounting_rollup *AccountingRollup, err error) {
-	__node_id_val := accounting_rollup_node_id.value()
-	__start_time_val := accounting_rollup_start_time.value()
-	__put_total_val := accounting_rollup_put_total.value()
-	__get_total_val := accounting_rollup_get_total.value()
-	__get_audit_total_val := accounting_rollup_get_audit_total.value()
-	__get_repair_total_val := accounting_rollup_get_repair_total.value()
-	__put_repair_total_val := accounting_rollup_put_repair_total.value()
-	__at_rest_total_val := accounting_rollup_at_rest_total.value()
+func (f NodePing_LastSuccessAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_rollups ( node_id, start_time, put_total, get_total, get_audit_total, get_repair_total, put_repair_total, at_rest_total ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total")
+func (f NodePing_LastSuccessAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val)
+func (NodePing_LastSuccessAt_Field) _Column() string { return "last_success_at" }
 
-	accounting_rollup = &AccountingRollup{}
-	err = obj.driver.QueryRow(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return accounting_rollup, nil
+type NodePing_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
+func NodePing_CreatedAt(v time.Time) NodePing_CreatedAt_Field {
+	return NodePing_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Create_AccountingRaw(ctx context.Context,
-	accounting_raw_node_id AccountingRaw_NodeId_Field,
-	accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
-	accounting_raw_data_total AccountingRaw_DataTotal_Field,
-	accounting_raw_data_type AccountingRaw_DataType_Field) (
-	accounting_raw *AccountingRaw, err error) {
+func (f NodePing_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	__now := obj.db.Hooks.Now().UTC()
-	__node_id_val := accounting_raw_node_id.value()
-	__interval_end_time_val := accounting_raw_interval_end_time.value()
-	__data_total_val := accounting_raw_data_total.value()
-	__data_type_val := accounting_raw_data_type.value()
-	__created_at_val := __now
+func (NodePing_CreatedAt_Field) _Column() string { return "created_at" }
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_raws ( node_id, interval_end_time, data_total, data_type, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at")
+type NodeStanding struct {
+	Id     []byte
+	Status int
+	Reason string
+	Since  time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val)
+func (NodeStanding) _Table() string { return "node_standings" }
 
-	accounting_raw = &AccountingRaw{}
-	err = obj.driver.QueryRow(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val).Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return accounting_raw, nil
+type NodeStanding_Update_Fields struct {
+	Status NodeStanding_Status_Field
+	Reason NodeStanding_Reason_Field
+	Since  NodeStanding_Since_Field
+}
 
+type NodeStanding_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (obj *postgresImpl) Create_Node(ctx context.Context,
-	node_id Node_Id_Field,
-	node_audit_success_count Node_AuditSuccessCount_Field,
-	node_total_audit_count Node_TotalAuditCount_Field,
-	node_audit_success_ratio Node_AuditSuccessRatio_Field,
-	node_uptime_success_count Node_UptimeSuccessCount_Field,
-	node_total_uptime_count Node_TotalUptimeCount_Field,
-	node_uptime_ratio Node_UptimeRatio_Field) (
-	node *Node, err error) {
+func NodeStanding_Id(v []byte) NodeStanding_Id_Field {
+	return NodeStanding_Id_Field{_set: true, _value: v}
+}
 
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := node_id.value()
-	__audit_success_count_val := node_audit_success_count.value()
-	__total_audit_count_val := node_total_audit_count.value()
-	__audit_success_ratio_val := node_audit_success_ratio.value()
-	__uptime_success_count_val := node_uptime_success_count.value()
-	__total_uptime_count_val := node_total_uptime_count.value()
-	__uptime_ratio_val := node_uptime_ratio.value()
-	__created_at_val := __now
-	__updated_at_val := __now
+func (f NodeStanding_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at")
+func (NodeStanding_Id_Field) _Column() string { return "id" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val)
+type NodeStanding_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
 
-	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return node, nil
+func NodeStanding_Status(v int) NodeStanding_Status_Field {
+	return NodeStanding_Status_Field{_set: true, _value: v}
+}
 
+func (f NodeStanding_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-func (obj *postgresImpl) Create_OverlayCacheNode(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
-	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
-	overlay_cache_node_address OverlayCacheNode_Address_Field,
-	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
-	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
-	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
-	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
-	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
-	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
-	overlay_cache_node_audit_success_ratio OverlayCacheNode_AuditSuccessRatio_Field,
-	overlay_cache_node_audit_uptime_ratio OverlayCacheNode_AuditUptimeRatio_Field,
-	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
-	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
-	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
-	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
-	overlay_cache_node *OverlayCacheNode, err error) {
-	__node_id_val := overlay_cache_node_node_id.value()
-	__node_type_val := overlay_cache_node_node_type.value()
-	__address_val := overlay_cache_node_address.value()
-	__protocol_val := overlay_cache_node_protocol.value()
-	__operator_email_val := overlay_cache_node_operator_email.value()
-	__operator_wallet_val := overlay_cache_node_operator_wallet.value()
-	__free_bandwidth_val := overlay_cache_node_free_bandwidth.value()
-	__free_disk_val := overlay_cache_node_free_disk.value()
-	__latency_90_val := overlay_cache_node_latency_90.value()
-	__audit_success_ratio_val := overlay_cache_node_audit_success_ratio.value()
-	__audit_uptime_ratio_val := overlay_cache_node_audit_uptime_ratio.value()
-	__audit_count_val := overlay_cache_node_audit_count.value()
-	__audit_success_count_val := overlay_cache_node_audit_success_count.value()
-	__uptime_count_val := overlay_cache_node_uptime_count.value()
-	__uptime_success_count_val := overlay_cache_node_uptime_success_count.value()
+func (NodeStanding_Status_Field) _Column() string { return "status" }
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, operator_email, operator_wallet, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count")
+type NodeStanding_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val)
+func NodeStanding_Reason(v string) NodeStanding_Reason_Field {
+	return NodeStanding_Reason_Field{_set: true, _value: v}
+}
 
-	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f NodeStanding_Reason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return overlay_cache_node, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) Create_Injuredsegment(ctx context.Context,
-	injuredsegment_info Injuredsegment_Info_Field) (
-	injuredsegment *Injuredsegment, err error) {
-	__info_val := injuredsegment_info.value()
+func (NodeStanding_Reason_Field) _Column() string { return "reason" }
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( info ) VALUES ( ? ) RETURNING injuredsegments.id, injuredsegments.info")
+type NodeStanding_Since_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __info_val)
+func NodeStanding_Since(v time.Time) NodeStanding_Since_Field {
+	return NodeStanding_Since_Field{_set: true, _value: v}
+}
 
-	injuredsegment = &Injuredsegment{}
-	err = obj.driver.QueryRow(__stmt, __info_val).Scan(&injuredsegment.Id, &injuredsegment.Info)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f NodeStanding_Since_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return injuredsegment, nil
+	return f._value
+}
 
+func (NodeStanding_Since_Field) _Column() string { return "since" }
+
+type Node struct {
+	Id                    []byte
+	AuditSuccessCount     int64
+	TotalAuditCount       int64
+	AuditSuccessRatio     float64
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeSuccessCount    int64
+	TotalUptimeCount      int64
+	UptimeRatio           float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
 }
 
-func (obj *postgresImpl) Create_User(ctx context.Context,
-	user_id User_Id_Field,
-	user_first_name User_FirstName_Field,
-	user_last_name User_LastName_Field,
-	user_password_hash User_PasswordHash_Field,
-	optional User_Create_Fields) (
-	user *User, err error) {
+func (Node) _Table() string { return "nodes" }
 
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := user_id.value()
-	__first_name_val := user_first_name.value()
-	__last_name_val := user_last_name.value()
-	__email_val := optional.Email.value()
-	__password_hash_val := user_password_hash.value()
-	__created_at_val := __now
+type Node_Update_Fields struct {
+	AuditSuccessCount     Node_AuditSuccessCount_Field
+	TotalAuditCount       Node_TotalAuditCount_Field
+	AuditSuccessRatio     Node_AuditSuccessRatio_Field
+	AuditReputationAlpha  Node_AuditReputationAlpha_Field
+	AuditReputationBeta   Node_AuditReputationBeta_Field
+	UptimeSuccessCount    Node_UptimeSuccessCount_Field
+	TotalUptimeCount      Node_TotalUptimeCount_Field
+	UptimeRatio           Node_UptimeRatio_Field
+	UptimeReputationAlpha Node_UptimeReputationAlpha_Field
+	UptimeReputationBeta  Node_UptimeReputationBeta_Field
+}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, first_name, last_name, email, password_hash, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at")
+type Node_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __first_name_val, __last_name_val, __email_val, __password_hash_val, __created_at_val)
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
+}
 
-	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __first_name_val, __last_name_val, __email_val, __password_hash_val, __created_at_val).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Node_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return user, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) Create_Project(ctx context.Context,
-	project_id Project_Id_Field,
-	project_name Project_Name_Field,
-	project_description Project_Description_Field) (
-	project *Project, err error) {
+func (Node_Id_Field) _Column() string { return "id" }
 
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := project_id.value()
-	__name_val := project_name.value()
-	__description_val := project_description.value()
-	__created_at_val := __now
-
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.created_at")
+type Node_AuditSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
+	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+}
 
-	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Node_AuditSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return project, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) Create_ProjectMember(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
-	project_member *ProjectMember, err error) {
-
-	__now := obj.db.Hooks.Now().UTC()
-	__member_id_val := project_member_member_id.value()
-	__project_id_val := project_member_project_id.value()
-	__created_at_val := __now
+func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+type Node_TotalAuditCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
+	return Node_TotalAuditCount_Field{_set: true, _value: v}
+}
 
-	project_member = &ProjectMember{}
-	err = obj.driver.QueryRow(__stmt, __member_id_val, __project_id_val, __created_at_val).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Node_TotalAuditCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return project_member, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) Create_ApiKey(ctx context.Context,
-	api_key_id ApiKey_Id_Field,
-	api_key_project_id ApiKey_ProjectId_Field,
-	api_key_key ApiKey_Key_Field,
-	api_key_name ApiKey_Name_Field) (
-	api_key *ApiKey, err error) {
-
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := api_key_id.value()
-	__project_id_val := api_key_project_id.value()
-	__key_val := api_key_key.value()
-	__name_val := api_key_name.value()
-	__created_at_val := __now
+func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, name, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at")
+type Node_AuditSuccessRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+func Node_AuditSuccessRatio(v float64) Node_AuditSuccessRatio_Field {
+	return Node_AuditSuccessRatio_Field{_set: true, _value: v}
+}
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Node_AuditSuccessRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return api_key, nil
+	return f._value
+}
+
+func (Node_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
 
+type Node_AuditReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
 }
 
-func (obj *postgresImpl) Limited_Bwagreement(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Bwagreement, err error) {
+func Node_AuditReputationAlpha(v float64) Node_AuditReputationAlpha_Field {
+	return Node_AuditReputationAlpha_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements LIMIT ? OFFSET ?")
+func (f Node_AuditReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values)
+func (Node_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
 
-	__values = append(__values, limit, offset)
+type Node_AuditReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Node_AuditReputationBeta(v float64) Node_AuditReputationBeta_Field {
+	return Node_AuditReputationBeta_Field{_set: true, _value: v}
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Node_AuditReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (Node_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
 
+type Node_UptimeSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (obj *postgresImpl) All_Bwagreement(ctx context.Context) (
-	rows []*Bwagreement, err error) {
+func Node_UptimeSuccessCount(v int64) Node_UptimeSuccessCount_Field {
+	return Node_UptimeSuccessCount_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements")
+func (f Node_UptimeSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values)
+func (Node_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type Node_TotalUptimeCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
+func Node_TotalUptimeCount(v int64) Node_TotalUptimeCount_Field {
+	return Node_TotalUptimeCount_Field{_set: true, _value: v}
+}
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+func (f Node_TotalUptimeCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return rows, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
-	bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
-	rows []*Bwagreement, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements WHERE bwagreements.created_at > ?")
+func (Node_TotalUptimeCount_Field) _Column() string { return "total_uptime_count" }
 
-	var __values []interface{}
-	__values = append(__values, bwagreement_created_at_greater.value())
+type Node_UptimeRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Node_UptimeRatio(v float64) Node_UptimeRatio_Field {
+	return Node_UptimeRatio_Field{_set: true, _value: v}
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Node_UptimeRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (Node_UptimeRatio_Field) _Column() string { return "uptime_ratio" }
 
+type Node_UptimeReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
 }
 
-func (obj *postgresImpl) Get_Irreparabledb_By_Segmentpath(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
-	irreparabledb *Irreparabledb, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+func Node_UptimeReputationAlpha(v float64) Node_UptimeReputationAlpha_Field {
+	return Node_UptimeReputationAlpha_Field{_set: true, _value: v}
+}
 
-	var __values []interface{}
-	__values = append(__values, irreparabledb_segmentpath.value())
+func (f Node_UptimeReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func (Node_UptimeReputationAlpha_Field) _Column() string { return "uptime_reputation_alpha" }
 
-	irreparabledb = &Irreparabledb{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&irreparabledb.Segmentpath, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return irreparabledb, nil
+type Node_UptimeReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
 
+func Node_UptimeReputationBeta(v float64) Node_UptimeReputationBeta_Field {
+	return Node_UptimeReputationBeta_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
-	accounting_timestamps_name AccountingTimestamps_Name_Field) (
-	row *Value_Row, err error) {
+func (f Node_UptimeReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_timestamps.value FROM accounting_timestamps WHERE accounting_timestamps.name = ?")
+func (Node_UptimeReputationBeta_Field) _Column() string { return "uptime_reputation_beta" }
 
-	var __values []interface{}
-	__values = append(__values, accounting_timestamps_name.value())
+type Node_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
+	return Node_CreatedAt_Field{_set: true, _value: v}
+}
 
-	row = &Value_Row{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.Value)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Node_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return row, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) Get_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
-	accounting_rollup *AccountingRollup, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.id = ?")
+func (Node_CreatedAt_Field) _Column() string { return "created_at" }
 
-	var __values []interface{}
-	__values = append(__values, accounting_rollup_id.value())
+type Node_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
+	return Node_UpdatedAt_Field{_set: true, _value: v}
+}
 
-	accounting_rollup = &AccountingRollup{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f Node_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return accounting_rollup, nil
+	return f._value
+}
 
+func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type ObjectTag struct {
+	ProjectId     []byte
+	BucketName    []byte
+	TagKey        []byte
+	TagValue      []byte
+	EncryptedPath []byte
 }
 
-func (obj *postgresImpl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Context,
-	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
-	rows []*AccountingRollup, err error) {
+func (ObjectTag) _Table() string { return "object_tags" }
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.start_time >= ?")
+type ObjectTag_Update_Fields struct {
+}
 
-	var __values []interface{}
-	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value())
+type ObjectTag_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func ObjectTag_ProjectId(v []byte) ObjectTag_ProjectId_Field {
+	return ObjectTag_ProjectId_Field{_set: true, _value: v}
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f ObjectTag_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		accounting_rollup := &AccountingRollup{}
-		err = __rows.Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, accounting_rollup)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (ObjectTag_ProjectId_Field) _Column() string { return "project_id" }
 
+type ObjectTag_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (obj *postgresImpl) Get_AccountingRaw_By_Id(ctx context.Context,
-	accounting_raw_id AccountingRaw_Id_Field) (
-	accounting_raw *AccountingRaw, err error) {
+func ObjectTag_BucketName(v []byte) ObjectTag_BucketName_Field {
+	return ObjectTag_BucketName_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE accounting_raws.id = ?")
+func (f ObjectTag_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values, accounting_raw_id.value())
+func (ObjectTag_BucketName_Field) _Column() string { return "bucket_name" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type ObjectTag_TagKey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	accounting_raw = &AccountingRaw{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func ObjectTag_TagKey(v []byte) ObjectTag_TagKey_Field {
+	return ObjectTag_TagKey_Field{_set: true, _value: v}
+}
+
+func (f ObjectTag_TagKey_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return accounting_raw, nil
+	return f._value
+}
 
+func (ObjectTag_TagKey_Field) _Column() string { return "tag_key" }
+
+type ObjectTag_TagValue_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (obj *postgresImpl) All_AccountingRaw(ctx context.Context) (
-	rows []*AccountingRaw, err error) {
+func ObjectTag_TagValue(v []byte) ObjectTag_TagValue_Field {
+	return ObjectTag_TagValue_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws")
+func (f ObjectTag_TagValue_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values)
+func (ObjectTag_TagValue_Field) _Column() string { return "tag_value" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type ObjectTag_EncryptedPath_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
+func ObjectTag_EncryptedPath(v []byte) ObjectTag_EncryptedPath_Field {
+	return ObjectTag_EncryptedPath_Field{_set: true, _value: v}
+}
 
-	for __rows.Next() {
-		accounting_raw := &AccountingRaw{}
-		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, accounting_raw)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+func (f ObjectTag_EncryptedPath_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return rows, nil
+	return f._value
+}
 
+func (ObjectTag_EncryptedPath_Field) _Column() string { return "encrypted_path" }
+
+type OverlayCacheNode struct {
+	NodeId                []byte
+	NodeType              int
+	Address               string
+	Protocol              int
+	LastNet               string
+	OperatorEmail         string
+	OperatorWallet        string
+	OperatorRegion        string
+	Version               string
+	FreeBandwidth         int64
+	FreeDisk              int64
+	Latency90             int64
+	AuditSuccessRatio     float64
+	AuditUptimeRatio      float64
+	AuditCount            int64
+	AuditSuccessCount     int64
+	UptimeCount           int64
+	UptimeSuccessCount    int64
+	AuditReputationScore  float64
+	UptimeReputationScore float64
 }
 
-func (obj *postgresImpl) All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx context.Context,
-	accounting_raw_interval_end_time_greater_or_equal AccountingRaw_IntervalEndTime_Field) (
-	rows []*AccountingRaw, err error) {
+func (OverlayCacheNode) _Table() string { return "overlay_cache_nodes" }
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE accounting_raws.interval_end_time >= ?")
+type OverlayCacheNode_Update_Fields struct {
+	Address               OverlayCacheNode_Address_Field
+	Protocol              OverlayCacheNode_Protocol_Field
+	LastNet               OverlayCacheNode_LastNet_Field
+	OperatorEmail         OverlayCacheNode_OperatorEmail_Field
+	OperatorWallet        OverlayCacheNode_OperatorWallet_Field
+	OperatorRegion        OverlayCacheNode_OperatorRegion_Field
+	Version               OverlayCacheNode_Version_Field
+	FreeBandwidth         OverlayCacheNode_FreeBandwidth_Field
+	FreeDisk              OverlayCacheNode_FreeDisk_Field
+	Latency90             OverlayCacheNode_Latency90_Field
+	AuditSuccessRatio     OverlayCacheNode_AuditSuccessRatio_Field
+	AuditUptimeRatio      OverlayCacheNode_AuditUptimeRatio_Field
+	AuditCount            OverlayCacheNode_AuditCount_Field
+	AuditSuccessCount     OverlayCacheNode_AuditSuccessCount_Field
+	UptimeCount           OverlayCacheNode_UptimeCount_Field
+	UptimeSuccessCount    OverlayCacheNode_UptimeSuccessCount_Field
+	AuditReputationScore  OverlayCacheNode_AuditReputationScore_Field
+	UptimeReputationScore OverlayCacheNode_UptimeReputationScore_Field
+}
 
-	var __values []interface{}
-	__values = append(__values, accounting_raw_interval_end_time_greater_or_equal.value())
+type OverlayCacheNode_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func OverlayCacheNode_NodeId(v []byte) OverlayCacheNode_NodeId_Field {
+	return OverlayCacheNode_NodeId_Field{_set: true, _value: v}
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		accounting_raw := &AccountingRaw{}
-		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, accounting_raw)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (OverlayCacheNode_NodeId_Field) _Column() string { return "node_id" }
+
+type OverlayCacheNode_NodeType_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
 
+func OverlayCacheNode_NodeType(v int) OverlayCacheNode_NodeType_Field {
+	return OverlayCacheNode_NodeType_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
-	node *Node, err error) {
+func (f OverlayCacheNode_NodeType_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+func (OverlayCacheNode_NodeType_Field) _Column() string { return "node_type" }
 
-	var __values []interface{}
-	__values = append(__values, node_id.value())
+type OverlayCacheNode_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func OverlayCacheNode_Address(v string) OverlayCacheNode_Address_Field {
+	return OverlayCacheNode_Address_Field{_set: true, _value: v}
+}
 
-	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return node, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) All_Node_Id(ctx context.Context) (
-	rows []*Id_Row, err error) {
+func (OverlayCacheNode_Address_Field) _Column() string { return "address" }
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id FROM nodes")
-
-	var __values []interface{}
-	__values = append(__values)
+type OverlayCacheNode_Protocol_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func OverlayCacheNode_Protocol(v int) OverlayCacheNode_Protocol_Field {
+	return OverlayCacheNode_Protocol_Field{_set: true, _value: v}
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_Protocol_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		row := &Id_Row{}
-		err = __rows.Scan(&row.Id)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, row)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (OverlayCacheNode_Protocol_Field) _Column() string { return "protocol" }
 
+type OverlayCacheNode_LastNet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (obj *postgresImpl) All_Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_By_AccountingRollup_StartTime_GreaterOrEqual_And_AccountingRollup_StartTime_Less_OrderBy_Asc_Node_Id(ctx context.Context,
-	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field,
-	accounting_rollup_start_time_less AccountingRollup_StartTime_Field) (
-	rows []*Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row, err error) {
+func OverlayCacheNode_LastNet(v string) OverlayCacheNode_LastNet_Field {
+	return OverlayCacheNode_LastNet_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.created_at, nodes.audit_success_ratio, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM nodes  JOIN accounting_rollups ON nodes.id = accounting_rollups.node_id WHERE accounting_rollups.start_time >= ? AND accounting_rollups.start_time < ? ORDER BY nodes.id")
+func (f OverlayCacheNode_LastNet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value(), accounting_rollup_start_time_less.value())
+func (OverlayCacheNode_LastNet_Field) _Column() string { return "last_net" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type OverlayCacheNode_OperatorEmail_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
+func OverlayCacheNode_OperatorEmail(v string) OverlayCacheNode_OperatorEmail_Field {
+	return OverlayCacheNode_OperatorEmail_Field{_set: true, _value: v}
+}
 
-	for __rows.Next() {
-		row := &Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row{}
-		err = __rows.Scan(&row.Node_Id, &row.Node_CreatedAt, &row.Node_AuditSuccessRatio, &row.AccountingRollup_StartTime, &row.AccountingRollup_PutTotal, &row.AccountingRollup_GetTotal, &row.AccountingRollup_GetAuditTotal, &row.AccountingRollup_GetRepairTotal, &row.AccountingRollup_PutRepairTotal, &row.AccountingRollup_AtRestTotal)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, row)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_OperatorEmail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return rows, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
-	overlay_cache_node *OverlayCacheNode, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+func (OverlayCacheNode_OperatorEmail_Field) _Column() string { return "operator_email" }
 
-	var __values []interface{}
-	__values = append(__values, overlay_cache_node_node_id.value())
+type OverlayCacheNode_OperatorWallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func OverlayCacheNode_OperatorWallet(v string) OverlayCacheNode_OperatorWallet_Field {
+	return OverlayCacheNode_OperatorWallet_Field{_set: true, _value: v}
+}
 
-	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_OperatorWallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return overlay_cache_node, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
-	row *OperatorWallet_Row, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.operator_wallet FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+func (OverlayCacheNode_OperatorWallet_Field) _Column() string { return "operator_wallet" }
 
-	var __values []interface{}
-	__values = append(__values, overlay_cache_node_node_id.value())
+type OverlayCacheNode_OperatorRegion_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func OverlayCacheNode_OperatorRegion(v string) OverlayCacheNode_OperatorRegion_Field {
+	return OverlayCacheNode_OperatorRegion_Field{_set: true, _value: v}
+}
 
-	row = &OperatorWallet_Row{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.OperatorWallet)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_OperatorRegion_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return row, nil
+	return f._value
+}
 
+func (OverlayCacheNode_OperatorRegion_Field) _Column() string { return "operator_region" }
+
+type OverlayCacheNode_Version_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (obj *postgresImpl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx context.Context,
-	overlay_cache_node_node_id_greater_or_equal OverlayCacheNode_NodeId_Field,
-	limit int, offset int64) (
-	rows []*OverlayCacheNode, err error) {
+func OverlayCacheNode_Version(v string) OverlayCacheNode_Version_Field {
+	return OverlayCacheNode_Version_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
+func (f OverlayCacheNode_Version_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values, overlay_cache_node_node_id_greater_or_equal.value())
+func (OverlayCacheNode_Version_Field) _Column() string { return "version" }
 
-	__values = append(__values, limit, offset)
+type OverlayCacheNode_FreeBandwidth_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func OverlayCacheNode_FreeBandwidth(v int64) OverlayCacheNode_FreeBandwidth_Field {
+	return OverlayCacheNode_FreeBandwidth_Field{_set: true, _value: v}
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_FreeBandwidth_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		overlay_cache_node := &OverlayCacheNode{}
-		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, overlay_cache_node)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (OverlayCacheNode_FreeBandwidth_Field) _Column() string { return "free_bandwidth" }
 
+type OverlayCacheNode_FreeDisk_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (obj *postgresImpl) First_Injuredsegment(ctx context.Context) (
-	injuredsegment *Injuredsegment, err error) {
+func OverlayCacheNode_FreeDisk(v int64) OverlayCacheNode_FreeDisk_Field {
+	return OverlayCacheNode_FreeDisk_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT 1 OFFSET 0")
+func (f OverlayCacheNode_FreeDisk_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values)
+func (OverlayCacheNode_FreeDisk_Field) _Column() string { return "free_disk" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type OverlayCacheNode_Latency90_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
+func OverlayCacheNode_Latency90(v int64) OverlayCacheNode_Latency90_Field {
+	return OverlayCacheNode_Latency90_Field{_set: true, _value: v}
+}
 
-	if !__rows.Next() {
-		if err := __rows.Err(); err != nil {
-			return nil, obj.makeErr(err)
-		}
-		return nil, nil
+func (f OverlayCacheNode_Latency90_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	injuredsegment = &Injuredsegment{}
-	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
+func (OverlayCacheNode_Latency90_Field) _Column() string { return "latency_90" }
 
-	return injuredsegment, nil
+type OverlayCacheNode_AuditSuccessRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
 
+func OverlayCacheNode_AuditSuccessRatio(v float64) OverlayCacheNode_AuditSuccessRatio_Field {
+	return OverlayCacheNode_AuditSuccessRatio_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Limited_Injuredsegment(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Injuredsegment, err error) {
+func (f OverlayCacheNode_AuditSuccessRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT ? OFFSET ?")
+func (OverlayCacheNode_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
 
-	var __values []interface{}
-	__values = append(__values)
+type OverlayCacheNode_AuditUptimeRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
 
-	__values = append(__values, limit, offset)
+func OverlayCacheNode_AuditUptimeRatio(v float64) OverlayCacheNode_AuditUptimeRatio_Field {
+	return OverlayCacheNode_AuditUptimeRatio_Field{_set: true, _value: v}
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_AuditUptimeRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		injuredsegment := &Injuredsegment{}
-		err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, injuredsegment)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (OverlayCacheNode_AuditUptimeRatio_Field) _Column() string { return "audit_uptime_ratio" }
 
+type OverlayCacheNode_AuditCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (obj *postgresImpl) Get_User_By_Email(ctx context.Context,
-	user_email User_Email_Field) (
-	user *User, err error) {
+func OverlayCacheNode_AuditCount(v int64) OverlayCacheNode_AuditCount_Field {
+	return OverlayCacheNode_AuditCount_Field{_set: true, _value: v}
+}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "users.email", Equal: true, Right: "?", Null: true}
+func (f OverlayCacheNode_AuditCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE "), __cond_0}}
+func (OverlayCacheNode_AuditCount_Field) _Column() string { return "audit_count" }
 
-	var __values []interface{}
-	__values = append(__values)
+type OverlayCacheNode_AuditSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	if !user_email.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, user_email.value())
+func OverlayCacheNode_AuditSuccessCount(v int64) OverlayCacheNode_AuditSuccessCount_Field {
+	return OverlayCacheNode_AuditSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func (OverlayCacheNode_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
 
-	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return user, nil
+type OverlayCacheNode_UptimeCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
+func OverlayCacheNode_UptimeCount(v int64) OverlayCacheNode_UptimeCount_Field {
+	return OverlayCacheNode_UptimeCount_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
-	user_id User_Id_Field) (
-	user *User, err error) {
+func (f OverlayCacheNode_UptimeCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE users.id = ?")
+func (OverlayCacheNode_UptimeCount_Field) _Column() string { return "uptime_count" }
 
-	var __values []interface{}
-	__values = append(__values, user_id.value())
+type OverlayCacheNode_UptimeSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func OverlayCacheNode_UptimeSuccessCount(v int64) OverlayCacheNode_UptimeSuccessCount_Field {
+	return OverlayCacheNode_UptimeSuccessCount_Field{_set: true, _value: v}
+}
 
-	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_UptimeSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return user, nil
+	return f._value
+}
+
+func (OverlayCacheNode_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
 
+type OverlayCacheNode_AuditReputationScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
 }
 
-func (obj *postgresImpl) All_Project(ctx context.Context) (
-	rows []*Project, err error) {
+func OverlayCacheNode_AuditReputationScore(v float64) OverlayCacheNode_AuditReputationScore_Field {
+	return OverlayCacheNode_AuditReputationScore_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects")
+func (f OverlayCacheNode_AuditReputationScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values)
+func (OverlayCacheNode_AuditReputationScore_Field) _Column() string { return "audit_reputation_score" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type OverlayCacheNode_UptimeReputationScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
+func OverlayCacheNode_UptimeReputationScore(v float64) OverlayCacheNode_UptimeReputationScore_Field {
+	return OverlayCacheNode_UptimeReputationScore_Field{_set: true, _value: v}
+}
 
-	for __rows.Next() {
-		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, project)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+func (f OverlayCacheNode_UptimeReputationScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return rows, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
-	project_id Project_Id_Field) (
-	project *Project, err error) {
+func (OverlayCacheNode_UptimeReputationScore_Field) _Column() string {
+	return "uptime_reputation_score"
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+type PartnerSerial struct {
+	SerialNumber string
+	PartnerId    string
+	CreatedAt    time.Time
+}
 
-	var __values []interface{}
-	__values = append(__values, project_id.value())
+func (PartnerSerial) _Table() string { return "partner_serials" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type PartnerSerial_Update_Fields struct {
+}
 
-	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return project, nil
+type PartnerSerial_SerialNumber_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
+func PartnerSerial_SerialNumber(v string) PartnerSerial_SerialNumber_Field {
+	return PartnerSerial_SerialNumber_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*Project, err error) {
+func (f PartnerSerial_SerialNumber_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+func (PartnerSerial_SerialNumber_Field) _Column() string { return "serial_number" }
 
-	var __values []interface{}
-	__values = append(__values, project_member_member_id.value())
+type PartnerSerial_PartnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func PartnerSerial_PartnerId(v string) PartnerSerial_PartnerId_Field {
+	return PartnerSerial_PartnerId_Field{_set: true, _value: v}
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f PartnerSerial_PartnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, project)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (PartnerSerial_PartnerId_Field) _Column() string { return "partner_id" }
 
+type PartnerSerial_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (obj *postgresImpl) All_ProjectMember_By_MemberId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*ProjectMember, err error) {
+func PartnerSerial_CreatedAt(v time.Time) PartnerSerial_CreatedAt_Field {
+	return PartnerSerial_CreatedAt_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+func (f PartnerSerial_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values, project_member_member_id.value())
+func (PartnerSerial_CreatedAt_Field) _Column() string { return "created_at" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type PartnerStorageTally struct {
+	Id              int64
+	PartnerId       string
+	IntervalEndTime time.Time
+	DataTotal       float64
+	CreatedAt       time.Time
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
+func (PartnerStorageTally) _Table() string { return "partner_storage_tallies" }
 
-	for __rows.Next() {
-		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, project_member)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+type PartnerStorageTally_Update_Fields struct {
+}
 
+type PartnerStorageTally_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (obj *postgresImpl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
-	project_member_project_id ProjectMember_ProjectId_Field,
-	limit int, offset int64) (
-	rows []*ProjectMember, err error) {
+func PartnerStorageTally_Id(v int64) PartnerStorageTally_Id_Field {
+	return PartnerStorageTally_Id_Field{_set: true, _value: v}
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+func (f PartnerStorageTally_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __values []interface{}
-	__values = append(__values, project_member_project_id.value())
+func (PartnerStorageTally_Id_Field) _Column() string { return "id" }
 
-	__values = append(__values, limit, offset)
+type PartnerStorageTally_PartnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func PartnerStorageTally_PartnerId(v string) PartnerStorageTally_PartnerId_Field {
+	return PartnerStorageTally_PartnerId_Field{_set: true, _value: v}
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f PartnerStorageTally_PartnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	defer __rows.Close()
+	return f._value
+}
 
-	for __rows.Next() {
-		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, project_member)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+func (PartnerStorageTally_PartnerId_Field) _Column() string { return "partner_id" }
 
+type PartnerStorageTally_IntervalEndTime_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (obj *postgresImpl) Get_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
-	api_key *ApiKey, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+func PartnerStorageTally_IntervalEndTime(v time.Time) PartnerStorageTally_IntervalEndTime_Field {
+	return PartnerStorageTally_IntervalEndTime_Field{_set: true, _value: v}
+}
 
-	var __values []interface{}
-	__values = append(__values, api_key_id.value())
+func (f PartnerStorageTally_IntervalEndTime_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func (PartnerStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return api_key, nil
+type PartnerStorageTally_DataTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
 
+func PartnerStorageTally_DataTotal(v float64) PartnerStorageTally_DataTotal_Field {
+	return PartnerStorageTally_DataTotal_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Get_ApiKey_By_Key(ctx context.Context,
-	api_key_key ApiKey_Key_Field) (
-	api_key *ApiKey, err error) {
+func (f PartnerStorageTally_DataTotal_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+func (PartnerStorageTally_DataTotal_Field) _Column() string { return "data_total" }
 
-	var __values []interface{}
-	__values = append(__values, api_key_key.value())
+type PartnerStorageTally_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func PartnerStorageTally_CreatedAt(v time.Time) PartnerStorageTally_CreatedAt_Field {
+	return PartnerStorageTally_CreatedAt_Field{_set: true, _value: v}
+}
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f PartnerStorageTally_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return api_key, nil
+	return f._value
+}
 
+func (PartnerStorageTally_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectDeletion struct {
+	ProjectId       []byte
+	RequestedBy     []byte
+	PurgeAfter      time.Time
+	PurgedAt        *time.Time
+	SegmentsDeleted int64
+	PiecesDeleted   int64
+	ApiKeysDeleted  int64
+	CreatedAt       time.Time
 }
 
-func (obj *postgresImpl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
-	api_key_project_id ApiKey_ProjectId_Field) (
-	rows []*ApiKey, err error) {
+func (ProjectDeletion) _Table() string { return "project_deletions" }
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
+type ProjectDeletion_Create_Fields struct {
+	PurgedAt ProjectDeletion_PurgedAt_Field
+}
 
-	var __values []interface{}
-	__values = append(__values, api_key_project_id.value())
+type ProjectDeletion_Update_Fields struct {
+	PurgedAt        ProjectDeletion_PurgedAt_Field
+	SegmentsDeleted ProjectDeletion_SegmentsDeleted_Field
+	PiecesDeleted   ProjectDeletion_PiecesDeleted_Field
+	ApiKeysDeleted  ProjectDeletion_ApiKeysDeleted_Field
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type ProjectDeletion_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
+func ProjectDeletion_ProjectId(v []byte) ProjectDeletion_ProjectId_Field {
+	return ProjectDeletion_ProjectId_Field{_set: true, _value: v}
+}
 
-	for __rows.Next() {
-		api_key := &ApiKey{}
-		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, api_key)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+func (f ProjectDeletion_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return rows, nil
-
+	return f._value
 }
 
-func (obj *postgresImpl) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
-	update Irreparabledb_Update_Fields) (
-	irreparabledb *Irreparabledb, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (ProjectDeletion_ProjectId_Field) _Column() string { return "project_id" }
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE irreparabledbs SET "), __sets, __sqlbundle_Literal(" WHERE irreparabledbs.segmentpath = ? RETURNING irreparabledbs.segmentpath, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count")}}
+type ProjectDeletion_RequestedBy_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+func ProjectDeletion_RequestedBy(v []byte) ProjectDeletion_RequestedBy_Field {
+	return ProjectDeletion_RequestedBy_Field{_set: true, _value: v}
+}
 
-	if update.Segmentdetail._set {
-		__values = append(__values, update.Segmentdetail.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segmentdetail = ?"))
+func (f ProjectDeletion_RequestedBy_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	if update.PiecesLostCount._set {
-		__values = append(__values, update.PiecesLostCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("pieces_lost_count = ?"))
-	}
+func (ProjectDeletion_RequestedBy_Field) _Column() string { return "requested_by" }
 
-	if update.SegDamagedUnixSec._set {
-		__values = append(__values, update.SegDamagedUnixSec.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("seg_damaged_unix_sec = ?"))
-	}
+type ProjectDeletion_PurgeAfter_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	if update.RepairAttemptCount._set {
-		__values = append(__values, update.RepairAttemptCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("repair_attempt_count = ?"))
-	}
+func ProjectDeletion_PurgeAfter(v time.Time) ProjectDeletion_PurgeAfter_Field {
+	return ProjectDeletion_PurgeAfter_Field{_set: true, _value: v}
+}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+func (f ProjectDeletion_PurgeAfter_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__args = append(__args, irreparabledb_segmentpath.value())
+func (ProjectDeletion_PurgeAfter_Field) _Column() string { return "purge_after" }
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+type ProjectDeletion_PurgedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func ProjectDeletion_PurgedAt(v time.Time) ProjectDeletion_PurgedAt_Field {
+	return ProjectDeletion_PurgedAt_Field{_set: true, _value: &v}
+}
 
-	irreparabledb = &Irreparabledb{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&irreparabledb.Segmentpath, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, obj.makeErr(err)
+func ProjectDeletion_PurgedAt_Raw(v *time.Time) ProjectDeletion_PurgedAt_Field {
+	if v == nil {
+		return ProjectDeletion_PurgedAt_Null()
 	}
-	return irreparabledb, nil
+	return ProjectDeletion_PurgedAt(*v)
 }
 
-func (obj *postgresImpl) Update_AccountingTimestamps_By_Name(ctx context.Context,
-	accounting_timestamps_name AccountingTimestamps_Name_Field,
-	update AccountingTimestamps_Update_Fields) (
-	accounting_timestamps *AccountingTimestamps, err error) {
-	var __sets = &__sqlbundle_Hole{}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE accounting_timestamps SET "), __sets, __sqlbundle_Literal(" WHERE accounting_timestamps.name = ? RETURNING accounting_timestamps.name, accounting_timestamps.value")}}
-
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+func ProjectDeletion_PurgedAt_Null() ProjectDeletion_PurgedAt_Field {
+	return ProjectDeletion_PurgedAt_Field{_set: true, _null: true}
+}
 
-	if update.Value._set {
-		__values = append(__values, update.Value.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("value = ?"))
-	}
+func (f ProjectDeletion_PurgedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+func (f ProjectDeletion_PurgedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__args = append(__args, accounting_timestamps_name.value())
+func (ProjectDeletion_PurgedAt_Field) _Column() string { return "purged_at" }
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+type ProjectDeletion_SegmentsDeleted_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func ProjectDeletion_SegmentsDeleted(v int64) ProjectDeletion_SegmentsDeleted_Field {
+	return ProjectDeletion_SegmentsDeleted_Field{_set: true, _value: v}
+}
 
-	accounting_timestamps = &AccountingTimestamps{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_timestamps.Name, &accounting_timestamps.Value)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f ProjectDeletion_SegmentsDeleted_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return accounting_timestamps, nil
+	return f._value
 }
 
-func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
-	node *Node, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (ProjectDeletion_SegmentsDeleted_Field) _Column() string { return "segments_deleted" }
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at")}}
+type ProjectDeletion_PiecesDeleted_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+func ProjectDeletion_PiecesDeleted(v int64) ProjectDeletion_PiecesDeleted_Field {
+	return ProjectDeletion_PiecesDeleted_Field{_set: true, _value: v}
+}
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+func (f ProjectDeletion_PiecesDeleted_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	if update.TotalAuditCount._set {
-		__values = append(__values, update.TotalAuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
-	}
-
-	if update.AuditSuccessRatio._set {
-		__values = append(__values, update.AuditSuccessRatio.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
-	}
+func (ProjectDeletion_PiecesDeleted_Field) _Column() string { return "pieces_deleted" }
 
-	if update.UptimeSuccessCount._set {
-		__values = append(__values, update.UptimeSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
-	}
+type ProjectDeletion_ApiKeysDeleted_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	if update.TotalUptimeCount._set {
-		__values = append(__values, update.TotalUptimeCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_uptime_count = ?"))
-	}
+func ProjectDeletion_ApiKeysDeleted(v int64) ProjectDeletion_ApiKeysDeleted_Field {
+	return ProjectDeletion_ApiKeysDeleted_Field{_set: true, _value: v}
+}
 
-	if update.UptimeRatio._set {
-		__values = append(__values, update.UptimeRatio.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_ratio = ?"))
+func (f ProjectDeletion_ApiKeysDeleted_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__now := obj.db.Hooks.Now().UTC()
+func (ProjectDeletion_ApiKeysDeleted_Field) _Column() string { return "api_keys_deleted" }
 
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+type ProjectDeletion_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	__args = append(__args, node_id.value())
+func ProjectDeletion_CreatedAt(v time.Time) ProjectDeletion_CreatedAt_Field {
+	return ProjectDeletion_CreatedAt_Field{_set: true, _value: v}
+}
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+func (f ProjectDeletion_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func (ProjectDeletion_CreatedAt_Field) _Column() string { return "created_at" }
 
-	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return node, nil
+type ProjectInvoice struct {
+	Id          string
+	ProjectId   []byte
+	CustomerId  string
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Amount      int64
+	Status      string
+	CreatedAt   time.Time
 }
 
-func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
-	update OverlayCacheNode_Update_Fields) (
-	overlay_cache_node *OverlayCacheNode, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (ProjectInvoice) _Table() string { return "project_invoices" }
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE overlay_cache_nodes SET "), __sets, __sqlbundle_Literal(" WHERE overlay_cache_nodes.node_id = ? RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count")}}
+type ProjectInvoice_Update_Fields struct {
+	Status ProjectInvoice_Status_Field
+}
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+type ProjectInvoice_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	if update.Address._set {
-		__values = append(__values, update.Address.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
-	}
+func ProjectInvoice_Id(v string) ProjectInvoice_Id_Field {
+	return ProjectInvoice_Id_Field{_set: true, _value: v}
+}
 
-	if update.Protocol._set {
-		__values = append(__values, update.Protocol.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+func (f ProjectInvoice_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	if update.OperatorEmail._set {
-		__values = append(__values, update.OperatorEmail.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_email = ?"))
-	}
+func (ProjectInvoice_Id_Field) _Column() string { return "id" }
 
-	if update.OperatorWallet._set {
-		__values = append(__values, update.OperatorWallet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet = ?"))
-	}
+type ProjectInvoice_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	if update.FreeBandwidth._set {
-		__values = append(__values, update.FreeBandwidth.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_bandwidth = ?"))
-	}
+func ProjectInvoice_ProjectId(v []byte) ProjectInvoice_ProjectId_Field {
+	return ProjectInvoice_ProjectId_Field{_set: true, _value: v}
+}
 
-	if update.FreeDisk._set {
-		__values = append(__values, update.FreeDisk.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+func (f ProjectInvoice_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	if update.Latency90._set {
-		__values = append(__values, update.Latency90.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
-	}
+func (ProjectInvoice_ProjectId_Field) _Column() string { return "project_id" }
 
-	if update.AuditSuccessRatio._set {
-		__values = append(__values, update.AuditSuccessRatio.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
-	}
+type ProjectInvoice_CustomerId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	if update.AuditUptimeRatio._set {
-		__values = append(__values, update.AuditUptimeRatio.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_uptime_ratio = ?"))
-	}
+func ProjectInvoice_CustomerId(v string) ProjectInvoice_CustomerId_Field {
+	return ProjectInvoice_CustomerId_Field{_set: true, _value: v}
+}
 
-	if update.AuditCount._set {
-		__values = append(__values, update.AuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_count = ?"))
+func (f ProjectInvoice_CustomerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
-	}
+func (ProjectInvoice_CustomerId_Field) _Column() string { return "customer_id" }
 
-	if update.UptimeCount._set {
-		__values = append(__values, update.UptimeCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_count = ?"))
-	}
+type ProjectInvoice_PeriodStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	if update.UptimeSuccessCount._set {
-		__values = append(__values, update.UptimeSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
-	}
+func ProjectInvoice_PeriodStart(v time.Time) ProjectInvoice_PeriodStart_Field {
+	return ProjectInvoice_PeriodStart_Field{_set: true, _value: v}
+}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+func (f ProjectInvoice_PeriodStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__args = append(__args, overlay_cache_node_node_id.value())
+func (ProjectInvoice_PeriodStart_Field) _Column() string { return "period_start" }
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+type ProjectInvoice_PeriodEnd_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func ProjectInvoice_PeriodEnd(v time.Time) ProjectInvoice_PeriodEnd_Field {
+	return ProjectInvoice_PeriodEnd_Field{_set: true, _value: v}
+}
 
-	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f ProjectInvoice_PeriodEnd_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return overlay_cache_node, nil
+	return f._value
 }
 
-func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
-	user_id User_Id_Field,
-	update User_Update_Fields) (
-	user *User, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (ProjectInvoice_PeriodEnd_Field) _Column() string { return "period_end" }
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at")}}
+type ProjectInvoice_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+func ProjectInvoice_Amount(v int64) ProjectInvoice_Amount_Field {
+	return ProjectInvoice_Amount_Field{_set: true, _value: v}
+}
 
-	if update.FirstName._set {
-		__values = append(__values, update.FirstName.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("first_name = ?"))
+func (f ProjectInvoice_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	if update.LastName._set {
-		__values = append(__values, update.LastName.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_name = ?"))
-	}
+func (ProjectInvoice_Amount_Field) _Column() string { return "amount" }
 
-	if update.Email._set {
-		__values = append(__values, update.Email.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
-	}
+type ProjectInvoice_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	if update.PasswordHash._set {
-		__values = append(__values, update.PasswordHash.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("password_hash = ?"))
-	}
+func ProjectInvoice_Status(v string) ProjectInvoice_Status_Field {
+	return ProjectInvoice_Status_Field{_set: true, _value: v}
+}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+func (f ProjectInvoice_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__args = append(__args, user_id.value())
+func (ProjectInvoice_Status_Field) _Column() string { return "status" }
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+type ProjectInvoice_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func ProjectInvoice_CreatedAt(v time.Time) ProjectInvoice_CreatedAt_Field {
+	return ProjectInvoice_CreatedAt_Field{_set: true, _value: v}
+}
 
-	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f ProjectInvoice_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return user, nil
+	return f._value
 }
 
-func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
-	project_id Project_Id_Field,
-	update Project_Update_Fields) (
-	project *Project, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (ProjectInvoice_CreatedAt_Field) _Column() string { return "created_at" }
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.created_at")}}
+type ProjectStorageTally struct {
+	Id              int64
+	ProjectId       []byte
+	IntervalEndTime time.Time
+	DataTotal       float64
+	CreatedAt       time.Time
+}
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+func (ProjectStorageTally) _Table() string { return "project_storage_tallies" }
 
-	if update.Description._set {
-		__values = append(__values, update.Description.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("description = ?"))
-	}
+type ProjectStorageTally_Update_Fields struct {
+}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+type ProjectStorageTally_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ProjectStorageTally_Id(v int64) ProjectStorageTally_Id_Field {
+	return ProjectStorageTally_Id_Field{_set: true, _value: v}
+}
+
+func (f ProjectStorageTally_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__args = append(__args, project_id.value())
+func (ProjectStorageTally_Id_Field) _Column() string { return "id" }
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+type ProjectStorageTally_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func ProjectStorageTally_ProjectId(v []byte) ProjectStorageTally_ProjectId_Field {
+	return ProjectStorageTally_ProjectId_Field{_set: true, _value: v}
+}
 
-	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f ProjectStorageTally_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return project, nil
+	return f._value
 }
 
-func (obj *postgresImpl) Update_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field,
-	update ApiKey_Update_Fields) (
-	api_key *ApiKey, err error) {
-	var __sets = &__sqlbundle_Hole{}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE api_keys SET "), __sets, __sqlbundle_Literal(" WHERE api_keys.id = ? RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at")}}
+func (ProjectStorageTally_ProjectId_Field) _Column() string { return "project_id" }
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+type ProjectStorageTally_IntervalEndTime_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	if update.Name._set {
-		__values = append(__values, update.Name.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
-	}
+func ProjectStorageTally_IntervalEndTime(v time.Time) ProjectStorageTally_IntervalEndTime_Field {
+	return ProjectStorageTally_IntervalEndTime_Field{_set: true, _value: v}
+}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+func (f ProjectStorageTally_IntervalEndTime_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__args = append(__args, api_key_id.value())
+func (ProjectStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+type ProjectStorageTally_DataTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func ProjectStorageTally_DataTotal(v float64) ProjectStorageTally_DataTotal_Field {
+	return ProjectStorageTally_DataTotal_Field{_set: true, _value: v}
+}
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (f ProjectStorageTally_DataTotal_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return api_key, nil
+	return f._value
 }
 
-func (obj *postgresImpl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
-	deleted bool, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
-
-	var __values []interface{}
-	__values = append(__values, irreparabledb_segmentpath.value())
+func (ProjectStorageTally_DataTotal_Field) _Column() string { return "data_total" }
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type ProjectStorageTally_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+func ProjectStorageTally_CreatedAt(v time.Time) ProjectStorageTally_CreatedAt_Field {
+	return ProjectStorageTally_CreatedAt_Field{_set: true, _value: v}
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
+func (f ProjectStorageTally_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	return __count > 0, nil
+func (ProjectStorageTally_CreatedAt_Field) _Column() string { return "created_at" }
 
+type Project struct {
+	Id          []byte
+	Name        string
+	Description string
+	CreatedAt   time.Time
 }
 
-func (obj *postgresImpl) Delete_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
-	deleted bool, err error) {
+func (Project) _Table() string { return "projects" }
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_rollups WHERE accounting_rollups.id = ?")
+type Project_Update_Fields struct {
+	Description Project_Description_Field
+}
 
-	var __values []interface{}
-	__values = append(__values, accounting_rollup_id.value())
+type Project_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Project_Id(v []byte) Project_Id_Field {
+	return Project_Id_Field{_set: true, _value: v}
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
+func (f Project_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+func (Project_Id_Field) _Column() string { return "id" }
 
-	return __count > 0, nil
+type Project_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
+func Project_Name(v string) Project_Name_Field {
+	return Project_Name_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Delete_AccountingRaw_By_Id(ctx context.Context,
-	accounting_raw_id AccountingRaw_Id_Field) (
-	deleted bool, err error) {
+func (f Project_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_raws WHERE accounting_raws.id = ?")
+func (Project_Name_Field) _Column() string { return "name" }
 
-	var __values []interface{}
-	__values = append(__values, accounting_raw_id.value())
+type Project_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Project_Description(v string) Project_Description_Field {
+	return Project_Description_Field{_set: true, _value: v}
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
+func (f Project_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+func (Project_Description_Field) _Column() string { return "description" }
 
-	return __count > 0, nil
+type Project_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
+func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
+	return Project_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Delete_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
-	deleted bool, err error) {
+func (f Project_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
+func (Project_CreatedAt_Field) _Column() string { return "created_at" }
 
-	var __values []interface{}
-	__values = append(__values, node_id.value())
+type SegmentHealth struct {
+	Path         []byte
+	LastAudited  *time.Time
+	LastRepaired *time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func (SegmentHealth) _Table() string { return "segment_healths" }
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+type SegmentHealth_Create_Fields struct {
+	LastAudited  SegmentHealth_LastAudited_Field
+	LastRepaired SegmentHealth_LastRepaired_Field
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+type SegmentHealth_Update_Fields struct {
+	LastAudited  SegmentHealth_LastAudited_Field
+	LastRepaired SegmentHealth_LastRepaired_Field
+}
 
-	return __count > 0, nil
+type SegmentHealth_Path_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
+func SegmentHealth_Path(v []byte) SegmentHealth_Path_Field {
+	return SegmentHealth_Path_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
-	deleted bool, err error) {
+func (f SegmentHealth_Path_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+func (SegmentHealth_Path_Field) _Column() string { return "path" }
 
-	var __values []interface{}
-	__values = append(__values, overlay_cache_node_node_id.value())
+type SegmentHealth_LastAudited_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func SegmentHealth_LastAudited(v time.Time) SegmentHealth_LastAudited_Field {
+	return SegmentHealth_LastAudited_Field{_set: true, _value: &v}
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
+func SegmentHealth_LastAudited_Raw(v *time.Time) SegmentHealth_LastAudited_Field {
+	if v == nil {
+		return SegmentHealth_LastAudited_Null()
 	}
+	return SegmentHealth_LastAudited(*v)
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+func SegmentHealth_LastAudited_Null() SegmentHealth_LastAudited_Field {
+	return SegmentHealth_LastAudited_Field{_set: true, _null: true}
+}
 
-	return __count > 0, nil
+func (f SegmentHealth_LastAudited_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
+func (f SegmentHealth_LastAudited_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-func (obj *postgresImpl) Delete_Injuredsegment_By_Id(ctx context.Context,
-	injuredsegment_id Injuredsegment_Id_Field) (
-	deleted bool, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.id = ?")
+func (SegmentHealth_LastAudited_Field) _Column() string { return "last_audited" }
 
-	var __values []interface{}
-	__values = append(__values, injuredsegment_id.value())
+type SegmentHealth_LastRepaired_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func SegmentHealth_LastRepaired(v time.Time) SegmentHealth_LastRepaired_Field {
+	return SegmentHealth_LastRepaired_Field{_set: true, _value: &v}
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
+func SegmentHealth_LastRepaired_Raw(v *time.Time) SegmentHealth_LastRepaired_Field {
+	if v == nil {
+		return SegmentHealth_LastRepaired_Null()
 	}
+	return SegmentHealth_LastRepaired(*v)
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
+func SegmentHealth_LastRepaired_Null() SegmentHealth_LastRepaired_Field {
+	return SegmentHealth_LastRepaired_Field{_set: true, _null: true}
+}
+
+func (f SegmentHealth_LastRepaired_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f SegmentHealth_LastRepaired_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	return __count > 0, nil
+func (SegmentHealth_LastRepaired_Field) _Column() string { return "last_repaired" }
 
+type Session struct {
+	Id               []byte
+	UserId           []byte
+	Device           string
+	Address          string
+	RefreshTokenHash []byte
+	ExpiresAt        time.Time
+	LastUsedAt       time.Time
+	CreatedAt        time.Time
 }
 
-func (obj *postgresImpl) Delete_User_By_Id(ctx context.Context,
-	user_id User_Id_Field) (
-	deleted bool, err error) {
+func (Session) _Table() string { return "sessions" }
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
+type Session_Update_Fields struct {
+	RefreshTokenHash Session_RefreshTokenHash_Field
+	LastUsedAt       Session_LastUsedAt_Field
+}
 
-	var __values []interface{}
-	__values = append(__values, user_id.value())
+type Session_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Session_Id(v []byte) Session_Id_Field {
+	return Session_Id_Field{_set: true, _value: v}
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
+func (f Session_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+func (Session_Id_Field) _Column() string { return "id" }
 
-	return __count > 0, nil
+type Session_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
+func Session_UserId(v []byte) Session_UserId_Field {
+	return Session_UserId_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Delete_Project_By_Id(ctx context.Context,
-	project_id Project_Id_Field) (
-	deleted bool, err error) {
+func (f Session_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM projects WHERE projects.id = ?")
+func (Session_UserId_Field) _Column() string { return "user_id" }
 
-	var __values []interface{}
-	__values = append(__values, project_id.value())
+type Session_Device_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Session_Device(v string) Session_Device_Field {
+	return Session_Device_Field{_set: true, _value: v}
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
+func (f Session_Device_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+func (Session_Device_Field) _Column() string { return "device" }
 
-	return __count > 0, nil
+type Session_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
 
+func Session_Address(v string) Session_Address_Field {
+	return Session_Address_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
-	deleted bool, err error) {
+func (f Session_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
+func (Session_Address_Field) _Column() string { return "address" }
 
-	var __values []interface{}
-	__values = append(__values, project_member_member_id.value(), project_member_project_id.value())
+type Session_RefreshTokenHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Session_RefreshTokenHash(v []byte) Session_RefreshTokenHash_Field {
+	return Session_RefreshTokenHash_Field{_set: true, _value: v}
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
+func (f Session_RefreshTokenHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+func (Session_RefreshTokenHash_Field) _Column() string { return "refresh_token_hash" }
 
-	return __count > 0, nil
+type Session_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
+func Session_ExpiresAt(v time.Time) Session_ExpiresAt_Field {
+	return Session_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (obj *postgresImpl) Delete_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
-	deleted bool, err error) {
+func (f Session_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
+func (Session_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-	var __values []interface{}
-	__values = append(__values, api_key_id.value())
+type Session_LastUsedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func Session_LastUsedAt(v time.Time) Session_LastUsedAt_Field {
+	return Session_LastUsedAt_Field{_set: true, _value: v}
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
+func (f Session_LastUsedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
+func (Session_LastUsedAt_Field) _Column() string { return "last_used_at" }
 
-	return __count > 0, nil
+type Session_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
+func Session_CreatedAt(v time.Time) Session_CreatedAt_Field {
+	return Session_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (impl postgresImpl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(*pq.Error); ok {
-		if e.Code.Class() == "23" {
-			return e.Constraint, true
-		}
+func (f Session_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return "", false
+	return f._value
 }
 
-func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error) {
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.Exec("DELETE FROM project_members;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+func (Session_CreatedAt_Field) _Column() string { return "created_at" }
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM api_keys;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+type UserPayment struct {
+	UserId     []byte
+	CustomerId string
+	Credit     int64
+	CreatedAt  time.Time
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM users;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+func (UserPayment) _Table() string { return "user_payments" }
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM projects;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+type UserPayment_Update_Fields struct {
+	Credit UserPayment_Credit_Field
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM overlay_cache_nodes;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+type UserPayment_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM nodes;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+func UserPayment_UserId(v []byte) UserPayment_UserId_Field {
+	return UserPayment_UserId_Field{_set: true, _value: v}
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM irreparabledbs;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (f UserPayment_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM injuredsegments;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+func (UserPayment_UserId_Field) _Column() string { return "user_id" }
+
+type UserPayment_CustomerId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func UserPayment_CustomerId(v string) UserPayment_CustomerId_Field {
+	return UserPayment_CustomerId_Field{_set: true, _value: v}
+}
+
+func (f UserPayment_CustomerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserPayment_CustomerId_Field) _Column() string { return "customer_id" }
+
+type UserPayment_Credit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func UserPayment_Credit(v int64) UserPayment_Credit_Field {
+	return UserPayment_Credit_Field{_set: true, _value: v}
+}
+
+func (f UserPayment_Credit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserPayment_Credit_Field) _Column() string { return "credit" }
+
+type UserPayment_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func UserPayment_CreatedAt(v time.Time) UserPayment_CreatedAt_Field {
+	return UserPayment_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f UserPayment_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserPayment_CreatedAt_Field) _Column() string { return "created_at" }
+
+type User struct {
+	Id           []byte
+	FirstName    string
+	LastName     string
+	Email        *string
+	PasswordHash []byte
+	CreatedAt    time.Time
+}
+
+func (User) _Table() string { return "users" }
+
+type User_Create_Fields struct {
+	Email User_Email_Field
+}
+
+type User_Update_Fields struct {
+	FirstName    User_FirstName_Field
+	LastName     User_LastName_Field
+	Email        User_Email_Field
+	PasswordHash User_PasswordHash_Field
+}
+
+type User_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_Id(v []byte) User_Id_Field {
+	return User_Id_Field{_set: true, _value: v}
+}
+
+func (f User_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Id_Field) _Column() string { return "id" }
+
+type User_FirstName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func User_FirstName(v string) User_FirstName_Field {
+	return User_FirstName_Field{_set: true, _value: v}
+}
+
+func (f User_FirstName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_FirstName_Field) _Column() string { return "first_name" }
+
+type User_LastName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func User_LastName(v string) User_LastName_Field {
+	return User_LastName_Field{_set: true, _value: v}
+}
+
+func (f User_LastName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_LastName_Field) _Column() string { return "last_name" }
+
+type User_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_Email(v string) User_Email_Field {
+	return User_Email_Field{_set: true, _value: &v}
+}
+
+func User_Email_Raw(v *string) User_Email_Field {
+	if v == nil {
+		return User_Email_Null()
+	}
+	return User_Email(*v)
+}
+
+func User_Email_Null() User_Email_Field {
+	return User_Email_Field{_set: true, _null: true}
+}
+
+func (f User_Email_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Email_Field) _Column() string { return "email" }
+
+type User_PasswordHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_PasswordHash(v []byte) User_PasswordHash_Field {
+	return User_PasswordHash_Field{_set: true, _value: v}
+}
+
+func (f User_PasswordHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+
+type User_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func User_CreatedAt(v time.Time) User_CreatedAt_Field {
+	return User_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f User_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ApiKey struct {
+	Id        []byte
+	ProjectId []byte
+	Key       []byte
+	Name      string
+	CreatedAt time.Time
+}
+
+func (ApiKey) _Table() string { return "api_keys" }
+
+type ApiKey_Update_Fields struct {
+	Name ApiKey_Name_Field
+}
+
+type ApiKey_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_Id(v []byte) ApiKey_Id_Field {
+	return ApiKey_Id_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Id_Field) _Column() string { return "id" }
+
+type ApiKey_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
+	return ApiKey_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
+
+type ApiKey_Key_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_Key(v []byte) ApiKey_Key_Field {
+	return ApiKey_Key_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Key_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Key_Field) _Column() string { return "key" }
+
+type ApiKey_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ApiKey_Name(v string) ApiKey_Name_Field {
+	return ApiKey_Name_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Name_Field) _Column() string { return "name" }
+
+type ApiKey_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
+	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectMember struct {
+	MemberId  []byte
+	ProjectId []byte
+	CreatedAt time.Time
+}
+
+func (ProjectMember) _Table() string { return "project_members" }
+
+type ProjectMember_Update_Fields struct {
+}
+
+type ProjectMember_MemberId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
+	return ProjectMember_MemberId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMember_MemberId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
+
+type ProjectMember_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
+	return ProjectMember_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMember_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectMember_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
+	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectMember_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
+
+func toUTC(t time.Time) time.Time {
+	return t.UTC()
+}
+
+func toDate(t time.Time) time.Time {
+	// keep up the minute portion so that translations between timezones will
+	// continue to reflect properly.
+	return t.Truncate(time.Minute)
+}
+
+//
+// runtime support for building sql statements
+//
+
+type __sqlbundle_SQL interface {
+	Render() string
+
+	private()
+}
+
+type __sqlbundle_Dialect interface {
+	Rebind(sql string) string
+}
+
+type __sqlbundle_RenderOp int
+
+const (
+	__sqlbundle_NoFlatten __sqlbundle_RenderOp = iota
+	__sqlbundle_NoTerminate
+)
+
+func __sqlbundle_Render(dialect __sqlbundle_Dialect, sql __sqlbundle_SQL, ops ...__sqlbundle_RenderOp) string {
+	out := sql.Render()
+
+	flatten := true
+	terminate := true
+	for _, op := range ops {
+		switch op {
+		case __sqlbundle_NoFlatten:
+			flatten = false
+		case __sqlbundle_NoTerminate:
+			terminate = false
+		}
+	}
+
+	if flatten {
+		out = __sqlbundle_flattenSQL(out)
+	}
+	if terminate {
+		out += ";"
+	}
+
+	return dialect.Rebind(out)
+}
+
+var __sqlbundle_reSpace = regexp.MustCompile(`\s+`)
+
+func __sqlbundle_flattenSQL(s string) string {
+	return strings.TrimSpace(__sqlbundle_reSpace.ReplaceAllString(s, " "))
+}
+
+// this type is specially named to match up with the name returned by the
+// dialect impl in the sql package.
+type __sqlbundle_postgres struct{}
+
+func (p __sqlbundle_postgres) Rebind(sql string) string {
+	out := make([]byte, 0, len(sql)+10)
+
+	j := 1
+	for i := 0; i < len(sql); i++ {
+		ch := sql[i]
+		if ch != '?' {
+			out = append(out, ch)
+			continue
+		}
+
+		out = append(out, '$')
+		out = append(out, strconv.Itoa(j)...)
+		j++
+	}
+
+	return string(out)
+}
+
+// this type is specially named to match up with the name returned by the
+// dialect impl in the sql package.
+type __sqlbundle_sqlite3 struct{}
+
+func (s __sqlbundle_sqlite3) Rebind(sql string) string {
+	return sql
+}
+
+type __sqlbundle_Literal string
+
+func (__sqlbundle_Literal) private() {}
+
+func (l __sqlbundle_Literal) Render() string { return string(l) }
+
+type __sqlbundle_Literals struct {
+	Join string
+	SQLs []__sqlbundle_SQL
+}
+
+func (__sqlbundle_Literals) private() {}
+
+func (l __sqlbundle_Literals) Render() string {
+	var out bytes.Buffer
+
+	first := true
+	for _, sql := range l.SQLs {
+		if sql == nil {
+			continue
+		}
+		if !first {
+			out.WriteString(l.Join)
+		}
+		first = false
+		out.WriteString(sql.Render())
+	}
+
+	return out.String()
+}
+
+type __sqlbundle_Condition struct {
+	// set at compile/embed time
+	Name  string
+	Left  string
+	Equal bool
+	Right string
+
+	// set at runtime
+	Null bool
+}
+
+func (*__sqlbundle_Condition) private() {}
+
+func (c *__sqlbundle_Condition) Render() string {
+
+	switch {
+	case c.Equal && c.Null:
+		return c.Left + " is null"
+	case c.Equal && !c.Null:
+		return c.Left + " = " + c.Right
+	case !c.Equal && c.Null:
+		return c.Left + " is not null"
+	case !c.Equal && !c.Null:
+		return c.Left + " != " + c.Right
+	default:
+		panic("unhandled case")
+	}
+}
+
+type __sqlbundle_Hole struct {
+	// set at compiile/embed time
+	Name string
+
+	// set at runtime
+	SQL __sqlbundle_SQL
+}
+
+func (*__sqlbundle_Hole) private() {}
+
+func (h *__sqlbundle_Hole) Render() string { return h.SQL.Render() }
+
+//
+// end runtime support for building sql statements
+//
+
+type Id_Row struct {
+	Id []byte
+}
+
+type Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row struct {
+	Node_Id                         []byte
+	Node_CreatedAt                  time.Time
+	Node_AuditSuccessRatio          float64
+	AccountingRollup_StartTime      time.Time
+	AccountingRollup_PutTotal       int64
+	AccountingRollup_GetTotal       int64
+	AccountingRollup_GetAuditTotal  int64
+	AccountingRollup_GetRepairTotal int64
+	AccountingRollup_PutRepairTotal int64
+	AccountingRollup_AtRestTotal    float64
+}
+
+type OperatorWallet_Row struct {
+	OperatorWallet string
+}
+
+type Value_Row struct {
+	Value time.Time
+}
+
+func (obj *postgresImpl) Create_Bwagreement(ctx context.Context,
+	bwagreement_serialnum Bwagreement_Serialnum_Field,
+	bwagreement_storage_node_id Bwagreement_StorageNodeId_Field,
+	bwagreement_uplink_id Bwagreement_UplinkId_Field,
+	bwagreement_action Bwagreement_Action_Field,
+	bwagreement_total Bwagreement_Total_Field,
+	bwagreement_expires_at Bwagreement_ExpiresAt_Field) (
+	bwagreement *Bwagreement, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__serialnum_val := bwagreement_serialnum.value()
+	__storage_node_id_val := bwagreement_storage_node_id.value()
+	__uplink_id_val := bwagreement_uplink_id.value()
+	__action_val := bwagreement_action.value()
+	__total_val := bwagreement_total.value()
+	__created_at_val := __now
+	__expires_at_val := bwagreement_expires_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreements ( serialnum, storage_node_id, uplink_id, action, total, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __serialnum_val, __storage_node_id_val, __uplink_id_val, __action_val, __total_val, __created_at_val, __expires_at_val)
+
+	bwagreement = &Bwagreement{}
+	err = obj.driver.QueryRow(__stmt, __serialnum_val, __storage_node_id_val, __uplink_id_val, __action_val, __total_val, __created_at_val, __expires_at_val).Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement, nil
+
+}
+
+func (obj *postgresImpl) Create_Irreparabledb(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
+	irreparabledb_project_id Irreparabledb_ProjectId_Field,
+	irreparabledb_segmentdetail Irreparabledb_Segmentdetail_Field,
+	irreparabledb_pieces_lost_count Irreparabledb_PiecesLostCount_Field,
+	irreparabledb_seg_damaged_unix_sec Irreparabledb_SegDamagedUnixSec_Field,
+	irreparabledb_repair_attempt_count Irreparabledb_RepairAttemptCount_Field,
+	irreparabledb_last_error Irreparabledb_LastError_Field) (
+	irreparabledb *Irreparabledb, err error) {
+	__segmentpath_val := irreparabledb_segmentpath.value()
+	__project_id_val := irreparabledb_project_id.value()
+	__segmentdetail_val := irreparabledb_segmentdetail.value()
+	__pieces_lost_count_val := irreparabledb_pieces_lost_count.value()
+	__seg_damaged_unix_sec_val := irreparabledb_seg_damaged_unix_sec.value()
+	__repair_attempt_count_val := irreparabledb_repair_attempt_count.value()
+	__last_error_val := irreparabledb_last_error.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO irreparabledbs ( segmentpath, project_id, segmentdetail, pieces_lost_count, seg_damaged_unix_sec, repair_attempt_count, last_error ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING irreparabledbs.segmentpath, irreparabledbs.project_id, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count, irreparabledbs.last_error")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __segmentpath_val, __project_id_val, __segmentdetail_val, __pieces_lost_count_val, __seg_damaged_unix_sec_val, __repair_attempt_count_val, __last_error_val)
+
+	irreparabledb = &Irreparabledb{}
+	err = obj.driver.QueryRow(__stmt, __segmentpath_val, __project_id_val, __segmentdetail_val, __pieces_lost_count_val, __seg_damaged_unix_sec_val, __repair_attempt_count_val, __last_error_val).Scan(&irreparabledb.Segmentpath, &irreparabledb.ProjectId, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount, &irreparabledb.LastError)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return irreparabledb, nil
+
+}
+
+func (obj *postgresImpl) Create_AccountingTimestamps(ctx context.Context,
+	accounting_timestamps_name AccountingTimestamps_Name_Field,
+	accounting_timestamps_value AccountingTimestamps_Value_Field) (
+	accounting_timestamps *AccountingTimestamps, err error) {
+	__name_val := accounting_timestamps_name.value()
+	__value_val := accounting_timestamps_value.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_timestamps ( name, value ) VALUES ( ?, ? ) RETURNING accounting_timestamps.name, accounting_timestamps.value")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __name_val, __value_val)
+
+	accounting_timestamps = &AccountingTimestamps{}
+	err = obj.driver.QueryRow(__stmt, __name_val, __value_val).Scan(&accounting_timestamps.Name, &accounting_timestamps.Value)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_timestamps, nil
+
+}
+
+func (obj *postgresImpl) Create_AccountingRollup(ctx context.Context,
+	accounting_rollup_node_id AccountingRollup_NodeId_Field,
+	accounting_rollup_start_time AccountingRollup_StartTime_Field,
+	accounting_rollup_put_total AccountingRollup_PutTotal_Field,
+	accounting_rollup_get_total AccountingRollup_GetTotal_Field,
+	accounting_rollup_get_audit_total AccountingRollup_GetAuditTotal_Field,
+	accounting_rollup_get_repair_total AccountingRollup_GetRepairTotal_Field,
+	accounting_rollup_put_repair_total AccountingRollup_PutRepairTotal_Field,
+	accounting_rollup_at_rest_total AccountingRollup_AtRestTotal_Field) (
+	accounting_rollup *AccountingRollup, err error) {
+	__node_id_val := accounting_rollup_node_id.value()
+	__start_time_val := accounting_rollup_start_time.value()
+	__put_total_val := accounting_rollup_put_total.value()
+	__get_total_val := accounting_rollup_get_total.value()
+	__get_audit_total_val := accounting_rollup_get_audit_total.value()
+	__get_repair_total_val := accounting_rollup_get_repair_total.value()
+	__put_repair_total_val := accounting_rollup_put_repair_total.value()
+	__at_rest_total_val := accounting_rollup_at_rest_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_rollups ( node_id, start_time, put_total, get_total, get_audit_total, get_repair_total, put_repair_total, at_rest_total ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val)
+
+	accounting_rollup = &AccountingRollup{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_rollup, nil
+
+}
+
+func (obj *postgresImpl) Create_AccountingRaw(ctx context.Context,
+	accounting_raw_node_id AccountingRaw_NodeId_Field,
+	accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
+	accounting_raw_data_total AccountingRaw_DataTotal_Field,
+	accounting_raw_data_type AccountingRaw_DataType_Field) (
+	accounting_raw *AccountingRaw, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := accounting_raw_node_id.value()
+	__interval_end_time_val := accounting_raw_interval_end_time.value()
+	__data_total_val := accounting_raw_data_total.value()
+	__data_type_val := accounting_raw_data_type.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_raws ( node_id, interval_end_time, data_total, data_type, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val)
+
+	accounting_raw = &AccountingRaw{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val).Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_raw, nil
+
+}
+
+func (obj *postgresImpl) Create_AccountingViolation(ctx context.Context,
+	accounting_violation_name AccountingViolation_Name_Field,
+	accounting_violation_interval_start AccountingViolation_IntervalStart_Field,
+	accounting_violation_interval_end AccountingViolation_IntervalEnd_Field,
+	accounting_violation_expected AccountingViolation_Expected_Field,
+	accounting_violation_actual AccountingViolation_Actual_Field,
+	accounting_violation_details AccountingViolation_Details_Field) (
+	accounting_violation *AccountingViolation, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__name_val := accounting_violation_name.value()
+	__interval_start_val := accounting_violation_interval_start.value()
+	__interval_end_val := accounting_violation_interval_end.value()
+	__expected_val := accounting_violation_expected.value()
+	__actual_val := accounting_violation_actual.value()
+	__details_val := accounting_violation_details.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_violations ( name, interval_start, interval_end, expected, actual, details, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING accounting_violations.id, accounting_violations.name, accounting_violations.interval_start, accounting_violations.interval_end, accounting_violations.expected, accounting_violations.actual, accounting_violations.details, accounting_violations.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __name_val, __interval_start_val, __interval_end_val, __expected_val, __actual_val, __details_val, __created_at_val)
+
+	accounting_violation = &AccountingViolation{}
+	err = obj.driver.QueryRow(__stmt, __name_val, __interval_start_val, __interval_end_val, __expected_val, __actual_val, __details_val, __created_at_val).Scan(&accounting_violation.Id, &accounting_violation.Name, &accounting_violation.IntervalStart, &accounting_violation.IntervalEnd, &accounting_violation.Expected, &accounting_violation.Actual, &accounting_violation.Details, &accounting_violation.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_violation, nil
+
+}
+
+func (obj *postgresImpl) Create_Node(ctx context.Context,
+	node_id Node_Id_Field,
+	node_audit_success_count Node_AuditSuccessCount_Field,
+	node_total_audit_count Node_TotalAuditCount_Field,
+	node_audit_success_ratio Node_AuditSuccessRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_success_count Node_UptimeSuccessCount_Field,
+	node_total_uptime_count Node_TotalUptimeCount_Field,
+	node_uptime_ratio Node_UptimeRatio_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field) (
+	node *Node, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := node_id.value()
+	__audit_success_count_val := node_audit_success_count.value()
+	__total_audit_count_val := node_total_audit_count.value()
+	__audit_success_ratio_val := node_audit_success_ratio.value()
+	__audit_reputation_alpha_val := node_audit_reputation_alpha.value()
+	__audit_reputation_beta_val := node_audit_reputation_beta.value()
+	__uptime_success_count_val := node_uptime_success_count.value()
+	__total_uptime_count_val := node_total_uptime_count.value()
+	__uptime_ratio_val := node_uptime_ratio.value()
+	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
+	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, audit_reputation_alpha, audit_reputation_beta, uptime_success_count, total_uptime_count, uptime_ratio, uptime_reputation_alpha, uptime_reputation_beta, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __created_at_val, __updated_at_val)
+
+	node = &Node{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __created_at_val, __updated_at_val).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+
+}
+
+func (obj *postgresImpl) Create_OverlayCacheNode(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
+	overlay_cache_node_address OverlayCacheNode_Address_Field,
+	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+	overlay_cache_node_last_net OverlayCacheNode_LastNet_Field,
+	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
+	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+	overlay_cache_node_operator_region OverlayCacheNode_OperatorRegion_Field,
+	overlay_cache_node_version OverlayCacheNode_Version_Field,
+	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
+	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
+	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
+	overlay_cache_node_audit_success_ratio OverlayCacheNode_AuditSuccessRatio_Field,
+	overlay_cache_node_audit_uptime_ratio OverlayCacheNode_AuditUptimeRatio_Field,
+	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
+	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
+	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
+	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+	overlay_cache_node_audit_reputation_score OverlayCacheNode_AuditReputationScore_Field,
+	overlay_cache_node_uptime_reputation_score OverlayCacheNode_UptimeReputationScore_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+	__node_id_val := overlay_cache_node_node_id.value()
+	__node_type_val := overlay_cache_node_node_type.value()
+	__address_val := overlay_cache_node_address.value()
+	__protocol_val := overlay_cache_node_protocol.value()
+	__last_net_val := overlay_cache_node_last_net.value()
+	__operator_email_val := overlay_cache_node_operator_email.value()
+	__operator_wallet_val := overlay_cache_node_operator_wallet.value()
+	__operator_region_val := overlay_cache_node_operator_region.value()
+	__version_val := overlay_cache_node_version.value()
+	__free_bandwidth_val := overlay_cache_node_free_bandwidth.value()
+	__free_disk_val := overlay_cache_node_free_disk.value()
+	__latency_90_val := overlay_cache_node_latency_90.value()
+	__audit_success_ratio_val := overlay_cache_node_audit_success_ratio.value()
+	__audit_uptime_ratio_val := overlay_cache_node_audit_uptime_ratio.value()
+	__audit_count_val := overlay_cache_node_audit_count.value()
+	__audit_success_count_val := overlay_cache_node_audit_success_count.value()
+	__uptime_count_val := overlay_cache_node_uptime_count.value()
+	__uptime_success_count_val := overlay_cache_node_uptime_success_count.value()
+	__audit_reputation_score_val := overlay_cache_node_audit_reputation_score.value()
+	__uptime_reputation_score_val := overlay_cache_node_uptime_reputation_score.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, last_net, operator_email, operator_wallet, operator_region, version, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count, audit_reputation_score, uptime_reputation_score ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.last_net, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_region, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audit_reputation_score, overlay_cache_nodes.uptime_reputation_score")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __last_net_val, __operator_email_val, __operator_wallet_val, __operator_region_val, __version_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __audit_reputation_score_val, __uptime_reputation_score_val)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __last_net_val, __operator_email_val, __operator_wallet_val, __operator_region_val, __version_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __audit_reputation_score_val, __uptime_reputation_score_val).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.LastNet, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorRegion, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditReputationScore, &overlay_cache_node.UptimeReputationScore)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+
+}
+
+func (obj *postgresImpl) Create_Injuredsegment(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	injuredsegment_priority Injuredsegment_Priority_Field,
+	injuredsegment_info Injuredsegment_Info_Field,
+	injuredsegment_attempts Injuredsegment_Attempts_Field,
+	injuredsegment_leased_until Injuredsegment_LeasedUntil_Field) (
+	injuredsegment *Injuredsegment, err error) {
+	__path_val := injuredsegment_path.value()
+	__priority_val := injuredsegment_priority.value()
+	__info_val := injuredsegment_info.value()
+	__attempts_val := injuredsegment_attempts.value()
+	__leased_until_val := injuredsegment_leased_until.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( path, priority, info, attempts, leased_until ) VALUES ( ?, ?, ?, ?, ? ) RETURNING injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __path_val, __priority_val, __info_val, __attempts_val, __leased_until_val)
+
+	injuredsegment = &Injuredsegment{}
+	err = obj.driver.QueryRow(__stmt, __path_val, __priority_val, __info_val, __attempts_val, __leased_until_val).Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return injuredsegment, nil
+
+}
+
+func (obj *postgresImpl) Create_User(ctx context.Context,
+	user_id User_Id_Field,
+	user_first_name User_FirstName_Field,
+	user_last_name User_LastName_Field,
+	user_password_hash User_PasswordHash_Field,
+	optional User_Create_Fields) (
+	user *User, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := user_id.value()
+	__first_name_val := user_first_name.value()
+	__last_name_val := user_last_name.value()
+	__email_val := optional.Email.value()
+	__password_hash_val := user_password_hash.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, first_name, last_name, email, password_hash, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __first_name_val, __last_name_val, __email_val, __password_hash_val, __created_at_val)
+
+	user = &User{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __first_name_val, __last_name_val, __email_val, __password_hash_val, __created_at_val).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user, nil
+
+}
+
+func (obj *postgresImpl) Create_Project(ctx context.Context,
+	project_id Project_Id_Field,
+	project_name Project_Name_Field,
+	project_description Project_Description_Field) (
+	project *Project, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_id.value()
+	__name_val := project_name.value()
+	__description_val := project_description.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+
+	project = &Project{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project, nil
+
+}
+
+func (obj *postgresImpl) Create_ProjectMember(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member *ProjectMember, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__member_id_val := project_member_member_id.value()
+	__project_id_val := project_member_project_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+
+	project_member = &ProjectMember{}
+	err = obj.driver.QueryRow(__stmt, __member_id_val, __project_id_val, __created_at_val).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+
+}
+
+func (obj *postgresImpl) Create_ApiKey(ctx context.Context,
+	api_key_id ApiKey_Id_Field,
+	api_key_project_id ApiKey_ProjectId_Field,
+	api_key_key ApiKey_Key_Field,
+	api_key_name ApiKey_Name_Field) (
+	api_key *ApiKey, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := api_key_id.value()
+	__project_id_val := api_key_project_id.value()
+	__key_val := api_key_key.value()
+	__name_val := api_key_name.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, name, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *postgresImpl) Create_ProjectDeletion(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	project_deletion_requested_by ProjectDeletion_RequestedBy_Field,
+	project_deletion_purge_after ProjectDeletion_PurgeAfter_Field,
+	project_deletion_segments_deleted ProjectDeletion_SegmentsDeleted_Field,
+	project_deletion_pieces_deleted ProjectDeletion_PiecesDeleted_Field,
+	project_deletion_api_keys_deleted ProjectDeletion_ApiKeysDeleted_Field,
+	optional ProjectDeletion_Create_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_deletion_project_id.value()
+	__requested_by_val := project_deletion_requested_by.value()
+	__purge_after_val := project_deletion_purge_after.value()
+	__purged_at_val := optional.PurgedAt.value()
+	__segments_deleted_val := project_deletion_segments_deleted.value()
+	__pieces_deleted_val := project_deletion_pieces_deleted.value()
+	__api_keys_deleted_val := project_deletion_api_keys_deleted.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_deletions ( project_id, requested_by, purge_after, purged_at, segments_deleted, pieces_deleted, api_keys_deleted, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING project_deletions.project_id, project_deletions.requested_by, project_deletions.purge_after, project_deletions.purged_at, project_deletions.segments_deleted, project_deletions.pieces_deleted, project_deletions.api_keys_deleted, project_deletions.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __requested_by_val, __purge_after_val, __purged_at_val, __segments_deleted_val, __pieces_deleted_val, __api_keys_deleted_val, __created_at_val)
+
+	project_deletion = &ProjectDeletion{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __requested_by_val, __purge_after_val, __purged_at_val, __segments_deleted_val, __pieces_deleted_val, __api_keys_deleted_val, __created_at_val).Scan(&project_deletion.ProjectId, &project_deletion.RequestedBy, &project_deletion.PurgeAfter, &project_deletion.PurgedAt, &project_deletion.SegmentsDeleted, &project_deletion.PiecesDeleted, &project_deletion.ApiKeysDeleted, &project_deletion.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_deletion, nil
+
+}
+
+func (obj *postgresImpl) Create_Session(ctx context.Context,
+	session_id Session_Id_Field,
+	session_user_id Session_UserId_Field,
+	session_device Session_Device_Field,
+	session_address Session_Address_Field,
+	session_refresh_token_hash Session_RefreshTokenHash_Field,
+	session_expires_at Session_ExpiresAt_Field,
+	session_last_used_at Session_LastUsedAt_Field) (
+	session *Session, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := session_id.value()
+	__user_id_val := session_user_id.value()
+	__device_val := session_device.value()
+	__address_val := session_address.value()
+	__refresh_token_hash_val := session_refresh_token_hash.value()
+	__expires_at_val := session_expires_at.value()
+	__last_used_at_val := session_last_used_at.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO sessions ( id, user_id, device, address, refresh_token_hash, expires_at, last_used_at, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING sessions.id, sessions.user_id, sessions.device, sessions.address, sessions.refresh_token_hash, sessions.expires_at, sessions.last_used_at, sessions.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __user_id_val, __device_val, __address_val, __refresh_token_hash_val, __expires_at_val, __last_used_at_val, __created_at_val)
+
+	session = &Session{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __user_id_val, __device_val, __address_val, __refresh_token_hash_val, __expires_at_val, __last_used_at_val, __created_at_val).Scan(&session.Id, &session.UserId, &session.Device, &session.Address, &session.RefreshTokenHash, &session.ExpiresAt, &session.LastUsedAt, &session.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return session, nil
+
+}
+
+func (obj *postgresImpl) Create_AuthEvent(ctx context.Context,
+	auth_event_user_id AuthEvent_UserId_Field,
+	auth_event_session_id AuthEvent_SessionId_Field,
+	auth_event_kind AuthEvent_Kind_Field,
+	auth_event_device AuthEvent_Device_Field,
+	auth_event_address AuthEvent_Address_Field) (
+	auth_event *AuthEvent, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__user_id_val := auth_event_user_id.value()
+	__session_id_val := auth_event_session_id.value()
+	__kind_val := auth_event_kind.value()
+	__device_val := auth_event_device.value()
+	__address_val := auth_event_address.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO auth_events ( user_id, session_id, kind, device, address, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING auth_events.id, auth_events.user_id, auth_events.session_id, auth_events.kind, auth_events.device, auth_events.address, auth_events.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __user_id_val, __session_id_val, __kind_val, __device_val, __address_val, __created_at_val)
+
+	auth_event = &AuthEvent{}
+	err = obj.driver.QueryRow(__stmt, __user_id_val, __session_id_val, __kind_val, __device_val, __address_val, __created_at_val).Scan(&auth_event.Id, &auth_event.UserId, &auth_event.SessionId, &auth_event.Kind, &auth_event.Device, &auth_event.Address, &auth_event.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return auth_event, nil
+
+}
+
+func (obj *postgresImpl) Create_BucketTemplate(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field,
+	bucket_template_path_cipher BucketTemplate_PathCipher_Field,
+	bucket_template_data_cipher BucketTemplate_DataCipher_Field,
+	bucket_template_block_size BucketTemplate_BlockSize_Field,
+	bucket_template_share_size BucketTemplate_ShareSize_Field,
+	bucket_template_required_shares BucketTemplate_RequiredShares_Field,
+	bucket_template_repair_shares BucketTemplate_RepairShares_Field,
+	bucket_template_optimal_shares BucketTemplate_OptimalShares_Field,
+	bucket_template_total_shares BucketTemplate_TotalShares_Field,
+	bucket_template_distinct_subnets BucketTemplate_DistinctSubnets_Field,
+	bucket_template_distinct_regions BucketTemplate_DistinctRegions_Field,
+	bucket_template_updated_at BucketTemplate_UpdatedAt_Field) (
+	bucket_template *BucketTemplate, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_template_project_id.value()
+	__path_cipher_val := bucket_template_path_cipher.value()
+	__data_cipher_val := bucket_template_data_cipher.value()
+	__block_size_val := bucket_template_block_size.value()
+	__share_size_val := bucket_template_share_size.value()
+	__required_shares_val := bucket_template_required_shares.value()
+	__repair_shares_val := bucket_template_repair_shares.value()
+	__optimal_shares_val := bucket_template_optimal_shares.value()
+	__total_shares_val := bucket_template_total_shares.value()
+	__distinct_subnets_val := bucket_template_distinct_subnets.value()
+	__distinct_regions_val := bucket_template_distinct_regions.value()
+	__updated_at_val := bucket_template_updated_at.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_templates ( project_id, path_cipher, data_cipher, block_size, share_size, required_shares, repair_shares, optimal_shares, total_shares, distinct_subnets, distinct_regions, updated_at, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_templates.project_id, bucket_templates.path_cipher, bucket_templates.data_cipher, bucket_templates.block_size, bucket_templates.share_size, bucket_templates.required_shares, bucket_templates.repair_shares, bucket_templates.optimal_shares, bucket_templates.total_shares, bucket_templates.distinct_subnets, bucket_templates.distinct_regions, bucket_templates.updated_at, bucket_templates.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __path_cipher_val, __data_cipher_val, __block_size_val, __share_size_val, __required_shares_val, __repair_shares_val, __optimal_shares_val, __total_shares_val, __distinct_subnets_val, __distinct_regions_val, __updated_at_val, __created_at_val)
+
+	bucket_template = &BucketTemplate{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __path_cipher_val, __data_cipher_val, __block_size_val, __share_size_val, __required_shares_val, __repair_shares_val, __optimal_shares_val, __total_shares_val, __distinct_subnets_val, __distinct_regions_val, __updated_at_val, __created_at_val).Scan(&bucket_template.ProjectId, &bucket_template.PathCipher, &bucket_template.DataCipher, &bucket_template.BlockSize, &bucket_template.ShareSize, &bucket_template.RequiredShares, &bucket_template.RepairShares, &bucket_template.OptimalShares, &bucket_template.TotalShares, &bucket_template.DistinctSubnets, &bucket_template.DistinctRegions, &bucket_template.UpdatedAt, &bucket_template.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_template, nil
+
+}
+
+func (obj *postgresImpl) Create_NodePing(ctx context.Context,
+	node_ping_id NodePing_Id_Field,
+	node_ping_success_rate NodePing_SuccessRate_Field,
+	node_ping_ping_count NodePing_PingCount_Field,
+	node_ping_last_ping_at NodePing_LastPingAt_Field,
+	optional NodePing_Create_Fields) (
+	node_ping *NodePing, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := node_ping_id.value()
+	__success_rate_val := node_ping_success_rate.value()
+	__ping_count_val := node_ping_ping_count.value()
+	__last_ping_at_val := node_ping_last_ping_at.value()
+	__last_success_at_val := optional.LastSuccessAt.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_pings ( id, success_rate, ping_count, last_ping_at, last_success_at, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING node_pings.id, node_pings.success_rate, node_pings.ping_count, node_pings.last_ping_at, node_pings.last_success_at, node_pings.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __success_rate_val, __ping_count_val, __last_ping_at_val, __last_success_at_val, __created_at_val)
+
+	node_ping = &NodePing{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __success_rate_val, __ping_count_val, __last_ping_at_val, __last_success_at_val, __created_at_val).Scan(&node_ping.Id, &node_ping.SuccessRate, &node_ping.PingCount, &node_ping.LastPingAt, &node_ping.LastSuccessAt, &node_ping.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_ping, nil
+
+}
+
+func (obj *postgresImpl) Create_NodeStanding(ctx context.Context,
+	node_standing_id NodeStanding_Id_Field,
+	node_standing_status NodeStanding_Status_Field,
+	node_standing_reason NodeStanding_Reason_Field,
+	node_standing_since NodeStanding_Since_Field) (
+	node_standing *NodeStanding, err error) {
+
+	__id_val := node_standing_id.value()
+	__status_val := node_standing_status.value()
+	__reason_val := node_standing_reason.value()
+	__since_val := node_standing_since.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_standings ( id, status, reason, since ) VALUES ( ?, ?, ?, ? ) RETURNING node_standings.id, node_standings.status, node_standings.reason, node_standings.since")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __status_val, __reason_val, __since_val)
+
+	node_standing = &NodeStanding{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __status_val, __reason_val, __since_val).Scan(&node_standing.Id, &node_standing.Status, &node_standing.Reason, &node_standing.Since)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_standing, nil
+
+}
+
+func (obj *postgresImpl) Create_ObjectTag(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_tag_key ObjectTag_TagKey_Field,
+	object_tag_tag_value ObjectTag_TagValue_Field,
+	object_tag_encrypted_path ObjectTag_EncryptedPath_Field) (
+	object_tag *ObjectTag, err error) {
+
+	__project_id_val := object_tag_project_id.value()
+	__bucket_name_val := object_tag_bucket_name.value()
+	__tag_key_val := object_tag_tag_key.value()
+	__tag_value_val := object_tag_tag_value.value()
+	__encrypted_path_val := object_tag_encrypted_path.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO object_tags ( project_id, bucket_name, tag_key, tag_value, encrypted_path ) VALUES ( ?, ?, ?, ?, ? ) RETURNING object_tags.project_id, object_tags.bucket_name, object_tags.tag_key, object_tags.tag_value, object_tags.encrypted_path")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __tag_key_val, __tag_value_val, __encrypted_path_val)
+
+	object_tag = &ObjectTag{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __bucket_name_val, __tag_key_val, __tag_value_val, __encrypted_path_val).Scan(&object_tag.ProjectId, &object_tag.BucketName, &object_tag.TagKey, &object_tag.TagValue, &object_tag.EncryptedPath)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return object_tag, nil
+
+}
+
+func (obj *postgresImpl) Create_BucketAttribution(ctx context.Context,
+	bucket_attribution_project_id BucketAttribution_ProjectId_Field,
+	bucket_attribution_bucket_name BucketAttribution_BucketName_Field,
+	bucket_attribution_partner_id BucketAttribution_PartnerId_Field) (
+	bucket_attribution *BucketAttribution, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_attribution_project_id.value()
+	__bucket_name_val := bucket_attribution_bucket_name.value()
+	__partner_id_val := bucket_attribution_partner_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_attributions ( project_id, bucket_name, partner_id, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING bucket_attributions.project_id, bucket_attributions.bucket_name, bucket_attributions.partner_id, bucket_attributions.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __partner_id_val, __created_at_val)
+
+	bucket_attribution = &BucketAttribution{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __bucket_name_val, __partner_id_val, __created_at_val).Scan(&bucket_attribution.ProjectId, &bucket_attribution.BucketName, &bucket_attribution.PartnerId, &bucket_attribution.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_attribution, nil
+
+}
+
+func (obj *postgresImpl) Create_PartnerStorageTally(ctx context.Context,
+	partner_storage_tally_partner_id PartnerStorageTally_PartnerId_Field,
+	partner_storage_tally_interval_end_time PartnerStorageTally_IntervalEndTime_Field,
+	partner_storage_tally_data_total PartnerStorageTally_DataTotal_Field) (
+	partner_storage_tally *PartnerStorageTally, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__partner_id_val := partner_storage_tally_partner_id.value()
+	__interval_end_time_val := partner_storage_tally_interval_end_time.value()
+	__data_total_val := partner_storage_tally_data_total.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO partner_storage_tallies ( partner_id, interval_end_time, data_total, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING partner_storage_tallies.id, partner_storage_tallies.partner_id, partner_storage_tallies.interval_end_time, partner_storage_tallies.data_total, partner_storage_tallies.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __partner_id_val, __interval_end_time_val, __data_total_val, __created_at_val)
+
+	partner_storage_tally = &PartnerStorageTally{}
+	err = obj.driver.QueryRow(__stmt, __partner_id_val, __interval_end_time_val, __data_total_val, __created_at_val).Scan(&partner_storage_tally.Id, &partner_storage_tally.PartnerId, &partner_storage_tally.IntervalEndTime, &partner_storage_tally.DataTotal, &partner_storage_tally.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return partner_storage_tally, nil
+
+}
+
+func (obj *postgresImpl) Create_PartnerSerial(ctx context.Context,
+	partner_serial_serial_number PartnerSerial_SerialNumber_Field,
+	partner_serial_partner_id PartnerSerial_PartnerId_Field) (
+	partner_serial *PartnerSerial, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__serial_number_val := partner_serial_serial_number.value()
+	__partner_id_val := partner_serial_partner_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO partner_serials ( serial_number, partner_id, created_at ) VALUES ( ?, ?, ? ) RETURNING partner_serials.serial_number, partner_serials.partner_id, partner_serials.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __serial_number_val, __partner_id_val, __created_at_val)
+
+	partner_serial = &PartnerSerial{}
+	err = obj.driver.QueryRow(__stmt, __serial_number_val, __partner_id_val, __created_at_val).Scan(&partner_serial.SerialNumber, &partner_serial.PartnerId, &partner_serial.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return partner_serial, nil
+
+}
+
+func (obj *postgresImpl) Create_BucketStat(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field,
+	bucket_stat_object_count BucketStat_ObjectCount_Field,
+	bucket_stat_total_bytes BucketStat_TotalBytes_Field,
+	bucket_stat_updated_at BucketStat_UpdatedAt_Field) (
+	bucket_stat *BucketStat, err error) {
+
+	__project_id_val := bucket_stat_project_id.value()
+	__bucket_name_val := bucket_stat_bucket_name.value()
+	__object_count_val := bucket_stat_object_count.value()
+	__total_bytes_val := bucket_stat_total_bytes.value()
+	__updated_at_val := bucket_stat_updated_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_stats ( project_id, bucket_name, object_count, total_bytes, updated_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING bucket_stats.project_id, bucket_stats.bucket_name, bucket_stats.object_count, bucket_stats.total_bytes, bucket_stats.updated_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __object_count_val, __total_bytes_val, __updated_at_val)
+
+	bucket_stat = &BucketStat{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __bucket_name_val, __object_count_val, __total_bytes_val, __updated_at_val).Scan(&bucket_stat.ProjectId, &bucket_stat.BucketName, &bucket_stat.ObjectCount, &bucket_stat.TotalBytes, &bucket_stat.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_stat, nil
+
+}
+
+func (obj *postgresImpl) Create_BucketLifecycle(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	bucket_lifecycle_expire_after_days BucketLifecycle_ExpireAfterDays_Field,
+	bucket_lifecycle_versions_prefix BucketLifecycle_VersionsPrefix_Field,
+	bucket_lifecycle_versions_expire_after_days BucketLifecycle_VersionsExpireAfterDays_Field,
+	bucket_lifecycle_updated_at BucketLifecycle_UpdatedAt_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	__project_id_val := bucket_lifecycle_project_id.value()
+	__bucket_name_val := bucket_lifecycle_bucket_name.value()
+	__expire_after_days_val := bucket_lifecycle_expire_after_days.value()
+	__versions_prefix_val := bucket_lifecycle_versions_prefix.value()
+	__versions_expire_after_days_val := bucket_lifecycle_versions_expire_after_days.value()
+	__updated_at_val := bucket_lifecycle_updated_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_lifecycles ( project_id, bucket_name, expire_after_days, versions_prefix, versions_expire_after_days, updated_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.expire_after_days, bucket_lifecycles.versions_prefix, bucket_lifecycles.versions_expire_after_days, bucket_lifecycles.updated_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __expire_after_days_val, __versions_prefix_val, __versions_expire_after_days_val, __updated_at_val)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __bucket_name_val, __expire_after_days_val, __versions_prefix_val, __versions_expire_after_days_val, __updated_at_val).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.ExpireAfterDays, &bucket_lifecycle.VersionsPrefix, &bucket_lifecycle.VersionsExpireAfterDays, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *postgresImpl) Create_ProjectInvoice(ctx context.Context,
+	project_invoice_id ProjectInvoice_Id_Field,
+	project_invoice_project_id ProjectInvoice_ProjectId_Field,
+	project_invoice_customer_id ProjectInvoice_CustomerId_Field,
+	project_invoice_period_start ProjectInvoice_PeriodStart_Field,
+	project_invoice_period_end ProjectInvoice_PeriodEnd_Field,
+	project_invoice_amount ProjectInvoice_Amount_Field,
+	project_invoice_status ProjectInvoice_Status_Field) (
+	project_invoice *ProjectInvoice, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_invoice_id.value()
+	__project_id_val := project_invoice_project_id.value()
+	__customer_id_val := project_invoice_customer_id.value()
+	__period_start_val := project_invoice_period_start.value()
+	__period_end_val := project_invoice_period_end.value()
+	__amount_val := project_invoice_amount.value()
+	__status_val := project_invoice_status.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_invoices ( id, project_id, customer_id, period_start, period_end, amount, status, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING project_invoices.id, project_invoices.project_id, project_invoices.customer_id, project_invoices.period_start, project_invoices.period_end, project_invoices.amount, project_invoices.status, project_invoices.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __customer_id_val, __period_start_val, __period_end_val, __amount_val, __status_val, __created_at_val)
+
+	project_invoice = &ProjectInvoice{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __customer_id_val, __period_start_val, __period_end_val, __amount_val, __status_val, __created_at_val).Scan(&project_invoice.Id, &project_invoice.ProjectId, &project_invoice.CustomerId, &project_invoice.PeriodStart, &project_invoice.PeriodEnd, &project_invoice.Amount, &project_invoice.Status, &project_invoice.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_invoice, nil
+
+}
+
+func (obj *postgresImpl) Create_ProjectStorageTally(ctx context.Context,
+	project_storage_tally_project_id ProjectStorageTally_ProjectId_Field,
+	project_storage_tally_interval_end_time ProjectStorageTally_IntervalEndTime_Field,
+	project_storage_tally_data_total ProjectStorageTally_DataTotal_Field) (
+	project_storage_tally *ProjectStorageTally, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_storage_tally_project_id.value()
+	__interval_end_time_val := project_storage_tally_interval_end_time.value()
+	__data_total_val := project_storage_tally_data_total.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_storage_tallies ( project_id, interval_end_time, data_total, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING project_storage_tallies.id, project_storage_tallies.project_id, project_storage_tallies.interval_end_time, project_storage_tallies.data_total, project_storage_tallies.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __interval_end_time_val, __data_total_val, __created_at_val)
+
+	project_storage_tally = &ProjectStorageTally{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __interval_end_time_val, __data_total_val, __created_at_val).Scan(&project_storage_tally.Id, &project_storage_tally.ProjectId, &project_storage_tally.IntervalEndTime, &project_storage_tally.DataTotal, &project_storage_tally.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_storage_tally, nil
+
+}
+
+func (obj *postgresImpl) Create_UserPayment(ctx context.Context,
+	user_payment_user_id UserPayment_UserId_Field,
+	user_payment_customer_id UserPayment_CustomerId_Field,
+	user_payment_credit UserPayment_Credit_Field) (
+	user_payment *UserPayment, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__user_id_val := user_payment_user_id.value()
+	__customer_id_val := user_payment_customer_id.value()
+	__credit_val := user_payment_credit.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO user_payments ( user_id, customer_id, credit, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING user_payments.user_id, user_payments.customer_id, user_payments.credit, user_payments.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __user_id_val, __customer_id_val, __credit_val, __created_at_val)
+
+	user_payment = &UserPayment{}
+	err = obj.driver.QueryRow(__stmt, __user_id_val, __customer_id_val, __credit_val, __created_at_val).Scan(&user_payment.UserId, &user_payment.CustomerId, &user_payment.Credit, &user_payment.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user_payment, nil
+
+}
+
+func (obj *postgresImpl) Create_SegmentHealth(ctx context.Context,
+	segment_health_path SegmentHealth_Path_Field,
+	optional SegmentHealth_Create_Fields) (
+	segment_health *SegmentHealth, err error) {
+
+	__path_val := segment_health_path.value()
+	__last_audited_val := optional.LastAudited.value()
+	__last_repaired_val := optional.LastRepaired.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO segment_healths ( path, last_audited, last_repaired ) VALUES ( ?, ?, ? ) RETURNING segment_healths.path, segment_healths.last_audited, segment_healths.last_repaired")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __path_val, __last_audited_val, __last_repaired_val)
+
+	segment_health = &SegmentHealth{}
+	err = obj.driver.QueryRow(__stmt, __path_val, __last_audited_val, __last_repaired_val).Scan(&segment_health.Path, &segment_health.LastAudited, &segment_health.LastRepaired)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return segment_health, nil
+
+}
+
+func (obj *postgresImpl) Create_AuditRecord(ctx context.Context,
+	audit_record_node_id AuditRecord_NodeId_Field,
+	audit_record_audited_at AuditRecord_AuditedAt_Field,
+	audit_record_segment_hash AuditRecord_SegmentHash_Field,
+	audit_record_stripe_index AuditRecord_StripeIndex_Field,
+	audit_record_piece_num AuditRecord_PieceNum_Field,
+	audit_record_outcome AuditRecord_Outcome_Field,
+	audit_record_error AuditRecord_Error_Field,
+	audit_record_duration_ms AuditRecord_DurationMs_Field) (
+	audit_record *AuditRecord, err error) {
+
+	__node_id_val := audit_record_node_id.value()
+	__audited_at_val := audit_record_audited_at.value()
+	__segment_hash_val := audit_record_segment_hash.value()
+	__stripe_index_val := audit_record_stripe_index.value()
+	__piece_num_val := audit_record_piece_num.value()
+	__outcome_val := audit_record_outcome.value()
+	__error_val := audit_record_error.value()
+	__duration_ms_val := audit_record_duration_ms.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO audit_records ( node_id, audited_at, segment_hash, stripe_index, piece_num, outcome, error, duration_ms ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING audit_records.node_id, audit_records.audited_at, audit_records.segment_hash, audit_records.stripe_index, audit_records.piece_num, audit_records.outcome, audit_records.error, audit_records.duration_ms")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __audited_at_val, __segment_hash_val, __stripe_index_val, __piece_num_val, __outcome_val, __error_val, __duration_ms_val)
+
+	audit_record = &AuditRecord{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __audited_at_val, __segment_hash_val, __stripe_index_val, __piece_num_val, __outcome_val, __error_val, __duration_ms_val).Scan(&audit_record.NodeId, &audit_record.AuditedAt, &audit_record.SegmentHash, &audit_record.StripeIndex, &audit_record.PieceNum, &audit_record.Outcome, &audit_record.Error, &audit_record.DurationMs)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_record, nil
+
+}
+
+func (obj *postgresImpl) Limited_Bwagreement(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_Bwagreement(ctx context.Context) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
+	bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements WHERE bwagreements.created_at > ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_created_at_greater.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_Irreparabledb_By_Segmentpath(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
+	irreparabledb *Irreparabledb, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.project_id, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count, irreparabledbs.last_error FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+
+	var __values []interface{}
+	__values = append(__values, irreparabledb_segmentpath.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	irreparabledb = &Irreparabledb{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&irreparabledb.Segmentpath, &irreparabledb.ProjectId, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount, &irreparabledb.LastError)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return irreparabledb, nil
+
+}
+
+func (obj *postgresImpl) Limited_Irreparabledb_By_ProjectId_OrderBy_Asc_Segmentpath(ctx context.Context,
+	irreparabledb_project_id Irreparabledb_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*Irreparabledb, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.project_id, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count, irreparabledbs.last_error FROM irreparabledbs WHERE irreparabledbs.project_id = ? ORDER BY irreparabledbs.segmentpath LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, irreparabledb_project_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		irreparabledb := &Irreparabledb{}
+		err = __rows.Scan(&irreparabledb.Segmentpath, &irreparabledb.ProjectId, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount, &irreparabledb.LastError)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, irreparabledb)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Limited_Irreparabledb_By_SegDamagedUnixSec_Less_OrderBy_Asc_SegDamagedUnixSec(ctx context.Context,
+	irreparabledb_seg_damaged_unix_sec_less Irreparabledb_SegDamagedUnixSec_Field,
+	limit int, offset int64) (
+	rows []*Irreparabledb, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.project_id, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count, irreparabledbs.last_error FROM irreparabledbs WHERE irreparabledbs.seg_damaged_unix_sec < ? ORDER BY irreparabledbs.seg_damaged_unix_sec LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, irreparabledb_seg_damaged_unix_sec_less.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		irreparabledb := &Irreparabledb{}
+		err = __rows.Scan(&irreparabledb.Segmentpath, &irreparabledb.ProjectId, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount, &irreparabledb.LastError)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, irreparabledb)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_OrderBy_Asc_EncryptedPath(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_tag_key ObjectTag_TagKey_Field,
+	object_tag_tag_value ObjectTag_TagValue_Field,
+	limit int, offset int64) (
+	rows []*ObjectTag, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT object_tags.project_id, object_tags.bucket_name, object_tags.tag_key, object_tags.tag_value, object_tags.encrypted_path FROM object_tags WHERE object_tags.project_id = ? AND object_tags.bucket_name = ? AND object_tags.tag_key = ? AND object_tags.tag_value = ? ORDER BY object_tags.encrypted_path LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, object_tag_project_id.value(), object_tag_bucket_name.value(), object_tag_tag_key.value(), object_tag_tag_value.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		object_tag := &ObjectTag{}
+		err = __rows.Scan(&object_tag.ProjectId, &object_tag.BucketName, &object_tag.TagKey, &object_tag.TagValue, &object_tag.EncryptedPath)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, object_tag)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_GreaterOrEqual_And_TagValue_Less_OrderBy_Asc_TagValue_Asc_EncryptedPath(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_tag_key ObjectTag_TagKey_Field,
+	object_tag_tag_value_greater_or_equal ObjectTag_TagValue_Field,
+	object_tag_tag_value_less ObjectTag_TagValue_Field,
+	limit int, offset int64) (
+	rows []*ObjectTag, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT object_tags.project_id, object_tags.bucket_name, object_tags.tag_key, object_tags.tag_value, object_tags.encrypted_path FROM object_tags WHERE object_tags.project_id = ? AND object_tags.bucket_name = ? AND object_tags.tag_key = ? AND object_tags.tag_value >= ? AND object_tags.tag_value < ? ORDER BY object_tags.tag_value, object_tags.encrypted_path LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, object_tag_project_id.value(), object_tag_bucket_name.value(), object_tag_tag_key.value(), object_tag_tag_value_greater_or_equal.value(), object_tag_tag_value_less.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		object_tag := &ObjectTag{}
+		err = __rows.Scan(&object_tag.ProjectId, &object_tag.BucketName, &object_tag.TagKey, &object_tag.TagValue, &object_tag.EncryptedPath)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, object_tag)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_BucketAttribution_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_attribution_project_id BucketAttribution_ProjectId_Field,
+	bucket_attribution_bucket_name BucketAttribution_BucketName_Field) (
+	bucket_attribution *BucketAttribution, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_attributions.project_id, bucket_attributions.bucket_name, bucket_attributions.partner_id, bucket_attributions.created_at FROM bucket_attributions WHERE bucket_attributions.project_id = ? AND bucket_attributions.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_attribution_project_id.value(), bucket_attribution_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_attribution = &BucketAttribution{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_attribution.ProjectId, &bucket_attribution.BucketName, &bucket_attribution.PartnerId, &bucket_attribution.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_attribution, nil
+
+}
+
+func (obj *postgresImpl) Get_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field) (
+	bucket_stat *BucketStat, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_stats.project_id, bucket_stats.bucket_name, bucket_stats.object_count, bucket_stats.total_bytes, bucket_stats.updated_at FROM bucket_stats WHERE bucket_stats.project_id = ? AND bucket_stats.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_stat_project_id.value(), bucket_stat_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_stat = &BucketStat{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_stat.ProjectId, &bucket_stat.BucketName, &bucket_stat.ObjectCount, &bucket_stat.TotalBytes, &bucket_stat.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_stat, nil
+
+}
+
+func (obj *postgresImpl) All_BucketStat_By_ProjectId_OrderBy_Asc_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field) (
+	rows []*BucketStat, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_stats.project_id, bucket_stats.bucket_name, bucket_stats.object_count, bucket_stats.total_bytes, bucket_stats.updated_at FROM bucket_stats WHERE bucket_stats.project_id = ? ORDER BY bucket_stats.bucket_name")
+
+	var __values []interface{}
+	__values = append(__values, bucket_stat_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_stat := &BucketStat{}
+		err = __rows.Scan(&bucket_stat.ProjectId, &bucket_stat.BucketName, &bucket_stat.ObjectCount, &bucket_stat.TotalBytes, &bucket_stat.UpdatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_stat)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.expire_after_days, bucket_lifecycles.versions_prefix, bucket_lifecycles.versions_expire_after_days, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.ExpireAfterDays, &bucket_lifecycle.VersionsPrefix, &bucket_lifecycle.VersionsExpireAfterDays, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *postgresImpl) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
+	accounting_timestamps_name AccountingTimestamps_Name_Field) (
+	row *Value_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_timestamps.value FROM accounting_timestamps WHERE accounting_timestamps.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_timestamps_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Value_Row{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.Value)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *postgresImpl) Get_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	accounting_rollup *AccountingRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	accounting_rollup = &AccountingRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_rollup, nil
+
+}
+
+func (obj *postgresImpl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Context,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
+	rows []*AccountingRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.start_time >= ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_rollup := &AccountingRollup{}
+		err = __rows.Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_rollup)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_AccountingRollup_By_NodeId_And_StartTime_GreaterOrEqual_And_StartTime_Less_OrderBy_Asc_StartTime(ctx context.Context,
+	accounting_rollup_node_id AccountingRollup_NodeId_Field,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field,
+	accounting_rollup_start_time_less AccountingRollup_StartTime_Field) (
+	rows []*AccountingRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.node_id = ? AND accounting_rollups.start_time >= ? AND accounting_rollups.start_time < ? ORDER BY accounting_rollups.start_time")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_node_id.value(), accounting_rollup_start_time_greater_or_equal.value(), accounting_rollup_start_time_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_rollup := &AccountingRollup{}
+		err = __rows.Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_rollup)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_AccountingRaw_By_Id(ctx context.Context,
+	accounting_raw_id AccountingRaw_Id_Field) (
+	accounting_raw *AccountingRaw, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE accounting_raws.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_raw_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	accounting_raw = &AccountingRaw{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_raw, nil
+
+}
+
+func (obj *postgresImpl) All_AccountingRaw(ctx context.Context) (
+	rows []*AccountingRaw, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_raw := &AccountingRaw{}
+		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_raw)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx context.Context,
+	accounting_raw_interval_end_time_greater_or_equal AccountingRaw_IntervalEndTime_Field) (
+	rows []*AccountingRaw, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE accounting_raws.interval_end_time >= ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_raw_interval_end_time_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_raw := &AccountingRaw{}
+		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_raw)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	node *Node, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+
+}
+
+func (obj *postgresImpl) All_Node_Id(ctx context.Context) (
+	rows []*Id_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id FROM nodes")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		row := &Id_Row{}
+		err = __rows.Scan(&row.Id)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, row)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_By_AccountingRollup_StartTime_GreaterOrEqual_And_AccountingRollup_StartTime_Less_OrderBy_Asc_Node_Id(ctx context.Context,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field,
+	accounting_rollup_start_time_less AccountingRollup_StartTime_Field) (
+	rows []*Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.created_at, nodes.audit_success_ratio, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM nodes  JOIN accounting_rollups ON nodes.id = accounting_rollups.node_id WHERE accounting_rollups.start_time >= ? AND accounting_rollups.start_time < ? ORDER BY nodes.id")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value(), accounting_rollup_start_time_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		row := &Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row{}
+		err = __rows.Scan(&row.Node_Id, &row.Node_CreatedAt, &row.Node_AuditSuccessRatio, &row.AccountingRollup_StartTime, &row.AccountingRollup_PutTotal, &row.AccountingRollup_GetTotal, &row.AccountingRollup_GetAuditTotal, &row.AccountingRollup_GetRepairTotal, &row.AccountingRollup_PutRepairTotal, &row.AccountingRollup_AtRestTotal)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, row)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.last_net, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_region, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audit_reputation_score, overlay_cache_nodes.uptime_reputation_score FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.LastNet, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorRegion, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditReputationScore, &overlay_cache_node.UptimeReputationScore)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+
+}
+
+func (obj *postgresImpl) Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	row *OperatorWallet_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.operator_wallet FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &OperatorWallet_Row{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.OperatorWallet)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *postgresImpl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx context.Context,
+	overlay_cache_node_node_id_greater_or_equal OverlayCacheNode_NodeId_Field,
+	limit int, offset int64) (
+	rows []*OverlayCacheNode, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.last_net, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_region, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audit_reputation_score, overlay_cache_nodes.uptime_reputation_score FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id_greater_or_equal.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		overlay_cache_node := &OverlayCacheNode{}
+		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.LastNet, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorRegion, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditReputationScore, &overlay_cache_node.UptimeReputationScore)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, overlay_cache_node)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) First_Injuredsegment_OrderBy_Asc_Priority_Id(ctx context.Context) (
+	injuredsegment *Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until FROM injuredsegments ORDER BY injuredsegments.priority, injuredsegments.id LIMIT 1 OFFSET 0")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	if !__rows.Next() {
+		if err := __rows.Err(); err != nil {
+			return nil, obj.makeErr(err)
+		}
+		return nil, nil
+	}
+
+	injuredsegment = &Injuredsegment{}
+	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	return injuredsegment, nil
+
+}
+
+func (obj *postgresImpl) First_Injuredsegment_By_LeasedUntil_Less_OrderBy_Asc_Priority_Id(ctx context.Context,
+	injuredsegment_leased_until_less Injuredsegment_LeasedUntil_Field) (
+	injuredsegment *Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until FROM injuredsegments WHERE injuredsegments.leased_until < ? ORDER BY injuredsegments.priority, injuredsegments.id LIMIT 1 OFFSET 0")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_leased_until_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	if !__rows.Next() {
+		if err := __rows.Err(); err != nil {
+			return nil, obj.makeErr(err)
+		}
+		return nil, nil
+	}
+
+	injuredsegment = &Injuredsegment{}
+	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	return injuredsegment, nil
+
+}
+
+func (obj *postgresImpl) Limited_Injuredsegment_OrderBy_Asc_Priority_Id(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until FROM injuredsegments ORDER BY injuredsegments.priority, injuredsegments.id LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		injuredsegment := &Injuredsegment{}
+		err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, injuredsegment)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	injuredsegment *Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until FROM injuredsegments WHERE injuredsegments.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	injuredsegment = &Injuredsegment{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return injuredsegment, nil
+
+}
+
+func (obj *postgresImpl) Get_User_By_Email(ctx context.Context,
+	user_email User_Email_Field) (
+	user *User, err error) {
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "users.email", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE "), __cond_0}}
+
+	var __values []interface{}
+	__values = append(__values)
+
+	if !user_email.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, user_email.value())
+	}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user = &User{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user, nil
+
+}
+
+func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
+	user_id User_Id_Field) (
+	user *User, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE users.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user = &User{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user, nil
+
+}
+
+func (obj *postgresImpl) All_Project(ctx context.Context) (
+	rows []*Project, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project := &Project{}
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
+	project_id Project_Id_Field) (
+	project *Project, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project = &Project{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project, nil
+
+}
+
+func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
+	rows []*Project, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+
+	var __values []interface{}
+	__values = append(__values, project_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project := &Project{}
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_ProjectMember_By_MemberId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
+	rows []*ProjectMember, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_member := &ProjectMember{}
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_member)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*ProjectMember, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, project_member_project_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_member := &ProjectMember{}
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_member)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
+	api_key *ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *postgresImpl) Get_ApiKey_By_Key(ctx context.Context,
+	api_key_key ApiKey_Key_Field) (
+	api_key *ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_key.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *postgresImpl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+	api_key_project_id ApiKey_ProjectId_Field) (
+	rows []*ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
+
+	var __values []interface{}
+	__values = append(__values, api_key_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		api_key := &ApiKey{}
+		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, api_key)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+	project_deletion *ProjectDeletion, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.requested_by, project_deletions.purge_after, project_deletions.purged_at, project_deletions.segments_deleted, project_deletions.pieces_deleted, project_deletions.api_keys_deleted, project_deletions.created_at FROM project_deletions WHERE project_deletions.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_deletion_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_deletion = &ProjectDeletion{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_deletion.ProjectId, &project_deletion.RequestedBy, &project_deletion.PurgeAfter, &project_deletion.PurgedAt, &project_deletion.SegmentsDeleted, &project_deletion.PiecesDeleted, &project_deletion.ApiKeysDeleted, &project_deletion.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_deletion, nil
+
+}
+
+func (obj *postgresImpl) All_ProjectDeletion_By_PurgeAfter_Less_And_PurgedAt_Is_Null(ctx context.Context,
+	project_deletion_purge_after_less ProjectDeletion_PurgeAfter_Field) (
+	rows []*ProjectDeletion, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.requested_by, project_deletions.purge_after, project_deletions.purged_at, project_deletions.segments_deleted, project_deletions.pieces_deleted, project_deletions.api_keys_deleted, project_deletions.created_at FROM project_deletions WHERE project_deletions.purge_after < ? AND project_deletions.purged_at is NULL")
+
+	var __values []interface{}
+	__values = append(__values, project_deletion_purge_after_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_deletion := &ProjectDeletion{}
+		err = __rows.Scan(&project_deletion.ProjectId, &project_deletion.RequestedBy, &project_deletion.PurgeAfter, &project_deletion.PurgedAt, &project_deletion.SegmentsDeleted, &project_deletion.PiecesDeleted, &project_deletion.ApiKeysDeleted, &project_deletion.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_deletion)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_Session_By_Id(ctx context.Context,
+	session_id Session_Id_Field) (
+	session *Session, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT sessions.id, sessions.user_id, sessions.device, sessions.address, sessions.refresh_token_hash, sessions.expires_at, sessions.last_used_at, sessions.created_at FROM sessions WHERE sessions.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, session_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	session = &Session{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&session.Id, &session.UserId, &session.Device, &session.Address, &session.RefreshTokenHash, &session.ExpiresAt, &session.LastUsedAt, &session.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return session, nil
+
+}
+
+func (obj *postgresImpl) All_Session_By_UserId_OrderBy_Desc_LastUsedAt(ctx context.Context,
+	session_user_id Session_UserId_Field) (
+	rows []*Session, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT sessions.id, sessions.user_id, sessions.device, sessions.address, sessions.refresh_token_hash, sessions.expires_at, sessions.last_used_at, sessions.created_at FROM sessions WHERE sessions.user_id = ? ORDER BY sessions.last_used_at DESC")
+
+	var __values []interface{}
+	__values = append(__values, session_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		session := &Session{}
+		err = __rows.Scan(&session.Id, &session.UserId, &session.Device, &session.Address, &session.RefreshTokenHash, &session.ExpiresAt, &session.LastUsedAt, &session.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, session)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Limited_AuthEvent_By_UserId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	auth_event_user_id AuthEvent_UserId_Field,
+	limit int, offset int64) (
+	rows []*AuthEvent, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT auth_events.id, auth_events.user_id, auth_events.session_id, auth_events.kind, auth_events.device, auth_events.address, auth_events.created_at FROM auth_events WHERE auth_events.user_id = ? ORDER BY auth_events.created_at DESC LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, auth_event_user_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		auth_event := &AuthEvent{}
+		err = __rows.Scan(&auth_event.Id, &auth_event.UserId, &auth_event.SessionId, &auth_event.Kind, &auth_event.Device, &auth_event.Address, &auth_event.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, auth_event)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_BucketTemplate_By_ProjectId(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field) (
+	bucket_template *BucketTemplate, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_templates.project_id, bucket_templates.path_cipher, bucket_templates.data_cipher, bucket_templates.block_size, bucket_templates.share_size, bucket_templates.required_shares, bucket_templates.repair_shares, bucket_templates.optimal_shares, bucket_templates.total_shares, bucket_templates.distinct_subnets, bucket_templates.distinct_regions, bucket_templates.updated_at, bucket_templates.created_at FROM bucket_templates WHERE bucket_templates.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_template_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_template = &BucketTemplate{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_template.ProjectId, &bucket_template.PathCipher, &bucket_template.DataCipher, &bucket_template.BlockSize, &bucket_template.ShareSize, &bucket_template.RequiredShares, &bucket_template.RepairShares, &bucket_template.OptimalShares, &bucket_template.TotalShares, &bucket_template.DistinctSubnets, &bucket_template.DistinctRegions, &bucket_template.UpdatedAt, &bucket_template.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_template, nil
+
+}
+
+func (obj *postgresImpl) Get_NodePing_By_Id(ctx context.Context,
+	node_ping_id NodePing_Id_Field) (
+	node_ping *NodePing, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_pings.id, node_pings.success_rate, node_pings.ping_count, node_pings.last_ping_at, node_pings.last_success_at, node_pings.created_at FROM node_pings WHERE node_pings.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_ping_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_ping = &NodePing{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node_ping.Id, &node_ping.SuccessRate, &node_ping.PingCount, &node_ping.LastPingAt, &node_ping.LastSuccessAt, &node_ping.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_ping, nil
+
+}
+
+func (obj *postgresImpl) Get_NodeStanding_By_Id(ctx context.Context,
+	node_standing_id NodeStanding_Id_Field) (
+	node_standing *NodeStanding, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_standings.id, node_standings.status, node_standings.reason, node_standings.since FROM node_standings WHERE node_standings.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_standing_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_standing = &NodeStanding{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node_standing.Id, &node_standing.Status, &node_standing.Reason, &node_standing.Since)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_standing, nil
+
+}
+
+func (obj *postgresImpl) Get_ProjectInvoice_By_Id(ctx context.Context,
+	project_invoice_id ProjectInvoice_Id_Field) (
+	project_invoice *ProjectInvoice, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invoices.id, project_invoices.project_id, project_invoices.customer_id, project_invoices.period_start, project_invoices.period_end, project_invoices.amount, project_invoices.status, project_invoices.created_at FROM project_invoices WHERE project_invoices.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_invoice_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_invoice = &ProjectInvoice{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_invoice.Id, &project_invoice.ProjectId, &project_invoice.CustomerId, &project_invoice.PeriodStart, &project_invoice.PeriodEnd, &project_invoice.Amount, &project_invoice.Status, &project_invoice.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_invoice, nil
+
+}
+
+func (obj *postgresImpl) Get_ProjectInvoice_By_ProjectId_And_PeriodStart(ctx context.Context,
+	project_invoice_project_id ProjectInvoice_ProjectId_Field,
+	project_invoice_period_start ProjectInvoice_PeriodStart_Field) (
+	project_invoice *ProjectInvoice, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invoices.id, project_invoices.project_id, project_invoices.customer_id, project_invoices.period_start, project_invoices.period_end, project_invoices.amount, project_invoices.status, project_invoices.created_at FROM project_invoices WHERE project_invoices.project_id = ? AND project_invoices.period_start = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_invoice_project_id.value(), project_invoice_period_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_invoice = &ProjectInvoice{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_invoice.Id, &project_invoice.ProjectId, &project_invoice.CustomerId, &project_invoice.PeriodStart, &project_invoice.PeriodEnd, &project_invoice.Amount, &project_invoice.Status, &project_invoice.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_invoice, nil
+
+}
+
+func (obj *postgresImpl) Get_UserPayment_By_UserId(ctx context.Context,
+	user_payment_user_id UserPayment_UserId_Field) (
+	user_payment *UserPayment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT user_payments.user_id, user_payments.customer_id, user_payments.credit, user_payments.created_at FROM user_payments WHERE user_payments.user_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, user_payment_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user_payment = &UserPayment{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user_payment.UserId, &user_payment.CustomerId, &user_payment.Credit, &user_payment.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user_payment, nil
+
+}
+
+func (obj *postgresImpl) Get_SegmentHealth_By_Path(ctx context.Context,
+	segment_health_path SegmentHealth_Path_Field) (
+	segment_health *SegmentHealth, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT segment_healths.path, segment_healths.last_audited, segment_healths.last_repaired FROM segment_healths WHERE segment_healths.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, segment_health_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	segment_health = &SegmentHealth{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&segment_health.Path, &segment_health.LastAudited, &segment_health.LastRepaired)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return segment_health, nil
+
+}
+
+func (obj *postgresImpl) Limited_AuditRecord_By_NodeId_And_AuditedAt_GreaterOrEqual_OrderBy_Desc_AuditedAt(ctx context.Context,
+	audit_record_node_id AuditRecord_NodeId_Field,
+	audit_record_audited_at_greater_or_equal AuditRecord_AuditedAt_Field,
+	limit int, offset int64) (
+	rows []*AuditRecord, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_records.node_id, audit_records.audited_at, audit_records.segment_hash, audit_records.stripe_index, audit_records.piece_num, audit_records.outcome, audit_records.error, audit_records.duration_ms FROM audit_records WHERE audit_records.node_id = ? AND audit_records.audited_at >= ? ORDER BY audit_records.audited_at DESC LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, audit_record_node_id.value(), audit_record_audited_at_greater_or_equal.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		audit_record := &AuditRecord{}
+		err = __rows.Scan(&audit_record.NodeId, &audit_record.AuditedAt, &audit_record.SegmentHash, &audit_record.StripeIndex, &audit_record.PieceNum, &audit_record.Outcome, &audit_record.Error, &audit_record.DurationMs)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, audit_record)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
+	update Irreparabledb_Update_Fields) (
+	irreparabledb *Irreparabledb, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE irreparabledbs SET "), __sets, __sqlbundle_Literal(" WHERE irreparabledbs.segmentpath = ? RETURNING irreparabledbs.segmentpath, irreparabledbs.project_id, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count, irreparabledbs.last_error")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Segmentdetail._set {
+		__values = append(__values, update.Segmentdetail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segmentdetail = ?"))
+	}
+
+	if update.PiecesLostCount._set {
+		__values = append(__values, update.PiecesLostCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("pieces_lost_count = ?"))
+	}
+
+	if update.SegDamagedUnixSec._set {
+		__values = append(__values, update.SegDamagedUnixSec.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("seg_damaged_unix_sec = ?"))
+	}
+
+	if update.RepairAttemptCount._set {
+		__values = append(__values, update.RepairAttemptCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("repair_attempt_count = ?"))
+	}
+
+	if update.LastError._set {
+		__values = append(__values, update.LastError.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_error = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, irreparabledb_segmentpath.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	irreparabledb = &Irreparabledb{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&irreparabledb.Segmentpath, &irreparabledb.ProjectId, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount, &irreparabledb.LastError)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return irreparabledb, nil
+}
+
+func (obj *postgresImpl) Update_AccountingTimestamps_By_Name(ctx context.Context,
+	accounting_timestamps_name AccountingTimestamps_Name_Field,
+	update AccountingTimestamps_Update_Fields) (
+	accounting_timestamps *AccountingTimestamps, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE accounting_timestamps SET "), __sets, __sqlbundle_Literal(" WHERE accounting_timestamps.name = ? RETURNING accounting_timestamps.name, accounting_timestamps.value")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Value._set {
+		__values = append(__values, update.Value.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("value = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, accounting_timestamps_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	accounting_timestamps = &AccountingTimestamps{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_timestamps.Name, &accounting_timestamps.Value)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_timestamps, nil
+}
+
+func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	node *Node, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.TotalAuditCount._set {
+		__values = append(__values, update.TotalAuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
+	}
+
+	if update.AuditSuccessRatio._set {
+		__values = append(__values, update.AuditSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
+	}
+
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UptimeSuccessCount._set {
+		__values = append(__values, update.UptimeSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
+	}
+
+	if update.TotalUptimeCount._set {
+		__values = append(__values, update.TotalUptimeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_uptime_count = ?"))
+	}
+
+	if update.UptimeRatio._set {
+		__values = append(__values, update.UptimeRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_ratio = ?"))
+	}
+
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+}
+
+func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	update OverlayCacheNode_Update_Fields) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE overlay_cache_nodes SET "), __sets, __sqlbundle_Literal(" WHERE overlay_cache_nodes.node_id = ? RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.last_net, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_region, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audit_reputation_score, overlay_cache_nodes.uptime_reputation_score")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Address._set {
+		__values = append(__values, update.Address.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	}
+
+	if update.Protocol._set {
+		__values = append(__values, update.Protocol.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	}
+
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+
+	if update.OperatorEmail._set {
+		__values = append(__values, update.OperatorEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_email = ?"))
+	}
+
+	if update.OperatorWallet._set {
+		__values = append(__values, update.OperatorWallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet = ?"))
+	}
+
+	if update.OperatorRegion._set {
+		__values = append(__values, update.OperatorRegion.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_region = ?"))
+	}
+
+	if update.Version._set {
+		__values = append(__values, update.Version.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("version = ?"))
+	}
+
+	if update.FreeBandwidth._set {
+		__values = append(__values, update.FreeBandwidth.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_bandwidth = ?"))
+	}
+
+	if update.FreeDisk._set {
+		__values = append(__values, update.FreeDisk.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	}
+
+	if update.Latency90._set {
+		__values = append(__values, update.Latency90.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	}
+
+	if update.AuditSuccessRatio._set {
+		__values = append(__values, update.AuditSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
+	}
+
+	if update.AuditUptimeRatio._set {
+		__values = append(__values, update.AuditUptimeRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_uptime_ratio = ?"))
+	}
+
+	if update.AuditCount._set {
+		__values = append(__values, update.AuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_count = ?"))
+	}
+
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.UptimeCount._set {
+		__values = append(__values, update.UptimeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_count = ?"))
+	}
+
+	if update.UptimeSuccessCount._set {
+		__values = append(__values, update.UptimeSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
+	}
+
+	if update.AuditReputationScore._set {
+		__values = append(__values, update.AuditReputationScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_score = ?"))
+	}
+
+	if update.UptimeReputationScore._set {
+		__values = append(__values, update.UptimeReputationScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_score = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, overlay_cache_node_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.LastNet, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorRegion, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditReputationScore, &overlay_cache_node.UptimeReputationScore)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+}
+
+func (obj *postgresImpl) Update_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	update Injuredsegment_Update_Fields) (
+	injuredsegment *Injuredsegment, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE injuredsegments SET "), __sets, __sqlbundle_Literal(" WHERE injuredsegments.path = ? RETURNING injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Priority._set {
+		__values = append(__values, update.Priority.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("priority = ?"))
+	}
+
+	if update.Info._set {
+		__values = append(__values, update.Info.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("info = ?"))
+	}
+
+	if update.Attempts._set {
+		__values = append(__values, update.Attempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("attempts = ?"))
+	}
+
+	if update.LeasedUntil._set {
+		__values = append(__values, update.LeasedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("leased_until = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, injuredsegment_path.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	injuredsegment = &Injuredsegment{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return injuredsegment, nil
+}
+
+func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
+	user_id User_Id_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.FirstName._set {
+		__values = append(__values, update.FirstName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("first_name = ?"))
+	}
+
+	if update.LastName._set {
+		__values = append(__values, update.LastName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_name = ?"))
+	}
+
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
+	}
+
+	if update.PasswordHash._set {
+		__values = append(__values, update.PasswordHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("password_hash = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, user_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user = &User{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user, nil
+}
+
+func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
+	project_id Project_Id_Field,
+	update Project_Update_Fields) (
+	project *Project, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Description._set {
+		__values = append(__values, update.Description.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("description = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project = &Project{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project, nil
+}
+
+func (obj *postgresImpl) Update_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field,
+	update ApiKey_Update_Fields) (
+	api_key *ApiKey, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE api_keys SET "), __sets, __sqlbundle_Literal(" WHERE api_keys.id = ? RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, api_key_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+}
+
+func (obj *postgresImpl) Update_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	update ProjectDeletion_Update_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_deletions SET "), __sets, __sqlbundle_Literal(" WHERE project_deletions.project_id = ? RETURNING project_deletions.project_id, project_deletions.requested_by, project_deletions.purge_after, project_deletions.purged_at, project_deletions.segments_deleted, project_deletions.pieces_deleted, project_deletions.api_keys_deleted, project_deletions.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.PurgedAt._set {
+		__values = append(__values, update.PurgedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("purged_at = ?"))
+	}
+
+	if update.SegmentsDeleted._set {
+		__values = append(__values, update.SegmentsDeleted.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segments_deleted = ?"))
+	}
+
+	if update.PiecesDeleted._set {
+		__values = append(__values, update.PiecesDeleted.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("pieces_deleted = ?"))
+	}
+
+	if update.ApiKeysDeleted._set {
+		__values = append(__values, update.ApiKeysDeleted.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_keys_deleted = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_deletion_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_deletion = &ProjectDeletion{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_deletion.ProjectId, &project_deletion.RequestedBy, &project_deletion.PurgeAfter, &project_deletion.PurgedAt, &project_deletion.SegmentsDeleted, &project_deletion.PiecesDeleted, &project_deletion.ApiKeysDeleted, &project_deletion.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_deletion, nil
+}
+
+func (obj *postgresImpl) Update_Session_By_Id(ctx context.Context,
+	session_id Session_Id_Field,
+	update Session_Update_Fields) (
+	session *Session, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE sessions SET "), __sets, __sqlbundle_Literal(" WHERE sessions.id = ? RETURNING sessions.id, sessions.user_id, sessions.device, sessions.address, sessions.refresh_token_hash, sessions.expires_at, sessions.last_used_at, sessions.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.RefreshTokenHash._set {
+		__values = append(__values, update.RefreshTokenHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("refresh_token_hash = ?"))
+	}
+
+	if update.LastUsedAt._set {
+		__values = append(__values, update.LastUsedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_used_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, session_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	session = &Session{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&session.Id, &session.UserId, &session.Device, &session.Address, &session.RefreshTokenHash, &session.ExpiresAt, &session.LastUsedAt, &session.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return session, nil
+}
+
+func (obj *postgresImpl) Update_BucketTemplate_By_ProjectId(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field,
+	update BucketTemplate_Update_Fields) (
+	bucket_template *BucketTemplate, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_templates SET "), __sets, __sqlbundle_Literal(" WHERE bucket_templates.project_id = ? RETURNING bucket_templates.project_id, bucket_templates.path_cipher, bucket_templates.data_cipher, bucket_templates.block_size, bucket_templates.share_size, bucket_templates.required_shares, bucket_templates.repair_shares, bucket_templates.optimal_shares, bucket_templates.total_shares, bucket_templates.distinct_subnets, bucket_templates.distinct_regions, bucket_templates.updated_at, bucket_templates.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.PathCipher._set {
+		__values = append(__values, update.PathCipher.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("path_cipher = ?"))
+	}
+
+	if update.DataCipher._set {
+		__values = append(__values, update.DataCipher.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("data_cipher = ?"))
+	}
+
+	if update.BlockSize._set {
+		__values = append(__values, update.BlockSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("block_size = ?"))
+	}
+
+	if update.ShareSize._set {
+		__values = append(__values, update.ShareSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("share_size = ?"))
+	}
+
+	if update.RequiredShares._set {
+		__values = append(__values, update.RequiredShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("required_shares = ?"))
+	}
+
+	if update.RepairShares._set {
+		__values = append(__values, update.RepairShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("repair_shares = ?"))
+	}
+
+	if update.OptimalShares._set {
+		__values = append(__values, update.OptimalShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("optimal_shares = ?"))
+	}
+
+	if update.TotalShares._set {
+		__values = append(__values, update.TotalShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_shares = ?"))
+	}
+
+	if update.DistinctSubnets._set {
+		__values = append(__values, update.DistinctSubnets.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("distinct_subnets = ?"))
+	}
+
+	if update.DistinctRegions._set {
+		__values = append(__values, update.DistinctRegions.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("distinct_regions = ?"))
+	}
+
+	if update.UpdatedAt._set {
+		__values = append(__values, update.UpdatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bucket_template_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_template = &BucketTemplate{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_template.ProjectId, &bucket_template.PathCipher, &bucket_template.DataCipher, &bucket_template.BlockSize, &bucket_template.ShareSize, &bucket_template.RequiredShares, &bucket_template.RepairShares, &bucket_template.OptimalShares, &bucket_template.TotalShares, &bucket_template.DistinctSubnets, &bucket_template.DistinctRegions, &bucket_template.UpdatedAt, &bucket_template.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_template, nil
+}
+
+func (obj *postgresImpl) Update_NodePing_By_Id(ctx context.Context,
+	node_ping_id NodePing_Id_Field,
+	update NodePing_Update_Fields) (
+	node_ping *NodePing, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_pings SET "), __sets, __sqlbundle_Literal(" WHERE node_pings.id = ? RETURNING node_pings.id, node_pings.success_rate, node_pings.ping_count, node_pings.last_ping_at, node_pings.last_success_at, node_pings.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.SuccessRate._set {
+		__values = append(__values, update.SuccessRate.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("success_rate = ?"))
+	}
+
+	if update.PingCount._set {
+		__values = append(__values, update.PingCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ping_count = ?"))
+	}
+
+	if update.LastPingAt._set {
+		__values = append(__values, update.LastPingAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ping_at = ?"))
+	}
+
+	if update.LastSuccessAt._set {
+		__values = append(__values, update.LastSuccessAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_success_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, node_ping_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_ping = &NodePing{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node_ping.Id, &node_ping.SuccessRate, &node_ping.PingCount, &node_ping.LastPingAt, &node_ping.LastSuccessAt, &node_ping.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_ping, nil
+}
+
+func (obj *postgresImpl) Update_NodeStanding_By_Id(ctx context.Context,
+	node_standing_id NodeStanding_Id_Field,
+	update NodeStanding_Update_Fields) (
+	node_standing *NodeStanding, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_standings SET "), __sets, __sqlbundle_Literal(" WHERE node_standings.id = ? RETURNING node_standings.id, node_standings.status, node_standings.reason, node_standings.since")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
+	}
+
+	if update.Reason._set {
+		__values = append(__values, update.Reason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("reason = ?"))
+	}
+
+	if update.Since._set {
+		__values = append(__values, update.Since.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("since = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, node_standing_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_standing = &NodeStanding{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node_standing.Id, &node_standing.Status, &node_standing.Reason, &node_standing.Since)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_standing, nil
+}
+
+func (obj *postgresImpl) Update_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field,
+	update BucketStat_Update_Fields) (
+	bucket_stat *BucketStat, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_stats SET "), __sets, __sqlbundle_Literal(" WHERE bucket_stats.project_id = ? AND bucket_stats.bucket_name = ? RETURNING bucket_stats.project_id, bucket_stats.bucket_name, bucket_stats.object_count, bucket_stats.total_bytes, bucket_stats.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.ObjectCount._set {
+		__values = append(__values, update.ObjectCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("object_count = ?"))
+	}
+
+	if update.TotalBytes._set {
+		__values = append(__values, update.TotalBytes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_bytes = ?"))
+	}
+
+	if update.UpdatedAt._set {
+		__values = append(__values, update.UpdatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bucket_stat_project_id.value(), bucket_stat_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_stat = &BucketStat{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_stat.ProjectId, &bucket_stat.BucketName, &bucket_stat.ObjectCount, &bucket_stat.TotalBytes, &bucket_stat.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_stat, nil
+}
+
+func (obj *postgresImpl) Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	update BucketLifecycle_Update_Fields) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_lifecycles SET "), __sets, __sqlbundle_Literal(" WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ? RETURNING bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.expire_after_days, bucket_lifecycles.versions_prefix, bucket_lifecycles.versions_expire_after_days, bucket_lifecycles.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.ExpireAfterDays._set {
+		__values = append(__values, update.ExpireAfterDays.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("expire_after_days = ?"))
+	}
+
+	if update.VersionsPrefix._set {
+		__values = append(__values, update.VersionsPrefix.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versions_prefix = ?"))
+	}
+
+	if update.VersionsExpireAfterDays._set {
+		__values = append(__values, update.VersionsExpireAfterDays.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versions_expire_after_days = ?"))
+	}
+
+	if update.UpdatedAt._set {
+		__values = append(__values, update.UpdatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.ExpireAfterDays, &bucket_lifecycle.VersionsPrefix, &bucket_lifecycle.VersionsExpireAfterDays, &bucket_lifecycle.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+}
+
+func (obj *postgresImpl) Update_ProjectInvoice_By_Id(ctx context.Context,
+	project_invoice_id ProjectInvoice_Id_Field,
+	update ProjectInvoice_Update_Fields) (
+	project_invoice *ProjectInvoice, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_invoices SET "), __sets, __sqlbundle_Literal(" WHERE project_invoices.id = ? RETURNING project_invoices.id, project_invoices.project_id, project_invoices.customer_id, project_invoices.period_start, project_invoices.period_end, project_invoices.amount, project_invoices.status, project_invoices.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_invoice_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_invoice = &ProjectInvoice{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_invoice.Id, &project_invoice.ProjectId, &project_invoice.CustomerId, &project_invoice.PeriodStart, &project_invoice.PeriodEnd, &project_invoice.Amount, &project_invoice.Status, &project_invoice.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_invoice, nil
+}
+
+func (obj *postgresImpl) Update_UserPayment_By_UserId(ctx context.Context,
+	user_payment_user_id UserPayment_UserId_Field,
+	update UserPayment_Update_Fields) (
+	user_payment *UserPayment, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE user_payments SET "), __sets, __sqlbundle_Literal(" WHERE user_payments.user_id = ? RETURNING user_payments.user_id, user_payments.customer_id, user_payments.credit, user_payments.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Credit._set {
+		__values = append(__values, update.Credit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("credit = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, user_payment_user_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user_payment = &UserPayment{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user_payment.UserId, &user_payment.CustomerId, &user_payment.Credit, &user_payment.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user_payment, nil
+}
+
+func (obj *postgresImpl) Update_SegmentHealth_By_Path(ctx context.Context,
+	segment_health_path SegmentHealth_Path_Field,
+	update SegmentHealth_Update_Fields) (
+	segment_health *SegmentHealth, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE segment_healths SET "), __sets, __sqlbundle_Literal(" WHERE segment_healths.path = ? RETURNING segment_healths.path, segment_healths.last_audited, segment_healths.last_repaired")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.LastAudited._set {
+		__values = append(__values, update.LastAudited.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_audited = ?"))
+	}
+
+	if update.LastRepaired._set {
+		__values = append(__values, update.LastRepaired.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_repaired = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, segment_health_path.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	segment_health = &SegmentHealth{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&segment_health.Path, &segment_health.LastAudited, &segment_health.LastRepaired)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return segment_health, nil
+}
+
+func (obj *postgresImpl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+
+	var __values []interface{}
+	__values = append(__values, irreparabledb_segmentpath.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_rollups WHERE accounting_rollups.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_AccountingRaw_By_Id(ctx context.Context,
+	accounting_raw_id AccountingRaw_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_raws WHERE accounting_raws.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_raw_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_Injuredsegment_By_Id(ctx context.Context,
+	injuredsegment_id Injuredsegment_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_AuditRecord_By_AuditedAt_Less(ctx context.Context,
+	audit_record_audited_at_less AuditRecord_AuditedAt_Field) (
+	count int64, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM audit_records WHERE audit_records.audited_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, audit_record_audited_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *postgresImpl) Delete_User_By_Id(ctx context.Context,
+	user_id User_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_Project_By_Id(ctx context.Context,
+	project_id Project_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM projects WHERE projects.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_member_member_id.value(), project_member_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (impl postgresImpl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(*pq.Error); ok {
+		if e.Code.Class() == "23" {
+			return e.Constraint, true
+		}
+	}
+	return "", false
+}
+
+func (obj *postgresImpl) Delete_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_deletions WHERE project_deletions.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_deletion_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_Session_By_Id(ctx context.Context,
+	session_id Session_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM sessions WHERE sessions.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, session_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_Session_By_UserId(ctx context.Context,
+	session_user_id Session_UserId_Field) (
+	count int64, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM sessions WHERE sessions.user_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, session_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *postgresImpl) Delete_BucketTemplate_By_ProjectId(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_templates WHERE bucket_templates.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_template_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_NodePing_By_Id(ctx context.Context,
+	node_ping_id NodePing_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_pings WHERE node_pings.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_ping_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_ObjectTag_By_ProjectId_And_BucketName_And_EncryptedPath(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_encrypted_path ObjectTag_EncryptedPath_Field) (
+	count int64, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM object_tags WHERE object_tags.project_id = ? AND object_tags.bucket_name = ? AND object_tags.encrypted_path = ?")
+
+	var __values []interface{}
+	__values = append(__values, object_tag_project_id.value(), object_tag_bucket_name.value(), object_tag_encrypted_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *postgresImpl) Delete_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_stats WHERE bucket_stats.project_id = ? AND bucket_stats.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_stat_project_id.value(), bucket_stat_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error) {
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.Exec("DELETE FROM project_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM users;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM user_payments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM sessions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM segment_healths;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM projects;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_storage_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_invoices;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_deletions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM partner_storage_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM partner_serials;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM overlay_cache_nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM object_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_standings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_pings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM irreparabledbs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM injuredsegments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_templates;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_stats;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_lifecycles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_attributions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM auth_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM audit_records;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM accounting_violations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM accounting_timestamps;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM accounting_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM accounting_raws;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
 	__count, err = __res.RowsAffected()
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+
+	return count, nil
+
+}
+
+func (obj *sqlite3Impl) Create_Bwagreement(ctx context.Context,
+	bwagreement_serialnum Bwagreement_Serialnum_Field,
+	bwagreement_storage_node_id Bwagreement_StorageNodeId_Field,
+	bwagreement_uplink_id Bwagreement_UplinkId_Field,
+	bwagreement_action Bwagreement_Action_Field,
+	bwagreement_total Bwagreement_Total_Field,
+	bwagreement_expires_at Bwagreement_ExpiresAt_Field) (
+	bwagreement *Bwagreement, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__serialnum_val := bwagreement_serialnum.value()
+	__storage_node_id_val := bwagreement_storage_node_id.value()
+	__uplink_id_val := bwagreement_uplink_id.value()
+	__action_val := bwagreement_action.value()
+	__total_val := bwagreement_total.value()
+	__created_at_val := __now
+	__expires_at_val := bwagreement_expires_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreements ( serialnum, storage_node_id, uplink_id, action, total, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __serialnum_val, __storage_node_id_val, __uplink_id_val, __action_val, __total_val, __created_at_val, __expires_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __serialnum_val, __storage_node_id_val, __uplink_id_val, __action_val, __total_val, __created_at_val, __expires_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBwagreement(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_Irreparabledb(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
+	irreparabledb_project_id Irreparabledb_ProjectId_Field,
+	irreparabledb_segmentdetail Irreparabledb_Segmentdetail_Field,
+	irreparabledb_pieces_lost_count Irreparabledb_PiecesLostCount_Field,
+	irreparabledb_seg_damaged_unix_sec Irreparabledb_SegDamagedUnixSec_Field,
+	irreparabledb_repair_attempt_count Irreparabledb_RepairAttemptCount_Field,
+	irreparabledb_last_error Irreparabledb_LastError_Field) (
+	irreparabledb *Irreparabledb, err error) {
+	__segmentpath_val := irreparabledb_segmentpath.value()
+	__project_id_val := irreparabledb_project_id.value()
+	__segmentdetail_val := irreparabledb_segmentdetail.value()
+	__pieces_lost_count_val := irreparabledb_pieces_lost_count.value()
+	__seg_damaged_unix_sec_val := irreparabledb_seg_damaged_unix_sec.value()
+	__repair_attempt_count_val := irreparabledb_repair_attempt_count.value()
+	__last_error_val := irreparabledb_last_error.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO irreparabledbs ( segmentpath, project_id, segmentdetail, pieces_lost_count, seg_damaged_unix_sec, repair_attempt_count, last_error ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __segmentpath_val, __project_id_val, __segmentdetail_val, __pieces_lost_count_val, __seg_damaged_unix_sec_val, __repair_attempt_count_val, __last_error_val)
+
+	__res, err := obj.driver.Exec(__stmt, __segmentpath_val, __project_id_val, __segmentdetail_val, __pieces_lost_count_val, __seg_damaged_unix_sec_val, __repair_attempt_count_val, __last_error_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastIrreparabledb(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_AccountingTimestamps(ctx context.Context,
+	accounting_timestamps_name AccountingTimestamps_Name_Field,
+	accounting_timestamps_value AccountingTimestamps_Value_Field) (
+	accounting_timestamps *AccountingTimestamps, err error) {
+	__name_val := accounting_timestamps_name.value()
+	__value_val := accounting_timestamps_value.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_timestamps ( name, value ) VALUES ( ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __name_val, __value_val)
+
+	__res, err := obj.driver.Exec(__stmt, __name_val, __value_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAccountingTimestamps(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_AccountingRollup(ctx context.Context,
+	accounting_rollup_node_id AccountingRollup_NodeId_Field,
+	accounting_rollup_start_time AccountingRollup_StartTime_Field,
+	accounting_rollup_put_total AccountingRollup_PutTotal_Field,
+	accounting_rollup_get_total AccountingRollup_GetTotal_Field,
+	accounting_rollup_get_audit_total AccountingRollup_GetAuditTotal_Field,
+	accounting_rollup_get_repair_total AccountingRollup_GetRepairTotal_Field,
+	accounting_rollup_put_repair_total AccountingRollup_PutRepairTotal_Field,
+	accounting_rollup_at_rest_total AccountingRollup_AtRestTotal_Field) (
+	accounting_rollup *AccountingRollup, err error) {
+	__node_id_val := accounting_rollup_node_id.value()
+	__start_time_val := accounting_rollup_start_time.value()
+	__put_total_val := accounting_rollup_put_total.value()
+	__get_total_val := accounting_rollup_get_total.value()
+	__get_audit_total_val := accounting_rollup_get_audit_total.value()
+	__get_repair_total_val := accounting_rollup_get_repair_total.value()
+	__put_repair_total_val := accounting_rollup_put_repair_total.value()
+	__at_rest_total_val := accounting_rollup_at_rest_total.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_rollups ( node_id, start_time, put_total, get_total, get_audit_total, get_repair_total, put_repair_total, at_rest_total ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAccountingRollup(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_AccountingRaw(ctx context.Context,
+	accounting_raw_node_id AccountingRaw_NodeId_Field,
+	accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
+	accounting_raw_data_total AccountingRaw_DataTotal_Field,
+	accounting_raw_data_type AccountingRaw_DataType_Field) (
+	accounting_raw *AccountingRaw, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := accounting_raw_node_id.value()
+	__interval_end_time_val := accounting_raw_interval_end_time.value()
+	__data_total_val := accounting_raw_data_total.value()
+	__data_type_val := accounting_raw_data_type.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_raws ( node_id, interval_end_time, data_total, data_type, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAccountingRaw(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_AccountingViolation(ctx context.Context,
+	accounting_violation_name AccountingViolation_Name_Field,
+	accounting_violation_interval_start AccountingViolation_IntervalStart_Field,
+	accounting_violation_interval_end AccountingViolation_IntervalEnd_Field,
+	accounting_violation_expected AccountingViolation_Expected_Field,
+	accounting_violation_actual AccountingViolation_Actual_Field,
+	accounting_violation_details AccountingViolation_Details_Field) (
+	accounting_violation *AccountingViolation, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__name_val := accounting_violation_name.value()
+	__interval_start_val := accounting_violation_interval_start.value()
+	__interval_end_val := accounting_violation_interval_end.value()
+	__expected_val := accounting_violation_expected.value()
+	__actual_val := accounting_violation_actual.value()
+	__details_val := accounting_violation_details.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_violations ( name, interval_start, interval_end, expected, actual, details, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __name_val, __interval_start_val, __interval_end_val, __expected_val, __actual_val, __details_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __name_val, __interval_start_val, __interval_end_val, __expected_val, __actual_val, __details_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAccountingViolation(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_Node(ctx context.Context,
+	node_id Node_Id_Field,
+	node_audit_success_count Node_AuditSuccessCount_Field,
+	node_total_audit_count Node_TotalAuditCount_Field,
+	node_audit_success_ratio Node_AuditSuccessRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_success_count Node_UptimeSuccessCount_Field,
+	node_total_uptime_count Node_TotalUptimeCount_Field,
+	node_uptime_ratio Node_UptimeRatio_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field) (
+	node *Node, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := node_id.value()
+	__audit_success_count_val := node_audit_success_count.value()
+	__total_audit_count_val := node_total_audit_count.value()
+	__audit_success_ratio_val := node_audit_success_ratio.value()
+	__audit_reputation_alpha_val := node_audit_reputation_alpha.value()
+	__audit_reputation_beta_val := node_audit_reputation_beta.value()
+	__uptime_success_count_val := node_uptime_success_count.value()
+	__total_uptime_count_val := node_total_uptime_count.value()
+	__uptime_ratio_val := node_uptime_ratio.value()
+	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
+	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, audit_reputation_alpha, audit_reputation_beta, uptime_success_count, total_uptime_count, uptime_ratio, uptime_reputation_alpha, uptime_reputation_beta, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __created_at_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __created_at_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastNode(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_OverlayCacheNode(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
+	overlay_cache_node_address OverlayCacheNode_Address_Field,
+	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+	overlay_cache_node_last_net OverlayCacheNode_LastNet_Field,
+	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
+	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+	overlay_cache_node_operator_region OverlayCacheNode_OperatorRegion_Field,
+	overlay_cache_node_version OverlayCacheNode_Version_Field,
+	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
+	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
+	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
+	overlay_cache_node_audit_success_ratio OverlayCacheNode_AuditSuccessRatio_Field,
+	overlay_cache_node_audit_uptime_ratio OverlayCacheNode_AuditUptimeRatio_Field,
+	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
+	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
+	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
+	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+	overlay_cache_node_audit_reputation_score OverlayCacheNode_AuditReputationScore_Field,
+	overlay_cache_node_uptime_reputation_score OverlayCacheNode_UptimeReputationScore_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+	__node_id_val := overlay_cache_node_node_id.value()
+	__node_type_val := overlay_cache_node_node_type.value()
+	__address_val := overlay_cache_node_address.value()
+	__protocol_val := overlay_cache_node_protocol.value()
+	__last_net_val := overlay_cache_node_last_net.value()
+	__operator_email_val := overlay_cache_node_operator_email.value()
+	__operator_wallet_val := overlay_cache_node_operator_wallet.value()
+	__operator_region_val := overlay_cache_node_operator_region.value()
+	__version_val := overlay_cache_node_version.value()
+	__free_bandwidth_val := overlay_cache_node_free_bandwidth.value()
+	__free_disk_val := overlay_cache_node_free_disk.value()
+	__latency_90_val := overlay_cache_node_latency_90.value()
+	__audit_success_ratio_val := overlay_cache_node_audit_success_ratio.value()
+	__audit_uptime_ratio_val := overlay_cache_node_audit_uptime_ratio.value()
+	__audit_count_val := overlay_cache_node_audit_count.value()
+	__audit_success_count_val := overlay_cache_node_audit_success_count.value()
+	__uptime_count_val := overlay_cache_node_uptime_count.value()
+	__uptime_success_count_val := overlay_cache_node_uptime_success_count.value()
+	__audit_reputation_score_val := overlay_cache_node_audit_reputation_score.value()
+	__uptime_reputation_score_val := overlay_cache_node_uptime_reputation_score.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, last_net, operator_email, operator_wallet, operator_region, version, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count, audit_reputation_score, uptime_reputation_score ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __last_net_val, __operator_email_val, __operator_wallet_val, __operator_region_val, __version_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __audit_reputation_score_val, __uptime_reputation_score_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __last_net_val, __operator_email_val, __operator_wallet_val, __operator_region_val, __version_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __audit_reputation_score_val, __uptime_reputation_score_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastOverlayCacheNode(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_Injuredsegment(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	injuredsegment_priority Injuredsegment_Priority_Field,
+	injuredsegment_info Injuredsegment_Info_Field,
+	injuredsegment_attempts Injuredsegment_Attempts_Field,
+	injuredsegment_leased_until Injuredsegment_LeasedUntil_Field) (
+	injuredsegment *Injuredsegment, err error) {
+	__path_val := injuredsegment_path.value()
+	__priority_val := injuredsegment_priority.value()
+	__info_val := injuredsegment_info.value()
+	__attempts_val := injuredsegment_attempts.value()
+	__leased_until_val := injuredsegment_leased_until.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( path, priority, info, attempts, leased_until ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __path_val, __priority_val, __info_val, __attempts_val, __leased_until_val)
+
+	__res, err := obj.driver.Exec(__stmt, __path_val, __priority_val, __info_val, __attempts_val, __leased_until_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastInjuredsegment(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_User(ctx context.Context,
+	user_id User_Id_Field,
+	user_first_name User_FirstName_Field,
+	user_last_name User_LastName_Field,
+	user_password_hash User_PasswordHash_Field,
+	optional User_Create_Fields) (
+	user *User, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := user_id.value()
+	__first_name_val := user_first_name.value()
+	__last_name_val := user_last_name.value()
+	__email_val := optional.Email.value()
+	__password_hash_val := user_password_hash.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, first_name, last_name, email, password_hash, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __first_name_val, __last_name_val, __email_val, __password_hash_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __first_name_val, __last_name_val, __email_val, __password_hash_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastUser(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_Project(ctx context.Context,
+	project_id Project_Id_Field,
+	project_name Project_Name_Field,
+	project_description Project_Description_Field) (
+	project *Project, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_id.value()
+	__name_val := project_name.value()
+	__description_val := project_description.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProject(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ProjectMember(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member *ProjectMember, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__member_id_val := project_member_member_id.value()
+	__project_id_val := project_member_project_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProjectMember(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ApiKey(ctx context.Context,
+	api_key_id ApiKey_Id_Field,
+	api_key_project_id ApiKey_ProjectId_Field,
+	api_key_key ApiKey_Key_Field,
+	api_key_name ApiKey_Name_Field) (
+	api_key *ApiKey, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := api_key_id.value()
+	__project_id_val := api_key_project_id.value()
+	__key_val := api_key_key.value()
+	__name_val := api_key_name.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, name, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastApiKey(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ProjectDeletion(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	project_deletion_requested_by ProjectDeletion_RequestedBy_Field,
+	project_deletion_purge_after ProjectDeletion_PurgeAfter_Field,
+	project_deletion_segments_deleted ProjectDeletion_SegmentsDeleted_Field,
+	project_deletion_pieces_deleted ProjectDeletion_PiecesDeleted_Field,
+	project_deletion_api_keys_deleted ProjectDeletion_ApiKeysDeleted_Field,
+	optional ProjectDeletion_Create_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_deletion_project_id.value()
+	__requested_by_val := project_deletion_requested_by.value()
+	__purge_after_val := project_deletion_purge_after.value()
+	__purged_at_val := optional.PurgedAt.value()
+	__segments_deleted_val := project_deletion_segments_deleted.value()
+	__pieces_deleted_val := project_deletion_pieces_deleted.value()
+	__api_keys_deleted_val := project_deletion_api_keys_deleted.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_deletions ( project_id, requested_by, purge_after, purged_at, segments_deleted, pieces_deleted, api_keys_deleted, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __requested_by_val, __purge_after_val, __purged_at_val, __segments_deleted_val, __pieces_deleted_val, __api_keys_deleted_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __requested_by_val, __purge_after_val, __purged_at_val, __segments_deleted_val, __pieces_deleted_val, __api_keys_deleted_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProjectDeletion(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_Session(ctx context.Context,
+	session_id Session_Id_Field,
+	session_user_id Session_UserId_Field,
+	session_device Session_Device_Field,
+	session_address Session_Address_Field,
+	session_refresh_token_hash Session_RefreshTokenHash_Field,
+	session_expires_at Session_ExpiresAt_Field,
+	session_last_used_at Session_LastUsedAt_Field) (
+	session *Session, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := session_id.value()
+	__user_id_val := session_user_id.value()
+	__device_val := session_device.value()
+	__address_val := session_address.value()
+	__refresh_token_hash_val := session_refresh_token_hash.value()
+	__expires_at_val := session_expires_at.value()
+	__last_used_at_val := session_last_used_at.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO sessions ( id, user_id, device, address, refresh_token_hash, expires_at, last_used_at, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __user_id_val, __device_val, __address_val, __refresh_token_hash_val, __expires_at_val, __last_used_at_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __user_id_val, __device_val, __address_val, __refresh_token_hash_val, __expires_at_val, __last_used_at_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastSession(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_AuthEvent(ctx context.Context,
+	auth_event_user_id AuthEvent_UserId_Field,
+	auth_event_session_id AuthEvent_SessionId_Field,
+	auth_event_kind AuthEvent_Kind_Field,
+	auth_event_device AuthEvent_Device_Field,
+	auth_event_address AuthEvent_Address_Field) (
+	auth_event *AuthEvent, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__user_id_val := auth_event_user_id.value()
+	__session_id_val := auth_event_session_id.value()
+	__kind_val := auth_event_kind.value()
+	__device_val := auth_event_device.value()
+	__address_val := auth_event_address.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO auth_events ( user_id, session_id, kind, device, address, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __user_id_val, __session_id_val, __kind_val, __device_val, __address_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __user_id_val, __session_id_val, __kind_val, __device_val, __address_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAuthEvent(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_BucketTemplate(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field,
+	bucket_template_path_cipher BucketTemplate_PathCipher_Field,
+	bucket_template_data_cipher BucketTemplate_DataCipher_Field,
+	bucket_template_block_size BucketTemplate_BlockSize_Field,
+	bucket_template_share_size BucketTemplate_ShareSize_Field,
+	bucket_template_required_shares BucketTemplate_RequiredShares_Field,
+	bucket_template_repair_shares BucketTemplate_RepairShares_Field,
+	bucket_template_optimal_shares BucketTemplate_OptimalShares_Field,
+	bucket_template_total_shares BucketTemplate_TotalShares_Field,
+	bucket_template_distinct_subnets BucketTemplate_DistinctSubnets_Field,
+	bucket_template_distinct_regions BucketTemplate_DistinctRegions_Field,
+	bucket_template_updated_at BucketTemplate_UpdatedAt_Field) (
+	bucket_template *BucketTemplate, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_template_project_id.value()
+	__path_cipher_val := bucket_template_path_cipher.value()
+	__data_cipher_val := bucket_template_data_cipher.value()
+	__block_size_val := bucket_template_block_size.value()
+	__share_size_val := bucket_template_share_size.value()
+	__required_shares_val := bucket_template_required_shares.value()
+	__repair_shares_val := bucket_template_repair_shares.value()
+	__optimal_shares_val := bucket_template_optimal_shares.value()
+	__total_shares_val := bucket_template_total_shares.value()
+	__distinct_subnets_val := bucket_template_distinct_subnets.value()
+	__distinct_regions_val := bucket_template_distinct_regions.value()
+	__updated_at_val := bucket_template_updated_at.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_templates ( project_id, path_cipher, data_cipher, block_size, share_size, required_shares, repair_shares, optimal_shares, total_shares, distinct_subnets, distinct_regions, updated_at, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __path_cipher_val, __data_cipher_val, __block_size_val, __share_size_val, __required_shares_val, __repair_shares_val, __optimal_shares_val, __total_shares_val, __distinct_subnets_val, __distinct_regions_val, __updated_at_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __path_cipher_val, __data_cipher_val, __block_size_val, __share_size_val, __required_shares_val, __repair_shares_val, __optimal_shares_val, __total_shares_val, __distinct_subnets_val, __distinct_regions_val, __updated_at_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBucketTemplate(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_NodePing(ctx context.Context,
+	node_ping_id NodePing_Id_Field,
+	node_ping_success_rate NodePing_SuccessRate_Field,
+	node_ping_ping_count NodePing_PingCount_Field,
+	node_ping_last_ping_at NodePing_LastPingAt_Field,
+	optional NodePing_Create_Fields) (
+	node_ping *NodePing, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := node_ping_id.value()
+	__success_rate_val := node_ping_success_rate.value()
+	__ping_count_val := node_ping_ping_count.value()
+	__last_ping_at_val := node_ping_last_ping_at.value()
+	__last_success_at_val := optional.LastSuccessAt.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_pings ( id, success_rate, ping_count, last_ping_at, last_success_at, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __success_rate_val, __ping_count_val, __last_ping_at_val, __last_success_at_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __success_rate_val, __ping_count_val, __last_ping_at_val, __last_success_at_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastNodePing(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_NodeStanding(ctx context.Context,
+	node_standing_id NodeStanding_Id_Field,
+	node_standing_status NodeStanding_Status_Field,
+	node_standing_reason NodeStanding_Reason_Field,
+	node_standing_since NodeStanding_Since_Field) (
+	node_standing *NodeStanding, err error) {
+
+	__id_val := node_standing_id.value()
+	__status_val := node_standing_status.value()
+	__reason_val := node_standing_reason.value()
+	__since_val := node_standing_since.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_standings ( id, status, reason, since ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __status_val, __reason_val, __since_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __status_val, __reason_val, __since_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastNodeStanding(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ObjectTag(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_tag_key ObjectTag_TagKey_Field,
+	object_tag_tag_value ObjectTag_TagValue_Field,
+	object_tag_encrypted_path ObjectTag_EncryptedPath_Field) (
+	object_tag *ObjectTag, err error) {
+
+	__project_id_val := object_tag_project_id.value()
+	__bucket_name_val := object_tag_bucket_name.value()
+	__tag_key_val := object_tag_tag_key.value()
+	__tag_value_val := object_tag_tag_value.value()
+	__encrypted_path_val := object_tag_encrypted_path.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO object_tags ( project_id, bucket_name, tag_key, tag_value, encrypted_path ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __tag_key_val, __tag_value_val, __encrypted_path_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __bucket_name_val, __tag_key_val, __tag_value_val, __encrypted_path_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastObjectTag(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_BucketAttribution(ctx context.Context,
+	bucket_attribution_project_id BucketAttribution_ProjectId_Field,
+	bucket_attribution_bucket_name BucketAttribution_BucketName_Field,
+	bucket_attribution_partner_id BucketAttribution_PartnerId_Field) (
+	bucket_attribution *BucketAttribution, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_attribution_project_id.value()
+	__bucket_name_val := bucket_attribution_bucket_name.value()
+	__partner_id_val := bucket_attribution_partner_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_attributions ( project_id, bucket_name, partner_id, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __partner_id_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __bucket_name_val, __partner_id_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBucketAttribution(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_PartnerStorageTally(ctx context.Context,
+	partner_storage_tally_partner_id PartnerStorageTally_PartnerId_Field,
+	partner_storage_tally_interval_end_time PartnerStorageTally_IntervalEndTime_Field,
+	partner_storage_tally_data_total PartnerStorageTally_DataTotal_Field) (
+	partner_storage_tally *PartnerStorageTally, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__partner_id_val := partner_storage_tally_partner_id.value()
+	__interval_end_time_val := partner_storage_tally_interval_end_time.value()
+	__data_total_val := partner_storage_tally_data_total.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO partner_storage_tallies ( partner_id, interval_end_time, data_total, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __partner_id_val, __interval_end_time_val, __data_total_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __partner_id_val, __interval_end_time_val, __data_total_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastPartnerStorageTally(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_PartnerSerial(ctx context.Context,
+	partner_serial_serial_number PartnerSerial_SerialNumber_Field,
+	partner_serial_partner_id PartnerSerial_PartnerId_Field) (
+	partner_serial *PartnerSerial, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__serial_number_val := partner_serial_serial_number.value()
+	__partner_id_val := partner_serial_partner_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO partner_serials ( serial_number, partner_id, created_at ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __serial_number_val, __partner_id_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __serial_number_val, __partner_id_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastPartnerSerial(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_BucketStat(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field,
+	bucket_stat_object_count BucketStat_ObjectCount_Field,
+	bucket_stat_total_bytes BucketStat_TotalBytes_Field,
+	bucket_stat_updated_at BucketStat_UpdatedAt_Field) (
+	bucket_stat *BucketStat, err error) {
+
+	__project_id_val := bucket_stat_project_id.value()
+	__bucket_name_val := bucket_stat_bucket_name.value()
+	__object_count_val := bucket_stat_object_count.value()
+	__total_bytes_val := bucket_stat_total_bytes.value()
+	__updated_at_val := bucket_stat_updated_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_stats ( project_id, bucket_name, object_count, total_bytes, updated_at ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __object_count_val, __total_bytes_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __bucket_name_val, __object_count_val, __total_bytes_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBucketStat(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_BucketLifecycle(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	bucket_lifecycle_expire_after_days BucketLifecycle_ExpireAfterDays_Field,
+	bucket_lifecycle_versions_prefix BucketLifecycle_VersionsPrefix_Field,
+	bucket_lifecycle_versions_expire_after_days BucketLifecycle_VersionsExpireAfterDays_Field,
+	bucket_lifecycle_updated_at BucketLifecycle_UpdatedAt_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	__project_id_val := bucket_lifecycle_project_id.value()
+	__bucket_name_val := bucket_lifecycle_bucket_name.value()
+	__expire_after_days_val := bucket_lifecycle_expire_after_days.value()
+	__versions_prefix_val := bucket_lifecycle_versions_prefix.value()
+	__versions_expire_after_days_val := bucket_lifecycle_versions_expire_after_days.value()
+	__updated_at_val := bucket_lifecycle_updated_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_lifecycles ( project_id, bucket_name, expire_after_days, versions_prefix, versions_expire_after_days, updated_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __expire_after_days_val, __versions_prefix_val, __versions_expire_after_days_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __bucket_name_val, __expire_after_days_val, __versions_prefix_val, __versions_expire_after_days_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBucketLifecycle(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ProjectInvoice(ctx context.Context,
+	project_invoice_id ProjectInvoice_Id_Field,
+	project_invoice_project_id ProjectInvoice_ProjectId_Field,
+	project_invoice_customer_id ProjectInvoice_CustomerId_Field,
+	project_invoice_period_start ProjectInvoice_PeriodStart_Field,
+	project_invoice_period_end ProjectInvoice_PeriodEnd_Field,
+	project_invoice_amount ProjectInvoice_Amount_Field,
+	project_invoice_status ProjectInvoice_Status_Field) (
+	project_invoice *ProjectInvoice, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_invoice_id.value()
+	__project_id_val := project_invoice_project_id.value()
+	__customer_id_val := project_invoice_customer_id.value()
+	__period_start_val := project_invoice_period_start.value()
+	__period_end_val := project_invoice_period_end.value()
+	__amount_val := project_invoice_amount.value()
+	__status_val := project_invoice_status.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_invoices ( id, project_id, customer_id, period_start, period_end, amount, status, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __customer_id_val, __period_start_val, __period_end_val, __amount_val, __status_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __customer_id_val, __period_start_val, __period_end_val, __amount_val, __status_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProjectInvoice(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ProjectStorageTally(ctx context.Context,
+	project_storage_tally_project_id ProjectStorageTally_ProjectId_Field,
+	project_storage_tally_interval_end_time ProjectStorageTally_IntervalEndTime_Field,
+	project_storage_tally_data_total ProjectStorageTally_DataTotal_Field) (
+	project_storage_tally *ProjectStorageTally, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_storage_tally_project_id.value()
+	__interval_end_time_val := project_storage_tally_interval_end_time.value()
+	__data_total_val := project_storage_tally_data_total.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_storage_tallies ( project_id, interval_end_time, data_total, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __interval_end_time_val, __data_total_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __interval_end_time_val, __data_total_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProjectStorageTally(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_UserPayment(ctx context.Context,
+	user_payment_user_id UserPayment_UserId_Field,
+	user_payment_customer_id UserPayment_CustomerId_Field,
+	user_payment_credit UserPayment_Credit_Field) (
+	user_payment *UserPayment, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__user_id_val := user_payment_user_id.value()
+	__customer_id_val := user_payment_customer_id.value()
+	__credit_val := user_payment_credit.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO user_payments ( user_id, customer_id, credit, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __user_id_val, __customer_id_val, __credit_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __user_id_val, __customer_id_val, __credit_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastUserPayment(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_SegmentHealth(ctx context.Context,
+	segment_health_path SegmentHealth_Path_Field,
+	optional SegmentHealth_Create_Fields) (
+	segment_health *SegmentHealth, err error) {
+
+	__path_val := segment_health_path.value()
+	__last_audited_val := optional.LastAudited.value()
+	__last_repaired_val := optional.LastRepaired.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO segment_healths ( path, last_audited, last_repaired ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __path_val, __last_audited_val, __last_repaired_val)
+
+	__res, err := obj.driver.Exec(__stmt, __path_val, __last_audited_val, __last_repaired_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastSegmentHealth(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_AuditRecord(ctx context.Context,
+	audit_record_node_id AuditRecord_NodeId_Field,
+	audit_record_audited_at AuditRecord_AuditedAt_Field,
+	audit_record_segment_hash AuditRecord_SegmentHash_Field,
+	audit_record_stripe_index AuditRecord_StripeIndex_Field,
+	audit_record_piece_num AuditRecord_PieceNum_Field,
+	audit_record_outcome AuditRecord_Outcome_Field,
+	audit_record_error AuditRecord_Error_Field,
+	audit_record_duration_ms AuditRecord_DurationMs_Field) (
+	audit_record *AuditRecord, err error) {
+
+	__node_id_val := audit_record_node_id.value()
+	__audited_at_val := audit_record_audited_at.value()
+	__segment_hash_val := audit_record_segment_hash.value()
+	__stripe_index_val := audit_record_stripe_index.value()
+	__piece_num_val := audit_record_piece_num.value()
+	__outcome_val := audit_record_outcome.value()
+	__error_val := audit_record_error.value()
+	__duration_ms_val := audit_record_duration_ms.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO audit_records ( node_id, audited_at, segment_hash, stripe_index, piece_num, outcome, error, duration_ms ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __audited_at_val, __segment_hash_val, __stripe_index_val, __piece_num_val, __outcome_val, __error_val, __duration_ms_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __audited_at_val, __segment_hash_val, __stripe_index_val, __piece_num_val, __outcome_val, __error_val, __duration_ms_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAuditRecord(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Limited_Bwagreement(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_Bwagreement(ctx context.Context) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
+	bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
+	rows []*Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements WHERE bwagreements.created_at > ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_created_at_greater.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bwagreement := &Bwagreement{}
+		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bwagreement)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_Irreparabledb_By_Segmentpath(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
+	irreparabledb *Irreparabledb, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.project_id, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count, irreparabledbs.last_error FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+
+	var __values []interface{}
+	__values = append(__values, irreparabledb_segmentpath.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	irreparabledb = &Irreparabledb{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&irreparabledb.Segmentpath, &irreparabledb.ProjectId, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount, &irreparabledb.LastError)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return irreparabledb, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Irreparabledb_By_ProjectId_OrderBy_Asc_Segmentpath(ctx context.Context,
+	irreparabledb_project_id Irreparabledb_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*Irreparabledb, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.project_id, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count, irreparabledbs.last_error FROM irreparabledbs WHERE irreparabledbs.project_id = ? ORDER BY irreparabledbs.segmentpath LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, irreparabledb_project_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		irreparabledb := &Irreparabledb{}
+		err = __rows.Scan(&irreparabledb.Segmentpath, &irreparabledb.ProjectId, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount, &irreparabledb.LastError)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, irreparabledb)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Irreparabledb_By_SegDamagedUnixSec_Less_OrderBy_Asc_SegDamagedUnixSec(ctx context.Context,
+	irreparabledb_seg_damaged_unix_sec_less Irreparabledb_SegDamagedUnixSec_Field,
+	limit int, offset int64) (
+	rows []*Irreparabledb, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.project_id, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count, irreparabledbs.last_error FROM irreparabledbs WHERE irreparabledbs.seg_damaged_unix_sec < ? ORDER BY irreparabledbs.seg_damaged_unix_sec LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, irreparabledb_seg_damaged_unix_sec_less.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		irreparabledb := &Irreparabledb{}
+		err = __rows.Scan(&irreparabledb.Segmentpath, &irreparabledb.ProjectId, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount, &irreparabledb.LastError)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, irreparabledb)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_OrderBy_Asc_EncryptedPath(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_tag_key ObjectTag_TagKey_Field,
+	object_tag_tag_value ObjectTag_TagValue_Field,
+	limit int, offset int64) (
+	rows []*ObjectTag, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT object_tags.project_id, object_tags.bucket_name, object_tags.tag_key, object_tags.tag_value, object_tags.encrypted_path FROM object_tags WHERE object_tags.project_id = ? AND object_tags.bucket_name = ? AND object_tags.tag_key = ? AND object_tags.tag_value = ? ORDER BY object_tags.encrypted_path LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, object_tag_project_id.value(), object_tag_bucket_name.value(), object_tag_tag_key.value(), object_tag_tag_value.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		object_tag := &ObjectTag{}
+		err = __rows.Scan(&object_tag.ProjectId, &object_tag.BucketName, &object_tag.TagKey, &object_tag.TagValue, &object_tag.EncryptedPath)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, object_tag)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_GreaterOrEqual_And_TagValue_Less_OrderBy_Asc_TagValue_Asc_EncryptedPath(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_tag_key ObjectTag_TagKey_Field,
+	object_tag_tag_value_greater_or_equal ObjectTag_TagValue_Field,
+	object_tag_tag_value_less ObjectTag_TagValue_Field,
+	limit int, offset int64) (
+	rows []*ObjectTag, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT object_tags.project_id, object_tags.bucket_name, object_tags.tag_key, object_tags.tag_value, object_tags.encrypted_path FROM object_tags WHERE object_tags.project_id = ? AND object_tags.bucket_name = ? AND object_tags.tag_key = ? AND object_tags.tag_value >= ? AND object_tags.tag_value < ? ORDER BY object_tags.tag_value, object_tags.encrypted_path LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, object_tag_project_id.value(), object_tag_bucket_name.value(), object_tag_tag_key.value(), object_tag_tag_value_greater_or_equal.value(), object_tag_tag_value_less.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		object_tag := &ObjectTag{}
+		err = __rows.Scan(&object_tag.ProjectId, &object_tag.BucketName, &object_tag.TagKey, &object_tag.TagValue, &object_tag.EncryptedPath)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, object_tag)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_BucketAttribution_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_attribution_project_id BucketAttribution_ProjectId_Field,
+	bucket_attribution_bucket_name BucketAttribution_BucketName_Field) (
+	bucket_attribution *BucketAttribution, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_attributions.project_id, bucket_attributions.bucket_name, bucket_attributions.partner_id, bucket_attributions.created_at FROM bucket_attributions WHERE bucket_attributions.project_id = ? AND bucket_attributions.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_attribution_project_id.value(), bucket_attribution_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_attribution = &BucketAttribution{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_attribution.ProjectId, &bucket_attribution.BucketName, &bucket_attribution.PartnerId, &bucket_attribution.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_attribution, nil
+
+}
+
+func (obj *sqlite3Impl) Get_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field) (
+	bucket_stat *BucketStat, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_stats.project_id, bucket_stats.bucket_name, bucket_stats.object_count, bucket_stats.total_bytes, bucket_stats.updated_at FROM bucket_stats WHERE bucket_stats.project_id = ? AND bucket_stats.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_stat_project_id.value(), bucket_stat_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_stat = &BucketStat{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_stat.ProjectId, &bucket_stat.BucketName, &bucket_stat.ObjectCount, &bucket_stat.TotalBytes, &bucket_stat.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_stat, nil
+
+}
+
+func (obj *sqlite3Impl) All_BucketStat_By_ProjectId_OrderBy_Asc_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field) (
+	rows []*BucketStat, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_stats.project_id, bucket_stats.bucket_name, bucket_stats.object_count, bucket_stats.total_bytes, bucket_stats.updated_at FROM bucket_stats WHERE bucket_stats.project_id = ? ORDER BY bucket_stats.bucket_name")
+
+	var __values []interface{}
+	__values = append(__values, bucket_stat_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_stat := &BucketStat{}
+		err = __rows.Scan(&bucket_stat.ProjectId, &bucket_stat.BucketName, &bucket_stat.ObjectCount, &bucket_stat.TotalBytes, &bucket_stat.UpdatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_stat)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.expire_after_days, bucket_lifecycles.versions_prefix, bucket_lifecycles.versions_expire_after_days, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.ExpireAfterDays, &bucket_lifecycle.VersionsPrefix, &bucket_lifecycle.VersionsExpireAfterDays, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *sqlite3Impl) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
+	accounting_timestamps_name AccountingTimestamps_Name_Field) (
+	row *Value_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_timestamps.value FROM accounting_timestamps WHERE accounting_timestamps.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_timestamps_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Value_Row{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.Value)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *sqlite3Impl) Get_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	accounting_rollup *AccountingRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	accounting_rollup = &AccountingRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_rollup, nil
+
+}
+
+func (obj *sqlite3Impl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Context,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
+	rows []*AccountingRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.start_time >= ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_rollup := &AccountingRollup{}
+		err = __rows.Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_rollup)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_AccountingRollup_By_NodeId_And_StartTime_GreaterOrEqual_And_StartTime_Less_OrderBy_Asc_StartTime(ctx context.Context,
+	accounting_rollup_node_id AccountingRollup_NodeId_Field,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field,
+	accounting_rollup_start_time_less AccountingRollup_StartTime_Field) (
+	rows []*AccountingRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.node_id = ? AND accounting_rollups.start_time >= ? AND accounting_rollups.start_time < ? ORDER BY accounting_rollups.start_time")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_node_id.value(), accounting_rollup_start_time_greater_or_equal.value(), accounting_rollup_start_time_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_rollup := &AccountingRollup{}
+		err = __rows.Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_rollup)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_AccountingRaw_By_Id(ctx context.Context,
+	accounting_raw_id AccountingRaw_Id_Field) (
+	accounting_raw *AccountingRaw, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE accounting_raws.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_raw_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	accounting_raw = &AccountingRaw{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_raw, nil
+
+}
+
+func (obj *sqlite3Impl) All_AccountingRaw(ctx context.Context) (
+	rows []*AccountingRaw, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_raw := &AccountingRaw{}
+		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_raw)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx context.Context,
+	accounting_raw_interval_end_time_greater_or_equal AccountingRaw_IntervalEndTime_Field) (
+	rows []*AccountingRaw, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE accounting_raws.interval_end_time >= ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_raw_interval_end_time_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_raw := &AccountingRaw{}
+		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_raw)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	node *Node, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+
+}
+
+func (obj *sqlite3Impl) All_Node_Id(ctx context.Context) (
+	rows []*Id_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id FROM nodes")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		row := &Id_Row{}
+		err = __rows.Scan(&row.Id)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, row)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_By_AccountingRollup_StartTime_GreaterOrEqual_And_AccountingRollup_StartTime_Less_OrderBy_Asc_Node_Id(ctx context.Context,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field,
+	accounting_rollup_start_time_less AccountingRollup_StartTime_Field) (
+	rows []*Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.created_at, nodes.audit_success_ratio, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM nodes  JOIN accounting_rollups ON nodes.id = accounting_rollups.node_id WHERE accounting_rollups.start_time >= ? AND accounting_rollups.start_time < ? ORDER BY nodes.id")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value(), accounting_rollup_start_time_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		row := &Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row{}
+		err = __rows.Scan(&row.Node_Id, &row.Node_CreatedAt, &row.Node_AuditSuccessRatio, &row.AccountingRollup_StartTime, &row.AccountingRollup_PutTotal, &row.AccountingRollup_GetTotal, &row.AccountingRollup_GetAuditTotal, &row.AccountingRollup_GetRepairTotal, &row.AccountingRollup_PutRepairTotal, &row.AccountingRollup_AtRestTotal)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, row)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.last_net, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_region, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audit_reputation_score, overlay_cache_nodes.uptime_reputation_score FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.LastNet, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorRegion, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditReputationScore, &overlay_cache_node.UptimeReputationScore)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+
+}
+
+func (obj *sqlite3Impl) Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	row *OperatorWallet_Row, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.operator_wallet FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &OperatorWallet_Row{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.OperatorWallet)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx context.Context,
+	overlay_cache_node_node_id_greater_or_equal OverlayCacheNode_NodeId_Field,
+	limit int, offset int64) (
+	rows []*OverlayCacheNode, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.last_net, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_region, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audit_reputation_score, overlay_cache_nodes.uptime_reputation_score FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, overlay_cache_node_node_id_greater_or_equal.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		overlay_cache_node := &OverlayCacheNode{}
+		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.LastNet, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorRegion, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditReputationScore, &overlay_cache_node.UptimeReputationScore)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, overlay_cache_node)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) First_Injuredsegment_OrderBy_Asc_Priority_Id(ctx context.Context) (
+	injuredsegment *Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until FROM injuredsegments ORDER BY injuredsegments.priority, injuredsegments.id LIMIT 1 OFFSET 0")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	if !__rows.Next() {
+		if err := __rows.Err(); err != nil {
+			return nil, obj.makeErr(err)
+		}
+		return nil, nil
+	}
+
+	injuredsegment = &Injuredsegment{}
+	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	return injuredsegment, nil
+
+}
+
+func (obj *sqlite3Impl) First_Injuredsegment_By_LeasedUntil_Less_OrderBy_Asc_Priority_Id(ctx context.Context,
+	injuredsegment_leased_until_less Injuredsegment_LeasedUntil_Field) (
+	injuredsegment *Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until FROM injuredsegments WHERE injuredsegments.leased_until < ? ORDER BY injuredsegments.priority, injuredsegments.id LIMIT 1 OFFSET 0")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_leased_until_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	if !__rows.Next() {
+		if err := __rows.Err(); err != nil {
+			return nil, obj.makeErr(err)
+		}
+		return nil, nil
+	}
+
+	injuredsegment = &Injuredsegment{}
+	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	return injuredsegment, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Injuredsegment_OrderBy_Asc_Priority_Id(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until FROM injuredsegments ORDER BY injuredsegments.priority, injuredsegments.id LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		injuredsegment := &Injuredsegment{}
+		err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, injuredsegment)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	injuredsegment *Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until FROM injuredsegments WHERE injuredsegments.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	injuredsegment = &Injuredsegment{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return injuredsegment, nil
+
+}
+
+func (obj *sqlite3Impl) Get_User_By_Email(ctx context.Context,
+	user_email User_Email_Field) (
+	user *User, err error) {
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "users.email", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE "), __cond_0}}
+
+	var __values []interface{}
+	__values = append(__values)
+
+	if !user_email.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, user_email.value())
+	}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user = &User{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user, nil
+
+}
+
+func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
+	user_id User_Id_Field) (
+	user *User, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE users.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user = &User{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user, nil
+
+}
+
+func (obj *sqlite3Impl) All_Project(ctx context.Context) (
+	rows []*Project, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project := &Project{}
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
+	project_id Project_Id_Field) (
+	project *Project, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project = &Project{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project, nil
+
+}
+
+func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
+	rows []*Project, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+
+	var __values []interface{}
+	__values = append(__values, project_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project := &Project{}
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
+	rows []*ProjectMember, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_member := &ProjectMember{}
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_member)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*ProjectMember, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, project_member_project_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_member := &ProjectMember{}
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_member)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
+	api_key *ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *sqlite3Impl) Get_ApiKey_By_Key(ctx context.Context,
+	api_key_key ApiKey_Key_Field) (
+	api_key *ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_key.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+	api_key_project_id ApiKey_ProjectId_Field) (
+	rows []*ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
+
+	var __values []interface{}
+	__values = append(__values, api_key_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		api_key := &ApiKey{}
+		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, api_key)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+	project_deletion *ProjectDeletion, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.requested_by, project_deletions.purge_after, project_deletions.purged_at, project_deletions.segments_deleted, project_deletions.pieces_deleted, project_deletions.api_keys_deleted, project_deletions.created_at FROM project_deletions WHERE project_deletions.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_deletion_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_deletion = &ProjectDeletion{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_deletion.ProjectId, &project_deletion.RequestedBy, &project_deletion.PurgeAfter, &project_deletion.PurgedAt, &project_deletion.SegmentsDeleted, &project_deletion.PiecesDeleted, &project_deletion.ApiKeysDeleted, &project_deletion.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_deletion, nil
+
+}
+
+func (obj *sqlite3Impl) All_ProjectDeletion_By_PurgeAfter_Less_And_PurgedAt_Is_Null(ctx context.Context,
+	project_deletion_purge_after_less ProjectDeletion_PurgeAfter_Field) (
+	rows []*ProjectDeletion, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.requested_by, project_deletions.purge_after, project_deletions.purged_at, project_deletions.segments_deleted, project_deletions.pieces_deleted, project_deletions.api_keys_deleted, project_deletions.created_at FROM project_deletions WHERE project_deletions.purge_after < ? AND project_deletions.purged_at is NULL")
+
+	var __values []interface{}
+	__values = append(__values, project_deletion_purge_after_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_deletion := &ProjectDeletion{}
+		err = __rows.Scan(&project_deletion.ProjectId, &project_deletion.RequestedBy, &project_deletion.PurgeAfter, &project_deletion.PurgedAt, &project_deletion.SegmentsDeleted, &project_deletion.PiecesDeleted, &project_deletion.ApiKeysDeleted, &project_deletion.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_deletion)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_Session_By_Id(ctx context.Context,
+	session_id Session_Id_Field) (
+	session *Session, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT sessions.id, sessions.user_id, sessions.device, sessions.address, sessions.refresh_token_hash, sessions.expires_at, sessions.last_used_at, sessions.created_at FROM sessions WHERE sessions.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, session_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	session = &Session{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&session.Id, &session.UserId, &session.Device, &session.Address, &session.RefreshTokenHash, &session.ExpiresAt, &session.LastUsedAt, &session.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return session, nil
+
+}
+
+func (obj *sqlite3Impl) All_Session_By_UserId_OrderBy_Desc_LastUsedAt(ctx context.Context,
+	session_user_id Session_UserId_Field) (
+	rows []*Session, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT sessions.id, sessions.user_id, sessions.device, sessions.address, sessions.refresh_token_hash, sessions.expires_at, sessions.last_used_at, sessions.created_at FROM sessions WHERE sessions.user_id = ? ORDER BY sessions.last_used_at DESC")
+
+	var __values []interface{}
+	__values = append(__values, session_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		session := &Session{}
+		err = __rows.Scan(&session.Id, &session.UserId, &session.Device, &session.Address, &session.RefreshTokenHash, &session.ExpiresAt, &session.LastUsedAt, &session.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, session)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_AuthEvent_By_UserId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	auth_event_user_id AuthEvent_UserId_Field,
+	limit int, offset int64) (
+	rows []*AuthEvent, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT auth_events.id, auth_events.user_id, auth_events.session_id, auth_events.kind, auth_events.device, auth_events.address, auth_events.created_at FROM auth_events WHERE auth_events.user_id = ? ORDER BY auth_events.created_at DESC LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, auth_event_user_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		auth_event := &AuthEvent{}
+		err = __rows.Scan(&auth_event.Id, &auth_event.UserId, &auth_event.SessionId, &auth_event.Kind, &auth_event.Device, &auth_event.Address, &auth_event.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, auth_event)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_BucketTemplate_By_ProjectId(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field) (
+	bucket_template *BucketTemplate, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_templates.project_id, bucket_templates.path_cipher, bucket_templates.data_cipher, bucket_templates.block_size, bucket_templates.share_size, bucket_templates.required_shares, bucket_templates.repair_shares, bucket_templates.optimal_shares, bucket_templates.total_shares, bucket_templates.distinct_subnets, bucket_templates.distinct_regions, bucket_templates.updated_at, bucket_templates.created_at FROM bucket_templates WHERE bucket_templates.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_template_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_template = &BucketTemplate{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_template.ProjectId, &bucket_template.PathCipher, &bucket_template.DataCipher, &bucket_template.BlockSize, &bucket_template.ShareSize, &bucket_template.RequiredShares, &bucket_template.RepairShares, &bucket_template.OptimalShares, &bucket_template.TotalShares, &bucket_template.DistinctSubnets, &bucket_template.DistinctRegions, &bucket_template.UpdatedAt, &bucket_template.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_template, nil
+
+}
+
+func (obj *sqlite3Impl) Get_NodePing_By_Id(ctx context.Context,
+	node_ping_id NodePing_Id_Field) (
+	node_ping *NodePing, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_pings.id, node_pings.success_rate, node_pings.ping_count, node_pings.last_ping_at, node_pings.last_success_at, node_pings.created_at FROM node_pings WHERE node_pings.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_ping_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_ping = &NodePing{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node_ping.Id, &node_ping.SuccessRate, &node_ping.PingCount, &node_ping.LastPingAt, &node_ping.LastSuccessAt, &node_ping.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_ping, nil
+
+}
+
+func (obj *sqlite3Impl) Get_NodeStanding_By_Id(ctx context.Context,
+	node_standing_id NodeStanding_Id_Field) (
+	node_standing *NodeStanding, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_standings.id, node_standings.status, node_standings.reason, node_standings.since FROM node_standings WHERE node_standings.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_standing_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node_standing = &NodeStanding{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node_standing.Id, &node_standing.Status, &node_standing.Reason, &node_standing.Since)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_standing, nil
+
+}
+
+func (obj *sqlite3Impl) Get_ProjectInvoice_By_Id(ctx context.Context,
+	project_invoice_id ProjectInvoice_Id_Field) (
+	project_invoice *ProjectInvoice, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invoices.id, project_invoices.project_id, project_invoices.customer_id, project_invoices.period_start, project_invoices.period_end, project_invoices.amount, project_invoices.status, project_invoices.created_at FROM project_invoices WHERE project_invoices.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_invoice_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_invoice = &ProjectInvoice{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_invoice.Id, &project_invoice.ProjectId, &project_invoice.CustomerId, &project_invoice.PeriodStart, &project_invoice.PeriodEnd, &project_invoice.Amount, &project_invoice.Status, &project_invoice.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_invoice, nil
+
+}
+
+func (obj *sqlite3Impl) Get_ProjectInvoice_By_ProjectId_And_PeriodStart(ctx context.Context,
+	project_invoice_project_id ProjectInvoice_ProjectId_Field,
+	project_invoice_period_start ProjectInvoice_PeriodStart_Field) (
+	project_invoice *ProjectInvoice, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invoices.id, project_invoices.project_id, project_invoices.customer_id, project_invoices.period_start, project_invoices.period_end, project_invoices.amount, project_invoices.status, project_invoices.created_at FROM project_invoices WHERE project_invoices.project_id = ? AND project_invoices.period_start = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_invoice_project_id.value(), project_invoice_period_start.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_invoice = &ProjectInvoice{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_invoice.Id, &project_invoice.ProjectId, &project_invoice.CustomerId, &project_invoice.PeriodStart, &project_invoice.PeriodEnd, &project_invoice.Amount, &project_invoice.Status, &project_invoice.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_invoice, nil
+
+}
+
+func (obj *sqlite3Impl) Get_UserPayment_By_UserId(ctx context.Context,
+	user_payment_user_id UserPayment_UserId_Field) (
+	user_payment *UserPayment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT user_payments.user_id, user_payments.customer_id, user_payments.credit, user_payments.created_at FROM user_payments WHERE user_payments.user_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, user_payment_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user_payment = &UserPayment{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user_payment.UserId, &user_payment.CustomerId, &user_payment.Credit, &user_payment.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user_payment, nil
+
+}
+
+func (obj *sqlite3Impl) Get_SegmentHealth_By_Path(ctx context.Context,
+	segment_health_path SegmentHealth_Path_Field) (
+	segment_health *SegmentHealth, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT segment_healths.path, segment_healths.last_audited, segment_healths.last_repaired FROM segment_healths WHERE segment_healths.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, segment_health_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	segment_health = &SegmentHealth{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&segment_health.Path, &segment_health.LastAudited, &segment_health.LastRepaired)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return segment_health, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_AuditRecord_By_NodeId_And_AuditedAt_GreaterOrEqual_OrderBy_Desc_AuditedAt(ctx context.Context,
+	audit_record_node_id AuditRecord_NodeId_Field,
+	audit_record_audited_at_greater_or_equal AuditRecord_AuditedAt_Field,
+	limit int, offset int64) (
+	rows []*AuditRecord, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_records.node_id, audit_records.audited_at, audit_records.segment_hash, audit_records.stripe_index, audit_records.piece_num, audit_records.outcome, audit_records.error, audit_records.duration_ms FROM audit_records WHERE audit_records.node_id = ? AND audit_records.audited_at >= ? ORDER BY audit_records.audited_at DESC LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, audit_record_node_id.value(), audit_record_audited_at_greater_or_equal.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		audit_record := &AuditRecord{}
+		err = __rows.Scan(&audit_record.NodeId, &audit_record.AuditedAt, &audit_record.SegmentHash, &audit_record.StripeIndex, &audit_record.PieceNum, &audit_record.Outcome, &audit_record.Error, &audit_record.DurationMs)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, audit_record)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
+	update Irreparabledb_Update_Fields) (
+	irreparabledb *Irreparabledb, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE irreparabledbs SET "), __sets, __sqlbundle_Literal(" WHERE irreparabledbs.segmentpath = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Segmentdetail._set {
+		__values = append(__values, update.Segmentdetail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segmentdetail = ?"))
+	}
+
+	if update.PiecesLostCount._set {
+		__values = append(__values, update.PiecesLostCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("pieces_lost_count = ?"))
+	}
+
+	if update.SegDamagedUnixSec._set {
+		__values = append(__values, update.SegDamagedUnixSec.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("seg_damaged_unix_sec = ?"))
+	}
+
+	if update.RepairAttemptCount._set {
+		__values = append(__values, update.RepairAttemptCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("repair_attempt_count = ?"))
+	}
+
+	if update.LastError._set {
+		__values = append(__values, update.LastError.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_error = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, irreparabledb_segmentpath.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	irreparabledb = &Irreparabledb{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.project_id, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count, irreparabledbs.last_error FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&irreparabledb.Segmentpath, &irreparabledb.ProjectId, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount, &irreparabledb.LastError)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return irreparabledb, nil
+}
+
+func (obj *sqlite3Impl) Update_AccountingTimestamps_By_Name(ctx context.Context,
+	accounting_timestamps_name AccountingTimestamps_Name_Field,
+	update AccountingTimestamps_Update_Fields) (
+	accounting_timestamps *AccountingTimestamps, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE accounting_timestamps SET "), __sets, __sqlbundle_Literal(" WHERE accounting_timestamps.name = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Value._set {
+		__values = append(__values, update.Value.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("value = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, accounting_timestamps_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	accounting_timestamps = &AccountingTimestamps{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT accounting_timestamps.name, accounting_timestamps.value FROM accounting_timestamps WHERE accounting_timestamps.name = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&accounting_timestamps.Name, &accounting_timestamps.Value)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return accounting_timestamps, nil
+}
+
+func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	node *Node, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.TotalAuditCount._set {
+		__values = append(__values, update.TotalAuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
+	}
+
+	if update.AuditSuccessRatio._set {
+		__values = append(__values, update.AuditSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
+	}
+
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UptimeSuccessCount._set {
+		__values = append(__values, update.UptimeSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
+	}
+
+	if update.TotalUptimeCount._set {
+		__values = append(__values, update.TotalUptimeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_uptime_count = ?"))
+	}
+
+	if update.UptimeRatio._set {
+		__values = append(__values, update.UptimeRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_ratio = ?"))
+	}
+
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+}
+
+func (obj *sqlite3Impl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	update OverlayCacheNode_Update_Fields) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE overlay_cache_nodes SET "), __sets, __sqlbundle_Literal(" WHERE overlay_cache_nodes.node_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Address._set {
+		__values = append(__values, update.Address.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	}
+
+	if update.Protocol._set {
+		__values = append(__values, update.Protocol.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	}
+
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+
+	if update.OperatorEmail._set {
+		__values = append(__values, update.OperatorEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_email = ?"))
+	}
+
+	if update.OperatorWallet._set {
+		__values = append(__values, update.OperatorWallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet = ?"))
+	}
+
+	if update.OperatorRegion._set {
+		__values = append(__values, update.OperatorRegion.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_region = ?"))
+	}
+
+	if update.Version._set {
+		__values = append(__values, update.Version.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("version = ?"))
+	}
+
+	if update.FreeBandwidth._set {
+		__values = append(__values, update.FreeBandwidth.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_bandwidth = ?"))
+	}
+
+	if update.FreeDisk._set {
+		__values = append(__values, update.FreeDisk.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	}
+
+	if update.Latency90._set {
+		__values = append(__values, update.Latency90.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	}
+
+	if update.AuditSuccessRatio._set {
+		__values = append(__values, update.AuditSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
+	}
+
+	if update.AuditUptimeRatio._set {
+		__values = append(__values, update.AuditUptimeRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_uptime_ratio = ?"))
+	}
+
+	if update.AuditCount._set {
+		__values = append(__values, update.AuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_count = ?"))
+	}
+
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.UptimeCount._set {
+		__values = append(__values, update.UptimeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_count = ?"))
+	}
+
+	if update.UptimeSuccessCount._set {
+		__values = append(__values, update.UptimeSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
+	}
+
+	if update.AuditReputationScore._set {
+		__values = append(__values, update.AuditReputationScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_score = ?"))
+	}
+
+	if update.UptimeReputationScore._set {
+		__values = append(__values, update.UptimeReputationScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_score = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, overlay_cache_node_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.last_net, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_region, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audit_reputation_score, overlay_cache_nodes.uptime_reputation_score FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.LastNet, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorRegion, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditReputationScore, &overlay_cache_node.UptimeReputationScore)
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM bwagreements;")
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	return overlay_cache_node, nil
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (obj *sqlite3Impl) Update_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	update Injuredsegment_Update_Fields) (
+	injuredsegment *Injuredsegment, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE injuredsegments SET "), __sets, __sqlbundle_Literal(" WHERE injuredsegments.path = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Priority._set {
+		__values = append(__values, update.Priority.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("priority = ?"))
 	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM accounting_timestamps;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+
+	if update.Info._set {
+		__values = append(__values, update.Info.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("info = ?"))
 	}
 
-	__count, err = __res.RowsAffected()
+	if update.Attempts._set {
+		__values = append(__values, update.Attempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("attempts = ?"))
+	}
+
+	if update.LeasedUntil._set {
+		__values = append(__values, update.LeasedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("leased_until = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, injuredsegment_path.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	injuredsegment = &Injuredsegment{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until FROM injuredsegments WHERE injuredsegments.path = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM accounting_rollups;")
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	return injuredsegment, nil
+}
 
-	__count, err = __res.RowsAffected()
+func (obj *sqlite3Impl) Update_User_By_Id(ctx context.Context,
+	user_id User_Id_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.FirstName._set {
+		__values = append(__values, update.FirstName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("first_name = ?"))
+	}
+
+	if update.LastName._set {
+		__values = append(__values, update.LastName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_name = ?"))
+	}
+
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
+	}
+
+	if update.PasswordHash._set {
+		__values = append(__values, update.PasswordHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("password_hash = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, user_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user = &User{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE users.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM accounting_raws;")
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
+	return user, nil
+}
 
-	__count, err = __res.RowsAffected()
+func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
+	project_id Project_Id_Field,
+	update Project_Update_Fields) (
+	project *Project, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Description._set {
+		__values = append(__values, update.Description.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("description = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project = &Project{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	count += __count
 
-	return count, nil
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project, nil
 }
 
-func (obj *sqlite3Impl) Create_Bwagreement(ctx context.Context,
-	bwagreement_serialnum Bwagreement_Serialnum_Field,
-	bwagreement_storage_node_id Bwagreement_StorageNodeId_Field,
-	bwagreement_uplink_id Bwagreement_UplinkId_Field,
-	bwagreement_action Bwagreement_Action_Field,
-	bwagreement_total Bwagreement_Total_Field,
-	bwagreement_expires_at Bwagreement_ExpiresAt_Field) (
-	bwagreement *Bwagreement, err error) {
+func (obj *sqlite3Impl) Update_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field,
+	update ApiKey_Update_Fields) (
+	api_key *ApiKey, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	__now := obj.db.Hooks.Now().UTC()
-	__serialnum_val := bwagreement_serialnum.value()
-	__storage_node_id_val := bwagreement_storage_node_id.value()
-	__uplink_id_val := bwagreement_uplink_id.value()
-	__action_val := bwagreement_action.value()
-	__total_val := bwagreement_total.value()
-	__created_at_val := __now
-	__expires_at_val := bwagreement_expires_at.value()
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE api_keys SET "), __sets, __sqlbundle_Literal(" WHERE api_keys.id = ?")}}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bwagreements ( serialnum, storage_node_id, uplink_id, action, total, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, api_key_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __serialnum_val, __storage_node_id_val, __uplink_id_val, __action_val, __total_val, __created_at_val, __expires_at_val)
+	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.Exec(__stmt, __serialnum_val, __storage_node_id_val, __uplink_id_val, __action_val, __total_val, __created_at_val, __expires_at_val)
+	api_key = &ApiKey{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	__pk, err := __res.LastInsertId()
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastBwagreement(ctx, __pk)
-
+	return api_key, nil
 }
 
-func (obj *sqlite3Impl) Create_Irreparabledb(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
-	irreparabledb_segmentdetail Irreparabledb_Segmentdetail_Field,
-	irreparabledb_pieces_lost_count Irreparabledb_PiecesLostCount_Field,
-	irreparabledb_seg_damaged_unix_sec Irreparabledb_SegDamagedUnixSec_Field,
-	irreparabledb_repair_attempt_count Irreparabledb_RepairAttemptCount_Field) (
-	irreparabledb *Irreparabledb, err error) {
-	__segmentpath_val := irreparabledb_segmentpath.value()
-	__segmentdetail_val := irreparabledb_segmentdetail.value()
-	__pieces_lost_count_val := irreparabledb_pieces_lost_count.value()
-	__seg_damaged_unix_sec_val := irreparabledb_seg_damaged_unix_sec.value()
-	__repair_attempt_count_val := irreparabledb_repair_attempt_count.value()
+func (obj *sqlite3Impl) Update_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	update ProjectDeletion_Update_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO irreparabledbs ( segmentpath, segmentdetail, pieces_lost_count, seg_damaged_unix_sec, repair_attempt_count ) VALUES ( ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_deletions SET "), __sets, __sqlbundle_Literal(" WHERE project_deletions.project_id = ?")}}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __segmentpath_val, __segmentdetail_val, __pieces_lost_count_val, __seg_damaged_unix_sec_val, __repair_attempt_count_val)
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
 
-	__res, err := obj.driver.Exec(__stmt, __segmentpath_val, __segmentdetail_val, __pieces_lost_count_val, __seg_damaged_unix_sec_val, __repair_attempt_count_val)
-	if err != nil {
-		return nil, obj.makeErr(err)
+	if update.PurgedAt._set {
+		__values = append(__values, update.PurgedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("purged_at = ?"))
 	}
-	__pk, err := __res.LastInsertId()
-	if err != nil {
-		return nil, obj.makeErr(err)
+
+	if update.SegmentsDeleted._set {
+		__values = append(__values, update.SegmentsDeleted.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segments_deleted = ?"))
 	}
-	return obj.getLastIrreparabledb(ctx, __pk)
 
-}
+	if update.PiecesDeleted._set {
+		__values = append(__values, update.PiecesDeleted.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("pieces_deleted = ?"))
+	}
 
-func (obj *sqlite3Impl) Create_AccountingTimestamps(ctx context.Context,
-	accounting_timestamps_name AccountingTimestamps_Name_Field,
-	accounting_timestamps_value AccountingTimestamps_Value_Field) (
-	accounting_timestamps *AccountingTimestamps, err error) {
-	__name_val := accounting_timestamps_name.value()
-	__value_val := accounting_timestamps_value.value()
+	if update.ApiKeysDeleted._set {
+		__values = append(__values, update.ApiKeysDeleted.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_keys_deleted = ?"))
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_timestamps ( name, value ) VALUES ( ?, ? )")
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_deletion_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __name_val, __value_val)
+	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.Exec(__stmt, __name_val, __value_val)
+	project_deletion = &ProjectDeletion{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	__pk, err := __res.LastInsertId()
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.requested_by, project_deletions.purge_after, project_deletions.purged_at, project_deletions.segments_deleted, project_deletions.pieces_deleted, project_deletions.api_keys_deleted, project_deletions.created_at FROM project_deletions WHERE project_deletions.project_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project_deletion.ProjectId, &project_deletion.RequestedBy, &project_deletion.PurgeAfter, &project_deletion.PurgedAt, &project_deletion.SegmentsDeleted, &project_deletion.PiecesDeleted, &project_deletion.ApiKeysDeleted, &project_deletion.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastAccountingTimestamps(ctx, __pk)
-
+	return project_deletion, nil
 }
 
-func (obj *sqlite3Impl) Create_AccountingRollup(ctx context.Context,
-	accounting_rollup_node_id AccountingRollup_NodeId_Field,
-	accounting_rollup_start_time AccountingRollup_StartTime_Field,
-	accounting_rollup_put_total AccountingRollup_PutTotal_Field,
-	accounting_rollup_get_total AccountingRollup_GetTotal_Field,
-	accounting_rollup_get_audit_total AccountingRollup_GetAuditTotal_Field,
-	accounting_rollup_get_repair_total AccountingRollup_GetRepairTotal_Field,
-	accounting_rollup_put_repair_total AccountingRollup_PutRepairTotal_Field,
-	accounting_rollup_at_rest_total AccountingRollup_AtRestTotal_Field) (
-	accounting_rollup *AccountingRollup, err error) {
-	__node_id_val := accounting_rollup_node_id.value()
-	__start_time_val := accounting_rollup_start_time.value()
-	__put_total_val := accounting_rollup_put_total.value()
-	__get_total_val := accounting_rollup_get_total.value()
-	__get_audit_total_val := accounting_rollup_get_audit_total.value()
-	__get_repair_total_val := accounting_rollup_get_repair_total.value()
-	__put_repair_total_val := accounting_rollup_put_repair_total.value()
-	__at_rest_total_val := accounting_rollup_at_rest_total.value()
+func (obj *sqlite3Impl) Update_Session_By_Id(ctx context.Context,
+	session_id Session_Id_Field,
+	update Session_Update_Fields) (
+	session *Session, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_rollups ( node_id, start_time, put_total, get_total, get_audit_total, get_repair_total, put_repair_total, at_rest_total ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE sessions SET "), __sets, __sqlbundle_Literal(" WHERE sessions.id = ?")}}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val)
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
 
-	__res, err := obj.driver.Exec(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	__pk, err := __res.LastInsertId()
-	if err != nil {
-		return nil, obj.makeErr(err)
+	if update.RefreshTokenHash._set {
+		__values = append(__values, update.RefreshTokenHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("refresh_token_hash = ?"))
 	}
-	return obj.getLastAccountingRollup(ctx, __pk)
 
-}
+	if update.LastUsedAt._set {
+		__values = append(__values, update.LastUsedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_used_at = ?"))
+	}
 
-func (obj *sqlite3Impl) Create_AccountingRaw(ctx context.Context,
-	accounting_raw_node_id AccountingRaw_NodeId_Field,
-	accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
-	accounting_raw_data_total AccountingRaw_DataTotal_Field,
-	accounting_raw_data_type AccountingRaw_DataType_Field) (
-	accounting_raw *AccountingRaw, err error) {
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
 
-	__now := obj.db.Hooks.Now().UTC()
-	__node_id_val := accounting_raw_node_id.value()
-	__interval_end_time_val := accounting_raw_interval_end_time.value()
-	__data_total_val := accounting_raw_data_total.value()
-	__data_type_val := accounting_raw_data_type.value()
-	__created_at_val := __now
+	__args = append(__args, session_id.value())
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_raws ( node_id, interval_end_time, data_total, data_type, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val)
+	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.Exec(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val)
+	session = &Session{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	__pk, err := __res.LastInsertId()
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT sessions.id, sessions.user_id, sessions.device, sessions.address, sessions.refresh_token_hash, sessions.expires_at, sessions.last_used_at, sessions.created_at FROM sessions WHERE sessions.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&session.Id, &session.UserId, &session.Device, &session.Address, &session.RefreshTokenHash, &session.ExpiresAt, &session.LastUsedAt, &session.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastAccountingRaw(ctx, __pk)
-
+	return session, nil
 }
 
-func (obj *sqlite3Impl) Create_Node(ctx context.Context,
-	node_id Node_Id_Field,
-	node_audit_success_count Node_AuditSuccessCount_Field,
-	node_total_audit_count Node_TotalAuditCount_Field,
-	node_audit_success_ratio Node_AuditSuccessRatio_Field,
-	node_uptime_success_count Node_UptimeSuccessCount_Field,
-	node_total_uptime_count Node_TotalUptimeCount_Field,
-	node_uptime_ratio Node_UptimeRatio_Field) (
-	node *Node, err error) {
+func (obj *sqlite3Impl) Update_BucketTemplate_By_ProjectId(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field,
+	update BucketTemplate_Update_Fields) (
+	bucket_template *BucketTemplate, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := node_id.value()
-	__audit_success_count_val := node_audit_success_count.value()
-	__total_audit_count_val := node_total_audit_count.value()
-	__audit_success_ratio_val := node_audit_success_ratio.value()
-	__uptime_success_count_val := node_uptime_success_count.value()
-	__total_uptime_count_val := node_total_uptime_count.value()
-	__uptime_ratio_val := node_uptime_ratio.value()
-	__created_at_val := __now
-	__updated_at_val := __now
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_templates SET "), __sets, __sqlbundle_Literal(" WHERE bucket_templates.project_id = ?")}}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val)
+	if update.PathCipher._set {
+		__values = append(__values, update.PathCipher.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("path_cipher = ?"))
+	}
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val)
-	if err != nil {
-		return nil, obj.makeErr(err)
+	if update.DataCipher._set {
+		__values = append(__values, update.DataCipher.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("data_cipher = ?"))
 	}
-	__pk, err := __res.LastInsertId()
-	if err != nil {
-		return nil, obj.makeErr(err)
+
+	if update.BlockSize._set {
+		__values = append(__values, update.BlockSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("block_size = ?"))
 	}
-	return obj.getLastNode(ctx, __pk)
 
-}
+	if update.ShareSize._set {
+		__values = append(__values, update.ShareSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("share_size = ?"))
+	}
 
-func (obj *sqlite3Impl) Create_OverlayCacheNode(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
-	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
-	overlay_cache_node_address OverlayCacheNode_Address_Field,
-	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
-	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
-	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
-	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
-	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
-	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
-	overlay_cache_node_audit_success_ratio OverlayCacheNode_AuditSuccessRatio_Field,
-	overlay_cache_node_audit_uptime_ratio OverlayCacheNode_AuditUptimeRatio_Field,
-	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
-	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
-	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
-	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
-	overlay_cache_node *OverlayCacheNode, err error) {
-	__node_id_val := overlay_cache_node_node_id.value()
-	__node_type_val := overlay_cache_node_node_type.value()
-	__address_val := overlay_cache_node_address.value()
-	__protocol_val := overlay_cache_node_protocol.value()
-	__operator_email_val := overlay_cache_node_operator_email.value()
-	__operator_wallet_val := overlay_cache_node_operator_wallet.value()
-	__free_bandwidth_val := overlay_cache_node_free_bandwidth.value()
-	__free_disk_val := overlay_cache_node_free_disk.value()
-	__latency_90_val := overlay_cache_node_latency_90.value()
-	__audit_success_ratio_val := overlay_cache_node_audit_success_ratio.value()
-	__audit_uptime_ratio_val := overlay_cache_node_audit_uptime_ratio.value()
-	__audit_count_val := overlay_cache_node_audit_count.value()
-	__audit_success_count_val := overlay_cache_node_audit_success_count.value()
-	__uptime_count_val := overlay_cache_node_uptime_count.value()
-	__uptime_success_count_val := overlay_cache_node_uptime_success_count.value()
+	if update.RequiredShares._set {
+		__values = append(__values, update.RequiredShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("required_shares = ?"))
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, operator_email, operator_wallet, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	if update.RepairShares._set {
+		__values = append(__values, update.RepairShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("repair_shares = ?"))
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val)
+	if update.OptimalShares._set {
+		__values = append(__values, update.OptimalShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("optimal_shares = ?"))
+	}
 
-	__res, err := obj.driver.Exec(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val)
-	if err != nil {
-		return nil, obj.makeErr(err)
+	if update.TotalShares._set {
+		__values = append(__values, update.TotalShares.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_shares = ?"))
 	}
-	__pk, err := __res.LastInsertId()
-	if err != nil {
-		return nil, obj.makeErr(err)
+
+	if update.DistinctSubnets._set {
+		__values = append(__values, update.DistinctSubnets.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("distinct_subnets = ?"))
 	}
-	return obj.getLastOverlayCacheNode(ctx, __pk)
 
-}
+	if update.DistinctRegions._set {
+		__values = append(__values, update.DistinctRegions.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("distinct_regions = ?"))
+	}
 
-func (obj *sqlite3Impl) Create_Injuredsegment(ctx context.Context,
-	injuredsegment_info Injuredsegment_Info_Field) (
-	injuredsegment *Injuredsegment, err error) {
-	__info_val := injuredsegment_info.value()
+	if update.UpdatedAt._set {
+		__values = append(__values, update.UpdatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( info ) VALUES ( ? )")
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bucket_template_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __info_val)
+	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.Exec(__stmt, __info_val)
+	bucket_template = &BucketTemplate{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	__pk, err := __res.LastInsertId()
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT bucket_templates.project_id, bucket_templates.path_cipher, bucket_templates.data_cipher, bucket_templates.block_size, bucket_templates.share_size, bucket_templates.required_shares, bucket_templates.repair_shares, bucket_templates.optimal_shares, bucket_templates.total_shares, bucket_templates.distinct_subnets, bucket_templates.distinct_regions, bucket_templates.updated_at, bucket_templates.created_at FROM bucket_templates WHERE bucket_templates.project_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&bucket_template.ProjectId, &bucket_template.PathCipher, &bucket_template.DataCipher, &bucket_template.BlockSize, &bucket_template.ShareSize, &bucket_template.RequiredShares, &bucket_template.RepairShares, &bucket_template.OptimalShares, &bucket_template.TotalShares, &bucket_template.DistinctSubnets, &bucket_template.DistinctRegions, &bucket_template.UpdatedAt, &bucket_template.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastInjuredsegment(ctx, __pk)
-
+	return bucket_template, nil
 }
 
-func (obj *sqlite3Impl) Create_User(ctx context.Context,
-	user_id User_Id_Field,
-	user_first_name User_FirstName_Field,
-	user_last_name User_LastName_Field,
-	user_password_hash User_PasswordHash_Field,
-	optional User_Create_Fields) (
-	user *User, err error) {
-
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := user_id.value()
-	__first_name_val := user_first_name.value()
-	__last_name_val := user_last_name.value()
-	__email_val := optional.Email.value()
-	__password_hash_val := user_password_hash.value()
-	__created_at_val := __now
+func (obj *sqlite3Impl) Update_NodePing_By_Id(ctx context.Context,
+	node_ping_id NodePing_Id_Field,
+	update NodePing_Update_Fields) (
+	node_ping *NodePing, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, first_name, last_name, email, password_hash, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_pings SET "), __sets, __sqlbundle_Literal(" WHERE node_pings.id = ?")}}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __first_name_val, __last_name_val, __email_val, __password_hash_val, __created_at_val)
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __first_name_val, __last_name_val, __email_val, __password_hash_val, __created_at_val)
-	if err != nil {
-		return nil, obj.makeErr(err)
+	if update.SuccessRate._set {
+		__values = append(__values, update.SuccessRate.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("success_rate = ?"))
 	}
-	__pk, err := __res.LastInsertId()
-	if err != nil {
-		return nil, obj.makeErr(err)
+
+	if update.PingCount._set {
+		__values = append(__values, update.PingCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ping_count = ?"))
 	}
-	return obj.getLastUser(ctx, __pk)
 
-}
+	if update.LastPingAt._set {
+		__values = append(__values, update.LastPingAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ping_at = ?"))
+	}
 
-func (obj *sqlite3Impl) Create_Project(ctx context.Context,
-	project_id Project_Id_Field,
-	project_name Project_Name_Field,
-	project_description Project_Description_Field) (
-	project *Project, err error) {
+	if update.LastSuccessAt._set {
+		__values = append(__values, update.LastSuccessAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_success_at = ?"))
+	}
 
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := project_id.value()
-	__name_val := project_name.value()
-	__description_val := project_description.value()
-	__created_at_val := __now
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, created_at ) VALUES ( ?, ?, ?, ? )")
+	__args = append(__args, node_ping_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+	node_ping = &NodePing{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	__pk, err := __res.LastInsertId()
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT node_pings.id, node_pings.success_rate, node_pings.ping_count, node_pings.last_ping_at, node_pings.last_success_at, node_pings.created_at FROM node_pings WHERE node_pings.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node_ping.Id, &node_ping.SuccessRate, &node_ping.PingCount, &node_ping.LastPingAt, &node_ping.LastSuccessAt, &node_ping.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastProject(ctx, __pk)
-
+	return node_ping, nil
 }
 
-func (obj *sqlite3Impl) Create_ProjectMember(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
-	project_member *ProjectMember, err error) {
-
-	__now := obj.db.Hooks.Now().UTC()
-	__member_id_val := project_member_member_id.value()
-	__project_id_val := project_member_project_id.value()
-	__created_at_val := __now
+func (obj *sqlite3Impl) Update_NodeStanding_By_Id(ctx context.Context,
+	node_standing_id NodeStanding_Id_Field,
+	update NodeStanding_Update_Fields) (
+	node_standing *NodeStanding, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_standings SET "), __sets, __sqlbundle_Literal(" WHERE node_standings.id = ?")}}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
 
-	__res, err := obj.driver.Exec(__stmt, __member_id_val, __project_id_val, __created_at_val)
-	if err != nil {
-		return nil, obj.makeErr(err)
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
-	__pk, err := __res.LastInsertId()
-	if err != nil {
-		return nil, obj.makeErr(err)
+
+	if update.Reason._set {
+		__values = append(__values, update.Reason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("reason = ?"))
 	}
-	return obj.getLastProjectMember(ctx, __pk)
 
-}
+	if update.Since._set {
+		__values = append(__values, update.Since.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("since = ?"))
+	}
 
-func (obj *sqlite3Impl) Create_ApiKey(ctx context.Context,
-	api_key_id ApiKey_Id_Field,
-	api_key_project_id ApiKey_ProjectId_Field,
-	api_key_key ApiKey_Key_Field,
-	api_key_name ApiKey_Name_Field) (
-	api_key *ApiKey, err error) {
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
 
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := api_key_id.value()
-	__project_id_val := api_key_project_id.value()
-	__key_val := api_key_key.value()
-	__name_val := api_key_name.value()
-	__created_at_val := __now
+	__args = append(__args, node_standing_id.value())
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, name, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+	node_standing = &NodeStanding{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	__pk, err := __res.LastInsertId()
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT node_standings.id, node_standings.status, node_standings.reason, node_standings.since FROM node_standings WHERE node_standings.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node_standing.Id, &node_standing.Status, &node_standing.Reason, &node_standing.Since)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastApiKey(ctx, __pk)
-
+	return node_standing, nil
 }
 
-func (obj *sqlite3Impl) Limited_Bwagreement(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Bwagreement, err error) {
+func (obj *sqlite3Impl) Update_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field,
+	update BucketStat_Update_Fields) (
+	bucket_stat *BucketStat, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_stats SET "), __sets, __sqlbundle_Literal(" WHERE bucket_stats.project_id = ? AND bucket_stats.bucket_name = ?")}}
 
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
-	__values = append(__values)
+	var __args []interface{}
 
-	__values = append(__values, limit, offset)
+	if update.ObjectCount._set {
+		__values = append(__values, update.ObjectCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("object_count = ?"))
+	}
+
+	if update.TotalBytes._set {
+		__values = append(__values, update.TotalBytes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_bytes = ?"))
+	}
+
+	if update.UpdatedAt._set {
+		__values = append(__values, update.UpdatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bucket_stat_project_id.value(), bucket_stat_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	bucket_stat = &BucketStat{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT bucket_stats.project_id, bucket_stats.bucket_name, bucket_stats.object_count, bucket_stats.total_bytes, bucket_stats.updated_at FROM bucket_stats WHERE bucket_stats.project_id = ? AND bucket_stats.bucket_name = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&bucket_stat.ProjectId, &bucket_stat.BucketName, &bucket_stat.ObjectCount, &bucket_stat.TotalBytes, &bucket_stat.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
-	if err := __rows.Err(); err != nil {
+	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return rows, nil
-
+	return bucket_stat, nil
 }
 
-func (obj *sqlite3Impl) All_Bwagreement(ctx context.Context) (
-	rows []*Bwagreement, err error) {
+func (obj *sqlite3Impl) Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	update BucketLifecycle_Update_Fields) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_lifecycles SET "), __sets, __sqlbundle_Literal(" WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")}}
 
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
-	__values = append(__values)
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	var __args []interface{}
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
+	if update.ExpireAfterDays._set {
+		__values = append(__values, update.ExpireAfterDays.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("expire_after_days = ?"))
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
+	if update.VersionsPrefix._set {
+		__values = append(__values, update.VersionsPrefix.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versions_prefix = ?"))
 	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+
+	if update.VersionsExpireAfterDays._set {
+		__values = append(__values, update.VersionsExpireAfterDays.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versions_expire_after_days = ?"))
 	}
-	return rows, nil
 
-}
+	if update.UpdatedAt._set {
+		__values = append(__values, update.UpdatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	}
 
-func (obj *sqlite3Impl) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
-	bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
-	rows []*Bwagreement, err error) {
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements WHERE bwagreements.created_at > ?")
+	__args = append(__args, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
 
-	var __values []interface{}
-	__values = append(__values, bwagreement_created_at_greater.value())
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	bucket_lifecycle = &BucketLifecycle{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		bwagreement := &Bwagreement{}
-		err = __rows.Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, bwagreement)
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.expire_after_days, bucket_lifecycles.versions_prefix, bucket_lifecycles.versions_expire_after_days, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.ExpireAfterDays, &bucket_lifecycle.VersionsPrefix, &bucket_lifecycle.VersionsExpireAfterDays, &bucket_lifecycle.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
-	if err := __rows.Err(); err != nil {
+	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return rows, nil
-
+	return bucket_lifecycle, nil
 }
 
-func (obj *sqlite3Impl) Get_Irreparabledb_By_Segmentpath(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
-	irreparabledb *Irreparabledb, err error) {
+func (obj *sqlite3Impl) Update_ProjectInvoice_By_Id(ctx context.Context,
+	project_invoice_id ProjectInvoice_Id_Field,
+	update ProjectInvoice_Update_Fields) (
+	project_invoice *ProjectInvoice, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_invoices SET "), __sets, __sqlbundle_Literal(" WHERE project_invoices.id = ?")}}
 
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
-	__values = append(__values, irreparabledb_segmentpath.value())
+	var __args []interface{}
+
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_invoice_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	irreparabledb = &Irreparabledb{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&irreparabledb.Segmentpath, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount)
+	project_invoice = &ProjectInvoice{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return irreparabledb, nil
 
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT project_invoices.id, project_invoices.project_id, project_invoices.customer_id, project_invoices.period_start, project_invoices.period_end, project_invoices.amount, project_invoices.status, project_invoices.created_at FROM project_invoices WHERE project_invoices.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project_invoice.Id, &project_invoice.ProjectId, &project_invoice.CustomerId, &project_invoice.PeriodStart, &project_invoice.PeriodEnd, &project_invoice.Amount, &project_invoice.Status, &project_invoice.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_invoice, nil
 }
 
-func (obj *sqlite3Impl) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
-	accounting_timestamps_name AccountingTimestamps_Name_Field) (
-	row *Value_Row, err error) {
+func (obj *sqlite3Impl) Update_UserPayment_By_UserId(ctx context.Context,
+	user_payment_user_id UserPayment_UserId_Field,
+	update UserPayment_Update_Fields) (
+	user_payment *UserPayment, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_timestamps.value FROM accounting_timestamps WHERE accounting_timestamps.name = ?")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE user_payments SET "), __sets, __sqlbundle_Literal(" WHERE user_payments.user_id = ?")}}
 
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
-	__values = append(__values, accounting_timestamps_name.value())
+	var __args []interface{}
+
+	if update.Credit._set {
+		__values = append(__values, update.Credit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("credit = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, user_payment_user_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	row = &Value_Row{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.Value)
+	user_payment = &UserPayment{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT user_payments.user_id, user_payments.customer_id, user_payments.credit, user_payments.created_at FROM user_payments WHERE user_payments.user_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&user_payment.UserId, &user_payment.CustomerId, &user_payment.Credit, &user_payment.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return row, nil
-
+	return user_payment, nil
 }
 
-func (obj *sqlite3Impl) Get_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
-	accounting_rollup *AccountingRollup, err error) {
+func (obj *sqlite3Impl) Update_SegmentHealth_By_Path(ctx context.Context,
+	segment_health_path SegmentHealth_Path_Field,
+	update SegmentHealth_Update_Fields) (
+	segment_health *SegmentHealth, err error) {
+	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.id = ?")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE segment_healths SET "), __sets, __sqlbundle_Literal(" WHERE segment_healths.path = ?")}}
 
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
-	__values = append(__values, accounting_rollup_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	var __args []interface{}
 
-	accounting_rollup = &AccountingRollup{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
-	if err != nil {
-		return nil, obj.makeErr(err)
+	if update.LastAudited._set {
+		__values = append(__values, update.LastAudited.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_audited = ?"))
 	}
-	return accounting_rollup, nil
 
-}
+	if update.LastRepaired._set {
+		__values = append(__values, update.LastRepaired.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_repaired = ?"))
+	}
 
-func (obj *sqlite3Impl) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Context,
-	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
-	rows []*AccountingRollup, err error) {
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE accounting_rollups.start_time >= ?")
+	__args = append(__args, segment_health_path.value())
 
-	var __values []interface{}
-	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value())
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	segment_health = &SegmentHealth{}
+	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		accounting_rollup := &AccountingRollup{}
-		err = __rows.Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, accounting_rollup)
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT segment_healths.path, segment_healths.last_audited, segment_healths.last_repaired FROM segment_healths WHERE segment_healths.path = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&segment_health.Path, &segment_health.LastAudited, &segment_health.LastRepaired)
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
-	if err := __rows.Err(); err != nil {
+	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return rows, nil
-
+	return segment_health, nil
 }
 
-func (obj *sqlite3Impl) Get_AccountingRaw_By_Id(ctx context.Context,
-	accounting_raw_id AccountingRaw_Id_Field) (
-	accounting_raw *AccountingRaw, err error) {
+func (obj *sqlite3Impl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE accounting_raws.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_raw_id.value())
+	__values = append(__values, irreparabledb_segmentpath.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	accounting_raw = &AccountingRaw{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	return accounting_raw, nil
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) All_AccountingRaw(ctx context.Context) (
-	rows []*AccountingRaw, err error) {
+func (obj *sqlite3Impl) Delete_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_rollups WHERE accounting_rollups.id = ?")
 
 	var __values []interface{}
-	__values = append(__values)
+	__values = append(__values, accounting_rollup_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		accounting_raw := &AccountingRaw{}
-		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, accounting_raw)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return rows, nil
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx context.Context,
-	accounting_raw_interval_end_time_greater_or_equal AccountingRaw_IntervalEndTime_Field) (
-	rows []*AccountingRaw, err error) {
+func (obj *sqlite3Impl) Delete_AccountingRaw_By_Id(ctx context.Context,
+	accounting_raw_id AccountingRaw_Id_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE accounting_raws.interval_end_time >= ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_raws WHERE accounting_raws.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_raw_interval_end_time_greater_or_equal.value())
+	__values = append(__values, accounting_raw_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		accounting_raw := &AccountingRaw{}
-		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, accounting_raw)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return rows, nil
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
+func (obj *sqlite3Impl) Delete_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
-	node *Node, err error) {
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -5046,981 +13569,951 @@ func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	return node, nil
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) All_Node_Id(ctx context.Context) (
-	rows []*Id_Row, err error) {
+func (obj *sqlite3Impl) Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id FROM nodes")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values)
+	__values = append(__values, overlay_cache_node_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		row := &Id_Row{}
-		err = __rows.Scan(&row.Id)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, row)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return rows, nil
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) All_Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_By_AccountingRollup_StartTime_GreaterOrEqual_And_AccountingRollup_StartTime_Less_OrderBy_Asc_Node_Id(ctx context.Context,
-	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field,
-	accounting_rollup_start_time_less AccountingRollup_StartTime_Field) (
-	rows []*Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row, err error) {
+func (obj *sqlite3Impl) Delete_Injuredsegment_By_Id(ctx context.Context,
+	injuredsegment_id Injuredsegment_Id_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.created_at, nodes.audit_success_ratio, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM nodes  JOIN accounting_rollups ON nodes.id = accounting_rollups.node_id WHERE accounting_rollups.start_time >= ? AND accounting_rollups.start_time < ? ORDER BY nodes.id")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_rollup_start_time_greater_or_equal.value(), accounting_rollup_start_time_less.value())
+	__values = append(__values, injuredsegment_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		row := &Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row{}
-		err = __rows.Scan(&row.Node_Id, &row.Node_CreatedAt, &row.Node_AuditSuccessRatio, &row.AccountingRollup_StartTime, &row.AccountingRollup_PutTotal, &row.AccountingRollup_GetTotal, &row.AccountingRollup_GetAuditTotal, &row.AccountingRollup_GetRepairTotal, &row.AccountingRollup_PutRepairTotal, &row.AccountingRollup_AtRestTotal)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, row)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return rows, nil
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
-	overlay_cache_node *OverlayCacheNode, err error) {
+func (obj *sqlite3Impl) Delete_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.path = ?")
 
 	var __values []interface{}
-	__values = append(__values, overlay_cache_node_node_id.value())
+	__values = append(__values, injuredsegment_path.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return overlay_cache_node, nil
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
-	row *OperatorWallet_Row, err error) {
+func (obj *sqlite3Impl) Delete_AuditRecord_By_AuditedAt_Less(ctx context.Context,
+	audit_record_audited_at_less AuditRecord_AuditedAt_Field) (
+	count int64, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.operator_wallet FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM audit_records WHERE audit_records.audited_at < ?")
 
 	var __values []interface{}
-	__values = append(__values, overlay_cache_node_node_id.value())
+	__values = append(__values, audit_record_audited_at_less.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	row = &OperatorWallet_Row{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&row.OperatorWallet)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-	return row, nil
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
 
 }
 
-func (obj *sqlite3Impl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx context.Context,
-	overlay_cache_node_node_id_greater_or_equal OverlayCacheNode_NodeId_Field,
-	limit int, offset int64) (
-	rows []*OverlayCacheNode, err error) {
+func (obj *sqlite3Impl) Delete_User_By_Id(ctx context.Context,
+	user_id User_Id_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, overlay_cache_node_node_id_greater_or_equal.value())
-
-	__values = append(__values, limit, offset)
+	__values = append(__values, user_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
-
-	for __rows.Next() {
-		overlay_cache_node := &OverlayCacheNode{}
-		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, overlay_cache_node)
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return rows, nil
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) First_Injuredsegment(ctx context.Context) (
-	injuredsegment *Injuredsegment, err error) {
+func (obj *sqlite3Impl) Delete_Project_By_Id(ctx context.Context,
+	project_id Project_Id_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT 1 OFFSET 0")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
-	__values = append(__values)
+	__values = append(__values, project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
-
-	if !__rows.Next() {
-		if err := __rows.Err(); err != nil {
-			return nil, obj.makeErr(err)
-		}
-		return nil, nil
+		return false, obj.makeErr(err)
 	}
 
-	injuredsegment = &Injuredsegment{}
-	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
+	__count, err := __res.RowsAffected()
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
 
-	return injuredsegment, nil
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) Limited_Injuredsegment(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Injuredsegment, err error) {
+func (obj *sqlite3Impl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
 
 	var __values []interface{}
-	__values = append(__values)
-
-	__values = append(__values, limit, offset)
+	__values = append(__values, project_member_member_id.value(), project_member_project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		injuredsegment := &Injuredsegment{}
-		err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, injuredsegment)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return rows, nil
 
-}
+	return __count > 0, nil
 
-func (obj *sqlite3Impl) Get_User_By_Email(ctx context.Context,
-	user_email User_Email_Field) (
-	user *User, err error) {
+}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "users.email", Equal: true, Right: "?", Null: true}
+func (obj *sqlite3Impl) Delete_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE "), __cond_0}}
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
-	__values = append(__values)
-
-	if !user_email.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, user_email.value())
-	}
+	__values = append(__values, api_key_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	return user, nil
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
-	user_id User_Id_Field) (
-	user *User, err error) {
+func (obj *sqlite3Impl) Delete_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_deletions WHERE project_deletions.project_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, user_id.value())
+	__values = append(__values, project_deletion_project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	return user, nil
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) All_Project(ctx context.Context) (
-	rows []*Project, err error) {
+func (obj *sqlite3Impl) Delete_Session_By_Id(ctx context.Context,
+	session_id Session_Id_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM sessions WHERE sessions.id = ?")
 
 	var __values []interface{}
-	__values = append(__values)
+	__values = append(__values, session_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, project)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return rows, nil
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
-	project_id Project_Id_Field) (
-	project *Project, err error) {
+func (obj *sqlite3Impl) Delete_Session_By_UserId(ctx context.Context,
+	session_user_id Session_UserId_Field) (
+	count int64, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM sessions WHERE sessions.user_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_id.value())
+	__values = append(__values, session_user_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-	return project, nil
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
 
 }
 
-func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*Project, err error) {
+func (obj *sqlite3Impl) Delete_BucketTemplate_By_ProjectId(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_templates WHERE bucket_templates.project_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value())
+	__values = append(__values, bucket_template_project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, project)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return rows, nil
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*ProjectMember, err error) {
+func (obj *sqlite3Impl) Delete_NodePing_By_Id(ctx context.Context,
+	node_ping_id NodePing_Id_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_pings WHERE node_pings.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value())
+	__values = append(__values, node_ping_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
-	defer __rows.Close()
 
-	for __rows.Next() {
-		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, project_member)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return rows, nil
-
-}
 
-func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
-	project_member_project_id ProjectMember_ProjectId_Field,
-	limit int, offset int64) (
-	rows []*ProjectMember, err error) {
+	return __count > 0, nil
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+}
 
-	var __values []interface{}
-	__values = append(__values, project_member_project_id.value())
+func (obj *sqlite3Impl) getLastBwagreement(ctx context.Context,
+	pk int64) (
+	bwagreement *Bwagreement, err error) {
 
-	__values = append(__values, limit, offset)
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, pk)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	bwagreement = &Bwagreement{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	defer __rows.Close()
+	return bwagreement, nil
 
-	for __rows.Next() {
-		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, project_member)
-	}
-	if err := __rows.Err(); err != nil {
+}
+
+func (obj *sqlite3Impl) getLastIrreparabledb(ctx context.Context,
+	pk int64) (
+	irreparabledb *Irreparabledb, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.project_id, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count, irreparabledbs.last_error FROM irreparabledbs WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	irreparabledb = &Irreparabledb{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&irreparabledb.Segmentpath, &irreparabledb.ProjectId, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount, &irreparabledb.LastError)
+	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return rows, nil
+	return irreparabledb, nil
 
 }
 
-func (obj *sqlite3Impl) Get_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
-	api_key *ApiKey, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+func (obj *sqlite3Impl) getLastAccountingTimestamps(ctx context.Context,
+	pk int64) (
+	accounting_timestamps *AccountingTimestamps, err error) {
 
-	var __values []interface{}
-	__values = append(__values, api_key_id.value())
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_timestamps.name, accounting_timestamps.value FROM accounting_timestamps WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, pk)
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	accounting_timestamps = &AccountingTimestamps{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&accounting_timestamps.Name, &accounting_timestamps.Value)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return api_key, nil
+	return accounting_timestamps, nil
 
 }
 
-func (obj *sqlite3Impl) Get_ApiKey_By_Key(ctx context.Context,
-	api_key_key ApiKey_Key_Field) (
-	api_key *ApiKey, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+func (obj *sqlite3Impl) getLastAccountingRollup(ctx context.Context,
+	pk int64) (
+	accounting_rollup *AccountingRollup, err error) {
 
-	var __values []interface{}
-	__values = append(__values, api_key_key.value())
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, pk)
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	accounting_rollup = &AccountingRollup{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return api_key, nil
+	return accounting_rollup, nil
 
 }
 
-func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
-	api_key_project_id ApiKey_ProjectId_Field) (
-	rows []*ApiKey, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
+func (obj *sqlite3Impl) getLastAccountingRaw(ctx context.Context,
+	pk int64) (
+	accounting_raw *AccountingRaw, err error) {
 
-	var __values []interface{}
-	__values = append(__values, api_key_project_id.value())
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, pk)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	accounting_raw = &AccountingRaw{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	defer __rows.Close()
-
-	for __rows.Next() {
-		api_key := &ApiKey{}
-		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, api_key)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+	return accounting_raw, nil
 
 }
 
-func (obj *sqlite3Impl) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
-	update Irreparabledb_Update_Fields) (
-	irreparabledb *Irreparabledb, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (obj *sqlite3Impl) getLastAccountingViolation(ctx context.Context,
+	pk int64) (
+	accounting_violation *AccountingViolation, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE irreparabledbs SET "), __sets, __sqlbundle_Literal(" WHERE irreparabledbs.segmentpath = ?")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_violations.id, accounting_violations.name, accounting_violations.interval_start, accounting_violations.interval_end, accounting_violations.expected, accounting_violations.actual, accounting_violations.details, accounting_violations.created_at FROM accounting_violations WHERE _rowid_ = ?")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	if update.Segmentdetail._set {
-		__values = append(__values, update.Segmentdetail.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segmentdetail = ?"))
+	accounting_violation = &AccountingViolation{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&accounting_violation.Id, &accounting_violation.Name, &accounting_violation.IntervalStart, &accounting_violation.IntervalEnd, &accounting_violation.Expected, &accounting_violation.Actual, &accounting_violation.Details, &accounting_violation.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return accounting_violation, nil
 
-	if update.PiecesLostCount._set {
-		__values = append(__values, update.PiecesLostCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("pieces_lost_count = ?"))
-	}
+}
 
-	if update.SegDamagedUnixSec._set {
-		__values = append(__values, update.SegDamagedUnixSec.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("seg_damaged_unix_sec = ?"))
-	}
+func (obj *sqlite3Impl) getLastNode(ctx context.Context,
+	pk int64) (
+	node *Node, err error) {
 
-	if update.RepairAttemptCount._set {
-		__values = append(__values, update.RepairAttemptCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("repair_attempt_count = ?"))
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.created_at, nodes.updated_at FROM nodes WHERE _rowid_ = ?")
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	node = &Node{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.CreatedAt, &node.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return node, nil
 
-	__args = append(__args, irreparabledb_segmentpath.value())
+}
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+func (obj *sqlite3Impl) getLastOverlayCacheNode(ctx context.Context,
+	pk int64) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.last_net, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_region, overlay_cache_nodes.version, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.audit_reputation_score, overlay_cache_nodes.uptime_reputation_score FROM overlay_cache_nodes WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, pk)
 
-	irreparabledb = &Irreparabledb{}
-	_, err = obj.driver.Exec(__stmt, __values...)
+	overlay_cache_node = &OverlayCacheNode{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.LastNet, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorRegion, &overlay_cache_node.Version, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.AuditReputationScore, &overlay_cache_node.UptimeReputationScore)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
+	return overlay_cache_node, nil
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+}
 
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+func (obj *sqlite3Impl) getLastInjuredsegment(ctx context.Context,
+	pk int64) (
+	injuredsegment *Injuredsegment, err error) {
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&irreparabledb.Segmentpath, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.path, injuredsegments.priority, injuredsegments.info, injuredsegments.attempts, injuredsegments.leased_until FROM injuredsegments WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	injuredsegment = &Injuredsegment{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&injuredsegment.Id, &injuredsegment.Path, &injuredsegment.Priority, &injuredsegment.Info, &injuredsegment.Attempts, &injuredsegment.LeasedUntil)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return irreparabledb, nil
+	return injuredsegment, nil
+
 }
 
-func (obj *sqlite3Impl) Update_AccountingTimestamps_By_Name(ctx context.Context,
-	accounting_timestamps_name AccountingTimestamps_Name_Field,
-	update AccountingTimestamps_Update_Fields) (
-	accounting_timestamps *AccountingTimestamps, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (obj *sqlite3Impl) getLastUser(ctx context.Context,
+	pk int64) (
+	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE accounting_timestamps SET "), __sets, __sqlbundle_Literal(" WHERE accounting_timestamps.name = ?")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE _rowid_ = ?")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	if update.Value._set {
-		__values = append(__values, update.Value.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("value = ?"))
+	user = &User{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return user, nil
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
-	}
+}
 
-	__args = append(__args, accounting_timestamps_name.value())
+func (obj *sqlite3Impl) getLastProject(ctx context.Context,
+	pk int64) (
+	project *Project, err error) {
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, pk)
 
-	accounting_timestamps = &AccountingTimestamps{}
-	_, err = obj.driver.Exec(__stmt, __values...)
+	project = &Project{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
+	return project, nil
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT accounting_timestamps.name, accounting_timestamps.value FROM accounting_timestamps WHERE accounting_timestamps.name = ?")
+}
 
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+func (obj *sqlite3Impl) getLastProjectMember(ctx context.Context,
+	pk int64) (
+	project_member *ProjectMember, err error) {
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&accounting_timestamps.Name, &accounting_timestamps.Value)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	project_member = &ProjectMember{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return accounting_timestamps, nil
-}
+	return project_member, nil
 
-func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
-	node *Node, err error) {
-	var __sets = &__sqlbundle_Hole{}
+}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+func (obj *sqlite3Impl) getLastApiKey(ctx context.Context,
+	pk int64) (
+	api_key *ApiKey, err error) {
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE _rowid_ = ?")
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
-	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	if update.TotalAuditCount._set {
-		__values = append(__values, update.TotalAuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return api_key, nil
 
-	if update.AuditSuccessRatio._set {
-		__values = append(__values, update.AuditSuccessRatio.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
-	}
+}
 
-	if update.UptimeSuccessCount._set {
-		__values = append(__values, update.UptimeSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
-	}
+func (obj *sqlite3Impl) getLastProjectDeletion(ctx context.Context,
+	pk int64) (
+	project_deletion *ProjectDeletion, err error) {
 
-	if update.TotalUptimeCount._set {
-		__values = append(__values, update.TotalUptimeCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_uptime_count = ?"))
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.requested_by, project_deletions.purge_after, project_deletions.purged_at, project_deletions.segments_deleted, project_deletions.pieces_deleted, project_deletions.api_keys_deleted, project_deletions.created_at FROM project_deletions WHERE _rowid_ = ?")
 
-	if update.UptimeRatio._set {
-		__values = append(__values, update.UptimeRatio.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_ratio = ?"))
-	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	__now := obj.db.Hooks.Now().UTC()
+	project_deletion = &ProjectDeletion{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_deletion.ProjectId, &project_deletion.RequestedBy, &project_deletion.PurgeAfter, &project_deletion.PurgedAt, &project_deletion.SegmentsDeleted, &project_deletion.PiecesDeleted, &project_deletion.ApiKeysDeleted, &project_deletion.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_deletion, nil
 
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+}
 
-	__args = append(__args, node_id.value())
+func (obj *sqlite3Impl) getLastSession(ctx context.Context,
+	pk int64) (
+	session *Session, err error) {
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	var __embed_stmt = __sqlbundle_Literal("SELECT sessions.id, sessions.user_id, sessions.device, sessions.address, sessions.refresh_token_hash, sessions.expires_at, sessions.last_used_at, sessions.created_at FROM sessions WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, pk)
 
-	node = &Node{}
-	_, err = obj.driver.Exec(__stmt, __values...)
+	session = &Session{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&session.Id, &session.UserId, &session.Device, &session.Address, &session.RefreshTokenHash, &session.ExpiresAt, &session.LastUsedAt, &session.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
+	return session, nil
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+}
 
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+func (obj *sqlite3Impl) getLastAuthEvent(ctx context.Context,
+	pk int64) (
+	auth_event *AuthEvent, err error) {
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT auth_events.id, auth_events.user_id, auth_events.session_id, auth_events.kind, auth_events.device, auth_events.address, auth_events.created_at FROM auth_events WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	auth_event = &AuthEvent{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&auth_event.Id, &auth_event.UserId, &auth_event.SessionId, &auth_event.Kind, &auth_event.Device, &auth_event.Address, &auth_event.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	return auth_event, nil
+
 }
 
-func (obj *sqlite3Impl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
-	update OverlayCacheNode_Update_Fields) (
-	overlay_cache_node *OverlayCacheNode, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (obj *sqlite3Impl) getLastBucketTemplate(ctx context.Context,
+	pk int64) (
+	bucket_template *BucketTemplate, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE overlay_cache_nodes SET "), __sets, __sqlbundle_Literal(" WHERE overlay_cache_nodes.node_id = ?")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_templates.project_id, bucket_templates.path_cipher, bucket_templates.data_cipher, bucket_templates.block_size, bucket_templates.share_size, bucket_templates.required_shares, bucket_templates.repair_shares, bucket_templates.optimal_shares, bucket_templates.total_shares, bucket_templates.distinct_subnets, bucket_templates.distinct_regions, bucket_templates.updated_at, bucket_templates.created_at FROM bucket_templates WHERE _rowid_ = ?")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	if update.Address._set {
-		__values = append(__values, update.Address.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	bucket_template = &BucketTemplate{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_template.ProjectId, &bucket_template.PathCipher, &bucket_template.DataCipher, &bucket_template.BlockSize, &bucket_template.ShareSize, &bucket_template.RequiredShares, &bucket_template.RepairShares, &bucket_template.OptimalShares, &bucket_template.TotalShares, &bucket_template.DistinctSubnets, &bucket_template.DistinctRegions, &bucket_template.UpdatedAt, &bucket_template.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return bucket_template, nil
 
-	if update.Protocol._set {
-		__values = append(__values, update.Protocol.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
-	}
+}
 
-	if update.OperatorEmail._set {
-		__values = append(__values, update.OperatorEmail.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_email = ?"))
-	}
+func (obj *sqlite3Impl) getLastNodePing(ctx context.Context,
+	pk int64) (
+	node_ping *NodePing, err error) {
 
-	if update.OperatorWallet._set {
-		__values = append(__values, update.OperatorWallet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet = ?"))
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_pings.id, node_pings.success_rate, node_pings.ping_count, node_pings.last_ping_at, node_pings.last_success_at, node_pings.created_at FROM node_pings WHERE _rowid_ = ?")
 
-	if update.FreeBandwidth._set {
-		__values = append(__values, update.FreeBandwidth.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_bandwidth = ?"))
-	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	if update.FreeDisk._set {
-		__values = append(__values, update.FreeDisk.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	node_ping = &NodePing{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node_ping.Id, &node_ping.SuccessRate, &node_ping.PingCount, &node_ping.LastPingAt, &node_ping.LastSuccessAt, &node_ping.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return node_ping, nil
 
-	if update.Latency90._set {
-		__values = append(__values, update.Latency90.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
-	}
+}
 
-	if update.AuditSuccessRatio._set {
-		__values = append(__values, update.AuditSuccessRatio.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
-	}
+func (obj *sqlite3Impl) getLastNodeStanding(ctx context.Context,
+	pk int64) (
+	node_standing *NodeStanding, err error) {
 
-	if update.AuditUptimeRatio._set {
-		__values = append(__values, update.AuditUptimeRatio.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_uptime_ratio = ?"))
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_standings.id, node_standings.status, node_standings.reason, node_standings.since FROM node_standings WHERE _rowid_ = ?")
 
-	if update.AuditCount._set {
-		__values = append(__values, update.AuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_count = ?"))
-	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	node_standing = &NodeStanding{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node_standing.Id, &node_standing.Status, &node_standing.Reason, &node_standing.Since)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return node_standing, nil
 
-	if update.UptimeCount._set {
-		__values = append(__values, update.UptimeCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_count = ?"))
-	}
+}
 
-	if update.UptimeSuccessCount._set {
-		__values = append(__values, update.UptimeSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
-	}
+func (obj *sqlite3Impl) getLastObjectTag(ctx context.Context,
+	pk int64) (
+	object_tag *ObjectTag, err error) {
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+	var __embed_stmt = __sqlbundle_Literal("SELECT object_tags.project_id, object_tags.bucket_name, object_tags.tag_key, object_tags.tag_value, object_tags.encrypted_path FROM object_tags WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	object_tag = &ObjectTag{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&object_tag.ProjectId, &object_tag.BucketName, &object_tag.TagKey, &object_tag.TagValue, &object_tag.EncryptedPath)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return object_tag, nil
 
-	__args = append(__args, overlay_cache_node_node_id.value())
+}
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+func (obj *sqlite3Impl) getLastBucketAttribution(ctx context.Context,
+	pk int64) (
+	bucket_attribution *BucketAttribution, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_attributions.project_id, bucket_attributions.bucket_name, bucket_attributions.partner_id, bucket_attributions.created_at FROM bucket_attributions WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, pk)
 
-	overlay_cache_node = &OverlayCacheNode{}
-	_, err = obj.driver.Exec(__stmt, __values...)
+	bucket_attribution = &BucketAttribution{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_attribution.ProjectId, &bucket_attribution.BucketName, &bucket_attribution.PartnerId, &bucket_attribution.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
+	return bucket_attribution, nil
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+}
 
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+func (obj *sqlite3Impl) getLastPartnerStorageTally(ctx context.Context,
+	pk int64) (
+	partner_storage_tally *PartnerStorageTally, err error) {
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT partner_storage_tallies.id, partner_storage_tallies.partner_id, partner_storage_tallies.interval_end_time, partner_storage_tallies.data_total, partner_storage_tallies.created_at FROM partner_storage_tallies WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	partner_storage_tally = &PartnerStorageTally{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&partner_storage_tally.Id, &partner_storage_tally.PartnerId, &partner_storage_tally.IntervalEndTime, &partner_storage_tally.DataTotal, &partner_storage_tally.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return overlay_cache_node, nil
+	return partner_storage_tally, nil
+
 }
 
-func (obj *sqlite3Impl) Update_User_By_Id(ctx context.Context,
-	user_id User_Id_Field,
-	update User_Update_Fields) (
-	user *User, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (obj *sqlite3Impl) getLastPartnerSerial(ctx context.Context,
+	pk int64) (
+	partner_serial *PartnerSerial, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT partner_serials.serial_number, partner_serials.partner_id, partner_serials.created_at FROM partner_serials WHERE _rowid_ = ?")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	if update.FirstName._set {
-		__values = append(__values, update.FirstName.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("first_name = ?"))
+	partner_serial = &PartnerSerial{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&partner_serial.SerialNumber, &partner_serial.PartnerId, &partner_serial.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return partner_serial, nil
 
-	if update.LastName._set {
-		__values = append(__values, update.LastName.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_name = ?"))
-	}
+}
 
-	if update.Email._set {
-		__values = append(__values, update.Email.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
-	}
+func (obj *sqlite3Impl) getLastBucketStat(ctx context.Context,
+	pk int64) (
+	bucket_stat *BucketStat, err error) {
 
-	if update.PasswordHash._set {
-		__values = append(__values, update.PasswordHash.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("password_hash = ?"))
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_stats.project_id, bucket_stats.bucket_name, bucket_stats.object_count, bucket_stats.total_bytes, bucket_stats.updated_at FROM bucket_stats WHERE _rowid_ = ?")
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bucket_stat = &BucketStat{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_stat.ProjectId, &bucket_stat.BucketName, &bucket_stat.ObjectCount, &bucket_stat.TotalBytes, &bucket_stat.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return bucket_stat, nil
 
-	__args = append(__args, user_id.value())
+}
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+func (obj *sqlite3Impl) getLastBucketLifecycle(ctx context.Context,
+	pk int64) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.expire_after_days, bucket_lifecycles.versions_prefix, bucket_lifecycles.versions_expire_after_days, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, pk)
 
-	user = &User{}
-	_, err = obj.driver.Exec(__stmt, __values...)
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.ExpireAfterDays, &bucket_lifecycle.VersionsPrefix, &bucket_lifecycle.VersionsExpireAfterDays, &bucket_lifecycle.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
+	return bucket_lifecycle, nil
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE users.id = ?")
+}
 
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+func (obj *sqlite3Impl) getLastProjectInvoice(ctx context.Context,
+	pk int64) (
+	project_invoice *ProjectInvoice, err error) {
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invoices.id, project_invoices.project_id, project_invoices.customer_id, project_invoices.period_start, project_invoices.period_end, project_invoices.amount, project_invoices.status, project_invoices.created_at FROM project_invoices WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	project_invoice = &ProjectInvoice{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_invoice.Id, &project_invoice.ProjectId, &project_invoice.CustomerId, &project_invoice.PeriodStart, &project_invoice.PeriodEnd, &project_invoice.Amount, &project_invoice.Status, &project_invoice.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return user, nil
+	return project_invoice, nil
+
 }
 
-func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
-	project_id Project_Id_Field,
-	update Project_Update_Fields) (
-	project *Project, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (obj *sqlite3Impl) getLastProjectStorageTally(ctx context.Context,
+	pk int64) (
+	project_storage_tally *ProjectStorageTally, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_storage_tallies.id, project_storage_tallies.project_id, project_storage_tallies.interval_end_time, project_storage_tallies.data_total, project_storage_tallies.created_at FROM project_storage_tallies WHERE _rowid_ = ?")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	if update.Description._set {
-		__values = append(__values, update.Description.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("description = ?"))
+	project_storage_tally = &ProjectStorageTally{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_storage_tally.Id, &project_storage_tally.ProjectId, &project_storage_tally.IntervalEndTime, &project_storage_tally.DataTotal, &project_storage_tally.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return project_storage_tally, nil
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
-	}
+}
 
-	__args = append(__args, project_id.value())
+func (obj *sqlite3Impl) getLastUserPayment(ctx context.Context,
+	pk int64) (
+	user_payment *UserPayment, err error) {
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	var __embed_stmt = __sqlbundle_Literal("SELECT user_payments.user_id, user_payments.customer_id, user_payments.credit, user_payments.created_at FROM user_payments WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	obj.logStmt(__stmt, pk)
 
-	project = &Project{}
-	_, err = obj.driver.Exec(__stmt, __values...)
+	user_payment = &UserPayment{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&user_payment.UserId, &user_payment.CustomerId, &user_payment.Credit, &user_payment.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
+	return user_payment, nil
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+}
 
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+func (obj *sqlite3Impl) getLastSegmentHealth(ctx context.Context,
+	pk int64) (
+	segment_health *SegmentHealth, err error) {
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	var __embed_stmt = __sqlbundle_Literal("SELECT segment_healths.path, segment_healths.last_audited, segment_healths.last_repaired FROM segment_healths WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	segment_health = &SegmentHealth{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&segment_health.Path, &segment_health.LastAudited, &segment_health.LastRepaired)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return project, nil
+	return segment_health, nil
+
 }
 
-func (obj *sqlite3Impl) Update_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field,
-	update ApiKey_Update_Fields) (
-	api_key *ApiKey, err error) {
-	var __sets = &__sqlbundle_Hole{}
+func (obj *sqlite3Impl) getLastAuditRecord(ctx context.Context,
+	pk int64) (
+	audit_record *AuditRecord, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE api_keys SET "), __sets, __sqlbundle_Literal(" WHERE api_keys.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_records.node_id, audit_records.audited_at, audit_records.segment_hash, audit_records.stripe_index, audit_records.piece_num, audit_records.outcome, audit_records.error, audit_records.duration_ms FROM audit_records WHERE _rowid_ = ?")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
 
-	if update.Name._set {
-		__values = append(__values, update.Name.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	audit_record = &AuditRecord{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&audit_record.NodeId, &audit_record.AuditedAt, &audit_record.SegmentHash, &audit_record.StripeIndex, &audit_record.PieceNum, &audit_record.Outcome, &audit_record.Error, &audit_record.DurationMs)
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return audit_record, nil
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+}
+
+func (impl sqlite3Impl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(sqlite3.Error); ok {
+		if e.Code == sqlite3.ErrConstraint {
+			msg := err.Error()
+			colon := strings.LastIndex(msg, ":")
+			if colon != -1 {
+				return strings.TrimSpace(msg[colon:]), true
+			}
+			return "", true
+		}
 	}
+	return "", false
+}
 
-	__args = append(__args, api_key_id.value())
+func (obj *sqlite3Impl) Delete_ObjectTag_By_ProjectId_And_BucketName_And_EncryptedPath(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_encrypted_path ObjectTag_EncryptedPath_Field) (
+	count int64, err error) {
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM object_tags WHERE object_tags.project_id = ? AND object_tags.bucket_name = ? AND object_tags.encrypted_path = ?")
+
+	var __values []interface{}
+	__values = append(__values, object_tag_project_id.value(), object_tag_bucket_name.value(), object_tag_encrypted_path.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	api_key = &ApiKey{}
-	_, err = obj.driver.Exec(__stmt, __values...)
+	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
-
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
-
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	count, err = __res.RowsAffected()
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-	return api_key, nil
+
+	return count, nil
+
 }
 
-func (obj *sqlite3Impl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
+func (obj *sqlite3Impl) Delete_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_stats WHERE bucket_stats.project_id = ? AND bucket_stats.bucket_name = ?")
 
 	var __values []interface{}
-	__values = append(__values, irreparabledb_segmentpath.value())
+	__values = append(__values, bucket_stat_project_id.value(), bucket_stat_bucket_name.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -6039,14 +14532,15 @@ func (obj *sqlite3Impl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Delete_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
+func (obj *sqlite3Impl) Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_rollups WHERE accounting_rollups.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_rollup_id.value())
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -6065,1073 +14559,1455 @@ func (obj *sqlite3Impl) Delete_AccountingRollup_By_Id(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Delete_AccountingRaw_By_Id(ctx context.Context,
-	accounting_raw_id AccountingRaw_Id_Field) (
-	deleted bool, err error) {
+func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error) {
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.Exec("DELETE FROM project_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_raws WHERE accounting_raws.id = ?")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __values []interface{}
-	__values = append(__values, accounting_raw_id.value())
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM users;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM user_payments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM sessions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM segment_healths;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM projects;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_storage_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_invoices;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_deletions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM partner_storage_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM partner_serials;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM overlay_cache_nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM object_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_standings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_pings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM irreparabledbs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM injuredsegments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_templates;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_stats;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	return __count > 0, nil
-
-}
-
-func (obj *sqlite3Impl) Delete_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
-	deleted bool, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
-
-	var __values []interface{}
-	__values = append(__values, node_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.Exec(__stmt, __values...)
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_lifecycles;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	return __count > 0, nil
-
-}
-
-func (obj *sqlite3Impl) Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
-	deleted bool, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
-
-	var __values []interface{}
-	__values = append(__values, overlay_cache_node_node_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.Exec(__stmt, __values...)
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_attributions;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	return __count > 0, nil
-
-}
-
-func (obj *sqlite3Impl) Delete_Injuredsegment_By_Id(ctx context.Context,
-	injuredsegment_id Injuredsegment_Id_Field) (
-	deleted bool, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.id = ?")
-
-	var __values []interface{}
-	__values = append(__values, injuredsegment_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.Exec(__stmt, __values...)
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM auth_events;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	return __count > 0, nil
-
-}
-
-func (obj *sqlite3Impl) Delete_User_By_Id(ctx context.Context,
-	user_id User_Id_Field) (
-	deleted bool, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
-
-	var __values []interface{}
-	__values = append(__values, user_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.Exec(__stmt, __values...)
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM audit_records;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM accounting_violations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
-
-}
-
-func (obj *sqlite3Impl) Delete_Project_By_Id(ctx context.Context,
-	project_id Project_Id_Field) (
-	deleted bool, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM projects WHERE projects.id = ?")
-
-	var __values []interface{}
-	__values = append(__values, project_id.value())
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM accounting_timestamps;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM accounting_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM accounting_raws;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
+	count += __count
 
-	return __count > 0, nil
+	return count, nil
 
 }
 
-func (obj *sqlite3Impl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
-	deleted bool, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
-
-	var __values []interface{}
-	__values = append(__values, project_member_member_id.value(), project_member_project_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+type Rx struct {
+	db *DB
+	tx *Tx
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
+func (rx *Rx) UnsafeTx(ctx context.Context) (unsafe_tx *sql.Tx, err error) {
+	tx, err := rx.getTx(ctx)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return nil, err
 	}
+	return tx.Tx, nil
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
+func (rx *Rx) getTx(ctx context.Context) (tx *Tx, err error) {
+	if rx.tx == nil {
+		if rx.tx, err = rx.db.Open(ctx); err != nil {
+			return nil, err
+		}
 	}
+	return rx.tx, nil
+}
 
-	return __count > 0, nil
-
+func (rx *Rx) Rebind(s string) string {
+	return rx.db.Rebind(s)
 }
 
-func (obj *sqlite3Impl) Delete_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Commit() (err error) {
+	if rx.tx != nil {
+		err = rx.tx.Commit()
+		rx.tx = nil
+	}
+	return err
+}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
+func (rx *Rx) Rollback() (err error) {
+	if rx.tx != nil {
+		err = rx.tx.Rollback()
+		rx.tx = nil
+	}
+	return err
+}
 
-	var __values []interface{}
-	__values = append(__values, api_key_id.value())
+func (rx *Rx) All_AccountingRaw(ctx context.Context) (
+	rows []*AccountingRaw, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_AccountingRaw(ctx)
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+func (rx *Rx) All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx context.Context,
+	accounting_raw_interval_end_time_greater_or_equal AccountingRaw_IntervalEndTime_Field) (
+	rows []*AccountingRaw, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx, accounting_raw_interval_end_time_greater_or_equal)
+}
 
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
+func (rx *Rx) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Context,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
+	rows []*AccountingRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx, accounting_rollup_start_time_greater_or_equal)
+}
 
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
+func (rx *Rx) All_AccountingRollup_By_NodeId_And_StartTime_GreaterOrEqual_And_StartTime_Less_OrderBy_Asc_StartTime(ctx context.Context,
+	accounting_rollup_node_id AccountingRollup_NodeId_Field,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field,
+	accounting_rollup_start_time_less AccountingRollup_StartTime_Field) (
+	rows []*AccountingRollup, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-
-	return __count > 0, nil
-
+	return tx.All_AccountingRollup_By_NodeId_And_StartTime_GreaterOrEqual_And_StartTime_Less_OrderBy_Asc_StartTime(ctx, accounting_rollup_node_id, accounting_rollup_start_time_greater_or_equal, accounting_rollup_start_time_less)
 }
 
-func (obj *sqlite3Impl) getLastBwagreement(ctx context.Context,
-	pk int64) (
-	bwagreement *Bwagreement, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.serialnum, bwagreements.storage_node_id, bwagreements.uplink_id, bwagreements.action, bwagreements.total, bwagreements.created_at, bwagreements.expires_at FROM bwagreements WHERE _rowid_ = ?")
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+func (rx *Rx) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+	api_key_project_id ApiKey_ProjectId_Field) (
+	rows []*ApiKey, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx, api_key_project_id)
+}
 
-	bwagreement = &Bwagreement{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&bwagreement.Serialnum, &bwagreement.StorageNodeId, &bwagreement.UplinkId, &bwagreement.Action, &bwagreement.Total, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) All_BucketStat_By_ProjectId_OrderBy_Asc_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field) (
+	rows []*BucketStat, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return bwagreement, nil
+	return tx.All_BucketStat_By_ProjectId_OrderBy_Asc_BucketName(ctx, bucket_stat_project_id)
+}
 
+func (rx *Rx) All_Bwagreement(ctx context.Context) (
+	rows []*Bwagreement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Bwagreement(ctx)
 }
 
-func (obj *sqlite3Impl) getLastIrreparabledb(ctx context.Context,
-	pk int64) (
-	irreparabledb *Irreparabledb, err error) {
+func (rx *Rx) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
+	bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
+	rows []*Bwagreement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Bwagreement_By_CreatedAt_Greater(ctx, bwagreement_created_at_greater)
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT irreparabledbs.segmentpath, irreparabledbs.segmentdetail, irreparabledbs.pieces_lost_count, irreparabledbs.seg_damaged_unix_sec, irreparabledbs.repair_attempt_count FROM irreparabledbs WHERE _rowid_ = ?")
+func (rx *Rx) All_Node_Id(ctx context.Context) (
+	rows []*Id_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Node_Id(ctx)
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+func (rx *Rx) All_Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_By_AccountingRollup_StartTime_GreaterOrEqual_And_AccountingRollup_StartTime_Less_OrderBy_Asc_Node_Id(ctx context.Context,
+	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field,
+	accounting_rollup_start_time_less AccountingRollup_StartTime_Field) (
+	rows []*Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_By_AccountingRollup_StartTime_GreaterOrEqual_And_AccountingRollup_StartTime_Less_OrderBy_Asc_Node_Id(ctx, accounting_rollup_start_time_greater_or_equal, accounting_rollup_start_time_less)
+}
 
-	irreparabledb = &Irreparabledb{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&irreparabledb.Segmentpath, &irreparabledb.Segmentdetail, &irreparabledb.PiecesLostCount, &irreparabledb.SegDamagedUnixSec, &irreparabledb.RepairAttemptCount)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) All_Project(ctx context.Context) (
+	rows []*Project, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return irreparabledb, nil
+	return tx.All_Project(ctx)
+}
 
+func (rx *Rx) All_ProjectDeletion_By_PurgeAfter_Less_And_PurgedAt_Is_Null(ctx context.Context,
+	project_deletion_purge_after_less ProjectDeletion_PurgeAfter_Field) (
+	rows []*ProjectDeletion, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ProjectDeletion_By_PurgeAfter_Less_And_PurgedAt_Is_Null(ctx, project_deletion_purge_after_less)
 }
 
-func (obj *sqlite3Impl) getLastAccountingTimestamps(ctx context.Context,
-	pk int64) (
-	accounting_timestamps *AccountingTimestamps, err error) {
+func (rx *Rx) All_ProjectMember_By_MemberId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
+	rows []*ProjectMember, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ProjectMember_By_MemberId(ctx, project_member_member_id)
+}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_timestamps.name, accounting_timestamps.value FROM accounting_timestamps WHERE _rowid_ = ?")
+func (rx *Rx) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
+	rows []*Project, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx, project_member_member_id)
+}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+func (rx *Rx) All_Session_By_UserId_OrderBy_Desc_LastUsedAt(ctx context.Context,
+	session_user_id Session_UserId_Field) (
+	rows []*Session, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Session_By_UserId_OrderBy_Desc_LastUsedAt(ctx, session_user_id)
+}
 
-	accounting_timestamps = &AccountingTimestamps{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&accounting_timestamps.Name, &accounting_timestamps.Value)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_AccountingRaw(ctx context.Context,
+	accounting_raw_node_id AccountingRaw_NodeId_Field,
+	accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
+	accounting_raw_data_total AccountingRaw_DataTotal_Field,
+	accounting_raw_data_type AccountingRaw_DataType_Field) (
+	accounting_raw *AccountingRaw, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return accounting_timestamps, nil
+	return tx.Create_AccountingRaw(ctx, accounting_raw_node_id, accounting_raw_interval_end_time, accounting_raw_data_total, accounting_raw_data_type)
 
 }
 
-func (obj *sqlite3Impl) getLastAccountingRollup(ctx context.Context,
-	pk int64) (
+func (rx *Rx) Create_AccountingRollup(ctx context.Context,
+	accounting_rollup_node_id AccountingRollup_NodeId_Field,
+	accounting_rollup_start_time AccountingRollup_StartTime_Field,
+	accounting_rollup_put_total AccountingRollup_PutTotal_Field,
+	accounting_rollup_get_total AccountingRollup_GetTotal_Field,
+	accounting_rollup_get_audit_total AccountingRollup_GetAuditTotal_Field,
+	accounting_rollup_get_repair_total AccountingRollup_GetRepairTotal_Field,
+	accounting_rollup_put_repair_total AccountingRollup_PutRepairTotal_Field,
+	accounting_rollup_at_rest_total AccountingRollup_AtRestTotal_Field) (
 	accounting_rollup *AccountingRollup, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_rollups.id, accounting_rollups.node_id, accounting_rollups.start_time, accounting_rollups.put_total, accounting_rollups.get_total, accounting_rollups.get_audit_total, accounting_rollups.get_repair_total, accounting_rollups.put_repair_total, accounting_rollups.at_rest_total FROM accounting_rollups WHERE _rowid_ = ?")
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
-
-	accounting_rollup = &AccountingRollup{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&accounting_rollup.Id, &accounting_rollup.NodeId, &accounting_rollup.StartTime, &accounting_rollup.PutTotal, &accounting_rollup.GetTotal, &accounting_rollup.GetAuditTotal, &accounting_rollup.GetRepairTotal, &accounting_rollup.PutRepairTotal, &accounting_rollup.AtRestTotal)
-	if err != nil {
-		return nil, obj.makeErr(err)
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return accounting_rollup, nil
+	return tx.Create_AccountingRollup(ctx, accounting_rollup_node_id, accounting_rollup_start_time, accounting_rollup_put_total, accounting_rollup_get_total, accounting_rollup_get_audit_total, accounting_rollup_get_repair_total, accounting_rollup_put_repair_total, accounting_rollup_at_rest_total)
 
 }
 
-func (obj *sqlite3Impl) getLastAccountingRaw(ctx context.Context,
-	pk int64) (
-	accounting_raw *AccountingRaw, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE _rowid_ = ?")
+func (rx *Rx) Create_AccountingTimestamps(ctx context.Context,
+	accounting_timestamps_name AccountingTimestamps_Name_Field,
+	accounting_timestamps_value AccountingTimestamps_Value_Field) (
+	accounting_timestamps *AccountingTimestamps, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_AccountingTimestamps(ctx, accounting_timestamps_name, accounting_timestamps_value)
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+}
 
-	accounting_raw = &AccountingRaw{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_AccountingViolation(ctx context.Context,
+	accounting_violation_name AccountingViolation_Name_Field,
+	accounting_violation_interval_start AccountingViolation_IntervalStart_Field,
+	accounting_violation_interval_end AccountingViolation_IntervalEnd_Field,
+	accounting_violation_expected AccountingViolation_Expected_Field,
+	accounting_violation_actual AccountingViolation_Actual_Field,
+	accounting_violation_details AccountingViolation_Details_Field) (
+	accounting_violation *AccountingViolation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return accounting_raw, nil
+	return tx.Create_AccountingViolation(ctx, accounting_violation_name, accounting_violation_interval_start, accounting_violation_interval_end, accounting_violation_expected, accounting_violation_actual, accounting_violation_details)
 
 }
 
-func (obj *sqlite3Impl) getLastNode(ctx context.Context,
-	pk int64) (
-	node *Node, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE _rowid_ = ?")
+func (rx *Rx) Create_ApiKey(ctx context.Context,
+	api_key_id ApiKey_Id_Field,
+	api_key_project_id ApiKey_ProjectId_Field,
+	api_key_key ApiKey_Key_Field,
+	api_key_name ApiKey_Name_Field) (
+	api_key *ApiKey, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_ApiKey(ctx, api_key_id, api_key_project_id, api_key_key, api_key_name)
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+}
 
-	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_AuditRecord(ctx context.Context,
+	audit_record_node_id AuditRecord_NodeId_Field,
+	audit_record_audited_at AuditRecord_AuditedAt_Field,
+	audit_record_segment_hash AuditRecord_SegmentHash_Field,
+	audit_record_stripe_index AuditRecord_StripeIndex_Field,
+	audit_record_piece_num AuditRecord_PieceNum_Field,
+	audit_record_outcome AuditRecord_Outcome_Field,
+	audit_record_error AuditRecord_Error_Field,
+	audit_record_duration_ms AuditRecord_DurationMs_Field) (
+	audit_record *AuditRecord, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return node, nil
+	return tx.Create_AuditRecord(ctx, audit_record_node_id, audit_record_audited_at, audit_record_segment_hash, audit_record_stripe_index, audit_record_piece_num, audit_record_outcome, audit_record_error, audit_record_duration_ms)
 
 }
 
-func (obj *sqlite3Impl) getLastOverlayCacheNode(ctx context.Context,
-	pk int64) (
-	overlay_cache_node *OverlayCacheNode, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE _rowid_ = ?")
+func (rx *Rx) Create_AuthEvent(ctx context.Context,
+	auth_event_user_id AuthEvent_UserId_Field,
+	auth_event_session_id AuthEvent_SessionId_Field,
+	auth_event_kind AuthEvent_Kind_Field,
+	auth_event_device AuthEvent_Device_Field,
+	auth_event_address AuthEvent_Address_Field) (
+	auth_event *AuthEvent, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_AuthEvent(ctx, auth_event_user_id, auth_event_session_id, auth_event_kind, auth_event_device, auth_event_address)
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+}
 
-	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_BucketAttribution(ctx context.Context,
+	bucket_attribution_project_id BucketAttribution_ProjectId_Field,
+	bucket_attribution_bucket_name BucketAttribution_BucketName_Field,
+	bucket_attribution_partner_id BucketAttribution_PartnerId_Field) (
+	bucket_attribution *BucketAttribution, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return overlay_cache_node, nil
+	return tx.Create_BucketAttribution(ctx, bucket_attribution_project_id, bucket_attribution_bucket_name, bucket_attribution_partner_id)
 
 }
 
-func (obj *sqlite3Impl) getLastInjuredsegment(ctx context.Context,
-	pk int64) (
-	injuredsegment *Injuredsegment, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments WHERE _rowid_ = ?")
+func (rx *Rx) Create_BucketLifecycle(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	bucket_lifecycle_expire_after_days BucketLifecycle_ExpireAfterDays_Field,
+	bucket_lifecycle_versions_prefix BucketLifecycle_VersionsPrefix_Field,
+	bucket_lifecycle_versions_expire_after_days BucketLifecycle_VersionsExpireAfterDays_Field,
+	bucket_lifecycle_updated_at BucketLifecycle_UpdatedAt_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_BucketLifecycle(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name, bucket_lifecycle_expire_after_days, bucket_lifecycle_versions_prefix, bucket_lifecycle_versions_expire_after_days, bucket_lifecycle_updated_at)
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+}
 
-	injuredsegment = &Injuredsegment{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&injuredsegment.Id, &injuredsegment.Info)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_BucketStat(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field,
+	bucket_stat_object_count BucketStat_ObjectCount_Field,
+	bucket_stat_total_bytes BucketStat_TotalBytes_Field,
+	bucket_stat_updated_at BucketStat_UpdatedAt_Field) (
+	bucket_stat *BucketStat, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return injuredsegment, nil
+	return tx.Create_BucketStat(ctx, bucket_stat_project_id, bucket_stat_bucket_name, bucket_stat_object_count, bucket_stat_total_bytes, bucket_stat_updated_at)
 
 }
 
-func (obj *sqlite3Impl) getLastUser(ctx context.Context,
-	pk int64) (
-	user *User, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.first_name, users.last_name, users.email, users.password_hash, users.created_at FROM users WHERE _rowid_ = ?")
+func (rx *Rx) Create_BucketTemplate(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field,
+	bucket_template_path_cipher BucketTemplate_PathCipher_Field,
+	bucket_template_data_cipher BucketTemplate_DataCipher_Field,
+	bucket_template_block_size BucketTemplate_BlockSize_Field,
+	bucket_template_share_size BucketTemplate_ShareSize_Field,
+	bucket_template_required_shares BucketTemplate_RequiredShares_Field,
+	bucket_template_repair_shares BucketTemplate_RepairShares_Field,
+	bucket_template_optimal_shares BucketTemplate_OptimalShares_Field,
+	bucket_template_total_shares BucketTemplate_TotalShares_Field,
+	bucket_template_distinct_subnets BucketTemplate_DistinctSubnets_Field,
+	bucket_template_distinct_regions BucketTemplate_DistinctRegions_Field,
+	bucket_template_updated_at BucketTemplate_UpdatedAt_Field) (
+	bucket_template *BucketTemplate, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_BucketTemplate(ctx, bucket_template_project_id, bucket_template_path_cipher, bucket_template_data_cipher, bucket_template_block_size, bucket_template_share_size, bucket_template_required_shares, bucket_template_repair_shares, bucket_template_optimal_shares, bucket_template_total_shares, bucket_template_distinct_subnets, bucket_template_distinct_regions, bucket_template_updated_at)
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+}
 
-	user = &User{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&user.Id, &user.FirstName, &user.LastName, &user.Email, &user.PasswordHash, &user.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_Bwagreement(ctx context.Context,
+	bwagreement_serialnum Bwagreement_Serialnum_Field,
+	bwagreement_storage_node_id Bwagreement_StorageNodeId_Field,
+	bwagreement_uplink_id Bwagreement_UplinkId_Field,
+	bwagreement_action Bwagreement_Action_Field,
+	bwagreement_total Bwagreement_Total_Field,
+	bwagreement_expires_at Bwagreement_ExpiresAt_Field) (
+	bwagreement *Bwagreement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return user, nil
+	return tx.Create_Bwagreement(ctx, bwagreement_serialnum, bwagreement_storage_node_id, bwagreement_uplink_id, bwagreement_action, bwagreement_total, bwagreement_expires_at)
 
 }
 
-func (obj *sqlite3Impl) getLastProject(ctx context.Context,
-	pk int64) (
-	project *Project, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE _rowid_ = ?")
+func (rx *Rx) Create_Injuredsegment(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	injuredsegment_priority Injuredsegment_Priority_Field,
+	injuredsegment_info Injuredsegment_Info_Field,
+	injuredsegment_attempts Injuredsegment_Attempts_Field,
+	injuredsegment_leased_until Injuredsegment_LeasedUntil_Field) (
+	injuredsegment *Injuredsegment, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_Injuredsegment(ctx, injuredsegment_path, injuredsegment_priority, injuredsegment_info, injuredsegment_attempts, injuredsegment_leased_until)
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+}
 
-	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_Irreparabledb(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
+	irreparabledb_project_id Irreparabledb_ProjectId_Field,
+	irreparabledb_segmentdetail Irreparabledb_Segmentdetail_Field,
+	irreparabledb_pieces_lost_count Irreparabledb_PiecesLostCount_Field,
+	irreparabledb_seg_damaged_unix_sec Irreparabledb_SegDamagedUnixSec_Field,
+	irreparabledb_repair_attempt_count Irreparabledb_RepairAttemptCount_Field,
+	irreparabledb_last_error Irreparabledb_LastError_Field) (
+	irreparabledb *Irreparabledb, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return project, nil
+	return tx.Create_Irreparabledb(ctx, irreparabledb_segmentpath, irreparabledb_project_id, irreparabledb_segmentdetail, irreparabledb_pieces_lost_count, irreparabledb_seg_damaged_unix_sec, irreparabledb_repair_attempt_count, irreparabledb_last_error)
 
 }
 
-func (obj *sqlite3Impl) getLastProjectMember(ctx context.Context,
-	pk int64) (
-	project_member *ProjectMember, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE _rowid_ = ?")
+func (rx *Rx) Create_Node(ctx context.Context,
+	node_id Node_Id_Field,
+	node_audit_success_count Node_AuditSuccessCount_Field,
+	node_total_audit_count Node_TotalAuditCount_Field,
+	node_audit_success_ratio Node_AuditSuccessRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_success_count Node_UptimeSuccessCount_Field,
+	node_total_uptime_count Node_TotalUptimeCount_Field,
+	node_uptime_ratio Node_UptimeRatio_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field) (
+	node *Node, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_Node(ctx, node_id, node_audit_success_count, node_total_audit_count, node_audit_success_ratio, node_audit_reputation_alpha, node_audit_reputation_beta, node_uptime_success_count, node_total_uptime_count, node_uptime_ratio, node_uptime_reputation_alpha, node_uptime_reputation_beta)
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+}
 
-	project_member = &ProjectMember{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_NodePing(ctx context.Context,
+	node_ping_id NodePing_Id_Field,
+	node_ping_success_rate NodePing_SuccessRate_Field,
+	node_ping_ping_count NodePing_PingCount_Field,
+	node_ping_last_ping_at NodePing_LastPingAt_Field,
+	optional NodePing_Create_Fields) (
+	node_ping *NodePing, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return project_member, nil
+	return tx.Create_NodePing(ctx, node_ping_id, node_ping_success_rate, node_ping_ping_count, node_ping_last_ping_at, optional)
 
 }
 
-func (obj *sqlite3Impl) getLastApiKey(ctx context.Context,
-	pk int64) (
-	api_key *ApiKey, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE _rowid_ = ?")
+func (rx *Rx) Create_NodeStanding(ctx context.Context,
+	node_standing_id NodeStanding_Id_Field,
+	node_standing_status NodeStanding_Status_Field,
+	node_standing_reason NodeStanding_Reason_Field,
+	node_standing_since NodeStanding_Since_Field) (
+	node_standing *NodeStanding, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_NodeStanding(ctx, node_standing_id, node_standing_status, node_standing_reason, node_standing_since)
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+}
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
-	if err != nil {
-		return nil, obj.makeErr(err)
+func (rx *Rx) Create_ObjectTag(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_tag_key ObjectTag_TagKey_Field,
+	object_tag_tag_value ObjectTag_TagValue_Field,
+	object_tag_encrypted_path ObjectTag_EncryptedPath_Field) (
+	object_tag *ObjectTag, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return api_key, nil
+	return tx.Create_ObjectTag(ctx, object_tag_project_id, object_tag_bucket_name, object_tag_tag_key, object_tag_tag_value, object_tag_encrypted_path)
 
 }
 
-func (impl sqlite3Impl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(sqlite3.Error); ok {
-		if e.Code == sqlite3.ErrConstraint {
-			msg := err.Error()
-			colon := strings.LastIndex(msg, ":")
-			if colon != -1 {
-				return strings.TrimSpace(msg[colon:]), true
-			}
-			return "", true
-		}
+func (rx *Rx) Create_OverlayCacheNode(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
+	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
+	overlay_cache_node_address OverlayCacheNode_Address_Field,
+	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+	overlay_cache_node_last_net OverlayCacheNode_LastNet_Field,
+	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
+	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+	overlay_cache_node_operator_region OverlayCacheNode_OperatorRegion_Field,
+	overlay_cache_node_version OverlayCacheNode_Version_Field,
+	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
+	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
+	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
+	overlay_cache_node_audit_success_ratio OverlayCacheNode_AuditSuccessRatio_Field,
+	overlay_cache_node_audit_uptime_ratio OverlayCacheNode_AuditUptimeRatio_Field,
+	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
+	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
+	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
+	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+	overlay_cache_node_audit_reputation_score OverlayCacheNode_AuditReputationScore_Field,
+	overlay_cache_node_uptime_reputation_score OverlayCacheNode_UptimeReputationScore_Field) (
+	overlay_cache_node *OverlayCacheNode, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return "", false
+	return tx.Create_OverlayCacheNode(ctx, overlay_cache_node_node_id, overlay_cache_node_node_type, overlay_cache_node_address, overlay_cache_node_protocol, overlay_cache_node_last_net, overlay_cache_node_operator_email, overlay_cache_node_operator_wallet, overlay_cache_node_operator_region, overlay_cache_node_version, overlay_cache_node_free_bandwidth, overlay_cache_node_free_disk, overlay_cache_node_latency_90, overlay_cache_node_audit_success_ratio, overlay_cache_node_audit_uptime_ratio, overlay_cache_node_audit_count, overlay_cache_node_audit_success_count, overlay_cache_node_uptime_count, overlay_cache_node_uptime_success_count, overlay_cache_node_audit_reputation_score, overlay_cache_node_uptime_reputation_score)
+
 }
 
-func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error) {
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.Exec("DELETE FROM project_members;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (rx *Rx) Create_PartnerSerial(ctx context.Context,
+	partner_serial_serial_number PartnerSerial_SerialNumber_Field,
+	partner_serial_partner_id PartnerSerial_PartnerId_Field) (
+	partner_serial *PartnerSerial, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.Create_PartnerSerial(ctx, partner_serial_serial_number, partner_serial_partner_id)
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM api_keys;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM users;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (rx *Rx) Create_PartnerStorageTally(ctx context.Context,
+	partner_storage_tally_partner_id PartnerStorageTally_PartnerId_Field,
+	partner_storage_tally_interval_end_time PartnerStorageTally_IntervalEndTime_Field,
+	partner_storage_tally_data_total PartnerStorageTally_DataTotal_Field) (
+	partner_storage_tally *PartnerStorageTally, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.Create_PartnerStorageTally(ctx, partner_storage_tally_partner_id, partner_storage_tally_interval_end_time, partner_storage_tally_data_total)
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM projects;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM overlay_cache_nodes;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (rx *Rx) Create_Project(ctx context.Context,
+	project_id Project_Id_Field,
+	project_name Project_Name_Field,
+	project_description Project_Description_Field) (
+	project *Project, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.Create_Project(ctx, project_id, project_name, project_description)
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM nodes;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM irreparabledbs;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (rx *Rx) Create_ProjectDeletion(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	project_deletion_requested_by ProjectDeletion_RequestedBy_Field,
+	project_deletion_purge_after ProjectDeletion_PurgeAfter_Field,
+	project_deletion_segments_deleted ProjectDeletion_SegmentsDeleted_Field,
+	project_deletion_pieces_deleted ProjectDeletion_PiecesDeleted_Field,
+	project_deletion_api_keys_deleted ProjectDeletion_ApiKeysDeleted_Field,
+	optional ProjectDeletion_Create_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.Create_ProjectDeletion(ctx, project_deletion_project_id, project_deletion_requested_by, project_deletion_purge_after, project_deletion_segments_deleted, project_deletion_pieces_deleted, project_deletion_api_keys_deleted, optional)
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM injuredsegments;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM bwagreements;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (rx *Rx) Create_ProjectInvoice(ctx context.Context,
+	project_invoice_id ProjectInvoice_Id_Field,
+	project_invoice_project_id ProjectInvoice_ProjectId_Field,
+	project_invoice_customer_id ProjectInvoice_CustomerId_Field,
+	project_invoice_period_start ProjectInvoice_PeriodStart_Field,
+	project_invoice_period_end ProjectInvoice_PeriodEnd_Field,
+	project_invoice_amount ProjectInvoice_Amount_Field,
+	project_invoice_status ProjectInvoice_Status_Field) (
+	project_invoice *ProjectInvoice, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.Create_ProjectInvoice(ctx, project_invoice_id, project_invoice_project_id, project_invoice_customer_id, project_invoice_period_start, project_invoice_period_end, project_invoice_amount, project_invoice_status)
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM accounting_timestamps;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
+}
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM accounting_rollups;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+func (rx *Rx) Create_ProjectMember(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member *ProjectMember, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id)
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM accounting_raws;")
-	if err != nil {
-		return 0, obj.makeErr(err)
+}
+
+func (rx *Rx) Create_ProjectStorageTally(ctx context.Context,
+	project_storage_tally_project_id ProjectStorageTally_ProjectId_Field,
+	project_storage_tally_interval_end_time ProjectStorageTally_IntervalEndTime_Field,
+	project_storage_tally_data_total ProjectStorageTally_DataTotal_Field) (
+	project_storage_tally *ProjectStorageTally, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
+	return tx.Create_ProjectStorageTally(ctx, project_storage_tally_project_id, project_storage_tally_interval_end_time, project_storage_tally_data_total)
 
-	__count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-	count += __count
+}
 
-	return count, nil
+func (rx *Rx) Create_SegmentHealth(ctx context.Context,
+	segment_health_path SegmentHealth_Path_Field,
+	optional SegmentHealth_Create_Fields) (
+	segment_health *SegmentHealth, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_SegmentHealth(ctx, segment_health_path, optional)
 
 }
 
-type Rx struct {
-	db *DB
-	tx *Tx
+func (rx *Rx) Create_Session(ctx context.Context,
+	session_id Session_Id_Field,
+	session_user_id Session_UserId_Field,
+	session_device Session_Device_Field,
+	session_address Session_Address_Field,
+	session_refresh_token_hash Session_RefreshTokenHash_Field,
+	session_expires_at Session_ExpiresAt_Field,
+	session_last_used_at Session_LastUsedAt_Field) (
+	session *Session, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_Session(ctx, session_id, session_user_id, session_device, session_address, session_refresh_token_hash, session_expires_at, session_last_used_at)
+
 }
 
-func (rx *Rx) UnsafeTx(ctx context.Context) (unsafe_tx *sql.Tx, err error) {
-	tx, err := rx.getTx(ctx)
-	if err != nil {
-		return nil, err
+func (rx *Rx) Create_User(ctx context.Context,
+	user_id User_Id_Field,
+	user_first_name User_FirstName_Field,
+	user_last_name User_LastName_Field,
+	user_password_hash User_PasswordHash_Field,
+	optional User_Create_Fields) (
+	user *User, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return tx.Tx, nil
+	return tx.Create_User(ctx, user_id, user_first_name, user_last_name, user_password_hash, optional)
+
 }
 
-func (rx *Rx) getTx(ctx context.Context) (tx *Tx, err error) {
-	if rx.tx == nil {
-		if rx.tx, err = rx.db.Open(ctx); err != nil {
-			return nil, err
-		}
+func (rx *Rx) Create_UserPayment(ctx context.Context,
+	user_payment_user_id UserPayment_UserId_Field,
+	user_payment_customer_id UserPayment_CustomerId_Field,
+	user_payment_credit UserPayment_Credit_Field) (
+	user_payment *UserPayment, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return rx.tx, nil
-}
+	return tx.Create_UserPayment(ctx, user_payment_user_id, user_payment_customer_id, user_payment_credit)
 
-func (rx *Rx) Rebind(s string) string {
-	return rx.db.Rebind(s)
 }
 
-func (rx *Rx) Commit() (err error) {
-	if rx.tx != nil {
-		err = rx.tx.Commit()
-		rx.tx = nil
+func (rx *Rx) Delete_AccountingRaw_By_Id(ctx context.Context,
+	accounting_raw_id AccountingRaw_Id_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return err
+	return tx.Delete_AccountingRaw_By_Id(ctx, accounting_raw_id)
 }
 
-func (rx *Rx) Rollback() (err error) {
-	if rx.tx != nil {
-		err = rx.tx.Rollback()
-		rx.tx = nil
+func (rx *Rx) Delete_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
 	}
-	return err
+	return tx.Delete_AccountingRollup_By_Id(ctx, accounting_rollup_id)
 }
 
-func (rx *Rx) All_AccountingRaw(ctx context.Context) (
-	rows []*AccountingRaw, err error) {
+func (rx *Rx) Delete_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_AccountingRaw(ctx)
+	return tx.Delete_ApiKey_By_Id(ctx, api_key_id)
 }
 
-func (rx *Rx) All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx context.Context,
-	accounting_raw_interval_end_time_greater_or_equal AccountingRaw_IntervalEndTime_Field) (
-	rows []*AccountingRaw, err error) {
+func (rx *Rx) Delete_AuditRecord_By_AuditedAt_Less(ctx context.Context,
+	audit_record_audited_at_less AuditRecord_AuditedAt_Field) (
+	count int64, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx, accounting_raw_interval_end_time_greater_or_equal)
+	return tx.Delete_AuditRecord_By_AuditedAt_Less(ctx, audit_record_audited_at_less)
 }
 
-func (rx *Rx) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Context,
-	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
-	rows []*AccountingRollup, err error) {
+func (rx *Rx) Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx, accounting_rollup_start_time_greater_or_equal)
+	return tx.Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name)
 }
 
-func (rx *Rx) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
-	api_key_project_id ApiKey_ProjectId_Field) (
-	rows []*ApiKey, err error) {
+func (rx *Rx) Delete_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx, api_key_project_id)
+	return tx.Delete_BucketStat_By_ProjectId_And_BucketName(ctx, bucket_stat_project_id, bucket_stat_bucket_name)
 }
 
-func (rx *Rx) All_Bwagreement(ctx context.Context) (
-	rows []*Bwagreement, err error) {
+func (rx *Rx) Delete_BucketTemplate_By_ProjectId(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_Bwagreement(ctx)
+	return tx.Delete_BucketTemplate_By_ProjectId(ctx, bucket_template_project_id)
 }
 
-func (rx *Rx) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
-	bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
-	rows []*Bwagreement, err error) {
+func (rx *Rx) Delete_Injuredsegment_By_Id(ctx context.Context,
+	injuredsegment_id Injuredsegment_Id_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_Bwagreement_By_CreatedAt_Greater(ctx, bwagreement_created_at_greater)
+	return tx.Delete_Injuredsegment_By_Id(ctx, injuredsegment_id)
 }
 
-func (rx *Rx) All_Node_Id(ctx context.Context) (
-	rows []*Id_Row, err error) {
+func (rx *Rx) Delete_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_Node_Id(ctx)
+	return tx.Delete_Injuredsegment_By_Path(ctx, injuredsegment_path)
 }
 
-func (rx *Rx) All_Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_By_AccountingRollup_StartTime_GreaterOrEqual_And_AccountingRollup_StartTime_Less_OrderBy_Asc_Node_Id(ctx context.Context,
-	accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field,
-	accounting_rollup_start_time_less AccountingRollup_StartTime_Field) (
-	rows []*Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_Row, err error) {
+func (rx *Rx) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
+	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_Node_Id_Node_CreatedAt_Node_AuditSuccessRatio_AccountingRollup_StartTime_AccountingRollup_PutTotal_AccountingRollup_GetTotal_AccountingRollup_GetAuditTotal_AccountingRollup_GetRepairTotal_AccountingRollup_PutRepairTotal_AccountingRollup_AtRestTotal_By_AccountingRollup_StartTime_GreaterOrEqual_And_AccountingRollup_StartTime_Less_OrderBy_Asc_Node_Id(ctx, accounting_rollup_start_time_greater_or_equal, accounting_rollup_start_time_less)
+	return tx.Delete_Irreparabledb_By_Segmentpath(ctx, irreparabledb_segmentpath)
 }
 
-func (rx *Rx) All_Project(ctx context.Context) (
-	rows []*Project, err error) {
+func (rx *Rx) Delete_NodePing_By_Id(ctx context.Context,
+	node_ping_id NodePing_Id_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_Project(ctx)
+	return tx.Delete_NodePing_By_Id(ctx, node_ping_id)
 }
 
-func (rx *Rx) All_ProjectMember_By_MemberId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*ProjectMember, err error) {
+func (rx *Rx) Delete_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_ProjectMember_By_MemberId(ctx, project_member_member_id)
+	return tx.Delete_Node_By_Id(ctx, node_id)
 }
 
-func (rx *Rx) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*Project, err error) {
+func (rx *Rx) Delete_ObjectTag_By_ProjectId_And_BucketName_And_EncryptedPath(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_encrypted_path ObjectTag_EncryptedPath_Field) (
+	count int64, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx, project_member_member_id)
+	return tx.Delete_ObjectTag_By_ProjectId_And_BucketName_And_EncryptedPath(ctx, object_tag_project_id, object_tag_bucket_name, object_tag_encrypted_path)
 }
 
-func (rx *Rx) Create_AccountingRaw(ctx context.Context,
-	accounting_raw_node_id AccountingRaw_NodeId_Field,
-	accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
-	accounting_raw_data_total AccountingRaw_DataTotal_Field,
-	accounting_raw_data_type AccountingRaw_DataType_Field) (
-	accounting_raw *AccountingRaw, err error) {
+func (rx *Rx) Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
+	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_AccountingRaw(ctx, accounting_raw_node_id, accounting_raw_interval_end_time, accounting_raw_data_total, accounting_raw_data_type)
-
+	return tx.Delete_OverlayCacheNode_By_NodeId(ctx, overlay_cache_node_node_id)
 }
 
-func (rx *Rx) Create_AccountingRollup(ctx context.Context,
-	accounting_rollup_node_id AccountingRollup_NodeId_Field,
-	accounting_rollup_start_time AccountingRollup_StartTime_Field,
-	accounting_rollup_put_total AccountingRollup_PutTotal_Field,
-	accounting_rollup_get_total AccountingRollup_GetTotal_Field,
-	accounting_rollup_get_audit_total AccountingRollup_GetAuditTotal_Field,
-	accounting_rollup_get_repair_total AccountingRollup_GetRepairTotal_Field,
-	accounting_rollup_put_repair_total AccountingRollup_PutRepairTotal_Field,
-	accounting_rollup_at_rest_total AccountingRollup_AtRestTotal_Field) (
-	accounting_rollup *AccountingRollup, err error) {
+func (rx *Rx) Delete_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_AccountingRollup(ctx, accounting_rollup_node_id, accounting_rollup_start_time, accounting_rollup_put_total, accounting_rollup_get_total, accounting_rollup_get_audit_total, accounting_rollup_get_repair_total, accounting_rollup_put_repair_total, accounting_rollup_at_rest_total)
-
+	return tx.Delete_ProjectDeletion_By_ProjectId(ctx, project_deletion_project_id)
 }
 
-func (rx *Rx) Create_AccountingTimestamps(ctx context.Context,
-	accounting_timestamps_name AccountingTimestamps_Name_Field,
-	accounting_timestamps_value AccountingTimestamps_Value_Field) (
-	accounting_timestamps *AccountingTimestamps, err error) {
+func (rx *Rx) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_AccountingTimestamps(ctx, accounting_timestamps_name, accounting_timestamps_value)
+	return tx.Delete_ProjectMember_By_MemberId_And_ProjectId(ctx, project_member_member_id, project_member_project_id)
+}
 
+func (rx *Rx) Delete_Project_By_Id(ctx context.Context,
+	project_id Project_Id_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_Project_By_Id(ctx, project_id)
 }
 
-func (rx *Rx) Create_ApiKey(ctx context.Context,
-	api_key_id ApiKey_Id_Field,
-	api_key_project_id ApiKey_ProjectId_Field,
-	api_key_key ApiKey_Key_Field,
-	api_key_name ApiKey_Name_Field) (
-	api_key *ApiKey, err error) {
+func (rx *Rx) Delete_Session_By_Id(ctx context.Context,
+	session_id Session_Id_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_ApiKey(ctx, api_key_id, api_key_project_id, api_key_key, api_key_name)
+	return tx.Delete_Session_By_Id(ctx, session_id)
+}
 
+func (rx *Rx) Delete_Session_By_UserId(ctx context.Context,
+	session_user_id Session_UserId_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_Session_By_UserId(ctx, session_user_id)
 }
 
-func (rx *Rx) Create_Bwagreement(ctx context.Context,
-	bwagreement_serialnum Bwagreement_Serialnum_Field,
-	bwagreement_storage_node_id Bwagreement_StorageNodeId_Field,
-	bwagreement_uplink_id Bwagreement_UplinkId_Field,
-	bwagreement_action Bwagreement_Action_Field,
-	bwagreement_total Bwagreement_Total_Field,
-	bwagreement_expires_at Bwagreement_ExpiresAt_Field) (
-	bwagreement *Bwagreement, err error) {
+func (rx *Rx) Delete_User_By_Id(ctx context.Context,
+	user_id User_Id_Field) (
+	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Bwagreement(ctx, bwagreement_serialnum, bwagreement_storage_node_id, bwagreement_uplink_id, bwagreement_action, bwagreement_total, bwagreement_expires_at)
+	return tx.Delete_User_By_Id(ctx, user_id)
+}
 
+func (rx *Rx) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
+	accounting_timestamps_name AccountingTimestamps_Name_Field) (
+	row *Value_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Find_AccountingTimestamps_Value_By_Name(ctx, accounting_timestamps_name)
 }
 
-func (rx *Rx) Create_Injuredsegment(ctx context.Context,
-	injuredsegment_info Injuredsegment_Info_Field) (
+func (rx *Rx) First_Injuredsegment_OrderBy_Asc_Priority_Id(ctx context.Context) (
 	injuredsegment *Injuredsegment, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Injuredsegment(ctx, injuredsegment_info)
-
+	return tx.First_Injuredsegment_OrderBy_Asc_Priority_Id(ctx)
 }
 
-func (rx *Rx) Create_Irreparabledb(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
-	irreparabledb_segmentdetail Irreparabledb_Segmentdetail_Field,
-	irreparabledb_pieces_lost_count Irreparabledb_PiecesLostCount_Field,
-	irreparabledb_seg_damaged_unix_sec Irreparabledb_SegDamagedUnixSec_Field,
-	irreparabledb_repair_attempt_count Irreparabledb_RepairAttemptCount_Field) (
-	irreparabledb *Irreparabledb, err error) {
+func (rx *Rx) First_Injuredsegment_By_LeasedUntil_Less_OrderBy_Asc_Priority_Id(ctx context.Context,
+	injuredsegment_leased_until_less Injuredsegment_LeasedUntil_Field) (
+	injuredsegment *Injuredsegment, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Irreparabledb(ctx, irreparabledb_segmentpath, irreparabledb_segmentdetail, irreparabledb_pieces_lost_count, irreparabledb_seg_damaged_unix_sec, irreparabledb_repair_attempt_count)
-
+	return tx.First_Injuredsegment_By_LeasedUntil_Less_OrderBy_Asc_Priority_Id(ctx, injuredsegment_leased_until_less)
 }
 
-func (rx *Rx) Create_Node(ctx context.Context,
-	node_id Node_Id_Field,
-	node_audit_success_count Node_AuditSuccessCount_Field,
-	node_total_audit_count Node_TotalAuditCount_Field,
-	node_audit_success_ratio Node_AuditSuccessRatio_Field,
-	node_uptime_success_count Node_UptimeSuccessCount_Field,
-	node_total_uptime_count Node_TotalUptimeCount_Field,
-	node_uptime_ratio Node_UptimeRatio_Field) (
-	node *Node, err error) {
+func (rx *Rx) Get_AccountingRaw_By_Id(ctx context.Context,
+	accounting_raw_id AccountingRaw_Id_Field) (
+	accounting_raw *AccountingRaw, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Node(ctx, node_id, node_audit_success_count, node_total_audit_count, node_audit_success_ratio, node_uptime_success_count, node_total_uptime_count, node_uptime_ratio)
-
+	return tx.Get_AccountingRaw_By_Id(ctx, accounting_raw_id)
 }
 
-func (rx *Rx) Create_OverlayCacheNode(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
-	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
-	overlay_cache_node_address OverlayCacheNode_Address_Field,
-	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
-	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
-	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
-	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
-	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
-	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
-	overlay_cache_node_audit_success_ratio OverlayCacheNode_AuditSuccessRatio_Field,
-	overlay_cache_node_audit_uptime_ratio OverlayCacheNode_AuditUptimeRatio_Field,
-	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
-	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
-	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
-	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
-	overlay_cache_node *OverlayCacheNode, err error) {
+func (rx *Rx) Get_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	accounting_rollup *AccountingRollup, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_OverlayCacheNode(ctx, overlay_cache_node_node_id, overlay_cache_node_node_type, overlay_cache_node_address, overlay_cache_node_protocol, overlay_cache_node_operator_email, overlay_cache_node_operator_wallet, overlay_cache_node_free_bandwidth, overlay_cache_node_free_disk, overlay_cache_node_latency_90, overlay_cache_node_audit_success_ratio, overlay_cache_node_audit_uptime_ratio, overlay_cache_node_audit_count, overlay_cache_node_audit_success_count, overlay_cache_node_uptime_count, overlay_cache_node_uptime_success_count)
-
+	return tx.Get_AccountingRollup_By_Id(ctx, accounting_rollup_id)
 }
 
-func (rx *Rx) Create_Project(ctx context.Context,
-	project_id Project_Id_Field,
-	project_name Project_Name_Field,
-	project_description Project_Description_Field) (
-	project *Project, err error) {
+func (rx *Rx) Get_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
+	api_key *ApiKey, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Project(ctx, project_id, project_name, project_description)
-
+	return tx.Get_ApiKey_By_Id(ctx, api_key_id)
 }
 
-func (rx *Rx) Create_ProjectMember(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
-	project_member *ProjectMember, err error) {
+func (rx *Rx) Get_ApiKey_By_Key(ctx context.Context,
+	api_key_key ApiKey_Key_Field) (
+	api_key *ApiKey, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id)
-
+	return tx.Get_ApiKey_By_Key(ctx, api_key_key)
 }
 
-func (rx *Rx) Create_User(ctx context.Context,
-	user_id User_Id_Field,
-	user_first_name User_FirstName_Field,
-	user_last_name User_LastName_Field,
-	user_password_hash User_PasswordHash_Field,
-	optional User_Create_Fields) (
-	user *User, err error) {
+func (rx *Rx) Get_BucketAttribution_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_attribution_project_id BucketAttribution_ProjectId_Field,
+	bucket_attribution_bucket_name BucketAttribution_BucketName_Field) (
+	bucket_attribution *BucketAttribution, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_User(ctx, user_id, user_first_name, user_last_name, user_password_hash, optional)
-
+	return tx.Get_BucketAttribution_By_ProjectId_And_BucketName(ctx, bucket_attribution_project_id, bucket_attribution_bucket_name)
 }
 
-func (rx *Rx) Delete_AccountingRaw_By_Id(ctx context.Context,
-	accounting_raw_id AccountingRaw_Id_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_AccountingRaw_By_Id(ctx, accounting_raw_id)
+	return tx.Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name)
 }
 
-func (rx *Rx) Delete_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Get_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field) (
+	bucket_stat *BucketStat, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_AccountingRollup_By_Id(ctx, accounting_rollup_id)
+	return tx.Get_BucketStat_By_ProjectId_And_BucketName(ctx, bucket_stat_project_id, bucket_stat_bucket_name)
 }
 
-func (rx *Rx) Delete_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Get_BucketTemplate_By_ProjectId(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field) (
+	bucket_template *BucketTemplate, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_ApiKey_By_Id(ctx, api_key_id)
+	return tx.Get_BucketTemplate_By_ProjectId(ctx, bucket_template_project_id)
 }
 
-func (rx *Rx) Delete_Injuredsegment_By_Id(ctx context.Context,
-	injuredsegment_id Injuredsegment_Id_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Get_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	injuredsegment *Injuredsegment, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_Injuredsegment_By_Id(ctx, injuredsegment_id)
+	return tx.Get_Injuredsegment_By_Path(ctx, injuredsegment_path)
 }
 
-func (rx *Rx) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
+func (rx *Rx) Get_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
-	deleted bool, err error) {
+	irreparabledb *Irreparabledb, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_Irreparabledb_By_Segmentpath(ctx, irreparabledb_segmentpath)
+	return tx.Get_Irreparabledb_By_Segmentpath(ctx, irreparabledb_segmentpath)
 }
 
-func (rx *Rx) Delete_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Get_NodeStanding_By_Id(ctx context.Context,
+	node_standing_id NodeStanding_Id_Field) (
+	node_standing *NodeStanding, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_Node_By_Id(ctx, node_id)
+	return tx.Get_NodeStanding_By_Id(ctx, node_standing_id)
 }
 
-func (rx *Rx) Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
-	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Get_ProjectInvoice_By_Id(ctx context.Context,
+	project_invoice_id ProjectInvoice_Id_Field) (
+	project_invoice *ProjectInvoice, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_OverlayCacheNode_By_NodeId(ctx, overlay_cache_node_node_id)
+	return tx.Get_ProjectInvoice_By_Id(ctx, project_invoice_id)
 }
 
-func (rx *Rx) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Get_ProjectInvoice_By_ProjectId_And_PeriodStart(ctx context.Context,
+	project_invoice_project_id ProjectInvoice_ProjectId_Field,
+	project_invoice_period_start ProjectInvoice_PeriodStart_Field) (
+	project_invoice *ProjectInvoice, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_ProjectMember_By_MemberId_And_ProjectId(ctx, project_member_member_id, project_member_project_id)
+	return tx.Get_ProjectInvoice_By_ProjectId_And_PeriodStart(ctx, project_invoice_project_id, project_invoice_period_start)
 }
 
-func (rx *Rx) Delete_Project_By_Id(ctx context.Context,
-	project_id Project_Id_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Get_SegmentHealth_By_Path(ctx context.Context,
+	segment_health_path SegmentHealth_Path_Field) (
+	segment_health *SegmentHealth, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_Project_By_Id(ctx, project_id)
+	return tx.Get_SegmentHealth_By_Path(ctx, segment_health_path)
 }
 
-func (rx *Rx) Delete_User_By_Id(ctx context.Context,
-	user_id User_Id_Field) (
-	deleted bool, err error) {
+func (rx *Rx) Get_Session_By_Id(ctx context.Context,
+	session_id Session_Id_Field) (
+	session *Session, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_User_By_Id(ctx, user_id)
+	return tx.Get_Session_By_Id(ctx, session_id)
 }
 
-func (rx *Rx) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
-	accounting_timestamps_name AccountingTimestamps_Name_Field) (
-	row *Value_Row, err error) {
+func (rx *Rx) Get_UserPayment_By_UserId(ctx context.Context,
+	user_payment_user_id UserPayment_UserId_Field) (
+	user_payment *UserPayment, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Find_AccountingTimestamps_Value_By_Name(ctx, accounting_timestamps_name)
+	return tx.Get_UserPayment_By_UserId(ctx, user_payment_user_id)
 }
 
-func (rx *Rx) First_Injuredsegment(ctx context.Context) (
-	injuredsegment *Injuredsegment, err error) {
+func (rx *Rx) Limited_AuditRecord_By_NodeId_And_AuditedAt_GreaterOrEqual_OrderBy_Desc_AuditedAt(ctx context.Context,
+	audit_record_node_id AuditRecord_NodeId_Field,
+	audit_record_audited_at_greater_or_equal AuditRecord_AuditedAt_Field,
+	limit int, offset int64) (
+	rows []*AuditRecord, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.First_Injuredsegment(ctx)
+	return tx.Limited_AuditRecord_By_NodeId_And_AuditedAt_GreaterOrEqual_OrderBy_Desc_AuditedAt(ctx, audit_record_node_id, audit_record_audited_at_greater_or_equal, limit, offset)
 }
 
-func (rx *Rx) Get_AccountingRaw_By_Id(ctx context.Context,
-	accounting_raw_id AccountingRaw_Id_Field) (
-	accounting_raw *AccountingRaw, err error) {
+func (rx *Rx) Limited_AuthEvent_By_UserId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	auth_event_user_id AuthEvent_UserId_Field,
+	limit int, offset int64) (
+	rows []*AuthEvent, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Get_AccountingRaw_By_Id(ctx, accounting_raw_id)
+	return tx.Limited_AuthEvent_By_UserId_OrderBy_Desc_CreatedAt(ctx, auth_event_user_id, limit, offset)
 }
 
-func (rx *Rx) Get_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
-	accounting_rollup *AccountingRollup, err error) {
+func (rx *Rx) Limited_Irreparabledb_By_ProjectId_OrderBy_Asc_Segmentpath(ctx context.Context,
+	irreparabledb_project_id Irreparabledb_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*Irreparabledb, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Get_AccountingRollup_By_Id(ctx, accounting_rollup_id)
+	return tx.Limited_Irreparabledb_By_ProjectId_OrderBy_Asc_Segmentpath(ctx, irreparabledb_project_id, limit, offset)
 }
 
-func (rx *Rx) Get_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
-	api_key *ApiKey, err error) {
+func (rx *Rx) Limited_Irreparabledb_By_SegDamagedUnixSec_Less_OrderBy_Asc_SegDamagedUnixSec(ctx context.Context,
+	irreparabledb_seg_damaged_unix_sec_less Irreparabledb_SegDamagedUnixSec_Field,
+	limit int, offset int64) (
+	rows []*Irreparabledb, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Get_ApiKey_By_Id(ctx, api_key_id)
+	return tx.Limited_Irreparabledb_By_SegDamagedUnixSec_Less_OrderBy_Asc_SegDamagedUnixSec(ctx, irreparabledb_seg_damaged_unix_sec_less, limit, offset)
 }
 
-func (rx *Rx) Get_ApiKey_By_Key(ctx context.Context,
-	api_key_key ApiKey_Key_Field) (
-	api_key *ApiKey, err error) {
+func (rx *Rx) Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_OrderBy_Asc_EncryptedPath(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_tag_key ObjectTag_TagKey_Field,
+	object_tag_tag_value ObjectTag_TagValue_Field,
+	limit int, offset int64) (
+	rows []*ObjectTag, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Get_ApiKey_By_Key(ctx, api_key_key)
+	return tx.Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_OrderBy_Asc_EncryptedPath(ctx, object_tag_project_id, object_tag_bucket_name, object_tag_tag_key, object_tag_tag_value, limit, offset)
 }
 
-func (rx *Rx) Get_Irreparabledb_By_Segmentpath(ctx context.Context,
-	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
-	irreparabledb *Irreparabledb, err error) {
+func (rx *Rx) Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_GreaterOrEqual_And_TagValue_Less_OrderBy_Asc_TagValue_Asc_EncryptedPath(ctx context.Context,
+	object_tag_project_id ObjectTag_ProjectId_Field,
+	object_tag_bucket_name ObjectTag_BucketName_Field,
+	object_tag_tag_key ObjectTag_TagKey_Field,
+	object_tag_tag_value_greater_or_equal ObjectTag_TagValue_Field,
+	object_tag_tag_value_less ObjectTag_TagValue_Field,
+	limit int, offset int64) (
+	rows []*ObjectTag, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Get_Irreparabledb_By_Segmentpath(ctx, irreparabledb_segmentpath)
+	return tx.Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_GreaterOrEqual_And_TagValue_Less_OrderBy_Asc_TagValue_Asc_EncryptedPath(ctx, object_tag_project_id, object_tag_bucket_name, object_tag_tag_key, object_tag_tag_value_greater_or_equal, object_tag_tag_value_less, limit, offset)
+}
+
+func (rx *Rx) Get_NodePing_By_Id(ctx context.Context,
+	node_ping_id NodePing_Id_Field) (
+	node_ping *NodePing, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_NodePing_By_Id(ctx, node_ping_id)
 }
 
 func (rx *Rx) Get_Node_By_Id(ctx context.Context,
@@ -7164,6 +16040,16 @@ func (rx *Rx) Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx context.Context,
 	return tx.Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx, overlay_cache_node_node_id)
 }
 
+func (rx *Rx) Get_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+	project_deletion *ProjectDeletion, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_ProjectDeletion_By_ProjectId(ctx, project_deletion_project_id)
+}
+
 func (rx *Rx) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
@@ -7204,14 +16090,14 @@ func (rx *Rx) Limited_Bwagreement(ctx context.Context,
 	return tx.Limited_Bwagreement(ctx, limit, offset)
 }
 
-func (rx *Rx) Limited_Injuredsegment(ctx context.Context,
+func (rx *Rx) Limited_Injuredsegment_OrderBy_Asc_Priority_Id(ctx context.Context,
 	limit int, offset int64) (
 	rows []*Injuredsegment, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Limited_Injuredsegment(ctx, limit, offset)
+	return tx.Limited_Injuredsegment_OrderBy_Asc_Priority_Id(ctx, limit, offset)
 }
 
 func (rx *Rx) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx context.Context,
@@ -7258,6 +16144,52 @@ func (rx *Rx) Update_ApiKey_By_Id(ctx context.Context,
 	return tx.Update_ApiKey_By_Id(ctx, api_key_id, update)
 }
 
+func (rx *Rx) Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	update BucketLifecycle_Update_Fields) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name, update)
+}
+
+func (rx *Rx) Update_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_stat_project_id BucketStat_ProjectId_Field,
+	bucket_stat_bucket_name BucketStat_BucketName_Field,
+	update BucketStat_Update_Fields) (
+	bucket_stat *BucketStat, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_BucketStat_By_ProjectId_And_BucketName(ctx, bucket_stat_project_id, bucket_stat_bucket_name, update)
+}
+
+func (rx *Rx) Update_BucketTemplate_By_ProjectId(ctx context.Context,
+	bucket_template_project_id BucketTemplate_ProjectId_Field,
+	update BucketTemplate_Update_Fields) (
+	bucket_template *BucketTemplate, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_BucketTemplate_By_ProjectId(ctx, bucket_template_project_id, update)
+}
+
+func (rx *Rx) Update_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field,
+	update Injuredsegment_Update_Fields) (
+	injuredsegment *Injuredsegment, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_Injuredsegment_By_Path(ctx, injuredsegment_path, update)
+}
+
 func (rx *Rx) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 	update Irreparabledb_Update_Fields) (
@@ -7269,6 +16201,28 @@ func (rx *Rx) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 	return tx.Update_Irreparabledb_By_Segmentpath(ctx, irreparabledb_segmentpath, update)
 }
 
+func (rx *Rx) Update_NodePing_By_Id(ctx context.Context,
+	node_ping_id NodePing_Id_Field,
+	update NodePing_Update_Fields) (
+	node_ping *NodePing, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_NodePing_By_Id(ctx, node_ping_id, update)
+}
+
+func (rx *Rx) Update_NodeStanding_By_Id(ctx context.Context,
+	node_standing_id NodeStanding_Id_Field,
+	update NodeStanding_Update_Fields) (
+	node_standing *NodeStanding, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_NodeStanding_By_Id(ctx, node_standing_id, update)
+}
+
 func (rx *Rx) Update_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
@@ -7291,6 +16245,28 @@ func (rx *Rx) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 	return tx.Update_OverlayCacheNode_By_NodeId(ctx, overlay_cache_node_node_id, update)
 }
 
+func (rx *Rx) Update_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	update ProjectDeletion_Update_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectDeletion_By_ProjectId(ctx, project_deletion_project_id, update)
+}
+
+func (rx *Rx) Update_ProjectInvoice_By_Id(ctx context.Context,
+	project_invoice_id ProjectInvoice_Id_Field,
+	update ProjectInvoice_Update_Fields) (
+	project_invoice *ProjectInvoice, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectInvoice_By_Id(ctx, project_invoice_id, update)
+}
+
 func (rx *Rx) Update_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field,
 	update Project_Update_Fields) (
@@ -7302,6 +16278,39 @@ func (rx *Rx) Update_Project_By_Id(ctx context.Context,
 	return tx.Update_Project_By_Id(ctx, project_id, update)
 }
 
+func (rx *Rx) Update_SegmentHealth_By_Path(ctx context.Context,
+	segment_health_path SegmentHealth_Path_Field,
+	update SegmentHealth_Update_Fields) (
+	segment_health *SegmentHealth, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_SegmentHealth_By_Path(ctx, segment_health_path, update)
+}
+
+func (rx *Rx) Update_Session_By_Id(ctx context.Context,
+	session_id Session_Id_Field,
+	update Session_Update_Fields) (
+	session *Session, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_Session_By_Id(ctx, session_id, update)
+}
+
+func (rx *Rx) Update_UserPayment_By_UserId(ctx context.Context,
+	user_payment_user_id UserPayment_UserId_Field,
+	update UserPayment_Update_Fields) (
+	user_payment *UserPayment, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_UserPayment_By_UserId(ctx, user_payment_user_id, update)
+}
+
 func (rx *Rx) Update_User_By_Id(ctx context.Context,
 	user_id User_Id_Field,
 	update User_Update_Fields) (
@@ -7325,10 +16334,20 @@ type Methods interface {
 		accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
 		rows []*AccountingRollup, err error)
 
+	All_AccountingRollup_By_NodeId_And_StartTime_GreaterOrEqual_And_StartTime_Less_OrderBy_Asc_StartTime(ctx context.Context,
+		accounting_rollup_node_id AccountingRollup_NodeId_Field,
+		accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field,
+		accounting_rollup_start_time_less AccountingRollup_StartTime_Field) (
+		rows []*AccountingRollup, err error)
+
 	All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 		api_key_project_id ApiKey_ProjectId_Field) (
 		rows []*ApiKey, err error)
 
+	All_BucketStat_By_ProjectId_OrderBy_Asc_BucketName(ctx context.Context,
+		bucket_stat_project_id BucketStat_ProjectId_Field) (
+		rows []*BucketStat, err error)
+
 	All_Bwagreement(ctx context.Context) (
 		rows []*Bwagreement, err error)
 
@@ -7347,6 +16366,10 @@ type Methods interface {
 	All_Project(ctx context.Context) (
 		rows []*Project, err error)
 
+	All_ProjectDeletion_By_PurgeAfter_Less_And_PurgedAt_Is_Null(ctx context.Context,
+		project_deletion_purge_after_less ProjectDeletion_PurgeAfter_Field) (
+		rows []*ProjectDeletion, err error)
+
 	All_ProjectMember_By_MemberId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field) (
 		rows []*ProjectMember, err error)
@@ -7355,6 +16378,10 @@ type Methods interface {
 		project_member_member_id ProjectMember_MemberId_Field) (
 		rows []*Project, err error)
 
+	All_Session_By_UserId_OrderBy_Desc_LastUsedAt(ctx context.Context,
+		session_user_id Session_UserId_Field) (
+		rows []*Session, err error)
+
 	Create_AccountingRaw(ctx context.Context,
 		accounting_raw_node_id AccountingRaw_NodeId_Field,
 		accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
@@ -7378,6 +16405,15 @@ type Methods interface {
 		accounting_timestamps_value AccountingTimestamps_Value_Field) (
 		accounting_timestamps *AccountingTimestamps, err error)
 
+	Create_AccountingViolation(ctx context.Context,
+		accounting_violation_name AccountingViolation_Name_Field,
+		accounting_violation_interval_start AccountingViolation_IntervalStart_Field,
+		accounting_violation_interval_end AccountingViolation_IntervalEnd_Field,
+		accounting_violation_expected AccountingViolation_Expected_Field,
+		accounting_violation_actual AccountingViolation_Actual_Field,
+		accounting_violation_details AccountingViolation_Details_Field) (
+		accounting_violation *AccountingViolation, err error)
+
 	Create_ApiKey(ctx context.Context,
 		api_key_id ApiKey_Id_Field,
 		api_key_project_id ApiKey_ProjectId_Field,
@@ -7385,6 +16421,63 @@ type Methods interface {
 		api_key_name ApiKey_Name_Field) (
 		api_key *ApiKey, err error)
 
+	Create_AuditRecord(ctx context.Context,
+		audit_record_node_id AuditRecord_NodeId_Field,
+		audit_record_audited_at AuditRecord_AuditedAt_Field,
+		audit_record_segment_hash AuditRecord_SegmentHash_Field,
+		audit_record_stripe_index AuditRecord_StripeIndex_Field,
+		audit_record_piece_num AuditRecord_PieceNum_Field,
+		audit_record_outcome AuditRecord_Outcome_Field,
+		audit_record_error AuditRecord_Error_Field,
+		audit_record_duration_ms AuditRecord_DurationMs_Field) (
+		audit_record *AuditRecord, err error)
+
+	Create_AuthEvent(ctx context.Context,
+		auth_event_user_id AuthEvent_UserId_Field,
+		auth_event_session_id AuthEvent_SessionId_Field,
+		auth_event_kind AuthEvent_Kind_Field,
+		auth_event_device AuthEvent_Device_Field,
+		auth_event_address AuthEvent_Address_Field) (
+		auth_event *AuthEvent, err error)
+
+	Create_BucketAttribution(ctx context.Context,
+		bucket_attribution_project_id BucketAttribution_ProjectId_Field,
+		bucket_attribution_bucket_name BucketAttribution_BucketName_Field,
+		bucket_attribution_partner_id BucketAttribution_PartnerId_Field) (
+		bucket_attribution *BucketAttribution, err error)
+
+	Create_BucketLifecycle(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+		bucket_lifecycle_expire_after_days BucketLifecycle_ExpireAfterDays_Field,
+		bucket_lifecycle_versions_prefix BucketLifecycle_VersionsPrefix_Field,
+		bucket_lifecycle_versions_expire_after_days BucketLifecycle_VersionsExpireAfterDays_Field,
+		bucket_lifecycle_updated_at BucketLifecycle_UpdatedAt_Field) (
+		bucket_lifecycle *BucketLifecycle, err error)
+
+	Create_BucketStat(ctx context.Context,
+		bucket_stat_project_id BucketStat_ProjectId_Field,
+		bucket_stat_bucket_name BucketStat_BucketName_Field,
+		bucket_stat_object_count BucketStat_ObjectCount_Field,
+		bucket_stat_total_bytes BucketStat_TotalBytes_Field,
+		bucket_stat_updated_at BucketStat_UpdatedAt_Field) (
+		bucket_stat *BucketStat, err error)
+
+	Create_BucketTemplate(ctx context.Context,
+		bucket_template_project_id BucketTemplate_ProjectId_Field,
+		bucket_template_path_cipher BucketTemplate_PathCipher_Field,
+		bucket_template_data_cipher BucketTemplate_DataCipher_Field,
+		bucket_template_block_size BucketTemplate_BlockSize_Field,
+		bucket_template_share_size BucketTemplate_ShareSize_Field,
+		bucket_template_required_shares BucketTemplate_RequiredShares_Field,
+		bucket_template_repair_shares BucketTemplate_RepairShares_Field,
+		bucket_template_optimal_shares BucketTemplate_OptimalShares_Field,
+		bucket_template_total_shares BucketTemplate_TotalShares_Field,
+		bucket_template_distinct_subnets BucketTemplate_DistinctSubnets_Field,
+		bucket_template_distinct_regions BucketTemplate_DistinctRegions_Field,
+		bucket_template_updated_at BucketTemplate_UpdatedAt_Field) (
+		bucket_template *BucketTemplate, err error)
+
 	Create_Bwagreement(ctx context.Context,
 		bwagreement_serialnum Bwagreement_Serialnum_Field,
 		bwagreement_storage_node_id Bwagreement_StorageNodeId_Field,
@@ -7395,15 +16488,21 @@ type Methods interface {
 		bwagreement *Bwagreement, err error)
 
 	Create_Injuredsegment(ctx context.Context,
-		injuredsegment_info Injuredsegment_Info_Field) (
+		injuredsegment_path Injuredsegment_Path_Field,
+		injuredsegment_priority Injuredsegment_Priority_Field,
+		injuredsegment_info Injuredsegment_Info_Field,
+		injuredsegment_attempts Injuredsegment_Attempts_Field,
+		injuredsegment_leased_until Injuredsegment_LeasedUntil_Field) (
 		injuredsegment *Injuredsegment, err error)
 
 	Create_Irreparabledb(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
+		irreparabledb_project_id Irreparabledb_ProjectId_Field,
 		irreparabledb_segmentdetail Irreparabledb_Segmentdetail_Field,
 		irreparabledb_pieces_lost_count Irreparabledb_PiecesLostCount_Field,
 		irreparabledb_seg_damaged_unix_sec Irreparabledb_SegDamagedUnixSec_Field,
-		irreparabledb_repair_attempt_count Irreparabledb_RepairAttemptCount_Field) (
+		irreparabledb_repair_attempt_count Irreparabledb_RepairAttemptCount_Field,
+		irreparabledb_last_error Irreparabledb_LastError_Field) (
 		irreparabledb *Irreparabledb, err error)
 
 	Create_Node(ctx context.Context,
@@ -7411,18 +16510,48 @@ type Methods interface {
 		node_audit_success_count Node_AuditSuccessCount_Field,
 		node_total_audit_count Node_TotalAuditCount_Field,
 		node_audit_success_ratio Node_AuditSuccessRatio_Field,
+		node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+		node_audit_reputation_beta Node_AuditReputationBeta_Field,
 		node_uptime_success_count Node_UptimeSuccessCount_Field,
 		node_total_uptime_count Node_TotalUptimeCount_Field,
-		node_uptime_ratio Node_UptimeRatio_Field) (
+		node_uptime_ratio Node_UptimeRatio_Field,
+		node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+		node_uptime_reputation_beta Node_UptimeReputationBeta_Field) (
 		node *Node, err error)
 
+	Create_NodePing(ctx context.Context,
+		node_ping_id NodePing_Id_Field,
+		node_ping_success_rate NodePing_SuccessRate_Field,
+		node_ping_ping_count NodePing_PingCount_Field,
+		node_ping_last_ping_at NodePing_LastPingAt_Field,
+		optional NodePing_Create_Fields) (
+		node_ping *NodePing, err error)
+
+	Create_NodeStanding(ctx context.Context,
+		node_standing_id NodeStanding_Id_Field,
+		node_standing_status NodeStanding_Status_Field,
+		node_standing_reason NodeStanding_Reason_Field,
+		node_standing_since NodeStanding_Since_Field) (
+		node_standing *NodeStanding, err error)
+
+	Create_ObjectTag(ctx context.Context,
+		object_tag_project_id ObjectTag_ProjectId_Field,
+		object_tag_bucket_name ObjectTag_BucketName_Field,
+		object_tag_tag_key ObjectTag_TagKey_Field,
+		object_tag_tag_value ObjectTag_TagValue_Field,
+		object_tag_encrypted_path ObjectTag_EncryptedPath_Field) (
+		object_tag *ObjectTag, err error)
+
 	Create_OverlayCacheNode(ctx context.Context,
 		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
 		overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
 		overlay_cache_node_address OverlayCacheNode_Address_Field,
 		overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+		overlay_cache_node_last_net OverlayCacheNode_LastNet_Field,
 		overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
 		overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+		overlay_cache_node_operator_region OverlayCacheNode_OperatorRegion_Field,
+		overlay_cache_node_version OverlayCacheNode_Version_Field,
 		overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
 		overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
 		overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
@@ -7431,20 +16560,74 @@ type Methods interface {
 		overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
 		overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
 		overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
-		overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
+		overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+		overlay_cache_node_audit_reputation_score OverlayCacheNode_AuditReputationScore_Field,
+		overlay_cache_node_uptime_reputation_score OverlayCacheNode_UptimeReputationScore_Field) (
 		overlay_cache_node *OverlayCacheNode, err error)
 
+	Create_PartnerSerial(ctx context.Context,
+		partner_serial_serial_number PartnerSerial_SerialNumber_Field,
+		partner_serial_partner_id PartnerSerial_PartnerId_Field) (
+		partner_serial *PartnerSerial, err error)
+
+	Create_PartnerStorageTally(ctx context.Context,
+		partner_storage_tally_partner_id PartnerStorageTally_PartnerId_Field,
+		partner_storage_tally_interval_end_time PartnerStorageTally_IntervalEndTime_Field,
+		partner_storage_tally_data_total PartnerStorageTally_DataTotal_Field) (
+		partner_storage_tally *PartnerStorageTally, err error)
+
 	Create_Project(ctx context.Context,
 		project_id Project_Id_Field,
 		project_name Project_Name_Field,
 		project_description Project_Description_Field) (
 		project *Project, err error)
 
+	Create_ProjectDeletion(ctx context.Context,
+		project_deletion_project_id ProjectDeletion_ProjectId_Field,
+		project_deletion_requested_by ProjectDeletion_RequestedBy_Field,
+		project_deletion_purge_after ProjectDeletion_PurgeAfter_Field,
+		project_deletion_segments_deleted ProjectDeletion_SegmentsDeleted_Field,
+		project_deletion_pieces_deleted ProjectDeletion_PiecesDeleted_Field,
+		project_deletion_api_keys_deleted ProjectDeletion_ApiKeysDeleted_Field,
+		optional ProjectDeletion_Create_Fields) (
+		project_deletion *ProjectDeletion, err error)
+
+	Create_ProjectInvoice(ctx context.Context,
+		project_invoice_id ProjectInvoice_Id_Field,
+		project_invoice_project_id ProjectInvoice_ProjectId_Field,
+		project_invoice_customer_id ProjectInvoice_CustomerId_Field,
+		project_invoice_period_start ProjectInvoice_PeriodStart_Field,
+		project_invoice_period_end ProjectInvoice_PeriodEnd_Field,
+		project_invoice_amount ProjectInvoice_Amount_Field,
+		project_invoice_status ProjectInvoice_Status_Field) (
+		project_invoice *ProjectInvoice, err error)
+
 	Create_ProjectMember(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
 		project_member *ProjectMember, err error)
 
+	Create_ProjectStorageTally(ctx context.Context,
+		project_storage_tally_project_id ProjectStorageTally_ProjectId_Field,
+		project_storage_tally_interval_end_time ProjectStorageTally_IntervalEndTime_Field,
+		project_storage_tally_data_total ProjectStorageTally_DataTotal_Field) (
+		project_storage_tally *ProjectStorageTally, err error)
+
+	Create_SegmentHealth(ctx context.Context,
+		segment_health_path SegmentHealth_Path_Field,
+		optional SegmentHealth_Create_Fields) (
+		segment_health *SegmentHealth, err error)
+
+	Create_Session(ctx context.Context,
+		session_id Session_Id_Field,
+		session_user_id Session_UserId_Field,
+		session_device Session_Device_Field,
+		session_address Session_Address_Field,
+		session_refresh_token_hash Session_RefreshTokenHash_Field,
+		session_expires_at Session_ExpiresAt_Field,
+		session_last_used_at Session_LastUsedAt_Field) (
+		session *Session, err error)
+
 	Create_User(ctx context.Context,
 		user_id User_Id_Field,
 		user_first_name User_FirstName_Field,
@@ -7453,6 +16636,12 @@ type Methods interface {
 		optional User_Create_Fields) (
 		user *User, err error)
 
+	Create_UserPayment(ctx context.Context,
+		user_payment_user_id UserPayment_UserId_Field,
+		user_payment_customer_id UserPayment_CustomerId_Field,
+		user_payment_credit UserPayment_Credit_Field) (
+		user_payment *UserPayment, err error)
+
 	Delete_AccountingRaw_By_Id(ctx context.Context,
 		accounting_raw_id AccountingRaw_Id_Field) (
 		deleted bool, err error)
@@ -7465,22 +16654,58 @@ type Methods interface {
 		api_key_id ApiKey_Id_Field) (
 		deleted bool, err error)
 
+	Delete_AuditRecord_By_AuditedAt_Less(ctx context.Context,
+		audit_record_audited_at_less AuditRecord_AuditedAt_Field) (
+		count int64, err error)
+
+	Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+		deleted bool, err error)
+
+	Delete_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_stat_project_id BucketStat_ProjectId_Field,
+		bucket_stat_bucket_name BucketStat_BucketName_Field) (
+		deleted bool, err error)
+
+	Delete_BucketTemplate_By_ProjectId(ctx context.Context,
+		bucket_template_project_id BucketTemplate_ProjectId_Field) (
+		deleted bool, err error)
+
 	Delete_Injuredsegment_By_Id(ctx context.Context,
 		injuredsegment_id Injuredsegment_Id_Field) (
 		deleted bool, err error)
 
+	Delete_Injuredsegment_By_Path(ctx context.Context,
+		injuredsegment_path Injuredsegment_Path_Field) (
+		deleted bool, err error)
+
 	Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 		deleted bool, err error)
 
+	Delete_NodePing_By_Id(ctx context.Context,
+		node_ping_id NodePing_Id_Field) (
+		deleted bool, err error)
+
 	Delete_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field) (
 		deleted bool, err error)
 
+	Delete_ObjectTag_By_ProjectId_And_BucketName_And_EncryptedPath(ctx context.Context,
+		object_tag_project_id ObjectTag_ProjectId_Field,
+		object_tag_bucket_name ObjectTag_BucketName_Field,
+		object_tag_encrypted_path ObjectTag_EncryptedPath_Field) (
+		count int64, err error)
+
 	Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
 		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
 		deleted bool, err error)
 
+	Delete_ProjectDeletion_By_ProjectId(ctx context.Context,
+		project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+		deleted bool, err error)
+
 	Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
@@ -7490,6 +16715,14 @@ type Methods interface {
 		project_id Project_Id_Field) (
 		deleted bool, err error)
 
+	Delete_Session_By_Id(ctx context.Context,
+		session_id Session_Id_Field) (
+		deleted bool, err error)
+
+	Delete_Session_By_UserId(ctx context.Context,
+		session_user_id Session_UserId_Field) (
+		count int64, err error)
+
 	Delete_User_By_Id(ctx context.Context,
 		user_id User_Id_Field) (
 		deleted bool, err error)
@@ -7498,7 +16731,11 @@ type Methods interface {
 		accounting_timestamps_name AccountingTimestamps_Name_Field) (
 		row *Value_Row, err error)
 
-	First_Injuredsegment(ctx context.Context) (
+	First_Injuredsegment_OrderBy_Asc_Priority_Id(ctx context.Context) (
+		injuredsegment *Injuredsegment, err error)
+
+	First_Injuredsegment_By_LeasedUntil_Less_OrderBy_Asc_Priority_Id(ctx context.Context,
+		injuredsegment_leased_until_less Injuredsegment_LeasedUntil_Field) (
 		injuredsegment *Injuredsegment, err error)
 
 	Get_AccountingRaw_By_Id(ctx context.Context,
@@ -7517,10 +16754,100 @@ type Methods interface {
 		api_key_key ApiKey_Key_Field) (
 		api_key *ApiKey, err error)
 
+	Get_BucketAttribution_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_attribution_project_id BucketAttribution_ProjectId_Field,
+		bucket_attribution_bucket_name BucketAttribution_BucketName_Field) (
+		bucket_attribution *BucketAttribution, err error)
+
+	Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+		bucket_lifecycle *BucketLifecycle, err error)
+
+	Get_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_stat_project_id BucketStat_ProjectId_Field,
+		bucket_stat_bucket_name BucketStat_BucketName_Field) (
+		bucket_stat *BucketStat, err error)
+
+	Get_BucketTemplate_By_ProjectId(ctx context.Context,
+		bucket_template_project_id BucketTemplate_ProjectId_Field) (
+		bucket_template *BucketTemplate, err error)
+
+	Get_Injuredsegment_By_Path(ctx context.Context,
+		injuredsegment_path Injuredsegment_Path_Field) (
+		injuredsegment *Injuredsegment, err error)
+
 	Get_Irreparabledb_By_Segmentpath(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 		irreparabledb *Irreparabledb, err error)
 
+	Get_NodeStanding_By_Id(ctx context.Context,
+		node_standing_id NodeStanding_Id_Field) (
+		node_standing *NodeStanding, err error)
+
+	Get_ProjectInvoice_By_Id(ctx context.Context,
+		project_invoice_id ProjectInvoice_Id_Field) (
+		project_invoice *ProjectInvoice, err error)
+
+	Get_ProjectInvoice_By_ProjectId_And_PeriodStart(ctx context.Context,
+		project_invoice_project_id ProjectInvoice_ProjectId_Field,
+		project_invoice_period_start ProjectInvoice_PeriodStart_Field) (
+		project_invoice *ProjectInvoice, err error)
+
+	Get_SegmentHealth_By_Path(ctx context.Context,
+		segment_health_path SegmentHealth_Path_Field) (
+		segment_health *SegmentHealth, err error)
+
+	Get_Session_By_Id(ctx context.Context,
+		session_id Session_Id_Field) (
+		session *Session, err error)
+
+	Get_UserPayment_By_UserId(ctx context.Context,
+		user_payment_user_id UserPayment_UserId_Field) (
+		user_payment *UserPayment, err error)
+
+	Limited_AuditRecord_By_NodeId_And_AuditedAt_GreaterOrEqual_OrderBy_Desc_AuditedAt(ctx context.Context,
+		audit_record_node_id AuditRecord_NodeId_Field,
+		audit_record_audited_at_greater_or_equal AuditRecord_AuditedAt_Field,
+		limit int, offset int64) (
+		rows []*AuditRecord, err error)
+
+	Limited_AuthEvent_By_UserId_OrderBy_Desc_CreatedAt(ctx context.Context,
+		auth_event_user_id AuthEvent_UserId_Field,
+		limit int, offset int64) (
+		rows []*AuthEvent, err error)
+
+	Limited_Irreparabledb_By_ProjectId_OrderBy_Asc_Segmentpath(ctx context.Context,
+		irreparabledb_project_id Irreparabledb_ProjectId_Field,
+		limit int, offset int64) (
+		rows []*Irreparabledb, err error)
+
+	Limited_Irreparabledb_By_SegDamagedUnixSec_Less_OrderBy_Asc_SegDamagedUnixSec(ctx context.Context,
+		irreparabledb_seg_damaged_unix_sec_less Irreparabledb_SegDamagedUnixSec_Field,
+		limit int, offset int64) (
+		rows []*Irreparabledb, err error)
+
+	Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_OrderBy_Asc_EncryptedPath(ctx context.Context,
+		object_tag_project_id ObjectTag_ProjectId_Field,
+		object_tag_bucket_name ObjectTag_BucketName_Field,
+		object_tag_tag_key ObjectTag_TagKey_Field,
+		object_tag_tag_value ObjectTag_TagValue_Field,
+		limit int, offset int64) (
+		rows []*ObjectTag, err error)
+
+	Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_GreaterOrEqual_And_TagValue_Less_OrderBy_Asc_TagValue_Asc_EncryptedPath(ctx context.Context,
+		object_tag_project_id ObjectTag_ProjectId_Field,
+		object_tag_bucket_name ObjectTag_BucketName_Field,
+		object_tag_tag_key ObjectTag_TagKey_Field,
+		object_tag_tag_value_greater_or_equal ObjectTag_TagValue_Field,
+		object_tag_tag_value_less ObjectTag_TagValue_Field,
+		limit int, offset int64) (
+		rows []*ObjectTag, err error)
+
+	Get_NodePing_By_Id(ctx context.Context,
+		node_ping_id NodePing_Id_Field) (
+		node_ping *NodePing, err error)
+
 	Get_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field) (
 		node *Node, err error)
@@ -7533,6 +16860,10 @@ type Methods interface {
 		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
 		row *OperatorWallet_Row, err error)
 
+	Get_ProjectDeletion_By_ProjectId(ctx context.Context,
+		project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+		project_deletion *ProjectDeletion, err error)
+
 	Get_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field) (
 		project *Project, err error)
@@ -7549,7 +16880,7 @@ type Methods interface {
 		limit int, offset int64) (
 		rows []*Bwagreement, err error)
 
-	Limited_Injuredsegment(ctx context.Context,
+	Limited_Injuredsegment_OrderBy_Asc_Priority_Id(ctx context.Context,
 		limit int, offset int64) (
 		rows []*Injuredsegment, err error)
 
@@ -7573,11 +16904,43 @@ type Methods interface {
 		update ApiKey_Update_Fields) (
 		api_key *ApiKey, err error)
 
+	Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+		update BucketLifecycle_Update_Fields) (
+		bucket_lifecycle *BucketLifecycle, err error)
+
+	Update_BucketStat_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_stat_project_id BucketStat_ProjectId_Field,
+		bucket_stat_bucket_name BucketStat_BucketName_Field,
+		update BucketStat_Update_Fields) (
+		bucket_stat *BucketStat, err error)
+
+	Update_BucketTemplate_By_ProjectId(ctx context.Context,
+		bucket_template_project_id BucketTemplate_ProjectId_Field,
+		update BucketTemplate_Update_Fields) (
+		bucket_template *BucketTemplate, err error)
+
+	Update_Injuredsegment_By_Path(ctx context.Context,
+		injuredsegment_path Injuredsegment_Path_Field,
+		update Injuredsegment_Update_Fields) (
+		injuredsegment *Injuredsegment, err error)
+
 	Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 		update Irreparabledb_Update_Fields) (
 		irreparabledb *Irreparabledb, err error)
 
+	Update_NodePing_By_Id(ctx context.Context,
+		node_ping_id NodePing_Id_Field,
+		update NodePing_Update_Fields) (
+		node_ping *NodePing, err error)
+
+	Update_NodeStanding_By_Id(ctx context.Context,
+		node_standing_id NodeStanding_Id_Field,
+		update NodeStanding_Update_Fields) (
+		node_standing *NodeStanding, err error)
+
 	Update_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field,
 		update Node_Update_Fields) (
@@ -7588,11 +16951,36 @@ type Methods interface {
 		update OverlayCacheNode_Update_Fields) (
 		overlay_cache_node *OverlayCacheNode, err error)
 
+	Update_ProjectDeletion_By_ProjectId(ctx context.Context,
+		project_deletion_project_id ProjectDeletion_ProjectId_Field,
+		update ProjectDeletion_Update_Fields) (
+		project_deletion *ProjectDeletion, err error)
+
+	Update_ProjectInvoice_By_Id(ctx context.Context,
+		project_invoice_id ProjectInvoice_Id_Field,
+		update ProjectInvoice_Update_Fields) (
+		project_invoice *ProjectInvoice, err error)
+
 	Update_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field,
 		update Project_Update_Fields) (
 		project *Project, err error)
 
+	Update_SegmentHealth_By_Path(ctx context.Context,
+		segment_health_path SegmentHealth_Path_Field,
+		update SegmentHealth_Update_Fields) (
+		segment_health *SegmentHealth, err error)
+
+	Update_Session_By_Id(ctx context.Context,
+		session_id Session_Id_Field,
+		update Session_Update_Fields) (
+		session *Session, err error)
+
+	Update_UserPayment_By_UserId(ctx context.Context,
+		user_payment_user_id UserPayment_UserId_Field,
+		update UserPayment_Update_Fields) (
+		user_payment *UserPayment, err error)
+
 	Update_User_By_Id(ctx context.Context,
 		user_id User_Id_Field,
 		update User_Update_Fields) (
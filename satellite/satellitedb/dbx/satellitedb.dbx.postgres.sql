@@ -26,6 +26,78 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE accounting_violations (
+	id bigserial NOT NULL,
+	name text NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	interval_end timestamp with time zone NOT NULL,
+	expected double precision NOT NULL,
+	actual double precision NOT NULL,
+	details text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE audit_records (
+	node_id bytea NOT NULL,
+	audited_at timestamp with time zone NOT NULL,
+	segment_hash bytea NOT NULL,
+	stripe_index bigint NOT NULL,
+	piece_num integer NOT NULL,
+	outcome text NOT NULL,
+	error text NOT NULL,
+	duration_ms bigint NOT NULL,
+	PRIMARY KEY ( node_id, audited_at, segment_hash )
+);
+CREATE TABLE auth_events (
+	id bigserial NOT NULL,
+	user_id bytea NOT NULL,
+	session_id bytea NOT NULL,
+	kind text NOT NULL,
+	device text NOT NULL,
+	address text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_attributions (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	partner_id text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_lifecycles (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	expire_after_days integer NOT NULL,
+	versions_prefix bytea NOT NULL,
+	versions_expire_after_days integer NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_stats (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_count bigint NOT NULL,
+	total_bytes bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_templates (
+	project_id bytea NOT NULL,
+	path_cipher integer NOT NULL,
+	data_cipher integer NOT NULL,
+	block_size integer NOT NULL,
+	share_size integer NOT NULL,
+	required_shares integer NOT NULL,
+	repair_shares integer NOT NULL,
+	optimal_shares integer NOT NULL,
+	total_shares integer NOT NULL,
+	distinct_subnets boolean NOT NULL,
+	distinct_regions boolean NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE bwagreements (
 	serialnum text NOT NULL,
 	storage_node_id bytea NOT NULL,
@@ -38,36 +110,74 @@ CREATE TABLE bwagreements (
 );
 CREATE TABLE injuredsegments (
 	id bigserial NOT NULL,
+	path text NOT NULL,
+	priority bigint NOT NULL,
 	info bytea NOT NULL,
-	PRIMARY KEY ( id )
+	attempts bigint NOT NULL,
+	leased_until timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( path )
 );
 CREATE TABLE irreparabledbs (
 	segmentpath bytea NOT NULL,
+	project_id bytea NOT NULL,
 	segmentdetail bytea NOT NULL,
 	pieces_lost_count bigint NOT NULL,
 	seg_damaged_unix_sec bigint NOT NULL,
 	repair_attempt_count bigint NOT NULL,
+	last_error text NOT NULL,
 	PRIMARY KEY ( segmentpath )
 );
+CREATE TABLE node_pings (
+	id bytea NOT NULL,
+	success_rate double precision NOT NULL,
+	ping_count bigint NOT NULL,
+	last_ping_at timestamp with time zone NOT NULL,
+	last_success_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_standings (
+	id bytea NOT NULL,
+	status integer NOT NULL,
+	reason text NOT NULL,
+	since timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	audit_success_count bigint NOT NULL,
 	total_audit_count bigint NOT NULL,
 	audit_success_ratio double precision NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
 	uptime_success_count bigint NOT NULL,
 	total_uptime_count bigint NOT NULL,
 	uptime_ratio double precision NOT NULL,
+	uptime_reputation_alpha double precision NOT NULL,
+	uptime_reputation_beta double precision NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE object_tags (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	tag_key bytea NOT NULL,
+	tag_value bytea NOT NULL,
+	encrypted_path bytea NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, tag_key, tag_value, encrypted_path )
+);
 CREATE TABLE overlay_cache_nodes (
 	node_id bytea NOT NULL,
 	node_type integer NOT NULL,
 	address text NOT NULL,
 	protocol integer NOT NULL,
+	last_net text NOT NULL,
 	operator_email text NOT NULL,
 	operator_wallet text NOT NULL,
+	operator_region text NOT NULL,
+	version text NOT NULL,
 	free_bandwidth bigint NOT NULL,
 	free_disk bigint NOT NULL,
 	latency_90 bigint NOT NULL,
@@ -77,9 +187,56 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count bigint NOT NULL,
 	uptime_count bigint NOT NULL,
 	uptime_success_count bigint NOT NULL,
+	audit_reputation_score double precision NOT NULL,
+	uptime_reputation_score double precision NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
+CREATE TABLE partner_serials (
+	serial_number text NOT NULL,
+	partner_id text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
+CREATE TABLE partner_storage_tallies (
+	id bigserial NOT NULL,
+	partner_id text NOT NULL,
+	interval_end_time timestamp with time zone NOT NULL,
+	data_total double precision NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_deletions (
+	project_id bytea NOT NULL,
+	requested_by bytea NOT NULL,
+	purge_after timestamp with time zone NOT NULL,
+	purged_at timestamp with time zone,
+	segments_deleted bigint NOT NULL,
+	pieces_deleted bigint NOT NULL,
+	api_keys_deleted bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_invoices (
+	id text NOT NULL,
+	project_id bytea NOT NULL,
+	customer_id text NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	amount bigint NOT NULL,
+	status text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, period_start )
+);
+CREATE TABLE project_storage_tallies (
+	id bigserial NOT NULL,
+	project_id bytea NOT NULL,
+	interval_end_time timestamp with time zone NOT NULL,
+	data_total double precision NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -87,6 +244,30 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE segment_healths (
+	path bytea NOT NULL,
+	last_audited timestamp with time zone,
+	last_repaired timestamp with time zone,
+	PRIMARY KEY ( path )
+);
+CREATE TABLE sessions (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	device text NOT NULL,
+	address text NOT NULL,
+	refresh_token_hash bytea NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	last_used_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE user_payments (
+	user_id bytea NOT NULL,
+	customer_id text NOT NULL,
+	credit bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE users (
 	id bytea NOT NULL,
 	first_name text NOT NULL,
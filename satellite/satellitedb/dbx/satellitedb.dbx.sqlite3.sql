@@ -26,6 +26,78 @@ CREATE TABLE accounting_timestamps (
 	value TIMESTAMP NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE accounting_violations (
+	id INTEGER NOT NULL,
+	name TEXT NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	interval_end TIMESTAMP NOT NULL,
+	expected REAL NOT NULL,
+	actual REAL NOT NULL,
+	details TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE audit_records (
+	node_id BLOB NOT NULL,
+	audited_at TIMESTAMP NOT NULL,
+	segment_hash BLOB NOT NULL,
+	stripe_index INTEGER NOT NULL,
+	piece_num INTEGER NOT NULL,
+	outcome TEXT NOT NULL,
+	error TEXT NOT NULL,
+	duration_ms INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, audited_at, segment_hash )
+);
+CREATE TABLE auth_events (
+	id INTEGER NOT NULL,
+	user_id BLOB NOT NULL,
+	session_id BLOB NOT NULL,
+	kind TEXT NOT NULL,
+	device TEXT NOT NULL,
+	address TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_attributions (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	partner_id TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_lifecycles (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	expire_after_days INTEGER NOT NULL,
+	versions_prefix BLOB NOT NULL,
+	versions_expire_after_days INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_stats (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	object_count INTEGER NOT NULL,
+	total_bytes INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_templates (
+	project_id BLOB NOT NULL,
+	path_cipher INTEGER NOT NULL,
+	data_cipher INTEGER NOT NULL,
+	block_size INTEGER NOT NULL,
+	share_size INTEGER NOT NULL,
+	required_shares INTEGER NOT NULL,
+	repair_shares INTEGER NOT NULL,
+	optimal_shares INTEGER NOT NULL,
+	total_shares INTEGER NOT NULL,
+	distinct_subnets INTEGER NOT NULL,
+	distinct_regions INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE bwagreements (
 	serialnum TEXT NOT NULL,
 	storage_node_id BLOB NOT NULL,
@@ -38,36 +110,74 @@ CREATE TABLE bwagreements (
 );
 CREATE TABLE injuredsegments (
 	id INTEGER NOT NULL,
+	path TEXT NOT NULL,
+	priority INTEGER NOT NULL,
 	info BLOB NOT NULL,
-	PRIMARY KEY ( id )
+	attempts INTEGER NOT NULL,
+	leased_until TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( path )
 );
 CREATE TABLE irreparabledbs (
 	segmentpath BLOB NOT NULL,
+	project_id BLOB NOT NULL,
 	segmentdetail BLOB NOT NULL,
 	pieces_lost_count INTEGER NOT NULL,
 	seg_damaged_unix_sec INTEGER NOT NULL,
 	repair_attempt_count INTEGER NOT NULL,
+	last_error TEXT NOT NULL,
 	PRIMARY KEY ( segmentpath )
 );
+CREATE TABLE node_pings (
+	id BLOB NOT NULL,
+	success_rate REAL NOT NULL,
+	ping_count INTEGER NOT NULL,
+	last_ping_at TIMESTAMP NOT NULL,
+	last_success_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_standings (
+	id BLOB NOT NULL,
+	status INTEGER NOT NULL,
+	reason TEXT NOT NULL,
+	since TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	audit_success_count INTEGER NOT NULL,
 	total_audit_count INTEGER NOT NULL,
 	audit_success_ratio REAL NOT NULL,
+	audit_reputation_alpha REAL NOT NULL,
+	audit_reputation_beta REAL NOT NULL,
 	uptime_success_count INTEGER NOT NULL,
 	total_uptime_count INTEGER NOT NULL,
 	uptime_ratio REAL NOT NULL,
+	uptime_reputation_alpha REAL NOT NULL,
+	uptime_reputation_beta REAL NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	updated_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE object_tags (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	tag_key BLOB NOT NULL,
+	tag_value BLOB NOT NULL,
+	encrypted_path BLOB NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, tag_key, tag_value, encrypted_path )
+);
 CREATE TABLE overlay_cache_nodes (
 	node_id BLOB NOT NULL,
 	node_type INTEGER NOT NULL,
 	address TEXT NOT NULL,
 	protocol INTEGER NOT NULL,
+	last_net TEXT NOT NULL,
 	operator_email TEXT NOT NULL,
 	operator_wallet TEXT NOT NULL,
+	operator_region TEXT NOT NULL,
+	version TEXT NOT NULL,
 	free_bandwidth INTEGER NOT NULL,
 	free_disk INTEGER NOT NULL,
 	latency_90 INTEGER NOT NULL,
@@ -77,9 +187,56 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count INTEGER NOT NULL,
 	uptime_count INTEGER NOT NULL,
 	uptime_success_count INTEGER NOT NULL,
+	audit_reputation_score REAL NOT NULL,
+	uptime_reputation_score REAL NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
+CREATE TABLE partner_serials (
+	serial_number TEXT NOT NULL,
+	partner_id TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
+CREATE TABLE partner_storage_tallies (
+	id INTEGER NOT NULL,
+	partner_id TEXT NOT NULL,
+	interval_end_time TIMESTAMP NOT NULL,
+	data_total REAL NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_deletions (
+	project_id BLOB NOT NULL,
+	requested_by BLOB NOT NULL,
+	purge_after TIMESTAMP NOT NULL,
+	purged_at TIMESTAMP,
+	segments_deleted INTEGER NOT NULL,
+	pieces_deleted INTEGER NOT NULL,
+	api_keys_deleted INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_invoices (
+	id TEXT NOT NULL,
+	project_id BLOB NOT NULL,
+	customer_id TEXT NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	amount INTEGER NOT NULL,
+	status TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, period_start )
+);
+CREATE TABLE project_storage_tallies (
+	id INTEGER NOT NULL,
+	project_id BLOB NOT NULL,
+	interval_end_time TIMESTAMP NOT NULL,
+	data_total REAL NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE projects (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
@@ -87,6 +244,30 @@ CREATE TABLE projects (
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE segment_healths (
+	path BLOB NOT NULL,
+	last_audited TIMESTAMP,
+	last_repaired TIMESTAMP,
+	PRIMARY KEY ( path )
+);
+CREATE TABLE sessions (
+	id BLOB NOT NULL,
+	user_id BLOB NOT NULL,
+	device TEXT NOT NULL,
+	address TEXT NOT NULL,
+	refresh_token_hash BLOB NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	last_used_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE user_payments (
+	user_id BLOB NOT NULL,
+	customer_id TEXT NOT NULL,
+	credit INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE users (
 	id BLOB NOT NULL,
 	first_name TEXT NOT NULL,
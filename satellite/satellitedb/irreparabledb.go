@@ -5,6 +5,9 @@ package satellitedb
 
 import (
 	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
 
 	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/utils"
@@ -28,10 +31,12 @@ func (db *irreparableDB) IncrementRepairAttempts(ctx context.Context, segmentInf
 		_, err = tx.Create_Irreparabledb(
 			ctx,
 			dbx.Irreparabledb_Segmentpath(segmentInfo.EncryptedSegmentPath),
+			dbx.Irreparabledb_ProjectId(segmentInfo.ProjectID),
 			dbx.Irreparabledb_Segmentdetail(segmentInfo.EncryptedSegmentDetail),
 			dbx.Irreparabledb_PiecesLostCount(segmentInfo.LostPiecesCount),
 			dbx.Irreparabledb_SegDamagedUnixSec(segmentInfo.RepairUnixSec),
 			dbx.Irreparabledb_RepairAttemptCount(segmentInfo.RepairAttemptCount),
+			dbx.Irreparabledb_LastError(segmentInfo.LastError),
 		)
 		if err != nil {
 			return Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
@@ -42,6 +47,8 @@ func (db *irreparableDB) IncrementRepairAttempts(ctx context.Context, segmentInf
 		updateFields := dbx.Irreparabledb_Update_Fields{}
 		updateFields.RepairAttemptCount = dbx.Irreparabledb_RepairAttemptCount(dbxInfo.RepairAttemptCount)
 		updateFields.SegDamagedUnixSec = dbx.Irreparabledb_SegDamagedUnixSec(segmentInfo.RepairUnixSec)
+		updateFields.PiecesLostCount = dbx.Irreparabledb_PiecesLostCount(segmentInfo.LostPiecesCount)
+		updateFields.LastError = dbx.Irreparabledb_LastError(segmentInfo.LastError)
 		_, err = tx.Update_Irreparabledb_By_Segmentpath(
 			ctx,
 			dbx.Irreparabledb_Segmentpath(dbxInfo.Segmentpath),
@@ -62,13 +69,39 @@ func (db *irreparableDB) Get(ctx context.Context, segmentPath []byte) (resp *irr
 		return &irreparable.RemoteSegmentInfo{}, Error.Wrap(err)
 	}
 
-	return &irreparable.RemoteSegmentInfo{
-		EncryptedSegmentPath:   dbxInfo.Segmentpath,
-		EncryptedSegmentDetail: dbxInfo.Segmentdetail,
-		LostPiecesCount:        dbxInfo.PiecesLostCount,
-		RepairUnixSec:          dbxInfo.SegDamagedUnixSec,
-		RepairAttemptCount:     dbxInfo.RepairAttemptCount,
-	}, nil
+	return convertDBIrreparable(dbxInfo), nil
+}
+
+// ListCheckedBefore returns irreparable segments which were last checked before the given time
+func (db *irreparableDB) ListCheckedBefore(ctx context.Context, before time.Time, limit int) (resp []*irreparable.RemoteSegmentInfo, err error) {
+	rows, err := db.db.Limited_Irreparabledb_By_SegDamagedUnixSec_Less_OrderBy_Asc_SegDamagedUnixSec(ctx,
+		dbx.Irreparabledb_SegDamagedUnixSec(before.Unix()),
+		limit, 0,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, row := range rows {
+		resp = append(resp, convertDBIrreparable(row))
+	}
+	return resp, nil
+}
+
+// ListByProject returns irreparable segments belonging to a project
+func (db *irreparableDB) ListByProject(ctx context.Context, projectID uuid.UUID, limit int, offset int64) (resp []*irreparable.RemoteSegmentInfo, err error) {
+	rows, err := db.db.Limited_Irreparabledb_By_ProjectId_OrderBy_Asc_Segmentpath(ctx,
+		dbx.Irreparabledb_ProjectId(projectID[:]),
+		limit, offset,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, row := range rows {
+		resp = append(resp, convertDBIrreparable(row))
+	}
+	return resp, nil
 }
 
 // Delete a irreparable's segment info from the db
@@ -77,3 +110,15 @@ func (db *irreparableDB) Delete(ctx context.Context, segmentPath []byte) (err er
 
 	return Error.Wrap(err)
 }
+
+func convertDBIrreparable(dbxInfo *dbx.Irreparabledb) *irreparable.RemoteSegmentInfo {
+	return &irreparable.RemoteSegmentInfo{
+		EncryptedSegmentPath:   dbxInfo.Segmentpath,
+		ProjectID:              dbxInfo.ProjectId,
+		EncryptedSegmentDetail: dbxInfo.Segmentdetail,
+		LostPiecesCount:        dbxInfo.PiecesLostCount,
+		RepairUnixSec:          dbxInfo.SegDamagedUnixSec,
+		RepairAttemptCount:     dbxInfo.RepairAttemptCount,
+		LastError:              dbxInfo.LastError,
+	}
+}
@@ -13,15 +13,19 @@ import (
 	"github.com/skyrings/skyring-common/tools/uuid"
 
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
 )
 
 // locked implements a locking wrapper around satellite.DB.
@@ -69,6 +73,27 @@ func (m *lockedAccounting) LastTimestamp(ctx context.Context, timestampType stri
 	return m.db.LastTimestamp(ctx, timestampType)
 }
 
+// QueryNodeRollups returns the rollups of a node starting between start (inclusive) and end (exclusive)
+func (m *lockedAccounting) QueryNodeRollups(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) ([]*accounting.Rollup, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryNodeRollups(ctx, nodeID, start, end)
+}
+
+// QueryPartnerEgress returns the downloaded bytes of each partner agreed between start (inclusive) and end (exclusive)
+func (m *lockedAccounting) QueryPartnerEgress(ctx context.Context, start time.Time, end time.Time) (map[string]int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryPartnerEgress(ctx, start, end)
+}
+
+// QueryPartnerStorage returns the at-rest byte hours of each partner tallied between start (inclusive) and end (exclusive)
+func (m *lockedAccounting) QueryPartnerStorage(ctx context.Context, start time.Time, end time.Time) (map[string]float64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryPartnerStorage(ctx, start, end)
+}
+
 // QueryPaymentInfo queries StatDB, Accounting Rollup on nodeID
 func (m *lockedAccounting) QueryPaymentInfo(ctx context.Context, start time.Time, end time.Time) ([]*accounting.CSVRow, error) {
 	m.Lock()
@@ -76,6 +101,41 @@ func (m *lockedAccounting) QueryPaymentInfo(ctx context.Context, start time.Time
 	return m.db.QueryPaymentInfo(ctx, start, end)
 }
 
+// QueryRawTotals returns the raw tallies since (inclusive) summed by interval end time and data type, ordered by interval end time
+func (m *lockedAccounting) QueryRawTotals(ctx context.Context, since time.Time) ([]*accounting.RawTotal, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryRawTotals(ctx, since)
+}
+
+// QueryProjectStorage returns the at-rest byte hours of a project tallied between start (inclusive) and end (exclusive)
+func (m *lockedAccounting) QueryProjectStorage(ctx context.Context, projectID uuid.UUID, start time.Time, end time.Time) (float64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryProjectStorage(ctx, projectID, start, end)
+}
+
+// QueryProjectStorageTotal returns the at-rest byte hours of all projects tallied since (inclusive)
+func (m *lockedAccounting) QueryProjectStorageTotal(ctx context.Context, since time.Time) (float64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryProjectStorageTotal(ctx, since)
+}
+
+// QueryRollupTotals returns the sums of the rollups starting between start (inclusive) and end (exclusive)
+func (m *lockedAccounting) QueryRollupTotals(ctx context.Context, start time.Time, end time.Time) (*accounting.Rollup, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryRollupTotals(ctx, start, end)
+}
+
+// QueryViolations returns the broken invariants recorded since (inclusive), ordered by creation time
+func (m *lockedAccounting) QueryViolations(ctx context.Context, since time.Time) ([]*accounting.Violation, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryViolations(ctx, since)
+}
+
 // SaveAtRestRaw records raw tallies of at-rest-data.
 func (m *lockedAccounting) SaveAtRestRaw(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64) error {
 	m.Lock()
@@ -83,6 +143,13 @@ func (m *lockedAccounting) SaveAtRestRaw(ctx context.Context, latestTally time.T
 	return m.db.SaveAtRestRaw(ctx, latestTally, nodeData)
 }
 
+// SaveAtRestTallies records the raw tallies of each node and the tallies of each project and partner in a single transaction.
+func (m *lockedAccounting) SaveAtRestTallies(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64, projectData map[uuid.UUID]float64, partnerData map[string]float64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SaveAtRestTallies(ctx, latestTally, nodeData, projectData, partnerData)
+}
+
 // SaveBWRaw records raw sums of agreement values to the database and updates the LastTimestamp.
 func (m *lockedAccounting) SaveBWRaw(ctx context.Context, tallyEnd time.Time, bwTotals map[storj.NodeID][]int64) error {
 	m.Lock()
@@ -90,6 +157,20 @@ func (m *lockedAccounting) SaveBWRaw(ctx context.Context, tallyEnd time.Time, bw
 	return m.db.SaveBWRaw(ctx, tallyEnd, bwTotals)
 }
 
+// SavePartnerStorageTally records the at-rest byte hours of the buckets attributed to each partner.
+func (m *lockedAccounting) SavePartnerStorageTally(ctx context.Context, intervalEnd time.Time, partnerData map[string]float64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SavePartnerStorageTally(ctx, intervalEnd, partnerData)
+}
+
+// SaveProjectStorageTally records the at-rest byte hours of each project.
+func (m *lockedAccounting) SaveProjectStorageTally(ctx context.Context, intervalEnd time.Time, projectData map[uuid.UUID]float64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SaveProjectStorageTally(ctx, intervalEnd, projectData)
+}
+
 // SaveRollup records raw tallies of at rest data to the database
 func (m *lockedAccounting) SaveRollup(ctx context.Context, latestTally time.Time, stats accounting.RollupStats) error {
 	m.Lock()
@@ -97,6 +178,47 @@ func (m *lockedAccounting) SaveRollup(ctx context.Context, latestTally time.Time
 	return m.db.SaveRollup(ctx, latestTally, stats)
 }
 
+// SaveViolation records a broken invariant
+func (m *lockedAccounting) SaveViolation(ctx context.Context, violation *accounting.Violation) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SaveViolation(ctx, violation)
+}
+
+// AuditLog returns database for keeping the outcomes of audits
+func (m *locked) AuditLog() audit.LogDB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedAuditLog{m.Locker, m.db.AuditLog()}
+}
+
+// lockedAuditLog implements locking wrapper for audit.LogDB
+type lockedAuditLog struct {
+	sync.Locker
+	db audit.LogDB
+}
+
+// DeleteBefore deletes the records of audits before the given time
+func (m *lockedAuditLog) DeleteBefore(ctx context.Context, before time.Time) (deleted int64, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteBefore(ctx, before)
+}
+
+// ListByNode returns the most recent records of a node since the given time
+func (m *lockedAuditLog) ListByNode(ctx context.Context, nodeID storj.NodeID, since time.Time, limit int) ([]audit.Record, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListByNode(ctx, nodeID, since, limit)
+}
+
+// Record stores the records of an audit
+func (m *lockedAuditLog) Record(ctx context.Context, records []audit.Record) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Record(ctx, records)
+}
+
 // BandwidthAgreement returns database for storing bandwidth agreements
 func (m *locked) BandwidthAgreement() bwagreement.DB {
 	m.Lock()
@@ -131,6 +253,88 @@ func (m *lockedBandwidthAgreement) GetUplinkStats(ctx context.Context, a1 time.T
 	return m.db.GetUplinkStats(ctx, a1, a2)
 }
 
+// BucketAttributions returns database for the partners buckets are attributed to
+func (m *locked) BucketAttributions() pointerdb.BucketAttributions {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedBucketAttributions{m.Locker, m.db.BucketAttributions()}
+}
+
+// lockedBucketAttributions implements locking wrapper for pointerdb.BucketAttributions
+type lockedBucketAttributions struct {
+	sync.Locker
+	db pointerdb.BucketAttributions
+}
+
+// Create attributes a bucket to a partner
+func (m *lockedBucketAttributions) Create(ctx context.Context, attribution *pointerdb.BucketAttribution) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Create(ctx, attribution)
+}
+
+// CreateSerial records the serial number of a download allocation of a bucket attributed to a partner
+func (m *lockedBucketAttributions) CreateSerial(ctx context.Context, serialNumber string, partnerID string) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CreateSerial(ctx, serialNumber, partnerID)
+}
+
+// Get returns the attribution of a bucket, sql.ErrNoRows when it has none
+func (m *lockedBucketAttributions) Get(ctx context.Context, projectID uuid.UUID, bucket string) (*pointerdb.BucketAttribution, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, bucket)
+}
+
+// GetAll returns the attributions of all buckets
+func (m *lockedBucketAttributions) GetAll(ctx context.Context) ([]pointerdb.BucketAttribution, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetAll(ctx)
+}
+
+// BucketLifecycles returns database for the lifecycle configurations of buckets
+func (m *locked) BucketLifecycles() pointerdb.BucketLifecycles {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedBucketLifecycles{m.Locker, m.db.BucketLifecycles()}
+}
+
+// lockedBucketLifecycles implements locking wrapper for pointerdb.BucketLifecycles
+type lockedBucketLifecycles struct {
+	sync.Locker
+	db pointerdb.BucketLifecycles
+}
+
+// Delete removes the lifecycle of a bucket
+func (m *lockedBucketLifecycles) Delete(ctx context.Context, projectID uuid.UUID, bucket string) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, projectID, bucket)
+}
+
+// Get returns the lifecycle of a bucket, sql.ErrNoRows when it has none
+func (m *lockedBucketLifecycles) Get(ctx context.Context, projectID uuid.UUID, bucket string) (*pointerdb.BucketLifecycle, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, bucket)
+}
+
+// GetAll returns the lifecycles of all buckets
+func (m *lockedBucketLifecycles) GetAll(ctx context.Context) ([]pointerdb.BucketLifecycle, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetAll(ctx)
+}
+
+// Set creates or replaces the lifecycle of a bucket
+func (m *lockedBucketLifecycles) Set(ctx context.Context, lifecycle *pointerdb.BucketLifecycle) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Set(ctx, lifecycle)
+}
+
 // Close closes the database
 func (m *locked) Close() error {
 	m.Lock()
@@ -192,6 +396,14 @@ func (m *lockedAPIKeys) GetByKey(ctx context.Context, key console.APIKey) (*cons
 	return m.db.GetByKey(ctx, key)
 }
 
+// GetWithKey retrieves APIKeyInfo and the key with given ID, the key is
+// the secret restricted api keys are checked with
+func (m *lockedAPIKeys) GetWithKey(ctx context.Context, id uuid.UUID) (*console.APIKeyInfo, *console.APIKey, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetWithKey(ctx, id)
+}
+
 // GetByProjectID retrieves list of APIKeys for given projectID
 func (m *lockedAPIKeys) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]console.APIKeyInfo, error) {
 	m.Lock()
@@ -206,6 +418,116 @@ func (m *lockedAPIKeys) Update(ctx context.Context, key console.APIKeyInfo) erro
 	return m.db.Update(ctx, key)
 }
 
+// AuthEvents is a getter for AuthEvents repository
+func (m *lockedConsole) AuthEvents() console.AuthEvents {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedAuthEvents{m.Locker, m.db.AuthEvents()}
+}
+
+// lockedAuthEvents implements locking wrapper for console.AuthEvents
+type lockedAuthEvents struct {
+	sync.Locker
+	db console.AuthEvents
+}
+
+// GetByUserID is a method for querying the most recent events of a user.
+func (m *lockedAuthEvents) GetByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]console.AuthEvent, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByUserID(ctx, userID, limit)
+}
+
+// Insert is a method for recording an event.
+func (m *lockedAuthEvents) Insert(ctx context.Context, event *console.AuthEvent) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, event)
+}
+
+// BucketStats is a getter for BucketStats repository
+func (m *lockedConsole) BucketStats() console.BucketStats {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedBucketStats{m.Locker, m.db.BucketStats()}
+}
+
+// lockedBucketStats implements locking wrapper for console.BucketStats
+type lockedBucketStats struct {
+	sync.Locker
+	db console.BucketStats
+}
+
+// Add is a method for atomically adding deltas to the counters of a bucket.
+func (m *lockedBucketStats) Add(ctx context.Context, projectID uuid.UUID, bucketName string, objects int64, bytes int64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Add(ctx, projectID, bucketName, objects, bytes)
+}
+
+// Get is a method for querying the counters of a bucket, zero counters are returned for unknown buckets.
+func (m *lockedBucketStats) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (*console.BucketStat, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, bucketName)
+}
+
+// GetAll is a method for querying the counters of all buckets.
+func (m *lockedBucketStats) GetAll(ctx context.Context) ([]console.BucketStat, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetAll(ctx)
+}
+
+// GetByProject is a method for querying the counters of all buckets of a project.
+func (m *lockedBucketStats) GetByProject(ctx context.Context, projectID uuid.UUID) ([]console.BucketStat, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByProject(ctx, projectID)
+}
+
+// Reconcile is a method for replacing the counters of a bucket, unless they were
+// changed after unchangedSince. Zero counters remove the bucket.
+func (m *lockedBucketStats) Reconcile(ctx context.Context, stat console.BucketStat, unchangedSince time.Time) (reconciled bool, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Reconcile(ctx, stat, unchangedSince)
+}
+
+// BucketTemplates is a getter for BucketTemplates repository
+func (m *lockedConsole) BucketTemplates() console.BucketTemplates {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedBucketTemplates{m.Locker, m.db.BucketTemplates()}
+}
+
+// lockedBucketTemplates implements locking wrapper for console.BucketTemplates
+type lockedBucketTemplates struct {
+	sync.Locker
+	db console.BucketTemplates
+}
+
+// Delete is a method for removing the bucket template of a project.
+func (m *lockedBucketTemplates) Delete(ctx context.Context, projectID uuid.UUID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, projectID)
+}
+
+// Get is a method for querying the bucket template of a project.
+func (m *lockedBucketTemplates) Get(ctx context.Context, projectID uuid.UUID) (*console.BucketTemplate, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID)
+}
+
+// Upsert is a method for creating or replacing the bucket template of a project.
+func (m *lockedBucketTemplates) Upsert(ctx context.Context, template *console.BucketTemplate) (*console.BucketTemplate, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Upsert(ctx, template)
+}
+
 // Close is used to close db connection
 func (m *lockedConsole) Close() error {
 	m.Lock()
@@ -220,6 +542,62 @@ func (m *lockedConsole) CreateTables() error {
 	return m.db.CreateTables()
 }
 
+// ProjectDeletions is a getter for ProjectDeletions repository
+func (m *lockedConsole) ProjectDeletions() console.ProjectDeletions {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedProjectDeletions{m.Locker, m.db.ProjectDeletions()}
+}
+
+// lockedProjectDeletions implements locking wrapper for console.ProjectDeletions
+type lockedProjectDeletions struct {
+	sync.Locker
+	db console.ProjectDeletions
+}
+
+// Delete is a method for canceling a scheduled deletion.
+func (m *lockedProjectDeletions) Delete(ctx context.Context, projectID uuid.UUID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, projectID)
+}
+
+// Get is a method for querying the deletion of a project.
+func (m *lockedProjectDeletions) Get(ctx context.Context, projectID uuid.UUID) (*console.ProjectDeletion, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID)
+}
+
+// GetDue is a method for querying all not yet purged deletions whose grace period ended before the given time.
+func (m *lockedProjectDeletions) GetDue(ctx context.Context, before time.Time) ([]console.ProjectDeletion, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetDue(ctx, before)
+}
+
+// Insert is a method for scheduling deletion of a project.
+func (m *lockedProjectDeletions) Insert(ctx context.Context, deletion *console.ProjectDeletion) (*console.ProjectDeletion, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, deletion)
+}
+
+// Purge is a method for deleting the project with its api keys, members, usage data and bucket settings
+// and recording the deletion as purged in one transaction.
+func (m *lockedProjectDeletions) Purge(ctx context.Context, deletion *console.ProjectDeletion) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Purge(ctx, deletion)
+}
+
+// Update is a method for recording the purge results of a deletion.
+func (m *lockedProjectDeletions) Update(ctx context.Context, deletion *console.ProjectDeletion) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Update(ctx, deletion)
+}
+
 // ProjectMembers is a getter for ProjectMembers repository
 func (m *lockedConsole) ProjectMembers() console.ProjectMembers {
 	m.Lock()
@@ -316,6 +694,61 @@ func (m *lockedProjects) Update(ctx context.Context, project *console.Project) e
 	return m.db.Update(ctx, project)
 }
 
+// Sessions is a getter for Sessions repository
+func (m *lockedConsole) Sessions() console.Sessions {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedSessions{m.Locker, m.db.Sessions()}
+}
+
+// lockedSessions implements locking wrapper for console.Sessions
+type lockedSessions struct {
+	sync.Locker
+	db console.Sessions
+}
+
+// Delete is a method for revoking a session.
+func (m *lockedSessions) Delete(ctx context.Context, id uuid.UUID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, id)
+}
+
+// DeleteByUserID is a method for revoking all sessions of a user.
+func (m *lockedSessions) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteByUserID(ctx, userID)
+}
+
+// Get is a method for querying a session by id.
+func (m *lockedSessions) Get(ctx context.Context, id uuid.UUID) (*console.Session, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, id)
+}
+
+// GetByUserID is a method for querying all sessions of a user, most recently used first.
+func (m *lockedSessions) GetByUserID(ctx context.Context, userID uuid.UUID) ([]console.Session, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByUserID(ctx, userID)
+}
+
+// Insert is a method for creating a session.
+func (m *lockedSessions) Insert(ctx context.Context, session *console.Session) (*console.Session, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, session)
+}
+
+// Update is a method for rotating the refresh token and recording the last use of a session.
+func (m *lockedSessions) Update(ctx context.Context, session *console.Session) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Update(ctx, session)
+}
+
 // Users is a getter for Users repository
 func (m *lockedConsole) Users() console.Users {
 	m.Lock()
@@ -419,6 +852,115 @@ func (m *lockedIrreparable) IncrementRepairAttempts(ctx context.Context, segment
 	return m.db.IncrementRepairAttempts(ctx, segmentInfo)
 }
 
+// ListByProject returns irreparable segments belonging to a project.
+func (m *lockedIrreparable) ListByProject(ctx context.Context, projectID uuid.UUID, limit int, offset int64) ([]*irreparable.RemoteSegmentInfo, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListByProject(ctx, projectID, limit, offset)
+}
+
+// ListCheckedBefore returns irreparable segments which were last checked before the given time.
+func (m *lockedIrreparable) ListCheckedBefore(ctx context.Context, before time.Time, limit int) ([]*irreparable.RemoteSegmentInfo, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListCheckedBefore(ctx, before, limit)
+}
+
+// NodePings returns database for tracking the ping success rate of nodes
+func (m *locked) NodePings() overlay.PingDB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedNodePings{m.Locker, m.db.NodePings()}
+}
+
+// lockedNodePings implements locking wrapper for overlay.PingDB
+type lockedNodePings struct {
+	sync.Locker
+	db overlay.PingDB
+}
+
+// Get returns the ping stats of a node
+func (m *lockedNodePings) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.PingStats, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, nodeID)
+}
+
+// Record updates the ping stats of a node with the outcome of a single ping, weighting the previous success rate by decay
+func (m *lockedNodePings) Record(ctx context.Context, nodeID storj.NodeID, success bool, decay float64) (*overlay.PingStats, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Record(ctx, nodeID, success, decay)
+}
+
+// NodeStandings returns database for tracking since when nodes have their standing
+func (m *locked) NodeStandings() overlay.StandingDB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedNodeStandings{m.Locker, m.db.NodeStandings()}
+}
+
+// lockedNodeStandings implements locking wrapper for overlay.StandingDB
+type lockedNodeStandings struct {
+	sync.Locker
+	db overlay.StandingDB
+}
+
+// Get returns the last recorded standing of a node
+func (m *lockedNodeStandings) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeStanding, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, nodeID)
+}
+
+// Set records a new standing of a node
+func (m *lockedNodeStandings) Set(ctx context.Context, standing overlay.NodeStanding) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Set(ctx, standing)
+}
+
+// ObjectTags returns database for searching objects by their tags
+func (m *locked) ObjectTags() pointerdb.ObjectTags {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedObjectTags{m.Locker, m.db.ObjectTags()}
+}
+
+// lockedObjectTags implements locking wrapper for pointerdb.ObjectTags
+type lockedObjectTags struct {
+	sync.Locker
+	db pointerdb.ObjectTags
+}
+
+// Delete removes all tags of an object
+func (m *lockedObjectTags) Delete(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, projectID, bucket, path)
+}
+
+// Move moves all tags of an object to the new path of the object
+func (m *lockedObjectTags) Move(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path, newBucket string, newPath storj.Path) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Move(ctx, projectID, bucket, path, newBucket, newPath)
+}
+
+// Search returns the objects of a bucket which have a tag with the given key
+func (m *lockedObjectTags) Search(ctx context.Context, projectID uuid.UUID, bucket string, key string, value string, prefix bool, limit int, offset int64) ([]pointerdb.TaggedObject, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Search(ctx, projectID, bucket, key, value, prefix, limit, offset)
+}
+
+// Set replaces all tags of an object
+func (m *lockedObjectTags) Set(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path, tags []pointerdb.ObjectTag) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Set(ctx, projectID, bucket, path, tags)
+}
+
 // OverlayCache returns database for caching overlay information
 func (m *locked) OverlayCache() overlay.DB {
 	m.Lock()
@@ -432,6 +974,13 @@ type lockedOverlayCache struct {
 	db overlay.DB
 }
 
+// Capacity returns the number of storage nodes and their reported free space
+func (m *lockedOverlayCache) Capacity(ctx context.Context) (*overlay.Capacity, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Capacity(ctx)
+}
+
 // Delete deletes node based on id
 func (m *lockedOverlayCache) Delete(ctx context.Context, id storj.NodeID) error {
 	m.Lock()
@@ -495,6 +1044,75 @@ func (m *lockedOverlayCache) Update(ctx context.Context, value *pb.Node) error {
 	return m.db.Update(ctx, value)
 }
 
+// Payments returns database for storing customers and invoices
+func (m *locked) Payments() payments.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedPayments{m.Locker, m.db.Payments()}
+}
+
+// lockedPayments implements locking wrapper for payments.DB
+type lockedPayments struct {
+	sync.Locker
+	db payments.DB
+}
+
+// CreateCustomer stores the customer account of a user
+func (m *lockedPayments) CreateCustomer(ctx context.Context, customer payments.Customer) (*payments.Customer, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CreateCustomer(ctx, customer)
+}
+
+// CreateInvoice stores an invoice
+func (m *lockedPayments) CreateInvoice(ctx context.Context, invoice payments.Invoice) (*payments.Invoice, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CreateInvoice(ctx, invoice)
+}
+
+// FinishInvoice replaces the pending invoice pendingID with the invoice created at the payment provider
+func (m *lockedPayments) FinishInvoice(ctx context.Context, pendingID string, invoice payments.Invoice) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.FinishInvoice(ctx, pendingID, invoice)
+}
+
+// GetCustomer returns the customer account of a user
+func (m *lockedPayments) GetCustomer(ctx context.Context, userID uuid.UUID) (*payments.Customer, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetCustomer(ctx, userID)
+}
+
+// GetInvoice returns an invoice by its id at the payment provider
+func (m *lockedPayments) GetInvoice(ctx context.Context, id string) (*payments.Invoice, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetInvoice(ctx, id)
+}
+
+// GetProjectInvoice returns the invoice of a project for the billing period starting at periodStart
+func (m *lockedPayments) GetProjectInvoice(ctx context.Context, projectID uuid.UUID, periodStart time.Time) (*payments.Invoice, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetProjectInvoice(ctx, projectID, periodStart)
+}
+
+// UpdateCredit sets the remaining credit of a user
+func (m *lockedPayments) UpdateCredit(ctx context.Context, userID uuid.UUID, credit int64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateCredit(ctx, userID, credit)
+}
+
+// UpdateInvoiceStatus sets the payment status of an invoice
+func (m *lockedPayments) UpdateInvoiceStatus(ctx context.Context, id string, status payments.InvoiceStatus) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateInvoiceStatus(ctx, id, status)
+}
+
 // RepairQueue returns queue for segments that need repairing
 func (m *locked) RepairQueue() queue.RepairQueue {
 	m.Lock()
@@ -508,6 +1126,20 @@ type lockedRepairQueue struct {
 	db queue.RepairQueue
 }
 
+// Claim leases the injured segment with the lowest priority which isn't leased yet.
+func (m *lockedRepairQueue) Claim(ctx context.Context, lease time.Duration) (*queue.Job, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Claim(ctx, lease)
+}
+
+// Complete removes a claimed segment from the queue.
+func (m *lockedRepairQueue) Complete(ctx context.Context, path storj.Path) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Complete(ctx, path)
+}
+
 // Dequeue removes an injured segment.
 func (m *lockedRepairQueue) Dequeue(ctx context.Context) (pb.InjuredSegment, error) {
 	m.Lock()
@@ -529,6 +1161,47 @@ func (m *lockedRepairQueue) Peekqueue(ctx context.Context, limit int) ([]pb.Inju
 	return m.db.Peekqueue(ctx, limit)
 }
 
+// Stats returns how many segments are queued and how many of those are leased.
+func (m *lockedRepairQueue) Stats(ctx context.Context) (queue.Stats, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Stats(ctx)
+}
+
+// SegmentHealth returns database for tracking when segments were audited and repaired
+func (m *locked) SegmentHealth() pointerdb.HealthDB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedSegmentHealth{m.Locker, m.db.SegmentHealth()}
+}
+
+// lockedSegmentHealth implements locking wrapper for pointerdb.HealthDB
+type lockedSegmentHealth struct {
+	sync.Locker
+	db pointerdb.HealthDB
+}
+
+// Get returns the health of a segment, segments without any records have zero times
+func (m *lockedSegmentHealth) Get(ctx context.Context, path storj.Path) (*pointerdb.SegmentHealth, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, path)
+}
+
+// RecordAudit records that the segment was audited at the given time
+func (m *lockedSegmentHealth) RecordAudit(ctx context.Context, path storj.Path, audited time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.RecordAudit(ctx, path, audited)
+}
+
+// RecordRepair records that the segment was repaired at the given time
+func (m *lockedSegmentHealth) RecordRepair(ctx context.Context, path storj.Path, repaired time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.RecordRepair(ctx, path, repaired)
+}
+
 // StatDB returns database for storing node statistics
 func (m *locked) StatDB() statdb.DB {
 	m.Lock()
@@ -556,6 +1229,13 @@ func (m *lockedStatDB) CreateEntryIfNotExists(ctx context.Context, nodeID storj.
 	return m.db.CreateEntryIfNotExists(ctx, nodeID)
 }
 
+// FindDisqualifiedNodes finds a subset of storagenodes whose reputation scores are below auditDQ or uptimeDQ.
+func (m *lockedStatDB) FindDisqualifiedNodes(ctx context.Context, nodeIDs storj.NodeIDList, auditDQ float64, uptimeDQ float64) (disqualified storj.NodeIDList, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.FindDisqualifiedNodes(ctx, nodeIDs, auditDQ, uptimeDQ)
+}
+
 // FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements.
 func (m *lockedStatDB) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *statdb.NodeStats) (invalid storj.NodeIDList, err error) {
 	m.Lock()
@@ -578,10 +1258,10 @@ func (m *lockedStatDB) Update(ctx context.Context, request *statdb.UpdateRequest
 }
 
 // UpdateAuditSuccess updates a single storagenode's audit stats.
-func (m *lockedStatDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool) (stats *statdb.NodeStats, err error) {
+func (m *lockedStatDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool, model reputation.Model) (stats *statdb.NodeStats, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateAuditSuccess(ctx, nodeID, auditSuccess)
+	return m.db.UpdateAuditSuccess(ctx, nodeID, auditSuccess, model)
 }
 
 // UpdateBatch for updating multiple storage nodes' stats.
@@ -592,8 +1272,8 @@ func (m *lockedStatDB) UpdateBatch(ctx context.Context, requests []*statdb.Updat
 }
 
 // UpdateUptime updates a single storagenode's uptime stats.
-func (m *lockedStatDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *statdb.NodeStats, err error) {
+func (m *lockedStatDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, model reputation.Model) (stats *statdb.NodeStats, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateUptime(ctx, nodeID, isUp)
+	return m.db.UpdateUptime(ctx, nodeID, isUp, model)
 }
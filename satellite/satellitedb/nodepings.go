@@ -0,0 +1,100 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/utils"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// nodePings implements overlay.PingDB
+type nodePings struct {
+	db *dbx.DB
+}
+
+// Get returns the ping stats of a node
+func (pings *nodePings) Get(ctx context.Context, nodeID storj.NodeID) (stats *overlay.PingStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbPing, err := pings.db.Get_NodePing_By_Id(ctx, dbx.NodePing_Id(nodeID.Bytes()))
+	if err == sql.ErrNoRows {
+		return nil, overlay.ErrNodeNotFound
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return convertPingStats(nodeID, dbPing), nil
+}
+
+// Record updates the ping stats of a node with the outcome of a single ping
+func (pings *nodePings) Record(ctx context.Context, nodeID storj.NodeID, success bool, decay float64) (stats *overlay.PingStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := pings.db.Open(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	now := time.Now().UTC()
+	id := dbx.NodePing_Id(nodeID.Bytes())
+
+	dbPing, err := tx.Get_NodePing_By_Id(ctx, id)
+	if err == sql.ErrNoRows {
+		optional := dbx.NodePing_Create_Fields{}
+		if success {
+			optional.LastSuccessAt = dbx.NodePing_LastSuccessAt(now)
+		}
+
+		dbPing, err = tx.Create_NodePing(ctx, id,
+			dbx.NodePing_SuccessRate(overlay.UpdateSuccessRate(0, 0, success, decay)),
+			dbx.NodePing_PingCount(1),
+			dbx.NodePing_LastPingAt(now),
+			optional,
+		)
+		if err != nil {
+			return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+		}
+
+		return convertPingStats(nodeID, dbPing), Error.Wrap(tx.Commit())
+	}
+	if err != nil {
+		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+	}
+
+	updateFields := dbx.NodePing_Update_Fields{
+		SuccessRate: dbx.NodePing_SuccessRate(overlay.UpdateSuccessRate(dbPing.SuccessRate, dbPing.PingCount, success, decay)),
+		PingCount:   dbx.NodePing_PingCount(dbPing.PingCount + 1),
+		LastPingAt:  dbx.NodePing_LastPingAt(now),
+	}
+	if success {
+		updateFields.LastSuccessAt = dbx.NodePing_LastSuccessAt(now)
+	}
+
+	dbPing, err = tx.Update_NodePing_By_Id(ctx, id, updateFields)
+	if err != nil {
+		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+	}
+
+	return convertPingStats(nodeID, dbPing), Error.Wrap(tx.Commit())
+}
+
+func convertPingStats(nodeID storj.NodeID, dbPing *dbx.NodePing) *overlay.PingStats {
+	stats := &overlay.PingStats{
+		NodeID:      nodeID,
+		SuccessRate: dbPing.SuccessRate,
+		PingCount:   dbPing.PingCount,
+		LastPingAt:  dbPing.LastPingAt,
+	}
+	if dbPing.LastSuccessAt != nil {
+		stats.LastSuccessAt = *dbPing.LastSuccessAt
+	}
+	return stats
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// nodeStandings implements overlay.StandingDB
+type nodeStandings struct {
+	db *dbx.DB
+}
+
+// Get returns the last recorded standing of a node
+func (standings *nodeStandings) Get(ctx context.Context, nodeID storj.NodeID) (standing *overlay.NodeStanding, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbStanding, err := standings.db.Get_NodeStanding_By_Id(ctx, dbx.NodeStanding_Id(nodeID.Bytes()))
+	if err == sql.ErrNoRows {
+		return nil, overlay.ErrNodeNotFound
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &overlay.NodeStanding{
+		NodeID: nodeID,
+		Status: pb.NodeStanding_Status(dbStanding.Status),
+		Reason: dbStanding.Reason,
+		Since:  dbStanding.Since,
+	}, nil
+}
+
+// Set records a new standing of a node
+func (standings *nodeStandings) Set(ctx context.Context, standing overlay.NodeStanding) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id := dbx.NodeStanding_Id(standing.NodeID.Bytes())
+	updated, err := standings.db.Update_NodeStanding_By_Id(ctx, id, dbx.NodeStanding_Update_Fields{
+		Status: dbx.NodeStanding_Status(int(standing.Status)),
+		Reason: dbx.NodeStanding_Reason(standing.Reason),
+		Since:  dbx.NodeStanding_Since(standing.Since),
+	})
+	if err != nil || updated != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = standings.db.Create_NodeStanding(ctx, id,
+		dbx.NodeStanding_Status(int(standing.Status)),
+		dbx.NodeStanding_Reason(standing.Reason),
+		dbx.NodeStanding_Since(standing.Since),
+	)
+	return Error.Wrap(err)
+}
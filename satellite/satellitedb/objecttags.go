@@ -0,0 +1,131 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/utils"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// objectTags implements pointerdb.ObjectTags
+type objectTags struct {
+	db *dbx.DB
+}
+
+// Set replaces all tags of an object
+func (tags *objectTags) Set(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path, objectTags []pointerdb.ObjectTag) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := tags.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = tx.Delete_ObjectTag_By_ProjectId_And_BucketName_And_EncryptedPath(ctx,
+		dbx.ObjectTag_ProjectId(projectID[:]),
+		dbx.ObjectTag_BucketName([]byte(bucket)),
+		dbx.ObjectTag_EncryptedPath([]byte(path)),
+	)
+	if err != nil {
+		return Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+	}
+
+	for _, tag := range objectTags {
+		_, err = tx.Create_ObjectTag(ctx,
+			dbx.ObjectTag_ProjectId(projectID[:]),
+			dbx.ObjectTag_BucketName([]byte(bucket)),
+			dbx.ObjectTag_TagKey([]byte(tag.Key)),
+			dbx.ObjectTag_TagValue([]byte(tag.Value)),
+			dbx.ObjectTag_EncryptedPath([]byte(path)),
+		)
+		if err != nil {
+			return Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+		}
+	}
+
+	return Error.Wrap(tx.Commit())
+}
+
+// Delete removes all tags of an object
+func (tags *objectTags) Delete(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = tags.db.Delete_ObjectTag_By_ProjectId_And_BucketName_And_EncryptedPath(ctx,
+		dbx.ObjectTag_ProjectId(projectID[:]),
+		dbx.ObjectTag_BucketName([]byte(bucket)),
+		dbx.ObjectTag_EncryptedPath([]byte(path)),
+	)
+	return Error.Wrap(err)
+}
+
+// Move moves all tags of an object to the new path of the object
+func (tags *objectTags) Move(ctx context.Context, projectID uuid.UUID, bucket string, path storj.Path, newBucket string, newPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// dbx doesn't update the fields of a primary key
+	_, err = tags.db.DB.Exec(tags.db.Rebind(`
+		UPDATE object_tags
+		SET bucket_name = ?, encrypted_path = ?
+		WHERE project_id = ? AND bucket_name = ? AND encrypted_path = ?`),
+		[]byte(newBucket), []byte(newPath), projectID[:], []byte(bucket), []byte(path))
+	return Error.Wrap(err)
+}
+
+// Search returns the objects of a bucket which have a tag with the given key
+// and value, when prefix is set the value only has to start with the given value
+func (tags *objectTags) Search(ctx context.Context, projectID uuid.UUID, bucket string, key, value string, prefix bool, limit int, offset int64) (objects []pointerdb.TaggedObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rows []*dbx.ObjectTag
+	if prefix {
+		rows, err = tags.db.Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_GreaterOrEqual_And_TagValue_Less_OrderBy_Asc_TagValue_Asc_EncryptedPath(ctx,
+			dbx.ObjectTag_ProjectId(projectID[:]),
+			dbx.ObjectTag_BucketName([]byte(bucket)),
+			dbx.ObjectTag_TagKey([]byte(key)),
+			dbx.ObjectTag_TagValue([]byte(value)),
+			dbx.ObjectTag_TagValue(prefixLimit(value)),
+			limit, offset,
+		)
+	} else {
+		rows, err = tags.db.Limited_ObjectTag_By_ProjectId_And_BucketName_And_TagKey_And_TagValue_OrderBy_Asc_EncryptedPath(ctx,
+			dbx.ObjectTag_ProjectId(projectID[:]),
+			dbx.ObjectTag_BucketName([]byte(bucket)),
+			dbx.ObjectTag_TagKey([]byte(key)),
+			dbx.ObjectTag_TagValue([]byte(value)),
+			limit, offset,
+		)
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, row := range rows {
+		objects = append(objects, pointerdb.TaggedObject{
+			Path: storj.Path(row.EncryptedPath),
+			Tag: pointerdb.ObjectTag{
+				Key:   string(row.TagKey),
+				Value: string(row.TagValue),
+			},
+		})
+	}
+	return objects, nil
+}
+
+// prefixLimit returns the smallest value which is larger than all values
+// starting with prefix. Tag values are valid utf-8, which never contains
+// the byte 0xff, so incrementing the last byte cannot overflow.
+func prefixLimit(prefix string) []byte {
+	if prefix == "" {
+		return []byte{0xff}
+	}
+	limit := []byte(prefix)
+	limit[len(limit)-1]++
+	return limit
+}
@@ -20,31 +20,47 @@ import (
 var _ overlay.DB = (*overlaycache)(nil)
 
 type overlaycache struct {
-	db *dbx.DB
+	db     *dbx.DB
+	driver string
 }
 
 func (cache *overlaycache) SelectNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
-	return cache.queryFilteredNodes(ctx, criteria.Excluded, count, `
+	return cache.queryFilteredNodes(ctx, criteria.Excluded, criteria.DistinctSubnets, criteria.ExcludedSubnets, count, `
 		WHERE node_type = ? AND free_bandwidth >= ? AND free_disk >= ?
 		  AND audit_count >= ?
-		  AND audit_success_ratio >= ?
-		  AND uptime_count >= ?
-		  AND audit_uptime_ratio >= ?
-		`, int(criteria.Type), criteria.FreeBandwidth, criteria.FreeDisk,
-		criteria.AuditCount, criteria.AuditSuccessRatio, criteria.UptimeCount, criteria.UptimeSuccessRatio,
+		  AND audit_reputation_score >= ?
+		  AND uptime_reputation_score >= ?
+		`+safeRequireWallet(criteria.RequireWallet), int(criteria.Type), criteria.FreeBandwidth, criteria.FreeDisk,
+		criteria.AuditCount,
+		criteria.AuditReputationScore, criteria.UptimeReputationScore,
 	)
 }
 
 func (cache *overlaycache) SelectNewNodes(ctx context.Context, count int, criteria *overlay.NewNodeCriteria) ([]*pb.Node, error) {
-	return cache.queryFilteredNodes(ctx, criteria.Excluded, count, `
+	return cache.queryFilteredNodes(ctx, criteria.Excluded, criteria.DistinctSubnets, criteria.ExcludedSubnets, count, `
 		WHERE node_type = ? AND free_bandwidth >= ? AND free_disk >= ?
 		  AND audit_count < ?
-	`, int(criteria.Type), criteria.FreeBandwidth, criteria.FreeDisk,
+		  AND audit_reputation_score >= ?
+		  AND uptime_reputation_score >= ?
+	`+safeRequireWallet(criteria.RequireWallet), int(criteria.Type), criteria.FreeBandwidth, criteria.FreeDisk,
 		criteria.AuditThreshold,
+		criteria.AuditReputationScore, criteria.UptimeReputationScore,
 	)
 }
 
-func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []storj.NodeID, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
+// safeRequireWallet returns the condition skipping the nodes without an
+// operator wallet when required
+func safeRequireWallet(required bool) string {
+	if !required {
+		return ""
+	}
+	return ` AND operator_wallet <> ''`
+}
+
+// queryFilteredNodes selects count random nodes matching safeQuery, with
+// distinctSubnets at most one node of each subnet is selected and the nodes
+// of excludedSubnets aren't selected at all
+func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []storj.NodeID, distinctSubnets bool, excludedSubnets []string, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
 	if count == 0 {
 		return nil, nil
 	}
@@ -56,16 +72,55 @@ func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []st
 	for _, id := range excluded {
 		args = append(args, id.Bytes())
 	}
+
+	safeExcludeSubnets := ""
+	if distinctSubnets {
+		// nodes without a subnet have no address to store pieces at
+		safeExcludeSubnets = ` AND last_net <> ''`
+		if len(excludedSubnets) > 0 {
+			safeExcludeSubnets += ` AND last_net NOT IN (?` + strings.Repeat(", ?", len(excludedSubnets)-1) + `)`
+		}
+		for _, subnet := range excludedSubnets {
+			args = append(args, subnet)
+		}
+	}
 	args = append(args, count)
 
-	rows, err := cache.db.Query(cache.db.Rebind(`SELECT node_id,
-		node_type, address, free_bandwidth, free_disk, audit_success_ratio,
+	const safeColumns = `node_id,
+		node_type, address, last_net, free_bandwidth, free_disk, audit_success_ratio,
 		audit_uptime_ratio, audit_count, audit_success_count, uptime_count,
-		uptime_success_count
+		uptime_success_count, audit_reputation_score, uptime_reputation_score,
+		operator_wallet, operator_region, version`
+
+	query := `SELECT ` + safeColumns + `
 		FROM overlay_cache_nodes
-		`+safeQuery+safeExcludeNodes+`
+		` + safeQuery + safeExcludeNodes + safeExcludeSubnets + `
 		ORDER BY RANDOM()
-		LIMIT ?`), args...)
+		LIMIT ?`
+	if distinctSubnets {
+		switch cache.driver {
+		case "postgres", "cockroach":
+			// a random node of each subnet, selected in random order
+			query = `SELECT * FROM (
+				SELECT DISTINCT ON (last_net) ` + safeColumns + `
+				FROM overlay_cache_nodes
+				` + safeQuery + safeExcludeNodes + safeExcludeSubnets + `
+				ORDER BY last_net, RANDOM()
+			) subnets
+			ORDER BY RANDOM()
+			LIMIT ?`
+		default:
+			// sqlite returns the columns of an arbitrary node of each group
+			query = `SELECT ` + safeColumns + `
+				FROM overlay_cache_nodes
+				` + safeQuery + safeExcludeNodes + safeExcludeSubnets + `
+				GROUP BY last_net
+				ORDER BY RANDOM()
+				LIMIT ?`
+		}
+	}
+
+	rows, err := cache.db.Query(cache.db.Rebind(query), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -75,10 +130,12 @@ func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []st
 	for rows.Next() {
 		overlayNode := &dbx.OverlayCacheNode{}
 		err = rows.Scan(&overlayNode.NodeId, &overlayNode.NodeType,
-			&overlayNode.Address, &overlayNode.FreeBandwidth, &overlayNode.FreeDisk,
+			&overlayNode.Address, &overlayNode.LastNet, &overlayNode.FreeBandwidth, &overlayNode.FreeDisk,
 			&overlayNode.AuditSuccessRatio, &overlayNode.AuditUptimeRatio,
 			&overlayNode.AuditCount, &overlayNode.AuditSuccessCount,
-			&overlayNode.UptimeCount, &overlayNode.UptimeSuccessCount)
+			&overlayNode.UptimeCount, &overlayNode.UptimeSuccessCount,
+			&overlayNode.AuditReputationScore, &overlayNode.UptimeReputationScore,
+			&overlayNode.OperatorWallet, &overlayNode.OperatorRegion, &overlayNode.Version)
 		if err != nil {
 			return nil, err
 		}
@@ -187,20 +244,12 @@ func (cache *overlaycache) Paginate(ctx context.Context, offset int64, limit int
 
 // Update updates node information
 func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	if info == nil || info.Id.IsZero() {
 		return overlay.ErrEmptyNode
 	}
 
-	tx, err := cache.db.Open(ctx)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	// TODO: use upsert
-	_, err = tx.Get_OverlayCacheNode_By_NodeId(ctx,
-		dbx.OverlayCacheNode_NodeId(info.Id.Bytes()),
-	)
-
 	address := info.Address
 	if address == nil {
 		address = &pb.NodeAddress{}
@@ -224,38 +273,49 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 		reputation = &pb.NodeStats{}
 	}
 
-	if err != nil {
-		_, err = tx.Create_OverlayCacheNode(
-			ctx,
+	return Error.Wrap(withTx(ctx, cache.db, func(tx *dbx.Tx) error {
+		// TODO: use upsert
+		_, err := tx.Get_OverlayCacheNode_By_NodeId(ctx,
 			dbx.OverlayCacheNode_NodeId(info.Id.Bytes()),
-
-			dbx.OverlayCacheNode_NodeType(int(info.Type)),
-			dbx.OverlayCacheNode_Address(address.Address),
-			dbx.OverlayCacheNode_Protocol(int(address.Transport)),
-
-			dbx.OverlayCacheNode_OperatorEmail(metadata.Email),
-			dbx.OverlayCacheNode_OperatorWallet(metadata.Wallet),
-
-			dbx.OverlayCacheNode_FreeBandwidth(restrictions.FreeBandwidth),
-			dbx.OverlayCacheNode_FreeDisk(restrictions.FreeDisk),
-
-			dbx.OverlayCacheNode_Latency90(reputation.Latency_90),
-			dbx.OverlayCacheNode_AuditSuccessRatio(reputation.AuditSuccessRatio),
-			dbx.OverlayCacheNode_AuditUptimeRatio(reputation.UptimeRatio),
-			dbx.OverlayCacheNode_AuditCount(reputation.AuditCount),
-			dbx.OverlayCacheNode_AuditSuccessCount(reputation.AuditSuccessCount),
-
-			dbx.OverlayCacheNode_UptimeCount(reputation.UptimeCount),
-			dbx.OverlayCacheNode_UptimeSuccessCount(reputation.UptimeSuccessCount),
 		)
 		if err != nil {
-			return Error.Wrap(errs.Combine(err, tx.Rollback()))
+			_, err = tx.Create_OverlayCacheNode(
+				ctx,
+				dbx.OverlayCacheNode_NodeId(info.Id.Bytes()),
+
+				dbx.OverlayCacheNode_NodeType(int(info.Type)),
+				dbx.OverlayCacheNode_Address(address.Address),
+				dbx.OverlayCacheNode_Protocol(int(address.Transport)),
+				dbx.OverlayCacheNode_LastNet(info.LastNet),
+
+				dbx.OverlayCacheNode_OperatorEmail(metadata.Email),
+				dbx.OverlayCacheNode_OperatorWallet(metadata.Wallet),
+				dbx.OverlayCacheNode_OperatorRegion(metadata.Region),
+				dbx.OverlayCacheNode_Version(metadata.Version),
+
+				dbx.OverlayCacheNode_FreeBandwidth(restrictions.FreeBandwidth),
+				dbx.OverlayCacheNode_FreeDisk(restrictions.FreeDisk),
+
+				dbx.OverlayCacheNode_Latency90(reputation.Latency_90),
+				dbx.OverlayCacheNode_AuditSuccessRatio(reputation.AuditSuccessRatio),
+				dbx.OverlayCacheNode_AuditUptimeRatio(reputation.UptimeRatio),
+				dbx.OverlayCacheNode_AuditCount(reputation.AuditCount),
+				dbx.OverlayCacheNode_AuditSuccessCount(reputation.AuditSuccessCount),
+
+				dbx.OverlayCacheNode_UptimeCount(reputation.UptimeCount),
+				dbx.OverlayCacheNode_UptimeSuccessCount(reputation.UptimeSuccessCount),
+
+				dbx.OverlayCacheNode_AuditReputationScore(reputation.AuditReputationScore),
+				dbx.OverlayCacheNode_UptimeReputationScore(reputation.UptimeReputationScore),
+			)
+			return err
 		}
-	} else {
+
 		update := dbx.OverlayCacheNode_Update_Fields{
 			// TODO: should we be able to update node type?
 			Address:  dbx.OverlayCacheNode_Address(address.Address),
 			Protocol: dbx.OverlayCacheNode_Protocol(int(address.Transport)),
+			LastNet:  dbx.OverlayCacheNode_LastNet(info.LastNet),
 
 			Latency90:          dbx.OverlayCacheNode_Latency90(info.Reputation.Latency_90),
 			AuditSuccessRatio:  dbx.OverlayCacheNode_AuditSuccessRatio(info.Reputation.AuditSuccessRatio),
@@ -264,11 +324,16 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 			AuditSuccessCount:  dbx.OverlayCacheNode_AuditSuccessCount(info.Reputation.AuditSuccessCount),
 			UptimeCount:        dbx.OverlayCacheNode_UptimeCount(info.Reputation.UptimeCount),
 			UptimeSuccessCount: dbx.OverlayCacheNode_UptimeSuccessCount(info.Reputation.UptimeSuccessCount),
+
+			AuditReputationScore:  dbx.OverlayCacheNode_AuditReputationScore(info.Reputation.AuditReputationScore),
+			UptimeReputationScore: dbx.OverlayCacheNode_UptimeReputationScore(info.Reputation.UptimeReputationScore),
 		}
 
 		if info.Metadata != nil {
 			update.OperatorEmail = dbx.OverlayCacheNode_OperatorEmail(info.Metadata.Email)
 			update.OperatorWallet = dbx.OverlayCacheNode_OperatorWallet(info.Metadata.Wallet)
+			update.OperatorRegion = dbx.OverlayCacheNode_OperatorRegion(info.Metadata.Region)
+			update.Version = dbx.OverlayCacheNode_Version(info.Metadata.Version)
 		}
 
 		if info.Restrictions != nil {
@@ -276,24 +341,22 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 			update.FreeDisk = dbx.OverlayCacheNode_FreeDisk(restrictions.FreeDisk)
 		}
 
-		_, err := tx.Update_OverlayCacheNode_By_NodeId(ctx,
+		_, err = tx.Update_OverlayCacheNode_By_NodeId(ctx,
 			dbx.OverlayCacheNode_NodeId(info.Id.Bytes()),
 			update,
 		)
-		if err != nil {
-			return Error.Wrap(errs.Combine(err, tx.Rollback()))
-		}
-	}
-
-	return Error.Wrap(tx.Commit())
+		return err
+	}))
 }
 
 // Delete deletes node based on id
 func (cache *overlaycache) Delete(ctx context.Context, id storj.NodeID) error {
-	_, err := cache.db.Delete_OverlayCacheNode_By_NodeId(ctx,
-		dbx.OverlayCacheNode_NodeId(id.Bytes()),
-	)
-	return err
+	return withRetry(ctx, func(ctx context.Context) error {
+		_, err := cache.db.Delete_OverlayCacheNode_By_NodeId(ctx,
+			dbx.OverlayCacheNode_NodeId(id.Bytes()),
+		)
+		return err
+	})
 }
 
 func convertOverlayNode(info *dbx.OverlayCacheNode) (*pb.Node, error) {
@@ -307,15 +370,18 @@ func convertOverlayNode(info *dbx.OverlayCacheNode) (*pb.Node, error) {
 	}
 
 	node := &pb.Node{
-		Id:   id,
-		Type: pb.NodeType(info.NodeType),
+		Id:      id,
+		Type:    pb.NodeType(info.NodeType),
+		LastNet: info.LastNet,
 		Address: &pb.NodeAddress{
 			Address:   info.Address,
 			Transport: pb.NodeTransport(info.Protocol),
 		},
 		Metadata: &pb.NodeMetadata{
-			Email:  info.OperatorEmail,
-			Wallet: info.OperatorWallet,
+			Email:   info.OperatorEmail,
+			Wallet:  info.OperatorWallet,
+			Region:  info.OperatorRegion,
+			Version: info.Version,
 		},
 		Restrictions: &pb.NodeRestrictions{
 			FreeBandwidth: info.FreeBandwidth,
@@ -330,13 +396,16 @@ func convertOverlayNode(info *dbx.OverlayCacheNode) (*pb.Node, error) {
 			AuditSuccessCount:  info.AuditSuccessCount,
 			UptimeCount:        info.UptimeCount,
 			UptimeSuccessCount: info.UptimeSuccessCount,
+
+			AuditReputationScore:  info.AuditReputationScore,
+			UptimeReputationScore: info.UptimeReputationScore,
 		},
 	}
 
 	if node.Address.Address == "" {
 		node.Address = nil
 	}
-	if node.Metadata.Email == "" && node.Metadata.Wallet == "" {
+	if node.Metadata.Email == "" && node.Metadata.Wallet == "" && node.Metadata.Region == "" && node.Metadata.Version == "" {
 		node.Metadata = nil
 	}
 	if node.Restrictions.FreeBandwidth < 0 && node.Restrictions.FreeDisk < 0 {
@@ -357,3 +426,19 @@ func (cache *overlaycache) GetWalletAddress(ctx context.Context, id storj.NodeID
 	}
 	return w.OperatorWallet, nil
 }
+
+// Capacity returns the number of storage nodes and their reported free space
+func (cache *overlaycache) Capacity(ctx context.Context) (_ *overlay.Capacity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	capacity := &overlay.Capacity{}
+	err = cache.db.DB.QueryRow(cache.db.Rebind(`
+		SELECT COUNT(*), COALESCE(SUM(free_disk), 0) FROM overlay_cache_nodes
+		WHERE node_type = ?`),
+		int(pb.NodeType_STORAGE),
+	).Scan(&capacity.StorageNodes, &capacity.FreeDisk)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return capacity, nil
+}
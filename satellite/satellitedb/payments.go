@@ -0,0 +1,189 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/satellite/payments"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// paymentsDB implements payments.DB
+type paymentsDB struct {
+	db *dbx.DB
+}
+
+// CreateCustomer stores the customer account of a user
+func (db *paymentsDB) CreateCustomer(ctx context.Context, customer payments.Customer) (_ *payments.Customer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	created, err := db.db.Create_UserPayment(ctx,
+		dbx.UserPayment_UserId(customer.UserID[:]),
+		dbx.UserPayment_CustomerId(customer.CustomerID),
+		dbx.UserPayment_Credit(customer.Credit),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return customerFromDBX(created)
+}
+
+// GetCustomer returns the customer account of a user
+func (db *paymentsDB) GetCustomer(ctx context.Context, userID uuid.UUID) (_ *payments.Customer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	customer, err := db.db.Get_UserPayment_By_UserId(ctx, dbx.UserPayment_UserId(userID[:]))
+	if err == sql.ErrNoRows {
+		return nil, payments.ErrCustomerNotFound
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return customerFromDBX(customer)
+}
+
+// UpdateCredit sets the remaining credit of a user
+func (db *paymentsDB) UpdateCredit(ctx context.Context, userID uuid.UUID, credit int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := db.db.Update_UserPayment_By_UserId(ctx,
+		dbx.UserPayment_UserId(userID[:]),
+		dbx.UserPayment_Update_Fields{Credit: dbx.UserPayment_Credit(credit)},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated == nil {
+		return payments.ErrCustomerNotFound
+	}
+	return nil
+}
+
+// CreateInvoice stores an invoice
+func (db *paymentsDB) CreateInvoice(ctx context.Context, invoice payments.Invoice) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	created, err := db.db.Create_ProjectInvoice(ctx,
+		dbx.ProjectInvoice_Id(invoice.ID),
+		dbx.ProjectInvoice_ProjectId(invoice.ProjectID[:]),
+		dbx.ProjectInvoice_CustomerId(invoice.CustomerID),
+		dbx.ProjectInvoice_PeriodStart(invoice.PeriodStart),
+		dbx.ProjectInvoice_PeriodEnd(invoice.PeriodEnd),
+		dbx.ProjectInvoice_Amount(invoice.Amount),
+		dbx.ProjectInvoice_Status(string(invoice.Status)),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return invoiceFromDBX(created)
+}
+
+// FinishInvoice replaces the pending invoice pendingID with the invoice created at the payment provider
+func (db *paymentsDB) FinishInvoice(ctx context.Context, pendingID string, invoice payments.Invoice) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the id is the primary key, which dbx doesn't allow to update
+	result, err := db.db.DB.ExecContext(ctx, db.db.Rebind(`UPDATE project_invoices
+		SET id = ?, amount = ?, status = ?
+		WHERE id = ? AND status = ?`),
+		invoice.ID, invoice.Amount, string(invoice.Status), pendingID, string(payments.InvoicePending))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated == 0 {
+		return payments.ErrInvoiceNotFound
+	}
+	return nil
+}
+
+// GetInvoice returns an invoice by its id at the payment provider
+func (db *paymentsDB) GetInvoice(ctx context.Context, id string) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invoice, err := db.db.Get_ProjectInvoice_By_Id(ctx, dbx.ProjectInvoice_Id(id))
+	if err == sql.ErrNoRows {
+		return nil, payments.ErrInvoiceNotFound
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return invoiceFromDBX(invoice)
+}
+
+// GetProjectInvoice returns the invoice of a project for the billing period starting at periodStart
+func (db *paymentsDB) GetProjectInvoice(ctx context.Context, projectID uuid.UUID, periodStart time.Time) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invoice, err := db.db.Get_ProjectInvoice_By_ProjectId_And_PeriodStart(ctx,
+		dbx.ProjectInvoice_ProjectId(projectID[:]),
+		dbx.ProjectInvoice_PeriodStart(periodStart),
+	)
+	if err == sql.ErrNoRows {
+		return nil, payments.ErrInvoiceNotFound
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return invoiceFromDBX(invoice)
+}
+
+// UpdateInvoiceStatus sets the payment status of an invoice
+func (db *paymentsDB) UpdateInvoiceStatus(ctx context.Context, id string, status payments.InvoiceStatus) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := db.db.Update_ProjectInvoice_By_Id(ctx,
+		dbx.ProjectInvoice_Id(id),
+		dbx.ProjectInvoice_Update_Fields{Status: dbx.ProjectInvoice_Status(string(status))},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated == nil {
+		return payments.ErrInvoiceNotFound
+	}
+	return nil
+}
+
+// customerFromDBX converts the dbx user payment to a payments.Customer
+func customerFromDBX(customer *dbx.UserPayment) (*payments.Customer, error) {
+	userID, err := bytesToUUID(customer.UserId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &payments.Customer{
+		UserID:     userID,
+		CustomerID: customer.CustomerId,
+		Credit:     customer.Credit,
+		CreatedAt:  customer.CreatedAt,
+	}, nil
+}
+
+// invoiceFromDBX converts the dbx project invoice to a payments.Invoice
+func invoiceFromDBX(invoice *dbx.ProjectInvoice) (*payments.Invoice, error) {
+	projectID, err := bytesToUUID(invoice.ProjectId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &payments.Invoice{
+		ID:          invoice.Id,
+		ProjectID:   projectID,
+		CustomerID:  invoice.CustomerId,
+		PeriodStart: invoice.PeriodStart,
+		PeriodEnd:   invoice.PeriodEnd,
+		Amount:      invoice.Amount,
+		Status:      payments.InvoiceStatus(invoice.Status),
+		CreatedAt:   invoice.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/utils"
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// implementation of ProjectDeletions interface repository using spacemonkeygo/dbx orm
+type projectDeletions struct {
+	methods dbx.Methods
+	db      *dbx.DB
+}
+
+// Get is a method for querying the deletion of a project.
+func (deletions *projectDeletions) Get(ctx context.Context, projectID uuid.UUID) (*console.ProjectDeletion, error) {
+	deletion, err := deletions.methods.Get_ProjectDeletion_By_ProjectId(ctx, dbx.ProjectDeletion_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return projectDeletionFromDBX(deletion)
+}
+
+// GetDue is a method for querying all not yet purged deletions whose grace period ended before the given time.
+func (deletions *projectDeletions) GetDue(ctx context.Context, before time.Time) ([]console.ProjectDeletion, error) {
+	deletionsDbx, err := deletions.methods.All_ProjectDeletion_By_PurgeAfter_Less_And_PurgedAt_Is_Null(ctx, dbx.ProjectDeletion_PurgeAfter(before))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []console.ProjectDeletion
+	var errors []error
+
+	for _, deletionDbx := range deletionsDbx {
+		deletion, err := projectDeletionFromDBX(deletionDbx)
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+
+		result = append(result, *deletion)
+	}
+
+	return result, utils.CombineErrors(errors...)
+}
+
+// Insert is a method for scheduling deletion of a project.
+func (deletions *projectDeletions) Insert(ctx context.Context, deletion *console.ProjectDeletion) (*console.ProjectDeletion, error) {
+	created, err := deletions.methods.Create_ProjectDeletion(ctx,
+		dbx.ProjectDeletion_ProjectId(deletion.ProjectID[:]),
+		dbx.ProjectDeletion_RequestedBy(deletion.RequestedBy[:]),
+		dbx.ProjectDeletion_PurgeAfter(deletion.PurgeAfter),
+		dbx.ProjectDeletion_SegmentsDeleted(deletion.SegmentsDeleted),
+		dbx.ProjectDeletion_PiecesDeleted(deletion.PiecesDeleted),
+		dbx.ProjectDeletion_ApiKeysDeleted(deletion.APIKeysDeleted),
+		dbx.ProjectDeletion_Create_Fields{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectDeletionFromDBX(created)
+}
+
+// Delete is a method for canceling a scheduled deletion.
+func (deletions *projectDeletions) Delete(ctx context.Context, projectID uuid.UUID) error {
+	_, err := deletions.methods.Delete_ProjectDeletion_By_ProjectId(ctx, dbx.ProjectDeletion_ProjectId(projectID[:]))
+
+	return err
+}
+
+// Update is a method for recording the purge results of a deletion.
+func (deletions *projectDeletions) Update(ctx context.Context, deletion *console.ProjectDeletion) error {
+	updateFields := dbx.ProjectDeletion_Update_Fields{
+		SegmentsDeleted: dbx.ProjectDeletion_SegmentsDeleted(deletion.SegmentsDeleted),
+		PiecesDeleted:   dbx.ProjectDeletion_PiecesDeleted(deletion.PiecesDeleted),
+		ApiKeysDeleted:  dbx.ProjectDeletion_ApiKeysDeleted(deletion.APIKeysDeleted),
+	}
+	if deletion.Purged() {
+		updateFields.PurgedAt = dbx.ProjectDeletion_PurgedAt(deletion.PurgedAt)
+	}
+
+	_, err := deletions.methods.Update_ProjectDeletion_By_ProjectId(ctx,
+		dbx.ProjectDeletion_ProjectId(deletion.ProjectID[:]),
+		updateFields)
+
+	return err
+}
+
+// projectTables are the tables with data of a project, in the order the data is deleted.
+// Api keys and project members reference the project, so they are deleted before it.
+var projectTables = []string{
+	"object_tags",
+	"bucket_lifecycles",
+	"bucket_attributions",
+	"bucket_templates",
+	"bucket_stats",
+	"project_storage_tallies",
+	"irreparabledbs",
+	"api_keys",
+	"project_members",
+}
+
+// Purge is a method for deleting the project with its api keys, members, usage data and bucket settings
+// and recording the deletion as purged in one transaction.
+func (deletions *projectDeletions) Purge(ctx context.Context, deletion *console.ProjectDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if deletions.db == nil {
+		return errs.New("project can't be purged in a transaction")
+	}
+
+	// the transaction may be retried, so the deletion is only updated once it committed
+	var purged console.ProjectDeletion
+	err = withTx(ctx, deletions.db, func(tx *dbx.Tx) error {
+		purged = *deletion
+		for _, table := range projectTables {
+			result, err := tx.Tx.ExecContext(ctx, deletions.db.Rebind(`DELETE FROM `+table+` WHERE project_id = ?`), deletion.ProjectID[:])
+			if err != nil {
+				return err
+			}
+			if table == "api_keys" {
+				deleted, err := result.RowsAffected()
+				if err != nil {
+					return err
+				}
+				purged.APIKeysDeleted += deleted
+			}
+		}
+
+		if _, err := tx.Delete_Project_By_Id(ctx, dbx.Project_Id(deletion.ProjectID[:])); err != nil {
+			return err
+		}
+
+		purged.PurgedAt = time.Now()
+		return (&projectDeletions{methods: tx}).Update(ctx, &purged)
+	})
+	if err != nil {
+		return err
+	}
+
+	*deletion = purged
+	return nil
+}
+
+// projectDeletionFromDBX is used for creating ProjectDeletion entity from autogenerated dbx.ProjectDeletion struct
+func projectDeletionFromDBX(deletion *dbx.ProjectDeletion) (*console.ProjectDeletion, error) {
+	if deletion == nil {
+		return nil, errs.New("project deletion parameter is nil")
+	}
+
+	projectID, err := bytesToUUID(deletion.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	requestedBy, err := bytesToUUID(deletion.RequestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &console.ProjectDeletion{
+		ProjectID:       projectID,
+		RequestedBy:     requestedBy,
+		PurgeAfter:      deletion.PurgeAfter,
+		SegmentsDeleted: deletion.SegmentsDeleted,
+		PiecesDeleted:   deletion.PiecesDeleted,
+		APIKeysDeleted:  deletion.ApiKeysDeleted,
+		CreatedAt:       deletion.CreatedAt,
+	}
+	if deletion.PurgedAt != nil {
+		result.PurgedAt = *deletion.PurgedAt
+	}
+
+	return result, nil
+}
@@ -5,10 +5,14 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 
+	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/utils"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 	"storj.io/storj/storage"
@@ -24,21 +28,44 @@ func (r *repairQueue) Enqueue(ctx context.Context, seg *pb.InjuredSegment) error
 		return err
 	}
 
-	_, err = r.db.Create_Injuredsegment(
-		ctx,
-		dbx.Injuredsegment_Info(val),
-	)
-	return err
+	tx, err := r.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	path := dbx.Injuredsegment_Path(seg.Path)
+	priority := queue.Priority(seg)
+
+	_, err = tx.Get_Injuredsegment_By_Path(ctx, path)
+	switch {
+	case err == sql.ErrNoRows:
+		_, err = tx.Create_Injuredsegment(ctx,
+			path,
+			dbx.Injuredsegment_Priority(priority),
+			dbx.Injuredsegment_Info(val),
+			dbx.Injuredsegment_Attempts(0),
+			dbx.Injuredsegment_LeasedUntil(time.Time{}),
+		)
+	case err == nil:
+		// the segment is already queued, requeue it with the health observed last
+		_, err = tx.Update_Injuredsegment_By_Path(ctx, path, dbx.Injuredsegment_Update_Fields{
+			Priority: dbx.Injuredsegment_Priority(priority),
+			Info:     dbx.Injuredsegment_Info(val),
+		})
+	}
+	if err != nil {
+		return Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+	}
+	return Error.Wrap(tx.Commit())
 }
 
 func (r *repairQueue) Dequeue(ctx context.Context) (pb.InjuredSegment, error) {
-	// TODO: fix out of order issue
 	tx, err := r.db.Open(ctx)
 	if err != nil {
 		return pb.InjuredSegment{}, Error.Wrap(err)
 	}
 
-	res, err := tx.First_Injuredsegment(ctx)
+	res, err := tx.First_Injuredsegment_OrderBy_Asc_Priority_Id(ctx)
 	if err != nil {
 		return pb.InjuredSegment{}, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
 	} else if res == nil {
@@ -69,7 +96,7 @@ func (r *repairQueue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSeg
 	if limit <= 0 || limit > storage.LookupLimit {
 		limit = storage.LookupLimit
 	}
-	rows, err := r.db.Limited_Injuredsegment(ctx, limit, 0)
+	rows, err := r.db.Limited_Injuredsegment_OrderBy_Asc_Priority_Id(ctx, limit, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -84,3 +111,69 @@ func (r *repairQueue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSeg
 	}
 	return segments, nil
 }
+
+// maxClaimConflicts is how many times Claim retries when other workers
+// claim the same segment concurrently
+const maxClaimConflicts = 10
+
+func (r *repairQueue) Claim(ctx context.Context, lease time.Duration) (_ *queue.Job, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for conflicts := 0; conflicts < maxClaimConflicts; conflicts++ {
+		now := time.Now().UTC()
+
+		res, err := r.db.First_Injuredsegment_By_LeasedUntil_Less_OrderBy_Asc_Priority_Id(ctx,
+			dbx.Injuredsegment_LeasedUntil(now))
+		if err != nil {
+			return nil, Error.Wrap(err)
+		} else if res == nil {
+			return nil, Error.Wrap(storage.ErrEmptyQueue.New(""))
+		}
+
+		// the attempt counter makes sure that only one of the workers
+		// racing for the same segment gets the lease
+		leasedUntil := now.Add(lease)
+		result, err := r.db.DB.Exec(r.db.Rebind(`UPDATE injuredsegments
+			SET attempts = ?, leased_until = ?
+			WHERE id = ? AND attempts = ?`),
+			res.Attempts+1, leasedUntil, res.Id, res.Attempts)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		claimed, err := result.RowsAffected()
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		job := &queue.Job{
+			Attempts:    res.Attempts + 1,
+			LeasedUntil: leasedUntil,
+		}
+		if err = proto.Unmarshal(res.Info, &job.Segment); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return job, nil
+	}
+
+	return nil, Error.New("segment claimed by other workers %d times in a row", maxClaimConflicts)
+}
+
+func (r *repairQueue) Complete(ctx context.Context, path storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = r.db.Delete_Injuredsegment_By_Path(ctx, dbx.Injuredsegment_Path(path))
+	return Error.Wrap(err)
+}
+
+func (r *repairQueue) Stats(ctx context.Context) (stats queue.Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = r.db.DB.QueryRow(r.db.Rebind(`SELECT
+			COUNT(*),
+			COALESCE(SUM(CASE WHEN leased_until > ? THEN 1 ELSE 0 END), 0)
+		FROM injuredsegments`), time.Now().UTC()).Scan(&stats.Queued, &stats.Leased)
+	return stats, Error.Wrap(err)
+}
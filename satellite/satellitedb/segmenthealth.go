@@ -0,0 +1,76 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// segmentHealth implements pointerdb.HealthDB
+type segmentHealth struct {
+	db *dbx.DB
+}
+
+// RecordAudit records that the segment was audited at the given time
+func (db *segmentHealth) RecordAudit(ctx context.Context, path storj.Path, audited time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.record(ctx, path,
+		dbx.SegmentHealth_Update_Fields{LastAudited: dbx.SegmentHealth_LastAudited(audited.UTC())},
+		dbx.SegmentHealth_Create_Fields{LastAudited: dbx.SegmentHealth_LastAudited(audited.UTC())},
+	)
+}
+
+// RecordRepair records that the segment was repaired at the given time
+func (db *segmentHealth) RecordRepair(ctx context.Context, path storj.Path, repaired time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.record(ctx, path,
+		dbx.SegmentHealth_Update_Fields{LastRepaired: dbx.SegmentHealth_LastRepaired(repaired.UTC())},
+		dbx.SegmentHealth_Create_Fields{LastRepaired: dbx.SegmentHealth_LastRepaired(repaired.UTC())},
+	)
+}
+
+// record updates the health of a segment, creating it when it doesn't exist yet
+func (db *segmentHealth) record(ctx context.Context, path storj.Path, update dbx.SegmentHealth_Update_Fields, create dbx.SegmentHealth_Create_Fields) error {
+	updated, err := db.db.Update_SegmentHealth_By_Path(ctx, dbx.SegmentHealth_Path([]byte(path)), update)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated != nil {
+		return nil
+	}
+
+	_, err = db.db.Create_SegmentHealth(ctx, dbx.SegmentHealth_Path([]byte(path)), create)
+	return Error.Wrap(err)
+}
+
+// Get returns the health of a segment, segments without any records have zero times
+func (db *segmentHealth) Get(ctx context.Context, path storj.Path) (_ *pointerdb.SegmentHealth, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	health := &pointerdb.SegmentHealth{Path: path}
+
+	row, err := db.db.Get_SegmentHealth_By_Path(ctx, dbx.SegmentHealth_Path([]byte(path)))
+	if err == sql.ErrNoRows {
+		return health, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if row.LastAudited != nil {
+		health.LastAudited = *row.LastAudited
+	}
+	if row.LastRepaired != nil {
+		health.LastRepaired = *row.LastRepaired
+	}
+	return health, nil
+}
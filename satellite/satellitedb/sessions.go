@@ -0,0 +1,130 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/utils"
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// implementation of Sessions interface repository using spacemonkeygo/dbx orm
+type sessions struct {
+	db dbx.Methods
+}
+
+// Get is a method for querying a session by id.
+func (sessions *sessions) Get(ctx context.Context, id uuid.UUID) (*console.Session, error) {
+	session, err := sessions.db.Get_Session_By_Id(ctx, dbx.Session_Id(id[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionFromDBX(session)
+}
+
+// GetByUserID is a method for querying all sessions of a user, most recently used first.
+func (sessions *sessions) GetByUserID(ctx context.Context, userID uuid.UUID) ([]console.Session, error) {
+	sessionsDbx, err := sessions.db.All_Session_By_UserId_OrderBy_Desc_LastUsedAt(ctx, dbx.Session_UserId(userID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []console.Session
+	var errors []error
+
+	for _, sessionDbx := range sessionsDbx {
+		session, err := sessionFromDBX(sessionDbx)
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+
+		result = append(result, *session)
+	}
+
+	return result, utils.CombineErrors(errors...)
+}
+
+// Insert is a method for creating a session.
+func (sessions *sessions) Insert(ctx context.Context, session *console.Session) (*console.Session, error) {
+	id, err := uuid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := sessions.db.Create_Session(ctx,
+		dbx.Session_Id(id[:]),
+		dbx.Session_UserId(session.UserID[:]),
+		dbx.Session_Device(session.Device),
+		dbx.Session_Address(session.Address),
+		dbx.Session_RefreshTokenHash(session.RefreshTokenHash),
+		dbx.Session_ExpiresAt(session.ExpiresAt),
+		dbx.Session_LastUsedAt(session.LastUsedAt),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionFromDBX(created)
+}
+
+// Update is a method for rotating the refresh token and recording the last use of a session.
+func (sessions *sessions) Update(ctx context.Context, session *console.Session) error {
+	_, err := sessions.db.Update_Session_By_Id(ctx,
+		dbx.Session_Id(session.ID[:]),
+		dbx.Session_Update_Fields{
+			RefreshTokenHash: dbx.Session_RefreshTokenHash(session.RefreshTokenHash),
+			LastUsedAt:       dbx.Session_LastUsedAt(session.LastUsedAt),
+		})
+
+	return err
+}
+
+// Delete is a method for revoking a session.
+func (sessions *sessions) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := sessions.db.Delete_Session_By_Id(ctx, dbx.Session_Id(id[:]))
+
+	return err
+}
+
+// DeleteByUserID is a method for revoking all sessions of a user.
+func (sessions *sessions) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := sessions.db.Delete_Session_By_UserId(ctx, dbx.Session_UserId(userID[:]))
+
+	return err
+}
+
+// sessionFromDBX is used for creating Session entity from autogenerated dbx.Session struct
+func sessionFromDBX(session *dbx.Session) (*console.Session, error) {
+	if session == nil {
+		return nil, errs.New("session parameter is nil")
+	}
+
+	id, err := bytesToUUID(session.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := bytesToUUID(session.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.Session{
+		ID:               id,
+		UserID:           userID,
+		Device:           session.Device,
+		Address:          session.Address,
+		RefreshTokenHash: session.RefreshTokenHash,
+		ExpiresAt:        session.ExpiresAt,
+		LastUsedAt:       session.LastUsedAt,
+		CreatedAt:        session.CreatedAt,
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/reputation"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/utils"
@@ -37,6 +38,11 @@ func getNodeStats(nodeID storj.NodeID, dbNode *dbx.Node) *statdb.NodeStats {
 		UptimeRatio:        dbNode.UptimeRatio,
 		UptimeSuccessCount: dbNode.UptimeSuccessCount,
 		UptimeCount:        dbNode.TotalUptimeCount,
+
+		AuditReputationAlpha:  dbNode.AuditReputationAlpha,
+		AuditReputationBeta:   dbNode.AuditReputationBeta,
+		UptimeReputationAlpha: dbNode.UptimeReputationAlpha,
+		UptimeReputationBeta:  dbNode.UptimeReputationBeta,
 	}
 	return nodeStats
 }
@@ -52,6 +58,9 @@ func (s *statDB) Create(ctx context.Context, nodeID storj.NodeID, startingStats
 		totalUptimeCount   int64
 		uptimeSuccessCount int64
 		uptimeRatio        float64
+
+		auditReputation  reputation.Reputation
+		uptimeReputation reputation.Reputation
 	)
 
 	if startingStats != nil {
@@ -68,6 +77,9 @@ func (s *statDB) Create(ctx context.Context, nodeID storj.NodeID, startingStats
 		if err != nil {
 			return nil, errUptime.Wrap(err)
 		}
+
+		auditReputation = startingStats.AuditReputation()
+		uptimeReputation = startingStats.UptimeReputation()
 	}
 
 	dbNode, err := s.db.Create_Node(
@@ -76,9 +88,13 @@ func (s *statDB) Create(ctx context.Context, nodeID storj.NodeID, startingStats
 		dbx.Node_AuditSuccessCount(auditSuccessCount),
 		dbx.Node_TotalAuditCount(totalAuditCount),
 		dbx.Node_AuditSuccessRatio(auditSuccessRatio),
+		dbx.Node_AuditReputationAlpha(auditReputation.Alpha),
+		dbx.Node_AuditReputationBeta(auditReputation.Beta),
 		dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
 		dbx.Node_TotalUptimeCount(totalUptimeCount),
 		dbx.Node_UptimeRatio(uptimeRatio),
+		dbx.Node_UptimeReputationAlpha(uptimeReputation.Alpha),
+		dbx.Node_UptimeReputationBeta(uptimeReputation.Beta),
 	)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -157,136 +173,206 @@ func (s *statDB) findInvalidNodesQuery(nodeIds storj.NodeIDList, auditSuccess, u
 	return rows, err
 }
 
-// Update a single storagenode's stats in the db
-func (s *statDB) Update(ctx context.Context, updateReq *statdb.UpdateRequest) (stats *statdb.NodeStats, err error) {
+// FindDisqualifiedNodes finds a subset of storagenodes whose reputation scores are below the thresholds.
+// Nodes without any reputation history are never disqualified.
+func (s *statDB) FindDisqualifiedNodes(ctx context.Context, nodeIDs storj.NodeIDList, auditDQ, uptimeDQ float64) (disqualified storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	nodeID := updateReq.NodeID
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
 
-	tx, err := s.db.Open(ctx)
+	args := make([]interface{}, len(nodeIDs))
+	for i, id := range nodeIDs {
+		args[i] = id.Bytes()
+	}
+	args = append(args, auditDQ, uptimeDQ)
+
+	// alpha / (alpha + beta) < dq, written without the division
+	rows, err := s.db.Query(s.db.Rebind(`SELECT nodes.id
+		FROM nodes
+		WHERE nodes.id IN (?`+strings.Repeat(", ?", len(nodeIDs)-1)+`)
+		AND (
+			nodes.audit_reputation_alpha < ? * (nodes.audit_reputation_alpha + nodes.audit_reputation_beta)
+			OR nodes.uptime_reputation_alpha < ? * (nodes.uptime_reputation_alpha + nodes.uptime_reputation_beta)
+		)`), args...)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
-	dbNode, err := tx.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
-	if err != nil {
-		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+	defer func() {
+		err = utils.CombineErrors(err, rows.Close())
+	}()
+
+	for rows.Next() {
+		var idBytes []byte
+		if err := rows.Scan(&idBytes); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		id, err := storj.NodeIDFromBytes(idBytes)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		disqualified = append(disqualified, id)
 	}
 
-	auditSuccessCount := dbNode.AuditSuccessCount
-	totalAuditCount := dbNode.TotalAuditCount
-	var auditSuccessRatio float64
-	uptimeSuccessCount := dbNode.UptimeSuccessCount
-	totalUptimeCount := dbNode.TotalUptimeCount
-	var uptimeRatio float64
-
-	auditSuccessCount, totalAuditCount, auditSuccessRatio = updateRatioVars(
-		updateReq.AuditSuccess,
-		auditSuccessCount,
-		totalAuditCount,
-	)
+	return disqualified, Error.Wrap(rows.Err())
+}
 
-	uptimeSuccessCount, totalUptimeCount, uptimeRatio = updateRatioVars(
-		updateReq.IsUp,
-		uptimeSuccessCount,
-		totalUptimeCount,
-	)
+// Update a single storagenode's stats in the db
+func (s *statDB) Update(ctx context.Context, updateReq *statdb.UpdateRequest) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	updateFields := dbx.Node_Update_Fields{
-		AuditSuccessCount:  dbx.Node_AuditSuccessCount(auditSuccessCount),
-		TotalAuditCount:    dbx.Node_TotalAuditCount(totalAuditCount),
-		AuditSuccessRatio:  dbx.Node_AuditSuccessRatio(auditSuccessRatio),
-		UptimeSuccessCount: dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
-		TotalUptimeCount:   dbx.Node_TotalUptimeCount(totalUptimeCount),
-		UptimeRatio:        dbx.Node_UptimeRatio(uptimeRatio),
-	}
+	nodeID := updateReq.NodeID
 
-	updateFields.UptimeSuccessCount = dbx.Node_UptimeSuccessCount(uptimeSuccessCount)
-	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
-	updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
+	var dbNode *dbx.Node
+	err = withTx(ctx, s.db, func(tx *dbx.Tx) (err error) {
+		dbNode, err = tx.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
+		if err != nil {
+			return err
+		}
+
+		auditSuccessCount := dbNode.AuditSuccessCount
+		totalAuditCount := dbNode.TotalAuditCount
+		var auditSuccessRatio float64
+		uptimeSuccessCount := dbNode.UptimeSuccessCount
+		totalUptimeCount := dbNode.TotalUptimeCount
+		var uptimeRatio float64
+
+		auditSuccessCount, totalAuditCount, auditSuccessRatio = updateRatioVars(
+			updateReq.AuditSuccess,
+			auditSuccessCount,
+			totalAuditCount,
+		)
+
+		uptimeSuccessCount, totalUptimeCount, uptimeRatio = updateRatioVars(
+			updateReq.IsUp,
+			uptimeSuccessCount,
+			totalUptimeCount,
+		)
+
+		auditReputation := updateReq.AuditModel.Update(reputation.Reputation{
+			Alpha: dbNode.AuditReputationAlpha,
+			Beta:  dbNode.AuditReputationBeta,
+		}, updateReq.AuditSuccess)
+
+		uptimeReputation := updateReq.UptimeModel.Update(reputation.Reputation{
+			Alpha: dbNode.UptimeReputationAlpha,
+			Beta:  dbNode.UptimeReputationBeta,
+		}, updateReq.IsUp)
+
+		updateFields := dbx.Node_Update_Fields{
+			AuditSuccessCount:     dbx.Node_AuditSuccessCount(auditSuccessCount),
+			TotalAuditCount:       dbx.Node_TotalAuditCount(totalAuditCount),
+			AuditSuccessRatio:     dbx.Node_AuditSuccessRatio(auditSuccessRatio),
+			AuditReputationAlpha:  dbx.Node_AuditReputationAlpha(auditReputation.Alpha),
+			AuditReputationBeta:   dbx.Node_AuditReputationBeta(auditReputation.Beta),
+			UptimeSuccessCount:    dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
+			TotalUptimeCount:      dbx.Node_TotalUptimeCount(totalUptimeCount),
+			UptimeRatio:           dbx.Node_UptimeRatio(uptimeRatio),
+			UptimeReputationAlpha: dbx.Node_UptimeReputationAlpha(uptimeReputation.Alpha),
+			UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(uptimeReputation.Beta),
+		}
 
-	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+		updateFields.UptimeSuccessCount = dbx.Node_UptimeSuccessCount(uptimeSuccessCount)
+		updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
+		updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
+
+		dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+		return err
+	})
 	if err != nil {
-		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+		return nil, Error.Wrap(err)
 	}
 
-	nodeStats := getNodeStats(nodeID, dbNode)
-	return nodeStats, Error.Wrap(tx.Commit())
+	return getNodeStats(nodeID, dbNode), nil
 }
 
 // UpdateUptime updates a single storagenode's uptime stats in the db
-func (s *statDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *statdb.NodeStats, err error) {
+func (s *statDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, model reputation.Model) (stats *statdb.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	tx, err := s.db.Open(ctx)
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-	dbNode, err := tx.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
-	if err != nil {
-		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
-	}
+	var dbNode *dbx.Node
+	err = withTx(ctx, s.db, func(tx *dbx.Tx) (err error) {
+		dbNode, err = tx.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
+		if err != nil {
+			return err
+		}
 
-	uptimeSuccessCount := dbNode.UptimeSuccessCount
-	totalUptimeCount := dbNode.TotalUptimeCount
-	var uptimeRatio float64
+		uptimeSuccessCount := dbNode.UptimeSuccessCount
+		totalUptimeCount := dbNode.TotalUptimeCount
+		var uptimeRatio float64
 
-	updateFields := dbx.Node_Update_Fields{}
+		updateFields := dbx.Node_Update_Fields{}
 
-	uptimeSuccessCount, totalUptimeCount, uptimeRatio = updateRatioVars(
-		isUp,
-		uptimeSuccessCount,
-		totalUptimeCount,
-	)
+		uptimeSuccessCount, totalUptimeCount, uptimeRatio = updateRatioVars(
+			isUp,
+			uptimeSuccessCount,
+			totalUptimeCount,
+		)
 
-	updateFields.UptimeSuccessCount = dbx.Node_UptimeSuccessCount(uptimeSuccessCount)
-	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
-	updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
+		uptimeReputation := model.Update(reputation.Reputation{
+			Alpha: dbNode.UptimeReputationAlpha,
+			Beta:  dbNode.UptimeReputationBeta,
+		}, isUp)
 
-	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+		updateFields.UptimeSuccessCount = dbx.Node_UptimeSuccessCount(uptimeSuccessCount)
+		updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
+		updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
+		updateFields.UptimeReputationAlpha = dbx.Node_UptimeReputationAlpha(uptimeReputation.Alpha)
+		updateFields.UptimeReputationBeta = dbx.Node_UptimeReputationBeta(uptimeReputation.Beta)
+
+		dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+		return err
+	})
 	if err != nil {
-		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+		return nil, Error.Wrap(err)
 	}
 
-	nodeStats := getNodeStats(nodeID, dbNode)
-	return nodeStats, Error.Wrap(tx.Commit())
+	return getNodeStats(nodeID, dbNode), nil
 }
 
 // UpdateAuditSuccess updates a single storagenode's uptime stats in the db
-func (s *statDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool) (stats *statdb.NodeStats, err error) {
+func (s *statDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool, model reputation.Model) (stats *statdb.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	tx, err := s.db.Open(ctx)
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-	dbNode, err := tx.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
-	if err != nil {
-		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
-	}
+	var dbNode *dbx.Node
+	err = withTx(ctx, s.db, func(tx *dbx.Tx) (err error) {
+		dbNode, err = tx.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
+		if err != nil {
+			return err
+		}
 
-	auditSuccessCount := dbNode.AuditSuccessCount
-	totalAuditCount := dbNode.TotalAuditCount
-	var auditRatio float64
+		auditSuccessCount := dbNode.AuditSuccessCount
+		totalAuditCount := dbNode.TotalAuditCount
+		var auditRatio float64
 
-	updateFields := dbx.Node_Update_Fields{}
+		updateFields := dbx.Node_Update_Fields{}
 
-	auditSuccessCount, totalAuditCount, auditRatio = updateRatioVars(
-		auditSuccess,
-		auditSuccessCount,
-		totalAuditCount,
-	)
+		auditSuccessCount, totalAuditCount, auditRatio = updateRatioVars(
+			auditSuccess,
+			auditSuccessCount,
+			totalAuditCount,
+		)
 
-	updateFields.AuditSuccessCount = dbx.Node_AuditSuccessCount(auditSuccessCount)
-	updateFields.TotalAuditCount = dbx.Node_TotalAuditCount(totalAuditCount)
-	updateFields.AuditSuccessRatio = dbx.Node_AuditSuccessRatio(auditRatio)
+		auditReputation := model.Update(reputation.Reputation{
+			Alpha: dbNode.AuditReputationAlpha,
+			Beta:  dbNode.AuditReputationBeta,
+		}, auditSuccess)
 
-	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+		updateFields.AuditSuccessCount = dbx.Node_AuditSuccessCount(auditSuccessCount)
+		updateFields.TotalAuditCount = dbx.Node_TotalAuditCount(totalAuditCount)
+		updateFields.AuditSuccessRatio = dbx.Node_AuditSuccessRatio(auditRatio)
+		updateFields.AuditReputationAlpha = dbx.Node_AuditReputationAlpha(auditReputation.Alpha)
+		updateFields.AuditReputationBeta = dbx.Node_AuditReputationBeta(auditReputation.Beta)
+
+		dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+		return err
+	})
 	if err != nil {
-		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+		return nil, Error.Wrap(err)
 	}
 
-	nodeStats := getNodeStats(nodeID, dbNode)
-	return nodeStats, Error.Wrap(tx.Commit())
+	return getNodeStats(nodeID, dbNode), nil
 }
 
 // UpdateBatch for updating multiple storage nodes' stats in the db
@@ -0,0 +1,41 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/dbutil/txutil"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// withTx runs fn in a transaction and commits it. CockroachDB runs every
+// transaction as serializable and aborts one of two conflicting transactions,
+// which then has to be run again from the start, so transactions failing
+// with a serialization conflict or deadlock are retried.
+func withTx(ctx context.Context, db *dbx.DB, fn func(tx *dbx.Tx) error) error {
+	return txutil.Retry(ctx, txutil.DefaultConfig, func(ctx context.Context) error {
+		return runTx(ctx, db, fn)
+	})
+}
+
+// withRetry runs fn, which does a single write outside of a transaction,
+// again when it fails with a serialization conflict or deadlock
+func withRetry(ctx context.Context, fn func(ctx context.Context) error) error {
+	return txutil.Retry(ctx, txutil.DefaultConfig, fn)
+}
+
+// runTx runs fn in a single transaction
+func runTx(ctx context.Context, db *dbx.DB, fn func(tx *dbx.Tx) error) error {
+	tx, err := db.Open(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		return errs.Combine(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/dbutil/txutil"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+func TestIsRetryable(t *testing.T) {
+	conflict := &pq.Error{Code: "40001", Message: "restart transaction"}
+	violation := &pq.Error{Code: "23505", Message: "duplicate key value"}
+
+	assert.True(t, txutil.IsRetryable(conflict))
+	assert.True(t, txutil.IsRetryable(Error.Wrap(conflict)))
+	assert.True(t, txutil.IsRetryable(Error.Wrap(&dbx.Error{Err: conflict})))
+
+	assert.False(t, txutil.IsRetryable(nil))
+	assert.False(t, txutil.IsRetryable(violation))
+	assert.False(t, txutil.IsRetryable(&dbx.Error{Err: violation}))
+	assert.False(t, txutil.IsRetryable(errors.New("restart transaction")))
+}
@@ -0,0 +1,258 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package dashboardapi serves the state of the storage node as json for the
+// web dashboard and third-party monitoring.
+package dashboardapi
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/pkg/piecestore/psserver"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/operator"
+)
+
+const (
+	contentType     = "Content-Type"
+	applicationJSON = "application/json"
+
+	// defaultDays is how many days of bandwidth are returned when not asked otherwise
+	defaultDays = 30
+	// maxDays is the most days of bandwidth that can be asked for
+	maxDays = 366
+	// notificationLimit is how many notifications are returned at most
+	notificationLimit = 100
+	// maxSettingsSize is the largest settings request body that is read
+	maxSettingsSize = 64 << 10
+)
+
+// Error is storage node dashboard api error type
+var Error = errs.Class("dashboard api error")
+
+// Config contains configuration for the dashboard api server
+type Config struct {
+	Address string `help:"address of the local dashboard api, empty disables it" default:"127.0.0.1:7778"`
+}
+
+// Node returns the state of the node
+type Node interface {
+	NodeDashboard(ctx context.Context, since time.Time) (*psserver.NodeDashboard, error)
+}
+
+// Notifications gives access to the notifications of the operator
+type Notifications interface {
+	List(ctx context.Context, unreadOnly bool, limit int) ([]notifications.Notification, error)
+	Read(ctx context.Context, id int64) error
+	ReadAll(ctx context.Context) error
+}
+
+// Settings changes the operator settings without a restart
+type Settings interface {
+	Settings() operator.Settings
+	Update(ctx context.Context, update operator.Settings) (operator.Settings, error)
+}
+
+// Auth authenticates the requests to the api
+type Auth interface {
+	Handler(next http.Handler) http.Handler
+}
+
+// Server represents the dashboard api server
+type Server struct {
+	log *zap.Logger
+
+	node          Node
+	notifications Notifications
+	settings      Settings
+	listener      net.Listener
+	server        http.Server
+}
+
+// NewServer creates new instance of dashboard api server
+func NewServer(log *zap.Logger, node Node, notifications Notifications, settings Settings, auth Auth, listener net.Listener) *Server {
+	server := &Server{
+		log:           log,
+		node:          node,
+		notifications: notifications,
+		settings:      settings,
+		listener:      listener,
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/dashboard", http.HandlerFunc(server.dashboardHandler))
+	mux.Handle("/api/notifications", http.HandlerFunc(server.notificationsHandler))
+	mux.Handle("/api/notifications/read", http.HandlerFunc(server.readHandler))
+	mux.Handle("/api/settings", http.HandlerFunc(server.settingsHandler))
+
+	server.server = http.Server{
+		Handler: auth.Handler(mux),
+	}
+
+	return server
+}
+
+// dashboardHandler returns the state of the node, the days query parameter
+// sets how many days of bandwidth are returned
+func (server *Server) dashboardHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	days, err := parseDays(req.URL.Query().Get("days"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	since := time.Now().AddDate(0, 0, 1-days)
+	dashboard, err := server.node.NodeDashboard(req.Context(), since)
+	if err != nil {
+		server.log.Error("failed to get dashboard", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(contentType, applicationJSON)
+	if err := json.NewEncoder(w).Encode(dashboard); err != nil {
+		server.log.Error("failed to encode dashboard", zap.Error(err))
+	}
+}
+
+// notificationsHandler returns the most recent notifications, only the unread
+// ones when the unread query parameter is true
+func (server *Server) notificationsHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	unreadOnly := req.URL.Query().Get("unread") == "true"
+	list, err := server.notifications.List(req.Context(), unreadOnly, notificationLimit)
+	if err != nil {
+		server.log.Error("failed to list notifications", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(contentType, applicationJSON)
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		server.log.Error("failed to encode notifications", zap.Error(err))
+	}
+}
+
+// readHandler marks the notification of the id query parameter as read, or
+// every notification without it
+func (server *Server) readHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	value := req.URL.Query().Get("id")
+	if value == "" {
+		if err := server.notifications.ReadAll(req.Context()); err != nil {
+			server.log.Error("failed to read notifications", zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		http.Error(w, Error.New("invalid id %q", value).Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.notifications.Read(req.Context(), id)
+	switch {
+	case notifications.ErrNotFound.Has(err):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case err != nil:
+		server.log.Error("failed to read notification", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// settingsHandler returns the operator settings, a POST changes the settings
+// set in the body first. The allocations are in bytes.
+func (server *Server) settingsHandler(w http.ResponseWriter, req *http.Request) {
+	settings := server.settings.Settings()
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		var update operator.Settings
+		if err := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxSettingsSize)).Decode(&update); err != nil {
+			http.Error(w, Error.New("invalid settings: %v", err).Error(), http.StatusBadRequest)
+			return
+		}
+
+		var err error
+		settings, err = server.settings.Update(req.Context(), update)
+		if err != nil {
+			server.log.Warn("failed to update settings", zap.Error(err))
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set(contentType, applicationJSON)
+	if err := json.NewEncoder(w).Encode(settings); err != nil {
+		server.log.Error("failed to encode settings", zap.Error(err))
+	}
+}
+
+// parseDays parses the number of days of bandwidth to return
+func parseDays(value string) (int, error) {
+	if value == "" {
+		return defaultDays, nil
+	}
+
+	days, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, Error.New("invalid days %q", value)
+	}
+	if days < 1 || days > maxDays {
+		return 0, Error.New("days should be between 1 and %d", maxDays)
+	}
+	return days, nil
+}
+
+// Run starts the server that hosts the api endpoint
+func (server *Server) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return server.server.Shutdown(nil)
+	})
+	group.Go(func() error {
+		defer cancel()
+		return server.server.Serve(server.listener)
+	})
+
+	return group.Wait()
+}
+
+// Close closes server and underlying listener
+func (server *Server) Close() error {
+	return server.server.Close()
+}
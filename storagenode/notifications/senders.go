@@ -0,0 +1,84 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"time"
+)
+
+// sendTimeout is how long delivering a notification may take
+const sendTimeout = 10 * time.Second
+
+// Webhook posts notifications as json to an url
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook creates a sender posting notifications to url
+func NewWebhook(url string) *Webhook {
+	return &Webhook{
+		url:    url,
+		client: &http.Client{Timeout: sendTimeout},
+	}
+}
+
+// Send posts the notification to the webhook url
+func (webhook *Webhook) Send(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, webhook.url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhook.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// Email sends notifications as emails through an smtp server
+type Email struct {
+	address string
+	from    string
+	to      string
+}
+
+// NewEmail creates a sender emailing notifications from from to to through
+// the smtp server at address
+func NewEmail(address, from, to string) *Email {
+	return &Email{
+		address: address,
+		from:    from,
+		to:      to,
+	}
+}
+
+// Send emails the notification
+func (email *Email) Send(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	message := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: Storage node: %s\r\n\r\n%s\r\n",
+		email.from, email.to, notification.Title, notification.Message)
+	return Error.Wrap(smtp.SendMail(email.address, nil, email.from, []string{email.to}, []byte(message)))
+}
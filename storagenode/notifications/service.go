@@ -0,0 +1,285 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package notifications notifies the operator of the storage node about
+// events which need their attention.
+package notifications
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default notifications errs class
+	Error = errs.Class("notifications error")
+	// ErrNotFound is returned when a notification doesn't exist
+	ErrNotFound = errs.Class("notification not found")
+)
+
+// Types of notifications
+const (
+	TypeOutdatedVersion = "outdated_version"
+	TypeAuditFailures   = "audit_failures"
+	TypeDiskFull        = "disk_full"
+	TypeClockSkew       = "clock_skew"
+	TypeStanding        = "standing"
+)
+
+// Config contains the configurable values of operator notifications
+type Config struct {
+	Interval      time.Duration `help:"how often the node checks for events the operator should be notified of" default:"1h0m0s"`
+	DiskFullRatio float64       `help:"ratio of the allocated space in use above which the operator is notified" default:"0.9"`
+	MinAuditRatio float64       `help:"audit success ratio reported by a satellite below which the operator is notified" default:"0.95"`
+	MaxClockSkew  time.Duration `help:"difference to the clock of a satellite above which the operator is notified" default:"5m0s"`
+
+	WebhookURL  string `help:"url notifications are posted to as json, empty disables it" default:""`
+	SMTPAddress string `help:"address of the smtp server notifications are emailed through, empty disables email" default:""`
+	EmailFrom   string `help:"sender address of notification emails" default:""`
+	EmailTo     string `help:"address notification emails are sent to" default:""`
+}
+
+// DB stores the notifications
+type DB interface {
+	AddNotification(notification psdb.Notification) (added bool, err error)
+	GetNotifications(unreadOnly bool, limit int) ([]psdb.Notification, error)
+	ReadNotification(id int64) error
+	ReadAllNotifications() error
+}
+
+// Node returns the state of the node the notifications are about
+type Node interface {
+	NodeDashboard(ctx context.Context, since time.Time) (*psserver.NodeDashboard, error)
+}
+
+// Notification is an event the operator should know about
+type Notification struct {
+	ID      int64     `json:"id"`
+	Type    string    `json:"type"`
+	Title   string    `json:"title"`
+	Message string    `json:"message"`
+	Created time.Time `json:"created"`
+	Read    bool      `json:"read"`
+}
+
+// Sender delivers notifications to the operator outside of the dashboard
+type Sender interface {
+	Send(ctx context.Context, notification Notification) error
+}
+
+// Service checks the state of the node at regular intervals and notifies the
+// operator of the events which need their attention
+type Service struct {
+	log     *zap.Logger
+	db      DB
+	node    Node
+	config  Config
+	senders []Sender
+}
+
+// NewService creates a notification service, which delivers notifications by
+// webhook and email when they are configured
+func NewService(log *zap.Logger, db DB, node Node, config Config) *Service {
+	service := &Service{
+		log:    log,
+		db:     db,
+		node:   node,
+		config: config,
+	}
+	if config.WebhookURL != "" {
+		service.senders = append(service.senders, NewWebhook(config.WebhookURL))
+	}
+	if config.SMTPAddress != "" {
+		service.senders = append(service.senders, NewEmail(config.SMTPAddress, config.EmailFrom, config.EmailTo))
+	}
+	return service
+}
+
+// Run checks the state of the node at regular intervals
+func (service *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(service.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		err := service.Check(ctx)
+		if err != nil {
+			service.log.Error("check", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the service is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// Check notifies the operator of the events of the current state of the node
+func (service *Service) Check(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dashboard, err := service.node.NodeDashboard(ctx, time.Now())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var errlist errs.Group
+
+	allocated := dashboard.UsedSpace + dashboard.AvailableSpace
+	if allocated > 0 && float64(dashboard.UsedSpace)/float64(allocated) >= service.config.DiskFullRatio {
+		errlist.Add(service.Notify(ctx, psdb.Notification{
+			Type:  TypeDiskFull,
+			Key:   TypeDiskFull,
+			Title: "Disk nearly full",
+			Message: fmt.Sprintf("%d of the %d allocated bytes are used, the node accepts no uploads once the space is used up",
+				dashboard.UsedSpace, allocated),
+		}))
+	}
+
+	current, versionErr := version.Parse(version.Version)
+	if versionErr != nil {
+		service.log.Debug("unparsable version", zap.String("version", version.Version))
+	}
+
+	for _, satellite := range dashboard.Satellites {
+		if satellite.Standing != "" && satellite.Standing != pb.NodeStanding_GOOD.String() {
+			// the key changes with the status, so every change is notified
+			errlist.Add(service.Notify(ctx, psdb.Notification{
+				Type:  TypeStanding,
+				Key:   fmt.Sprintf("%s:%s:%s:%d", TypeStanding, satellite.SatelliteID, satellite.Standing, satellite.StandingSince.Unix()),
+				Title: "Node " + strings.ToLower(satellite.Standing),
+				Message: fmt.Sprintf("satellite %s reported the node as %s: %s",
+					satellite.SatelliteID, strings.ToLower(satellite.Standing), satellite.StandingReason),
+			}))
+		}
+
+		if satellite.StatsReceivedAt.IsZero() {
+			continue
+		}
+
+		if satellite.AuditSuccessRatio < service.config.MinAuditRatio {
+			errlist.Add(service.Notify(ctx, psdb.Notification{
+				Type:  TypeAuditFailures,
+				Key:   TypeAuditFailures + ":" + satellite.SatelliteID.String(),
+				Title: "Audits failing",
+				Message: fmt.Sprintf("satellite %s reported an audit success ratio of %.2f, the node is disqualified when too many audits fail",
+					satellite.SatelliteID, satellite.AuditSuccessRatio),
+			}))
+		}
+
+		if skew := satellite.ClockSkew; skew > service.config.MaxClockSkew || -skew > service.config.MaxClockSkew {
+			errlist.Add(service.Notify(ctx, psdb.Notification{
+				Type:  TypeClockSkew,
+				Key:   TypeClockSkew + ":" + satellite.SatelliteID.String(),
+				Title: "Clock out of sync",
+				Message: fmt.Sprintf("the clock of satellite %s differs by %s from the node's, signed agreements may be rejected",
+					satellite.SatelliteID, skew),
+			}))
+		}
+
+		if satellite.MinimumVersion != "" && versionErr == nil {
+			minimum, err := version.Parse(satellite.MinimumVersion)
+			if err != nil {
+				service.log.Debug("unparsable minimum version",
+					zap.Stringer("satellite", satellite.SatelliteID), zap.String("version", satellite.MinimumVersion))
+				continue
+			}
+			if current.Less(minimum) {
+				errlist.Add(service.Notify(ctx, psdb.Notification{
+					Type:  TypeOutdatedVersion,
+					Key:   TypeOutdatedVersion + ":" + minimum.String(),
+					Title: "Outdated version",
+					Message: fmt.Sprintf("satellite %s requires at least %s, the node runs %s",
+						satellite.SatelliteID, minimum, current),
+				}))
+			}
+		}
+	}
+
+	return errlist.Err()
+}
+
+// Notify stores the notification and delivers it unless an unread
+// notification with the same key exists
+func (service *Service) Notify(ctx context.Context, notification psdb.Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if notification.Created.IsZero() {
+		notification.Created = time.Now()
+	}
+
+	added, err := service.db.AddNotification(notification)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !added {
+		return nil
+	}
+
+	service.log.Warn(notification.Title, zap.String("type", notification.Type), zap.String("message", notification.Message))
+	for _, sender := range service.senders {
+		if err := sender.Send(ctx, fromDB(notification)); err != nil {
+			service.log.Error("failed to send notification", zap.String("type", notification.Type), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// List returns up to limit notifications, most recent first
+func (service *Service) List(ctx context.Context, unreadOnly bool, limit int) (notifications []Notification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stored, err := service.db.GetNotifications(unreadOnly, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	notifications = make([]Notification, 0, len(stored))
+	for _, notification := range stored {
+		notifications = append(notifications, fromDB(notification))
+	}
+	return notifications, nil
+}
+
+// Read marks the notification with id as read
+func (service *Service) Read(ctx context.Context, id int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.db.ReadNotification(id)
+	if err == sql.ErrNoRows {
+		return ErrNotFound.New("%d", id)
+	}
+	return Error.Wrap(err)
+}
+
+// ReadAll marks every notification as read
+func (service *Service) ReadAll(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(service.db.ReadAllNotifications())
+}
+
+// fromDB converts a stored notification
+func fromDB(notification psdb.Notification) Notification {
+	return Notification{
+		ID:      notification.ID,
+		Type:    notification.Type,
+		Title:   notification.Title,
+		Message: notification.Message,
+		Created: notification.Created,
+		Read:    notification.Read,
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/piecestore/psserver"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/storagenode/notifications"
+)
+
+type mockNode struct {
+	dashboard psserver.NodeDashboard
+}
+
+func (node *mockNode) NodeDashboard(ctx context.Context, since time.Time) (*psserver.NodeDashboard, error) {
+	return &node.dashboard, nil
+}
+
+func TestCheck(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := psdb.OpenInMemory()
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	received := make(chan notifications.Notification, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var notification notifications.Notification
+		if err := json.NewDecoder(req.Body).Decode(&notification); err == nil {
+			received <- notification
+		}
+	}))
+	defer webhook.Close()
+
+	satelliteID := teststorj.NodeIDFromString("satellite")
+	node := &mockNode{dashboard: psserver.NodeDashboard{
+		UsedSpace:      95,
+		AvailableSpace: 5,
+		Satellites: []psserver.SatelliteDashboard{{
+			SatelliteID:       satelliteID,
+			AuditSuccessRatio: 0.5,
+			MinimumVersion:    "v1000.0.0",
+			ClockSkew:         -time.Hour,
+			StatsReceivedAt:   time.Now(),
+			Standing:          "SUSPENDED",
+			StandingReason:    "audit score 0.40 is below 0.60",
+			StandingSince:     time.Now(),
+		}},
+	}}
+
+	service := notifications.NewService(zap.NewNop(), db, node, notifications.Config{
+		DiskFullRatio: 0.9,
+		MinAuditRatio: 0.95,
+		MaxClockSkew:  5 * time.Minute,
+		WebhookURL:    webhook.URL,
+	})
+
+	require.NoError(t, service.Check(ctx))
+
+	list, err := service.List(ctx, true, 10)
+	require.NoError(t, err)
+
+	types := map[string]bool{}
+	for _, notification := range list {
+		types[notification.Type] = true
+	}
+	assert.Equal(t, map[string]bool{
+		notifications.TypeDiskFull:        true,
+		notifications.TypeAuditFailures:   true,
+		notifications.TypeOutdatedVersion: true,
+		notifications.TypeClockSkew:       true,
+		notifications.TypeStanding:        true,
+	}, types)
+	assert.Len(t, received, 5)
+
+	// unread notifications aren't repeated
+	require.NoError(t, service.Check(ctx))
+	list, err = service.List(ctx, false, 10)
+	require.NoError(t, err)
+	assert.Len(t, list, 5)
+	assert.Len(t, received, 5)
+
+	require.NoError(t, service.Read(ctx, list[0].ID))
+	unread, err := service.List(ctx, true, 10)
+	require.NoError(t, err)
+	assert.Len(t, unread, 4)
+
+	err = service.Read(ctx, -1)
+	assert.True(t, notifications.ErrNotFound.Has(err))
+
+	require.NoError(t, service.ReadAll(ctx))
+	unread, err = service.List(ctx, true, 10)
+	require.NoError(t, err)
+	assert.Len(t, unread, 0)
+
+	// the node recovered, nothing is notified again
+	node.dashboard = psserver.NodeDashboard{UsedSpace: 10, AvailableSpace: 90}
+	require.NoError(t, service.Check(ctx))
+	unread, err = service.List(ctx, true, 10)
+	require.NoError(t, err)
+	assert.Len(t, unread, 0)
+}
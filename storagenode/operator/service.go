@@ -0,0 +1,135 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package operator changes the settings of the storage node operator while
+// the node runs.
+package operator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default operator errs class
+	Error = errs.Class("operator error")
+)
+
+// Settings are the operator settings which can be changed without a restart
+type Settings struct {
+	Email              string      `json:"email"`
+	Wallet             string      `json:"wallet"`
+	AllocatedDiskSpace memory.Size `json:"allocatedDiskSpace"`
+	AllocatedBandwidth memory.Size `json:"allocatedBandwidth"`
+}
+
+// RoutingTable announces the metadata of the node to the satellites
+type RoutingTable interface {
+	Local() pb.Node
+	UpdateMetadata(metadata *pb.NodeMetadata) error
+}
+
+// Storage stores the pieces within the allocated disk space and bandwidth
+type Storage interface {
+	SetAllocated(diskSpace, bandwidth memory.Size) error
+}
+
+// Monitor announces the capacity of the node to the satellites
+type Monitor interface {
+	Refresh(ctx context.Context) error
+}
+
+// Service changes the operator settings, the satellites learn about the
+// changes the next time they contact the node
+type Service struct {
+	log     *zap.Logger
+	rt      RoutingTable
+	storage Storage
+	monitor Monitor
+
+	mu       sync.Mutex
+	settings Settings
+}
+
+// NewService creates a service changing the operator settings, which start out as settings
+func NewService(log *zap.Logger, settings Settings, rt RoutingTable, storage Storage, monitor Monitor) *Service {
+	return &Service{
+		log:      log,
+		rt:       rt,
+		storage:  storage,
+		monitor:  monitor,
+		settings: settings,
+	}
+}
+
+// Settings returns the current operator settings
+func (service *Service) Settings() Settings {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.settings
+}
+
+// Update changes the settings which are set in update, the zero values keep
+// the current settings. The changed settings are returned.
+func (service *Service) Update(ctx context.Context, update Settings) (_ Settings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	settings := service.settings
+	if update.Email != "" {
+		settings.Email = update.Email
+	}
+	if update.Wallet != "" {
+		settings.Wallet = update.Wallet
+	}
+	if update.AllocatedDiskSpace != 0 {
+		settings.AllocatedDiskSpace = update.AllocatedDiskSpace
+	}
+	if update.AllocatedBandwidth != 0 {
+		settings.AllocatedBandwidth = update.AllocatedBandwidth
+	}
+
+	operator := kademlia.OperatorConfig{Email: settings.Email, Wallet: settings.Wallet}
+	if err := operator.Verify(service.log); err != nil {
+		return service.settings, Error.Wrap(err)
+	}
+
+	if settings.Email != service.settings.Email || settings.Wallet != service.settings.Wallet {
+		var metadata pb.NodeMetadata
+		if current := service.rt.Local().Metadata; current != nil {
+			metadata = *current
+		}
+		metadata.Email, metadata.Wallet = settings.Email, settings.Wallet
+
+		if err := service.rt.UpdateMetadata(&metadata); err != nil {
+			return service.settings, Error.Wrap(err)
+		}
+		service.settings.Email, service.settings.Wallet = settings.Email, settings.Wallet
+	}
+
+	if settings.AllocatedDiskSpace != service.settings.AllocatedDiskSpace || settings.AllocatedBandwidth != service.settings.AllocatedBandwidth {
+		if err := service.storage.SetAllocated(settings.AllocatedDiskSpace, settings.AllocatedBandwidth); err != nil {
+			return service.settings, Error.Wrap(err)
+		}
+		service.settings.AllocatedDiskSpace, service.settings.AllocatedBandwidth = settings.AllocatedDiskSpace, settings.AllocatedBandwidth
+
+		// the capacity is otherwise only announced at the next refresh interval
+		if err := service.monitor.Refresh(ctx); err != nil {
+			service.log.Warn("failed to announce the changed capacity", zap.Error(err))
+		}
+	}
+
+	return service.settings, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package operator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storagenode/operator"
+)
+
+const (
+	wallet      = "0x0123456789012345678901234567890123456789"
+	otherWallet = "0x9876543210987654321098765432109876543210"
+)
+
+type routingTable struct{ self pb.Node }
+
+func (rt *routingTable) Local() pb.Node { return rt.self }
+
+func (rt *routingTable) UpdateMetadata(metadata *pb.NodeMetadata) error {
+	rt.self.Metadata = metadata
+	return nil
+}
+
+type storage struct{ diskSpace, bandwidth memory.Size }
+
+func (storage *storage) SetAllocated(diskSpace, bandwidth memory.Size) error {
+	storage.diskSpace, storage.bandwidth = diskSpace, bandwidth
+	return nil
+}
+
+type monitor struct{ refreshes int }
+
+func (monitor *monitor) Refresh(ctx context.Context) error {
+	monitor.refreshes++
+	return nil
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	initial := operator.Settings{
+		Email:              "operator@example.com",
+		Wallet:             wallet,
+		AllocatedDiskSpace: 1 * memory.TB,
+		AllocatedBandwidth: 500 * memory.GB,
+	}
+	rt := &routingTable{self: pb.Node{Metadata: &pb.NodeMetadata{Email: initial.Email, Wallet: wallet, Region: "eu"}}}
+	storage := &storage{}
+	monitor := &monitor{}
+	service := operator.NewService(zap.NewNop(), initial, rt, storage, monitor)
+
+	{ // the wallet is re-announced, the region is kept
+		settings, err := service.Update(ctx, operator.Settings{Wallet: otherWallet})
+		require.NoError(t, err)
+		assert.Equal(t, otherWallet, settings.Wallet)
+		assert.Equal(t, initial.Email, settings.Email)
+		assert.Equal(t, &pb.NodeMetadata{Email: initial.Email, Wallet: otherWallet, Region: "eu"}, rt.self.Metadata)
+		assert.Equal(t, 0, monitor.refreshes)
+	}
+
+	{ // an invalid wallet changes nothing
+		_, err := service.Update(ctx, operator.Settings{Wallet: "invalid", AllocatedBandwidth: memory.TB})
+		require.Error(t, err)
+		assert.Equal(t, otherWallet, service.Settings().Wallet)
+		assert.Equal(t, initial.AllocatedBandwidth, service.Settings().AllocatedBandwidth)
+	}
+
+	{ // allocations are applied and the capacity is announced
+		settings, err := service.Update(ctx, operator.Settings{AllocatedDiskSpace: 2 * memory.TB})
+		require.NoError(t, err)
+		assert.Equal(t, 2*memory.TB, settings.AllocatedDiskSpace)
+		assert.Equal(t, initial.AllocatedBandwidth, settings.AllocatedBandwidth)
+		assert.Equal(t, 2*memory.TB, storage.diskSpace)
+		assert.Equal(t, initial.AllocatedBandwidth, storage.bandwidth)
+		assert.Equal(t, 1, monitor.refreshes)
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package pairing authenticates the clients of the local api of the storage
+// node: the dashboard api, the dashboard stream and the kademlia inspector.
+package pairing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	// Error is the default pairing errs class
+	Error = errs.Class("pairing error")
+	// ErrNotFound is returned when an agent isn't paired
+	ErrNotFound = errs.Class("agent not found")
+)
+
+const (
+	// inspectorMethods is the prefix of the methods of the kademlia inspector
+	inspectorMethods = "/inspector.KadInspector/"
+	// dashboardMethod is the stream of the storagenode dashboard command
+	dashboardMethod = "/piecestoreroutes.PieceStoreRoutes/Dashboard"
+
+	// bearerPrefix precedes the token in the Authorization header
+	bearerPrefix = "Bearer "
+	// tokenSize is the number of random bytes of a token
+	tokenSize = 32
+)
+
+// Config contains the configuration of the local api authentication
+type Config struct {
+	Enabled bool `help:"require the dashboard api, dashboard and inspector clients to be the node itself or a paired agent" default:"true"`
+}
+
+// Authorizer allows only the node itself and the agents paired by the
+// operator to use the local api. The agents are read from the database on
+// every request, so agents paired while the node runs are accepted at once.
+type Authorizer struct {
+	log     *zap.Logger
+	self    storj.NodeID
+	db      *psdb.DB
+	enabled bool
+}
+
+// NewAuthorizer creates an authorizer of the local api of the node self
+func NewAuthorizer(log *zap.Logger, self storj.NodeID, db *psdb.DB, config Config) *Authorizer {
+	return &Authorizer{
+		log:     log,
+		self:    self,
+		db:      db,
+		enabled: config.Enabled,
+	}
+}
+
+// Pair pairs an agent with name and returns the token it authenticates with,
+// the token is shown only once. An agent with a non-zero nodeID can also
+// connect with that identity. Pairing an existing name replaces its token.
+func (authorizer *Authorizer) Pair(name string, nodeID storj.NodeID) (token string, err error) {
+	if name == "" {
+		return "", Error.New("agent name is empty")
+	}
+
+	random := make([]byte, tokenSize)
+	if _, err := rand.Read(random); err != nil {
+		return "", Error.Wrap(err)
+	}
+	token = base64.RawURLEncoding.EncodeToString(random)
+
+	err = authorizer.db.AddPairedAgent(psdb.PairedAgent{
+		Name:      name,
+		NodeID:    nodeID,
+		TokenHash: hashToken(token),
+		Created:   time.Now(),
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	authorizer.log.Info("agent paired", zap.String("name", name))
+	return token, nil
+}
+
+// Unpair removes the agent with name, its token and identity are refused afterwards
+func (authorizer *Authorizer) Unpair(name string) error {
+	err := authorizer.db.DeletePairedAgent(name)
+	if err == sql.ErrNoRows {
+		return ErrNotFound.New("%q", name)
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	authorizer.log.Info("agent unpaired", zap.String("name", name))
+	return nil
+}
+
+// Agents returns the paired agents
+func (authorizer *Authorizer) Agents() ([]psdb.PairedAgent, error) {
+	agents, err := authorizer.db.GetPairedAgents()
+	return agents, Error.Wrap(err)
+}
+
+// AuthorizeToken returns the name of the agent paired with token
+func (authorizer *Authorizer) AuthorizeToken(token string) (name string, err error) {
+	if token == "" {
+		return "", Error.New("missing token")
+	}
+
+	agents, err := authorizer.db.GetPairedAgents()
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	hash := hashToken(token)
+	for _, agent := range agents {
+		if string(agent.TokenHash) == string(hash) {
+			return agent.Name, nil
+		}
+	}
+	return "", Error.New("invalid token")
+}
+
+// AuthorizePeer checks that the peer of ctx is the node itself or a paired agent
+func (authorizer *Authorizer) AuthorizePeer(ctx context.Context) error {
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if peer.ID == authorizer.self {
+		return nil
+	}
+
+	agents, err := authorizer.db.GetPairedAgents()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, agent := range agents {
+		if !agent.NodeID.IsZero() && agent.NodeID == peer.ID {
+			return nil
+		}
+	}
+	return Error.New("%s isn't paired", peer.ID)
+}
+
+// UnaryInterceptor refuses the inspector requests of unpaired peers
+func (authorizer *Authorizer) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := authorizer.authorizeMethod(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor refuses the dashboard streams of unpaired peers
+func (authorizer *Authorizer) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := authorizer.authorizeMethod(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// authorizeMethod checks the peer of ctx when method belongs to the local api
+func (authorizer *Authorizer) authorizeMethod(ctx context.Context, method string) error {
+	if !authorizer.enabled {
+		return nil
+	}
+	if !strings.HasPrefix(method, inspectorMethods) && method != dashboardMethod {
+		return nil
+	}
+
+	if err := authorizer.AuthorizePeer(ctx); err != nil {
+		authorizer.log.Debug("refused local api request", zap.String("method", method), zap.Error(err))
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
+
+// Handler refuses the requests to next without the token of a paired agent in
+// the Authorization header
+func (authorizer *Authorizer) Handler(next http.Handler) http.Handler {
+	if !authorizer.enabled {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		header := req.Header.Get("Authorization")
+		if !strings.HasPrefix(header, bearerPrefix) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "missing token, pair an agent with the storagenode pair command", http.StatusUnauthorized)
+			return
+		}
+
+		if _, err := authorizer.AuthorizeToken(strings.TrimPrefix(header, bearerPrefix)); err != nil {
+			authorizer.log.Debug("refused dashboard api request", zap.String("path", req.URL.Path), zap.Error(err))
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// hashToken returns what is stored of token
+func hashToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
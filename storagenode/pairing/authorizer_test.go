@@ -0,0 +1,85 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pairing_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/pairing"
+)
+
+func TestAuthorizer(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := psdb.OpenInMemory()
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	self := teststorj.NodeIDFromString("self")
+	agentID := teststorj.NodeIDFromString("agent")
+	authorizer := pairing.NewAuthorizer(zap.NewNop(), self, db, pairing.Config{Enabled: true})
+
+	monitoring, err := authorizer.Pair("monitoring", agentID)
+	require.NoError(t, err)
+	phone, err := authorizer.Pair("phone", storj.NodeID{})
+	require.NoError(t, err)
+	assert.NotEqual(t, monitoring, phone)
+
+	agents, err := authorizer.Agents()
+	require.NoError(t, err)
+	require.Len(t, agents, 2)
+	assert.Equal(t, "monitoring", agents[0].Name)
+	assert.Equal(t, agentID, agents[0].NodeID)
+	assert.True(t, agents[1].NodeID.IsZero())
+
+	name, err := authorizer.AuthorizeToken(phone)
+	require.NoError(t, err)
+	assert.Equal(t, "phone", name)
+
+	_, err = authorizer.AuthorizeToken("invalid")
+	assert.Error(t, err)
+
+	server := httptest.NewServer(authorizer.Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})))
+	defer server.Close()
+
+	get := func(token string) int {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusNoContent, get(monitoring))
+	assert.Equal(t, http.StatusUnauthorized, get(""))
+	assert.Equal(t, http.StatusUnauthorized, get("invalid"))
+
+	// the token of an unpaired agent is refused at once
+	require.NoError(t, authorizer.Unpair("monitoring"))
+	assert.Equal(t, http.StatusUnauthorized, get(monitoring))
+	assert.True(t, pairing.ErrNotFound.Has(authorizer.Unpair("monitoring")))
+
+	// pairing again replaces the token
+	replaced, err := authorizer.Pair("phone", storj.NodeID{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, get(phone))
+	assert.Equal(t, http.StatusNoContent, get(replaced))
+}
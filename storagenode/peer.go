@@ -6,23 +6,34 @@ package storagenode
 import (
 	"context"
 	"net"
+	"net/http"
+	"os"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 
+	"storj.io/storj/internal/version"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
 	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/lazyfilewalker"
 	"storj.io/storj/pkg/piecestore/psserver"
 	"storj.io/storj/pkg/piecestore/psserver/agreementsender"
 	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/relay"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/storage"
+	"storj.io/storj/storagenode/dashboardapi"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/operator"
+	"storj.io/storj/storagenode/pairing"
+	"storj.io/storj/storagenode/preflight"
+	"storj.io/storj/storagenode/trust"
 )
 
 // DB is the master database for Storage Node
@@ -45,11 +56,21 @@ type Config struct {
 	Server   server.Config
 	Kademlia kademlia.Config
 	Storage  psserver.Config
+	Relay    relay.Config
+	Trust    trust.Config
+
+	DashboardAPI  dashboardapi.Config
+	Notifications notifications.Config
+	Pairing       pairing.Config
+	Preflight     preflight.Config
 }
 
 // Verify verifies whether configuration is consistent and acceptable.
 func (config *Config) Verify(log *zap.Logger) error {
-	return config.Kademlia.Verify(log)
+	return errs.Combine(
+		config.Kademlia.Verify(log),
+		config.Storage.WriteOptions().Verify(),
+	)
 }
 
 // Peer is the representation of a Storage Node.
@@ -65,6 +86,8 @@ type Peer struct {
 	Public struct {
 		Listener net.Listener
 		Server   *server.Server
+		// Relay accepts connections through the relay when configured
+		Relay *relay.Listener
 	}
 
 	// services and endpoints
@@ -76,15 +99,43 @@ type Peer struct {
 		Inspector    *kademlia.Inspector
 	}
 
+	Trust struct {
+		Pool *trust.Pool
+	}
+
 	Storage struct {
-		Endpoint  *psserver.Server // TODO: separate into endpoint and service
-		Monitor   *psserver.Monitor
-		Collector *psserver.Collector
+		Endpoint   *psserver.Server // TODO: separate into endpoint and service
+		Monitor    *psserver.Monitor
+		Collector  *psserver.Collector
+		UsedSpace  *psserver.UsedSpaceWalker
+		DiskHealth *psserver.DiskHealth // nil when the disk isn't monitored
 	}
 
 	Agreements struct {
 		Sender *agreementsender.AgreementSender
 	}
+
+	Notifications struct {
+		Service *notifications.Service
+	}
+
+	Operator struct {
+		Service *operator.Service
+	}
+
+	Pairing struct {
+		Authorizer *pairing.Authorizer
+	}
+
+	Preflight struct {
+		Checker *preflight.Checker
+	}
+
+	// DashboardAPI is nil when it's disabled
+	DashboardAPI struct {
+		Listener net.Listener
+		Endpoint *dashboardapi.Server
+	}
 }
 
 // New creates a new Storage Node.
@@ -98,19 +149,33 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config) (*P
 
 	var err error
 
+	{ // setup local api authentication
+		peer.Pairing.Authorizer = pairing.NewAuthorizer(peer.Log.Named("pairing"), peer.ID(), peer.DB.PSDB(), config.Pairing)
+	}
+
 	{ // setup listener and server
 		peer.Public.Listener, err = net.Listen("tcp", config.Server.Address)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		publicConfig := server.Config{Address: peer.Public.Listener.Addr().String()}
+		publicConfig := server.Config{
+			Address:         peer.Public.Listener.Addr().String(),
+			ConnectionLimit: config.Server.ConnectionLimit,
+		}
 		publicOptions, err := server.NewOptions(peer.Identity, publicConfig)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		publicOptions.StreamInterceptor = peer.Pairing.Authorizer.StreamInterceptor
+
+		listener := peer.Public.Listener
+		if config.Relay.Address != "" {
+			peer.Public.Relay = relay.NewListener(peer.Log.Named("relay"), peer.Identity, peer.Public.Listener, config.Relay)
+			listener = peer.Public.Relay
+		}
 
-		peer.Public.Server, err = server.New(publicOptions, peer.Public.Listener, nil)
+		peer.Public.Server, err = server.New(publicOptions, listener, peer.Pairing.Authorizer.UnaryInterceptor)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -123,16 +188,27 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config) (*P
 			config.ExternalAddress = peer.Public.Server.Addr().String()
 		}
 
+		address := &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   config.ExternalAddress,
+		}
+		if peer.Public.Relay != nil {
+			// the node can't be reached directly, advertise the relay instead
+			address = &pb.NodeAddress{
+				Transport: pb.NodeTransport_RELAY_TCP_TLS_GRPC,
+				Address:   peer.Public.Relay.RelayAddress(),
+			}
+		}
+
 		self := pb.Node{
-			Id:   peer.ID(),
-			Type: pb.NodeType_STORAGE,
-			Address: &pb.NodeAddress{
-				Transport: pb.NodeTransport_TCP_TLS_GRPC,
-				Address:   config.ExternalAddress,
-			},
+			Id:      peer.ID(),
+			Type:    pb.NodeType_STORAGE,
+			Address: address,
 			Metadata: &pb.NodeMetadata{
-				Email:  config.Operator.Email,
-				Wallet: config.Operator.Wallet,
+				Email:   config.Operator.Email,
+				Wallet:  config.Operator.Wallet,
+				Region:  config.Operator.Region,
+				Version: version.Version,
 			},
 		}
 
@@ -148,27 +224,54 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config) (*P
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Kademlia.Endpoint = kademlia.NewEndpoint(peer.Log.Named("kademlia:endpoint"), peer.Kademlia.Service, peer.Kademlia.RoutingTable)
+		peer.Kademlia.Endpoint = kademlia.NewEndpoint(peer.Log.Named("kademlia:endpoint"), peer.Kademlia.Service, peer.Kademlia.RoutingTable, config.RateLimit)
 		pb.RegisterNodesServer(peer.Public.Server.GRPC(), peer.Kademlia.Endpoint)
 
 		peer.Kademlia.Inspector = kademlia.NewInspector(peer.Kademlia.Service, peer.Identity)
 		pb.RegisterKadInspectorServer(peer.Public.Server.GRPC(), peer.Kademlia.Inspector)
 	}
 
+	{ // setup trusted satellites
+		peer.Trust.Pool, err = trust.NewPool(peer.Log.Named("trust"), peer.DB.PSDB(), peer.DB.Storage(), config.Storage, config.Trust)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	{ // setup piecestore
 		// TODO: move this setup logic into psstore package
 		config := config.Storage
 
+		if config.DiskHealthEnabled {
+			smartctl := psserver.Smartctl{Path: config.DiskHealthSmartctl, Device: config.DiskHealthDevice}
+			peer.Storage.DiskHealth = psserver.NewDiskHealth(peer.Log.Named("piecestore:diskhealth"), smartctl, config.DiskHealthInterval, config.DiskHealthMaxReallocatedSectors)
+		}
+
 		// TODO: psserver shouldn't need the private key
-		peer.Storage.Endpoint, err = psserver.NewEndpoint(peer.Log.Named("piecestore"), config, peer.DB.Storage(), peer.DB.PSDB(), peer.Identity.Key, peer.Kademlia.Service)
+		peer.Storage.Endpoint, err = psserver.NewEndpoint(peer.Log.Named("piecestore"), config, peer.DB.Storage(), peer.DB.PSDB(), peer.Identity.Key, peer.Kademlia.Service, peer.Storage.DiskHealth, peer.Trust.Pool)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
 		pb.RegisterPieceStoreRoutesServer(peer.Public.Server.GRPC(), peer.Storage.Endpoint)
+		peer.Kademlia.Endpoint.SetStandingReceiver(peer.Storage.Endpoint)
 
 		// TODO: organize better
 		peer.Storage.Monitor = psserver.NewMonitor(peer.Log.Named("piecestore:monitor"), config.KBucketRefreshInterval, peer.Kademlia.RoutingTable, peer.Storage.Endpoint)
-		peer.Storage.Collector = psserver.NewCollector(peer.Log.Named("piecestore:collector"), peer.DB.PSDB(), peer.DB.Storage(), config.CollectorInterval)
+		peer.Storage.Collector = psserver.NewCollector(peer.Log.Named("piecestore:collector"), peer.DB.PSDB(), peer.DB.Storage(), config.CollectorInterval, config.TrashRetention, config.CollectorBatchSize)
+
+		var lazy *lazyfilewalker.Supervisor
+		if config.LazyFilewalkerEnabled {
+			executable, err := os.Executable()
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			lazy = lazyfilewalker.NewSupervisor(peer.Log.Named("piecestore:lazyfilewalker"), executable)
+		}
+		peer.Storage.UsedSpace = psserver.NewUsedSpaceWalker(peer.Log.Named("piecestore:usedspace"), peer.DB.PSDB(), peer.DB.Storage(), lazy, config.UsedSpaceInterval)
+	}
+
+	{ // setup preflight checks
+		peer.Preflight.Checker = preflight.NewChecker(peer.Log.Named("preflight"), peer.ID(), peer.DB.Storage().Dir(), peer.DB.PSDB(), peer.Kademlia.Service, peer.Trust.Pool, config.Preflight)
 	}
 
 	{ // agreements
@@ -176,17 +279,66 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config) (*P
 		peer.Agreements.Sender = agreementsender.New(
 			peer.Log.Named("agreements"),
 			peer.DB.PSDB(), peer.Identity, peer.Kademlia.Service,
-			config.AgreementSenderCheckInterval,
+			agreementsender.Config{
+				CheckInterval:    config.AgreementSenderCheckInterval,
+				Jitter:           config.AgreementSenderJitter,
+				BatchWindow:      config.AgreementSenderBatchWindow,
+				Shuffle:          config.AgreementSenderShuffle,
+				SettlementWindow: config.AgreementSettlementWindow,
+				VacuumInterval:   config.DatabaseVacuumInterval,
+			},
 		)
 	}
 
+	{ // setup notifications
+		peer.Notifications.Service = notifications.NewService(peer.Log.Named("notifications"), peer.DB.PSDB(), peer.Storage.Endpoint, config.Notifications)
+	}
+
+	{ // setup operator settings
+		peer.Operator.Service = operator.NewService(peer.Log.Named("operator"), operator.Settings{
+			Email:              config.Kademlia.Operator.Email,
+			Wallet:             config.Kademlia.Operator.Wallet,
+			AllocatedDiskSpace: config.Storage.AllocatedDiskSpace,
+			AllocatedBandwidth: config.Storage.AllocatedBandwidth,
+		}, peer.Kademlia.RoutingTable, peer.Storage.Endpoint, peer.Storage.Monitor)
+	}
+
+	if config.DashboardAPI.Address != "" { // setup dashboard api
+		peer.DashboardAPI.Listener, err = net.Listen("tcp", config.DashboardAPI.Address)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.DashboardAPI.Endpoint = dashboardapi.NewServer(peer.Log.Named("dashboardapi"), peer.Storage.Endpoint, peer.Notifications.Service, peer.Operator.Service, peer.Pairing.Authorizer, peer.DashboardAPI.Listener)
+	}
+
 	return peer, nil
 }
 
 // Run runs storage node until it's either closed or it errors.
 func (peer *Peer) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 	group, ctx := errgroup.WithContext(ctx)
 
+	// the public server runs during the preflight checks, so the satellites can reach the node
+	if peer.Public.Relay != nil {
+		group.Go(func() error {
+			return ignoreCancel(peer.Public.Relay.Run(ctx))
+		})
+	}
+	group.Go(func() error {
+		// TODO: move the message into Server instead
+		peer.Log.Sugar().Infof("Node %s started on %s", peer.Identity.ID, peer.Public.Server.Addr().String())
+		return ignoreCancel(peer.Public.Server.Run(ctx))
+	})
+
+	if err := peer.Preflight.Checker.Run(ctx); err != nil {
+		peer.Log.Error("Refusing to start, fix the failed preflight checks", zap.Error(err))
+		cancel()
+		return errs.Combine(err, group.Wait())
+	}
+
 	group.Go(func() error {
 		return ignoreCancel(peer.Kademlia.Service.Bootstrap(ctx))
 	})
@@ -203,16 +355,30 @@ func (peer *Peer) Run(ctx context.Context) error {
 		return ignoreCancel(peer.Storage.Collector.Run(ctx))
 	})
 	group.Go(func() error {
-		// TODO: move the message into Server instead
-		peer.Log.Sugar().Infof("Node %s started on %s", peer.Identity.ID, peer.Public.Server.Addr().String())
-		return ignoreCancel(peer.Public.Server.Run(ctx))
+		return ignoreCancel(peer.Storage.UsedSpace.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Notifications.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Trust.Pool.Run(ctx))
+	})
+	if peer.Storage.DiskHealth != nil {
+		group.Go(func() error {
+			return ignoreCancel(peer.Storage.DiskHealth.Run(ctx))
+		})
+	}
+	if peer.DashboardAPI.Endpoint != nil {
+		group.Go(func() error {
+			return ignoreCancel(peer.DashboardAPI.Endpoint.Run(ctx))
+		})
+	}
 
 	return group.Wait()
 }
 
 func ignoreCancel(err error) error {
-	if err == context.Canceled || err == grpc.ErrServerStopped {
+	if err == context.Canceled || err == grpc.ErrServerStopped || err == http.ErrServerClosed {
 		return nil
 	}
 	return err
@@ -229,11 +395,19 @@ func (peer *Peer) Close() error {
 		errlist.Add(peer.Public.Server.Close())
 	} else {
 		// peer.Public.Server automatically closes listener
-		if peer.Public.Listener != nil {
+		if peer.Public.Relay != nil {
+			errlist.Add(peer.Public.Relay.Close())
+		} else if peer.Public.Listener != nil {
 			errlist.Add(peer.Public.Listener.Close())
 		}
 	}
 
+	if peer.DashboardAPI.Endpoint != nil {
+		errlist.Add(peer.DashboardAPI.Endpoint.Close())
+	} else if peer.DashboardAPI.Listener != nil {
+		errlist.Add(peer.DashboardAPI.Listener.Close())
+	}
+
 	// close services in reverse initialization order
 	if peer.Storage.Endpoint != nil {
 		errlist.Add(peer.Storage.Endpoint.Close())
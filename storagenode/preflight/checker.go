@@ -0,0 +1,226 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package preflight checks on startup that the storage node can work, so a
+// misconfigured node refuses to start instead of failing audits until the
+// satellites disqualify it.
+package preflight
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the error class of a failed check
+	Error = errs.Class("preflight check failed")
+)
+
+// testFileSize is the size of the file written to the storage directory
+const testFileSize = 4096
+
+// Config contains the configuration of the preflight checks
+type Config struct {
+	Enabled      bool          `help:"refuse to start when the clock, the storage directory, the identity or the external address is unusable" default:"true"`
+	Timeout      time.Duration `help:"how long the satellites are waited for" default:"1m0s"`
+	MaxClockSkew time.Duration `help:"largest difference to the clock of a satellite the node starts with" default:"5m0s"`
+}
+
+// DB records which node the database belongs to
+type DB interface {
+	GetNodeID() (storj.NodeID, error)
+	SetNodeID(id storj.NodeID) error
+}
+
+// Network finds the satellites and asks them to ping the node back
+type Network interface {
+	GetBootstrapNodes() []pb.Node
+	FindNode(ctx context.Context, id storj.NodeID) (pb.Node, error)
+	PingBack(ctx context.Context, node pb.Node) (*pb.PingResponse, error)
+}
+
+// Satellites returns the satellites the node stores data for
+type Satellites interface {
+	Trusted() storj.NodeIDList
+}
+
+// Checker runs the preflight checks
+type Checker struct {
+	log        *zap.Logger
+	self       storj.NodeID
+	storageDir string
+	db         DB
+	network    Network
+	satellites Satellites
+	config     Config
+}
+
+// NewChecker creates the preflight checks of node self
+func NewChecker(log *zap.Logger, self storj.NodeID, storageDir string, db DB, network Network, satellites Satellites, config Config) *Checker {
+	return &Checker{
+		log:        log,
+		self:       self,
+		storageDir: storageDir,
+		db:         db,
+		network:    network,
+		satellites: satellites,
+		config:     config,
+	}
+}
+
+// Run runs every check and returns why the node mustn't start, the public
+// server must be running so the satellites can reach the node
+func (checker *Checker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !checker.config.Enabled {
+		checker.log.Warn("Preflight checks are disabled")
+		return nil
+	}
+
+	var group errs.Group
+	group.Add(checker.CheckStorage())
+	group.Add(checker.CheckIdentity())
+	group.Add(checker.CheckNetwork(ctx))
+	if err := group.Err(); err != nil {
+		return err
+	}
+
+	checker.log.Info("Preflight checks passed")
+	return nil
+}
+
+// CheckStorage checks that the storage directory reads back what is written to it
+func (checker *Checker) CheckStorage() error {
+	if err := os.MkdirAll(checker.storageDir, 0700); err != nil {
+		return Error.New("storage directory %q can't be created: %v", checker.storageDir, err)
+	}
+
+	file, err := ioutil.TempFile(checker.storageDir, ".preflight-")
+	if err != nil {
+		return Error.New("storage directory %q isn't writable: %v", checker.storageDir, err)
+	}
+	path := file.Name()
+
+	data := make([]byte, testFileSize)
+	_, err = rand.Read(data)
+	if err == nil {
+		_, err = file.Write(data)
+	}
+	err = errs.Combine(err, file.Sync(), file.Close())
+	if err != nil {
+		return Error.New("storage directory %q isn't writable: %v", checker.storageDir, errs.Combine(err, os.Remove(path)))
+	}
+
+	read, err := ioutil.ReadFile(path)
+	err = errs.Combine(err, os.Remove(path))
+	if err != nil {
+		return Error.New("storage directory %q isn't readable: %v", checker.storageDir, err)
+	}
+	if !bytes.Equal(read, data) {
+		return Error.New("storage directory %q returned different data than was written", checker.storageDir)
+	}
+	return nil
+}
+
+// CheckIdentity checks that the database belongs to the identity of the
+// node, the pieces of another node would fail every audit
+func (checker *Checker) CheckIdentity() error {
+	recorded, err := checker.db.GetNodeID()
+	if err != nil {
+		return Error.New("database can't be read: %v", err)
+	}
+
+	if recorded.IsZero() {
+		// databases created before the node was recorded belong to the current identity
+		if err := checker.db.SetNodeID(checker.self); err != nil {
+			return Error.New("database isn't writable: %v", err)
+		}
+		return nil
+	}
+
+	if recorded != checker.self {
+		return Error.New("database belongs to node %s but the identity is of node %s, start the node with the identity it was created with",
+			recorded, checker.self)
+	}
+	return nil
+}
+
+// CheckNetwork checks that the clock of the node is in sync with the
+// satellites and that the satellites can reach the node at its external
+// address. The bootstrap nodes are asked as well, so a new node is checked
+// before it found the satellites.
+func (checker *Checker) CheckNetwork(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithTimeout(ctx, checker.config.Timeout)
+	defer cancel()
+
+	targets := checker.network.GetBootstrapNodes()
+	for _, id := range checker.satellites.Trusted() {
+		node, err := checker.network.FindNode(ctx, id)
+		if err != nil {
+			checker.log.Debug("satellite not found", zap.Stringer("Satellite ID", id), zap.Error(err))
+			continue
+		}
+		targets = append(targets, node)
+	}
+	if len(targets) == 0 {
+		checker.log.Warn("No satellites or bootstrap nodes to check the clock and the external address with")
+		return nil
+	}
+
+	var group errs.Group
+	var answered, asked, reachedBy int
+	var reasons []string
+	for _, target := range targets {
+		before := time.Now()
+		resp, err := checker.network.PingBack(ctx, target)
+		if err != nil {
+			checker.log.Debug("node didn't answer", zap.String("address", target.GetAddress().GetAddress()), zap.Error(err))
+			continue
+		}
+		answered++
+
+		// nodes which don't tell their clock don't ping back either
+		if resp.GetSentUnixSec() == 0 {
+			continue
+		}
+
+		// the node answered at some point between sending the request and receiving the response
+		local := before.Add(time.Since(before) / 2)
+		skew := time.Unix(resp.GetSentUnixSec(), 0).Sub(local)
+		if skew > checker.config.MaxClockSkew || -skew > checker.config.MaxClockSkew {
+			group.Add(Error.New("clock differs by %s from the clock of %s, sync the system clock (e.g. with ntp)",
+				skew.Round(time.Second), target.GetAddress().GetAddress()))
+		}
+
+		asked++
+		if resp.GetPingbackOk() {
+			reachedBy++
+		} else {
+			reasons = append(reasons, resp.GetPingbackError())
+		}
+	}
+
+	if answered == 0 {
+		group.Add(Error.New("none of the %d satellites and bootstrap nodes answered, check the network connection", len(targets)))
+	}
+	if asked > 0 && reachedBy == 0 {
+		group.Add(Error.New("the node isn't reachable from the outside, check the external address and the port forwarding: %v", reasons))
+	}
+	return group.Err()
+}
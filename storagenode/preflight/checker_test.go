@@ -0,0 +1,110 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package preflight_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/preflight"
+)
+
+type network struct {
+	satellites map[storj.NodeID]pb.Node
+	responses  map[string]*pb.PingResponse
+}
+
+func (network *network) GetBootstrapNodes() []pb.Node { return nil }
+
+func (network *network) FindNode(ctx context.Context, id storj.NodeID) (pb.Node, error) {
+	node, ok := network.satellites[id]
+	if !ok {
+		return pb.Node{}, errors.New("not found")
+	}
+	return node, nil
+}
+
+func (network *network) PingBack(ctx context.Context, node pb.Node) (*pb.PingResponse, error) {
+	resp, ok := network.responses[node.Address.Address]
+	if !ok {
+		return nil, errors.New("unreachable")
+	}
+	return resp, nil
+}
+
+type satellites storj.NodeIDList
+
+func (satellites satellites) Trusted() storj.NodeIDList { return storj.NodeIDList(satellites) }
+
+func TestChecker(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := psdb.OpenInMemory()
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	self := teststorj.NodeIDFromString("self")
+	satellite1 := teststorj.NodeIDFromString("satellite1")
+	satellite2 := teststorj.NodeIDFromString("satellite2")
+	net := &network{
+		satellites: map[storj.NodeID]pb.Node{
+			satellite1: {Id: satellite1, Address: &pb.NodeAddress{Address: "satellite1"}},
+			satellite2: {Id: satellite2, Address: &pb.NodeAddress{Address: "satellite2"}},
+		},
+		responses: map[string]*pb.PingResponse{
+			"satellite1": {SentUnixSec: time.Now().Unix(), PingbackOk: true},
+			"satellite2": {SentUnixSec: time.Now().Unix(), PingbackError: "connection refused"},
+		},
+	}
+	config := preflight.Config{Enabled: true, Timeout: time.Minute, MaxClockSkew: 5 * time.Minute}
+
+	checker := preflight.NewChecker(zap.NewNop(), self, ctx.Dir("storage"), db, net, satellites{satellite1, satellite2}, config)
+	require.NoError(t, checker.Run(ctx))
+
+	recorded, err := db.GetNodeID()
+	require.NoError(t, err)
+	assert.Equal(t, self, recorded)
+
+	{ // the database of another node
+		other := preflight.NewChecker(zap.NewNop(), teststorj.NodeIDFromString("other"), ctx.Dir("storage"), db, net, satellites{}, config)
+		assert.True(t, preflight.Error.Has(other.CheckIdentity()))
+	}
+
+	{ // the storage directory is inside a file
+		file := ctx.File("file")
+		require.NoError(t, ioutil.WriteFile(file, []byte("file"), 0644))
+		broken := preflight.NewChecker(zap.NewNop(), self, filepath.Join(file, "storage"), db, net, satellites{}, config)
+		assert.Error(t, broken.CheckStorage())
+	}
+
+	{ // no satellite reaches the node
+		net.responses["satellite1"].PingbackOk = false
+		assert.Error(t, checker.CheckNetwork(ctx))
+		net.responses["satellite1"].PingbackOk = true
+	}
+
+	{ // the clock is off
+		net.responses["satellite2"].SentUnixSec = time.Now().Add(time.Hour).Unix()
+		assert.Error(t, checker.CheckNetwork(ctx))
+	}
+
+	{ // no satellite answers
+		net.responses = nil
+		assert.Error(t, checker.CheckNetwork(ctx))
+	}
+}
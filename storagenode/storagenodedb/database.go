@@ -23,6 +23,9 @@ type Config struct {
 	Storage  string
 	Info     string
 	Kademlia string
+
+	// Write is how pieces are written to Storage
+	Write pstore.WriteOptions
 }
 
 // DB contains access to different database tables
@@ -34,7 +37,7 @@ type DB struct {
 
 // New creates a new master database for storage node
 func New(config Config) (*DB, error) {
-	storage := pstore.NewStorage(config.Storage)
+	storage := pstore.NewStorageWithOptions(config.Storage, config.Write)
 
 	psdb, err := psdb.Open(config.Info)
 	if err != nil {
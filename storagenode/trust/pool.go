@@ -0,0 +1,324 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package trust keeps track of the satellites the storage node stores data for.
+package trust
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/psserver"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default trust errs class
+	Error = errs.Class("trust error")
+)
+
+const (
+	// fetchTimeout is how long fetching a list of trusted satellites may take
+	fetchTimeout = 30 * time.Second
+	// maxListSize is the largest list of trusted satellites which is read
+	maxListSize = memory.MiB
+)
+
+// Config contains the configurable values of the trusted satellites
+type Config struct {
+	Sources         string        `help:"comma-separated list of urls of trusted satellite lists, which contain a satellite id per line" default:""`
+	RefreshInterval time.Duration `help:"how often the trusted satellites are refreshed from the sources and the explicitly trusted satellites" default:"15m0s"`
+	GracePeriod     time.Duration `help:"how long the pieces of a satellite which is no longer trusted are kept before they are deleted, 0 never deletes them" default:"168h0m0s"`
+}
+
+// Pool decides which satellites are trusted, from the whitelist of the
+// piecestore config, the lists of the sources and the satellites the operator
+// trusted or untrusted explicitly. The pieces of satellites which are no
+// longer trusted are deleted after a grace period.
+type Pool struct {
+	log     *zap.Logger
+	db      *psdb.DB
+	storage *pstore.Storage
+	config  Config
+	client  *http.Client
+
+	restricted bool
+	static     storj.NodeIDList
+	sources    []string
+
+	mu        sync.RWMutex
+	trusted   map[storj.NodeID]struct{}
+	untrusted map[storj.NodeID]struct{}
+	fetched   map[string]storj.NodeIDList // last fetched list of each source
+}
+
+// NewPool creates a pool of trusted satellites, the satellites of the
+// sources are only trusted after they were fetched by Refresh
+func NewPool(log *zap.Logger, db *psdb.DB, storage *pstore.Storage, storageConfig psserver.Config, config Config) (*Pool, error) {
+	static, err := storageConfig.WhitelistedSatellites()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var sources []string
+	for _, source := range strings.Split(config.Sources, ",") {
+		if source = strings.TrimSpace(source); source != "" {
+			sources = append(sources, source)
+		}
+	}
+
+	pool := &Pool{
+		log:     log,
+		db:      db,
+		storage: storage,
+		config:  config,
+		client:  &http.Client{Timeout: fetchTimeout},
+
+		restricted: storageConfig.SatelliteIDRestriction || len(sources) > 0,
+		static:     static,
+		sources:    sources,
+
+		fetched: make(map[string]storj.NodeIDList),
+	}
+	if err := pool.reload(); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// IsTrusted returns whether the node stores data for the satellite, an
+// untrusted satellite is never trusted and every other satellite is trusted
+// when the node doesn't restrict the satellites
+func (pool *Pool) IsTrusted(id storj.NodeID) bool {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	if _, ok := pool.untrusted[id]; ok {
+		return false
+	}
+	if !pool.restricted {
+		return true
+	}
+	_, ok := pool.trusted[id]
+	return ok
+}
+
+// Trusted returns the satellites which are trusted, it's empty when the node
+// doesn't restrict the satellites
+func (pool *Pool) Trusted() storj.NodeIDList {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	ids := make(storj.NodeIDList, 0, len(pool.trusted))
+	for id := range pool.trusted {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Untrusted returns the satellites the operator explicitly stopped trusting
+func (pool *Pool) Untrusted() storj.NodeIDList {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	ids := make(storj.NodeIDList, 0, len(pool.untrusted))
+	for id := range pool.untrusted {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Restricted returns whether only the listed satellites are trusted
+func (pool *Pool) Restricted() bool {
+	return pool.restricted
+}
+
+// Run refreshes the trusted satellites and deletes the pieces of the
+// satellites which are no longer trusted at regular intervals
+func (pool *Pool) Run(ctx context.Context) error {
+	ticker := time.NewTicker(pool.config.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := pool.Refresh(ctx); err != nil {
+			pool.log.Error("refresh", zap.Error(err))
+		}
+		if err := pool.Cleanup(ctx); err != nil {
+			pool.log.Error("cleanup", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the pool is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// Refresh fetches the lists of the sources and reloads the explicitly
+// trusted satellites, a source which can't be fetched keeps its last list
+func (pool *Pool) Refresh(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var errlist errs.Group
+	for _, source := range pool.sources {
+		ids, err := pool.fetch(ctx, source)
+		if err != nil {
+			errlist.Add(Error.New("failed to fetch %s: %v", source, err))
+			continue
+		}
+		pool.mu.Lock()
+		pool.fetched[source] = ids
+		pool.mu.Unlock()
+	}
+
+	errlist.Add(pool.reload())
+	return errlist.Err()
+}
+
+// reload rebuilds the trusted satellites from the static list, the fetched
+// lists and the explicitly trusted satellites in the database
+func (pool *Pool) reload() error {
+	explicit, err := pool.db.GetSatelliteTrust()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	trusted := make(map[storj.NodeID]struct{})
+	for _, id := range pool.static {
+		trusted[id] = struct{}{}
+	}
+	for _, ids := range pool.fetched {
+		for _, id := range ids {
+			trusted[id] = struct{}{}
+		}
+	}
+
+	untrusted := make(map[storj.NodeID]struct{})
+	for id, isTrusted := range explicit {
+		if isTrusted {
+			trusted[id] = struct{}{}
+		} else {
+			delete(trusted, id)
+			untrusted[id] = struct{}{}
+		}
+	}
+
+	pool.trusted, pool.untrusted = trusted, untrusted
+	return nil
+}
+
+// fetch returns the satellite ids listed at source, empty lines and lines
+// starting with # are skipped
+func (pool *Pool) fetch(ctx context.Context, source string) (ids storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequest(http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := pool.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errs.New("unexpected status %s", resp.Status)
+	}
+
+	scanner := bufio.NewScanner(http.MaxBytesReader(nil, resp.Body, maxListSize.Int64()))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// lines may be node urls of the form <node id>@<address>
+		if i := strings.Index(line, "@"); i >= 0 {
+			line = line[:i]
+		}
+		id, err := storj.NodeIDFromString(line)
+		if err != nil {
+			return nil, errs.New("invalid satellite id %q: %v", line, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, scanner.Err()
+}
+
+// Cleanup records since when the satellites with pieces on the node aren't
+// trusted and deletes their pieces once the grace period has passed
+func (pool *Pool) Cleanup(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pool.mu.RLock()
+	complete := len(pool.fetched) == len(pool.sources)
+	pool.mu.RUnlock()
+	if !complete {
+		// a satellite of a source which wasn't fetched yet isn't untrusted
+		return nil
+	}
+
+	satellites, err := pool.db.GetSatellitesWithPieces()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := time.Now()
+	for _, id := range satellites {
+		if pool.IsTrusted(id) {
+			err = pool.db.DeleteUntrustedSince(id)
+		} else {
+			err = pool.db.SetUntrustedSince(id, now)
+		}
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	if pool.config.GracePeriod <= 0 {
+		return nil
+	}
+
+	untrusted, err := pool.db.GetUntrustedSince()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var errlist errs.Group
+	for id, since := range untrusted {
+		if pool.IsTrusted(id) {
+			// trusted again since the satellite was checked
+			errlist.Add(pool.db.DeleteUntrustedSince(id))
+			continue
+		}
+		if now.Sub(since) < pool.config.GracePeriod {
+			continue
+		}
+
+		pool.log.Info("deleting the pieces of an untrusted satellite", zap.Stringer("Satellite ID", id), zap.Time("Untrusted Since", since))
+		_, err := psserver.ForgetSatellite(ctx, pool.log, pool, pool.db, pool.storage, id, nil)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+		errlist.Add(pool.db.DeleteUntrustedSince(id))
+	}
+	return Error.Wrap(errlist.Err())
+}
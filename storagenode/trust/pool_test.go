@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/piecestore/psserver"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestPool(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := psdb.OpenInMemory()
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	static := teststorj.NodeIDFromString("static")
+	listed := teststorj.NodeIDFromString("listed")
+	added := teststorj.NodeIDFromString("added")
+	other := teststorj.NodeIDFromString("other")
+
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, "# trusted satellites\n\n%s@127.0.0.1:7777\n", listed)
+	}))
+	defer source.Close()
+
+	pool, err := trust.NewPool(zap.NewNop(), db, nil,
+		psserver.Config{WhitelistedSatelliteIDs: static.String(), SatelliteIDRestriction: true},
+		trust.Config{Sources: source.URL, RefreshInterval: time.Hour})
+	require.NoError(t, err)
+
+	assert.True(t, pool.IsTrusted(static))
+	assert.False(t, pool.IsTrusted(listed), "trusted before the source was fetched")
+
+	require.NoError(t, pool.Refresh(ctx))
+	assert.True(t, pool.IsTrusted(static))
+	assert.True(t, pool.IsTrusted(listed))
+	assert.False(t, pool.IsTrusted(added))
+	assert.False(t, pool.IsTrusted(other))
+
+	require.NoError(t, db.SetSatelliteTrust(added, true))
+	require.NoError(t, db.SetSatelliteTrust(listed, false))
+	require.NoError(t, pool.Refresh(ctx))
+	assert.True(t, pool.IsTrusted(added))
+	assert.False(t, pool.IsTrusted(listed))
+	assert.Len(t, pool.Trusted(), 2)
+	assert.Len(t, pool.Untrusted(), 1)
+}
+
+func TestPoolUnrestricted(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := psdb.OpenInMemory()
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	removed := teststorj.NodeIDFromString("removed")
+	other := teststorj.NodeIDFromString("other")
+	require.NoError(t, db.SetSatelliteTrust(removed, false))
+
+	pool, err := trust.NewPool(zap.NewNop(), db, nil, psserver.Config{}, trust.Config{RefreshInterval: time.Hour})
+	require.NoError(t, err)
+
+	assert.False(t, pool.Restricted())
+	assert.True(t, pool.IsTrusted(other))
+	assert.False(t, pool.IsTrusted(removed))
+}